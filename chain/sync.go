@@ -18,11 +18,13 @@ import (
 	"time"
 
 	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/ratelimit"
 	"decred.org/dcrwallet/v5/rpc/client/dcrd"
 	"decred.org/dcrwallet/v5/validate"
 	"decred.org/dcrwallet/v5/wallet"
 	"github.com/decred/dcrd/blockchain/stake/v5"
 	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/chaincfg/v3"
 	"github.com/decred/dcrd/crypto/blake256"
 	"github.com/decred/dcrd/mixing/mixpool"
 	"github.com/decred/dcrd/wire"
@@ -38,6 +40,9 @@ type Syncer struct {
 	atomicWalletSynced     atomic.Uint32 // CAS (synced=1) when wallet syncing complete
 	atomicTargetSyncHeight atomic.Int32
 
+	// phase records the sync phase for SyncStatus reporting.
+	phase wallet.SyncPhaseTracker
+
 	wallet   *wallet.Wallet
 	opts     *RPCOptions
 	rpc      *dcrd.RPC
@@ -49,6 +54,10 @@ type Syncer struct {
 	discoverAccts bool
 	mu            sync.Mutex
 
+	// throttle limits the download rate and concurrent cfilter requests.
+	// A nil throttle does not limit.
+	throttle *ratelimit.Throttle
+
 	// Sidechain management
 	sidechains   wallet.SidechainForest
 	sidechainsMu sync.Mutex
@@ -110,6 +119,14 @@ func (s *Syncer) SetCallbacks(cb *Callbacks) {
 	s.cb = cb
 }
 
+// SetThrottle limits the concurrent compact filter requests of the syncer,
+// switching from the initial to steady state limits of the throttle once
+// synced.  Limiting the download rate additionally requires the RPCOptions
+// Dial function to dial with the throttle's Dial method.
+func (s *Syncer) SetThrottle(t *ratelimit.Throttle) {
+	s.throttle = t
+}
+
 // RPC returns the JSON-RPC client to the underlying dcrd node.
 func (s *Syncer) RPC() *dcrd.RPC {
 	return s.rpc
@@ -136,10 +153,24 @@ func (s *Syncer) Synced(ctx context.Context) (bool, int32) {
 	return synced, targetHeight
 }
 
+// SyncPhase returns the current sync phase and the block height the phase has
+// progressed through.
+func (s *Syncer) SyncPhase() (wallet.SyncPhase, int32) {
+	return s.phase.Phase()
+}
+
+// SyncBackend describes the syncer as an "rpc" backend syncing from the
+// configured dcrd server.
+func (s *Syncer) SyncBackend() (string, []string) {
+	return "rpc", []string{s.opts.Address}
+}
+
 // synced checks the atomic that controls wallet syncness and if previously
 // unsynced, updates to synced and notifies the callback, if set.
 func (s *Syncer) synced() {
 	swapped := s.atomicWalletSynced.CompareAndSwap(0, 1)
+	s.phase.Begin(wallet.SyncPhaseSynced)
+	s.throttle.Steady()
 	if swapped && s.cb != nil && s.cb.Synced != nil {
 		s.cb.Synced(true)
 	}
@@ -149,18 +180,21 @@ func (s *Syncer) synced() {
 // synced, updates to unsynced and notifies the callback, if set.
 func (s *Syncer) unsynced() {
 	swapped := s.atomicWalletSynced.CompareAndSwap(1, 0)
+	s.phase.Begin(wallet.SyncPhaseConnecting)
 	if swapped && s.cb != nil && s.cb.Synced != nil {
 		s.cb.Synced(false)
 	}
 }
 
 func (s *Syncer) fetchMissingCfiltersStart() {
+	s.phase.Begin(wallet.SyncPhaseFetchingCFilters)
 	if s.cb != nil && s.cb.FetchMissingCFiltersStarted != nil {
 		s.cb.FetchMissingCFiltersStarted()
 	}
 }
 
 func (s *Syncer) fetchMissingCfiltersProgress(startMissingCFilterHeight, endMissinCFilterHeight int32) {
+	s.phase.Progress(endMissinCFilterHeight)
 	if s.cb != nil && s.cb.FetchMissingCFiltersProgress != nil {
 		s.cb.FetchMissingCFiltersProgress(startMissingCFilterHeight, endMissinCFilterHeight)
 	}
@@ -173,14 +207,16 @@ func (s *Syncer) fetchMissingCfiltersFinished() {
 }
 
 func (s *Syncer) fetchHeadersStart() {
+	s.phase.Begin(wallet.SyncPhaseFetchingHeaders)
 	if s.cb != nil && s.cb.FetchHeadersStarted != nil {
 		s.cb.FetchHeadersStarted()
 	}
 }
 
-func (s *Syncer) fetchHeadersProgress(fetchedHeadersCount int32, lastHeaderTime int64) {
+func (s *Syncer) fetchHeadersProgress(fetchedHeadersCount int32, lastHeader *wire.BlockHeader) {
+	s.phase.Progress(int32(lastHeader.Height))
 	if s.cb != nil && s.cb.FetchHeadersProgress != nil {
-		s.cb.FetchHeadersProgress(fetchedHeadersCount, lastHeaderTime)
+		s.cb.FetchHeadersProgress(fetchedHeadersCount, lastHeader.Timestamp.Unix())
 	}
 }
 
@@ -190,6 +226,7 @@ func (s *Syncer) fetchHeadersFinished() {
 	}
 }
 func (s *Syncer) discoverAddressesStart() {
+	s.phase.Begin(wallet.SyncPhaseDiscoveringAddresses)
 	if s.cb != nil && s.cb.DiscoverAddressesStarted != nil {
 		s.cb.DiscoverAddressesStarted()
 	}
@@ -202,12 +239,14 @@ func (s *Syncer) discoverAddressesFinished() {
 }
 
 func (s *Syncer) rescanStart() {
+	s.phase.Begin(wallet.SyncPhaseRescanning)
 	if s.cb != nil && s.cb.RescanStarted != nil {
 		s.cb.RescanStarted()
 	}
 }
 
 func (s *Syncer) rescanProgress(rescannedThrough int32) {
+	s.phase.Progress(rescannedThrough)
 	if s.cb != nil && s.cb.RescanProgress != nil {
 		s.cb.RescanProgress(rescannedThrough)
 	}
@@ -261,7 +300,12 @@ func (s *Syncer) getHeaders(ctx context.Context) error {
 			g.Go(func() error {
 				header := headers[i]
 				hash := header.BlockHash()
+				release, err := s.throttle.Acquire(ctx)
+				if err != nil {
+					return err
+				}
 				filter, proofIndex, proof, err := s.rpc.CFilterV2(ctx, &hash)
+				release()
 				if err != nil {
 					return err
 				}
@@ -297,7 +341,7 @@ func (s *Syncer) getHeaders(ctx context.Context) error {
 			s.sidechainsMu.Unlock()
 		}
 
-		s.fetchHeadersProgress(int32(added), headers[len(headers)-1].Timestamp.Unix())
+		s.fetchHeadersProgress(int32(added), headers[len(headers)-1])
 
 		log.Infof("Fetched %d new header(s) ending at height %d from %s",
 			added, nodes[len(nodes)-1].Header.Height, s.rpc)
@@ -516,6 +560,79 @@ func (s *Syncer) waitRPCSync(ctx context.Context, minHeight int64) error {
 // locators.
 var hashStop chainhash.Hash
 
+// dial connects to the dcrd websocket JSON-RPC server described by o.
+func dial(ctx context.Context, o *RPCOptions, opts ...wsrpc.Option) (*wsrpc.Client, error) {
+	addr, err := normalizeAddress(o.Address, o.DefaultPort)
+	if err != nil {
+		return nil, errors.E(errors.Invalid, err)
+	}
+	if o.Insecure {
+		addr = "ws://" + addr + "/ws"
+	} else {
+		addr = "wss://" + addr + "/ws"
+	}
+	opts = append(make([]wsrpc.Option, 0, len(opts)+3), opts...)
+	if o.User != "" {
+		opts = append(opts, wsrpc.WithBasicAuth(o.User, o.Pass))
+	}
+	if o.Dial != nil {
+		opts = append(opts, wsrpc.WithDial(o.Dial))
+	}
+	if len(o.CA) != 0 && !o.Insecure {
+		pool := x509.NewCertPool()
+		pool.AppendCertsFromPEM(o.CA)
+		tc := &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			CipherSuites: []uint16{ // Only applies to TLS 1.2. TLS 1.3 ciphersuites are not configurable.
+				tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305,
+				tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305,
+				tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+				tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+				tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+				tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+			},
+			RootCAs: pool,
+		}
+		if len(o.ClientCert) != 0 {
+			keypair, err := tls.X509KeyPair(o.ClientCert, o.ClientKey)
+			if err != nil {
+				return nil, err
+			}
+			tc.Certificates = []tls.Certificate{keypair}
+		}
+		opts = append(opts, wsrpc.WithTLSConfig(tc))
+	}
+	return wsrpc.Dial(ctx, addr, opts...)
+}
+
+// PublishTransactions connects to the dcrd JSON-RPC server described by opts
+// and submits the transactions to its mempool, without synchronizing any
+// wallet.  The server must be running on the network described by params.
+func PublishTransactions(ctx context.Context, params *chaincfg.Params, opts *RPCOptions, txs ...*wire.MsgTx) error {
+	const op errors.Op = "chain.PublishTransactions"
+
+	wsClient, err := dial(ctx, opts)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	defer wsClient.Close()
+	rpc := dcrd.New(wsClient)
+
+	var netID wire.CurrencyNet
+	err = rpc.Call(ctx, "getcurrentnet", &netID)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if netID != params.Net {
+		return errors.E(op, "mismatched networks")
+	}
+	err = rpc.PublishTransactions(ctx, txs...)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
 // Run synchronizes the wallet, returning when synchronization fails or the
 // context is cancelled.  If startupSync is true, all synchronization tasks
 // needed to fully register the wallet for notifications and synchronize it with
@@ -533,6 +650,8 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 	s.done = make(chan struct{})
 	s.err = nil
 	s.doneMu.Unlock()
+	s.phase.Begin(wallet.SyncPhaseConnecting)
+	s.throttle.Initial()
 	defer func() {
 		s.doneMu.Lock()
 		close(s.done)
@@ -549,49 +668,8 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 		ctx:    ntfnCtx,
 		closed: make(chan struct{}),
 	}
-	addr, err := normalizeAddress(s.opts.Address, s.opts.DefaultPort)
-	if err != nil {
-		return errors.E(errors.Invalid, err)
-	}
-	if s.opts.Insecure {
-		addr = "ws://" + addr + "/ws"
-	} else {
-		addr = "wss://" + addr + "/ws"
-	}
-	opts := make([]wsrpc.Option, 0, 5)
-	if s.opts.User != "" {
-		opts = append(opts, wsrpc.WithBasicAuth(s.opts.User, s.opts.Pass))
-	}
-	opts = append(opts, wsrpc.WithNotifier(s.notifier))
-	opts = append(opts, wsrpc.WithoutPongDeadline())
-	if s.opts.Dial != nil {
-		opts = append(opts, wsrpc.WithDial(s.opts.Dial))
-	}
-	if len(s.opts.CA) != 0 && !s.opts.Insecure {
-		pool := x509.NewCertPool()
-		pool.AppendCertsFromPEM(s.opts.CA)
-		tc := &tls.Config{
-			MinVersion: tls.VersionTLS12,
-			CipherSuites: []uint16{ // Only applies to TLS 1.2. TLS 1.3 ciphersuites are not configurable.
-				tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305,
-				tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305,
-				tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
-				tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
-				tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
-				tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
-			},
-			RootCAs: pool,
-		}
-		if len(s.opts.ClientCert) != 0 {
-			keypair, err := tls.X509KeyPair(s.opts.ClientCert, s.opts.ClientKey)
-			if err != nil {
-				return err
-			}
-			tc.Certificates = []tls.Certificate{keypair}
-		}
-		opts = append(opts, wsrpc.WithTLSConfig(tc))
-	}
-	wsClient, err := wsrpc.Dial(ctx, addr, opts...)
+	wsClient, err := dial(ctx, s.opts, wsrpc.WithNotifier(s.notifier),
+		wsrpc.WithoutPongDeadline())
 	if err != nil {
 		return err
 	}
@@ -607,6 +685,15 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 	if netID != params.Net {
 		return errors.E("mismatched networks")
 	}
+	var genesis string
+	err = s.rpc.Call(ctx, "getblockhash", &genesis, 0)
+	if err != nil {
+		return err
+	}
+	if genesis != params.GenesisHash.String() {
+		return errors.E(errors.Errorf("mismatched genesis blocks: "+
+			"server has %v, wallet expects %v", genesis, &params.GenesisHash))
+	}
 
 	// Ensure the RPC server has a compatible API version.
 	var api struct {
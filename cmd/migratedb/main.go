@@ -0,0 +1,115 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+
+	"decred.org/dcrwallet/v5/wallet"
+	_ "decred.org/dcrwallet/v5/wallet/drivers/bdb"
+	"github.com/jessevdk/go-flags"
+)
+
+var newlineBytes = []byte{'\n'}
+
+var opts = struct {
+	Driver string `long:"driver" description:"Database driver of the wallet database"`
+	DB     string `long:"db" description:"Path to the wallet database" required:"true"`
+	Export string `long:"export" description:"Export the database to this file (- for stdout)"`
+	Import string `long:"import" description:"Create the database from this export file (- for stdin)"`
+}{
+	Driver: "bdb",
+}
+
+func fatalf(format string, args ...any) {
+	fmt.Fprintf(os.Stderr, format, args...)
+	os.Stderr.Write(newlineBytes)
+	os.Exit(1)
+}
+
+// Parse and validate flags.
+func init() {
+	_, err := flags.Parse(&opts)
+	if err != nil {
+		os.Exit(1)
+	}
+	if (opts.Export == "") == (opts.Import == "") {
+		fatalf("exactly one of --export or --import must be specified")
+	}
+}
+
+func exportDB(ctx context.Context) error {
+	db, err := wallet.OpenDB(opts.Driver, opts.DB)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if opts.Export == "-" {
+		return wallet.ExportDB(ctx, db, os.Stdout)
+	}
+	f, err := os.OpenFile(opts.Export, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	err = wallet.ExportDB(ctx, db, f)
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(opts.Export)
+	}
+	return err
+}
+
+func importDB(ctx context.Context) error {
+	var r io.Reader = os.Stdin
+	if opts.Import != "-" {
+		f, err := os.Open(opts.Import)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	if _, err := os.Stat(opts.DB); err == nil {
+		return fmt.Errorf("database %s already exists", opts.DB)
+	}
+	db, err := wallet.CreateDB(opts.Driver, opts.DB)
+	if err != nil {
+		return err
+	}
+	err = wallet.ImportDB(ctx, db, r)
+	if closeErr := db.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(opts.DB)
+	}
+	return err
+}
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	var err error
+	if opts.Export != "" {
+		err = exportDB(ctx)
+	} else {
+		err = importDB(ctx)
+	}
+	if err != nil {
+		fatalf("%v", err)
+	}
+}
@@ -16,10 +16,12 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"decred.org/cspp/v2/solverrpc"
 	"decred.org/dcrwallet/v5/errors"
 	"decred.org/dcrwallet/v5/internal/cfgutil"
+	"decred.org/dcrwallet/v5/internal/eventbus"
 	"decred.org/dcrwallet/v5/internal/loggers"
 	"decred.org/dcrwallet/v5/internal/netparams"
 	"decred.org/dcrwallet/v5/version"
@@ -27,6 +29,7 @@ import (
 	"decred.org/dcrwallet/v5/wallet/txrules"
 	"github.com/decred/dcrd/connmgr/v3"
 	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/wire"
 	"github.com/decred/go-socks/socks"
 	"github.com/decred/slog"
 	flags "github.com/jessevdk/go-flags"
@@ -51,6 +54,7 @@ const (
 	defaultEnableTicketBuyer       = false
 	defaultEnableVoting            = false
 	defaultPurchaseAccount         = "default"
+	defaultOfflineTxAccount        = "default"
 	defaultPromptPass              = false
 	defaultPass                    = ""
 	defaultPromptPublicPass        = false
@@ -60,7 +64,13 @@ const (
 	defaultDisableCoinTypeUpgrades = false
 	defaultCircuitLimit            = 32
 	defaultMixSplitLimit           = 10
+	defaultEventBusPrefix          = "dcrwallet"
+	defaultCheckpointInterval      = time.Hour
+	defaultReplicaSnapshotInterval = time.Minute
+	defaultReplicaPollInterval     = 10 * time.Second
+	defaultSidechainPruneDepth     = 256
 	defaultVSPMaxFee               = dcrutil.Amount(0.2e8)
+	defaultVoteCosignerTimeout     = 30 * time.Second
 
 	// ticket buyer options
 	defaultBalanceToMaintainAbsolute = 0
@@ -88,9 +98,16 @@ type config struct {
 	Create             bool                    `long:"create" description:"Create new wallet"`
 	CreateTemp         bool                    `long:"createtemp" description:"Create simulation wallet in nonstandard --appdata; private passphrase is 'password'"`
 	CreateWatchingOnly bool                    `long:"createwatchingonly" description:"Create watching wallet from account extended pubkey"`
+	CreateUnsignedTx   string                  `long:"createunsignedtx" description:"Create an unsigned transaction paying the address/amount pairs of a JSON file and exit"`
+	SignTx             string                  `long:"signtx" description:"Sign a transaction file created by --createunsignedtx and exit"`
+	BroadcastTx        string                  `long:"broadcasttx" description:"Broadcast a transaction file signed by --signtx and exit"`
+	OfflineTxAccount   string                  `long:"offlinetxaccount" description:"Account funding transactions created with --createunsignedtx"`
+	OfflineTxOut       string                  `long:"offlinetxout" description:"File to write created and signed transactions to (default: stdout)"`
+	MigrateRows        bool                    `long:"migraterows" description:"Rewrite all account and address rows in the latest database serialization and exit"`
 	AppDataDir         *cfgutil.ExplicitString `short:"A" long:"appdata" description:"Application data directory for wallet config, databases and logs"`
 	TestNet            bool                    `long:"testnet" description:"Use the test network"`
 	SimNet             bool                    `long:"simnet" description:"Use the simulation test network"`
+	ChainParams        string                  `long:"chainparams" description:"Use the private network defined by this JSON file"`
 	NoInitialLoad      bool                    `long:"noinitialload" description:"Defer wallet creation/opening on startup and enable loading wallets over RPC"`
 	DebugLevel         string                  `short:"d" long:"debuglevel" description:"Logging level {trace, debug, info, warn, error, critical}"`
 	LogDir             *cfgutil.ExplicitString `long:"logdir" description:"Directory to log output."`
@@ -105,16 +122,41 @@ type config struct {
 	PromptPass              bool                `long:"promptpass" description:"Prompt for private passphase from terminal and unlock without timeout"`
 	Pass                    string              `long:"pass" description:"Unlock with private passphrase"`
 	PromptPublicPass        bool                `long:"promptpublicpass" description:"Prompt for public passphrase from terminal"`
+	WalletPassCmd           cfgutil.ArgvFlag    `long:"walletpasscmd" description:"Command, as a JSON array of the program and its arguments, which prints the public passphrase to stdout"`
+	PassCmd                 cfgutil.ArgvFlag    `long:"passcmd" description:"Command, as a JSON array of the program and its arguments, which prints the private passphrase to stdout; unlock with its output at startup"`
+	PassKeyring             bool                `long:"passkeyring" description:"Read passphrases from the OS keyring (service \"dcrwallet\", accounts \"<network>-public\" and \"<network>-private\")"`
+	SpendApprovalCmd        string              `long:"spendapprovalcmd" description:"Command run to approve spends before they are signed; receives the spend as JSON on stdin and approves by exiting successfully"`
+	SpendApprovalThreshold  *cfgutil.AmountFlag `long:"spendapprovalthreshold" description:"Require approval of spends sending more than this amount to addresses outside of the wallet"`
+	SpendApprovalTimeout    time.Duration       `long:"spendapprovaltimeout" description:"Reject spends which are not approved within this duration (0 waits indefinitely)"`
 	EnableTicketBuyer       bool                `long:"enableticketbuyer" description:"Enable the automatic ticket buyer"`
 	EnableVoting            bool                `long:"enablevoting" description:"Automatically vote on winning tickets"`
+	VoteCosignerCmd         []cfgutil.ArgvFlag  `long:"votecosignercmd" description:"Command, as a JSON array of the program and its arguments, run to add the signatures of another device to votes of tickets with multisig voting scripts; receives the vote as hex on stdin and prints the cosigned vote (may be specified multiple times)"`
+	VoteCosignerTimeout     time.Duration       `long:"votecosignertimeout" description:"Abandon vote cosigning which does not complete within this duration of the votes being signed"`
 	PurchaseAccount         string              `long:"purchaseaccount" description:"Account to autobuy tickets from"`
 	GapLimit                uint32              `long:"gaplimit" description:"Allowed unused address gap between used addresses of accounts"`
+	WatchingOnlyGapLimit    uint32              `long:"watchingonlygaplimit" description:"Allowed unused address gap of watching-only wallets, used instead of --gaplimit when larger"`
 	WatchLast               uint32              `long:"watchlast" description:"Limit watched previous addresses of each HD account branch"`
 	ManualTickets           bool                `long:"manualtickets" description:"Do not discover new tickets through network synchronization"`
 	AllowHighFees           bool                `long:"allowhighfees" description:"Do not perform high fee checks"`
 	RelayFee                *cfgutil.AmountFlag `long:"txfee" description:"Transaction fee per kilobyte"`
-	AccountGapLimit         int                 `long:"accountgaplimit" description:"Allowed gap of unused accounts"`
+	IdleLockTimeout         time.Duration       `long:"idlelocktimeout" description:"Lock the wallet after this duration without signing activity (0 to disable)"`
+	TxExpiry                int32               `long:"txexpiry" description:"Number of blocks in which sent transactions must be mined before expiring, allowing their inputs to be spent again (0 to disable)"`
+	AccountGapLimit         int                 `long:"accountgaplimit" description:"Allowed gap of unused accounts; accounts are discovered during restore until this many unused accounts follow the last used account"`
+	AccountRollover         bool                `long:"accountrollover" description:"Create and derive new receiving addresses from a successor account when an account's external addresses approach the maximum or --accountrollovercap"`
+	AccountRolloverCap      uint32              `long:"accountrollovercap" description:"Number of external addresses of an account after which --accountrollover derives addresses from a successor account (0 approaches the maximum)"`
 	DisableCoinTypeUpgrades bool                `long:"disablecointypeupgrades" description:"Never upgrade from legacy to SLIP0044 coin type keys"`
+	DisableTicketAddrCheck  bool                `long:"disableticketaddrcheck" description:"Do not skip previously used addresses when deriving the voting and commitment addresses of purchased tickets"`
+	TxPruneDepth            int32               `long:"txprunedepth" description:"Prune serialized transactions whose outputs are spent and with more than this many confirmations; refetched from the network when needed (0 to disable)"`
+	SidechainPruneDepth     int32               `long:"sidechainprunedepth" description:"Prune saved headers and cfilters of blocks reorganized out of the main chain more than this many blocks below the tip (0 to disable)"`
+	TreasuryKeys            bool                `long:"treasurykeys" description:"Permit importing treasury spend signing keys and signing treasury spends with them"`
+	VoteOnly                bool                `long:"voteonly" description:"Only permit voting, revocations, and read operations; all other spends and private key exports are refused"`
+	AccountsOnlyRecover     bool                `long:"accountsonly-recover" description:"Recover a wallet with damaged transaction history by replacing its transaction store with an empty store, preserving keys and accounts; the history is rebuilt by rescanning during sync (use for a single run)"`
+	Checkpoint              string              `long:"checkpoint" description:"Periodically write an authenticated checkpoint of account address cursors to this file; import it with importcheckpoint after restoring from seed to skip rediscovering address usage"`
+	CheckpointInterval      time.Duration       `long:"checkpointinterval" description:"Interval between writes of the --checkpoint file"`
+	ReplicaSnapshot         string              `long:"replicasnapshot" description:"Periodically write a snapshot of the wallet database to this file for read-only replicas started with --replicaof"`
+	ReplicaSnapshotInterval time.Duration       `long:"replicasnapshotinterval" description:"Interval between writes of the --replicasnapshot file"`
+	ReplicaOf               string              `long:"replicaof" description:"Run as a read-only replica serving reporting JSON-RPC methods from the wallet database snapshot at this path, reloading it when replaced"`
+	ReplicaPollInterval     time.Duration       `long:"replicapollinterval" description:"Interval between checks of the --replicaof snapshot for replacement"`
 
 	// RPC client options
 	RPCConnect       string                  `short:"c" long:"rpcconnect" description:"Network address of dcrd RPC server"`
@@ -140,10 +182,30 @@ type config struct {
 	// Offline mode.
 	Offline bool `long:"offline" description:"Do not sync the wallet"`
 
+	// Event bus publishing
+	EventBus       string `long:"eventbus" description:"Publish wallet events to a message bus (e.g. nats://127.0.0.1:4222)"`
+	EventBusPrefix string `long:"eventbusprefix" description:"Prefix of event bus subjects"`
+
 	// SPV options
 	SPV               bool     `long:"spv" description:"Sync using simplified payment verification"`
 	SPVConnect        []string `long:"spvconnect" description:"SPV sync only with specified peers; disables DNS seeding"`
 	SPVDisableRelayTx bool     `long:"spvdisablerelaytx" description:"Disable receiving mempool transactions when in SPV mode"`
+	SPVUserAgent      string   `long:"spvuseragent" description:"User agent advertised to SPV peers, as name:version"`
+	SPVRequiredSvcs   []string `long:"spvrequiredservice" description:"Service flag (nodenetwork, nodebloom, nodecf, or a number) SPV peers must advertise; may be specified multiple times"`
+	SPVMinPeerVersion uint32   `long:"spvminpeerversion" description:"Minimum protocol version of SPV peers"`
+	SPVAllow          []string `long:"spvallow" description:"Only connect to SPV peers in this CIDR network; may be specified multiple times"`
+	SPVDeny           []string `long:"spvdeny" description:"Never connect to SPV peers in this CIDR network; may be specified multiple times"`
+	spvUAName         string
+	spvUAVersion      string
+	spvRequiredSvcs   wire.ServiceFlag
+	spvAllow          []*net.IPNet
+	spvDeny           []*net.IPNet
+
+	// Sync throttling
+	SyncInitialRate        uint32 `long:"syncinitialrate" description:"Maximum download rate in KiB/s before the wallet is synced (0 is unlimited)"`
+	SyncRate               uint32 `long:"syncrate" description:"Maximum download rate in KiB/s after the wallet is synced, including rescans (0 is unlimited)"`
+	SyncInitialConcurrency int    `long:"syncinitialconcurrency" description:"Maximum concurrent block and compact filter requests before the wallet is synced (0 is unlimited)"`
+	SyncConcurrency        int    `long:"syncconcurrency" description:"Maximum concurrent block and compact filter requests after the wallet is synced (0 is unlimited)"`
 
 	// RPC server options
 	RPCCert                *cfgutil.ExplicitString `long:"rpccert" description:"RPC server TLS certificate"`
@@ -160,6 +222,10 @@ type config struct {
 	Username               string                  `short:"u" long:"username" description:"JSON-RPC username and default dcrd RPC username"`
 	Password               string                  `short:"P" long:"password" default-mask:"-" description:"JSON-RPC password and default dcrd RPC password"`
 	JSONRPCAuthType        string                  `long:"jsonrpcauthtype" description:"Method for JSON-RPC client authentication (basic or clientcert)"`
+	Webhooks               bool                    `long:"webhooks" description:"Enable registering webhooks over JSON-RPC to notify HTTP endpoints of address activity"`
+	PendingSpends          bool                    `long:"pendingspends" description:"Record payments sent over JSON-RPC as pending spends, which are only signed and published once approved by the spend approval user; all other spends to addresses outside of the wallet are refused"`
+	ApproveUsername        string                  `long:"approveusername" description:"JSON-RPC username of the spend approval user"`
+	ApprovePassword        string                  `long:"approvepassword" default-mask:"-" description:"JSON-RPC password of the spend approval user"`
 
 	// IPC options
 	PipeTx            *uint `long:"pipetx" description:"File descriptor or handle of write end pipe to enable child -> parent process communication"`
@@ -177,6 +243,12 @@ type config struct {
 	ChangeAccount      string `long:"changeaccount" description:"Account used to derive unmixed CoinJoin outputs in CoinShuffle++ protocol"`
 	MixChange          bool   `long:"mixchange" description:"Use CoinShuffle++ to mix change account outputs into mix account"`
 	MixSplitLimit      int    `long:"mixsplitlimit" description:"Connection limit to CoinShuffle++ server per change amount"`
+	MixMinPeers        int    `long:"mixminpeers" description:"Minimum number of peers required to complete a mix; mixes with fewer peers are not signed"`
+	MixMinPeerVersion  uint32 `long:"mixminpeerversion" description:"Minimum protocol version of network peers to exchange mixing messages with"`
+
+	ChangePolicy        string `long:"changepolicy" description:"Account/branch to receive the change of sent payments; uses the internal branch of the sending account if unset; does not apply to mixing change"`
+	changePolicyAccount string
+	changePolicyBranch  uint32
 
 	TBOpts ticketBuyerOptions `group:"Ticket Buyer Options" namespace:"ticketbuyer"`
 
@@ -187,6 +259,10 @@ type ticketBuyerOptions struct {
 	BalanceToMaintainAbsolute *cfgutil.AmountFlag `long:"balancetomaintainabsolute" description:"Amount of funds to keep in wallet when purchasing tickets"`
 	Limit                     uint                `long:"limit" description:"Buy no more than specified number of tickets per block"`
 	VotingAccount             string              `long:"votingaccount" description:"Account used to derive addresses specifying voting rights"`
+	TargetTickets             uint                `long:"targettickets" description:"Front-load purchases to hold this many live tickets by targetheight"`
+	TargetHeight              uint32              `long:"targetheight" description:"Block height (e.g. start of an agenda voting window) by which targettickets must be live"`
+	SourceBranches            []uint32            `long:"sourcebranch" description:"Only purchase tickets with outputs paying to this branch (0 or 1) of the purchasing account (may be repeated)"`
+	MinConf                   uint32              `long:"minconf" description:"Minimum number of confirmations of outputs spent by ticket purchases"`
 }
 
 type vspOptions struct {
@@ -356,6 +432,7 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		DcrdClientKey:           cfgutil.NewExplicitString(defaultDcrdClientKeyFile),
 		dial:                    new(net.Dialer).DialContext,
 		lookup:                  net.LookupIP,
+		OfflineTxAccount:        defaultOfflineTxAccount,
 		PromptPass:              defaultPromptPass,
 		Pass:                    defaultPass,
 		PromptPublicPass:        defaultPromptPublicPass,
@@ -368,14 +445,21 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		DcrdAuthType:            defaultAuthType,
 		EnableTicketBuyer:       defaultEnableTicketBuyer,
 		EnableVoting:            defaultEnableVoting,
+		VoteCosignerTimeout:     defaultVoteCosignerTimeout,
 		PurchaseAccount:         defaultPurchaseAccount,
 		GapLimit:                defaultGapLimit,
 		AllowHighFees:           defaultAllowHighFees,
 		RelayFee:                cfgutil.NewAmountFlag(txrules.DefaultRelayFeePerKb),
+		SpendApprovalThreshold:  cfgutil.NewAmountFlag(0),
 		AccountGapLimit:         defaultAccountGapLimit,
 		DisableCoinTypeUpgrades: defaultDisableCoinTypeUpgrades,
 		CircuitLimit:            defaultCircuitLimit,
 		MixSplitLimit:           defaultMixSplitLimit,
+		EventBusPrefix:          defaultEventBusPrefix,
+		CheckpointInterval:      defaultCheckpointInterval,
+		ReplicaSnapshotInterval: defaultReplicaSnapshotInterval,
+		ReplicaPollInterval:     defaultReplicaPollInterval,
+		SidechainPruneDepth:     defaultSidechainPruneDepth,
 		CSPPSolver:              cfgutil.NewExplicitString(solverrpc.SolverProcess),
 
 		// Ticket Buyer Options
@@ -483,9 +567,19 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		activeNet = &netparams.SimNetParams
 		numNets++
 	}
+	if cfg.ChainParams != "" {
+		params, err := netparams.LoadDefinition(cleanAndExpandPath(cfg.ChainParams))
+		if err != nil {
+			err := errors.Errorf("%s: %v", funcName, err)
+			fmt.Fprintln(os.Stderr, err)
+			return loadConfigError(err)
+		}
+		activeNet = params
+		numNets++
+	}
 	if numNets > 1 {
-		str := "%s: The testnet and simnet params can't be used " +
-			"together -- choose one"
+		str := "%s: The testnet, simnet, and chainparams options can't " +
+			"be used together -- choose one"
 		err := errors.Errorf(str, "loadConfig")
 		fmt.Fprintln(os.Stderr, err)
 		return loadConfigError(err)
@@ -573,6 +667,31 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		return loadConfigError(err)
 	}
 
+	// Deadline-aware purchasing requires both a ticket target and a height.
+	if (cfg.TBOpts.TargetTickets == 0) != (cfg.TBOpts.TargetHeight == 0) {
+		str := "%s: ticketbuyer.targettickets and ticketbuyer.targetheight " +
+			"must be specified together"
+		err := errors.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+
+	for _, branch := range cfg.TBOpts.SourceBranches {
+		if branch > 1 {
+			str := "%s: ticketbuyer.sourcebranch must be 0 or 1: %v"
+			err := errors.Errorf(str, funcName, branch)
+			fmt.Fprintln(os.Stderr, err)
+			return loadConfigError(err)
+		}
+	}
+
+	if !eventbus.ValidPrefix(cfg.EventBusPrefix) {
+		str := "%s: invalid eventbusprefix %q"
+		err := errors.Errorf(str, funcName, cfg.EventBusPrefix)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+
 	// Exit if you try to use a simulation wallet with a standard
 	// data directory.
 	if !cfg.AppDataDir.ExplicitlySet() && cfg.CreateTemp {
@@ -600,6 +719,35 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		return loadConfigError(err)
 	}
 
+	offlineTxModes := 0
+	for _, file := range []string{cfg.CreateUnsignedTx, cfg.SignTx, cfg.BroadcastTx} {
+		if file != "" {
+			offlineTxModes++
+		}
+	}
+	if offlineTxModes > 1 {
+		err := errors.Errorf("Only one of --createunsignedtx, --signtx, " +
+			"and --broadcasttx may be specified.")
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+	if offlineTxModes != 0 && (cfg.Create || cfg.CreateTemp ||
+		cfg.CreateWatchingOnly || cfg.NoInitialLoad) {
+		err := errors.Errorf("Offline transaction modes can not be " +
+			"used when creating a wallet or with --noinitialload.")
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+
+	if cfg.MigrateRows && (offlineTxModes != 0 || cfg.Create ||
+		cfg.CreateTemp || cfg.CreateWatchingOnly || cfg.NoInitialLoad) {
+		err := errors.Errorf("--migraterows can not be used with offline " +
+			"transaction modes, when creating a wallet, or with " +
+			"--noinitialload.")
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+
 	dbFileExists, err := cfgutil.FileExists(dbPath)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
@@ -659,7 +807,7 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 
 		// Created successfully, so exit now with success.
 		os.Exit(0)
-	} else if !dbFileExists && !cfg.NoInitialLoad {
+	} else if !dbFileExists && !cfg.NoInitialLoad && cfg.ReplicaOf == "" {
 		err := errors.Errorf("The wallet does not exist.  Run with the " +
 			"--create option to initialize and create it.")
 		fmt.Fprintln(os.Stderr, err)
@@ -757,22 +905,19 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		}
 	}
 
-	// Parse mixedaccount account/branch
+	// Parse mixedaccount and changepolicy account/branch
 	if cfg.MixedAccount != "" {
-		indexSlash := strings.LastIndex(cfg.MixedAccount, "/")
-		if indexSlash == -1 {
-			err := errors.Errorf("--mixedaccount must have form 'accountname/branch'")
+		cfg.mixedAccount, cfg.mixedBranch, err = parseAccountBranch(
+			"mixedaccount", cfg.MixedAccount)
+		if err != nil {
 			fmt.Fprintln(os.Stderr, err)
 			return loadConfigError(err)
 		}
-		cfg.mixedAccount = cfg.MixedAccount[:indexSlash]
-		switch cfg.MixedAccount[indexSlash+1:] {
-		case "0":
-			cfg.mixedBranch = 0
-		case "1":
-			cfg.mixedBranch = 1
-		default:
-			err := errors.Errorf("--mixedaccount branch must be 0 or 1")
+	}
+	if cfg.ChangePolicy != "" {
+		cfg.changePolicyAccount, cfg.changePolicyBranch, err = parseAccountBranch(
+			"changepolicy", cfg.ChangePolicy)
+		if err != nil {
 			fmt.Fprintln(os.Stderr, err)
 			return loadConfigError(err)
 		}
@@ -848,6 +993,84 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		return loadConfigError(err)
 	}
 
+	if len(cfg.PassCmd.Argv) != 0 && (cfg.Pass != "" || cfg.PromptPass) {
+		err := errors.E("--passcmd may not be used with --pass or --promptpass")
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+
+	if cfg.TxExpiry < 0 {
+		err := errors.E("--txexpiry may not be negative")
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+
+	if cfg.TxPruneDepth != 0 && cfg.TxPruneDepth < wallet.MinTxPruneDepth {
+		err := errors.Errorf("--txprunedepth must be 0 or at least %d",
+			wallet.MinTxPruneDepth)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+
+	if cfg.Checkpoint != "" && cfg.CheckpointInterval <= 0 {
+		err := errors.E("--checkpointinterval must be positive")
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+
+	if cfg.ReplicaSnapshot != "" && cfg.ReplicaSnapshotInterval <= 0 {
+		err := errors.E("--replicasnapshotinterval must be positive")
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+	if cfg.ReplicaOf != "" {
+		if cfg.ReplicaPollInterval <= 0 {
+			err := errors.E("--replicapollinterval must be positive")
+			fmt.Fprintln(os.Stderr, err)
+			return loadConfigError(err)
+		}
+		if cfg.ReplicaSnapshot != "" || cfg.NoInitialLoad ||
+			cfg.EnableVoting || cfg.EnableTicketBuyer ||
+			cfg.MixingEnabled || cfg.MixChange {
+			err := errors.E("--replicaof may not be used with " +
+				"--replicasnapshot, --noinitialload, --enablevoting, " +
+				"--enableticketbuyer, --mixing, or --mixchange")
+			fmt.Fprintln(os.Stderr, err)
+			return loadConfigError(err)
+		}
+		if len(cfg.GRPCListeners) != 0 {
+			err := errors.E("--replicaof only serves JSON-RPC " +
+				"and may not be used with --grpclisten")
+			fmt.Fprintln(os.Stderr, err)
+			return loadConfigError(err)
+		}
+		cfg.NoGRPC = true
+	}
+
+	if cfg.MixMinPeers < 0 {
+		err := errors.E("--mixminpeers may not be negative")
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+	if cfg.MixMinPeerVersion > wire.ProtocolVersion {
+		err := errors.Errorf("--mixminpeerversion may not exceed protocol version %d",
+			wire.ProtocolVersion)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+
+	if cfg.SidechainPruneDepth < 0 {
+		err := errors.E("--sidechainprunedepth may not be negative")
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+
+	if cfg.VoteOnly && (cfg.EnableTicketBuyer || cfg.MixingEnabled || cfg.MixChange) {
+		err := errors.E("--voteonly may not be used with --enableticketbuyer, --mixing, or --mixchange")
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+
 	if cfg.SPV && cfg.EnableVoting {
 		err := errors.E("SPV voting is not possible: disable --spv or --enablevoting")
 		fmt.Fprintln(os.Stderr, err)
@@ -864,6 +1087,57 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 			return loadConfigError(err)
 		}
 	}
+	if cfg.SPVUserAgent != "" {
+		name, ver, _ := strings.Cut(cfg.SPVUserAgent, ":")
+		if name == "" || strings.ContainsAny(cfg.SPVUserAgent, "/()") ||
+			strings.ContainsAny(ver, ":") {
+			err := errors.Errorf("invalid --spvuseragent %q: must be "+
+				"name:version without '/', '(' or ')'", cfg.SPVUserAgent)
+			fmt.Fprintln(os.Stderr, err)
+			return loadConfigError(err)
+		}
+		v := wire.NewMsgVersion(new(wire.NetAddress), new(wire.NetAddress), 0, 0)
+		if err := v.AddUserAgent(name, ver); err != nil {
+			err := errors.Errorf("invalid --spvuseragent: %v", err)
+			fmt.Fprintln(os.Stderr, err)
+			return loadConfigError(err)
+		}
+		cfg.spvUAName, cfg.spvUAVersion = name, ver
+	}
+	for _, svc := range cfg.SPVRequiredSvcs {
+		flag, err := parseServiceFlag(svc)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return loadConfigError(err)
+		}
+		cfg.spvRequiredSvcs |= flag
+	}
+	if cfg.IdleLockTimeout < 0 {
+		err := errors.New("--idlelocktimeout may not be negative")
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+	if cfg.SyncInitialConcurrency < 0 || cfg.SyncConcurrency < 0 {
+		err := errors.New("--syncinitialconcurrency and --syncconcurrency may not be negative")
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+	if cfg.SPVMinPeerVersion > wire.ProtocolVersion {
+		err := errors.Errorf("--spvminpeerversion may not exceed protocol version %d",
+			wire.ProtocolVersion)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+	cfg.spvAllow, err = parseCIDRs("spvallow", cfg.SPVAllow)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+	cfg.spvDeny, err = parseCIDRs("spvdeny", cfg.SPVDeny)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
 
 	// Default to localhost listen addresses if no listeners were manually
 	// specified.  When the RPC server is configured to be disabled, remove all
@@ -979,6 +1253,15 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 	cfg.DcrdClientCert.Value = cleanAndExpandPath(cfg.DcrdClientCert.Value)
 	cfg.DcrdClientKey.Value = cleanAndExpandPath(cfg.DcrdClientKey.Value)
 	cfg.ClientCAFile.Value = cleanAndExpandPath(cfg.ClientCAFile.Value)
+	if cfg.Checkpoint != "" {
+		cfg.Checkpoint = cleanAndExpandPath(cfg.Checkpoint)
+	}
+	if cfg.ReplicaSnapshot != "" {
+		cfg.ReplicaSnapshot = cleanAndExpandPath(cfg.ReplicaSnapshot)
+	}
+	if cfg.ReplicaOf != "" {
+		cfg.ReplicaOf = cleanAndExpandPath(cfg.ReplicaOf)
+	}
 
 	// If the dcrd username or password are unset, use the same auth as for
 	// the client.  The two settings were previously shared for dcrd and
@@ -1034,6 +1317,23 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		return loadConfigError(err)
 	}
 
+	// Pending spends are approved by a second basic auth user.
+	if cfg.PendingSpends {
+		var err error
+		switch {
+		case cfg.JSONRPCAuthType != authTypeBasic:
+			err = errors.New("--pendingspends requires basic JSON-RPC authentication")
+		case cfg.ApproveUsername == "" || cfg.ApprovePassword == "":
+			err = errors.New("--pendingspends requires --approveusername and --approvepassword")
+		case cfg.ApproveUsername == cfg.Username:
+			err = errors.New("--approveusername must differ from --username")
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return loadConfigError(err)
+		}
+	}
+
 	// Make list of old versions of testnet directories.
 	var oldTestNets []string
 	oldTestNets = append(oldTestNets, filepath.Join(cfg.AppDataDir.Value, "testnet"))
@@ -1049,3 +1349,56 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 
 	return &cfg, remainingArgs, nil
 }
+
+// parseAccountBranch parses the account name and branch of an option value
+// with the form 'accountname/branch'.
+func parseAccountBranch(flag, value string) (account string, branch uint32, err error) {
+	indexSlash := strings.LastIndex(value, "/")
+	if indexSlash == -1 {
+		return "", 0, errors.Errorf("--%s must have form 'accountname/branch'", flag)
+	}
+	switch value[indexSlash+1:] {
+	case "0":
+		branch = 0
+	case "1":
+		branch = 1
+	default:
+		return "", 0, errors.Errorf("--%s branch must be 0 or 1", flag)
+	}
+	return value[:indexSlash], branch, nil
+}
+
+// serviceFlagNames maps the names accepted by --spvrequiredservice to wire
+// service flags.
+var serviceFlagNames = map[string]wire.ServiceFlag{
+	"nodenetwork": wire.SFNodeNetwork,
+	"nodebloom":   wire.SFNodeBloom,
+	"nodecf":      wire.SFNodeCF,
+}
+
+// parseServiceFlag parses a service flag by name, with an optional "sf"
+// prefix, or as a numeric value.
+func parseServiceFlag(value string) (wire.ServiceFlag, error) {
+	name := strings.TrimPrefix(strings.ToLower(value), "sf")
+	if flag, ok := serviceFlagNames[name]; ok {
+		return flag, nil
+	}
+	flag, err := strconv.ParseUint(value, 0, 64)
+	if err != nil {
+		return 0, errors.Errorf("--spvrequiredservice: unknown service flag %q", value)
+	}
+	return wire.ServiceFlag(flag), nil
+}
+
+// parseCIDRs parses the CIDR networks of a repeated option.
+func parseCIDRs(flag string, values []string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(values))
+	for _, v := range values {
+		_, n, err := net.ParseCIDR(v)
+		if err != nil {
+			return nil, errors.Errorf("--%s: invalid CIDR network %q", flag, v)
+		}
+		nets = append(nets, n)
+	}
+	return nets, nil
+}
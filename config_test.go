@@ -0,0 +1,49 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/decred/dcrd/wire"
+)
+
+func TestParseServiceFlag(t *testing.T) {
+	tests := []struct {
+		value string
+		flag  wire.ServiceFlag
+		err   bool
+	}{
+		{"nodenetwork", wire.SFNodeNetwork, false},
+		{"nodebloom", wire.SFNodeBloom, false},
+		{"nodecf", wire.SFNodeCF, false},
+		{"SFNodeCF", wire.SFNodeCF, false},
+		{"sfnodenetwork", wire.SFNodeNetwork, false},
+		{"NodeBloom", wire.SFNodeBloom, false},
+		{"4", wire.SFNodeCF, false},
+		{"0x5", wire.SFNodeNetwork | wire.SFNodeCF, false},
+		{"", 0, true},
+		{"nodeunknown", 0, true},
+		{"sf", 0, true},
+		{"-1", 0, true},
+		{"0x10000000000000000", 0, true},
+	}
+	for _, tc := range tests {
+		flag, err := parseServiceFlag(tc.value)
+		if tc.err {
+			if err == nil {
+				t.Errorf("parseServiceFlag(%q) = %v, want error", tc.value, flag)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseServiceFlag(%q): %v", tc.value, err)
+			continue
+		}
+		if flag != tc.flag {
+			t.Errorf("parseServiceFlag(%q) = %v, want %v", tc.value, flag, tc.flag)
+		}
+	}
+}
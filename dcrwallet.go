@@ -16,19 +16,26 @@ import (
 	"path/filepath"
 	"runtime"
 	"runtime/pprof"
+	"strings"
 	"time"
 
 	"decred.org/dcrwallet/v5/chain"
 	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/internal/eventbus"
 	ldr "decred.org/dcrwallet/v5/internal/loader"
 	"decred.org/dcrwallet/v5/internal/loggers"
 	"decred.org/dcrwallet/v5/internal/prompt"
 	"decred.org/dcrwallet/v5/internal/rpc/rpcserver"
+	"decred.org/dcrwallet/v5/internal/spendapproval"
+	"decred.org/dcrwallet/v5/internal/votecosign"
+	"decred.org/dcrwallet/v5/internal/webhook"
 	"decred.org/dcrwallet/v5/p2p"
+	"decred.org/dcrwallet/v5/ratelimit"
 	"decred.org/dcrwallet/v5/spv"
 	"decred.org/dcrwallet/v5/ticketbuyer"
 	"decred.org/dcrwallet/v5/version"
 	"decred.org/dcrwallet/v5/wallet"
+	"decred.org/dcrwallet/v5/wallet/udb"
 	"github.com/decred/dcrd/addrmgr/v2"
 	"github.com/decred/dcrd/wire"
 )
@@ -172,6 +179,32 @@ func run(ctx context.Context) error {
 		cfg.DisableCoinTypeUpgrades, cfg.MixingEnabled, cfg.ManualTickets,
 		cfg.MixSplitLimit, cfg.dial)
 
+	// Read passphrases from external commands and the OS keyring, in that
+	// order, when configured.
+	var passSources ldr.PassphraseSources
+	if len(cfg.WalletPassCmd.Argv) != 0 || len(cfg.PassCmd.Argv) != 0 {
+		passSources = append(passSources, &ldr.CommandSource{
+			Public:  cfg.WalletPassCmd.Argv,
+			Private: cfg.PassCmd.Argv,
+		})
+	}
+	if cfg.PassKeyring {
+		passSources = append(passSources, &ldr.KeyringSource{
+			Service: "dcrwallet",
+			Network: activeNet.Params.Name,
+		})
+	}
+	if len(passSources) != 0 {
+		loader.SetPassphraseSource(passSources)
+	}
+	loader.SetTxPruneDepth(cfg.TxPruneDepth)
+	loader.SetSidechainPruneDepth(cfg.SidechainPruneDepth)
+	loader.SetWatchingOnlyGapLimit(cfg.WatchingOnlyGapLimit)
+	loader.SetVoteOnly(cfg.VoteOnly)
+	loader.SetTreasuryKeys(cfg.TreasuryKeys)
+	loader.SetRebuildTxStore(cfg.AccountsOnlyRecover)
+	loader.SetReplicaOf(cfg.ReplicaOf)
+
 	// Stop any services started by the loader after the shutdown procedure is
 	// initialized and this function returns.
 	defer func() {
@@ -196,12 +229,24 @@ func run(ctx context.Context) error {
 		walletPass := []byte(cfg.WalletPass)
 		if cfg.PromptPublicPass {
 			walletPass, _ = passPrompt(ctx, "Enter public wallet passphrase", false)
+		} else if pass, err := loader.Passphrase(ctx, ldr.PublicPassphrase); err == nil {
+			walletPass = pass
+		} else if !errors.Is(err, errors.NotExist) {
+			log.Errorf("Failed to read public passphrase: %v", err)
+			return err
 		}
 
 		if done(ctx) {
 			return ctx.Err()
 		}
 
+		// Replicas reopen the wallet with the public passphrase each time
+		// the snapshot is replaced.
+		var replicaPass []byte
+		if cfg.ReplicaOf != "" {
+			replicaPass = append(replicaPass, walletPass...)
+		}
+
 		// Load the wallet.  It must have been created already or this will
 		// return an appropriate error.
 		var w *wallet.Wallet
@@ -230,6 +275,29 @@ func run(ctx context.Context) error {
 			}
 		}
 
+		// Replicas only serve reporting RPCs from the snapshot, and are
+		// never unlocked or synced.
+		if cfg.ReplicaOf != "" {
+			defer zero(replicaPass)
+			return runReplica(ctx, loader, replicaPass)
+		}
+
+		// Perform any offline transaction mode and exit without unlocking
+		// the wallet for services or starting the RPC servers and syncers.
+		if cfg.offlineTxMode() {
+			if err := setChangePolicy(ctx, w); err != nil {
+				log.Errorf("Failed to set change policy: %v", err)
+				return err
+			}
+			return runOfflineTx(ctx, w)
+		}
+
+		// Migrate database rows and exit when requested as a
+		// maintenance mode.
+		if cfg.MigrateRows {
+			return migrateRows(ctx, w)
+		}
+
 		// TODO(jrick): I think that this prompt should be removed
 		// entirely instead of enabling it when --noinitialload is
 		// unset.  It can be replaced with an RPC request (either
@@ -246,8 +314,18 @@ func run(ctx context.Context) error {
 				log.Errorf("Incorrect passphrase in pass config setting.")
 				return err
 			}
-		} else {
+		} else if w.WatchingOnly() {
 			passphrase = startPromptPass(ctx, w)
+		} else {
+			passphrase, err = loader.UnlockWallet(ctx)
+			switch {
+			case errors.Is(err, errors.NotExist):
+				passphrase = startPromptPass(ctx, w)
+			case err != nil:
+				log.Errorf("Failed to unlock wallet with external "+
+					"private passphrase: %v", err)
+				return err
+			}
 		}
 
 		if cfg.VSPOpts.URL != "" {
@@ -339,6 +417,10 @@ func run(ctx context.Context) error {
 				Account:            purchaseAccount,
 				Maintain:           cfg.TBOpts.BalanceToMaintainAbsolute.Amount,
 				Limit:              int(cfg.TBOpts.Limit),
+				TargetTickets:      int(cfg.TBOpts.TargetTickets),
+				TargetHeight:       int32(cfg.TBOpts.TargetHeight),
+				SourceBranches:     cfg.TBOpts.SourceBranches,
+				MinConf:            int32(cfg.TBOpts.MinConf),
 				VotingAccount:      votingAccount,
 				Mixing:             cfg.MixingEnabled,
 				MixChange:          cfg.MixChange,
@@ -373,7 +455,119 @@ func run(ctx context.Context) error {
 	//
 	// Servers will be associated with a loaded wallet if it has already been
 	// loaded, or after it is loaded later on.
-	gRPCServer, jsonRPCServer, err := startRPCServers(ctx, loader)
+	var webhooks *webhook.Dispatcher
+	if cfg.Webhooks {
+		webhooks = webhook.NewDispatcher(cfg.dial)
+		loader.RunAfterLoad(func(w *wallet.Wallet) {
+			go func() {
+				err := webhooks.Run(ctx, w)
+				if err != nil && !errors.Is(err, context.Canceled) {
+					log.Errorf("Webhook dispatcher ended: %v", err)
+				}
+			}()
+		})
+	}
+	if cfg.EventBus != "" {
+		pub, err := eventbus.NewPublisher(cfg.EventBus, cfg.dial)
+		if err != nil {
+			log.Errorf("Unable to create event bus publisher: %v", err)
+			return err
+		}
+		bus := eventbus.New(pub, cfg.EventBusPrefix)
+		loader.RunAfterLoad(func(w *wallet.Wallet) {
+			go func() {
+				err := bus.Run(ctx, w)
+				if err != nil && !errors.Is(err, context.Canceled) {
+					log.Errorf("Event bus publisher ended: %v", err)
+				}
+			}()
+		})
+	}
+	if cfg.Checkpoint != "" {
+		loader.RunAfterLoad(func(w *wallet.Wallet) {
+			go func() {
+				err := w.RunCheckpoints(ctx, cfg.Checkpoint, cfg.CheckpointInterval)
+				if err != nil && !errors.Is(err, context.Canceled) {
+					log.Errorf("Wallet checkpoints ended: %v", err)
+				}
+			}()
+		})
+	}
+	if cfg.ReplicaSnapshot != "" {
+		loader.RunAfterLoad(func(w *wallet.Wallet) {
+			go func() {
+				err := w.RunSnapshots(ctx, cfg.ReplicaSnapshot,
+					cfg.ReplicaSnapshotInterval)
+				if err != nil && !errors.Is(err, context.Canceled) {
+					log.Errorf("Wallet snapshots ended: %v", err)
+				}
+			}()
+		})
+	}
+	loader.RunAfterLoad(func(w *wallet.Wallet) {
+		if w.ReadOnly() {
+			return
+		}
+		go func() {
+			err := w.RunInstanceHeartbeats(ctx)
+			if err != nil && !errors.Is(err, context.Canceled) {
+				log.Errorf("Wallet heartbeats ended: %v", err)
+			}
+		}()
+	})
+	if cfg.PendingSpends {
+		loader.RunAfterLoad(func(w *wallet.Wallet) {
+			w.RequirePendingSpends(true)
+		})
+	}
+	if approver := newSpendApprover(); approver != nil {
+		threshold := cfg.SpendApprovalThreshold.Amount
+		loader.RunAfterLoad(func(w *wallet.Wallet) {
+			w.SetSpendApprover(approver, threshold)
+		})
+	}
+	if cfg.AccountRollover {
+		loader.RunAfterLoad(func(w *wallet.Wallet) {
+			w.SetAccountRollover(true, cfg.AccountRolloverCap)
+		})
+	}
+	if cfg.DisableTicketAddrCheck {
+		loader.RunAfterLoad(func(w *wallet.Wallet) {
+			w.SetTicketAddressCheck(false)
+		})
+	}
+	if cfg.IdleLockTimeout != 0 {
+		loader.RunAfterLoad(func(w *wallet.Wallet) {
+			if err := w.SetIdleLockTimeout(cfg.IdleLockTimeout); err != nil {
+				log.Errorf("Failed to set idle lock timeout: %v", err)
+			}
+		})
+	}
+	if cfg.TxExpiry != 0 {
+		loader.RunAfterLoad(func(w *wallet.Wallet) {
+			if err := w.SetTxExpiry(cfg.TxExpiry); err != nil {
+				log.Errorf("Failed to set transaction expiry: %v", err)
+			}
+		})
+	}
+	if cosigners := newVoteCosigners(); len(cosigners) != 0 {
+		loader.RunAfterLoad(func(w *wallet.Wallet) {
+			w.SetVoteCosigners(cfg.VoteCosignerTimeout, cosigners...)
+		})
+	}
+	loader.RunAfterLoad(func(w *wallet.Wallet) {
+		if err := setChangePolicy(ctx, w); err != nil {
+			log.Errorf("Failed to set change policy: %v", err)
+		}
+		err := w.SetMixPolicy(wallet.MixPolicy{
+			MinPeers:       cfg.MixMinPeers,
+			MinPeerVersion: cfg.MixMinPeerVersion,
+		})
+		if err != nil {
+			log.Errorf("Failed to set mix policy: %v", err)
+		}
+	})
+	gRPCServer, jsonRPCServer, err := startRPCServers(ctx, loader, webhooks)
 	if err != nil {
 		log.Errorf("Unable to create RPC servers: %v", err)
 		return err
@@ -522,18 +716,44 @@ func startPromptPass(ctx context.Context, w *wallet.Wallet) []byte {
 	}
 }
 
+// migrateRows rewrites all account and address rows of the opened wallet in
+// the latest database serialization, logging progress.
+func migrateRows(ctx context.Context, w *wallet.Wallet) error {
+	log.Infof("Migrating account and address rows")
+	res, err := w.MigrateRows(ctx, func(p udb.RowMigration) {
+		log.Infof("Examined %d accounts and %d addresses", p.Accounts,
+			p.Addresses)
+	})
+	if err != nil {
+		log.Errorf("Failed to migrate rows: %v", err)
+		return err
+	}
+	log.Infof("Rewrote %d of %d account rows and %d of %d address rows",
+		res.AccountsRewritten, res.Accounts, res.AddressesRewritten,
+		res.Addresses)
+	return nil
+}
+
 func spvLoop(ctx context.Context, w *wallet.Wallet) {
 	addr := &net.TCPAddr{IP: net.ParseIP("::1"), Port: 0}
 	amgrDir := filepath.Join(cfg.AppDataDir.Value, w.ChainParams().Name)
 	amgr := addrmgr.New(amgrDir, cfg.lookup)
+	throttle := newSyncThrottle()
 	for {
 		lp := p2p.NewLocalPeer(w.ChainParams(), addr, amgr)
-		lp.SetDialFunc(cfg.dial)
+		lp.SetDialFunc(throttle.Dial(cfg.dial))
 		lp.SetDisableRelayTx(cfg.SPVDisableRelayTx)
+		if cfg.spvUAName != "" {
+			lp.SetUserAgent(cfg.spvUAName, cfg.spvUAVersion)
+		}
+		lp.SetRequiredServices(cfg.spvRequiredSvcs)
+		lp.SetMinProtocolVersion(cfg.SPVMinPeerVersion)
+		lp.SetPeerFilter(cfg.spvAllow, cfg.spvDeny)
 		syncer := spv.NewSyncer(w, lp)
 		if len(cfg.SPVConnect) > 0 {
 			syncer.SetPersistentPeers(cfg.SPVConnect)
 		}
+		syncer.SetThrottle(throttle)
 		err := syncer.Run(ctx)
 		if err == nil || done(ctx) {
 			loggers.SyncLog.Infof("SPV synchronization stopped")
@@ -554,29 +774,13 @@ func spvLoop(ctx context.Context, w *wallet.Wallet) {
 // to the network.  If/when the RPC connection is lost, the wallet is
 // disassociated from the client and a new connection is attempmted.
 func rpcSyncLoop(ctx context.Context, w *wallet.Wallet) {
-	certs := readCAFile()
-	clientCert, clientKey := readClientCertKey()
-	dial := cfg.dial
-	if cfg.NoDcrdProxy {
-		dial = new(net.Dialer).DialContext
-	}
+	opts := dcrdRPCOptions()
+	throttle := newSyncThrottle()
+	opts.Dial = throttle.Dial(opts.Dial)
 	for {
-		rpcOptions := &chain.RPCOptions{
-			Address:     cfg.RPCConnect,
-			DefaultPort: activeNet.JSONRPCClientPort,
-			User:        cfg.DcrdUsername,
-			Pass:        cfg.DcrdPassword,
-			Dial:        dial,
-			CA:          certs,
-			Insecure:    cfg.DisableClientTLS,
-		}
-		if len(clientCert) != 0 {
-			rpcOptions.User = ""
-			rpcOptions.Pass = ""
-			rpcOptions.ClientCert = clientCert
-			rpcOptions.ClientKey = clientKey
-		}
-		syncer := chain.NewSyncer(w, rpcOptions)
+		rpcOptions := *opts
+		syncer := chain.NewSyncer(w, &rpcOptions)
+		syncer.SetThrottle(throttle)
 		err := syncer.Run(ctx)
 		if err != nil {
 			if errors.Is(err, context.Canceled) || ctx.Err() != nil {
@@ -593,6 +797,90 @@ func rpcSyncLoop(ctx context.Context, w *wallet.Wallet) {
 	}
 }
 
+// newSyncThrottle returns the throttle limiting the bandwidth and request
+// concurrency of the syncer, or nil when no limits are configured.  The same
+// throttle is reused when the syncer is restarted.
+func newSyncThrottle() *ratelimit.Throttle {
+	if cfg.SyncInitialRate == 0 && cfg.SyncRate == 0 &&
+		cfg.SyncInitialConcurrency == 0 && cfg.SyncConcurrency == 0 {
+		return nil
+	}
+	initial := ratelimit.Limits{
+		Rate:        int64(cfg.SyncInitialRate) * 1024,
+		Concurrency: cfg.SyncInitialConcurrency,
+	}
+	steady := ratelimit.Limits{
+		Rate:        int64(cfg.SyncRate) * 1024,
+		Concurrency: cfg.SyncConcurrency,
+	}
+	return ratelimit.New(initial, steady)
+}
+
+// dcrdRPCOptions returns the options used to connect to the dcrd JSON-RPC
+// server.
+func dcrdRPCOptions() *chain.RPCOptions {
+	certs := readCAFile()
+	clientCert, clientKey := readClientCertKey()
+	dial := cfg.dial
+	if cfg.NoDcrdProxy {
+		dial = new(net.Dialer).DialContext
+	}
+	rpcOptions := &chain.RPCOptions{
+		Address:     cfg.RPCConnect,
+		DefaultPort: activeNet.JSONRPCClientPort,
+		User:        cfg.DcrdUsername,
+		Pass:        cfg.DcrdPassword,
+		Dial:        dial,
+		CA:          certs,
+		Insecure:    cfg.DisableClientTLS,
+	}
+	if len(clientCert) != 0 {
+		rpcOptions.User = ""
+		rpcOptions.Pass = ""
+		rpcOptions.ClientCert = clientCert
+		rpcOptions.ClientKey = clientKey
+	}
+	return rpcOptions
+}
+
+// newSpendApprover returns the spend approver configured by --spendapprovalcmd,
+// or nil when spend approval is not required.
+func newSpendApprover() wallet.SpendApprover {
+	if cfg.SpendApprovalCmd == "" {
+		return nil
+	}
+	return &spendapproval.Command{
+		Argv:    strings.Fields(cfg.SpendApprovalCmd),
+		Params:  activeNet.Params,
+		Timeout: cfg.SpendApprovalTimeout,
+	}
+}
+
+// newVoteCosigners returns the vote cosigners configured by --votecosignercmd.
+func newVoteCosigners() []wallet.VoteCosigner {
+	cosigners := make([]wallet.VoteCosigner, 0, len(cfg.VoteCosignerCmd))
+	for _, cmd := range cfg.VoteCosignerCmd {
+		cosigners = append(cosigners, &votecosign.Command{Argv: cmd.Argv})
+	}
+	return cosigners
+}
+
+// setChangePolicy applies the change policy configured by --changepolicy to
+// the wallet.
+func setChangePolicy(ctx context.Context, w *wallet.Wallet) error {
+	if cfg.ChangePolicy == "" {
+		return nil
+	}
+	account, err := w.AccountNumber(ctx, cfg.changePolicyAccount)
+	if err != nil {
+		return errors.Errorf("changepolicy: %w", err)
+	}
+	return w.SetChangePolicy(ctx, &wallet.ChangePolicy{
+		Account: account,
+		Branch:  cfg.changePolicyBranch,
+	})
+}
+
 func readCAFile() []byte {
 	// Read certificate file if TLS is not disabled.
 	var certs []byte
@@ -0,0 +1,168 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package errors
+
+import (
+	"context"
+	"errors"
+)
+
+// Code is a stable machine-readable identifier of an error condition reported
+// to RPC clients.  Codes are never renamed or reused, and unlike error
+// strings, may be matched by clients.
+type Code string
+
+// Error codes.  Every error kind has a code, and more specific conditions are
+// described by attaching a Detail to errors.
+const (
+	ErrUnknown             Code = "ErrUnknown"
+	ErrInternal            Code = "ErrInternal"
+	ErrInvalid             Code = "ErrInvalid"
+	ErrPermission          Code = "ErrPermission"
+	ErrIO                  Code = "ErrIO"
+	ErrExist               Code = "ErrExist"
+	ErrNotExist            Code = "ErrNotExist"
+	ErrEncoding            Code = "ErrEncoding"
+	ErrCrypto              Code = "ErrCrypto"
+	ErrLocked              Code = "ErrLocked"
+	ErrPassphrase          Code = "ErrPassphrase"
+	ErrSeed                Code = "ErrSeed"
+	ErrWatchingOnly        Code = "ErrWatchingOnly"
+	ErrInsufficientBalance Code = "ErrInsufficientBalance"
+	ErrScriptFailure       Code = "ErrScriptFailure"
+	ErrPolicy              Code = "ErrPolicy"
+	ErrConsensus           Code = "ErrConsensus"
+	ErrDoubleSpend         Code = "ErrDoubleSpend"
+	ErrProtocol            Code = "ErrProtocol"
+	ErrNoPeers             Code = "ErrNoPeers"
+	ErrDeployment          Code = "ErrDeployment"
+	ErrCanceled            Code = "ErrCanceled"
+
+	// ErrLockedAccount describes an account with a unique passphrase which
+	// must be unlocked.  The account parameter names the account.
+	ErrLockedAccount Code = "ErrLockedAccount"
+
+	// ErrGapLimit describes an address request refused by the unused
+	// address gap limit.  The account and gaplimit parameters name the
+	// account and the limit.
+	ErrGapLimit Code = "ErrGapLimit"
+)
+
+// Detail describes an error condition to RPC clients with a stable code, a
+// hint for remediating the condition, and parameters naming its subject.
+//
+// A Detail is attached to an error by passing it as an argument to E.
+type Detail struct {
+	Code   Code              `json:"code"`
+	Hint   string            `json:"hint,omitempty"`
+	Params map[string]string `json:"params,omitempty"`
+}
+
+// Code returns the error code of the kind.
+func (k Kind) Code() Code {
+	switch k {
+	case Bug:
+		return ErrInternal
+	case Invalid:
+		return ErrInvalid
+	case Permission:
+		return ErrPermission
+	case IO:
+		return ErrIO
+	case Exist:
+		return ErrExist
+	case NotExist:
+		return ErrNotExist
+	case Encoding:
+		return ErrEncoding
+	case Crypto:
+		return ErrCrypto
+	case Locked:
+		return ErrLocked
+	case Passphrase:
+		return ErrPassphrase
+	case Seed:
+		return ErrSeed
+	case WatchingOnly:
+		return ErrWatchingOnly
+	case InsufficientBalance:
+		return ErrInsufficientBalance
+	case ScriptFailure:
+		return ErrScriptFailure
+	case Policy:
+		return ErrPolicy
+	case Consensus:
+		return ErrConsensus
+	case DoubleSpend:
+		return ErrDoubleSpend
+	case Protocol:
+		return ErrProtocol
+	case NoPeers:
+		return ErrNoPeers
+	case Deployment:
+		return ErrDeployment
+	default:
+		return ErrUnknown
+	}
+}
+
+// Hint returns a hint for remediating errors of the kind, or the empty string
+// when no generic remediation is known.
+func (k Kind) Hint() string {
+	switch k {
+	case Bug:
+		return "report the error to the dcrwallet developers"
+	case Invalid:
+		return "check the request parameters"
+	case Permission:
+		return "the request is not permitted by the wallet configuration"
+	case IO:
+		return "check the wallet database and available disk space"
+	case Exist:
+		return "the item already exists and does not need to be created"
+	case NotExist:
+		return "check the names and identifiers in the request"
+	case Encoding:
+		return "check the encoding of the request parameters"
+	case Locked:
+		return "unlock the wallet or account and retry"
+	case Passphrase:
+		return "retry with the correct passphrase"
+	case Seed:
+		return "check the seed words or hex encoding"
+	case WatchingOnly:
+		return "the request requires private keys which are not held by the wallet"
+	case InsufficientBalance:
+		return "wait for unconfirmed funds to mature or request a smaller amount"
+	case Policy:
+		return "the request is refused by wallet policy; adjust the request or policy"
+	case NoPeers:
+		return "check the network connection and dcrd or SPV peers"
+	case Deployment:
+		return "wait for the consensus deployment to activate"
+	default:
+		return ""
+	}
+}
+
+// Describe returns the description of err reported to RPC clients.  This is
+// the outermost Detail attached to err or its wrapped errors, or otherwise a
+// description of its kind.
+func Describe(err error) Detail {
+	var e *Error
+	for next := err; errors.As(next, &e); next = e.Err {
+		if e.detail != nil {
+			return *e.detail
+		}
+	}
+	var kind Kind
+	if errors.As(err, &kind) {
+		return Detail{Code: kind.Code(), Hint: kind.Hint()}
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return Detail{Code: ErrCanceled}
+	}
+	return Detail{Code: ErrUnknown}
+}
@@ -34,7 +34,8 @@ type Error struct {
 	Kind Kind
 	Err  error
 
-	stack []byte
+	detail *Detail
+	stack  []byte
 }
 
 // Op describes the operation, method, or RPC in which an error condition was
@@ -174,10 +175,12 @@ func Errorf(format string, args ...any) error {
 //	    Description of the error condition.  String types populate the
 //	    Err field and overwrite, and are overwritten by, other arguments
 //	    which implement the error interface.
+//	errors.Detail
+//	    The description of the error reported to RPC clients.
 //	error
-//	    The underlying error.  If the error is an *Error, the Op and Kind
-//	    will be promoted to the newly created error if not set to another
-//	    value in the args.
+//	    The underlying error.  If the error is an *Error, the Op, Kind, and
+//	    Detail will be promoted to the newly created error if not set to
+//	    another value in the args.
 //
 // If another *Error is passed as an argument and no other arguments differ from
 // the wrapped error, instead of wrapping the error, the errors are collapsed
@@ -197,6 +200,8 @@ func E(args ...any) error {
 			e.Op = arg
 		case Kind:
 			e.Kind = arg
+		case Detail:
+			e.detail = &arg
 		case string:
 			e.Err = New(arg)
 		case *Error:
@@ -210,10 +215,10 @@ func E(args ...any) error {
 		}
 	}
 
-	// Promote the Op and Kind of the nested Error to the newly created error,
-	// if these fields were not part of the args.  This improves matching
-	// capabilities as well as improving the order of these fields in the
-	// formatted error.
+	// Promote the Op, Kind, and Detail of the nested Error to the newly
+	// created error, if these fields were not part of the args.  This improves
+	// matching capabilities as well as improving the order of these fields in
+	// the formatted error.
 	if e.Err == prev && prev != nil {
 		if e.Op == "" {
 			e.Op = prev.Op
@@ -221,10 +226,14 @@ func E(args ...any) error {
 		if e.Kind == 0 {
 			e.Kind = prev.Kind
 		}
+		if e.detail == nil {
+			e.detail = prev.detail
+		}
 
 		// Remove the previous error from error chain if it does not have any
 		// unique fields.
-		if (prev.Op == "" || e.Op == prev.Op) && (prev.Kind == 0 || e.Kind == prev.Kind) {
+		if (prev.Op == "" || e.Op == prev.Op) && (prev.Kind == 0 || e.Kind == prev.Kind) &&
+			(prev.detail == nil || e.detail == prev.detail) {
 			e.Err = prev.Err
 			if e.stack == nil {
 				e.stack = prev.stack
@@ -5,7 +5,9 @@
 package errors
 
 import (
+	"context"
 	std "errors"
+	"fmt"
 	"testing"
 )
 
@@ -79,3 +81,37 @@ func TestDoubleWrappedErrorWithKind(t *testing.T) {
 		t.Errorf("Wrapped error: %T %+[1]v", err.(*Error).Unwrap())
 	}
 }
+
+func TestDescribe(t *testing.T) {
+	locked := Detail{
+		Code:   ErrLockedAccount,
+		Params: map[string]string{"account": "cold"},
+	}
+	tests := []struct {
+		err  error
+		code Code
+	}{
+		{E(Op("op"), Locked, locked, "account is locked"), ErrLockedAccount},
+		{E(Op("outer"), E(Op("op"), Locked, locked)), ErrLockedAccount},
+		{fmt.Errorf("wrapped: %w", E(Locked, locked)), ErrLockedAccount},
+		{E(Op("op"), Locked), ErrLocked},
+		{Passphrase, ErrPassphrase},
+		{fmt.Errorf("wrapped: %w", context.Canceled), ErrCanceled},
+		{std.New("base error"), ErrUnknown},
+	}
+	for i, tc := range tests {
+		d := Describe(tc.err)
+		if d.Code != tc.code {
+			t.Errorf("test %d: code %v, want %v", i, d.Code, tc.code)
+		}
+	}
+
+	// Details do not prevent matching the wrapped error kind.
+	err := E(Op("op"), E(Locked, locked))
+	if !Is(err, Locked) {
+		t.Errorf("no match on kind of error with detail")
+	}
+	if d := Describe(err); d.Params["account"] != "cold" {
+		t.Errorf("detail params %v", d.Params)
+	}
+}
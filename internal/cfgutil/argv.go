@@ -0,0 +1,46 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package cfgutil
+
+import (
+	"encoding/json"
+
+	"decred.org/dcrwallet/v5/errors"
+)
+
+// ArgvFlag is a command and its arguments, written as a JSON array of
+// strings such as ["dcrctl", "--wallet", "cosignvote", "-"].  Arguments are
+// passed to the command exactly as written, and may contain whitespace.  It
+// implements the flags.Marshaler and Unmarshaler interfaces so it can be used
+// as a config struct field.
+type ArgvFlag struct {
+	Argv []string
+}
+
+// MarshalFlag satisfies the flags.Marshaler interface.
+func (a *ArgvFlag) MarshalFlag() (string, error) {
+	if len(a.Argv) == 0 {
+		return "", nil
+	}
+	b, err := json.Marshal(a.Argv)
+	return string(b), err
+}
+
+// UnmarshalFlag satisfies the flags.Unmarshaler interface.
+func (a *ArgvFlag) UnmarshalFlag(value string) error {
+	if value == "" {
+		a.Argv = nil
+		return nil
+	}
+	var argv []string
+	if err := json.Unmarshal([]byte(value), &argv); err != nil {
+		return errors.New("command must be a JSON array of strings")
+	}
+	if len(argv) == 0 || argv[0] == "" {
+		return errors.New("command must name a program")
+	}
+	a.Argv = argv
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package cfgutil
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestArgvFlag(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		argv  []string
+		err   bool
+	}{{
+		name:  "empty",
+		value: "",
+	}, {
+		name:  "program",
+		value: `["pass"]`,
+		argv:  []string{"pass"},
+	}, {
+		name:  "arguments with whitespace",
+		value: `["/opt/My Tools/pass", "show", "dcrwallet private", ""]`,
+		argv:  []string{"/opt/My Tools/pass", "show", "dcrwallet private", ""},
+	}, {
+		name:  "unquoted command",
+		value: "pass show dcrwallet/private",
+		err:   true,
+	}, {
+		name:  "string",
+		value: `"pass"`,
+		err:   true,
+	}, {
+		name:  "non-string arguments",
+		value: `["pass", 1]`,
+		err:   true,
+	}, {
+		name:  "empty array",
+		value: `[]`,
+		err:   true,
+	}, {
+		name:  "empty program",
+		value: `["", "show"]`,
+		err:   true,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a := &ArgvFlag{Argv: []string{"previous"}}
+			err := a.UnmarshalFlag(test.value)
+			if test.err {
+				if err == nil {
+					t.Fatalf("parsed %q as %q", test.value, a.Argv)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(a.Argv, test.argv) {
+				t.Fatalf("parsed %q, want %q", a.Argv, test.argv)
+			}
+
+			// Marshaled flags parse to the same arguments.
+			s, err := a.MarshalFlag()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var b ArgvFlag
+			if err := b.UnmarshalFlag(s); err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(b.Argv, test.argv) {
+				t.Fatalf("marshaled %q parsed as %q", s, b.Argv)
+			}
+		})
+	}
+}
@@ -0,0 +1,431 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package eventbus publishes wallet events to a message bus.
+//
+// Every message is a JSON encoded Envelope.  The envelope Version is bumped
+// whenever a change to the event schema is not backwards compatible.  Events
+// are published to the following subjects, each beginning with a configured
+// prefix:
+//
+//	<prefix>.tx.new            Transaction first seen unmined (TxEvent)
+//	<prefix>.tx.confirmed      Transaction mined in a main chain block (TxEvent)
+//	<prefix>.tip               Main chain tip changed (TipEvent)
+//	<prefix>.ticket            Ticket purchased, voted, or revoked (TicketEvent)
+//	<prefix>.idlelock          Wallet about to be or was locked due to inactivity (IdleLockEvent)
+//	<prefix>.instanceconflict  Another wallet process wrote to the database (InstanceConflictEvent)
+//
+// NATS is the only supported transport.
+package eventbus
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet"
+	"github.com/decred/dcrd/wire"
+)
+
+// SchemaVersion is the version of the event schema.
+const SchemaVersion = 1
+
+// Event types, also used as subject suffixes.
+const (
+	TypeTxNew            = "tx.new"
+	TypeTxConfirmed      = "tx.confirmed"
+	TypeTip              = "tip"
+	TypeTicket           = "ticket"
+	TypeIdleLock         = "idlelock"
+	TypeInstanceConflict = "instanceconflict"
+)
+
+// Ticket statuses reported by TicketEvent.
+const (
+	TicketPurchased = "purchased"
+	TicketVoted     = "voted"
+	TicketRevoked   = "revoked"
+)
+
+const queueSize = 1024
+
+// DialFunc dials a network connection.
+type DialFunc func(ctx context.Context, network, addr string) (net.Conn, error)
+
+// Publisher publishes messages to subjects of a message bus.
+type Publisher interface {
+	Publish(ctx context.Context, subject string, data []byte) error
+	Close() error
+}
+
+// NewPublisher returns a Publisher for the bus at rawURL.  Only nats:// URLs
+// are supported.  Connections are established on the first publish.
+func NewPublisher(rawURL string, dial DialFunc) (Publisher, error) {
+	const op errors.Op = "eventbus.NewPublisher"
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, errors.E(op, errors.Invalid, err)
+	}
+	switch u.Scheme {
+	case "nats":
+		if u.Hostname() == "" {
+			return nil, errors.E(op, errors.Invalid, "missing NATS host")
+		}
+		return newNATSPublisher(u, dial), nil
+	default:
+		return nil, errors.E(op, errors.Invalid,
+			errors.Errorf("unsupported event bus scheme %q", u.Scheme))
+	}
+}
+
+// ValidPrefix returns whether prefix may be used to begin subject names.
+func ValidPrefix(prefix string) bool {
+	if prefix == "" || strings.ContainsAny(prefix, " \t\r\n*>") {
+		return false
+	}
+	for _, tok := range strings.Split(prefix, ".") {
+		if tok == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// Envelope wraps every published event.
+type Envelope struct {
+	Version   int    `json:"version"`
+	Type      string `json:"type"`
+	Timestamp int64  `json:"timestamp"`
+	Data      any    `json:"data"`
+}
+
+// TxCredit describes a wallet output of a transaction.
+type TxCredit struct {
+	Index    uint32 `json:"index"`
+	Account  uint32 `json:"account"`
+	Internal bool   `json:"internal"`
+	Address  string `json:"address,omitempty"`
+	Amount   int64  `json:"amount"`
+}
+
+// TxDebit describes a transaction input spending a wallet output.
+type TxDebit struct {
+	Index           uint32 `json:"index"`
+	PreviousAccount uint32 `json:"previousaccount"`
+	PreviousAmount  int64  `json:"previousamount"`
+}
+
+// TxEvent describes a wallet transaction.  Block fields are only set for
+// confirmed transactions.  Amounts are in atoms.
+type TxEvent struct {
+	Hash        string     `json:"hash"`
+	TxType      string     `json:"txtype"`
+	Fee         int64      `json:"fee"`
+	Credits     []TxCredit `json:"credits"`
+	Debits      []TxDebit  `json:"debits"`
+	BlockHash   string     `json:"blockhash,omitempty"`
+	BlockHeight int32      `json:"blockheight,omitempty"`
+}
+
+// TipEvent describes a main chain tip change.
+type TipEvent struct {
+	Height   int32    `json:"height"`
+	Attached []string `json:"attached"`
+	Detached []string `json:"detached"`
+}
+
+// TicketEvent describes a change in status of a wallet ticket.  For votes and
+// revocations, Hash is the spending transaction and Ticket is the spent
+// ticket.
+type TicketEvent struct {
+	Status      string `json:"status"`
+	Hash        string `json:"hash"`
+	Ticket      string `json:"ticket"`
+	BlockHeight int32  `json:"blockheight,omitempty"`
+}
+
+// IdleLockEvent warns that the wallet will be locked due to inactivity in
+// Remaining seconds, or reports that it was locked when Locked is set.
+type IdleLockEvent struct {
+	Remaining int64 `json:"remaining"`
+	Locked    bool  `json:"locked"`
+}
+
+// InstanceConflictEvent reports that another wallet process was detected
+// writing to the wallet database, or to a copy which replaced it.  Running is
+// set when the other process had not closed the database when this wallet
+// opened it.
+type InstanceConflictEvent struct {
+	Message string `json:"message"`
+	Running bool   `json:"running"`
+}
+
+type message struct {
+	subject string
+	data    []byte
+}
+
+// Bus publishes wallet events through a Publisher.
+type Bus struct {
+	pub    Publisher
+	prefix string
+	queue  chan message
+}
+
+// New returns a Bus publishing to subjects beginning with prefix.
+func New(pub Publisher, prefix string) *Bus {
+	return &Bus{
+		pub:    pub,
+		prefix: prefix,
+		queue:  make(chan message, queueSize),
+	}
+}
+
+func txTypeString(t wallet.TransactionType) string {
+	switch t {
+	case wallet.TransactionTypeCoinbase:
+		return "coinbase"
+	case wallet.TransactionTypeTicketPurchase:
+		return "ticket"
+	case wallet.TransactionTypeVote:
+		return "vote"
+	case wallet.TransactionTypeRevocation:
+		return "revocation"
+	case wallet.TransactionTypeTreasuryAdd:
+		return "treasuryadd"
+	default:
+		return "regular"
+	}
+}
+
+func txEvent(tx *wallet.TransactionSummary, block *wallet.Block) *TxEvent {
+	e := &TxEvent{
+		Hash:    tx.Hash.String(),
+		TxType:  txTypeString(tx.Type),
+		Fee:     int64(tx.Fee),
+		Credits: make([]TxCredit, 0, len(tx.MyOutputs)),
+		Debits:  make([]TxDebit, 0, len(tx.MyInputs)),
+	}
+	for i := range tx.MyOutputs {
+		out := &tx.MyOutputs[i]
+		c := TxCredit{
+			Index:    out.Index,
+			Account:  out.Account,
+			Internal: out.Internal,
+			Amount:   int64(out.Amount),
+		}
+		if out.Address != nil {
+			c.Address = out.Address.String()
+		}
+		e.Credits = append(e.Credits, c)
+	}
+	for i := range tx.MyInputs {
+		in := &tx.MyInputs[i]
+		e.Debits = append(e.Debits, TxDebit{
+			Index:           in.Index,
+			PreviousAccount: in.PreviousAccount,
+			PreviousAmount:  int64(in.PreviousAmount),
+		})
+	}
+	if block != nil {
+		e.BlockHash = block.Header.BlockHash().String()
+		e.BlockHeight = int32(block.Header.Height)
+	}
+	return e
+}
+
+// ticketEvent returns the ticket event for a mined ticket purchase, vote, or
+// revocation, or nil for other transactions.  Events for unmined stake
+// transactions are not created.
+func ticketEvent(tx *wallet.TransactionSummary, block *wallet.Block) (*TicketEvent, error) {
+	var status string
+	switch tx.Type {
+	case wallet.TransactionTypeTicketPurchase:
+		status = TicketPurchased
+	case wallet.TransactionTypeVote:
+		status = TicketVoted
+	case wallet.TransactionTypeRevocation:
+		status = TicketRevoked
+	default:
+		return nil, nil
+	}
+	e := &TicketEvent{
+		Status:      status,
+		Hash:        tx.Hash.String(),
+		Ticket:      tx.Hash.String(),
+		BlockHeight: int32(block.Header.Height),
+	}
+	if status != TicketPurchased {
+		// The ticket is the spent output of the final input of both
+		// votes and revocations.
+		var msgTx wire.MsgTx
+		if err := msgTx.Deserialize(bytes.NewReader(tx.Transaction)); err != nil {
+			return nil, err
+		}
+		if len(msgTx.TxIn) == 0 {
+			return nil, errors.Errorf("stake transaction %v has no inputs", tx.Hash)
+		}
+		e.Ticket = msgTx.TxIn[len(msgTx.TxIn)-1].PreviousOutPoint.Hash.String()
+	}
+	return e, nil
+}
+
+// events returns the envelopes of all events described by a transaction
+// notification.
+func events(n *wallet.TransactionNotifications, now int64) ([]*Envelope, error) {
+	var envs []*Envelope
+	add := func(typ string, data any) {
+		envs = append(envs, &Envelope{
+			Version:   SchemaVersion,
+			Type:      typ,
+			Timestamp: now,
+			Data:      data,
+		})
+	}
+	for i := range n.UnminedTransactions {
+		add(TypeTxNew, txEvent(&n.UnminedTransactions[i], nil))
+	}
+	for i := range n.AttachedBlocks {
+		b := &n.AttachedBlocks[i]
+		for j := range b.Transactions {
+			tx := &b.Transactions[j]
+			add(TypeTxConfirmed, txEvent(tx, b))
+			te, err := ticketEvent(tx, b)
+			if err != nil {
+				return nil, err
+			}
+			if te != nil {
+				add(TypeTicket, te)
+			}
+		}
+	}
+	return envs, nil
+}
+
+func tipEvent(n *wallet.MainTipChangedNotification, now int64) *Envelope {
+	e := &TipEvent{
+		Height:   n.NewHeight,
+		Attached: make([]string, len(n.AttachedBlocks)),
+		Detached: make([]string, len(n.DetachedBlocks)),
+	}
+	for i, h := range n.AttachedBlocks {
+		e.Attached[i] = h.String()
+	}
+	for i, h := range n.DetachedBlocks {
+		e.Detached[i] = h.String()
+	}
+	return &Envelope{
+		Version:   SchemaVersion,
+		Type:      TypeTip,
+		Timestamp: now,
+		Data:      e,
+	}
+}
+
+func idleLockEvent(n *wallet.IdleLockNotification, now int64) *Envelope {
+	return &Envelope{
+		Version:   SchemaVersion,
+		Type:      TypeIdleLock,
+		Timestamp: now,
+		Data: &IdleLockEvent{
+			Remaining: int64(n.Remaining.Round(time.Second) / time.Second),
+			Locked:    n.Locked,
+		},
+	}
+}
+
+func instanceConflictEvent(c *wallet.InstanceConflict) *Envelope {
+	return &Envelope{
+		Version:   SchemaVersion,
+		Type:      TypeInstanceConflict,
+		Timestamp: c.Detected.Unix(),
+		Data: &InstanceConflictEvent{
+			Message: c.String(),
+			Running: c.Running,
+		},
+	}
+}
+
+func (b *Bus) enqueue(env *Envelope) {
+	data, err := json.Marshal(env)
+	if err != nil {
+		log.Errorf("Marshal %s event: %v", env.Type, err)
+		return
+	}
+	select {
+	case b.queue <- message{subject: b.prefix + "." + env.Type, data: data}:
+	default:
+		log.Warnf("Event bus queue full; dropping %s event", env.Type)
+	}
+}
+
+// Run publishes events of w until ctx is cancelled.
+func (b *Bus) Run(ctx context.Context, w *wallet.Wallet) error {
+	txClient := w.NtfnServer.TransactionNotifications()
+	defer txClient.Done()
+	tipClient := w.NtfnServer.MainTipChangedNotifications()
+	defer tipClient.Done()
+	idleLockClient := w.NtfnServer.IdleLockNotifications()
+	defer idleLockClient.Done()
+	conflictClient := w.NtfnServer.InstanceConflictNotifications()
+	defer conflictClient.Done()
+
+	go b.publishLoop(ctx)
+
+	// Conflicts detected while opening the wallet precede the
+	// notification clients.
+	if c := w.InstanceConflict(); c != nil {
+		b.enqueue(instanceConflictEvent(c))
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case n := <-txClient.C:
+			envs, err := events(n, time.Now().Unix())
+			if err != nil {
+				log.Errorf("Transaction events: %v", err)
+				continue
+			}
+			for _, env := range envs {
+				b.enqueue(env)
+			}
+		case n := <-tipClient.C:
+			b.enqueue(tipEvent(n, time.Now().Unix()))
+		case n := <-idleLockClient.C:
+			b.enqueue(idleLockEvent(n, time.Now().Unix()))
+		case c := <-conflictClient.C:
+			b.enqueue(instanceConflictEvent(c))
+		}
+	}
+}
+
+func (b *Bus) publishLoop(ctx context.Context) {
+	defer b.pub.Close()
+	var failing bool
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case m := <-b.queue:
+			err := b.pub.Publish(ctx, m.subject, m.data)
+			switch {
+			case err != nil && !failing:
+				log.Errorf("Publish to %s failed: %v", m.subject, err)
+				failing = true
+			case err != nil:
+				log.Debugf("Publish to %s failed: %v", m.subject, err)
+			case failing:
+				log.Infof("Event bus publishing resumed")
+				failing = false
+			}
+		}
+	}
+}
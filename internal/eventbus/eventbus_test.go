@@ -0,0 +1,135 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package eventbus
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"decred.org/dcrwallet/v5/wallet"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestEvents(t *testing.T) {
+	ticketHash := chainhash.Hash{1}
+	vote := wire.NewMsgTx()
+	vote.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, 0, nil))
+	vote.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&ticketHash, 0, wire.TxTreeStake), 0, nil))
+	var buf bytes.Buffer
+	if err := vote.Serialize(&buf); err != nil {
+		t.Fatal(err)
+	}
+	voteHash := vote.TxHash()
+
+	regularHash := chainhash.Hash{2}
+	regular := wallet.TransactionSummary{
+		Hash:      &regularHash,
+		MyOutputs: []wallet.TransactionSummaryOutput{{Index: 1, Amount: 10}},
+	}
+	n := &wallet.TransactionNotifications{
+		UnminedTransactions: []wallet.TransactionSummary{regular},
+		AttachedBlocks: []wallet.Block{{
+			Header: &wire.BlockHeader{Height: 50},
+			Transactions: []wallet.TransactionSummary{
+				regular,
+				{Hash: &voteHash, Transaction: buf.Bytes(), Type: wallet.TransactionTypeVote},
+			},
+		}},
+	}
+
+	envs, err := events(n, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var types []string
+	for _, env := range envs {
+		types = append(types, env.Type)
+	}
+	want := []string{TypeTxNew, TypeTxConfirmed, TypeTxConfirmed, TypeTicket}
+	if strings.Join(types, ",") != strings.Join(want, ",") {
+		t.Fatalf("event types %v, want %v", types, want)
+	}
+	te := envs[3].Data.(*TicketEvent)
+	if te.Status != TicketVoted || te.Ticket != ticketHash.String() ||
+		te.Hash != voteHash.String() || te.BlockHeight != 50 {
+		t.Errorf("unexpected ticket event %+v", te)
+	}
+	if txe := envs[1].Data.(*TxEvent); txe.BlockHeight != 50 || len(txe.Credits) != 1 {
+		t.Errorf("unexpected confirmed event %+v", txe)
+	}
+}
+
+func TestValidPrefix(t *testing.T) {
+	for prefix, valid := range map[string]bool{
+		"dcrwallet":      true,
+		"wallets.hot.a":  true,
+		"":               false,
+		"a..b":           false,
+		".a":             false,
+		"a b":            false,
+		"wallet.*":       false,
+		"wallet.events>": false,
+	} {
+		if ValidPrefix(prefix) != valid {
+			t.Errorf("ValidPrefix(%q) != %v", prefix, valid)
+		}
+	}
+}
+
+func TestNATSPublish(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+
+	received := make(chan string, 1)
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		br := bufio.NewReader(conn)
+		conn.Write([]byte("INFO {\"server_id\":\"test\"}\r\n"))
+		line, _ := br.ReadString('\n')
+		if !strings.HasPrefix(line, "CONNECT {") {
+			received <- "bad connect: " + line
+			return
+		}
+		if line, _ = br.ReadString('\n'); line != "PING\r\n" {
+			received <- "bad ping: " + line
+			return
+		}
+		conn.Write([]byte("PONG\r\n"))
+		pub, _ := br.ReadString('\n')
+		payload, _ := br.ReadString('\n')
+		received <- pub + payload
+	}()
+
+	p, err := NewPublisher("nats://"+lis.Addr().String(), new(net.Dialer).DialContext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+	err = p.Publish(context.Background(), "dcrwallet.tip", []byte(`{"a":1}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := <-received
+	want := "PUB dcrwallet.tip 7\r\n{\"a\":1}\r\n"
+	if got != want {
+		t.Errorf("server received %q, want %q", got, want)
+	}
+
+	if _, err := NewPublisher("zmq://127.0.0.1", nil); err == nil {
+		t.Errorf("unsupported scheme accepted")
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package eventbus
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+)
+
+const (
+	natsDefaultPort   = "4222"
+	natsDialTimeout   = 10 * time.Second
+	natsWriteTimeout  = 10 * time.Second
+	natsMaxLineLength = 1 << 16
+)
+
+// natsPublisher is a minimal publish-only client of the NATS text protocol.
+// The connection is dialed lazily and redialed on the next publish after any
+// error.
+type natsPublisher struct {
+	addr string
+	user string
+	pass string
+	dial DialFunc
+
+	mu   sync.Mutex
+	conn net.Conn
+	bw   *bufio.Writer
+}
+
+func newNATSPublisher(u *url.URL, dial DialFunc) *natsPublisher {
+	host := u.Host
+	if u.Port() == "" {
+		host = net.JoinHostPort(u.Hostname(), natsDefaultPort)
+	}
+	p := &natsPublisher{addr: host, dial: dial}
+	if u.User != nil {
+		p.user = u.User.Username()
+		p.pass, _ = u.User.Password()
+	}
+	return p
+}
+
+type natsConnect struct {
+	Verbose  bool   `json:"verbose"`
+	Pedantic bool   `json:"pedantic"`
+	Name     string `json:"name"`
+	Lang     string `json:"lang"`
+	Version  string `json:"version"`
+	Protocol int    `json:"protocol"`
+	User     string `json:"user,omitempty"`
+	Pass     string `json:"pass,omitempty"`
+}
+
+// connect dials the server and completes the CONNECT handshake, confirmed by
+// a PING/PONG round trip.
+func (p *natsPublisher) connect(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, natsDialTimeout)
+	defer cancel()
+	conn, err := p.dial(ctx, "tcp", p.addr)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	br := bufio.NewReaderSize(conn, natsMaxLineLength)
+	line, err := br.ReadString('\n')
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	if !strings.HasPrefix(line, "INFO ") {
+		conn.Close()
+		return errors.Errorf("nats: unexpected greeting %q", strings.TrimSpace(line))
+	}
+
+	connect, err := json.Marshal(&natsConnect{
+		Name:     "dcrwallet",
+		Lang:     "go",
+		Version:  "1",
+		Protocol: 0,
+		User:     p.user,
+		Pass:     p.pass,
+	})
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	bw := bufio.NewWriter(conn)
+	bw.WriteString("CONNECT ")
+	bw.Write(connect)
+	bw.WriteString("\r\nPING\r\n")
+	if err := bw.Flush(); err != nil {
+		conn.Close()
+		return err
+	}
+	line, err = br.ReadString('\n')
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	if line = strings.TrimSpace(line); line != "PONG" {
+		conn.Close()
+		return errors.Errorf("nats: handshake failed: %s", line)
+	}
+	conn.SetDeadline(time.Time{})
+
+	p.conn = conn
+	p.bw = bw
+	go p.readLoop(conn, br)
+	return nil
+}
+
+// readLoop answers server PINGs and logs server errors until the connection
+// is closed.
+func (p *natsPublisher) readLoop(conn net.Conn, br *bufio.Reader) {
+	for {
+		line, err := br.ReadString('\n')
+		if err != nil {
+			p.reset(conn)
+			return
+		}
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "PING":
+			p.mu.Lock()
+			if p.conn == conn {
+				p.bw.WriteString("PONG\r\n")
+				err = p.bw.Flush()
+			}
+			p.mu.Unlock()
+			if err != nil {
+				p.reset(conn)
+				return
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			log.Warnf("NATS server %s: %s", p.addr, line)
+		}
+	}
+}
+
+// reset closes conn and forgets it if it is still the current connection.
+func (p *natsPublisher) reset(conn net.Conn) {
+	p.mu.Lock()
+	if p.conn == conn {
+		p.conn = nil
+		p.bw = nil
+	}
+	p.mu.Unlock()
+	conn.Close()
+}
+
+// Publish implements the Publisher interface.
+func (p *natsPublisher) Publish(ctx context.Context, subject string, data []byte) error {
+	defer p.mu.Unlock()
+	p.mu.Lock()
+
+	if p.conn == nil {
+		if err := p.connect(ctx); err != nil {
+			return err
+		}
+	}
+	conn := p.conn
+	conn.SetWriteDeadline(time.Now().Add(natsWriteTimeout))
+	p.bw.WriteString("PUB ")
+	p.bw.WriteString(subject)
+	p.bw.WriteByte(' ')
+	p.bw.WriteString(strconv.Itoa(len(data)))
+	p.bw.WriteString("\r\n")
+	p.bw.Write(data)
+	p.bw.WriteString("\r\n")
+	err := p.bw.Flush()
+	if err != nil {
+		p.conn = nil
+		p.bw = nil
+		conn.Close()
+	}
+	return err
+}
+
+// Close implements the Publisher interface.
+func (p *natsPublisher) Close() error {
+	defer p.mu.Unlock()
+	p.mu.Lock()
+
+	if p.conn == nil {
+		return nil
+	}
+	err := p.conn.Close()
+	p.conn = nil
+	p.bw = nil
+	return err
+}
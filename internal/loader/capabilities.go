@@ -0,0 +1,57 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package loader
+
+import (
+	"sort"
+
+	"decred.org/dcrwallet/v5/chain"
+	"decred.org/dcrwallet/v5/spv"
+)
+
+// Sync modes reported by Capabilities.
+const (
+	SyncModeNone = "none"
+	SyncModeSPV  = "spv"
+	SyncModeRPC  = "rpc"
+)
+
+// Capabilities describes the enabled subsystems of an RPC server and any
+// loaded wallet.
+type Capabilities struct {
+	// WalletLoaded records whether a wallet is loaded.  The sync mode and
+	// wallet features are only reported for a loaded wallet.
+	WalletLoaded bool
+
+	// SyncMode describes how the loaded wallet is synced to the network.
+	SyncMode string
+
+	// Features are the sorted names of the server features and the
+	// capabilities of the loaded wallet.
+	Features []string
+}
+
+// Capabilities describes the loaded wallet, if any, together with features
+// provided by the RPC server calling it.  Every RPC server reports
+// capabilities through this method so that clients observe the same features
+// regardless of the API used.
+func (l *Loader) Capabilities(serverFeatures ...string) *Capabilities {
+	c := &Capabilities{
+		SyncMode: SyncModeNone,
+		Features: append([]string{}, serverFeatures...),
+	}
+	if w, ok := l.LoadedWallet(); ok {
+		c.WalletLoaded = true
+		c.Features = append(c.Features, w.Capabilities()...)
+		switch n, _ := w.NetworkBackend(); n.(type) {
+		case *spv.Syncer:
+			c.SyncMode = SyncModeSPV
+		case *chain.Syncer:
+			c.SyncMode = SyncModeRPC
+		}
+	}
+	sort.Strings(c.Features)
+	return c
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package loader
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os/exec"
+
+	werrors "decred.org/dcrwallet/v5/errors"
+)
+
+// errSecItemNotFound is the exit status of the security program when no
+// matching keychain item exists.
+const errSecItemNotFound = 44
+
+// keyringLookup reads a generic password from the login keychain.  Entries
+// may be created with
+//
+//	security add-generic-password -s <service> -a <account> -w
+func keyringLookup(ctx context.Context, service, account string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "security", "find-generic-password",
+		"-s", service, "-a", account, "-w")
+	out, err := cmd.Output()
+	var exitErr *exec.ExitError
+	switch {
+	case errors.As(err, &exitErr) && exitErr.ExitCode() == errSecItemNotFound:
+		return nil, werrors.E(werrors.NotExist, werrors.Errorf("no keychain item for %s %s", service, account))
+	case err != nil:
+		return nil, werrors.E(werrors.IO, werrors.Errorf("security: %w", err))
+	}
+	pass := bytes.Clone(bytes.TrimSuffix(out, []byte("\n")))
+	clear(out)
+	return pass, nil
+}
@@ -0,0 +1,17 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+//go:build !unix && !windows
+
+package loader
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+)
+
+func keyringLookup(ctx context.Context, service, account string) ([]byte, error) {
+	return nil, errors.E(errors.Invalid, "no keyring support on this platform")
+}
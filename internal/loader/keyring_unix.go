@@ -0,0 +1,38 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+//go:build unix && !darwin
+
+package loader
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os/exec"
+
+	werrors "decred.org/dcrwallet/v5/errors"
+)
+
+// keyringLookup reads a password from the Secret Service using the
+// secret-tool program distributed with libsecret.  Entries may be created with
+//
+//	secret-tool store --label=dcrwallet service <service> account <account>
+func keyringLookup(ctx context.Context, service, account string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "secret-tool", "lookup",
+		"service", service, "account", account)
+	out, err := cmd.Output()
+	var exitErr *exec.ExitError
+	switch {
+	case errors.As(err, &exitErr) && len(exitErr.Stderr) == 0:
+		// secret-tool exits with status 1 and no error message when no
+		// matching item exists.
+		return nil, werrors.E(werrors.NotExist, werrors.Errorf("no keyring entry for %s %s", service, account))
+	case err != nil:
+		return nil, werrors.E(werrors.IO, werrors.Errorf("secret-tool: %w", err))
+	}
+	pass := bytes.Clone(bytes.TrimSuffix(out, []byte("\n")))
+	clear(out)
+	return pass, nil
+}
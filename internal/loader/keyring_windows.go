@@ -0,0 +1,78 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package loader
+
+import (
+	"context"
+	"unicode/utf16"
+	"unicode/utf8"
+	"unsafe"
+
+	"decred.org/dcrwallet/v5/errors"
+	"golang.org/x/sys/windows"
+)
+
+var (
+	modadvapi32   = windows.NewLazySystemDLL("advapi32.dll")
+	procCredReadW = modadvapi32.NewProc("CredReadW")
+	procCredFree  = modadvapi32.NewProc("CredFree")
+)
+
+const credTypeGeneric = 1
+
+// credential mirrors the Win32 CREDENTIALW structure.
+type credential struct {
+	Flags              uint32
+	Type               uint32
+	TargetName         *uint16
+	Comment            *uint16
+	LastWritten        windows.Filetime
+	CredentialBlobSize uint32
+	CredentialBlob     *byte
+	Persist            uint32
+	AttributeCount     uint32
+	Attributes         uintptr
+	TargetAlias        *uint16
+	UserName           *uint16
+}
+
+// keyringLookup reads a generic credential from the Credential Manager.  The
+// credential blob is decoded as UTF-16, which is how passwords are stored by
+// the Credential Manager control panel and cmdkey:
+//
+//	cmdkey /generic:<service>:<account> /user:<account> /pass
+func keyringLookup(ctx context.Context, service, account string) ([]byte, error) {
+	target, err := windows.UTF16PtrFromString(service + ":" + account)
+	if err != nil {
+		return nil, errors.E(errors.Invalid, err)
+	}
+	var cred *credential
+	r, _, err := procCredReadW.Call(uintptr(unsafe.Pointer(target)),
+		credTypeGeneric, 0, uintptr(unsafe.Pointer(&cred)))
+	if r == 0 {
+		if err == windows.ERROR_NOT_FOUND {
+			return nil, errors.E(errors.NotExist, errors.Errorf("no credential for %s:%s", service, account))
+		}
+		return nil, errors.E(errors.IO, errors.Errorf("CredReadW: %w", err))
+	}
+	defer procCredFree.Call(uintptr(unsafe.Pointer(cred)))
+
+	blob := unsafe.Slice(cred.CredentialBlob, cred.CredentialBlobSize)
+	if len(blob)%2 != 0 {
+		return nil, errors.E(errors.Encoding, "credential is not UTF-16 encoded")
+	}
+	u := make([]uint16, len(blob)/2)
+	for i := range u {
+		u[i] = uint16(blob[2*i]) | uint16(blob[2*i+1])<<8
+	}
+	runes := utf16.Decode(u)
+	clear(u)
+	pass := make([]byte, 0, len(runes)*utf8.UTFMax)
+	for _, r := range runes {
+		pass = utf8.AppendRune(pass, r)
+	}
+	clear(runes)
+	return pass, nil
+}
@@ -48,7 +48,15 @@ type Loader struct {
 	relayFee                dcrutil.Amount
 	vspMaxFee               dcrutil.Amount
 	mixSplitLimit           int
+	txPruneDepth            int32
+	sidechainPruneDepth     int32
+	watchingOnlyGapLimit    uint32
+	voteOnly                bool
+	treasuryKeys            bool
+	rebuildTxStore          bool
+	replicaOf               string
 	dialer                  wallet.DialFunc
+	passSource              PassphraseSource
 
 	mu sync.Mutex
 }
@@ -76,6 +84,84 @@ func NewLoader(chainParams *chaincfg.Params, dbDirPath string, votingEnabled boo
 	}
 }
 
+// SetTxPruneDepth configures periodic pruning of transactions with more than
+// depth confirmations by subsequently opened wallets.  Pruning is disabled
+// with a zero depth.
+func (l *Loader) SetTxPruneDepth(depth int32) {
+	l.mu.Lock()
+	l.txPruneDepth = depth
+	l.mu.Unlock()
+}
+
+// SetSidechainPruneDepth configures periodic pruning of the headers and
+// compact filters of blocks reorganized out of the main chain more than depth
+// blocks below the tip by subsequently opened wallets.  Pruning is disabled
+// with a zero depth.
+func (l *Loader) SetSidechainPruneDepth(depth int32) {
+	l.mu.Lock()
+	l.sidechainPruneDepth = depth
+	l.mu.Unlock()
+}
+
+// SetWatchingOnlyGapLimit configures subsequently opened watching-only
+// wallets to use an address gap limit of n when it is larger than the wallet
+// gap limit.
+func (l *Loader) SetWatchingOnlyGapLimit(n uint32) {
+	l.mu.Lock()
+	l.watchingOnlyGapLimit = n
+	l.mu.Unlock()
+}
+
+// SetVoteOnly configures subsequently opened wallets to only permit voting,
+// revocations, and read operations.
+func (l *Loader) SetVoteOnly(voteOnly bool) {
+	l.mu.Lock()
+	l.voteOnly = voteOnly
+	l.mu.Unlock()
+}
+
+// SetTreasuryKeys configures subsequently opened wallets to permit importing
+// treasury spend signing keys and signing treasury spends.
+func (l *Loader) SetTreasuryKeys(enable bool) {
+	l.mu.Lock()
+	l.treasuryKeys = enable
+	l.mu.Unlock()
+}
+
+// SetRebuildTxStore configures the next opened wallet to replace its
+// transaction store with an empty store before opening, preserving its keys
+// and accounts.  The transaction history is rebuilt by rescanning the
+// blockchain when the wallet is synced.  The store is only rebuilt once.
+func (l *Loader) SetRebuildTxStore(rebuild bool) {
+	l.mu.Lock()
+	l.rebuildTxStore = rebuild
+	l.mu.Unlock()
+}
+
+// rebuildWalletTxStore replaces the transaction store of an unopened wallet
+// database with the empty store of a scratch database created in the wallet
+// directory.  Requires mutex to be locked.
+func (l *Loader) rebuildWalletTxStore(ctx context.Context, db wallet.DB) error {
+	scratchPath := filepath.Join(l.dbDirPath, walletDbName+".rebuild")
+	if err := os.Remove(scratchPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	scratch, err := wallet.CreateDB(driver, scratchPath)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(scratchPath)
+	defer scratch.Close()
+
+	log.Infof("Rebuilding the transaction store of the wallet")
+	err = wallet.RebuildTxStore(ctx, db, scratch, l.chainParams)
+	if err != nil {
+		return err
+	}
+	l.rebuildTxStore = false
+	return nil
+}
+
 // onLoaded executes each added callback and prevents loader from loading any
 // additional wallets.  Requires mutex to be locked.
 func (l *Loader) onLoaded(w *wallet.Wallet, db wallet.DB) {
@@ -179,6 +265,11 @@ func (l *Loader) CreateWatchingOnlyWallet(ctx context.Context, extendedPubKey st
 		AllowHighFees:           l.allowHighFees,
 		RelayFee:                l.relayFee,
 		VSPMaxFee:               l.vspMaxFee,
+		TxPruneDepth:            l.txPruneDepth,
+		SidechainPruneDepth:     l.sidechainPruneDepth,
+		WatchingOnlyGapLimit:    l.watchingOnlyGapLimit,
+		VoteOnly:                l.voteOnly,
+		TreasuryKeys:            l.treasuryKeys,
 		MixSplitLimit:           l.mixSplitLimit,
 		Params:                  l.chainParams,
 		Dialer:                  l.dialer,
@@ -269,6 +360,11 @@ func (l *Loader) CreateNewWallet(ctx context.Context, pubPassphrase, privPassphr
 		AllowHighFees:           l.allowHighFees,
 		RelayFee:                l.relayFee,
 		VSPMaxFee:               l.vspMaxFee,
+		TxPruneDepth:            l.txPruneDepth,
+		SidechainPruneDepth:     l.sidechainPruneDepth,
+		WatchingOnlyGapLimit:    l.watchingOnlyGapLimit,
+		VoteOnly:                l.voteOnly,
+		TreasuryKeys:            l.treasuryKeys,
 		Params:                  l.chainParams,
 		Dialer:                  l.dialer,
 	}
@@ -295,10 +391,15 @@ func (l *Loader) OpenExistingWallet(ctx context.Context, pubPassphrase []byte) (
 		return nil, errors.E(op, errors.Exist, "wallet already opened")
 	}
 
-	// Open the database using the boltdb backend.
+	// Open the database using the boltdb backend.  Replicas open the
+	// replicated snapshot read-only.
 	dbPath := filepath.Join(l.dbDirPath, walletDbName)
+	dbArgs := []any{dbPath}
+	if l.replicaOf != "" {
+		dbArgs = []any{l.replicaOf, true}
+	}
 	l.mu.Unlock()
-	db, err := wallet.OpenDB(driver, dbPath)
+	db, err := wallet.OpenDB(driver, dbArgs...)
 	l.mu.Lock()
 
 	if err != nil {
@@ -315,7 +416,30 @@ func (l *Loader) OpenExistingWallet(ctx context.Context, pubPassphrase []byte) (
 		}
 	}()
 
-	cfg := &wallet.Config{
+	if l.rebuildTxStore {
+		if l.replicaOf != "" {
+			return nil, errors.E(op, errors.Invalid, "transaction store of "+
+				"a replica can not be rebuilt")
+		}
+		err = l.rebuildWalletTxStore(ctx, db)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+
+	w, err = wallet.Open(ctx, l.walletConfig(db, pubPassphrase))
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	l.onLoaded(w, db)
+	return w, nil
+}
+
+// walletConfig returns the configuration of wallets opened by the loader from
+// the database db.  Requires mutex to be locked.
+func (l *Loader) walletConfig(db wallet.DB, pubPassphrase []byte) *wallet.Config {
+	return &wallet.Config{
 		DB:                      db,
 		PubPassphrase:           pubPassphrase,
 		VotingEnabled:           l.votingEnabled,
@@ -328,17 +452,16 @@ func (l *Loader) OpenExistingWallet(ctx context.Context, pubPassphrase []byte) (
 		AllowHighFees:           l.allowHighFees,
 		RelayFee:                l.relayFee,
 		VSPMaxFee:               l.vspMaxFee,
+		TxPruneDepth:            l.txPruneDepth,
+		SidechainPruneDepth:     l.sidechainPruneDepth,
+		WatchingOnlyGapLimit:    l.watchingOnlyGapLimit,
+		VoteOnly:                l.voteOnly,
+		TreasuryKeys:            l.treasuryKeys,
+		ReadOnly:                l.replicaOf != "",
 		MixSplitLimit:           l.mixSplitLimit,
 		Params:                  l.chainParams,
 		Dialer:                  l.dialer,
 	}
-	w, err = wallet.Open(ctx, cfg)
-	if err != nil {
-		return nil, errors.E(op, err)
-	}
-
-	l.onLoaded(w, db)
-	return w, nil
 }
 
 // DbDirPath returns the Loader's database directory path
@@ -382,6 +505,10 @@ func (l *Loader) UnloadWallet() error {
 		return errors.E(op, errors.Invalid, "wallet is unopened")
 	}
 
+	l.wallet.CloseVSPs()
+	if err := l.wallet.ReleaseInstance(context.Background()); err != nil {
+		log.Errorf("Failed to record wallet close: %v", err)
+	}
 	err := l.db.Close()
 	if err != nil {
 		return errors.E(op, err)
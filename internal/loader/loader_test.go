@@ -0,0 +1,116 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package loader
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestOpenExistingWalletRebuildTxStore(t *testing.T) {
+	ctx := context.Background()
+	params := chaincfg.SimNetParams()
+	pubPass := []byte("public")
+	l := NewLoader(params, t.TempDir(), false, 20, 0, false, 1e4, 0, 0,
+		false, false, false, 0, nil)
+
+	w, err := l.CreateNewWallet(ctx, pubPass, []byte("private"),
+		bytes.Repeat([]byte{0x07}, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, pkScript := addr.PaymentScript()
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, 0, nil))
+	tx.AddTxOut(&wire.TxOut{Value: 1e8, Version: version, PkScript: pkScript})
+	if err := w.AddTransaction(ctx, tx, &params.GenesisHash); err != nil {
+		t.Fatal(err)
+	}
+	txHash := tx.TxHash()
+	hashes := []*chainhash.Hash{&txHash}
+	if err := l.UnloadWallet(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Opening without the option preserves the transaction history.
+	w, err = l.OpenExistingWallet(ctx, pubPass)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, notFound, err := w.GetTransactionsByHashes(ctx, hashes); err != nil || len(notFound) != 0 {
+		t.Fatalf("transaction missing before rebuild: %v", err)
+	}
+	if err := l.UnloadWallet(); err != nil {
+		t.Fatal(err)
+	}
+
+	l.SetRebuildTxStore(true)
+	w, err = l.OpenExistingWallet(ctx, pubPass)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := w.GetTransactionsByHashes(ctx, hashes); !errors.Is(err, errors.NotExist) {
+		t.Fatalf("transaction remains after rebuild: %v", err)
+	}
+	if _, err := w.NewExternalAddress(ctx, 0); err != nil {
+		t.Fatalf("accounts were not preserved: %v", err)
+	}
+	if l.rebuildTxStore {
+		t.Error("rebuild remains configured after opening")
+	}
+	if err := l.UnloadWallet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCapabilities(t *testing.T) {
+	ctx := context.Background()
+	params := chaincfg.SimNetParams()
+	l := NewLoader(params, t.TempDir(), false, 20, 0, false, 1e4, 0, 0,
+		false, true, false, 0, nil)
+
+	// Without a wallet, only the server features are reported.
+	c := l.Capabilities("webhooks", "pendingspends")
+	if c.WalletLoaded || c.SyncMode != SyncModeNone {
+		t.Fatalf("unloaded wallet reported as loaded=%v syncmode=%q",
+			c.WalletLoaded, c.SyncMode)
+	}
+	if want := []string{"pendingspends", "webhooks"}; !slices.Equal(c.Features, want) {
+		t.Fatalf("features %v, want %v", c.Features, want)
+	}
+	if c := l.Capabilities(); c.Features == nil {
+		t.Fatal("features are nil rather than empty")
+	}
+
+	_, err := l.CreateNewWallet(ctx, []byte("public"), []byte("private"),
+		bytes.Repeat([]byte{0x07}, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.UnloadWallet()
+
+	// Wallet capabilities are merged with the server features in sorted
+	// order.  The wallet is not yet syncing.
+	c = l.Capabilities("webhooks", "pendingspends")
+	if !c.WalletLoaded || c.SyncMode != SyncModeNone {
+		t.Fatalf("loaded wallet reported as loaded=%v syncmode=%q",
+			c.WalletLoaded, c.SyncMode)
+	}
+	want := []string{"mixing", "pendingspends", "webhooks"}
+	if !slices.Equal(c.Features, want) {
+		t.Fatalf("features %v, want %v", c.Features, want)
+	}
+}
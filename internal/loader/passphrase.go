@@ -0,0 +1,165 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package loader
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+
+	"decred.org/dcrwallet/v5/errors"
+)
+
+// PassphraseKind identifies the public or private wallet passphrase.
+type PassphraseKind int
+
+// Passphrase kinds.
+const (
+	PublicPassphrase PassphraseKind = iota
+	PrivatePassphrase
+)
+
+// String returns "public" or "private".
+func (k PassphraseKind) String() string {
+	if k == PrivatePassphrase {
+		return "private"
+	}
+	return "public"
+}
+
+// PassphraseSource provides wallet passphrases from outside of the wallet's
+// configuration, such as an external program or the operating system's
+// keyring.  Sources return an error with the NotExist kind when they do not
+// hold a passphrase of the requested kind.
+type PassphraseSource interface {
+	Passphrase(ctx context.Context, kind PassphraseKind) ([]byte, error)
+}
+
+// CommandSource reads passphrases from the standard output of external
+// commands.  Each command is the program name followed by its arguments, and
+// a nil command provides no passphrase.  A single trailing newline is removed
+// from the output.  Commands inherit the wallet's standard input and error so
+// they may interactively prompt for a passphrase, e.g. to unlock a password
+// manager.
+type CommandSource struct {
+	Public  []string
+	Private []string
+}
+
+// Passphrase runs the command for the passphrase kind and returns its output.
+func (s *CommandSource) Passphrase(ctx context.Context, kind PassphraseKind) ([]byte, error) {
+	const op errors.Op = "loader.CommandSource.Passphrase"
+	argv := s.Public
+	if kind == PrivatePassphrase {
+		argv = s.Private
+	}
+	if len(argv) == 0 {
+		return nil, errors.E(op, errors.NotExist, errors.Errorf("no %v passphrase command", kind))
+	}
+	cmd := exec.CommandContext(ctx, argv[0], argv[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		clear(out)
+		return nil, errors.E(op, errors.Errorf("%v passphrase command: %w", kind, err))
+	}
+	n := len(out)
+	if bytes.HasSuffix(out, []byte("\r\n")) {
+		n -= 2
+	} else if bytes.HasSuffix(out, []byte("\n")) {
+		n--
+	}
+	pass := bytes.Clone(out[:n])
+	clear(out)
+	return pass, nil
+}
+
+// KeyringSource reads passphrases from the operating system's credential
+// store: the Secret Service (libsecret) on Linux and BSDs, the login Keychain
+// on macOS, and the Credential Manager on Windows.  Passphrases are stored
+// under Service with an account name of the wallet's network and passphrase
+// kind joined by a hyphen, e.g. "mainnet-private".
+//
+// On Windows, the generic credential target name is the service and account
+// joined by a colon, e.g. "dcrwallet:mainnet-private".
+type KeyringSource struct {
+	Service string
+	Network string
+}
+
+// Passphrase looks up the passphrase of the requested kind in the keyring.
+func (s *KeyringSource) Passphrase(ctx context.Context, kind PassphraseKind) ([]byte, error) {
+	const op errors.Op = "loader.KeyringSource.Passphrase"
+	pass, err := keyringLookup(ctx, s.Service, s.Network+"-"+kind.String())
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return pass, nil
+}
+
+// PassphraseSources tries each source in order, returning the first
+// passphrase found.
+type PassphraseSources []PassphraseSource
+
+// Passphrase returns the passphrase from the first source which holds one.
+func (s PassphraseSources) Passphrase(ctx context.Context, kind PassphraseKind) ([]byte, error) {
+	for _, src := range s {
+		pass, err := src.Passphrase(ctx, kind)
+		if errors.Is(err, errors.NotExist) {
+			continue
+		}
+		return pass, err
+	}
+	return nil, errors.E(errors.NotExist, errors.Errorf("no %v passphrase source", kind))
+}
+
+// SetPassphraseSource configures the source of passphrases used by Passphrase
+// and UnlockWallet.
+func (l *Loader) SetPassphraseSource(src PassphraseSource) {
+	l.mu.Lock()
+	l.passSource = src
+	l.mu.Unlock()
+}
+
+// Passphrase returns a passphrase from the loader's passphrase source.  An
+// error with the NotExist kind is returned when no source is configured or
+// the source does not provide the passphrase.
+func (l *Loader) Passphrase(ctx context.Context, kind PassphraseKind) ([]byte, error) {
+	const op errors.Op = "loader.Passphrase"
+	l.mu.Lock()
+	src := l.passSource
+	l.mu.Unlock()
+	if src == nil {
+		return nil, errors.E(op, errors.NotExist, "no passphrase source")
+	}
+	pass, err := src.Passphrase(ctx, kind)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return pass, nil
+}
+
+// UnlockWallet unlocks the loaded wallet with the private passphrase provided
+// by the passphrase source, without a timeout.  The passphrase is returned so
+// callers may start services which require it, and should be zeroed when no
+// longer needed.
+func (l *Loader) UnlockWallet(ctx context.Context) ([]byte, error) {
+	const op errors.Op = "loader.UnlockWallet"
+	w, ok := l.LoadedWallet()
+	if !ok {
+		return nil, errors.E(op, errors.Invalid, "wallet is not loaded")
+	}
+	pass, err := l.Passphrase(ctx, PrivatePassphrase)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if err := w.Unlock(ctx, pass, nil); err != nil {
+		clear(pass)
+		return nil, errors.E(op, err)
+	}
+	return pass, nil
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package loader
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet"
+)
+
+// SetReplicaOf configures subsequently opened wallets to be read-only replicas
+// opened from the database snapshot at path, as written by another wallet
+// process with wallet.RunSnapshots.  Replicas serve reporting requests without
+// contending with the primary wallet for its database.  An empty path disables
+// replica mode.
+func (l *Loader) SetReplicaOf(path string) {
+	l.mu.Lock()
+	l.replicaOf = path
+	l.mu.Unlock()
+}
+
+// ReloadReplica reopens the loaded replica wallet from the current snapshot.
+// The previously loaded wallet is replaced only after the new snapshot is
+// opened, and its database is closed once in-progress reads complete.
+// Callbacks added with RunAfterLoad are not executed for reloaded wallets.
+func (l *Loader) ReloadReplica(ctx context.Context, pubPassphrase []byte) error {
+	const op errors.Op = "loader.ReloadReplica"
+
+	l.mu.Lock()
+	path := l.replicaOf
+	if path == "" || l.wallet == nil {
+		l.mu.Unlock()
+		return errors.E(op, errors.Invalid, "no replica wallet is loaded")
+	}
+	l.mu.Unlock()
+
+	db, err := wallet.OpenDB(driver, path, true)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	l.mu.Lock()
+	cfg := l.walletConfig(db, pubPassphrase)
+	l.mu.Unlock()
+	w, err := wallet.Open(ctx, cfg)
+	if err != nil {
+		db.Close()
+		return errors.E(op, err)
+	}
+
+	l.mu.Lock()
+	if l.wallet == nil {
+		l.mu.Unlock()
+		db.Close()
+		return errors.E(op, errors.Invalid, "replica wallet was unloaded")
+	}
+	prev := l.db
+	l.wallet = w
+	l.db = db
+	l.mu.Unlock()
+
+	if err := prev.Close(); err != nil {
+		log.Errorf("Failed to close previous replica snapshot: %v", err)
+	}
+	return nil
+}
+
+// FollowReplica polls the replica snapshot every interval and reloads the
+// replica wallet after the snapshot is replaced, until the context is
+// cancelled.  Failed reloads are logged and retried at the next interval.
+func (l *Loader) FollowReplica(ctx context.Context, pubPassphrase []byte, interval time.Duration) error {
+	l.mu.Lock()
+	path := l.replicaOf
+	l.mu.Unlock()
+
+	var lastMod time.Time
+	if fi, err := os.Stat(path); err == nil {
+		lastMod = fi.ModTime()
+	}
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+		fi, err := os.Stat(path)
+		if err != nil {
+			log.Warnf("Unable to stat replica snapshot %s: %v", path, err)
+			continue
+		}
+		if fi.ModTime().Equal(lastMod) {
+			continue
+		}
+		if err := l.ReloadReplica(ctx, pubPassphrase); err != nil {
+			log.Errorf("Failed to reload replica snapshot: %v", err)
+			continue
+		}
+		lastMod = fi.ModTime()
+		log.Infof("Reloaded replica snapshot written %v",
+			lastMod.Format(time.RFC3339))
+	}
+}
@@ -55,6 +55,8 @@ var (
 	MixcLog    = backendLog.Logger("MIXC")
 	MixpLog    = backendLog.Logger("MIXP")
 	VspcLog    = backendLog.Logger("VSPC")
+	HookLog    = backendLog.Logger("HOOK")
+	EvbsLog    = backendLog.Logger("EVBS")
 )
 
 // InitLogRotator initializes the logging rotater to write logs to logFile and
@@ -0,0 +1,225 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package netparams
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"regexp"
+	"strconv"
+
+	"decred.org/dcrwallet/v5/errors"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+// Definition describes the parameters of a private network derived from one
+// of the public networks.  Parameters which are not defined, other than the
+// DNS seeds, keep the values of the base network.  Byte strings are hex
+// encoded.
+type Definition struct {
+	// Base names the network the definition is derived from: mainnet,
+	// testnet3, or simnet.
+	Base string `json:"base"`
+
+	// Name and Net identify the network, and must not be those of any
+	// public network.
+	Name string `json:"name"`
+	Net  uint32 `json:"net"`
+
+	// Genesis is the serialized genesis block.
+	Genesis string `json:"genesis,omitempty"`
+
+	DefaultPort       string   `json:"defaultport,omitempty"`
+	JSONRPCClientPort string   `json:"jsonrpcclientport,omitempty"`
+	JSONRPCServerPort string   `json:"jsonrpcserverport,omitempty"`
+	GRPCServerPort    string   `json:"grpcserverport,omitempty"`
+	DNSSeeds          []string `json:"dnsseeds,omitempty"`
+
+	PubKeyAddrID     string `json:"pubkeyaddrid,omitempty"`
+	PubKeyHashAddrID string `json:"pubkeyhashaddrid,omitempty"`
+	PKHEdwardsAddrID string `json:"pkhedwardsaddrid,omitempty"`
+	PKHSchnorrAddrID string `json:"pkhschnorraddrid,omitempty"`
+	ScriptHashAddrID string `json:"scripthashaddrid,omitempty"`
+	PrivateKeyID     string `json:"privatekeyid,omitempty"`
+	HDPrivateKeyID   string `json:"hdprivatekeyid,omitempty"`
+	HDPublicKeyID    string `json:"hdpublickeyid,omitempty"`
+
+	SLIP0044CoinType *uint32 `json:"slip0044cointype,omitempty"`
+	LegacyCoinType   *uint32 `json:"legacycointype,omitempty"`
+
+	// PiKeys replaces the treasury spend keys when defined.
+	PiKeys []string `json:"pikeys,omitempty"`
+}
+
+// validName matches network names which are safe to use as the name of the
+// network data directory.
+var validName = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// LoadDefinition reads the JSON network definition at path and returns its
+// parameters.
+func LoadDefinition(path string) (*Params, error) {
+	const op errors.Op = "netparams.LoadDefinition"
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	var d Definition
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&d); err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	p, err := d.Params()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return p, nil
+}
+
+// Params returns the parameters of the defined network.
+func (d *Definition) Params() (*Params, error) {
+	const op errors.Op = "netparams.Definition.Params"
+	invalid := func(format string, args ...any) error {
+		return errors.E(op, errors.Invalid, errors.Errorf(format, args...))
+	}
+
+	var p Params
+	switch d.Base {
+	case "mainnet":
+		p = MainNetParams
+		p.Params = chaincfg.MainNetParams()
+	case "testnet3":
+		p = TestNet3Params
+		p.Params = chaincfg.TestNet3Params()
+	case "simnet":
+		p = SimNetParams
+		p.Params = chaincfg.SimNetParams()
+	default:
+		return nil, invalid("unknown base network %q", d.Base)
+	}
+
+	public := []*chaincfg.Params{chaincfg.MainNetParams(),
+		chaincfg.TestNet3Params(), chaincfg.SimNetParams(),
+		chaincfg.RegNetParams()}
+	if !validName.MatchString(d.Name) {
+		return nil, invalid("invalid network name %q", d.Name)
+	}
+	if d.Net == 0 {
+		return nil, invalid("network magic is not defined")
+	}
+	for _, pub := range public {
+		if d.Name == pub.Name {
+			return nil, invalid("network name %q is used by a public "+
+				"network", d.Name)
+		}
+		if wire.CurrencyNet(d.Net) == pub.Net {
+			return nil, invalid("network magic %#08x is used by the %s "+
+				"network", d.Net, pub.Name)
+		}
+	}
+	p.Name = d.Name
+	p.Net = wire.CurrencyNet(d.Net)
+
+	if d.Genesis != "" {
+		var genesis wire.MsgBlock
+		err := genesis.Deserialize(hex.NewDecoder(bytes.NewReader([]byte(d.Genesis))))
+		if err != nil {
+			return nil, invalid("invalid genesis block: %v", err)
+		}
+		p.GenesisBlock = &genesis
+		p.GenesisHash = genesis.BlockHash()
+	}
+
+	ports := []struct {
+		name string
+		def  string
+		dst  *string
+	}{
+		{"defaultport", d.DefaultPort, &p.DefaultPort},
+		{"jsonrpcclientport", d.JSONRPCClientPort, &p.JSONRPCClientPort},
+		{"jsonrpcserverport", d.JSONRPCServerPort, &p.JSONRPCServerPort},
+		{"grpcserverport", d.GRPCServerPort, &p.GRPCServerPort},
+	}
+	for _, port := range ports {
+		if port.def == "" {
+			continue
+		}
+		n, err := strconv.ParseUint(port.def, 10, 16)
+		if err != nil || n == 0 {
+			return nil, invalid("invalid %s %q", port.name, port.def)
+		}
+		*port.dst = port.def
+	}
+
+	// The seeders of the base network are never used, as they would
+	// only return peers of the public network.
+	p.DNSSeeds = make([]chaincfg.DNSSeed, 0, len(d.DNSSeeds))
+	for _, host := range d.DNSSeeds {
+		p.DNSSeeds = append(p.DNSSeeds, chaincfg.DNSSeed{
+			Host:         host,
+			HasFiltering: true,
+		})
+	}
+
+	ids := []struct {
+		name string
+		def  string
+		dst  []byte
+	}{
+		{"pubkeyaddrid", d.PubKeyAddrID, p.PubKeyAddrID[:]},
+		{"pubkeyhashaddrid", d.PubKeyHashAddrID, p.PubKeyHashAddrID[:]},
+		{"pkhedwardsaddrid", d.PKHEdwardsAddrID, p.PKHEdwardsAddrID[:]},
+		{"pkhschnorraddrid", d.PKHSchnorrAddrID, p.PKHSchnorrAddrID[:]},
+		{"scripthashaddrid", d.ScriptHashAddrID, p.ScriptHashAddrID[:]},
+		{"privatekeyid", d.PrivateKeyID, p.PrivateKeyID[:]},
+		{"hdprivatekeyid", d.HDPrivateKeyID, p.HDPrivateKeyID[:]},
+		{"hdpublickeyid", d.HDPublicKeyID, p.HDPublicKeyID[:]},
+	}
+	for _, id := range ids {
+		if id.def == "" {
+			continue
+		}
+		b, err := hex.DecodeString(id.def)
+		if err != nil || len(b) != len(id.dst) {
+			return nil, invalid("%s must be %d hex encoded bytes",
+				id.name, len(id.dst))
+		}
+		copy(id.dst, b)
+	}
+	addrIDs := map[[2]byte]string{}
+	for _, id := range ids[:5] {
+		k := [2]byte(id.dst)
+		if other, ok := addrIDs[k]; ok {
+			return nil, invalid("%s and %s are equal", other, id.name)
+		}
+		addrIDs[k] = id.name
+	}
+	if p.HDPrivateKeyID == p.HDPublicKeyID {
+		return nil, invalid("hdprivatekeyid and hdpublickeyid are equal")
+	}
+
+	if d.SLIP0044CoinType != nil {
+		p.SLIP0044CoinType = *d.SLIP0044CoinType
+	}
+	if d.LegacyCoinType != nil {
+		p.LegacyCoinType = *d.LegacyCoinType
+	}
+
+	if d.PiKeys != nil {
+		p.PiKeys = make([][]byte, 0, len(d.PiKeys))
+		for _, s := range d.PiKeys {
+			key, err := hex.DecodeString(s)
+			if err != nil || len(key) != 33 {
+				return nil, invalid("invalid pikey %q", s)
+			}
+			p.PiKeys = append(p.PiKeys, key)
+		}
+	}
+
+	return &p, nil
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package netparams
+
+import (
+	"bytes"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestDefinitionParams(t *testing.T) {
+	simnet := chaincfg.SimNetParams()
+	genesis := simnet.GenesisBlock.Header
+	genesis.Nonce++
+	var buf bytes.Buffer
+	if err := (&wire.MsgBlock{Header: genesis}).Serialize(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	d := &Definition{
+		Base:              "simnet",
+		Name:              "privnet",
+		Net:               0x12345678,
+		Genesis:           hex.EncodeToString(buf.Bytes()),
+		JSONRPCClientPort: "29556",
+		DNSSeeds:          []string{"seed.example.com"},
+		PubKeyHashAddrID:  "0f21",
+		HDPublicKeyID:     "01020304",
+	}
+	p, err := d.Params()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "privnet" || p.Net != 0x12345678 {
+		t.Errorf("network identified as %s %v", p.Name, p.Net)
+	}
+	if p.GenesisHash != genesis.BlockHash() || p.GenesisHash == simnet.GenesisHash {
+		t.Errorf("genesis hash %v not replaced", p.GenesisHash)
+	}
+	if p.JSONRPCClientPort != "29556" || p.GRPCServerPort != SimNetParams.GRPCServerPort {
+		t.Errorf("ports %s and %s", p.JSONRPCClientPort, p.GRPCServerPort)
+	}
+	if len(p.DNSSeeds) != 1 || p.DNSSeeds[0].Host != "seed.example.com" {
+		t.Errorf("dns seeds %v", p.DNSSeeds)
+	}
+	if p.PubKeyHashAddrID != [2]byte{0x0f, 0x21} ||
+		p.HDPublicKeyID != [4]byte{1, 2, 3, 4} ||
+		p.ScriptHashAddrID != simnet.ScriptHashAddrID {
+		t.Errorf("unexpected prefixes %x %x %x", p.PubKeyHashAddrID,
+			p.HDPublicKeyID, p.ScriptHashAddrID)
+	}
+
+	// The public network parameters are not modified.
+	if SimNetParams.Name != "simnet" || SimNetParams.JSONRPCClientPort != "19556" ||
+		len(SimNetParams.DNSSeeds) != len(simnet.DNSSeeds) {
+		t.Errorf("public network parameters modified")
+	}
+
+	invalid := []func(d *Definition){
+		func(d *Definition) { d.Base = "regnet" },
+		func(d *Definition) { d.Name = "simnet" },
+		func(d *Definition) { d.Name = "../privnet" },
+		func(d *Definition) { d.Net = 0 },
+		func(d *Definition) { d.Net = uint32(wire.MainNet) },
+		func(d *Definition) { d.Genesis = "00" },
+		func(d *Definition) { d.DefaultPort = "70000" },
+		func(d *Definition) { d.PubKeyHashAddrID = "0f" },
+		func(d *Definition) { d.ScriptHashAddrID = d.PubKeyHashAddrID },
+		func(d *Definition) { d.PiKeys = []string{"02"} },
+	}
+	for i, modify := range invalid {
+		d := *d
+		modify(&d)
+		if _, err := d.Params(); !errors.Is(err, errors.Invalid) {
+			t.Errorf("invalid definition %d: %v", i, err)
+		}
+	}
+}
+
+func TestLoadDefinition(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "privnet.json")
+	err := os.WriteFile(path, []byte(`{"base": "testnet3", "name": "privnet", "net": 1}`), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := LoadDefinition(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "privnet" || p.JSONRPCServerPort != TestNet3Params.JSONRPCServerPort {
+		t.Errorf("unexpected params %s %s", p.Name, p.JSONRPCServerPort)
+	}
+
+	err = os.WriteFile(path, []byte(`{"base": "testnet3", "name": "privnet", "net": 1, "port": "1"}`), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadDefinition(path); !errors.Is(err, errors.Encoding) {
+		t.Errorf("unknown field: %v", err)
+	}
+}
@@ -7,6 +7,9 @@ package jsonrpc
 import (
 	"context"
 	"net"
+
+	"decred.org/dcrwallet/v5/internal/webhook"
+	"github.com/decred/dcrd/dcrutil/v4"
 )
 
 // Options contains the required options for running the legacy RPC server.
@@ -14,6 +17,18 @@ type Options struct {
 	Username string
 	Password string
 
+	// PendingSpends records payments sent by the send methods as pending
+	// spends.  Pending spends may only be approved or rejected by clients
+	// authenticating with the approve credentials, which may in turn call
+	// only the pending spend and wallet (un)locking methods.
+	PendingSpends   bool
+	ApproveUsername string
+	ApprovePassword string
+
+	// Replica restricts clients to the reporting methods served by
+	// read-only replicas of a wallet.
+	Replica bool
+
 	MaxPOSTClients      int64
 	MaxWebsocketClients int64
 
@@ -23,10 +38,21 @@ type Options struct {
 	MixChangeAccount   string
 	TicketSplitAccount string
 
+	// Ticket buyer configuration, used as the default ticket purchasing
+	// assumptions of stake forecasts.
+	TicketBuyerEnabled bool
+	PurchaseAccount    string
+	BalanceToMaintain  dcrutil.Amount
+	TicketBuyerLimit   int
+
 	VSPHost   string
 	VSPPubKey string
 	Dial      func(ctx context.Context, network, addr string) (net.Conn, error)
 
+	// Webhooks manages outbound HTTP notifications.  Webhook RPCs error
+	// when nil.
+	Webhooks *webhook.Dispatcher
+
 	Loggers Loggers
 }
 
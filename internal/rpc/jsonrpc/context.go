@@ -19,3 +19,14 @@ func remoteAddr(ctx context.Context) string {
 	}
 	return v.(string)
 }
+
+// withApprover marks requests of a client authenticated as the spend approval
+// user.
+func withApprover(parent context.Context) context.Context {
+	return context.WithValue(parent, contextKey("approver"), true)
+}
+
+func isApprover(ctx context.Context) bool {
+	v, _ := ctx.Value(contextKey("approver")).(bool)
+	return v
+}
@@ -6,6 +6,7 @@
 package jsonrpc
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"decred.org/dcrwallet/v5/errors"
@@ -17,6 +18,8 @@ func convertError(err error) *dcrjson.RPCError {
 	switch err := err.(type) {
 	case *dcrjson.RPCError:
 		return err
+	case *walletRPCError:
+		return err.RPCError
 	case *wsrpc.Error:
 		return &dcrjson.RPCError{
 			Code:    dcrjson.RPCErrorCode(err.Code),
@@ -48,10 +51,112 @@ func convertError(err error) *dcrjson.RPCError {
 	}
 }
 
-func rpcError(code dcrjson.RPCErrorCode, err error) *dcrjson.RPCError {
-	return &dcrjson.RPCError{
-		Code:    code,
-		Message: err.Error(),
+// rpcErrorKinds maps JSON-RPC error codes to the kinds of wallet errors they
+// describe.  These describe errors returned by handlers as JSON-RPC errors
+// rather than as wallet errors.
+var rpcErrorKinds = map[dcrjson.RPCErrorCode]errors.Kind{
+	dcrjson.ErrRPCInternal.Code:             errors.Bug,
+	dcrjson.ErrRPCInvalidParameter:          errors.Invalid,
+	dcrjson.ErrRPCInvalidParams.Code:        errors.Invalid,
+	dcrjson.ErrRPCInvalidRequest.Code:       errors.Encoding,
+	dcrjson.ErrRPCParse.Code:                errors.Encoding,
+	dcrjson.ErrRPCDeserialization:           errors.Encoding,
+	dcrjson.ErrRPCInvalidAddressOrKey:       errors.Invalid,
+	dcrjson.ErrRPCWalletInvalidAccountName:  errors.NotExist,
+	dcrjson.ErrRPCWalletUnlockNeeded:        errors.Locked,
+	dcrjson.ErrRPCWalletPassphraseIncorrect: errors.Passphrase,
+	dcrjson.ErrRPCWalletInsufficientFunds:   errors.InsufficientBalance,
+	dcrjson.ErrRPCClientNotConnected:        errors.NoPeers,
+	dcrjson.ErrRPCClientInInitialDownload:   errors.NoPeers,
+	dcrjson.ErrRPCUnimplemented:             errors.Invalid,
+	dcrjson.ErrRPCWalletAlreadyUnlocked:     errors.Exist,
+	dcrjson.ErrRPCWalletWrongEncState:       errors.Invalid,
+	dcrjson.ErrRPCWalletKeypoolRanOut:       errors.Policy,
+}
+
+// describeError returns the description of err reported in the data member of
+// JSON-RPC error objects.  Errors which do not describe themselves are
+// described by the kind of wallet error corresponding to their JSON-RPC code.
+func describeError(err error, rpcErr *dcrjson.RPCError) errors.Detail {
+	d := errors.Describe(err)
+	if d.Code != errors.ErrUnknown {
+		return d
+	}
+	if kind, ok := rpcErrorKinds[rpcErr.Code]; ok {
+		return errors.Describe(kind)
+	}
+	return d
+}
+
+// errorObject is a JSON-RPC error object.  In addition to the members of
+// dcrjson.RPCError, the data member describes the error with a stable code and
+// remediation hint.
+type errorObject struct {
+	Code    dcrjson.RPCErrorCode `json:"code,omitempty"`
+	Message string               `json:"message,omitempty"`
+	Data    *errors.Detail       `json:"data,omitempty"`
+}
+
+func newErrorObject(err error) *errorObject {
+	rpcErr := convertError(err)
+	d := describeError(err, rpcErr)
+	return &errorObject{
+		Code:    rpcErr.Code,
+		Message: rpcErr.Message,
+		Data:    &d,
+	}
+}
+
+// response is a JSON-RPC response with an errorObject error.
+type response struct {
+	Jsonrpc string          `json:"jsonrpc"`
+	Result  json.RawMessage `json:"result"`
+	Error   *errorObject    `json:"error"`
+	ID      *any            `json:"id"`
+}
+
+// marshalResponse marshals a JSON-RPC response to a request with the result,
+// or with the error converted to an errorObject if err is non-nil.  It is
+// otherwise equivalent to dcrjson.MarshalResponse.
+func marshalResponse(rpcVersion string, id, result any, err error) ([]byte, error) {
+	if rpcVersion != "2.0" && rpcVersion != "1.0" {
+		rpcVersion = "1.0"
+	}
+	if !dcrjson.IsValidIDType(id) {
+		return nil, errors.Errorf("the id of type '%T' is invalid", id)
+	}
+	resp := &response{
+		Jsonrpc: rpcVersion,
+		ID:      &id,
+	}
+	if err != nil {
+		resp.Error = newErrorObject(err)
+	}
+	marshalledResult, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	resp.Result = marshalledResult
+	return json.Marshal(resp)
+}
+
+// walletRPCError is a JSON-RPC error created from a wallet error.  The wallet
+// error is kept so that the error data describes it, including any attached
+// errors.Detail, rather than only the kind of the JSON-RPC code.
+type walletRPCError struct {
+	*dcrjson.RPCError
+	err error
+}
+
+func (e *walletRPCError) Unwrap() error { return e.err }
+
+func rpcError(code dcrjson.RPCErrorCode, err error) error {
+	return &walletRPCError{
+		RPCError: &dcrjson.RPCError{
+			Code:    code,
+			Message: err.Error(),
+		},
+		err: err,
 	}
 }
 
@@ -104,6 +209,31 @@ var (
 		Message: "wallet or account locked; use walletpassphrase or unlockaccount first",
 	}
 
+	errWebhooksDisabled = &dcrjson.RPCError{
+		Code:    dcrjson.ErrRPCWallet,
+		Message: "webhooks are disabled; restart with --webhooks",
+	}
+
+	errApproverMethod = &dcrjson.RPCError{
+		Code:    dcrjson.ErrRPCWallet,
+		Message: "method not available to the spend approval user",
+	}
+
+	errApproverOnly = &dcrjson.RPCError{
+		Code:    dcrjson.ErrRPCWallet,
+		Message: "method requires the spend approval user",
+	}
+
+	errReplicaMethod = &dcrjson.RPCError{
+		Code:    dcrjson.ErrRPCWallet,
+		Message: "method not available on read-only replicas",
+	}
+
+	errPendingSpendsDisabled = &dcrjson.RPCError{
+		Code:    dcrjson.ErrRPCWallet,
+		Message: "pending spends are disabled; restart with --pendingspends",
+	}
+
 	errReservedAccountName = &dcrjson.RPCError{
 		Code:    dcrjson.ErrRPCInvalidParameter,
 		Message: "account name is reserved by RPC server",
@@ -13,6 +13,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"math"
 	"math/big"
 	"sort"
 	"strconv"
@@ -22,12 +23,14 @@ import (
 
 	"decred.org/dcrwallet/v5/chain"
 	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/internal/webhook"
 	"decred.org/dcrwallet/v5/p2p"
 	"decred.org/dcrwallet/v5/rpc/client/dcrd"
 	"decred.org/dcrwallet/v5/rpc/jsonrpc/types"
 	"decred.org/dcrwallet/v5/spv"
 	"decred.org/dcrwallet/v5/version"
 	"decred.org/dcrwallet/v5/wallet"
+	"decred.org/dcrwallet/v5/wallet/paymenturi"
 	"decred.org/dcrwallet/v5/wallet/txauthor"
 	"decred.org/dcrwallet/v5/wallet/txrules"
 	"decred.org/dcrwallet/v5/wallet/txsizes"
@@ -53,9 +56,9 @@ import (
 
 // API version constants
 const (
-	jsonrpcSemverString = "10.0.0"
+	jsonrpcSemverString = "10.66.0"
 	jsonrpcSemverMajor  = 10
-	jsonrpcSemverMinor  = 0
+	jsonrpcSemverMinor  = 66
 	jsonrpcSemverPatch  = 0
 )
 
@@ -83,109 +86,197 @@ func confirms(txHeight, curHeight int32) int32 {
 
 // the registered rpc handlers
 var handlers = map[string]handler{
-	"abandontransaction":        {fn: (*Server).abandonTransaction},
-	"accountaddressindex":       {fn: (*Server).accountAddressIndex},
-	"accountsyncaddressindex":   {fn: (*Server).accountSyncAddressIndex},
-	"accountunlocked":           {fn: (*Server).accountUnlocked},
-	"addmultisigaddress":        {fn: (*Server).addMultiSigAddress},
-	"addtransaction":            {fn: (*Server).addTransaction},
-	"auditreuse":                {fn: (*Server).auditReuse},
-	"consolidate":               {fn: (*Server).consolidate},
-	"createmultisig":            {fn: (*Server).createMultiSig},
-	"createnewaccount":          {fn: (*Server).createNewAccount},
-	"createrawtransaction":      {fn: (*Server).createRawTransaction},
-	"createsignature":           {fn: (*Server).createSignature},
-	"debuglevel":                {fn: (*Server).debugLevel},
-	"disapprovepercent":         {fn: (*Server).disapprovePercent},
-	"discoverusage":             {fn: (*Server).discoverUsage},
-	"dumpprivkey":               {fn: (*Server).dumpPrivKey},
-	"fundrawtransaction":        {fn: (*Server).fundRawTransaction},
-	"getaccount":                {fn: (*Server).getAccount},
-	"getaccountaddress":         {fn: (*Server).getAccountAddress},
-	"getaddressesbyaccount":     {fn: (*Server).getAddressesByAccount},
-	"getbalance":                {fn: (*Server).getBalance},
-	"getbestblock":              {fn: (*Server).getBestBlock},
-	"getbestblockhash":          {fn: (*Server).getBestBlockHash},
-	"getblockcount":             {fn: (*Server).getBlockCount},
-	"getblockhash":              {fn: (*Server).getBlockHash},
-	"getblockheader":            {fn: (*Server).getBlockHeader},
-	"getblock":                  {fn: (*Server).getBlock},
-	"getcoinjoinsbyacct":        {fn: (*Server).getcoinjoinsbyacct},
-	"getcurrentnet":             {fn: (*Server).getCurrentNet},
-	"getinfo":                   {fn: (*Server).getInfo},
-	"getmasterpubkey":           {fn: (*Server).getMasterPubkey},
-	"getmultisigoutinfo":        {fn: (*Server).getMultisigOutInfo},
-	"getnewaddress":             {fn: (*Server).getNewAddress},
-	"getpeerinfo":               {fn: (*Server).getPeerInfo},
-	"getrawchangeaddress":       {fn: (*Server).getRawChangeAddress},
-	"getreceivedbyaccount":      {fn: (*Server).getReceivedByAccount},
-	"getreceivedbyaddress":      {fn: (*Server).getReceivedByAddress},
-	"getstakeinfo":              {fn: (*Server).getStakeInfo},
-	"gettickets":                {fn: (*Server).getTickets},
-	"gettransaction":            {fn: (*Server).getTransaction},
-	"gettxout":                  {fn: (*Server).getTxOut},
-	"getunconfirmedbalance":     {fn: (*Server).getUnconfirmedBalance},
-	"getvotechoices":            {fn: (*Server).getVoteChoices},
-	"getwalletfee":              {fn: (*Server).getWalletFee},
-	"help":                      {fn: (*Server).help},
-	"getcfilterv2":              {fn: (*Server).getCFilterV2},
-	"importcfiltersv2":          {fn: (*Server).importCFiltersV2},
-	"importprivkey":             {fn: (*Server).importPrivKey},
-	"importpubkey":              {fn: (*Server).importPubKey},
-	"importscript":              {fn: (*Server).importScript},
-	"importxpub":                {fn: (*Server).importXpub},
-	"listaccounts":              {fn: (*Server).listAccounts},
-	"listaddresstransactions":   {fn: (*Server).listAddressTransactions},
-	"listalltransactions":       {fn: (*Server).listAllTransactions},
-	"listlockunspent":           {fn: (*Server).listLockUnspent},
-	"listreceivedbyaccount":     {fn: (*Server).listReceivedByAccount},
-	"listreceivedbyaddress":     {fn: (*Server).listReceivedByAddress},
-	"listsinceblock":            {fn: (*Server).listSinceBlock},
-	"listtransactions":          {fn: (*Server).listTransactions},
-	"listunspent":               {fn: (*Server).listUnspent},
-	"lockaccount":               {fn: (*Server).lockAccount},
-	"lockunspent":               {fn: (*Server).lockUnspent},
-	"mixaccount":                {fn: (*Server).mixAccount},
-	"mixoutput":                 {fn: (*Server).mixOutput},
-	"purchaseticket":            {fn: (*Server).purchaseTicket},
-	"processunmanagedticket":    {fn: (*Server).processUnmanagedTicket},
-	"redeemmultisigout":         {fn: (*Server).redeemMultiSigOut},
-	"redeemmultisigouts":        {fn: (*Server).redeemMultiSigOuts},
-	"renameaccount":             {fn: (*Server).renameAccount},
-	"rescanwallet":              {fn: (*Server).rescanWallet},
-	"sendfrom":                  {fn: (*Server).sendFrom},
-	"sendfromtreasury":          {fn: (*Server).sendFromTreasury},
-	"sendmany":                  {fn: (*Server).sendMany},
-	"sendrawtransaction":        {fn: (*Server).sendRawTransaction},
-	"sendtoaddress":             {fn: (*Server).sendToAddress},
-	"sendtomultisig":            {fn: (*Server).sendToMultiSig},
-	"sendtotreasury":            {fn: (*Server).sendToTreasury},
-	"setaccountpassphrase":      {fn: (*Server).setAccountPassphrase},
-	"setdisapprovepercent":      {fn: (*Server).setDisapprovePercent},
-	"settreasurypolicy":         {fn: (*Server).setTreasuryPolicy},
-	"settspendpolicy":           {fn: (*Server).setTSpendPolicy},
-	"settxfee":                  {fn: (*Server).setTxFee},
-	"setvotechoice":             {fn: (*Server).setVoteChoice},
-	"signmessage":               {fn: (*Server).signMessage},
-	"signrawtransaction":        {fn: (*Server).signRawTransaction},
-	"signrawtransactions":       {fn: (*Server).signRawTransactions},
-	"spendoutputs":              {fn: (*Server).spendOutputs},
-	"sweepaccount":              {fn: (*Server).sweepAccount},
-	"syncstatus":                {fn: (*Server).syncStatus},
-	"ticketinfo":                {fn: (*Server).ticketInfo},
-	"treasurypolicy":            {fn: (*Server).treasuryPolicy},
-	"tspendpolicy":              {fn: (*Server).tspendPolicy},
-	"unlockaccount":             {fn: (*Server).unlockAccount},
-	"validateaddress":           {fn: (*Server).validateAddress},
-	"validatepredcp0005cf":      {fn: (*Server).validatePreDCP0005CF},
-	"verifymessage":             {fn: (*Server).verifyMessage},
-	"version":                   {fn: (*Server).version},
-	"walletinfo":                {fn: (*Server).walletInfo},
-	"walletislocked":            {fn: (*Server).walletIsLocked},
-	"walletlock":                {fn: (*Server).walletLock},
-	"walletpassphrase":          {fn: (*Server).walletPassphrase},
-	"walletpassphrasechange":    {fn: (*Server).walletPassphraseChange},
-	"walletpubpassphrasechange": {fn: (*Server).walletPubPassphraseChange},
+	"abandontransaction":           {fn: (*Server).abandonTransaction},
+	"accountaddressindex":          {fn: (*Server).accountAddressIndex},
+	"accountsyncaddressindex":      {fn: (*Server).accountSyncAddressIndex},
+	"accountunlocked":              {fn: (*Server).accountUnlocked},
+	"addmultisigaddress":           {fn: (*Server).addMultiSigAddress},
+	"addressreusereport":           {fn: (*Server).addressReuseReport},
+	"addtransaction":               {fn: (*Server).addTransaction},
+	"addwebhook":                   {fn: (*Server).addWebhook},
+	"annotaterawtransaction":       {fn: (*Server).annotateRawTransaction},
+	"approvependingspend":          {fn: (*Server).approvePendingSpend},
+	"attestaddress":                {fn: (*Server).attestAddress},
+	"auditreuse":                   {fn: (*Server).auditReuse},
+	"clearmixedspendpolicy":        {fn: (*Server).clearMixedSpendPolicy},
+	"consolidate":                  {fn: (*Server).consolidate},
+	"cosignvote":                   {fn: (*Server).cosignVote},
+	"createinvoice":                {fn: (*Server).createInvoice},
+	"createmultisig":               {fn: (*Server).createMultiSig},
+	"createmultisigspend":          {fn: (*Server).createMultisigSpend},
+	"createnewaccount":             {fn: (*Server).createNewAccount},
+	"createpaymenturi":             {fn: (*Server).createPaymentURI},
+	"createrawtransaction":         {fn: (*Server).createRawTransaction},
+	"createreservesnapshot":        {fn: (*Server).createReserveSnapshot},
+	"createsignature":              {fn: (*Server).createSignature},
+	"createswapcontract":           {fn: (*Server).createSwapContract},
+	"debugdump":                    {fn: (*Server).debugDump},
+	"debuginspectdb":               {fn: (*Server).debugInspectDB},
+	"debuglevel":                   {fn: (*Server).debugLevel},
+	"debugtimings":                 {fn: (*Server).debugTimings},
+	"deleteinvoice":                {fn: (*Server).deleteInvoice},
+	"disapprovepercent":            {fn: (*Server).disapprovePercent},
+	"discoverusage":                {fn: (*Server).discoverUsage},
+	"dumpprivkey":                  {fn: (*Server).dumpPrivKey},
+	"estimaterawtransaction":       {fn: (*Server).estimateRawTransaction},
+	"estimatetxsize":               {fn: (*Server).estimateTxSize},
+	"exportvotepolicies":           {fn: (*Server).exportVotePolicies},
+	"exportvotingaccount":          {fn: (*Server).exportVotingAccount},
+	"forecaststake":                {fn: (*Server).forecastStake},
+	"fundrawtransaction":           {fn: (*Server).fundRawTransaction},
+	"getaccount":                   {fn: (*Server).getAccount},
+	"getaccountaddress":            {fn: (*Server).getAccountAddress},
+	"getaccountblindedid":          {fn: (*Server).getAccountBlindedID},
+	"getaccountbyid":               {fn: (*Server).getAccountByID},
+	"getaccountchildxpub":          {fn: (*Server).getAccountChildXpub},
+	"getaccountcryptoparams":       {fn: (*Server).getAccountCryptoParams},
+	"getaccountid":                 {fn: (*Server).getAccountID},
+	"getaccountwatchkey":           {fn: (*Server).getAccountWatchKey},
+	"getaddressesbyaccount":        {fn: (*Server).getAddressesByAccount},
+	"getbalance":                   {fn: (*Server).getBalance},
+	"getbestblock":                 {fn: (*Server).getBestBlock},
+	"getbestblockhash":             {fn: (*Server).getBestBlockHash},
+	"getblock":                     {fn: (*Server).getBlock},
+	"getblockcount":                {fn: (*Server).getBlockCount},
+	"getblockhash":                 {fn: (*Server).getBlockHash},
+	"getblockheader":               {fn: (*Server).getBlockHeader},
+	"getcapabilities":              {fn: (*Server).getCapabilities},
+	"getcfilterv2":                 {fn: (*Server).getCFilterV2},
+	"getcoinjoinsbyacct":           {fn: (*Server).getcoinjoinsbyacct},
+	"getcurrentnet":                {fn: (*Server).getCurrentNet},
+	"getdestinationpolicy":         {fn: (*Server).getDestinationPolicy},
+	"getduressaccount":             {fn: (*Server).getDuressAccount},
+	"getidlelock":                  {fn: (*Server).getIdleLock},
+	"getinfo":                      {fn: (*Server).getInfo},
+	"getinvoice":                   {fn: (*Server).getInvoice},
+	"getjournalevents":             {fn: (*Server).getJournalEvents},
+	"getmasterpubkey":              {fn: (*Server).getMasterPubkey},
+	"getmixedspendpolicy":          {fn: (*Server).getMixedSpendPolicy},
+	"getmixpolicy":                 {fn: (*Server).getMixPolicy},
+	"getmultisigoutinfo":           {fn: (*Server).getMultisigOutInfo},
+	"getnewaddress":                {fn: (*Server).getNewAddress},
+	"getpeerinfo":                  {fn: (*Server).getPeerInfo},
+	"getrawchangeaddress":          {fn: (*Server).getRawChangeAddress},
+	"getreceivedbyaccount":         {fn: (*Server).getReceivedByAccount},
+	"getreceivedbyaddress":         {fn: (*Server).getReceivedByAddress},
+	"getreservedbalance":           {fn: (*Server).getReservedBalance},
+	"getstakeinfo":                 {fn: (*Server).getStakeInfo},
+	"getticketpools":               {fn: (*Server).getTicketPools},
+	"gettickets":                   {fn: (*Server).getTickets},
+	"gettransaction":               {fn: (*Server).getTransaction},
+	"gettxout":                     {fn: (*Server).getTxOut},
+	"getunconfirmedbalance":        {fn: (*Server).getUnconfirmedBalance},
+	"getutxostats":                 {fn: (*Server).getUTXOStats},
+	"getvotechoices":               {fn: (*Server).getVoteChoices},
+	"getvotediagnostics":           {fn: (*Server).getVoteDiagnostics},
+	"getvoteversioninfo":           {fn: (*Server).getVoteVersionInfo},
+	"getwalletfee":                 {fn: (*Server).getWalletFee},
+	"getwalletstats":               {fn: (*Server).getWalletStats},
+	"help":                         {fn: (*Server).help},
+	"holdamount":                   {fn: (*Server).holdAmount},
+	"holdoutputs":                  {fn: (*Server).holdOutputs},
+	"importcfiltersv2":             {fn: (*Server).importCFiltersV2},
+	"importcheckpoint":             {fn: (*Server).importCheckpoint},
+	"importexternalsigneraccount":  {fn: (*Server).importExternalSignerAccount},
+	"importlegacystakepooltickets": {fn: (*Server).importLegacyStakePoolTickets},
+	"importprivkey":                {fn: (*Server).importPrivKey},
+	"importpubkey":                 {fn: (*Server).importPubKey},
+	"importscript":                 {fn: (*Server).importScript},
+	"importslip0044account":        {fn: (*Server).importSLIP0044Account},
+	"importtreasurykey":            {fn: (*Server).importTreasuryKey},
+	"importvotepolicies":           {fn: (*Server).importVotePolicies},
+	"importvotingaccount":          {fn: (*Server).importVotingAccount},
+	"importxpub":                   {fn: (*Server).importXpub},
+	"listaccounts":                 {fn: (*Server).listAccounts},
+	"listaddresstransactions":      {fn: (*Server).listAddressTransactions},
+	"listalltransactions":          {fn: (*Server).listAllTransactions},
+	"listexpiredaddresses":         {fn: (*Server).listExpiredAddresses},
+	"listexternalsigners":          {fn: (*Server).listExternalSigners},
+	"listfundsreservations":        {fn: (*Server).listFundsReservations},
+	"listholds":                    {fn: (*Server).listHolds},
+	"listinvoices":                 {fn: (*Server).listInvoices},
+	"listlockunspent":              {fn: (*Server).listLockUnspent},
+	"listpendingspends":            {fn: (*Server).listPendingSpends},
+	"listreceivedbyaccount":        {fn: (*Server).listReceivedByAccount},
+	"listreceivedbyaddress":        {fn: (*Server).listReceivedByAddress},
+	"listsinceblock":               {fn: (*Server).listSinceBlock},
+	"listtransactions":             {fn: (*Server).listTransactions},
+	"listtreasurykeys":             {fn: (*Server).listTreasuryKeys},
+	"listunspent":                  {fn: (*Server).listUnspent},
+	"listwebhooks":                 {fn: (*Server).listWebhooks},
+	"lockaccount":                  {fn: (*Server).lockAccount},
+	"lockunspent":                  {fn: (*Server).lockUnspent},
+	"lookupaccountblindedid":       {fn: (*Server).lookupAccountBlindedID},
+	"matchcfilters":                {fn: (*Server).matchCFilters},
+	"mixaccount":                   {fn: (*Server).mixAccount},
+	"mixoutput":                    {fn: (*Server).mixOutput},
+	"parsepaymenturi":              {fn: (*Server).parsePaymentURI},
+	"pregenerateaddresses":         {fn: (*Server).pregenerateAddresses},
+	"previewmixaccount":            {fn: (*Server).previewMixAccount},
+	"processunmanagedticket":       {fn: (*Server).processUnmanagedTicket},
+	"provevspownership":            {fn: (*Server).proveVSPOwnership},
+	"prunesidechains":              {fn: (*Server).pruneSidechains},
+	"purchaseticket":               {fn: (*Server).purchaseTicket},
+	"recovermixoutputs":            {fn: (*Server).recoverMixOutputs},
+	"redeemmultisigout":            {fn: (*Server).redeemMultiSigOut},
+	"redeemmultisigouts":           {fn: (*Server).redeemMultiSigOuts},
+	"redeemswap":                   {fn: (*Server).redeemSwap},
+	"refundswap":                   {fn: (*Server).refundSwap},
+	"rejectpendingspend":           {fn: (*Server).rejectPendingSpend},
+	"releasefunds":                 {fn: (*Server).releaseFunds},
+	"releasehold":                  {fn: (*Server).releaseHold},
+	"removetreasurykey":            {fn: (*Server).removeTreasuryKey},
+	"removewebhook":                {fn: (*Server).removeWebhook},
+	"renameaccount":                {fn: (*Server).renameAccount},
+	"rescanwallet":                 {fn: (*Server).rescanWallet},
+	"reservefunds":                 {fn: (*Server).reserveFunds},
+	"sendfrom":                     {fn: (*Server).sendFrom},
+	"sendfromtreasury":             {fn: (*Server).sendFromTreasury},
+	"sendmany":                     {fn: (*Server).sendMany},
+	"sendrawtransaction":           {fn: (*Server).sendRawTransaction},
+	"sendtoaddress":                {fn: (*Server).sendToAddress},
+	"sendtomultisig":               {fn: (*Server).sendToMultiSig},
+	"sendtotreasury":               {fn: (*Server).sendToTreasury},
+	"setaccountpassphrase":         {fn: (*Server).setAccountPassphrase},
+	"setdestinationoverride":       {fn: (*Server).setDestinationOverride},
+	"setdestinationpolicy":         {fn: (*Server).setDestinationPolicy},
+	"setdisapprovepercent":         {fn: (*Server).setDisapprovePercent},
+	"setduresspassphrase":          {fn: (*Server).setDuressPassphrase},
+	"setlockmode":                  {fn: (*Server).setLockMode},
+	"setmaxfeerate":                {fn: (*Server).setMaxFeeRate},
+	"setmixedspendpolicy":          {fn: (*Server).setMixedSpendPolicy},
+	"setmixpolicy":                 {fn: (*Server).setMixPolicy},
+	"settreasurypolicy":            {fn: (*Server).setTreasuryPolicy},
+	"settspendpolicy":              {fn: (*Server).setTSpendPolicy},
+	"settxfee":                     {fn: (*Server).setTxFee},
+	"setvotechoice":                {fn: (*Server).setVoteChoice},
+	"signmessage":                  {fn: (*Server).signMessage},
+	"signrawtransaction":           {fn: (*Server).signRawTransaction},
+	"signrawtransactions":          {fn: (*Server).signRawTransactions},
+	"signtspend":                   {fn: (*Server).signTSpend},
+	"simulatestake":                {fn: (*Server).simulateStake},
+	"spendoutputs":                 {fn: (*Server).spendOutputs},
+	"sweepaccount":                 {fn: (*Server).sweepAccount},
+	"sweepprivkey":                 {fn: (*Server).sweepPrivKey},
+	"sweeptocoldstorage":           {fn: (*Server).sweepToColdStorage},
+	"syncstatus":                   {fn: (*Server).syncStatus},
+	"ticketaddressreuse":           {fn: (*Server).ticketAddressReuse},
+	"ticketinfo":                   {fn: (*Server).ticketInfo},
+	"treasurypolicy":               {fn: (*Server).treasuryPolicy},
+	"tspendpolicy":                 {fn: (*Server).tspendPolicy},
+	"unlockaccount":                {fn: (*Server).unlockAccount},
+	"validateaddress":              {fn: (*Server).validateAddress},
+	"validatepredcp0005cf":         {fn: (*Server).validatePreDCP0005CF},
+	"verifymessage":                {fn: (*Server).verifyMessage},
+	"verifyreservesnapshot":        {fn: (*Server).verifyReserveSnapshot},
+	"verifyvotingaccount":          {fn: (*Server).verifyVotingAccount},
+	"version":                      {fn: (*Server).version},
+	"walletinfo":                   {fn: (*Server).walletInfo},
+	"walletislocked":               {fn: (*Server).walletIsLocked},
+	"walletlock":                   {fn: (*Server).walletLock},
+	"walletpassphrase":             {fn: (*Server).walletPassphrase},
+	"walletpassphrasechange":       {fn: (*Server).walletPassphraseChange},
+	"walletpubpassphrasechange":    {fn: (*Server).walletPubPassphraseChange},
 
 	// Unimplemented/unsupported RPCs which may be found in other
 	// cryptocurrency wallets.
@@ -199,6 +290,19 @@ var handlers = map[string]handler{
 	"setaccount":           {fn: unsupported, noHelp: true},
 }
 
+// supportedMethods is the sorted names of all implemented methods.  It is set
+// by init as the handlers referencing it may not be part of its initializer.
+var supportedMethods []string
+
+func init() {
+	for method, h := range handlers {
+		if !h.noHelp {
+			supportedMethods = append(supportedMethods, method)
+		}
+	}
+	sort.Strings(supportedMethods)
+}
+
 // unimplemented handles an unimplemented RPC request with the
 // appropriate error.
 func unimplemented(*Server, context.Context, any) (any, error) {
@@ -219,8 +323,9 @@ func unsupported(*Server, context.Context, any) (any, error) {
 
 // lazyHandler is a closure over a requestHandler or passthrough request with
 // the RPC server's wallet and chain server variables as part of the closure
-// context.
-type lazyHandler func() (any, *dcrjson.RPCError)
+// context.  Returned errors are converted to JSON-RPC error objects by
+// marshalResponse.
+type lazyHandler func() (any, error)
 
 // lazyApplyHandler looks up the best request handler func for the method,
 // returning a closure that will execute it with the (required) wallet and
@@ -229,7 +334,7 @@ type lazyHandler func() (any, *dcrjson.RPCError)
 func lazyApplyHandler(s *Server, ctx context.Context, request *dcrjson.Request) lazyHandler {
 	handlerData, ok := handlers[request.Method]
 	if !ok {
-		return func() (any, *dcrjson.RPCError) {
+		return func() (any, error) {
 			// Attempt RPC passthrough if possible
 			n, ok := s.walletLoader.NetworkBackend()
 			if !ok {
@@ -253,13 +358,13 @@ func lazyApplyHandler(s *Server, ctx context.Context, request *dcrjson.Request)
 				}
 			}
 			if err != nil {
-				return nil, convertError(err)
+				return nil, err
 			}
 			return resp, nil
 		}
 	}
 
-	return func() (any, *dcrjson.RPCError) {
+	return func() (any, error) {
 		params, err := dcrjson.ParseParams(types.Method(request.Method), request.Params)
 		if err != nil {
 			return nil, dcrjson.ErrRPCInvalidRequest
@@ -272,7 +377,7 @@ func lazyApplyHandler(s *Server, ctx context.Context, request *dcrjson.Request)
 		}()
 		resp, err := handlerData.fn(s, ctx, params)
 		if err != nil {
-			return nil, convertError(err)
+			return nil, err
 		}
 		return resp, nil
 	}
@@ -281,25 +386,25 @@ func lazyApplyHandler(s *Server, ctx context.Context, request *dcrjson.Request)
 // makeResponse makes the JSON-RPC response struct for the result and error
 // returned by a requestHandler.  The returned response is not ready for
 // marshaling and sending off to a client, but must be
-func makeResponse(id, result any, err error) dcrjson.Response {
+func makeResponse(id, result any, err error) response {
 	idPtr := idPointer(id)
 	if err != nil {
-		return dcrjson.Response{
+		return response{
 			ID:    idPtr,
-			Error: convertError(err),
+			Error: newErrorObject(err),
 		}
 	}
 	resultBytes, err := json.Marshal(result)
 	if err != nil {
-		return dcrjson.Response{
+		return response{
 			ID: idPtr,
-			Error: &dcrjson.RPCError{
+			Error: newErrorObject(&dcrjson.RPCError{
 				Code:    dcrjson.ErrRPCInternal.Code,
 				Message: "Unexpected error marshalling result",
-			},
+			}),
 		}
 	}
-	return dcrjson.Response{
+	return response{
 		ID:     idPtr,
 		Result: json.RawMessage(resultBytes),
 	}
@@ -388,6 +493,37 @@ func (s *Server) accountSyncAddressIndex(ctx context.Context, icmd any) (any, er
 	return nil, w.SyncLastReturnedAddress(ctx, account, branch, index)
 }
 
+// pregenerateAddresses handles a pregenerateaddresses request by returning
+// the next addresses of an account branch regardless of the gap limit.
+func (s *Server) pregenerateAddresses(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.PregenerateAddressesCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+
+	addrs, err := w.PregenerateAddresses(ctx, account, *cmd.Branch, cmd.Count)
+	if err != nil {
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	res := make([]string, len(addrs))
+	for i, addr := range addrs {
+		res[i] = addr.String()
+	}
+	return res, nil
+}
+
 // walletPubKeys decodes each encoded key or address to a public key.  If the
 // address is P2PKH, the wallet is queried for the public key.
 func walletPubKeys(ctx context.Context, w *wallet.Wallet, keys []string) ([][]byte, error) {
@@ -448,7 +584,7 @@ func (s *Server) addMultiSigAddress(ctx context.Context, icmd any) (any, error)
 		return nil, err
 	}
 
-	err = w.ImportScript(ctx, script)
+	_, err = w.ImportScript(ctx, script, wallet.NoRescan)
 	if err != nil && !errors.Is(err, errors.Exist) {
 		return nil, err
 	}
@@ -492,71 +628,18 @@ func (s *Server) auditReuse(ctx context.Context, icmd any) (any, error) {
 		since = *cmd.Since
 	}
 
-	reuse := make(map[string][]string)
-	inRange := make(map[string]struct{})
-	params := w.ChainParams()
-	err := w.GetTransactions(ctx, func(b *wallet.Block) (bool, error) {
-		for _, tx := range b.Transactions {
-			// Votes and revocations are skipped because they must
-			// only pay to addresses previously committed to by
-			// ticket purchases, and this "address reuse" is
-			// expected.
-			switch tx.Type {
-			case wallet.TransactionTypeVote, wallet.TransactionTypeRevocation:
-				continue
-			}
-			for _, out := range tx.MyOutputs {
-				addr := out.Address.String()
-				outpoints := reuse[addr]
-				outpoint := wire.OutPoint{Hash: *tx.Hash, Index: out.Index}
-				reuse[addr] = append(outpoints, outpoint.String())
-				if b.Header == nil || int32(b.Header.Height) >= since {
-					inRange[addr] = struct{}{}
-				}
-			}
-			if tx.Type != wallet.TransactionTypeTicketPurchase {
-				continue
-			}
-			ticket := new(wire.MsgTx)
-			err := ticket.Deserialize(bytes.NewReader(tx.Transaction))
-			if err != nil {
-				return false, err
-			}
-			for i := 1; i < len(ticket.TxOut); i += 2 { // iterate commitments
-				out := ticket.TxOut[i]
-				addr, err := stake.AddrFromSStxPkScrCommitment(out.PkScript, params)
-				if err != nil {
-					return false, err
-				}
-				have, err := w.HaveAddress(ctx, addr)
-				if err != nil {
-					return false, err
-				}
-				if !have {
-					continue
-				}
-				s := addr.String()
-				outpoints := reuse[s]
-				outpoint := wire.OutPoint{Hash: *tx.Hash, Index: uint32(i)}
-				reuse[s] = append(outpoints, outpoint.String())
-				if b.Header == nil || int32(b.Header.Height) >= since {
-					inRange[s] = struct{}{}
-				}
-			}
-		}
-		return false, nil
-	}, nil, nil)
+	report, err := w.AddressReuse(ctx, since)
 	if err != nil {
 		return nil, err
 	}
-	for s, outpoints := range reuse {
-		if len(outpoints) <= 1 {
-			delete(reuse, s)
-			continue
-		}
-		if _, ok := inRange[s]; !ok {
-			delete(reuse, s)
+	reuse := make(map[string][]string, len(report.Addresses))
+	for i := range report.Addresses {
+		r := &report.Addresses[i]
+		outpoints := make([]string, len(r.Outputs))
+		for j := range r.Outputs {
+			outpoints[j] = r.Outputs[j].String()
 		}
+		reuse[r.Address.String()] = outpoints
 	}
 	return reuse, nil
 }
@@ -874,40 +957,47 @@ func (s *Server) dumpPrivKey(ctx context.Context, icmd any) (any, error) {
 	return key, nil
 }
 
-func (s *Server) fundRawTransaction(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.FundRawTransactionCmd)
-	w, ok := s.walletLoader.LoadedWallet()
-	if !ok {
-		return nil, errUnloadedWallet
-	}
+// fundRawTransactionParams describes the transaction outputs, funding account
+// and options of the fundrawtransaction and estimaterawtransaction commands.
+type fundRawTransactionParams struct {
+	tx           *wire.MsgTx
+	account      uint32
+	changeSource txauthor.ChangeSource // nil to derive from the account
+	feeRate      dcrutil.Amount
+	confs        int32
+}
+
+func parseFundRawTransaction(ctx context.Context, w *wallet.Wallet, hexString, fundAccount string,
+	opts *types.FundRawTransactionOptions) (*fundRawTransactionParams, error) {
 
 	var (
 		changeAddress string
-		feeRate       = w.RelayFee()
-		confs         = int32(1)
+		p             = &fundRawTransactionParams{
+			feeRate: w.RelayFee(),
+			confs:   1,
+		}
 	)
-	if cmd.Options != nil {
-		opts := cmd.Options
+	if opts != nil {
 		var err error
 		if opts.ChangeAddress != nil {
 			changeAddress = *opts.ChangeAddress
 		}
 		if opts.FeeRate != nil {
-			feeRate, err = dcrutil.NewAmount(*opts.FeeRate)
+			p.feeRate, err = dcrutil.NewAmount(*opts.FeeRate)
 			if err != nil {
 				return nil, err
 			}
 		}
 		if opts.ConfTarget != nil {
-			confs = *opts.ConfTarget
-			if confs < 0 {
+			p.confs = *opts.ConfTarget
+			if p.confs < 0 {
 				return nil, errors.New("confs must be non-negative")
 			}
 		}
 	}
 
-	tx := new(wire.MsgTx)
-	err := tx.Deserialize(hex.NewDecoder(strings.NewReader(cmd.HexString)))
+	p.tx = new(wire.MsgTx)
+	err := p.tx.Deserialize(hex.NewDecoder(strings.NewReader(hexString)))
 	if err != nil {
 		return nil, err
 	}
@@ -917,26 +1007,40 @@ func (s *Server) fundRawTransaction(ctx context.Context, icmd any) (any, error)
 	// is changed later to work on a PSDT structure that includes this
 	// information, this functionality may be enabled.  For now, prevent
 	// the method from continuing.
-	if len(tx.TxIn) != 0 {
+	if len(p.tx.TxIn) != 0 {
 		return nil, errors.New("transaction must not already have inputs")
 	}
 
-	accountNum, err := w.AccountNumber(ctx, cmd.FundAccount)
+	p.account, err = w.AccountNumber(ctx, fundAccount)
 	if err != nil {
 		return nil, err
 	}
 
-	// Because there are no other inputs, a new transaction can be created.
-	var changeSource txauthor.ChangeSource
 	if changeAddress != "" {
-		var err error
-		changeSource, err = makeScriptChangeSource(changeAddress, w.ChainParams())
+		p.changeSource, err = makeScriptChangeSource(changeAddress, w.ChainParams())
 		if err != nil {
 			return nil, err
 		}
 	}
-	atx, err := w.NewUnsignedTransaction(ctx, tx.TxOut, feeRate, accountNum, confs,
-		wallet.OutputSelectionAlgorithmDefault, changeSource, nil)
+	return p, nil
+}
+
+func (s *Server) fundRawTransaction(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.FundRawTransactionCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	p, err := parseFundRawTransaction(ctx, w, cmd.HexString, cmd.FundAccount, cmd.Options)
+	if err != nil {
+		return nil, err
+	}
+	tx := p.tx
+
+	// Because there are no other inputs, a new transaction can be created.
+	atx, err := w.NewUnsignedTransaction(ctx, tx.TxOut, p.feeRate, p.account, p.confs,
+		wallet.OutputSelectionAlgorithmDefault, p.changeSource, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1035,6 +1139,15 @@ func (s *Server) getAddressesByAccount(ctx context.Context, icmd any) (any, erro
 	if err != nil {
 		return nil, err
 	}
+
+	// Include watched addresses imported into the account.
+	imported, err := w.ImportedAddresses(ctx, cmd.Account)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range imported {
+		addrs = append(addrs, a.String())
+	}
 	return addressStringsMarshaler(addrs), nil
 }
 
@@ -1073,6 +1186,8 @@ func (s *Server) getBalance(ctx context.Context, icmd any) (any, error) {
 			totImmatureCoinbase dcrutil.Amount
 			totImmatureStakegen dcrutil.Amount
 			totLocked           dcrutil.Amount
+			totLockedLive       dcrutil.Amount
+			totLockedUnspent    dcrutil.Amount
 			totSpendable        dcrutil.Amount
 			totUnconfirmed      dcrutil.Amount
 			totVotingAuthority  dcrutil.Amount
@@ -1095,6 +1210,8 @@ func (s *Server) getBalance(ctx context.Context, icmd any) (any, error) {
 			totImmatureCoinbase += bal.ImmatureCoinbaseRewards
 			totImmatureStakegen += bal.ImmatureStakeGeneration
 			totLocked += bal.LockedByTickets
+			totLockedLive += bal.LockedByLiveTickets
+			totLockedUnspent += bal.LockedByUnspent
 			totSpendable += bal.Spendable
 			totUnconfirmed += bal.Unconfirmed
 			totVotingAuthority += bal.VotingAuthority
@@ -1105,6 +1222,8 @@ func (s *Server) getBalance(ctx context.Context, icmd any) (any, error) {
 				ImmatureCoinbaseRewards: bal.ImmatureCoinbaseRewards.ToCoin(),
 				ImmatureStakeGeneration: bal.ImmatureStakeGeneration.ToCoin(),
 				LockedByTickets:         bal.LockedByTickets.ToCoin(),
+				LockedByLiveTickets:     bal.LockedByLiveTickets.ToCoin(),
+				LockedByUnspent:         bal.LockedByUnspent.ToCoin(),
 				Spendable:               bal.Spendable.ToCoin(),
 				Total:                   bal.Total.ToCoin(),
 				Unconfirmed:             bal.Unconfirmed.ToCoin(),
@@ -1117,6 +1236,8 @@ func (s *Server) getBalance(ctx context.Context, icmd any) (any, error) {
 		result.TotalImmatureCoinbaseRewards = totImmatureCoinbase.ToCoin()
 		result.TotalImmatureStakeGeneration = totImmatureStakegen.ToCoin()
 		result.TotalLockedByTickets = totLocked.ToCoin()
+		result.TotalLockedByLiveTickets = totLockedLive.ToCoin()
+		result.TotalLockedByUnspent = totLockedUnspent.ToCoin()
 		result.TotalSpendable = totSpendable.ToCoin()
 		result.TotalUnconfirmed = totUnconfirmed.ToCoin()
 		result.TotalVotingAuthority = totVotingAuthority.ToCoin()
@@ -1143,6 +1264,8 @@ func (s *Server) getBalance(ctx context.Context, icmd any) (any, error) {
 			ImmatureCoinbaseRewards: bal.ImmatureCoinbaseRewards.ToCoin(),
 			ImmatureStakeGeneration: bal.ImmatureStakeGeneration.ToCoin(),
 			LockedByTickets:         bal.LockedByTickets.ToCoin(),
+			LockedByLiveTickets:     bal.LockedByLiveTickets.ToCoin(),
+			LockedByUnspent:         bal.LockedByUnspent.ToCoin(),
 			Spendable:               bal.Spendable.ToCoin(),
 			Total:                   bal.Total.ToCoin(),
 			Unconfirmed:             bal.Unconfirmed.ToCoin(),
@@ -1721,7 +1844,8 @@ func difficultyRatio(bits uint32, params *chaincfg.Params) float64 {
 	return diff
 }
 
-// syncStatus handles a syncstatus request.
+// syncStatus handles a syncstatus request by reporting the current sync phase
+// and its progress, along with the network backend and its peers.
 func (s *Server) syncStatus(ctx context.Context, icmd any) (any, error) {
 	w, ok := s.walletLoader.LoadedWallet()
 	if !ok {
@@ -1751,10 +1875,22 @@ func (s *Server) syncStatus(ctx context.Context, icmd any) (any, error) {
 		headersFetchProgress = 1 - (float32(blocksToFetch) / float32(totalHeadersToFetch))
 	}
 
+	status := w.SyncStatus(ctx)
+	peers := status.Peers
+	if peers == nil {
+		peers = []string{}
+	}
+
 	return &types.SyncStatusResult{
 		Synced:               synced,
 		InitialBlockDownload: walletBestBlockTooOld,
 		HeadersFetchProgress: headersFetchProgress,
+		Phase:                status.Phase.String(),
+		PhaseHeight:          status.Height,
+		PhaseTargetHeight:    status.TargetHeight,
+		TipHeight:            status.TipHeight,
+		Backend:              status.Backend,
+		Peers:                peers,
 	}, nil
 }
 
@@ -2001,6 +2137,34 @@ func (s *Server) importCFiltersV2(ctx context.Context, icmd any) (any, error) {
 	return nil, nil
 }
 
+// importCheckpoint handles an importcheckpoint request by restoring the
+// account address cursors of a wallet checkpoint written with the --checkpoint
+// option.
+func (s *Server) importCheckpoint(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ImportCheckpointCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	c, err := w.ImportCheckpoint(ctx, []byte(cmd.Checkpoint))
+	if errors.Is(err, errors.Encoding) || errors.Is(err, errors.Invalid) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	if errors.Is(err, errors.Locked) {
+		return nil, errWalletUnlockNeeded
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &types.ImportCheckpointResult{
+		BlockHash:   c.BlockHash.String(),
+		BlockHeight: c.BlockHeight,
+		Created:     c.Created,
+		Accounts:    len(c.Accounts),
+	}, nil
+}
+
 // importPrivKey handles an importprivkey request by parsing
 // a WIF-encoded private key and adding it to an account.
 func (s *Server) importPrivKey(ctx context.Context, icmd any) (any, error) {
@@ -2010,17 +2174,12 @@ func (s *Server) importPrivKey(ctx context.Context, icmd any) (any, error) {
 		return nil, errUnloadedWallet
 	}
 
-	rescan := true
-	if cmd.Rescan != nil {
-		rescan = *cmd.Rescan
-	}
 	scanFrom := int32(0)
 	if cmd.ScanFrom != nil {
 		scanFrom = int32(*cmd.ScanFrom)
 	}
-	n, ok := s.walletLoader.NetworkBackend()
-	if rescan && !ok {
-		return nil, errNoNetwork
+	if cmd.Rescan != nil && !*cmd.Rescan {
+		scanFrom = wallet.NoRescan
 	}
 
 	// Ensure that private keys are only imported to the correct account.
@@ -2036,7 +2195,7 @@ func (s *Server) importPrivKey(ctx context.Context, icmd any) (any, error) {
 	}
 
 	// Import the private key, handling any errors.
-	_, err = w.ImportPrivateKey(ctx, wif)
+	_, rescan, err := w.ImportPrivateKey(ctx, wif, scanFrom)
 	if err != nil {
 		switch {
 		case errors.Is(err, errors.Exist):
@@ -2049,17 +2208,7 @@ func (s *Server) importPrivKey(ctx context.Context, icmd any) (any, error) {
 		}
 	}
 
-	if rescan {
-		// Rescan in the background rather than blocking the rpc request. Use
-		// the server waitgroup to ensure the rescan can return cleanly rather
-		// than being killed mid database transaction.
-		s.wg.Add(1)
-		go func() {
-			defer s.wg.Done()
-			serverCtx := s.httpServer.BaseContext(nil)
-			_ = w.RescanFromHeight(serverCtx, n, scanFrom)
-		}()
-	}
+	s.startImportRescan(rescan)
 
 	return nil, nil
 }
@@ -2075,21 +2224,24 @@ func (s *Server) importPubKey(ctx context.Context, icmd any) (any, error) {
 		return nil, errUnloadedWallet
 	}
 
-	rescan := true
-	if cmd.Rescan != nil {
-		rescan = *cmd.Rescan
-	}
 	scanFrom := int32(0)
 	if cmd.ScanFrom != nil {
 		scanFrom = int32(*cmd.ScanFrom)
 	}
-	n, ok := s.walletLoader.NetworkBackend()
-	if rescan && !ok {
-		return nil, errNoNetwork
+	if cmd.Rescan != nil && !*cmd.Rescan {
+		scanFrom = wallet.NoRescan
 	}
 
+	account := uint32(udb.ImportedAddrAccount)
 	if cmd.Label != nil && *cmd.Label != udb.ImportedAddrAccountName {
-		return nil, errNotImportedAccount
+		var err error
+		account, err = w.AccountNumber(ctx, *cmd.Label)
+		if err != nil {
+			if errors.Is(err, errors.NotExist) {
+				return nil, errAccountNotFound
+			}
+			return nil, err
+		}
 	}
 
 	pk, err := hex.DecodeString(cmd.PubKey)
@@ -2097,7 +2249,7 @@ func (s *Server) importPubKey(ctx context.Context, icmd any) (any, error) {
 		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
 	}
 
-	_, err = w.ImportPublicKey(ctx, pk)
+	_, rescan, err := w.ImportPublicKeyToAccount(ctx, pk, account, scanFrom)
 	if errors.Is(err, errors.Exist) {
 		// Do not return duplicate address errors, and skip any
 		// rescans.
@@ -2107,17 +2259,7 @@ func (s *Server) importPubKey(ctx context.Context, icmd any) (any, error) {
 		return nil, err
 	}
 
-	if rescan {
-		// Rescan in the background rather than blocking the rpc request. Use
-		// the server waitgroup to ensure the rescan can return cleanly rather
-		// than being killed mid database transaction.
-		s.wg.Add(1)
-		go func() {
-			defer s.wg.Done()
-			serverCtx := s.httpServer.BaseContext(nil)
-			_ = w.RescanFromHeight(serverCtx, n, scanFrom)
-		}()
-	}
+	s.startImportRescan(rescan)
 
 	return nil, nil
 }
@@ -2130,17 +2272,12 @@ func (s *Server) importScript(ctx context.Context, icmd any) (any, error) {
 		return nil, errUnloadedWallet
 	}
 
-	rescan := true
-	if cmd.Rescan != nil {
-		rescan = *cmd.Rescan
-	}
 	scanFrom := int32(0)
 	if cmd.ScanFrom != nil {
 		scanFrom = int32(*cmd.ScanFrom)
 	}
-	n, ok := s.walletLoader.NetworkBackend()
-	if rescan && !ok {
-		return nil, errNoNetwork
+	if cmd.Rescan != nil && !*cmd.Rescan {
+		scanFrom = wallet.NoRescan
 	}
 
 	rs, err := hex.DecodeString(cmd.Hex)
@@ -2150,8 +2287,18 @@ func (s *Server) importScript(ctx context.Context, icmd any) (any, error) {
 	if len(rs) == 0 {
 		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "empty script")
 	}
+	account := uint32(udb.ImportedAddrAccount)
+	if cmd.Account != nil && *cmd.Account != udb.ImportedAddrAccountName {
+		account, err = w.AccountNumber(ctx, *cmd.Account)
+		if err != nil {
+			if errors.Is(err, errors.NotExist) {
+				return nil, errAccountNotFound
+			}
+			return nil, err
+		}
+	}
 
-	err = w.ImportScript(ctx, rs)
+	rescan, err := w.ImportScriptToAccount(ctx, rs, account, scanFrom)
 	if errors.Is(err, errors.Exist) {
 		return nil, nil
 	}
@@ -2159,21 +2306,32 @@ func (s *Server) importScript(ctx context.Context, icmd any) (any, error) {
 		return nil, err
 	}
 
-	if rescan {
-		// Rescan in the background rather than blocking the rpc request. Use
-		// the server waitgroup to ensure the rescan can return cleanly rather
-		// than being killed mid database transaction.
-		s.wg.Add(1)
-		go func() {
-			defer s.wg.Done()
-			serverCtx := s.httpServer.BaseContext(nil)
-			_ = w.RescanFromHeight(serverCtx, n, scanFrom)
-		}()
-	}
+	s.startImportRescan(rescan)
 
 	return nil, nil
 }
 
+// startImportRescan performs the rescan scheduled by an import in the
+// background rather than blocking the rpc request.  The server waitgroup is
+// used to ensure the rescan can return cleanly rather than being killed mid
+// database transaction.  Without a network backend, the rescan is left to the
+// next sync of the wallet.
+func (s *Server) startImportRescan(rescan *wallet.ImportRescan) {
+	if rescan == nil {
+		return
+	}
+	n, ok := s.walletLoader.NetworkBackend()
+	if !ok {
+		return
+	}
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		serverCtx := s.httpServer.BaseContext(nil)
+		_ = rescan.Rescan(serverCtx, n)
+	}()
+}
+
 func (s *Server) importXpub(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.ImportXpubCmd)
 	w, ok := s.walletLoader.LoadedWallet()
@@ -2189,6 +2347,47 @@ func (s *Server) importXpub(ctx context.Context, icmd any) (any, error) {
 	return nil, w.ImportXpubAccount(ctx, cmd.Name, xpub)
 }
 
+// importExternalSignerAccount handles an importexternalsigneraccount request
+// by importing the account xpub of a hardware signing device as a new
+// watching-only account, returning the account number.
+func (s *Server) importExternalSignerAccount(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ImportExternalSignerAccountCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	if cmd.Name == "*" {
+		return nil, errReservedAccountName
+	}
+	xpub, err := hdkeychain.NewKeyFromString(cmd.Xpub, w.ChainParams())
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	fingerprint, err := hex.DecodeString(cmd.Fingerprint)
+	if err != nil || len(fingerprint) != 4 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"fingerprint must be 4 hex-encoded bytes")
+	}
+	path, err := udb.ParseDerivationPath(cmd.Path)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	signer := &udb.ExternalSigner{
+		Fingerprint: binary.BigEndian.Uint32(fingerprint),
+		Path:        path,
+		Model:       cmd.Model,
+	}
+	account, err := w.ImportExternalSignerAccount(ctx, cmd.Name, xpub, signer)
+	if err != nil {
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	return account, nil
+}
+
 // createNewAccount handles a createnewaccount request by creating and
 // returning a new account. If the last account has no transaction history
 // as per BIP 0044 a new account cannot be created so an error will be returned.
@@ -2299,6 +2498,26 @@ func (s *Server) getMultisigOutInfo(ctx context.Context, icmd any) (any, error)
 	return result, nil
 }
 
+// gapPolicyOptions returns the address call options for a gap policy
+// parameter.
+func gapPolicyOptions(gapPolicy *string) ([]wallet.NextAddressCallOption, error) {
+	if gapPolicy == nil {
+		return nil, nil
+	}
+	switch *gapPolicy {
+	case "":
+		return nil, nil
+	case "error":
+		return []wallet.NextAddressCallOption{wallet.WithGapPolicyError()}, nil
+	case "ignore":
+		return []wallet.NextAddressCallOption{wallet.WithGapPolicyIgnore()}, nil
+	case "wrap":
+		return []wallet.NextAddressCallOption{wallet.WithGapPolicyWrap()}, nil
+	default:
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "unknown gap policy %q", *gapPolicy)
+	}
+}
+
 // getNewAddress handles a getnewaddress request by returning a new
 // address for an account.  If the account does not exist an appropriate
 // error is returned.
@@ -2309,19 +2528,9 @@ func (s *Server) getNewAddress(ctx context.Context, icmd any) (any, error) {
 		return nil, errUnloadedWallet
 	}
 
-	var callOpts []wallet.NextAddressCallOption
-	if cmd.GapPolicy != nil {
-		switch *cmd.GapPolicy {
-		case "":
-		case "error":
-			callOpts = append(callOpts, wallet.WithGapPolicyError())
-		case "ignore":
-			callOpts = append(callOpts, wallet.WithGapPolicyIgnore())
-		case "wrap":
-			callOpts = append(callOpts, wallet.WithGapPolicyWrap())
-		default:
-			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "unknown gap policy %q", *cmd.GapPolicy)
-		}
+	callOpts, err := gapPolicyOptions(cmd.GapPolicy)
+	if err != nil {
+		return nil, err
 	}
 
 	acctName := "default"
@@ -2336,30 +2545,50 @@ func (s *Server) getNewAddress(ctx context.Context, icmd any) (any, error) {
 		return nil, err
 	}
 
-	addr, err := w.NewExternalAddress(ctx, account, callOpts...)
+	if cmd.ExpiresIn == nil && cmd.ExpiryHeight == nil {
+		addr, err := w.NewExternalAddress(ctx, account, callOpts...)
+		if err != nil {
+			return nil, err
+		}
+		return addr.String(), nil
+	}
+
+	var expiry time.Time
+	var expiryHeight int32
+	if cmd.ExpiresIn != nil {
+		if *cmd.ExpiresIn <= 0 {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"expiresin must be positive")
+		}
+		expiry = time.Now().Add(time.Duration(*cmd.ExpiresIn) * time.Second)
+	}
+	if cmd.ExpiryHeight != nil {
+		expiryHeight = *cmd.ExpiryHeight
+		if _, tipHeight := w.MainChainTip(ctx); expiryHeight <= tipHeight {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"expiryheight must be above the main chain tip height %d", tipHeight)
+		}
+	}
+	addr, err := w.NewExpiringAddress(ctx, account, expiry, expiryHeight, callOpts...)
 	if err != nil {
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
 		return nil, err
 	}
 	return addr.String(), nil
 }
 
-// getRawChangeAddress handles a getrawchangeaddress request by creating
-// and returning a new change address for an account.
-//
-// Note: bitcoind allows specifying the account as an optional parameter,
-// but ignores the parameter.
-func (s *Server) getRawChangeAddress(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.GetRawChangeAddressCmd)
+// createPaymentURI handles a createpaymenturi request by returning a payment
+// request URI for a new external address of an account.
+func (s *Server) createPaymentURI(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.CreatePaymentURICmd)
 	w, ok := s.walletLoader.LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	acctName := "default"
-	if cmd.Account != nil {
-		acctName = *cmd.Account
-	}
-	account, err := w.AccountNumber(ctx, acctName)
+	account, err := w.AccountNumber(ctx, *cmd.Account)
 	if err != nil {
 		if errors.Is(err, errors.NotExist) {
 			return nil, errAccountNotFound
@@ -2367,65 +2596,322 @@ func (s *Server) getRawChangeAddress(ctx context.Context, icmd any) (any, error)
 		return nil, err
 	}
 
-	addr, err := w.NewChangeAddress(ctx, account)
+	var amount dcrutil.Amount
+	if cmd.Amount != nil {
+		amount, err = dcrutil.NewAmount(*cmd.Amount)
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		if amount < 0 {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"amount must not be negative")
+		}
+	}
+	var label, message string
+	if cmd.Label != nil {
+		label = *cmd.Label
+	}
+	if cmd.Message != nil {
+		message = *cmd.Message
+	}
+	var expiry time.Time
+	if cmd.ExpiresIn != nil {
+		if *cmd.ExpiresIn <= 0 {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"expiresin must be positive")
+		}
+		expiry = time.Now().Add(time.Duration(*cmd.ExpiresIn) * time.Second)
+	}
+
+	uri, err := w.NewPaymentRequest(ctx, account, amount, label, message, expiry)
 	if err != nil {
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
 		return nil, err
 	}
-
-	// Return the new payment address string.
-	return addr.String(), nil
+	return &types.CreatePaymentURIResult{
+		URI:     uri.String(),
+		Address: uri.Address.String(),
+	}, nil
 }
 
-// getReceivedByAccount handles a getreceivedbyaccount request by returning
-// the total amount received by addresses of an account.
-func (s *Server) getReceivedByAccount(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.GetReceivedByAccountCmd)
+// parsePaymentURI handles a parsepaymenturi request by decoding a payment
+// request URI, and reconciling payments to the address when the request was
+// issued by the wallet.
+func (s *Server) parsePaymentURI(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ParsePaymentURICmd)
 	w, ok := s.walletLoader.LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	account, err := w.AccountNumber(ctx, cmd.Account)
+	uri, err := paymenturi.Parse(cmd.URI, w.ChainParams())
 	if err != nil {
-		if errors.Is(err, errors.NotExist) {
-			return nil, errAccountNotFound
-		}
-		return nil, err
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	res := &types.ParsePaymentURIResult{
+		Address: uri.Address.String(),
+		Amount:  uri.Amount.ToCoin(),
+		Label:   uri.Label,
+		Message: uri.Message,
+	}
+	if !uri.Expiry.IsZero() {
+		res.Expiry = uri.Expiry.Unix()
 	}
 
-	// Transactions are not tracked for imported xpub accounts.
-	if account > udb.ImportedAddrAccount {
-		return 0.0, nil
+	r, err := w.PaymentRequest(ctx, uri.Address)
+	if errors.Is(err, errors.NotExist) {
+		return res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	accountName, err := w.AccountName(ctx, r.Account)
+	if err != nil {
+		return nil, err
+	}
+	res.Request = &types.PaymentRequestResult{
+		Account:  accountName,
+		Issued:   r.Issued.Unix(),
+		Received: r.Received.ToCoin(),
+		Paid:     r.Paid(),
+		Expired:  !r.Expiry.IsZero() && !time.Now().Before(r.Expiry),
 	}
+	return res, nil
+}
 
-	// TODO: This is more inefficient that it could be, but the entire
-	// algorithm is already dominated by reading every transaction in the
-	// wallet's history.
-	results, err := w.TotalReceivedForAccounts(ctx, int32(*cmd.MinConf))
+// invoiceResult returns the JSON-RPC result describing an invoice.
+func invoiceResult(ctx context.Context, w *wallet.Wallet, inv *wallet.Invoice) (*types.InvoiceResult, error) {
+	accountName, err := w.AccountName(ctx, inv.Account)
 	if err != nil {
 		return nil, err
 	}
-	acctIndex := int(account)
-	if account == udb.ImportedAddrAccount {
-		acctIndex = len(results) - 1
+	res := &types.InvoiceResult{
+		ID:            inv.ID,
+		Address:       inv.Address,
+		Account:       accountName,
+		Amount:        inv.Amount.ToCoin(),
+		Received:      inv.Received.ToCoin(),
+		Status:        inv.Status.String(),
+		Created:       inv.Created.Unix(),
+		ChangedHeight: inv.ChangedHeight,
+	}
+	if !inv.Expiry.IsZero() {
+		res.Expiry = inv.Expiry.Unix()
 	}
-	return results[acctIndex].TotalReceived.ToCoin(), nil
+	if !inv.Updated.IsZero() {
+		res.Updated = inv.Updated.Unix()
+	}
+	return res, nil
 }
 
-// getReceivedByAddress handles a getreceivedbyaddress request by returning
-// the total amount received by a single address.
-func (s *Server) getReceivedByAddress(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.GetReceivedByAddressCmd)
+// createInvoice handles a createinvoice request by recording an invoice paid
+// to a new external address of an account.
+func (s *Server) createInvoice(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.CreateInvoiceCmd)
 	w, ok := s.walletLoader.LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	addr, err := decodeAddress(cmd.Address, w.ChainParams())
+	account, err := w.AccountNumber(ctx, *cmd.Account)
 	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
 		return nil, err
 	}
-	total, err := w.TotalReceivedForAddr(ctx, addr, int32(*cmd.MinConf))
+	amount, err := dcrutil.NewAmount(cmd.Amount)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	var expiry time.Time
+	if cmd.ExpiresIn != nil {
+		if *cmd.ExpiresIn <= 0 {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"expiresin must be positive")
+		}
+		expiry = time.Now().Add(time.Duration(*cmd.ExpiresIn) * time.Second)
+	}
+
+	callOpts, err := gapPolicyOptions(cmd.GapPolicy)
+	if err != nil {
+		return nil, err
+	}
+	inv, err := w.NewInvoice(ctx, cmd.ID, account, amount, expiry, callOpts...)
+	if err != nil {
+		if errors.Is(err, errors.Invalid) || errors.Is(err, errors.Exist) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	return invoiceResult(ctx, w, inv)
+}
+
+// getInvoice handles a getinvoice request by returning an invoice and its
+// reconciliation with mined payments.
+func (s *Server) getInvoice(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetInvoiceCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	inv, err := w.Invoice(ctx, cmd.ID)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	return invoiceResult(ctx, w, inv)
+}
+
+// listInvoices handles a listinvoices request by returning all invoices,
+// optionally limited to an account or status.
+func (s *Server) listInvoices(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ListInvoicesCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	var filterAccount, filterStatus bool
+	var account uint32
+	var status udb.InvoiceStatus
+	if cmd.Account != nil {
+		var err error
+		account, err = w.AccountNumber(ctx, *cmd.Account)
+		if err != nil {
+			if errors.Is(err, errors.NotExist) {
+				return nil, errAccountNotFound
+			}
+			return nil, err
+		}
+		filterAccount = true
+	}
+	if cmd.Status != nil {
+		var err error
+		status, err = udb.ParseInvoiceStatus(*cmd.Status)
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		filterStatus = true
+	}
+
+	invoices, err := w.Invoices(ctx, func(inv *wallet.Invoice) bool {
+		return (!filterAccount || inv.Account == account) &&
+			(!filterStatus || inv.Status == status)
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*types.InvoiceResult, 0, len(invoices))
+	for _, inv := range invoices {
+		r, err := invoiceResult(ctx, w, inv)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, r)
+	}
+	return res, nil
+}
+
+// deleteInvoice handles a deleteinvoice request by removing an invoice.
+func (s *Server) deleteInvoice(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.DeleteInvoiceCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	err := w.DeleteInvoice(ctx, cmd.ID)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
+// getRawChangeAddress handles a getrawchangeaddress request by creating
+// and returning a new change address for an account.
+//
+// Note: bitcoind allows specifying the account as an optional parameter,
+// but ignores the parameter.
+func (s *Server) getRawChangeAddress(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetRawChangeAddressCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	acctName := "default"
+	if cmd.Account != nil {
+		acctName = *cmd.Account
+	}
+	account, err := w.AccountNumber(ctx, acctName)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+
+	addr, err := w.NewChangeAddress(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the new payment address string.
+	return addr.String(), nil
+}
+
+// getReceivedByAccount handles a getreceivedbyaccount request by returning
+// the total amount received by addresses of an account.
+func (s *Server) getReceivedByAccount(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetReceivedByAccountCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+
+	// Transactions are not tracked for imported xpub accounts.
+	if account > udb.ImportedAddrAccount {
+		return 0.0, nil
+	}
+
+	total, err := w.TotalReceivedForAccount(ctx, account, int32(*cmd.MinConf))
+	if err != nil {
+		return nil, err
+	}
+	return total.ToCoin(), nil
+}
+
+// getReceivedByAddress handles a getreceivedbyaddress request by returning
+// the total amount received by a single address.
+func (s *Server) getReceivedByAddress(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetReceivedByAddressCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	addr, err := decodeAddress(cmd.Address, w.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+	total, err := w.TotalReceivedForAddr(ctx, addr, int32(*cmd.MinConf))
 	if err != nil {
 		if errors.Is(err, errors.NotExist) {
 			return nil, errAddressNotInWallet
@@ -2769,6 +3255,44 @@ func (s *Server) getTxOut(ctx context.Context, icmd any) (any, error) {
 	}, nil
 }
 
+// getVoteDiagnostics handles the getvotediagnostics command by returning the
+// timing of the most recent votes created by the wallet.
+func (s *Server) getVoteDiagnostics(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetVoteDiagnosticsCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+	if *cmd.Count <= 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"count must be positive")
+	}
+
+	diags := w.VoteDiagnostics(*cmd.Count)
+	res := make([]types.VoteDiagnosticResult, 0, len(diags))
+	for i := range diags {
+		d := &diags[i]
+		r := types.VoteDiagnosticResult{
+			Ticket:      d.Ticket.String(),
+			Vote:        d.Vote.String(),
+			BlockHash:   d.BlockHash.String(),
+			BlockHeight: d.BlockHeight,
+			Notified:    d.Notified.Unix(),
+			SignedMs:    millis(d.Signed),
+			BroadcastMs: millis(d.Broadcast),
+			Backend:     d.Backend,
+			Peers:       d.Peers,
+			Size:        d.Size,
+			Fee:         d.Fee.ToCoin(),
+		}
+		if d.Err != nil {
+			r.Error = d.Err.Error()
+		}
+		res = append(res, r)
+	}
+	return res, nil
+}
+
 // getVoteChoices handles a getvotechoices request by returning configured vote
 // preferences for each agenda of the latest supported stake version.
 func (s *Server) getVoteChoices(ctx context.Context, icmd any) (any, error) {
@@ -2820,6 +3344,53 @@ func (s *Server) getVoteChoices(ctx context.Context, icmd any) (any, error) {
 	return resp, nil
 }
 
+// getVoteVersionInfo handles a getvoteversioninfo request by returning the
+// agendas of the wallet's vote version, the vote versions of the wallet and
+// network, and any saved vote choices which are no longer applied.
+func (s *Server) getVoteVersionInfo(ctx context.Context, icmd any) (any, error) {
+	_ = icmd.(*types.GetVoteVersionInfoCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	status, err := w.VoteVersionStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &types.GetVoteVersionInfoResult{
+		VoteVersion:         status.VoteVersion,
+		NetworkStakeVersion: status.NetworkStakeVersion,
+		Agendas:             make([]types.VoteVersionAgenda, 0, len(status.Agendas)),
+		ObsoleteChoices:     make([]types.ObsoleteVoteChoice, 0, len(status.Obsolete)),
+		Warnings:            status.Warnings,
+	}
+	if resp.Warnings == nil {
+		resp.Warnings = []string{}
+	}
+	for i := range status.Agendas {
+		a := &status.Agendas[i]
+		choices := make([]string, 0, len(a.Vote.Choices))
+		for _, c := range a.Vote.Choices {
+			choices = append(choices, c.Id)
+		}
+		resp.Agendas = append(resp.Agendas, types.VoteVersionAgenda{
+			AgendaID:    a.Vote.Id,
+			Description: a.Vote.Description,
+			Choices:     choices,
+			StartTime:   a.StartTime.Unix(),
+			ExpireTime:  a.ExpireTime.Unix(),
+			Expired:     a.Expired,
+			ChoiceID:    a.ChoiceID,
+			Saved:       a.Saved,
+		})
+	}
+	for _, o := range status.Obsolete {
+		resp.ObsoleteChoices = append(resp.ObsoleteChoices, types.ObsoleteVoteChoice(o))
+	}
+	return resp, nil
+}
+
 // getWalletFee returns the currently set tx fee for the requested wallet
 func (s *Server) getWalletFee(ctx context.Context, icmd any) (any, error) {
 	w, ok := s.walletLoader.LoadedWallet()
@@ -3049,6 +3620,9 @@ func (s *Server) listReceivedByAddress(ctx context.Context, icmd any) (any, erro
 	err = wallet.UnstableAPI(w).RangeTransactions(ctx, 0, endHeight, func(details []udb.TxDetails) (bool, error) {
 		confirmations := confirms(details[0].Block.Height, tipHeight)
 		for _, tx := range details {
+			if tx.Pruned {
+				continue
+			}
 			for _, cred := range tx.Credits {
 				pkVersion := tx.MsgTx.TxOut[cred.Index].Version
 				pkScript := tx.MsgTx.TxOut[cred.Index].PkScript
@@ -3347,6 +3921,38 @@ func (s *Server) purchaseTicket(ctx context.Context, icmd any) (any, error) {
 	if cmd.DontSignTx != nil {
 		dontSignTx = *cmd.DontSignTx
 	}
+	if dontSignTx && cmd.IdempotencyKey != nil {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"idempotency keys are unsupported for unsigned ticket purchases")
+	}
+
+	var votingScript []byte
+	if cmd.VotingScript != nil {
+		votingScript, err = hex.DecodeString(*cmd.VotingScript)
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+		}
+		// VSPs require the private key of a P2PKH voting address.
+		if s.cfg.VSPHost != "" {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"votingscript may not be used when a VSP is configured")
+		}
+	}
+
+	// Fund signed tickets with a mixed split transaction when mixing is
+	// enabled, unless overridden by the request.
+	mixing := s.cfg.MixingEnabled && !dontSignTx
+	if cmd.MixSplit != nil {
+		mixing = *cmd.MixSplit
+	}
+	if mixing && !s.cfg.MixingEnabled {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"mixed ticket splits require --mixing")
+	}
+	if mixing && dontSignTx {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"mixed ticket splits may not be left unsigned")
+	}
 
 	var mixedAccount uint32
 	var mixedAccountBranch uint32
@@ -3355,7 +3961,7 @@ func (s *Server) purchaseTicket(ctx context.Context, icmd any) (any, error) {
 	// mixing is enabled).
 	var changeAccount = account
 
-	if s.cfg.MixingEnabled {
+	if mixing {
 		mixedAccount, err = w.AccountNumber(ctx, s.cfg.MixAccount)
 		if err != nil {
 			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
@@ -3402,9 +4008,10 @@ func (s *Server) purchaseTicket(ctx context.Context, icmd any) (any, error) {
 		MinConf:       minConf,
 		Expiry:        expiry,
 		DontSignTx:    dontSignTx,
+		VotingScript:  votingScript,
 
 		// CSPP
-		Mixing:             s.cfg.MixingEnabled,
+		Mixing:             mixing,
 		MixedAccount:       mixedAccount,
 		MixedAccountBranch: mixedAccountBranch,
 		MixedSplitAccount:  mixedSplitAccount,
@@ -3414,22 +4021,34 @@ func (s *Server) purchaseTicket(ctx context.Context, icmd any) (any, error) {
 	}
 	// Use the mixed account as voting account if mixing is enabled,
 	// otherwise use the source account.
-	if s.cfg.MixingEnabled {
+	if mixing {
 		request.VotingAccount = mixedAccount
 	} else {
 		request.VotingAccount = account
 	}
 
-	ticketsResponse, err := w.PurchaseTickets(ctx, n, request)
+	var ticketsResponse *wallet.PurchaseTicketsResponse
+	hashes, err := idempotent(ctx, w, cmd.IdempotencyKey, cmd, func() ([]chainhash.Hash, error) {
+		var err error
+		ticketsResponse, err = w.PurchaseTickets(ctx, n, request)
+		if err != nil {
+			return nil, err
+		}
+		hashes := make([]chainhash.Hash, len(ticketsResponse.TicketHashes))
+		for i, h := range ticketsResponse.TicketHashes {
+			hashes[i] = *h
+		}
+		return hashes, nil
+	})
+	if errors.Is(err, errors.Invalid) && votingScript != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
 	if err != nil {
 		return nil, err
 	}
-	ticketsTx := ticketsResponse.Tickets
-	splitTx := ticketsResponse.SplitTx
 
 	// If dontSignTx is false, we return the TicketHashes of the published txs.
 	if !dontSignTx {
-		hashes := ticketsResponse.TicketHashes
 		hashStrs := make([]string, len(hashes))
 		for i := range hashes {
 			hashStrs[i] = hashes[i].String()
@@ -3437,6 +4056,8 @@ func (s *Server) purchaseTicket(ctx context.Context, icmd any) (any, error) {
 
 		return hashStrs, err
 	}
+	ticketsTx := ticketsResponse.Tickets
+	splitTx := ticketsResponse.SplitTx
 
 	// Otherwise we return its unsigned tickets bytes and the splittx, so a
 	// cold wallet can handle it.
@@ -3507,41 +4128,174 @@ func (s *Server) processUnmanagedTicket(ctx context.Context, icmd any) (any, err
 
 }
 
-// makeOutputs creates a slice of transaction outputs from a pair of address
-// strings to amounts.  This is used to create the outputs to include in newly
-// created transactions from a JSON object describing the output destinations
-// and amounts.
-func makeOutputs(pairs map[string]dcrutil.Amount, chainParams *chaincfg.Params) ([]*wire.TxOut, error) {
-	outputs := make([]*wire.TxOut, 0, len(pairs))
-	for addrStr, amt := range pairs {
-		if amt < 0 {
-			return nil, errNeedPositiveAmount
-		}
-		addr, err := decodeAddress(addrStr, chainParams)
-		if err != nil {
-			return nil, err
-		}
-
-		vers, pkScript := addr.PaymentScript()
+// proveVSPOwnership handles the provevspownership command by signing a VSP API
+// request of a wallet ticket.
+func (s *Server) proveVSPOwnership(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ProveVSPOwnershipCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
 
-		outputs = append(outputs, &wire.TxOut{
-			Value:    int64(amt),
-			PkScript: pkScript,
-			Version:  vers,
-		})
+	hash, err := chainhash.NewHashFromStr(cmd.TicketHash)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
 	}
-	return outputs, nil
+	proof, err := w.ProveVSPOwnership(ctx, *cmd.APIVersion, hash, []byte(cmd.Request))
+	if err != nil {
+		switch {
+		case errors.Is(err, errors.NotExist):
+			return nil, rpcError(dcrjson.ErrRPCNoTxInfo, err)
+		case errors.Is(err, errors.Locked):
+			return nil, errWalletUnlockNeeded
+		case errors.Is(err, errors.Invalid), errors.Is(err, errors.Encoding):
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	return &types.ProveVSPOwnershipResult{
+		Address:   proof.Address.String(),
+		Header:    proof.Header,
+		Signature: base64.StdEncoding.EncodeToString(proof.Signature),
+	}, nil
 }
 
-// sendPairs creates and sends payment transactions.
-// It returns the transaction hash in string format upon success
-// All errors are returned in dcrjson.RPCError format
-func (s *Server) sendPairs(ctx context.Context, w *wallet.Wallet, amounts map[string]dcrutil.Amount, account uint32, minconf int32) (string, error) {
-	changeAccount := account
-	if s.cfg.MixingEnabled && s.cfg.MixAccount != "" && s.cfg.MixChangeAccount != "" {
-		mixAccount, err := w.AccountNumber(ctx, s.cfg.MixAccount)
-		if err != nil {
-			return "", err
+// pruneSidechains handles the prunesidechains command by removing the saved
+// headers and cfilters of blocks which are not in the main chain.
+func (s *Server) pruneSidechains(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.PruneSidechainsCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	pruned, err := w.PruneSidechains(ctx, *cmd.Depth)
+	if err != nil {
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	retained, err := w.SidechainRecords(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.PruneSidechainsResult{
+		PrunedHeaders:  pruned.Headers,
+		PrunedCFilters: pruned.CFilters,
+		Headers:        retained.Headers,
+		CFilters:       retained.CFilters,
+	}, nil
+}
+
+// cosignVote handles the cosignvote command by adding the wallet's signatures
+// to a vote of a ticket with a multisig voting script.
+func (s *Server) cosignVote(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.CosignVoteCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	vote := wire.NewMsgTx()
+	err := vote.Deserialize(hex.NewDecoder(strings.NewReader(cmd.HexVote)))
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCDeserialization, err)
+	}
+	vote, complete, err := w.CosignVote(ctx, vote)
+	if err != nil {
+		switch {
+		case errors.Is(err, errors.NotExist):
+			return nil, rpcError(dcrjson.ErrRPCNoTxInfo, err)
+		case errors.Is(err, errors.Locked):
+			return nil, errWalletUnlockNeeded
+		case errors.Is(err, errors.Invalid):
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	sb := new(strings.Builder)
+	err = vote.Serialize(hex.NewEncoder(sb))
+	if err != nil {
+		return nil, err
+	}
+	return &types.CosignVoteResult{
+		Hex:      sb.String(),
+		Complete: complete,
+	}, nil
+}
+
+// makeOutputs creates a slice of transaction outputs from a pair of address
+// strings to amounts.  This is used to create the outputs to include in newly
+// created transactions from a JSON object describing the output destinations
+// and amounts.
+func makeOutputs(pairs map[string]dcrutil.Amount, chainParams *chaincfg.Params) ([]*wire.TxOut, error) {
+	outputs := make([]*wire.TxOut, 0, len(pairs))
+	for addrStr, amt := range pairs {
+		if amt < 0 {
+			return nil, errNeedPositiveAmount
+		}
+		addr, err := decodeAddress(addrStr, chainParams)
+		if err != nil {
+			return nil, err
+		}
+
+		vers, pkScript := addr.PaymentScript()
+
+		outputs = append(outputs, &wire.TxOut{
+			Value:    int64(amt),
+			PkScript: pkScript,
+			Version:  vers,
+		})
+	}
+	return outputs, nil
+}
+
+// idempotent calls create at most once for a non-nil idempotency key, returning
+// the hashes of the transactions created by the first call on retries of the
+// same command.  The JSON encoding of the command identifies the request.
+func idempotent(ctx context.Context, w *wallet.Wallet, key *string, cmd any,
+	create func() ([]chainhash.Hash, error)) ([]chainhash.Hash, error) {
+
+	if key == nil {
+		return create()
+	}
+	request, err := idempotentRequest(cmd)
+	if err != nil {
+		return nil, err
+	}
+	hashes, err := w.Idempotent(ctx, *key, request, create)
+	if errors.Is(err, errors.Exist) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return hashes, err
+}
+
+// idempotentRequest returns the encoding of a command identifying requests
+// made with an idempotency key.
+func idempotentRequest(cmd any) ([]byte, error) {
+	request, err := json.Marshal(cmd)
+	if err != nil {
+		return nil, err
+	}
+	return append(fmt.Appendf(nil, "%T", cmd), request...), nil
+}
+
+// sendPairs creates and sends payment transactions.
+// It returns the transaction hash in string format upon success
+// All errors are returned in dcrjson.RPCError format.  When idempotencyKey is
+// non-nil, retries of cmd with the same key return the original transaction
+// hash rather than sending again.  A non-nil destinationOverride permits
+// payments prohibited by the destination policy of the account, and a non-nil
+// expiryBlocks overrides the wallet's transaction expiry.
+func (s *Server) sendPairs(ctx context.Context, w *wallet.Wallet, amounts map[string]dcrutil.Amount,
+	account uint32, minconf int32, idempotencyKey, destinationOverride *string, expiryBlocks *int32,
+	cmd any) (string, error) {
+	changeAccount := account
+	if s.cfg.MixingEnabled && s.cfg.MixAccount != "" && s.cfg.MixChangeAccount != "" {
+		mixAccount, err := w.AccountNumber(ctx, s.cfg.MixAccount)
+		if err != nil {
+			return "", err
 		}
 		if account == mixAccount {
 			changeAccount, err = w.AccountNumber(ctx, s.cfg.MixChangeAccount)
@@ -3555,18 +4309,56 @@ func (s *Server) sendPairs(ctx context.Context, w *wallet.Wallet, amounts map[st
 	if err != nil {
 		return "", err
 	}
-	txSha, err := w.SendOutputs(ctx, outputs, account, changeAccount, minconf)
-	if err != nil {
-		if errors.Is(err, errors.Locked) {
-			return "", errWalletUnlockNeeded
+	var opts []wallet.TxAuthorOption
+	if destinationOverride != nil {
+		opts = append(opts, wallet.WithDestinationOverride([]byte(*destinationOverride)))
+	}
+	if expiryBlocks != nil {
+		if *expiryBlocks < 0 {
+			return "", rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative expiryblocks")
 		}
-		if errors.Is(err, errors.InsufficientBalance) {
+		opts = append(opts, wallet.WithTxExpiry(*expiryBlocks))
+	}
+	var hash *chainhash.Hash
+	if s.cfg.PendingSpends {
+		// The transaction is published with the same hash once
+		// approved.
+		var hashes []chainhash.Hash
+		hashes, err = idempotent(ctx, w, idempotencyKey, cmd, func() ([]chainhash.Hash, error) {
+			p, err := w.ProposeSpend(ctx, outputs, account, changeAccount, minconf, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return []chainhash.Hash{p.Hash}, nil
+		})
+		if err == nil {
+			hash = &hashes[0]
+		}
+	} else {
+		// The idempotency key is recorded with the transaction before
+		// it is published.
+		if idempotencyKey != nil {
+			request, err := idempotentRequest(cmd)
+			if err != nil {
+				return "", err
+			}
+			opts = append(opts, wallet.WithIdempotencyKey(*idempotencyKey, request))
+		}
+		hash, err = w.SendOutputs(ctx, outputs, account, changeAccount, minconf, opts...)
+	}
+	if err != nil {
+		// Locked errors are returned unconverted to describe any locked
+		// account in the error data.
+		switch {
+		case errors.Is(err, errors.InsufficientBalance):
 			return "", rpcError(dcrjson.ErrRPCWalletInsufficientFunds, err)
+		case idempotencyKey != nil && errors.Is(err, errors.Exist):
+			return "", rpcError(dcrjson.ErrRPCInvalidParameter, err)
 		}
 		return "", err
 	}
 
-	return txSha.String(), nil
+	return hash.String(), nil
 }
 
 // sendAmountToTreasury creates and sends payment transactions to the treasury.
@@ -4183,9 +4975,101 @@ func (s *Server) rescanWallet(ctx context.Context, icmd any) (any, error) {
 	return nil, err
 }
 
+// recoverMixOutputs handles the recovermixoutputs command.
+func (s *Server) recoverMixOutputs(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.RecoverMixOutputsCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	n, ok := s.walletLoader.NetworkBackend()
+	if !ok {
+		return nil, errNoNetwork
+	}
+
+	// Recover outputs of the mixed and change accounts by default.
+	var accountNames []string
+	switch {
+	case cmd.Accounts != nil:
+		accountNames = *cmd.Accounts
+	case s.cfg.MixAccount != "":
+		accountNames = append(accountNames, s.cfg.MixAccount)
+		if s.cfg.MixChangeAccount != "" && s.cfg.MixChangeAccount != s.cfg.MixAccount {
+			accountNames = append(accountNames, s.cfg.MixChangeAccount)
+		}
+	default:
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"accounts must be specified when no mixed account is configured")
+	}
+	accounts := make([]uint32, 0, len(accountNames))
+	for _, name := range accountNames {
+		account, err := w.AccountNumber(ctx, name)
+		if err != nil {
+			if errors.Is(err, errors.NotExist) {
+				return nil, errAccountNotFound
+			}
+			return nil, err
+		}
+		accounts = append(accounts, account)
+	}
+
+	r, err := w.RecoverMixOutputs(ctx, n, accounts, *cmd.ScanLen, *cmd.StartHeight)
+	if errors.Is(err, errors.Invalid) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	index := func(child uint32) int64 {
+		if child == ^uint32(0) {
+			return -1
+		}
+		return int64(child)
+	}
+	res := &types.RecoverMixOutputsResult{
+		Branches: make([]types.RecoveredMixBranch, 0, len(r.Branches)),
+		Outputs:  make([]types.RecoveredMixOutput, 0, len(r.Outputs)),
+	}
+	for i := range r.Branches {
+		b := &r.Branches[i]
+		name, err := w.AccountName(ctx, b.Account)
+		if err != nil {
+			return nil, err
+		}
+		res.Branches = append(res.Branches, types.RecoveredMixBranch{
+			Account:            name,
+			Branch:             b.Branch,
+			LastUsedBefore:     index(b.LastUsedBefore),
+			LastReturnedBefore: index(b.LastReturnedBefore),
+			LastUsed:           index(b.LastUsed),
+			LastReturned:       index(b.LastReturned),
+		})
+	}
+	for i := range r.Outputs {
+		o := &r.Outputs[i]
+		name, err := w.AccountName(ctx, o.Account)
+		if err != nil {
+			return nil, err
+		}
+		res.Outputs = append(res.Outputs, types.RecoveredMixOutput{
+			TxHash:       o.OutPoint.Hash.String(),
+			Vout:         o.OutPoint.Index,
+			Amount:       o.Amount.ToCoin(),
+			Account:      name,
+			Branch:       o.Branch,
+			Index:        o.Index,
+			Unreferenced: o.Unreferenced,
+		})
+	}
+	return res, nil
+}
+
 // spendOutputsInputSource creates an input source from a wallet and a list of
 // outputs to be spent.  Only the provided outputs will be returned by the
-// source, without any other input selection.
+// source, without any other input selection.  Locked outputs, including held
+// outputs, may not be spent.
 func spendOutputsInputSource(ctx context.Context, w *wallet.Wallet,
 	account string, inputs []*wire.TxIn) (txauthor.InputSource, error) {
 
@@ -4196,6 +5080,11 @@ func spendOutputsInputSource(ctx context.Context, w *wallet.Wallet,
 	detail.Scripts = make([][]byte, len(inputs))
 	detail.RedeemScriptSizes = make([]int, len(inputs))
 	for i, in := range inputs {
+		prev := &in.PreviousOutPoint
+		if w.LockedOutpoint(&prev.Hash, prev.Index) {
+			err := errors.Errorf("output %v is locked", prev)
+			return nil, errors.E(errors.Invalid, err)
+		}
 		prevOut, err := w.FetchOutput(ctx, &in.PreviousOutPoint)
 		if err != nil {
 			return nil, err
@@ -4267,10 +5156,6 @@ func (s *Server) spendOutputs(ctx context.Context, icmd any) (any, error) {
 	if !ok {
 		return nil, errUnloadedWallet
 	}
-	n, err := w.NetworkBackend()
-	if err != nil {
-		return nil, err
-	}
 
 	params := w.ChainParams()
 
@@ -4317,23 +5202,32 @@ func (s *Server) spendOutputs(ctx context.Context, icmd any) (any, error) {
 		account: account,
 	}
 
-	secretsSource, err := w.SecretsSource()
-	if err != nil {
-		return nil, err
-	}
-	defer secretsSource.Close()
-
 	atx, err := txauthor.NewUnsignedTransaction(outputs, w.RelayFee(),
 		inputSource, changeSource, params.MaxTxSize)
 	if err != nil {
 		return nil, err
 	}
 	atx.RandomizeChangePosition()
-	err = atx.AddAllInputScripts(secretsSource)
+
+	// Sign with the wallet so that the spend is subject to the same
+	// policies as any other signed transaction.
+	prevScripts := make(map[wire.OutPoint][]byte, len(atx.Tx.TxIn))
+	for i, in := range atx.Tx.TxIn {
+		prevScripts[in.PreviousOutPoint] = atx.PrevScripts[i]
+	}
+	sigErrs, err := w.SignTransaction(ctx, atx.Tx, txscript.SigHashAll,
+		prevScripts, nil, nil)
 	if err != nil {
 		return nil, err
 	}
+	if len(sigErrs) != 0 {
+		return nil, sigErrs[0].Error
+	}
 
+	n, err := w.NetworkBackend()
+	if err != nil {
+		return nil, err
+	}
 	hash, err := w.PublishTransaction(ctx, atx.Tx, n)
 	if err != nil {
 		return nil, err
@@ -4462,7 +5356,8 @@ func (s *Server) sendFrom(ctx context.Context, icmd any) (any, error) {
 		cmd.ToAddress: amt,
 	}
 
-	return s.sendPairs(ctx, w, pairs, account, minConf)
+	return s.sendPairs(ctx, w, pairs, account, minConf, cmd.IdempotencyKey,
+		cmd.DestinationOverride, cmd.ExpiryBlocks, cmd)
 }
 
 // sendMany handles a sendmany RPC request by creating a new transaction
@@ -4504,7 +5399,8 @@ func (s *Server) sendMany(ctx context.Context, icmd any) (any, error) {
 		pairs[k] = amt
 	}
 
-	return s.sendPairs(ctx, w, pairs, account, minConf)
+	return s.sendPairs(ctx, w, pairs, account, minConf, cmd.IdempotencyKey,
+		cmd.DestinationOverride, cmd.ExpiryBlocks, cmd)
 }
 
 // sendToAddress handles a sendtoaddress RPC request by creating a new
@@ -4541,7 +5437,8 @@ func (s *Server) sendToAddress(ctx context.Context, icmd any) (any, error) {
 	}
 
 	// sendtoaddress always spends from the default account, this matches bitcoind
-	return s.sendPairs(ctx, w, pairs, udb.DefaultAccountNum, 1)
+	return s.sendPairs(ctx, w, pairs, udb.DefaultAccountNum, 1,
+		cmd.IdempotencyKey, cmd.DestinationOverride, cmd.ExpiryBlocks, cmd)
 }
 
 // sendToMultiSig handles a sendtomultisig RPC request by creating a new
@@ -4653,8 +5550,16 @@ func (s *Server) sendToTreasury(ctx context.Context, icmd any) (any, error) {
 		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative amount")
 	}
 
-	// sendtotreasury always spends from the default account.
-	return s.sendAmountToTreasury(ctx, w, amt, udb.DefaultAccountNum, 1)
+	account, err := w.AccountNumber(ctx, *cmd.FromAccount)
+	if err != nil {
+		return nil, err
+	}
+	minConf := int32(*cmd.MinConf)
+	if minConf < 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative minconf")
+	}
+
+	return s.sendAmountToTreasury(ctx, w, amt, account, minConf)
 }
 
 // transaction spending treasury balance.
@@ -4686,6 +5591,10 @@ func (s *Server) setTxFee(ctx context.Context, icmd any) (any, error) {
 	if err != nil {
 		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
 	}
+	if maxFeeRate := w.MaxFeeRate(); maxFeeRate != 0 && relayFee > maxFeeRate {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"fee %v exceeds maximum fee rate %v", relayFee, maxFeeRate)
+	}
 	w.SetRelayFee(relayFee)
 
 	// A boolean true result is returned upon success.
@@ -5214,518 +6123,3396 @@ func (s *Server) sweepAccount(ctx context.Context, icmd any) (any, error) {
 	return res, nil
 }
 
-// validateAddress handles the validateaddress command.
-func (s *Server) validateAddress(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.ValidateAddressCmd)
+// reserveFunds handles the reservefunds command.
+func (s *Server) reserveFunds(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ReserveFundsCmd)
 	w, ok := s.walletLoader.LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	result := types.ValidateAddressResult{}
-	addr, err := decodeAddress(cmd.Address, w.ChainParams())
+	account, err := w.AccountNumber(ctx, cmd.Account)
 	if err != nil {
-		result.Script = stdscript.STNonStandard.String()
-		// Use result zero value (IsValid=false).
-		return result, nil
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
 	}
-
-	result.Address = addr.String()
-	result.IsValid = true
-	ver, scr := addr.PaymentScript()
-	class, _ := stdscript.ExtractAddrs(ver, scr, w.ChainParams())
-	result.Script = class.String()
-	if pker, ok := addr.(stdaddr.SerializedPubKeyer); ok {
-		result.PubKey = hex.EncodeToString(pker.SerializedPubKey())
-		result.PubKeyAddr = addr.String()
+	amount, err := dcrutil.NewAmount(cmd.Amount)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
 	}
-	if class == stdscript.STScriptHash {
-		result.IsScript = true
+	if amount <= 0 {
+		return nil, errNeedPositiveAmount
 	}
-	if _, ok := addr.(stdaddr.Hash160er); ok {
-		result.IsCompressed = true
+	minConf := int32(*cmd.MinConf)
+	if minConf < 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative minconf")
 	}
 
-	ka, err := w.KnownAddress(ctx, addr)
+	r, err := w.ReserveFunds(ctx, cmd.ID, account, amount, minConf)
 	if err != nil {
-		if errors.Is(err, errors.NotExist) {
-			// No additional information available about the address.
-			return result, nil
+		switch {
+		case errors.Is(err, errors.InsufficientBalance):
+			return nil, rpcError(dcrjson.ErrRPCWalletInsufficientFunds, err)
+		case errors.Is(err, errors.Exist), errors.Is(err, errors.Invalid):
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
 		}
 		return nil, err
 	}
+	return marshalFundsReservation(r, cmd.Account), nil
+}
 
-	// The address lookup was successful which means there is further
-	// information about it available and it is "mine".
-	result.IsMine = true
-	result.Account = ka.AccountName()
+func marshalFundsReservation(r *wallet.FundsReservation, accountName string) *types.FundsReservationResult {
+	outpoints := make([]string, len(r.Outpoints))
+	for i := range r.Outpoints {
+		outpoints[i] = r.Outpoints[i].String()
+	}
+	return &types.FundsReservationResult{
+		ID:        r.ID,
+		Account:   accountName,
+		Outpoints: outpoints,
+		Amount:    r.Amount.ToCoin(),
+	}
+}
 
-	switch ka := ka.(type) {
-	case wallet.PubKeyHashAddress:
-		pubKey := ka.PubKey()
-		result.PubKey = hex.EncodeToString(pubKey)
-		pubKeyAddr, err := stdaddr.NewAddressPubKeyEcdsaSecp256k1V0Raw(pubKey, w.ChainParams())
+// releaseFunds handles the releasefunds command.
+func (s *Server) releaseFunds(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ReleaseFundsCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	err := w.ReleaseFunds(ctx, cmd.ID)
+	if errors.Is(err, errors.NotExist) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return nil, err
+}
+
+// listFundsReservations handles the listfundsreservations command.
+func (s *Server) listFundsReservations(ctx context.Context, icmd any) (any, error) {
+	_ = icmd.(*types.ListFundsReservationsCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	reservations := w.FundsReservations()
+	res := make([]*types.FundsReservationResult, 0, len(reservations))
+	for i := range reservations {
+		r := &reservations[i]
+		accountName, err := w.AccountName(ctx, r.Account)
 		if err != nil {
 			return nil, err
 		}
-		result.PubKeyAddr = pubKeyAddr.String()
-	case wallet.P2SHAddress:
-		version, script := ka.RedeemScript()
-		result.Hex = hex.EncodeToString(script)
+		res = append(res, marshalFundsReservation(r, accountName))
+	}
+	return res, nil
+}
+
+// holdError converts errors returned when placing holds.
+func holdError(err error) error {
+	switch {
+	case errors.Is(err, errors.InsufficientBalance):
+		return rpcError(dcrjson.ErrRPCWalletInsufficientFunds, err)
+	case errors.Is(err, errors.Exist), errors.Is(err, errors.Invalid),
+		errors.Is(err, errors.NotExist):
+		return rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return err
+}
+
+// holdOutputs handles the holdoutputs command.
+func (s *Server) holdOutputs(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.HoldOutputsCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	outpoints := make([]wire.OutPoint, 0, len(cmd.Outpoints))
+	for _, o := range cmd.Outpoints {
+		op, err := parseOutpoint(o)
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		outpoints = append(outpoints, *op)
+	}
+
+	h, err := w.HoldOutputs(ctx, cmd.ID, cmd.Reason, outpoints)
+	if err != nil {
+		return nil, holdError(err)
+	}
+	accountName, err := w.AccountName(ctx, h.Account)
+	if err != nil {
+		return nil, err
+	}
+	return marshalHold(h, accountName), nil
+}
+
+// holdAmount handles the holdamount command.
+func (s *Server) holdAmount(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.HoldAmountCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	amount, err := dcrutil.NewAmount(cmd.Amount)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	if amount <= 0 {
+		return nil, errNeedPositiveAmount
+	}
+	minConf := int32(*cmd.MinConf)
+	if minConf < 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative minconf")
+	}
+
+	h, err := w.HoldAmount(ctx, cmd.ID, cmd.Reason, account, amount, minConf)
+	if err != nil {
+		return nil, holdError(err)
+	}
+	return marshalHold(h, cmd.Account), nil
+}
+
+func marshalHold(h *wallet.Hold, accountName string) *types.HoldResult {
+	outpoints := make([]string, len(h.Outpoints))
+	for i := range h.Outpoints {
+		outpoints[i] = h.Outpoints[i].String()
+	}
+	return &types.HoldResult{
+		ID:        h.ID,
+		Account:   accountName,
+		Amount:    h.Amount.ToCoin(),
+		Held:      h.Held.ToCoin(),
+		Reason:    h.Reason,
+		Created:   h.Created.Unix(),
+		Outpoints: outpoints,
+	}
+}
+
+// listHolds handles the listholds command.
+func (s *Server) listHolds(ctx context.Context, icmd any) (any, error) {
+	_ = icmd.(*types.ListHoldsCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	holds, err := w.Holds(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*types.HoldResult, 0, len(holds))
+	for i := range holds {
+		h := &holds[i]
+		accountName, err := w.AccountName(ctx, h.Account)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, marshalHold(h, accountName))
+	}
+	return res, nil
+}
+
+// releaseHold handles the releasehold command.
+func (s *Server) releaseHold(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ReleaseHoldCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	err := w.ReleaseHold(ctx, cmd.ID)
+	if errors.Is(err, errors.NotExist) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return nil, err
+}
+
+// getReservedBalance handles the getreservedbalance command.  The available
+// balance is the spendable balance less all outputs reserved for orders.
+func (s *Server) getReservedBalance(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetReservedBalanceCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	minConf := int32(*cmd.MinConf)
+	if minConf < 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative minconf")
+	}
+
+	bal, err := w.AccountBalance(ctx, account, minConf)
+	if err != nil {
+		return nil, err
+	}
+	reserved := w.ReservedBalance(account)
+	available := bal.Spendable - reserved
+	if available < 0 {
+		// Reserved outputs may have fewer confirmations than the
+		// minconf used to calculate the spendable balance.
+		available = 0
+	}
+	return &types.GetReservedBalanceResult{
+		Spendable: bal.Spendable.ToCoin(),
+		Reserved:  reserved.ToCoin(),
+		Available: available.ToCoin(),
+	}, nil
+}
+
+// createSwapContract handles the createswapcontract command.
+func (s *Server) createSwapContract(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.CreateSwapContractCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	recipient, err := decodeAddress(cmd.Recipient, w.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+	secretHash, err := decodeHexStr(cmd.SecretHash)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := w.NewSwapContract(ctx, account, recipient, secretHash, cmd.LockTime)
+	if err != nil {
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	return &types.CreateSwapContractResult{
+		Contract:      hex.EncodeToString(c.Contract),
+		Address:       c.Address.String(),
+		RefundAddress: c.RefundAddress.String(),
+		LockTime:      c.LockTime,
+	}, nil
+}
+
+// decodeSwapArgs decodes the account, contract transaction, and contract
+// parameters shared by the redeemswap and refundswap commands.
+func decodeSwapArgs(ctx context.Context, w *wallet.Wallet, accountName, contractTxHex,
+	contractHex string) (uint32, *wire.MsgTx, []byte, error) {
+
+	account, err := w.AccountNumber(ctx, accountName)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return 0, nil, nil, errAccountNotFound
+		}
+		return 0, nil, nil, err
+	}
+	contractTx := new(wire.MsgTx)
+	err = contractTx.Deserialize(hex.NewDecoder(strings.NewReader(contractTxHex)))
+	if err != nil {
+		return 0, nil, nil, rpcError(dcrjson.ErrRPCDeserialization, err)
+	}
+	contract, err := decodeHexStr(contractHex)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	return account, contractTx, contract, nil
+}
+
+// redeemSwap handles the redeemswap command.
+func (s *Server) redeemSwap(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.RedeemSwapCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, contractTx, contract, err := decodeSwapArgs(ctx, w, cmd.Account,
+		cmd.ContractTx, cmd.Contract)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := decodeHexStr(cmd.Secret)
+	if err != nil {
+		return nil, err
+	}
+	n, err := w.NetworkBackend()
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := w.RedeemSwap(ctx, n, account, contractTx, contract, secret)
+	if err != nil {
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	return hash.String(), nil
+}
+
+// refundSwap handles the refundswap command.
+func (s *Server) refundSwap(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.RefundSwapCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, contractTx, contract, err := decodeSwapArgs(ctx, w, cmd.Account,
+		cmd.ContractTx, cmd.Contract)
+	if err != nil {
+		return nil, err
+	}
+	n, err := w.NetworkBackend()
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := w.RefundSwap(ctx, n, account, contractTx, contract)
+	if err != nil {
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	return hash.String(), nil
+}
+
+// addWebhook handles the addwebhook command.
+func (s *Server) addWebhook(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.AddWebhookCmd)
+	if s.cfg.Webhooks == nil {
+		return nil, errWebhooksDisabled
+	}
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	var filter webhook.Filter
+	if cmd.Account != nil {
+		account, err := w.AccountNumber(ctx, *cmd.Account)
+		if err != nil {
+			if errors.Is(err, errors.NotExist) {
+				return nil, errAccountNotFound
+			}
+			return nil, err
+		}
+		filter.Account = &account
+	}
+	if cmd.Address != nil {
+		addr, err := decodeAddress(*cmd.Address, w.ChainParams())
+		if err != nil {
+			return nil, err
+		}
+		filter.Address = addr.String()
+	}
+	if cmd.MinAmount != nil {
+		amount, err := dcrutil.NewAmount(*cmd.MinAmount)
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		filter.MinAmount = amount
+	}
+
+	h, err := s.cfg.Webhooks.Register(cmd.URL, filter)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	res, err := marshalWebhook(ctx, w, h)
+	if err != nil {
+		return nil, err
+	}
+	res.Secret = hex.EncodeToString(h.Secret)
+	return res, nil
+}
+
+func marshalWebhook(ctx context.Context, w *wallet.Wallet, h *webhook.Hook) (*types.WebhookResult, error) {
+	res := &types.WebhookResult{
+		ID:      h.ID,
+		URL:     h.URL,
+		Address: h.Filter.Address,
+	}
+	if h.Filter.Account != nil {
+		name, err := w.AccountName(ctx, *h.Filter.Account)
+		if err != nil {
+			return nil, err
+		}
+		res.Account = name
+	}
+	if h.Filter.MinAmount != 0 {
+		minAmount := h.Filter.MinAmount.ToCoin()
+		res.MinAmount = &minAmount
+	}
+	return res, nil
+}
+
+// removeWebhook handles the removewebhook command.
+func (s *Server) removeWebhook(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.RemoveWebhookCmd)
+	if s.cfg.Webhooks == nil {
+		return nil, errWebhooksDisabled
+	}
+
+	err := s.cfg.Webhooks.Unregister(cmd.ID)
+	if errors.Is(err, errors.NotExist) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return nil, err
+}
+
+// listWebhooks handles the listwebhooks command.
+func (s *Server) listWebhooks(ctx context.Context, icmd any) (any, error) {
+	_ = icmd.(*types.ListWebhooksCmd)
+	if s.cfg.Webhooks == nil {
+		return nil, errWebhooksDisabled
+	}
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	hooks := s.cfg.Webhooks.Hooks()
+	res := make([]*types.WebhookResult, 0, len(hooks))
+	for i := range hooks {
+		r, err := marshalWebhook(ctx, w, &hooks[i])
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, r)
+	}
+	return res, nil
+}
+
+// importTreasuryKey handles the importtreasurykey command by importing a
+// treasury spend signing key, encoded as WIF or hex, for use by signtspend.
+func (s *Server) importTreasuryKey(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ImportTreasuryKeyCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	var privKey []byte
+	if wif, err := dcrutil.DecodeWIF(cmd.PrivKey, w.ChainParams().PrivateKeyID); err == nil {
+		privKey = wif.PrivKey()
+	} else {
+		privKey, err = hex.DecodeString(cmd.PrivKey)
+		if err != nil {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidAddressOrKey,
+				"private key is not WIF or hex encoded")
+		}
+	}
+	defer clear(privKey)
+
+	pubKey, err := w.ImportTreasuryKey(ctx, cmd.Name, privKey)
+	if err != nil {
+		if errors.Is(err, errors.Locked) {
+			return nil, errWalletUnlockNeeded
+		}
+		return nil, err
+	}
+	return hex.EncodeToString(pubKey), nil
+}
+
+// listTreasuryKeys handles the listtreasurykeys command.
+func (s *Server) listTreasuryKeys(ctx context.Context, icmd any) (any, error) {
+	_ = icmd.(*types.ListTreasuryKeysCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	keys, err := w.TreasuryKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]types.TreasuryKeyResult, 0, len(keys))
+	for i := range keys {
+		res = append(res, types.TreasuryKeyResult{
+			PubKey:  hex.EncodeToString(keys[i].PubKey),
+			Name:    keys[i].Name,
+			Created: keys[i].Created.Unix(),
+		})
+	}
+	return res, nil
+}
+
+// removeTreasuryKey handles the removetreasurykey command.
+func (s *Server) removeTreasuryKey(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.RemoveTreasuryKeyCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	pubKey, err := hex.DecodeString(cmd.PubKey)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+	}
+	err = w.RemoveTreasuryKey(ctx, pubKey)
+	if errors.Is(err, errors.NotExist) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	if errors.Is(err, errors.Locked) {
+		return nil, errWalletUnlockNeeded
+	}
+	return nil, err
+}
+
+// signTSpend handles the signtspend command by signing a treasury spend with
+// an imported treasury key and returning the signed transaction.
+func (s *Server) signTSpend(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SignTSpendCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	tx := new(wire.MsgTx)
+	err := tx.Deserialize(hex.NewDecoder(strings.NewReader(cmd.Tx)))
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCDeserialization, err)
+	}
+	pubKey, err := hex.DecodeString(cmd.PubKey)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+	}
+
+	err = w.SignTSpend(ctx, tx, pubKey)
+	if err != nil {
+		switch {
+		case errors.Is(err, errors.Locked):
+			return nil, errWalletUnlockNeeded
+		case errors.Is(err, errors.NotExist):
+			return nil, rpcError(dcrjson.ErrRPCInvalidAddressOrKey, err)
+		}
+		return nil, err
+	}
+
+	var b strings.Builder
+	b.Grow(2 * tx.SerializeSize())
+	err = tx.Serialize(hex.NewEncoder(&b))
+	if err != nil {
+		return nil, err
+	}
+	return b.String(), nil
+}
+
+// validateAddress handles the validateaddress command.
+func (s *Server) validateAddress(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ValidateAddressCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	result := types.ValidateAddressResult{}
+	addr, err := decodeAddress(cmd.Address, w.ChainParams())
+	if err != nil {
+		result.Script = stdscript.STNonStandard.String()
+		// Use result zero value (IsValid=false).
+		return result, nil
+	}
+
+	result.Address = addr.String()
+	result.IsValid = true
+	ver, scr := addr.PaymentScript()
+	class, _ := stdscript.ExtractAddrs(ver, scr, w.ChainParams())
+	result.Script = class.String()
+	if pker, ok := addr.(stdaddr.SerializedPubKeyer); ok {
+		result.PubKey = hex.EncodeToString(pker.SerializedPubKey())
+		result.PubKeyAddr = addr.String()
+	}
+	if class == stdscript.STScriptHash {
+		result.IsScript = true
+	}
+	if _, ok := addr.(stdaddr.Hash160er); ok {
+		result.IsCompressed = true
+	}
+
+	ka, err := w.KnownAddress(ctx, addr)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			// No additional information available about the address.
+			return result, nil
+		}
+		return nil, err
+	}
+
+	// The address lookup was successful which means there is further
+	// information about it available and it is "mine".
+	result.IsMine = true
+	result.Account = ka.AccountName()
+
+	switch ka := ka.(type) {
+	case wallet.PubKeyHashAddress:
+		pubKey := ka.PubKey()
+		result.PubKey = hex.EncodeToString(pubKey)
+		pubKeyAddr, err := stdaddr.NewAddressPubKeyEcdsaSecp256k1V0Raw(pubKey, w.ChainParams())
+		if err != nil {
+			return nil, err
+		}
+		result.PubKeyAddr = pubKeyAddr.String()
+	case wallet.P2SHAddress:
+		version, script := ka.RedeemScript()
+		result.Hex = hex.EncodeToString(script)
 
 		class, addrs := stdscript.ExtractAddrs(version, script, w.ChainParams())
 		addrStrings := make([]string, len(addrs))
 		for i, a := range addrs {
 			addrStrings[i] = a.String()
 		}
-		result.Addresses = addrStrings
-		result.Script = class.String()
+		result.Addresses = addrStrings
+		result.Script = class.String()
+
+		// Multi-signature scripts also provide the number of required
+		// signatures.
+		if class == stdscript.STMultiSig {
+			result.SigsRequired = int32(stdscript.DetermineRequiredSigs(version, script))
+		}
+	}
+
+	if ka, ok := ka.(wallet.BIP0044Address); ok {
+		acct, branch, child := ka.Path()
+		if ka.AccountKind() != wallet.AccountKindImportedXpub {
+			result.AccountN = &acct
+		}
+		result.Branch = &branch
+		result.Index = &child
+	}
+
+	return result, nil
+}
+
+// validatePreDCP0005CF handles the validatepredcp0005cf command.
+func (s *Server) validatePreDCP0005CF(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	err := w.ValidatePreDCP0005CFilters(ctx)
+	return err == nil, err
+}
+
+// verifyMessage handles the verifymessage command by verifying the provided
+// compact signature for the given address and message.
+func (s *Server) verifyMessage(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.VerifyMessageCmd)
+
+	var valid bool
+
+	// Decode address and base64 signature from the request.
+	addr, err := stdaddr.DecodeAddress(cmd.Address, s.activeNet)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := base64.StdEncoding.DecodeString(cmd.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	// Addresses must have an associated secp256k1 private key and must be P2PKH
+	// (P2PK and P2SH is not allowed).
+	switch addr.(type) {
+	case *stdaddr.AddressPubKeyHashEcdsaSecp256k1V0:
+	default:
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"address must be secp256k1 pay-to-pubkey-hash")
+	}
+
+	valid, err = wallet.VerifyMessage(cmd.Message, addr, sig, s.activeNet)
+	// Mirror Bitcoin Core behavior, which treats all erorrs as an invalid
+	// signature.
+	return err == nil && valid, nil
+}
+
+// version handles the version command by returning the RPC API versions of the
+// wallet and, optionally, the consensus RPC server as well if it is associated
+// with the server.  The chainClient is optional, and this is simply a helper
+// function for the versionWithChainRPC and versionNoChainRPC handlers.
+func (s *Server) version(ctx context.Context, icmd any) (any, error) {
+	resp := make(map[string]dcrdtypes.VersionResult)
+	n, _ := s.walletLoader.NetworkBackend()
+	if chainSyncer, ok := n.(*chain.Syncer); ok {
+		err := chainSyncer.RPC().Call(ctx, "version", &resp)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp["dcrwallet"] = dcrdtypes.VersionResult{
+		VersionString: version.String(),
+		Major:         version.Major,
+		Minor:         version.Minor,
+		Patch:         version.Patch,
+		Prerelease:    version.PreRelease,
+		BuildMetadata: version.BuildMetadata,
+	}
+	resp["dcrwalletjsonrpcapi"] = dcrdtypes.VersionResult{
+		VersionString: jsonrpcSemverString,
+		Major:         jsonrpcSemverMajor,
+		Minor:         jsonrpcSemverMinor,
+		Patch:         jsonrpcSemverPatch,
+	}
+	return resp, nil
+}
+
+// getCapabilities handles the getcapabilities command by describing the API
+// version, supported methods, and enabled subsystems of the server and any
+// loaded wallet.
+func (s *Server) getCapabilities(ctx context.Context, icmd any) (any, error) {
+	var serverFeatures []string
+	if s.cfg.PendingSpends {
+		serverFeatures = append(serverFeatures, "pendingspends")
+	}
+	if s.cfg.TicketBuyerEnabled {
+		serverFeatures = append(serverFeatures, "ticketbuyer")
+	}
+	if s.cfg.Webhooks != nil {
+		serverFeatures = append(serverFeatures, "webhooks")
+	}
+	caps := s.walletLoader.Capabilities(serverFeatures...)
+	return &types.GetCapabilitiesResult{
+		APIVersion:   jsonrpcSemverString,
+		Version:      version.String(),
+		WalletLoaded: caps.WalletLoaded,
+		SyncMode:     caps.SyncMode,
+		Features:     caps.Features,
+		Methods:      supportedMethods,
+	}, nil
+}
+
+// walletInfo gets the current information about the wallet. If the daemon
+// is connected and fails to ping, the function will still return that the
+// daemon is disconnected.
+func (s *Server) walletInfo(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	var connected, spvMode bool
+	switch n, _ := w.NetworkBackend(); syncer := n.(type) {
+	case *spv.Syncer:
+		spvMode = true
+		connected = len(syncer.GetRemotePeers()) > 0
+	case *chain.Syncer:
+		err := syncer.RPC().Call(ctx, "ping", nil)
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if err != nil {
+			log.Warnf("Ping failed on connected daemon client: %v", err)
+		} else {
+			connected = true
+		}
+	case nil:
+		log.Warnf("walletInfo - no network backend")
+	default:
+		log.Errorf("walletInfo - invalid network backend (%T).", n)
+		return nil, &dcrjson.RPCError{
+			Code:    dcrjson.ErrRPCMisc,
+			Message: "invalid network backend",
+		}
+	}
+
+	coinType, err := w.CoinType(ctx)
+	if errors.Is(err, errors.WatchingOnly) {
+		// This is a watching-only wallet, which does not store the active coin
+		// type. Return CoinTypes default value (0), which will be omitted from
+		// the JSON response, and log a debug message.
+		log.Debug("Watching only wallets do not store the coin type keys.")
+	} else if err != nil {
+		log.Errorf("Failed to retrieve the active coin type: %v", err)
+		coinType = 0
+	}
+
+	unlocked := !(w.Locked())
+	fi := w.RelayFee()
+	voteBits := w.VoteBits()
+	var voteVersion uint32
+	_ = binary.Read(bytes.NewBuffer(voteBits.ExtendedBits[0:4]), binary.LittleEndian, &voteVersion)
+	voting := w.VotingEnabled()
+
+	wi := &types.WalletInfoResult{
+		DaemonConnected:  connected,
+		SPV:              spvMode,
+		Unlocked:         unlocked,
+		CoinType:         coinType,
+		TxFee:            fi.ToCoin(),
+		MaxFeeRate:       w.MaxFeeRate().ToCoin(),
+		VoteBits:         voteBits.Bits,
+		VoteBitsExtended: hex.EncodeToString(voteBits.ExtendedBits),
+		VoteVersion:      voteVersion,
+		Voting:           voting,
+		VSP:              s.cfg.VSPHost,
+		ManualTickets:    w.ManualTickets(),
+		LockMode:         w.LockMode().String(),
+	}
+	if c := w.InstanceConflict(); c != nil {
+		wi.InstanceConflict = c.String()
+	}
+
+	birthState, err := w.BirthState(ctx)
+	if err != nil {
+		log.Errorf("Failed to get birth state: %v", err)
+	} else if birthState != nil &&
+		!(birthState.SetFromTime || birthState.SetFromHeight) {
+		wi.BirthHash = birthState.Hash.String()
+		wi.BirthHeight = birthState.Height
+	}
+
+	return wi, nil
+}
+
+// walletIsLocked handles the walletislocked extension request by
+// returning the current lock state (false for unlocked, true for locked)
+// of an account.
+func (s *Server) walletIsLocked(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	return w.Locked(), nil
+}
+
+// walletLock handles a walletlock request by locking the all account
+// wallets, returning an error if any wallet is not encrypted (for example,
+// a watching-only wallet).
+func (s *Server) walletLock(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	w.Lock()
+	return nil, nil
+}
+
+// setLockMode handles the setlockmode command by selecting whether locking
+// the wallet also locks unlocked imported voting accounts.
+func (s *Server) setLockMode(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SetLockModeCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	mode, err := wallet.ParseLockMode(cmd.Mode)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	if w.WatchingOnly() {
+		return nil, errors.E(errors.WatchingOnly)
+	}
+	return nil, w.SetLockMode(mode)
+}
+
+// getIdleLock handles the getidlelock command by returning the idle lock
+// timeout and the remaining time before the wallet is locked due to
+// inactivity.
+func (s *Server) getIdleLock(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	remaining, active := w.IdleLockRemaining()
+	return &types.IdleLockResult{
+		Timeout:   int64(w.IdleLockTimeout() / time.Second),
+		Active:    active,
+		Remaining: int64(remaining.Round(time.Second) / time.Second),
+	}, nil
+}
+
+// walletPassphrase responds to the walletpassphrase request by unlocking the
+// wallet. The decryption key is saved in the wallet until timeout seconds
+// expires, after which the wallet is locked. A timeout of 0 leaves the wallet
+// unlocked indefinitely.
+func (s *Server) walletPassphrase(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.WalletPassphraseCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	timeout := time.Second * time.Duration(cmd.Timeout)
+	var unlockAfter <-chan time.Time
+	if timeout != 0 {
+		unlockAfter = time.After(timeout)
+	}
+	err := w.Unlock(ctx, []byte(cmd.Passphrase), unlockAfter)
+	return nil, err
+}
+
+// walletPassphraseChange responds to the walletpassphrasechange request
+// by unlocking all accounts with the provided old passphrase, and
+// re-encrypting each private key with an AES key derived from the new
+// passphrase.
+//
+// If the old passphrase is correct and the passphrase is changed, all
+// wallets will be immediately locked.
+func (s *Server) walletPassphraseChange(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.WalletPassphraseChangeCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	err := w.ChangePrivatePassphrase(ctx, []byte(cmd.OldPassphrase),
+		[]byte(cmd.NewPassphrase))
+	if err != nil {
+		if errors.Is(err, errors.Passphrase) {
+			return nil, rpcErrorf(dcrjson.ErrRPCWalletPassphraseIncorrect, "incorrect passphrase")
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
+func (s *Server) mixOutput(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.MixOutputCmd)
+	if !s.cfg.MixingEnabled {
+		return nil, errors.E("Mixing is not configured")
+	}
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	outpoint, err := parseOutpoint(cmd.Outpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	mixAccount, err := w.AccountNumber(ctx, s.cfg.MixAccount)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	changeAccount, err := w.AccountNumber(ctx, s.cfg.MixChangeAccount)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+
+	mixBranch := s.cfg.MixBranch
+
+	err = w.MixOutput(ctx, outpoint, changeAccount, mixAccount, mixBranch)
+	return nil, err
+}
+
+func (s *Server) mixAccount(ctx context.Context, icmd any) (any, error) {
+	if !s.cfg.MixingEnabled {
+		return nil, errors.E("Mixing is not configured")
+	}
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	mixAccount, err := w.AccountNumber(ctx, s.cfg.MixAccount)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	changeAccount, err := w.AccountNumber(ctx, s.cfg.MixChangeAccount)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+
+	mixBranch := s.cfg.MixBranch
+
+	err = w.MixAccount(ctx, changeAccount, mixAccount, mixBranch)
+	return nil, err
+}
+
+// previewMixAccount handles the previewmixaccount command by reporting how
+// the eligible outputs of the mixing change account would be split by the next
+// mixaccount, without mixing them.
+func (s *Server) previewMixAccount(ctx context.Context, icmd any) (any, error) {
+	if !s.cfg.MixingEnabled {
+		return nil, errors.E("Mixing is not configured")
+	}
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	changeAccount, err := w.AccountNumber(ctx, s.cfg.MixChangeAccount)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+
+	previews, err := w.PreviewMixAccount(ctx, changeAccount)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]types.MixSplitPreviewResult, 0, len(previews))
+	for i := range previews {
+		p := &previews[i]
+		res = append(res, types.MixSplitPreviewResult{
+			Outpoint:     p.OutPoint.String(),
+			Amount:       p.Amount.ToCoin(),
+			Denomination: p.Denomination.ToCoin(),
+			Count:        p.Count,
+			Change:       p.Change.ToCoin(),
+			Fee:          p.Fee.ToCoin(),
+		})
+	}
+	return res, nil
+}
+
+func parseOutpoint(s string) (*wire.OutPoint, error) {
+	const op errors.Op = "parseOutpoint"
+	if len(s) < 66 {
+		return nil, errors.E(op, "bad len")
+	}
+	if s[64] != ':' { // sep follows 32 bytes of hex
+		return nil, errors.E(op, "bad separator")
+	}
+	hash, err := chainhash.NewHashFromStr(s[:64])
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	index, err := strconv.ParseUint(s[65:], 10, 32)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return &wire.OutPoint{Hash: *hash, Index: uint32(index)}, nil
+}
+
+// walletPubPassphraseChange responds to the walletpubpassphrasechange request
+// by modifying the public passphrase of the wallet.
+func (s *Server) walletPubPassphraseChange(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.WalletPubPassphraseChangeCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	err := w.ChangePublicPassphrase(ctx, []byte(cmd.OldPassphrase),
+		[]byte(cmd.NewPassphrase))
+	if errors.Is(errors.Passphrase, err) {
+		return nil, rpcErrorf(dcrjson.ErrRPCWalletPassphraseIncorrect, "incorrect passphrase")
+	}
+	return nil, err
+}
+
+func (s *Server) setAccountPassphrase(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SetAccountPassphraseCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	err = w.SetAccountPassphrase(ctx, account, []byte(cmd.Passphrase))
+	return nil, err
+}
+
+func (s *Server) accountUnlocked(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.AccountUnlockedCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+
+	encrypted, err := w.AccountHasPassphrase(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	if !encrypted {
+		return &types.AccountUnlockedResult{}, nil
+	}
+
+	unlocked, err := w.AccountUnlocked(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.AccountUnlockedResult{
+		Encrypted: true,
+		Unlocked:  &unlocked,
+	}, nil
+}
+
+func (s *Server) unlockAccount(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.UnlockAccountCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	err = w.UnlockAccount(ctx, account, []byte(cmd.Passphrase))
+	return nil, err
+}
+
+func (s *Server) lockAccount(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.LockAccountCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	if cmd.Account == "*" {
+		a, err := w.Accounts(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, acct := range a.Accounts {
+			if acct.AccountEncrypted && acct.AccountUnlocked {
+				err = w.LockAccount(ctx, acct.AccountNumber)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+		return nil, nil
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	err = w.LockAccount(ctx, account)
+	return nil, err
+}
+
+// setMixedSpendPolicy handles the setmixedspendpolicy command.
+func (s *Server) setMixedSpendPolicy(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SetMixedSpendPolicyCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	err = w.SetMixedSpendPolicy(ctx, account, *cmd.Branch)
+	if errors.Is(err, errors.Invalid) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return nil, err
+}
+
+// clearMixedSpendPolicy handles the clearmixedspendpolicy command.
+func (s *Server) clearMixedSpendPolicy(ctx context.Context, icmd any) (any, error) {
+	_ = icmd.(*types.ClearMixedSpendPolicyCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	return nil, w.ClearMixedSpendPolicy(ctx)
+}
+
+// getMixedSpendPolicy handles the getmixedspendpolicy command.
+func (s *Server) getMixedSpendPolicy(ctx context.Context, icmd any) (any, error) {
+	_ = icmd.(*types.GetMixedSpendPolicyCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	p := w.MixedSpendPolicy()
+	if p == nil {
+		return &types.GetMixedSpendPolicyResult{}, nil
+	}
+	accountName, err := w.AccountName(ctx, p.Account)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GetMixedSpendPolicyResult{
+		Enabled: true,
+		Account: accountName,
+		Branch:  p.Branch,
+	}, nil
+}
+
+// setDestinationPolicy handles the setdestinationpolicy command.
+func (s *Server) setDestinationPolicy(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SetDestinationPolicyCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+
+	// A policy without an allowlist or denied destinations removes any
+	// restriction.
+	var p *udb.DestinationPolicy
+	if *cmd.Allowlist || (cmd.Denied != nil && len(*cmd.Denied) != 0) {
+		p = &udb.DestinationPolicy{Allowlist: *cmd.Allowlist}
+		if cmd.Allowed != nil {
+			p.Allowed = *cmd.Allowed
+		}
+		if cmd.Denied != nil {
+			p.Denied = *cmd.Denied
+		}
+	}
+	var credential []byte
+	if cmd.Credential != nil {
+		credential = []byte(*cmd.Credential)
+	}
+	err = w.SetDestinationPolicy(ctx, account, p, credential)
+	switch {
+	case errors.Is(err, errors.Invalid):
+		return nil, rpcError(dcrjson.ErrRPCInvalidAddressOrKey, err)
+	case errors.Is(err, errors.Locked):
+		return nil, errWalletUnlockNeeded
+	case errors.Is(err, errors.Permission):
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return nil, err
+}
+
+// getAccountCryptoParams handles the getaccountcryptoparams command.
+func (s *Server) getAccountCryptoParams(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetAccountCryptoParamsCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	type account struct {
+		name   string
+		number uint32
+	}
+	var accounts []account
+	if cmd.Account != nil {
+		number, err := w.AccountNumber(ctx, *cmd.Account)
+		if err != nil {
+			if errors.Is(err, errors.NotExist) {
+				return nil, errAccountNotFound
+			}
+			return nil, err
+		}
+		accounts = append(accounts, account{*cmd.Account, number})
+	} else {
+		res, err := w.Accounts(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range res.Accounts {
+			a := &res.Accounts[i]
+			accounts = append(accounts, account{a.AccountName, a.AccountNumber})
+		}
+	}
+
+	res := make([]types.AccountCryptoParamsResult, 0, len(accounts))
+	for _, a := range accounts {
+		p, err := w.AccountCryptoParams(ctx, a.number)
+		if err != nil {
+			return nil, err
+		}
+		r := types.AccountCryptoParamsResult{
+			AccountName:   a.name,
+			AccountNumber: a.number,
+			Encryption:    p.Encryption,
+		}
+		if p.Scrypt != nil {
+			r.ScryptN = p.Scrypt.N
+			r.ScryptR = p.Scrypt.R
+			r.ScryptP = p.Scrypt.P
+		}
+		if p.Argon2id != nil {
+			r.Argon2idTime = p.Argon2id.Time
+			r.Argon2idMemory = p.Argon2id.Memory
+			r.Argon2idThreads = p.Argon2id.Threads
+		}
+		if !p.PassphraseChanged.IsZero() {
+			r.PassphraseChanged = p.PassphraseChanged.Unix()
+		}
+		res = append(res, r)
+	}
+	return res, nil
+}
+
+// getDestinationPolicy handles the getdestinationpolicy command.
+func (s *Server) getDestinationPolicy(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetDestinationPolicyCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	p, err := w.DestinationPolicy(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	res := &types.GetDestinationPolicyResult{
+		Allowed: []string{},
+		Denied:  []string{},
+	}
+	if p != nil {
+		res.Allowlist = p.Allowlist
+		res.Allowed = append(res.Allowed, p.Allowed...)
+		res.Denied = append(res.Denied, p.Denied...)
+	}
+	return res, nil
+}
+
+// setDestinationOverride handles the setdestinationoverride command.
+func (s *Server) setDestinationOverride(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SetDestinationOverrideCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	var current []byte
+	if cmd.Current != nil {
+		current = []byte(*cmd.Current)
+	}
+	err := w.SetDestinationOverride(ctx, current, []byte(cmd.Credential))
+	switch {
+	case errors.Is(err, errors.Locked):
+		return nil, errWalletUnlockNeeded
+	case errors.Is(err, errors.Permission):
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return nil, err
+}
+
+// setMixPolicy handles the setmixpolicy command.
+func (s *Server) setMixPolicy(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SetMixPolicyCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	err := w.SetMixPolicy(wallet.MixPolicy{
+		MinPeers:       cmd.MinPeers,
+		MinPeerVersion: *cmd.MinPeerVersion,
+	})
+	if errors.Is(err, errors.Invalid) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return nil, err
+}
+
+// getMixPolicy handles the getmixpolicy command.
+func (s *Server) getMixPolicy(ctx context.Context, icmd any) (any, error) {
+	_ = icmd.(*types.GetMixPolicyCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	p := w.MixPolicy()
+	return &types.GetMixPolicyResult{
+		MinPeers:       p.MinPeers,
+		MinPeerVersion: p.MinPeerVersion,
+	}, nil
+}
+
+// sweepPrivKey handles the sweepprivkey command.
+func (s *Server) sweepPrivKey(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SweepPrivKeyCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+	n, ok := s.walletLoader.NetworkBackend()
+	if !ok {
+		return nil, errNoNetwork
+	}
+
+	wif, err := dcrutil.DecodeWIF(cmd.PrivKey, w.ChainParams().PrivateKeyID)
+	if err != nil {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidAddressOrKey, "WIF decode failed: %v", err)
+	}
+	account, err := w.AccountNumber(ctx, *cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+
+	res, err := w.SweepPrivateKey(ctx, n, wif, account, int32(*cmd.ScanFrom))
+	if err != nil {
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	inputs := make([]string, len(res.Inputs))
+	for i := range res.Inputs {
+		inputs[i] = res.Inputs[i].String()
+	}
+	return &types.SweepPrivKeyResult{
+		TxHash: res.Hash.String(),
+		Inputs: inputs,
+		Amount: res.Amount.ToCoin(),
+		Fee:    res.Fee.ToCoin(),
+	}, nil
+}
+
+// sweepToColdStorage handles the sweeptocoldstorage command.
+func (s *Server) sweepToColdStorage(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SweepToColdStorageCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+	n, ok := s.walletLoader.NetworkBackend()
+	if !ok && !*cmd.DryRun {
+		return nil, errNoNetwork
+	}
+
+	lookupAccount := func(name string) (uint32, error) {
+		account, err := w.AccountNumber(ctx, name)
+		if errors.Is(err, errors.NotExist) {
+			return 0, errAccountNotFound
+		}
+		return account, err
+	}
+	source, err := lookupAccount(cmd.SourceAccount)
+	if err != nil {
+		return nil, err
+	}
+	req := &wallet.ColdSweepRequest{
+		SourceAccount: source,
+		MinConf:       int32(*cmd.MinConf),
+		DestXpubIndex: *cmd.DestXpubIndex,
+		MaxInputs:     *cmd.MaxInputs,
+		DryRun:        *cmd.DryRun,
+	}
+	if cmd.DestAccount != nil {
+		dest, err := lookupAccount(*cmd.DestAccount)
+		if err != nil {
+			return nil, err
+		}
+		req.DestAccount = &dest
+	}
+	if cmd.DestXpub != nil {
+		req.DestXpub, err = hdkeychain.NewKeyFromString(*cmd.DestXpub, w.ChainParams())
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+	}
+	if cmd.FeeRate != nil {
+		req.FeeRate, err = dcrutil.NewAmount(*cmd.FeeRate)
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+	}
+	if cmd.MaxFee != nil {
+		req.MaxFee, err = dcrutil.NewAmount(*cmd.MaxFee)
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+	}
+
+	sweep, err := w.SweepToColdStorage(ctx, n, req)
+	switch {
+	case errors.Is(err, errors.Invalid):
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	case errors.Is(err, errors.InsufficientBalance):
+		return nil, rpcError(dcrjson.ErrRPCWalletInsufficientFunds, err)
+	case errors.Is(err, errors.Locked):
+		return nil, errWalletUnlockNeeded
+	case err != nil:
+		return nil, err
+	}
+
+	res := make([]*types.ColdSweepTxResult, 0, len(sweep))
+	for _, st := range sweep {
+		var b strings.Builder
+		b.Grow(2 * st.Tx.SerializeSize())
+		if err := st.Tx.Serialize(hex.NewEncoder(&b)); err != nil {
+			return nil, err
+		}
+		r := &types.ColdSweepTxResult{
+			TxHash:      st.Tx.TxHash().String(),
+			Transaction: b.String(),
+			Inputs:      st.Inputs,
+			Amount:      st.Amount.ToCoin(),
+			Fee:         st.Fee.ToCoin(),
+		}
+		if st.Address != nil {
+			r.Address = st.Address.String()
+		}
+		res = append(res, r)
+	}
+	return res, nil
+}
+
+// setDuressPassphrase handles the setduresspassphrase command.
+func (s *Server) setDuressPassphrase(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SetDuressPassphraseCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	if cmd.Passphrase == "" {
+		return nil, w.ClearDuressPassphrase(ctx)
+	}
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	err = w.SetDuressPassphrase(ctx, account, []byte(cmd.Passphrase))
+	switch {
+	case errors.Is(err, errors.Invalid):
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	case errors.Is(err, errors.Locked):
+		return nil, errWalletUnlockNeeded
+	}
+	return nil, err
+}
+
+// getDuressAccount handles the getduressaccount command.
+func (s *Server) getDuressAccount(ctx context.Context, icmd any) (any, error) {
+	_ = icmd.(*types.GetDuressAccountCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, ok, err := w.DuressAccount(ctx)
+	if errors.Is(err, errors.Locked) {
+		return nil, errWalletUnlockNeeded
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return &types.GetDuressAccountResult{}, nil
+	}
+	accountName, err := w.AccountName(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GetDuressAccountResult{
+		Enabled: true,
+		Account: accountName,
+	}, nil
+}
+
+// createMultisigSpend handles a createmultisigspend request by authoring an
+// unsigned transaction spending outputs of a P2SH multisig address.  Inputs
+// and outputs are sorted deterministically and change is returned to the
+// multisig address, so every cosigner creates the same transaction for the
+// same request.
+func (s *Server) createMultisigSpend(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.CreateMultisigSpendCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	addr, err := decodeAddress(cmd.FromScrAddress, w.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+	p2shAddr, ok := addr.(*stdaddr.AddressScriptHashV0)
+	if !ok {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "address is not P2SH")
+	}
+	req := &wallet.MultisigSpendRequest{Address: p2shAddr}
+	for addrStr, amt := range cmd.Amounts {
+		addr, err := decodeAddress(addrStr, w.ChainParams())
+		if err != nil {
+			return nil, err
+		}
+		amount, err := dcrutil.NewAmount(amt)
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		version, script := addr.PaymentScript()
+		req.Outputs = append(req.Outputs, &wire.TxOut{
+			Value:    int64(amount),
+			Version:  version,
+			PkScript: script,
+		})
+	}
+	if cmd.FeeRate != nil {
+		req.FeeRate, err = dcrutil.NewAmount(*cmd.FeeRate)
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+	}
+	if cmd.Outpoints != nil {
+		for _, s := range *cmd.Outpoints {
+			op, err := parseOutpoint(s)
+			if err != nil {
+				return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+			}
+			req.Inputs = append(req.Inputs, *op)
+		}
+	}
+
+	atx, err := w.AuthorMultisigSpend(ctx, req)
+	if errors.Is(err, errors.Invalid) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var b strings.Builder
+	b.Grow(2 * atx.Tx.SerializeSize())
+	err = atx.Tx.Serialize(hex.NewEncoder(&b))
+	if err != nil {
+		return nil, err
+	}
+	var outputTotal int64
+	for _, out := range atx.Tx.TxOut {
+		outputTotal += out.Value
+	}
+	return &types.CreateMultisigSpendResult{
+		Hex:         b.String(),
+		TxHash:      atx.Tx.TxHash().String(),
+		Fee:         (atx.TotalInput - dcrutil.Amount(outputTotal)).ToCoin(),
+		ChangeIndex: atx.ChangeIndex,
+	}, nil
+}
+
+// getAccountID handles the getaccountid command by returning the immutable
+// UUID and rename history of an account.
+func (s *Server) getAccountID(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetAccountIDCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	return accountIDResult(ctx, w, account)
+}
+
+// getAccountByID handles the getaccountbyid command by returning the current
+// name, number, and rename history of the account with a UUID.
+func (s *Server) getAccountByID(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetAccountByIDCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	id, err := udb.ParseAccountUUID(cmd.UUID)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	account, err := w.AccountByUUID(ctx, id)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	return accountIDResult(ctx, w, account)
+}
+
+func accountIDResult(ctx context.Context, w *wallet.Wallet, account uint32) (*types.AccountIDResult, error) {
+	name, err := w.AccountName(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	id, err := w.AccountUUID(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	renames, err := w.AccountRenames(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	res := &types.AccountIDResult{
+		AccountName:   name,
+		AccountNumber: account,
+		UUID:          id.String(),
+		Renames:       make([]types.AccountRenameResult, 0, len(renames)),
+	}
+	for _, r := range renames {
+		res.Renames = append(res.Renames, types.AccountRenameResult{
+			Time:    r.Time.Unix(),
+			OldName: r.OldName,
+			NewName: r.NewName,
+		})
+	}
+	return res, nil
+}
+
+// createReserveSnapshot handles the createreservesnapshot command by signing
+// a proof of the wallet's unspent outputs at the main chain tip.
+func (s *Server) createReserveSnapshot(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.CreateReserveSnapshotCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	snap, err := w.ReserveSnapshot(ctx, *cmd.Message)
+	if err != nil {
+		if errors.Is(err, errors.Locked) {
+			return nil, errWalletUnlockNeeded
+		}
+		return nil, err
+	}
+	res := &types.ReserveSnapshotResult{
+		BlockHash:   snap.BlockHash.String(),
+		BlockHeight: snap.BlockHeight,
+		Message:     snap.Message,
+		MerkleRoot:  snap.MerkleRoot.String(),
+		Total:       snap.Total.ToCoin(),
+		Outputs:     make([]types.ReserveOutputResult, 0, len(snap.Outputs)),
+	}
+	for i := range snap.Outputs {
+		o := &snap.Outputs[i]
+		res.Outputs = append(res.Outputs, types.ReserveOutputResult{
+			TxID:          o.OutPoint.Hash.String(),
+			Vout:          o.OutPoint.Index,
+			Tree:          o.OutPoint.Tree,
+			Amount:        o.Amount.ToCoin(),
+			ScriptVersion: o.Version,
+			PkScript:      hex.EncodeToString(o.PkScript),
+			Address:       o.Address,
+			Signature:     base64.StdEncoding.EncodeToString(o.Signature),
+		})
+	}
+	return res, nil
+}
+
+// verifyReserveSnapshot handles the verifyreservesnapshot command by checking
+// the signatures of a reserve snapshot and that all of its outputs remain
+// unspent.  This requires a dcrd RPC connection to look up outputs which are
+// not controlled by the wallet.
+func (s *Server) verifyReserveSnapshot(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.VerifyReserveSnapshotCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+	n, err := w.NetworkBackend()
+	if err != nil {
+		return nil, err
+	}
+	chainSyncer, ok := n.(*chain.Syncer)
+	if !ok {
+		return nil, errRPCClientNotConnected
+	}
+
+	snap := &wallet.ReserveSnapshot{
+		BlockHeight: cmd.Snapshot.BlockHeight,
+		Message:     cmd.Snapshot.Message,
+		Outputs:     make([]wallet.ReserveOutput, 0, len(cmd.Snapshot.Outputs)),
+	}
+	if err := chainhash.Decode(&snap.BlockHash, cmd.Snapshot.BlockHash); err != nil {
+		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+	}
+	if err := chainhash.Decode(&snap.MerkleRoot, cmd.Snapshot.MerkleRoot); err != nil {
+		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+	}
+	snap.Total, err = dcrutil.NewAmount(cmd.Snapshot.Total)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	for i := range cmd.Snapshot.Outputs {
+		o := &cmd.Snapshot.Outputs[i]
+		ro := wallet.ReserveOutput{
+			OutPoint: wire.OutPoint{Index: o.Vout, Tree: o.Tree},
+			Version:  o.ScriptVersion,
+			Address:  o.Address,
+		}
+		if err := chainhash.Decode(&ro.OutPoint.Hash, o.TxID); err != nil {
+			return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+		}
+		ro.Amount, err = dcrutil.NewAmount(o.Amount)
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		ro.PkScript, err = hex.DecodeString(o.PkScript)
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+		}
+		ro.Signature, err = base64.StdEncoding.DecodeString(o.Signature)
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		snap.Outputs = append(snap.Outputs, ro)
+	}
+
+	unspentOutput := func(ctx context.Context, op *wire.OutPoint) (*wire.TxOut, error) {
+		res, err := chainSyncer.GetTxOut(ctx, &op.Hash, op.Index, op.Tree, false)
+		if err != nil {
+			return nil, err
+		}
+		if res == nil {
+			return nil, errors.E(errors.NotExist, errors.Errorf("output %v "+
+				"is spent or does not exist", op))
+		}
+		amount, err := dcrutil.NewAmount(res.Value)
+		if err != nil {
+			return nil, err
+		}
+		pkScript, err := hex.DecodeString(res.ScriptPubKey.Hex)
+		if err != nil {
+			return nil, err
+		}
+		return &wire.TxOut{
+			Value:    int64(amount),
+			Version:  res.ScriptPubKey.Version,
+			PkScript: pkScript,
+		}, nil
+	}
+	total, err := wallet.VerifyReserveSnapshot(ctx, snap, w.ChainParams(), unspentOutput)
+	switch {
+	case errors.Is(err, errors.Invalid), errors.Is(err, errors.NotExist):
+		return &types.VerifyReserveSnapshotResult{Error: err.Error()}, nil
+	case err != nil:
+		return nil, err
+	}
+	return &types.VerifyReserveSnapshotResult{Valid: true, Total: total.ToCoin()}, nil
+}
+
+// getWalletStats handles the getwalletstats command by aggregating wallet
+// transactions mined over a range of blocks.
+func (s *Server) getWalletStats(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetWalletStatsCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	interval := *cmd.Interval
+	if interval == 0 {
+		// A single interval covers the whole range.  The range length
+		// may not fit in an int32, and the range is limited by the
+		// main chain tip regardless.
+		interval = math.MaxInt32
+	}
+	stats, err := w.BlockRangeStats(ctx, cmd.StartHeight, cmd.EndHeight, interval)
+	if err != nil {
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	res := make([]types.WalletStatsResult, 0, len(stats))
+	for i := range stats {
+		st := &stats[i]
+		res = append(res, types.WalletStatsResult{
+			StartHeight:     st.StartHeight,
+			EndHeight:       st.EndHeight,
+			Transactions:    st.Transactions,
+			TotalIn:         st.Credits.ToCoin(),
+			TotalOut:        st.Debits.ToCoin(),
+			Fees:            st.Fees.ToCoin(),
+			TicketPurchases: st.TicketPurchases,
+			Votes:           st.Votes,
+			Revocations:     st.Revocations,
+		})
+	}
+	return res, nil
+}
+
+// getUTXOStats handles the getutxostats command by summarizing the coin ages,
+// dust, and values of the unspent outputs of each account.
+func (s *Server) getUTXOStats(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetUTXOStatsCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	var filterAccount bool
+	var account uint32
+	if cmd.Account != nil {
+		var err error
+		account, err = w.AccountNumber(ctx, *cmd.Account)
+		if err != nil {
+			if errors.Is(err, errors.NotExist) {
+				return nil, errAccountNotFound
+			}
+			return nil, err
+		}
+		filterAccount = true
+	}
+	var dustThreshold dcrutil.Amount
+	if cmd.DustThreshold != nil {
+		var err error
+		dustThreshold, err = dcrutil.NewAmount(*cmd.DustThreshold)
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		if dustThreshold <= 0 {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"dustthreshold must be positive")
+		}
+	}
 
-		// Multi-signature scripts also provide the number of required
-		// signatures.
-		if class == stdscript.STMultiSig {
-			result.SigsRequired = int32(stdscript.DetermineRequiredSigs(version, script))
+	stats, err := w.UTXOStats(ctx, dustThreshold)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]types.UTXOStatsResult, 0, len(stats))
+	for i := range stats {
+		st := &stats[i]
+		if filterAccount && st.Account != account {
+			continue
+		}
+		accountName, err := w.AccountName(ctx, st.Account)
+		if err != nil {
+			return nil, err
 		}
+		res = append(res, types.UTXOStatsResult{
+			Account:   accountName,
+			Count:     st.Count,
+			Total:     st.Total.ToCoin(),
+			MedianAge: st.MedianAge,
+			MeanAge:   st.MeanAge,
+			DustCount: st.DustCount,
+			DustTotal: st.DustTotal.ToCoin(),
+			Largest:   st.Largest.ToCoin(),
+			Smallest:  st.Smallest.ToCoin(),
+		})
 	}
+	return res, nil
+}
 
-	if ka, ok := ka.(wallet.BIP0044Address); ok {
-		acct, branch, child := ka.Path()
-		if ka.AccountKind() != wallet.AccountKindImportedXpub {
-			result.AccountN = &acct
+// annotateRawTransaction handles the annotaterawtransaction command by
+// decoding a transaction and describing which of its inputs and outputs
+// belong to the wallet.
+func (s *Server) annotateRawTransaction(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.AnnotateRawTransactionCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	tx := wire.NewMsgTx()
+	err := tx.Deserialize(hex.NewDecoder(strings.NewReader(cmd.HexTx)))
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCDeserialization, err)
+	}
+	a, err := w.AnnotateTransaction(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	var txType string
+	switch wallet.TxTransactionType(tx) {
+	case wallet.TransactionTypeCoinbase:
+		txType = "coinbase"
+	case wallet.TransactionTypeTicketPurchase:
+		txType = "ticket"
+	case wallet.TransactionTypeVote:
+		txType = "vote"
+	case wallet.TransactionTypeRevocation:
+		txType = "revocation"
+	case wallet.TransactionTypeTreasuryAdd:
+		txType = "treasuryadd"
+	default:
+		txType = "regular"
+	}
+	res := &types.AnnotateRawTransactionResult{
+		TxID:         tx.TxHash().String(),
+		Version:      tx.Version,
+		LockTime:     tx.LockTime,
+		Expiry:       tx.Expiry,
+		Type:         txType,
+		WalletDebit:  a.Debits.ToCoin(),
+		WalletCredit: a.Credits.ToCoin(),
+		Vin:          make([]types.AnnotatedInputResult, 0, len(a.Inputs)),
+		Vout:         make([]types.AnnotatedOutputResult, 0, len(a.Outputs)),
+	}
+	if a.FeeKnown {
+		fee := a.Fee.ToCoin()
+		res.Fee = &fee
+	}
+	for i := range a.Inputs {
+		in := &a.Inputs[i]
+		r := types.AnnotatedInputResult{
+			TxID:     in.PreviousOutPoint.Hash.String(),
+			Vout:     in.PreviousOutPoint.Index,
+			Tree:     in.PreviousOutPoint.Tree,
+			Sequence: tx.TxIn[i].Sequence,
+			AmountIn: dcrutil.Amount(tx.TxIn[i].ValueIn).ToCoin(),
+		}
+		if in.PreviousOutput != nil {
+			prevAmount := dcrutil.Amount(in.PreviousOutput.Value).ToCoin()
+			r.PrevAmount = &prevAmount
+		}
+		if o := in.Owner; o != nil {
+			r.Mine = true
+			r.Account = &o.Account
+			r.AccountName = o.AccountName
+			r.Address = o.Address.String()
+			r.Path = o.Path
+		}
+		res.Vin = append(res.Vin, r)
+	}
+	for i := range a.Outputs {
+		out := &a.Outputs[i]
+		r := types.AnnotatedOutputResult{
+			N:          out.Index,
+			Value:      dcrutil.Amount(out.Output.Value).ToCoin(),
+			Version:    out.Output.Version,
+			ScriptType: out.ScriptType.String(),
+			PkScript:   hex.EncodeToString(out.Output.PkScript),
+		}
+		for _, addr := range out.Addresses {
+			r.Addresses = append(r.Addresses, addr.String())
+		}
+		if o := out.Owner; o != nil {
+			r.Mine = true
+			r.Account = &o.Account
+			r.AccountName = o.AccountName
+			r.Address = o.Address.String()
+			r.Path = o.Path
+		}
+		res.Vout = append(res.Vout, r)
+	}
+	return res, nil
+}
+
+// importLegacyStakePoolTickets handles the importlegacystakepooltickets
+// command by importing the voting script of a legacy stake pool and recording
+// its tickets as managed by the pool.
+func (s *Server) importLegacyStakePoolTickets(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ImportLegacyStakePoolTicketsCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	script, err := hex.DecodeString(cmd.Script)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+	}
+	poolFeeAddr, err := decodeAddress(cmd.PoolFeeAddress, w.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+	req := &wallet.LegacyStakePoolImport{
+		Host:           cmd.Host,
+		Script:         script,
+		PoolFeeAddress: poolFeeAddr,
+	}
+	if cmd.Tickets != nil {
+		for _, t := range *cmd.Tickets {
+			hash, err := chainhash.NewHashFromStr(t)
+			if err != nil {
+				return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+			}
+			req.Tickets = append(req.Tickets, hash)
 		}
-		result.Branch = &branch
-		result.Index = &child
 	}
-
-	return result, nil
+
+	imported, err := w.ImportLegacyStakePoolTickets(ctx, req)
+	if err != nil {
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		if errors.Is(err, errors.NotExist) {
+			return nil, rpcError(dcrjson.ErrRPCNoTxInfo, err)
+		}
+		return nil, err
+	}
+	res := make([]string, 0, len(imported))
+	for _, hash := range imported {
+		res = append(res, hash.String())
+	}
+	return res, nil
+}
+
+// debugTimings handles the debugtimings command by returning the recorded
+// durations of wallet operations and RPC method calls.
+func (s *Server) debugTimings(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.DebugTimingsCmd)
+	reset := *cmd.Reset
+
+	res := &types.DebugTimingsResult{
+		Operations: []types.TimingResult{},
+	}
+	if w, ok := s.walletLoader.LoadedWallet(); ok {
+		for _, t := range w.OpTimings(reset) {
+			res.Operations = append(res.Operations,
+				timingResult(t.Name, t.Count, t.Total, t.Max))
+		}
+	}
+	res.Methods = s.timings.results(reset)
+	return res, nil
+}
+
+// debugDump handles the debugdump command by returning a structural
+// description of the wallet which is safe to attach to bug reports.
+func (s *Server) debugDump(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	d, err := w.DebugDump(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := &types.DebugDumpResult{
+		Network:             d.Network,
+		DBVersion:           d.DBVersion,
+		CoinType:            d.CoinType,
+		WatchingOnly:        d.WatchingOnly,
+		Locked:              d.Locked,
+		TipHeight:           d.MainChainTipHeight,
+		BirthHeight:         d.BirthHeight,
+		MissingCFilters:     d.MissingCFilters,
+		UnminedTransactions: d.UnminedTransactions,
+		Accounts:            make([]types.DebugDumpAccountResult, 0, len(d.Accounts)),
+		Buckets:             d.Buckets,
+	}
+	for _, a := range d.Accounts {
+		res.Accounts = append(res.Accounts, types.DebugDumpAccountResult{
+			AccountNumber:        a.AccountNumber,
+			AccountType:          a.AccountType,
+			LastUsedExternal:     a.LastUsedExternalIndex,
+			LastUsedInternal:     a.LastUsedInternalIndex,
+			LastReturnedExternal: a.LastReturnedExternalIndex,
+			LastReturnedInternal: a.LastReturnedInternalIndex,
+			ImportedKeys:         a.ImportedKeyCount,
+		})
+	}
+	return res, nil
+}
+
+// debugInspectDB handles the debuginspectdb command by describing the buckets
+// and serialization versions of the wallet database, redacting any keys and
+// values which may identify the wallet.
+func (s *Server) debugInspectDB(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.DebugInspectDBCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	var bucket string
+	if cmd.Bucket != nil {
+		bucket = *cmd.Bucket
+	}
+	if *cmd.Limit < 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative limit")
+	}
+
+	in, err := w.InspectDB(ctx, bucket, *cmd.Limit)
+	if err != nil {
+		return nil, err
+	}
+	res := &types.DebugInspectDBResult{
+		Versions:      in.Versions,
+		LatestVersion: in.LatestVersion,
+		Buckets:       make([]types.BucketSummaryResult, 0, len(in.Buckets)),
+		TotalEntries:  in.TotalEntries,
+	}
+	for _, b := range in.Buckets {
+		res.Buckets = append(res.Buckets, types.BucketSummaryResult{
+			Path:       b.Path,
+			Buckets:    b.Buckets,
+			Entries:    b.Entries,
+			KeyBytes:   b.KeyBytes,
+			ValueBytes: b.ValueBytes,
+		})
+	}
+	for _, e := range in.Entries {
+		res.Entries = append(res.Entries, types.BucketEntryResult{
+			Key:       e.Key,
+			Redacted:  e.Redacted,
+			Bucket:    e.Bucket,
+			KeyLen:    e.KeyLen,
+			ValueLen:  e.ValueLen,
+			ValueHash: e.ValueHash,
+		})
+	}
+	return res, nil
+}
+
+// addressReuseReport handles the addressreusereport command by returning the
+// number and value of outputs paying each reused address, summarized by
+// account.
+func (s *Server) addressReuseReport(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.AddressReuseReportCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	var since int32
+	if cmd.Since != nil {
+		since = *cmd.Since
+	}
+	report, err := w.AddressReuse(ctx, since)
+	if err != nil {
+		return nil, err
+	}
+	res := &types.AddressReuseReportResult{
+		Addresses: make([]types.ReusedAddressResult, 0, len(report.Addresses)),
+		Accounts:  make([]types.AccountAddressReuseResult, 0, len(report.Accounts)),
+	}
+	for _, r := range report.Addresses {
+		outpoints := make([]string, len(r.Outputs))
+		for i := range r.Outputs {
+			outpoints[i] = r.Outputs[i].String()
+		}
+		res.Addresses = append(res.Addresses, types.ReusedAddressResult{
+			Address:   r.Address.String(),
+			Account:   r.Account,
+			Count:     len(r.Outputs),
+			Total:     r.Total.ToCoin(),
+			OutPoints: outpoints,
+		})
+	}
+	for _, a := range report.Accounts {
+		name, err := w.AccountName(ctx, a.Account)
+		if err != nil {
+			return nil, err
+		}
+		res.Accounts = append(res.Accounts, types.AccountAddressReuseResult{
+			Account:     a.Account,
+			AccountName: name,
+			Addresses:   a.Addresses,
+			Count:       a.Outputs,
+			Total:       a.Total.ToCoin(),
+		})
+	}
+	return res, nil
+}
+
+// ticketAddressReuse handles the ticketaddressreuse command by returning the
+// wallet addresses committed to by more than a single ticket.
+func (s *Server) ticketAddressReuse(ctx context.Context, icmd any) (any, error) {
+	_ = icmd.(*types.TicketAddressReuseCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	reused, err := w.TicketAddressReuse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]types.ReusedTicketAddressResult, 0, len(reused))
+	for _, r := range reused {
+		kind := "commitment"
+		if r.Voting {
+			kind = "voting"
+		}
+		tickets := make([]string, len(r.Tickets))
+		for i := range r.Tickets {
+			tickets[i] = r.Tickets[i].String()
+		}
+		res = append(res, types.ReusedTicketAddressResult{
+			Address: r.Address.String(),
+			Account: r.Account,
+			Kind:    kind,
+			Tickets: tickets,
+		})
+	}
+	return res, nil
+}
+
+// importSLIP0044Account handles the importslip0044account command by merging
+// a SLIP0044 coin type account into a legacy coin type wallet as an account
+// encrypted by its own passphrase.
+func (s *Server) importSLIP0044Account(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ImportSLIP0044AccountCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+	if cmd.Passphrase == "" {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "passphrase must not be empty")
+	}
+
+	name := fmt.Sprintf("slip0044-account-%d", cmd.Account)
+	if cmd.Name != nil {
+		name = *cmd.Name
+	}
+	return w.ImportSLIP0044Account(ctx, cmd.Account, []byte(cmd.Passphrase), name)
+}
+
+// getTicketPools handles the getticketpools command by summarizing the
+// unspent tickets managed by each VSP.
+func (s *Server) getTicketPools(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetTicketPoolsCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	interval := *cmd.Interval
+	if interval == 0 {
+		// Default to one day of blocks.
+		interval = int32(24 * time.Hour / w.ChainParams().TargetTimePerBlock)
+	}
+	pools, err := w.TicketPools(ctx, interval)
+	if err != nil {
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	res := make([]types.TicketPoolResult, 0, len(pools))
+	for i := range pools {
+		p := &pools[i]
+		expiries := make([]types.TicketExpiriesResult, 0, len(p.Expiries))
+		for _, e := range p.Expiries {
+			expiries = append(expiries, types.TicketExpiriesResult{
+				StartHeight: e.StartHeight,
+				EndHeight:   e.EndHeight,
+				Tickets:     e.Tickets,
+				Value:       e.Value.ToCoin(),
+			})
+		}
+		res = append(res, types.TicketPoolResult{
+			Host:        p.Host,
+			Tickets:     p.Tickets(),
+			Unmined:     p.Unmined,
+			Immature:    p.Immature,
+			Live:        p.Live,
+			FeePending:  p.FeePending,
+			LockedValue: p.LockedValue.ToCoin(),
+			Expiries:    expiries,
+		})
+	}
+	return res, nil
+}
+
+// approvePendingSpend handles the approvependingspend command by signing and
+// publishing a pending spend.  It may only be called by the spend approval
+// user.
+func (s *Server) approvePendingSpend(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ApprovePendingSpendCmd)
+	if !s.cfg.PendingSpends {
+		return nil, errPendingSpendsDisabled
+	}
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	hash, err := chainhash.NewHashFromStr(cmd.TxHash)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+	}
+	err = w.ApprovePendingSpend(ctx, hash)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		if errors.Is(err, errors.Locked) {
+			return nil, errWalletUnlockNeeded
+		}
+		return nil, err
+	}
+	return hash.String(), nil
+}
+
+// listPendingSpends handles the listpendingspends command by returning the
+// spends awaiting approval.
+func (s *Server) listPendingSpends(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	spends, err := w.PendingSpends(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]types.PendingSpendResult, 0, len(spends))
+	for i := range spends {
+		p := &spends[i]
+		accountName, err := w.AccountName(ctx, p.Account)
+		if err != nil {
+			return nil, err
+		}
+		txHex, err := p.Tx.Bytes()
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, types.PendingSpendResult{
+			TxHash:   p.Hash.String(),
+			Account:  accountName,
+			Proposed: p.Proposed.Unix(),
+			Amount:   p.Amount.ToCoin(),
+			Fee:      p.Fee.ToCoin(),
+			Hex:      hex.EncodeToString(txHex),
+		})
+	}
+	return res, nil
+}
+
+// rejectPendingSpend handles the rejectpendingspend command by removing a
+// pending spend and unlocking its inputs.  It may only be called by the spend
+// approval user.
+func (s *Server) rejectPendingSpend(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.RejectPendingSpendCmd)
+	if !s.cfg.PendingSpends {
+		return nil, errPendingSpendsDisabled
+	}
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	hash, err := chainhash.NewHashFromStr(cmd.TxHash)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+	}
+	err = w.RejectPendingSpend(ctx, hash)
+	if errors.Is(err, errors.NotExist) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return nil, err
+}
+
+// setMaxFeeRate handles the setmaxfeerate command by persisting the maximum
+// fee rate of all wallet-authored transactions.
+func (s *Server) setMaxFeeRate(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SetMaxFeeRateCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	if cmd.Amount < 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative amount")
+	}
+	maxFeeRate, err := dcrutil.NewAmount(cmd.Amount)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return nil, w.SetMaxFeeRate(ctx, maxFeeRate)
 }
 
-// validatePreDCP0005CF handles the validatepredcp0005cf command.
-func (s *Server) validatePreDCP0005CF(ctx context.Context, icmd any) (any, error) {
+// getAccountChildXpub handles the getaccountchildxpub command by deriving the
+// extended public key of a hardened child path of an account.
+func (s *Server) getAccountChildXpub(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetAccountChildXpubCmd)
 	w, ok := s.walletLoader.LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	err := w.ValidatePreDCP0005CFilters(ctx)
-	return err == nil, err
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	path, err := parseHardenedPath(cmd.Path)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	xpub, err := w.AccountChildXpub(ctx, account, path)
+	if err != nil {
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		if errors.Is(err, errors.Locked) {
+			return nil, errWalletUnlockNeeded
+		}
+		return nil, err
+	}
+	return xpub.String(), nil
 }
 
-// verifyMessage handles the verifymessage command by verifying the provided
-// compact signature for the given address and message.
-func (s *Server) verifyMessage(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.VerifyMessageCmd)
-
-	var valid bool
+// getAccountWatchKey handles the getaccountwatchkey command by returning the
+// external-only watch key of an account and importing the addresses of the
+// requested window.
+func (s *Server) getAccountWatchKey(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetAccountWatchKeyCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
 
-	// Decode address and base64 signature from the request.
-	addr, err := stdaddr.DecodeAddress(cmd.Address, s.activeNet)
+	account, err := w.AccountNumber(ctx, cmd.Account)
 	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
 		return nil, err
 	}
-	sig, err := base64.StdEncoding.DecodeString(cmd.Signature)
-	if err != nil {
-		return nil, err
+	scanFrom := int32(wallet.NoRescan)
+	if cmd.Rescan != nil && *cmd.Rescan {
+		scanFrom = 0
+		if cmd.ScanFrom != nil {
+			scanFrom = int32(*cmd.ScanFrom)
+		}
+	}
+	start, count := uint32(0), uint32(20)
+	if cmd.Start != nil {
+		start = *cmd.Start
+	}
+	if cmd.Count != nil {
+		count = *cmd.Count
 	}
 
-	// Addresses must have an associated secp256k1 private key and must be P2PKH
-	// (P2PK and P2SH is not allowed).
-	switch addr.(type) {
-	case *stdaddr.AddressPubKeyHashEcdsaSecp256k1V0:
-	default:
-		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
-			"address must be secp256k1 pay-to-pubkey-hash")
+	key, rescan, err := w.AccountWatchKey(ctx, account, start, count, scanFrom)
+	if err != nil {
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		if errors.Is(err, errors.Locked) {
+			return nil, errWalletUnlockNeeded
+		}
+		return nil, err
 	}
+	s.startImportRescan(rescan)
 
-	valid, err = wallet.VerifyMessage(cmd.Message, addr, sig, s.activeNet)
-	// Mirror Bitcoin Core behavior, which treats all erorrs as an invalid
-	// signature.
-	return err == nil && valid, nil
+	return &types.GetAccountWatchKeyResult{
+		Xpub:  key.Xpub.String(),
+		Start: key.Start,
+		Count: key.Count,
+	}, nil
 }
 
-// version handles the version command by returning the RPC API versions of the
-// wallet and, optionally, the consensus RPC server as well if it is associated
-// with the server.  The chainClient is optional, and this is simply a helper
-// function for the versionWithChainRPC and versionNoChainRPC handlers.
-func (s *Server) version(ctx context.Context, icmd any) (any, error) {
-	resp := make(map[string]dcrdtypes.VersionResult)
-	n, _ := s.walletLoader.NetworkBackend()
-	if chainSyncer, ok := n.(*chain.Syncer); ok {
-		err := chainSyncer.RPC().Call(ctx, "version", &resp)
+// parseHardenedPath parses a child path relative to an account key, such as
+// "13'/0'", where every index must be hardened with a ' or h suffix.  The
+// returned indexes do not include the hardened offset.
+func parseHardenedPath(s string) ([]uint32, error) {
+	if s == "" {
+		return nil, errors.New("empty path")
+	}
+	elems := strings.Split(s, "/")
+	path := make([]uint32, 0, len(elems))
+	for _, e := range elems {
+		i := strings.TrimRight(e, "'h")
+		if len(e)-len(i) != 1 {
+			return nil, errors.Errorf("path element %q is not hardened", e)
+		}
+		index, err := strconv.ParseUint(i, 10, 31)
 		if err != nil {
-			return nil, err
+			return nil, errors.Errorf("invalid path element %q", e)
 		}
+		path = append(path, uint32(index))
 	}
+	return path, nil
+}
 
-	resp["dcrwallet"] = dcrdtypes.VersionResult{
-		VersionString: version.String(),
-		Major:         version.Major,
-		Minor:         version.Minor,
-		Patch:         version.Patch,
-		Prerelease:    version.PreRelease,
-		BuildMetadata: version.BuildMetadata,
+// matchCFilters handles the matchcfilters command by returning the main chain
+// blocks in a height range whose compact filters match a wallet script.
+func (s *Server) matchCFilters(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.MatchCFiltersCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
-	resp["dcrwalletjsonrpcapi"] = dcrdtypes.VersionResult{
-		VersionString: jsonrpcSemverString,
-		Major:         jsonrpcSemverMajor,
-		Minor:         jsonrpcSemverMinor,
-		Patch:         jsonrpcSemverPatch,
+
+	matches, err := w.MatchCFilters(ctx, cmd.StartHeight, cmd.EndHeight)
+	if err != nil {
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
 	}
-	return resp, nil
+	res := make([]types.MatchCFiltersResult, len(matches))
+	for i := range matches {
+		res[i] = types.MatchCFiltersResult{
+			Hash:   matches[i].Hash.String(),
+			Height: matches[i].Height,
+		}
+	}
+	return res, nil
 }
 
-// walletInfo gets the current information about the wallet. If the daemon
-// is connected and fails to ping, the function will still return that the
-// daemon is disconnected.
-func (s *Server) walletInfo(ctx context.Context, icmd any) (any, error) {
+// getJournalEvents handles the getjournalevents command by returning events
+// recorded by the wallet's event journal beginning with a sequence number.
+func (s *Server) getJournalEvents(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetJournalEventsCmd)
 	w, ok := s.walletLoader.LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	var connected, spvMode bool
-	switch n, _ := w.NetworkBackend(); syncer := n.(type) {
-	case *spv.Syncer:
-		spvMode = true
-		connected = len(syncer.GetRemotePeers()) > 0
-	case *chain.Syncer:
-		err := syncer.RPC().Call(ctx, "ping", nil)
-		if ctx.Err() != nil {
-			return nil, ctx.Err()
+	events, next, err := w.JournalEvents(ctx, cmd.FromSequence, *cmd.Count)
+	if err != nil {
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
 		}
-		if err != nil {
-			log.Warnf("Ping failed on connected daemon client: %v", err)
-		} else {
-			connected = true
+		return nil, err
+	}
+	res := &types.GetJournalEventsResult{
+		Events:       make([]types.JournalEventResult, len(events)),
+		NextSequence: next,
+		Pruned:       len(events) != 0 && events[0].Sequence > cmd.FromSequence,
+	}
+	for i, e := range events {
+		r := types.JournalEventResult{
+			Sequence: e.Sequence,
+			Type:     e.Type.String(),
+			Time:     e.Time.Unix(),
 		}
-	case nil:
-		log.Warnf("walletInfo - no network backend")
-	default:
-		log.Errorf("walletInfo - invalid network backend (%T).", n)
-		return nil, &dcrjson.RPCError{
-			Code:    dcrjson.ErrRPCMisc,
-			Message: "invalid network backend",
+		if e.Type != udb.JournalTipChanged {
+			r.TxHash = e.Hash.String()
+		}
+		if e.Type == udb.JournalTxMined || e.Type == udb.JournalTxDetached ||
+			e.Type == udb.JournalTipChanged {
+			r.BlockHash = e.BlockHash.String()
+			r.Height = e.Height
 		}
+		res.Events[i] = r
 	}
+	return res, nil
+}
 
-	coinType, err := w.CoinType(ctx)
-	if errors.Is(err, errors.WatchingOnly) {
-		// This is a watching-only wallet, which does not store the active coin
-		// type. Return CoinTypes default value (0), which will be omitted from
-		// the JSON response, and log a debug message.
-		log.Debug("Watching only wallets do not store the coin type keys.")
-	} else if err != nil {
-		log.Errorf("Failed to retrieve the active coin type: %v", err)
-		coinType = 0
+// getAccountBlindedID handles the getaccountblindedid command by returning
+// the blinded ID of an account.
+func (s *Server) getAccountBlindedID(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetAccountBlindedIDCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
 	}
 
-	unlocked := !(w.Locked())
-	fi := w.RelayFee()
-	voteBits := w.VoteBits()
-	var voteVersion uint32
-	_ = binary.Read(bytes.NewBuffer(voteBits.ExtendedBits[0:4]), binary.LittleEndian, &voteVersion)
-	voting := w.VotingEnabled()
-
-	wi := &types.WalletInfoResult{
-		DaemonConnected:  connected,
-		SPV:              spvMode,
-		Unlocked:         unlocked,
-		CoinType:         coinType,
-		TxFee:            fi.ToCoin(),
-		VoteBits:         voteBits.Bits,
-		VoteBitsExtended: hex.EncodeToString(voteBits.ExtendedBits),
-		VoteVersion:      voteVersion,
-		Voting:           voting,
-		VSP:              s.cfg.VSPHost,
-		ManualTickets:    w.ManualTickets(),
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
 	}
-
-	birthState, err := w.BirthState(ctx)
+	id, err := w.AccountBlindedID(ctx, account)
 	if err != nil {
-		log.Errorf("Failed to get birth state: %v", err)
-	} else if birthState != nil &&
-		!(birthState.SetFromTime || birthState.SetFromHeight) {
-		wi.BirthHash = birthState.Hash.String()
-		wi.BirthHeight = birthState.Height
+		if errors.Is(err, errors.NotExist) {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"account %q has no blinded ID", cmd.Account)
+		}
+		return nil, err
 	}
-
-	return wi, nil
+	return id.String(), nil
 }
 
-// walletIsLocked handles the walletislocked extension request by
-// returning the current lock state (false for unlocked, true for locked)
-// of an account.
-func (s *Server) walletIsLocked(ctx context.Context, icmd any) (any, error) {
+// lookupAccountBlindedID handles the lookupaccountblindedid command by
+// returning the name, number, and rename history of the account with a
+// blinded ID.
+func (s *Server) lookupAccountBlindedID(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.LookupAccountBlindedIDCmd)
 	w, ok := s.walletLoader.LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	return w.Locked(), nil
+	id, err := udb.ParseAccountBlindedID(cmd.BlindedID)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	account, err := w.AccountByBlindedID(ctx, id)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	return accountIDResult(ctx, w, account)
 }
 
-// walletLock handles a walletlock request by locking the all account
-// wallets, returning an error if any wallet is not encrypted (for example,
-// a watching-only wallet).
-func (s *Server) walletLock(ctx context.Context, icmd any) (any, error) {
+// attestAddress handles the attestaddress command by signing an attestation
+// of the derivation of a wallet address with the address' account key.
+func (s *Server) attestAddress(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.AttestAddressCmd)
 	w, ok := s.walletLoader.LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	w.Lock()
-	return nil, nil
+	addr, err := decodeAddress(cmd.Address, w.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+	a, err := w.AttestAddress(ctx, addr)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAddressNotInWallet
+		}
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		if errors.Is(err, errors.Locked) {
+			return nil, errWalletUnlockNeeded
+		}
+		return nil, err
+	}
+	xpub, err := w.AccountXpub(ctx, a.Account)
+	if err != nil {
+		return nil, err
+	}
+	return &types.AttestAddressResult{
+		Address:     a.Address.String(),
+		Account:     a.Account,
+		Branch:      a.Branch,
+		Index:       a.Child,
+		Fingerprint: fmt.Sprintf("%08x", a.Fingerprint),
+		AccountXpub: xpub.String(),
+		Signature:   base64.StdEncoding.EncodeToString(a.Signature),
+	}, nil
 }
 
-// walletPassphrase responds to the walletpassphrase request by unlocking the
-// wallet. The decryption key is saved in the wallet until timeout seconds
-// expires, after which the wallet is locked. A timeout of 0 leaves the wallet
-// unlocked indefinitely.
-func (s *Server) walletPassphrase(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.WalletPassphraseCmd)
+// listExternalSigners handles the listexternalsigners command by returning
+// the accounts whose keys are held by hardware signing devices, with the
+// device and derivation path to request signatures from.
+func (s *Server) listExternalSigners(ctx context.Context, icmd any) (any, error) {
+	_ = icmd.(*types.ListExternalSignersCmd)
 	w, ok := s.walletLoader.LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	timeout := time.Second * time.Duration(cmd.Timeout)
-	var unlockAfter <-chan time.Time
-	if timeout != 0 {
-		unlockAfter = time.After(timeout)
+	accounts, err := w.Accounts(ctx)
+	if err != nil {
+		return nil, err
 	}
-	err := w.Unlock(ctx, []byte(cmd.Passphrase), unlockAfter)
-	return nil, err
-}
-
-// walletPassphraseChange responds to the walletpassphrasechange request
-// by unlocking all accounts with the provided old passphrase, and
-// re-encrypting each private key with an AES key derived from the new
-// passphrase.
-//
-// If the old passphrase is correct and the passphrase is changed, all
-// wallets will be immediately locked.
-func (s *Server) walletPassphraseChange(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.WalletPassphraseChangeCmd)
+	res := []types.ExternalSignerResult{}
+	for i := range accounts.Accounts {
+		a := &accounts.Accounts[i]
+		if a.ExternalSigner == nil {
+			continue
+		}
+		var fingerprint [4]byte
+		binary.BigEndian.PutUint32(fingerprint[:], a.ExternalSigner.Fingerprint)
+		res = append(res, types.ExternalSignerResult{
+			Account:     a.AccountNumber,
+			AccountName: a.AccountName,
+			Fingerprint: hex.EncodeToString(fingerprint[:]),
+			Path:        a.ExternalSigner.PathString(),
+			Model:       a.ExternalSigner.Model,
+		})
+	}
+	return res, nil
+}
+
+// listExpiredAddresses handles the listexpiredaddresses command by returning
+// addresses issued with an expiry which expired before receiving a payment.
+func (s *Server) listExpiredAddresses(ctx context.Context, icmd any) (any, error) {
 	w, ok := s.walletLoader.LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	err := w.ChangePrivatePassphrase(ctx, []byte(cmd.OldPassphrase),
-		[]byte(cmd.NewPassphrase))
+	expired, err := w.ExpiredAddresses(ctx)
 	if err != nil {
-		if errors.Is(err, errors.Passphrase) {
-			return nil, rpcErrorf(dcrjson.ErrRPCWalletPassphraseIncorrect, "incorrect passphrase")
-		}
 		return nil, err
 	}
-	return nil, nil
+	res := make([]types.ExpiredAddressResult, 0, len(expired))
+	for _, e := range expired {
+		ka, err := w.KnownAddress(ctx, e.Address)
+		if err != nil {
+			return nil, err
+		}
+		r := types.ExpiredAddressResult{
+			Address:      e.Address.String(),
+			Account:      ka.AccountName(),
+			Issued:       e.Issued.Unix(),
+			ExpiryHeight: e.ExpiryHeight,
+		}
+		if !e.Expiry.IsZero() {
+			r.ExpiryTime = e.Expiry.Unix()
+		}
+		if !e.Paid.IsZero() {
+			r.PaidTime = e.Paid.Unix()
+			r.PaidHeight = e.PaidHeight
+		}
+		res = append(res, r)
+	}
+	return res, nil
 }
 
-func (s *Server) mixOutput(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.MixOutputCmd)
-	if !s.cfg.MixingEnabled {
-		return nil, errors.E("Mixing is not configured")
-	}
+// exportVotingAccount handles the exportvotingaccount command by returning
+// the extended private key and first voting addresses of an account, for
+// import by a separate voting wallet.
+func (s *Server) exportVotingAccount(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ExportVotingAccountCmd)
 	w, ok := s.walletLoader.LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	outpoint, err := parseOutpoint(cmd.Outpoint)
-	if err != nil {
-		return nil, err
-	}
-
-	mixAccount, err := w.AccountNumber(ctx, s.cfg.MixAccount)
+	account, err := w.AccountNumber(ctx, cmd.Account)
 	if err != nil {
 		if errors.Is(err, errors.NotExist) {
 			return nil, errAccountNotFound
 		}
 		return nil, err
 	}
-	changeAccount, err := w.AccountNumber(ctx, s.cfg.MixChangeAccount)
+	export, err := w.ExportVotingAccount(ctx, account, *cmd.Count)
 	if err != nil {
-		if errors.Is(err, errors.NotExist) {
-			return nil, errAccountNotFound
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		if errors.Is(err, errors.Locked) {
+			return nil, errWalletUnlockNeeded
 		}
 		return nil, err
 	}
-
-	mixBranch := s.cfg.MixBranch
-
-	err = w.MixOutput(ctx, outpoint, changeAccount, mixAccount, mixBranch)
-	return nil, err
+	addrs := make([]string, len(export.Addresses))
+	for i, a := range export.Addresses {
+		addrs[i] = a.String()
+	}
+	return &types.ExportVotingAccountResult{
+		Xpriv:     export.Xpriv.String(),
+		Addresses: addrs,
+	}, nil
 }
 
-func (s *Server) mixAccount(ctx context.Context, icmd any) (any, error) {
-	if !s.cfg.MixingEnabled {
-		return nil, errors.E("Mixing is not configured")
-	}
+// importVotingAccount handles the importvotingaccount command by importing an
+// account extended private key for voting, encrypted by a passphrase.
+func (s *Server) importVotingAccount(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ImportVotingAccountCmd)
 	w, ok := s.walletLoader.LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	mixAccount, err := w.AccountNumber(ctx, s.cfg.MixAccount)
+	xpriv, err := hdkeychain.NewKeyFromString(cmd.Xpriv, w.ChainParams())
 	if err != nil {
-		if errors.Is(err, errors.NotExist) {
-			return nil, errAccountNotFound
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	if !xpriv.IsPrivate() {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"key is not an extended private key")
+	}
+	defer xpriv.Zero()
+	account, err := w.ImportVotingAccount(ctx, xpriv, []byte(cmd.Passphrase), cmd.Name)
+	if err != nil {
+		if errors.Is(err, errors.Exist) || errors.Is(err, errors.Passphrase) ||
+			errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
 		}
 		return nil, err
 	}
-	changeAccount, err := w.AccountNumber(ctx, s.cfg.MixChangeAccount)
+	return account, nil
+}
+
+// verifyVotingAccount handles the verifyvotingaccount command by checking
+// that voting addresses exported by another wallet are derived by an
+// account.
+func (s *Server) verifyVotingAccount(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.VerifyVotingAccountCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
 	if err != nil {
 		if errors.Is(err, errors.NotExist) {
 			return nil, errAccountNotFound
 		}
 		return nil, err
 	}
-
-	mixBranch := s.cfg.MixBranch
-
-	err = w.MixAccount(ctx, changeAccount, mixAccount, mixBranch)
-	return nil, err
-}
-
-func parseOutpoint(s string) (*wire.OutPoint, error) {
-	const op errors.Op = "parseOutpoint"
-	if len(s) < 66 {
-		return nil, errors.E(op, "bad len")
+	if len(cmd.Addresses) == 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "no addresses")
 	}
-	if s[64] != ':' { // sep follows 32 bytes of hex
-		return nil, errors.E(op, "bad separator")
+	addrs := make([]stdaddr.Address, len(cmd.Addresses))
+	for i, a := range cmd.Addresses {
+		addrs[i], err = decodeAddress(a, w.ChainParams())
+		if err != nil {
+			return nil, err
+		}
 	}
-	hash, err := chainhash.NewHashFromStr(s[:64])
-	if err != nil {
-		return nil, errors.E(op, err)
+	err = w.VerifyVotingAddresses(ctx, account, addrs)
+	if errors.Is(err, errors.Invalid) {
+		var e *errors.Error
+		if errors.As(err, &e) && e.Err != nil {
+			err = e.Err
+		}
+		return &types.VerifyVotingAccountResult{Error: err.Error()}, nil
 	}
-	index, err := strconv.ParseUint(s[65:], 10, 32)
 	if err != nil {
-		return nil, errors.E(op, err)
+		return nil, err
 	}
-	return &wire.OutPoint{Hash: *hash, Index: uint32(index)}, nil
+	return &types.VerifyVotingAccountResult{Valid: true}, nil
 }
 
-// walletPubPassphraseChange responds to the walletpubpassphrasechange request
-// by modifying the public passphrase of the wallet.
-func (s *Server) walletPubPassphraseChange(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.WalletPubPassphraseChangeCmd)
+// exportVotePolicies handles the exportvotepolicies command by returning the
+// default consensus and treasury voting policies of the wallet signed by the
+// key of a wallet address.
+func (s *Server) exportVotePolicies(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ExportVotePoliciesCmd)
 	w, ok := s.walletLoader.LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	err := w.ChangePublicPassphrase(ctx, []byte(cmd.OldPassphrase),
-		[]byte(cmd.NewPassphrase))
-	if errors.Is(errors.Passphrase, err) {
-		return nil, rpcErrorf(dcrjson.ErrRPCWalletPassphraseIncorrect, "incorrect passphrase")
+	addr, err := decodeAddress(cmd.Address, w.ChainParams())
+	if err != nil {
+		return nil, err
 	}
-	return nil, err
+	blob, err := w.ExportVotePolicies(ctx, addr)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAddressNotInWallet
+		}
+		if errors.Is(err, errors.Locked) {
+			return nil, errWalletUnlockNeeded
+		}
+		return nil, err
+	}
+	return string(blob), nil
 }
 
-func (s *Server) setAccountPassphrase(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.SetAccountPassphraseCmd)
+// importVotePolicies handles the importvotepolicies command by applying vote
+// policies exported by exportvotepolicies.
+func (s *Server) importVotePolicies(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ImportVotePoliciesCmd)
 	w, ok := s.walletLoader.LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	account, err := w.AccountNumber(ctx, cmd.Account)
+	addr, err := decodeAddress(cmd.Address, w.ChainParams())
 	if err != nil {
-		if errors.Is(err, errors.NotExist) {
-			return nil, errAccountNotFound
+		return nil, err
+	}
+	_, err = w.ImportVotePolicies(ctx, []byte(cmd.Policies), addr)
+	if err != nil {
+		if errors.Is(err, errors.Invalid) || errors.Is(err, errors.Encoding) ||
+			errors.Is(err, errors.Permission) || errors.Is(err, errors.Exist) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
 		}
 		return nil, err
 	}
-	err = w.SetAccountPassphrase(ctx, account, []byte(cmd.Passphrase))
-	return nil, err
+	return nil, nil
 }
 
-func (s *Server) accountUnlocked(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.AccountUnlockedCmd)
+// estimateRawTransaction handles the estimaterawtransaction command by
+// selecting inputs to fund a raw transaction and returning the size and fee of
+// the funded transaction, without deriving change addresses or requiring
+// private keys.
+func (s *Server) estimateRawTransaction(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.EstimateRawTransactionCmd)
 	w, ok := s.walletLoader.LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	account, err := w.AccountNumber(ctx, cmd.Account)
+	p, err := parseFundRawTransaction(ctx, w, cmd.HexString, cmd.FundAccount, cmd.Options)
 	if err != nil {
-		if errors.Is(err, errors.NotExist) {
-			return nil, errAccountNotFound
-		}
 		return nil, err
 	}
-
-	encrypted, err := w.AccountHasPassphrase(ctx, account)
+	e, err := w.EstimateUnsignedTransaction(ctx, p.tx.TxOut, p.feeRate, p.account,
+		p.confs, wallet.OutputSelectionAlgorithmDefault, p.changeSource)
 	if err != nil {
 		return nil, err
 	}
-	if !encrypted {
-		return &types.AccountUnlockedResult{}, nil
-	}
 
-	unlocked, err := w.AccountUnlocked(ctx, account)
-	if err != nil {
-		return nil, err
+	inputs := make([]string, len(e.Inputs))
+	for i := range e.Inputs {
+		inputs[i] = e.Inputs[i].String()
 	}
-
-	return &types.AccountUnlockedResult{
-		Encrypted: true,
-		Unlocked:  &unlocked,
+	return &types.EstimateRawTransactionResult{
+		Inputs:     inputs,
+		TotalInput: e.TotalInput.ToCoin(),
+		Fee:        e.Fee.ToCoin(),
+		Change:     e.Change.ToCoin(),
+		Size:       e.SerializeSize,
+		SignedSize: e.SignedSerializeSize,
 	}, nil
 }
 
-func (s *Server) unlockAccount(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.UnlockAccountCmd)
+// forecastStake handles the forecaststake command by projecting the expected
+// ticket votes and balances of the wallet, assuming the configured ticket
+// buyer parameters unless overridden.
+func (s *Server) forecastStake(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ForecastStakeCmd)
 	w, ok := s.walletLoader.LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	account, err := w.AccountNumber(ctx, cmd.Account)
+	params := w.ChainParams()
+	cfg := &wallet.StakeForecastConfig{
+		Blocks:     *cmd.Blocks,
+		Interval:   *cmd.Interval,
+		BuyTickets: s.cfg.TicketBuyerEnabled,
+		Maintain:   s.cfg.BalanceToMaintain,
+		Limit:      s.cfg.TicketBuyerLimit,
+	}
+	if cfg.Blocks == 0 {
+		// Default to the lifetime of a ticket purchased in the next block
+		// and the maturity of its revocation.
+		cfg.Blocks = int32(params.TicketMaturity) + int32(params.TicketExpiry) +
+			int32(params.CoinbaseMaturity) + 2
+	}
+	if cfg.Interval == 0 {
+		// Default to one day of blocks.
+		cfg.Interval = int32(24 * time.Hour / params.TargetTimePerBlock)
+	}
+	accountName := s.cfg.PurchaseAccount
+	if opts := cmd.Options; opts != nil {
+		var err error
+		if opts.Account != nil {
+			accountName = *opts.Account
+		}
+		if opts.BuyTickets != nil {
+			cfg.BuyTickets = *opts.BuyTickets
+		}
+		if opts.Maintain != nil {
+			cfg.Maintain, err = dcrutil.NewAmount(*opts.Maintain)
+			if err != nil {
+				return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+			}
+		}
+		if opts.Limit != nil {
+			cfg.Limit = *opts.Limit
+		}
+		if opts.TicketPrice != nil {
+			cfg.TicketPrice, err = dcrutil.NewAmount(*opts.TicketPrice)
+			if err != nil {
+				return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+			}
+		}
+	}
+	if accountName == "" {
+		accountName = "default"
+	}
+	account, err := w.AccountNumber(ctx, accountName)
 	if err != nil {
 		if errors.Is(err, errors.NotExist) {
 			return nil, errAccountNotFound
 		}
 		return nil, err
 	}
-	err = w.UnlockAccount(ctx, account, []byte(cmd.Passphrase))
-	return nil, err
+	cfg.Account = account
+
+	f, err := w.ForecastStake(ctx, cfg)
+	if err != nil {
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	res := &types.ForecastStakeResult{
+		TipHeight:   f.TipHeight,
+		TicketPrice: f.TicketPrice.ToCoin(),
+		Tickets:     make([]types.TicketVoteForecastResult, 0, len(f.Tickets)),
+		Samples:     make([]types.StakeForecastSampleResult, 0, len(f.Samples)),
+	}
+	for i := range f.Tickets {
+		t := &f.Tickets[i]
+		res.Tickets = append(res.Tickets, types.TicketVoteForecastResult{
+			Hash:               t.Hash.String(),
+			Value:              t.Value.ToCoin(),
+			FirstVoteHeight:    t.FirstVoteHeight,
+			LastVoteHeight:     t.LastVoteHeight,
+			VoteProbability:    t.VoteProbability,
+			ExpectedVoteHeight: t.ExpectedVoteHeight,
+			ExpectedVoteTime:   t.ExpectedVoteTime.Unix(),
+		})
+	}
+	for i := range f.Samples {
+		sample := &f.Samples[i]
+		res.Samples = append(res.Samples, types.StakeForecastSampleResult{
+			Height:    sample.Height,
+			Time:      sample.Time.Unix(),
+			Locked:    sample.Locked.ToCoin(),
+			Tickets:   sample.Tickets,
+			Immature:  sample.Immature.ToCoin(),
+			Spendable: sample.Spendable.ToCoin(),
+			Votes:     sample.Votes,
+			Purchased: sample.Purchased,
+		})
+	}
+	return res, nil
 }
 
-func (s *Server) lockAccount(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.LockAccountCmd)
+// simulateStake handles the simulatestake command by simulating the expected
+// returns of staking a hypothetical balance.
+func (s *Server) simulateStake(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SimulateStakeCmd)
 	w, ok := s.walletLoader.LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	if cmd.Account == "*" {
-		a, err := w.Accounts(ctx)
+	balance, err := dcrutil.NewAmount(cmd.Amount)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	var price dcrutil.Amount
+	if cmd.TicketPrice != nil {
+		price, err = dcrutil.NewAmount(*cmd.TicketPrice)
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+	}
+	// Bound the period so it can not overflow a time.Duration.  Periods
+	// with too many blocks to simulate are rejected by the wallet.
+	const maxDays = 100 * 365
+	if *cmd.Days < 1 || *cmd.Days > maxDays {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"days must be between 1 and %d", maxDays)
+	}
+	period := time.Duration(*cmd.Days) * 24 * time.Hour
+	blocks := int32(min(int64(period/w.ChainParams().TargetTimePerBlock), math.MaxInt32))
+
+	sim, err := w.SimulateStake(ctx, balance, price, blocks)
+	if err != nil {
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	return &types.SimulateStakeResult{
+		Amount:       sim.Balance.ToCoin(),
+		TicketPrice:  sim.TicketPrice.ToCoin(),
+		Blocks:       sim.Blocks,
+		Value:        sim.Value.ToCoin(),
+		Rewards:      sim.Rewards.ToCoin(),
+		Purchased:    sim.Purchased,
+		Votes:        sim.Votes,
+		Return:       sim.Return,
+		AnnualReturn: sim.AnnualReturn,
+	}, nil
+}
+
+// sigScriptSize returns the worst case size of the signature script of an
+// input redeeming an output script of a named type.
+func sigScriptSize(scriptType string) (int, error) {
+	switch scriptType {
+	case "p2pkh":
+		return txsizes.RedeemP2PKHSigScriptSize, nil
+	case "p2pk":
+		return txsizes.RedeemP2PKSigScriptSize, nil
+	case "p2sh":
+		return txsizes.RedeemP2SHSigScriptSize, nil
+	}
+	var required, keys int
+	_, err := fmt.Sscanf(scriptType, "multisig-%d-of-%d", &required, &keys)
+	if err != nil || required < 1 || keys < required || keys > txscript.MaxPubKeysPerMultiSig {
+		return 0, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"unknown input script type %q", scriptType)
+	}
+	return txsizes.RedeemMultiSigSigScriptSize(required, keys), nil
+}
+
+// estimateTxSize handles the estimatetxsize command by returning the size and
+// fee of a hypothetical transaction with inputs of the given script types
+// paying to addresses.
+func (s *Server) estimateTxSize(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.EstimateTxSizeCmd)
+
+	feeRate := txrules.DefaultRelayFeePerKb
+	if w, ok := s.walletLoader.LoadedWallet(); ok {
+		feeRate = w.RelayFee()
+	}
+	if cmd.FeeRate != nil {
+		var err error
+		feeRate, err = dcrutil.NewAmount(*cmd.FeeRate)
+		if err != nil || feeRate < 0 {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"invalid fee rate %v", *cmd.FeeRate)
+		}
+	}
+
+	inputSizes := make([]int, 0, len(cmd.Inputs))
+	for _, typ := range cmd.Inputs {
+		size, err := sigScriptSize(typ)
 		if err != nil {
 			return nil, err
 		}
-		for _, acct := range a.Accounts {
-			if acct.AccountEncrypted && acct.AccountUnlocked {
-				err = w.LockAccount(ctx, acct.AccountNumber)
-				if err != nil {
-					return nil, err
-				}
+		inputSizes = append(inputSizes, size)
+	}
+	addrs := make([]stdaddr.Address, 0, len(cmd.Outputs))
+	for _, a := range cmd.Outputs {
+		addr, err := decodeAddress(a, s.activeNet)
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, addr)
+	}
+	// stakeScriptSizes returns the sizes of the stake tagged scripts
+	// created by f for each output address.
+	stakeScriptSizes := func(f func(stdaddr.StakeAddress) []byte) ([]int, error) {
+		sizes := make([]int, 0, len(addrs))
+		for _, addr := range addrs {
+			stakeAddr, ok := addr.(stdaddr.StakeAddress)
+			if !ok {
+				return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+					"address %v is not usable by stake transactions", addr)
 			}
+			sizes = append(sizes, len(f(stakeAddr)))
 		}
-		return nil, nil
+		return sizes, nil
 	}
 
-	account, err := w.AccountNumber(ctx, cmd.Account)
-	if err != nil {
-		if errors.Is(err, errors.NotExist) {
-			return nil, errAccountNotFound
+	var size int
+	switch cmd.TxType {
+	case "regular":
+		if len(inputSizes) == 0 {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "no inputs")
 		}
-		return nil, err
+		outputSizes := make([]int, 0, len(addrs))
+		for _, addr := range addrs {
+			_, script := addr.PaymentScript()
+			outputSizes = append(outputSizes, len(script))
+		}
+		var changeScriptSize int
+		if *cmd.Change {
+			changeScriptSize = txsizes.P2PKHPkScriptSize
+		}
+		size = txsizes.EstimateSerializeSizeFromScriptSizes(inputSizes,
+			outputSizes, changeScriptSize)
+
+	case "ticket":
+		if len(inputSizes) == 0 || len(addrs) != 1 {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"tickets require inputs and a single voting address output")
+		}
+		submission, err := stakeScriptSizes(func(a stdaddr.StakeAddress) []byte {
+			_, script := a.VotingRightsScript()
+			return script
+		})
+		if err != nil {
+			return nil, err
+		}
+		// The wallet pays ticket change to P2PKH addresses.
+		size = txsizes.EstimateTicketSize(inputSizes, submission[0],
+			txsizes.P2PKHPkScriptSize+1)
+
+	case "vote":
+		ticketSigScriptSize := txsizes.RedeemP2PKHSigScriptSize
+		switch len(inputSizes) {
+		case 0:
+		case 1:
+			ticketSigScriptSize = inputSizes[0]
+		default:
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"votes spend a single ticket input")
+		}
+		if len(addrs) == 0 {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"votes require reward addresses")
+		}
+		rewards, err := stakeScriptSizes(func(a stdaddr.StakeAddress) []byte {
+			_, script := a.PayVoteCommitmentScript()
+			return script
+		})
+		if err != nil {
+			return nil, err
+		}
+		size = txsizes.EstimateVoteSize(ticketSigScriptSize, rewards)
+
+	case "revocation":
+		if len(inputSizes) != 0 || len(addrs) == 0 {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"revocations require refund addresses and no inputs")
+		}
+		refunds, err := stakeScriptSizes(func(a stdaddr.StakeAddress) []byte {
+			_, script := a.PayRevokeCommitmentScript()
+			return script
+		})
+		if err != nil {
+			return nil, err
+		}
+		size = txsizes.EstimateRevocationSize(refunds)
+
+	default:
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"unknown transaction type %q", cmd.TxType)
 	}
-	err = w.LockAccount(ctx, account)
-	return nil, err
+
+	return &types.EstimateTxSizeResult{
+		Size:    size,
+		Fee:     txrules.FeeForSerializeSize(feeRate, size).ToCoin(),
+		FeeRate: feeRate.ToCoin(),
+	}, nil
 }
 
 // decodeHexStr decodes the hex encoding of a string, possibly prepending a
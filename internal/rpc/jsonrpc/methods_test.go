@@ -0,0 +1,64 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package jsonrpc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/internal/loader"
+	"decred.org/dcrwallet/v5/rpc/jsonrpc/types"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestSpendOutputsHeld(t *testing.T) {
+	ctx := context.Background()
+	params := chaincfg.SimNetParams()
+	l := loader.NewLoader(params, t.TempDir(), false, 20, 0, false, 1e4, 0, 0,
+		false, false, false, 0, nil)
+	privPass := []byte("private")
+	w, err := l.CreateNewWallet(ctx, []byte("public"), privPass,
+		bytes.Repeat([]byte{0x07}, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.UnloadWallet()
+	if err := w.Unlock(ctx, privPass, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, pkScript := addr.PaymentScript()
+	fund := wire.NewMsgTx()
+	fund.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, 0, nil))
+	fund.AddTxOut(&wire.TxOut{Value: 5e8, Version: version, PkScript: pkScript})
+	if err := w.AddTransaction(ctx, fund, &params.GenesisHash); err != nil {
+		t.Fatal(err)
+	}
+	fundHash := fund.TxHash()
+	held := *wire.NewOutPoint(&fundHash, 0, wire.TxTreeRegular)
+	if _, err := w.HoldOutputs(ctx, "hold", "reason", []wire.OutPoint{held}); err != nil {
+		t.Fatal(err)
+	}
+
+	s := &Server{walletLoader: l}
+	_, err = s.spendOutputs(ctx, &types.SpendOutputsCmd{
+		Account:           "default",
+		PreviousOutpoints: []string{fmt.Sprintf("%v:%d", &fundHash, 0)},
+		Outputs: []types.AddressAmountPair{
+			{Address: addr.String(), Amount: 1},
+		},
+	})
+	if !errors.Is(err, errors.Invalid) {
+		t.Fatalf("spent held output: %v", err)
+	}
+}
@@ -6,10 +6,16 @@
 package jsonrpc
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
 	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"github.com/decred/dcrd/dcrjson/v4"
 )
 
 func TestThrottle(t *testing.T) {
@@ -52,3 +58,131 @@ func TestThrottle(t *testing.T) {
 		t.Fatalf("status codes: want: %v, got: %v", want, got)
 	}
 }
+
+func TestApproverAuth(t *testing.T) {
+	authsha := sha256.Sum256(httpBasicAuth("user", "pass"))
+	approvesha := sha256.Sum256(httpBasicAuth("approver", "approvepass"))
+	s := &Server{authsha: &authsha, approvesha: &approvesha}
+
+	tests := []struct {
+		user, pass string
+		approver   bool
+		ok         bool
+	}{
+		{"user", "pass", false, true},
+		{"approver", "approvepass", true, true},
+		{"approver", "pass", false, false},
+	}
+	for _, tc := range tests {
+		r := httptest.NewRequest("POST", "/", nil)
+		r.SetBasicAuth(tc.user, tc.pass)
+		approver, err := s.checkAuthHeader(r)
+		if (err == nil) != tc.ok || approver != tc.approver {
+			t.Errorf("%s:%s: approver %v err %v", tc.user, tc.pass, approver, err)
+		}
+	}
+
+	ctx := context.Background()
+	call := func(ctx context.Context, method string) error {
+		_, err := s.handlerClosure(ctx, &dcrjson.Request{Method: method})()
+		return err
+	}
+	if err := call(ctx, "approvependingspend"); err != errApproverOnly {
+		t.Errorf("user approved spend: %v", err)
+	}
+	if err := call(withApprover(ctx), "getbalance"); err != errApproverMethod {
+		t.Errorf("approver called getbalance: %v", err)
+	}
+}
+
+func TestReplicaMethods(t *testing.T) {
+	for method := range replicaMethods {
+		if _, ok := handlers[method]; !ok {
+			t.Errorf("replica method %q has no handler", method)
+		}
+	}
+
+	s := &Server{cfg: Options{Replica: true}}
+	_, err := s.handlerClosure(context.Background(),
+		&dcrjson.Request{Method: "sendtoaddress"})()
+	if err != errReplicaMethod {
+		t.Errorf("replica called sendtoaddress: %v", err)
+	}
+}
+
+func TestErrorData(t *testing.T) {
+	gapLimit := errors.Detail{
+		Code:   errors.ErrGapLimit,
+		Hint:   "increase the gap limit",
+		Params: map[string]string{"gaplimit": "20"},
+	}
+	tests := []struct {
+		err  error
+		code dcrjson.RPCErrorCode
+		data errors.Code
+	}{
+		{errors.E(errors.Locked, errors.Detail{Code: errors.ErrLockedAccount}), dcrjson.ErrRPCWalletUnlockNeeded, errors.ErrLockedAccount},
+		{errors.E(errors.InsufficientBalance), dcrjson.ErrRPCWalletInsufficientFunds, errors.ErrInsufficientBalance},
+		{errWalletUnlockNeeded, dcrjson.ErrRPCWalletUnlockNeeded, errors.ErrLocked},
+		{errAccountNotFound, dcrjson.ErrRPCWalletInvalidAccountName, errors.ErrNotExist},
+		{errors.New("unclassified"), dcrjson.ErrRPCWallet, errors.ErrUnknown},
+		{rpcError(dcrjson.ErrRPCInvalidParameter, errors.E(errors.Invalid, gapLimit)), dcrjson.ErrRPCInvalidParameter, errors.ErrGapLimit},
+		{rpcError(dcrjson.ErrRPCInvalidParameter, errors.E(errors.NotExist)), dcrjson.ErrRPCInvalidParameter, errors.ErrNotExist},
+		{rpcError(dcrjson.ErrRPCInvalidParameter, errors.New("unclassified")), dcrjson.ErrRPCInvalidParameter, errors.ErrInvalid},
+	}
+	for i, tc := range tests {
+		b, err := marshalResponse("1.0", 1, nil, tc.err)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var resp response
+		if err := json.Unmarshal(b, &resp); err != nil {
+			t.Fatal(err)
+		}
+		if resp.Error == nil || resp.Error.Data == nil {
+			t.Fatalf("test %d: missing error data: %s", i, b)
+		}
+		if resp.Error.Code != tc.code || resp.Error.Data.Code != tc.data {
+			t.Errorf("test %d: code %v data %v, want %v %v", i,
+				resp.Error.Code, resp.Error.Data.Code, tc.code, tc.data)
+		}
+		if tc.data == gapLimit.Code && (resp.Error.Data.Hint != gapLimit.Hint ||
+			resp.Error.Data.Params["gaplimit"] != gapLimit.Params["gaplimit"]) {
+			t.Errorf("test %d: data %+v, want %+v", i, resp.Error.Data, gapLimit)
+		}
+	}
+}
+
+func TestParseHardenedPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want []uint32
+	}{
+		{"13'", []uint32{13}},
+		{"13'/0h", []uint32{13, 0}},
+		{"2147483647'", []uint32{1<<31 - 1}},
+		{"", nil},
+		{"13", nil},
+		{"13'/0", nil},
+		{"13''", nil},
+		{"2147483648'", nil},
+		{"m/13'", nil},
+		{"-1'", nil},
+	}
+	for _, tc := range tests {
+		path, err := parseHardenedPath(tc.path)
+		if tc.want == nil {
+			if err == nil {
+				t.Errorf("%q: parsed invalid path as %v", tc.path, path)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: %v", tc.path, err)
+			continue
+		}
+		if !reflect.DeepEqual(path, tc.want) {
+			t.Errorf("%q: parsed %v, want %v", tc.path, path, tc.want)
+		}
+	}
+}
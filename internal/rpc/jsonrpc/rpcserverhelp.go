@@ -4,109 +4,197 @@ package jsonrpc
 
 func helpDescsEnUS() map[string]string {
 	return map[string]string{
-		"abandontransaction":        "abandontransaction \"hash\"\n\nRemove an unconfirmed transaction and all dependent transactions\n\nArguments:\n1. hash (string, required) Hash of transaction to remove\n\nResult:\nNothing\n",
-		"accountaddressindex":       "accountaddressindex \"account\" branch\n\nGet the current address index for some account branch\n\nArguments:\n1. account (string, required)  String for the account\n2. branch  (numeric, required) Number for the branch (0=external, 1=internal)\n\nResult:\nn (numeric) The address index for this account branch\n",
-		"accountsyncaddressindex":   "accountsyncaddressindex \"account\" branch index\n\nSynchronize an account branch to some passed address index\n\nArguments:\n1. account (string, required)  String for the account\n2. branch  (numeric, required) Number for the branch (0=external, 1=internal)\n3. index   (numeric, required) The address index to synchronize to\n\nResult:\nNothing\n",
-		"accountunlocked":           "accountunlocked \"account\"\n\nReport account encryption and locked status\n\nArguments:\n1. account (string, required) Account name\n\nResult:\n{\n \"encrypted\": true|false, (boolean) Whether the account is individually encrypted with a separate passphrase\n \"unlocked\": true|false,  (boolean) If the individually encrypted account is unlocked. Omitted for unencrypted accounts.\n}                         \n",
-		"addmultisigaddress":        "addmultisigaddress nrequired [\"key\",...] (\"account\")\n\nGenerates and imports a multisig address and redeeming script to the 'imported' account.\n\nArguments:\n1. nrequired (numeric, required)         The number of signatures required to redeem outputs paid to this address\n2. keys      (array of string, required) Pubkeys and/or pay-to-pubkey-hash addresses to partially control the multisig address\n3. account   (string, optional)          DEPRECATED -- Unused (all imported addresses belong to the imported account)\n\nResult:\n\"value\" (string) The imported pay-to-script-hash address\n",
-		"addtransaction":            "addtransaction \"blockhash\" \"transaction\"\n\nManually record a transaction mined in a main chain block\n\nArguments:\n1. blockhash   (string, required) Hash of block which mines transaction\n2. transaction (string, required) Hex-encoded serialized transaction\n\nResult:\nNothing\n",
-		"auditreuse":                "auditreuse (since)\n\nReports outputs identifying address reuse\n\nArguments:\n1. since (numeric, optional) Only report reusage since some main chain block height\n\nResult:\n{\n \"Array of outpoints referencing the reused address\": Reused address, (object) Object keying reused addresses to arrays of outpoint strings\n ...\n}\n",
-		"consolidate":               "consolidate inputs (\"account\" \"address\")\n\nConsolidate n many UTXOs into a single output in the wallet.\n\nArguments:\n1. inputs  (numeric, required) Number of UTXOs to consolidate as inputs\n2. account (string, optional)  Optional: Account from which unspent outputs are picked. When no address specified, also the account used to obtain an output address.\n3. address (string, optional)  Optional: Address to pay.  Default is obtained via getnewaddress from the account's address pool.\n\nResult:\n\"value\" (string) Transaction hash for the consolidation transaction\n",
-		"createmultisig":            "createmultisig nrequired [\"key\",...]\n\nGenerate a multisig address and redeem script.\n\nArguments:\n1. nrequired (numeric, required)         The number of signatures required to redeem outputs paid to this address\n2. keys      (array of string, required) Pubkeys and/or pay-to-pubkey-hash addresses to partially control the multisig address\n\nResult:\n{\n \"address\": \"value\",      (string) The generated pay-to-script-hash address\n \"redeemScript\": \"value\", (string) The script required to redeem outputs paid to the multisig address\n}                         \n",
-		"createnewaccount":          "createnewaccount \"account\"\n\nCreates a new account.\nThe wallet must be unlocked for this request to succeed.\n\nArguments:\n1. account (string, required) Name of the new account\n\nResult:\nNothing\n",
-		"createrawtransaction":      "createrawtransaction [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...] {\"address\":amount,...} (locktime expiry)\n\nReturns a new transaction spending the provided inputs and sending to the provided addresses.\nThe transaction inputs are not signed in the created transaction.\nThe signrawtransaction RPC command provided by wallet must be used to sign the resulting transaction.\n\nArguments:\n1. inputs (array of object, required) The inputs to the transaction\n[{\n \"amount\": n.nnn, (numeric) The previous output amount\n \"txid\": \"value\", (string)  The transaction hash of the referenced output\n \"vout\": n,       (numeric) The output index of the referenced output\n \"tree\": n,       (numeric) The tree to generate transaction for\n},...]\n2. amounts (object, required) JSON object with the destination addresses as keys and amounts as values\n{\n \"address\": n.nnn, (object) The destination address as the key and the amount in DCR as the value\n ...\n}\n3. locktime (numeric, optional) Locktime value; a non-zero value will also locktime-activate the inputs\n4. expiry   (numeric, optional) Expiry value; a non-zero value when the transaction expiry\n\nResult:\n\"value\" (string) Hex-encoded bytes of the serialized transaction\n",
-		"createsignature":           "createsignature \"address\" inputindex hashtype \"previouspkscript\" \"serializedtransaction\"\n\nGenerate a signature for a transaction input script.\n\nArguments:\n1. address               (string, required)  The address of the private key to use to create the signature.\n2. inputindex            (numeric, required) The index of the transaction input to sign.\n3. hashtype              (numeric, required) The signature hash flags to use.\n4. previouspkscript      (string, required)  The hex encoded previous output script or P2SH redeem script.\n5. serializedtransaction (string, required)  The hex encoded transaction to add input signatures to.\n\nResult:\n{\n \"signature\": \"value\", (string) The hex encoded signature.\n \"publickey\": \"value\", (string) The hex encoded serialized compressed pubkey of the address.\n}                      \n",
-		"debuglevel":                "debuglevel \"levelspec\"\n\nDynamically changes the debug logging level.\nThe levelspec can either a debug level or of the form:\n<subsystem>=<level>,<subsystem2>=<level2>,...\nThe valid debug levels are trace, debug, info, warn, error, and critical.\nThe valid subsystems are CMGR, DCRW, GRPC, LODR, MIXC, MIXP, PEER, RPCS, SYNC, TKBY, VSPC, and WLLT.\nFinally the keyword 'show' will return a list of the available subsystems.\n\nArguments:\n1. levelspec (string, required) The debug level(s) to use or the keyword 'show'\n\nResult:\n\"value\" (string) The string 'Done.'\n",
-		"disapprovepercent":         "disapprovepercent\n\nReturns the wallet's current block disapprove percent per vote. i.e. 100 means that all votes disapprove the block they are called on. Only used for testing purposes.\n\nArguments:\nNone\n\nResult:\nn (numeric) The disapprove percent. When voting, this percent of votes will randomly disapprove the block they are called on.\n",
-		"discoverusage":             "discoverusage (\"startblock\" discoveraccounts gaplimit)\n\nPerform address and/or account discovery\n\nArguments:\n1. startblock       (string, optional)  Hash of block to begin discovery from, or null to scan from the genesis block\n2. discoveraccounts (boolean, optional) Perform account discovery in addition to address discovery.  Requires unlocked wallet.\n3. gaplimit         (numeric, optional) Allowed unused address gap.\n\nResult:\nNothing\n",
-		"dumpprivkey":               "dumpprivkey \"address\"\n\nReturns the private key in WIF encoding that controls some wallet address.\n\nArguments:\n1. address (string, required) The address to return a private key for\n\nResult:\n\"value\" (string) The WIF-encoded private key\n",
-		"fundrawtransaction":        "fundrawtransaction \"hexstring\" \"fundaccount\" ({\"changeaddress\":changeaddress,\"feerate\":feerate,\"conftarget\":conftarget})\n\nAdds unsigned inputs and change output to a raw transaction\n\nArguments:\n1. hexstring   (string, required) Serialized transaction in hex encoding\n2. fundaccount (string, required) Account of outputs to spend in transaction\n3. options     (object, optional) Object to specify fixed change address, alternative fee rate, and confirmation target\n{\n \"changeaddress\": \"value\", (string)  Provide a change address rather than deriving one from the funding account\n \"feerate\": n.nnn,         (numeric) Alternative fee rate\n \"conf_target\": n,         (numeric) Required confirmations of selected previous outputs\n}                          \n\nResult:\n{\n \"hex\": \"value\", (string)  Funded transaction in hex encoding\n \"fee\": n.nnn,   (numeric) Absolute fee of funded transaction\n}                \n",
-		"getaccount":                "getaccount \"address\"\n\nLookup the account name that some wallet address belongs to.\n\nArguments:\n1. address (string, required) The address to query the account for\n\nResult:\n\"value\" (string) The name of the account that 'address' belongs to\n",
-		"getaccountaddress":         "getaccountaddress \"account\"\n\nDEPRECATED -- Returns the most recent external payment address for an account that has not been seen publicly.\nA new address is generated for the account if the most recently generated address has been seen on the blockchain or in mempool.\n\nArguments:\n1. account (string, required) The account of the returned address\n\nResult:\n\"value\" (string) The unused address for 'account'\n",
-		"getaddressesbyaccount":     "getaddressesbyaccount \"account\"\n\nDEPRECATED -- Returns all addresses strings controlled by a single account.\n\nArguments:\n1. account (string, required) Account name to fetch addresses for\n\nResult:\n[\"value\",...] (array of string) All addresses controlled by 'account'\n",
-		"getbalance":                "getbalance (\"account\" minconf=1)\n\nCalculates and returns the balance of all accounts.\n\nArguments:\n1. account (string, optional)             The account name to query the balance for, or \"*\" to consider all accounts (default=\"*\")\n2. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an unspent output's value is included in the balance\n\nResult:\n{\n \"balances\": [{                         (array of object) Balances for all accounts.\n  \"accountname\": \"value\",               (string)          Name of account.\n  \"immaturecoinbaserewards\": n.nnn,     (numeric)         Immature Coinbase reward coins.\n  \"immaturestakegeneration\": n.nnn,     (numeric)         Number of immature stake coins.\n  \"lockedbytickets\": n.nnn,             (numeric)         Coins locked by tickets.\n  \"spendable\": n.nnn,                   (numeric)         Spendable number of coins.\n  \"total\": n.nnn,                       (numeric)         Total amount of coins.\n  \"unconfirmed\": n.nnn,                 (numeric)         Unconfirmed number of coins.\n  \"votingauthority\": n.nnn,             (numeric)         Coins for voting authority.\n },...],                                                  \n \"blockhash\": \"value\",                  (string)          Block hash.\n \"totalimmaturecoinbaserewards\": n.nnn, (numeric)         Total number of immature coinbase reward coins.\n \"totalimmaturestakegeneration\": n.nnn, (numeric)         Total number of immature stake coins.\n \"totallockedbytickets\": n.nnn,         (numeric)         Total number of coins locked by tickets.\n \"totalspendable\": n.nnn,               (numeric)         Total number of spendable number of coins.\n \"cumulativetotal\": n.nnn,              (numeric)         Total number of coins.\n \"totalunconfirmed\": n.nnn,             (numeric)         Total number of unconfirmed coins.\n \"totalvotingauthority\": n.nnn,         (numeric)         Total number of coins for voting authority.\n}                                       \n",
-		"getbestblock":              "getbestblock\n\nReturns the hash and height of the newest block in the best chain that wallet has finished syncing with.\n\nArguments:\nNone\n\nResult:\n{\n \"hash\": \"value\", (string)  The hash of the block\n \"height\": n,     (numeric) The blockchain height of the block\n}                 \n",
-		"getbestblockhash":          "getbestblockhash\n\nReturns the hash of the newest block in the best chain that wallet has finished syncing with.\n\nArguments:\nNone\n\nResult:\n\"value\" (string) The hash of the most recent synced-to block\n",
-		"getblockcount":             "getblockcount\n\nReturns the blockchain height of the newest block in the best chain that wallet has finished syncing with.\n\nArguments:\nNone\n\nResult:\nn.nnn (numeric) The blockchain height of the most recent synced-to block\n",
-		"getblockhash":              "getblockhash index\n\nReturns the hash of a main chain block at some height\n\nArguments:\n1. index (numeric, required) The block height\n\nResult:\n\"value\" (string) The main chain block hash\n",
-		"getblockheader":            "getblockheader \"hash\" (verbose=true)\n\nReturns information about a block header given its hash.\n\nArguments:\n1. hash    (string, required)                The hash of the block\n2. verbose (boolean, optional, default=true) Specifies the block header is returned as a JSON object instead of hex-encoded string\n\nResult:\n{\n \"hash\": \"value\",              (string)  The hash of the block (same as provided)\n \"powhash\": \"value\",           (string)  The Proof-of-Work hash of the block (same as hash prior to DCP0011 activation)\n \"confirmations\": n,           (numeric) The number of confirmations\n \"version\": n,                 (numeric) The block version\n \"merkleroot\": \"value\",        (string)  The merkle root of the regular transaction tree\n \"stakeroot\": \"value\",         (string)  The merkle root of the stake transaction tree\n \"votebits\": n,                (numeric) The vote bits\n \"finalstate\": \"value\",        (string)  The final state value of the ticket pool\n \"voters\": n,                  (numeric) The number of votes in the block\n \"freshstake\": n,              (numeric) The number of new tickets in the block\n \"revocations\": n,             (numeric) The number of revocations in the block\n \"poolsize\": n,                (numeric) The size of the live ticket pool\n \"bits\": \"value\",              (string)  The bits which represent the block difficulty\n \"sbits\": n.nnn,               (numeric) The stake difficulty in coins\n \"height\": n,                  (numeric) The height of the block in the block chain\n \"size\": n,                    (numeric) The size of the block in bytes\n \"time\": n,                    (numeric) The block time in seconds since 1 Jan 1970 GMT\n \"mediantime\": n,              (numeric) The median block time over the last 11 blocks\n \"nonce\": n,                   (numeric) The block nonce\n \"extradata\": \"value\",         (string)  Extra data field for the requested block\n \"stakeversion\": n,            (numeric) The stake version of the block\n \"difficulty\": n.nnn,          (numeric) The proof-of-work difficulty as a multiple of the minimum difficulty\n \"chainwork\": \"value\",         (string)  The total number of hashes expected to produce the chain up to the block in hex (not set in SPV mode)\n \"previousblockhash\": \"value\", (string)  The hash of the previous block\n \"nextblockhash\": \"value\",     (string)  The hash of the next block (only if there is one)\n}                              \n",
-		"getblock":                  "getblock \"hash\" (verbose=true verbosetx=false)\n\nReturns information about a block given its hash.\n\nArguments:\n1. hash      (string, required)                 The hash of the block\n2. verbose   (boolean, optional, default=true)  Specifies the block is returned as a JSON object instead of hex-encoded string\n3. verbosetx (boolean, optional, default=false) Specifies that each transaction is returned as a JSON object and only applies if the verbose flag is true (dcrd extension)\n\nResult:\n{\n \"hash\": \"value\",               (string)          The hash of the block (same as provided)\n \"powhash\": \"value\",            (string)          The Proof-of-Work hash of the block (same as hash prior to DCP0011 activation)\n \"confirmations\": n,            (numeric)         The number of confirmations\n \"size\": n,                     (numeric)         The size of the block\n \"height\": n,                   (numeric)         The height of the block in the block chain\n \"version\": n,                  (numeric)         The block version\n \"merkleroot\": \"value\",         (string)          Root hash of the merkle tree\n \"stakeroot\": \"value\",          (string)          The block's sstx hashes the were included\n \"tx\": [\"value\",...],           (array of string) The transaction hashes (only when verbosetx=false)\n \"rawtx\": [{                    (array of object) The transactions as JSON objects (only when verbosetx=true)\n  \"hex\": \"value\",               (string)          Hex-encoded transaction\n  \"txid\": \"value\",              (string)          The hash of the transaction\n  \"version\": n,                 (numeric)         The transaction version\n  \"locktime\": n,                (numeric)         The transaction lock time\n  \"expiry\": n,                  (numeric)         The transacion expiry\n  \"vin\": [{                     (array of object) The transaction inputs as JSON objects\n   \"coinbase\": \"value\",         (string)          The hex-encoded bytes of the signature script (coinbase txns only)\n   \"stakebase\": \"value\",        (string)          The hex-encoded bytes of the signature script (vote txns only)\n   \"treasurybase\": true|false,  (boolean)         Whether or not the input is a treasury base (treasurybase txns only)\n   \"treasuryspend\": \"value\",    (string)          The hex-encoded bytes of the signature script (treasury spend txns only)\n   \"txid\": \"value\",             (string)          The hash of the origin transaction (non-coinbase txns only)\n   \"vout\": n,                   (numeric)         The index of the output being redeemed from the origin transaction (non-coinbase txns only)\n   \"tree\": n,                   (numeric)         The tree of the transaction\n   \"sequence\": n,               (numeric)         The script sequence number\n   \"amountin\": n.nnn,           (numeric)         The amount in\n   \"blockheight\": n,            (numeric)         The block height of the origin transaction\n   \"blockindex\": n,             (numeric)         The block idx of the origin transaction\n   \"scriptSig\": {               (object)          The signature script used to redeem the origin transaction as a JSON object (non-coinbase txns only)\n    \"asm\": \"value\",             (string)          Disassembly of the script\n    \"hex\": \"value\",             (string)          Hex-encoded bytes of the script\n   },                                             \n  },...],                                         \n  \"vout\": [{                    (array of object) The transaction outputs as JSON objects\n   \"value\": n.nnn,              (numeric)         The amount in DCR\n   \"n\": n,                      (numeric)         The index of this transaction output\n   \"version\": n,                (numeric)         The version of the public key script\n   \"scriptPubKey\": {            (object)          The public key script used to pay coins as a JSON object\n    \"asm\": \"value\",             (string)          Disassembly of the script\n    \"hex\": \"value\",             (string)          Hex-encoded bytes of the script\n    \"reqSigs\": n,               (numeric)         The number of required signatures\n    \"type\": \"value\",            (string)          The type of the script (e.g. 'pubkeyhash')\n    \"addresses\": [\"value\",...], (array of string) The Decred addresses associated with this script\n    \"commitamt\": n.nnn,         (numeric)         The ticket commitment value if the script is for a staking commitment\n    \"version\": n,               (numeric)         The script version\n   },                                             \n  },...],                                         \n  \"blockhash\": \"value\",         (string)          The hash of the block that contains the transaction\n  \"blockheight\": n,             (numeric)         The height of the block that contains the transaction\n  \"blockindex\": n,              (numeric)         The index within the array of transactions contained by the block\n  \"confirmations\": n,           (numeric)         Number of confirmations of the block\n  \"time\": n,                    (numeric)         Transaction time in seconds since 1 Jan 1970 GMT\n  \"blocktime\": n,               (numeric)         Block time in seconds since the 1 Jan 1970 GMT\n },...],                                          \n \"stx\": [\"value\",...],          (array of string) The block's sstx hashes the were included\n \"rawstx\": [{                   (array of object) The block's raw sstx hashes the were included\n  \"hex\": \"value\",               (string)          Hex-encoded transaction\n  \"txid\": \"value\",              (string)          The hash of the transaction\n  \"version\": n,                 (numeric)         The transaction version\n  \"locktime\": n,                (numeric)         The transaction lock time\n  \"expiry\": n,                  (numeric)         The transacion expiry\n  \"vin\": [{                     (array of object) The transaction inputs as JSON objects\n   \"coinbase\": \"value\",         (string)          The hex-encoded bytes of the signature script (coinbase txns only)\n   \"stakebase\": \"value\",        (string)          The hex-encoded bytes of the signature script (vote txns only)\n   \"treasurybase\": true|false,  (boolean)         Whether or not the input is a treasury base (treasurybase txns only)\n   \"treasuryspend\": \"value\",    (string)          The hex-encoded bytes of the signature script (treasury spend txns only)\n   \"txid\": \"value\",             (string)          The hash of the origin transaction (non-coinbase txns only)\n   \"vout\": n,                   (numeric)         The index of the output being redeemed from the origin transaction (non-coinbase txns only)\n   \"tree\": n,                   (numeric)         The tree of the transaction\n   \"sequence\": n,               (numeric)         The script sequence number\n   \"amountin\": n.nnn,           (numeric)         The amount in\n   \"blockheight\": n,            (numeric)         The block height of the origin transaction\n   \"blockindex\": n,             (numeric)         The block idx of the origin transaction\n   \"scriptSig\": {               (object)          The signature script used to redeem the origin transaction as a JSON object (non-coinbase txns only)\n    \"asm\": \"value\",             (string)          Disassembly of the script\n    \"hex\": \"value\",             (string)          Hex-encoded bytes of the script\n   },                                             \n  },...],                                         \n  \"vout\": [{                    (array of object) The transaction outputs as JSON objects\n   \"value\": n.nnn,              (numeric)         The amount in DCR\n   \"n\": n,                      (numeric)         The index of this transaction output\n   \"version\": n,                (numeric)         The version of the public key script\n   \"scriptPubKey\": {            (object)          The public key script used to pay coins as a JSON object\n    \"asm\": \"value\",             (string)          Disassembly of the script\n    \"hex\": \"value\",             (string)          Hex-encoded bytes of the script\n    \"reqSigs\": n,               (numeric)         The number of required signatures\n    \"type\": \"value\",            (string)          The type of the script (e.g. 'pubkeyhash')\n    \"addresses\": [\"value\",...], (array of string) The Decred addresses associated with this script\n    \"commitamt\": n.nnn,         (numeric)         The ticket commitment value if the script is for a staking commitment\n    \"version\": n,               (numeric)         The script version\n   },                                             \n  },...],                                         \n  \"blockhash\": \"value\",         (string)          The hash of the block that contains the transaction\n  \"blockheight\": n,             (numeric)         The height of the block that contains the transaction\n  \"blockindex\": n,              (numeric)         The index within the array of transactions contained by the block\n  \"confirmations\": n,           (numeric)         Number of confirmations of the block\n  \"time\": n,                    (numeric)         Transaction time in seconds since 1 Jan 1970 GMT\n  \"blocktime\": n,               (numeric)         Block time in seconds since the 1 Jan 1970 GMT\n },...],                                          \n \"time\": n,                     (numeric)         The block time in seconds since 1 Jan 1970 GMT\n \"mediantime\": n,               (numeric)         The median block time over the last 11 blocks\n \"nonce\": n,                    (numeric)         The block nonce\n \"votebits\": n,                 (numeric)         The block's voting results\n \"finalstate\": \"value\",         (string)          The block's finalstate\n \"voters\": n,                   (numeric)         The number votes in the block\n \"freshstake\": n,               (numeric)         The number of new tickets in the block\n \"revocations\": n,              (numeric)         The number of revocations in the block\n \"poolsize\": n,                 (numeric)         The size of the live ticket pool\n \"bits\": \"value\",               (string)          The bits which represent the block difficulty\n \"sbits\": n.nnn,                (numeric)         The stake difficulty of the block\n \"extradata\": \"value\",          (string)          Extra data field for the requested block\n \"stakeversion\": n,             (numeric)         Stake Version of the block\n \"difficulty\": n.nnn,           (numeric)         The proof-of-work difficulty as a multiple of the minimum difficulty\n \"chainwork\": \"value\",          (string)          The total number of hashes expected to produce the chain up to the block in hex\n \"previousblockhash\": \"value\",  (string)          The hash of the previous block\n \"nextblockhash\": \"value\",      (string)          The hash of the next block (only if there is one)\n}                               \n",
-		"getcoinjoinsbyacct":        "getcoinjoinsbyacct\n\nGet coinjoin outputs by account.\n\nArguments:\nNone\n\nResult:\n{\n \"Accounts name\": Coinjoin outputs sum., (object) Return a map of account's name and its coinjoin outputs sum.\n ...\n}\n",
-		"getcurrentnet":             "getcurrentnet\n\nGet Decred network the wallet is connected to.\n\nArguments:\nNone\n\nResult:\nn (numeric) The network identifier\n",
-		"getinfo":                   "getinfo\n\nReturns a JSON object containing various state info.\n\nArguments:\nNone\n\nResult:\n{\n \"version\": n,          (numeric) The version of the server\n \"protocolversion\": n,  (numeric) The latest supported protocol version\n \"walletversion\": n,    (numeric) The version of the address manager database\n \"balance\": n.nnn,      (numeric) The balance of all accounts calculated with one block confirmation\n \"blocks\": n,           (numeric) The number of blocks processed\n \"timeoffset\": n,       (numeric) The time offset\n \"connections\": n,      (numeric) The number of connected peers\n \"proxy\": \"value\",      (string)  The proxy used by the server\n \"difficulty\": n.nnn,   (numeric) The current target difficulty\n \"testnet\": true|false, (boolean) Whether or not server is using testnet\n \"keypoololdest\": n,    (numeric) Unset\n \"keypoolsize\": n,      (numeric) Unset\n \"unlocked_until\": n,   (numeric) Unset\n \"paytxfee\": n.nnn,     (numeric) The fee per kB of the serialized tx size used each time more fee is required for an authored transaction\n \"relayfee\": n.nnn,     (numeric) The minimum relay fee for non-free transactions in DCR/KB\n \"errors\": \"value\",     (string)  Any current errors\n}                       \n",
-		"getmasterpubkey":           "getmasterpubkey (\"account\")\n\nRequests the master pubkey from the wallet.\n\nArguments:\n1. account (string, optional) The account to get the master pubkey for\n\nResult:\n\"value\" (string) The master pubkey for the wallet\n",
-		"getmultisigoutinfo":        "getmultisigoutinfo \"hash\" index\n\nReturns information about a multisignature output.\n\nArguments:\n1. hash  (string, required)  Input hash to check.\n2. index (numeric, required) Index of input.\n\nResult:\n{\n \"address\": \"value\",       (string)          Script address.\n \"redeemscript\": \"value\",  (string)          Hex of the redeeming script.\n \"m\": n,                   (numeric)         m (in m-of-n)\n \"n\": n,                   (numeric)         n (in m-of-n)\n \"pubkeys\": [\"value\",...], (array of string) Associated pubkeys.\n \"txhash\": \"value\",        (string)          txhash\n \"blockheight\": n,         (numeric)         Height of the containing block.\n \"blockhash\": \"value\",     (string)          Hash of the containing block.\n \"spent\": true|false,      (boolean)         If it has been spent.\n \"spentby\": \"value\",       (string)          Hash of spending tx.\n \"spentbyindex\": n,        (numeric)         Index of spending tx.\n \"amount\": n.nnn,          (numeric)         Amount of coins contained.\n}                          \n",
-		"getnewaddress":             "getnewaddress (\"account\" \"gappolicy\")\n\nGenerates and returns a new payment address.\n\nArguments:\n1. account   (string, optional) Account name the new address will belong to (default=\"default\")\n2. gappolicy (string, optional) String defining the policy to use when the BIP0044 gap limit would be violated, may be \"error\", \"ignore\", or \"wrap\"\n\nResult:\n\"value\" (string) The payment address\n",
-		"getpeerinfo":               "getpeerinfo\n\nReturns data on remote peers when in spv mode.\n\nArguments:\nNone\n\nResult:\n{\n \"id\": n,              (numeric) A unique node ID\n \"addr\": \"value\",      (string)  The remote IP address and port of the peer\n \"addrlocal\": \"value\", (string)  The local IP address and port of the peer\n \"services\": \"value\",  (string)  Services bitmask which represents the services supported by the peer\n \"version\": n,         (numeric) The protocol version of the peer\n \"subver\": \"value\",    (string)  The user agent of the peer\n \"startingheight\": n,  (numeric) The latest block height the peer knew about when the connection was established\n \"banscore\": n,        (numeric) The ban score\n}                      \n",
-		"getrawchangeaddress":       "getrawchangeaddress (\"account\")\n\nGenerates and returns a new internal payment address for use as a change address in raw transactions.\n\nArguments:\n1. account (string, optional) Account name the new internal address will belong to (default=\"default\")\n\nResult:\n\"value\" (string) The internal payment address\n",
-		"getreceivedbyaccount":      "getreceivedbyaccount \"account\" (minconf=1)\n\nReturns the total amount received by addresses of some account, including spent outputs.\n\nArguments:\n1. account (string, required)             Account name to query total received amount for\n2. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an output's value is included in the total\n\nResult:\nn.nnn (numeric) The total received amount valued in decred\n",
-		"getreceivedbyaddress":      "getreceivedbyaddress \"address\" (minconf=1)\n\nReturns the total amount received by a single address, including spent outputs.\n\nArguments:\n1. address (string, required)             Payment address which received outputs to include in total\n2. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an output's value is included in the total\n\nResult:\nn.nnn (numeric) The total received amount valued in decred\n",
-		"getstakeinfo":              "getstakeinfo\n\nReturns statistics about staking from the wallet.\n\nArguments:\nNone\n\nResult:\n{\n \"blockheight\": n,          (numeric) Current block height for stake info.\n \"difficulty\": n.nnn,       (numeric) Current stake difficulty.\n \"totalsubsidy\": n.nnn,     (numeric) Total amount of coins earned by proof-of-stake voting\n \"ownmempooltix\": n,        (numeric) Number of tickets submitted by this wallet currently in mempool\n \"immature\": n,             (numeric) Number of tickets from this wallet that are in the blockchain but which are not yet mature\n \"unspent\": n,              (numeric) Number of unspent tickets\n \"voted\": n,                (numeric) Number of votes cast by this wallet\n \"revoked\": n,              (numeric) Number of missed tickets that were missed and then revoked\n \"unspentexpired\": n,       (numeric) Number of unspent tickets which are past expiry\n \"poolsize\": n,             (numeric) Number of live tickets in the ticket pool.\n \"allmempooltix\": n,        (numeric) Number of tickets currently in the mempool\n \"live\": n,                 (numeric) Number of mature, active tickets owned by this wallet\n \"proportionlive\": n.nnn,   (numeric) (Live / PoolSize)\n \"missed\": n,               (numeric) Number of missed tickets (failure to vote, not including expired)\n \"proportionmissed\": n.nnn, (numeric) (Missed / (Missed + Voted))\n \"expired\": n,              (numeric) Number of tickets that have expired\n}                           \n",
-		"gettickets":                "gettickets includeimmature\n\nReturning the hashes of the tickets currently owned by wallet.\n\nArguments:\n1. includeimmature (boolean, required) If true include immature tickets in the results.\n\nResult:\n{\n \"hashes\": [\"value\",...], (array of string) Hashes of the tickets owned by the wallet encoded as strings\n}                         \n",
-		"gettransaction":            "gettransaction \"txid\" (includewatchonly=false)\n\nReturns a JSON object with details regarding a transaction relevant to this wallet.\n\nArguments:\n1. txid             (string, required)                 Hash of the transaction to query\n2. includewatchonly (boolean, optional, default=false) Also consider transactions involving watched addresses\n\nResult:\n{\n \"amount\": n.nnn,                  (numeric)         The total amount this transaction credits to the wallet, valued in decred\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value, or 0 if 'txid' is not a sent transaction\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"txid\": \"value\",                  (string)          The transaction hash\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"details\": [{                     (array of object) Additional details for each recorded wallet credit and debit\n  \"account\": \"value\",              (string)          DEPRECATED -- Unset\n  \"address\": \"value\",              (string)          The address an output was paid to, or the empty string if the output is nonstandard or this detail is regarding a transaction input\n  \"amount\": n.nnn,                 (numeric)         The amount of a received output\n  \"category\": \"value\",             (string)          The kind of detail: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs\n  \"involveswatchonly\": true|false, (boolean)         Unset\n  \"fee\": n.nnn,                    (numeric)         The included fee for a sent transaction\n  \"vout\": n,                       (numeric)         The transaction output index\n },...],                                             \n \"hex\": \"value\",                   (string)          The transaction encoded as a hexadecimal string\n \"type\": \"value\",                  (string)          The type of transaction (regular, ticket, vote, or revocation)\n \"ticketstatus\": \"value\",          (string)          Status of ticket (if transaction is a ticket)\n}                                  \n",
-		"gettxout":                  "gettxout \"txid\" vout tree (includemempool=true)\n\nReturns information about an unspent transaction output.\n\nArguments:\n1. txid           (string, required)                The hash of the transaction\n2. vout           (numeric, required)               The index of the output\n3. tree           (numeric, required)               The tree of the transaction\n4. includemempool (boolean, optional, default=true) Include the mempool when true\n\nResult:\n{\n \"bestblock\": \"value\",        (string)          The block hash that contains the transaction output\n \"confirmations\": n,          (numeric)         The number of confirmations\n \"value\": n.nnn,              (numeric)         The transaction amount in DCR\n \"scriptPubKey\": {            (object)          The public key script used to pay coins as a JSON object\n  \"asm\": \"value\",             (string)          Disassembly of the script\n  \"hex\": \"value\",             (string)          Hex-encoded bytes of the script\n  \"reqSigs\": n,               (numeric)         The number of required signatures\n  \"type\": \"value\",            (string)          The type of the script (e.g. 'pubkeyhash')\n  \"addresses\": [\"value\",...], (array of string) The Decred addresses associated with this script\n  \"commitamt\": n.nnn,         (numeric)         The ticket commitment value if the script is for a staking commitment\n  \"version\": n,               (numeric)         The script version\n },                                             \n \"coinbase\": true|false,      (boolean)         Whether or not the transaction is a coinbase\n}                             \n",
-		"getunconfirmedbalance":     "getunconfirmedbalance (\"account\")\n\nCalculates the unspent output value of all unmined transaction outputs for an account.\n\nArguments:\n1. account (string, optional) The account to query the unconfirmed balance for (default=\"default\")\n\nResult:\nn.nnn (numeric) Total amount of all unmined unspent outputs of the account valued in decred.\n",
-		"getvotechoices":            "getvotechoices (\"tickethash\")\n\nRetrieve the currently configured default vote choices for the latest supported stake agendas\n\nArguments:\n1. tickethash (string, optional) The hash of the ticket to return vote choices for. If the ticket has no choices set, the default vote choices are returned\n\nResult:\n{\n \"version\": n,                  (numeric)         The latest stake version supported by the software and the version of the included agendas\n \"choices\": [{                  (array of object) The currently configured agenda vote choices, including abstaining votes\n  \"agendaid\": \"value\",          (string)          The ID for the agenda the choice concerns\n  \"agendadescription\": \"value\", (string)          A description of the agenda the choice concerns\n  \"choiceid\": \"value\",          (string)          The ID of the current choice for this agenda\n  \"choicedescription\": \"value\", (string)          A description of the current choice for this agenda\n },...],                                          \n}                               \n",
-		"getwalletfee":              "getwalletfee\n\nGet currently set transaction fee for the wallet\n\nArguments:\nNone\n\nResult:\nn.nnn (numeric) Current tx fee (in DCR)\n",
-		"getcfilterv2":              "getcfilterv2 \"blockhash\"\n\nReturns the version 2 block filter for the given block along with the key required to query it for matches against committed scripts.\n\nArguments:\n1. blockhash (string, required) The block hash of the filter to retrieve\n\nResult:\n{\n \"blockhash\": \"value\", (string) The block hash for which the filter includes data\n \"filter\": \"value\",    (string) Hex-encoded bytes of the serialized filter\n \"key\": \"value\",       (string) The key required to query the filter for matches against committed scripts\n}                      \n",
-		"help":                      "help (\"command\")\n\nReturns a list of all commands or help for a specified command.\n\nArguments:\n1. command (string, optional) The command to retrieve help for\n\nResult (no command provided):\n\"value\" (string) List of commands\n\nResult (command specified):\n\"value\" (string) Help for specified command\n",
-		"importcfiltersv2":          "importcfiltersv2 startheight [\"filter\",...]\n\nImports a list of v2 cfilters into the wallet. Does not perform validation on the filters\n\nArguments:\n1. startheight (numeric, required)         The starting block height for this list of cfilters\n2. filters     (array of string, required) The list of hex-encoded cfilters\n\nResult:\nNothing\n",
-		"importprivkey":             "importprivkey \"privkey\" (\"label\" rescan=true scanfrom)\n\nImports a WIF-encoded private key to the 'imported' account.\n\nArguments:\n1. privkey  (string, required)                The WIF-encoded private key\n2. label    (string, optional)                Unused (must be unset or 'imported')\n3. rescan   (boolean, optional, default=true) Rescan the blockchain (since the genesis block, or scanfrom block) for outputs controlled by the imported key\n4. scanfrom (numeric, optional)               Block number for where to start rescan from\n\nResult:\nNothing\n",
-		"importpubkey":              "importpubkey \"pubkey\" (\"label\" rescan=true scanfrom)\n\nImports a compressed (33-byte) secp256k1 public key and the derived P2PKH address to the imported account.\n\nArguments:\n1. pubkey   (string, required)                The hex-encoded 33-byte compressed public key\n2. label    (string, optional)                Unused (must be unset or 'imported')\n3. rescan   (boolean, optional, default=true) Rescan the blockchain (since the genesis block, or scanfrom block) for outputs controlled by the imported key\n4. scanfrom (numeric, optional)               Block number for where to start rescan from\n\nResult:\nNothing\n",
-		"importscript":              "importscript \"hex\" (rescan=true scanfrom)\n\nImport a redeem script.\n\nArguments:\n1. hex      (string, required)                Hex encoded script to import\n2. rescan   (boolean, optional, default=true) Rescans the blockchain (since the genesis block, or scanfrom block) for outputs controlled by the imported key\n3. scanfrom (numeric, optional)               Block number for where to start rescan from\n\nResult:\nNothing\n",
-		"importxpub":                "importxpub \"name\" \"xpub\"\n\nImport a HD extended public key as a new account.\n\nArguments:\n1. name (string, required) Name of new account\n2. xpub (string, required) Extended public key\n\nResult:\nNothing\n",
-		"listaccounts":              "listaccounts (minconf=1)\n\nDEPRECATED -- Returns a JSON object of all accounts and their balances.\n\nArguments:\n1. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an unspent output's value is included in the balance\n\nResult:\n{\n \"The account name\": The account balance valued in decred, (object) JSON object with account names as keys and decred amounts as values\n ...\n}\n",
-		"listaddresstransactions":   "listaddresstransactions [\"address\",...] (\"account\")\n\nReturns a JSON array of objects containing verbose details for wallet transactions pertaining some addresses.\n\nArguments:\n1. addresses (array of string, required) Addresses to filter transaction results by\n2. account   (string, optional)          Unused (must be unset or \"*\")\n\nResult:\n[{\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in decred\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n},...]\n",
-		"listalltransactions":       "listalltransactions (\"account\")\n\nReturns a JSON array of objects in the same format as 'listtransactions' without limiting the number of returned objects.\n\nArguments:\n1. account (string, optional) Unused (must be unset or \"*\")\n\nResult:\n[{\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in decred\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n},...]\n",
-		"listlockunspent":           "listlockunspent (\"account\")\n\nReturns a JSON array of outpoints marked as locked (with lockunspent) for this wallet session.\n\nArguments:\n1. account (string, optional) If set, only returns outpoints from this account that are marked as locked\n\nResult:\n[{\n \"amount\": n.nnn, (numeric) The previous output amount\n \"txid\": \"value\", (string)  The transaction hash of the referenced output\n \"vout\": n,       (numeric) The output index of the referenced output\n \"tree\": n,       (numeric) The tree to generate transaction for\n},...]\n",
-		"listreceivedbyaccount":     "listreceivedbyaccount (minconf=1 includeempty=false includewatchonly=false)\n\nReturns a JSON array of objects listing all accounts and the total amount received by each account.\n\nArguments:\n1. minconf          (numeric, optional, default=1)     Minimum number of block confirmations required before a transaction is considered\n2. includeempty     (boolean, optional, default=false) Unused\n3. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"account\": \"value\", (string)  The name of the account\n \"amount\": n.nnn,    (numeric) Total amount received by payment addresses of the account valued in decred\n \"confirmations\": n, (numeric) Number of block confirmations of the most recent transaction relevant to the account\n},...]\n",
-		"listreceivedbyaddress":     "listreceivedbyaddress (minconf=1 includeempty=false includewatchonly=false)\n\nReturns a JSON array of objects listing wallet payment addresses and their total received amounts.\n\nArguments:\n1. minconf          (numeric, optional, default=1)     Minimum number of block confirmations required before a transaction is considered\n2. includeempty     (boolean, optional, default=false) Unused\n3. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"account\": \"value\",              (string)          DEPRECATED -- Unset\n \"address\": \"value\",              (string)          The payment address\n \"amount\": n.nnn,                 (numeric)         Total amount received by the payment address valued in decred\n \"confirmations\": n,              (numeric)         Number of block confirmations of the most recent transaction relevant to the address\n \"txids\": [\"value\",...],          (array of string) Transaction hashes of all transactions involving this address\n \"involvesWatchonly\": true|false, (boolean)         Unset\n},...]\n",
-		"listsinceblock":            "listsinceblock (\"blockhash\" targetconfirmations=1 includewatchonly=false)\n\nReturns a JSON array of objects listing details of all wallet transactions after some block.\n\nArguments:\n1. blockhash           (string, optional)                 Hash of the parent block of the first block to consider transactions from, or unset to list all transactions\n2. targetconfirmations (numeric, optional, default=1)     Minimum number of block confirmations of the last block in the result object.  Must be 1 or greater.  Note: The transactions array in the result object is not affected by this parameter\n3. includewatchonly    (boolean, optional, default=false) Unused\n\nResult:\n{\n \"transactions\": [{                 (array of object) JSON array of objects containing verbose details of the each transaction\n  \"account\": \"value\",               (string)          DEPRECATED -- Unset\n  \"address\": \"value\",               (string)          Payment address for a transaction output\n  \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in decred\n  \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n  \"blockindex\": n,                  (numeric)         Unset\n  \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n  \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n  \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n  \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n  \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n  \"involveswatchonly\": true|false,  (boolean)         Unset\n  \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n  \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n  \"txid\": \"value\",                  (string)          The hash of the transaction\n  \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n  \"vout\": n,                        (numeric)         The transaction output index\n  \"walletconflicts\": [\"value\",...], (array of string) Unset\n  \"comment\": \"value\",               (string)          Unset\n  \"otheraccount\": \"value\",          (string)          Unset\n },...],                                              \n \"lastblock\": \"value\",              (string)          Hash of the latest-synced block to be used in later calls to listsinceblock\n}                                   \n",
-		"listtransactions":          "listtransactions (\"account\" count=10 from=0 includewatchonly=false)\n\nReturns a JSON array of objects containing verbose details for wallet transactions.\n\nArguments:\n1. account          (string, optional)                 DEPRECATED -- Unused (must be unset or \"*\")\n2. count            (numeric, optional, default=10)    Maximum number of transactions to create results from\n3. from             (numeric, optional, default=0)     Number of transactions to skip before results are created\n4. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in decred\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n},...]\n",
-		"listunspent":               "listunspent (minconf=1 maxconf=9999999 [\"address\",...] \"account\")\n\nReturns a JSON array of objects representing unlocked unspent outputs controlled by wallet keys.\n\nArguments:\n1. minconf   (numeric, optional, default=1)       Minimum number of block confirmations required before a transaction output is considered\n2. maxconf   (numeric, optional, default=9999999) Maximum number of block confirmations required before a transaction output is excluded\n3. addresses (array of string, optional)          If set, limits the returned details to unspent outputs received by any of these payment addresses\n4. account   (string, optional)                   If set, only return unspent outputs from this account\n\nResult:\n{\n \"txid\": \"value\",         (string)  The transaction hash of the referenced output\n \"vout\": n,               (numeric) The output index of the referenced output\n \"tree\": n,               (numeric) The tree the transaction comes from\n \"txtype\": n,             (numeric) The type of the transaction\n \"address\": \"value\",      (string)  The payment address that received the output\n \"account\": \"value\",      (string)  The account associated with the receiving payment address\n \"scriptPubKey\": \"value\", (string)  The output script encoded as a hexadecimal string\n \"redeemScript\": \"value\", (string)  The redeemScript if scriptPubKey is P2SH\n \"amount\": n.nnn,         (numeric) The amount of the output valued in decred\n \"confirmations\": n,      (numeric) The number of block confirmations of the transaction\n \"spendable\": true|false, (boolean) Whether the output is entirely controlled by wallet keys/scripts (false for partially controlled multisig outputs or outputs to watch-only addresses)\n}                         \n",
-		"lockaccount":               "lockaccount \"account\"\n\nLock an individually-encrypted account\n\nArguments:\n1. account (string, required) Account to lock\n\nResult:\nNothing\n",
-		"lockunspent":               "lockunspent unlock [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...]\n\nLocks or unlocks an unspent output.\nLocked outputs are not chosen for transaction inputs of authored transactions and are not included in 'listunspent' results.\nLocked outputs are volatile and are not saved across wallet restarts.\nIf unlock is true and no transaction outputs are specified, all locked outputs are marked unlocked.\n\nArguments:\n1. unlock       (boolean, required)         True to unlock outputs, false to lock\n2. transactions (array of object, required) Transaction outputs to lock or unlock\n[{\n \"amount\": n.nnn, (numeric) The previous output amount\n \"txid\": \"value\", (string)  The transaction hash of the referenced output\n \"vout\": n,       (numeric) The output index of the referenced output\n \"tree\": n,       (numeric) The tree to generate transaction for\n},...]\n\nResult:\ntrue|false (boolean) The boolean 'true'\n",
-		"mixaccount":                "mixaccount\n\nMix all outputs of an account.\n\nArguments:\nNone\n\nResult:\nNothing\n",
-		"mixoutput":                 "mixoutput \"outpoint\"\n\nMix a specific output.\n\nArguments:\n1. outpoint (string, required) Outpoint (in form \"txhash:index\") to mix\n\nResult:\nNothing\n",
-		"processunmanagedticket":    "processunmanagedticket \"tickethash\"\n\nProcesses tickets for vsp client based on ticket hash.\n\nArguments:\n1. tickethash (string, required) The ticket hash of ticket to be processed by the vsp client.\n\nResult:\nNothing\n",
-		"purchaseticket":            "purchaseticket \"fromaccount\" spendlimit (minconf=1 numtickets=1 expiry \"comment\" dontsigntx)\n\nPurchase ticket using available funds.\n\nArguments:\n1. fromaccount (string, required)             The account to use for purchase (default=\"default\")\n2. spendlimit  (numeric, required)            Limit on the amount to spend on ticket\n3. minconf     (numeric, optional, default=1) Minimum number of block confirmations required\n4. numtickets  (numeric, optional, default=1) The number of tickets to purchase\n5. expiry      (numeric, optional)            Height at which the purchase tickets expire\n6. comment     (string, optional)             Unused\n7. dontsigntx  (boolean, optional)            Return unsigned split and ticket transactions instead of signing and publishing\n\nResult:\n\"value\" (string) Hash of the resulting ticket\n",
-		"redeemmultisigout":         "redeemmultisigout \"hash\" index tree (\"address\")\n\nTakes the input and constructs a P2PKH paying to the specified address.\n\nArguments:\n1. hash    (string, required)  Hash of the input transaction\n2. index   (numeric, required) Idx of the input transaction\n3. tree    (numeric, required) Tree the transaction is on.\n4. address (string, optional)  Address to pay to.\n\nResult:\n{\n \"hex\": \"value\",         (string)          Resulting hash.\n \"complete\": true|false, (boolean)         Shows if opperation was completed.\n \"errors\": [{            (array of object) Any errors generated.\n  \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n  \"vout\": n,             (numeric)         The output index of the referenced previous output\n  \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n  \"sequence\": n,         (numeric)         Script sequence number\n  \"error\": \"value\",      (string)          Verification or signing error related to the input\n },...],                                   \n}                        \n",
-		"redeemmultisigouts":        "redeemmultisigouts \"fromscraddress\" (\"toaddress\" number)\n\nTakes a hash, looks up all unspent outpoints and generates list artially signed transactions spending to either an address specified or internal addresses\n\nArguments:\n1. fromscraddress (string, required)  Input script hash address.\n2. toaddress      (string, optional)  Address to look for (if not internal addresses).\n3. number         (numeric, optional) Number of outpoints found.\n\nResult:\n{\n \"hex\": \"value\",         (string)          Resulting hash.\n \"complete\": true|false, (boolean)         Shows if opperation was completed.\n \"errors\": [{            (array of object) Any errors generated.\n  \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n  \"vout\": n,             (numeric)         The output index of the referenced previous output\n  \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n  \"sequence\": n,         (numeric)         Script sequence number\n  \"error\": \"value\",      (string)          Verification or signing error related to the input\n },...],                                   \n}                        \n",
-		"renameaccount":             "renameaccount \"oldaccount\" \"newaccount\"\n\nRenames an account.\n\nArguments:\n1. oldaccount (string, required) The old account name to rename\n2. newaccount (string, required) The new name for the account\n\nResult:\nNothing\n",
-		"rescanwallet":              "rescanwallet (beginheight=0)\n\nRescan the block chain for wallet data, blocking until the rescan completes or exits with an error\n\nArguments:\n1. beginheight (numeric, optional, default=0) The height of the first block to begin the rescan from\n\nResult:\nNothing\n",
-		"sendfrom":                  "sendfrom \"fromaccount\" \"toaddress\" amount (minconf=1 \"comment\" \"commentto\")\n\nAuthors, signs, and sends a transaction that outputs some amount to a payment address.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. fromaccount (string, required)             Account to pick unspent outputs from\n2. toaddress   (string, required)             Address to pay\n3. amount      (numeric, required)            Amount to send to the payment address valued in decred\n4. minconf     (numeric, optional, default=1) Minimum number of block confirmations required before a transaction output is eligible to be spent\n5. comment     (string, optional)             Unused\n6. commentto   (string, optional)             Unused\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
-		"sendfromtreasury":          "sendfromtreasury \"key\" amounts\n\nSend from treasury balance to multiple recipients.\n\nArguments:\n1. key     (string, required) Politeia public key\n2. amounts (object, required) Pairs of payment addresses and the output amount to pay each\n{\n \"Address to pay\": Amount to send to the payment address valued in decred, (object) JSON object using payment addresses as keys and output amounts valued in decred to send to each address\n ...\n}\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
-		"sendmany":                  "sendmany \"fromaccount\" {\"address\":amount,...} (minconf=1 \"comment\")\n\nAuthors, signs, and sends a transaction that outputs to many payment addresses.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. fromaccount (string, required) Account to pick unspent outputs from\n2. amounts     (object, required) Pairs of payment addresses and the output amount to pay each\n{\n \"Address to pay\": Amount to send to the payment address valued in decred, (object) JSON object using payment addresses as keys and output amounts valued in decred to send to each address\n ...\n}\n3. minconf (numeric, optional, default=1) Minimum number of block confirmations required before a transaction output is eligible to be spent\n4. comment (string, optional)             Unused\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
-		"sendrawtransaction":        "sendrawtransaction \"hextx\" (allowhighfees=false)\n\nSubmits the serialized, hex-encoded transaction to the local peer and relays it to the network.\n\nArguments:\n1. hextx         (string, required)                 Serialized, hex-encoded signed transaction\n2. allowhighfees (boolean, optional, default=false) Whether or not to allow insanely high fees\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
-		"sendtoaddress":             "sendtoaddress \"address\" amount (\"comment\" \"commentto\")\n\nAuthors, signs, and sends a transaction that outputs some amount to a payment address.\nUnlike sendfrom, outputs are always chosen from the default account.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. address   (string, required)  Address to pay\n2. amount    (numeric, required) Amount to send to the payment address valued in decred\n3. comment   (string, optional)  Unused\n4. commentto (string, optional)  Unused\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
-		"sendtomultisig":            "sendtomultisig \"fromaccount\" amount [\"pubkey\",...] (nrequired=1 minconf=1 \"comment\")\n\nAuthors, signs, and sends a transaction that outputs some amount to a multisig address.\nUnlike sendfrom, outputs are always chosen from the default account.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. fromaccount (string, required)             Unused\n2. amount      (numeric, required)            Amount to send to the payment address valued in decred\n3. pubkeys     (array of string, required)    Pubkey to send to.\n4. nrequired   (numeric, optional, default=1) The number of signatures required to redeem outputs paid to this address\n5. minconf     (numeric, optional, default=1) Minimum number of block confirmations required\n6. comment     (string, optional)             Unused\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
-		"sendtotreasury":            "sendtotreasury amount\n\nSend decred to treasury\n\nArguments:\n1. amount (numeric, required) Amount to send to treasury\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
-		"setaccountpassphrase":      "setaccountpassphrase \"account\" \"passphrase\"\n\nIndividually encrypt or change per-account passphrase\n\nArguments:\n1. account    (string, required) Account to modify\n2. passphrase (string, required) New passphrase to use.\nIf this is the empty string, the account passphrase is removed and the account becomes encrypted by the global wallet passhprase.\n\nResult:\nNothing\n",
-		"setdisapprovepercent":      "setdisapprovepercent percent\n\nSets the wallet's block disapprove percent per vote. The wallet will randomly disapprove blocks with this percent of votes. Only used for testing purposes and will fail on mainnet.\n\nArguments:\n1. percent (numeric, required) The percent of votes to disapprove blocks. i.e. 100 means that all votes disapprove the block they are called on. Must be between zero and one hundred.\n\nResult:\nNothing\n",
-		"settreasurypolicy":         "settreasurypolicy \"key\" \"policy\" (\"ticket\")\n\nSet a voting policy for treasury spends by a particular key\n\nArguments:\n1. key    (string, required) Treasury key to set policy for\n2. policy (string, required) Voting policy for a treasury key (invalid/abstain, yes, or no)\n3. ticket (string, optional) Ticket hash to set a per-ticket treasury key policy\n\nResult:\nNothing\n",
-		"settspendpolicy":           "settspendpolicy \"hash\" \"policy\" (\"ticket\")\n\nSet a voting policy for a treasury spend transaction\n\nArguments:\n1. hash   (string, required) Hash of treasury spend transaction to set policy for\n2. policy (string, required) Voting policy for a tspend transaction (invalid/abstain, yes, or no)\n3. ticket (string, optional) Ticket hash to set a per-ticket tspend approval policy\n\nResult:\nNothing\n",
-		"settxfee":                  "settxfee amount\n\nModify the fee per kB of the serialized tx size used each time more fee is required for an authored transaction.\n\nArguments:\n1. amount (numeric, required) The new fee per kB of the serialized tx size valued in decred\n\nResult:\ntrue|false (boolean) The boolean 'true'\n",
-		"setvotechoice":             "setvotechoice \"agendaid\" \"choiceid\" (\"tickethash\")\n\nSets choices for defined agendas in the latest stake version supported by this software\n\nArguments:\n1. agendaid   (string, required) The ID for the agenda to modify\n2. choiceid   (string, required) The ID for the choice to choose\n3. tickethash (string, optional) The hash of the ticket to set choices for\n\nResult:\nNothing\n",
-		"signmessage":               "signmessage \"address\" \"message\"\n\nSigns a message using the private key of a payment address.\n\nArguments:\n1. address (string, required) Payment address of private key used to sign the message with\n2. message (string, required) Message to sign\n\nResult:\n\"value\" (string) The signed message encoded as a base64 string\n",
-		"signrawtransaction":        "signrawtransaction \"rawtx\" ([{\"txid\":\"value\",\"vout\":n,\"tree\":n,\"scriptpubkey\":\"value\",\"redeemscript\":\"value\"},...] [\"privkey\",...] flags=\"ALL\")\n\nSigns transaction inputs using private keys from this wallet and request.\nThe valid flags options are ALL, NONE, SINGLE, ALL|ANYONECANPAY, NONE|ANYONECANPAY, and SINGLE|ANYONECANPAY.\n\nArguments:\n1. rawtx    (string, required)                Unsigned or partially unsigned transaction to sign encoded as a hexadecimal string\n2. inputs   (array of object, optional)       Additional data regarding inputs that this wallet may not be tracking\n3. privkeys (array of string, optional)       Additional WIF-encoded private keys to use when creating signatures\n4. flags    (string, optional, default=\"ALL\") Sighash flags\n\nResult:\n{\n \"hex\": \"value\",         (string)          The resulting transaction encoded as a hexadecimal string\n \"complete\": true|false, (boolean)         Whether all input signatures have been created\n \"errors\": [{            (array of object) Script verification errors (if exists)\n  \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n  \"vout\": n,             (numeric)         The output index of the referenced previous output\n  \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n  \"sequence\": n,         (numeric)         Script sequence number\n  \"error\": \"value\",      (string)          Verification or signing error related to the input\n },...],                                   \n}                        \n",
-		"signrawtransactions":       "signrawtransactions [\"rawtx\",...] (send=true)\n\nSigns transaction inputs using private keys from this wallet and request for a list of transactions.\n\n\nArguments:\n1. rawtxs (array of string, required)       A list of transactions to sign (and optionally send).\n2. send   (boolean, optional, default=true) Set true to send the transactions after signing.\n\nResult:\n{\n \"results\": [{             (array of object) Returned values from the signrawtransactions command.\n  \"signingresult\": {       (object)          Success or failure of signing.\n   \"hex\": \"value\",         (string)          The resulting transaction encoded as a hexadecimal string\n   \"complete\": true|false, (boolean)         Whether all input signatures have been created\n   \"errors\": [{            (array of object) Script verification errors (if exists)\n    \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n    \"vout\": n,             (numeric)         The output index of the referenced previous output\n    \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n    \"sequence\": n,         (numeric)         Script sequence number\n    \"error\": \"value\",      (string)          Verification or signing error related to the input\n   },...],                                   \n  },                                         \n  \"sent\": true|false,      (boolean)         Tells if the transaction was sent.\n  \"txhash\": \"value\",       (string)          The hash of the signed tx.\n },...],                                     \n}                          \n",
-		"spendoutputs":              "spendoutputs \"account\" [\"previousoutpoint\",...] [{\"address\":\"value\",\"amount\":n.nnn},...]\n\nCreate, sign, and publish a transaction spending the specified wallet outputs, and paying an array of address/amount pairs.\nOutputs must belong to the specified account, and change (if needed) is returned to an internal address of the same account.\n\nArguments:\n1. account           (string, required)          Account of specified previous outpoints, and account used to return change\n2. previousoutpoints (array of string, required) Array of outpoints in string encoding (\"hash:index\")\n3. outputs           (array of object, required) Array of JSON objects, each specifying an address string and amount\n[{\n \"address\": \"value\", (string)  Address to pay\n \"amount\": n.nnn,    (numeric) Amount to pay the address\n},...]\n\nResult:\n\"value\" (string) The published transaction hash\n",
-		"sweepaccount":              "sweepaccount \"sourceaccount\" \"destinationaddress\" (requiredconfirmations feeperkb)\n\nMoves as much value as possible in a transaction from an account.\n\n\nArguments:\n1. sourceaccount         (string, required)  The account to be swept.\n2. destinationaddress    (string, required)  The destination address to pay to.\n3. requiredconfirmations (numeric, optional) The minimum utxo confirmation requirement (optional).\n4. feeperkb              (numeric, optional) The minimum relay fee policy (optional).\n\nResult:\n{\n \"unsignedtransaction\": \"value\",     (string)  The hex encoded string of the unsigned transaction.\n \"totalpreviousoutputamount\": n.nnn, (numeric) The total transaction input amount.\n \"totaloutputamount\": n.nnn,         (numeric) The total transaction output amount.\n \"estimatedsignedsize\": n,           (numeric) The estimated size of the transaction when signed.\n}                                    \n",
-		"syncstatus":                "syncstatus\n\nReturns information about this wallet's synchronization to the network.\n\nArguments:\nNone\n\nResult:\n{\n \"synced\": true|false,               (boolean) Whether or not the wallet is fully caught up to the network.\n \"initialblockdownload\": true|false, (boolean) Best guess of whether this wallet is in the initial block download mode used to catch up the blockchain when it is far behind.\n \"headersfetchprogress\": n.nnn,      (numeric) Estimated progress of the headers fetching stage of the current sync process.\n}                                    \n",
-		"ticketinfo":                "ticketinfo (startheight=0)\n\nReturns details of each wallet ticket transaction\n\nArguments:\n1. startheight (numeric, optional, default=0) Specify the starting block height to scan from\n\nResult:\n[{\n \"hash\": \"value\",               (string)          Transaction hash of the ticket\n \"cost\": n.nnn,                 (numeric)         Amount paid to purchase the ticket; this may be greater than the ticket price at time of purchase\n \"votingaddress\": \"value\",      (string)          Address of 0th output, which describes the requirements to spend the ticket\n \"status\": \"value\",             (string)          Description of ticket status (unknown, unmined, immature, mature, live, voted, missed, expired, unspent, revoked)\n \"blockhash\": \"value\",          (string)          Hash of block ticket is mined in\n \"blockheight\": n,              (numeric)         Height of block ticket is mined in\n \"vote\": \"value\",               (string)          Transaction hash of vote which spends the ticket\n \"revocation\": \"value\",         (string)          Transaction hash of revocation which spends the ticket\n \"choices\": [{                  (array of object) Vote preferences set for the ticket\n  \"agendaid\": \"value\",          (string)          The ID for the agenda the choice concerns\n  \"agendadescription\": \"value\", (string)          A description of the agenda the choice concerns\n  \"choiceid\": \"value\",          (string)          The ID of the current choice for this agenda\n  \"choicedescription\": \"value\", (string)          A description of the current choice for this agenda\n },...],                                          \n \"vsphost\": \"value\",            (string)          VSP Host associated with the ticket (if any)\n},...]\n",
-		"treasurypolicy":            "treasurypolicy (\"key\" \"ticket\")\n\nReturn voting policies for treasury spend transactions by key\n\nArguments:\n1. key    (string, optional) Return the policy for a particular key\n2. ticket (string, optional) Return policies used by a specific ticket hash\n\nResult (no key provided):\n[{\n \"key\": \"value\",    (string) Treasury key associated with a policy\n \"policy\": \"value\", (string) Voting policy description (abstain, yes, or no)\n \"ticket\": \"value\", (string) Ticket hash of a per-ticket treasury key approval policy\n},...]\n\nResult (key specified):\n{\n \"key\": \"value\",    (string) Treasury key associated with a policy\n \"policy\": \"value\", (string) Voting policy description (abstain, yes, or no)\n \"ticket\": \"value\", (string) Ticket hash of a per-ticket treasury key approval policy\n}                   \n",
-		"tspendpolicy":              "tspendpolicy (\"hash\" \"ticket\")\n\nReturn voting policies for treasury spend transactions\n\nArguments:\n1. hash   (string, optional) Return the policy for a particular tspend hash\n2. ticket (string, optional) Return policies used by a specific ticket hash\n\nResult (no tspend hash provided):\n[{\n \"hash\": \"value\",   (string) Treasury spend transaction hash\n \"policy\": \"value\", (string) Voting policy description (abstain, yes, or no)\n \"ticket\": \"value\", (string) Ticket hash of a per-ticket tspend approval policy\n},...]\n\nResult (tspend hash specified):\n{\n \"hash\": \"value\",   (string) Treasury spend transaction hash\n \"policy\": \"value\", (string) Voting policy description (abstain, yes, or no)\n \"ticket\": \"value\", (string) Ticket hash of a per-ticket tspend approval policy\n}                   \n",
-		"unlockaccount":             "unlockaccount \"account\" \"passphrase\"\n\nUnlock an individually-encrypted account\n\nArguments:\n1. account    (string, required) Account to unlock\n2. passphrase (string, required) Account passphrase\n\nResult:\nNothing\n",
-		"validateaddress":           "validateaddress \"address\"\n\nVerify that an address is valid.\nExtra details are returned if the address is controlled by this wallet.\nThe following fields are valid only when the address is controlled by this wallet (ismine=true): pubkey, account, addresses, hex, and sigsrequired.\nThe following fields are only valid when address has an associated public key: pubkey, iscompressed.\nThe following fields are only valid when address is a pay-to-script-hash address: addresses, hex, and script.\nIf the address is a multisig address controlled by this wallet, the multisig fields will be left unset if the wallet is locked since the redeem script cannot be decrypted.\n\nArguments:\n1. address (string, required) Address to validate\n\nResult:\n{\n \"isvalid\": true|false,      (boolean)         Whether or not the address is valid\n \"address\": \"value\",         (string)          The payment address (only when isvalid is true)\n \"ismine\": true|false,       (boolean)         Whether this address is controlled by the wallet (only when isvalid is true)\n \"iswatchonly\": true|false,  (boolean)         Unset\n \"isscript\": true|false,     (boolean)         Whether the payment address is a pay-to-script-hash address (only when isvalid is true)\n \"pubkeyaddr\": \"value\",      (string)          The pubkey for this payment address (only when isvalid is true)\n \"pubkey\": \"value\",          (string)          The associated public key of the payment address, if any (only when isvalid is true)\n \"iscompressed\": true|false, (boolean)         Whether the address was created by hashing a compressed public key, if any (only when isvalid is true)\n \"account\": \"value\",         (string)          The account this payment address belongs to (only when isvalid is true)\n \"addresses\": [\"value\",...], (array of string) All associated payment addresses of the script if address is a multisig address (only when isvalid is true)\n \"hex\": \"value\",             (string)          The redeem script \n \"script\": \"value\",          (string)          The class of redeem script for a multisig address\n \"sigsrequired\": n,          (numeric)         The number of required signatures to redeem outputs to the multisig address\n \"accountn\": n,              (numeric)         The account number. This number plus 2 ^ 31 is the HD account the address was derived from. Not available for imported accounts. Only present for BIP0044 derived addresses.\n \"branch\": n,                (numeric)         The HD branch. Only present for BIP0044 derived addresses.\n \"index\": n,                 (numeric)         The HD index. Only present for BIP0044 derived addresses.\n}                            \n",
-		"validatepredcp0005cf":      "validatepredcp0005cf\n\nValidate whether all stored cfilters from before DCP0005 activation are correct according to the expected hardcoded hash\n\nArguments:\nNone\n\nResult:\ntrue|false (boolean) Whether the cfilters are valid\n",
-		"verifymessage":             "verifymessage \"address\" \"signature\" \"message\"\n\nVerify a message was signed with the associated private key of some address.\n\nArguments:\n1. address   (string, required) Address used to sign message\n2. signature (string, required) The signature to verify\n3. message   (string, required) The message to verify\n\nResult:\ntrue|false (boolean) Whether the message was signed with the private key of 'address'\n",
-		"version":                   "version\n\nReturns application and API versions (semver) keyed by their names\n\nArguments:\nNone\n\nResult:\n{\n \"Program or API name\": Object containing the semantic version, (object) Version objects keyed by the program or API name\n ...\n}\n",
-		"walletinfo":                "walletinfo\n\nReturns global information about the wallet\n\nArguments:\nNone\n\nResult:\n{\n \"daemonconnected\": true|false, (boolean) Whether or not the wallet is currently connected to the daemon RPC\n \"spv\": true|false,             (boolean) Whether or not wallet is syncing in SPV mode\n \"unlocked\": true|false,        (boolean) Whether or not the wallet is unlocked\n \"cointype\": n,                 (numeric) Active coin type. Not available for watching-only wallets.\n \"txfee\": n.nnn,                (numeric) Transaction fee per kB of the serialized tx size in coins\n \"votebits\": n,                 (numeric) Vote bits setting\n \"votebitsextended\": \"value\",   (string)  Extended vote bits setting\n \"voteversion\": n,              (numeric) Version of votes that will be generated\n \"voting\": true|false,          (boolean) Whether or not the wallet is currently voting tickets\n \"vsp\": \"value\",                (string)  VSP URL used when purchasing tickets\n \"manualtickets\": true|false,   (boolean) Whether or not the wallet is only accepting tickets manually\n \"birthhash\": \"value\",          (string)  The wallet birth hash.\n \"birthheight\": n,              (numeric) The wallet birth height.\n}                               \n",
-		"walletislocked":            "walletislocked\n\nReturns whether or not the wallet is locked.\n\nArguments:\nNone\n\nResult:\ntrue|false (boolean) Whether the wallet is locked\n",
-		"walletlock":                "walletlock\n\nLock the wallet.\n\nArguments:\nNone\n\nResult:\nNothing\n",
-		"walletpassphrase":          "walletpassphrase \"passphrase\" timeout\n\nUnlock the wallet.\n\nArguments:\n1. passphrase (string, required)  The wallet passphrase\n2. timeout    (numeric, required) The number of seconds to wait before the wallet automatically locks. 0 leaves the wallet unlocked indefinitely.\n\nResult:\nNothing\n",
-		"walletpassphrasechange":    "walletpassphrasechange \"oldpassphrase\" \"newpassphrase\"\n\nChange the wallet passphrase.\n\nArguments:\n1. oldpassphrase (string, required) The old wallet passphrase\n2. newpassphrase (string, required) The new wallet passphrase\n\nResult:\nNothing\n",
-		"walletpubpassphrasechange": "walletpubpassphrasechange \"oldpassphrase\" \"newpassphrase\"\n\nChange the wallet's public passphrase.\n\nArguments:\n1. oldpassphrase (string, required) The old wallet passphrase\n2. newpassphrase (string, required) The new wallet passphrase\n\nResult:\nNothing\n",
+		"abandontransaction":           "abandontransaction \"hash\"\n\nRemove an unconfirmed transaction and all dependent transactions\n\nArguments:\n1. hash (string, required) Hash of transaction to remove\n\nResult:\nNothing\n",
+		"accountaddressindex":          "accountaddressindex \"account\" branch\n\nGet the current address index for some account branch\n\nArguments:\n1. account (string, required)  String for the account\n2. branch  (numeric, required) Number for the branch (0=external, 1=internal)\n\nResult:\nn (numeric) The address index for this account branch\n",
+		"accountsyncaddressindex":      "accountsyncaddressindex \"account\" branch index\n\nSynchronize an account branch to some passed address index\n\nArguments:\n1. account (string, required)  String for the account\n2. branch  (numeric, required) Number for the branch (0=external, 1=internal)\n3. index   (numeric, required) The address index to synchronize to\n\nResult:\nNothing\n",
+		"accountunlocked":              "accountunlocked \"account\"\n\nReport account encryption and locked status\n\nArguments:\n1. account (string, required) Account name\n\nResult:\n{\n \"encrypted\": true|false, (boolean) Whether the account is individually encrypted with a separate passphrase\n \"unlocked\": true|false,  (boolean) If the individually encrypted account is unlocked. Omitted for unencrypted accounts.\n}                         \n",
+		"addmultisigaddress":           "addmultisigaddress nrequired [\"key\",...] (\"account\")\n\nGenerates and imports a multisig address and redeeming script to the 'imported' account.\n\nArguments:\n1. nrequired (numeric, required)         The number of signatures required to redeem outputs paid to this address\n2. keys      (array of string, required) Pubkeys and/or pay-to-pubkey-hash addresses to partially control the multisig address\n3. account   (string, optional)          DEPRECATED -- Unused (all imported addresses belong to the imported account)\n\nResult:\n\"value\" (string) The imported pay-to-script-hash address\n",
+		"addressreusereport":           "addressreusereport (since)\n\nReports the number and value of outputs paying each reused wallet address, and totals for each account.\nVotes and revocations paying addresses committed to by tickets are not considered reuse.\n\nArguments:\n1. since (numeric, optional) Only report addresses paid at least once since some main chain block height\n\nResult:\n{\n \"addresses\": [{              (array of object) Reused addresses, ordered by decreasing number of outputs\n  \"address\": \"value\",         (string)          The reused address\n  \"account\": n,               (numeric)         The account of the address\n  \"count\": n,                 (numeric)         The number of outputs paying the address\n  \"total\": n.nnn,             (numeric)         The total value of outputs paying the address\n  \"outpoints\": [\"value\",...], (array of string) The outpoints paying the address\n },...],                                        \n \"accounts\": [{               (array of object) Address reuse totals of each account with reused addresses\n  \"account\": n,               (numeric)         The account number\n  \"accountname\": \"value\",     (string)          The account name\n  \"addresses\": n,             (numeric)         The number of reused addresses of the account\n  \"count\": n,                 (numeric)         The number of outputs paying reused addresses of the account\n  \"total\": n.nnn,             (numeric)         The total value of outputs paying reused addresses of the account\n },...],                                        \n}                             \n",
+		"addtransaction":               "addtransaction \"blockhash\" \"transaction\"\n\nManually record a transaction mined in a main chain block\n\nArguments:\n1. blockhash   (string, required) Hash of block which mines transaction\n2. transaction (string, required) Hex-encoded serialized transaction\n\nResult:\nNothing\n",
+		"addwebhook":                   "addwebhook \"url\" (\"account\" \"address\" minamount)\n\nRegisters an HTTP endpoint to be notified of wallet outputs matching a filter.\nA JSON event is POSTed when a matching transaction is first seen unmined and again when it is mined.\nThe request body is authenticated with an HMAC-SHA256 keyed by the returned secret in the Dcrwallet-Signature header.\nWebhooks are not persisted and must be added again after the wallet is restarted.\n\nArguments:\n1. url       (string, required)  The http or https URL to POST events to\n2. account   (string, optional)  Only notify outputs of this account\n3. address   (string, optional)  Only notify outputs paying to this address\n4. minamount (numeric, optional) Only notify outputs of at least this value\n\nResult:\n{\n \"id\": \"value\",      (string)  The identifier of the webhook\n \"url\": \"value\",     (string)  The URL events are POSTed to\n \"secret\": \"value\",  (string)  The hex encoded HMAC-SHA256 key used to sign events (only returned by addwebhook)\n \"account\": \"value\", (string)  The account filter, if any\n \"address\": \"value\", (string)  The address filter, if any\n \"minamount\": n.nnn, (numeric) The minimum output value filter, if any\n}                    \n",
+		"annotaterawtransaction":       "annotaterawtransaction \"hextx\"\n\nDecodes a raw transaction and describes which of its inputs and outputs belong to the wallet.\nPrevious outputs are only described when the previous transaction is recorded by the wallet.\n\nArguments:\n1. hextx (string, required) The hex-encoded serialized transaction\n\nResult:\n{\n \"txid\": \"value\",             (string)          The transaction hash\n \"version\": n,                (numeric)         The transaction version\n \"locktime\": n,               (numeric)         The transaction lock time\n \"expiry\": n,                 (numeric)         The transaction expiry height\n \"type\": \"value\",             (string)          The transaction type (regular, coinbase, ticket, vote, revocation, or treasuryadd)\n \"walletdebit\": n.nnn,        (numeric)         The total value of spent wallet outputs\n \"walletcredit\": n.nnn,       (numeric)         The total value of outputs paying to the wallet\n \"fee\": n.nnn,                (numeric)         The transaction fee, when the values of all previous outputs are known\n \"vin\": [{                    (array of object) The annotated transaction inputs\n  \"txid\": \"value\",            (string)          The hash of the previous transaction\n  \"vout\": n,                  (numeric)         The index of the previous output\n  \"tree\": n,                  (numeric)         The tree of the previous transaction\n  \"sequence\": n,              (numeric)         The input sequence number\n  \"amountin\": n.nnn,          (numeric)         The input value committed to by the transaction\n  \"prevamount\": n.nnn,        (numeric)         The value of the previous output, if known\n  \"mine\": true|false,         (boolean)         Whether the previous output pays to the wallet\n  \"account\": n,               (numeric)         The account number of the previous output's address\n  \"accountname\": \"value\",     (string)          The account name of the previous output's address\n  \"address\": \"value\",         (string)          The wallet address paid by the previous output\n  \"path\": \"value\",            (string)          The derivation path of the address\n },...],                                        \n \"vout\": [{                   (array of object) The annotated transaction outputs\n  \"n\": n,                     (numeric)         The output index\n  \"value\": n.nnn,             (numeric)         The output value\n  \"version\": n,               (numeric)         The output script version\n  \"scripttype\": \"value\",      (string)          The type of the output script\n  \"pkscript\": \"value\",        (string)          The hex-encoded output script\n  \"addresses\": [\"value\",...], (array of string) The addresses paid by the output script\n  \"mine\": true|false,         (boolean)         Whether the output pays to the wallet\n  \"account\": n,               (numeric)         The account number of the output's address\n  \"accountname\": \"value\",     (string)          The account name of the output's address\n  \"address\": \"value\",         (string)          The wallet address paid by the output\n  \"path\": \"value\",            (string)          The derivation path of the address\n },...],                                        \n}                             \n",
+		"approvependingspend":          "approvependingspend \"txhash\"\n\nSigns and publishes a spend awaiting approval.\nThis method may only be called by the spend approval user (--approveusername), and requires the wallet to be unlocked.\nThe spend remains pending if it can not be signed or published.\n\nArguments:\n1. txhash (string, required) The transaction hash of the pending spend\n\nResult:\n\"value\" (string) The hash of the published transaction\n",
+		"attestaddress":                "attestaddress \"address\"\n\nReturns an attestation that an address is derived by the wallet, signed by the extended key of the address' account.\nThe signature is a compact signature of the BLAKE-256 hash of the var-string encodings of \"Decred Address Attestation:\\n\" and the address, followed by the little endian uint32 account, branch, index and fingerprint.\nReceivers of the address verify that the signature was created by the claimed wallet's account xpub, that the fingerprint is the xpub's parent fingerprint, and that the address is derived by the xpub at the branch and index.\nRequires the wallet or account to be unlocked.\n\nArguments:\n1. address (string, required) The address derived by a wallet account\n\nResult:\n{\n \"address\": \"value\",     (string)  The attested address\n \"account\": n,           (numeric) The account number\n \"branch\": n,            (numeric) The account branch deriving the address\n \"index\": n,             (numeric) The child index of the address in the branch\n \"fingerprint\": \"value\", (string)  The hex-encoded wallet fingerprint (the BIP0032 fingerprint of the wallet's coin type key)\n \"accountxpub\": \"value\", (string)  The account extended public key which signed the attestation\n \"signature\": \"value\",   (string)  The base64-encoded compact signature of the attestation\n}                        \n",
+		"auditreuse":                   "auditreuse (since)\n\nReports outputs identifying address reuse\n\nArguments:\n1. since (numeric, optional) Only report reusage since some main chain block height\n\nResult:\n{\n \"Array of outpoints referencing the reused address\": Reused address, (object) Object keying reused addresses to arrays of outpoint strings\n ...\n}\n",
+		"clearmixedspendpolicy":        "clearmixedspendpolicy\n\nRemoves the mixed spend policy, allowing transactions to spend from any account.\n\nArguments:\nNone\n\nResult:\nNothing\n",
+		"consolidate":                  "consolidate inputs (\"account\" \"address\")\n\nConsolidate n many UTXOs into a single output in the wallet.\n\nArguments:\n1. inputs  (numeric, required) Number of UTXOs to consolidate as inputs\n2. account (string, optional)  Optional: Account from which unspent outputs are picked. When no address specified, also the account used to obtain an output address.\n3. address (string, optional)  Optional: Address to pay.  Default is obtained via getnewaddress from the account's address pool.\n\nResult:\n\"value\" (string) Transaction hash for the consolidation transaction\n",
+		"cosignvote":                   "cosignvote \"hexvote\"\n\nAdds the wallet's signatures to a vote of a ticket whose voting rights are held by an imported multisig script.\nDevices holding keys of the script use this to assemble a completely signed vote when no single wallet holds enough keys.\n\nArguments:\n1. hexvote (string, required) The hex-encoded vote, which may already include signatures of other devices\n\nResult:\n{\n \"hex\": \"value\",         (string)  The hex-encoded vote with the wallet's signatures added\n \"complete\": true|false, (boolean) Whether the vote has enough signatures to be published\n}                        \n",
+		"createinvoice":                "createinvoice \"id\" amount (account=\"default\" expiresin \"gappolicy\")\n\nRecords an invoice for an amount paid to a new external address of an account.\nThe amount received by the address is reconciled with the invoice as payments are mined and reorged, and the invoice status becomes partial, paid, or overpaid.\nInvoices with an expiry which are not fully paid before expiring become expired, and later payments continue to be recorded as received.\n\nArguments:\n1. id        (string, required)                    A unique identifier chosen by the caller (at most 128 bytes)\n2. amount    (numeric, required)                   The amount in DCR to invoice\n3. account   (string, optional, default=\"default\") Account name the new address will belong to\n4. expiresin (numeric, optional)                   Optional number of seconds after which the invoice expires\n5. gappolicy (string, optional)                    String defining the policy to use when the BIP0044 gap limit would be violated, may be \"error\", \"ignore\", or \"wrap\" (default: \"ignore\", returning and watching addresses beyond the gap limit)\n\nResult:\n{\n \"id\": \"value\",      (string)  The identifier of the invoice\n \"address\": \"value\", (string)  The address to be paid\n \"account\": \"value\", (string)  The account of the address\n \"amount\": n.nnn,    (numeric) The invoiced amount in DCR\n \"received\": n.nnn,  (numeric) The total amount in DCR of mined outputs received by the address\n \"status\": \"value\",  (string)  The invoice status (pending, partial, paid, overpaid, or expired)\n \"created\": n,       (numeric) The Unix time the invoice was created\n \"expiry\": n,        (numeric) The Unix time the invoice expires (omitted if it does not expire)\n \"updated\": n,       (numeric) The Unix time the received amount or status last changed (omitted if unchanged)\n \"changedheight\": n, (numeric) The main chain tip height when the received amount last changed (omitted if nothing was received)\n}                    \n",
+		"createmultisig":               "createmultisig nrequired [\"key\",...]\n\nGenerate a multisig address and redeem script.\n\nArguments:\n1. nrequired (numeric, required)         The number of signatures required to redeem outputs paid to this address\n2. keys      (array of string, required) Pubkeys and/or pay-to-pubkey-hash addresses to partially control the multisig address\n\nResult:\n{\n \"address\": \"value\",      (string) The generated pay-to-script-hash address\n \"redeemScript\": \"value\", (string) The script required to redeem outputs paid to the multisig address\n}                         \n",
+		"createmultisigspend":          "createmultisigspend \"fromscraddress\" {\"address\":amount,...} (feerate [\"outpoint\",...])\n\nCreates an unsigned transaction spending outputs of a P2SH multisig address.\nInputs and outputs are sorted deterministically and any change is returned to the multisig address, so cosigners with the same view of the address's unspent outputs create identical transactions for the same arguments.\nThe transaction may then be signed by each cosigner using signrawtransaction.\n\nArguments:\n1. fromscraddress (string, required) The P2SH multisig address to spend from\n2. amounts        (object, required) Pairs of payment addresses and the output amount to pay each\n{\n \"Address to pay\": Amount to send to the payment address valued in decred, (object) JSON object using payment addresses as keys and output amounts valued in decred to send to each address\n ...\n}\n3. feerate   (numeric, optional)         The fee per kilobyte, which cosigners must agree on (default: the wallet relay fee)\n4. outpoints (array of string, optional) Outpoints (\"hash:index\") of the multisig address to spend (default: all unspent outputs)\n\nResult:\n{\n \"hex\": \"value\",    (string)  The hex encoded unsigned transaction\n \"txhash\": \"value\", (string)  The transaction hash, which does not change when signed\n \"fee\": n.nnn,      (numeric) The transaction fee\n \"changeindex\": n,  (numeric) The index of the change output, or -1 without change\n}                   \n",
+		"createnewaccount":             "createnewaccount \"account\"\n\nCreates a new account.\nThe wallet must be unlocked for this request to succeed.\n\nArguments:\n1. account (string, required) Name of the new account\n\nResult:\nNothing\n",
+		"createpaymenturi":             "createpaymenturi (account=\"default\" amount \"label\" \"message\" expiresin)\n\nGenerates a new payment address and returns a decred: payment request URI paying to it.\nThe requested amount, label, message, and expiry are recorded with the address, and payments to the address are reconciled with the request by parsepaymenturi.\nRequests with an expiry are also reported by listexpiredaddresses if they expire before receiving a payment.\n\nArguments:\n1. account   (string, optional, default=\"default\") Account name the new address will belong to\n2. amount    (numeric, optional)                   Optional amount in DCR to request\n3. label     (string, optional)                    Optional label of the payment recipient\n4. message   (string, optional)                    Optional message describing the payment\n5. expiresin (numeric, optional)                   Optional number of seconds after which the request expires\n\nResult:\n{\n \"uri\": \"value\",     (string) The payment request URI\n \"address\": \"value\", (string) The new payment address\n}                    \n",
+		"createrawtransaction":         "createrawtransaction [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...] {\"address\":amount,...} (locktime expiry)\n\nReturns a new transaction spending the provided inputs and sending to the provided addresses.\nThe transaction inputs are not signed in the created transaction.\nThe signrawtransaction RPC command provided by wallet must be used to sign the resulting transaction.\n\nArguments:\n1. inputs (array of object, required) The inputs to the transaction\n[{\n \"amount\": n.nnn, (numeric) The previous output amount\n \"txid\": \"value\", (string)  The transaction hash of the referenced output\n \"vout\": n,       (numeric) The output index of the referenced output\n \"tree\": n,       (numeric) The tree to generate transaction for\n},...]\n2. amounts (object, required) JSON object with the destination addresses as keys and amounts as values\n{\n \"address\": n.nnn, (object) The destination address as the key and the amount in DCR as the value\n ...\n}\n3. locktime (numeric, optional) Locktime value; a non-zero value will also locktime-activate the inputs\n4. expiry   (numeric, optional) Expiry value; a non-zero value when the transaction expiry\n\nResult:\n\"value\" (string) Hex-encoded bytes of the serialized transaction\n",
+		"createreservesnapshot":        "createreservesnapshot (message=\"\")\n\nCreates a proof of reserves committing to every unspent output paid to a public key hash of the wallet at the main chain tip.\nEach output is signed by its controlling key over a message including the block hash, the merkle root of all outputs, and the optional message.\nRequires the wallet to be unlocked.\n\nArguments:\n1. message (string, optional, default=\"\") A message included in every signature, such as a date or challenge from an auditor\n\nResult:\n{\n \"blockhash\": \"value\",  (string)          The main chain block of the snapshot\n \"blockheight\": n,      (numeric)         The height of the snapshot block\n \"message\": \"value\",    (string)          The message included in every signature\n \"merkleroot\": \"value\", (string)          The merkle root committing to every output\n \"total\": n.nnn,        (numeric)         The total value of all outputs\n \"outputs\": [{          (array of object) Every signed output, ordered by outpoint\n  \"txid\": \"value\",      (string)          The transaction hash of the output\n  \"vout\": n,            (numeric)         The output index\n  \"tree\": n,            (numeric)         The transaction tree of the output\n  \"amount\": n.nnn,      (numeric)         The output value\n  \"scriptversion\": n,   (numeric)         The output script version\n  \"pkscript\": \"value\",  (string)          The hex-encoded output script\n  \"address\": \"value\",   (string)          The public key hash address paid by the output\n  \"signature\": \"value\", (string)          The base64-encoded compact signature of the proof message by the address key\n },...],                                  \n}                       \n",
+		"createsignature":              "createsignature \"address\" inputindex hashtype \"previouspkscript\" \"serializedtransaction\"\n\nGenerate a signature for a transaction input script.\n\nArguments:\n1. address               (string, required)  The address of the private key to use to create the signature.\n2. inputindex            (numeric, required) The index of the transaction input to sign.\n3. hashtype              (numeric, required) The signature hash flags to use.\n4. previouspkscript      (string, required)  The hex encoded previous output script or P2SH redeem script.\n5. serializedtransaction (string, required)  The hex encoded transaction to add input signatures to.\n\nResult:\n{\n \"signature\": \"value\", (string) The hex encoded signature.\n \"publickey\": \"value\", (string) The hex encoded serialized compressed pubkey of the address.\n}                      \n",
+		"createswapcontract":           "createswapcontract \"account\" \"recipient\" \"secrethash\" locktime\n\nCreates an atomic swap contract paying to a recipient when a secret is revealed, or refundable to a new internal address of an account after a locktime.\nThe contract is not funded by this command.\n\nArguments:\n1. account    (string, required)  The account used to derive the refund address\n2. recipient  (string, required)  The P2PKH address which may redeem the contract by revealing the secret\n3. secrethash (string, required)  The hex encoded SHA256 hash of the 32 byte secret\n4. locktime   (numeric, required) The block height or unix time after which the contract may be refunded\n\nResult:\n{\n \"contract\": \"value\",      (string)  The hex encoded contract script\n \"address\": \"value\",       (string)  The P2SH address of the contract\n \"refundaddress\": \"value\", (string)  The address which may refund the contract after the locktime\n \"locktime\": n,            (numeric) The contract locktime\n}                          \n",
+		"debugdump":                    "debugdump\n\nReturns a structural description of the wallet suitable for attaching to bug reports.\nKeys, addresses, account names, hashes, and balances are not included.\n\nArguments:\nNone\n\nResult:\n{\n \"network\": \"value\",            (string)          The network of the wallet\n \"dbversion\": n,                (numeric)         The database version\n \"cointype\": n,                 (numeric)         The BIP0044 coin type\n \"watchingonly\": true|false,    (boolean)         Whether the wallet is watching-only\n \"locked\": true|false,          (boolean)         Whether the wallet is locked\n \"tipheight\": n,                (numeric)         The height of the main chain tip\n \"birthheight\": n,              (numeric)         The recorded wallet birthday height\n \"missingcfilters\": true|false, (boolean)         Whether main chain compact filters are missing\n \"unminedtransactions\": n,      (numeric)         The number of unmined transactions\n \"accounts\": [{                 (array of object) The address cursors of each account\n  \"accountnumber\": n,           (numeric)         The account number\n  \"accounttype\": n,             (numeric)         The account type\n  \"lastusedexternal\": n,        (numeric)         The last used external address index\n  \"lastusedinternal\": n,        (numeric)         The last used internal address index\n  \"lastreturnedexternal\": n,    (numeric)         The last returned external address index\n  \"lastreturnedinternal\": n,    (numeric)         The last returned internal address index\n  \"importedkeys\": n,            (numeric)         The number of imported keys\n },...],                                          \n \"buckets\": {                   (object)          The number of key/value pairs of each database bucket, keyed by bucket path\n  \"The bucket path\": The number of key/value pairs, (object) Database bucket sizes\n  ...\n }\n} \n",
+		"debuginspectdb":               "debuginspectdb (\"bucket\" limit=100)\n\nDescribes the buckets of the wallet database, their sizes, and the recorded serialization versions, to debug damaged wallets without copying the database file.\nNested buckets of buckets other than schema buckets are summarized together under the path component '*'.\nKeys are only revealed for schema buckets (meta, waddrmgr, waddrmgr/main, waddrmgr/meta, waddrmgr/sync, and wtxmgr); all other keys, including nested bucket names, and all values, are replaced by hashes salted with a secret generated for each call.\nHashes may be compared within a single result, but not between calls.\n\nArguments:\n1. bucket (string, optional)               Slash-separated path of a bucket whose entries are described, e.g. waddrmgr/main\n2. limit  (numeric, optional, default=100) Maximum number of bucket entries to describe\n\nResult:\n{\n \"versions\": { (object) Serialization versions recorded by the database, keyed by the path of the version key\n  \"The version key path\": The recorded version, (object) Recorded serialization versions\n  ...\n }\n \"latestversion\": n,      (numeric)         The newest database version understood by this wallet\n \"buckets\": [{            (array of object) The size of every database bucket, sorted by path\n  \"path\": \"value\",        (string)          The bucket path\n  \"buckets\": n,           (numeric)         The number of buckets summarized by the path\n  \"entries\": n,           (numeric)         The number of key/value pairs\n  \"keybytes\": n,          (numeric)         The total length of keys\n  \"valuebytes\": n,        (numeric)         The total length of values\n },...],                                    \n \"entries\": [{            (array of object) Entries of the requested bucket, up to the limit\n  \"key\": \"value\",         (string)          The key, or a salted hash of the key when redacted\n  \"redacted\": true|false, (boolean)         Whether the key is redacted\n  \"bucket\": true|false,   (boolean)         Whether the entry is a nested bucket\n  \"keylen\": n,            (numeric)         The length of the key\n  \"valuelen\": n,          (numeric)         The length of the value\n  \"valuehash\": \"value\",   (string)          A salted hash of the value (omitted for nested buckets)\n },...],                                    \n \"totalentries\": n,       (numeric)         The total number of entries of the requested bucket\n}                         \n",
+		"debugtimings":                 "debugtimings (reset=false)\n\nReturns the recorded durations of wallet operations and RPC method calls, to attribute latency to passphrase unlocks, database contention, signing, the network backend, or individual methods.\nWallet operations are unlock (scrypt key derivation), deriveaddress, dbview (read transactions held), dbwritewait (waiting for the database writer), dbupdate (write transactions held), sign, and publish.\n\nArguments:\n1. reset (boolean, optional, default=false) Reset the recorded durations after returning them\n\nResult:\n{\n \"operations\": [{   (array of object) Durations of wallet operations, sorted by name\n  \"name\": \"value\",  (string)          The operation or method name\n  \"count\": n,       (numeric)         The number of completed operations or calls\n  \"inflight\": n,    (numeric)         The number of calls in progress (methods only, omitted when zero)\n  \"totalms\": n.nnn, (numeric)         The total duration in milliseconds\n  \"meanms\": n.nnn,  (numeric)         The mean duration in milliseconds\n  \"maxms\": n.nnn,   (numeric)         The maximum duration in milliseconds\n },...],                              \n \"methods\": [{      (array of object) Durations of RPC method calls, sorted by method\n  \"name\": \"value\",  (string)          The operation or method name\n  \"count\": n,       (numeric)         The number of completed operations or calls\n  \"inflight\": n,    (numeric)         The number of calls in progress (methods only, omitted when zero)\n  \"totalms\": n.nnn, (numeric)         The total duration in milliseconds\n  \"meanms\": n.nnn,  (numeric)         The mean duration in milliseconds\n  \"maxms\": n.nnn,   (numeric)         The maximum duration in milliseconds\n },...],                              \n}                   \n",
+		"debuglevel":                   "debuglevel \"levelspec\"\n\nDynamically changes the debug logging level.\nThe levelspec can either a debug level or of the form:\n<subsystem>=<level>,<subsystem2>=<level2>,...\nThe valid debug levels are trace, debug, info, warn, error, and critical.\nThe valid subsystems are CMGR, DCRW, GRPC, LODR, MIXC, MIXP, PEER, RPCS, SYNC, TKBY, VSPC, and WLLT.\nFinally the keyword 'show' will return a list of the available subsystems.\n\nArguments:\n1. levelspec (string, required) The debug level(s) to use or the keyword 'show'\n\nResult:\n\"value\" (string) The string 'Done.'\n",
+		"deleteinvoice":                "deleteinvoice \"id\"\n\nRemoves an invoice created by createinvoice. The address of the invoice remains a wallet address.\n\nArguments:\n1. id (string, required) The identifier of the invoice\n\nResult:\nNothing\n",
+		"disapprovepercent":            "disapprovepercent\n\nReturns the wallet's current block disapprove percent per vote. i.e. 100 means that all votes disapprove the block they are called on. Only used for testing purposes.\n\nArguments:\nNone\n\nResult:\nn (numeric) The disapprove percent. When voting, this percent of votes will randomly disapprove the block they are called on.\n",
+		"discoverusage":                "discoverusage (\"startblock\" discoveraccounts gaplimit)\n\nPerform address and/or account discovery\n\nArguments:\n1. startblock       (string, optional)  Hash of block to begin discovery from, or null to scan from the genesis block\n2. discoveraccounts (boolean, optional) Perform account discovery in addition to address discovery.  Requires unlocked wallet.\n3. gaplimit         (numeric, optional) Allowed unused address gap.\n\nResult:\nNothing\n",
+		"dumpprivkey":                  "dumpprivkey \"address\"\n\nReturns the private key in WIF encoding that controls some wallet address.\n\nArguments:\n1. address (string, required) The address to return a private key for\n\nResult:\n\"value\" (string) The WIF-encoded private key\n",
+		"estimaterawtransaction":       "estimaterawtransaction \"hexstring\" \"fundaccount\" ({\"changeaddress\":changeaddress,\"feerate\":feerate,\"conftarget\":conftarget})\n\nSelects inputs to fund a raw transaction as fundrawtransaction does, and returns the size and fee of the funded transaction without modifying the wallet.\nSignature script sizes are determined by the script types of the selected outputs, and private keys are not required, allowing watching-only wallets to quote fees of transactions signed offline.\n\nArguments:\n1. hexstring   (string, required) Serialized transaction in hex encoding\n2. fundaccount (string, required) Account of outputs to spend in transaction\n3. options     (object, optional) Object to specify fixed change address, alternative fee rate, and confirmation target\n{\n \"changeaddress\": \"value\", (string)  Provide a change address rather than deriving one from the funding account\n \"feerate\": n.nnn,         (numeric) Alternative fee rate\n \"conf_target\": n,         (numeric) Required confirmations of selected previous outputs\n}                          \n\nResult:\n{\n \"inputs\": [\"value\",...], (array of string) The selected previous outputs, formatted as txid:index\n \"totalinput\": n.nnn,     (numeric)         The total value of the selected previous outputs\n \"fee\": n.nnn,            (numeric)         Absolute fee of the funded transaction\n \"change\": n.nnn,         (numeric)         Value of the change output, or zero without change\n \"size\": n,               (numeric)         Serialize size of the funded transaction without signature scripts\n \"signedsize\": n,         (numeric)         Estimated serialize size of the signed transaction, from which the fee is calculated\n}                         \n",
+		"estimatetxsize":               "estimatetxsize \"txtype\" [\"input\",...] [\"output\",...] (change=false feerate)\n\nReturns the worst case serialized size and fee of a hypothetical signed transaction spending inputs of the given script types and paying to addresses.\nRegular transactions pay each output address, and optionally P2PKH change.\nTickets pay the single voting address output, and a commitment and P2PKH change output for each input.\nVotes spend a ticket redeemed by the single input script type (default p2pkh) and pay each reward address.\nRevocations have no inputs and pay each refund address.\n\nArguments:\n1. txtype  (string, required)                 The transaction type: regular, ticket, vote, or revocation\n2. inputs  (array of string, required)        The script types of previous outputs redeemed by inputs: p2pkh, p2pk, p2sh, or multisig-M-of-N\n3. outputs (array of string, required)        The addresses paid by outputs\n4. change  (boolean, optional, default=false) Whether a regular transaction pays change\n5. feerate (numeric, optional)                Fee rate in DCR/kB (default is the wallet relay fee)\n\nResult:\n{\n \"size\": n,        (numeric) Worst case serialized size of the signed transaction\n \"fee\": n.nnn,     (numeric) Fee of the transaction at the fee rate\n \"feerate\": n.nnn, (numeric) The fee rate in DCR/kB\n}                  \n",
+		"exportvotepolicies":           "exportvotepolicies \"address\"\n\nExports the default agenda choices and the treasury spend and treasury key policies of the wallet, signed by the key of a wallet address, for import by importvotepolicies on other voting wallets.\nPer-ticket policies are not exported.\nRequires the wallet to be unlocked.\n\nArguments:\n1. address (string, required) The wallet address signing the policies\n\nResult:\n\"value\" (string) The signed policies\n",
+		"exportvotingaccount":          "exportvotingaccount \"account\" (count=20)\n\nExports the extended private key of an account designated as the voting account of purchased tickets, for import by importvotingaccount on a separate voting wallet.\nThe first voting addresses of the account are returned to be checked by verifyvotingaccount on the voting wallet.\nThe default account and accounts holding any funds can not be exported.\nRequires the wallet or account to be unlocked.\n\nArguments:\n1. account (string, required)              The voting account\n2. count   (numeric, optional, default=20) Number of voting addresses to return\n\nResult:\n{\n \"xpriv\": \"value\",           (string)          The account extended private key\n \"addresses\": [\"value\",...], (array of string) The first voting addresses of the account\n}                            \n",
+		"forecaststake":                "forecaststake (blocks=0 interval=0 {\"account\":account,\"buytickets\":buytickets,\"maintain\":maintain,\"limit\":limit,\"ticketprice\":ticketprice})\n\nProjects the expected votes of unspent tickets and the expected locked and spendable balances of the wallet over future blocks, assuming the configured ticket buyer parameters unless overridden.\nLive tickets are assumed to be chosen to vote by each block with probability 1/ticketpoolsize, unmined tickets are assumed to be mined in the next block, and missed tickets are assumed to be revoked after expiry.\nVote rewards are calculated with the current subsidy split, and transaction and VSP fees are not included.\n\nArguments:\n1. blocks   (numeric, optional, default=0) Number of blocks to project (default is the lifetime of a new ticket)\n2. interval (numeric, optional, default=0) Number of blocks between samples (default is one day of blocks)\n3. options  (object, optional)             Object overriding the ticket buyer purchasing account, whether tickets are purchased, balance to maintain, per-block purchase limit, and ticket price\n{\n \"account\": \"value\",       (string)  The purchasing account\n \"buytickets\": true|false, (boolean) Whether tickets are purchased\n \"maintain\": n.nnn,        (numeric) Spendable balance to maintain when purchasing tickets\n \"limit\": n,               (numeric) Maximum number of tickets purchased per block, or zero for no limit\n \"ticketprice\": n.nnn,     (numeric) Price of purchased tickets (default is the next stake difficulty)\n}                          \n\nResult:\n{\n \"tipheight\": n,            (numeric)         The main chain tip height\n \"ticketprice\": n.nnn,      (numeric)         The assumed price of purchased tickets\n \"tickets\": [{              (array of object) The expected votes of unspent tickets\n  \"hash\": \"value\",          (string)          The ticket hash\n  \"value\": n.nnn,           (numeric)         The ticket price\n  \"firstvoteheight\": n,     (numeric)         Height of the first block which may choose the ticket to vote\n  \"lastvoteheight\": n,      (numeric)         Height of the last block which may choose the ticket to vote before expiry\n  \"voteprobability\": n.nnn, (numeric)         Probability of the ticket voting before expiry\n  \"expectedvoteheight\": n,  (numeric)         Expected height of the vote, if the ticket votes\n  \"expectedvotetime\": n,    (numeric)         Expected unix time of the vote, if the ticket votes\n },...],                                      \n \"samples\": [{              (array of object) The expected stake and balances at each sampled block\n  \"height\": n,              (numeric)         The block height\n  \"time\": n,                (numeric)         The expected unix time of the block\n  \"locked\": n.nnn,          (numeric)         Expected value of unspent tickets\n  \"tickets\": n.nnn,         (numeric)         Expected number of unspent tickets\n  \"immature\": n.nnn,        (numeric)         Expected value of immature vote and revocation outputs\n  \"spendable\": n.nnn,       (numeric)         Expected spendable balance of the purchasing account\n  \"votes\": n.nnn,           (numeric)         Expected number of votes since the main chain tip\n  \"purchased\": n,           (numeric)         Number of tickets purchased since the main chain tip\n },...],                                      \n}                           \n",
+		"fundrawtransaction":           "fundrawtransaction \"hexstring\" \"fundaccount\" ({\"changeaddress\":changeaddress,\"feerate\":feerate,\"conftarget\":conftarget})\n\nAdds unsigned inputs and change output to a raw transaction\n\nArguments:\n1. hexstring   (string, required) Serialized transaction in hex encoding\n2. fundaccount (string, required) Account of outputs to spend in transaction\n3. options     (object, optional) Object to specify fixed change address, alternative fee rate, and confirmation target\n{\n \"changeaddress\": \"value\", (string)  Provide a change address rather than deriving one from the funding account\n \"feerate\": n.nnn,         (numeric) Alternative fee rate\n \"conf_target\": n,         (numeric) Required confirmations of selected previous outputs\n}                          \n\nResult:\n{\n \"hex\": \"value\", (string)  Funded transaction in hex encoding\n \"fee\": n.nnn,   (numeric) Absolute fee of funded transaction\n}                \n",
+		"getaccount":                   "getaccount \"address\"\n\nLookup the account name that some wallet address belongs to.\n\nArguments:\n1. address (string, required) The address to query the account for\n\nResult:\n\"value\" (string) The name of the account that 'address' belongs to\n",
+		"getaccountaddress":            "getaccountaddress \"account\"\n\nDEPRECATED -- Returns the most recent external payment address for an account that has not been seen publicly.\nA new address is generated for the account if the most recently generated address has been seen on the blockchain or in mempool.\n\nArguments:\n1. account (string, required) The account of the returned address\n\nResult:\n\"value\" (string) The unused address for 'account'\n",
+		"getaccountblindedid":          "getaccountblindedid \"account\"\n\nReturns the blinded ID of an account: a keyed BLAKE-256 hash of the account extended public key.\nThe key is private to the wallet, so blinded IDs can be shared with external services to refer to an account without revealing its xpub.\nThe imported account has no blinded ID.\n\nArguments:\n1. account (string, required) The account name\n\nResult:\n\"value\" (string) The hex-encoded blinded ID\n",
+		"getaccountbyid":               "getaccountbyid \"uuid\"\n\nReturns the current name, number, and rename history of the account identified by a UUID.\n\nArguments:\n1. uuid (string, required) The account UUID\n\nResult:\n{\n \"accountname\": \"value\", (string)          The current account name\n \"accountnumber\": n,     (numeric)         The account number\n \"uuid\": \"value\",        (string)          The immutable account UUID\n \"renames\": [{           (array of object) Each rename of the account, oldest first\n  \"time\": n,             (numeric)         The unix time of the rename\n  \"oldname\": \"value\",    (string)          The account name before the rename\n  \"newname\": \"value\",    (string)          The account name after the rename\n },...],                                   \n}                        \n",
+		"getaccountchildxpub":          "getaccountchildxpub \"account\" \"path\"\n\nDerives the extended public key of a hardened child path below an account's extended key, for use by external protocols requiring purpose-specific keys (e.g. identity or encryption keys) derived from the wallet seed.\nOnly hardened paths may be derived, so the keys are neither derivable from the account xpub nor used for wallet addresses.\nRequires the wallet or account to be unlocked.\n\nArguments:\n1. account (string, required) The account name\n2. path    (string, required) The child path relative to the account key, with each index hardened by a ' or h suffix (e.g. \"13'/0'\")\n\nResult:\n\"value\" (string) The extended public key of the child path\n",
+		"getaccountcryptoparams":       "getaccountcryptoparams (\"account\")\n\nReturns the encryption scheme and key derivation parameters protecting the private keys of accounts, and when their passphrases were last changed.\n\nArguments:\n1. account (string, optional) The account name, or all accounts when omitted\n\nResult:\n[{\n \"accountname\": \"value\", (string)  The account name\n \"accountnumber\": n,     (numeric) The account number\n \"encryption\": \"value\",  (string)  The encryption scheme (\"none\", \"snacl-scrypt\" for keys protected by the wallet passphrase, or \"xchacha20poly1305-argon2id\" for keys protected by a unique account passphrase)\n \"scryptn\": n,           (numeric) The scrypt N (CPU/memory cost) parameter of the wallet passphrase KDF\n \"scryptr\": n,           (numeric) The scrypt r (block size) parameter of the wallet passphrase KDF\n \"scryptp\": n,           (numeric) The scrypt p (parallelism) parameter of the wallet passphrase KDF\n \"argon2idtime\": n,      (numeric) The Argon2id time parameter of the account passphrase KDF\n \"argon2idmemory\": n,    (numeric) The Argon2id memory parameter (KiB) of the account passphrase KDF\n \"argon2idthreads\": n,   (numeric) The Argon2id parallelism of the account passphrase KDF\n \"passphrasechanged\": n, (numeric) Unix time the passphrase was last changed, omitted when unknown\n},...]\n",
+		"getaccountid":                 "getaccountid \"account\"\n\nReturns the immutable UUID and rename history of an account.\nUnlike account names, UUIDs do not change when accounts are renamed.\n\nArguments:\n1. account (string, required) The current name of the account\n\nResult:\n{\n \"accountname\": \"value\", (string)          The current account name\n \"accountnumber\": n,     (numeric)         The account number\n \"uuid\": \"value\",        (string)          The immutable account UUID\n \"renames\": [{           (array of object) Each rename of the account, oldest first\n  \"time\": n,             (numeric)         The unix time of the rename\n  \"oldname\": \"value\",    (string)          The account name before the rename\n  \"newname\": \"value\",    (string)          The account name after the rename\n },...],                                   \n}                        \n",
+		"getaccountwatchkey":           "getaccountwatchkey \"account\" (start=0 count=20 rescan=false scanfrom)\n\nReturns an external-only extended public key and address index window which third-party services may use to detect payments to an account.\nThe key is derived from a hardened child of the account key, so neither the account xpub nor its internal (change) branch is revealed.\nThe addresses of the window are imported to the account, and windows may be extended by later requests.\nRequires the unlocked wallet, and is not supported by accounts with unique passphrases.\n\nArguments:\n1. account  (string, required)                 The account name\n2. start    (numeric, optional, default=0)     The first child index of the window\n3. count    (numeric, optional, default=20)    The number of addresses in the window (at most 1000)\n4. rescan   (boolean, optional, default=false) Rescan the blockchain for transactions of the window's addresses\n5. scanfrom (numeric, optional)                Block height to begin the rescan from\n\nResult:\n{\n \"xpub\": \"value\", (string)  The extended public key of the watch key branch, whose children are the window's addresses\n \"start\": n,      (numeric) The first child index of the window\n \"count\": n,      (numeric) The number of addresses in the window\n}                 \n",
+		"getaddressesbyaccount":        "getaddressesbyaccount \"account\"\n\nDEPRECATED -- Returns all addresses strings controlled by a single account.\n\nArguments:\n1. account (string, required) Account name to fetch addresses for\n\nResult:\n[\"value\",...] (array of string) All addresses controlled by 'account'\n",
+		"getbalance":                   "getbalance (\"account\" minconf=1)\n\nCalculates and returns the balance of all accounts.\n\nArguments:\n1. account (string, optional)             The account name to query the balance for, or \"*\" to consider all accounts (default=\"*\")\n2. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an unspent output's value is included in the balance\n\nResult:\n{\n \"balances\": [{                         (array of object) Balances for all accounts.\n  \"accountname\": \"value\",               (string)          Name of account.\n  \"immaturecoinbaserewards\": n.nnn,     (numeric)         Immature Coinbase reward coins.\n  \"immaturestakegeneration\": n.nnn,     (numeric)         Number of immature stake coins.\n  \"lockedbytickets\": n.nnn,             (numeric)         Coins locked by tickets.\n  \"lockedbylivetickets\": n.nnn,         (numeric)         Coins locked by live (mature and unexpired) tickets; included in lockedbytickets.\n  \"lockedbyunspent\": n.nnn,             (numeric)         Value of unspent outputs locked by lockunspent or reservefunds; included in the other balances.\n  \"spendable\": n.nnn,                   (numeric)         Spendable number of coins.\n  \"total\": n.nnn,                       (numeric)         Total amount of coins.\n  \"unconfirmed\": n.nnn,                 (numeric)         Unconfirmed number of coins.\n  \"votingauthority\": n.nnn,             (numeric)         Coins for voting authority.\n },...],                                                  \n \"blockhash\": \"value\",                  (string)          Block hash.\n \"totalimmaturecoinbaserewards\": n.nnn, (numeric)         Total number of immature coinbase reward coins.\n \"totalimmaturestakegeneration\": n.nnn, (numeric)         Total number of immature stake coins.\n \"totallockedbytickets\": n.nnn,         (numeric)         Total number of coins locked by tickets.\n \"totallockedbylivetickets\": n.nnn,     (numeric)         Total number of coins locked by live tickets.\n \"totallockedbyunspent\": n.nnn,         (numeric)         Total value of unspent outputs locked by lockunspent or reservefunds.\n \"totalspendable\": n.nnn,               (numeric)         Total number of spendable number of coins.\n \"cumulativetotal\": n.nnn,              (numeric)         Total number of coins.\n \"totalunconfirmed\": n.nnn,             (numeric)         Total number of unconfirmed coins.\n \"totalvotingauthority\": n.nnn,         (numeric)         Total number of coins for voting authority.\n}                                       \n",
+		"getcapabilities":              "getcapabilities\n\nReturns the API version, supported methods, and enabled optional subsystems of the wallet and RPC server, so clients may adapt without probing with trial calls.\n\nArguments:\nNone\n\nResult:\n{\n \"apiversion\": \"value\",      (string)          The semantic version of the JSON-RPC API\n \"version\": \"value\",         (string)          The version of dcrwallet\n \"walletloaded\": true|false, (boolean)         Whether a wallet is loaded; wallet features and the sync mode are only reported for loaded wallets\n \"syncmode\": \"value\",        (string)          How the wallet is synced to the network (\"spv\", \"rpc\", or \"none\")\n \"features\": [\"value\",...],  (array of string) Sorted names of the enabled optional subsystems (\"accountrollover\", \"mixing\", \"pendingspends\", \"readonly\", \"sidechainpruning\", \"ticketbuyer\", \"treasurykeys\", \"txpruning\", \"voteonly\", \"vsp\", \"watchingonly\", \"webhooks\")\n \"methods\": [\"value\",...],   (array of string) Sorted names of the supported methods\n}                            \n",
+		"getbestblock":                 "getbestblock\n\nReturns the hash and height of the newest block in the best chain that wallet has finished syncing with.\n\nArguments:\nNone\n\nResult:\n{\n \"hash\": \"value\", (string)  The hash of the block\n \"height\": n,     (numeric) The blockchain height of the block\n}                 \n",
+		"getbestblockhash":             "getbestblockhash\n\nReturns the hash of the newest block in the best chain that wallet has finished syncing with.\n\nArguments:\nNone\n\nResult:\n\"value\" (string) The hash of the most recent synced-to block\n",
+		"getblockcount":                "getblockcount\n\nReturns the blockchain height of the newest block in the best chain that wallet has finished syncing with.\n\nArguments:\nNone\n\nResult:\nn.nnn (numeric) The blockchain height of the most recent synced-to block\n",
+		"getblockhash":                 "getblockhash index\n\nReturns the hash of a main chain block at some height\n\nArguments:\n1. index (numeric, required) The block height\n\nResult:\n\"value\" (string) The main chain block hash\n",
+		"getblockheader":               "getblockheader \"hash\" (verbose=true)\n\nReturns information about a block header given its hash.\n\nArguments:\n1. hash    (string, required)                The hash of the block\n2. verbose (boolean, optional, default=true) Specifies the block header is returned as a JSON object instead of hex-encoded string\n\nResult:\n{\n \"hash\": \"value\",              (string)  The hash of the block (same as provided)\n \"powhash\": \"value\",           (string)  The Proof-of-Work hash of the block (same as hash prior to DCP0011 activation)\n \"confirmations\": n,           (numeric) The number of confirmations\n \"version\": n,                 (numeric) The block version\n \"merkleroot\": \"value\",        (string)  The merkle root of the regular transaction tree\n \"stakeroot\": \"value\",         (string)  The merkle root of the stake transaction tree\n \"votebits\": n,                (numeric) The vote bits\n \"finalstate\": \"value\",        (string)  The final state value of the ticket pool\n \"voters\": n,                  (numeric) The number of votes in the block\n \"freshstake\": n,              (numeric) The number of new tickets in the block\n \"revocations\": n,             (numeric) The number of revocations in the block\n \"poolsize\": n,                (numeric) The size of the live ticket pool\n \"bits\": \"value\",              (string)  The bits which represent the block difficulty\n \"sbits\": n.nnn,               (numeric) The stake difficulty in coins\n \"height\": n,                  (numeric) The height of the block in the block chain\n \"size\": n,                    (numeric) The size of the block in bytes\n \"time\": n,                    (numeric) The block time in seconds since 1 Jan 1970 GMT\n \"mediantime\": n,              (numeric) The median block time over the last 11 blocks\n \"nonce\": n,                   (numeric) The block nonce\n \"extradata\": \"value\",         (string)  Extra data field for the requested block\n \"stakeversion\": n,            (numeric) The stake version of the block\n \"difficulty\": n.nnn,          (numeric) The proof-of-work difficulty as a multiple of the minimum difficulty\n \"chainwork\": \"value\",         (string)  The total number of hashes expected to produce the chain up to the block in hex (not set in SPV mode)\n \"previousblockhash\": \"value\", (string)  The hash of the previous block\n \"nextblockhash\": \"value\",     (string)  The hash of the next block (only if there is one)\n}                              \n",
+		"getblock":                     "getblock \"hash\" (verbose=true verbosetx=false)\n\nReturns information about a block given its hash.\n\nArguments:\n1. hash      (string, required)                 The hash of the block\n2. verbose   (boolean, optional, default=true)  Specifies the block is returned as a JSON object instead of hex-encoded string\n3. verbosetx (boolean, optional, default=false) Specifies that each transaction is returned as a JSON object and only applies if the verbose flag is true (dcrd extension)\n\nResult:\n{\n \"hash\": \"value\",               (string)          The hash of the block (same as provided)\n \"powhash\": \"value\",            (string)          The Proof-of-Work hash of the block (same as hash prior to DCP0011 activation)\n \"confirmations\": n,            (numeric)         The number of confirmations\n \"size\": n,                     (numeric)         The size of the block\n \"height\": n,                   (numeric)         The height of the block in the block chain\n \"version\": n,                  (numeric)         The block version\n \"merkleroot\": \"value\",         (string)          Root hash of the merkle tree\n \"stakeroot\": \"value\",          (string)          The block's sstx hashes the were included\n \"tx\": [\"value\",...],           (array of string) The transaction hashes (only when verbosetx=false)\n \"rawtx\": [{                    (array of object) The transactions as JSON objects (only when verbosetx=true)\n  \"hex\": \"value\",               (string)          Hex-encoded transaction\n  \"txid\": \"value\",              (string)          The hash of the transaction\n  \"version\": n,                 (numeric)         The transaction version\n  \"locktime\": n,                (numeric)         The transaction lock time\n  \"expiry\": n,                  (numeric)         The transacion expiry\n  \"vin\": [{                     (array of object) The transaction inputs as JSON objects\n   \"coinbase\": \"value\",         (string)          The hex-encoded bytes of the signature script (coinbase txns only)\n   \"stakebase\": \"value\",        (string)          The hex-encoded bytes of the signature script (vote txns only)\n   \"treasurybase\": true|false,  (boolean)         Whether or not the input is a treasury base (treasurybase txns only)\n   \"treasuryspend\": \"value\",    (string)          The hex-encoded bytes of the signature script (treasury spend txns only)\n   \"txid\": \"value\",             (string)          The hash of the origin transaction (non-coinbase txns only)\n   \"vout\": n,                   (numeric)         The index of the output being redeemed from the origin transaction (non-coinbase txns only)\n   \"tree\": n,                   (numeric)         The tree of the transaction\n   \"sequence\": n,               (numeric)         The script sequence number\n   \"amountin\": n.nnn,           (numeric)         The amount in\n   \"blockheight\": n,            (numeric)         The block height of the origin transaction\n   \"blockindex\": n,             (numeric)         The block idx of the origin transaction\n   \"scriptSig\": {               (object)          The signature script used to redeem the origin transaction as a JSON object (non-coinbase txns only)\n    \"asm\": \"value\",             (string)          Disassembly of the script\n    \"hex\": \"value\",             (string)          Hex-encoded bytes of the script\n   },                                             \n  },...],                                         \n  \"vout\": [{                    (array of object) The transaction outputs as JSON objects\n   \"value\": n.nnn,              (numeric)         The amount in DCR\n   \"n\": n,                      (numeric)         The index of this transaction output\n   \"version\": n,                (numeric)         The version of the public key script\n   \"scriptPubKey\": {            (object)          The public key script used to pay coins as a JSON object\n    \"asm\": \"value\",             (string)          Disassembly of the script\n    \"hex\": \"value\",             (string)          Hex-encoded bytes of the script\n    \"reqSigs\": n,               (numeric)         The number of required signatures\n    \"type\": \"value\",            (string)          The type of the script (e.g. 'pubkeyhash')\n    \"addresses\": [\"value\",...], (array of string) The Decred addresses associated with this script\n    \"commitamt\": n.nnn,         (numeric)         The ticket commitment value if the script is for a staking commitment\n    \"version\": n,               (numeric)         The script version\n   },                                             \n  },...],                                         \n  \"blockhash\": \"value\",         (string)          The hash of the block that contains the transaction\n  \"blockheight\": n,             (numeric)         The height of the block that contains the transaction\n  \"blockindex\": n,              (numeric)         The index within the array of transactions contained by the block\n  \"confirmations\": n,           (numeric)         Number of confirmations of the block\n  \"time\": n,                    (numeric)         Transaction time in seconds since 1 Jan 1970 GMT\n  \"blocktime\": n,               (numeric)         Block time in seconds since the 1 Jan 1970 GMT\n },...],                                          \n \"stx\": [\"value\",...],          (array of string) The block's sstx hashes the were included\n \"rawstx\": [{                   (array of object) The block's raw sstx hashes the were included\n  \"hex\": \"value\",               (string)          Hex-encoded transaction\n  \"txid\": \"value\",              (string)          The hash of the transaction\n  \"version\": n,                 (numeric)         The transaction version\n  \"locktime\": n,                (numeric)         The transaction lock time\n  \"expiry\": n,                  (numeric)         The transacion expiry\n  \"vin\": [{                     (array of object) The transaction inputs as JSON objects\n   \"coinbase\": \"value\",         (string)          The hex-encoded bytes of the signature script (coinbase txns only)\n   \"stakebase\": \"value\",        (string)          The hex-encoded bytes of the signature script (vote txns only)\n   \"treasurybase\": true|false,  (boolean)         Whether or not the input is a treasury base (treasurybase txns only)\n   \"treasuryspend\": \"value\",    (string)          The hex-encoded bytes of the signature script (treasury spend txns only)\n   \"txid\": \"value\",             (string)          The hash of the origin transaction (non-coinbase txns only)\n   \"vout\": n,                   (numeric)         The index of the output being redeemed from the origin transaction (non-coinbase txns only)\n   \"tree\": n,                   (numeric)         The tree of the transaction\n   \"sequence\": n,               (numeric)         The script sequence number\n   \"amountin\": n.nnn,           (numeric)         The amount in\n   \"blockheight\": n,            (numeric)         The block height of the origin transaction\n   \"blockindex\": n,             (numeric)         The block idx of the origin transaction\n   \"scriptSig\": {               (object)          The signature script used to redeem the origin transaction as a JSON object (non-coinbase txns only)\n    \"asm\": \"value\",             (string)          Disassembly of the script\n    \"hex\": \"value\",             (string)          Hex-encoded bytes of the script\n   },                                             \n  },...],                                         \n  \"vout\": [{                    (array of object) The transaction outputs as JSON objects\n   \"value\": n.nnn,              (numeric)         The amount in DCR\n   \"n\": n,                      (numeric)         The index of this transaction output\n   \"version\": n,                (numeric)         The version of the public key script\n   \"scriptPubKey\": {            (object)          The public key script used to pay coins as a JSON object\n    \"asm\": \"value\",             (string)          Disassembly of the script\n    \"hex\": \"value\",             (string)          Hex-encoded bytes of the script\n    \"reqSigs\": n,               (numeric)         The number of required signatures\n    \"type\": \"value\",            (string)          The type of the script (e.g. 'pubkeyhash')\n    \"addresses\": [\"value\",...], (array of string) The Decred addresses associated with this script\n    \"commitamt\": n.nnn,         (numeric)         The ticket commitment value if the script is for a staking commitment\n    \"version\": n,               (numeric)         The script version\n   },                                             \n  },...],                                         \n  \"blockhash\": \"value\",         (string)          The hash of the block that contains the transaction\n  \"blockheight\": n,             (numeric)         The height of the block that contains the transaction\n  \"blockindex\": n,              (numeric)         The index within the array of transactions contained by the block\n  \"confirmations\": n,           (numeric)         Number of confirmations of the block\n  \"time\": n,                    (numeric)         Transaction time in seconds since 1 Jan 1970 GMT\n  \"blocktime\": n,               (numeric)         Block time in seconds since the 1 Jan 1970 GMT\n },...],                                          \n \"time\": n,                     (numeric)         The block time in seconds since 1 Jan 1970 GMT\n \"mediantime\": n,               (numeric)         The median block time over the last 11 blocks\n \"nonce\": n,                    (numeric)         The block nonce\n \"votebits\": n,                 (numeric)         The block's voting results\n \"finalstate\": \"value\",         (string)          The block's finalstate\n \"voters\": n,                   (numeric)         The number votes in the block\n \"freshstake\": n,               (numeric)         The number of new tickets in the block\n \"revocations\": n,              (numeric)         The number of revocations in the block\n \"poolsize\": n,                 (numeric)         The size of the live ticket pool\n \"bits\": \"value\",               (string)          The bits which represent the block difficulty\n \"sbits\": n.nnn,                (numeric)         The stake difficulty of the block\n \"extradata\": \"value\",          (string)          Extra data field for the requested block\n \"stakeversion\": n,             (numeric)         Stake Version of the block\n \"difficulty\": n.nnn,           (numeric)         The proof-of-work difficulty as a multiple of the minimum difficulty\n \"chainwork\": \"value\",          (string)          The total number of hashes expected to produce the chain up to the block in hex\n \"previousblockhash\": \"value\",  (string)          The hash of the previous block\n \"nextblockhash\": \"value\",      (string)          The hash of the next block (only if there is one)\n}                               \n",
+		"getcoinjoinsbyacct":           "getcoinjoinsbyacct\n\nGet coinjoin outputs by account.\n\nArguments:\nNone\n\nResult:\n{\n \"Accounts name\": Coinjoin outputs sum., (object) Return a map of account's name and its coinjoin outputs sum.\n ...\n}\n",
+		"getcurrentnet":                "getcurrentnet\n\nGet Decred network the wallet is connected to.\n\nArguments:\nNone\n\nResult:\nn (numeric) The network identifier\n",
+		"getdestinationpolicy":         "getdestinationpolicy \"account\"\n\nReturns the destination policy restricting the payments of an account.\n\nArguments:\n1. account (string, required) The account name\n\nResult:\n{\n \"allowlist\": true|false,  (boolean)         Whether payments to destinations which are not allowed are rejected\n \"allowed\": [\"value\",...], (array of string) Addresses which may be paid in allowlist mode\n \"denied\": [\"value\",...],  (array of string) Addresses which may never be paid\n}                          \n",
+		"getduressaccount":             "getduressaccount\n\nReturns the account unlocked by the duress passphrase. Requires the wallet to be unlocked.\n\nArguments:\nNone\n\nResult:\n{\n \"enabled\": true|false, (boolean) Whether a duress passphrase is configured\n \"account\": \"value\",    (string)  The account unlocked by the duress passphrase (omitted when disabled)\n}                       \n",
+		"getinfo":                      "getinfo\n\nReturns a JSON object containing various state info.\n\nArguments:\nNone\n\nResult:\n{\n \"version\": n,          (numeric) The version of the server\n \"protocolversion\": n,  (numeric) The latest supported protocol version\n \"walletversion\": n,    (numeric) The version of the address manager database\n \"balance\": n.nnn,      (numeric) The balance of all accounts calculated with one block confirmation\n \"blocks\": n,           (numeric) The number of blocks processed\n \"timeoffset\": n,       (numeric) The time offset\n \"connections\": n,      (numeric) The number of connected peers\n \"proxy\": \"value\",      (string)  The proxy used by the server\n \"difficulty\": n.nnn,   (numeric) The current target difficulty\n \"testnet\": true|false, (boolean) Whether or not server is using testnet\n \"keypoololdest\": n,    (numeric) Unset\n \"keypoolsize\": n,      (numeric) Unset\n \"unlocked_until\": n,   (numeric) Unset\n \"paytxfee\": n.nnn,     (numeric) The fee per kB of the serialized tx size used each time more fee is required for an authored transaction\n \"relayfee\": n.nnn,     (numeric) The minimum relay fee for non-free transactions in DCR/KB\n \"errors\": \"value\",     (string)  Any current errors\n}                       \n",
+		"getinvoice":                   "getinvoice \"id\"\n\nReturns an invoice created by createinvoice and its reconciliation with mined payments.\n\nArguments:\n1. id (string, required) The identifier of the invoice\n\nResult:\n{\n \"id\": \"value\",      (string)  The identifier of the invoice\n \"address\": \"value\", (string)  The address to be paid\n \"account\": \"value\", (string)  The account of the address\n \"amount\": n.nnn,    (numeric) The invoiced amount in DCR\n \"received\": n.nnn,  (numeric) The total amount in DCR of mined outputs received by the address\n \"status\": \"value\",  (string)  The invoice status (pending, partial, paid, overpaid, or expired)\n \"created\": n,       (numeric) The Unix time the invoice was created\n \"expiry\": n,        (numeric) The Unix time the invoice expires (omitted if it does not expire)\n \"updated\": n,       (numeric) The Unix time the received amount or status last changed (omitted if unchanged)\n \"changedheight\": n, (numeric) The main chain tip height when the received amount last changed (omitted if nothing was received)\n}                    \n",
+		"getjournalevents":             "getjournalevents fromsequence (count=1000)\n\nReturns events recorded by the wallet's event journal, beginning with a sequence number.\nEvents are recorded for wallet transactions entering the unmined set (txunmined), mined in (txmined) or detached from (txdetached) a main chain block, removed while unmined (txremoved), and removed after expiring unmined (txexpired), for each new main chain tip (tipchanged), and for tickets whose VSP fee payment was abandoned after failed retries (vspfeefailed).\nEvents are recorded atomically with the changes they describe, so clients which resume from the next sequence number after disconnecting never miss an event.\nThe same change may be recorded more than once, and clients must tolerate duplicate events.\n\nArguments:\n1. fromsequence (numeric, required)               The sequence number of the first event to return, beginning at 1\n2. count        (numeric, optional, default=1000) The maximum number of events to return\n\nResult:\n{\n \"events\": [{           (array of object) The events in increasing sequence order\n  \"sequence\": n,        (numeric)         The sequence number of the event\n  \"type\": \"value\",      (string)          The event type (txunmined, txmined, txdetached, txremoved, txexpired, tipchanged, or vspfeefailed)\n  \"txhash\": \"value\",    (string)          The hash of the transaction, or of the ticket for vspfeefailed (omitted for tipchanged)\n  \"blockhash\": \"value\", (string)          The block the transaction was mined in or detached from, or the new tip block\n  \"height\": n,          (numeric)         The height of the block (omitted for unmined transaction events)\n  \"time\": n,            (numeric)         The Unix time the event was recorded\n },...],                                  \n \"nextsequence\": n,     (numeric)         The sequence number which will be assigned to the next recorded event\n \"pruned\": true|false,  (boolean)         Whether events after fromsequence were removed from the journal and could not be returned\n}                       \n",
+		"getmasterpubkey":              "getmasterpubkey (\"account\")\n\nRequests the master pubkey from the wallet.\n\nArguments:\n1. account (string, optional) The account to get the master pubkey for\n\nResult:\n\"value\" (string) The master pubkey for the wallet\n",
+		"getmixedspendpolicy":          "getmixedspendpolicy\n\nReturns the mixed spend policy restricting the inputs of wallet-authored transactions.\n\nArguments:\nNone\n\nResult:\n{\n \"enabled\": true|false, (boolean) Whether transactions may only spend mixed outputs\n \"account\": \"value\",    (string)  The mixed account (omitted when disabled)\n \"branch\": n,           (numeric) The branch of the mixed account receiving mixed outputs\n}                       \n",
+		"getmixpolicy":                 "getmixpolicy\n\nReturns the minimum peer count and peer protocol version required of mixes.\n\nArguments:\nNone\n\nResult:\n{\n \"minpeers\": n,       (numeric) The minimum number of peers required to complete a mix\n \"minpeerversion\": n, (numeric) The minimum protocol version of network peers that mixing messages are exchanged with\n}                     \n",
+		"getmultisigoutinfo":           "getmultisigoutinfo \"hash\" index\n\nReturns information about a multisignature output.\n\nArguments:\n1. hash  (string, required)  Input hash to check.\n2. index (numeric, required) Index of input.\n\nResult:\n{\n \"address\": \"value\",       (string)          Script address.\n \"redeemscript\": \"value\",  (string)          Hex of the redeeming script.\n \"m\": n,                   (numeric)         m (in m-of-n)\n \"n\": n,                   (numeric)         n (in m-of-n)\n \"pubkeys\": [\"value\",...], (array of string) Associated pubkeys.\n \"txhash\": \"value\",        (string)          txhash\n \"blockheight\": n,         (numeric)         Height of the containing block.\n \"blockhash\": \"value\",     (string)          Hash of the containing block.\n \"spent\": true|false,      (boolean)         If it has been spent.\n \"spentby\": \"value\",       (string)          Hash of spending tx.\n \"spentbyindex\": n,        (numeric)         Index of spending tx.\n \"amount\": n.nnn,          (numeric)         Amount of coins contained.\n}                          \n",
+		"getnewaddress":                "getnewaddress (\"account\" \"gappolicy\" expiresin expiryheight)\n\nGenerates and returns a new payment address.\nAddresses issued with an expiry time or height are reported by listexpiredaddresses if they expire before receiving a payment.\n\nArguments:\n1. account      (string, optional)  Account name the new address will belong to (default=\"default\")\n2. gappolicy    (string, optional)  String defining the policy to use when the BIP0044 gap limit would be violated, may be \"error\", \"ignore\", or \"wrap\"\n3. expiresin    (numeric, optional) Optional number of seconds after which the address expires\n4. expiryheight (numeric, optional) Optional block height at which the address expires\n\nResult:\n\"value\" (string) The payment address\n",
+		"getpeerinfo":                  "getpeerinfo\n\nReturns data on remote peers when in spv mode.\n\nArguments:\nNone\n\nResult:\n{\n \"id\": n,              (numeric) A unique node ID\n \"addr\": \"value\",      (string)  The remote IP address and port of the peer\n \"addrlocal\": \"value\", (string)  The local IP address and port of the peer\n \"services\": \"value\",  (string)  Services bitmask which represents the services supported by the peer\n \"version\": n,         (numeric) The protocol version of the peer\n \"subver\": \"value\",    (string)  The user agent of the peer\n \"startingheight\": n,  (numeric) The latest block height the peer knew about when the connection was established\n \"banscore\": n,        (numeric) The ban score\n}                      \n",
+		"getrawchangeaddress":          "getrawchangeaddress (\"account\")\n\nGenerates and returns a new internal payment address for use as a change address in raw transactions.\n\nArguments:\n1. account (string, optional) Account name the new internal address will belong to (default=\"default\")\n\nResult:\n\"value\" (string) The internal payment address\n",
+		"getreceivedbyaccount":         "getreceivedbyaccount \"account\" (minconf=1)\n\nReturns the total amount received by addresses of some account, including spent outputs.\n\nArguments:\n1. account (string, required)             Account name to query total received amount for\n2. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an output's value is included in the total\n\nResult:\nn.nnn (numeric) The total received amount valued in decred\n",
+		"getreceivedbyaddress":         "getreceivedbyaddress \"address\" (minconf=1)\n\nReturns the total amount received by a single address, including spent outputs.\n\nArguments:\n1. address (string, required)             Payment address which received outputs to include in total\n2. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an output's value is included in the total\n\nResult:\nn.nnn (numeric) The total received amount valued in decred\n",
+		"getreservedbalance":           "getreservedbalance \"account\" (minconf=1)\n\nReturns the spendable balance of an account along with the value of outputs reserved for orders and the remaining available balance.\n\nArguments:\n1. account (string, required)             The account to query\n2. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an output is considered spendable\n\nResult:\n{\n \"spendable\": n.nnn, (numeric) The spendable balance of the account\n \"reserved\": n.nnn,  (numeric) The total value of outputs reserved from the account\n \"available\": n.nnn, (numeric) The spendable balance not reserved for orders\n}                    \n",
+		"getstakeinfo":                 "getstakeinfo\n\nReturns statistics about staking from the wallet.\n\nArguments:\nNone\n\nResult:\n{\n \"blockheight\": n,          (numeric) Current block height for stake info.\n \"difficulty\": n.nnn,       (numeric) Current stake difficulty.\n \"totalsubsidy\": n.nnn,     (numeric) Total amount of coins earned by proof-of-stake voting\n \"ownmempooltix\": n,        (numeric) Number of tickets submitted by this wallet currently in mempool\n \"immature\": n,             (numeric) Number of tickets from this wallet that are in the blockchain but which are not yet mature\n \"unspent\": n,              (numeric) Number of unspent tickets\n \"voted\": n,                (numeric) Number of votes cast by this wallet\n \"revoked\": n,              (numeric) Number of missed tickets that were missed and then revoked\n \"unspentexpired\": n,       (numeric) Number of unspent tickets which are past expiry\n \"poolsize\": n,             (numeric) Number of live tickets in the ticket pool.\n \"allmempooltix\": n,        (numeric) Number of tickets currently in the mempool\n \"live\": n,                 (numeric) Number of mature, active tickets owned by this wallet\n \"proportionlive\": n.nnn,   (numeric) (Live / PoolSize)\n \"missed\": n,               (numeric) Number of missed tickets (failure to vote, not including expired)\n \"proportionmissed\": n.nnn, (numeric) (Missed / (Missed + Voted))\n \"expired\": n,              (numeric) Number of tickets that have expired\n}                           \n",
+		"getticketpools":               "getticketpools (interval=0)\n\nReturns the unspent, unexpired tickets of the wallet grouped by the VSP managing each ticket, with a histogram of ticket expiry heights.\nTickets not managed by any VSP are reported with an empty host. Live tickets may include missed tickets which are not yet revoked.\n\nArguments:\n1. interval (numeric, optional, default=0) The number of blocks in each interval of the expiry histogram (default is one day of blocks)\n\nResult:\n[{\n \"host\": \"value\",      (string)          The VSP host, or empty for tickets not managed by a VSP\n \"tickets\": n,         (numeric)         The total number of tickets\n \"unmined\": n,         (numeric)         The number of unmined tickets\n \"immature\": n,        (numeric)         The number of immature tickets\n \"live\": n,            (numeric)         The number of mature tickets which are not spent or expired\n \"feepending\": n,      (numeric)         The number of tickets whose VSP fee payment is not confirmed by the VSP\n \"lockedvalue\": n.nnn, (numeric)         The total ticket price of all tickets\n \"expiries\": [{        (array of object) Intervals of block heights in which mined tickets expire, in order of increasing height\n  \"startheight\": n,    (numeric)         The first block height of the interval\n  \"endheight\": n,      (numeric)         The last block height of the interval\n  \"tickets\": n,        (numeric)         The number of tickets expiring in the interval\n  \"value\": n.nnn,      (numeric)         The total ticket price of tickets expiring in the interval\n },...],                                 \n},...]\n",
+		"gettickets":                   "gettickets includeimmature\n\nReturning the hashes of the tickets currently owned by wallet.\n\nArguments:\n1. includeimmature (boolean, required) If true include immature tickets in the results.\n\nResult:\n{\n \"hashes\": [\"value\",...], (array of string) Hashes of the tickets owned by the wallet encoded as strings\n}                         \n",
+		"gettransaction":               "gettransaction \"txid\" (includewatchonly=false)\n\nReturns a JSON object with details regarding a transaction relevant to this wallet.\n\nArguments:\n1. txid             (string, required)                 Hash of the transaction to query\n2. includewatchonly (boolean, optional, default=false) Also consider transactions involving watched addresses\n\nResult:\n{\n \"amount\": n.nnn,                  (numeric)         The total amount this transaction credits to the wallet, valued in decred\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value, or 0 if 'txid' is not a sent transaction\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"txid\": \"value\",                  (string)          The transaction hash\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"details\": [{                     (array of object) Additional details for each recorded wallet credit and debit\n  \"account\": \"value\",              (string)          DEPRECATED -- Unset\n  \"address\": \"value\",              (string)          The address an output was paid to, or the empty string if the output is nonstandard or this detail is regarding a transaction input\n  \"amount\": n.nnn,                 (numeric)         The amount of a received output\n  \"category\": \"value\",             (string)          The kind of detail: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs\n  \"involveswatchonly\": true|false, (boolean)         Unset\n  \"fee\": n.nnn,                    (numeric)         The included fee for a sent transaction\n  \"vout\": n,                       (numeric)         The transaction output index\n },...],                                             \n \"hex\": \"value\",                   (string)          The transaction encoded as a hexadecimal string\n \"type\": \"value\",                  (string)          The type of transaction (regular, ticket, vote, or revocation)\n \"ticketstatus\": \"value\",          (string)          Status of ticket (if transaction is a ticket)\n}                                  \n",
+		"gettxout":                     "gettxout \"txid\" vout tree (includemempool=true)\n\nReturns information about an unspent transaction output.\n\nArguments:\n1. txid           (string, required)                The hash of the transaction\n2. vout           (numeric, required)               The index of the output\n3. tree           (numeric, required)               The tree of the transaction\n4. includemempool (boolean, optional, default=true) Include the mempool when true\n\nResult:\n{\n \"bestblock\": \"value\",        (string)          The block hash that contains the transaction output\n \"confirmations\": n,          (numeric)         The number of confirmations\n \"value\": n.nnn,              (numeric)         The transaction amount in DCR\n \"scriptPubKey\": {            (object)          The public key script used to pay coins as a JSON object\n  \"asm\": \"value\",             (string)          Disassembly of the script\n  \"hex\": \"value\",             (string)          Hex-encoded bytes of the script\n  \"reqSigs\": n,               (numeric)         The number of required signatures\n  \"type\": \"value\",            (string)          The type of the script (e.g. 'pubkeyhash')\n  \"addresses\": [\"value\",...], (array of string) The Decred addresses associated with this script\n  \"commitamt\": n.nnn,         (numeric)         The ticket commitment value if the script is for a staking commitment\n  \"version\": n,               (numeric)         The script version\n },                                             \n \"coinbase\": true|false,      (boolean)         Whether or not the transaction is a coinbase\n}                             \n",
+		"getunconfirmedbalance":        "getunconfirmedbalance (\"account\")\n\nCalculates the unspent output value of all unmined transaction outputs for an account.\n\nArguments:\n1. account (string, optional) The account to query the unconfirmed balance for (default=\"default\")\n\nResult:\nn.nnn (numeric) Total amount of all unmined unspent outputs of the account valued in decred.\n",
+		"getvotechoices":               "getvotechoices (\"tickethash\")\n\nRetrieve the currently configured default vote choices for the latest supported stake agendas\n\nArguments:\n1. tickethash (string, optional) The hash of the ticket to return vote choices for. If the ticket has no choices set, the default vote choices are returned\n\nResult:\n{\n \"version\": n,                  (numeric)         The latest stake version supported by the software and the version of the included agendas\n \"choices\": [{                  (array of object) The currently configured agenda vote choices, including abstaining votes\n  \"agendaid\": \"value\",          (string)          The ID for the agenda the choice concerns\n  \"agendadescription\": \"value\", (string)          A description of the agenda the choice concerns\n  \"choiceid\": \"value\",          (string)          The ID of the current choice for this agenda\n  \"choicedescription\": \"value\", (string)          A description of the current choice for this agenda\n },...],                                          \n}                               \n",
+		"getvotediagnostics":           "getvotediagnostics (count=20)\n\nReturns the timing of the most recent votes created by the wallet, most recent first, to diagnose votes broadcast late due to a slow network backend or vote cosigner.\nDurations are measured from when the wallet was notified of the winning tickets of the voted block.\nDiagnostics are kept in memory for up to 512 votes since the wallet was opened.\n\nArguments:\n1. count (numeric, optional, default=20) Maximum number of votes to return\n\nResult:\n[{\n \"ticket\": \"value\",      (string)          The hash of the voting ticket\n \"vote\": \"value\",        (string)          The hash of the vote transaction\n \"blockhash\": \"value\",   (string)          The hash of the voted block\n \"blockheight\": n,       (numeric)         The height of the voted block\n \"notified\": n,          (numeric)         The unix time the winning tickets notification was received\n \"signedms\": n.nnn,      (numeric)         Milliseconds from the notification until the vote was signed, including vote cosigners\n \"broadcastms\": n.nnn,   (numeric)         Milliseconds from the notification until the network backend accepted or rejected the vote\n \"backend\": \"value\",     (string)          The kind of network backend the vote was published with\n \"peers\": [\"value\",...], (array of string) The addresses of the network backend's peers or servers\n \"size\": n,              (numeric)         The serialized size of the vote in bytes\n \"fee\": n.nnn,           (numeric)         The fee paid by the vote in DCR\n \"error\": \"value\",       (string)          The error publishing the votes of the block, if any\n},...]\n",
+		"getvoteversioninfo":           "getvoteversioninfo\n\nReturns the agendas of the wallet's vote version with the saved default vote choices, the vote versions of the wallet and network, and warnings about saved choices made obsolete by a vote version upgrade\n\nArguments:\nNone\n\nResult:\n{\n \"voteversion\": n,          (numeric)         The vote version of votes created by the wallet and the version of the included agendas\n \"networkstakeversion\": n,  (numeric)         The stake version of the main chain tip block, or 0 if unknown\n \"agendas\": [{              (array of object) The agendas of the wallet's vote version\n  \"agendaid\": \"value\",      (string)          The ID of the agenda\n  \"description\": \"value\",   (string)          The description of the agenda\n  \"choices\": [\"value\",...], (array of string) The IDs of the choices defined by the agenda\n  \"starttime\": n,           (numeric)         The unix time at which voting on the agenda begins\n  \"expiretime\": n,          (numeric)         The unix time at which the agenda expires\n  \"expired\": true|false,    (boolean)         Whether the agenda has expired\n  \"choiceid\": \"value\",      (string)          The default vote choice, or abstain if no choice is saved\n  \"saved\": true|false,      (boolean)         Whether the default vote choice was saved by the wallet\n },...],                                      \n \"obsoletechoices\": [{      (array of object) Saved vote choices which are not applied to the wallet's vote version\n  \"version\": n,             (numeric)         The vote version the choice was saved for\n  \"agendaid\": \"value\",      (string)          The ID of the agenda\n  \"choiceid\": \"value\",      (string)          The saved choice ID\n },...],                                      \n \"warnings\": [\"value\",...], (array of string) Conditions requiring attention, such as saved choices which are no longer applied\n}                           \n",
+		"getwalletfee":                 "getwalletfee\n\nGet currently set transaction fee for the wallet\n\nArguments:\nNone\n\nResult:\nn.nnn (numeric) Current tx fee (in DCR)\n",
+		"getcfilterv2":                 "getcfilterv2 \"blockhash\"\n\nReturns the version 2 block filter for the given block along with the key required to query it for matches against committed scripts.\n\nArguments:\n1. blockhash (string, required) The block hash of the filter to retrieve\n\nResult:\n{\n \"blockhash\": \"value\", (string) The block hash for which the filter includes data\n \"filter\": \"value\",    (string) Hex-encoded bytes of the serialized filter\n \"key\": \"value\",       (string) The key required to query the filter for matches against committed scripts\n}                      \n",
+		"getidlelock":                  "getidlelock\n\nReturns the idle lock timeout and the remaining time before the wallet is locked without signing activity.\nSigning transactions, messages, or hashes, and decrypting messages, restarts the timeout.\n\nArguments:\nNone\n\nResult:\n{\n \"timeout\": n,         (numeric) The idle lock timeout in seconds, or 0 when the idle lock is disabled\n \"active\": true|false, (boolean) Whether the wallet is unlocked and will be locked after the timeout\n \"remaining\": n,       (numeric) Seconds remaining before the wallet is locked due to inactivity, or 0 when not active\n}                      \n",
+		"getutxostats":                 "getutxostats (\"account\" dustthreshold)\n\nReturns statistics of the unspent outputs of each account, ordered by account number, to guide consolidation and mixing.\nCoin ages are measured in blocks as the number of confirmations of each output, and unmined outputs have an age of zero.\nTicket submission outputs and outputs spent by unmined transactions are not included.\n\nArguments:\n1. account       (string, optional)  If set, only returns statistics of this account\n2. dustthreshold (numeric, optional) Optional value in DCR below which outputs are counted as dust (default is the dust limit at the wallet's relay fee)\n\nResult:\n[{\n \"account\": \"value\", (string)  The account name\n \"count\": n,         (numeric) The number of unspent outputs\n \"total\": n.nnn,     (numeric) The total value of the unspent outputs\n \"medianage\": n.nnn, (numeric) The median coin age in blocks\n \"meanage\": n.nnn,   (numeric) The mean coin age in blocks\n \"dustcount\": n,     (numeric) The number of dust outputs\n \"dusttotal\": n.nnn, (numeric) The total value of dust outputs\n \"largest\": n.nnn,   (numeric) The value of the largest unspent output\n \"smallest\": n.nnn,  (numeric) The value of the smallest unspent output\n},...]\n",
+		"getwalletstats":               "getwalletstats startheight endheight (interval=0)\n\nReturns statistics of wallet transactions mined in a range of main chain blocks, divided into intervals.\nThe range is limited by the main chain tip.\n\nArguments:\n1. startheight (numeric, required)            The first block height of the range\n2. endheight   (numeric, required)            The last block height of the range\n3. interval    (numeric, optional, default=0) The number of blocks in each interval (default is the entire range)\n\nResult:\n[{\n \"startheight\": n,     (numeric) The first block height of the interval\n \"endheight\": n,       (numeric) The last block height of the interval\n \"transactions\": n,    (numeric) The number of wallet transactions mined in the interval\n \"totalin\": n.nnn,     (numeric) The total value of outputs paying to the wallet\n \"totalout\": n.nnn,    (numeric) The total value of spent wallet outputs\n \"fees\": n.nnn,        (numeric) The total fees of transactions spending only wallet outputs\n \"ticketpurchases\": n, (numeric) The number of ticket purchases\n \"votes\": n,           (numeric) The number of votes\n \"revocations\": n,     (numeric) The number of revocations\n},...]\n",
+		"holdamount":                   "holdamount \"id\" \"account\" amount \"reason\" (minconf=1)\n\nSelects unspent outputs of an account totaling at least an amount and places a hold on them.\nHeld outputs are not spent by authored transactions, are not unlocked by lockunspent, and remain held across wallet restarts until released with releasehold.\n\nArguments:\n1. id      (string, required)             A unique identifier for the hold\n2. account (string, required)             The account to hold outputs of\n3. amount  (numeric, required)            The minimum total value of outputs to hold\n4. reason  (string, required)             The reason for the hold\n5. minconf (numeric, optional, default=1) Minimum number of block confirmations required for held outputs\n\nResult:\n{\n \"id\": \"value\",              (string)          The identifier of the hold\n \"account\": \"value\",         (string)          The account of the held outputs\n \"amount\": n.nnn,            (numeric)         The amount requested by holdamount, or zero for holds placed by holdoutputs\n \"held\": n.nnn,              (numeric)         The total value of the held outputs which remain unspent\n \"reason\": \"value\",          (string)          The reason for the hold\n \"created\": n,               (numeric)         The Unix time the hold was placed\n \"outpoints\": [\"value\",...], (array of string) The held outpoints\n}                            \n",
+		"holdoutputs":                  "holdoutputs \"id\" \"reason\" [\"outpoint\",...]\n\nPlaces a hold on unspent outputs of a single account, such as to comply with a legal hold.\nHeld outputs are not spent by authored transactions, are not unlocked by lockunspent, and remain held across wallet restarts until released with releasehold.\nOutputs which are already locked, such as the inputs of pending spends or funds reservations, may not be held.\n\nArguments:\n1. id        (string, required)          A unique identifier for the hold\n2. reason    (string, required)          The reason for the hold\n3. outpoints (array of string, required) The outpoints to hold, formatted as txid:index\n\nResult:\n{\n \"id\": \"value\",              (string)          The identifier of the hold\n \"account\": \"value\",         (string)          The account of the held outputs\n \"amount\": n.nnn,            (numeric)         The amount requested by holdamount, or zero for holds placed by holdoutputs\n \"held\": n.nnn,              (numeric)         The total value of the held outputs which remain unspent\n \"reason\": \"value\",          (string)          The reason for the hold\n \"created\": n,               (numeric)         The Unix time the hold was placed\n \"outpoints\": [\"value\",...], (array of string) The held outpoints\n}                            \n",
+		"help":                         "help (\"command\")\n\nReturns a list of all commands or help for a specified command.\n\nArguments:\n1. command (string, optional) The command to retrieve help for\n\nResult (no command provided):\n\"value\" (string) List of commands\n\nResult (command specified):\n\"value\" (string) Help for specified command\n",
+		"importcfiltersv2":             "importcfiltersv2 startheight [\"filter\",...]\n\nImports a list of v2 cfilters into the wallet. Does not perform validation on the filters\n\nArguments:\n1. startheight (numeric, required)         The starting block height for this list of cfilters\n2. filters     (array of string, required) The list of hex-encoded cfilters\n\nResult:\nNothing\n",
+		"importcheckpoint":             "importcheckpoint \"checkpoint\"\n\nRestores the account address cursors recorded by a wallet checkpoint written with the --checkpoint option, typically after restoring the wallet from seed.\nThe next address discovery only searches for address usage in blocks after the checkpoint block.\nThe checkpoint must be authenticated by a key derived from this wallet's seed, and the wallet must be unlocked to verify it.\n\nArguments:\n1. checkpoint (string, required) The contents of the checkpoint file\n\nResult:\n{\n \"blockhash\": \"value\", (string)  The hash of the last processed block recorded by the checkpoint\n \"blockheight\": n,     (numeric) The height of the last processed block recorded by the checkpoint\n \"created\": n,         (numeric) The Unix time the checkpoint was written\n \"accounts\": n,        (numeric) The number of accounts restored by the checkpoint\n}                      \n",
+		"importexternalsigneraccount":  "importexternalsigneraccount \"name\" \"xpub\" \"fingerprint\" \"path\" \"model\"\n\nImport the account extended public key of a hardware signing device, such as a Ledger or Trezor, as a new watching-only account.\nThe device and derivation path are reported by listexternalsigners so clients know which device must sign for the account.\n\nArguments:\n1. name        (string, required) Name of new account\n2. xpub        (string, required) Account extended public key exported by the device\n3. fingerprint (string, required) Hex-encoded 4-byte fingerprint of the device's master key\n4. path        (string, required) Derivation path of the account key from the master key (e.g. m/44'/42'/0')\n5. model       (string, required) Device model (e.g. ledger or trezor)\n\nResult:\nn.nnn (numeric) The number of the imported account\n",
+		"importlegacystakepooltickets": "importlegacystakepooltickets \"host\" \"script\" \"poolfeeaddress\" ([\"ticket\",...])\n\nImports the voting script of a legacy stake pool and records tickets purchased through the pool as managed by it.\nTickets must vote with the pool script and pay the pool fee address in their first commitment.\nTickets not yet recorded by the wallet are discovered by rescanning after the script is imported.\n\nArguments:\n1. host           (string, required)          The URL of the stake pool, recorded as the VSP host of each ticket\n2. script         (string, required)          The hex-encoded 1-of-2 multisig redeem script of the pool ticket address\n3. poolfeeaddress (string, required)          The pool fee address committed to by pool tickets\n4. tickets        (array of string, optional) Hashes of the tickets to import (default is all unspent wallet tickets purchased through the pool)\n\nResult:\n[\"value\",...] (array of string) The hashes of all imported tickets\n",
+		"importprivkey":                "importprivkey \"privkey\" (\"label\" rescan=true scanfrom)\n\nImports a WIF-encoded private key to the 'imported' account.\n\nArguments:\n1. privkey  (string, required)                The WIF-encoded private key\n2. label    (string, optional)                Unused (must be unset or 'imported')\n3. rescan   (boolean, optional, default=true) Rescan the blockchain (since the genesis block, or scanfrom block) for outputs controlled by the imported key\n4. scanfrom (numeric, optional)               Block number for where to start rescan from.  The rescan is recorded by the wallet and resumed by the next sync if it does not complete\n\nResult:\nNothing\n",
+		"importpubkey":                 "importpubkey \"pubkey\" (\"label\" rescan=true scanfrom)\n\nImports a compressed (33-byte) secp256k1 public key and the derived P2PKH address as a watched address of an account (the imported account by default).\n\nArguments:\n1. pubkey   (string, required)                The hex-encoded 33-byte compressed public key\n2. label    (string, optional)                Name of an existing account to assign the address to (default: 'imported')\n3. rescan   (boolean, optional, default=true) Rescan the blockchain (since the genesis block, or scanfrom block) for outputs controlled by the imported key\n4. scanfrom (numeric, optional)               Block number for where to start rescan from.  The rescan is recorded by the wallet and resumed by the next sync if it does not complete\n\nResult:\nNothing\n",
+		"importscript":                 "importscript \"hex\" (rescan=true scanfrom \"account\")\n\nImport a redeem script as a watched P2SH address of an account (the imported account by default). Outputs paying the script are never spent by the wallet.\n\nArguments:\n1. hex      (string, required)                Hex encoded script to import\n2. rescan   (boolean, optional, default=true) Rescans the blockchain (since the genesis block, or scanfrom block) for outputs controlled by the imported key\n3. scanfrom (numeric, optional)               Block number for where to start rescan from.  The rescan is recorded by the wallet and resumed by the next sync if it does not complete\n4. account  (string, optional)                Name of an existing account to assign the P2SH address to (default: 'imported')\n\nResult:\nNothing\n",
+		"importtreasurykey":            "importtreasurykey \"privkey\" \"name\"\n\nImports the private key of a treasury spend signing key of the network for use by signtspend.\nThe key is encrypted by the wallet passphrase, is not associated with any account, and is never exported.\nRequires an unlocked wallet started with the --treasurykeys option.\n\nArguments:\n1. privkey (string, required) The WIF or hex encoded private key\n2. name    (string, required) A name describing the key\n\nResult:\n\"value\" (string) The hex encoded compressed public key of the imported key\n",
+		"importslip0044account":        "importslip0044account account \"passphrase\" (\"name\")\n\nImports an account derived with the SLIP0044 coin type into a wallet using the legacy coin type, such as a wallet restored from a seed used with both coin types.\nThe account is encrypted by its own passphrase and must be unlocked with unlockaccount before spending its funds. Rescan the wallet to discover usage of the account.\n\nArguments:\n1. account    (numeric, required) The SLIP0044 account number\n2. passphrase (string, required)  The passphrase encrypting the imported account\n3. name       (string, optional)  Name of the new account (default: 'slip0044-account-N')\n\nResult:\nn.nnn (numeric) The account number of the imported account\n",
+		"importvotepolicies":           "importvotepolicies \"policies\" \"address\"\n\nApplies vote policies exported by exportvotepolicies after verifying their signature.\nDefault agenda choices are set to the exported choices, and treasury spend and treasury key policies are replaced by the exported policies, abstaining from treasury spends and keys without an exported policy.\nNo policies are applied if any exported agenda or choice is not supported by the wallet.\nEach export may only be applied once, so previously imported policies are rejected.\n\nArguments:\n1. policies (string, required) The signed policies\n2. address  (string, required) The address expected to have signed the policies\n\nResult:\nNothing\n",
+		"importvotingaccount":          "importvotingaccount \"xpriv\" \"passphrase\" \"name\"\n\nImports an account extended private key, such as one exported by exportvotingaccount, as a voting account.\nThe key is encrypted by a separate passphrase, which unlocks the account with unlockaccount.\n\nArguments:\n1. xpriv      (string, required) The account extended private key\n2. passphrase (string, required) The passphrase encrypting the account key\n3. name       (string, required) The name of the new account\n\nResult:\nn.nnn (numeric) The number of the imported account\n",
+		"importxpub":                   "importxpub \"name\" \"xpub\"\n\nImport a HD extended public key as a new account.\n\nArguments:\n1. name (string, required) Name of new account\n2. xpub (string, required) Extended public key\n\nResult:\nNothing\n",
+		"listaccounts":                 "listaccounts (minconf=1)\n\nDEPRECATED -- Returns a JSON object of all accounts and their balances.\n\nArguments:\n1. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an unspent output's value is included in the balance\n\nResult:\n{\n \"The account name\": The account balance valued in decred, (object) JSON object with account names as keys and decred amounts as values\n ...\n}\n",
+		"listaddresstransactions":      "listaddresstransactions [\"address\",...] (\"account\")\n\nReturns a JSON array of objects containing verbose details for wallet transactions pertaining some addresses.\n\nArguments:\n1. addresses (array of string, required) Addresses to filter transaction results by\n2. account   (string, optional)          Unused (must be unset or \"*\")\n\nResult:\n[{\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in decred\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n},...]\n",
+		"listalltransactions":          "listalltransactions (\"account\")\n\nReturns a JSON array of objects in the same format as 'listtransactions' without limiting the number of returned objects.\n\nArguments:\n1. account (string, optional) Unused (must be unset or \"*\")\n\nResult:\n[{\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in decred\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n},...]\n",
+		"listexpiredaddresses":         "listexpiredaddresses\n\nReturns addresses issued by getnewaddress with an expiry time or height which expired before receiving a payment, ordered by the time they were issued.\nAddresses which received a payment after expiring are included with the time and main chain tip height the payment was first observed, allowing late payments to be monitored.\n\nArguments:\nNone\n\nResult:\n[{\n \"address\": \"value\", (string)  The expired address\n \"account\": \"value\", (string)  The account of the address\n \"issued\": n,        (numeric) The Unix time the address was issued\n \"expirytime\": n,    (numeric) The Unix time the address expires (omitted when expiring only by height)\n \"expiryheight\": n,  (numeric) The block height the address expires (omitted when expiring only by time)\n \"paidtime\": n,      (numeric) The Unix time a late payment was first observed (omitted if unpaid)\n \"paidheight\": n,    (numeric) The main chain tip height when the late payment was first observed (omitted if unpaid)\n},...]\n",
+		"listexternalsigners":          "listexternalsigners\n\nLists the accounts whose keys are held by hardware signing devices.\n\nArguments:\nNone\n\nResult:\n[{\n \"account\": n,           (numeric) The account number\n \"accountname\": \"value\", (string)  The account name\n \"fingerprint\": \"value\", (string)  Hex-encoded fingerprint of the device's master key\n \"path\": \"value\",        (string)  Derivation path of the account key from the master key\n \"model\": \"value\",       (string)  Device model\n},...]\n",
+		"listfundsreservations":        "listfundsreservations\n\nReturns all current funds reservations.\n\nArguments:\nNone\n\nResult:\n[{\n \"id\": \"value\",              (string)          The identifier of the reservation\n \"account\": \"value\",         (string)          The account of the reserved outputs\n \"outpoints\": [\"value\",...], (array of string) The reserved outpoints\n \"amount\": n.nnn,            (numeric)         The total value of the reserved outputs\n},...]\n",
+		"listholds":                    "listholds\n\nReturns all holds placed by holdoutputs and holdamount.\n\nArguments:\nNone\n\nResult:\n[{\n \"id\": \"value\",              (string)          The identifier of the hold\n \"account\": \"value\",         (string)          The account of the held outputs\n \"amount\": n.nnn,            (numeric)         The amount requested by holdamount, or zero for holds placed by holdoutputs\n \"held\": n.nnn,              (numeric)         The total value of the held outputs which remain unspent\n \"reason\": \"value\",          (string)          The reason for the hold\n \"created\": n,               (numeric)         The Unix time the hold was placed\n \"outpoints\": [\"value\",...], (array of string) The held outpoints\n},...]\n",
+		"listinvoices":                 "listinvoices (\"account\" \"status\")\n\nReturns invoices created by createinvoice ordered by identifier.\n\nArguments:\n1. account (string, optional) If set, only returns invoices paid to this account\n2. status  (string, optional) If set, only returns invoices with this status (pending, partial, paid, overpaid, or expired)\n\nResult:\n[{\n \"id\": \"value\",      (string)  The identifier of the invoice\n \"address\": \"value\", (string)  The address to be paid\n \"account\": \"value\", (string)  The account of the address\n \"amount\": n.nnn,    (numeric) The invoiced amount in DCR\n \"received\": n.nnn,  (numeric) The total amount in DCR of mined outputs received by the address\n \"status\": \"value\",  (string)  The invoice status (pending, partial, paid, overpaid, or expired)\n \"created\": n,       (numeric) The Unix time the invoice was created\n \"expiry\": n,        (numeric) The Unix time the invoice expires (omitted if it does not expire)\n \"updated\": n,       (numeric) The Unix time the received amount or status last changed (omitted if unchanged)\n \"changedheight\": n, (numeric) The main chain tip height when the received amount last changed (omitted if nothing was received)\n},...]\n",
+		"listlockunspent":              "listlockunspent (\"account\")\n\nReturns a JSON array of outpoints marked as locked (with lockunspent) for this wallet session.\n\nArguments:\n1. account (string, optional) If set, only returns outpoints from this account that are marked as locked\n\nResult:\n[{\n \"amount\": n.nnn, (numeric) The previous output amount\n \"txid\": \"value\", (string)  The transaction hash of the referenced output\n \"vout\": n,       (numeric) The output index of the referenced output\n \"tree\": n,       (numeric) The tree to generate transaction for\n},...]\n",
+		"listpendingspends":            "listpendingspends\n\nLists the payments created by the send methods which await approval when the wallet is run with --pendingspends.\nThe inputs of pending spends remain locked until the spend is approved or rejected.\n\nArguments:\nNone\n\nResult:\n[{\n \"txhash\": \"value\",  (string)  The hash of the transaction, which is unchanged by signing\n \"account\": \"value\", (string)  The account paying for the spend\n \"proposed\": n,      (numeric) The Unix time at which the spend was created\n \"amount\": n.nnn,    (numeric) The total value of outputs not paying to wallet addresses\n \"fee\": n.nnn,       (numeric) The transaction fee\n \"hex\": \"value\",     (string)  The hex-encoded unsigned transaction\n},...]\n",
+		"listreceivedbyaccount":        "listreceivedbyaccount (minconf=1 includeempty=false includewatchonly=false)\n\nReturns a JSON array of objects listing all accounts and the total amount received by each account.\n\nArguments:\n1. minconf          (numeric, optional, default=1)     Minimum number of block confirmations required before a transaction is considered\n2. includeempty     (boolean, optional, default=false) Unused\n3. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"account\": \"value\", (string)  The name of the account\n \"amount\": n.nnn,    (numeric) Total amount received by payment addresses of the account valued in decred\n \"confirmations\": n, (numeric) Number of block confirmations of the most recent transaction relevant to the account\n},...]\n",
+		"listreceivedbyaddress":        "listreceivedbyaddress (minconf=1 includeempty=false includewatchonly=false)\n\nReturns a JSON array of objects listing wallet payment addresses and their total received amounts.\n\nArguments:\n1. minconf          (numeric, optional, default=1)     Minimum number of block confirmations required before a transaction is considered\n2. includeempty     (boolean, optional, default=false) Unused\n3. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"account\": \"value\",              (string)          DEPRECATED -- Unset\n \"address\": \"value\",              (string)          The payment address\n \"amount\": n.nnn,                 (numeric)         Total amount received by the payment address valued in decred\n \"confirmations\": n,              (numeric)         Number of block confirmations of the most recent transaction relevant to the address\n \"txids\": [\"value\",...],          (array of string) Transaction hashes of all transactions involving this address\n \"involvesWatchonly\": true|false, (boolean)         Unset\n},...]\n",
+		"listsinceblock":               "listsinceblock (\"blockhash\" targetconfirmations=1 includewatchonly=false)\n\nReturns a JSON array of objects listing details of all wallet transactions after some block.\n\nArguments:\n1. blockhash           (string, optional)                 Hash of the parent block of the first block to consider transactions from, or unset to list all transactions\n2. targetconfirmations (numeric, optional, default=1)     Minimum number of block confirmations of the last block in the result object.  Must be 1 or greater.  Note: The transactions array in the result object is not affected by this parameter\n3. includewatchonly    (boolean, optional, default=false) Unused\n\nResult:\n{\n \"transactions\": [{                 (array of object) JSON array of objects containing verbose details of the each transaction\n  \"account\": \"value\",               (string)          DEPRECATED -- Unset\n  \"address\": \"value\",               (string)          Payment address for a transaction output\n  \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in decred\n  \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n  \"blockindex\": n,                  (numeric)         Unset\n  \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n  \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n  \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n  \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n  \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n  \"involveswatchonly\": true|false,  (boolean)         Unset\n  \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n  \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n  \"txid\": \"value\",                  (string)          The hash of the transaction\n  \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n  \"vout\": n,                        (numeric)         The transaction output index\n  \"walletconflicts\": [\"value\",...], (array of string) Unset\n  \"comment\": \"value\",               (string)          Unset\n  \"otheraccount\": \"value\",          (string)          Unset\n },...],                                              \n \"lastblock\": \"value\",              (string)          Hash of the latest-synced block to be used in later calls to listsinceblock\n}                                   \n",
+		"listtransactions":             "listtransactions (\"account\" count=10 from=0 includewatchonly=false)\n\nReturns a JSON array of objects containing verbose details for wallet transactions.\n\nArguments:\n1. account          (string, optional)                 DEPRECATED -- Unused (must be unset or \"*\")\n2. count            (numeric, optional, default=10)    Maximum number of transactions to create results from\n3. from             (numeric, optional, default=0)     Number of transactions to skip before results are created\n4. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in decred\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n},...]\n",
+		"listtreasurykeys":             "listtreasurykeys\n\nReturns the imported treasury spend signing keys.\n\nArguments:\nNone\n\nResult:\n[{\n \"pubkey\": \"value\", (string)  The hex encoded compressed public key\n \"name\": \"value\",   (string)  The name of the key\n \"created\": n,      (numeric) The Unix time the key was imported\n},...]\n",
+		"listunspent":                  "listunspent (minconf=1 maxconf=9999999 [\"address\",...] \"account\")\n\nReturns a JSON array of objects representing unlocked unspent outputs controlled by wallet keys.\n\nArguments:\n1. minconf   (numeric, optional, default=1)       Minimum number of block confirmations required before a transaction output is considered\n2. maxconf   (numeric, optional, default=9999999) Maximum number of block confirmations required before a transaction output is excluded\n3. addresses (array of string, optional)          If set, limits the returned details to unspent outputs received by any of these payment addresses\n4. account   (string, optional)                   If set, only return unspent outputs from this account\n\nResult:\n{\n \"txid\": \"value\",         (string)  The transaction hash of the referenced output\n \"vout\": n,               (numeric) The output index of the referenced output\n \"tree\": n,               (numeric) The tree the transaction comes from\n \"txtype\": n,             (numeric) The type of the transaction\n \"address\": \"value\",      (string)  The payment address that received the output\n \"account\": \"value\",      (string)  The account associated with the receiving payment address\n \"scriptPubKey\": \"value\", (string)  The output script encoded as a hexadecimal string\n \"redeemScript\": \"value\", (string)  The redeemScript if scriptPubKey is P2SH\n \"amount\": n.nnn,         (numeric) The amount of the output valued in decred\n \"confirmations\": n,      (numeric) The number of block confirmations of the transaction\n \"spendable\": true|false, (boolean) Whether the output is entirely controlled by wallet keys/scripts (false for partially controlled multisig outputs or outputs to watch-only addresses)\n}                         \n",
+		"listwebhooks":                 "listwebhooks\n\nReturns all registered webhooks.\n\nArguments:\nNone\n\nResult:\n[{\n \"id\": \"value\",      (string)  The identifier of the webhook\n \"url\": \"value\",     (string)  The URL events are POSTed to\n \"secret\": \"value\",  (string)  The hex encoded HMAC-SHA256 key used to sign events (only returned by addwebhook)\n \"account\": \"value\", (string)  The account filter, if any\n \"address\": \"value\", (string)  The address filter, if any\n \"minamount\": n.nnn, (numeric) The minimum output value filter, if any\n},...]\n",
+		"lockaccount":                  "lockaccount \"account\"\n\nLock an individually-encrypted account\n\nArguments:\n1. account (string, required) Account to lock\n\nResult:\nNothing\n",
+		"lockunspent":                  "lockunspent unlock [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...]\n\nLocks or unlocks an unspent output.\nLocked outputs are not chosen for transaction inputs of authored transactions and are not included in 'listunspent' results.\nLocked outputs are volatile and are not saved across wallet restarts.\nIf unlock is true and no transaction outputs are specified, all locked outputs are marked unlocked.\nOutputs held by holdoutputs or holdamount are only unlocked by releasehold.\n\nArguments:\n1. unlock       (boolean, required)         True to unlock outputs, false to lock\n2. transactions (array of object, required) Transaction outputs to lock or unlock\n[{\n \"amount\": n.nnn, (numeric) The previous output amount\n \"txid\": \"value\", (string)  The transaction hash of the referenced output\n \"vout\": n,       (numeric) The output index of the referenced output\n \"tree\": n,       (numeric) The tree to generate transaction for\n},...]\n\nResult:\ntrue|false (boolean) The boolean 'true'\n",
+		"lookupaccountblindedid":       "lookupaccountblindedid \"blindedid\"\n\nReturns the current name, number, and rename history of the account identified by a blinded ID.\nErrors if the wallet does not own an account with the blinded ID.\n\nArguments:\n1. blindedid (string, required) The hex-encoded blinded ID\n\nResult:\n{\n \"accountname\": \"value\", (string)          The current account name\n \"accountnumber\": n,     (numeric)         The account number\n \"uuid\": \"value\",        (string)          The immutable account UUID\n \"renames\": [{           (array of object) Each rename of the account, oldest first\n  \"time\": n,             (numeric)         The unix time of the rename\n  \"oldname\": \"value\",    (string)          The account name before the rename\n  \"newname\": \"value\",    (string)          The account name after the rename\n },...],                                   \n}                        \n",
+		"matchcfilters":                "matchcfilters startheight endheight\n\nReturns the main chain blocks in a height range whose version 2 compact filters match any wallet script, without fetching or processing the blocks.\nScripts of all imported addresses and of HD account addresses through the gap limit beyond the last returned address are matched.\nCompact filters may report false positives, but never omit a block paying to or spending from a matched script, so the result lists every block that must be inspected to audit the wallet's transactions.\n\nArguments:\n1. startheight (numeric, required) The height of the first block to match\n2. endheight   (numeric, required) The height of the last block to match, which may not be above the main chain tip\n\nResult:\n[{\n \"hash\": \"value\", (string)  The hash of the matching block\n \"height\": n,     (numeric) The height of the matching block\n},...]\n",
+		"mixaccount":                   "mixaccount\n\nMix all outputs of an account.\n\nArguments:\nNone\n\nResult:\nNothing\n",
+		"mixoutput":                    "mixoutput \"outpoint\"\n\nMix a specific output.\n\nArguments:\n1. outpoint (string, required) Outpoint (in form \"txhash:index\") to mix\n\nResult:\nNothing\n",
+		"parsepaymenturi":              "parsepaymenturi \"uri\"\n\nParses a decred: payment request URI.\nWhen the URI pays an address issued by createpaymenturi, the recorded request and the amount received by the address are also returned.\n\nArguments:\n1. uri (string, required) The payment request URI\n\nResult:\n{\n \"address\": \"value\",     (string)  The address to pay\n \"amount\": n.nnn,        (numeric) The requested amount in DCR (omitted if no amount was requested)\n \"label\": \"value\",       (string)  The label of the payment recipient (omitted if empty)\n \"message\": \"value\",     (string)  The message describing the payment (omitted if empty)\n \"expiry\": n,            (numeric) The Unix time the request expires (omitted if the request does not expire)\n \"request\": {            (object)  The request recorded by this wallet (omitted if the address was not issued by createpaymenturi)\n  \"account\": \"value\",    (string)  The account of the address\n  \"issued\": n,           (numeric) The Unix time the request was issued\n  \"received\": n.nnn,     (numeric) The total amount in DCR of mined outputs received by the address\n  \"paid\": true|false,    (boolean) Whether the address has received at least the requested amount, or any amount when no amount was requested\n  \"expired\": true|false, (boolean) Whether the request has expired\n },                                \n}                        \n",
+		"pregenerateaddresses":         "pregenerateaddresses \"account\" count (branch=0)\n\nReturns the next addresses of an account branch, ignoring the unused address gap limit.\nThe addresses are recorded as returned and watched for payments, allowing watching-only wallets to hand out addresses far ahead of observed usage.\nThe gap limit of watching-only wallets may also be raised with the --watchingonlygaplimit option.\n\nArguments:\n1. account (string, required)             The account of the addresses\n2. count   (numeric, required)            The number of addresses to generate (at most 10000)\n3. branch  (numeric, optional, default=0) The account branch (0 for external, 1 for internal)\n\nResult:\n[\"value\",...] (array of string) The generated addresses, in order of child index\n",
+		"previewmixaccount":            "previewmixaccount\n\nReports how each output of the mixing change account eligible for mixing would be split by mixaccount, at the current ticket price and relay fee, without mixing or deriving addresses.\nA single mixaccount call mixes a limited number of randomly selected outputs, so previewed outputs may require several mixes.\n\nArguments:\nNone\n\nResult:\n[{\n \"outpoint\": \"value\",   (string)  The outpoint (in form \"txhash:index\") to be mixed\n \"amount\": n.nnn,       (numeric) The output amount\n \"denomination\": n.nnn, (numeric) The denomination of the mixed outputs, or zero if the output can not be mixed\n \"count\": n,            (numeric) The number of mixed outputs\n \"change\": n.nnn,       (numeric) The unmixed change amount\n \"fee\": n.nnn,          (numeric) The estimated fee paid by the output\n},...]\n",
+		"processunmanagedticket":       "processunmanagedticket \"tickethash\"\n\nProcesses tickets for vsp client based on ticket hash.\n\nArguments:\n1. tickethash (string, required) The ticket hash of ticket to be processed by the vsp client.\n\nResult:\nNothing\n",
+		"provevspownership":            "provevspownership \"tickethash\" \"request\" (apiversion=3)\n\nSigns a VSP API request of a wallet ticket, proving ownership of the ticket to the VSP in the format required by the API version.\nVersion 3 requests are JSON objects identifying the ticket by their tickethash field, and are signed by the key of the ticket commitment address.\nRequires the wallet to be unlocked.\n\nArguments:\n1. tickethash (string, required)             The hash of the ticket\n2. request    (string, required)             The exact request body sent to the VSP\n3. apiversion (numeric, optional, default=3) The VSP API version\n\nResult:\n{\n \"address\": \"value\",   (string) The address whose key signed the request\n \"header\": \"value\",    (string) The HTTP header sending the signature to the VSP\n \"signature\": \"value\", (string) The base64-encoded signature\n}                      \n",
+		"prunesidechains":              "prunesidechains (depth=256)\n\nRemoves the saved block headers and cfilters of blocks which were reorganized out of the main chain, and cfilters of blocks without saved headers.\nRecords of main chain blocks are never removed, and pruned records are saved again if their blocks return to the main chain.\n\nArguments:\n1. depth (numeric, optional, default=256) Only prune records of blocks at least this many blocks below the main chain tip\n\nResult:\n{\n \"prunedheaders\": n,  (numeric) The number of removed headers\n \"prunedcfilters\": n, (numeric) The number of removed cfilters\n \"headers\": n,        (numeric) The number of retained headers of blocks not in the main chain\n \"cfilters\": n,       (numeric) The number of retained cfilters of blocks not in the main chain\n}                     \n",
+		"purchaseticket":               "purchaseticket \"fromaccount\" spendlimit (minconf=1 numtickets=1 expiry \"comment\" dontsigntx \"votingscript\" \"idempotencykey\" mixsplit)\n\nPurchase ticket using available funds.\n\nArguments:\n1. fromaccount  (string, required)             The account to use for purchase (default=\"default\")\n2. spendlimit   (numeric, required)            Limit on the amount to spend on ticket\n3. minconf      (numeric, optional, default=1) Minimum number of block confirmations required\n4. numtickets   (numeric, optional, default=1) The number of tickets to purchase\n5. expiry       (numeric, optional)            Height at which the purchase tickets expire\n6. comment      (string, optional)             Unused\n7. dontsigntx   (boolean, optional)            Return unsigned split and ticket transactions instead of signing and publishing\n8. votingscript (string, optional)             Hex encoded multisig redeem script whose P2SH address receives the voting rights of the tickets (default: addresses of the purchasing or mixed account).\nThe script is imported, and the wallet must hold the private keys of the required number of signatures, or some keys with vote cosigners configured.\nMay not be used when a VSP is configured.\n9.  idempotencykey (string, optional)  Optional key identifying the request; retrying with the same key returns the original ticket hashes instead of purchasing again (unsupported with dontsigntx)\n10. mixsplit       (boolean, optional) Fund the tickets with a split transaction mixed through CoinShuffle++ (default: true when --mixing is enabled and dontsigntx is unset; unsupported with dontsigntx)\n\nResult:\n\"value\" (string) Hash of the resulting ticket\n",
+		"recovermixoutputs":            "recovermixoutputs ([\"account\",...] scanlen=1000 startheight=0)\n\nSearches both branches of mixing accounts for outputs stranded by interrupted mixes, such as outputs paying to addresses beyond those recorded by the wallet.\nAddresses beyond the last used or returned address of each branch are watched, the block chain is rescanned, and the address cursors are moved beyond any used addresses found.\nRecovered outputs become spendable wallet funds.\n\nArguments:\n1. accounts    (array of string, optional)       The accounts to search (default: the configured mixed and change accounts)\n2. scanlen     (numeric, optional, default=1000) The number of addresses of each branch to search beyond the last used or returned address\n3. startheight (numeric, optional, default=0)    The height of the first block to rescan\n\nResult:\n{\n \"branches\": [{               (array of object) The address cursors of each searched account branch\n  \"account\": \"value\",         (string)          The account name\n  \"branch\": n,                (numeric)         The account branch (0 for external, 1 for internal)\n  \"lastusedbefore\": n,        (numeric)         The last used child index before recovery, or -1\n  \"lastreturnedbefore\": n,    (numeric)         The last returned child index before recovery, or -1\n  \"lastused\": n,              (numeric)         The last used child index after recovery, or -1\n  \"lastreturned\": n,          (numeric)         The last returned child index after recovery, or -1\n },...],                                        \n \"outputs\": [{                (array of object) Unspent outputs paying to addresses beyond the last used address of their branch before recovery\n  \"txhash\": \"value\",          (string)          The transaction hash of the output\n  \"vout\": n,                  (numeric)         The output index\n  \"amount\": n.nnn,            (numeric)         The output value in DCR\n  \"account\": \"value\",         (string)          The account name\n  \"branch\": n,                (numeric)         The account branch (0 for external, 1 for internal)\n  \"index\": n,                 (numeric)         The child index of the output address\n  \"unreferenced\": true|false, (boolean)         Whether the address was beyond the last returned address of its branch before recovery\n },...],                                        \n}                             \n",
+		"redeemmultisigout":            "redeemmultisigout \"hash\" index tree (\"address\")\n\nTakes the input and constructs a P2PKH paying to the specified address.\n\nArguments:\n1. hash    (string, required)  Hash of the input transaction\n2. index   (numeric, required) Idx of the input transaction\n3. tree    (numeric, required) Tree the transaction is on.\n4. address (string, optional)  Address to pay to.\n\nResult:\n{\n \"hex\": \"value\",         (string)          Resulting hash.\n \"complete\": true|false, (boolean)         Shows if opperation was completed.\n \"errors\": [{            (array of object) Any errors generated.\n  \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n  \"vout\": n,             (numeric)         The output index of the referenced previous output\n  \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n  \"sequence\": n,         (numeric)         Script sequence number\n  \"error\": \"value\",      (string)          Verification or signing error related to the input\n },...],                                   \n}                        \n",
+		"redeemmultisigouts":           "redeemmultisigouts \"fromscraddress\" (\"toaddress\" number)\n\nTakes a hash, looks up all unspent outpoints and generates list artially signed transactions spending to either an address specified or internal addresses\n\nArguments:\n1. fromscraddress (string, required)  Input script hash address.\n2. toaddress      (string, optional)  Address to look for (if not internal addresses).\n3. number         (numeric, optional) Number of outpoints found.\n\nResult:\n{\n \"hex\": \"value\",         (string)          Resulting hash.\n \"complete\": true|false, (boolean)         Shows if opperation was completed.\n \"errors\": [{            (array of object) Any errors generated.\n  \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n  \"vout\": n,             (numeric)         The output index of the referenced previous output\n  \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n  \"sequence\": n,         (numeric)         Script sequence number\n  \"error\": \"value\",      (string)          Verification or signing error related to the input\n },...],                                   \n}                        \n",
+		"redeemswap":                   "redeemswap \"account\" \"contracttx\" \"contract\" \"secret\"\n\nRedeems an atomic swap contract by revealing its secret, paying the contract value less fees to a new internal address of an account.\n\nArguments:\n1. account    (string, required) The account to receive the redeemed value\n2. contracttx (string, required) The hex encoded transaction paying to the contract\n3. contract   (string, required) The hex encoded contract script\n4. secret     (string, required) The hex encoded 32 byte secret\n\nResult:\n\"value\" (string) The published transaction hash\n",
+		"refundswap":                   "refundswap \"account\" \"contracttx\" \"contract\"\n\nRefunds an atomic swap contract after its locktime, paying the contract value less fees to a new internal address of an account.\n\nArguments:\n1. account    (string, required) The account to receive the refunded value\n2. contracttx (string, required) The hex encoded transaction paying to the contract\n3. contract   (string, required) The hex encoded contract script\n\nResult:\n\"value\" (string) The published transaction hash\n",
+		"rejectpendingspend":           "rejectpendingspend \"txhash\"\n\nRemoves a spend awaiting approval without signing it, unlocking its inputs.\nThis method may only be called by the spend approval user (--approveusername).\n\nArguments:\n1. txhash (string, required) The transaction hash of the pending spend\n\nResult:\nNothing\n",
+		"releasefunds":                 "releasefunds \"id\"\n\nReleases a funds reservation, unlocking its reserved outputs.\n\nArguments:\n1. id (string, required) The identifier of the reservation\n\nResult:\nNothing\n",
+		"releasehold":                  "releasehold \"id\"\n\nReleases a hold, unlocking its held outputs.\n\nArguments:\n1. id (string, required) The identifier of the hold\n\nResult:\nNothing\n",
+		"removetreasurykey":            "removetreasurykey \"pubkey\"\n\nRemoves an imported treasury spend signing key.\nRequires an unlocked wallet started with the --treasurykeys option.\n\nArguments:\n1. pubkey (string, required) The hex encoded public key of the key to remove\n\nResult:\nNothing\n",
+		"removewebhook":                "removewebhook \"id\"\n\nRemoves a registered webhook.\n\nArguments:\n1. id (string, required) The identifier of the webhook\n\nResult:\nNothing\n",
+		"renameaccount":                "renameaccount \"oldaccount\" \"newaccount\"\n\nRenames an account.\n\nArguments:\n1. oldaccount (string, required) The old account name to rename\n2. newaccount (string, required) The new name for the account\n\nResult:\nNothing\n",
+		"rescanwallet":                 "rescanwallet (beginheight=0)\n\nRescan the block chain for wallet data, blocking until the rescan completes or exits with an error\n\nArguments:\n1. beginheight (numeric, optional, default=0) The height of the first block to begin the rescan from\n\nResult:\nNothing\n",
+		"reservefunds":                 "reservefunds \"id\" \"account\" amount (minconf=1)\n\nSelects and locks unspent outputs of an account to fund an order.\nReserved outputs are not spent by other transactions until released with releasefunds.\nReservations are recorded in the wallet database and remain in effect across restarts.\n\nArguments:\n1. id      (string, required)             A unique identifier for the reservation, such as an order ID\n2. account (string, required)             The account to reserve outputs from\n3. amount  (numeric, required)            The minimum total value of outputs to reserve\n4. minconf (numeric, optional, default=1) Minimum number of block confirmations required for reserved outputs\n\nResult:\n{\n \"id\": \"value\",              (string)          The identifier of the reservation\n \"account\": \"value\",         (string)          The account of the reserved outputs\n \"outpoints\": [\"value\",...], (array of string) The reserved outpoints\n \"amount\": n.nnn,            (numeric)         The total value of the reserved outputs\n}                            \n",
+		"sendfrom":                     "sendfrom \"fromaccount\" \"toaddress\" amount (minconf=1 \"comment\" \"commentto\" \"idempotencykey\" \"destinationoverride\" expiryblocks)\n\nAuthors, signs, and sends a transaction that outputs some amount to a payment address.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. fromaccount         (string, required)             Account to pick unspent outputs from\n2. toaddress           (string, required)             Address to pay\n3. amount              (numeric, required)            Amount to send to the payment address valued in decred\n4. minconf             (numeric, optional, default=1) Minimum number of block confirmations required before a transaction output is eligible to be spent\n5. comment             (string, optional)             Unused\n6. commentto           (string, optional)             Unused\n7. idempotencykey      (string, optional)             Optional key identifying the request; retrying with the same key returns the original transaction hash instead of sending again\n8. destinationoverride (string, optional)             Optional credential set by setdestinationoverride permitting payment to destinations prohibited by the destination policy of the account\n9. expiryblocks        (numeric, optional)            Optional number of blocks in which the transaction must be mined before expiring, overriding --txexpiry (0 for no expiry)\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
+		"sendfromtreasury":             "sendfromtreasury \"key\" amounts\n\nSend from treasury balance to multiple recipients.\n\nArguments:\n1. key     (string, required) Politeia public key\n2. amounts (object, required) Pairs of payment addresses and the output amount to pay each\n{\n \"Address to pay\": Amount to send to the payment address valued in decred, (object) JSON object using payment addresses as keys and output amounts valued in decred to send to each address\n ...\n}\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
+		"sendmany":                     "sendmany \"fromaccount\" {\"address\":amount,...} (minconf=1 \"comment\" \"idempotencykey\" \"destinationoverride\" expiryblocks)\n\nAuthors, signs, and sends a transaction that outputs to many payment addresses.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. fromaccount (string, required) Account to pick unspent outputs from\n2. amounts     (object, required) Pairs of payment addresses and the output amount to pay each\n{\n \"Address to pay\": Amount to send to the payment address valued in decred, (object) JSON object using payment addresses as keys and output amounts valued in decred to send to each address\n ...\n}\n3. minconf             (numeric, optional, default=1) Minimum number of block confirmations required before a transaction output is eligible to be spent\n4. comment             (string, optional)             Unused\n5. idempotencykey      (string, optional)             Optional key identifying the request; retrying with the same key returns the original transaction hash instead of sending again\n6. destinationoverride (string, optional)             Optional credential set by setdestinationoverride permitting payment to destinations prohibited by the destination policy of the account\n7. expiryblocks        (numeric, optional)            Optional number of blocks in which the transaction must be mined before expiring, overriding --txexpiry (0 for no expiry)\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
+		"sendrawtransaction":           "sendrawtransaction \"hextx\" (allowhighfees=false)\n\nSubmits the serialized, hex-encoded transaction to the local peer and relays it to the network.\n\nArguments:\n1. hextx         (string, required)                 Serialized, hex-encoded signed transaction\n2. allowhighfees (boolean, optional, default=false) Whether or not to allow insanely high fees\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
+		"sendtoaddress":                "sendtoaddress \"address\" amount (\"comment\" \"commentto\" \"idempotencykey\" \"destinationoverride\" expiryblocks)\n\nAuthors, signs, and sends a transaction that outputs some amount to a payment address.\nUnlike sendfrom, outputs are always chosen from the default account.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. address             (string, required)  Address to pay\n2. amount              (numeric, required) Amount to send to the payment address valued in decred\n3. comment             (string, optional)  Unused\n4. commentto           (string, optional)  Unused\n5. idempotencykey      (string, optional)  Optional key identifying the request; retrying with the same key returns the original transaction hash instead of sending again\n6. destinationoverride (string, optional)  Optional credential set by setdestinationoverride permitting payment to destinations prohibited by the destination policy of the account\n7. expiryblocks        (numeric, optional) Optional number of blocks in which the transaction must be mined before expiring, overriding --txexpiry (0 for no expiry)\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
+		"sendtomultisig":               "sendtomultisig \"fromaccount\" amount [\"pubkey\",...] (nrequired=1 minconf=1 \"comment\")\n\nAuthors, signs, and sends a transaction that outputs some amount to a multisig address.\nUnlike sendfrom, outputs are always chosen from the default account.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. fromaccount (string, required)             Unused\n2. amount      (numeric, required)            Amount to send to the payment address valued in decred\n3. pubkeys     (array of string, required)    Pubkey to send to.\n4. nrequired   (numeric, optional, default=1) The number of signatures required to redeem outputs paid to this address\n5. minconf     (numeric, optional, default=1) Minimum number of block confirmations required\n6. comment     (string, optional)             Unused\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
+		"sendtotreasury":               "sendtotreasury amount (fromaccount=\"default\" minconf=1)\n\nSend decred to treasury\n\nArguments:\n1. amount      (numeric, required)                   Amount to send to treasury\n2. fromaccount (string, optional, default=\"default\") Account to pick unspent outputs from\n3. minconf     (numeric, optional, default=1)        Minimum number of block confirmations required before a transaction output is eligible to be spent\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
+		"setaccountpassphrase":         "setaccountpassphrase \"account\" \"passphrase\"\n\nIndividually encrypt or change per-account passphrase\n\nArguments:\n1. account    (string, required) Account to modify\n2. passphrase (string, required) New passphrase to use.\nIf this is the empty string, the account passphrase is removed and the account becomes encrypted by the global wallet passhprase.\n\nResult:\nNothing\n",
+		"setdestinationoverride":       "setdestinationoverride \"credential\" (\"current\")\n\nSets the credential which permits payments prohibited by destination policies when provided to sendfrom, sendmany, or sendtoaddress, and which is required to change destination policies.\nOnly a verifier derived from the credential is recorded.\nRequires the unlocked wallet.\n\nArguments:\n1. credential (string, required) The override credential (empty to remove the override)\n2. current    (string, optional) The current override credential, required when one is set\n\nResult:\nNothing\n",
+		"setdestinationpolicy":         "setdestinationpolicy \"account\" (allowlist=false [\"allowed\",...] [\"denied\",...] \"credential\")\n\nPersists a policy restricting the destinations of payments from an account.\nPayments to denied addresses are rejected, and in allowlist mode, payments to addresses which are not allowed are also rejected.\nPolicies apply to every transaction signed with the account's keys, including raw transactions and swept outputs.\nPayments to HD addresses of the wallet are never restricted, but payments to imported keys and scripts are.\nA policy without allowlist mode or denied addresses removes any restriction.\nRequires the override credential set by setdestinationoverride, or the unlocked wallet when no credential is set.\n\nArguments:\n1. account    (string, required)                 The account name\n2. allowlist  (boolean, optional, default=false) Reject payments to addresses which are not allowed\n3. allowed    (array of string, optional)        Addresses which may be paid in allowlist mode\n4. denied     (array of string, optional)        Addresses which may never be paid\n5. credential (string, optional)                 The override credential set by setdestinationoverride\n\nResult:\nNothing\n",
+		"setdisapprovepercent":         "setdisapprovepercent percent\n\nSets the wallet's block disapprove percent per vote. The wallet will randomly disapprove blocks with this percent of votes. Only used for testing purposes and will fail on mainnet.\n\nArguments:\n1. percent (numeric, required) The percent of votes to disapprove blocks. i.e. 100 means that all votes disapprove the block they are called on. Must be between zero and one hundred.\n\nResult:\nNothing\n",
+		"setduresspassphrase":          "setduresspassphrase \"account\" \"passphrase\"\n\nSets a secondary private passphrase for use under coercion.\nUnlocking with the duress passphrase reports the wallet as unlocked, but only the private keys of the duress account are available.\nRequires the wallet to be unlocked with its private passphrase.\n\nArguments:\n1. account    (string, required) The low-value account unlocked by the duress passphrase\n2. passphrase (string, required) The duress passphrase, which must differ from the private passphrase.\nIf this is the empty string, the duress passphrase is removed.\n\nResult:\nNothing\n",
+		"setlockmode":                  "setlockmode \"mode\"\n\nSets which keys are removed from memory when the wallet is locked, including locks after a walletpassphrase timeout.\nIn spending mode, locking removes the keys of all spending accounts but keeps unlocked imported voting accounts unlocked, so always-on voting wallets may continue to vote without holding spending keys in memory.\nChanging the mode does not lock the wallet.\n\nArguments:\n1. mode (string, required) The lock mode, \"full\" to lock all accounts or \"spending\" to keep imported voting accounts unlocked\n\nResult:\nNothing\n",
+		"setmaxfeerate":                "setmaxfeerate amount\n\nPersistently sets the maximum fee per kB of the serialized tx size of all transactions authored by the wallet, including sends, ticket purchases, and mixes.\nCreating a transaction at a higher fee rate, whether provided as a method argument or set by settxfee, is rejected.\n\nArguments:\n1. amount (numeric, required) The maximum fee per kB of the serialized tx size valued in decred, or 0 to remove the limit\n\nResult:\nNothing\n",
+		"setmixedspendpolicy":          "setmixedspendpolicy \"account\" (branch=0)\n\nPersistently restricts sends and ticket purchases to only spend outputs of a mixed account branch.\nSpending from any other account is rejected, and an insufficient balance error describing the policy is returned when too few mixed funds are available.\n\nArguments:\n1. account (string, required)             The mixed account\n2. branch  (numeric, optional, default=0) The branch of the mixed account receiving mixed outputs\n\nResult:\nNothing\n",
+		"setmixpolicy":                 "setmixpolicy minpeers (minpeerversion=0)\n\nSets the minimum peer count and peer protocol version required of mixes.\nThe wallet refuses to sign mix transactions with fewer peers rather than completing mixes in small anonymity sets.\nThe policy is not persisted and is reset to the --mixminpeers and --mixminpeerversion options on restart.\n\nArguments:\n1. minpeers       (numeric, required)            The minimum number of peers required to complete a mix (values of 4 or less impose no restriction)\n2. minpeerversion (numeric, optional, default=0) The minimum protocol version of network peers to exchange mixing messages with\n\nResult:\nNothing\n",
+		"settreasurypolicy":            "settreasurypolicy \"key\" \"policy\" (\"ticket\")\n\nSet a voting policy for treasury spends by a particular key\n\nArguments:\n1. key    (string, required) Treasury key to set policy for\n2. policy (string, required) Voting policy for a treasury key (invalid/abstain, yes, or no)\n3. ticket (string, optional) Ticket hash to set a per-ticket treasury key policy\n\nResult:\nNothing\n",
+		"settspendpolicy":              "settspendpolicy \"hash\" \"policy\" (\"ticket\")\n\nSet a voting policy for a treasury spend transaction\n\nArguments:\n1. hash   (string, required) Hash of treasury spend transaction to set policy for\n2. policy (string, required) Voting policy for a tspend transaction (invalid/abstain, yes, or no)\n3. ticket (string, optional) Ticket hash to set a per-ticket tspend approval policy\n\nResult:\nNothing\n",
+		"settxfee":                     "settxfee amount\n\nModify the fee per kB of the serialized tx size used each time more fee is required for an authored transaction.\n\nArguments:\n1. amount (numeric, required) The new fee per kB of the serialized tx size valued in decred\n\nResult:\ntrue|false (boolean) The boolean 'true'\n",
+		"setvotechoice":                "setvotechoice \"agendaid\" \"choiceid\" (\"tickethash\")\n\nSets choices for defined agendas in the latest stake version supported by this software\n\nArguments:\n1. agendaid   (string, required) The ID for the agenda to modify\n2. choiceid   (string, required) The ID for the choice to choose\n3. tickethash (string, optional) The hash of the ticket to set choices for\n\nResult:\nNothing\n",
+		"signmessage":                  "signmessage \"address\" \"message\"\n\nSigns a message using the private key of a payment address.\n\nArguments:\n1. address (string, required) Payment address of private key used to sign the message with\n2. message (string, required) Message to sign\n\nResult:\n\"value\" (string) The signed message encoded as a base64 string\n",
+		"signrawtransaction":           "signrawtransaction \"rawtx\" ([{\"txid\":\"value\",\"vout\":n,\"tree\":n,\"scriptpubkey\":\"value\",\"redeemscript\":\"value\"},...] [\"privkey\",...] flags=\"ALL\")\n\nSigns transaction inputs using private keys from this wallet and request.\nThe valid flags options are ALL, NONE, SINGLE, ALL|ANYONECANPAY, NONE|ANYONECANPAY, and SINGLE|ANYONECANPAY.\n\nArguments:\n1. rawtx    (string, required)                Unsigned or partially unsigned transaction to sign encoded as a hexadecimal string\n2. inputs   (array of object, optional)       Additional data regarding inputs that this wallet may not be tracking\n3. privkeys (array of string, optional)       Additional WIF-encoded private keys to use when creating signatures\n4. flags    (string, optional, default=\"ALL\") Sighash flags\n\nResult:\n{\n \"hex\": \"value\",         (string)          The resulting transaction encoded as a hexadecimal string\n \"complete\": true|false, (boolean)         Whether all input signatures have been created\n \"errors\": [{            (array of object) Script verification errors (if exists)\n  \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n  \"vout\": n,             (numeric)         The output index of the referenced previous output\n  \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n  \"sequence\": n,         (numeric)         Script sequence number\n  \"error\": \"value\",      (string)          Verification or signing error related to the input\n },...],                                   \n}                        \n",
+		"signrawtransactions":          "signrawtransactions [\"rawtx\",...] (send=true)\n\nSigns transaction inputs using private keys from this wallet and request for a list of transactions.\n\n\nArguments:\n1. rawtxs (array of string, required)       A list of transactions to sign (and optionally send).\n2. send   (boolean, optional, default=true) Set true to send the transactions after signing.\n\nResult:\n{\n \"results\": [{             (array of object) Returned values from the signrawtransactions command.\n  \"signingresult\": {       (object)          Success or failure of signing.\n   \"hex\": \"value\",         (string)          The resulting transaction encoded as a hexadecimal string\n   \"complete\": true|false, (boolean)         Whether all input signatures have been created\n   \"errors\": [{            (array of object) Script verification errors (if exists)\n    \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n    \"vout\": n,             (numeric)         The output index of the referenced previous output\n    \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n    \"sequence\": n,         (numeric)         Script sequence number\n    \"error\": \"value\",      (string)          Verification or signing error related to the input\n   },...],                                   \n  },                                         \n  \"sent\": true|false,      (boolean)         Tells if the transaction was sent.\n  \"txhash\": \"value\",       (string)          The hash of the signed tx.\n },...],                                     \n}                          \n",
+		"signtspend":                   "signtspend \"tx\" \"pubkey\"\n\nSigns a treasury spend transaction with an imported treasury key, replacing the signature script of its only input.\nRequires an unlocked wallet started with the --treasurykeys option.\n\nArguments:\n1. tx     (string, required) The hex encoded unsigned treasury spend transaction\n2. pubkey (string, required) The hex encoded public key of the imported treasury key to sign with\n\nResult:\n\"value\" (string) The hex encoded signed transaction\n",
+		"simulatestake":                "simulatestake amount (days=365 ticketprice)\n\nSimulates the expected returns of staking a hypothetical balance over a period following the main chain tip.\nAll of the balance which can be is used to purchase tickets, and vote rewards and returned ticket value are restaked as they mature.\nThe simulation makes the assumptions of forecaststake, using the subsidy schedule of the network, and the ticket price is assumed to remain constant.\n\nArguments:\n1. amount      (numeric, required)              The hypothetical balance to stake\n2. days        (numeric, optional, default=365) The number of days to simulate, at most 36500\n3. ticketprice (numeric, optional)              Price of purchased tickets (default is the next stake difficulty)\n\nResult:\n{\n \"amount\": n.nnn,       (numeric) The simulated balance\n \"ticketprice\": n.nnn,  (numeric) The assumed price of purchased tickets\n \"blocks\": n,           (numeric) The number of simulated blocks\n \"value\": n.nnn,        (numeric) Expected total of the spendable, immature and locked balances at the end of the period\n \"rewards\": n.nnn,      (numeric) Expected increase of the balance over the period\n \"purchased\": n,        (numeric) Number of tickets purchased during the period\n \"votes\": n.nnn,        (numeric) Expected number of votes cast during the period\n \"return\": n.nnn,       (numeric) Expected return over the period as a fraction of the balance\n \"annualreturn\": n.nnn, (numeric) Expected return compounded over one year as a fraction of the balance\n}                       \n",
+		"spendoutputs":                 "spendoutputs \"account\" [\"previousoutpoint\",...] [{\"address\":\"value\",\"amount\":n.nnn},...]\n\nCreate, sign, and publish a transaction spending the specified wallet outputs, and paying an array of address/amount pairs.\nOutputs must belong to the specified account, and change (if needed) is returned to an internal address of the same account.\n\nArguments:\n1. account           (string, required)          Account of specified previous outpoints, and account used to return change\n2. previousoutpoints (array of string, required) Array of outpoints in string encoding (\"hash:index\")\n3. outputs           (array of object, required) Array of JSON objects, each specifying an address string and amount\n[{\n \"address\": \"value\", (string)  Address to pay\n \"amount\": n.nnn,    (numeric) Amount to pay the address\n},...]\n\nResult:\n\"value\" (string) The published transaction hash\n",
+		"sweepaccount":                 "sweepaccount \"sourceaccount\" \"destinationaddress\" (requiredconfirmations feeperkb)\n\nMoves as much value as possible in a transaction from an account.\n\n\nArguments:\n1. sourceaccount         (string, required)  The account to be swept.\n2. destinationaddress    (string, required)  The destination address to pay to.\n3. requiredconfirmations (numeric, optional) The minimum utxo confirmation requirement (optional).\n4. feeperkb              (numeric, optional) The minimum relay fee policy (optional).\n\nResult:\n{\n \"unsignedtransaction\": \"value\",     (string)  The hex encoded string of the unsigned transaction.\n \"totalpreviousoutputamount\": n.nnn, (numeric) The total transaction input amount.\n \"totaloutputamount\": n.nnn,         (numeric) The total transaction output amount.\n \"estimatedsignedsize\": n,           (numeric) The estimated size of the transaction when signed.\n}                                    \n",
+		"sweepprivkey":                 "sweepprivkey \"privkey\" (account=\"default\" scanfrom=0)\n\nPublishes a transaction spending the mature unspent outputs paying to the P2PKH address of a private key to a new internal address of an account.\nOutputs are discovered using the wallet's compact filters, and the key is not imported.\nOnly the oldest outputs which fit in the maximum transaction size are spent; the remaining outputs may be swept again after the transaction is mined.\n\nArguments:\n1. privkey  (string, required)                    The WIF-encoded private key to sweep\n2. account  (string, optional, default=\"default\") The account receiving the swept value\n3. scanfrom (numeric, optional, default=0)        The block height to begin searching for outputs\n\nResult:\n{\n \"txhash\": \"value\",       (string)          The published transaction hash\n \"inputs\": [\"value\",...], (array of string) The swept outpoints\n \"amount\": n.nnn,         (numeric)         The total value of the swept outputs\n \"fee\": n.nnn,            (numeric)         The transaction fee\n}                         \n",
+		"sweeptocoldstorage":           "sweeptocoldstorage \"sourceaccount\" (\"destaccount\" \"destxpub\" destxpubindex=0 feerate maxfee maxinputs=0 minconf=1 dryrun=false)\n\nSpends the entire spendable balance of an account to another wallet account or to addresses derived from an account extended public key.\nOutputs are batched into transactions paying a single output each, and no change is returned to the source account.\n\nArguments:\n1. sourceaccount (string, required)                 The account to sweep\n2. destaccount   (string, optional)                 The wallet account receiving the swept value at new external addresses (mutually exclusive with destxpub)\n3. destxpub      (string, optional)                 An account extended public key whose external branch addresses receive the swept value (mutually exclusive with destaccount)\n4. destxpubindex (numeric, optional, default=0)     The first external branch child index of destxpub to pay\n5. feerate       (numeric, optional)                The fee per kilobyte (default: the wallet relay fee)\n6. maxfee        (numeric, optional)                Abort the sweep if any transaction would pay a greater fee (default: no limit)\n7. maxinputs     (numeric, optional, default=0)     Maximum number of inputs per transaction (default: 500)\n8. minconf       (numeric, optional, default=1)     Minimum number of block confirmations of swept outputs\n9. dryrun        (boolean, optional, default=false) Create the transactions without signing or publishing them\n\nResult:\n[{\n \"txhash\": \"value\",      (string)  The transaction hash\n \"transaction\": \"value\", (string)  The hex encoded transaction, unsigned for dry runs\n \"address\": \"value\",     (string)  The address paid by the transaction (omitted for dry runs to a wallet account)\n \"inputs\": n,            (numeric) The number of inputs spent\n \"amount\": n.nnn,        (numeric) The total value of the spent outputs\n \"fee\": n.nnn,           (numeric) The transaction fee\n},...]\n",
+		"syncstatus":                   "syncstatus\n\nReturns information about this wallet's synchronization to the network.\n\nArguments:\nNone\n\nResult:\n{\n \"synced\": true|false,               (boolean)         Whether or not the wallet is fully caught up to the network.\n \"initialblockdownload\": true|false, (boolean)         Best guess of whether this wallet is in the initial block download mode used to catch up the blockchain when it is far behind.\n \"headersfetchprogress\": n.nnn,      (numeric)         Estimated progress of the headers fetching stage of the current sync process.\n \"phase\": \"value\",                   (string)          The current sync phase (connecting, fetchingcfilters, fetchingheaders, discoveringaddresses, rescanning, or synced).\n \"phaseheight\": n,                   (numeric)         The block height the current phase has progressed through, or 0 when the phase does not report progress.\n \"phasetargetheight\": n,             (numeric)         The block height the current phase completes at, or 0 when the phase does not report progress.\n \"tipheight\": n,                     (numeric)         The height of the wallet's main chain tip.\n \"backend\": \"value\",                 (string)          The kind of network backend (spv, rpc, or offline).\n \"peers\": [\"value\",...],             (array of string) Addresses of the peers or dcrd server the wallet syncs from.\n}                                    \n",
+		"ticketaddressreuse":           "ticketaddressreuse\n\nAudits the wallet's tickets for voting and commitment addresses shared by more than one ticket, as reuse links the tickets to the same owner.\nVoting addresses of voting scripts, which are shared by all users of the script, are not considered reuse.\n\nArguments:\nNone\n\nResult:\n[{\n \"address\": \"value\",       (string)          The reused address\n \"account\": n,             (numeric)         The account of the address\n \"kind\": \"value\",          (string)          Whether the address was used for the tickets' voting rights (\"voting\") or reward commitments (\"commitment\")\n \"tickets\": [\"value\",...], (array of string) The hashes of the tickets using the address\n},...]\n",
+		"ticketinfo":                   "ticketinfo (startheight=0)\n\nReturns details of each wallet ticket transaction\n\nArguments:\n1. startheight (numeric, optional, default=0) Specify the starting block height to scan from\n\nResult:\n[{\n \"hash\": \"value\",               (string)          Transaction hash of the ticket\n \"cost\": n.nnn,                 (numeric)         Amount paid to purchase the ticket; this may be greater than the ticket price at time of purchase\n \"votingaddress\": \"value\",      (string)          Address of 0th output, which describes the requirements to spend the ticket\n \"status\": \"value\",             (string)          Description of ticket status (unknown, unmined, immature, mature, live, voted, missed, expired, unspent, revoked)\n \"blockhash\": \"value\",          (string)          Hash of block ticket is mined in\n \"blockheight\": n,              (numeric)         Height of block ticket is mined in\n \"vote\": \"value\",               (string)          Transaction hash of vote which spends the ticket\n \"revocation\": \"value\",         (string)          Transaction hash of revocation which spends the ticket\n \"choices\": [{                  (array of object) Vote preferences set for the ticket\n  \"agendaid\": \"value\",          (string)          The ID for the agenda the choice concerns\n  \"agendadescription\": \"value\", (string)          A description of the agenda the choice concerns\n  \"choiceid\": \"value\",          (string)          The ID of the current choice for this agenda\n  \"choicedescription\": \"value\", (string)          A description of the current choice for this agenda\n },...],                                          \n \"vsphost\": \"value\",            (string)          VSP Host associated with the ticket (if any)\n},...]\n",
+		"treasurypolicy":               "treasurypolicy (\"key\" \"ticket\")\n\nReturn voting policies for treasury spend transactions by key\n\nArguments:\n1. key    (string, optional) Return the policy for a particular key\n2. ticket (string, optional) Return policies used by a specific ticket hash\n\nResult (no key provided):\n[{\n \"key\": \"value\",    (string) Treasury key associated with a policy\n \"policy\": \"value\", (string) Voting policy description (abstain, yes, or no)\n \"ticket\": \"value\", (string) Ticket hash of a per-ticket treasury key approval policy\n},...]\n\nResult (key specified):\n{\n \"key\": \"value\",    (string) Treasury key associated with a policy\n \"policy\": \"value\", (string) Voting policy description (abstain, yes, or no)\n \"ticket\": \"value\", (string) Ticket hash of a per-ticket treasury key approval policy\n}                   \n",
+		"tspendpolicy":                 "tspendpolicy (\"hash\" \"ticket\")\n\nReturn voting policies for treasury spend transactions\n\nArguments:\n1. hash   (string, optional) Return the policy for a particular tspend hash\n2. ticket (string, optional) Return policies used by a specific ticket hash\n\nResult (no tspend hash provided):\n[{\n \"hash\": \"value\",   (string) Treasury spend transaction hash\n \"policy\": \"value\", (string) Voting policy description (abstain, yes, or no)\n \"ticket\": \"value\", (string) Ticket hash of a per-ticket tspend approval policy\n},...]\n\nResult (tspend hash specified):\n{\n \"hash\": \"value\",   (string) Treasury spend transaction hash\n \"policy\": \"value\", (string) Voting policy description (abstain, yes, or no)\n \"ticket\": \"value\", (string) Ticket hash of a per-ticket tspend approval policy\n}                   \n",
+		"unlockaccount":                "unlockaccount \"account\" \"passphrase\"\n\nUnlock an individually-encrypted account\n\nArguments:\n1. account    (string, required) Account to unlock\n2. passphrase (string, required) Account passphrase\n\nResult:\nNothing\n",
+		"validateaddress":              "validateaddress \"address\"\n\nVerify that an address is valid.\nExtra details are returned if the address is controlled by this wallet.\nThe following fields are valid only when the address is controlled by this wallet (ismine=true): pubkey, account, addresses, hex, and sigsrequired.\nThe following fields are only valid when address has an associated public key: pubkey, iscompressed.\nThe following fields are only valid when address is a pay-to-script-hash address: addresses, hex, and script.\nIf the address is a multisig address controlled by this wallet, the multisig fields will be left unset if the wallet is locked since the redeem script cannot be decrypted.\n\nArguments:\n1. address (string, required) Address to validate\n\nResult:\n{\n \"isvalid\": true|false,      (boolean)         Whether or not the address is valid\n \"address\": \"value\",         (string)          The payment address (only when isvalid is true)\n \"ismine\": true|false,       (boolean)         Whether this address is controlled by the wallet (only when isvalid is true)\n \"iswatchonly\": true|false,  (boolean)         Unset\n \"isscript\": true|false,     (boolean)         Whether the payment address is a pay-to-script-hash address (only when isvalid is true)\n \"pubkeyaddr\": \"value\",      (string)          The pubkey for this payment address (only when isvalid is true)\n \"pubkey\": \"value\",          (string)          The associated public key of the payment address, if any (only when isvalid is true)\n \"iscompressed\": true|false, (boolean)         Whether the address was created by hashing a compressed public key, if any (only when isvalid is true)\n \"account\": \"value\",         (string)          The account this payment address belongs to (only when isvalid is true)\n \"addresses\": [\"value\",...], (array of string) All associated payment addresses of the script if address is a multisig address (only when isvalid is true)\n \"hex\": \"value\",             (string)          The redeem script \n \"script\": \"value\",          (string)          The class of redeem script for a multisig address\n \"sigsrequired\": n,          (numeric)         The number of required signatures to redeem outputs to the multisig address\n \"accountn\": n,              (numeric)         The account number. This number plus 2 ^ 31 is the HD account the address was derived from. Not available for imported accounts. Only present for BIP0044 derived addresses.\n \"branch\": n,                (numeric)         The HD branch. Only present for BIP0044 derived addresses.\n \"index\": n,                 (numeric)         The HD index. Only present for BIP0044 derived addresses.\n}                            \n",
+		"validatepredcp0005cf":         "validatepredcp0005cf\n\nValidate whether all stored cfilters from before DCP0005 activation are correct according to the expected hardcoded hash\n\nArguments:\nNone\n\nResult:\ntrue|false (boolean) Whether the cfilters are valid\n",
+		"verifymessage":                "verifymessage \"address\" \"signature\" \"message\"\n\nVerify a message was signed with the associated private key of some address.\n\nArguments:\n1. address   (string, required) Address used to sign message\n2. signature (string, required) The signature to verify\n3. message   (string, required) The message to verify\n\nResult:\ntrue|false (boolean) Whether the message was signed with the private key of 'address'\n",
+		"verifyreservesnapshot":        "verifyreservesnapshot {\"blockhash\":\"value\",\"blockheight\":n,\"message\":\"value\",\"merkleroot\":\"value\",\"total\":n.nnn,\"outputs\":[{\"txid\":\"value\",\"vout\":n,\"tree\":n,\"amount\":n.nnn,\"scriptversion\":n,\"pkscript\":\"value\",\"address\":\"value\",\"signature\":\"value\"},...]}\n\nVerifies a reserve snapshot by checking the merkle commitment and signatures of all outputs and that each output remains unspent.\nRequires an RPC connection to dcrd.\n\nArguments:\n1. snapshot (object, required) The reserve snapshot as returned by createreservesnapshot\n{\n \"blockhash\": \"value\",  (string)          The main chain block of the snapshot\n \"blockheight\": n,      (numeric)         The height of the snapshot block\n \"message\": \"value\",    (string)          The message included in every signature\n \"merkleroot\": \"value\", (string)          The merkle root committing to every output\n \"total\": n.nnn,        (numeric)         The total value of all outputs\n \"outputs\": [{          (array of object) Every signed output, ordered by outpoint\n  \"txid\": \"value\",      (string)          The transaction hash of the output\n  \"vout\": n,            (numeric)         The output index\n  \"tree\": n,            (numeric)         The transaction tree of the output\n  \"amount\": n.nnn,      (numeric)         The output value\n  \"scriptversion\": n,   (numeric)         The output script version\n  \"pkscript\": \"value\",  (string)          The hex-encoded output script\n  \"address\": \"value\",   (string)          The public key hash address paid by the output\n  \"signature\": \"value\", (string)          The base64-encoded compact signature of the proof message by the address key\n },...],                                  \n}                       \n\nResult:\n{\n \"valid\": true|false, (boolean) Whether the snapshot is valid\n \"total\": n.nnn,      (numeric) The total value of all verified outputs\n \"error\": \"value\",    (string)  The reason the snapshot is invalid\n}                     \n",
+		"verifyvotingaccount":          "verifyvotingaccount \"account\" [\"address\",...]\n\nVerifies that addresses, such as those returned by exportvotingaccount on another wallet, are the first voting addresses of an account.\n\nArguments:\n1. account   (string, required)          The voting account\n2. addresses (array of string, required) The voting addresses in order of derivation\n\nResult:\n{\n \"valid\": true|false, (boolean) Whether all addresses are voting addresses of the account\n \"error\": \"value\",    (string)  Describes the first address which is not derived by the account\n}                     \n",
+		"version":                      "version\n\nReturns application and API versions (semver) keyed by their names\n\nArguments:\nNone\n\nResult:\n{\n \"Program or API name\": Object containing the semantic version, (object) Version objects keyed by the program or API name\n ...\n}\n",
+		"walletinfo":                   "walletinfo\n\nReturns global information about the wallet\n\nArguments:\nNone\n\nResult:\n{\n \"daemonconnected\": true|false, (boolean) Whether or not the wallet is currently connected to the daemon RPC\n \"spv\": true|false,             (boolean) Whether or not wallet is syncing in SPV mode\n \"unlocked\": true|false,        (boolean) Whether or not the wallet is unlocked\n \"cointype\": n,                 (numeric) Active coin type. Not available for watching-only wallets.\n \"txfee\": n.nnn,                (numeric) Transaction fee per kB of the serialized tx size in coins\n \"maxfeerate\": n.nnn,           (numeric) Maximum transaction fee per kB of the serialized tx size in coins, omitted when there is no limit\n \"votebits\": n,                 (numeric) Vote bits setting\n \"votebitsextended\": \"value\",   (string)  Extended vote bits setting\n \"voteversion\": n,              (numeric) Version of votes that will be generated\n \"voting\": true|false,          (boolean) Whether or not the wallet is currently voting tickets\n \"vsp\": \"value\",                (string)  VSP URL used when purchasing tickets\n \"manualtickets\": true|false,   (boolean) Whether or not the wallet is only accepting tickets manually\n \"lockmode\": \"value\",           (string)  The lock mode set by setlockmode, \"full\" or \"spending\"\n \"birthhash\": \"value\",          (string)  The wallet birth hash.\n \"birthheight\": n,              (numeric) The wallet birth height.\n \"instanceconflict\": \"value\",   (string)  Describes another wallet process detected writing to this wallet's database or a copy of it, which corrupts the wallet (omitted if none was detected)\n}                               \n",
+		"walletislocked":               "walletislocked\n\nReturns whether or not the wallet is locked.\n\nArguments:\nNone\n\nResult:\ntrue|false (boolean) Whether the wallet is locked\n",
+		"walletlock":                   "walletlock\n\nLock the wallet.\n\nArguments:\nNone\n\nResult:\nNothing\n",
+		"walletpassphrase":             "walletpassphrase \"passphrase\" timeout\n\nUnlock the wallet.\n\nArguments:\n1. passphrase (string, required)  The wallet passphrase\n2. timeout    (numeric, required) The number of seconds to wait before the wallet automatically locks. 0 leaves the wallet unlocked indefinitely.\n\nResult:\nNothing\n",
+		"walletpassphrasechange":       "walletpassphrasechange \"oldpassphrase\" \"newpassphrase\"\n\nChange the wallet passphrase.\n\nArguments:\n1. oldpassphrase (string, required) The old wallet passphrase\n2. newpassphrase (string, required) The new wallet passphrase\n\nResult:\nNothing\n",
+		"walletpubpassphrasechange":    "walletpubpassphrasechange \"oldpassphrase\" \"newpassphrase\"\n\nChange the wallet's public passphrase.\n\nArguments:\n1. oldpassphrase (string, required) The old wallet passphrase\n2. newpassphrase (string, required) The new wallet passphrase\n\nResult:\nNothing\n",
 	}
 }
 
@@ -114,4 +202,4 @@ var localeHelpDescs = map[string]func() map[string]string{
 	"en_US": helpDescsEnUS,
 }
 
-var requestUsages = "abandontransaction \"hash\"\naccountaddressindex \"account\" branch\naccountsyncaddressindex \"account\" branch index\naccountunlocked \"account\"\naddmultisigaddress nrequired [\"key\",...] (\"account\")\naddtransaction \"blockhash\" \"transaction\"\nauditreuse (since)\nconsolidate inputs (\"account\" \"address\")\ncreatemultisig nrequired [\"key\",...]\ncreatenewaccount \"account\"\ncreaterawtransaction [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...] {\"address\":amount,...} (locktime expiry)\ncreatesignature \"address\" inputindex hashtype \"previouspkscript\" \"serializedtransaction\"\ndebuglevel \"levelspec\"\ndisapprovepercent\ndiscoverusage (\"startblock\" discoveraccounts gaplimit)\ndumpprivkey \"address\"\nfundrawtransaction \"hexstring\" \"fundaccount\" ({\"changeaddress\":changeaddress,\"feerate\":feerate,\"conftarget\":conftarget})\ngetaccount \"address\"\ngetaccountaddress \"account\"\ngetaddressesbyaccount \"account\"\ngetbalance (\"account\" minconf=1)\ngetbestblock\ngetbestblockhash\ngetblockcount\ngetblockhash index\ngetblockheader \"hash\" (verbose=true)\ngetblock \"hash\" (verbose=true verbosetx=false)\ngetcoinjoinsbyacct\ngetcurrentnet\ngetinfo\ngetmasterpubkey (\"account\")\ngetmultisigoutinfo \"hash\" index\ngetnewaddress (\"account\" \"gappolicy\")\ngetpeerinfo\ngetrawchangeaddress (\"account\")\ngetreceivedbyaccount \"account\" (minconf=1)\ngetreceivedbyaddress \"address\" (minconf=1)\ngetstakeinfo\ngettickets includeimmature\ngettransaction \"txid\" (includewatchonly=false)\ngettxout \"txid\" vout tree (includemempool=true)\ngetunconfirmedbalance (\"account\")\ngetvotechoices (\"tickethash\")\ngetwalletfee\ngetcfilterv2 \"blockhash\"\nhelp (\"command\")\nimportcfiltersv2 startheight [\"filter\",...]\nimportprivkey \"privkey\" (\"label\" rescan=true scanfrom)\nimportpubkey \"pubkey\" (\"label\" rescan=true scanfrom)\nimportscript \"hex\" (rescan=true scanfrom)\nimportxpub \"name\" \"xpub\"\nlistaccounts (minconf=1)\nlistaddresstransactions [\"address\",...] (\"account\")\nlistalltransactions (\"account\")\nlistlockunspent (\"account\")\nlistreceivedbyaccount (minconf=1 includeempty=false includewatchonly=false)\nlistreceivedbyaddress (minconf=1 includeempty=false includewatchonly=false)\nlistsinceblock (\"blockhash\" targetconfirmations=1 includewatchonly=false)\nlisttransactions (\"account\" count=10 from=0 includewatchonly=false)\nlistunspent (minconf=1 maxconf=9999999 [\"address\",...] \"account\")\nlockaccount \"account\"\nlockunspent unlock [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...]\nmixaccount\nmixoutput \"outpoint\"\nprocessunmanagedticket \"tickethash\"\npurchaseticket \"fromaccount\" spendlimit (minconf=1 numtickets=1 expiry \"comment\" dontsigntx)\nredeemmultisigout \"hash\" index tree (\"address\")\nredeemmultisigouts \"fromscraddress\" (\"toaddress\" number)\nrenameaccount \"oldaccount\" \"newaccount\"\nrescanwallet (beginheight=0)\nsendfrom \"fromaccount\" \"toaddress\" amount (minconf=1 \"comment\" \"commentto\")\nsendfromtreasury \"key\" amounts\nsendmany \"fromaccount\" {\"address\":amount,...} (minconf=1 \"comment\")\nsendrawtransaction \"hextx\" (allowhighfees=false)\nsendtoaddress \"address\" amount (\"comment\" \"commentto\")\nsendtomultisig \"fromaccount\" amount [\"pubkey\",...] (nrequired=1 minconf=1 \"comment\")\nsendtotreasury amount\nsetaccountpassphrase \"account\" \"passphrase\"\nsetdisapprovepercent percent\nsettreasurypolicy \"key\" \"policy\" (\"ticket\")\nsettspendpolicy \"hash\" \"policy\" (\"ticket\")\nsettxfee amount\nsetvotechoice \"agendaid\" \"choiceid\" (\"tickethash\")\nsignmessage \"address\" \"message\"\nsignrawtransaction \"rawtx\" ([{\"txid\":\"value\",\"vout\":n,\"tree\":n,\"scriptpubkey\":\"value\",\"redeemscript\":\"value\"},...] [\"privkey\",...] flags=\"ALL\")\nsignrawtransactions [\"rawtx\",...] (send=true)\nspendoutputs \"account\" [\"previousoutpoint\",...] [{\"address\":\"value\",\"amount\":n.nnn},...]\nsweepaccount \"sourceaccount\" \"destinationaddress\" (requiredconfirmations feeperkb)\nsyncstatus\nticketinfo (startheight=0)\ntreasurypolicy (\"key\" \"ticket\")\ntspendpolicy (\"hash\" \"ticket\")\nunlockaccount \"account\" \"passphrase\"\nvalidateaddress \"address\"\nvalidatepredcp0005cf\nverifymessage \"address\" \"signature\" \"message\"\nversion\nwalletinfo\nwalletislocked\nwalletlock\nwalletpassphrase \"passphrase\" timeout\nwalletpassphrasechange \"oldpassphrase\" \"newpassphrase\"\nwalletpubpassphrasechange \"oldpassphrase\" \"newpassphrase\""
+var requestUsages = "abandontransaction \"hash\"\naccountaddressindex \"account\" branch\naccountsyncaddressindex \"account\" branch index\naccountunlocked \"account\"\naddmultisigaddress nrequired [\"key\",...] (\"account\")\naddressreusereport (since)\naddtransaction \"blockhash\" \"transaction\"\naddwebhook \"url\" (\"account\" \"address\" minamount)\nannotaterawtransaction \"hextx\"\napprovependingspend \"txhash\"\nattestaddress \"address\"\nauditreuse (since)\nclearmixedspendpolicy\nconsolidate inputs (\"account\" \"address\")\ncosignvote \"hexvote\"\ncreateinvoice \"id\" amount (account=\"default\" expiresin \"gappolicy\")\ncreatemultisig nrequired [\"key\",...]\ncreatemultisigspend \"fromscraddress\" {\"address\":amount,...} (feerate [\"outpoint\",...])\ncreatenewaccount \"account\"\ncreatepaymenturi (account=\"default\" amount \"label\" \"message\" expiresin)\ncreaterawtransaction [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...] {\"address\":amount,...} (locktime expiry)\ncreatereservesnapshot (message=\"\")\ncreatesignature \"address\" inputindex hashtype \"previouspkscript\" \"serializedtransaction\"\ncreateswapcontract \"account\" \"recipient\" \"secrethash\" locktime\ndebugdump\ndebuginspectdb (\"bucket\" limit=100)\ndebugtimings (reset=false)\ndebuglevel \"levelspec\"\ndeleteinvoice \"id\"\ndisapprovepercent\ndiscoverusage (\"startblock\" discoveraccounts gaplimit)\ndumpprivkey \"address\"\nestimaterawtransaction \"hexstring\" \"fundaccount\" ({\"changeaddress\":changeaddress,\"feerate\":feerate,\"conftarget\":conftarget})\nestimatetxsize \"txtype\" [\"input\",...] [\"output\",...] (change=false feerate)\nexportvotepolicies \"address\"\nexportvotingaccount \"account\" (count=20)\nforecaststake (blocks=0 interval=0 {\"account\":account,\"buytickets\":buytickets,\"maintain\":maintain,\"limit\":limit,\"ticketprice\":ticketprice})\nfundrawtransaction \"hexstring\" \"fundaccount\" ({\"changeaddress\":changeaddress,\"feerate\":feerate,\"conftarget\":conftarget})\ngetaccount \"address\"\ngetaccountaddress \"account\"\ngetaccountblindedid \"account\"\ngetaccountbyid \"uuid\"\ngetaccountchildxpub \"account\" \"path\"\ngetaccountcryptoparams (\"account\")\ngetaccountid \"account\"\ngetaccountwatchkey \"account\" (start=0 count=20 rescan=false scanfrom)\ngetaddressesbyaccount \"account\"\ngetbalance (\"account\" minconf=1)\ngetcapabilities\ngetbestblock\ngetbestblockhash\ngetblockcount\ngetblockhash index\ngetblockheader \"hash\" (verbose=true)\ngetblock \"hash\" (verbose=true verbosetx=false)\ngetcoinjoinsbyacct\ngetcurrentnet\ngetdestinationpolicy \"account\"\ngetduressaccount\ngetinfo\ngetinvoice \"id\"\ngetjournalevents fromsequence (count=1000)\ngetmasterpubkey (\"account\")\ngetmixedspendpolicy\ngetmixpolicy\ngetmultisigoutinfo \"hash\" index\ngetnewaddress (\"account\" \"gappolicy\" expiresin expiryheight)\ngetpeerinfo\ngetrawchangeaddress (\"account\")\ngetreceivedbyaccount \"account\" (minconf=1)\ngetreceivedbyaddress \"address\" (minconf=1)\ngetreservedbalance \"account\" (minconf=1)\ngetstakeinfo\ngetticketpools (interval=0)\ngettickets includeimmature\ngettransaction \"txid\" (includewatchonly=false)\ngettxout \"txid\" vout tree (includemempool=true)\ngetunconfirmedbalance (\"account\")\ngetvotechoices (\"tickethash\")\ngetvotediagnostics (count=20)\ngetvoteversioninfo\ngetwalletfee\ngetcfilterv2 \"blockhash\"\ngetidlelock\ngetutxostats (\"account\" dustthreshold)\ngetwalletstats startheight endheight (interval=0)\nholdamount \"id\" \"account\" amount \"reason\" (minconf=1)\nholdoutputs \"id\" \"reason\" [\"outpoint\",...]\nhelp (\"command\")\nimportcfiltersv2 startheight [\"filter\",...]\nimportcheckpoint \"checkpoint\"\nimportexternalsigneraccount \"name\" \"xpub\" \"fingerprint\" \"path\" \"model\"\nimportlegacystakepooltickets \"host\" \"script\" \"poolfeeaddress\" ([\"ticket\",...])\nimportprivkey \"privkey\" (\"label\" rescan=true scanfrom)\nimportpubkey \"pubkey\" (\"label\" rescan=true scanfrom)\nimportscript \"hex\" (rescan=true scanfrom \"account\")\nimporttreasurykey \"privkey\" \"name\"\nimportslip0044account account \"passphrase\" (\"name\")\nimportvotepolicies \"policies\" \"address\"\nimportvotingaccount \"xpriv\" \"passphrase\" \"name\"\nimportxpub \"name\" \"xpub\"\nlistaccounts (minconf=1)\nlistaddresstransactions [\"address\",...] (\"account\")\nlistalltransactions (\"account\")\nlistexpiredaddresses\nlistexternalsigners\nlistfundsreservations\nlistholds\nlistinvoices (\"account\" \"status\")\nlistlockunspent (\"account\")\nlistpendingspends\nlistreceivedbyaccount (minconf=1 includeempty=false includewatchonly=false)\nlistreceivedbyaddress (minconf=1 includeempty=false includewatchonly=false)\nlistsinceblock (\"blockhash\" targetconfirmations=1 includewatchonly=false)\nlisttransactions (\"account\" count=10 from=0 includewatchonly=false)\nlisttreasurykeys\nlistunspent (minconf=1 maxconf=9999999 [\"address\",...] \"account\")\nlistwebhooks\nlockaccount \"account\"\nlockunspent unlock [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...]\nlookupaccountblindedid \"blindedid\"\nmatchcfilters startheight endheight\nmixaccount\nmixoutput \"outpoint\"\nparsepaymenturi \"uri\"\npregenerateaddresses \"account\" count (branch=0)\npreviewmixaccount\nprocessunmanagedticket \"tickethash\"\nprovevspownership \"tickethash\" \"request\" (apiversion=3)\nprunesidechains (depth=256)\npurchaseticket \"fromaccount\" spendlimit (minconf=1 numtickets=1 expiry \"comment\" dontsigntx \"votingscript\" \"idempotencykey\" mixsplit)\nrecovermixoutputs ([\"account\",...] scanlen=1000 startheight=0)\nredeemmultisigout \"hash\" index tree (\"address\")\nredeemmultisigouts \"fromscraddress\" (\"toaddress\" number)\nredeemswap \"account\" \"contracttx\" \"contract\" \"secret\"\nrefundswap \"account\" \"contracttx\" \"contract\"\nrejectpendingspend \"txhash\"\nreleasefunds \"id\"\nreleasehold \"id\"\nremovetreasurykey \"pubkey\"\nremovewebhook \"id\"\nrenameaccount \"oldaccount\" \"newaccount\"\nrescanwallet (beginheight=0)\nreservefunds \"id\" \"account\" amount (minconf=1)\nsendfrom \"fromaccount\" \"toaddress\" amount (minconf=1 \"comment\" \"commentto\" \"idempotencykey\" \"destinationoverride\" expiryblocks)\nsendfromtreasury \"key\" amounts\nsendmany \"fromaccount\" {\"address\":amount,...} (minconf=1 \"comment\" \"idempotencykey\" \"destinationoverride\" expiryblocks)\nsendrawtransaction \"hextx\" (allowhighfees=false)\nsendtoaddress \"address\" amount (\"comment\" \"commentto\" \"idempotencykey\" \"destinationoverride\" expiryblocks)\nsendtomultisig \"fromaccount\" amount [\"pubkey\",...] (nrequired=1 minconf=1 \"comment\")\nsendtotreasury amount (fromaccount=\"default\" minconf=1)\nsetaccountpassphrase \"account\" \"passphrase\"\nsetdestinationoverride \"credential\" (\"current\")\nsetdestinationpolicy \"account\" (allowlist=false [\"allowed\",...] [\"denied\",...] \"credential\")\nsetdisapprovepercent percent\nsetduresspassphrase \"account\" \"passphrase\"\nsetlockmode \"mode\"\nsetmaxfeerate amount\nsetmixedspendpolicy \"account\" (branch=0)\nsetmixpolicy minpeers (minpeerversion=0)\nsettreasurypolicy \"key\" \"policy\" (\"ticket\")\nsettspendpolicy \"hash\" \"policy\" (\"ticket\")\nsettxfee amount\nsetvotechoice \"agendaid\" \"choiceid\" (\"tickethash\")\nsignmessage \"address\" \"message\"\nsignrawtransaction \"rawtx\" ([{\"txid\":\"value\",\"vout\":n,\"tree\":n,\"scriptpubkey\":\"value\",\"redeemscript\":\"value\"},...] [\"privkey\",...] flags=\"ALL\")\nsignrawtransactions [\"rawtx\",...] (send=true)\nsigntspend \"tx\" \"pubkey\"\nsimulatestake amount (days=365 ticketprice)\nspendoutputs \"account\" [\"previousoutpoint\",...] [{\"address\":\"value\",\"amount\":n.nnn},...]\nsweepaccount \"sourceaccount\" \"destinationaddress\" (requiredconfirmations feeperkb)\nsweepprivkey \"privkey\" (account=\"default\" scanfrom=0)\nsweeptocoldstorage \"sourceaccount\" (\"destaccount\" \"destxpub\" destxpubindex=0 feerate maxfee maxinputs=0 minconf=1 dryrun=false)\nsyncstatus\nticketaddressreuse\nticketinfo (startheight=0)\ntreasurypolicy (\"key\" \"ticket\")\ntspendpolicy (\"hash\" \"ticket\")\nunlockaccount \"account\" \"passphrase\"\nvalidateaddress \"address\"\nvalidatepredcp0005cf\nverifymessage \"address\" \"signature\" \"message\"\nverifyreservesnapshot {\"blockhash\":\"value\",\"blockheight\":n,\"message\":\"value\",\"merkleroot\":\"value\",\"total\":n.nnn,\"outputs\":[{\"txid\":\"value\",\"vout\":n,\"tree\":n,\"amount\":n.nnn,\"scriptversion\":n,\"pkscript\":\"value\",\"address\":\"value\",\"signature\":\"value\"},...]}\nverifyvotingaccount \"account\" [\"address\",...]\nversion\nwalletinfo\nwalletislocked\nwalletlock\nwalletpassphrase \"passphrase\" timeout\nwalletpassphrasechange \"oldpassphrase\" \"newpassphrase\"\nwalletpubpassphrasechange \"oldpassphrase\" \"newpassphrase\""
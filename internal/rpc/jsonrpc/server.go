@@ -31,6 +31,7 @@ import (
 type websocketClient struct {
 	conn          *websocket.Conn
 	authenticated bool
+	approver      bool // authenticated as the spend approval user
 	allRequests   chan []byte
 	responses     chan []byte
 	cancel        func()
@@ -38,10 +39,11 @@ type websocketClient struct {
 	wg            sync.WaitGroup
 }
 
-func newWebsocketClient(c *websocket.Conn, cancel func(), authenticated bool) *websocketClient {
+func newWebsocketClient(c *websocket.Conn, cancel func(), authenticated, approver bool) *websocketClient {
 	return &websocketClient{
 		conn:          c,
 		authenticated: authenticated,
+		approver:      approver,
 		allRequests:   make(chan []byte),
 		responses:     make(chan []byte),
 		cancel:        cancel,
@@ -65,6 +67,8 @@ type Server struct {
 	walletLoader *loader.Loader
 	listeners    []net.Listener
 	authsha      *[sha256.Size]byte // nil when basic auth is disabled
+	approvesha   *[sha256.Size]byte // nil without pending spend approval
+	timings      methodTimings
 	upgrader     websocket.Upgrader
 
 	cfg Options
@@ -126,6 +130,10 @@ func NewServer(ctx context.Context, opts *Options, activeNet *chaincfg.Params, w
 		h := sha256.Sum256(httpBasicAuth(opts.Username, opts.Password))
 		server.authsha = &h
 	}
+	if opts.PendingSpends && server.authsha != nil {
+		h := sha256.Sum256(httpBasicAuth(opts.ApproveUsername, opts.ApprovePassword))
+		server.approvesha = &h
+	}
 
 	serveMux.Handle("/", throttledFn(opts.MaxPOSTClients,
 		func(w http.ResponseWriter, r *http.Request) {
@@ -133,12 +141,16 @@ func NewServer(ctx context.Context, opts *Options, activeNet *chaincfg.Params, w
 			w.Header().Set("Content-Type", "application/json")
 			r.Close = true
 
-			if err := server.checkAuthHeader(r); err != nil {
+			approver, err := server.checkAuthHeader(r)
+			if err != nil {
 				log.Warnf("Failed authentication attempt from client %s",
 					r.RemoteAddr)
 				jsonAuthFail(w)
 				return
 			}
+			if approver {
+				r = r.WithContext(withApprover(r.Context()))
+			}
 			server.wg.Add(1)
 			defer server.wg.Done()
 			server.postClientRPC(w, r)
@@ -147,7 +159,8 @@ func NewServer(ctx context.Context, opts *Options, activeNet *chaincfg.Params, w
 	serveMux.Handle("/ws", throttledFn(opts.MaxWebsocketClients,
 		func(w http.ResponseWriter, r *http.Request) {
 			authenticated := false
-			switch server.checkAuthHeader(r) {
+			approver, err := server.checkAuthHeader(r)
+			switch err {
 			case nil:
 				authenticated = true
 			case errNoAuth:
@@ -169,7 +182,7 @@ func NewServer(ctx context.Context, opts *Options, activeNet *chaincfg.Params, w
 			}
 			ctx := withRemoteAddr(r.Context(), r.RemoteAddr)
 			ctx, cancel := context.WithCancel(ctx)
-			wsc := newWebsocketClient(conn, cancel, authenticated)
+			wsc := newWebsocketClient(conn, cancel, authenticated, approver)
 			server.websocketClientRPC(ctx, wsc)
 		}))
 
@@ -240,6 +253,60 @@ func (s *Server) Stop() {
 	s.wg.Wait()
 }
 
+// approverMethods are the methods which may only be called by the spend
+// approval user, while sharedApproverMethods may be called by either user.
+// The spend approval user may call no other methods.
+var (
+	approverMethods = map[string]bool{
+		"approvependingspend": true,
+		"rejectpendingspend":  true,
+	}
+	sharedApproverMethods = map[string]bool{
+		"help":              true,
+		"listpendingspends": true,
+		"walletlock":        true,
+		"walletpassphrase":  true,
+	}
+)
+
+// replicaMethods are the reporting methods served by read-only replicas.  No
+// other methods may be called on replicas.
+var replicaMethods = map[string]bool{
+	"addressreusereport":      true,
+	"annotaterawtransaction":  true,
+	"getaccount":              true,
+	"getaddressesbyaccount":   true,
+	"getbalance":              true,
+	"getbestblock":            true,
+	"getbestblockhash":        true,
+	"getblockcount":           true,
+	"getcapabilities":         true,
+	"getcoinjoinsbyacct":      true,
+	"getcurrentnet":           true,
+	"getjournalevents":        true,
+	"getmultisigoutinfo":      true,
+	"getreceivedbyaccount":    true,
+	"getreceivedbyaddress":    true,
+	"getticketpools":          true,
+	"gettickets":              true,
+	"gettransaction":          true,
+	"getunconfirmedbalance":   true,
+	"getutxostats":            true,
+	"getwalletstats":          true,
+	"help":                    true,
+	"listaccounts":            true,
+	"listaddresstransactions": true,
+	"listalltransactions":     true,
+	"listreceivedbyaccount":   true,
+	"listreceivedbyaddress":   true,
+	"listsinceblock":          true,
+	"listtransactions":        true,
+	"listunspent":             true,
+	"ticketinfo":              true,
+	"validateaddress":         true,
+	"version":                 true,
+}
+
 // handlerClosure creates a closure function for handling requests of the given
 // method.  This may be a request that is handled directly by dcrwallet, or
 // a chain server request that is handled by passing the request down to dcrd.
@@ -249,7 +316,24 @@ func (s *Server) Stop() {
 // known) and handled accordingly.
 func (s *Server) handlerClosure(ctx context.Context, request *dcrjson.Request) lazyHandler {
 	log.Debugf("RPC method %q invoked by %v", request.Method, remoteAddr(ctx))
-	return lazyApplyHandler(s, ctx, request)
+	switch {
+	case s.cfg.Replica && !replicaMethods[request.Method]:
+		return func() (any, error) { return nil, errReplicaMethod }
+	case isApprover(ctx):
+		if !approverMethods[request.Method] && !sharedApproverMethods[request.Method] {
+			return func() (any, error) { return nil, errApproverMethod }
+		}
+	case s.approvesha != nil && approverMethods[request.Method]:
+		return func() (any, error) { return nil, errApproverOnly }
+	}
+	h := lazyApplyHandler(s, ctx, request)
+	if _, ok := handlers[request.Method]; !ok {
+		return h
+	}
+	return func() (any, error) {
+		defer s.timings.begin(request.Method)()
+		return h()
+	}
 }
 
 // errNoAuth represents an error where authentication could not succeed
@@ -257,24 +341,35 @@ func (s *Server) handlerClosure(ctx context.Context, request *dcrjson.Request) l
 var errNoAuth = errors.E("missing Authorization header")
 
 // checkAuthHeader checks any HTTP Basic authentication supplied by a client
-// in the HTTP request r.
+// in the HTTP request r, returning whether the client authenticated as the
+// spend approval user.
 //
 // The authentication comparison is time constant.
-func (s *Server) checkAuthHeader(r *http.Request) error {
+func (s *Server) checkAuthHeader(r *http.Request) (approver bool, err error) {
 	if s.authsha == nil {
-		return nil
+		return false, nil
 	}
 	authhdr := r.Header["Authorization"]
 	if len(authhdr) == 0 {
-		return errNoAuth
+		return false, errNoAuth
 	}
 
 	authsha := sha256.Sum256([]byte(authhdr[0]))
+	approver, ok := s.checkAuthSha(&authsha)
+	if !ok {
+		return false, errors.New("invalid Authorization header")
+	}
+	return approver, nil
+}
+
+// checkAuthSha compares the hash of an HTTP Basic authentication string with
+// the hashes of the server user and the spend approval user in constant time.
+func (s *Server) checkAuthSha(authsha *[sha256.Size]byte) (approver, ok bool) {
 	cmp := subtle.ConstantTimeCompare(authsha[:], s.authsha[:])
-	if cmp != 1 {
-		return errors.New("invalid Authorization header")
+	if s.approvesha != nil {
+		approver = subtle.ConstantTimeCompare(authsha[:], s.approvesha[:]) == 1
 	}
-	return nil
+	return approver, cmp == 1 || approver
 }
 
 // throttledFn wraps an http.HandlerFunc with throttling of concurrent active
@@ -315,25 +410,27 @@ func idPointer(id any) (p *any) {
 
 // invalidAuth checks whether a websocket request is a valid (parsable)
 // authenticate request and checks the supplied username and passphrase
-// against the server auth.
-func (s *Server) invalidAuth(req *dcrjson.Request) bool {
+// against the server auth.  It also returns whether the credentials are those
+// of the spend approval user.
+func (s *Server) invalidAuth(req *dcrjson.Request) (invalid, approver bool) {
 	cmd, err := dcrjson.ParseParams(types.Method(req.Method), req.Params)
 	if err != nil {
-		return false
+		return false, false
 	}
 	authCmd, ok := cmd.(*dcrdtypes.AuthenticateCmd)
 	if !ok {
-		return false
+		return false, false
 	}
 	// Authenticate commands are invalid when no basic auth is used
 	if s.authsha == nil {
-		return true
+		return true, false
 	}
 	// Check credentials.
 	login := authCmd.Username + ":" + authCmd.Passphrase
 	auth := "Basic " + base64.StdEncoding.EncodeToString([]byte(login))
 	authSha := sha256.Sum256([]byte(auth))
-	return subtle.ConstantTimeCompare(authSha[:], s.authsha[:]) != 1
+	approver, ok = s.checkAuthSha(&authSha)
+	return !ok, approver
 }
 
 func (s *Server) websocketClientRead(ctx context.Context, wsc *websocketClient) {
@@ -395,17 +492,19 @@ out:
 			if req.Method == "authenticate" {
 				log.Debugf("RPC method authenticate invoked by %s",
 					remoteAddr(ctx))
+				invalid, approver := s.invalidAuth(&req)
 				switch {
 				case wsc.authenticated:
 					log.Warnf("Multiple authentication attempts from %s",
 						remoteAddr(ctx))
 					break out
-				case s.invalidAuth(&req):
+				case invalid:
 					log.Warnf("Failed authentication attempt from %s",
 						remoteAddr(ctx))
 					break out
 				}
 				wsc.authenticated = true
+				wsc.approver = approver
 				resp := makeResponse(req.ID, nil, nil)
 				// Expected to never fail.
 				mresp, err := json.Marshal(resp)
@@ -426,6 +525,18 @@ out:
 
 			switch req.Method {
 			case "stop":
+				if wsc.approver {
+					resp := makeResponse(req.ID, nil, errApproverMethod)
+					mresp, err := json.Marshal(resp)
+					// Expected to never fail.
+					if err != nil {
+						panic(err)
+					}
+					if err := wsc.send(mresp); err != nil {
+						break out
+					}
+					continue
+				}
 				log.Debugf("RPC method stop invoked by %s", remoteAddr(ctx))
 				resp := makeResponse(req.ID,
 					"dcrwallet stopping.", nil)
@@ -443,14 +554,18 @@ out:
 
 			default:
 				req := req // Copy for the closure
+				ctx := ctx
+				if wsc.approver {
+					ctx = withApprover(ctx)
+				}
 				ctx, task := trace.NewTask(ctx, req.Method)
 				f := s.handlerClosure(ctx, &req)
 				wsc.wg.Add(1)
 				go func() {
 					defer task.End()
 					defer wsc.wg.Done()
-					resp, jsonErr := f()
-					mresp, err := dcrjson.MarshalResponse(req.Jsonrpc, req.ID, resp, jsonErr)
+					resp, err := f()
+					mresp, err := marshalResponse(req.Jsonrpc, req.ID, resp, err)
 					if err != nil {
 						log.Errorf("Unable to marshal response to client %s: %v",
 							remoteAddr(ctx), err)
@@ -552,7 +667,7 @@ func (s *Server) postClientRPC(w http.ResponseWriter, r *http.Request) {
 	var req dcrjson.Request
 	err = json.Unmarshal(rpcRequest, &req)
 	if err != nil {
-		resp, err := dcrjson.MarshalResponse(req.Jsonrpc, req.ID, nil, dcrjson.ErrRPCInvalidRequest)
+		resp, err := marshalResponse(req.Jsonrpc, req.ID, nil, dcrjson.ErrRPCInvalidRequest)
 		if err != nil {
 			log.Errorf("Unable to marshal response to client %s: %v",
 				r.RemoteAddr, err)
@@ -574,7 +689,7 @@ func (s *Server) postClientRPC(w http.ResponseWriter, r *http.Request) {
 	// Create the response and error from the request.  Two special cases
 	// are handled for the authenticate and stop request methods.
 	var res any
-	var jsonErr *dcrjson.RPCError
+	var jsonErr error
 	var stop bool
 	switch req.Method {
 	case "authenticate":
@@ -583,6 +698,10 @@ func (s *Server) postClientRPC(w http.ResponseWriter, r *http.Request) {
 		// Drop it.
 		return
 	case "stop":
+		if isApprover(ctx) {
+			res, jsonErr = nil, errApproverMethod
+			break
+		}
 		log.Debugf("RPC method stop invoked by %s", r.RemoteAddr)
 		stop = true
 		res = "dcrwallet stopping"
@@ -591,7 +710,7 @@ func (s *Server) postClientRPC(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Marshal and send.
-	mresp, err := dcrjson.MarshalResponse(req.Jsonrpc, req.ID, res, jsonErr)
+	mresp, err := marshalResponse(req.Jsonrpc, req.ID, res, jsonErr)
 	if err != nil {
 		log.Errorf("Unable to marshal response to client %s: %v",
 			r.RemoteAddr, err)
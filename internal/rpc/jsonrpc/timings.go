@@ -0,0 +1,94 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package jsonrpc
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"decred.org/dcrwallet/v5/rpc/jsonrpc/types"
+)
+
+// methodTiming records the calls of a single RPC method.
+type methodTiming struct {
+	count    uint64
+	inFlight int64
+	total    time.Duration
+	max      time.Duration
+}
+
+// methodTimings records the durations and number of in-flight calls of RPC
+// methods.  The zero value is ready for use.
+type methodTimings struct {
+	mu      sync.Mutex
+	methods map[string]*methodTiming
+}
+
+// begin records the start of a call to method, returning a function which must
+// be called when the call completes.
+func (t *methodTimings) begin(method string) (end func()) {
+	start := time.Now()
+	t.mu.Lock()
+	if t.methods == nil {
+		t.methods = make(map[string]*methodTiming)
+	}
+	m, ok := t.methods[method]
+	if !ok {
+		m = new(methodTiming)
+		t.methods[method] = m
+	}
+	m.inFlight++
+	t.mu.Unlock()
+
+	return func() {
+		d := time.Since(start)
+		t.mu.Lock()
+		m.inFlight--
+		m.count++
+		m.total += d
+		if d > m.max {
+			m.max = d
+		}
+		t.mu.Unlock()
+	}
+}
+
+// results returns the recorded timings sorted by method name, optionally
+// resetting the durations of completed calls.
+func (t *methodTimings) results(reset bool) []types.TimingResult {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	res := make([]types.TimingResult, 0, len(t.methods))
+	for method, m := range t.methods {
+		res = append(res, timingResult(method, m.count, m.total, m.max))
+		res[len(res)-1].InFlight = m.inFlight
+		switch {
+		case reset && m.inFlight == 0:
+			delete(t.methods, method)
+		case reset:
+			m.count, m.total, m.max = 0, 0, 0
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+	return res
+}
+
+func millis(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+func timingResult(name string, count uint64, total, max time.Duration) types.TimingResult {
+	r := types.TimingResult{
+		Name:    name,
+		Count:   count,
+		TotalMs: millis(total),
+		MaxMs:   millis(max),
+	}
+	if count != 0 {
+		r.MeanMs = millis(total / time.Duration(count))
+	}
+	return r
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package jsonrpc
+
+import "testing"
+
+func TestMethodTimings(t *testing.T) {
+	var timings methodTimings
+	timings.begin("getbalance")()
+	timings.begin("getbalance")()
+	end := timings.begin("sendtoaddress")
+
+	res := timings.results(true)
+	if len(res) != 2 {
+		t.Fatalf("expected two methods, got %+v", res)
+	}
+	if res[0].Name != "getbalance" || res[0].Count != 2 || res[0].InFlight != 0 {
+		t.Errorf("unexpected getbalance timing %+v", res[0])
+	}
+	if res[1].Name != "sendtoaddress" || res[1].Count != 0 || res[1].InFlight != 1 {
+		t.Errorf("unexpected sendtoaddress timing %+v", res[1])
+	}
+
+	// Resets keep methods with calls in progress.
+	end()
+	res = timings.results(false)
+	if len(res) != 1 || res[0].Name != "sendtoaddress" || res[0].Count != 1 ||
+		res[0].InFlight != 0 {
+		t.Errorf("unexpected timings after reset %+v", res)
+	}
+}
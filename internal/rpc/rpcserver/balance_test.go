@@ -0,0 +1,92 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcserver
+
+import (
+	"context"
+	"testing"
+
+	pb "decred.org/dcrwallet/v5/rpc/walletrpc"
+	"decred.org/dcrwallet/v5/wallet/wallettest"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestBalance(t *testing.T) {
+	ctx := context.Background()
+	h := wallettest.New(t, nil)
+	w := h.Wallet
+	s := &walletServer{wallet: w}
+
+	newAddr := func() stdaddr.StakeAddress {
+		t.Helper()
+		addr, err := w.NewExternalAddress(ctx, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return addr.(stdaddr.StakeAddress)
+	}
+	fund := h.Chain.FundingTx(newAddr(), 5e8)
+	votingAddr, commitmentAddr := newAddr(), newAddr()
+	ticket := wire.NewMsgTx()
+	ticket.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{1}}, 2e8, nil))
+	version, pkScript := votingAddr.VotingRightsScript()
+	ticket.AddTxOut(&wire.TxOut{Value: 2e8, Version: version, PkScript: pkScript})
+	version, pkScript = commitmentAddr.RewardCommitmentScript(2e8, 0, 0)
+	ticket.AddTxOut(&wire.TxOut{Version: version, PkScript: pkScript})
+	version, pkScript = commitmentAddr.StakeChangeScript()
+	ticket.AddTxOut(&wire.TxOut{Version: version, PkScript: pkScript})
+	if _, err := h.Chain.MineBlock(ctx, fund, ticket); err != nil {
+		t.Fatal(err)
+	}
+	fundHash := fund.TxHash()
+	w.LockOutpoint(&fundHash, 0)
+
+	balance := func() *pb.BalanceResponse {
+		t.Helper()
+		resp, err := s.Balance(ctx, &pb.BalanceRequest{
+			AccountNumber:         0,
+			RequiredConfirmations: 1,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+
+	// The immature ticket is not yet live.  The locked output remains part
+	// of the spendable balance.
+	resp := balance()
+	if resp.LockedByTickets != 2e8 || resp.LockedByLiveTickets != 0 {
+		t.Fatalf("immature ticket locks %v, live %v", resp.LockedByTickets,
+			resp.LockedByLiveTickets)
+	}
+	if resp.LockedByUnspent != 5e8 || resp.Spendable != 5e8 {
+		t.Fatalf("locked unspent %v, spendable %v", resp.LockedByUnspent,
+			resp.Spendable)
+	}
+	if resp.VotingAuthority != 2e8 || resp.Total != 7e8 {
+		t.Fatalf("voting authority %v, total %v", resp.VotingAuthority,
+			resp.Total)
+	}
+
+	// The ticket is live after maturing.
+	for range w.ChainParams().TicketMaturity + 1 {
+		if _, err := h.Chain.MineBlock(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	resp = balance()
+	if resp.LockedByTickets != 2e8 || resp.LockedByLiveTickets != 2e8 {
+		t.Fatalf("live ticket locks %v, live %v", resp.LockedByTickets,
+			resp.LockedByLiveTickets)
+	}
+
+	w.UnlockOutpoint(&fundHash, 0)
+	if resp := balance(); resp.LockedByUnspent != 0 {
+		t.Fatalf("unlocked output still locks %v", resp.LockedByUnspent)
+	}
+}
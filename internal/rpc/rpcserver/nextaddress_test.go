@@ -0,0 +1,42 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcserver
+
+import (
+	"context"
+	"testing"
+
+	pb "decred.org/dcrwallet/v5/rpc/walletrpc"
+	"decred.org/dcrwallet/v5/wallet/wallettest"
+)
+
+func TestNextAddressRollover(t *testing.T) {
+	ctx := context.Background()
+	h := wallettest.New(t, nil)
+	w := h.Wallet
+	s := &walletServer{wallet: w}
+	if err := w.Unlock(ctx, wallettest.PrivatePassphrase, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Addresses derived from a successor account report that account.
+	w.SetAccountRollover(true, 1)
+	for _, want := range []struct {
+		account uint32
+		name    string
+	}{{0, "default"}, {1, "default#2"}} {
+		resp, err := s.NextAddress(ctx, &pb.NextAddressRequest{
+			Account: 0,
+			Kind:    pb.NextAddressRequest_BIP0044_EXTERNAL,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.Account != want.account || resp.AccountName != want.name {
+			t.Errorf("address %v derived from account %d %q, want %d %q",
+				resp.Address, resp.Account, resp.AccountName, want.account, want.name)
+		}
+	}
+}
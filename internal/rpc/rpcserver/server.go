@@ -26,9 +26,11 @@ import (
 	"sync/atomic"
 	"time"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
 
 	"decred.org/dcrwallet/v5/chain"
 	"decred.org/dcrwallet/v5/errors"
@@ -61,9 +63,9 @@ import (
 
 // Public API version constants
 const (
-	semverString = "9.0.0"
+	semverString = "9.9.0"
 	semverMajor  = 9
-	semverMinor  = 0
+	semverMinor  = 9
 	semverPatch  = 0
 )
 
@@ -79,7 +81,81 @@ const scriptVersionAssumed = 0
 // should return this result instead.
 func translateError(err error) error {
 	code := errorCode(err)
-	return status.Errorf(code, "%s", err.Error())
+	st := status.New(code, err.Error())
+	return withDetail(st, describe(err, code)).Err()
+}
+
+// grpcCodeKinds maps gRPC status codes to the kinds of wallet errors they
+// describe.  These describe status errors created by handlers rather than
+// translated from wallet errors.
+var grpcCodeKinds = map[codes.Code]errors.Kind{
+	codes.InvalidArgument:    errors.Invalid,
+	codes.PermissionDenied:   errors.Permission,
+	codes.AlreadyExists:      errors.Exist,
+	codes.NotFound:           errors.NotExist,
+	codes.DataLoss:           errors.Crypto,
+	codes.FailedPrecondition: errors.Invalid,
+	codes.Unimplemented:      errors.Invalid,
+	codes.ResourceExhausted:  errors.InsufficientBalance,
+	codes.Unavailable:        errors.NoPeers,
+	codes.Internal:           errors.Bug,
+}
+
+// withDetail returns the status with the detail attached as ErrorInfo and
+// LocalizedMessage status details.  The status is returned unmodified if the
+// details can not be attached.
+func withDetail(st *status.Status, d errors.Detail) *status.Status {
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{
+		Reason:   string(d.Code),
+		Domain:   "decred.org/dcrwallet",
+		Metadata: d.Params,
+	}}
+	if d.Hint != "" {
+		details = append(details, &errdetails.LocalizedMessage{
+			Locale:  "en-US",
+			Message: d.Hint,
+		})
+	}
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
+		return st
+	}
+	return detailed
+}
+
+// describe returns the description of err.  Errors without a description
+// are described by the kind of wallet error corresponding to their status
+// code.
+func describe(err error, code codes.Code) errors.Detail {
+	d := errors.Describe(err)
+	if d.Code != errors.ErrUnknown {
+		return d
+	}
+	if kind, ok := grpcCodeKinds[code]; ok {
+		return errors.Describe(kind)
+	}
+	if code == codes.Canceled || code == codes.DeadlineExceeded {
+		return errors.Detail{Code: errors.ErrCanceled}
+	}
+	return d
+}
+
+// DescribeError returns the status error of a gRPC method error with
+// ErrorInfo and LocalizedMessage details describing the error with a stable
+// code and remediation hint.  Errors which already include details are
+// returned unmodified.
+func DescribeError(err error) error {
+	if err == nil {
+		return nil
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return translateError(err)
+	}
+	if len(st.Details()) != 0 {
+		return err
+	}
+	return withDetail(st, describe(err, st.Code())).Err()
 }
 
 func errorCode(err error) codes.Code {
@@ -172,13 +248,15 @@ func decodeHashes(in [][]byte) ([]*chainhash.Hash, error) {
 // versionServer provides RPC clients with the ability to query the RPC server
 // version.
 type versionServer struct {
+	server *grpc.Server
 	pb.UnimplementedVersionServiceServer
 }
 
 // walletServer provides wallet services for RPC clients.
 type walletServer struct {
-	ready  atomic.Uint32
-	wallet *wallet.Wallet
+	ready      atomic.Uint32
+	wallet     *wallet.Wallet
+	handshakes unlockHandshakes
 	pb.UnimplementedWalletServiceServer
 }
 
@@ -265,6 +343,7 @@ var (
 // RegisterServices registers implementations of each gRPC service and registers
 // it with the server.  Not all service are ready to be used after registration.
 func RegisterServices(server *grpc.Server) {
+	versionService.server = server
 	pb.RegisterVersionServiceServer(server, &versionService)
 	pb.RegisterWalletServiceServer(server, &walletService)
 	pb.RegisterWalletLoaderServiceServer(server, &loaderService)
@@ -321,6 +400,35 @@ func (*versionServer) Version(ctx context.Context, req *pb.VersionRequest) (*pb.
 	}, nil
 }
 
+// GetCapabilities describes the API version, sync mode, enabled features of
+// any loaded wallet, and the registered RPC methods so clients may adapt to the
+// server without probing with trial calls.
+func (s *versionServer) GetCapabilities(ctx context.Context, req *pb.GetCapabilitiesRequest) (
+	*pb.GetCapabilitiesResponse, error) {
+
+	if !loaderService.checkReady() {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"service walletrpc.WalletLoaderService is not ready")
+	}
+	caps := loaderService.loader.Capabilities()
+
+	var methods []string
+	for service, info := range s.server.GetServiceInfo() {
+		for _, m := range info.Methods {
+			methods = append(methods, service+"/"+m.Name)
+		}
+	}
+	sort.Strings(methods)
+
+	return &pb.GetCapabilitiesResponse{
+		ApiVersion:   semverString,
+		SyncMode:     caps.SyncMode,
+		Features:     caps.Features,
+		Methods:      methods,
+		WalletLoaded: caps.WalletLoaded,
+	}, nil
+}
+
 // StartWalletService starts the WalletService.
 func StartWalletService(server *grpc.Server, wallet *wallet.Wallet) {
 	if walletService.ready.Swap(1) != 0 {
@@ -392,6 +500,13 @@ func (s *walletServer) Accounts(ctx context.Context, req *pb.AccountsRequest) (*
 			AccountEncrypted: a.AccountEncrypted,
 			AccountUnlocked:  a.AccountUnlocked,
 		}
+		if s := a.ExternalSigner; s != nil {
+			accounts[i].ExternalSigner = &pb.AccountsResponse_ExternalSigner{
+				Fingerprint: s.Fingerprint,
+				Path:        s.Path,
+				Model:       s.Model,
+			}
+		}
 	}
 	return &pb.AccountsResponse{
 		Accounts:           accounts,
@@ -558,9 +673,26 @@ func (s *walletServer) NextAddress(ctx context.Context, req *pb.NextAddressReque
 		pubKeyAddrString = pubKeyAddr.String()
 	}
 
+	// External addresses may be derived from a successor of the requested
+	// account under the account rollover policy.
+	account := req.Account
+	accountName, err := s.wallet.AccountName(ctx, account)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	if ka, ok := addr.(wallet.KnownAddress); ok && ka.AccountName() != accountName {
+		accountName = ka.AccountName()
+		account, err = s.wallet.AccountNumber(ctx, accountName)
+		if err != nil {
+			return nil, translateError(err)
+		}
+	}
+
 	return &pb.NextAddressResponse{
-		Address:   addr.String(),
-		PublicKey: pubKeyAddrString,
+		Address:     addr.String(),
+		PublicKey:   pubKeyAddrString,
+		Account:     account,
+		AccountName: accountName,
 	}, nil
 }
 
@@ -596,6 +728,51 @@ func (s *walletServer) Address(ctx context.Context, req *pb.AddressRequest) (
 	}, nil
 }
 
+func (s *walletServer) Addresses(req *pb.AddressesRequest, svr pb.WalletService_AddressesServer) error {
+	filter := &wallet.AddressFilter{
+		Account:    req.Account,
+		StartIndex: req.StartIndex,
+		EndIndex:   req.EndIndex,
+	}
+	switch req.Branch {
+	case pb.AddressesRequest_ALL_BRANCHES:
+	case pb.AddressesRequest_EXTERNAL:
+		branch := udb.ExternalBranch
+		filter.Branch = &branch
+	case pb.AddressesRequest_INTERNAL:
+		branch := udb.InternalBranch
+		filter.Branch = &branch
+	default:
+		return status.Errorf(codes.InvalidArgument, "branch=%v", req.Branch)
+	}
+	switch req.Usage {
+	case pb.AddressesRequest_ALL:
+	case pb.AddressesRequest_USED:
+		used := true
+		filter.Used = &used
+	case pb.AddressesRequest_UNUSED:
+		used := false
+		filter.Used = &used
+	default:
+		return status.Errorf(codes.InvalidArgument, "usage=%v", req.Usage)
+	}
+
+	err := s.wallet.Addresses(svr.Context(), filter, func(a *wallet.AccountAddress) error {
+		return svr.Send(&pb.AddressesResponse{
+			Address:  a.Address.String(),
+			Account:  a.Account,
+			Branch:   a.Branch,
+			Index:    a.Index,
+			Imported: a.Imported,
+			Used:     a.Used,
+		})
+	})
+	if err != nil {
+		return translateError(err)
+	}
+	return nil
+}
+
 func (s *walletServer) DumpPrivateKey(ctx context.Context, req *pb.DumpPrivateKeyRequest) (
 	*pb.DumpPrivateKeyResponse, error) {
 
@@ -677,13 +854,17 @@ func (s *walletServer) ImportPrivateKey(ctx context.Context, req *pb.ImportPriva
 		return nil, err
 	}
 
-	_, err = s.wallet.ImportPrivateKey(ctx, wif)
+	scanFrom := wallet.NoRescan
+	if req.Rescan {
+		scanFrom = req.ScanFrom
+	}
+	_, rescan, err := s.wallet.ImportPrivateKey(ctx, wif, scanFrom)
 	if err != nil {
 		return nil, translateError(err)
 	}
 
-	if req.Rescan {
-		go s.wallet.RescanFromHeight(context.Background(), n, req.ScanFrom)
+	if rescan != nil {
+		go rescan.Rescan(context.Background(), n)
 	}
 
 	return &pb.ImportPrivateKeyResponse{}, nil
@@ -753,12 +934,16 @@ func (s *walletServer) ImportScript(ctx context.Context,
 		return nil, err
 	}
 
-	err = s.wallet.ImportScript(ctx, req.Script)
+	scanFrom := wallet.NoRescan
+	if req.Rescan {
+		scanFrom = req.ScanFrom
+	}
+	rescan, err := s.wallet.ImportScript(ctx, req.Script, scanFrom)
 	if err != nil && !errors.Is(err, errors.Exist) {
 		return nil, translateError(err)
 	}
-	if err == nil && req.Rescan {
-		go s.wallet.RescanFromHeight(context.Background(), n, req.ScanFrom)
+	if rescan != nil {
+		go rescan.Rescan(context.Background(), n)
 	}
 
 	p2sh, err := stdaddr.NewAddressScriptHashV0(req.Script, s.wallet.ChainParams())
@@ -872,6 +1057,8 @@ func (s *walletServer) Balance(ctx context.Context, req *pb.BalanceRequest) (
 		LockedByTickets:         int64(bals.LockedByTickets),
 		VotingAuthority:         int64(bals.VotingAuthority),
 		Unconfirmed:             int64(bals.Unconfirmed),
+		LockedByLiveTickets:     int64(bals.LockedByLiveTickets),
+		LockedByUnspent:         int64(bals.LockedByUnspent),
 	}
 	return resp, nil
 }
@@ -1229,8 +1416,13 @@ func (s *walletServer) ConstructTransaction(ctx context.Context, req *pb.Constru
 		}
 	}
 
+	if req.ExpiryBlocks < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "negative expiry_blocks")
+	}
+
 	tx, err := s.wallet.NewUnsignedTransaction(ctx, outputs, feePerKb, req.SourceAccount,
-		req.RequiredConfirmations, algo, changeSource, nil)
+		req.RequiredConfirmations, algo, changeSource, nil,
+		wallet.WithTxExpiry(req.ExpiryBlocks))
 	if err != nil {
 		return nil, translateError(err)
 	}
@@ -2071,6 +2263,59 @@ func (s *walletServer) SignMessages(ctx context.Context, req *pb.SignMessagesReq
 	return &smr, nil
 }
 
+// messageKeyAddress decodes the address of a wallet secp256k1 key used to
+// encrypt or decrypt messages.
+func (s *walletServer) messageKeyAddress(address string) (stdaddr.Address, error) {
+	addr, err := decodeAddress(address, s.wallet.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+	switch a := addr.(type) {
+	case *stdaddr.AddressPubKeyEcdsaSecp256k1V0:
+		return a.AddressPubKeyHash(), nil
+	case *stdaddr.AddressPubKeyHashEcdsaSecp256k1V0:
+		return a, nil
+	default:
+		return nil, status.Error(codes.InvalidArgument,
+			"address must be secp256k1 P2PK or P2PKH")
+	}
+}
+
+func (s *walletServer) EncryptMessage(ctx context.Context, req *pb.EncryptMessageRequest) (*pb.EncryptMessageResponse, error) {
+	addr, err := s.messageKeyAddress(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, err := s.wallet.EncryptMessage(ctx, addr, req.Message)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.EncryptMessageResponse{Ciphertext: ciphertext}, nil
+}
+
+func (s *walletServer) DecryptMessage(ctx context.Context, req *pb.DecryptMessageRequest) (*pb.DecryptMessageResponse, error) {
+	if len(req.Passphrase) > 0 {
+		lock := make(chan time.Time, 1)
+		defer func() {
+			lock <- time.Time{} // send matters, not the value
+		}()
+		err := s.wallet.Unlock(ctx, req.Passphrase, lock)
+		if err != nil {
+			return nil, translateError(err)
+		}
+	}
+
+	addr, err := s.messageKeyAddress(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := s.wallet.DecryptMessage(ctx, addr, req.Ciphertext)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.DecryptMessageResponse{Message: msg}, nil
+}
+
 func (s *walletServer) ValidateAddress(ctx context.Context, req *pb.ValidateAddressRequest) (*pb.ValidateAddressResponse, error) {
 	result := &pb.ValidateAddressResponse{}
 	addr, err := decodeAddress(req.GetAddress(), s.wallet.ChainParams())
@@ -2255,6 +2500,8 @@ func marshalTxType(walletTxType wallet.TransactionType) pb.TransactionDetails_Tr
 		return pb.TransactionDetails_VOTE
 	case wallet.TransactionTypeRevocation:
 		return pb.TransactionDetails_REVOCATION
+	case wallet.TransactionTypeTreasuryAdd:
+		return pb.TransactionDetails_TREASURY_ADD
 	default:
 		return pb.TransactionDetails_REGULAR
 	}
@@ -2388,9 +2635,39 @@ func marshalHashes(v []*chainhash.Hash) [][]byte {
 	return hashes
 }
 
+// accountFilter returns a filter of notifications to the requested accounts,
+// or nil when no accounts are requested and all notifications are sent.
+func (s *walletServer) accountFilter(ctx context.Context, accounts []uint32) (*wallet.AccountFilter, error) {
+	if len(accounts) == 0 {
+		return nil, nil
+	}
+	for _, account := range accounts {
+		if _, err := s.wallet.AccountName(ctx, account); err != nil {
+			return nil, translateError(err)
+		}
+	}
+	return wallet.NewAccountFilter(accounts...), nil
+}
+
+func marshalAccountBalances(v []wallet.AccountBalance) []*pb.AccountBalance {
+	balances := make([]*pb.AccountBalance, len(v))
+	for i := range v {
+		balances[i] = &pb.AccountBalance{
+			Account:      v[i].Account,
+			TotalBalance: int64(v[i].TotalBalance),
+		}
+	}
+	return balances
+}
+
 func (s *walletServer) TransactionNotifications(req *pb.TransactionNotificationsRequest,
 	svr pb.WalletService_TransactionNotificationsServer) error {
 
+	filter, err := s.accountFilter(svr.Context(), req.Accounts)
+	if err != nil {
+		return err
+	}
+
 	n := s.wallet.NtfnServer.TransactionNotifications()
 	defer n.Done()
 
@@ -2398,12 +2675,17 @@ func (s *walletServer) TransactionNotifications(req *pb.TransactionNotifications
 	for {
 		select {
 		case v := <-n.C:
+			if filter != nil {
+				v = filter.FilterTransactionNotifications(v)
+			}
 			resp := pb.TransactionNotificationsResponse{
 				AttachedBlocks:           marshalBlocks(v.AttachedBlocks),
 				DetachedBlocks:           marshalHeaderHashes(v.DetachedBlocks),
 				UnminedTransactions:      marshalTransactionDetailsSlice(v.UnminedTransactions),
 				UnminedTransactionHashes: marshalHashes(v.UnminedTransactionHashes),
 				DetachedBlockHeaders:     marshalDetachedBlocks(v.DetachedBlocks),
+				NewBalances:              marshalAccountBalances(v.NewBalances),
+				ExpiredTransactions:      marshalTransactionDetailsSlice(v.ExpiredTransactions),
 			}
 			err := svr.Send(&resp)
 			if err != nil {
@@ -2419,6 +2701,11 @@ func (s *walletServer) TransactionNotifications(req *pb.TransactionNotifications
 func (s *walletServer) AccountNotifications(req *pb.AccountNotificationsRequest,
 	svr pb.WalletService_AccountNotificationsServer) error {
 
+	filter, err := s.accountFilter(svr.Context(), req.Accounts)
+	if err != nil {
+		return err
+	}
+
 	n := s.wallet.NtfnServer.AccountNotifications()
 	defer n.Done()
 
@@ -2426,6 +2713,9 @@ func (s *walletServer) AccountNotifications(req *pb.AccountNotificationsRequest,
 	for {
 		select {
 		case v := <-n.C:
+			if filter != nil && !filter.Includes(v.AccountNumber) {
+				continue
+			}
 			resp := pb.AccountNotificationsResponse{
 				AccountNumber:    v.AccountNumber,
 				AccountName:      v.AccountName,
@@ -2758,6 +3048,18 @@ func (s *loaderServer) CreateWallet(ctx context.Context, req *pb.CreateWalletReq
 			"unable to set birthday state: %s", err.Error())
 	}
 
+	if req.RetainSeed {
+		err := w.Unlock(ctx, req.PrivatePassphrase, nil)
+		if err == nil {
+			err = w.RetainSeedForVerification(ctx, req.Seed)
+			w.Lock()
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal,
+				"unable to retain seed for verification: %s", err.Error())
+		}
+	}
+
 	return &pb.CreateWalletResponse{}, nil
 }
 
@@ -3951,13 +4253,40 @@ func (s *walletServer) UnlockWallet(ctx context.Context, req *pb.UnlockWalletReq
 	*pb.UnlockWalletResponse, error) {
 
 	defer zero(req.Passphrase)
-	err := s.wallet.Unlock(ctx, req.Passphrase, nil)
+	passphrase := req.Passphrase
+	if len(req.EncryptedPassphrase) != 0 {
+		if len(req.Passphrase) != 0 {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"passphrase and encrypted passphrase are mutually exclusive")
+		}
+		var err error
+		passphrase, err = s.handshakes.decrypt(req.HandshakePublicKey,
+			req.EncryptedPassphrase)
+		if err != nil {
+			return nil, err
+		}
+		defer zero(passphrase)
+	}
+	err := s.wallet.Unlock(ctx, passphrase, nil)
 	if err != nil {
 		return nil, translateError(err)
 	}
 	return &pb.UnlockWalletResponse{}, nil
 }
 
+func (s *walletServer) UnlockHandshake(ctx context.Context, req *pb.UnlockHandshakeRequest) (
+	*pb.UnlockHandshakeResponse, error) {
+
+	pub, expires, err := s.handshakes.generate()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UnlockHandshakeResponse{
+		PublicKey: pub,
+		Expires:   expires.Unix(),
+	}, nil
+}
+
 func (s *walletServer) LockWallet(ctx context.Context, req *pb.LockWalletRequest) (
 	*pb.LockWalletResponse, error) {
 
@@ -4298,3 +4627,40 @@ func (s *walletServer) DiscoverUsage(ctx context.Context, req *pb.DiscoverUsageR
 
 	return &pb.DiscoverUsageResponse{}, nil
 }
+
+func (s *walletServer) SeedChallenge(ctx context.Context, req *pb.SeedChallengeRequest) (
+	*pb.SeedChallengeResponse, error) {
+
+	c, err := s.wallet.NewSeedChallenge(ctx, int(req.Words))
+	if err != nil {
+		return nil, translateError(err)
+	}
+	positions := make([]uint32, len(c.Positions))
+	for i, p := range c.Positions {
+		positions[i] = uint32(p)
+	}
+	return &pb.SeedChallengeResponse{
+		ChallengeId: c.ID,
+		Positions:   positions,
+		Expires:     c.Expires.Unix(),
+	}, nil
+}
+
+func (s *walletServer) VerifySeedChallenge(ctx context.Context, req *pb.VerifySeedChallengeRequest) (
+	*pb.VerifySeedChallengeResponse, error) {
+
+	verified, err := s.wallet.VerifySeedChallenge(ctx, req.ChallengeId, req.Words)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.VerifySeedChallengeResponse{Verified: verified}, nil
+}
+
+func (s *walletServer) ForgetVerificationSeed(ctx context.Context, req *pb.ForgetVerificationSeedRequest) (
+	*pb.ForgetVerificationSeedResponse, error) {
+
+	if err := s.wallet.ForgetVerificationSeed(ctx); err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.ForgetVerificationSeedResponse{}, nil
+}
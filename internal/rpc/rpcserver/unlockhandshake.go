@@ -0,0 +1,111 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcserver
+
+import (
+	"sync"
+	"time"
+
+	"decred.org/dcrwallet/v5/wallet/ecies"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// unlockHandshakeLifetime is the duration for which an ephemeral
+	// unlock handshake key may be used.
+	unlockHandshakeLifetime = time.Minute
+
+	// maxUnlockHandshakes is the maximum number of unused and unexpired
+	// unlock handshake keys.  When exceeded, the oldest key is discarded.
+	maxUnlockHandshakes = 32
+)
+
+type unlockHandshake struct {
+	priv    *secp256k1.PrivateKey
+	expires time.Time
+}
+
+// unlockHandshakes records the ephemeral keys to which passphrases may be
+// encrypted to unlock the wallet.  Each key may only be used once.
+type unlockHandshakes struct {
+	mu   sync.Mutex
+	keys map[[secp256k1.PubKeyBytesLenCompressed]byte]*unlockHandshake
+}
+
+// prune removes expired keys.  h.mu must be held.
+func (h *unlockHandshakes) prune(now time.Time) {
+	for pub, k := range h.keys {
+		if !now.Before(k.expires) {
+			k.priv.Zero()
+			delete(h.keys, pub)
+		}
+	}
+}
+
+// generate creates a new ephemeral key and returns its serialized compressed
+// public key and expiry time.
+func (h *unlockHandshakes) generate() ([]byte, time.Time, error) {
+	priv, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		return nil, time.Time{}, status.Errorf(codes.Internal,
+			"failed to generate handshake key: %v", err)
+	}
+	var pub [secp256k1.PubKeyBytesLenCompressed]byte
+	copy(pub[:], priv.PubKey().SerializeCompressed())
+
+	now := time.Now()
+	k := &unlockHandshake{priv: priv, expires: now.Add(unlockHandshakeLifetime)}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.keys == nil {
+		h.keys = make(map[[secp256k1.PubKeyBytesLenCompressed]byte]*unlockHandshake)
+	}
+	h.prune(now)
+	for len(h.keys) >= maxUnlockHandshakes {
+		var oldest [secp256k1.PubKeyBytesLenCompressed]byte
+		var oldestExpiry time.Time
+		for pub, k := range h.keys {
+			if oldestExpiry.IsZero() || k.expires.Before(oldestExpiry) {
+				oldest, oldestExpiry = pub, k.expires
+			}
+		}
+		h.keys[oldest].priv.Zero()
+		delete(h.keys, oldest)
+	}
+	h.keys[pub] = k
+	return pub[:], k.expires, nil
+}
+
+// decrypt decrypts a passphrase envelope encrypted to the handshake key pub.
+// The key is discarded, whether or not decryption succeeds.
+func (h *unlockHandshakes) decrypt(pub, envelope []byte) ([]byte, error) {
+	var key [secp256k1.PubKeyBytesLenCompressed]byte
+	if len(pub) != len(key) {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"handshake public key must be %d bytes", len(key))
+	}
+	copy(key[:], pub)
+
+	h.mu.Lock()
+	h.prune(time.Now())
+	k, ok := h.keys[key]
+	delete(h.keys, key)
+	h.mu.Unlock()
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"unknown or expired handshake public key")
+	}
+	defer k.priv.Zero()
+
+	passphrase, err := ecies.Decrypt(k.priv, envelope)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"invalid passphrase envelope: %v", err)
+	}
+	return passphrase, nil
+}
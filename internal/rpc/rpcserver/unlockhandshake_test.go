@@ -0,0 +1,135 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcserver
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/v5/wallet/ecies"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// sealPassphrase encrypts a passphrase to a handshake public key.
+func sealPassphrase(t *testing.T, pub, passphrase []byte) []byte {
+	t.Helper()
+	pubKey, err := secp256k1.ParsePubKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	envelope, err := ecies.Encrypt(pubKey, passphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return envelope
+}
+
+func testHandshakeCode(t *testing.T, err error, want codes.Code) {
+	t.Helper()
+	if code := status.Code(err); code != want {
+		t.Fatalf("error code %v, want %v (err: %v)", code, want, err)
+	}
+}
+
+func TestUnlockHandshakeDecryptOnce(t *testing.T) {
+	var h unlockHandshakes
+	pub, expires, err := h.generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := time.Until(expires); d <= 0 || d > unlockHandshakeLifetime {
+		t.Fatalf("handshake expires in %v", d)
+	}
+
+	passphrase := []byte("passphrase")
+	envelope := sealPassphrase(t, pub, passphrase)
+	got, err := h.decrypt(pub, envelope)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, passphrase) {
+		t.Fatalf("decrypted %q, want %q", got, passphrase)
+	}
+
+	// The key is discarded after use, so the envelope can not be replayed.
+	_, err = h.decrypt(pub, envelope)
+	testHandshakeCode(t, err, codes.InvalidArgument)
+
+	// Keys are also discarded when decryption fails.
+	pub, _, err = h.generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	envelope = sealPassphrase(t, pub, passphrase)
+	corrupt := bytes.Clone(envelope)
+	corrupt[len(corrupt)-1] ^= 1
+	_, err = h.decrypt(pub, corrupt)
+	testHandshakeCode(t, err, codes.InvalidArgument)
+	_, err = h.decrypt(pub, envelope)
+	testHandshakeCode(t, err, codes.InvalidArgument)
+
+	// Malformed public keys are rejected.
+	_, err = h.decrypt(pub[:len(pub)-1], envelope)
+	testHandshakeCode(t, err, codes.InvalidArgument)
+}
+
+func TestUnlockHandshakeExpiry(t *testing.T) {
+	var h unlockHandshakes
+	expired, _, err := h.generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	live, _, err := h.generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var key [secp256k1.PubKeyBytesLenCompressed]byte
+	copy(key[:], expired)
+	h.keys[key].expires = time.Now()
+
+	_, err = h.decrypt(expired, sealPassphrase(t, expired, []byte("passphrase")))
+	testHandshakeCode(t, err, codes.InvalidArgument)
+	if _, ok := h.keys[key]; ok {
+		t.Fatal("expired handshake key was not pruned")
+	}
+	if _, err := h.decrypt(live, sealPassphrase(t, live, []byte("passphrase"))); err != nil {
+		t.Fatalf("unexpired handshake: %v", err)
+	}
+}
+
+func TestUnlockHandshakeEviction(t *testing.T) {
+	var h unlockHandshakes
+	pubs := make([][]byte, maxUnlockHandshakes+1)
+	for i := range pubs {
+		pub, _, err := h.generate()
+		if err != nil {
+			t.Fatal(err)
+		}
+		pubs[i] = pub
+
+		// Keys generated within the same clock tick would share an
+		// expiry, so make the generation order explicit.
+		var key [secp256k1.PubKeyBytesLenCompressed]byte
+		copy(key[:], pub)
+		h.keys[key].expires = time.Now().Add(unlockHandshakeLifetime +
+			time.Duration(i)*time.Second)
+	}
+	if len(h.keys) != maxUnlockHandshakes {
+		t.Fatalf("%d handshake keys recorded, want %d", len(h.keys),
+			maxUnlockHandshakes)
+	}
+
+	// The oldest key was evicted to record the newest.
+	_, err := h.decrypt(pubs[0], sealPassphrase(t, pubs[0], []byte("passphrase")))
+	testHandshakeCode(t, err, codes.InvalidArgument)
+	for _, pub := range pubs[1:] {
+		if _, err := h.decrypt(pub, sealPassphrase(t, pub, []byte("passphrase"))); err != nil {
+			t.Fatalf("handshake evicted early: %v", err)
+		}
+	}
+}
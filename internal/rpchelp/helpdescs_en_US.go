@@ -39,11 +39,113 @@ var helpDescsEnUS = map[string]string{
 	"addmultisigaddress-nrequired": "The number of signatures required to redeem outputs paid to this address",
 	"addmultisigaddress--result0":  "The imported pay-to-script-hash address",
 
+	// AddressReuseReportCmd help.
+	"addressreusereport--synopsis": "Reports the number and value of outputs paying each reused wallet address, and totals for each account.\n" +
+		"Votes and revocations paying addresses committed to by tickets are not considered reuse.",
+	"addressreusereport-since": "Only report addresses paid at least once since some main chain block height",
+
+	// AddressReuseReportResult help.
+	"addressreusereportresult-addresses": "Reused addresses, ordered by decreasing number of outputs",
+	"addressreusereportresult-accounts":  "Address reuse totals of each account with reused addresses",
+
+	// ReusedAddressResult help.
+	"reusedaddressresult-address":   "The reused address",
+	"reusedaddressresult-account":   "The account of the address",
+	"reusedaddressresult-count":     "The number of outputs paying the address",
+	"reusedaddressresult-total":     "The total value of outputs paying the address",
+	"reusedaddressresult-outpoints": "The outpoints paying the address",
+
+	// AccountAddressReuseResult help.
+	"accountaddressreuseresult-account":     "The account number",
+	"accountaddressreuseresult-accountname": "The account name",
+	"accountaddressreuseresult-addresses":   "The number of reused addresses of the account",
+	"accountaddressreuseresult-count":       "The number of outputs paying reused addresses of the account",
+	"accountaddressreuseresult-total":       "The total value of outputs paying reused addresses of the account",
+
+	// TicketAddressReuseCmd help.
+	"ticketaddressreuse--synopsis": "Audits the wallet's tickets for voting and commitment addresses shared by more than one ticket, as reuse links the tickets to the same owner.\n" +
+		"Voting addresses of voting scripts, which are shared by all users of the script, are not considered reuse.",
+	"ticketaddressreuse--result0": "Reused ticket addresses, ordered by decreasing number of tickets",
+
+	// ReusedTicketAddressResult help.
+	"reusedticketaddressresult-address": "The reused address",
+	"reusedticketaddressresult-account": "The account of the address",
+	"reusedticketaddressresult-kind":    `Whether the address was used for the tickets' voting rights ("voting") or reward commitments ("commitment")`,
+	"reusedticketaddressresult-tickets": "The hashes of the tickets using the address",
+
 	// AddTransactionCmd help.
 	"addtransaction--synopsis":   "Manually record a transaction mined in a main chain block",
 	"addtransaction-blockhash":   "Hash of block which mines transaction",
 	"addtransaction-transaction": "Hex-encoded serialized transaction",
 
+	// AddWebhookCmd help.
+	"addwebhook--synopsis": "Registers an HTTP endpoint to be notified of wallet outputs matching a filter.\n" +
+		"A JSON event is POSTed when a matching transaction is first seen unmined and again when it is mined.\n" +
+		"The request body is authenticated with an HMAC-SHA256 keyed by the returned secret in the Dcrwallet-Signature header.\n" +
+		"Webhooks are not persisted and must be added again after the wallet is restarted.",
+	"addwebhook-url":       "The http or https URL to POST events to",
+	"addwebhook-account":   "Only notify outputs of this account",
+	"addwebhook-address":   "Only notify outputs paying to this address",
+	"addwebhook-minamount": "Only notify outputs of at least this value",
+
+	// WebhookResult help.
+	"webhookresult-id":        "The identifier of the webhook",
+	"webhookresult-url":       "The URL events are POSTed to",
+	"webhookresult-secret":    "The hex encoded HMAC-SHA256 key used to sign events (only returned by addwebhook)",
+	"webhookresult-account":   "The account filter, if any",
+	"webhookresult-address":   "The address filter, if any",
+	"webhookresult-minamount": "The minimum output value filter, if any",
+
+	// AnnotateRawTransactionCmd help.
+	"annotaterawtransaction--synopsis": "Decodes a raw transaction and describes which of its inputs and outputs belong to the wallet.\n" +
+		"Previous outputs are only described when the previous transaction is recorded by the wallet.",
+	"annotaterawtransaction-hextx": "The hex-encoded serialized transaction",
+
+	// AnnotateRawTransactionResult help.
+	"annotaterawtransactionresult-txid":         "The transaction hash",
+	"annotaterawtransactionresult-version":      "The transaction version",
+	"annotaterawtransactionresult-locktime":     "The transaction lock time",
+	"annotaterawtransactionresult-expiry":       "The transaction expiry height",
+	"annotaterawtransactionresult-type":         "The transaction type (regular, coinbase, ticket, vote, revocation, or treasuryadd)",
+	"annotaterawtransactionresult-walletdebit":  "The total value of spent wallet outputs",
+	"annotaterawtransactionresult-walletcredit": "The total value of outputs paying to the wallet",
+	"annotaterawtransactionresult-fee":          "The transaction fee, when the values of all previous outputs are known",
+	"annotaterawtransactionresult-vin":          "The annotated transaction inputs",
+	"annotaterawtransactionresult-vout":         "The annotated transaction outputs",
+
+	// AnnotatedInputResult help.
+	"annotatedinputresult-txid":        "The hash of the previous transaction",
+	"annotatedinputresult-vout":        "The index of the previous output",
+	"annotatedinputresult-tree":        "The tree of the previous transaction",
+	"annotatedinputresult-sequence":    "The input sequence number",
+	"annotatedinputresult-amountin":    "The input value committed to by the transaction",
+	"annotatedinputresult-prevamount":  "The value of the previous output, if known",
+	"annotatedinputresult-mine":        "Whether the previous output pays to the wallet",
+	"annotatedinputresult-account":     "The account number of the previous output's address",
+	"annotatedinputresult-accountname": "The account name of the previous output's address",
+	"annotatedinputresult-address":     "The wallet address paid by the previous output",
+	"annotatedinputresult-path":        "The derivation path of the address",
+
+	// AnnotatedOutputResult help.
+	"annotatedoutputresult-n":           "The output index",
+	"annotatedoutputresult-value":       "The output value",
+	"annotatedoutputresult-version":     "The output script version",
+	"annotatedoutputresult-scripttype":  "The type of the output script",
+	"annotatedoutputresult-pkscript":    "The hex-encoded output script",
+	"annotatedoutputresult-addresses":   "The addresses paid by the output script",
+	"annotatedoutputresult-mine":        "Whether the output pays to the wallet",
+	"annotatedoutputresult-account":     "The account number of the output's address",
+	"annotatedoutputresult-accountname": "The account name of the output's address",
+	"annotatedoutputresult-address":     "The wallet address paid by the output",
+	"annotatedoutputresult-path":        "The derivation path of the address",
+
+	// ApprovePendingSpendCmd help.
+	"approvependingspend--synopsis": "Signs and publishes a spend awaiting approval.\n" +
+		"This method may only be called by the spend approval user (--approveusername), and requires the wallet to be unlocked.\n" +
+		"The spend remains pending if it can not be signed or published.",
+	"approvependingspend-txhash":   "The transaction hash of the pending spend",
+	"approvependingspend--result0": "The hash of the published transaction",
+
 	// AuditReuseCmd help.
 	"auditreuse--synopsis":       "Reports outputs identifying address reuse",
 	"auditreuse-since":           "Only report reusage since some main chain block height",
@@ -51,6 +153,9 @@ var helpDescsEnUS = map[string]string{
 	"auditreuse--result0--value": "Reused address",
 	"auditreuse--result0--key":   "Array of outpoints referencing the reused address",
 
+	// ClearMixedSpendPolicyCmd help.
+	"clearmixedspendpolicy--synopsis": "Removes the mixed spend policy, allowing transactions to spend from any account.",
+
 	// ConsolidateCmd help.
 	"consolidate--synopsis": "Consolidate n many UTXOs into a single output in the wallet.",
 	"consolidate-inputs":    "Number of UTXOs to consolidate as inputs",
@@ -58,6 +163,16 @@ var helpDescsEnUS = map[string]string{
 	"consolidate-address":   "Optional: Address to pay.  Default is obtained via getnewaddress from the account's address pool.",
 	"consolidate--result0":  "Transaction hash for the consolidation transaction",
 
+	// CosignVoteCmd help.
+	"cosignvote--synopsis": "Adds the wallet's signatures to a vote of a ticket whose voting rights are held by an imported multisig script.\n" +
+		"Devices holding keys of the script use this to assemble a completely signed vote when no single wallet holds enough keys.",
+	"cosignvote-hexvote":  "The hex-encoded vote, which may already include signatures of other devices",
+	"cosignvote--result0": "The vote with the wallet's signatures added",
+
+	// CosignVoteResult help.
+	"cosignvoteresult-hex":      "The hex-encoded vote with the wallet's signatures added",
+	"cosignvoteresult-complete": "Whether the vote has enough signatures to be published",
+
 	// CreateMultisigCmd help.
 	"createmultisig--synopsis": "Generate a multisig address and redeem script.",
 	"createmultisig-keys":      "Pubkeys and/or pay-to-pubkey-hash addresses to partially control the multisig address",
@@ -71,11 +186,90 @@ var helpDescsEnUS = map[string]string{
 	"createsignatureresult-signature": "The hex encoded signature.",
 	"createsignatureresult-publickey": "The hex encoded serialized compressed pubkey of the address.",
 
+	// CreateMultisigSpendCmd help.
+	"createmultisigspend--synopsis": "Creates an unsigned transaction spending outputs of a P2SH multisig address.\n" +
+		"Inputs and outputs are sorted deterministically and any change is returned to the multisig address, so cosigners with the same view of the address's unspent outputs create identical transactions for the same arguments.\n" +
+		"The transaction may then be signed by each cosigner using signrawtransaction.",
+	"createmultisigspend-fromscraddress": "The P2SH multisig address to spend from",
+	"createmultisigspend-amounts":        "Pairs of payment addresses and the output amount to pay each",
+	"createmultisigspend-amounts--desc":  "JSON object using payment addresses as keys and output amounts valued in decred to send to each address",
+	"createmultisigspend-amounts--key":   "Address to pay",
+	"createmultisigspend-amounts--value": "Amount to send to the payment address valued in decred",
+	"createmultisigspend-feerate":        "The fee per kilobyte, which cosigners must agree on (default: the wallet relay fee)",
+	"createmultisigspend-outpoints":      "Outpoints (\"hash:index\") of the multisig address to spend (default: all unspent outputs)",
+
+	// CreateMultisigSpendResult help.
+	"createmultisigspendresult-hex":         "The hex encoded unsigned transaction",
+	"createmultisigspendresult-txhash":      "The transaction hash, which does not change when signed",
+	"createmultisigspendresult-fee":         "The transaction fee",
+	"createmultisigspendresult-changeindex": "The index of the change output, or -1 without change",
+
+	// CreateReserveSnapshotCmd help.
+	"createreservesnapshot--synopsis": "Creates a proof of reserves committing to every unspent output paid to a public key hash of the wallet at the main chain tip.\n" +
+		"Each output is signed by its controlling key over a message including the block hash, the merkle root of all outputs, and the optional message.\n" +
+		"Requires the wallet to be unlocked.",
+	"createreservesnapshot-message": "A message included in every signature, such as a date or challenge from an auditor",
+
+	// ReserveSnapshotResult help.
+	"reservesnapshotresult-blockhash":   "The main chain block of the snapshot",
+	"reservesnapshotresult-blockheight": "The height of the snapshot block",
+	"reservesnapshotresult-message":     "The message included in every signature",
+	"reservesnapshotresult-merkleroot":  "The merkle root committing to every output",
+	"reservesnapshotresult-total":       "The total value of all outputs",
+	"reservesnapshotresult-outputs":     "Every signed output, ordered by outpoint",
+
+	// ReserveOutputResult help.
+	"reserveoutputresult-txid":          "The transaction hash of the output",
+	"reserveoutputresult-vout":          "The output index",
+	"reserveoutputresult-tree":          "The transaction tree of the output",
+	"reserveoutputresult-amount":        "The output value",
+	"reserveoutputresult-scriptversion": "The output script version",
+	"reserveoutputresult-pkscript":      "The hex-encoded output script",
+	"reserveoutputresult-address":       "The public key hash address paid by the output",
+	"reserveoutputresult-signature":     "The base64-encoded compact signature of the proof message by the address key",
+
 	// CreateNewAccountCmd help.
 	"createnewaccount--synopsis": "Creates a new account.\n" +
 		"The wallet must be unlocked for this request to succeed.",
 	"createnewaccount-account": "Name of the new account",
 
+	// CreateInvoiceCmd help.
+	"createinvoice--synopsis": "Records an invoice for an amount paid to a new external address of an account.\n" +
+		"The amount received by the address is reconciled with the invoice as payments are mined and reorged, and the invoice status becomes partial, paid, or overpaid.\n" +
+		"Invoices with an expiry which are not fully paid before expiring become expired, and later payments continue to be recorded as received.",
+	"createinvoice-id":        "A unique identifier chosen by the caller (at most 128 bytes)",
+	"createinvoice-amount":    "The amount in DCR to invoice",
+	"createinvoice-account":   "Account name the new address will belong to",
+	"createinvoice-expiresin": "Optional number of seconds after which the invoice expires",
+	"createinvoice-gappolicy": `String defining the policy to use when the BIP0044 gap limit would be violated, may be "error", "ignore", or "wrap" (default: "ignore", returning and watching addresses beyond the gap limit)`,
+	"createinvoice--result0":  "The new invoice",
+
+	// InvoiceResult help.
+	"invoiceresult-id":            "The identifier of the invoice",
+	"invoiceresult-address":       "The address to be paid",
+	"invoiceresult-account":       "The account of the address",
+	"invoiceresult-amount":        "The invoiced amount in DCR",
+	"invoiceresult-received":      "The total amount in DCR of mined outputs received by the address",
+	"invoiceresult-status":        "The invoice status (pending, partial, paid, overpaid, or expired)",
+	"invoiceresult-created":       "The Unix time the invoice was created",
+	"invoiceresult-expiry":        "The Unix time the invoice expires (omitted if it does not expire)",
+	"invoiceresult-updated":       "The Unix time the received amount or status last changed (omitted if unchanged)",
+	"invoiceresult-changedheight": "The main chain tip height when the received amount last changed (omitted if nothing was received)",
+
+	// CreatePaymentURICmd help.
+	"createpaymenturi--synopsis": "Generates a new payment address and returns a decred: payment request URI paying to it.\n" +
+		"The requested amount, label, message, and expiry are recorded with the address, and payments to the address are reconciled with the request by parsepaymenturi.\n" +
+		"Requests with an expiry are also reported by listexpiredaddresses if they expire before receiving a payment.",
+	"createpaymenturi-account":   "Account name the new address will belong to",
+	"createpaymenturi-amount":    "Optional amount in DCR to request",
+	"createpaymenturi-label":     "Optional label of the payment recipient",
+	"createpaymenturi-message":   "Optional message describing the payment",
+	"createpaymenturi-expiresin": "Optional number of seconds after which the request expires",
+
+	// CreatePaymentURIResult help.
+	"createpaymenturiresult-uri":     "The payment request URI",
+	"createpaymenturiresult-address": "The new payment address",
+
 	// CreateRawTransactionCmd help.
 	"createrawtransaction--synopsis": "Returns a new transaction spending the provided inputs and sending to the provided addresses.\n" +
 		"The transaction inputs are not signed in the created transaction.\n" +
@@ -97,6 +291,20 @@ var helpDescsEnUS = map[string]string{
 	"createsignature-hashtype":              "The signature hash flags to use.",
 	"createsignature-previouspkscript":      "The hex encoded previous output script or P2SH redeem script.",
 
+	// CreateSwapContractCmd help.
+	"createswapcontract--synopsis": "Creates an atomic swap contract paying to a recipient when a secret is revealed, or refundable to a new internal address of an account after a locktime.\n" +
+		"The contract is not funded by this command.",
+	"createswapcontract-account":    "The account used to derive the refund address",
+	"createswapcontract-recipient":  "The P2PKH address which may redeem the contract by revealing the secret",
+	"createswapcontract-secrethash": "The hex encoded SHA256 hash of the 32 byte secret",
+	"createswapcontract-locktime":   "The block height or unix time after which the contract may be refunded",
+
+	// CreateSwapContractResult help.
+	"createswapcontractresult-contract":      "The hex encoded contract script",
+	"createswapcontractresult-address":       "The P2SH address of the contract",
+	"createswapcontractresult-refundaddress": "The address which may refund the contract after the locktime",
+	"createswapcontractresult-locktime":      "The contract locktime",
+
 	// DebugLevelCmd help.
 	"debuglevel--synopsis": "Dynamically changes the debug logging level.\n" +
 		"The levelspec can either a debug level or of the form:\n" +
@@ -110,6 +318,90 @@ var helpDescsEnUS = map[string]string{
 	"debuglevel--result0":    "The string 'Done.'",
 	"debuglevel--result1":    "The list of subsystems",
 
+	// DebugDumpCmd help.
+	"debugdump--synopsis": "Returns a structural description of the wallet suitable for attaching to bug reports.\n" +
+		"Keys, addresses, account names, hashes, and balances are not included.",
+
+	// DebugDumpResult help.
+	"debugdumpresult-network":             "The network of the wallet",
+	"debugdumpresult-dbversion":           "The database version",
+	"debugdumpresult-cointype":            "The BIP0044 coin type",
+	"debugdumpresult-watchingonly":        "Whether the wallet is watching-only",
+	"debugdumpresult-locked":              "Whether the wallet is locked",
+	"debugdumpresult-tipheight":           "The height of the main chain tip",
+	"debugdumpresult-birthheight":         "The recorded wallet birthday height",
+	"debugdumpresult-missingcfilters":     "Whether main chain compact filters are missing",
+	"debugdumpresult-unminedtransactions": "The number of unmined transactions",
+	"debugdumpresult-accounts":            "The address cursors of each account",
+	"debugdumpresult-buckets":             "The number of key/value pairs of each database bucket, keyed by bucket path",
+	"debugdumpresult-buckets--desc":       "Database bucket sizes",
+	"debugdumpresult-buckets--key":        "The bucket path",
+	"debugdumpresult-buckets--value":      "The number of key/value pairs",
+
+	// DebugDumpAccountResult help.
+	"debugdumpaccountresult-accountnumber":        "The account number",
+	"debugdumpaccountresult-accounttype":          "The account type",
+	"debugdumpaccountresult-lastusedexternal":     "The last used external address index",
+	"debugdumpaccountresult-lastusedinternal":     "The last used internal address index",
+	"debugdumpaccountresult-lastreturnedexternal": "The last returned external address index",
+	"debugdumpaccountresult-lastreturnedinternal": "The last returned internal address index",
+	"debugdumpaccountresult-importedkeys":         "The number of imported keys",
+
+	// DebugInspectDBCmd help.
+	"debuginspectdb--synopsis": "Describes the buckets of the wallet database, their sizes, and the recorded serialization versions, to debug damaged wallets without copying the database file.\n" +
+		"Nested buckets of buckets other than schema buckets are summarized together under the path component '*'.\n" +
+		"Keys are only revealed for schema buckets (meta, waddrmgr, waddrmgr/main, waddrmgr/meta, waddrmgr/sync, and wtxmgr); all other keys, including nested bucket names, and all values, are replaced by hashes salted with a secret generated for each call.\n" +
+		"Hashes may be compared within a single result, but not between calls.",
+	"debuginspectdb-bucket": "Slash-separated path of a bucket whose entries are described, e.g. waddrmgr/main",
+	"debuginspectdb-limit":  "Maximum number of bucket entries to describe",
+
+	// DebugInspectDBResult help.
+	"debuginspectdbresult-versions":        "Serialization versions recorded by the database, keyed by the path of the version key",
+	"debuginspectdbresult-versions--desc":  "Recorded serialization versions",
+	"debuginspectdbresult-versions--key":   "The version key path",
+	"debuginspectdbresult-versions--value": "The recorded version",
+	"debuginspectdbresult-latestversion":   "The newest database version understood by this wallet",
+	"debuginspectdbresult-buckets":         "The size of every database bucket, sorted by path",
+	"debuginspectdbresult-entries":         "Entries of the requested bucket, up to the limit",
+	"debuginspectdbresult-totalentries":    "The total number of entries of the requested bucket",
+
+	// BucketSummaryResult help.
+	"bucketsummaryresult-path":       "The bucket path",
+	"bucketsummaryresult-buckets":    "The number of buckets summarized by the path",
+	"bucketsummaryresult-entries":    "The number of key/value pairs",
+	"bucketsummaryresult-keybytes":   "The total length of keys",
+	"bucketsummaryresult-valuebytes": "The total length of values",
+
+	// BucketEntryResult help.
+	"bucketentryresult-key":       "The key, or a salted hash of the key when redacted",
+	"bucketentryresult-redacted":  "Whether the key is redacted",
+	"bucketentryresult-bucket":    "Whether the entry is a nested bucket",
+	"bucketentryresult-keylen":    "The length of the key",
+	"bucketentryresult-valuelen":  "The length of the value",
+	"bucketentryresult-valuehash": "A salted hash of the value (omitted for nested buckets)",
+
+	// DebugTimingsCmd help.
+	"debugtimings--synopsis": "Returns the recorded durations of wallet operations and RPC method calls, to attribute latency to passphrase unlocks, database contention, signing, the network backend, or individual methods.\n" +
+		"Wallet operations are unlock (scrypt key derivation), deriveaddress, dbview (read transactions held), dbwritewait (waiting for the database writer), dbupdate (write transactions held), sign, and publish.",
+	"debugtimings-reset":    "Reset the recorded durations after returning them",
+	"debugtimings--result0": "The recorded durations",
+
+	// DebugTimingsResult help.
+	"debugtimingsresult-operations": "Durations of wallet operations, sorted by name",
+	"debugtimingsresult-methods":    "Durations of RPC method calls, sorted by method",
+
+	// TimingResult help.
+	"timingresult-name":     "The operation or method name",
+	"timingresult-count":    "The number of completed operations or calls",
+	"timingresult-inflight": "The number of calls in progress (methods only, omitted when zero)",
+	"timingresult-totalms":  "The total duration in milliseconds",
+	"timingresult-meanms":   "The mean duration in milliseconds",
+	"timingresult-maxms":    "The maximum duration in milliseconds",
+
+	// DeleteInvoiceCmd help.
+	"deleteinvoice--synopsis": "Removes an invoice created by createinvoice. The address of the invoice remains a wallet address.",
+	"deleteinvoice-id":        "The identifier of the invoice",
+
 	// DisapprovePercentCmd help.
 	"disapprovepercent--synopsis": "Returns the wallet's current block disapprove percent per vote. i.e. 100 means that all votes disapprove the block they are called on. Only used for testing purposes.",
 	"disapprovepercent--result0":  "The disapprove percent. When voting, this percent of votes will randomly disapprove the block they are called on.",
@@ -125,6 +417,97 @@ var helpDescsEnUS = map[string]string{
 	"dumpprivkey-address":   "The address to return a private key for",
 	"dumpprivkey--result0":  "The WIF-encoded private key",
 
+	// EstimateRawTransactionCmd help.
+	"estimaterawtransaction--synopsis": "Selects inputs to fund a raw transaction as fundrawtransaction does, and returns the size and fee of the funded transaction without modifying the wallet.\n" +
+		"Signature script sizes are determined by the script types of the selected outputs, and private keys are not required, allowing watching-only wallets to quote fees of transactions signed offline.",
+	"estimaterawtransaction-hexstring":   "Serialized transaction in hex encoding",
+	"estimaterawtransaction-fundaccount": "Account of outputs to spend in transaction",
+	"estimaterawtransaction-options":     "Object to specify fixed change address, alternative fee rate, and confirmation target",
+
+	// EstimateRawTransactionResult help.
+	"estimaterawtransactionresult-inputs":     "The selected previous outputs, formatted as txid:index",
+	"estimaterawtransactionresult-totalinput": "The total value of the selected previous outputs",
+	"estimaterawtransactionresult-fee":        "Absolute fee of the funded transaction",
+	"estimaterawtransactionresult-change":     "Value of the change output, or zero without change",
+	"estimaterawtransactionresult-size":       "Serialize size of the funded transaction without signature scripts",
+	"estimaterawtransactionresult-signedsize": "Estimated serialize size of the signed transaction, from which the fee is calculated",
+
+	// ForecastStakeCmd help.
+	"forecaststake--synopsis": "Projects the expected votes of unspent tickets and the expected locked and spendable balances of the wallet over future blocks, assuming the configured ticket buyer parameters unless overridden.\n" +
+		"Live tickets are assumed to be chosen to vote by each block with probability 1/ticketpoolsize, unmined tickets are assumed to be mined in the next block, and missed tickets are assumed to be revoked after expiry.\n" +
+		"Vote rewards are calculated with the current subsidy split, and transaction and VSP fees are not included.",
+	"forecaststake-blocks":   "Number of blocks to project (default is the lifetime of a new ticket)",
+	"forecaststake-interval": "Number of blocks between samples (default is one day of blocks)",
+	"forecaststake-options":  "Object overriding the ticket buyer purchasing account, whether tickets are purchased, balance to maintain, per-block purchase limit, and ticket price",
+
+	// ForecastStakeOptions help.
+	"forecaststakeoptions-account":     "The purchasing account",
+	"forecaststakeoptions-buytickets":  "Whether tickets are purchased",
+	"forecaststakeoptions-maintain":    "Spendable balance to maintain when purchasing tickets",
+	"forecaststakeoptions-limit":       "Maximum number of tickets purchased per block, or zero for no limit",
+	"forecaststakeoptions-ticketprice": "Price of purchased tickets (default is the next stake difficulty)",
+
+	// ForecastStakeResult help.
+	"forecaststakeresult-tipheight":   "The main chain tip height",
+	"forecaststakeresult-ticketprice": "The assumed price of purchased tickets",
+	"forecaststakeresult-tickets":     "The expected votes of unspent tickets",
+	"forecaststakeresult-samples":     "The expected stake and balances at each sampled block",
+
+	// TicketVoteForecastResult help.
+	"ticketvoteforecastresult-hash":               "The ticket hash",
+	"ticketvoteforecastresult-value":              "The ticket price",
+	"ticketvoteforecastresult-firstvoteheight":    "Height of the first block which may choose the ticket to vote",
+	"ticketvoteforecastresult-lastvoteheight":     "Height of the last block which may choose the ticket to vote before expiry",
+	"ticketvoteforecastresult-voteprobability":    "Probability of the ticket voting before expiry",
+	"ticketvoteforecastresult-expectedvoteheight": "Expected height of the vote, if the ticket votes",
+	"ticketvoteforecastresult-expectedvotetime":   "Expected unix time of the vote, if the ticket votes",
+
+	// StakeForecastSampleResult help.
+	"stakeforecastsampleresult-height":    "The block height",
+	"stakeforecastsampleresult-time":      "The expected unix time of the block",
+	"stakeforecastsampleresult-locked":    "Expected value of unspent tickets",
+	"stakeforecastsampleresult-tickets":   "Expected number of unspent tickets",
+	"stakeforecastsampleresult-immature":  "Expected value of immature vote and revocation outputs",
+	"stakeforecastsampleresult-spendable": "Expected spendable balance of the purchasing account",
+	"stakeforecastsampleresult-votes":     "Expected number of votes since the main chain tip",
+	"stakeforecastsampleresult-purchased": "Number of tickets purchased since the main chain tip",
+
+	// SimulateStakeCmd help.
+	"simulatestake--synopsis": "Simulates the expected returns of staking a hypothetical balance over a period following the main chain tip.\n" +
+		"All of the balance which can be is used to purchase tickets, and vote rewards and returned ticket value are restaked as they mature.\n" +
+		"The simulation makes the assumptions of forecaststake, using the subsidy schedule of the network, and the ticket price is assumed to remain constant.",
+	"simulatestake-amount":      "The hypothetical balance to stake",
+	"simulatestake-days":        "The number of days to simulate, at most 36500",
+	"simulatestake-ticketprice": "Price of purchased tickets (default is the next stake difficulty)",
+
+	// SimulateStakeResult help.
+	"simulatestakeresult-amount":       "The simulated balance",
+	"simulatestakeresult-ticketprice":  "The assumed price of purchased tickets",
+	"simulatestakeresult-blocks":       "The number of simulated blocks",
+	"simulatestakeresult-value":        "Expected total of the spendable, immature and locked balances at the end of the period",
+	"simulatestakeresult-rewards":      "Expected increase of the balance over the period",
+	"simulatestakeresult-purchased":    "Number of tickets purchased during the period",
+	"simulatestakeresult-votes":        "Expected number of votes cast during the period",
+	"simulatestakeresult-return":       "Expected return over the period as a fraction of the balance",
+	"simulatestakeresult-annualreturn": "Expected return compounded over one year as a fraction of the balance",
+
+	// EstimateTxSizeCmd help.
+	"estimatetxsize--synopsis": "Returns the worst case serialized size and fee of a hypothetical signed transaction spending inputs of the given script types and paying to addresses.\n" +
+		"Regular transactions pay each output address, and optionally P2PKH change.\n" +
+		"Tickets pay the single voting address output, and a commitment and P2PKH change output for each input.\n" +
+		"Votes spend a ticket redeemed by the single input script type (default p2pkh) and pay each reward address.\n" +
+		"Revocations have no inputs and pay each refund address.",
+	"estimatetxsize-txtype":  "The transaction type: regular, ticket, vote, or revocation",
+	"estimatetxsize-inputs":  "The script types of previous outputs redeemed by inputs: p2pkh, p2pk, p2sh, or multisig-M-of-N",
+	"estimatetxsize-outputs": "The addresses paid by outputs",
+	"estimatetxsize-change":  "Whether a regular transaction pays change",
+	"estimatetxsize-feerate": "Fee rate in DCR/kB (default is the wallet relay fee)",
+
+	// EstimateTxSizeResult help.
+	"estimatetxsizeresult-size":    "Worst case serialized size of the signed transaction",
+	"estimatetxsizeresult-fee":     "Fee of the transaction at the fee rate",
+	"estimatetxsizeresult-feerate": "The fee rate in DCR/kB",
+
 	// FundRawTransactionCmd help.
 	"fundrawtransaction--synopsis":            "Adds unsigned inputs and change output to a raw transaction",
 	"fundrawtransaction-hexstring":            "Serialized transaction in hex encoding",
@@ -136,6 +519,122 @@ var helpDescsEnUS = map[string]string{
 	"fundrawtransactionresult-hex":            "Funded transaction in hex encoding",
 	"fundrawtransactionresult-fee":            "Absolute fee of funded transaction",
 
+	// GetAccountChildXpubCmd help.
+	"getaccountchildxpub--synopsis": "Derives the extended public key of a hardened child path below an account's extended key, for use by external protocols requiring purpose-specific keys (e.g. identity or encryption keys) derived from the wallet seed.\n" +
+		"Only hardened paths may be derived, so the keys are neither derivable from the account xpub nor used for wallet addresses.\n" +
+		"Requires the wallet or account to be unlocked.",
+	"getaccountchildxpub-account":  "The account name",
+	"getaccountchildxpub-path":     "The child path relative to the account key, with each index hardened by a ' or h suffix (e.g. \"13'/0'\")",
+	"getaccountchildxpub--result0": "The extended public key of the child path",
+
+	// GetAccountWatchKeyCmd help.
+	"getaccountwatchkey--synopsis": "Returns an external-only extended public key and address index window which third-party services may use to detect payments to an account.\n" +
+		"The key is derived from a hardened child of the account key, so neither the account xpub nor its internal (change) branch is revealed.\n" +
+		"The addresses of the window are imported to the account, and windows may be extended by later requests.\n" +
+		"Requires the unlocked wallet, and is not supported by accounts with unique passphrases.",
+	"getaccountwatchkey-account":  "The account name",
+	"getaccountwatchkey-start":    "The first child index of the window",
+	"getaccountwatchkey-count":    "The number of addresses in the window (at most 1000)",
+	"getaccountwatchkey-rescan":   "Rescan the blockchain for transactions of the window's addresses",
+	"getaccountwatchkey-scanfrom": "Block height to begin the rescan from",
+
+	// GetAccountWatchKeyResult help.
+	"getaccountwatchkeyresult-xpub":  "The extended public key of the watch key branch, whose children are the window's addresses",
+	"getaccountwatchkeyresult-start": "The first child index of the window",
+	"getaccountwatchkeyresult-count": "The number of addresses in the window",
+
+	// GetAccountIDCmd help.
+	"getaccountid--synopsis": "Returns the immutable UUID and rename history of an account.\n" +
+		"Unlike account names, UUIDs do not change when accounts are renamed.",
+	"getaccountid-account": "The current name of the account",
+
+	// GetAccountByIDCmd help.
+	"getaccountbyid--synopsis": "Returns the current name, number, and rename history of the account identified by a UUID.",
+	"getaccountbyid-uuid":      "The account UUID",
+
+	// GetAccountBlindedIDCmd help.
+	"getaccountblindedid--synopsis": "Returns the blinded ID of an account: a keyed BLAKE-256 hash of the account extended public key.\n" +
+		"The key is private to the wallet, so blinded IDs can be shared with external services to refer to an account without revealing its xpub.\n" +
+		"The imported account has no blinded ID.",
+	"getaccountblindedid-account":  "The account name",
+	"getaccountblindedid--result0": "The hex-encoded blinded ID",
+
+	// LookupAccountBlindedIDCmd help.
+	"lookupaccountblindedid--synopsis": "Returns the current name, number, and rename history of the account identified by a blinded ID.\n" +
+		"Errors if the wallet does not own an account with the blinded ID.",
+	"lookupaccountblindedid-blindedid": "The hex-encoded blinded ID",
+
+	// AttestAddressCmd help.
+	"attestaddress--synopsis": "Returns an attestation that an address is derived by the wallet, signed by the extended key of the address' account.\n" +
+		"The signature is a compact signature of the BLAKE-256 hash of the var-string encodings of \"Decred Address Attestation:\\n\" and the address, followed by the little endian uint32 account, branch, index and fingerprint.\n" +
+		"Receivers of the address verify that the signature was created by the claimed wallet's account xpub, that the fingerprint is the xpub's parent fingerprint, and that the address is derived by the xpub at the branch and index.\n" +
+		"Requires the wallet or account to be unlocked.",
+	"attestaddress-address": "The address derived by a wallet account",
+
+	// AttestAddressResult help.
+	"attestaddressresult-address":     "The attested address",
+	"attestaddressresult-account":     "The account number",
+	"attestaddressresult-branch":      "The account branch deriving the address",
+	"attestaddressresult-index":       "The child index of the address in the branch",
+	"attestaddressresult-fingerprint": "The hex-encoded wallet fingerprint (the BIP0032 fingerprint of the wallet's coin type key)",
+	"attestaddressresult-accountxpub": "The account extended public key which signed the attestation",
+	"attestaddressresult-signature":   "The base64-encoded compact signature of the attestation",
+
+	// ExportVotePoliciesCmd help.
+	"exportvotepolicies--synopsis": "Exports the default agenda choices and the treasury spend and treasury key policies of the wallet, signed by the key of a wallet address, for import by importvotepolicies on other voting wallets.\n" +
+		"Per-ticket policies are not exported.\n" +
+		"Requires the wallet to be unlocked.",
+	"exportvotepolicies-address":  "The wallet address signing the policies",
+	"exportvotepolicies--result0": "The signed policies",
+
+	// ImportVotePoliciesCmd help.
+	"importvotepolicies--synopsis": "Applies vote policies exported by exportvotepolicies after verifying their signature.\n" +
+		"Default agenda choices are set to the exported choices, and treasury spend and treasury key policies are replaced by the exported policies, abstaining from treasury spends and keys without an exported policy.\n" +
+		"No policies are applied if any exported agenda or choice is not supported by the wallet.\n" +
+		"Each export may only be applied once, so previously imported policies are rejected.",
+	"importvotepolicies-policies": "The signed policies",
+	"importvotepolicies-address":  "The address expected to have signed the policies",
+
+	// ExportVotingAccountCmd help.
+	"exportvotingaccount--synopsis": "Exports the extended private key of an account designated as the voting account of purchased tickets, for import by importvotingaccount on a separate voting wallet.\n" +
+		"The first voting addresses of the account are returned to be checked by verifyvotingaccount on the voting wallet.\n" +
+		"The default account and accounts holding any funds can not be exported.\n" +
+		"Requires the wallet or account to be unlocked.",
+	"exportvotingaccount-account": "The voting account",
+	"exportvotingaccount-count":   "Number of voting addresses to return",
+
+	// ExportVotingAccountResult help.
+	"exportvotingaccountresult-xpriv":     "The account extended private key",
+	"exportvotingaccountresult-addresses": "The first voting addresses of the account",
+
+	// ImportVotingAccountCmd help.
+	"importvotingaccount--synopsis": "Imports an account extended private key, such as one exported by exportvotingaccount, as a voting account.\n" +
+		"The key is encrypted by a separate passphrase, which unlocks the account with unlockaccount.",
+	"importvotingaccount-xpriv":      "The account extended private key",
+	"importvotingaccount-passphrase": "The passphrase encrypting the account key",
+	"importvotingaccount-name":       "The name of the new account",
+	"importvotingaccount--result0":   "The number of the imported account",
+
+	// VerifyVotingAccountCmd help.
+	"verifyvotingaccount--synopsis": "Verifies that addresses, such as those returned by exportvotingaccount on another wallet, are the first voting addresses of an account.",
+	"verifyvotingaccount-account":   "The voting account",
+	"verifyvotingaccount-addresses": "The voting addresses in order of derivation",
+
+	// VerifyVotingAccountResult help.
+	"verifyvotingaccountresult-valid": "Whether all addresses are voting addresses of the account",
+	"verifyvotingaccountresult-error": "Describes the first address which is not derived by the account",
+
+	// AccountIDResult help.
+	"accountidresult-accountname":   "The current account name",
+	"accountidresult-accountnumber": "The account number",
+	"accountidresult-uuid":          "The immutable account UUID",
+	"accountidresult-renames":       "Each rename of the account, oldest first",
+
+	// AccountRenameResult help.
+	"accountrenameresult-time":    "The unix time of the rename",
+	"accountrenameresult-oldname": "The account name before the rename",
+	"accountrenameresult-newname": "The account name after the rename",
+
 	// GetAccountAddressCmd help.
 	"getaccountaddress--synopsis": "DEPRECATED -- Returns the most recent external payment address for an account that has not been seen publicly.\n" +
 		"A new address is generated for the account if the most recently generated address has been seen on the blockchain or in mempool.",
@@ -152,6 +651,17 @@ var helpDescsEnUS = map[string]string{
 	"getaddressesbyaccount-account":   "Account name to fetch addresses for",
 	"getaddressesbyaccount--result0":  "All addresses controlled by 'account'",
 
+	// GetCapabilitiesCmd help.
+	"getcapabilities--synopsis": "Returns the API version, supported methods, and enabled optional subsystems of the wallet and RPC server, so clients may adapt without probing with trial calls.",
+
+	// GetCapabilitiesResult help.
+	"getcapabilitiesresult-apiversion":   "The semantic version of the JSON-RPC API",
+	"getcapabilitiesresult-version":      "The version of dcrwallet",
+	"getcapabilitiesresult-walletloaded": "Whether a wallet is loaded; wallet features and the sync mode are only reported for loaded wallets",
+	"getcapabilitiesresult-syncmode":     "How the wallet is synced to the network (\"spv\", \"rpc\", or \"none\")",
+	"getcapabilitiesresult-features":     "Sorted names of the enabled optional subsystems (\"accountrollover\", \"mixing\", \"pendingspends\", \"readonly\", \"sidechainpruning\", \"ticketbuyer\", \"treasurykeys\", \"txpruning\", \"voteonly\", \"vsp\", \"watchingonly\", \"webhooks\")",
+	"getcapabilitiesresult-methods":      "Sorted names of the supported methods",
+
 	// GetBalanceCmd help.
 	"getbalance--synopsis": "Calculates and returns the balance of all accounts.",
 	"getbalance-minconf":   "Minimum number of block confirmations required before an unspent output's value is included in the balance",
@@ -162,6 +672,8 @@ var helpDescsEnUS = map[string]string{
 	"getaccountbalanceresult-immaturecoinbaserewards": "Immature Coinbase reward coins.",
 	"getaccountbalanceresult-immaturestakegeneration": "Number of immature stake coins.",
 	"getaccountbalanceresult-lockedbytickets":         "Coins locked by tickets.",
+	"getaccountbalanceresult-lockedbylivetickets":     "Coins locked by live (mature and unexpired) tickets; included in lockedbytickets.",
+	"getaccountbalanceresult-lockedbyunspent":         "Value of unspent outputs locked by lockunspent or reservefunds; included in the other balances.",
 	"getaccountbalanceresult-spendable":               "Spendable number of coins.",
 	"getaccountbalanceresult-total":                   "Total amount of coins.",
 	"getaccountbalanceresult-unconfirmed":             "Unconfirmed number of coins.",
@@ -170,6 +682,8 @@ var helpDescsEnUS = map[string]string{
 	"getbalanceresult-totalimmaturecoinbaserewards":   "Total number of immature coinbase reward coins.",
 	"getbalanceresult-totalimmaturestakegeneration":   "Total number of immature stake coins.",
 	"getbalanceresult-totallockedbytickets":           "Total number of coins locked by tickets.",
+	"getbalanceresult-totallockedbylivetickets":       "Total number of coins locked by live tickets.",
+	"getbalanceresult-totallockedbyunspent":           "Total value of unspent outputs locked by lockunspent or reservefunds.",
 	"getbalanceresult-totalspendable":                 "Total number of spendable number of coins.",
 	"getbalanceresult-cumulativetotal":                "Total number of coins.",
 	"getbalanceresult-totalunconfirmed":               "Total number of unconfirmed coins.",
@@ -338,19 +852,99 @@ var helpDescsEnUS = map[string]string{
 	"syncstatusresult-synced":               "Whether or not the wallet is fully caught up to the network.",
 	"syncstatusresult-initialblockdownload": "Best guess of whether this wallet is in the initial block download mode used to catch up the blockchain when it is far behind.",
 	"syncstatusresult-headersfetchprogress": "Estimated progress of the headers fetching stage of the current sync process.",
+	"syncstatusresult-phase":                "The current sync phase (connecting, fetchingcfilters, fetchingheaders, discoveringaddresses, rescanning, or synced).",
+	"syncstatusresult-phaseheight":          "The block height the current phase has progressed through, or 0 when the phase does not report progress.",
+	"syncstatusresult-phasetargetheight":    "The block height the current phase completes at, or 0 when the phase does not report progress.",
+	"syncstatusresult-tipheight":            "The height of the wallet's main chain tip.",
+	"syncstatusresult-backend":              "The kind of network backend (spv, rpc, or offline).",
+	"syncstatusresult-peers":                "Addresses of the peers or dcrd server the wallet syncs from.",
 
 	// GetCurrentNetCmd help.
 	"getcurrentnet--synopsis": "Get Decred network the wallet is connected to.",
 	"getcurrentnet--result0":  "The network identifier",
 
+	// GetAccountCryptoParamsCmd help.
+	"getaccountcryptoparams--synopsis": "Returns the encryption scheme and key derivation parameters protecting the private keys of accounts, and when their passphrases were last changed.",
+	"getaccountcryptoparams-account":   "The account name, or all accounts when omitted",
+	"getaccountcryptoparams--result0":  "The encryption of each account",
+
+	// AccountCryptoParamsResult help.
+	"accountcryptoparamsresult-accountname":       "The account name",
+	"accountcryptoparamsresult-accountnumber":     "The account number",
+	"accountcryptoparamsresult-encryption":        "The encryption scheme (\"none\", \"snacl-scrypt\" for keys protected by the wallet passphrase, or \"xchacha20poly1305-argon2id\" for keys protected by a unique account passphrase)",
+	"accountcryptoparamsresult-scryptn":           "The scrypt N (CPU/memory cost) parameter of the wallet passphrase KDF",
+	"accountcryptoparamsresult-scryptr":           "The scrypt r (block size) parameter of the wallet passphrase KDF",
+	"accountcryptoparamsresult-scryptp":           "The scrypt p (parallelism) parameter of the wallet passphrase KDF",
+	"accountcryptoparamsresult-argon2idtime":      "The Argon2id time parameter of the account passphrase KDF",
+	"accountcryptoparamsresult-argon2idmemory":    "The Argon2id memory parameter (KiB) of the account passphrase KDF",
+	"accountcryptoparamsresult-argon2idthreads":   "The Argon2id parallelism of the account passphrase KDF",
+	"accountcryptoparamsresult-passphrasechanged": "Unix time the passphrase was last changed, omitted when unknown",
+
+	// GetDestinationPolicyCmd help.
+	"getdestinationpolicy--synopsis": "Returns the destination policy restricting the payments of an account.",
+	"getdestinationpolicy-account":   "The account name",
+
+	// GetDestinationPolicyResult help.
+	"getdestinationpolicyresult-allowlist": "Whether payments to destinations which are not allowed are rejected",
+	"getdestinationpolicyresult-allowed":   "Addresses which may be paid in allowlist mode",
+	"getdestinationpolicyresult-denied":    "Addresses which may never be paid",
+
+	// GetDuressAccountCmd help.
+	"getduressaccount--synopsis": "Returns the account unlocked by the duress passphrase. Requires the wallet to be unlocked.",
+
+	// GetDuressAccountResult help.
+	"getduressaccountresult-enabled": "Whether a duress passphrase is configured",
+	"getduressaccountresult-account": "The account unlocked by the duress passphrase (omitted when disabled)",
+
 	// GetInfoCmd help.
 	"getinfo--synopsis": "Returns a JSON object containing various state info.",
 
+	// GetInvoiceCmd help.
+	"getinvoice--synopsis": "Returns an invoice created by createinvoice and its reconciliation with mined payments.",
+	"getinvoice-id":        "The identifier of the invoice",
+	"getinvoice--result0":  "The invoice",
+
+	// GetJournalEventsCmd help.
+	"getjournalevents--synopsis": "Returns events recorded by the wallet's event journal, beginning with a sequence number.\n" +
+		"Events are recorded for wallet transactions entering the unmined set (txunmined), mined in (txmined) or detached from (txdetached) a main chain block, removed while unmined (txremoved), and removed after expiring unmined (txexpired), for each new main chain tip (tipchanged), and for tickets whose VSP fee payment was abandoned after failed retries (vspfeefailed).\n" +
+		"Events are recorded atomically with the changes they describe, so clients which resume from the next sequence number after disconnecting never miss an event.\n" +
+		"The same change may be recorded more than once, and clients must tolerate duplicate events.",
+	"getjournalevents-fromsequence": "The sequence number of the first event to return, beginning at 1",
+	"getjournalevents-count":        "The maximum number of events to return",
+
+	// GetJournalEventsResult help.
+	"getjournaleventsresult-events":       "The events in increasing sequence order",
+	"getjournaleventsresult-nextsequence": "The sequence number which will be assigned to the next recorded event",
+	"getjournaleventsresult-pruned":       "Whether events after fromsequence were removed from the journal and could not be returned",
+
+	// JournalEventResult help.
+	"journaleventresult-sequence":  "The sequence number of the event",
+	"journaleventresult-type":      "The event type (txunmined, txmined, txdetached, txremoved, txexpired, tipchanged, or vspfeefailed)",
+	"journaleventresult-txhash":    "The hash of the transaction, or of the ticket for vspfeefailed (omitted for tipchanged)",
+	"journaleventresult-blockhash": "The block the transaction was mined in or detached from, or the new tip block",
+	"journaleventresult-height":    "The height of the block (omitted for unmined transaction events)",
+	"journaleventresult-time":      "The Unix time the event was recorded",
+
 	// GetMasterPubkey help.
 	"getmasterpubkey--synopsis": "Requests the master pubkey from the wallet.",
 	"getmasterpubkey-account":   "The account to get the master pubkey for",
 	"getmasterpubkey--result0":  "The master pubkey for the wallet",
 
+	// GetMixedSpendPolicyCmd help.
+	"getmixedspendpolicy--synopsis": "Returns the mixed spend policy restricting the inputs of wallet-authored transactions.",
+
+	// GetMixedSpendPolicyResult help.
+	"getmixedspendpolicyresult-enabled": "Whether transactions may only spend mixed outputs",
+	"getmixedspendpolicyresult-account": "The mixed account (omitted when disabled)",
+	"getmixedspendpolicyresult-branch":  "The branch of the mixed account receiving mixed outputs",
+
+	// GetMixPolicyCmd help.
+	"getmixpolicy--synopsis": "Returns the minimum peer count and peer protocol version required of mixes.",
+
+	// GetMixPolicyResult help.
+	"getmixpolicyresult-minpeers":       "The minimum number of peers required to complete a mix",
+	"getmixpolicyresult-minpeerversion": "The minimum protocol version of network peers that mixing messages are exchanged with",
+
 	// GetMultisigOutInfo help.
 	"getmultisigoutinfo--synopsis": "Returns information about a multisignature output.",
 	"getmultisigoutinfo-index":     "Index of input.",
@@ -370,10 +964,13 @@ var helpDescsEnUS = map[string]string{
 	"getmultisigoutinforesult-address":      "Script address.",
 
 	// GetNewAddressCmd help.
-	"getnewaddress--synopsis": "Generates and returns a new payment address.",
-	"getnewaddress-account":   "Account name the new address will belong to (default=\"default\")",
-	"getnewaddress-gappolicy": `String defining the policy to use when the BIP0044 gap limit would be violated, may be "error", "ignore", or "wrap"`,
-	"getnewaddress--result0":  "The payment address",
+	"getnewaddress--synopsis": "Generates and returns a new payment address.\n" +
+		"Addresses issued with an expiry time or height are reported by listexpiredaddresses if they expire before receiving a payment.",
+	"getnewaddress-account":      "Account name the new address will belong to (default=\"default\")",
+	"getnewaddress-gappolicy":    `String defining the policy to use when the BIP0044 gap limit would be violated, may be "error", "ignore", or "wrap"`,
+	"getnewaddress-expiresin":    "Optional number of seconds after which the address expires",
+	"getnewaddress-expiryheight": "Optional block height at which the address expires",
+	"getnewaddress--result0":     "The payment address",
 
 	// GetPeerInfoCmd help.
 	"getpeerinfo--synopsis": "Returns data on remote peers when in spv mode.",
@@ -405,6 +1002,16 @@ var helpDescsEnUS = map[string]string{
 	"getreceivedbyaddress-minconf":   "Minimum number of block confirmations required before an output's value is included in the total",
 	"getreceivedbyaddress--result0":  "The total received amount valued in decred",
 
+	// GetReservedBalanceCmd help.
+	"getreservedbalance--synopsis": "Returns the spendable balance of an account along with the value of outputs reserved for orders and the remaining available balance.",
+	"getreservedbalance-account":   "The account to query",
+	"getreservedbalance-minconf":   "Minimum number of block confirmations required before an output is considered spendable",
+
+	// GetReservedBalanceResult help.
+	"getreservedbalanceresult-spendable": "The spendable balance of the account",
+	"getreservedbalanceresult-reserved":  "The total value of outputs reserved from the account",
+	"getreservedbalanceresult-available": "The spendable balance not reserved for orders",
+
 	// GetStakeInfo help.
 	"getstakeinfo--synopsis": "Returns statistics about staking from the wallet.",
 
@@ -437,6 +1044,28 @@ var helpDescsEnUS = map[string]string{
 	// GetTicketsResult help.
 	"getticketsresult-hashes": "Hashes of the tickets owned by the wallet encoded as strings",
 
+	// GetTicketPoolsCmd help.
+	"getticketpools--synopsis": "Returns the unspent, unexpired tickets of the wallet grouped by the VSP managing each ticket, with a histogram of ticket expiry heights.\n" +
+		"Tickets not managed by any VSP are reported with an empty host. Live tickets may include missed tickets which are not yet revoked.",
+	"getticketpools-interval": "The number of blocks in each interval of the expiry histogram (default is one day of blocks)",
+	"getticketpools--result0": "The tickets managed by each VSP, ordered by host",
+
+	// TicketPoolResult help.
+	"ticketpoolresult-host":        "The VSP host, or empty for tickets not managed by a VSP",
+	"ticketpoolresult-tickets":     "The total number of tickets",
+	"ticketpoolresult-unmined":     "The number of unmined tickets",
+	"ticketpoolresult-immature":    "The number of immature tickets",
+	"ticketpoolresult-live":        "The number of mature tickets which are not spent or expired",
+	"ticketpoolresult-feepending":  "The number of tickets whose VSP fee payment is not confirmed by the VSP",
+	"ticketpoolresult-lockedvalue": "The total ticket price of all tickets",
+	"ticketpoolresult-expiries":    "Intervals of block heights in which mined tickets expire, in order of increasing height",
+
+	// TicketExpiriesResult help.
+	"ticketexpiriesresult-startheight": "The first block height of the interval",
+	"ticketexpiriesresult-endheight":   "The last block height of the interval",
+	"ticketexpiriesresult-tickets":     "The number of tickets expiring in the interval",
+	"ticketexpiriesresult-value":       "The total ticket price of tickets expiring in the interval",
+
 	// GetTransactionCmd help.
 	"gettransaction--synopsis":        "Returns a JSON object with details regarding a transaction relevant to this wallet.",
 	"gettransaction-txid":             "Hash of the transaction to query",
@@ -480,10 +1109,104 @@ var helpDescsEnUS = map[string]string{
 	"getvotechoicesresult-version": "The latest stake version supported by the software and the version of the included agendas",
 	"getvotechoicesresult-choices": "The currently configured agenda vote choices, including abstaining votes",
 
+	// GetVoteDiagnosticsCmd help.
+	"getvotediagnostics--synopsis": "Returns the timing of the most recent votes created by the wallet, most recent first, to diagnose votes broadcast late due to a slow network backend or vote cosigner.\n" +
+		"Durations are measured from when the wallet was notified of the winning tickets of the voted block.\n" +
+		"Diagnostics are kept in memory for up to 512 votes since the wallet was opened.",
+	"getvotediagnostics-count":    "Maximum number of votes to return",
+	"getvotediagnostics--result0": "Diagnostics of each vote",
+
+	// VoteDiagnosticResult help.
+	"votediagnosticresult-ticket":      "The hash of the voting ticket",
+	"votediagnosticresult-vote":        "The hash of the vote transaction",
+	"votediagnosticresult-blockhash":   "The hash of the voted block",
+	"votediagnosticresult-blockheight": "The height of the voted block",
+	"votediagnosticresult-notified":    "The unix time the winning tickets notification was received",
+	"votediagnosticresult-signedms":    "Milliseconds from the notification until the vote was signed, including vote cosigners",
+	"votediagnosticresult-broadcastms": "Milliseconds from the notification until the network backend accepted or rejected the vote",
+	"votediagnosticresult-backend":     "The kind of network backend the vote was published with",
+	"votediagnosticresult-peers":       "The addresses of the network backend's peers or servers",
+	"votediagnosticresult-size":        "The serialized size of the vote in bytes",
+	"votediagnosticresult-fee":         "The fee paid by the vote in DCR",
+	"votediagnosticresult-error":       "The error publishing the votes of the block, if any",
+
+	// GetVoteVersionInfoCmd help.
+	"getvoteversioninfo--synopsis": "Returns the agendas of the wallet's vote version with the saved default vote choices, the vote versions of the wallet and network, and warnings about saved choices made obsolete by a vote version upgrade",
+
+	// GetVoteVersionInfoResult help.
+	"getvoteversioninforesult-voteversion":         "The vote version of votes created by the wallet and the version of the included agendas",
+	"getvoteversioninforesult-networkstakeversion": "The stake version of the main chain tip block, or 0 if unknown",
+	"getvoteversioninforesult-agendas":             "The agendas of the wallet's vote version",
+	"getvoteversioninforesult-obsoletechoices":     "Saved vote choices which are not applied to the wallet's vote version",
+	"getvoteversioninforesult-warnings":            "Conditions requiring attention, such as saved choices which are no longer applied",
+
+	// VoteVersionAgenda help.
+	"voteversionagenda-agendaid":    "The ID of the agenda",
+	"voteversionagenda-description": "The description of the agenda",
+	"voteversionagenda-choices":     "The IDs of the choices defined by the agenda",
+	"voteversionagenda-starttime":   "The unix time at which voting on the agenda begins",
+	"voteversionagenda-expiretime":  "The unix time at which the agenda expires",
+	"voteversionagenda-expired":     "Whether the agenda has expired",
+	"voteversionagenda-choiceid":    "The default vote choice, or abstain if no choice is saved",
+	"voteversionagenda-saved":       "Whether the default vote choice was saved by the wallet",
+
+	// ObsoleteVoteChoice help.
+	"obsoletevotechoice-version":  "The vote version the choice was saved for",
+	"obsoletevotechoice-agendaid": "The ID of the agenda",
+	"obsoletevotechoice-choiceid": "The saved choice ID",
+
 	// GetWalletFeeCmd help.
 	"getwalletfee--synopsis": "Get currently set transaction fee for the wallet",
 	"getwalletfee--result0":  "Current tx fee (in DCR)",
 
+	// GetIdleLockCmd help.
+	"getidlelock--synopsis": "Returns the idle lock timeout and the remaining time before the wallet is locked without signing activity.\n" +
+		"Signing transactions, messages, or hashes, and decrypting messages, restarts the timeout.",
+	"getidlelock--result0": "The idle lock state",
+
+	// IdleLockResult help.
+	"idlelockresult-timeout":   "The idle lock timeout in seconds, or 0 when the idle lock is disabled",
+	"idlelockresult-active":    "Whether the wallet is unlocked and will be locked after the timeout",
+	"idlelockresult-remaining": "Seconds remaining before the wallet is locked due to inactivity, or 0 when not active",
+
+	// GetUTXOStatsCmd help.
+	"getutxostats--synopsis": "Returns statistics of the unspent outputs of each account, ordered by account number, to guide consolidation and mixing.\n" +
+		"Coin ages are measured in blocks as the number of confirmations of each output, and unmined outputs have an age of zero.\n" +
+		"Ticket submission outputs and outputs spent by unmined transactions are not included.",
+	"getutxostats-account":       "If set, only returns statistics of this account",
+	"getutxostats-dustthreshold": "Optional value in DCR below which outputs are counted as dust (default is the dust limit at the wallet's relay fee)",
+	"getutxostats--result0":      "Statistics of each account with unspent outputs",
+
+	// UTXOStatsResult help.
+	"utxostatsresult-account":   "The account name",
+	"utxostatsresult-count":     "The number of unspent outputs",
+	"utxostatsresult-total":     "The total value of the unspent outputs",
+	"utxostatsresult-medianage": "The median coin age in blocks",
+	"utxostatsresult-meanage":   "The mean coin age in blocks",
+	"utxostatsresult-dustcount": "The number of dust outputs",
+	"utxostatsresult-dusttotal": "The total value of dust outputs",
+	"utxostatsresult-largest":   "The value of the largest unspent output",
+	"utxostatsresult-smallest":  "The value of the smallest unspent output",
+
+	// GetWalletStatsCmd help.
+	"getwalletstats--synopsis": "Returns statistics of wallet transactions mined in a range of main chain blocks, divided into intervals.\n" +
+		"The range is limited by the main chain tip.",
+	"getwalletstats-startheight": "The first block height of the range",
+	"getwalletstats-endheight":   "The last block height of the range",
+	"getwalletstats-interval":    "The number of blocks in each interval (default is the entire range)",
+	"getwalletstats--result0":    "Statistics of each interval, in order of increasing height",
+
+	// WalletStatsResult help.
+	"walletstatsresult-startheight":     "The first block height of the interval",
+	"walletstatsresult-endheight":       "The last block height of the interval",
+	"walletstatsresult-transactions":    "The number of wallet transactions mined in the interval",
+	"walletstatsresult-totalin":         "The total value of outputs paying to the wallet",
+	"walletstatsresult-totalout":        "The total value of spent wallet outputs",
+	"walletstatsresult-fees":            "The total fees of transactions spending only wallet outputs",
+	"walletstatsresult-ticketpurchases": "The number of ticket purchases",
+	"walletstatsresult-votes":           "The number of votes",
+	"walletstatsresult-revocations":     "The number of revocations",
+
 	// HelpCmd help.
 	"help--synopsis":   "Returns a list of all commands or help for a specified command.",
 	"help-command":     "The command to retrieve help for",
@@ -511,25 +1234,85 @@ var helpDescsEnUS = map[string]string{
 	"importcfiltersv2-startheight": "The starting block height for this list of cfilters",
 	"importcfiltersv2-filters":     "The list of hex-encoded cfilters",
 
+	// ImportCheckpointCmd help.
+	"importcheckpoint--synopsis": "Restores the account address cursors recorded by a wallet checkpoint written with the --checkpoint option, typically after restoring the wallet from seed.\n" +
+		"The next address discovery only searches for address usage in blocks after the checkpoint block.\n" +
+		"The checkpoint must be authenticated by a key derived from this wallet's seed, and the wallet must be unlocked to verify it.",
+	"importcheckpoint-checkpoint": "The contents of the checkpoint file",
+
+	// ImportCheckpointResult help.
+	"importcheckpointresult-blockhash":   "The hash of the last processed block recorded by the checkpoint",
+	"importcheckpointresult-blockheight": "The height of the last processed block recorded by the checkpoint",
+	"importcheckpointresult-created":     "The Unix time the checkpoint was written",
+	"importcheckpointresult-accounts":    "The number of accounts restored by the checkpoint",
+
+	// ImportLegacyStakePoolTicketsCmd help.
+	"importlegacystakepooltickets--synopsis": "Imports the voting script of a legacy stake pool and records tickets purchased through the pool as managed by it.\n" +
+		"Tickets must vote with the pool script and pay the pool fee address in their first commitment.\n" +
+		"Tickets not yet recorded by the wallet are discovered by rescanning after the script is imported.",
+	"importlegacystakepooltickets-host":           "The URL of the stake pool, recorded as the VSP host of each ticket",
+	"importlegacystakepooltickets-script":         "The hex-encoded 1-of-2 multisig redeem script of the pool ticket address",
+	"importlegacystakepooltickets-poolfeeaddress": "The pool fee address committed to by pool tickets",
+	"importlegacystakepooltickets-tickets":        "Hashes of the tickets to import (default is all unspent wallet tickets purchased through the pool)",
+	"importlegacystakepooltickets--result0":       "The hashes of all imported tickets",
+
 	// ImportPrivKeyCmd help.
 	"importprivkey--synopsis": "Imports a WIF-encoded private key to the 'imported' account.",
 	"importprivkey-privkey":   "The WIF-encoded private key",
 	"importprivkey-label":     "Unused (must be unset or 'imported')",
 	"importprivkey-rescan":    "Rescan the blockchain (since the genesis block, or scanfrom block) for outputs controlled by the imported key",
-	"importprivkey-scanfrom":  "Block number for where to start rescan from",
+	"importprivkey-scanfrom":  "Block number for where to start rescan from.  The rescan is recorded by the wallet and resumed by the next sync if it does not complete",
 
 	// ImportPubKeyCmd help.
-	"importpubkey--synopsis": "Imports a compressed (33-byte) secp256k1 public key and the derived P2PKH address to the imported account.",
+	"importpubkey--synopsis": "Imports a compressed (33-byte) secp256k1 public key and the derived P2PKH address as a watched address of an account (the imported account by default).",
 	"importpubkey-pubkey":    "The hex-encoded 33-byte compressed public key",
-	"importpubkey-label":     "Unused (must be unset or 'imported')",
+	"importpubkey-label":     "Name of an existing account to assign the address to (default: 'imported')",
 	"importpubkey-rescan":    "Rescan the blockchain (since the genesis block, or scanfrom block) for outputs controlled by the imported key",
-	"importpubkey-scanfrom":  "Block number for where to start rescan from",
+	"importpubkey-scanfrom":  "Block number for where to start rescan from.  The rescan is recorded by the wallet and resumed by the next sync if it does not complete",
 
 	// ImportScript help.
-	"importscript--synopsis": "Import a redeem script.",
+	"importscript--synopsis": "Import a redeem script as a watched P2SH address of an account (the imported account by default). Outputs paying the script are never spent by the wallet.",
 	"importscript-hex":       "Hex encoded script to import",
 	"importscript-rescan":    "Rescans the blockchain (since the genesis block, or scanfrom block) for outputs controlled by the imported key",
-	"importscript-scanfrom":  "Block number for where to start rescan from",
+	"importscript-scanfrom":  "Block number for where to start rescan from.  The rescan is recorded by the wallet and resumed by the next sync if it does not complete",
+	"importscript-account":   "Name of an existing account to assign the P2SH address to (default: 'imported')",
+
+	// ImportTreasuryKeyCmd help.
+	"importtreasurykey--synopsis": "Imports the private key of a treasury spend signing key of the network for use by signtspend.\n" +
+		"The key is encrypted by the wallet passphrase, is not associated with any account, and is never exported.\n" +
+		"Requires an unlocked wallet started with the --treasurykeys option.",
+	"importtreasurykey-privkey":  "The WIF or hex encoded private key",
+	"importtreasurykey-name":     "A name describing the key",
+	"importtreasurykey--result0": "The hex encoded compressed public key of the imported key",
+
+	// ImportSLIP0044AccountCmd help.
+	"importslip0044account--synopsis": "Imports an account derived with the SLIP0044 coin type into a wallet using the legacy coin type, such as a wallet restored from a seed used with both coin types.\n" +
+		"The account is encrypted by its own passphrase and must be unlocked with unlockaccount before spending its funds. Rescan the wallet to discover usage of the account.",
+	"importslip0044account-account":    "The SLIP0044 account number",
+	"importslip0044account-passphrase": "The passphrase encrypting the imported account",
+	"importslip0044account-name":       "Name of the new account (default: 'slip0044-account-N')",
+	"importslip0044account--result0":   "The account number of the imported account",
+
+	// ImportExternalSignerAccountCmd help.
+	"importexternalsigneraccount--synopsis": "Import the account extended public key of a hardware signing device, such as a Ledger or Trezor, as a new watching-only account.\n" +
+		"The device and derivation path are reported by listexternalsigners so clients know which device must sign for the account.",
+	"importexternalsigneraccount-name":        "Name of new account",
+	"importexternalsigneraccount-xpub":        "Account extended public key exported by the device",
+	"importexternalsigneraccount-fingerprint": "Hex-encoded 4-byte fingerprint of the device's master key",
+	"importexternalsigneraccount-path":        "Derivation path of the account key from the master key (e.g. m/44'/42'/0')",
+	"importexternalsigneraccount-model":       "Device model (e.g. ledger or trezor)",
+	"importexternalsigneraccount--result0":    "The number of the imported account",
+
+	// ListExternalSignersCmd help.
+	"listexternalsigners--synopsis": "Lists the accounts whose keys are held by hardware signing devices.",
+	"listexternalsigners--result0":  "The external signer accounts",
+
+	// ExternalSignerResult help.
+	"externalsignerresult-account":     "The account number",
+	"externalsignerresult-accountname": "The account name",
+	"externalsignerresult-fingerprint": "Hex-encoded fingerprint of the device's master key",
+	"externalsignerresult-path":        "Derivation path of the account key from the master key",
+	"externalsignerresult-model":       "Device model",
 
 	// ImportXpub help.
 	"importxpub--synopsis": "Import a HD extended public key as a new account.",
@@ -570,10 +1353,47 @@ var helpDescsEnUS = map[string]string{
 	"listalltransactions--synopsis": "Returns a JSON array of objects in the same format as 'listtransactions' without limiting the number of returned objects.",
 	"listalltransactions-account":   "Unused (must be unset or \"*\")",
 
+	// ListFundsReservationsCmd help.
+	"listfundsreservations--synopsis": "Returns all current funds reservations.",
+	"listfundsreservations--result0":  "Array of funds reservations",
+
+	// ListExpiredAddressesCmd help.
+	"listexpiredaddresses--synopsis": "Returns addresses issued by getnewaddress with an expiry time or height which expired before receiving a payment, ordered by the time they were issued.\n" +
+		"Addresses which received a payment after expiring are included with the time and main chain tip height the payment was first observed, allowing late payments to be monitored.",
+	"listexpiredaddresses--result0": "The expired addresses",
+
+	// ExpiredAddressResult help.
+	"expiredaddressresult-address":      "The expired address",
+	"expiredaddressresult-account":      "The account of the address",
+	"expiredaddressresult-issued":       "The Unix time the address was issued",
+	"expiredaddressresult-expirytime":   "The Unix time the address expires (omitted when expiring only by height)",
+	"expiredaddressresult-expiryheight": "The block height the address expires (omitted when expiring only by time)",
+	"expiredaddressresult-paidtime":     "The Unix time a late payment was first observed (omitted if unpaid)",
+	"expiredaddressresult-paidheight":   "The main chain tip height when the late payment was first observed (omitted if unpaid)",
+
+	// ListInvoicesCmd help.
+	"listinvoices--synopsis": "Returns invoices created by createinvoice ordered by identifier.",
+	"listinvoices-account":   "If set, only returns invoices paid to this account",
+	"listinvoices-status":    "If set, only returns invoices with this status (pending, partial, paid, overpaid, or expired)",
+	"listinvoices--result0":  "The invoices",
+
 	// ListLockUnspentCmd help.
 	"listlockunspent--synopsis": "Returns a JSON array of outpoints marked as locked (with lockunspent) for this wallet session.",
 	"listlockunspent-account":   "If set, only returns outpoints from this account that are marked as locked",
 
+	// ListPendingSpendsCmd help.
+	"listpendingspends--synopsis": "Lists the payments created by the send methods which await approval when the wallet is run with --pendingspends.\n" +
+		"The inputs of pending spends remain locked until the spend is approved or rejected.",
+	"listpendingspends--result0": "The spends awaiting approval, ordered by transaction hash",
+
+	// PendingSpendResult help.
+	"pendingspendresult-txhash":   "The hash of the transaction, which is unchanged by signing",
+	"pendingspendresult-account":  "The account paying for the spend",
+	"pendingspendresult-proposed": "The Unix time at which the spend was created",
+	"pendingspendresult-amount":   "The total value of outputs not paying to wallet addresses",
+	"pendingspendresult-fee":      "The transaction fee",
+	"pendingspendresult-hex":      "The hex-encoded unsigned transaction",
+
 	// ListReceivedByAccountCmd help.
 	"listreceivedbyaccount--synopsis":        "Returns a JSON array of objects listing all accounts and the total amount received by each account.",
 	"listreceivedbyaccount-minconf":          "Minimum number of block confirmations required before a transaction is considered",
@@ -620,6 +1440,15 @@ var helpDescsEnUS = map[string]string{
 	"listtransactions-from":             "Number of transactions to skip before results are created",
 	"listtransactions-includewatchonly": "Unused",
 
+	// ListTreasuryKeysCmd help.
+	"listtreasurykeys--synopsis": "Returns the imported treasury spend signing keys.",
+	"listtreasurykeys--result0":  "Array of imported treasury keys",
+
+	// TreasuryKeyResult help.
+	"treasurykeyresult-pubkey":  "The hex encoded compressed public key",
+	"treasurykeyresult-name":    "The name of the key",
+	"treasurykeyresult-created": "The Unix time the key was imported",
+
 	// ListTransactionsResult help.
 	"listtransactionsresult-account":           "DEPRECATED -- Unset",
 	"listtransactionsresult-address":           "Payment address for a transaction output",
@@ -661,6 +1490,10 @@ var helpDescsEnUS = map[string]string{
 	"listunspentresult-txtype":        "The type of the transaction",
 	"listunspentresult-tree":          "The tree the transaction comes from",
 
+	// ListWebhooksCmd help.
+	"listwebhooks--synopsis": "Returns all registered webhooks.",
+	"listwebhooks--result0":  "Array of registered webhooks",
+
 	// LockAccountCmd help.
 	"lockaccount--synopsis": "Lock an individually-encrypted account",
 	"lockaccount-account":   "Account to lock",
@@ -669,11 +1502,24 @@ var helpDescsEnUS = map[string]string{
 	"lockunspent--synopsis": "Locks or unlocks an unspent output.\n" +
 		"Locked outputs are not chosen for transaction inputs of authored transactions and are not included in 'listunspent' results.\n" +
 		"Locked outputs are volatile and are not saved across wallet restarts.\n" +
-		"If unlock is true and no transaction outputs are specified, all locked outputs are marked unlocked.",
+		"If unlock is true and no transaction outputs are specified, all locked outputs are marked unlocked.\n" +
+		"Outputs held by holdoutputs or holdamount are only unlocked by releasehold.",
 	"lockunspent-unlock":       "True to unlock outputs, false to lock",
 	"lockunspent-transactions": "Transaction outputs to lock or unlock",
 	"lockunspent--result0":     "The boolean 'true'",
 
+	// MatchCFiltersCmd help.
+	"matchcfilters--synopsis": "Returns the main chain blocks in a height range whose version 2 compact filters match any wallet script, without fetching or processing the blocks.\n" +
+		"Scripts of all imported addresses and of HD account addresses through the gap limit beyond the last returned address are matched.\n" +
+		"Compact filters may report false positives, but never omit a block paying to or spending from a matched script, so the result lists every block that must be inspected to audit the wallet's transactions.",
+	"matchcfilters-startheight": "The height of the first block to match",
+	"matchcfilters-endheight":   "The height of the last block to match, which may not be above the main chain tip",
+	"matchcfilters--result0":    "The matching blocks in increasing height order",
+
+	// MatchCFiltersResult help.
+	"matchcfiltersresult-hash":   "The hash of the matching block",
+	"matchcfiltersresult-height": "The height of the matching block",
+
 	// MixAccount help.
 	"mixaccount--synopsis": "Mix all outputs of an account.",
 	"mixaccount-account":   "Account to mix",
@@ -682,6 +1528,48 @@ var helpDescsEnUS = map[string]string{
 	"mixoutput--synopsis": "Mix a specific output.",
 	"mixoutput-outpoint":  `Outpoint (in form "txhash:index") to mix`,
 
+	// PreviewMixAccountCmd help.
+	"previewmixaccount--synopsis": "Reports how each output of the mixing change account eligible for mixing would be split by mixaccount, at the current ticket price and relay fee, without mixing or deriving addresses.\n" +
+		"A single mixaccount call mixes a limited number of randomly selected outputs, so previewed outputs may require several mixes.",
+	"previewmixaccount--result0": "Array of output splits, ordered by decreasing amount",
+
+	// MixSplitPreviewResult help.
+	"mixsplitpreviewresult-outpoint":     `The outpoint (in form "txhash:index") to be mixed`,
+	"mixsplitpreviewresult-amount":       "The output amount",
+	"mixsplitpreviewresult-denomination": "The denomination of the mixed outputs, or zero if the output can not be mixed",
+	"mixsplitpreviewresult-count":        "The number of mixed outputs",
+	"mixsplitpreviewresult-change":       "The unmixed change amount",
+	"mixsplitpreviewresult-fee":          "The estimated fee paid by the output",
+
+	// PregenerateAddressesCmd help.
+	"pregenerateaddresses--synopsis": "Returns the next addresses of an account branch, ignoring the unused address gap limit.\n" +
+		"The addresses are recorded as returned and watched for payments, allowing watching-only wallets to hand out addresses far ahead of observed usage.\n" +
+		"The gap limit of watching-only wallets may also be raised with the --watchingonlygaplimit option.",
+	"pregenerateaddresses-account":  "The account of the addresses",
+	"pregenerateaddresses-count":    "The number of addresses to generate (at most 10000)",
+	"pregenerateaddresses-branch":   "The account branch (0 for external, 1 for internal)",
+	"pregenerateaddresses--result0": "The generated addresses, in order of child index",
+
+	// ParsePaymentURICmd help.
+	"parsepaymenturi--synopsis": "Parses a decred: payment request URI.\n" +
+		"When the URI pays an address issued by createpaymenturi, the recorded request and the amount received by the address are also returned.",
+	"parsepaymenturi-uri": "The payment request URI",
+
+	// ParsePaymentURIResult help.
+	"parsepaymenturiresult-address": "The address to pay",
+	"parsepaymenturiresult-amount":  "The requested amount in DCR (omitted if no amount was requested)",
+	"parsepaymenturiresult-label":   "The label of the payment recipient (omitted if empty)",
+	"parsepaymenturiresult-message": "The message describing the payment (omitted if empty)",
+	"parsepaymenturiresult-expiry":  "The Unix time the request expires (omitted if the request does not expire)",
+	"parsepaymenturiresult-request": "The request recorded by this wallet (omitted if the address was not issued by createpaymenturi)",
+
+	// PaymentRequestResult help.
+	"paymentrequestresult-account":  "The account of the address",
+	"paymentrequestresult-issued":   "The Unix time the request was issued",
+	"paymentrequestresult-received": "The total amount in DCR of mined outputs received by the address",
+	"paymentrequestresult-paid":     "Whether the address has received at least the requested amount, or any amount when no amount was requested",
+	"paymentrequestresult-expired":  "Whether the request has expired",
+
 	// PurchaseTicketCmd help.
 	"purchaseticket--synopsis":          "Purchase ticket using available funds.",
 	"purchaseticket--result0":           "Hash of the resulting ticket",
@@ -693,11 +1581,69 @@ var helpDescsEnUS = map[string]string{
 	"purchaseticket-nosplittransaction": "Use ticket purchase change outputs instead of a split transaction",
 	"purchaseticket-comment":            "Unused",
 	"purchaseticket-dontsigntx":         "Return unsigned split and ticket transactions instead of signing and publishing",
+	"purchaseticket-idempotencykey":     "Optional key identifying the request; retrying with the same key returns the original ticket hashes instead of purchasing again (unsupported with dontsigntx)",
+	"purchaseticket-mixsplit":           "Fund the tickets with a split transaction mixed through CoinShuffle++ (default: true when --mixing is enabled and dontsigntx is unset; unsupported with dontsigntx)",
+	"purchaseticket-votingscript": "Hex encoded multisig redeem script whose P2SH address receives the voting rights of the tickets (default: addresses of the purchasing or mixed account).\n" +
+		"The script is imported, and the wallet must hold the private keys of the required number of signatures, or some keys with vote cosigners configured.\n" +
+		"May not be used when a VSP is configured.",
 
 	// ProcessUnmanagedTicket help.
 	"processunmanagedticket--synopsis":  "Processes tickets for vsp client based on ticket hash.",
 	"processunmanagedticket-tickethash": "The ticket hash of ticket to be processed by the vsp client.",
 
+	// ProveVSPOwnershipCmd help.
+	"provevspownership--synopsis": "Signs a VSP API request of a wallet ticket, proving ownership of the ticket to the VSP in the format required by the API version.\n" +
+		"Version 3 requests are JSON objects identifying the ticket by their tickethash field, and are signed by the key of the ticket commitment address.\n" +
+		"Requires the wallet to be unlocked.",
+	"provevspownership-tickethash": "The hash of the ticket",
+	"provevspownership-request":    "The exact request body sent to the VSP",
+	"provevspownership-apiversion": "The VSP API version",
+
+	// ProveVSPOwnershipResult help.
+	"provevspownershipresult-address":   "The address whose key signed the request",
+	"provevspownershipresult-header":    "The HTTP header sending the signature to the VSP",
+	"provevspownershipresult-signature": "The base64-encoded signature",
+
+	// PruneSidechainsCmd help.
+	"prunesidechains--synopsis": "Removes the saved block headers and cfilters of blocks which were reorganized out of the main chain, and cfilters of blocks without saved headers.\n" +
+		"Records of main chain blocks are never removed, and pruned records are saved again if their blocks return to the main chain.",
+	"prunesidechains-depth": "Only prune records of blocks at least this many blocks below the main chain tip",
+
+	// PruneSidechainsResult help.
+	"prunesidechainsresult-prunedheaders":  "The number of removed headers",
+	"prunesidechainsresult-prunedcfilters": "The number of removed cfilters",
+	"prunesidechainsresult-headers":        "The number of retained headers of blocks not in the main chain",
+	"prunesidechainsresult-cfilters":       "The number of retained cfilters of blocks not in the main chain",
+
+	// RecoverMixOutputsCmd help.
+	"recovermixoutputs--synopsis": "Searches both branches of mixing accounts for outputs stranded by interrupted mixes, such as outputs paying to addresses beyond those recorded by the wallet.\n" +
+		"Addresses beyond the last used or returned address of each branch are watched, the block chain is rescanned, and the address cursors are moved beyond any used addresses found.\n" +
+		"Recovered outputs become spendable wallet funds.",
+	"recovermixoutputs-accounts":    "The accounts to search (default: the configured mixed and change accounts)",
+	"recovermixoutputs-scanlen":     "The number of addresses of each branch to search beyond the last used or returned address",
+	"recovermixoutputs-startheight": "The height of the first block to rescan",
+
+	// RecoverMixOutputsResult help.
+	"recovermixoutputsresult-branches": "The address cursors of each searched account branch",
+	"recovermixoutputsresult-outputs":  "Unspent outputs paying to addresses beyond the last used address of their branch before recovery",
+
+	// RecoveredMixBranch help.
+	"recoveredmixbranch-account":            "The account name",
+	"recoveredmixbranch-branch":             "The account branch (0 for external, 1 for internal)",
+	"recoveredmixbranch-lastusedbefore":     "The last used child index before recovery, or -1",
+	"recoveredmixbranch-lastreturnedbefore": "The last returned child index before recovery, or -1",
+	"recoveredmixbranch-lastused":           "The last used child index after recovery, or -1",
+	"recoveredmixbranch-lastreturned":       "The last returned child index after recovery, or -1",
+
+	// RecoveredMixOutput help.
+	"recoveredmixoutput-txhash":       "The transaction hash of the output",
+	"recoveredmixoutput-vout":         "The output index",
+	"recoveredmixoutput-amount":       "The output value in DCR",
+	"recoveredmixoutput-account":      "The account name",
+	"recoveredmixoutput-branch":       "The account branch (0 for external, 1 for internal)",
+	"recoveredmixoutput-index":        "The child index of the output address",
+	"recoveredmixoutput-unreferenced": "Whether the address was beyond the last returned address of its branch before recovery",
+
 	// RedeemMultiSigout help.
 	"redeemmultisigout--synopsis": "Takes the input and constructs a P2PKH paying to the specified address.",
 	"redeemmultisigout-address":   "Address to pay to.",
@@ -715,6 +1661,39 @@ var helpDescsEnUS = map[string]string{
 	"redeemmultisigouts-toaddress":      "Address to look for (if not internal addresses).",
 	"redeemmultisigouts-fromscraddress": "Input script hash address.",
 
+	// RedeemSwapCmd help.
+	"redeemswap--synopsis":  "Redeems an atomic swap contract by revealing its secret, paying the contract value less fees to a new internal address of an account.",
+	"redeemswap-account":    "The account to receive the redeemed value",
+	"redeemswap-contracttx": "The hex encoded transaction paying to the contract",
+	"redeemswap-contract":   "The hex encoded contract script",
+	"redeemswap-secret":     "The hex encoded 32 byte secret",
+	"redeemswap--result0":   "The published transaction hash",
+
+	// RefundSwapCmd help.
+	"refundswap--synopsis":  "Refunds an atomic swap contract after its locktime, paying the contract value less fees to a new internal address of an account.",
+	"refundswap-account":    "The account to receive the refunded value",
+	"refundswap-contracttx": "The hex encoded transaction paying to the contract",
+	"refundswap-contract":   "The hex encoded contract script",
+	"refundswap--result0":   "The published transaction hash",
+
+	// ReleaseFundsCmd help.
+	"releasefunds--synopsis": "Releases a funds reservation, unlocking its reserved outputs.",
+	"releasefunds-id":        "The identifier of the reservation",
+
+	// RemoveWebhookCmd help.
+	"removewebhook--synopsis": "Removes a registered webhook.",
+	"removewebhook-id":        "The identifier of the webhook",
+
+	// RemoveTreasuryKeyCmd help.
+	"removetreasurykey--synopsis": "Removes an imported treasury spend signing key.\n" +
+		"Requires an unlocked wallet started with the --treasurykeys option.",
+	"removetreasurykey-pubkey": "The hex encoded public key of the key to remove",
+
+	// RejectPendingSpendCmd help.
+	"rejectpendingspend--synopsis": "Removes a spend awaiting approval without signing it, unlocking its inputs.\n" +
+		"This method may only be called by the spend approval user (--approveusername).",
+	"rejectpendingspend-txhash": "The transaction hash of the pending spend",
+
 	// RenameAccountCmd help.
 	"renameaccount--synopsis":  "Renames an account.",
 	"renameaccount-oldaccount": "The old account name to rename",
@@ -724,16 +1703,68 @@ var helpDescsEnUS = map[string]string{
 	"rescanwallet--synopsis":   "Rescan the block chain for wallet data, blocking until the rescan completes or exits with an error",
 	"rescanwallet-beginheight": "The height of the first block to begin the rescan from",
 
+	// ReserveFundsCmd help.
+	"reservefunds--synopsis": "Selects and locks unspent outputs of an account to fund an order.\n" +
+		"Reserved outputs are not spent by other transactions until released with releasefunds.\n" +
+		"Reservations are recorded in the wallet database and remain in effect across restarts.",
+	"reservefunds-id":      "A unique identifier for the reservation, such as an order ID",
+	"reservefunds-account": "The account to reserve outputs from",
+	"reservefunds-amount":  "The minimum total value of outputs to reserve",
+	"reservefunds-minconf": "Minimum number of block confirmations required for reserved outputs",
+
+	// FundsReservationResult help.
+	"fundsreservationresult-id":        "The identifier of the reservation",
+	"fundsreservationresult-account":   "The account of the reserved outputs",
+	"fundsreservationresult-outpoints": "The reserved outpoints",
+	"fundsreservationresult-amount":    "The total value of the reserved outputs",
+
+	// HoldOutputsCmd help.
+	"holdoutputs--synopsis": "Places a hold on unspent outputs of a single account, such as to comply with a legal hold.\n" +
+		"Held outputs are not spent by authored transactions, are not unlocked by lockunspent, and remain held across wallet restarts until released with releasehold.\n" +
+		"Outputs which are already locked, such as the inputs of pending spends or funds reservations, may not be held.",
+	"holdoutputs-id":        "A unique identifier for the hold",
+	"holdoutputs-reason":    "The reason for the hold",
+	"holdoutputs-outpoints": "The outpoints to hold, formatted as txid:index",
+
+	// HoldAmountCmd help.
+	"holdamount--synopsis": "Selects unspent outputs of an account totaling at least an amount and places a hold on them.\n" +
+		"Held outputs are not spent by authored transactions, are not unlocked by lockunspent, and remain held across wallet restarts until released with releasehold.",
+	"holdamount-id":      "A unique identifier for the hold",
+	"holdamount-account": "The account to hold outputs of",
+	"holdamount-amount":  "The minimum total value of outputs to hold",
+	"holdamount-reason":  "The reason for the hold",
+	"holdamount-minconf": "Minimum number of block confirmations required for held outputs",
+
+	// ListHoldsCmd help.
+	"listholds--synopsis": "Returns all holds placed by holdoutputs and holdamount.",
+	"listholds--result0":  "Array of holds",
+
+	// ReleaseHoldCmd help.
+	"releasehold--synopsis": "Releases a hold, unlocking its held outputs.",
+	"releasehold-id":        "The identifier of the hold",
+
+	// HoldResult help.
+	"holdresult-id":        "The identifier of the hold",
+	"holdresult-account":   "The account of the held outputs",
+	"holdresult-amount":    "The amount requested by holdamount, or zero for holds placed by holdoutputs",
+	"holdresult-held":      "The total value of the held outputs which remain unspent",
+	"holdresult-reason":    "The reason for the hold",
+	"holdresult-created":   "The Unix time the hold was placed",
+	"holdresult-outpoints": "The held outpoints",
+
 	// SendFromCmd help.
 	"sendfrom--synopsis": "Authors, signs, and sends a transaction that outputs some amount to a payment address.\n" +
 		"A change output is automatically included to send extra output value back to the original account.",
-	"sendfrom-fromaccount": "Account to pick unspent outputs from",
-	"sendfrom-toaddress":   "Address to pay",
-	"sendfrom-amount":      "Amount to send to the payment address valued in decred",
-	"sendfrom-minconf":     "Minimum number of block confirmations required before a transaction output is eligible to be spent",
-	"sendfrom-comment":     "Unused",
-	"sendfrom-commentto":   "Unused",
-	"sendfrom--result0":    "The transaction hash of the sent transaction",
+	"sendfrom-fromaccount":         "Account to pick unspent outputs from",
+	"sendfrom-toaddress":           "Address to pay",
+	"sendfrom-amount":              "Amount to send to the payment address valued in decred",
+	"sendfrom-minconf":             "Minimum number of block confirmations required before a transaction output is eligible to be spent",
+	"sendfrom-comment":             "Unused",
+	"sendfrom-commentto":           "Unused",
+	"sendfrom-idempotencykey":      "Optional key identifying the request; retrying with the same key returns the original transaction hash instead of sending again",
+	"sendfrom-destinationoverride": "Optional credential set by setdestinationoverride permitting payment to destinations prohibited by the destination policy of the account",
+	"sendfrom-expiryblocks":        "Optional number of blocks in which the transaction must be mined before expiring, overriding --txexpiry (0 for no expiry)",
+	"sendfrom--result0":            "The transaction hash of the sent transaction",
 
 	// SendFromTreasuryCmd help.
 	"sendfromtreasury--synopsis":      "Send from treasury balance to multiple recipients.",
@@ -747,14 +1778,17 @@ var helpDescsEnUS = map[string]string{
 	// SendManyCmd help.
 	"sendmany--synopsis": "Authors, signs, and sends a transaction that outputs to many payment addresses.\n" +
 		"A change output is automatically included to send extra output value back to the original account.",
-	"sendmany-fromaccount":    "Account to pick unspent outputs from",
-	"sendmany-amounts":        "Pairs of payment addresses and the output amount to pay each",
-	"sendmany-amounts--desc":  "JSON object using payment addresses as keys and output amounts valued in decred to send to each address",
-	"sendmany-amounts--key":   "Address to pay",
-	"sendmany-amounts--value": "Amount to send to the payment address valued in decred",
-	"sendmany-minconf":        "Minimum number of block confirmations required before a transaction output is eligible to be spent",
-	"sendmany-comment":        "Unused",
-	"sendmany--result0":       "The transaction hash of the sent transaction",
+	"sendmany-fromaccount":         "Account to pick unspent outputs from",
+	"sendmany-amounts":             "Pairs of payment addresses and the output amount to pay each",
+	"sendmany-amounts--desc":       "JSON object using payment addresses as keys and output amounts valued in decred to send to each address",
+	"sendmany-amounts--key":        "Address to pay",
+	"sendmany-amounts--value":      "Amount to send to the payment address valued in decred",
+	"sendmany-minconf":             "Minimum number of block confirmations required before a transaction output is eligible to be spent",
+	"sendmany-comment":             "Unused",
+	"sendmany-idempotencykey":      "Optional key identifying the request; retrying with the same key returns the original transaction hash instead of sending again",
+	"sendmany-destinationoverride": "Optional credential set by setdestinationoverride permitting payment to destinations prohibited by the destination policy of the account",
+	"sendmany-expiryblocks":        "Optional number of blocks in which the transaction must be mined before expiring, overriding --txexpiry (0 for no expiry)",
+	"sendmany--result0":            "The transaction hash of the sent transaction",
 
 	// SendRawTransactionCmd help.
 	"sendrawtransaction--synopsis":     "Submits the serialized, hex-encoded transaction to the local peer and relays it to the network.",
@@ -766,11 +1800,14 @@ var helpDescsEnUS = map[string]string{
 	"sendtoaddress--synopsis": "Authors, signs, and sends a transaction that outputs some amount to a payment address.\n" +
 		"Unlike sendfrom, outputs are always chosen from the default account.\n" +
 		"A change output is automatically included to send extra output value back to the original account.",
-	"sendtoaddress-address":   "Address to pay",
-	"sendtoaddress-amount":    "Amount to send to the payment address valued in decred",
-	"sendtoaddress-comment":   "Unused",
-	"sendtoaddress-commentto": "Unused",
-	"sendtoaddress--result0":  "The transaction hash of the sent transaction",
+	"sendtoaddress-address":             "Address to pay",
+	"sendtoaddress-amount":              "Amount to send to the payment address valued in decred",
+	"sendtoaddress-comment":             "Unused",
+	"sendtoaddress-commentto":           "Unused",
+	"sendtoaddress-idempotencykey":      "Optional key identifying the request; retrying with the same key returns the original transaction hash instead of sending again",
+	"sendtoaddress-destinationoverride": "Optional credential set by setdestinationoverride permitting payment to destinations prohibited by the destination policy of the account",
+	"sendtoaddress-expiryblocks":        "Optional number of blocks in which the transaction must be mined before expiring, overriding --txexpiry (0 for no expiry)",
+	"sendtoaddress--result0":            "The transaction hash of the sent transaction",
 
 	// SendToMultisigCmd help.
 	"sendtomultisig--synopsis": "Authors, signs, and sends a transaction that outputs some amount to a multisig address.\n" +
@@ -785,9 +1822,11 @@ var helpDescsEnUS = map[string]string{
 	"sendtomultisig--result0":    "The transaction hash of the sent transaction",
 
 	// SendToTreasuryCmd help.
-	"sendtotreasury--synopsis": "Send decred to treasury",
-	"sendtotreasury-amount":    "Amount to send to treasury",
-	"sendtotreasury--result0":  "The transaction hash of the sent transaction",
+	"sendtotreasury--synopsis":   "Send decred to treasury",
+	"sendtotreasury-amount":      "Amount to send to treasury",
+	"sendtotreasury-fromaccount": "Account to pick unspent outputs from",
+	"sendtotreasury-minconf":     "Minimum number of block confirmations required before a transaction output is eligible to be spent",
+	"sendtotreasury--result0":    "The transaction hash of the sent transaction",
 
 	// SetAccountPassphraseCmd help.
 	"setaccountpassphrase--synopsis": "Individually encrypt or change per-account passphrase",
@@ -800,10 +1839,38 @@ var helpDescsEnUS = map[string]string{
 	"setbalancetomaintain-balance":   "The new balance for wallet to maintain for automatic ticket purchasing",
 	"setbalancetomaintain--result0":  "Should return nothing",
 
+	// SetDestinationOverrideCmd help.
+	"setdestinationoverride--synopsis": "Sets the credential which permits payments prohibited by destination policies when provided to sendfrom, sendmany, or sendtoaddress, and which is required to change destination policies.\n" +
+		"Only a verifier derived from the credential is recorded.\n" +
+		"Requires the unlocked wallet.",
+	"setdestinationoverride-credential": "The override credential (empty to remove the override)",
+	"setdestinationoverride-current":    "The current override credential, required when one is set",
+
+	// SetDestinationPolicyCmd help.
+	"setdestinationpolicy--synopsis": "Persists a policy restricting the destinations of payments from an account.\n" +
+		"Payments to denied addresses are rejected, and in allowlist mode, payments to addresses which are not allowed are also rejected.\n" +
+		"Policies apply to every transaction signed with the account's keys, including raw transactions and swept outputs.\n" +
+		"Payments to HD addresses of the wallet are never restricted, but payments to imported keys and scripts are.\n" +
+		"A policy without allowlist mode or denied addresses removes any restriction.\n" +
+		"Requires the override credential set by setdestinationoverride, or the unlocked wallet when no credential is set.",
+	"setdestinationpolicy-account":    "The account name",
+	"setdestinationpolicy-allowlist":  "Reject payments to addresses which are not allowed",
+	"setdestinationpolicy-allowed":    "Addresses which may be paid in allowlist mode",
+	"setdestinationpolicy-denied":     "Addresses which may never be paid",
+	"setdestinationpolicy-credential": "The override credential set by setdestinationoverride",
+
 	// SetDisapprovePercentCmd help.
 	"setdisapprovepercent--synopsis": "Sets the wallet's block disapprove percent per vote. The wallet will randomly disapprove blocks with this percent of votes. Only used for testing purposes and will fail on mainnet.",
 	"setdisapprovepercent-percent":   "The percent of votes to disapprove blocks. i.e. 100 means that all votes disapprove the block they are called on. Must be between zero and one hundred.",
 
+	// SetDuressPassphraseCmd help.
+	"setduresspassphrase--synopsis": "Sets a secondary private passphrase for use under coercion.\n" +
+		"Unlocking with the duress passphrase reports the wallet as unlocked, but only the private keys of the duress account are available.\n" +
+		"Requires the wallet to be unlocked with its private passphrase.",
+	"setduresspassphrase-account": "The low-value account unlocked by the duress passphrase",
+	"setduresspassphrase-passphrase": "The duress passphrase, which must differ from the private passphrase.\n" +
+		"If this is the empty string, the duress passphrase is removed.",
+
 	// SetGenerate help
 	"setgenerate--synopsis":    "Enable or disable stake mining",
 	"setgenerate-generate":     "True to enable stake mining, false to disable.",
@@ -819,6 +1886,30 @@ var helpDescsEnUS = map[string]string{
 	"setticketmaxprice--synopsis": "Set the max price user is willing to pay for a ticket.",
 	"setticketmaxprice-max":       "The max price (in dcr).",
 
+	// SetLockModeCmd help.
+	"setlockmode--synopsis": "Sets which keys are removed from memory when the wallet is locked, including locks after a walletpassphrase timeout.\n" +
+		"In spending mode, locking removes the keys of all spending accounts but keeps unlocked imported voting accounts unlocked, so always-on voting wallets may continue to vote without holding spending keys in memory.\n" +
+		"Changing the mode does not lock the wallet.",
+	"setlockmode-mode": `The lock mode, "full" to lock all accounts or "spending" to keep imported voting accounts unlocked`,
+
+	// SetMaxFeeRateCmd help.
+	"setmaxfeerate--synopsis": "Persistently sets the maximum fee per kB of the serialized tx size of all transactions authored by the wallet, including sends, ticket purchases, and mixes.\n" +
+		"Creating a transaction at a higher fee rate, whether provided as a method argument or set by settxfee, is rejected.",
+	"setmaxfeerate-amount": "The maximum fee per kB of the serialized tx size valued in decred, or 0 to remove the limit",
+
+	// SetMixedSpendPolicyCmd help.
+	"setmixedspendpolicy--synopsis": "Persistently restricts sends and ticket purchases to only spend outputs of a mixed account branch.\n" +
+		"Spending from any other account is rejected, and an insufficient balance error describing the policy is returned when too few mixed funds are available.",
+	"setmixedspendpolicy-account": "The mixed account",
+	"setmixedspendpolicy-branch":  "The branch of the mixed account receiving mixed outputs",
+
+	// SetMixPolicyCmd help.
+	"setmixpolicy--synopsis": "Sets the minimum peer count and peer protocol version required of mixes.\n" +
+		"The wallet refuses to sign mix transactions with fewer peers rather than completing mixes in small anonymity sets.\n" +
+		"The policy is not persisted and is reset to the --mixminpeers and --mixminpeerversion options on restart.",
+	"setmixpolicy-minpeers":       "The minimum number of peers required to complete a mix (values of 4 or less impose no restriction)",
+	"setmixpolicy-minpeerversion": "The minimum protocol version of network peers to exchange mixing messages with",
+
 	// SetTreasuryPolicyCmd help.
 	"settreasurypolicy--synopsis": "Set a voting policy for treasury spends by a particular key",
 	"settreasurypolicy-key":       "Treasury key to set policy for",
@@ -879,6 +1970,13 @@ var helpDescsEnUS = map[string]string{
 	"signedtransaction-sent":            "Tells if the transaction was sent.",
 	"signedtransaction-signingresult":   "Success or failure of signing.",
 
+	// SignTSpendCmd help.
+	"signtspend--synopsis": "Signs a treasury spend transaction with an imported treasury key, replacing the signature script of its only input.\n" +
+		"Requires an unlocked wallet started with the --treasurykeys option.",
+	"signtspend-tx":       "The hex encoded unsigned treasury spend transaction",
+	"signtspend-pubkey":   "The hex encoded public key of the imported treasury key to sign with",
+	"signtspend--result0": "The hex encoded signed transaction",
+
 	// SpendOutputsCmd help.
 	"spendoutputs--synopsis": "Create, sign, and publish a transaction spending the specified wallet outputs, and paying an array of address/amount pairs.\n" +
 		"Outputs must belong to the specified account, and change (if needed) is returned to an internal address of the same account.",
@@ -902,6 +2000,42 @@ var helpDescsEnUS = map[string]string{
 	"sweepaccountresult-totaloutputamount":         "The total transaction output amount.",
 	"sweepaccountresult-estimatedsignedsize":       "The estimated size of the transaction when signed.",
 
+	// SweepPrivKeyCmd help.
+	"sweepprivkey--synopsis": "Publishes a transaction spending the mature unspent outputs paying to the P2PKH address of a private key to a new internal address of an account.\n" +
+		"Outputs are discovered using the wallet's compact filters, and the key is not imported.\n" +
+		"Only the oldest outputs which fit in the maximum transaction size are spent; the remaining outputs may be swept again after the transaction is mined.",
+	"sweepprivkey-privkey":  "The WIF-encoded private key to sweep",
+	"sweepprivkey-account":  "The account receiving the swept value",
+	"sweepprivkey-scanfrom": "The block height to begin searching for outputs",
+
+	// SweepPrivKeyResult help.
+	"sweepprivkeyresult-txhash": "The published transaction hash",
+	"sweepprivkeyresult-inputs": "The swept outpoints",
+	"sweepprivkeyresult-amount": "The total value of the swept outputs",
+	"sweepprivkeyresult-fee":    "The transaction fee",
+
+	// SweepToColdStorageCmd help.
+	"sweeptocoldstorage--synopsis": "Spends the entire spendable balance of an account to another wallet account or to addresses derived from an account extended public key.\n" +
+		"Outputs are batched into transactions paying a single output each, and no change is returned to the source account.",
+	"sweeptocoldstorage-sourceaccount": "The account to sweep",
+	"sweeptocoldstorage-destaccount":   "The wallet account receiving the swept value at new external addresses (mutually exclusive with destxpub)",
+	"sweeptocoldstorage-destxpub":      "An account extended public key whose external branch addresses receive the swept value (mutually exclusive with destaccount)",
+	"sweeptocoldstorage-destxpubindex": "The first external branch child index of destxpub to pay",
+	"sweeptocoldstorage-feerate":       "The fee per kilobyte (default: the wallet relay fee)",
+	"sweeptocoldstorage-maxfee":        "Abort the sweep if any transaction would pay a greater fee (default: no limit)",
+	"sweeptocoldstorage-maxinputs":     "Maximum number of inputs per transaction (default: 500)",
+	"sweeptocoldstorage-minconf":       "Minimum number of block confirmations of swept outputs",
+	"sweeptocoldstorage-dryrun":        "Create the transactions without signing or publishing them",
+	"sweeptocoldstorage--result0":      "The sweep transactions",
+
+	// ColdSweepTxResult help.
+	"coldsweeptxresult-txhash":      "The transaction hash",
+	"coldsweeptxresult-transaction": "The hex encoded transaction, unsigned for dry runs",
+	"coldsweeptxresult-address":     "The address paid by the transaction (omitted for dry runs to a wallet account)",
+	"coldsweeptxresult-inputs":      "The number of inputs spent",
+	"coldsweeptxresult-amount":      "The total value of the spent outputs",
+	"coldsweeptxresult-fee":         "The transaction fee",
+
 	// TicketInfoCmd help.
 	"ticketinfo--synopsis":           "Returns details of each wallet ticket transaction",
 	"ticketinfo-startheight":         "Specify the starting block height to scan from",
@@ -1005,6 +2139,16 @@ var helpDescsEnUS = map[string]string{
 	"votechoice-choiceid":          "The ID of the current choice for this agenda",
 	"votechoice-choicedescription": "A description of the current choice for this agenda",
 
+	// VerifyReserveSnapshotCmd help.
+	"verifyreservesnapshot--synopsis": "Verifies a reserve snapshot by checking the merkle commitment and signatures of all outputs and that each output remains unspent.\n" +
+		"Requires an RPC connection to dcrd.",
+	"verifyreservesnapshot-snapshot": "The reserve snapshot as returned by createreservesnapshot",
+
+	// VerifyReserveSnapshotResult help.
+	"verifyreservesnapshotresult-valid": "Whether the snapshot is valid",
+	"verifyreservesnapshotresult-total": "The total value of all verified outputs",
+	"verifyreservesnapshotresult-error": "The reason the snapshot is invalid",
+
 	// WalletInfoCmd help.
 	"walletinfo--synopsis":              "Returns global information about the wallet",
 	"walletinforesult-daemonconnected":  "Whether or not the wallet is currently connected to the daemon RPC",
@@ -1012,14 +2156,17 @@ var helpDescsEnUS = map[string]string{
 	"walletinforesult-unlocked":         "Whether or not the wallet is unlocked",
 	"walletinforesult-cointype":         "Active coin type. Not available for watching-only wallets.",
 	"walletinforesult-txfee":            "Transaction fee per kB of the serialized tx size in coins",
+	"walletinforesult-maxfeerate":       "Maximum transaction fee per kB of the serialized tx size in coins, omitted when there is no limit",
 	"walletinforesult-votebits":         "Vote bits setting",
 	"walletinforesult-votebitsextended": "Extended vote bits setting",
 	"walletinforesult-voteversion":      "Version of votes that will be generated",
 	"walletinforesult-voting":           "Whether or not the wallet is currently voting tickets",
 	"walletinforesult-vsp":              "VSP URL used when purchasing tickets",
 	"walletinforesult-manualtickets":    "Whether or not the wallet is only accepting tickets manually",
+	"walletinforesult-lockmode":         `The lock mode set by setlockmode, "full" or "spending"`,
 	"walletinforesult-birthhash":        "The wallet birth hash.",
 	"walletinforesult-birthheight":      "The wallet birth height.",
+	"walletinforesult-instanceconflict": "Describes another wallet process detected writing to this wallet's database or a copy of it, which corrupts the wallet (omitted if none was detected)",
 
 	// WalletIsLockedCmd help.
 	"walletislocked--synopsis": "Returns whether or not the wallet is locked.",
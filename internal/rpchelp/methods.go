@@ -32,22 +32,50 @@ var Methods = []struct {
 	{"accountsyncaddressindex", nil},
 	{"accountunlocked", []any{(*types.AccountUnlockedResult)(nil)}},
 	{"addmultisigaddress", returnsString},
+	{"addressreusereport", []any{(*types.AddressReuseReportResult)(nil)}},
 	{"addtransaction", nil},
+	{"addwebhook", []any{(*types.WebhookResult)(nil)}},
+	{"annotaterawtransaction", []any{(*types.AnnotateRawTransactionResult)(nil)}},
+	{"approvependingspend", returnsString},
+	{"attestaddress", []any{(*types.AttestAddressResult)(nil)}},
 	{"auditreuse", []any{(*map[string][]string)(nil)}},
+	{"clearmixedspendpolicy", nil},
 	{"consolidate", returnsString},
+	{"cosignvote", []any{(*types.CosignVoteResult)(nil)}},
+	{"createinvoice", []any{(*types.InvoiceResult)(nil)}},
 	{"createmultisig", []any{(*types.CreateMultiSigResult)(nil)}},
+	{"createmultisigspend", []any{(*types.CreateMultisigSpendResult)(nil)}},
 	{"createnewaccount", nil},
+	{"createpaymenturi", []any{(*types.CreatePaymentURIResult)(nil)}},
 	{"createrawtransaction", returnsString},
+	{"createreservesnapshot", []any{(*types.ReserveSnapshotResult)(nil)}},
 	{"createsignature", []any{(*types.CreateSignatureResult)(nil)}},
+	{"createswapcontract", []any{(*types.CreateSwapContractResult)(nil)}},
+	{"debugdump", []any{(*types.DebugDumpResult)(nil)}},
+	{"debuginspectdb", []any{(*types.DebugInspectDBResult)(nil)}},
+	{"debugtimings", []any{(*types.DebugTimingsResult)(nil)}},
 	{"debuglevel", returnsString},
+	{"deleteinvoice", nil},
 	{"disapprovepercent", []any{(*uint32)(nil)}},
 	{"discoverusage", nil},
 	{"dumpprivkey", returnsString},
+	{"estimaterawtransaction", []any{(*types.EstimateRawTransactionResult)(nil)}},
+	{"estimatetxsize", []any{(*types.EstimateTxSizeResult)(nil)}},
+	{"exportvotepolicies", returnsString},
+	{"exportvotingaccount", []any{(*types.ExportVotingAccountResult)(nil)}},
+	{"forecaststake", []any{(*types.ForecastStakeResult)(nil)}},
 	{"fundrawtransaction", []any{(*types.FundRawTransactionResult)(nil)}},
 	{"getaccount", returnsString},
 	{"getaccountaddress", returnsString},
+	{"getaccountblindedid", []any{(*string)(nil)}},
+	{"getaccountbyid", []any{(*types.AccountIDResult)(nil)}},
+	{"getaccountchildxpub", returnsString},
+	{"getaccountcryptoparams", []any{(*[]types.AccountCryptoParamsResult)(nil)}},
+	{"getaccountid", []any{(*types.AccountIDResult)(nil)}},
+	{"getaccountwatchkey", []any{(*types.GetAccountWatchKeyResult)(nil)}},
 	{"getaddressesbyaccount", returnsStringArray},
 	{"getbalance", []any{(*types.GetBalanceResult)(nil)}},
+	{"getcapabilities", []any{(*types.GetCapabilitiesResult)(nil)}},
 	{"getbestblock", []any{(*dcrdtypes.GetBestBlockResult)(nil)}},
 	{"getbestblockhash", returnsString},
 	{"getblockcount", returnsNumber},
@@ -56,47 +84,93 @@ var Methods = []struct {
 	{"getblock", []any{(*dcrdtypes.GetBlockVerboseResult)(nil)}},
 	{"getcoinjoinsbyacct", []any{(*map[string]uint32)(nil)}},
 	{"getcurrentnet", []any{(*uint32)(nil)}},
+	{"getdestinationpolicy", []any{(*types.GetDestinationPolicyResult)(nil)}},
+	{"getduressaccount", []any{(*types.GetDuressAccountResult)(nil)}},
 	{"getinfo", []any{(*types.InfoWalletResult)(nil)}},
+	{"getinvoice", []any{(*types.InvoiceResult)(nil)}},
+	{"getjournalevents", []any{(*types.GetJournalEventsResult)(nil)}},
 	{"getmasterpubkey", []any{(*string)(nil)}},
+	{"getmixedspendpolicy", []any{(*types.GetMixedSpendPolicyResult)(nil)}},
+	{"getmixpolicy", []any{(*types.GetMixPolicyResult)(nil)}},
 	{"getmultisigoutinfo", []any{(*types.GetMultisigOutInfoResult)(nil)}},
 	{"getnewaddress", returnsString},
 	{"getpeerinfo", []any{(*types.GetPeerInfoResult)(nil)}},
 	{"getrawchangeaddress", returnsString},
 	{"getreceivedbyaccount", returnsNumber},
 	{"getreceivedbyaddress", returnsNumber},
+	{"getreservedbalance", []any{(*types.GetReservedBalanceResult)(nil)}},
 	{"getstakeinfo", []any{(*types.GetStakeInfoResult)(nil)}},
+	{"getticketpools", []any{(*[]types.TicketPoolResult)(nil)}},
 	{"gettickets", []any{(*types.GetTicketsResult)(nil)}},
 	{"gettransaction", []any{(*types.GetTransactionResult)(nil)}},
 	{"gettxout", []any{(*dcrdtypes.GetTxOutResult)(nil)}},
 	{"getunconfirmedbalance", returnsNumber},
 	{"getvotechoices", []any{(*types.GetVoteChoicesResult)(nil)}},
+	{"getvotediagnostics", []any{(*[]types.VoteDiagnosticResult)(nil)}},
+	{"getvoteversioninfo", []any{(*types.GetVoteVersionInfoResult)(nil)}},
 	{"getwalletfee", returnsNumber},
 	{"getcfilterv2", []any{(*types.GetCFilterV2Result)(nil)}},
+	{"getidlelock", []any{(*types.IdleLockResult)(nil)}},
+	{"getutxostats", []any{(*[]types.UTXOStatsResult)(nil)}},
+	{"getwalletstats", []any{(*[]types.WalletStatsResult)(nil)}},
+	{"holdamount", []any{(*types.HoldResult)(nil)}},
+	{"holdoutputs", []any{(*types.HoldResult)(nil)}},
 	{"help", append(returnsString, returnsString[0])},
 	{"importcfiltersv2", nil},
+	{"importcheckpoint", []any{(*types.ImportCheckpointResult)(nil)}},
+	{"importexternalsigneraccount", returnsNumber},
+	{"importlegacystakepooltickets", []any{(*[]string)(nil)}},
 	{"importprivkey", nil},
 	{"importpubkey", nil},
 	{"importscript", nil},
+	{"importtreasurykey", returnsString},
+	{"importslip0044account", returnsNumber},
+	{"importvotepolicies", nil},
+	{"importvotingaccount", returnsNumber},
 	{"importxpub", nil},
 	{"listaccounts", []any{(*map[string]float64)(nil)}},
 	{"listaddresstransactions", returnsLTRArray},
 	{"listalltransactions", returnsLTRArray},
+	{"listexpiredaddresses", []any{(*[]types.ExpiredAddressResult)(nil)}},
+	{"listexternalsigners", []any{(*[]types.ExternalSignerResult)(nil)}},
+	{"listfundsreservations", []any{(*[]types.FundsReservationResult)(nil)}},
+	{"listholds", []any{(*[]types.HoldResult)(nil)}},
+	{"listinvoices", []any{(*[]types.InvoiceResult)(nil)}},
 	{"listlockunspent", []any{(*[]dcrdtypes.TransactionInput)(nil)}},
+	{"listpendingspends", []any{(*[]types.PendingSpendResult)(nil)}},
 	{"listreceivedbyaccount", []any{(*[]types.ListReceivedByAccountResult)(nil)}},
 	{"listreceivedbyaddress", []any{(*[]types.ListReceivedByAddressResult)(nil)}},
 	{"listsinceblock", []any{(*types.ListSinceBlockResult)(nil)}},
 	{"listtransactions", returnsLTRArray},
+	{"listtreasurykeys", []any{(*[]types.TreasuryKeyResult)(nil)}},
 	{"listunspent", []any{(*types.ListUnspentResult)(nil)}},
+	{"listwebhooks", []any{(*[]types.WebhookResult)(nil)}},
 	{"lockaccount", nil},
 	{"lockunspent", returnsBool},
+	{"lookupaccountblindedid", []any{(*types.AccountIDResult)(nil)}},
+	{"matchcfilters", []any{(*[]types.MatchCFiltersResult)(nil)}},
 	{"mixaccount", nil},
 	{"mixoutput", nil},
+	{"parsepaymenturi", []any{(*types.ParsePaymentURIResult)(nil)}},
+	{"pregenerateaddresses", returnsStringArray},
+	{"previewmixaccount", []any{(*[]types.MixSplitPreviewResult)(nil)}},
 	{"processunmanagedticket", nil},
+	{"provevspownership", []any{(*types.ProveVSPOwnershipResult)(nil)}},
+	{"prunesidechains", []any{(*types.PruneSidechainsResult)(nil)}},
 	{"purchaseticket", returnsString},
+	{"recovermixoutputs", []any{(*types.RecoverMixOutputsResult)(nil)}},
 	{"redeemmultisigout", []any{(*types.RedeemMultiSigOutResult)(nil)}},
 	{"redeemmultisigouts", []any{(*types.RedeemMultiSigOutResult)(nil)}},
+	{"redeemswap", returnsString},
+	{"refundswap", returnsString},
+	{"rejectpendingspend", nil},
+	{"releasefunds", nil},
+	{"releasehold", nil},
+	{"removetreasurykey", nil},
+	{"removewebhook", nil},
 	{"renameaccount", nil},
 	{"rescanwallet", nil},
+	{"reservefunds", []any{(*types.FundsReservationResult)(nil)}},
 	{"sendfrom", returnsString},
 	{"sendfromtreasury", returnsString},
 	{"sendmany", returnsString},
@@ -105,7 +179,14 @@ var Methods = []struct {
 	{"sendtomultisig", returnsString},
 	{"sendtotreasury", returnsString},
 	{"setaccountpassphrase", nil},
+	{"setdestinationoverride", nil},
+	{"setdestinationpolicy", nil},
 	{"setdisapprovepercent", nil},
+	{"setduresspassphrase", nil},
+	{"setlockmode", nil},
+	{"setmaxfeerate", nil},
+	{"setmixedspendpolicy", nil},
+	{"setmixpolicy", nil},
 	{"settreasurypolicy", nil},
 	{"settspendpolicy", nil},
 	{"settxfee", returnsBool},
@@ -113,9 +194,14 @@ var Methods = []struct {
 	{"signmessage", returnsString},
 	{"signrawtransaction", []any{(*types.SignRawTransactionResult)(nil)}},
 	{"signrawtransactions", []any{(*types.SignRawTransactionsResult)(nil)}},
+	{"signtspend", returnsString},
+	{"simulatestake", []any{(*types.SimulateStakeResult)(nil)}},
 	{"spendoutputs", returnsString},
 	{"sweepaccount", []any{(*types.SweepAccountResult)(nil)}},
+	{"sweepprivkey", []any{(*types.SweepPrivKeyResult)(nil)}},
+	{"sweeptocoldstorage", []any{(*[]types.ColdSweepTxResult)(nil)}},
 	{"syncstatus", []any{(*types.SyncStatusResult)(nil)}},
+	{"ticketaddressreuse", []any{(*[]types.ReusedTicketAddressResult)(nil)}},
 	{"ticketinfo", []any{(*[]types.TicketInfoResult)(nil)}},
 	{"treasurypolicy", []any{(*[]types.TreasuryPolicyResult)(nil), (*types.TreasuryPolicyResult)(nil)}},
 	{"tspendpolicy", []any{(*[]types.TSpendPolicyResult)(nil), (*types.TSpendPolicyResult)(nil)}},
@@ -123,6 +209,8 @@ var Methods = []struct {
 	{"validateaddress", []any{(*types.ValidateAddressWalletResult)(nil)}},
 	{"validatepredcp0005cf", returnsBool},
 	{"verifymessage", returnsBool},
+	{"verifyreservesnapshot", []any{(*types.VerifyReserveSnapshotResult)(nil)}},
+	{"verifyvotingaccount", []any{(*types.VerifyVotingAccountResult)(nil)}},
 	{"version", []any{(*map[string]dcrdtypes.VersionResult)(nil)}},
 	{"walletinfo", []any{(*types.WalletInfoResult)(nil)}},
 	{"walletislocked", returnsBool},
@@ -0,0 +1,110 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package spendapproval provides approvers which confirm wallet spends with an
+// operator before the wallet signs them.
+package spendapproval
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"os/exec"
+	"strings"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/txscript/v4/stdscript"
+)
+
+// Request is the JSON encoding of a spend approval request.
+type Request struct {
+	TxHash      string   `json:"txhash"`
+	Amount      float64  `json:"amount"`
+	Fee         float64  `json:"fee"`
+	Outputs     []Output `json:"outputs"`
+	Transaction string   `json:"transaction"`
+}
+
+// Output describes an output of a transaction awaiting approval.  Address is
+// empty for outputs which do not pay to a single address.
+type Output struct {
+	Address string  `json:"address,omitempty"`
+	Amount  float64 `json:"amount"`
+}
+
+// NewRequest creates the JSON request describing a spend approval.
+func NewRequest(a *wallet.SpendApproval, params stdaddr.AddressParams) (*Request, error) {
+	var buf bytes.Buffer
+	buf.Grow(a.Tx.SerializeSize())
+	if err := a.Tx.Serialize(&buf); err != nil {
+		return nil, err
+	}
+	req := &Request{
+		TxHash:      a.Tx.TxHash().String(),
+		Amount:      a.Amount.ToCoin(),
+		Fee:         a.Fee.ToCoin(),
+		Outputs:     make([]Output, 0, len(a.Tx.TxOut)),
+		Transaction: hex.EncodeToString(buf.Bytes()),
+	}
+	for _, out := range a.Tx.TxOut {
+		o := Output{Amount: dcrutil.Amount(out.Value).ToCoin()}
+		_, addrs := stdscript.ExtractAddrs(out.Version, out.PkScript, params)
+		if len(addrs) == 1 {
+			o.Address = addrs[0].String()
+		}
+		req.Outputs = append(req.Outputs, o)
+	}
+	return req, nil
+}
+
+// Command approves spends by running an external program, such as a tool
+// which requests confirmation from an operator or verifies a second factor.
+// The JSON encoded Request is written to the program's standard input, and
+// the spend is approved only when the program exits successfully.  Any output
+// of a failed program is reported as the reason for rejecting the spend.
+type Command struct {
+	Argv    []string
+	Params  stdaddr.AddressParams
+	Timeout time.Duration // rejects spends not decided in time when nonzero
+}
+
+// ApproveSpend implements wallet.SpendApprover.
+func (c *Command) ApproveSpend(ctx context.Context, a *wallet.SpendApproval) error {
+	const op errors.Op = "spendapproval.Command.ApproveSpend"
+	if len(c.Argv) == 0 {
+		return errors.E(op, errors.Invalid, "no approval command")
+	}
+	req, err := NewRequest(a, c.Params)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	stdin, err := json.Marshal(req)
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	if c.Timeout != 0 {
+		var cancel func()
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+		defer cancel()
+	}
+	cmd := exec.CommandContext(ctx, c.Argv[0], c.Argv[1:]...)
+	cmd.Stdin = bytes.NewReader(stdin)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		if ctx.Err() != nil {
+			return errors.E(op, errors.Permission, errors.Errorf("approval not received: %w", ctx.Err()))
+		}
+		if reason := strings.TrimSpace(string(out)); reason != "" {
+			return errors.E(op, errors.Permission, errors.Errorf("%v: %s", err, reason))
+		}
+		return errors.E(op, errors.Permission, err)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package spendapproval
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestCommand(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh is not available")
+	}
+	params := chaincfg.SimNetParams()
+	addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(make([]byte, 20), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, script := addr.PaymentScript()
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, 2e8, nil))
+	tx.AddTxOut(&wire.TxOut{Value: 1e8, Version: version, PkScript: script})
+	a := &wallet.SpendApproval{Tx: tx, Amount: 1e8, Fee: 1e8}
+
+	// The command approves only spends to the expected address.
+	approve := &Command{
+		Argv:   []string{"sh", "-c", "grep -q '\"address\":\"" + addr.String() + "\"'"},
+		Params: params,
+	}
+	if err := approve.ApproveSpend(context.Background(), a); err != nil {
+		t.Fatalf("spend not approved: %v", err)
+	}
+
+	reject := &Command{
+		Argv:   []string{"sh", "-c", "echo denied by operator; exit 1"},
+		Params: params,
+	}
+	err = reject.ApproveSpend(context.Background(), a)
+	if !errors.Is(err, errors.Permission) || !strings.Contains(err.Error(), "denied by operator") {
+		t.Fatalf("unexpected rejection error: %v", err)
+	}
+}
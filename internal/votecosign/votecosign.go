@@ -0,0 +1,88 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package votecosign provides cosigners which add the signatures of other
+// devices to votes of tickets with multisig voting scripts.
+package votecosign
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"os/exec"
+	"strings"
+
+	"decred.org/dcrwallet/v5/errors"
+	"github.com/decred/dcrd/wire"
+)
+
+// Command cosigns votes by running an external program, such as dcrctl
+// calling the cosignvote method of another wallet holding keys of the voting
+// script.  The hex encoded vote is written to the program's standard input,
+// and the program must write the cosigned vote to standard output, either as
+// hex or as the JSON result of the cosignvote method.  The program is killed
+// if the context of the cosigning request is done before it completes.
+type Command struct {
+	Argv []string
+}
+
+// CosignVote implements wallet.VoteCosigner.
+func (c *Command) CosignVote(ctx context.Context, vote *wire.MsgTx) (*wire.MsgTx, error) {
+	const op errors.Op = "votecosign.Command.CosignVote"
+	if len(c.Argv) == 0 {
+		return nil, errors.E(op, errors.Invalid, "no cosigner command")
+	}
+	var buf bytes.Buffer
+	buf.Grow(vote.SerializeSize())
+	if err := vote.Serialize(&buf); err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	cmd := exec.CommandContext(ctx, c.Argv[0], c.Argv[1:]...)
+	cmd.Stdin = strings.NewReader(hex.EncodeToString(buf.Bytes()))
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, errors.E(op, errors.Errorf("vote not cosigned: %w", ctx.Err()))
+		}
+		if reason := strings.TrimSpace(stderr.String()); reason != "" {
+			return nil, errors.E(op, errors.Errorf("%v: %s", err, reason))
+		}
+		return nil, errors.E(op, err)
+	}
+
+	cosigned, err := parseVote(out)
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	return cosigned, nil
+}
+
+// parseVote decodes a vote written as hex or as a JSON object with a hex
+// field.
+func parseVote(out []byte) (*wire.MsgTx, error) {
+	out = bytes.TrimSpace(out)
+	s := string(out)
+	if bytes.HasPrefix(out, []byte("{")) {
+		var res struct {
+			Hex string `json:"hex"`
+		}
+		if err := json.Unmarshal(out, &res); err != nil {
+			return nil, err
+		}
+		s = res.Hex
+	}
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	vote := new(wire.MsgTx)
+	if err := vote.Deserialize(bytes.NewReader(b)); err != nil {
+		return nil, err
+	}
+	return vote, nil
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package votecosign
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/decred/dcrd/wire"
+)
+
+func TestCommand(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh is not available")
+	}
+	vote := wire.NewMsgTx()
+	vote.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: wire.MaxPrevOutIndex}, 0, nil))
+	vote.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: [32]byte{1}}, 1e8, []byte{0x51}))
+	vote.AddTxOut(wire.NewTxOut(0, []byte{0x6a}))
+
+	// Echoing the vote as hex or as a cosignvote JSON result returns the
+	// same vote.
+	for _, script := range []string{"cat", `printf '{"hex":"%s","complete":true}' "$(cat)"`} {
+		c := &Command{Argv: []string{"sh", "-c", script}}
+		cosigned, err := c.CosignVote(context.Background(), vote)
+		if err != nil {
+			t.Fatalf("%s: %v", script, err)
+		}
+		if cosigned.TxHash() != vote.TxHash() ||
+			string(cosigned.TxIn[1].SignatureScript) != string(vote.TxIn[1].SignatureScript) {
+			t.Errorf("%s: cosigned vote differs", script)
+		}
+	}
+
+	fail := &Command{Argv: []string{"sh", "-c", "echo cosigner locked >&2; exit 1"}}
+	_, err := fail.CosignVote(context.Background(), vote)
+	if err == nil || !strings.Contains(err.Error(), "cosigner locked") {
+		t.Fatalf("unexpected failure error: %v", err)
+	}
+}
@@ -0,0 +1,326 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package webhook notifies registered HTTP endpoints of wallet address
+// activity.
+//
+// Each registered hook is given a filter and a secret.  When a wallet
+// transaction credits an output matching the filter, a JSON event is POSTed to
+// the hook's URL, once when the transaction is first seen unmined and again
+// when it is mined in a main chain block.  Transactions first observed in a
+// block only produce the confirmation event.  The request body is signed with
+// HMAC-SHA256 keyed by the hook secret, and the hex encoded MAC is set in the
+// Dcrwallet-Signature header.
+//
+// Hooks are held in memory and must be registered again after a restart.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet"
+	"github.com/decred/dcrd/dcrutil/v4"
+)
+
+// SignatureHeader is the HTTP header carrying the hex encoded HMAC-SHA256 of
+// the request body.
+const SignatureHeader = "Dcrwallet-Signature"
+
+// Event types.
+const (
+	EventReceived  = "received"
+	EventConfirmed = "confirmed"
+)
+
+const (
+	queueSize       = 256
+	maxAttempts     = 4
+	requestTimeout  = 30 * time.Second
+	initialBackoff  = 2 * time.Second
+	secretSize      = 32
+	hookIDSize      = 16
+	maxResponseBody = 1 << 12
+)
+
+// Filter selects the wallet outputs a hook is notified of.  Zero values match
+// any output.
+type Filter struct {
+	Account   *uint32
+	Address   string
+	MinAmount dcrutil.Amount
+}
+
+// Hook is a registered HTTP endpoint.
+type Hook struct {
+	ID     string
+	URL    string
+	Secret []byte
+	Filter Filter
+}
+
+func (h *Hook) matches(out *wallet.TransactionSummaryOutput) bool {
+	f := &h.Filter
+	if f.Account != nil && *f.Account != out.Account {
+		return false
+	}
+	if f.Address != "" && (out.Address == nil || out.Address.String() != f.Address) {
+		return false
+	}
+	return out.Amount >= f.MinAmount
+}
+
+// Event is the JSON body POSTed to a hook.
+type Event struct {
+	HookID      string `json:"hookid"`
+	Type        string `json:"type"`
+	TxHash      string `json:"txhash"`
+	Index       uint32 `json:"index"`
+	Account     uint32 `json:"account"`
+	Address     string `json:"address,omitempty"`
+	Amount      int64  `json:"amount"`
+	BlockHash   string `json:"blockhash,omitempty"`
+	BlockHeight int32  `json:"blockheight,omitempty"`
+	Timestamp   int64  `json:"timestamp"`
+}
+
+type delivery struct {
+	hook  *Hook
+	event *Event
+}
+
+// Dispatcher manages registered hooks and delivers events to them.
+type Dispatcher struct {
+	client *http.Client
+	queue  chan delivery
+
+	hooks map[string]*Hook
+	mu    sync.Mutex
+}
+
+// NewDispatcher returns a Dispatcher which dials hook endpoints using dial.
+func NewDispatcher(dial func(ctx context.Context, network, addr string) (net.Conn, error)) *Dispatcher {
+	return &Dispatcher{
+		client: &http.Client{
+			Transport: &http.Transport{DialContext: dial},
+			Timeout:   requestTimeout,
+		},
+		queue: make(chan delivery, queueSize),
+		hooks: make(map[string]*Hook),
+	}
+}
+
+// Register adds a hook notifying rawURL of outputs matching filter.  The
+// returned hook describes the generated ID and secret.
+func (d *Dispatcher) Register(rawURL string, filter Filter) (*Hook, error) {
+	const op errors.Op = "webhook.Register"
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, errors.E(op, errors.Invalid, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return nil, errors.E(op, errors.Invalid, "webhook URL must be an absolute http or https URL")
+	}
+
+	id := make([]byte, hookIDSize)
+	secret := make([]byte, secretSize)
+	rand.Read(id)
+	rand.Read(secret)
+	h := &Hook{
+		ID:     hex.EncodeToString(id),
+		URL:    u.String(),
+		Secret: secret,
+		Filter: filter,
+	}
+
+	d.mu.Lock()
+	d.hooks[h.ID] = h
+	d.mu.Unlock()
+
+	c := *h
+	return &c, nil
+}
+
+// Unregister removes a hook.
+func (d *Dispatcher) Unregister(id string) error {
+	defer d.mu.Unlock()
+	d.mu.Lock()
+
+	if _, ok := d.hooks[id]; !ok {
+		return errors.E("webhook.Unregister", errors.NotExist, errors.Errorf("no webhook %q", id))
+	}
+	delete(d.hooks, id)
+	return nil
+}
+
+// Hooks returns all registered hooks sorted by ID.  Secrets are omitted.
+func (d *Dispatcher) Hooks() []Hook {
+	defer d.mu.Unlock()
+	d.mu.Lock()
+
+	hooks := make([]Hook, 0, len(d.hooks))
+	for _, h := range d.hooks {
+		c := *h
+		c.Secret = nil
+		hooks = append(hooks, c)
+	}
+	sort.Slice(hooks, func(i, j int) bool { return hooks[i].ID < hooks[j].ID })
+	return hooks
+}
+
+// Run delivers events for transactions of w until ctx is cancelled.
+func (d *Dispatcher) Run(ctx context.Context, w *wallet.Wallet) error {
+	client := w.NtfnServer.TransactionNotifications()
+	defer client.Done()
+
+	go d.deliverLoop(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case n := <-client.C:
+			for _, dl := range d.deliveries(n, time.Now().Unix()) {
+				select {
+				case d.queue <- dl:
+				default:
+					log.Warnf("Webhook queue full; dropping %s event for %s:%d",
+						dl.event.Type, dl.event.TxHash, dl.event.Index)
+				}
+			}
+		}
+	}
+}
+
+// deliveries returns the events for each hook matching outputs of the
+// notified transactions.
+func (d *Dispatcher) deliveries(n *wallet.TransactionNotifications, now int64) []delivery {
+	d.mu.Lock()
+	hooks := make([]*Hook, 0, len(d.hooks))
+	for _, h := range d.hooks {
+		hooks = append(hooks, h)
+	}
+	d.mu.Unlock()
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	var dls []delivery
+	add := func(typ string, tx *wallet.TransactionSummary, block *wallet.Block) {
+		for i := range tx.MyOutputs {
+			out := &tx.MyOutputs[i]
+			for _, h := range hooks {
+				if !h.matches(out) {
+					continue
+				}
+				e := &Event{
+					HookID:    h.ID,
+					Type:      typ,
+					TxHash:    tx.Hash.String(),
+					Index:     out.Index,
+					Account:   out.Account,
+					Amount:    int64(out.Amount),
+					Timestamp: now,
+				}
+				if out.Address != nil {
+					e.Address = out.Address.String()
+				}
+				if block != nil {
+					e.BlockHash = block.Header.BlockHash().String()
+					e.BlockHeight = int32(block.Header.Height)
+				}
+				dls = append(dls, delivery{hook: h, event: e})
+			}
+		}
+	}
+	for i := range n.UnminedTransactions {
+		add(EventReceived, &n.UnminedTransactions[i], nil)
+	}
+	for i := range n.AttachedBlocks {
+		b := &n.AttachedBlocks[i]
+		for j := range b.Transactions {
+			add(EventConfirmed, &b.Transactions[j], b)
+		}
+	}
+	return dls
+}
+
+func (d *Dispatcher) deliverLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case dl := <-d.queue:
+			d.deliver(ctx, dl)
+		}
+	}
+}
+
+// deliver POSTs an event, retrying with exponential backoff on failure.
+func (d *Dispatcher) deliver(ctx context.Context, dl delivery) {
+	body, err := json.Marshal(dl.event)
+	if err != nil {
+		log.Errorf("Webhook %s: marshal event: %v", dl.hook.ID, err)
+		return
+	}
+	backoff := initialBackoff
+	for attempt := 1; ; attempt++ {
+		err = d.post(ctx, dl.hook, body)
+		if err == nil {
+			return
+		}
+		if attempt == maxAttempts || ctx.Err() != nil {
+			break
+		}
+		log.Debugf("Webhook %s: attempt %d failed: %v", dl.hook.ID, attempt, err)
+		select {
+		case <-ctx.Done():
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+	log.Warnf("Webhook %s: failed to deliver %s event for %s:%d: %v",
+		dl.hook.ID, dl.event.Type, dl.event.TxHash, dl.event.Index, err)
+}
+
+func (d *Dispatcher) post(ctx context.Context, h *Hook, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(h.Secret, body))
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	var buf [maxResponseBody]byte
+	_, _ = resp.Body.Read(buf[:])
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.Errorf("unexpected status %q", resp.Status)
+	}
+	return nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of body keyed by secret.
+// Receivers should compute the same value and compare it against the
+// Dcrwallet-Signature header using a constant time comparison.
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"decred.org/dcrwallet/v5/wallet"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestDeliveries(t *testing.T) {
+	d := NewDispatcher(new(net.Dialer).DialContext)
+	acct1 := uint32(1)
+	all, err := d.Register("http://127.0.0.1/all", Filter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	large, err := d.Register("https://127.0.0.1/large", Filter{Account: &acct1, MinAmount: 1e8})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.Register("ftp://127.0.0.1", Filter{}); err == nil {
+		t.Fatal("registered non-HTTP URL")
+	}
+
+	hash := chainhash.Hash{1}
+	tx := wallet.TransactionSummary{
+		Hash: &hash,
+		MyOutputs: []wallet.TransactionSummaryOutput{
+			{Index: 0, Account: 0, Amount: 5e8},
+			{Index: 1, Account: 1, Amount: 1e7},
+			{Index: 2, Account: 1, Amount: 2e8},
+		},
+	}
+	header := &wire.BlockHeader{Height: 100}
+	n := &wallet.TransactionNotifications{
+		UnminedTransactions: []wallet.TransactionSummary{tx},
+		AttachedBlocks: []wallet.Block{{
+			Header:       header,
+			Transactions: []wallet.TransactionSummary{tx},
+		}},
+	}
+
+	counts := make(map[string]map[string]int)
+	for _, dl := range d.deliveries(n, 0) {
+		if counts[dl.hook.ID] == nil {
+			counts[dl.hook.ID] = make(map[string]int)
+		}
+		counts[dl.hook.ID][dl.event.Type]++
+		if dl.event.Type == EventConfirmed && dl.event.BlockHeight != 100 {
+			t.Errorf("confirmed event has block height %d", dl.event.BlockHeight)
+		}
+		if dl.hook.ID == large.ID && dl.event.Index != 2 {
+			t.Errorf("filtered hook notified of output %d", dl.event.Index)
+		}
+	}
+	if c := counts[all.ID]; c[EventReceived] != 3 || c[EventConfirmed] != 3 {
+		t.Errorf("unfiltered hook events: %v", c)
+	}
+	if c := counts[large.ID]; c[EventReceived] != 1 || c[EventConfirmed] != 1 {
+		t.Errorf("filtered hook events: %v", c)
+	}
+
+	if err := d.Unregister(all.ID); err != nil {
+		t.Fatal(err)
+	}
+	if len(d.Hooks()) != 1 {
+		t.Errorf("expected 1 hook after unregister")
+	}
+}
+
+func TestPostSignature(t *testing.T) {
+	var gotEvent Event
+	var gotSig string
+	var gotBody []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotSig = r.Header.Get(SignatureHeader)
+		gotBody, _ = io.ReadAll(r.Body)
+		json.Unmarshal(gotBody, &gotEvent)
+	}))
+	defer srv.Close()
+
+	d := NewDispatcher(new(net.Dialer).DialContext)
+	h, err := d.Register(srv.URL, Filter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := &Event{HookID: h.ID, Type: EventReceived, TxHash: "ab", Amount: 1}
+	d.deliver(context.Background(), delivery{hook: h, event: e})
+
+	if gotEvent != *e {
+		t.Errorf("received event %+v, want %+v", gotEvent, *e)
+	}
+	if gotSig != Sign(h.Secret, gotBody) {
+		t.Errorf("invalid signature header")
+	}
+}
@@ -9,10 +9,12 @@ import (
 	"os"
 
 	"decred.org/dcrwallet/v5/chain"
+	"decred.org/dcrwallet/v5/internal/eventbus"
 	"decred.org/dcrwallet/v5/internal/loader"
 	"decred.org/dcrwallet/v5/internal/loggers"
 	"decred.org/dcrwallet/v5/internal/rpc/jsonrpc"
 	"decred.org/dcrwallet/v5/internal/rpc/rpcserver"
+	"decred.org/dcrwallet/v5/internal/webhook"
 	"decred.org/dcrwallet/v5/p2p"
 	"decred.org/dcrwallet/v5/spv"
 	"decred.org/dcrwallet/v5/ticketbuyer"
@@ -39,6 +41,8 @@ func init() {
 	connmgr.UseLogger(loggers.CmgrLog)
 	// XXX mixclient.UseLogger(loggers.MixcLog)
 	mixpool.UseLogger(loggers.MixpLog)
+	webhook.UseLogger(loggers.HookLog)
+	eventbus.UseLogger(loggers.EvbsLog)
 }
 
 // subsystemLoggers maps each subsystem identifier to its associated logger.
@@ -55,6 +59,8 @@ var subsystemLoggers = map[string]slog.Logger{
 	"MIXC": loggers.MixcLog,
 	"MIXP": loggers.MixpLog,
 	"VSPC": loggers.VspcLog,
+	"HOOK": loggers.HookLog,
+	"EVBS": loggers.EvbsLog,
 }
 
 // setLogLevel sets the logging level for provided subsystem.  Invalid
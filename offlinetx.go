@@ -0,0 +1,296 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"decred.org/dcrwallet/v5/chain"
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/p2p"
+	"decred.org/dcrwallet/v5/wallet"
+	"github.com/decred/dcrd/addrmgr/v2"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/wire"
+)
+
+// offlineTx is the file format of transactions passed between the offline
+// transaction modes.  Inputs describe the previous outputs spent by the
+// transaction so that it can be signed by a wallet which has never been
+// synced to the network.
+type offlineTx struct {
+	Transaction string           `json:"transaction"`
+	Inputs      []offlineTxInput `json:"inputs"`
+	Complete    bool             `json:"complete"`
+}
+
+type offlineTxInput struct {
+	TxID         string `json:"txid"`
+	Vout         uint32 `json:"vout"`
+	Tree         int8   `json:"tree"`
+	ScriptPubKey string `json:"scriptpubkey"`
+}
+
+// offlineTxMode returns whether one of the --createunsignedtx, --signtx, or
+// --broadcasttx modes was requested.
+func (c *config) offlineTxMode() bool {
+	return c.CreateUnsignedTx != "" || c.SignTx != "" || c.BroadcastTx != ""
+}
+
+// runOfflineTx performs the requested offline transaction mode using the
+// opened wallet.  The RPC servers and network syncers are never started.
+func runOfflineTx(ctx context.Context, w *wallet.Wallet) error {
+	var err error
+	switch {
+	case cfg.CreateUnsignedTx != "":
+		err = createUnsignedTx(ctx, w)
+	case cfg.SignTx != "":
+		err = signTx(ctx, w)
+	case cfg.BroadcastTx != "":
+		err = broadcastTx(ctx, w)
+	}
+	if err != nil {
+		log.Errorf("Offline transaction mode failed: %v", err)
+	}
+	return err
+}
+
+// createUnsignedTx creates an unsigned transaction paying the outputs read
+// from the --createunsignedtx file, which maps addresses to amounts in DCR.
+// Inputs are selected from the unspent outputs recorded by the wallet,
+// typically a watching-only wallet for the offline signing wallet's account.
+func createUnsignedTx(ctx context.Context, w *wallet.Wallet) error {
+	b, err := os.ReadFile(cfg.CreateUnsignedTx)
+	if err != nil {
+		return err
+	}
+	var amounts map[string]float64
+	if err := json.Unmarshal(b, &amounts); err != nil {
+		return errors.E(errors.Encoding, errors.Errorf("%s: %v",
+			cfg.CreateUnsignedTx, err))
+	}
+	if len(amounts) == 0 {
+		return errors.E(errors.Invalid, "no outputs to pay")
+	}
+	addrs := make([]string, 0, len(amounts))
+	for addr := range amounts {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+	outputs := make([]*wire.TxOut, 0, len(addrs))
+	for _, addr := range addrs {
+		a, err := stdaddr.DecodeAddress(addr, w.ChainParams())
+		if err != nil {
+			return errors.E(errors.Invalid, err)
+		}
+		amount, err := dcrutil.NewAmount(amounts[addr])
+		if err != nil {
+			return errors.E(errors.Invalid, err)
+		}
+		if amount <= 0 {
+			return errors.E(errors.Invalid, errors.Errorf("invalid "+
+				"amount %v paying %s", amount, addr))
+		}
+		version, script := a.PaymentScript()
+		outputs = append(outputs, &wire.TxOut{
+			Value:    int64(amount),
+			Version:  version,
+			PkScript: script,
+		})
+	}
+
+	account, err := w.AccountNumber(ctx, cfg.OfflineTxAccount)
+	if err != nil {
+		return err
+	}
+	atx, err := w.NewUnsignedTransaction(ctx, outputs, cfg.RelayFee.Amount,
+		account, 1, wallet.OutputSelectionAlgorithmDefault, nil, nil)
+	if err != nil {
+		return err
+	}
+	atx.RandomizeChangePosition()
+
+	f := &offlineTx{Inputs: make([]offlineTxInput, len(atx.Tx.TxIn))}
+	for i, in := range atx.Tx.TxIn {
+		prevOut := &in.PreviousOutPoint
+		f.Inputs[i] = offlineTxInput{
+			TxID:         prevOut.Hash.String(),
+			Vout:         prevOut.Index,
+			Tree:         prevOut.Tree,
+			ScriptPubKey: hex.EncodeToString(atx.PrevScripts[i]),
+		}
+	}
+	if err := writeOfflineTx(f, atx.Tx); err != nil {
+		return err
+	}
+	log.Infof("Created unsigned transaction spending %d inputs totaling %v",
+		len(atx.Tx.TxIn), atx.TotalInput)
+	return nil
+}
+
+// signTx signs the transaction read from the --signtx file with the wallet's
+// private keys.  Transactions which can not be completely signed are still
+// written with any added signatures, but are not marked complete.
+func signTx(ctx context.Context, w *wallet.Wallet) error {
+	f, tx, err := readOfflineTx(cfg.SignTx)
+	if err != nil {
+		return err
+	}
+	prevScripts := make(map[wire.OutPoint][]byte, len(f.Inputs))
+	for _, in := range f.Inputs {
+		hash, err := chainhash.NewHashFromStr(in.TxID)
+		if err != nil {
+			return errors.E(errors.Encoding, err)
+		}
+		script, err := hex.DecodeString(in.ScriptPubKey)
+		if err != nil {
+			return errors.E(errors.Encoding, err)
+		}
+		prevScripts[wire.OutPoint{Hash: *hash, Index: in.Vout, Tree: in.Tree}] = script
+	}
+
+	if approver := newSpendApprover(); approver != nil {
+		w.SetSpendApprover(approver, cfg.SpendApprovalThreshold.Amount)
+	}
+	passphrase := []byte(cfg.Pass)
+	if len(passphrase) == 0 {
+		passphrase, err = passPrompt(ctx, "Enter private passphrase", false)
+		if err != nil {
+			return err
+		}
+	}
+	defer zero(passphrase)
+	if err := w.Unlock(ctx, passphrase, nil); err != nil {
+		return err
+	}
+	defer w.Lock()
+
+	sigErrs, err := w.SignTransaction(ctx, tx, txscript.SigHashAll,
+		prevScripts, nil, nil)
+	if err != nil {
+		return err
+	}
+	for _, e := range sigErrs {
+		log.Warnf("Unable to sign input %d: %v", e.InputIndex, e.Error)
+	}
+	f.Complete = len(sigErrs) == 0
+	if err := writeOfflineTx(f, tx); err != nil {
+		return err
+	}
+	if f.Complete {
+		log.Infof("Signed transaction %v", tx.TxHash())
+	} else {
+		log.Warnf("Transaction %v is only partially signed", tx.TxHash())
+	}
+	return nil
+}
+
+// broadcastTx publishes the completely signed transaction read from the
+// --broadcasttx file.  With --spv, the transaction is relayed to the peers
+// configured by --spvconnect, and otherwise it is submitted to the dcrd
+// JSON-RPC server.
+func broadcastTx(ctx context.Context, w *wallet.Wallet) error {
+	f, tx, err := readOfflineTx(cfg.BroadcastTx)
+	if err != nil {
+		return err
+	}
+	if !f.Complete {
+		return errors.E(errors.Invalid, "transaction is not completely signed")
+	}
+
+	if cfg.SPV {
+		if len(cfg.SPVConnect) == 0 {
+			return errors.E(errors.Invalid, "broadcasting with --spv "+
+				"requires --spvconnect peers")
+		}
+		addr := &net.TCPAddr{IP: net.ParseIP("::1"), Port: 0}
+		amgrDir := filepath.Join(cfg.AppDataDir.Value, w.ChainParams().Name)
+		amgr := addrmgr.New(amgrDir, cfg.lookup)
+		lp := p2p.NewLocalPeer(w.ChainParams(), addr, amgr)
+		lp.SetDialFunc(cfg.dial)
+		var published int
+		for _, raddr := range cfg.SPVConnect {
+			rp, err := lp.ConnectOutbound(ctx, raddr, wire.SFNodeNetwork)
+			if err != nil {
+				log.Warnf("Unable to connect to peer %v: %v", raddr, err)
+				continue
+			}
+			err = rp.SendTransactions(ctx, tx)
+			rp.Disconnect(errors.E("transaction broadcast"))
+			if err != nil {
+				log.Warnf("Unable to publish to peer %v: %v", raddr, err)
+				continue
+			}
+			published++
+		}
+		if published == 0 {
+			return errors.E(errors.IO, "transaction was not published to any peer")
+		}
+	} else {
+		err := chain.PublishTransactions(ctx, w.ChainParams(), dcrdRPCOptions(), tx)
+		if err != nil {
+			return err
+		}
+	}
+	log.Infof("Published transaction %v", tx.TxHash())
+	return nil
+}
+
+func readOfflineTx(filename string) (*offlineTx, *wire.MsgTx, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+	f := new(offlineTx)
+	if err := json.Unmarshal(b, f); err != nil {
+		return nil, nil, errors.E(errors.Encoding, errors.Errorf("%s: %v",
+			filename, err))
+	}
+	serializedTx, err := hex.DecodeString(f.Transaction)
+	if err != nil {
+		return nil, nil, errors.E(errors.Encoding, err)
+	}
+	tx := new(wire.MsgTx)
+	if err := tx.Deserialize(bytes.NewReader(serializedTx)); err != nil {
+		return nil, nil, errors.E(errors.Encoding, err)
+	}
+	if len(f.Inputs) != len(tx.TxIn) {
+		return nil, nil, errors.E(errors.Invalid, errors.Errorf("%s: "+
+			"transaction has %d inputs but %d previous outputs are "+
+			"described", filename, len(tx.TxIn), len(f.Inputs)))
+	}
+	return f, tx, nil
+}
+
+// writeOfflineTx writes the transaction file to --offlinetxout, or to stdout
+// when no output file is configured.
+func writeOfflineTx(f *offlineTx, tx *wire.MsgTx) error {
+	var buf bytes.Buffer
+	buf.Grow(tx.SerializeSize())
+	if err := tx.Serialize(&buf); err != nil {
+		return err
+	}
+	f.Transaction = hex.EncodeToString(buf.Bytes())
+	b, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+	if cfg.OfflineTxOut == "" {
+		_, err = os.Stdout.Write(b)
+		return err
+	}
+	return os.WriteFile(cfg.OfflineTxOut, b, 0600)
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/internal/loader"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/txscript/v4"
+	"github.com/decred/dcrd/wire"
+)
+
+// testOfflineTx returns an unsigned transaction spending a single output
+// paying pkScript.
+func testOfflineTx(pkScript []byte) (*offlineTx, *wire.MsgTx) {
+	prev := wire.OutPoint{Hash: chainhash.Hash{1}, Index: 2, Tree: wire.TxTreeRegular}
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(&prev, 1e8, nil))
+	tx.AddTxOut(wire.NewTxOut(1e8-1e5, []byte{txscript.OP_TRUE}))
+	f := &offlineTx{Inputs: []offlineTxInput{{
+		TxID:         prev.Hash.String(),
+		Vout:         prev.Index,
+		Tree:         prev.Tree,
+		ScriptPubKey: hex.EncodeToString(pkScript),
+	}}}
+	return f, tx
+}
+
+func setOfflineTxConfig(t *testing.T, c *config) {
+	prev := cfg
+	cfg = c
+	t.Cleanup(func() { cfg = prev })
+}
+
+func TestOfflineTxEncoding(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "tx.json")
+	setOfflineTxConfig(t, &config{OfflineTxOut: out})
+
+	f, tx := testOfflineTx([]byte{txscript.OP_TRUE})
+	f.Complete = true
+	if err := writeOfflineTx(f, tx); err != nil {
+		t.Fatal(err)
+	}
+	f2, tx2, err := readOfflineTx(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx2.TxHash() != tx.TxHash() || !f2.Complete ||
+		len(f2.Inputs) != 1 || f2.Inputs[0] != f.Inputs[0] {
+		t.Fatalf("decoded %+v %v, want %+v %v", f2, tx2.TxHash(), f, tx.TxHash())
+	}
+
+	// Files must describe the previous output of every input.
+	f.Inputs = nil
+	if err := writeOfflineTx(f, tx); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := readOfflineTx(out); !errors.Is(err, errors.Invalid) {
+		t.Errorf("read file missing inputs: %v", err)
+	}
+
+	if err := os.WriteFile(out, []byte(`{"transaction":"zz"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := readOfflineTx(out); !errors.Is(err, errors.Encoding) {
+		t.Errorf("read invalid transaction: %v", err)
+	}
+}
+
+func TestOfflineTxSign(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	params := chaincfg.SimNetParams()
+	l := loader.NewLoader(params, dir, false, 20, 0, false, 1e4, 0, 0,
+		false, false, false, 0, nil)
+	privPass := []byte("private")
+	w, err := l.CreateNewWallet(ctx, []byte("public"), privPass,
+		bytes.Repeat([]byte{0x07}, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.UnloadWallet()
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, pkScript := addr.PaymentScript()
+
+	in := filepath.Join(dir, "unsigned.json")
+	out := filepath.Join(dir, "signed.json")
+	setOfflineTxConfig(t, &config{OfflineTxOut: in})
+	f, tx := testOfflineTx(pkScript)
+	if err := writeOfflineTx(f, tx); err != nil {
+		t.Fatal(err)
+	}
+
+	setOfflineTxConfig(t, &config{
+		SignTx:       in,
+		OfflineTxOut: out,
+		Pass:         string(privPass),
+	})
+	if err := signTx(ctx, w); err != nil {
+		t.Fatal(err)
+	}
+	if !w.Locked() {
+		t.Error("wallet remains unlocked after signing")
+	}
+
+	signed, signedTx, err := readOfflineTx(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !signed.Complete {
+		t.Fatal("signed transaction is not complete")
+	}
+	vm, err := txscript.NewEngine(pkScript, signedTx, 0,
+		txscript.ScriptVerifyCheckLockTimeVerify, 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := vm.Execute(); err != nil {
+		t.Fatalf("invalid signature: %v", err)
+	}
+}
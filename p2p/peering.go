@@ -34,10 +34,10 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
-// uaName is the LocalPeer useragent name.
+// uaName is the default LocalPeer useragent name.
 const uaName = "dcrwallet"
 
-// uaVersion is the LocalPeer useragent version.
+// uaVersion is the default LocalPeer useragent version.
 var uaVersion = version.String()
 
 // minPver is the minimum protocol version we require remote peers to
@@ -160,6 +160,14 @@ type LocalPeer struct {
 	chainParams    *chaincfg.Params
 	disableRelayTx bool
 
+	// Advertised user agent and the policy remote peers must satisfy.
+	uaName       string
+	uaVersion    string
+	requiredSvcs wire.ServiceFlag
+	requiredPver uint32
+	allowNets    []*net.IPNet
+	denyNets     []*net.IPNet
+
 	rpByID map[uint64]*RemotePeer
 	rpMu   sync.Mutex
 }
@@ -178,6 +186,9 @@ func NewLocalPeer(params *chaincfg.Params, extaddr *net.TCPAddr, amgr *addrmgr.A
 		extaddr:           extaddr,
 		amgr:              amgr,
 		chainParams:       params,
+		uaName:            uaName,
+		uaVersion:         uaVersion,
+		requiredPver:      minPver,
 		rpByID:            make(map[uint64]*RemotePeer),
 	}
 	return lp
@@ -198,6 +209,55 @@ func (lp *LocalPeer) SetDisableRelayTx(disableRelayTx bool) {
 	lp.disableRelayTx = disableRelayTx
 }
 
+// SetUserAgent sets the user agent name and version advertised to remote
+// peers.  This must be called before the local peer runs.
+func (lp *LocalPeer) SetUserAgent(name, ver string) {
+	lp.uaName = name
+	lp.uaVersion = ver
+}
+
+// SetRequiredServices sets service flags which every remote peer must
+// advertise, in addition to any services required by each outbound
+// connection.  This must be called before the local peer runs.
+func (lp *LocalPeer) SetRequiredServices(svcs wire.ServiceFlag) {
+	lp.requiredSvcs = svcs
+}
+
+// SetMinProtocolVersion sets the minimum protocol version remote peers must
+// implement.  Versions below the minimum supported by the local peer are
+// ignored.  This must be called before the local peer runs.
+func (lp *LocalPeer) SetMinProtocolVersion(pver uint32) {
+	lp.requiredPver = max(pver, minPver)
+}
+
+// SetPeerFilter sets the networks remote peers may be connected to.  When
+// allow is not empty, only peers in one of the allowed networks are
+// permitted.  Peers in any of the denied networks are never permitted.  This
+// must be called before the local peer runs.
+func (lp *LocalPeer) SetPeerFilter(allow, deny []*net.IPNet) {
+	lp.allowNets = allow
+	lp.denyNets = deny
+}
+
+// PermitsIP returns whether the peer filter permits connections to a remote
+// peer with the IP address.
+func (lp *LocalPeer) PermitsIP(ip net.IP) bool {
+	for _, n := range lp.denyNets {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+	if len(lp.allowNets) == 0 {
+		return true
+	}
+	for _, n := range lp.allowNets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
 func isCGNAT(ip net.IP) bool {
 	if ip4 := ip.To4(); ip4 != nil {
 		return ip4[0] == 100 && ip4[1]&0xc0 == 64 // 100.64.0.0/10
@@ -238,7 +298,10 @@ func (lp *LocalPeer) newMsgVersion(pver uint32, c net.Conn) (*wire.MsgVersion, e
 		return nil, err
 	}
 	v := wire.NewMsgVersion(la, ra, nonce, 0)
-	v.AddUserAgent(uaName, uaVersion)
+	err = v.AddUserAgent(lp.uaName, lp.uaVersion)
+	if err != nil {
+		return nil, err
+	}
 	v.ProtocolVersion = int32(pver)
 	v.DisableRelayTx = lp.disableRelayTx
 	return v, nil
@@ -267,6 +330,10 @@ func (lp *LocalPeer) ConnectOutbound(ctx context.Context, addr string, reqSvcs w
 	if err != nil {
 		return nil, err
 	}
+	if !lp.PermitsIP(tcpAddr.IP) {
+		op := errors.Opf(opf, addr)
+		return nil, errors.E(op, errors.Policy, "address is not permitted by peer filter")
+	}
 
 	// Create a net address with assumed services.
 	na := addrmgr.NewNetAddressIPPort(tcpAddr.IP, uint16(tcpAddr.Port), wire.SFNodeNetwork)
@@ -281,6 +348,7 @@ func (lp *LocalPeer) ConnectOutbound(ctx context.Context, addr string, reqSvcs w
 	go lp.serveUntilError(ctx, rp)
 
 	// Disconnect from the peer if it does not specify all required services.
+	reqSvcs |= lp.requiredSvcs
 	if rp.services&reqSvcs != reqSvcs {
 		op := errors.Opf(opf, rp.raddr)
 		err := errors.E(op, errors.Errorf("missing required service flags %v",
@@ -607,7 +675,7 @@ func handshake(ctx context.Context, lp *LocalPeer, id uint64, na *addrmgr.NetAdd
 	c.SetReadDeadline(time.Time{})
 
 	// Negotiate protocol down to compatible version
-	if uint32(rversion.ProtocolVersion) < minPver {
+	if uint32(rversion.ProtocolVersion) < lp.requiredPver {
 		return nil, errors.E(op, errors.Protocol, "remote peer has pver lower than minimum required")
 	}
 	if uint32(rversion.ProtocolVersion) < rp.pver {
@@ -2095,6 +2163,23 @@ func (rp *RemotePeer) PublishTransactions(ctx context.Context, txs ...*wire.MsgT
 	return nil
 }
 
+// SendTransactions sends txs to the remote peer without first advertising
+// them, waiting until each message is written.  This is useful to relay
+// transactions over short-lived connections which may close before the peer
+// requests any advertised inventory.
+func (rp *RemotePeer) SendTransactions(ctx context.Context, txs ...*wire.MsgTx) error {
+	const opf = "remotepeer(%v).SendTransactions"
+
+	for _, tx := range txs {
+		err := rp.sendMessageAck(ctx, tx)
+		if err != nil {
+			op := errors.Opf(opf, rp.raddr)
+			return errors.E(op, err)
+		}
+	}
+	return nil
+}
+
 // PublishMixMessages pushes an inventory message advertising transaction
 // hashes of txs.
 func (rp *RemotePeer) PublishMixMessages(ctx context.Context, msgs ...mixing.Message) error {
@@ -0,0 +1,58 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"net"
+	"testing"
+)
+
+func TestPermitsIP(t *testing.T) {
+	cidrs := func(s ...string) []*net.IPNet {
+		nets := make([]*net.IPNet, 0, len(s))
+		for _, s := range s {
+			_, n, err := net.ParseCIDR(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			nets = append(nets, n)
+		}
+		return nets
+	}
+
+	tests := []struct {
+		name    string
+		allow   []*net.IPNet
+		deny    []*net.IPNet
+		ip      string
+		permits bool
+	}{
+		{"no filter", nil, nil, "203.0.113.1", true},
+		{"allowed", cidrs("203.0.113.0/24"), nil, "203.0.113.1", true},
+		{"not allowed", cidrs("203.0.113.0/24"), nil, "198.51.100.1", false},
+		{"second allowed network", cidrs("203.0.113.0/24", "198.51.100.0/24"), nil, "198.51.100.1", true},
+		{"denied", nil, cidrs("203.0.113.0/24"), "203.0.113.1", false},
+		{"not denied", nil, cidrs("203.0.113.0/24"), "198.51.100.1", true},
+		{"denied within allowed", cidrs("203.0.113.0/24"), cidrs("203.0.113.128/25"), "203.0.113.129", false},
+		{"allowed outside denied", cidrs("203.0.113.0/24"), cidrs("203.0.113.128/25"), "203.0.113.1", true},
+		{"ipv6 allowed", cidrs("2001:db8::/32"), nil, "2001:db8::1", true},
+		{"ipv6 not allowed", cidrs("2001:db8::/32"), nil, "2001:db9::1", false},
+		{"ipv4 mapped ipv6", cidrs("203.0.113.0/24"), nil, "::ffff:203.0.113.1", true},
+		{"ipv6 in ipv4 filter", cidrs("203.0.113.0/24"), nil, "2001:db8::1", false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			lp := new(LocalPeer)
+			lp.SetPeerFilter(tc.allow, tc.deny)
+			ip := net.ParseIP(tc.ip)
+			if ip == nil {
+				t.Fatalf("invalid IP %q", tc.ip)
+			}
+			if permits := lp.PermitsIP(ip); permits != tc.permits {
+				t.Fatalf("PermitsIP(%v) = %v, want %v", ip, permits, tc.permits)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package ratelimit limits the network bandwidth and request concurrency of
+// wallet synchronization.
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+)
+
+// Limits describes the download limits of a synchronization phase.  Zero
+// values do not limit.
+type Limits struct {
+	// Rate is the maximum rate in bytes per second at which data is read
+	// from throttled connections.
+	Rate int64
+
+	// Concurrency is the maximum number of concurrent block and compact
+	// filter requests.
+	Concurrency int
+}
+
+// Throttle limits the read rate of network connections and the number of
+// concurrent requests of a syncer.  The initial limits apply until Steady is
+// called once the syncer has caught up with the network, and are applied
+// again by Initial when the syncer restarts.  The rate limit is shared by all
+// connections wrapped by the throttle.
+//
+// A nil Throttle does not limit.  All Throttle methods are concurrent safe.
+type Throttle struct {
+	initial, steady Limits
+
+	mu     sync.Mutex
+	limits Limits
+	tokens float64
+	last   time.Time
+	active int
+	wake   chan struct{}
+}
+
+// New returns a Throttle applying the initial limits.
+func New(initial, steady Limits) *Throttle {
+	return &Throttle{
+		initial: initial,
+		steady:  steady,
+		limits:  initial,
+		wake:    make(chan struct{}),
+	}
+}
+
+// Initial applies the initial sync limits.
+func (t *Throttle) Initial() {
+	if t != nil {
+		t.setLimits(t.initial)
+	}
+}
+
+// Steady applies the steady state limits.
+func (t *Throttle) Steady() {
+	if t != nil {
+		t.setLimits(t.steady)
+	}
+}
+
+// Limits returns the currently applied limits.
+func (t *Throttle) Limits() Limits {
+	if t == nil {
+		return Limits{}
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.limits
+}
+
+func (t *Throttle) setLimits(l Limits) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if l == t.limits {
+		return
+	}
+	t.limits = l
+	t.tokens = 0
+	t.last = time.Time{}
+	t.broadcast()
+}
+
+// broadcast wakes all waiters of Acquire.  t.mu must be held.
+func (t *Throttle) broadcast() {
+	close(t.wake)
+	t.wake = make(chan struct{})
+}
+
+// Acquire waits until fewer than the concurrency limit of requests are in
+// progress, and returns a function which must be called to release the
+// request when it completes.
+func (t *Throttle) Acquire(ctx context.Context) (release func(), err error) {
+	if t == nil {
+		return func() {}, nil
+	}
+	for {
+		t.mu.Lock()
+		if t.limits.Concurrency <= 0 || t.active < t.limits.Concurrency {
+			t.active++
+			t.mu.Unlock()
+			var once sync.Once
+			return func() { once.Do(t.release) }, nil
+		}
+		wake := t.wake
+		t.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-wake:
+		}
+	}
+}
+
+func (t *Throttle) release() {
+	t.mu.Lock()
+	t.active--
+	t.broadcast()
+	t.mu.Unlock()
+}
+
+// maxRead returns the maximum number of bytes which should be read at once
+// under the current rate limit, or n when unlimited.
+func (t *Throttle) maxRead(n int) int {
+	t.mu.Lock()
+	rate := t.limits.Rate
+	t.mu.Unlock()
+	if rate > 0 && int64(n) > rate {
+		return int(rate)
+	}
+	return n
+}
+
+// delay records that n bytes were read and returns how long the reader must
+// wait for the read to conform to the rate limit.  Reads may burst up to one
+// second of the rate.
+func (t *Throttle) delay(n int) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	rate := float64(t.limits.Rate)
+	if rate <= 0 {
+		return 0
+	}
+	now := time.Now()
+	if !t.last.IsZero() {
+		t.tokens = min(rate, t.tokens+now.Sub(t.last).Seconds()*rate)
+	}
+	t.last = now
+	t.tokens -= float64(n)
+	if t.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-t.tokens / rate * float64(time.Second))
+}
+
+// Conn returns a connection which reads from c at no more than the throttle's
+// rate limit.
+func (t *Throttle) Conn(c net.Conn) net.Conn {
+	if t == nil {
+		return c
+	}
+	return &conn{Conn: c, t: t}
+}
+
+// Dial returns a dial function which throttles the connections dialed by
+// dial.
+func (t *Throttle) Dial(dial func(ctx context.Context, network, addr string) (net.Conn, error)) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	if t == nil {
+		return dial
+	}
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		c, err := dial(ctx, network, addr)
+		if err != nil {
+			return nil, err
+		}
+		return t.Conn(c), nil
+	}
+}
+
+type conn struct {
+	net.Conn
+	t *Throttle
+}
+
+// Read reads from the connection, limiting the size of reads to the rate and
+// sleeping after each read until it conforms to the rate limit.
+func (c *conn) Read(b []byte) (int, error) {
+	b = b[:c.t.maxRead(len(b))]
+	n, err := c.Conn.Read(b)
+	if n > 0 {
+		if d := c.t.delay(n); d > 0 {
+			time.Sleep(d)
+		}
+	}
+	return n, err
+}
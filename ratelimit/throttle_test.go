@@ -0,0 +1,88 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package ratelimit
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestConcurrency(t *testing.T) {
+	ctx := context.Background()
+	th := New(Limits{Concurrency: 1}, Limits{Concurrency: 2})
+
+	release, err := th.Acquire(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if _, err := th.Acquire(timeoutCtx); err == nil {
+		t.Fatal("acquired request beyond the initial concurrency limit")
+	}
+
+	// Applying the steady limits wakes waiters.
+	acquired := make(chan struct{})
+	go func() {
+		if _, err := th.Acquire(ctx); err == nil {
+			close(acquired)
+		}
+	}()
+	th.Steady()
+	select {
+	case <-acquired:
+	case <-time.After(5 * time.Second):
+		t.Fatal("request not acquired after raising the concurrency limit")
+	}
+
+	// Releasing twice does not release another request.
+	release()
+	release()
+	if _, err := th.Acquire(ctx); err != nil {
+		t.Fatal(err)
+	}
+	timeoutCtx, cancel = context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if _, err := th.Acquire(timeoutCtx); err == nil {
+		t.Fatal("acquired request beyond the steady concurrency limit")
+	}
+}
+
+func TestRate(t *testing.T) {
+	const rate = 200000
+	th := New(Limits{Rate: rate}, Limits{})
+
+	server, client := net.Pipe()
+	defer client.Close()
+	go func() {
+		server.Write(make([]byte, rate/2))
+		server.Close()
+	}()
+
+	start := time.Now()
+	n, err := io.Copy(io.Discard, th.Conn(client))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != rate/2 {
+		t.Fatalf("read %d bytes", n)
+	}
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Fatalf("read %d bytes at %d bytes/s in %v", n, rate, elapsed)
+	}
+
+	// Steady limits of zero do not limit.
+	th.Steady()
+	if d := th.delay(rate * 10); d != 0 {
+		t.Fatalf("unlimited read delayed %v", d)
+	}
+	var nilThrottle *Throttle
+	if c := nilThrottle.Conn(client); c != client {
+		t.Fatal("nil throttle wrapped connection")
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	ldr "decred.org/dcrwallet/v5/internal/loader"
+)
+
+// runReplica serves the reporting JSON-RPC methods of a read-only replica
+// wallet opened from the --replicaof snapshot, reloading the wallet each time
+// the snapshot is replaced, until the context is cancelled.
+func runReplica(ctx context.Context, loader *ldr.Loader, pubPass []byte) error {
+	log.Infof("Serving read-only replica of %s", cfg.ReplicaOf)
+
+	_, jsonRPCServer, err := startRPCServers(ctx, loader, nil)
+	if err != nil {
+		log.Errorf("Unable to create RPC servers: %v", err)
+		return err
+	}
+	if jsonRPCServer != nil {
+		go func() {
+			for range jsonRPCServer.RequestProcessShutdown() {
+				requestShutdown()
+			}
+		}()
+		defer func() {
+			log.Warn("Stopping JSON-RPC server...")
+			jsonRPCServer.Stop()
+			log.Info("JSON-RPC server shutdown")
+		}()
+	}
+
+	err = loader.FollowReplica(ctx, pubPass, cfg.ReplicaPollInterval)
+	if errors.Is(err, context.Canceled) {
+		return ctx.Err()
+	}
+	return err
+}
@@ -110,6 +110,11 @@ func NewConsolidateCmd(inputs int, acct *string, addr *string) *ConsolidateCmd {
 	return &ConsolidateCmd{Inputs: inputs, Account: acct, Address: addr}
 }
 
+// CosignVoteCmd defines the cosignvote JSON-RPC command.
+type CosignVoteCmd struct {
+	HexVote string
+}
+
 // CreateMultisigCmd defines the createmultisig JSON-RPC command.
 type CreateMultisigCmd struct {
 	NRequired int
@@ -238,6 +243,9 @@ func NewGetAddressesByAccountCmd(account string) *GetAddressesByAccountCmd {
 	}
 }
 
+// GetCapabilitiesCmd defines the getcapabilities JSON-RPC command.
+type GetCapabilitiesCmd struct{}
+
 // GetBalanceCmd defines the getbalance JSON-RPC command.
 type GetBalanceCmd struct {
 	Account *string
@@ -282,8 +290,10 @@ func NewGetMultisigOutInfoCmd(hash string, index uint32) *GetMultisigOutInfoCmd
 
 // GetNewAddressCmd defines the getnewaddress JSON-RPC command.
 type GetNewAddressCmd struct {
-	Account   *string
-	GapPolicy *string
+	Account      *string
+	GapPolicy    *string
+	ExpiresIn    *int64
+	ExpiryHeight *int32
 }
 
 // NewGetNewAddressCmd returns a new instance which can be used to issue a
@@ -413,6 +423,12 @@ type GetVoteChoicesCmd struct {
 	TicketHash *string
 }
 
+// GetVoteDiagnosticsCmd defines the getvotediagnostics JSON-RPC command
+// arguments.
+type GetVoteDiagnosticsCmd struct {
+	Count *int `jsonrpcdefault:"20"`
+}
+
 // NewGetVoteChoicesCmd returns a new instance which can be used to
 // issue a JSON-RPC getvotechoices command.
 func NewGetVoteChoicesCmd(tickethash *string) *GetVoteChoicesCmd {
@@ -421,6 +437,9 @@ func NewGetVoteChoicesCmd(tickethash *string) *GetVoteChoicesCmd {
 	}
 }
 
+// GetVoteVersionInfoCmd defines the getvoteversioninfo JSON-RPC command.
+type GetVoteVersionInfoCmd struct{}
+
 // GetWalletFeeCmd defines the getwalletfee JSON-RPC command.
 type GetWalletFeeCmd struct{}
 
@@ -480,11 +499,12 @@ type ImportScriptCmd struct {
 	Hex      string
 	Rescan   *bool `jsonrpcdefault:"true"`
 	ScanFrom *int
+	Account  *string
 }
 
 // NewImportScriptCmd creates a new GetImportScriptCmd.
 func NewImportScriptCmd(hex string, rescan *bool, scanFrom *int) *ImportScriptCmd {
-	return &ImportScriptCmd{hex, rescan, scanFrom}
+	return &ImportScriptCmd{Hex: hex, Rescan: rescan, ScanFrom: scanFrom}
 }
 
 // ImportXpubCmd is a type for handling custom marshaling and unmarshaling of
@@ -494,6 +514,16 @@ type ImportXpubCmd struct {
 	Xpub string `json:"xpub"`
 }
 
+// ImportExternalSignerAccountCmd defines the importexternalsigneraccount
+// JSON-RPC command.
+type ImportExternalSignerAccountCmd struct {
+	Name        string `json:"name"`
+	Xpub        string `json:"xpub"`
+	Fingerprint string `json:"fingerprint"`
+	Path        string `json:"path"`
+	Model       string `json:"model"`
+}
+
 // ListAccountsCmd defines the listaccounts JSON-RPC command.
 type ListAccountsCmd struct {
 	MinConf *int `jsonrpcdefault:"1"`
@@ -677,13 +707,16 @@ func NewLockUnspentCmd(unlock bool, transactions []dcrdtypes.TransactionInput) *
 // PurchaseTicketCmd is a type handling custom marshaling and
 // unmarshaling of purchaseticket JSON RPC commands.
 type PurchaseTicketCmd struct {
-	FromAccount string
-	SpendLimit  float64 // In Coins
-	MinConf     *int    `jsonrpcdefault:"1"`
-	NumTickets  *int    `jsonrpcdefault:"1"`
-	Expiry      *int
-	Comment     *string
-	DontSignTx  *bool
+	FromAccount    string
+	SpendLimit     float64 // In Coins
+	MinConf        *int    `jsonrpcdefault:"1"`
+	NumTickets     *int    `jsonrpcdefault:"1"`
+	Expiry         *int
+	Comment        *string
+	DontSignTx     *bool
+	VotingScript   *string
+	IdempotencyKey *string
+	MixSplit       *bool
 }
 
 // NewPurchaseTicketCmd creates a new PurchaseTicketCmd.
@@ -706,6 +739,14 @@ type CreateUnsignedTicketResult struct {
 	SplitTx         string   `json:"splittx"`
 }
 
+// RecoverMixOutputsCmd defines the recovermixoutputs JSON-RPC command
+// arguments.
+type RecoverMixOutputsCmd struct {
+	Accounts    *[]string
+	ScanLen     *uint32 `jsonrpcdefault:"1000"`
+	StartHeight *int32  `jsonrpcdefault:"0"`
+}
+
 // RedeemMultiSigOutCmd is a type handling custom marshaling and
 // unmarshaling of redeemmultisigout JSON RPC commands.
 type RedeemMultiSigOutCmd struct {
@@ -766,12 +807,15 @@ type RescanWalletCmd struct {
 
 // SendFromCmd defines the sendfrom JSON-RPC command.
 type SendFromCmd struct {
-	FromAccount string
-	ToAddress   string
-	Amount      float64 // In DCR
-	MinConf     *int    `jsonrpcdefault:"1"`
-	Comment     *string
-	CommentTo   *string
+	FromAccount         string
+	ToAddress           string
+	Amount              float64 // In DCR
+	MinConf             *int    `jsonrpcdefault:"1"`
+	Comment             *string
+	CommentTo           *string
+	IdempotencyKey      *string
+	DestinationOverride *string
+	ExpiryBlocks        *int32
 }
 
 // NewSendFromCmd returns a new instance which can be used to issue a sendfrom
@@ -792,10 +836,13 @@ func NewSendFromCmd(fromAccount, toAddress string, amount float64, minConf *int,
 
 // SendManyCmd defines the sendmany JSON-RPC command.
 type SendManyCmd struct {
-	FromAccount string
-	Amounts     map[string]float64 `jsonrpcusage:"{\"address\":amount,...}"` // In DCR
-	MinConf     *int               `jsonrpcdefault:"1"`
-	Comment     *string
+	FromAccount         string
+	Amounts             map[string]float64 `jsonrpcusage:"{\"address\":amount,...}"` // In DCR
+	MinConf             *int               `jsonrpcdefault:"1"`
+	Comment             *string
+	IdempotencyKey      *string
+	DestinationOverride *string
+	ExpiryBlocks        *int32
 }
 
 // NewSendManyCmd returns a new instance which can be used to issue a sendmany
@@ -814,10 +861,13 @@ func NewSendManyCmd(fromAccount string, amounts map[string]float64, minConf *int
 
 // SendToAddressCmd defines the sendtoaddress JSON-RPC command.
 type SendToAddressCmd struct {
-	Address   string
-	Amount    float64
-	Comment   *string
-	CommentTo *string
+	Address             string
+	Amount              float64
+	Comment             *string
+	CommentTo           *string
+	IdempotencyKey      *string
+	DestinationOverride *string
+	ExpiryBlocks        *int32
 }
 
 // NewSendToAddressCmd returns a new instance which can be used to issue a
@@ -860,7 +910,9 @@ func NewSendToMultiSigCmd(fromaccount string, amount float64, pubkeys []string,
 
 // SendToTreasuryCmd defines the sendtotreasury JSON-RPC command.
 type SendToTreasuryCmd struct {
-	Amount float64
+	Amount      float64
+	FromAccount *string `jsonrpcdefault:"\"default\""`
+	MinConf     *int    `jsonrpcdefault:"1"`
 }
 
 // NewSendToTreasuryCmd returns a new instance which can be used to issue a
@@ -1117,6 +1169,9 @@ func NewWalletPassphraseChangeCmd(oldPassphrase, newPassphrase string) *WalletPa
 // MixAccountCmd defines the mixaccount JSON-RPC command.
 type MixAccountCmd struct{}
 
+// PreviewMixAccountCmd defines the previewmixaccount JSON-RPC command.
+type PreviewMixAccountCmd struct{}
+
 // MixOutputCmd defines the mixoutput JSON-RPC command.
 type MixOutputCmd struct {
 	Outpoint string `json:"outpoint"`
@@ -1177,6 +1232,19 @@ type ProcessUnmanagedTicketCmd struct {
 	TicketHash string
 }
 
+// ProveVSPOwnershipCmd defines the provevspownership JSON-RPC command
+// arguments.
+type ProveVSPOwnershipCmd struct {
+	TicketHash string
+	Request    string
+	APIVersion *uint32 `jsonrpcdefault:"3"`
+}
+
+// PruneSidechainsCmd defines the prunesidechains JSON-RPC command arguments.
+type PruneSidechainsCmd struct {
+	Depth *int32 `jsonrpcdefault:"256"`
+}
+
 // GetCoinjoinsByAcctCmd defines the getcoinjoinsbyaccount JSON-RPC command arguments.
 type GetCoinjoinsByAcctCmd struct{}
 
@@ -1194,6 +1262,504 @@ type AddressAmountPair struct {
 	Amount  float64 `json:"amount"`
 }
 
+// ReserveFundsCmd defines the reservefunds JSON-RPC command arguments.
+type ReserveFundsCmd struct {
+	ID      string
+	Account string
+	Amount  float64
+	MinConf *int `jsonrpcdefault:"1"`
+}
+
+// ReleaseFundsCmd defines the releasefunds JSON-RPC command arguments.
+type ReleaseFundsCmd struct {
+	ID string
+}
+
+// ListFundsReservationsCmd defines the listfundsreservations JSON-RPC command
+// arguments.
+type ListFundsReservationsCmd struct{}
+
+// GetReservedBalanceCmd defines the getreservedbalance JSON-RPC command
+// arguments.
+type GetReservedBalanceCmd struct {
+	Account string
+	MinConf *int `jsonrpcdefault:"1"`
+}
+
+// HoldOutputsCmd defines the holdoutputs JSON-RPC command arguments.
+type HoldOutputsCmd struct {
+	ID        string
+	Reason    string
+	Outpoints []string
+}
+
+// HoldAmountCmd defines the holdamount JSON-RPC command arguments.
+type HoldAmountCmd struct {
+	ID      string
+	Account string
+	Amount  float64
+	Reason  string
+	MinConf *int `jsonrpcdefault:"1"`
+}
+
+// ListHoldsCmd defines the listholds JSON-RPC command arguments.
+type ListHoldsCmd struct{}
+
+// ReleaseHoldCmd defines the releasehold JSON-RPC command arguments.
+type ReleaseHoldCmd struct {
+	ID string
+}
+
+// CreateSwapContractCmd defines the createswapcontract JSON-RPC command
+// arguments.
+type CreateSwapContractCmd struct {
+	Account    string
+	Recipient  string
+	SecretHash string
+	LockTime   int64
+}
+
+// RedeemSwapCmd defines the redeemswap JSON-RPC command arguments.
+type RedeemSwapCmd struct {
+	Account    string
+	ContractTx string
+	Contract   string
+	Secret     string
+}
+
+// RefundSwapCmd defines the refundswap JSON-RPC command arguments.
+type RefundSwapCmd struct {
+	Account    string
+	ContractTx string
+	Contract   string
+}
+
+// AddWebhookCmd defines the addwebhook JSON-RPC command arguments.
+type AddWebhookCmd struct {
+	URL       string
+	Account   *string
+	Address   *string
+	MinAmount *float64
+}
+
+// RemoveWebhookCmd defines the removewebhook JSON-RPC command arguments.
+type RemoveWebhookCmd struct {
+	ID string
+}
+
+// ListWebhooksCmd defines the listwebhooks JSON-RPC command arguments.
+type ListWebhooksCmd struct{}
+
+// ImportTreasuryKeyCmd defines the importtreasurykey JSON-RPC command
+// arguments.
+type ImportTreasuryKeyCmd struct {
+	PrivKey string
+	Name    string
+}
+
+// ListTreasuryKeysCmd defines the listtreasurykeys JSON-RPC command arguments.
+type ListTreasuryKeysCmd struct{}
+
+// RemoveTreasuryKeyCmd defines the removetreasurykey JSON-RPC command
+// arguments.
+type RemoveTreasuryKeyCmd struct {
+	PubKey string
+}
+
+// SignTSpendCmd defines the signtspend JSON-RPC command arguments.
+type SignTSpendCmd struct {
+	Tx     string
+	PubKey string
+}
+
+// SetMixedSpendPolicyCmd defines the setmixedspendpolicy JSON-RPC command
+// arguments.
+type SetMixedSpendPolicyCmd struct {
+	Account string
+	Branch  *uint32 `jsonrpcdefault:"0"`
+}
+
+// ClearMixedSpendPolicyCmd defines the clearmixedspendpolicy JSON-RPC command
+// arguments.
+type ClearMixedSpendPolicyCmd struct{}
+
+// GetMixedSpendPolicyCmd defines the getmixedspendpolicy JSON-RPC command
+// arguments.
+type GetMixedSpendPolicyCmd struct{}
+
+// SetDestinationPolicyCmd defines the setdestinationpolicy JSON-RPC command
+// arguments.
+type SetDestinationPolicyCmd struct {
+	Account    string
+	Allowlist  *bool `jsonrpcdefault:"false"`
+	Allowed    *[]string
+	Denied     *[]string
+	Credential *string
+}
+
+// GetAccountCryptoParamsCmd defines the getaccountcryptoparams JSON-RPC
+// command arguments.
+type GetAccountCryptoParamsCmd struct {
+	Account *string
+}
+
+// GetDestinationPolicyCmd defines the getdestinationpolicy JSON-RPC command
+// arguments.
+type GetDestinationPolicyCmd struct {
+	Account string
+}
+
+// SetDestinationOverrideCmd defines the setdestinationoverride JSON-RPC
+// command arguments.
+type SetDestinationOverrideCmd struct {
+	Credential string
+	Current    *string
+}
+
+// SetMixPolicyCmd defines the setmixpolicy JSON-RPC command arguments.
+type SetMixPolicyCmd struct {
+	MinPeers       int
+	MinPeerVersion *uint32 `jsonrpcdefault:"0"`
+}
+
+// GetMixPolicyCmd defines the getmixpolicy JSON-RPC command arguments.
+type GetMixPolicyCmd struct{}
+
+// SweepPrivKeyCmd defines the sweepprivkey JSON-RPC command arguments.
+type SweepPrivKeyCmd struct {
+	PrivKey  string
+	Account  *string `jsonrpcdefault:"\"default\""`
+	ScanFrom *int    `jsonrpcdefault:"0"`
+}
+
+// SweepToColdStorageCmd defines the sweeptocoldstorage JSON-RPC command
+// arguments.  Exactly one of DestAccount and DestXpub must be set.
+type SweepToColdStorageCmd struct {
+	SourceAccount string
+	DestAccount   *string
+	DestXpub      *string
+	DestXpubIndex *uint32 `jsonrpcdefault:"0"`
+	FeeRate       *float64
+	MaxFee        *float64
+	MaxInputs     *int  `jsonrpcdefault:"0"`
+	MinConf       *int  `jsonrpcdefault:"1"`
+	DryRun        *bool `jsonrpcdefault:"false"`
+}
+
+// SetDuressPassphraseCmd defines the setduresspassphrase JSON-RPC command
+// arguments.
+type SetDuressPassphraseCmd struct {
+	Account    string
+	Passphrase string
+}
+
+// GetDuressAccountCmd defines the getduressaccount JSON-RPC command.
+type GetDuressAccountCmd struct{}
+
+// CreateMultisigSpendCmd defines the createmultisigspend JSON-RPC command
+// arguments.
+type CreateMultisigSpendCmd struct {
+	FromScrAddress string
+	Amounts        map[string]float64 `jsonrpcusage:"{\"address\":amount,...}"` // In DCR
+	FeeRate        *float64
+	Outpoints      *[]string
+}
+
+// GetAccountIDCmd defines the getaccountid JSON-RPC command arguments.
+type GetAccountIDCmd struct {
+	Account string
+}
+
+// GetAccountByIDCmd defines the getaccountbyid JSON-RPC command arguments.
+type GetAccountByIDCmd struct {
+	UUID string
+}
+
+// CreateReserveSnapshotCmd defines the createreservesnapshot JSON-RPC command
+// arguments.
+type CreateReserveSnapshotCmd struct {
+	Message *string `jsonrpcdefault:"\"\""`
+}
+
+// VerifyReserveSnapshotCmd defines the verifyreservesnapshot JSON-RPC command
+// arguments.
+type VerifyReserveSnapshotCmd struct {
+	Snapshot ReserveSnapshotResult
+}
+
+// GetWalletStatsCmd defines the getwalletstats JSON-RPC command arguments.
+type GetWalletStatsCmd struct {
+	StartHeight int32
+	EndHeight   int32
+	Interval    *int32 `jsonrpcdefault:"0"`
+}
+
+// GetIdleLockCmd defines the getidlelock JSON-RPC command arguments.
+type GetIdleLockCmd struct{}
+
+// GetUTXOStatsCmd defines the getutxostats JSON-RPC command arguments.
+type GetUTXOStatsCmd struct {
+	Account       *string
+	DustThreshold *float64
+}
+
+// AnnotateRawTransactionCmd defines the annotaterawtransaction JSON-RPC
+// command arguments.
+type AnnotateRawTransactionCmd struct {
+	HexTx string
+}
+
+// ImportLegacyStakePoolTicketsCmd defines the importlegacystakepooltickets
+// JSON-RPC command arguments.
+type ImportLegacyStakePoolTicketsCmd struct {
+	Host           string
+	Script         string
+	PoolFeeAddress string
+	Tickets        *[]string
+}
+
+// DebugDumpCmd defines the debugdump JSON-RPC command arguments.
+type DebugDumpCmd struct{}
+
+// DebugInspectDBCmd defines the debuginspectdb JSON-RPC command arguments.
+type DebugInspectDBCmd struct {
+	Bucket *string `json:"bucket"`
+	Limit  *int    `jsonrpcdefault:"100"`
+}
+
+// DebugTimingsCmd defines the debugtimings JSON-RPC command arguments.
+type DebugTimingsCmd struct {
+	Reset *bool `jsonrpcdefault:"false"`
+}
+
+// AddressReuseReportCmd defines the addressreusereport JSON-RPC command
+// arguments.
+type AddressReuseReportCmd struct {
+	Since *int32 `json:"since"`
+}
+
+// TicketAddressReuseCmd defines the ticketaddressreuse JSON-RPC command
+// arguments.
+type TicketAddressReuseCmd struct{}
+
+// ImportSLIP0044AccountCmd defines the importslip0044account JSON-RPC command
+// arguments.
+type ImportSLIP0044AccountCmd struct {
+	Account    uint32  `json:"account"`
+	Passphrase string  `json:"passphrase"`
+	Name       *string `json:"name"`
+}
+
+// GetTicketPoolsCmd defines the getticketpools JSON-RPC command arguments.
+type GetTicketPoolsCmd struct {
+	Interval *int32 `jsonrpcdefault:"0"`
+}
+
+// ApprovePendingSpendCmd describes the command and parameters for performing
+// the approvependingspend method.
+type ApprovePendingSpendCmd struct {
+	TxHash string `json:"txhash"`
+}
+
+// ListPendingSpendsCmd describes the command for performing the
+// listpendingspends method.
+type ListPendingSpendsCmd struct{}
+
+// RejectPendingSpendCmd describes the command and parameters for performing
+// the rejectpendingspend method.
+type RejectPendingSpendCmd struct {
+	TxHash string `json:"txhash"`
+}
+
+// SetMaxFeeRateCmd defines the setmaxfeerate JSON-RPC command.
+type SetMaxFeeRateCmd struct {
+	Amount float64 // In DCR
+}
+
+// GetAccountChildXpubCmd defines the getaccountchildxpub JSON-RPC command.
+type GetAccountChildXpubCmd struct {
+	Account string `json:"account"`
+	Path    string `json:"path"`
+}
+
+// GetAccountWatchKeyCmd defines the getaccountwatchkey JSON-RPC command.
+type GetAccountWatchKeyCmd struct {
+	Account  string  `json:"account"`
+	Start    *uint32 `json:"start" jsonrpcdefault:"0"`
+	Count    *uint32 `json:"count" jsonrpcdefault:"20"`
+	Rescan   *bool   `json:"rescan" jsonrpcdefault:"false"`
+	ScanFrom *int    `json:"scanfrom"`
+}
+
+// MatchCFiltersCmd defines the matchcfilters JSON-RPC command.
+type MatchCFiltersCmd struct {
+	StartHeight int32 `json:"startheight"`
+	EndHeight   int32 `json:"endheight"`
+}
+
+// GetJournalEventsCmd defines the getjournalevents JSON-RPC command.
+type GetJournalEventsCmd struct {
+	FromSequence uint64 `json:"fromsequence"`
+	Count        *int   `json:"count" jsonrpcdefault:"1000"`
+}
+
+// GetAccountBlindedIDCmd defines the getaccountblindedid JSON-RPC command
+// arguments.
+type GetAccountBlindedIDCmd struct {
+	Account string
+}
+
+// LookupAccountBlindedIDCmd defines the lookupaccountblindedid JSON-RPC
+// command arguments.
+type LookupAccountBlindedIDCmd struct {
+	BlindedID string
+}
+
+// AttestAddressCmd defines the attestaddress JSON-RPC command arguments.
+type AttestAddressCmd struct {
+	Address string
+}
+
+// ListExpiredAddressesCmd defines the listexpiredaddresses JSON-RPC command.
+type ListExpiredAddressesCmd struct{}
+
+// ListExternalSignersCmd defines the listexternalsigners JSON-RPC command.
+type ListExternalSignersCmd struct{}
+
+// ExportVotingAccountCmd defines the exportvotingaccount JSON-RPC command
+// arguments.
+type ExportVotingAccountCmd struct {
+	Account string
+	Count   *uint32 `jsonrpcdefault:"20"`
+}
+
+// ImportVotingAccountCmd defines the importvotingaccount JSON-RPC command
+// arguments.
+type ImportVotingAccountCmd struct {
+	Xpriv      string
+	Passphrase string
+	Name       string
+}
+
+// VerifyVotingAccountCmd defines the verifyvotingaccount JSON-RPC command
+// arguments.
+type VerifyVotingAccountCmd struct {
+	Account   string
+	Addresses []string
+}
+
+// ExportVotePoliciesCmd defines the exportvotepolicies JSON-RPC command
+// arguments.
+type ExportVotePoliciesCmd struct {
+	Address string
+}
+
+// ImportVotePoliciesCmd defines the importvotepolicies JSON-RPC command
+// arguments.
+type ImportVotePoliciesCmd struct {
+	Policies string
+	Address  string
+}
+
+// EstimateRawTransactionCmd defines the estimaterawtransaction JSON-RPC
+// command arguments.
+type EstimateRawTransactionCmd struct {
+	HexString   string
+	FundAccount string
+	Options     *FundRawTransactionOptions
+}
+
+// ForecastStakeOptions overrides the ticket buyer configuration assumed by
+// the forecaststake command.
+type ForecastStakeOptions struct {
+	Account     *string  `json:"account"`
+	BuyTickets  *bool    `json:"buytickets"`
+	Maintain    *float64 `json:"maintain"`
+	Limit       *int     `json:"limit"`
+	TicketPrice *float64 `json:"ticketprice"`
+}
+
+// ForecastStakeCmd defines the forecaststake JSON-RPC command arguments.
+type ForecastStakeCmd struct {
+	Blocks   *int32 `jsonrpcdefault:"0"`
+	Interval *int32 `jsonrpcdefault:"0"`
+	Options  *ForecastStakeOptions
+}
+
+// SimulateStakeCmd defines the simulatestake JSON-RPC command arguments.
+type SimulateStakeCmd struct {
+	Amount      float64
+	Days        *int32 `jsonrpcdefault:"365"`
+	TicketPrice *float64
+}
+
+// EstimateTxSizeCmd defines the estimatetxsize JSON-RPC command arguments.
+type EstimateTxSizeCmd struct {
+	TxType  string
+	Inputs  []string
+	Outputs []string
+	Change  *bool `jsonrpcdefault:"false"`
+	FeeRate *float64
+}
+
+// CreatePaymentURICmd defines the createpaymenturi JSON-RPC command
+// arguments.
+type CreatePaymentURICmd struct {
+	Account   *string `jsonrpcdefault:"\"default\""`
+	Amount    *float64
+	Label     *string
+	Message   *string
+	ExpiresIn *int64
+}
+
+// ParsePaymentURICmd defines the parsepaymenturi JSON-RPC command arguments.
+type ParsePaymentURICmd struct {
+	URI string
+}
+
+// CreateInvoiceCmd defines the createinvoice JSON-RPC command arguments.
+type CreateInvoiceCmd struct {
+	ID        string
+	Amount    float64
+	Account   *string `jsonrpcdefault:"\"default\""`
+	ExpiresIn *int64
+	GapPolicy *string
+}
+
+// GetInvoiceCmd defines the getinvoice JSON-RPC command arguments.
+type GetInvoiceCmd struct {
+	ID string
+}
+
+// ListInvoicesCmd defines the listinvoices JSON-RPC command arguments.
+type ListInvoicesCmd struct {
+	Account *string
+	Status  *string
+}
+
+// DeleteInvoiceCmd defines the deleteinvoice JSON-RPC command arguments.
+type DeleteInvoiceCmd struct {
+	ID string
+}
+
+// PregenerateAddressesCmd defines the pregenerateaddresses JSON-RPC command
+// arguments.
+type PregenerateAddressesCmd struct {
+	Account string
+	Count   uint32
+	Branch  *uint32 `jsonrpcdefault:"0"`
+}
+
+// ImportCheckpointCmd defines the importcheckpoint JSON-RPC command arguments.
+type ImportCheckpointCmd struct {
+	Checkpoint string
+}
+
+// SetLockModeCmd defines the setlockmode JSON-RPC command arguments.
+type SetLockModeCmd struct {
+	Mode string
+}
+
 func init() {
 	type registeredMethod struct {
 		method string
@@ -1207,58 +1773,132 @@ func init() {
 		{"accountsyncaddressindex", (*AccountSyncAddressIndexCmd)(nil)},
 		{"accountunlocked", (*AccountUnlockedCmd)(nil)},
 		{"addmultisigaddress", (*AddMultisigAddressCmd)(nil)},
+		{"addressreusereport", (*AddressReuseReportCmd)(nil)},
 		{"addtransaction", (*AddTransactionCmd)(nil)},
+		{"addwebhook", (*AddWebhookCmd)(nil)},
+		{"annotaterawtransaction", (*AnnotateRawTransactionCmd)(nil)},
+		{"approvependingspend", (*ApprovePendingSpendCmd)(nil)},
+		{"attestaddress", (*AttestAddressCmd)(nil)},
 		{"auditreuse", (*AuditReuseCmd)(nil)},
+		{"clearmixedspendpolicy", (*ClearMixedSpendPolicyCmd)(nil)},
 		{"consolidate", (*ConsolidateCmd)(nil)},
+		{"cosignvote", (*CosignVoteCmd)(nil)},
+		{"createinvoice", (*CreateInvoiceCmd)(nil)},
 		{"createmultisig", (*CreateMultisigCmd)(nil)},
+		{"createmultisigspend", (*CreateMultisigSpendCmd)(nil)},
 		{"createnewaccount", (*CreateNewAccountCmd)(nil)},
+		{"createpaymenturi", (*CreatePaymentURICmd)(nil)},
+		{"createreservesnapshot", (*CreateReserveSnapshotCmd)(nil)},
 		{"createsignature", (*CreateSignatureCmd)(nil)},
+		{"createswapcontract", (*CreateSwapContractCmd)(nil)},
 		{"createvotingaccount", (*CreateVotingAccountCmd)(nil)},
+		{"debugdump", (*DebugDumpCmd)(nil)},
+		{"debuginspectdb", (*DebugInspectDBCmd)(nil)},
+		{"debugtimings", (*DebugTimingsCmd)(nil)},
+		{"deleteinvoice", (*DeleteInvoiceCmd)(nil)},
 		{"disapprovepercent", (*DisapprovePercentCmd)(nil)},
 		{"discoverusage", (*DiscoverUsageCmd)(nil)},
 		{"dumpprivkey", (*DumpPrivKeyCmd)(nil)},
+		{"estimaterawtransaction", (*EstimateRawTransactionCmd)(nil)},
+		{"estimatetxsize", (*EstimateTxSizeCmd)(nil)},
+		{"exportvotepolicies", (*ExportVotePoliciesCmd)(nil)},
+		{"exportvotingaccount", (*ExportVotingAccountCmd)(nil)},
+		{"forecaststake", (*ForecastStakeCmd)(nil)},
 		{"fundrawtransaction", (*FundRawTransactionCmd)(nil)},
 		{"getaccount", (*GetAccountCmd)(nil)},
 		{"getaccountaddress", (*GetAccountAddressCmd)(nil)},
+		{"getaccountblindedid", (*GetAccountBlindedIDCmd)(nil)},
+		{"getaccountbyid", (*GetAccountByIDCmd)(nil)},
+		{"getaccountchildxpub", (*GetAccountChildXpubCmd)(nil)},
+		{"getaccountcryptoparams", (*GetAccountCryptoParamsCmd)(nil)},
+		{"getaccountid", (*GetAccountIDCmd)(nil)},
+		{"getaccountwatchkey", (*GetAccountWatchKeyCmd)(nil)},
 		{"getaddressesbyaccount", (*GetAddressesByAccountCmd)(nil)},
 		{"getbalance", (*GetBalanceCmd)(nil)},
+		{"getcapabilities", (*GetCapabilitiesCmd)(nil)},
 		{"getcoinjoinsbyacct", (*GetCoinjoinsByAcctCmd)(nil)},
+		{"getdestinationpolicy", (*GetDestinationPolicyCmd)(nil)},
+		{"getduressaccount", (*GetDuressAccountCmd)(nil)},
+		{"getidlelock", (*GetIdleLockCmd)(nil)},
+		{"getinvoice", (*GetInvoiceCmd)(nil)},
+		{"getjournalevents", (*GetJournalEventsCmd)(nil)},
 		{"getmasterpubkey", (*GetMasterPubkeyCmd)(nil)},
+		{"getmixedspendpolicy", (*GetMixedSpendPolicyCmd)(nil)},
+		{"getmixpolicy", (*GetMixPolicyCmd)(nil)},
 		{"getmultisigoutinfo", (*GetMultisigOutInfoCmd)(nil)},
 		{"getnewaddress", (*GetNewAddressCmd)(nil)},
 		{"getrawchangeaddress", (*GetRawChangeAddressCmd)(nil)},
 		{"getreceivedbyaccount", (*GetReceivedByAccountCmd)(nil)},
 		{"getreceivedbyaddress", (*GetReceivedByAddressCmd)(nil)},
+		{"getreservedbalance", (*GetReservedBalanceCmd)(nil)},
 		{"getstakeinfo", (*GetStakeInfoCmd)(nil)},
+		{"getticketpools", (*GetTicketPoolsCmd)(nil)},
 		{"gettickets", (*GetTicketsCmd)(nil)},
 		{"gettransaction", (*GetTransactionCmd)(nil)},
 		{"getunconfirmedbalance", (*GetUnconfirmedBalanceCmd)(nil)},
+		{"getutxostats", (*GetUTXOStatsCmd)(nil)},
 		{"getvotechoices", (*GetVoteChoicesCmd)(nil)},
+		{"getvotediagnostics", (*GetVoteDiagnosticsCmd)(nil)},
+		{"getvoteversioninfo", (*GetVoteVersionInfoCmd)(nil)},
 		{"getwalletfee", (*GetWalletFeeCmd)(nil)},
+		{"getwalletstats", (*GetWalletStatsCmd)(nil)},
+		{"holdamount", (*HoldAmountCmd)(nil)},
+		{"holdoutputs", (*HoldOutputsCmd)(nil)},
 		{"importcfiltersv2", (*ImportCFiltersV2Cmd)(nil)},
+		{"importcheckpoint", (*ImportCheckpointCmd)(nil)},
+		{"importexternalsigneraccount", (*ImportExternalSignerAccountCmd)(nil)},
+		{"importlegacystakepooltickets", (*ImportLegacyStakePoolTicketsCmd)(nil)},
 		{"importprivkey", (*ImportPrivKeyCmd)(nil)},
 		{"importpubkey", (*ImportPubKeyCmd)(nil)},
 		{"importscript", (*ImportScriptCmd)(nil)},
+		{"importtreasurykey", (*ImportTreasuryKeyCmd)(nil)},
+		{"importslip0044account", (*ImportSLIP0044AccountCmd)(nil)},
+		{"importvotepolicies", (*ImportVotePoliciesCmd)(nil)},
+		{"importvotingaccount", (*ImportVotingAccountCmd)(nil)},
 		{"importxpub", (*ImportXpubCmd)(nil)},
 		{"listaccounts", (*ListAccountsCmd)(nil)},
 		{"listaddresstransactions", (*ListAddressTransactionsCmd)(nil)},
+		{"listexpiredaddresses", (*ListExpiredAddressesCmd)(nil)},
+		{"listexternalsigners", (*ListExternalSignersCmd)(nil)},
+		{"listfundsreservations", (*ListFundsReservationsCmd)(nil)},
+		{"listholds", (*ListHoldsCmd)(nil)},
 		{"listalltransactions", (*ListAllTransactionsCmd)(nil)},
+		{"listinvoices", (*ListInvoicesCmd)(nil)},
 		{"listlockunspent", (*ListLockUnspentCmd)(nil)},
+		{"listpendingspends", (*ListPendingSpendsCmd)(nil)},
 		{"listreceivedbyaccount", (*ListReceivedByAccountCmd)(nil)},
 		{"listreceivedbyaddress", (*ListReceivedByAddressCmd)(nil)},
 		{"listsinceblock", (*ListSinceBlockCmd)(nil)},
 		{"listtransactions", (*ListTransactionsCmd)(nil)},
+		{"listtreasurykeys", (*ListTreasuryKeysCmd)(nil)},
 		{"listunspent", (*ListUnspentCmd)(nil)},
+		{"listwebhooks", (*ListWebhooksCmd)(nil)},
 		{"lockaccount", (*LockAccountCmd)(nil)},
 		{"lockunspent", (*LockUnspentCmd)(nil)},
+		{"lookupaccountblindedid", (*LookupAccountBlindedIDCmd)(nil)},
+		{"matchcfilters", (*MatchCFiltersCmd)(nil)},
 		{"mixaccount", (*MixAccountCmd)(nil)},
 		{"mixoutput", (*MixOutputCmd)(nil)},
+		{"parsepaymenturi", (*ParsePaymentURICmd)(nil)},
 		{"purchaseticket", (*PurchaseTicketCmd)(nil)},
+		{"pregenerateaddresses", (*PregenerateAddressesCmd)(nil)},
+		{"previewmixaccount", (*PreviewMixAccountCmd)(nil)},
 		{"processunmanagedticket", (*ProcessUnmanagedTicketCmd)(nil)},
+		{"provevspownership", (*ProveVSPOwnershipCmd)(nil)},
+		{"prunesidechains", (*PruneSidechainsCmd)(nil)},
+		{"recovermixoutputs", (*RecoverMixOutputsCmd)(nil)},
 		{"redeemmultisigout", (*RedeemMultiSigOutCmd)(nil)},
 		{"redeemmultisigouts", (*RedeemMultiSigOutsCmd)(nil)},
+		{"redeemswap", (*RedeemSwapCmd)(nil)},
+		{"refundswap", (*RefundSwapCmd)(nil)},
+		{"rejectpendingspend", (*RejectPendingSpendCmd)(nil)},
+		{"releasefunds", (*ReleaseFundsCmd)(nil)},
+		{"releasehold", (*ReleaseHoldCmd)(nil)},
+		{"removetreasurykey", (*RemoveTreasuryKeyCmd)(nil)},
+		{"removewebhook", (*RemoveWebhookCmd)(nil)},
 		{"renameaccount", (*RenameAccountCmd)(nil)},
 		{"rescanwallet", (*RescanWalletCmd)(nil)},
+		{"reservefunds", (*ReserveFundsCmd)(nil)},
 		{"sendfrom", (*SendFromCmd)(nil)},
 		{"sendfromtreasury", (*SendFromTreasuryCmd)(nil)},
 		{"sendmany", (*SendManyCmd)(nil)},
@@ -1266,7 +1906,14 @@ func init() {
 		{"sendtomultisig", (*SendToMultiSigCmd)(nil)},
 		{"sendtotreasury", (*SendToTreasuryCmd)(nil)},
 		{"setaccountpassphrase", (*SetAccountPassphraseCmd)(nil)},
+		{"setdestinationoverride", (*SetDestinationOverrideCmd)(nil)},
+		{"setdestinationpolicy", (*SetDestinationPolicyCmd)(nil)},
 		{"setdisapprovepercent", (*SetDisapprovePercentCmd)(nil)},
+		{"setduresspassphrase", (*SetDuressPassphraseCmd)(nil)},
+		{"setlockmode", (*SetLockModeCmd)(nil)},
+		{"setmaxfeerate", (*SetMaxFeeRateCmd)(nil)},
+		{"setmixedspendpolicy", (*SetMixedSpendPolicyCmd)(nil)},
+		{"setmixpolicy", (*SetMixPolicyCmd)(nil)},
 		{"settreasurypolicy", (*SetTreasuryPolicyCmd)(nil)},
 		{"settspendpolicy", (*SetTSpendPolicyCmd)(nil)},
 		{"settxfee", (*SetTxFeeCmd)(nil)},
@@ -1274,14 +1921,21 @@ func init() {
 		{"signmessage", (*SignMessageCmd)(nil)},
 		{"signrawtransaction", (*SignRawTransactionCmd)(nil)},
 		{"signrawtransactions", (*SignRawTransactionsCmd)(nil)},
+		{"signtspend", (*SignTSpendCmd)(nil)},
+		{"simulatestake", (*SimulateStakeCmd)(nil)},
 		{"spendoutputs", (*SpendOutputsCmd)(nil)},
 		{"sweepaccount", (*SweepAccountCmd)(nil)},
+		{"sweepprivkey", (*SweepPrivKeyCmd)(nil)},
+		{"sweeptocoldstorage", (*SweepToColdStorageCmd)(nil)},
 		{"syncstatus", (*SyncStatusCmd)(nil)},
+		{"ticketaddressreuse", (*TicketAddressReuseCmd)(nil)},
 		{"ticketinfo", (*TicketInfoCmd)(nil)},
 		{"treasurypolicy", (*TreasuryPolicyCmd)(nil)},
 		{"tspendpolicy", (*TSpendPolicyCmd)(nil)},
 		{"unlockaccount", (*UnlockAccountCmd)(nil)},
 		{"validatepredcp0005cf", (*ValidatePreDCP0005CFCmd)(nil)},
+		{"verifyreservesnapshot", (*VerifyReserveSnapshotCmd)(nil)},
+		{"verifyvotingaccount", (*VerifyVotingAccountCmd)(nil)},
 		{"walletinfo", (*WalletInfoCmd)(nil)},
 		{"walletislocked", (*WalletIsLockedCmd)(nil)},
 		{"walletlock", (*WalletLockCmd)(nil)},
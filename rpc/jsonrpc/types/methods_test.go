@@ -213,6 +213,25 @@ func TestWalletSvrCmds(t *testing.T) {
 				GapPolicy: dcrjson.String("ignore"),
 			},
 		},
+		{
+			name: "getnewaddress expiry",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("getnewaddress"), "acct", "ignore", 3600, 1000)
+			},
+			staticCmd: func() any {
+				cmd := NewGetNewAddressCmd(dcrjson.String("acct"), dcrjson.String("ignore"))
+				cmd.ExpiresIn = dcrjson.Int64(3600)
+				cmd.ExpiryHeight = dcrjson.Int32(1000)
+				return cmd
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getnewaddress","params":["acct","ignore",3600,1000],"id":1}`,
+			unmarshalled: &GetNewAddressCmd{
+				Account:      dcrjson.String("acct"),
+				GapPolicy:    dcrjson.String("ignore"),
+				ExpiresIn:    dcrjson.Int64(3600),
+				ExpiryHeight: dcrjson.Int32(1000),
+			},
+		},
 		{
 			name: "getrawchangeaddress",
 			newCmd: func() (any, error) {
@@ -951,6 +970,26 @@ func TestWalletSvrCmds(t *testing.T) {
 				CommentTo: dcrjson.String("commentto"),
 			},
 		},
+		{
+			name: "sendtoaddress optional2",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("sendtoaddress"), "1Address", 0.5, "", "", "key")
+			},
+			staticCmd: func() any {
+				cmd := NewSendToAddressCmd("1Address", 0.5, dcrjson.String(""),
+					dcrjson.String(""))
+				cmd.IdempotencyKey = dcrjson.String("key")
+				return cmd
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"sendtoaddress","params":["1Address",0.5,"","","key"],"id":1}`,
+			unmarshalled: &SendToAddressCmd{
+				Address:        "1Address",
+				Amount:         0.5,
+				Comment:        dcrjson.String(""),
+				CommentTo:      dcrjson.String(""),
+				IdempotencyKey: dcrjson.String("key"),
+			},
+		},
 		{
 			name: "sendfromtreasury",
 			newCmd: func() (any, error) {
@@ -976,7 +1015,28 @@ func TestWalletSvrCmds(t *testing.T) {
 			},
 			marshalled: `{"jsonrpc":"1.0","method":"sendtotreasury","params":[0.5],"id":1}`,
 			unmarshalled: &SendToTreasuryCmd{
-				Amount: 0.5,
+				Amount:      0.5,
+				FromAccount: dcrjson.String("default"),
+				MinConf:     dcrjson.Int(1),
+			},
+		},
+		{
+			name: "sendtotreasury optional",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("sendtotreasury"), 0.5, "from", 6)
+			},
+			staticCmd: func() any {
+				return &SendToTreasuryCmd{
+					Amount:      0.5,
+					FromAccount: dcrjson.String("from"),
+					MinConf:     dcrjson.Int(6),
+				}
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"sendtotreasury","params":[0.5,"from",6],"id":1}`,
+			unmarshalled: &SendToTreasuryCmd{
+				Amount:      0.5,
+				FromAccount: dcrjson.String("from"),
+				MinConf:     dcrjson.Int(6),
 			},
 		},
 		{
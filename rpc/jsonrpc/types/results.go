@@ -17,12 +17,25 @@ type GetAccountBalanceResult struct {
 	ImmatureCoinbaseRewards float64 `json:"immaturecoinbaserewards"`
 	ImmatureStakeGeneration float64 `json:"immaturestakegeneration"`
 	LockedByTickets         float64 `json:"lockedbytickets"`
+	LockedByLiveTickets     float64 `json:"lockedbylivetickets"`
+	LockedByUnspent         float64 `json:"lockedbyunspent"`
 	Spendable               float64 `json:"spendable"`
 	Total                   float64 `json:"total"`
 	Unconfirmed             float64 `json:"unconfirmed"`
 	VotingAuthority         float64 `json:"votingauthority"`
 }
 
+// GetCapabilitiesResult models the data returned by the getcapabilities
+// command.
+type GetCapabilitiesResult struct {
+	APIVersion   string   `json:"apiversion"`
+	Version      string   `json:"version"`
+	WalletLoaded bool     `json:"walletloaded"`
+	SyncMode     string   `json:"syncmode"`
+	Features     []string `json:"features"`
+	Methods      []string `json:"methods"`
+}
+
 // GetBalanceResult models the data from the getbalance command.
 type GetBalanceResult struct {
 	Balances                     []GetAccountBalanceResult `json:"balances"`
@@ -30,6 +43,8 @@ type GetBalanceResult struct {
 	TotalImmatureCoinbaseRewards float64                   `json:"totalimmaturecoinbaserewards,omitempty"`
 	TotalImmatureStakeGeneration float64                   `json:"totalimmaturestakegeneration,omitempty"`
 	TotalLockedByTickets         float64                   `json:"totallockedbytickets,omitempty"`
+	TotalLockedByLiveTickets     float64                   `json:"totallockedbylivetickets,omitempty"`
+	TotalLockedByUnspent         float64                   `json:"totallockedbyunspent,omitempty"`
 	TotalSpendable               float64                   `json:"totalspendable,omitempty"`
 	CumulativeTotal              float64                   `json:"cumulativetotal,omitempty"`
 	TotalUnconfirmed             float64                   `json:"totalunconfirmed,omitempty"`
@@ -53,6 +68,12 @@ type GetMultisigOutInfoResult struct {
 	Amount       float64  `json:"amount"`
 }
 
+// CosignVoteResult models the data returned by the cosignvote command.
+type CosignVoteResult struct {
+	Hex      string `json:"hex"`
+	Complete bool   `json:"complete"`
+}
+
 // CreateMultiSigResult models the data returned from the createmultisig
 // command.
 type CreateMultiSigResult struct {
@@ -163,11 +184,666 @@ type GetVoteChoicesResult struct {
 	Choices []VoteChoice `json:"choices"`
 }
 
+// VoteVersionAgenda models an agenda of the wallet's vote version in the
+// getvoteversioninfo result.
+type VoteVersionAgenda struct {
+	AgendaID    string   `json:"agendaid"`
+	Description string   `json:"description"`
+	Choices     []string `json:"choices"`
+	StartTime   int64    `json:"starttime"`
+	ExpireTime  int64    `json:"expiretime"`
+	Expired     bool     `json:"expired"`
+	ChoiceID    string   `json:"choiceid"`
+	Saved       bool     `json:"saved"`
+}
+
+// ObsoleteVoteChoice models a saved vote choice which is not applied to the
+// wallet's vote version in the getvoteversioninfo result.
+type ObsoleteVoteChoice struct {
+	Version  uint32 `json:"version"`
+	AgendaID string `json:"agendaid"`
+	ChoiceID string `json:"choiceid"`
+}
+
+// GetVoteVersionInfoResult models the data returned by the getvoteversioninfo
+// command.
+type GetVoteVersionInfoResult struct {
+	VoteVersion         uint32               `json:"voteversion"`
+	NetworkStakeVersion uint32               `json:"networkstakeversion"`
+	Agendas             []VoteVersionAgenda  `json:"agendas"`
+	ObsoleteChoices     []ObsoleteVoteChoice `json:"obsoletechoices"`
+	Warnings            []string             `json:"warnings"`
+}
+
+// ExternalSignerResult models an external signer account in the
+// listexternalsigners result.
+type ExternalSignerResult struct {
+	Account     uint32 `json:"account"`
+	AccountName string `json:"accountname"`
+	Fingerprint string `json:"fingerprint"`
+	Path        string `json:"path"`
+	Model       string `json:"model"`
+}
+
 // SyncStatusResult models the data returned by the syncstatus command.
 type SyncStatusResult struct {
-	Synced               bool    `json:"synced"`
-	InitialBlockDownload bool    `json:"initialblockdownload"`
-	HeadersFetchProgress float32 `json:"headersfetchprogress"`
+	Synced               bool     `json:"synced"`
+	InitialBlockDownload bool     `json:"initialblockdownload"`
+	HeadersFetchProgress float32  `json:"headersfetchprogress"`
+	Phase                string   `json:"phase"`
+	PhaseHeight          int32    `json:"phaseheight"`
+	PhaseTargetHeight    int32    `json:"phasetargetheight"`
+	TipHeight            int32    `json:"tipheight"`
+	Backend              string   `json:"backend"`
+	Peers                []string `json:"peers"`
+}
+
+// FundsReservationResult models a funds reservation returned by the
+// reservefunds and listfundsreservations commands.
+type FundsReservationResult struct {
+	ID        string   `json:"id"`
+	Account   string   `json:"account"`
+	Outpoints []string `json:"outpoints"`
+	Amount    float64  `json:"amount"`
+}
+
+// HoldResult models a hold returned by the holdoutputs, holdamount, and
+// listholds commands.
+type HoldResult struct {
+	ID        string   `json:"id"`
+	Account   string   `json:"account"`
+	Amount    float64  `json:"amount"`
+	Held      float64  `json:"held"`
+	Reason    string   `json:"reason"`
+	Created   int64    `json:"created"`
+	Outpoints []string `json:"outpoints"`
+}
+
+// GetReservedBalanceResult models the data returned by the getreservedbalance
+// command.
+type GetReservedBalanceResult struct {
+	Spendable float64 `json:"spendable"`
+	Reserved  float64 `json:"reserved"`
+	Available float64 `json:"available"`
+}
+
+// CreateSwapContractResult models the data returned by the createswapcontract
+// command.
+type CreateSwapContractResult struct {
+	Contract      string `json:"contract"`
+	Address       string `json:"address"`
+	RefundAddress string `json:"refundaddress"`
+	LockTime      int64  `json:"locktime"`
+}
+
+// WebhookResult models a registered webhook returned by the addwebhook and
+// listwebhooks commands.  The secret is only returned by addwebhook.
+type WebhookResult struct {
+	ID        string   `json:"id"`
+	URL       string   `json:"url"`
+	Secret    string   `json:"secret,omitempty"`
+	Account   string   `json:"account,omitempty"`
+	Address   string   `json:"address,omitempty"`
+	MinAmount *float64 `json:"minamount,omitempty"`
+}
+
+// GetMixedSpendPolicyResult models the data returned by the
+// getmixedspendpolicy command.
+type GetMixedSpendPolicyResult struct {
+	Enabled bool   `json:"enabled"`
+	Account string `json:"account,omitempty"`
+	Branch  uint32 `json:"branch"`
+}
+
+// GetMixPolicyResult models the data returned by the getmixpolicy command.
+type GetMixPolicyResult struct {
+	MinPeers       int    `json:"minpeers"`
+	MinPeerVersion uint32 `json:"minpeerversion"`
+}
+
+// AccountCryptoParamsResult describes the encryption of the private keys of
+// an account.
+type AccountCryptoParamsResult struct {
+	AccountName       string `json:"accountname"`
+	AccountNumber     uint32 `json:"accountnumber"`
+	Encryption        string `json:"encryption"`
+	ScryptN           int    `json:"scryptn,omitempty"`
+	ScryptR           int    `json:"scryptr,omitempty"`
+	ScryptP           int    `json:"scryptp,omitempty"`
+	Argon2idTime      uint32 `json:"argon2idtime,omitempty"`
+	Argon2idMemory    uint32 `json:"argon2idmemory,omitempty"`
+	Argon2idThreads   uint8  `json:"argon2idthreads,omitempty"`
+	PassphraseChanged int64  `json:"passphrasechanged,omitempty"`
+}
+
+// GetAccountWatchKeyResult models the data returned by the getaccountwatchkey
+// command.
+type GetAccountWatchKeyResult struct {
+	Xpub  string `json:"xpub"`
+	Start uint32 `json:"start"`
+	Count uint32 `json:"count"`
+}
+
+// GetDestinationPolicyResult models the data returned by the
+// getdestinationpolicy command.
+type GetDestinationPolicyResult struct {
+	Allowlist bool     `json:"allowlist"`
+	Allowed   []string `json:"allowed"`
+	Denied    []string `json:"denied"`
+}
+
+// GetDuressAccountResult models the data returned by the getduressaccount
+// command.
+type GetDuressAccountResult struct {
+	Enabled bool   `json:"enabled"`
+	Account string `json:"account,omitempty"`
+}
+
+// CreateMultisigSpendResult models the data returned by the
+// createmultisigspend command.
+type CreateMultisigSpendResult struct {
+	Hex         string  `json:"hex"`
+	TxHash      string  `json:"txhash"`
+	Fee         float64 `json:"fee"`
+	ChangeIndex int     `json:"changeindex"`
+}
+
+// AccountIDResult models the data returned by the getaccountid and
+// getaccountbyid commands.
+type AccountIDResult struct {
+	AccountName   string                `json:"accountname"`
+	AccountNumber uint32                `json:"accountnumber"`
+	UUID          string                `json:"uuid"`
+	Renames       []AccountRenameResult `json:"renames"`
+}
+
+// AccountRenameResult describes a rename of an account.
+type AccountRenameResult struct {
+	Time    int64  `json:"time"`
+	OldName string `json:"oldname"`
+	NewName string `json:"newname"`
+}
+
+// ReserveSnapshotResult models the data returned by the createreservesnapshot
+// command.
+type ReserveSnapshotResult struct {
+	BlockHash   string                `json:"blockhash"`
+	BlockHeight int32                 `json:"blockheight"`
+	Message     string                `json:"message"`
+	MerkleRoot  string                `json:"merkleroot"`
+	Total       float64               `json:"total"`
+	Outputs     []ReserveOutputResult `json:"outputs"`
+}
+
+// ReserveOutputResult describes a signed output of a reserve snapshot.
+type ReserveOutputResult struct {
+	TxID          string  `json:"txid"`
+	Vout          uint32  `json:"vout"`
+	Tree          int8    `json:"tree"`
+	Amount        float64 `json:"amount"`
+	ScriptVersion uint16  `json:"scriptversion"`
+	PkScript      string  `json:"pkscript"`
+	Address       string  `json:"address"`
+	Signature     string  `json:"signature"`
+}
+
+// VerifyReserveSnapshotResult models the data returned by the
+// verifyreservesnapshot command.
+type VerifyReserveSnapshotResult struct {
+	Valid bool    `json:"valid"`
+	Total float64 `json:"total"`
+	Error string  `json:"error,omitempty"`
+}
+
+// WalletStatsResult models the data returned by the getwalletstats command
+// for each interval of blocks.
+type WalletStatsResult struct {
+	StartHeight     int32   `json:"startheight"`
+	EndHeight       int32   `json:"endheight"`
+	Transactions    int     `json:"transactions"`
+	TotalIn         float64 `json:"totalin"`
+	TotalOut        float64 `json:"totalout"`
+	Fees            float64 `json:"fees"`
+	TicketPurchases int     `json:"ticketpurchases"`
+	Votes           int     `json:"votes"`
+	Revocations     int     `json:"revocations"`
+}
+
+// IdleLockResult models the data returned by the getidlelock command.
+type IdleLockResult struct {
+	Timeout   int64 `json:"timeout"`
+	Active    bool  `json:"active"`
+	Remaining int64 `json:"remaining"`
+}
+
+// VoteDiagnosticResult models the data returned by the getvotediagnostics
+// command for each vote.
+type VoteDiagnosticResult struct {
+	Ticket      string   `json:"ticket"`
+	Vote        string   `json:"vote"`
+	BlockHash   string   `json:"blockhash"`
+	BlockHeight int32    `json:"blockheight"`
+	Notified    int64    `json:"notified"`
+	SignedMs    float64  `json:"signedms"`
+	BroadcastMs float64  `json:"broadcastms"`
+	Backend     string   `json:"backend,omitempty"`
+	Peers       []string `json:"peers,omitempty"`
+	Size        int      `json:"size"`
+	Fee         float64  `json:"fee"`
+	Error       string   `json:"error,omitempty"`
+}
+
+// MixSplitPreviewResult models objects returned by the previewmixaccount
+// command.
+type MixSplitPreviewResult struct {
+	Outpoint     string  `json:"outpoint"`
+	Amount       float64 `json:"amount"`
+	Denomination float64 `json:"denomination"`
+	Count        uint32  `json:"count"`
+	Change       float64 `json:"change"`
+	Fee          float64 `json:"fee"`
+}
+
+// UTXOStatsResult models the data returned by the getutxostats command for
+// each account.
+type UTXOStatsResult struct {
+	Account   string  `json:"account"`
+	Count     int     `json:"count"`
+	Total     float64 `json:"total"`
+	MedianAge float64 `json:"medianage"`
+	MeanAge   float64 `json:"meanage"`
+	DustCount int     `json:"dustcount"`
+	DustTotal float64 `json:"dusttotal"`
+	Largest   float64 `json:"largest"`
+	Smallest  float64 `json:"smallest"`
+}
+
+// AnnotateRawTransactionResult models the data returned by the
+// annotaterawtransaction command.
+type AnnotateRawTransactionResult struct {
+	TxID         string                  `json:"txid"`
+	Version      uint16                  `json:"version"`
+	LockTime     uint32                  `json:"locktime"`
+	Expiry       uint32                  `json:"expiry"`
+	Type         string                  `json:"type"`
+	WalletDebit  float64                 `json:"walletdebit"`
+	WalletCredit float64                 `json:"walletcredit"`
+	Fee          *float64                `json:"fee,omitempty"`
+	Vin          []AnnotatedInputResult  `json:"vin"`
+	Vout         []AnnotatedOutputResult `json:"vout"`
+}
+
+// AnnotatedInputResult describes a transaction input and its relationship to
+// the wallet.
+type AnnotatedInputResult struct {
+	TxID        string   `json:"txid"`
+	Vout        uint32   `json:"vout"`
+	Tree        int8     `json:"tree"`
+	Sequence    uint32   `json:"sequence"`
+	AmountIn    float64  `json:"amountin"`
+	PrevAmount  *float64 `json:"prevamount,omitempty"`
+	Mine        bool     `json:"mine"`
+	Account     *uint32  `json:"account,omitempty"`
+	AccountName string   `json:"accountname,omitempty"`
+	Address     string   `json:"address,omitempty"`
+	Path        string   `json:"path,omitempty"`
+}
+
+// AnnotatedOutputResult describes a transaction output and its relationship
+// to the wallet.
+type AnnotatedOutputResult struct {
+	N           uint32   `json:"n"`
+	Value       float64  `json:"value"`
+	Version     uint16   `json:"version"`
+	ScriptType  string   `json:"scripttype"`
+	PkScript    string   `json:"pkscript"`
+	Addresses   []string `json:"addresses,omitempty"`
+	Mine        bool     `json:"mine"`
+	Account     *uint32  `json:"account,omitempty"`
+	AccountName string   `json:"accountname,omitempty"`
+	Address     string   `json:"address,omitempty"`
+	Path        string   `json:"path,omitempty"`
+}
+
+// DebugDumpResult models the data returned by the debugdump command.
+type DebugDumpResult struct {
+	Network             string                   `json:"network"`
+	DBVersion           uint32                   `json:"dbversion"`
+	CoinType            uint32                   `json:"cointype"`
+	WatchingOnly        bool                     `json:"watchingonly"`
+	Locked              bool                     `json:"locked"`
+	TipHeight           int32                    `json:"tipheight"`
+	BirthHeight         uint32                   `json:"birthheight"`
+	MissingCFilters     bool                     `json:"missingcfilters"`
+	UnminedTransactions int                      `json:"unminedtransactions"`
+	Accounts            []DebugDumpAccountResult `json:"accounts"`
+	Buckets             map[string]int           `json:"buckets"`
+}
+
+// DebugDumpAccountResult describes the address cursors of an account in the
+// debugdump result.
+type DebugDumpAccountResult struct {
+	AccountNumber        uint32 `json:"accountnumber"`
+	AccountType          uint8  `json:"accounttype"`
+	LastUsedExternal     uint32 `json:"lastusedexternal"`
+	LastUsedInternal     uint32 `json:"lastusedinternal"`
+	LastReturnedExternal uint32 `json:"lastreturnedexternal"`
+	LastReturnedInternal uint32 `json:"lastreturnedinternal"`
+	ImportedKeys         uint32 `json:"importedkeys"`
+}
+
+// DebugInspectDBResult models the data returned by the debuginspectdb
+// command.
+type DebugInspectDBResult struct {
+	Versions      map[string]uint32     `json:"versions"`
+	LatestVersion uint32                `json:"latestversion"`
+	Buckets       []BucketSummaryResult `json:"buckets"`
+	Entries       []BucketEntryResult   `json:"entries,omitempty"`
+	TotalEntries  int                   `json:"totalentries,omitempty"`
+}
+
+// BucketSummaryResult describes the size of a database bucket in the
+// debuginspectdb result.
+type BucketSummaryResult struct {
+	Path       string `json:"path"`
+	Buckets    int    `json:"buckets"`
+	Entries    int    `json:"entries"`
+	KeyBytes   int    `json:"keybytes"`
+	ValueBytes int    `json:"valuebytes"`
+}
+
+// BucketEntryResult describes a redacted bucket entry in the debuginspectdb
+// result.
+type BucketEntryResult struct {
+	Key       string `json:"key"`
+	Redacted  bool   `json:"redacted"`
+	Bucket    bool   `json:"bucket"`
+	KeyLen    int    `json:"keylen"`
+	ValueLen  int    `json:"valuelen"`
+	ValueHash string `json:"valuehash,omitempty"`
+}
+
+// DebugTimingsResult models the data returned by the debugtimings command.
+type DebugTimingsResult struct {
+	Operations []TimingResult `json:"operations"`
+	Methods    []TimingResult `json:"methods"`
+}
+
+// TimingResult describes the recorded durations of a wallet operation or RPC
+// method in the debugtimings result.
+type TimingResult struct {
+	Name     string  `json:"name"`
+	Count    uint64  `json:"count"`
+	InFlight int64   `json:"inflight,omitempty"`
+	TotalMs  float64 `json:"totalms"`
+	MeanMs   float64 `json:"meanms"`
+	MaxMs    float64 `json:"maxms"`
+}
+
+// AddressReuseReportResult models the data returned by the
+// addressreusereport command.
+type AddressReuseReportResult struct {
+	Addresses []ReusedAddressResult       `json:"addresses"`
+	Accounts  []AccountAddressReuseResult `json:"accounts"`
+}
+
+// ReusedAddressResult describes a reused address in the addressreusereport
+// result.
+type ReusedAddressResult struct {
+	Address   string   `json:"address"`
+	Account   uint32   `json:"account"`
+	Count     int      `json:"count"`
+	Total     float64  `json:"total"`
+	OutPoints []string `json:"outpoints"`
+}
+
+// AccountAddressReuseResult describes the address reuse of an account in the
+// addressreusereport result.
+type AccountAddressReuseResult struct {
+	Account     uint32  `json:"account"`
+	AccountName string  `json:"accountname"`
+	Addresses   int     `json:"addresses"`
+	Count       int     `json:"count"`
+	Total       float64 `json:"total"`
+}
+
+// ReusedTicketAddressResult describes an address committed to by more than a
+// single ticket in the ticketaddressreuse result.
+type ReusedTicketAddressResult struct {
+	Address string   `json:"address"`
+	Account uint32   `json:"account"`
+	Kind    string   `json:"kind"`
+	Tickets []string `json:"tickets"`
+}
+
+// TicketPoolResult models the data returned by the getticketpools command for
+// the tickets managed by each VSP.
+type TicketPoolResult struct {
+	Host        string                 `json:"host"`
+	Tickets     int                    `json:"tickets"`
+	Unmined     int                    `json:"unmined"`
+	Immature    int                    `json:"immature"`
+	Live        int                    `json:"live"`
+	FeePending  int                    `json:"feepending"`
+	LockedValue float64                `json:"lockedvalue"`
+	Expiries    []TicketExpiriesResult `json:"expiries"`
+}
+
+// TicketExpiriesResult describes the tickets expiring in an interval of
+// blocks in the getticketpools result.
+type TicketExpiriesResult struct {
+	StartHeight int32   `json:"startheight"`
+	EndHeight   int32   `json:"endheight"`
+	Tickets     int     `json:"tickets"`
+	Value       float64 `json:"value"`
+}
+
+// PendingSpendResult models the data returned by the listpendingspends
+// command for each spend awaiting approval.
+type PendingSpendResult struct {
+	TxHash   string  `json:"txhash"`
+	Account  string  `json:"account"`
+	Proposed int64   `json:"proposed"`
+	Amount   float64 `json:"amount"`
+	Fee      float64 `json:"fee"`
+	Hex      string  `json:"hex"`
+}
+
+// MatchCFiltersResult models the data returned by the matchcfilters command
+// for each block whose compact filter matches a wallet script.
+type MatchCFiltersResult struct {
+	Hash   string `json:"hash"`
+	Height int32  `json:"height"`
+}
+
+// JournalEventResult models a wallet event returned by the getjournalevents
+// command.
+type JournalEventResult struct {
+	Sequence  uint64 `json:"sequence"`
+	Type      string `json:"type"`
+	TxHash    string `json:"txhash,omitempty"`
+	BlockHash string `json:"blockhash,omitempty"`
+	Height    int32  `json:"height,omitempty"`
+	Time      int64  `json:"time"`
+}
+
+// GetJournalEventsResult models the data returned by the getjournalevents
+// command.
+type GetJournalEventsResult struct {
+	Events       []JournalEventResult `json:"events"`
+	NextSequence uint64               `json:"nextsequence"`
+	Pruned       bool                 `json:"pruned"`
+}
+
+// AttestAddressResult models the data returned by the attestaddress command.
+type AttestAddressResult struct {
+	Address     string `json:"address"`
+	Account     uint32 `json:"account"`
+	Branch      uint32 `json:"branch"`
+	Index       uint32 `json:"index"`
+	Fingerprint string `json:"fingerprint"`
+	AccountXpub string `json:"accountxpub"`
+	Signature   string `json:"signature"`
+}
+
+// ExpiredAddressResult models an expired address returned by the
+// listexpiredaddresses command.
+type ExpiredAddressResult struct {
+	Address      string `json:"address"`
+	Account      string `json:"account"`
+	Issued       int64  `json:"issued"`
+	ExpiryTime   int64  `json:"expirytime,omitempty"`
+	ExpiryHeight int32  `json:"expiryheight,omitempty"`
+	PaidTime     int64  `json:"paidtime,omitempty"`
+	PaidHeight   int32  `json:"paidheight,omitempty"`
+}
+
+// CreatePaymentURIResult models the data returned by the createpaymenturi
+// command.
+type CreatePaymentURIResult struct {
+	URI     string `json:"uri"`
+	Address string `json:"address"`
+}
+
+// ParsePaymentURIResult models the data returned by the parsepaymenturi
+// command.
+type ParsePaymentURIResult struct {
+	Address string                `json:"address"`
+	Amount  float64               `json:"amount,omitempty"`
+	Label   string                `json:"label,omitempty"`
+	Message string                `json:"message,omitempty"`
+	Expiry  int64                 `json:"expiry,omitempty"`
+	Request *PaymentRequestResult `json:"request,omitempty"`
+}
+
+// PaymentRequestResult models the payment request recorded by the wallet for
+// an address parsed by the parsepaymenturi command.
+type PaymentRequestResult struct {
+	Account  string  `json:"account"`
+	Issued   int64   `json:"issued"`
+	Received float64 `json:"received"`
+	Paid     bool    `json:"paid"`
+	Expired  bool    `json:"expired"`
+}
+
+// InvoiceResult models an invoice returned by the createinvoice, getinvoice,
+// and listinvoices commands.
+type InvoiceResult struct {
+	ID            string  `json:"id"`
+	Address       string  `json:"address"`
+	Account       string  `json:"account"`
+	Amount        float64 `json:"amount"`
+	Received      float64 `json:"received"`
+	Status        string  `json:"status"`
+	Created       int64   `json:"created"`
+	Expiry        int64   `json:"expiry,omitempty"`
+	Updated       int64   `json:"updated,omitempty"`
+	ChangedHeight int32   `json:"changedheight,omitempty"`
+}
+
+// ExportVotingAccountResult models the data returned by the
+// exportvotingaccount command.
+type ExportVotingAccountResult struct {
+	Xpriv     string   `json:"xpriv"`
+	Addresses []string `json:"addresses"`
+}
+
+// ProveVSPOwnershipResult models the data returned by the provevspownership
+// command.
+type ProveVSPOwnershipResult struct {
+	Address   string `json:"address"`
+	Header    string `json:"header"`
+	Signature string `json:"signature"`
+}
+
+// PruneSidechainsResult models the data returned by the prunesidechains
+// command.
+type PruneSidechainsResult struct {
+	PrunedHeaders  int `json:"prunedheaders"`
+	PrunedCFilters int `json:"prunedcfilters"`
+	Headers        int `json:"headers"`
+	CFilters       int `json:"cfilters"`
+}
+
+// VerifyVotingAccountResult models the data returned by the
+// verifyvotingaccount command.
+type VerifyVotingAccountResult struct {
+	Valid bool   `json:"valid"`
+	Error string `json:"error,omitempty"`
+}
+
+// EstimateRawTransactionResult models the data returned by the
+// estimaterawtransaction command.
+type EstimateRawTransactionResult struct {
+	Inputs     []string `json:"inputs"`
+	TotalInput float64  `json:"totalinput"`
+	Fee        float64  `json:"fee"`
+	Change     float64  `json:"change"`
+	Size       int      `json:"size"`
+	SignedSize int      `json:"signedsize"`
+}
+
+// ForecastStakeResult models the data returned by the forecaststake command.
+type ForecastStakeResult struct {
+	TipHeight   int32                       `json:"tipheight"`
+	TicketPrice float64                     `json:"ticketprice"`
+	Tickets     []TicketVoteForecastResult  `json:"tickets"`
+	Samples     []StakeForecastSampleResult `json:"samples"`
+}
+
+// TicketVoteForecastResult models the expected vote of a ticket returned by
+// the forecaststake command.
+type TicketVoteForecastResult struct {
+	Hash               string  `json:"hash"`
+	Value              float64 `json:"value"`
+	FirstVoteHeight    int32   `json:"firstvoteheight"`
+	LastVoteHeight     int32   `json:"lastvoteheight"`
+	VoteProbability    float64 `json:"voteprobability"`
+	ExpectedVoteHeight int32   `json:"expectedvoteheight"`
+	ExpectedVoteTime   int64   `json:"expectedvotetime"`
+}
+
+// StakeForecastSampleResult models the expected stake and balance at a future
+// block returned by the forecaststake command.
+type StakeForecastSampleResult struct {
+	Height    int32   `json:"height"`
+	Time      int64   `json:"time"`
+	Locked    float64 `json:"locked"`
+	Tickets   float64 `json:"tickets"`
+	Immature  float64 `json:"immature"`
+	Spendable float64 `json:"spendable"`
+	Votes     float64 `json:"votes"`
+	Purchased int     `json:"purchased"`
+}
+
+// SimulateStakeResult models the data returned by the simulatestake command.
+type SimulateStakeResult struct {
+	Amount       float64 `json:"amount"`
+	TicketPrice  float64 `json:"ticketprice"`
+	Blocks       int32   `json:"blocks"`
+	Value        float64 `json:"value"`
+	Rewards      float64 `json:"rewards"`
+	Purchased    int     `json:"purchased"`
+	Votes        float64 `json:"votes"`
+	Return       float64 `json:"return"`
+	AnnualReturn float64 `json:"annualreturn"`
+}
+
+// EstimateTxSizeResult models the data returned by the estimatetxsize
+// command.
+type EstimateTxSizeResult struct {
+	Size    int     `json:"size"`
+	Fee     float64 `json:"fee"`
+	FeeRate float64 `json:"feerate"`
+}
+
+// ImportCheckpointResult models the data returned by the importcheckpoint
+// command.
+type ImportCheckpointResult struct {
+	BlockHash   string `json:"blockhash"`
+	BlockHeight int32  `json:"blockheight"`
+	Created     int64  `json:"created"`
+	Accounts    int    `json:"accounts"`
 }
 
 // InfoResult models the data returned by the wallet server getinfo
@@ -210,6 +886,9 @@ const (
 
 	// LTTTRevocation indicates a revocation.
 	LTTTRevocation ListTransactionsTxType = "revocation"
+
+	// LTTTTreasuryAdd indicates a treasury add.
+	LTTTTreasuryAdd ListTransactionsTxType = "treasuryadd"
 )
 
 // ListTransactionsResult models the data from the listtransactions command.
@@ -276,6 +955,36 @@ type ListUnspentResult struct {
 	Spendable     bool    `json:"spendable"`
 }
 
+// RecoverMixOutputsResult models the data returned from the recovermixoutputs
+// command.
+type RecoverMixOutputsResult struct {
+	Branches []RecoveredMixBranch `json:"branches"`
+	Outputs  []RecoveredMixOutput `json:"outputs"`
+}
+
+// RecoveredMixBranch describes the address cursors of an account branch
+// before and after mix output recovery.  Indexes are -1 when no address of the
+// branch was used or returned.
+type RecoveredMixBranch struct {
+	Account            string `json:"account"`
+	Branch             uint32 `json:"branch"`
+	LastUsedBefore     int64  `json:"lastusedbefore"`
+	LastReturnedBefore int64  `json:"lastreturnedbefore"`
+	LastUsed           int64  `json:"lastused"`
+	LastReturned       int64  `json:"lastreturned"`
+}
+
+// RecoveredMixOutput describes an unspent output found by mix output recovery.
+type RecoveredMixOutput struct {
+	TxHash       string  `json:"txhash"`
+	Vout         uint32  `json:"vout"`
+	Amount       float64 `json:"amount"`
+	Account      string  `json:"account"`
+	Branch       uint32  `json:"branch"`
+	Index        uint32  `json:"index"`
+	Unreferenced bool    `json:"unreferenced"`
+}
+
 // RedeemMultiSigOutResult models the data returned from the redeemmultisigout
 // command.
 type RedeemMultiSigOutResult struct {
@@ -339,6 +1048,25 @@ type SweepAccountResult struct {
 	EstimatedSignedSize       uint32  `json:"estimatedsignedsize"`
 }
 
+// SweepPrivKeyResult models the data returned by the sweepprivkey command.
+type SweepPrivKeyResult struct {
+	TxHash string   `json:"txhash"`
+	Inputs []string `json:"inputs"`
+	Amount float64  `json:"amount"`
+	Fee    float64  `json:"fee"`
+}
+
+// ColdSweepTxResult models a transaction returned by the sweeptocoldstorage
+// command.  The transaction is unsigned for dry runs.
+type ColdSweepTxResult struct {
+	TxHash      string  `json:"txhash"`
+	Transaction string  `json:"transaction"`
+	Address     string  `json:"address,omitempty"`
+	Inputs      int     `json:"inputs"`
+	Amount      float64 `json:"amount"`
+	Fee         float64 `json:"fee"`
+}
+
 // TicketInfoResult models the data returned from the ticketinfo command.
 type TicketInfoResult struct {
 	Hash          string       `json:"hash"`
@@ -353,6 +1081,13 @@ type TicketInfoResult struct {
 	VSPHost       string       `json:"vsphost,omitempty"`
 }
 
+// TreasuryKeyResult models objects returned by the listtreasurykeys command.
+type TreasuryKeyResult struct {
+	PubKey  string `json:"pubkey"`
+	Name    string `json:"name"`
+	Created int64  `json:"created"`
+}
+
 // TreasuryPolicyResult models objects returned by the treasurypolicy command.
 type TreasuryPolicyResult struct {
 	Key    string `json:"key"`
@@ -398,14 +1133,17 @@ type WalletInfoResult struct {
 	Unlocked         bool    `json:"unlocked"`
 	CoinType         uint32  `json:"cointype,omitempty"`
 	TxFee            float64 `json:"txfee"`
+	MaxFeeRate       float64 `json:"maxfeerate,omitempty"`
 	VoteBits         uint16  `json:"votebits"`
 	VoteBitsExtended string  `json:"votebitsextended"`
 	VoteVersion      uint32  `json:"voteversion"`
 	Voting           bool    `json:"voting"`
 	VSP              string  `json:"vsp"`
 	ManualTickets    bool    `json:"manualtickets"`
+	LockMode         string  `json:"lockmode"`
 	BirthHash        string  `json:"birthhash"`
 	BirthHeight      uint32  `json:"birthheight"`
+	InstanceConflict string  `json:"instanceconflict,omitempty"`
 }
 
 // AccountUnlockedResult models the data returned by the accountunlocked
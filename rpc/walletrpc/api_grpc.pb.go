@@ -19,7 +19,8 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	VersionService_Version_FullMethodName = "/walletrpc.VersionService/Version"
+	VersionService_Version_FullMethodName         = "/walletrpc.VersionService/Version"
+	VersionService_GetCapabilities_FullMethodName = "/walletrpc.VersionService/GetCapabilities"
 )
 
 // VersionServiceClient is the client API for VersionService service.
@@ -27,6 +28,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type VersionServiceClient interface {
 	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
+	GetCapabilities(ctx context.Context, in *GetCapabilitiesRequest, opts ...grpc.CallOption) (*GetCapabilitiesResponse, error)
 }
 
 type versionServiceClient struct {
@@ -47,11 +49,22 @@ func (c *versionServiceClient) Version(ctx context.Context, in *VersionRequest,
 	return out, nil
 }
 
+func (c *versionServiceClient) GetCapabilities(ctx context.Context, in *GetCapabilitiesRequest, opts ...grpc.CallOption) (*GetCapabilitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, VersionService_GetCapabilities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VersionServiceServer is the server API for VersionService service.
 // All implementations must embed UnimplementedVersionServiceServer
 // for forward compatibility.
 type VersionServiceServer interface {
 	Version(context.Context, *VersionRequest) (*VersionResponse, error)
+	GetCapabilities(context.Context, *GetCapabilitiesRequest) (*GetCapabilitiesResponse, error)
 	mustEmbedUnimplementedVersionServiceServer()
 }
 
@@ -65,6 +78,9 @@ type UnimplementedVersionServiceServer struct{}
 func (UnimplementedVersionServiceServer) Version(context.Context, *VersionRequest) (*VersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
 }
+func (UnimplementedVersionServiceServer) GetCapabilities(context.Context, *GetCapabilitiesRequest) (*GetCapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapabilities not implemented")
+}
 func (UnimplementedVersionServiceServer) mustEmbedUnimplementedVersionServiceServer() {}
 func (UnimplementedVersionServiceServer) testEmbeddedByValue()                        {}
 
@@ -104,6 +120,24 @@ func _VersionService_Version_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VersionService_GetCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VersionServiceServer).GetCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VersionService_GetCapabilities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VersionServiceServer).GetCapabilities(ctx, req.(*GetCapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VersionService_ServiceDesc is the grpc.ServiceDesc for VersionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,6 +149,10 @@ var VersionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Version",
 			Handler:    _VersionService_Version_Handler,
 		},
+		{
+			MethodName: "GetCapabilities",
+			Handler:    _VersionService_GetCapabilities_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api.proto",
@@ -127,6 +165,7 @@ const (
 	WalletService_AccountNumber_FullMethodName               = "/walletrpc.WalletService/AccountNumber"
 	WalletService_Accounts_FullMethodName                    = "/walletrpc.WalletService/Accounts"
 	WalletService_Address_FullMethodName                     = "/walletrpc.WalletService/Address"
+	WalletService_Addresses_FullMethodName                   = "/walletrpc.WalletService/Addresses"
 	WalletService_Balance_FullMethodName                     = "/walletrpc.WalletService/Balance"
 	WalletService_GetAccountExtendedPubKey_FullMethodName    = "/walletrpc.WalletService/GetAccountExtendedPubKey"
 	WalletService_GetAccountExtendedPrivKey_FullMethodName   = "/walletrpc.WalletService/GetAccountExtendedPrivKey"
@@ -167,6 +206,8 @@ const (
 	WalletService_LoadActiveDataFilters_FullMethodName       = "/walletrpc.WalletService/LoadActiveDataFilters"
 	WalletService_SignMessage_FullMethodName                 = "/walletrpc.WalletService/SignMessage"
 	WalletService_SignMessages_FullMethodName                = "/walletrpc.WalletService/SignMessages"
+	WalletService_EncryptMessage_FullMethodName              = "/walletrpc.WalletService/EncryptMessage"
+	WalletService_DecryptMessage_FullMethodName              = "/walletrpc.WalletService/DecryptMessage"
 	WalletService_ValidateAddress_FullMethodName             = "/walletrpc.WalletService/ValidateAddress"
 	WalletService_CommittedTickets_FullMethodName            = "/walletrpc.WalletService/CommittedTickets"
 	WalletService_SweepAccount_FullMethodName                = "/walletrpc.WalletService/SweepAccount"
@@ -177,6 +218,7 @@ const (
 	WalletService_UnlockAccount_FullMethodName               = "/walletrpc.WalletService/UnlockAccount"
 	WalletService_LockAccount_FullMethodName                 = "/walletrpc.WalletService/LockAccount"
 	WalletService_UnlockWallet_FullMethodName                = "/walletrpc.WalletService/UnlockWallet"
+	WalletService_UnlockHandshake_FullMethodName             = "/walletrpc.WalletService/UnlockHandshake"
 	WalletService_LockWallet_FullMethodName                  = "/walletrpc.WalletService/LockWallet"
 	WalletService_AccountUnlocked_FullMethodName             = "/walletrpc.WalletService/AccountUnlocked"
 	WalletService_SyncVSPFailedTickets_FullMethodName        = "/walletrpc.WalletService/SyncVSPFailedTickets"
@@ -186,6 +228,9 @@ const (
 	WalletService_SetVspdVoteChoices_FullMethodName          = "/walletrpc.WalletService/SetVspdVoteChoices"
 	WalletService_GetTrackedVSPTickets_FullMethodName        = "/walletrpc.WalletService/GetTrackedVSPTickets"
 	WalletService_DiscoverUsage_FullMethodName               = "/walletrpc.WalletService/DiscoverUsage"
+	WalletService_SeedChallenge_FullMethodName               = "/walletrpc.WalletService/SeedChallenge"
+	WalletService_VerifySeedChallenge_FullMethodName         = "/walletrpc.WalletService/VerifySeedChallenge"
+	WalletService_ForgetVerificationSeed_FullMethodName      = "/walletrpc.WalletService/ForgetVerificationSeed"
 )
 
 // WalletServiceClient is the client API for WalletService service.
@@ -199,6 +244,7 @@ type WalletServiceClient interface {
 	AccountNumber(ctx context.Context, in *AccountNumberRequest, opts ...grpc.CallOption) (*AccountNumberResponse, error)
 	Accounts(ctx context.Context, in *AccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
 	Address(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*AddressResponse, error)
+	Addresses(ctx context.Context, in *AddressesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AddressesResponse], error)
 	Balance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error)
 	GetAccountExtendedPubKey(ctx context.Context, in *GetAccountExtendedPubKeyRequest, opts ...grpc.CallOption) (*GetAccountExtendedPubKeyResponse, error)
 	GetAccountExtendedPrivKey(ctx context.Context, in *GetAccountExtendedPrivKeyRequest, opts ...grpc.CallOption) (*GetAccountExtendedPrivKeyResponse, error)
@@ -241,6 +287,8 @@ type WalletServiceClient interface {
 	LoadActiveDataFilters(ctx context.Context, in *LoadActiveDataFiltersRequest, opts ...grpc.CallOption) (*LoadActiveDataFiltersResponse, error)
 	SignMessage(ctx context.Context, in *SignMessageRequest, opts ...grpc.CallOption) (*SignMessageResponse, error)
 	SignMessages(ctx context.Context, in *SignMessagesRequest, opts ...grpc.CallOption) (*SignMessagesResponse, error)
+	EncryptMessage(ctx context.Context, in *EncryptMessageRequest, opts ...grpc.CallOption) (*EncryptMessageResponse, error)
+	DecryptMessage(ctx context.Context, in *DecryptMessageRequest, opts ...grpc.CallOption) (*DecryptMessageResponse, error)
 	ValidateAddress(ctx context.Context, in *ValidateAddressRequest, opts ...grpc.CallOption) (*ValidateAddressResponse, error)
 	CommittedTickets(ctx context.Context, in *CommittedTicketsRequest, opts ...grpc.CallOption) (*CommittedTicketsResponse, error)
 	SweepAccount(ctx context.Context, in *SweepAccountRequest, opts ...grpc.CallOption) (*SweepAccountResponse, error)
@@ -251,6 +299,7 @@ type WalletServiceClient interface {
 	UnlockAccount(ctx context.Context, in *UnlockAccountRequest, opts ...grpc.CallOption) (*UnlockAccountResponse, error)
 	LockAccount(ctx context.Context, in *LockAccountRequest, opts ...grpc.CallOption) (*LockAccountResponse, error)
 	UnlockWallet(ctx context.Context, in *UnlockWalletRequest, opts ...grpc.CallOption) (*UnlockWalletResponse, error)
+	UnlockHandshake(ctx context.Context, in *UnlockHandshakeRequest, opts ...grpc.CallOption) (*UnlockHandshakeResponse, error)
 	LockWallet(ctx context.Context, in *LockWalletRequest, opts ...grpc.CallOption) (*LockWalletResponse, error)
 	AccountUnlocked(ctx context.Context, in *AccountUnlockedRequest, opts ...grpc.CallOption) (*AccountUnlockedResponse, error)
 	SyncVSPFailedTickets(ctx context.Context, in *SyncVSPTicketsRequest, opts ...grpc.CallOption) (*SyncVSPTicketsResponse, error)
@@ -260,6 +309,9 @@ type WalletServiceClient interface {
 	SetVspdVoteChoices(ctx context.Context, in *SetVspdVoteChoicesRequest, opts ...grpc.CallOption) (*SetVspdVoteChoicesResponse, error)
 	GetTrackedVSPTickets(ctx context.Context, in *GetTrackedVSPTicketsRequest, opts ...grpc.CallOption) (*GetTrackedVSPTicketsResponse, error)
 	DiscoverUsage(ctx context.Context, in *DiscoverUsageRequest, opts ...grpc.CallOption) (*DiscoverUsageResponse, error)
+	SeedChallenge(ctx context.Context, in *SeedChallengeRequest, opts ...grpc.CallOption) (*SeedChallengeResponse, error)
+	VerifySeedChallenge(ctx context.Context, in *VerifySeedChallengeRequest, opts ...grpc.CallOption) (*VerifySeedChallengeResponse, error)
+	ForgetVerificationSeed(ctx context.Context, in *ForgetVerificationSeedRequest, opts ...grpc.CallOption) (*ForgetVerificationSeedResponse, error)
 }
 
 type walletServiceClient struct {
@@ -330,6 +382,25 @@ func (c *walletServiceClient) Address(ctx context.Context, in *AddressRequest, o
 	return out, nil
 }
 
+func (c *walletServiceClient) Addresses(ctx context.Context, in *AddressesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AddressesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[0], WalletService_Addresses_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AddressesRequest, AddressesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WalletService_AddressesClient = grpc.ServerStreamingClient[AddressesResponse]
+
 func (c *walletServiceClient) Balance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BalanceResponse)
@@ -372,7 +443,7 @@ func (c *walletServiceClient) GetTransaction(ctx context.Context, in *GetTransac
 
 func (c *walletServiceClient) GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetTransactionsResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[0], WalletService_GetTransactions_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[1], WalletService_GetTransactions_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -401,7 +472,7 @@ func (c *walletServiceClient) GetTicket(ctx context.Context, in *GetTicketReques
 
 func (c *walletServiceClient) GetTickets(ctx context.Context, in *GetTicketsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetTicketsResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[1], WalletService_GetTickets_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[2], WalletService_GetTickets_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -470,7 +541,7 @@ func (c *walletServiceClient) Spender(ctx context.Context, in *SpenderRequest, o
 
 func (c *walletServiceClient) GetCFilters(ctx context.Context, in *GetCFiltersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetCFiltersResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[2], WalletService_GetCFilters_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[3], WalletService_GetCFilters_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -519,7 +590,7 @@ func (c *walletServiceClient) BirthBlock(ctx context.Context, in *BirthBlockRequ
 
 func (c *walletServiceClient) TransactionNotifications(ctx context.Context, in *TransactionNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TransactionNotificationsResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[3], WalletService_TransactionNotifications_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[4], WalletService_TransactionNotifications_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -538,7 +609,7 @@ type WalletService_TransactionNotificationsClient = grpc.ServerStreamingClient[T
 
 func (c *walletServiceClient) AccountNotifications(ctx context.Context, in *AccountNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AccountNotificationsResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[4], WalletService_AccountNotifications_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[5], WalletService_AccountNotifications_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -557,7 +628,7 @@ type WalletService_AccountNotificationsClient = grpc.ServerStreamingClient[Accou
 
 func (c *walletServiceClient) ConfirmationNotifications(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ConfirmationNotificationsRequest, ConfirmationNotificationsResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[5], WalletService_ConfirmationNotifications_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[6], WalletService_ConfirmationNotifications_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -590,7 +661,7 @@ func (c *walletServiceClient) RenameAccount(ctx context.Context, in *RenameAccou
 
 func (c *walletServiceClient) Rescan(ctx context.Context, in *RescanRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RescanResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[6], WalletService_Rescan_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[7], WalletService_Rescan_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -679,7 +750,7 @@ func (c *walletServiceClient) FundTransaction(ctx context.Context, in *FundTrans
 
 func (c *walletServiceClient) UnspentOutputs(ctx context.Context, in *UnspentOutputsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[UnspentOutputResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[7], WalletService_UnspentOutputs_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[8], WalletService_UnspentOutputs_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -796,6 +867,26 @@ func (c *walletServiceClient) SignMessages(ctx context.Context, in *SignMessages
 	return out, nil
 }
 
+func (c *walletServiceClient) EncryptMessage(ctx context.Context, in *EncryptMessageRequest, opts ...grpc.CallOption) (*EncryptMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EncryptMessageResponse)
+	err := c.cc.Invoke(ctx, WalletService_EncryptMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) DecryptMessage(ctx context.Context, in *DecryptMessageRequest, opts ...grpc.CallOption) (*DecryptMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DecryptMessageResponse)
+	err := c.cc.Invoke(ctx, WalletService_DecryptMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *walletServiceClient) ValidateAddress(ctx context.Context, in *ValidateAddressRequest, opts ...grpc.CallOption) (*ValidateAddressResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ValidateAddressResponse)
@@ -896,6 +987,16 @@ func (c *walletServiceClient) UnlockWallet(ctx context.Context, in *UnlockWallet
 	return out, nil
 }
 
+func (c *walletServiceClient) UnlockHandshake(ctx context.Context, in *UnlockHandshakeRequest, opts ...grpc.CallOption) (*UnlockHandshakeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlockHandshakeResponse)
+	err := c.cc.Invoke(ctx, WalletService_UnlockHandshake_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *walletServiceClient) LockWallet(ctx context.Context, in *LockWalletRequest, opts ...grpc.CallOption) (*LockWalletResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LockWalletResponse)
@@ -986,6 +1087,36 @@ func (c *walletServiceClient) DiscoverUsage(ctx context.Context, in *DiscoverUsa
 	return out, nil
 }
 
+func (c *walletServiceClient) SeedChallenge(ctx context.Context, in *SeedChallengeRequest, opts ...grpc.CallOption) (*SeedChallengeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SeedChallengeResponse)
+	err := c.cc.Invoke(ctx, WalletService_SeedChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) VerifySeedChallenge(ctx context.Context, in *VerifySeedChallengeRequest, opts ...grpc.CallOption) (*VerifySeedChallengeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifySeedChallengeResponse)
+	err := c.cc.Invoke(ctx, WalletService_VerifySeedChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ForgetVerificationSeed(ctx context.Context, in *ForgetVerificationSeedRequest, opts ...grpc.CallOption) (*ForgetVerificationSeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForgetVerificationSeedResponse)
+	err := c.cc.Invoke(ctx, WalletService_ForgetVerificationSeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WalletServiceServer is the server API for WalletService service.
 // All implementations must embed UnimplementedWalletServiceServer
 // for forward compatibility.
@@ -997,6 +1128,7 @@ type WalletServiceServer interface {
 	AccountNumber(context.Context, *AccountNumberRequest) (*AccountNumberResponse, error)
 	Accounts(context.Context, *AccountsRequest) (*AccountsResponse, error)
 	Address(context.Context, *AddressRequest) (*AddressResponse, error)
+	Addresses(*AddressesRequest, grpc.ServerStreamingServer[AddressesResponse]) error
 	Balance(context.Context, *BalanceRequest) (*BalanceResponse, error)
 	GetAccountExtendedPubKey(context.Context, *GetAccountExtendedPubKeyRequest) (*GetAccountExtendedPubKeyResponse, error)
 	GetAccountExtendedPrivKey(context.Context, *GetAccountExtendedPrivKeyRequest) (*GetAccountExtendedPrivKeyResponse, error)
@@ -1039,6 +1171,8 @@ type WalletServiceServer interface {
 	LoadActiveDataFilters(context.Context, *LoadActiveDataFiltersRequest) (*LoadActiveDataFiltersResponse, error)
 	SignMessage(context.Context, *SignMessageRequest) (*SignMessageResponse, error)
 	SignMessages(context.Context, *SignMessagesRequest) (*SignMessagesResponse, error)
+	EncryptMessage(context.Context, *EncryptMessageRequest) (*EncryptMessageResponse, error)
+	DecryptMessage(context.Context, *DecryptMessageRequest) (*DecryptMessageResponse, error)
 	ValidateAddress(context.Context, *ValidateAddressRequest) (*ValidateAddressResponse, error)
 	CommittedTickets(context.Context, *CommittedTicketsRequest) (*CommittedTicketsResponse, error)
 	SweepAccount(context.Context, *SweepAccountRequest) (*SweepAccountResponse, error)
@@ -1049,6 +1183,7 @@ type WalletServiceServer interface {
 	UnlockAccount(context.Context, *UnlockAccountRequest) (*UnlockAccountResponse, error)
 	LockAccount(context.Context, *LockAccountRequest) (*LockAccountResponse, error)
 	UnlockWallet(context.Context, *UnlockWalletRequest) (*UnlockWalletResponse, error)
+	UnlockHandshake(context.Context, *UnlockHandshakeRequest) (*UnlockHandshakeResponse, error)
 	LockWallet(context.Context, *LockWalletRequest) (*LockWalletResponse, error)
 	AccountUnlocked(context.Context, *AccountUnlockedRequest) (*AccountUnlockedResponse, error)
 	SyncVSPFailedTickets(context.Context, *SyncVSPTicketsRequest) (*SyncVSPTicketsResponse, error)
@@ -1058,6 +1193,9 @@ type WalletServiceServer interface {
 	SetVspdVoteChoices(context.Context, *SetVspdVoteChoicesRequest) (*SetVspdVoteChoicesResponse, error)
 	GetTrackedVSPTickets(context.Context, *GetTrackedVSPTicketsRequest) (*GetTrackedVSPTicketsResponse, error)
 	DiscoverUsage(context.Context, *DiscoverUsageRequest) (*DiscoverUsageResponse, error)
+	SeedChallenge(context.Context, *SeedChallengeRequest) (*SeedChallengeResponse, error)
+	VerifySeedChallenge(context.Context, *VerifySeedChallengeRequest) (*VerifySeedChallengeResponse, error)
+	ForgetVerificationSeed(context.Context, *ForgetVerificationSeedRequest) (*ForgetVerificationSeedResponse, error)
 	mustEmbedUnimplementedWalletServiceServer()
 }
 
@@ -1086,6 +1224,9 @@ func (UnimplementedWalletServiceServer) Accounts(context.Context, *AccountsReque
 func (UnimplementedWalletServiceServer) Address(context.Context, *AddressRequest) (*AddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Address not implemented")
 }
+func (UnimplementedWalletServiceServer) Addresses(*AddressesRequest, grpc.ServerStreamingServer[AddressesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Addresses not implemented")
+}
 func (UnimplementedWalletServiceServer) Balance(context.Context, *BalanceRequest) (*BalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Balance not implemented")
 }
@@ -1206,6 +1347,12 @@ func (UnimplementedWalletServiceServer) SignMessage(context.Context, *SignMessag
 func (UnimplementedWalletServiceServer) SignMessages(context.Context, *SignMessagesRequest) (*SignMessagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignMessages not implemented")
 }
+func (UnimplementedWalletServiceServer) EncryptMessage(context.Context, *EncryptMessageRequest) (*EncryptMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncryptMessage not implemented")
+}
+func (UnimplementedWalletServiceServer) DecryptMessage(context.Context, *DecryptMessageRequest) (*DecryptMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecryptMessage not implemented")
+}
 func (UnimplementedWalletServiceServer) ValidateAddress(context.Context, *ValidateAddressRequest) (*ValidateAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidateAddress not implemented")
 }
@@ -1236,6 +1383,9 @@ func (UnimplementedWalletServiceServer) LockAccount(context.Context, *LockAccoun
 func (UnimplementedWalletServiceServer) UnlockWallet(context.Context, *UnlockWalletRequest) (*UnlockWalletResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnlockWallet not implemented")
 }
+func (UnimplementedWalletServiceServer) UnlockHandshake(context.Context, *UnlockHandshakeRequest) (*UnlockHandshakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockHandshake not implemented")
+}
 func (UnimplementedWalletServiceServer) LockWallet(context.Context, *LockWalletRequest) (*LockWalletResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LockWallet not implemented")
 }
@@ -1263,6 +1413,15 @@ func (UnimplementedWalletServiceServer) GetTrackedVSPTickets(context.Context, *G
 func (UnimplementedWalletServiceServer) DiscoverUsage(context.Context, *DiscoverUsageRequest) (*DiscoverUsageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DiscoverUsage not implemented")
 }
+func (UnimplementedWalletServiceServer) SeedChallenge(context.Context, *SeedChallengeRequest) (*SeedChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SeedChallenge not implemented")
+}
+func (UnimplementedWalletServiceServer) VerifySeedChallenge(context.Context, *VerifySeedChallengeRequest) (*VerifySeedChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifySeedChallenge not implemented")
+}
+func (UnimplementedWalletServiceServer) ForgetVerificationSeed(context.Context, *ForgetVerificationSeedRequest) (*ForgetVerificationSeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForgetVerificationSeed not implemented")
+}
 func (UnimplementedWalletServiceServer) mustEmbedUnimplementedWalletServiceServer() {}
 func (UnimplementedWalletServiceServer) testEmbeddedByValue()                       {}
 
@@ -1392,6 +1551,17 @@ func _WalletService_Address_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletService_Addresses_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AddressesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WalletServiceServer).Addresses(m, &grpc.GenericServerStream[AddressesRequest, AddressesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WalletService_AddressesServer = grpc.ServerStreamingServer[AddressesResponse]
+
 func _WalletService_Balance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BalanceRequest)
 	if err := dec(in); err != nil {
@@ -2052,6 +2222,42 @@ func _WalletService_SignMessages_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletService_EncryptMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncryptMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).EncryptMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_EncryptMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).EncryptMessage(ctx, req.(*EncryptMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_DecryptMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecryptMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).DecryptMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_DecryptMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).DecryptMessage(ctx, req.(*DecryptMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WalletService_ValidateAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ValidateAddressRequest)
 	if err := dec(in); err != nil {
@@ -2232,6 +2438,24 @@ func _WalletService_UnlockWallet_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletService_UnlockHandshake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockHandshakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).UnlockHandshake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_UnlockHandshake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).UnlockHandshake(ctx, req.(*UnlockHandshakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WalletService_LockWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LockWalletRequest)
 	if err := dec(in); err != nil {
@@ -2394,6 +2618,60 @@ func _WalletService_DiscoverUsage_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletService_SeedChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeedChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SeedChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_SeedChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SeedChallenge(ctx, req.(*SeedChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_VerifySeedChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifySeedChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).VerifySeedChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_VerifySeedChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).VerifySeedChallenge(ctx, req.(*VerifySeedChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ForgetVerificationSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForgetVerificationSeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ForgetVerificationSeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_ForgetVerificationSeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ForgetVerificationSeed(ctx, req.(*ForgetVerificationSeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WalletService_ServiceDesc is the grpc.ServiceDesc for WalletService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2553,6 +2831,14 @@ var WalletService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SignMessages",
 			Handler:    _WalletService_SignMessages_Handler,
 		},
+		{
+			MethodName: "EncryptMessage",
+			Handler:    _WalletService_EncryptMessage_Handler,
+		},
+		{
+			MethodName: "DecryptMessage",
+			Handler:    _WalletService_DecryptMessage_Handler,
+		},
 		{
 			MethodName: "ValidateAddress",
 			Handler:    _WalletService_ValidateAddress_Handler,
@@ -2593,6 +2879,10 @@ var WalletService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnlockWallet",
 			Handler:    _WalletService_UnlockWallet_Handler,
 		},
+		{
+			MethodName: "UnlockHandshake",
+			Handler:    _WalletService_UnlockHandshake_Handler,
+		},
 		{
 			MethodName: "LockWallet",
 			Handler:    _WalletService_LockWallet_Handler,
@@ -2629,8 +2919,25 @@ var WalletService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DiscoverUsage",
 			Handler:    _WalletService_DiscoverUsage_Handler,
 		},
+		{
+			MethodName: "SeedChallenge",
+			Handler:    _WalletService_SeedChallenge_Handler,
+		},
+		{
+			MethodName: "VerifySeedChallenge",
+			Handler:    _WalletService_VerifySeedChallenge_Handler,
+		},
+		{
+			MethodName: "ForgetVerificationSeed",
+			Handler:    _WalletService_ForgetVerificationSeed_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Addresses",
+			Handler:       _WalletService_Addresses_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "GetTransactions",
 			Handler:       _WalletService_GetTransactions_Handler,
@@ -28,6 +28,7 @@ import (
 	"decred.org/dcrwallet/v5/internal/loggers"
 	"decred.org/dcrwallet/v5/internal/rpc/jsonrpc"
 	"decred.org/dcrwallet/v5/internal/rpc/rpcserver"
+	"decred.org/dcrwallet/v5/internal/webhook"
 	"github.com/decred/dcrd/crypto/rand"
 
 	"google.golang.org/grpc"
@@ -245,7 +246,8 @@ func (rpcLoggers) SetLevels(levelSpec string) error {
 	return parseAndSetDebugLevels(levelSpec)
 }
 
-func startRPCServers(ctx context.Context, walletLoader *loader.Loader) (*grpc.Server, *jsonrpc.Server, error) {
+func startRPCServers(ctx context.Context, walletLoader *loader.Loader,
+	webhooks *webhook.Dispatcher) (*grpc.Server, *jsonrpc.Server, error) {
 	var jsonrpcAddrNotifier jsonrpcListenerEventServer
 	var grpcAddrNotifier grpcListenerEventServer
 	if cfg.RPCListenerEvents {
@@ -371,6 +373,10 @@ func startRPCServers(ctx context.Context, walletLoader *loader.Loader) (*grpc.Se
 		opts := jsonrpc.Options{
 			Username:            user,
 			Password:            pass,
+			PendingSpends:       cfg.PendingSpends,
+			Replica:             cfg.ReplicaOf != "",
+			ApproveUsername:     cfg.ApproveUsername,
+			ApprovePassword:     cfg.ApprovePassword,
 			MaxPOSTClients:      cfg.LegacyRPCMaxClients,
 			MaxWebsocketClients: cfg.LegacyRPCMaxWebsockets,
 			MixingEnabled:       cfg.MixingEnabled,
@@ -380,7 +386,12 @@ func startRPCServers(ctx context.Context, walletLoader *loader.Loader) (*grpc.Se
 			VSPHost:             cfg.VSPOpts.URL,
 			VSPPubKey:           cfg.VSPOpts.PubKey,
 			TicketSplitAccount:  cfg.TicketSplitAccount,
+			TicketBuyerEnabled:  cfg.EnableTicketBuyer,
+			PurchaseAccount:     cfg.PurchaseAccount,
+			BalanceToMaintain:   cfg.TBOpts.BalanceToMaintainAbsolute.Amount,
+			TicketBuyerLimit:    int(cfg.TBOpts.Limit),
 			Dial:                cfg.dial,
+			Webhooks:            webhooks,
 			Loggers:             rpcLoggers{},
 		}
 		jsonrpcServer = jsonrpc.NewServer(ctx, &opts, activeNet.Params, walletLoader, listeners)
@@ -414,9 +425,9 @@ func interceptStreaming(srv any, ss grpc.ServerStream, info *grpc.StreamServerIn
 	}
 	err := rpcserver.ServiceReady(serviceName(info.FullMethod))
 	if err != nil {
-		return err
+		return rpcserver.DescribeError(err)
 	}
-	err = handler(srv, ss)
+	err = rpcserver.DescribeError(handler(srv, ss))
 	if err != nil && ok {
 		logf := loggers.GrpcLog.Errorf
 		if status.Code(err) == codes.Canceled && done(ss.Context()) {
@@ -440,9 +451,10 @@ func interceptUnary(ctx context.Context, req any, info *grpc.UnaryServerInfo, ha
 	}
 	err = rpcserver.ServiceReady(serviceName(info.FullMethod))
 	if err != nil {
-		return nil, err
+		return nil, rpcserver.DescribeError(err)
 	}
 	resp, err = handler(ctx, req)
+	err = rpcserver.DescribeError(err)
 	if err != nil && ok {
 		loggers.GrpcLog.Errorf("Unary method %s invoked by %s errored: %v",
 			info.FullMethod, p.Addr.String(), err)
@@ -69,7 +69,12 @@ func (s *Syncer) Blocks(ctx context.Context, blockHashes []*chainhash.Hash) ([]*
 		if err != nil {
 			return nil, err
 		}
+		release, err := s.throttle.Acquire(ctx)
+		if err != nil {
+			return nil, err
+		}
 		blocks, err := rp.Blocks(ctx, blockHashes)
+		release()
 		if err != nil {
 			log.Debugf("unable to fetch blocks from %v: %v", rp, err)
 			continue
@@ -98,7 +103,12 @@ func (s *Syncer) CFiltersV2(ctx context.Context, blockHashes []*chainhash.Hash)
 		if err != nil {
 			return nil, err
 		}
+		release, err := s.throttle.Acquire(ctx)
+		if err != nil {
+			return nil, err
+		}
 		fs, err := rp.CFiltersV2(ctx, blockHashes)
+		release()
 		if err != nil {
 			log.Debugf("Error while fetching cfilters from %v: %v",
 				rp, err)
@@ -140,6 +150,14 @@ func (s *Syncer) cfiltersV2FromNodes(ctx context.Context, nodes []*wallet.BlockN
 	}
 	lastHeight := nodes[len(nodes)-1].Header.Height
 
+	// Wait for the throttle to allow the request before starting the
+	// watchdog.
+	release, err := s.throttle.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
 	// Specially once we get close to the tip, we may have a header in the
 	// best sidechain that has been reorged out and thus no peer will have
 	// its corresponding CFilters.  To recover from this case in a timely
@@ -464,8 +482,9 @@ func (s *Syncer) PublishMixMessages(ctx context.Context, msgs ...mixing.Message)
 		}
 	}
 	var mixingPeers int
+	minPver := s.wallet.MixPeerVersion()
 	err := s.forRemotes(func(rp *p2p.RemotePeer) error {
-		if rp.Pver() < wire.MixVersion {
+		if rp.Pver() < minPver {
 			return nil
 		}
 		mixingPeers++
@@ -555,7 +574,12 @@ func (s *Syncer) Rescan(ctx context.Context, blockHashes []chainhash.Hash, save
 				return err
 			}
 
+			release, err := s.throttle.Acquire(ctx)
+			if err != nil {
+				return err
+			}
 			blocks, err := rp.Blocks(ctx, fmatches)
+			release()
 			if err != nil {
 				continue PickPeer
 			}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"runtime"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"decred.org/dcrwallet/v5/errors"
 	"decred.org/dcrwallet/v5/lru"
 	"decred.org/dcrwallet/v5/p2p"
+	"decred.org/dcrwallet/v5/ratelimit"
 	"decred.org/dcrwallet/v5/validate"
 	"decred.org/dcrwallet/v5/wallet"
 	"github.com/decred/dcrd/addrmgr/v2"
@@ -55,6 +57,9 @@ type Syncer struct {
 	// atomics
 	atomicWalletSynced atomic.Uint32 // CAS (synced=1) when wallet syncing complete
 
+	// phase records the sync phase for SyncStatus reporting.
+	phase wallet.SyncPhaseTracker
+
 	wallet *wallet.Wallet
 	lp     *p2p.LocalPeer
 
@@ -64,6 +69,10 @@ type Syncer struct {
 
 	persistentPeers []string
 
+	// throttle limits the download rate and concurrent block and cfilter
+	// requests.  A nil throttle does not limit.
+	throttle *ratelimit.Throttle
+
 	connectingRemotes map[string]struct{}
 	remotes           map[string]*p2p.RemotePeer
 	remoteAvailable   chan struct{}
@@ -162,6 +171,14 @@ func (s *Syncer) SetPersistentPeers(peers []string) {
 	s.persistentPeers = peers
 }
 
+// SetThrottle limits the concurrent block and compact filter requests of the
+// syncer, switching from the initial to steady state limits of the throttle
+// once synced.  Limiting the download rate additionally requires peer
+// connections to be dialed with the throttle's Dial method.
+func (s *Syncer) SetThrottle(t *ratelimit.Throttle) {
+	s.throttle = t
+}
+
 // SetNotifications sets the possible various callbacks that are used
 // to notify interested parties to the syncing progress.
 func (s *Syncer) SetNotifications(ntfns *Notifications) {
@@ -177,6 +194,8 @@ func (s *Syncer) DisableDiscoverAccounts() {
 // synced checks the atomic that controls wallet syncness and if previously
 // unsynced, updates to synced and notifies the callback, if set.
 func (s *Syncer) synced() {
+	s.phase.Begin(wallet.SyncPhaseSynced)
+	s.throttle.Steady()
 	if s.atomicWalletSynced.CompareAndSwap(0, 1) &&
 		s.notifications != nil &&
 		s.notifications.Synced != nil {
@@ -188,6 +207,7 @@ func (s *Syncer) synced() {
 // synced, updates to unsynced and notifies the callback, if set.
 func (s *Syncer) unsynced() {
 	if s.atomicWalletSynced.CompareAndSwap(1, 0) {
+		s.phase.Begin(wallet.SyncPhaseConnecting)
 		if s.notifications != nil &&
 			s.notifications.Synced != nil {
 			s.notifications.Synced(false)
@@ -215,6 +235,25 @@ func (s *Syncer) Synced(ctx context.Context) (bool, int32) {
 	return synced, targetHeight
 }
 
+// SyncPhase returns the current sync phase and the block height the phase has
+// progressed through.
+func (s *Syncer) SyncPhase() (wallet.SyncPhase, int32) {
+	return s.phase.Phase()
+}
+
+// SyncBackend describes the syncer as an "spv" backend syncing from the
+// currently connected peers.
+func (s *Syncer) SyncBackend() (string, []string) {
+	s.remotesMu.Lock()
+	peers := make([]string, 0, len(s.remotes))
+	for addr := range s.remotes {
+		peers = append(peers, addr)
+	}
+	s.remotesMu.Unlock()
+	sort.Strings(peers)
+	return "spv", peers
+}
+
 // GetRemotePeers returns a map of connected remote peers.
 func (s *Syncer) GetRemotePeers() map[string]*p2p.RemotePeer {
 	s.remotesMu.Lock()
@@ -242,12 +281,14 @@ func (s *Syncer) peerDisconnected(remotesCount int, addr string) {
 }
 
 func (s *Syncer) fetchMissingCfiltersStart() {
+	s.phase.Begin(wallet.SyncPhaseFetchingCFilters)
 	if s.notifications != nil && s.notifications.FetchMissingCFiltersStarted != nil {
 		s.notifications.FetchMissingCFiltersStarted()
 	}
 }
 
 func (s *Syncer) fetchMissingCfiltersProgress(startMissingCFilterHeight, endMissinCFilterHeight int32) {
+	s.phase.Progress(endMissinCFilterHeight)
 	if s.notifications != nil && s.notifications.FetchMissingCFiltersProgress != nil {
 		s.notifications.FetchMissingCFiltersProgress(startMissingCFilterHeight, endMissinCFilterHeight)
 	}
@@ -260,12 +301,14 @@ func (s *Syncer) fetchMissingCfiltersFinished() {
 }
 
 func (s *Syncer) fetchHeadersStart() {
+	s.phase.Begin(wallet.SyncPhaseFetchingHeaders)
 	if s.notifications != nil && s.notifications.FetchHeadersStarted != nil {
 		s.notifications.FetchHeadersStarted()
 	}
 }
 
 func (s *Syncer) fetchHeadersProgress(lastHeader *wire.BlockHeader) {
+	s.phase.Progress(int32(lastHeader.Height))
 	if s.notifications != nil && s.notifications.FetchHeadersProgress != nil {
 		s.notifications.FetchHeadersProgress(int32(lastHeader.Height), lastHeader.Timestamp.Unix())
 	}
@@ -277,6 +320,7 @@ func (s *Syncer) fetchHeadersFinished() {
 	}
 }
 func (s *Syncer) discoverAddressesStart() {
+	s.phase.Begin(wallet.SyncPhaseDiscoveringAddresses)
 	if s.notifications != nil && s.notifications.DiscoverAddressesStarted != nil {
 		s.notifications.DiscoverAddressesStarted()
 	}
@@ -289,12 +333,14 @@ func (s *Syncer) discoverAddressesFinished() {
 }
 
 func (s *Syncer) rescanStart() {
+	s.phase.Begin(wallet.SyncPhaseRescanning)
 	if s.notifications != nil && s.notifications.RescanStarted != nil {
 		s.notifications.RescanStarted()
 	}
 }
 
 func (s *Syncer) rescanProgress(rescannedThrough int32) {
+	s.phase.Progress(rescannedThrough)
 	if s.notifications != nil && s.notifications.RescanProgress != nil {
 		s.notifications.RescanProgress(rescannedThrough)
 	}
@@ -340,6 +386,8 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 	s.done = make(chan struct{})
 	s.err = nil
 	s.doneMu.Unlock()
+	s.phase.Begin(wallet.SyncPhaseConnecting)
+	s.throttle.Initial()
 	defer func() {
 		s.doneMu.Lock()
 		close(s.done)
@@ -493,6 +541,9 @@ func (s *Syncer) peerCandidate(svcs wire.ServiceFlag) (*addrmgr.NetAddress, erro
 		_, isRemote := s.remotes[k]
 
 		switch {
+		// Skip peers which are not permitted by the peer filter.
+		case !s.lp.PermitsIP(na.IP):
+			fallthrough
 		// Skip peer if already connected, or in process of connecting
 		// TODO: this should work with network blocks, not exact addresses.
 		case isConnecting || isRemote:
@@ -809,7 +860,8 @@ func (s *Syncer) receiveGetData(ctx context.Context) error {
 				case wire.InvTypeTx:
 					txHashes = append(txHashes, &inv.Hash)
 				case wire.InvTypeMix:
-					if !s.wallet.MixingEnabled() {
+					if !s.wallet.MixingEnabled() ||
+						rp.Pver() < s.wallet.MixPeerVersion() {
 						notFound = append(notFound, inv)
 						continue
 					}
@@ -917,7 +969,8 @@ func (s *Syncer) receiveInv(ctx context.Context) error {
 			case wire.InvTypeTx:
 				txs = append(txs, &inv.Hash)
 			case wire.InvTypeMix:
-				if s.wallet.MixingEnabled() {
+				if s.wallet.MixingEnabled() &&
+					rp.Pver() >= s.wallet.MixPeerVersion() {
 					mixMsgs = append(mixMsgs, &inv.Hash)
 				}
 			}
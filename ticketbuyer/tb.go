@@ -33,6 +33,22 @@ type Config struct {
 	// Limit maximum number of purchased tickets per block
 	Limit int
 
+	// Deadline-aware purchasing.  When TargetTickets is non-zero, purchases
+	// are scheduled to hold at least TargetTickets unspent tickets which
+	// are live by the block at TargetHeight (e.g. the start of an agenda
+	// voting window), front-loading purchases instead of steady-state
+	// buying.  Once the deadline can no longer be met by new purchases,
+	// steady-state buying resumes.
+	TargetTickets int
+	TargetHeight  int32
+
+	// Coin source restrictions.  When SourceBranches is non-empty, only
+	// outputs paying to addresses of these branches of the purchasing
+	// account are spent.  MinConf is the minimum number of confirmations
+	// of spent outputs, and is ignored when less than the default.
+	SourceBranches []uint32
+	MinConf        int32
+
 	// CSPP-related options
 	Mixing             bool
 	MixedAccount       uint32
@@ -62,8 +78,12 @@ func New(w *wallet.Wallet, cfg Config) *TB {
 
 // Run executes the ticket buyer.  If the private passphrase is incorrect, or
 // ever becomes incorrect due to a wallet passphrase change, Run exits with an
-// errors.Passphrase error.
+// errors.Passphrase error.  Run errors immediately with errors.Permission for
+// wallets opened in vote-only mode.
 func (tb *TB) Run(ctx context.Context, passphrase []byte) error {
+	if tb.wallet.VoteOnly() {
+		return errors.E(errors.Permission, "ticket buyer is disabled by vote-only mode")
+	}
 	if len(passphrase) > 0 {
 		err := tb.wallet.Unlock(ctx, passphrase, nil)
 		if err != nil {
@@ -163,6 +183,25 @@ func (tb *TB) Run(ctx context.Context, passphrase []byte) error {
 				cfg.Limit = 1
 			}
 
+			// Override the per-block limit with the deadline quota when
+			// a live ticket target must be met by a future height.
+			if cfg.TargetTickets > 0 {
+				quota, ok, err := tb.deadlineQuota(ctx, height, &cfg)
+				switch {
+				case err != nil:
+					log.Errorf("Unable to determine deadline purchase quota: %v", err)
+				case !ok:
+				case quota == 0:
+					log.Debugf("Skipping purchase: target of %d live tickets "+
+						"by height %d is met", cfg.TargetTickets, cfg.TargetHeight)
+					multiple = 0
+				case cfg.Mixing:
+					multiple = quota
+				default:
+					cfg.Limit = quota
+				}
+			}
+
 			cancelCtx, cancel := context.WithCancel(ctx)
 			cancels = append(cancels, cancel)
 			buyTickets := func() {
@@ -244,6 +283,9 @@ func (tb *TB) buy(ctx context.Context, passphrase []byte, tip *wire.BlockHeader,
 	if mixing {
 		minconf = 2
 	}
+	if cfg.MinConf > minconf {
+		minconf = cfg.MinConf
+	}
 
 	sdiff, err := w.NextStakeDifficultyAfterHeader(ctx, tip)
 	if err != nil {
@@ -288,6 +330,8 @@ func (tb *TB) buy(ctx context.Context, passphrase []byte, tip *wire.BlockHeader,
 		MinConf:       minconf,
 		Expiry:        expiry,
 
+		SourceBranches: cfg.SourceBranches,
+
 		// CSPP
 		Mixing:             mixing,
 		MixedAccount:       mixedAccount,
@@ -307,6 +351,49 @@ func (tb *TB) buy(ctx context.Context, passphrase []byte, tip *wire.BlockHeader,
 	return err
 }
 
+// deadlineQuota returns the number of tickets to purchase in the next block
+// to remain on schedule for holding cfg.TargetTickets live tickets by
+// cfg.TargetHeight.  Purchases are scheduled to complete within the first half
+// of the remaining purchase window, so that missed or failed purchases may
+// still be made up before the deadline.  The boolean return is false when new
+// purchases can no longer mature before the deadline.
+func (tb *TB) deadlineQuota(ctx context.Context, height int32, cfg *Config) (int, bool, error) {
+	w := tb.wallet
+	params := w.ChainParams()
+
+	// A ticket mined at height m is live at heights greater than
+	// m+TicketMaturity.  The earliest a ticket purchased now may be mined is
+	// the next block (or the block after with a mixed split transaction).
+	firstMined := height + 1
+	if cfg.Mixing {
+		firstMined++
+	}
+	lastMined := cfg.TargetHeight - int32(params.TicketMaturity) - 1
+	blocksLeft := lastMined - firstMined + 1
+	if blocksLeft <= 0 {
+		return 0, false, nil
+	}
+
+	info, err := w.StakeInfo(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+	held := int(info.OwnMempoolTix+info.Immature+info.Unspent) -
+		int(info.UnspentExpired)
+	need := cfg.TargetTickets - held
+	if need <= 0 {
+		return 0, true, nil
+	}
+
+	schedule := (blocksLeft + 1) / 2
+	quota := (need + int(schedule) - 1) / int(schedule)
+	quota = min(quota, int(params.MaxFreshStakePerBlock))
+	log.Debugf("Deadline purchasing: %d of %d target tickets held, %d "+
+		"purchase blocks remaining, buying up to %d", held,
+		cfg.TargetTickets, blocksLeft, quota)
+	return quota, true, nil
+}
+
 // AccessConfig runs f with the current config passed as a parameter.  The
 // config is protected by a mutex and this function is safe for concurrent
 // access to read or modify the config.  It is unsafe to leak a pointer to the
@@ -0,0 +1,75 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/hdkeychain/v3"
+)
+
+// maxActivityGapLimit limits the expansion of account address gap limits by
+// recent address activity.  Configured gap limits above this are never reduced.
+const maxActivityGapLimit = 10000
+
+// activityGapLimit returns the address gap limit of an account with the
+// recorded address activity.  The gap is expanded from base to twice the most
+// addresses issued or used by the account in any one hour of the activity
+// window, so that busy accounts may hand out and receive payments to many
+// addresses between syncs without deposits falling outside of the watched and
+// discovered address range.
+func activityGapLimit(a *udb.AccountActivity, base uint32) uint32 {
+	peak := uint64(max(a.PeakIssued, a.PeakUsed))
+	gap := max(uint64(base), min(2*peak, maxActivityGapLimit))
+	return uint32(min(gap, hdkeychain.HardenedKeyStart-1))
+}
+
+// accountAddrGapLimit returns the address gap limit of an account expanded
+// from base by its recent address activity.
+func (w *Wallet) accountAddrGapLimit(dbtx walletdb.ReadTx, account, base uint32) (uint32, error) {
+	a, err := udb.AccountActivityFor(dbtx, account, time.Now())
+	if err != nil {
+		return 0, err
+	}
+	return activityGapLimit(a, base), nil
+}
+
+// AccountActivity returns the rates at which addresses of an account have
+// been issued and used over the last udb.ActivityWindow.
+func (w *Wallet) AccountActivity(ctx context.Context, account uint32) (*udb.AccountActivity, error) {
+	const op errors.Op = "wallet.AccountActivity"
+	var a *udb.AccountActivity
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		a, err = udb.AccountActivityFor(dbtx, account, time.Now())
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return a, nil
+}
+
+// AccountAddressGapLimit returns the address gap limit used when watching
+// for and discovering the addresses of an account.  This is the wallet's gap
+// limit, expanded for accounts which have recently issued or used many
+// addresses.
+func (w *Wallet) AccountAddressGapLimit(ctx context.Context, account uint32) (uint32, error) {
+	const op errors.Op = "wallet.AccountAddressGapLimit"
+	var gap uint32
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		gap, err = w.accountAddrGapLimit(dbtx, account, w.gapLimit)
+		return err
+	})
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return gap, nil
+}
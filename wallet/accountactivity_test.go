@@ -0,0 +1,176 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestAccountActivityGapLimit(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	gap, err := w.AccountAddressGapLimit(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gap != w.GapLimit() {
+		t.Fatalf("idle account gap limit %d, want %d", gap, w.GapLimit())
+	}
+
+	const issued = 30
+	for i := 0; i < issued; i++ {
+		addr, err := w.NewExternalAddress(ctx, 0, WithGapPolicyIgnore())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i%3 != 0 {
+			continue
+		}
+		err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+			ma, err := w.manager.Address(dbtx.ReadBucket(waddrmgrNamespaceKey), addr)
+			if err != nil {
+				return err
+			}
+			return w.markUsedAddresses("", dbtx, []udb.ManagedAddress{ma}, nil)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	a, err := w.AccountActivity(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Issued != issued || a.PeakIssued != issued {
+		t.Errorf("issued %d (peak %d), want %d", a.Issued, a.PeakIssued, issued)
+	}
+	// Marking every third address used advances the last used index to 27.
+	if a.Used != 28 {
+		t.Errorf("used %d, want 28", a.Used)
+	}
+
+	gap, err = w.AccountAddressGapLimit(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gap != 2*issued {
+		t.Fatalf("active account gap limit %d, want %d", gap, 2*issued)
+	}
+
+	// Other accounts are unaffected.
+	a, err = w.AccountActivity(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Issued != 0 || a.Used != 0 {
+		t.Errorf("unused account activity %+v", a)
+	}
+}
+
+func TestMarkUsedAddressesBatch(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	var external, internal []stdaddr.Address
+	for i := 0; i < 11; i++ {
+		a, err := w.NewExternalAddress(ctx, 0, WithGapPolicyIgnore())
+		if err != nil {
+			t.Fatal(err)
+		}
+		external = append(external, a)
+	}
+	for i := 0; i < 6; i++ {
+		a, err := w.NewInternalAddress(ctx, 0, WithGapPolicyIgnore())
+		if err != nil {
+			t.Fatal(err)
+		}
+		internal = append(internal, a)
+	}
+
+	// Addresses of both branches used by a block are marked at once.
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		var used []udb.ManagedAddress
+		for _, a := range []stdaddr.Address{external[10], internal[5], external[3]} {
+			ma, err := w.manager.Address(ns, a)
+			if err != nil {
+				return err
+			}
+			used = append(used, ma)
+		}
+		return w.markUsedAddresses("", dbtx, used, nil)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var props *udb.AccountProperties
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		props, err = w.manager.AccountProperties(dbtx.ReadBucket(waddrmgrNamespaceKey), 0)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if props.LastUsedExternalIndex != 10 || props.LastUsedInternalIndex != 5 {
+		t.Fatalf("last used indexes %d/%d, want 10/5",
+			props.LastUsedExternalIndex, props.LastUsedInternalIndex)
+	}
+	a, err := w.AccountActivity(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Used != 17 {
+		t.Errorf("used %d, want 17", a.Used)
+	}
+}
+
+func TestAccountActivityBlockTime(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	addr, err := w.NewExternalAddress(ctx, 0, WithGapPolicyIgnore())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Usage by a block mined outside of the activity window, such as one
+	// found by a rescan, is recorded at the block time and is not counted
+	// as recent activity.
+	header := &wire.BlockHeader{
+		Height:    1,
+		Timestamp: time.Now().Add(-2 * udb.ActivityWindow),
+	}
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ma, err := w.manager.Address(dbtx.ReadBucket(waddrmgrNamespaceKey), addr)
+		if err != nil {
+			return err
+		}
+		return w.markUsedAddresses("", dbtx, []udb.ManagedAddress{ma}, header)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := w.AccountActivity(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Used != 0 {
+		t.Errorf("used %d, want 0", a.Used)
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/hdkeychain/v3"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+)
+
+// addressesBatchSize is the number of imported addresses read from the
+// database in each transaction by Addresses, and the number of HD addresses
+// derived between checks for cancellation.
+const addressesBatchSize = 1000
+
+// AddressFilter selects the addresses of an account returned by Addresses.
+type AddressFilter struct {
+	Account uint32
+
+	// Branch, when non-nil, selects only the HD addresses of the external
+	// (0) or internal (1) branch.
+	Branch *uint32
+
+	// Used, when non-nil, selects only used or unused addresses.  HD
+	// addresses are used when their child index is at or below the last
+	// used index of their branch, and imported addresses are used when
+	// they have received mined outputs.
+	Used *bool
+
+	// StartIndex and EndIndex select the HD addresses with child indexes in
+	// the inclusive range.  A zero EndIndex selects addresses through the
+	// last returned child.
+	StartIndex uint32
+	EndIndex   uint32
+}
+
+// selectsImported returns whether the filter selects imported addresses.
+// Imported addresses have no branch or child index, and are only selected
+// when neither are filtered.
+func (f *AddressFilter) selectsImported() bool {
+	return f.Branch == nil && f.StartIndex == 0 && f.EndIndex == 0
+}
+
+// AccountAddress describes an address returned by Addresses.
+type AccountAddress struct {
+	Address  stdaddr.Address
+	Account  uint32
+	Branch   uint32
+	Index    uint32
+	Imported bool
+	Used     bool
+}
+
+// Addresses calls fn with each address of an account selected by the filter.
+// The HD addresses of HD accounts are visited through the last returned child
+// of each branch, in order of branch and child index.  Only the imported
+// account records imported addresses, which are visited without reading the
+// address index of HD accounts.
+//
+// Addresses are read in batches using many short database transactions, and
+// fn is never called during a database transaction, allowing very large
+// numbers of addresses to be visited without holding them in memory.
+// Iteration stops early with the error returned by fn.
+func (w *Wallet) Addresses(ctx context.Context, f *AddressFilter, fn func(*AccountAddress) error) error {
+	const op errors.Op = "wallet.Addresses"
+	if f.Branch != nil && *f.Branch != udb.ExternalBranch && *f.Branch != udb.InternalBranch {
+		return errors.E(op, errors.Invalid, errors.Errorf("invalid branch %d", *f.Branch))
+	}
+	if f.EndIndex != 0 && f.EndIndex < f.StartIndex {
+		return errors.E(op, errors.Invalid, "end index is below start index")
+	}
+
+	var props *udb.AccountProperties
+	var branchXpubs [2]*hdkeychain.ExtendedKey
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		var err error
+		props, err = w.manager.AccountProperties(addrmgrNs, f.Account)
+		if err != nil {
+			return err
+		}
+		if f.Account == udb.ImportedAddrAccount {
+			return nil
+		}
+		for branch := range branchXpubs {
+			branchXpubs[branch], err = w.manager.AccountBranchExtendedPubKey(
+				dbtx, f.Account, uint32(branch))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	if f.Account != udb.ImportedAddrAccount {
+		// HD accounts have no imported addresses, so the address index,
+		// which records every returned HD address, is not read.
+		lastReturned := [2]uint32{props.LastReturnedExternalIndex, props.LastReturnedInternalIndex}
+		lastUsed := [2]uint32{props.LastUsedExternalIndex, props.LastUsedInternalIndex}
+		for branch := uint32(0); branch < 2; branch++ {
+			if f.Branch != nil && *f.Branch != branch {
+				continue
+			}
+			err := w.branchAddresses(ctx, f, branchXpubs[branch], branch,
+				lastReturned[branch], lastUsed[branch], fn)
+			if err != nil {
+				return errors.E(op, err)
+			}
+		}
+		return nil
+	}
+
+	if !f.selectsImported() {
+		return nil
+	}
+	var page []AccountAddress
+	var cursor []byte
+	for {
+		if err := ctx.Err(); err != nil {
+			return errors.E(op, err)
+		}
+		page = page[:0]
+		err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+			addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+			var err error
+			cursor, err = w.manager.ForEachAccountAddressPage(addrmgrNs, f.Account,
+				cursor, addressesBatchSize, func(a udb.ManagedAddress) error {
+					if !a.Imported() {
+						return nil
+					}
+					used := w.txStore.ReceivedByAddress(dbtx, a.Address()) > 0
+					if f.Used != nil && *f.Used != used {
+						return nil
+					}
+					page = append(page, AccountAddress{
+						Address:  a.Address(),
+						Account:  f.Account,
+						Imported: true,
+						Used:     used,
+					})
+					return nil
+				})
+			return err
+		})
+		if err != nil {
+			return errors.E(op, err)
+		}
+		for i := range page {
+			if err := fn(&page[i]); err != nil {
+				return errors.E(op, err)
+			}
+		}
+		if cursor == nil {
+			return nil
+		}
+	}
+}
+
+// branchAddresses calls fn with the addresses of an account branch selected by
+// the filter, through the last returned child.
+func (w *Wallet) branchAddresses(ctx context.Context, f *AddressFilter, xpub *hdkeychain.ExtendedKey,
+	branch, lastReturned, lastUsed uint32, fn func(*AccountAddress) error) error {
+
+	// No addresses have been returned when the last returned index is
+	// ^uint32(0).
+	if lastReturned == ^uint32(0) || f.StartIndex > lastReturned {
+		return nil
+	}
+	end := lastReturned
+	if f.EndIndex != 0 {
+		end = min(end, f.EndIndex)
+	}
+	for child := f.StartIndex; child <= end; child++ {
+		if (child-f.StartIndex)%addressesBatchSize == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+		used := lastUsed != ^uint32(0) && child <= lastUsed
+		if f.Used != nil && *f.Used != used {
+			continue
+		}
+		addr, err := deriveChildAddress(xpub, child, w.chainParams)
+		if errors.Is(err, hdkeychain.ErrInvalidChild) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		err = fn(&AccountAddress{
+			Address: addr,
+			Account: f.Account,
+			Branch:  branch,
+			Index:   child,
+			Used:    used,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
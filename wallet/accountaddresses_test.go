@@ -0,0 +1,123 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet_test
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/wallettest"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+)
+
+func TestAddresses(t *testing.T) {
+	ctx := context.Background()
+	h := wallettest.New(t, nil)
+	w := h.Wallet
+
+	var external []stdaddr.Address
+	for i := 0; i < 5; i++ {
+		addr, err := w.NewExternalAddress(ctx, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		external = append(external, addr)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := w.NewInternalAddress(ctx, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Mark the second external address used.
+	if _, err := h.Chain.MineBlock(ctx, h.Chain.FundingTx(external[1], 1e8)); err != nil {
+		t.Fatal(err)
+	}
+
+	list := func(f *wallet.AddressFilter) []*wallet.AccountAddress {
+		t.Helper()
+		var addrs []*wallet.AccountAddress
+		err := w.Addresses(ctx, f, func(a *wallet.AccountAddress) error {
+			addrs = append(addrs, a)
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return addrs
+	}
+	branch := func(b uint32) *uint32 { return &b }
+	used := func(u bool) *bool { return &u }
+
+	all := list(&wallet.AddressFilter{Account: 0})
+	if len(all) != 7 {
+		t.Fatalf("listed %d addresses, want 7", len(all))
+	}
+	for i, a := range all[:5] {
+		if a.Branch != udb.ExternalBranch || a.Index != uint32(i) ||
+			a.Address.String() != external[i].String() {
+			t.Errorf("address %d is %v (branch %d index %d), want %v",
+				i, a.Address, a.Branch, a.Index, external[i])
+		}
+	}
+
+	internal := list(&wallet.AddressFilter{Account: 0, Branch: branch(udb.InternalBranch)})
+	if len(internal) != 2 || internal[0].Branch != udb.InternalBranch {
+		t.Errorf("listed %d internal addresses, want 2", len(internal))
+	}
+
+	ranged := list(&wallet.AddressFilter{Account: 0, Branch: branch(udb.ExternalBranch),
+		StartIndex: 1, EndIndex: 3})
+	if len(ranged) != 3 || ranged[0].Index != 1 || ranged[2].Index != 3 {
+		t.Errorf("listed %d addresses in index range, want 3", len(ranged))
+	}
+
+	usedAddrs := list(&wallet.AddressFilter{Account: 0, Branch: branch(udb.ExternalBranch),
+		Used: used(true)})
+	if len(usedAddrs) != 2 || !usedAddrs[1].Used {
+		t.Errorf("listed %d used external addresses, want 2", len(usedAddrs))
+	}
+	unused := list(&wallet.AddressFilter{Account: 0, Branch: branch(udb.ExternalBranch),
+		Used: used(false)})
+	if len(unused) != 3 || unused[0].Index != 2 {
+		t.Errorf("listed %d unused external addresses, want 3", len(unused))
+	}
+
+	// Imported addresses are only listed for the imported account.
+	if err := w.Unlock(ctx, wallettest.PrivatePassphrase, nil); err != nil {
+		t.Fatal(err)
+	}
+	priv, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wif, err := dcrutil.NewWIF(priv.Serialize(), w.ChainParams().PrivateKeyID,
+		dcrec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := w.ImportPrivateKey(ctx, wif, wallet.NoRescan); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(list(&wallet.AddressFilter{Account: 0})); n != 7 {
+		t.Errorf("listed %d addresses of HD account after import, want 7", n)
+	}
+	imported := list(&wallet.AddressFilter{Account: udb.ImportedAddrAccount})
+	if len(imported) != 1 || !imported[0].Imported {
+		t.Errorf("listed %d imported addresses, want 1", len(imported))
+	}
+
+	err = w.Addresses(ctx, &wallet.AddressFilter{Account: 0, StartIndex: 3, EndIndex: 2},
+		func(*wallet.AccountAddress) error { return nil })
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("inverted index range returned %v, want Invalid", err)
+	}
+}
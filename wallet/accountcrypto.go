@@ -0,0 +1,30 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+// AccountCryptoParams returns the encryption scheme and KDF parameters
+// protecting the private keys of an account, and when the passphrase
+// protecting them was last changed.  The wallet may be locked.
+func (w *Wallet) AccountCryptoParams(ctx context.Context, account uint32) (*udb.AccountCryptoParams, error) {
+	const op errors.Op = "wallet.AccountCryptoParams"
+	var params *udb.AccountCryptoParams
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		params, err = w.manager.AccountCryptoParams(dbtx, account)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return params, nil
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/wallet/udb"
+)
+
+func TestAccountCryptoParams(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	p, err := w.AccountCryptoParams(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Encryption != udb.AccountEncryptionGlobal || p.Scrypt == nil ||
+		p.Scrypt.N == 0 || p.Argon2id != nil {
+		t.Fatalf("unexpected default account params %+v", p)
+	}
+	if p.PassphraseChanged.IsZero() {
+		t.Errorf("wallet passphrase creation time not recorded")
+	}
+
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	const account = 0
+	if err := w.SetAccountPassphrase(ctx, account, []byte("account")); err != nil {
+		t.Fatal(err)
+	}
+	w.Lock()
+	p, err = w.AccountCryptoParams(ctx, account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Encryption != udb.AccountEncryptionUnique || p.Argon2id == nil ||
+		p.Argon2id.Time == 0 || p.Scrypt != nil {
+		t.Fatalf("unexpected unique account params %+v", p)
+	}
+	if p.PassphraseChanged.IsZero() {
+		t.Errorf("account passphrase change time not recorded")
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"github.com/decred/dcrd/chaincfg/chainhash"
+)
+
+// AccountFilter filters notifications to those relevant to a set of accounts,
+// allowing notification clients which are only trusted with some accounts to
+// subscribe without learning about the transactions and balances of others.
+//
+// Filters are stateful: the hashes of unmined transactions are only included
+// in filtered transaction notifications when the transaction was previously
+// included in a notification passed through the same filter.  Each
+// notification subscription must use its own filter.
+type AccountFilter struct {
+	accounts map[uint32]struct{}
+	unmined  map[chainhash.Hash]struct{}
+}
+
+// NewAccountFilter returns a filter of notifications to those relevant to the
+// accounts.
+func NewAccountFilter(accounts ...uint32) *AccountFilter {
+	f := &AccountFilter{
+		accounts: make(map[uint32]struct{}, len(accounts)),
+		unmined:  make(map[chainhash.Hash]struct{}),
+	}
+	for _, account := range accounts {
+		f.accounts[account] = struct{}{}
+	}
+	return f
+}
+
+// Includes returns whether the account passes the filter.
+func (f *AccountFilter) Includes(account uint32) bool {
+	_, ok := f.accounts[account]
+	return ok
+}
+
+// filterTx returns the transaction summary with only the debits and credits of
+// filtered accounts, and whether any remained.
+func (f *AccountFilter) filterTx(tx *TransactionSummary) (TransactionSummary, bool) {
+	filtered := *tx
+	filtered.MyInputs = nil
+	filtered.MyOutputs = nil
+	for _, in := range tx.MyInputs {
+		if f.Includes(in.PreviousAccount) {
+			filtered.MyInputs = append(filtered.MyInputs, in)
+		}
+	}
+	for _, out := range tx.MyOutputs {
+		if f.Includes(out.Account) {
+			filtered.MyOutputs = append(filtered.MyOutputs, out)
+		}
+	}
+	return filtered, len(filtered.MyInputs) != 0 || len(filtered.MyOutputs) != 0
+}
+
+func (f *AccountFilter) filterTxs(txs []TransactionSummary) []TransactionSummary {
+	var filtered []TransactionSummary
+	for i := range txs {
+		if tx, ok := f.filterTx(&txs[i]); ok {
+			filtered = append(filtered, tx)
+		}
+	}
+	return filtered
+}
+
+// FilterTransactionNotifications returns a copy of the notification including
+// only transactions which debit or credit the filtered accounts, with the
+// debits and credits of other accounts removed, and only the balances of the
+// filtered accounts.  Attached and detached blocks are always included, as
+// they describe the chain rather than the wallet.
+func (f *AccountFilter) FilterTransactionNotifications(n *TransactionNotifications) *TransactionNotifications {
+	filtered := &TransactionNotifications{
+		AttachedBlocks:      make([]Block, 0, len(n.AttachedBlocks)),
+		DetachedBlocks:      n.DetachedBlocks,
+		UnminedTransactions: f.filterTxs(n.UnminedTransactions),
+		ExpiredTransactions: f.filterTxs(n.ExpiredTransactions),
+	}
+	for _, b := range n.AttachedBlocks {
+		filtered.AttachedBlocks = append(filtered.AttachedBlocks, Block{
+			Header:       b.Header,
+			Transactions: f.filterTxs(b.Transactions),
+		})
+	}
+	for i := range filtered.UnminedTransactions {
+		f.unmined[*filtered.UnminedTransactions[i].Hash] = struct{}{}
+	}
+
+	// Forget transactions which are no longer unmined.
+	stillUnmined := make(map[chainhash.Hash]struct{}, len(n.UnminedTransactionHashes))
+	for _, h := range n.UnminedTransactionHashes {
+		if _, ok := f.unmined[*h]; ok {
+			filtered.UnminedTransactionHashes = append(filtered.UnminedTransactionHashes, h)
+			stillUnmined[*h] = struct{}{}
+		}
+	}
+	f.unmined = stillUnmined
+
+	for _, b := range n.NewBalances {
+		if f.Includes(b.Account) {
+			filtered.NewBalances = append(filtered.NewBalances, b)
+		}
+	}
+	return filtered
+}
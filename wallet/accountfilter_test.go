@@ -0,0 +1,73 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestAccountFilter(t *testing.T) {
+	hashes := []*chainhash.Hash{{1}, {2}, {3}}
+	mixed := TransactionSummary{
+		Hash: hashes[0],
+		MyInputs: []TransactionSummaryInput{
+			{Index: 0, PreviousAccount: 1},
+			{Index: 1, PreviousAccount: 2},
+		},
+		MyOutputs: []TransactionSummaryOutput{{Index: 0, Account: 2}},
+	}
+	other := TransactionSummary{
+		Hash:      hashes[1],
+		MyOutputs: []TransactionSummaryOutput{{Index: 0, Account: 2}},
+	}
+	n := &TransactionNotifications{
+		UnminedTransactions:      []TransactionSummary{mixed, other},
+		UnminedTransactionHashes: hashes,
+		NewBalances:              []AccountBalance{{Account: 1}, {Account: 2}},
+	}
+
+	f := NewAccountFilter(1)
+	filtered := f.FilterTransactionNotifications(n)
+	if len(filtered.UnminedTransactions) != 1 {
+		t.Fatalf("filtered %d unmined transactions, want 1",
+			len(filtered.UnminedTransactions))
+	}
+	tx := &filtered.UnminedTransactions[0]
+	if *tx.Hash != *hashes[0] || len(tx.MyInputs) != 1 ||
+		tx.MyInputs[0].PreviousAccount != 1 || len(tx.MyOutputs) != 0 {
+		t.Errorf("unexpected filtered transaction %+v", tx)
+	}
+	if len(n.UnminedTransactions[0].MyInputs) != 2 {
+		t.Errorf("filter modified the original notification")
+	}
+	if len(filtered.UnminedTransactionHashes) != 1 ||
+		*filtered.UnminedTransactionHashes[0] != *hashes[0] {
+		t.Errorf("unexpected unmined hashes %v", filtered.UnminedTransactionHashes)
+	}
+	if len(filtered.NewBalances) != 1 || filtered.NewBalances[0].Account != 1 {
+		t.Errorf("unexpected balances %v", filtered.NewBalances)
+	}
+
+	// Once mined, the transaction is reported in its block and its hash is
+	// no longer unmined.
+	n = &TransactionNotifications{
+		AttachedBlocks: []Block{{
+			Header:       &wire.BlockHeader{},
+			Transactions: []TransactionSummary{mixed, other},
+		}},
+		UnminedTransactionHashes: hashes[1:],
+	}
+	filtered = f.FilterTransactionNotifications(n)
+	if len(filtered.AttachedBlocks) != 1 ||
+		len(filtered.AttachedBlocks[0].Transactions) != 1 {
+		t.Fatalf("unexpected attached blocks %+v", filtered.AttachedBlocks)
+	}
+	if len(filtered.UnminedTransactionHashes) != 0 {
+		t.Errorf("unexpected unmined hashes %v", filtered.UnminedTransactionHashes)
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+// AccountUUID returns the immutable UUID of an account.  External systems
+// should prefer identifying accounts by UUID, as account names may be changed.
+func (w *Wallet) AccountUUID(ctx context.Context, account uint32) (udb.AccountUUID, error) {
+	const op errors.Op = "wallet.AccountUUID"
+	var u udb.AccountUUID
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		if _, err := w.manager.AccountName(addrmgrNs, account); err != nil {
+			return err
+		}
+		var err error
+		u, err = w.manager.AccountUUID(addrmgrNs, account)
+		return err
+	})
+	if err != nil {
+		return u, errors.E(op, err)
+	}
+	return u, nil
+}
+
+// AccountByUUID returns the account number of the account with a UUID.
+func (w *Wallet) AccountByUUID(ctx context.Context, u udb.AccountUUID) (uint32, error) {
+	const op errors.Op = "wallet.AccountByUUID"
+	var account uint32
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		var err error
+		account, err = w.manager.LookupAccountUUID(addrmgrNs, u)
+		return err
+	})
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return account, nil
+}
+
+// AccountBlindedID returns the blinded ID of an account, which identifies the
+// account's extended public key to external services without revealing it.
+func (w *Wallet) AccountBlindedID(ctx context.Context, account uint32) (udb.AccountBlindedID, error) {
+	const op errors.Op = "wallet.AccountBlindedID"
+	var id udb.AccountBlindedID
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		if _, err := w.manager.AccountName(addrmgrNs, account); err != nil {
+			return err
+		}
+		var err error
+		id, err = w.manager.AccountBlindedID(addrmgrNs, account)
+		return err
+	})
+	if err != nil {
+		return id, errors.E(op, err)
+	}
+	return id, nil
+}
+
+// AccountByBlindedID returns the account number of the account with a blinded
+// ID.
+func (w *Wallet) AccountByBlindedID(ctx context.Context, id udb.AccountBlindedID) (uint32, error) {
+	const op errors.Op = "wallet.AccountByBlindedID"
+	var account uint32
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		var err error
+		account, err = w.manager.LookupAccountBlindedID(addrmgrNs, id)
+		return err
+	})
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return account, nil
+}
+
+// AccountRenames returns every rename of an account, oldest first.
+func (w *Wallet) AccountRenames(ctx context.Context, account uint32) ([]udb.AccountRename, error) {
+	const op errors.Op = "wallet.AccountRenames"
+	var renames []udb.AccountRename
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		if _, err := w.manager.AccountName(addrmgrNs, account); err != nil {
+			return err
+		}
+		var err error
+		renames, err = w.manager.AccountRenames(addrmgrNs, account)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return renames, nil
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"github.com/decred/dcrd/crypto/blake256"
+	"github.com/decred/dcrd/hdkeychain/v3"
+)
+
+func TestAccountUUIDs(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	cfg.AccountGapLimit = 2
+	w, teardown := testWallet(ctx, t, &cfg, nil)
+	defer teardown()
+
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	account, err := w.NextAccount(ctx, "savings")
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := w.AccountUUID(ctx, account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defaultID, err := w.AccountUUID(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id == (udb.AccountUUID{}) || id == defaultID {
+		t.Fatalf("account UUIDs %v and %v not unique", id, defaultID)
+	}
+	if _, err := w.AccountUUID(ctx, 1000); !errors.Is(err, errors.NotExist) {
+		t.Errorf("UUID of missing account: %v", err)
+	}
+
+	parsed, err := udb.ParseAccountUUID(id.String())
+	if err != nil || parsed != id {
+		t.Fatalf("UUID %v did not round trip: %v %v", id, parsed, err)
+	}
+	if _, err := udb.ParseAccountUUID(id.String()[1:]); !errors.Is(err, errors.Encoding) {
+		t.Errorf("malformed UUID parsed: %v", err)
+	}
+
+	for _, name := range []string{"cold", "vault"} {
+		if err := w.RenameAccount(ctx, account, name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	after, err := w.AccountUUID(ctx, account)
+	if err != nil || after != id {
+		t.Fatalf("UUID changed after rename: %v %v", after, err)
+	}
+	found, err := w.AccountByUUID(ctx, id)
+	if err != nil || found != account {
+		t.Fatalf("AccountByUUID returned %d %v", found, err)
+	}
+	renames, err := w.AccountRenames(ctx, account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(renames) != 2 ||
+		renames[0].OldName != "savings" || renames[0].NewName != "cold" ||
+		renames[1].OldName != "cold" || renames[1].NewName != "vault" {
+		t.Errorf("unexpected rename history %+v", renames)
+	}
+
+	res, err := w.Accounts(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, a := range res.Accounts {
+		if a.AccountUUID == (udb.AccountUUID{}) {
+			t.Errorf("account %d has no UUID", a.AccountNumber)
+		}
+	}
+}
+
+func TestAccountBlindedIDs(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	cfg.AccountGapLimit = 2
+	w, teardown := testWallet(ctx, t, &cfg, nil)
+	defer teardown()
+
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	account, err := w.NextAccount(ctx, "savings")
+	if err != nil {
+		t.Fatal(err)
+	}
+	xpub, err := w.AccountXpub(ctx, account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	master, err := hdkeychain.NewMaster(bytes.Repeat([]byte{1}, 32), w.ChainParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.ImportXpubAccount(ctx, "watched", master.Neuter()); err != nil {
+		t.Fatal(err)
+	}
+	watched, err := w.AccountNumber(ctx, "watched")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ids := make(map[udb.AccountBlindedID]uint32)
+	for _, acct := range []uint32{0, account, watched} {
+		id, err := w.AccountBlindedID(ctx, acct)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if id == (udb.AccountBlindedID{}) {
+			t.Fatalf("account %d has zero blinded ID", acct)
+		}
+		ids[id] = acct
+	}
+	if len(ids) != 3 {
+		t.Fatal("blinded IDs are not unique")
+	}
+	for id, acct := range ids {
+		found, err := w.AccountByBlindedID(ctx, id)
+		if err != nil || found != acct {
+			t.Fatalf("AccountByBlindedID returned %d %v, want %d", found,
+				err, acct)
+		}
+		parsed, err := udb.ParseAccountBlindedID(id.String())
+		if err != nil || parsed != id {
+			t.Fatalf("blinded ID %v did not round trip: %v %v", id, parsed, err)
+		}
+		if blake256.Sum256([]byte(xpub.String())) == id {
+			t.Fatal("blinded ID is an unkeyed hash of the xpub")
+		}
+	}
+
+	if _, err := w.AccountBlindedID(ctx, udb.ImportedAddrAccount); !errors.Is(err, errors.NotExist) {
+		t.Errorf("blinded ID of imported account: %v", err)
+	}
+	if _, err := w.AccountByBlindedID(ctx, udb.AccountBlindedID{1}); !errors.Is(err, errors.NotExist) {
+		t.Errorf("lookup of unknown blinded ID: %v", err)
+	}
+	if _, err := udb.ParseAccountBlindedID("00"); !errors.Is(err, errors.Encoding) {
+		t.Errorf("short blinded ID parsed: %v", err)
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+)
+
+// SetAccountRollover sets whether new external addresses of a BIP0044 account
+// are instead derived from a successor account once the account's next
+// external child index reaches a limit.  The limit is the smaller of limit,
+// when nonzero, and the last child index allowing the gap limit of addresses
+// to be watched before MaxAddressesPerAccount.
+//
+// The successor of an account named "name" is named "name#2", followed by
+// "name#3", and so on.  Successor accounts are created when first needed,
+// which requires the wallet to be unlocked, and are announced by account
+// notifications with the RolloverFrom field set.  The policy is held for the
+// lifetime of the loaded wallet and is not persisted.
+func (w *Wallet) SetAccountRollover(enabled bool, limit uint32) {
+	var threshold uint32
+	if enabled {
+		threshold = udb.MaxAddressesPerAccount - w.gapLimit
+		if limit != 0 && limit < threshold {
+			threshold = limit
+		}
+	}
+	w.accountRolloverLimitMu.Lock()
+	w.accountRolloverLimit = threshold
+	w.accountRolloverLimitMu.Unlock()
+}
+
+// rolloverAccountName returns the name of the account succeeding an account
+// under the account rollover policy.
+func rolloverAccountName(name string) string {
+	base, seq := name, 1
+	if i := strings.LastIndexByte(name, '#'); i != -1 {
+		n, err := strconv.Atoi(name[i+1:])
+		if err == nil && n > 1 {
+			base, seq = name[:i], n
+		}
+	}
+	return base + "#" + strconv.Itoa(seq+1)
+}
+
+// rolloverAccount returns the number and name of the account from which the
+// next external address of account is derived.  This is the account itself
+// unless the account rollover policy is enabled and the account's external
+// branch reached the rollover limit, in which case successor accounts are
+// followed, and created when necessary, until one below the limit is found.
+func (w *Wallet) rolloverAccount(ctx context.Context, op errors.Op, account uint32) (uint32, string, error) {
+	w.accountRolloverLimitMu.Lock()
+	limit := w.accountRolloverLimit
+	w.accountRolloverLimitMu.Unlock()
+
+	name, _ := w.AccountName(ctx, account)
+	if limit == 0 || account > udb.MaxAccountNum {
+		return account, name, nil
+	}
+	for {
+		extChild, _, err := w.BIP0044BranchNextIndexes(ctx, account)
+		if err != nil {
+			return 0, "", errors.E(op, err)
+		}
+		if extChild < limit {
+			return account, name, nil
+		}
+
+		nextName := rolloverAccountName(name)
+		next, err := w.AccountNumber(ctx, nextName)
+		if errors.Is(err, errors.NotExist) {
+			const nextOp errors.Op = "wallet.NextAccount"
+			from := account
+			next, err = w.nextAccount(ctx, nextOp, nextName, &from)
+			if errors.Is(err, errors.Exist) {
+				// Created concurrently by another caller.
+				continue
+			}
+			if err != nil {
+				log.Errorf("Account %q reached the address rollover "+
+					"limit and account %q could not be created: %v",
+					name, nextName, err)
+				return 0, "", errors.E(op, err)
+			}
+			log.Infof("Account %q reached the address rollover limit; "+
+				"deriving new external addresses from account %q (%d)",
+				name, nextName, next)
+		}
+		if err != nil {
+			return 0, "", errors.E(op, err)
+		}
+		account, name = next, nextName
+	}
+}
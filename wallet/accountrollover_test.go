@@ -0,0 +1,81 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRolloverAccountName(t *testing.T) {
+	tests := []struct{ name, next string }{
+		{"default", "default#2"},
+		{"default#2", "default#3"},
+		{"savings#9", "savings#10"},
+		{"tag#1", "tag#1#2"},
+		{"tag#x", "tag#x#2"},
+	}
+	for _, test := range tests {
+		if next := rolloverAccountName(test.name); next != test.next {
+			t.Errorf("rolloverAccountName(%q) = %q, want %q", test.name, next, test.next)
+		}
+	}
+}
+
+func TestAccountRollover(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	cfg.AccountGapLimit = DefaultAccountGapLimit
+	w, teardown := testWallet(ctx, t, &cfg, nil)
+	defer teardown()
+
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	ntfns := w.NtfnServer.AccountNotifications()
+	defer ntfns.Done()
+	rollovers := make(chan *AccountNotification, 1)
+	go func() {
+		for n := range ntfns.C {
+			if n.RolloverFrom != nil {
+				rollovers <- n
+			}
+		}
+	}()
+
+	w.SetAccountRollover(true, 2)
+	accounts := make([]uint32, 5)
+	for i := range accounts {
+		addr, err := w.NewExternalAddress(ctx, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		accounts[i], _, _ = addr.(BIP0044Address).Path()
+	}
+	for i, want := range []uint32{0, 0, 1, 1, 2} {
+		if accounts[i] != want {
+			t.Fatalf("addresses derived from accounts %v", accounts)
+		}
+	}
+	for _, name := range []string{"default#2", "default#3"} {
+		if _, err := w.AccountNumber(ctx, name); err != nil {
+			t.Errorf("rollover account %q: %v", name, err)
+		}
+	}
+	n := <-rollovers
+	if n.AccountName != "default#2" || *n.RolloverFrom != 0 {
+		t.Errorf("unexpected rollover notification %+v", n)
+	}
+
+	// Disabling the policy derives from the requested account again.
+	w.SetAccountRollover(false, 0)
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if account, _, child := addr.(BIP0044Address).Path(); account != 0 || child != 2 {
+		t.Errorf("address derived from account %d child %d", account, child)
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/internal/compat"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/decred/dcrd/hdkeychain/v3"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/wire"
+)
+
+// AddressAttestation is a signed statement that an address is derived by a
+// wallet's account extended key at a branch and child index.
+//
+// Fingerprint identifies the wallet.  It is the parent fingerprint of the
+// account extended key, which is the BIP0032 fingerprint of the wallet's coin
+// type key and is shared by every account derived from the wallet seed.
+// Signature is a compact signature of the attested fields created by the
+// private key of the account extended key, and is checked against the
+// claimed account xpub by VerifyAddressAttestation.
+type AddressAttestation struct {
+	Address     stdaddr.Address
+	Account     uint32
+	Branch      uint32
+	Child       uint32
+	Fingerprint uint32
+	Signature   []byte
+}
+
+// addressAttestationHash returns the hash signed by an address attestation.
+func addressAttestationHash(a *AddressAttestation) []byte {
+	var buf bytes.Buffer
+	wire.WriteVarString(&buf, 0, "Decred Address Attestation:\n")
+	wire.WriteVarString(&buf, 0, a.Address.String())
+	var fields [16]byte
+	binary.LittleEndian.PutUint32(fields[0:4], a.Account)
+	binary.LittleEndian.PutUint32(fields[4:8], a.Branch)
+	binary.LittleEndian.PutUint32(fields[8:12], a.Child)
+	binary.LittleEndian.PutUint32(fields[12:16], a.Fingerprint)
+	buf.Write(fields[:])
+	return chainhash.HashB(buf.Bytes())
+}
+
+// AttestAddress returns an attestation that an address is derived by the
+// wallet, signed by the extended key of the address' account.  The address
+// must be derived by a BIP0044 account of the wallet, and the wallet or
+// account must be unlocked.
+func (w *Wallet) AttestAddress(ctx context.Context, addr stdaddr.Address) (*AddressAttestation, error) {
+	const op errors.Op = "wallet.AttestAddress"
+	if err := w.checkVoteOnly(); err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	ka, err := w.KnownAddress(ctx, addr)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	bip0044Addr, ok := ka.(BIP0044Address)
+	if !ok || ka.AccountKind() != AccountKindBIP0044 {
+		return nil, errors.E(op, errors.Invalid,
+			errors.Errorf("address %v is not derived by a wallet account", addr))
+	}
+	account, branch, child := bip0044Addr.Path()
+
+	var xpriv *hdkeychain.ExtendedKey
+	err = walletdb.View(ctx, w.db, func(tx walletdb.ReadTx) error {
+		var err error
+		xpriv, err = w.manager.AccountExtendedPrivKey(tx, account)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	defer xpriv.Zero()
+
+	a := &AddressAttestation{
+		Address:     addr,
+		Account:     account,
+		Branch:      branch,
+		Child:       child,
+		Fingerprint: xpriv.ParentFingerprint(),
+	}
+	serializedPriv, err := xpriv.SerializedPrivKey()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	privKey := secp256k1.PrivKeyFromBytes(serializedPriv)
+	defer privKey.Zero()
+	a.Signature = ecdsa.SignCompact(privKey, addressAttestationHash(a), true)
+	return a, nil
+}
+
+// VerifyAddressAttestation verifies that an address attestation was signed by
+// the claimed account extended public key xpub, that the attested wallet
+// fingerprint is the parent fingerprint of xpub, and that the attested
+// address is derived by xpub at the attested branch and child index.
+func VerifyAddressAttestation(a *AddressAttestation, xpub *hdkeychain.ExtendedKey,
+	params stdaddr.AddressParams) error {
+
+	const op errors.Op = "wallet.VerifyAddressAttestation"
+	if a.Fingerprint != xpub.ParentFingerprint() {
+		return errors.E(op, errors.Invalid, "wallet fingerprint does not match account key")
+	}
+	pubKey, _, err := ecdsa.RecoverCompact(a.Signature, addressAttestationHash(a))
+	if err != nil {
+		return errors.E(op, errors.Invalid, err)
+	}
+	if !bytes.Equal(pubKey.SerializeCompressed(), xpub.SerializedPubKey()) {
+		return errors.E(op, errors.Invalid, "attestation is not signed by account key")
+	}
+	branchKey, err := xpub.Child(a.Branch)
+	if err != nil {
+		return errors.E(op, errors.Invalid, err)
+	}
+	childKey, err := branchKey.Child(a.Child)
+	if err != nil {
+		return errors.E(op, errors.Invalid, err)
+	}
+	derived, err := compat.HD2Address(childKey, params)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if derived.String() != a.Address.String() {
+		return errors.E(op, errors.Invalid, "address is not derived by account key at attested path")
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"github.com/decred/dcrd/hdkeychain/v3"
+)
+
+func TestAttestAddress(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.AttestAddress(ctx, addr); !errors.Is(err, errors.Locked) {
+		t.Fatalf("attested address of locked wallet: %v", err)
+	}
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	a, err := w.AttestAddress(ctx, addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Account != 0 || a.Branch != 0 {
+		t.Errorf("attested path %d/%d/%d", a.Account, a.Branch, a.Child)
+	}
+
+	xpub, err := w.AccountXpub(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := w.ChainParams()
+	if err := VerifyAddressAttestation(a, xpub, params); err != nil {
+		t.Fatalf("valid attestation: %v", err)
+	}
+
+	// Attestations with modified fields, or checked against another
+	// wallet's xpub, are invalid.
+	otherMaster, err := hdkeychain.NewMaster(bytes.Repeat([]byte{1}, 32), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherXpub := otherMaster.Neuter()
+	if err := VerifyAddressAttestation(a, otherXpub, params); !errors.Is(err, errors.Invalid) {
+		t.Errorf("attestation verified by another account: %v", err)
+	}
+	modified := []func(a *AddressAttestation){
+		func(a *AddressAttestation) { a.Child++ },
+		func(a *AddressAttestation) { a.Branch = 1 },
+		func(a *AddressAttestation) { a.Fingerprint++ },
+		func(a *AddressAttestation) { a.Signature[10] ^= 1 },
+	}
+	for i, modify := range modified {
+		m := *a
+		m.Signature = append([]byte(nil), a.Signature...)
+		modify(&m)
+		if err := VerifyAddressAttestation(&m, xpub, params); !errors.Is(err, errors.Invalid) {
+			t.Errorf("modified attestation %d verified: %v", i, err)
+		}
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"context"
 	"encoding/binary"
 	"runtime/trace"
+	"strconv"
+	"time"
 
 	"decred.org/dcrwallet/v5/errors"
 	"decred.org/dcrwallet/v5/internal/compat"
@@ -19,6 +21,7 @@ import (
 	"github.com/decred/dcrd/hdkeychain/v3"
 	"github.com/decred/dcrd/txscript/v4"
 	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/wire"
 )
 
 // AccountKind describes the purpose and type of a wallet account.
@@ -113,40 +116,15 @@ type managedAddress struct {
 	acct      string
 	acctKind  AccountKind
 	addr      udb.ManagedAddress
-	script    func() (uint16, []byte)
 	scriptLen int
 }
 
 func (m *managedAddress) String() string                  { return m.addr.Address().String() }
-func (m *managedAddress) PaymentScript() (uint16, []byte) { return m.script() }
+func (m *managedAddress) PaymentScript() (uint16, []byte) { return m.addr.PaymentScript() }
 func (m *managedAddress) ScriptLen() int                  { return m.scriptLen }
 func (m *managedAddress) AccountName() string             { return m.acct }
 func (m *managedAddress) AccountKind() AccountKind        { return m.acctKind }
 
-// Possible implementations of m.script
-func (m *managedAddress) p2pkhScript() (uint16, []byte) {
-	pkh := m.addr.(udb.ManagedPubKeyAddress).AddrHash()
-	s := []byte{
-		0:  txscript.OP_DUP,
-		1:  txscript.OP_HASH160,
-		2:  txscript.OP_DATA_20,
-		23: txscript.OP_EQUALVERIFY,
-		24: txscript.OP_CHECKSIG,
-	}
-	copy(s[3:23], pkh)
-	return 0, s
-}
-func (m *managedAddress) p2shScript() (uint16, []byte) {
-	sh := m.addr.(udb.ManagedScriptAddress).AddrHash()
-	s := []byte{
-		0:  txscript.OP_HASH160,
-		1:  txscript.OP_DATA_20,
-		22: txscript.OP_EQUAL,
-	}
-	copy(s[2:22], sh)
-	return 0, s
-}
-
 // managedP2PKHAddress implements PubKeyHashAddress for a wrapped udb.ManagedAddress.
 type managedP2PKHAddress struct {
 	managedAddress
@@ -186,7 +164,6 @@ func wrapManagedAddress(addr udb.ManagedAddress, account string, kind AccountKin
 	}
 	switch a := addr.(type) {
 	case udb.ManagedPubKeyAddress:
-		ma.script = ma.p2pkhScript
 		ma.scriptLen = 25
 
 		if kind == AccountKindImported {
@@ -207,7 +184,6 @@ func wrapManagedAddress(addr udb.ManagedAddress, account string, kind AccountKin
 			child:               a.Index(),
 		}, nil
 	case udb.ManagedScriptAddress:
-		ma.script = ma.p2shScript
 		ma.scriptLen = 23
 		return &managedP2SHAddress{ma}, nil
 	default:
@@ -500,7 +476,11 @@ func (w *Wallet) persistReturnedChild(ctx context.Context, maybeDBTX walletdb.Re
 		if err != nil {
 			return err
 		}
-		return w.manager.MarkReturnedChildIndex(maybeDBTX, account, branch, child)
+		err = w.manager.MarkReturnedChildIndex(maybeDBTX, account, branch, child)
+		if err != nil {
+			return err
+		}
+		return udb.RecordAccountActivity(maybeDBTX, account, time.Now(), 1, 0)
 	}
 }
 
@@ -534,6 +514,8 @@ func (w *Wallet) nextAddress(ctx context.Context, op errors.Op, persist persistR
 	accountName string, account, branch uint32,
 	callOpts ...NextAddressCallOption) (stdaddr.Address, error) {
 
+	defer w.timings.observe(TimingDeriveAddress, time.Now())
+
 	var opts nextAddressCallOptions // TODO: zero values for now, add to wallet config later.
 	for _, c := range callOpts {
 		c(&opts)
@@ -563,8 +545,15 @@ func (w *Wallet) nextAddress(ctx context.Context, op errors.Op, persist persistR
 		if alb.cursor >= w.gapLimit {
 			switch opts.policy {
 			case gapPolicyError:
-				return nil, errors.E(op, errors.Policy,
-					"generating next address violates the unused address gap limit policy")
+				return nil, errors.E(op, errors.Policy, errors.Detail{
+					Code: errors.ErrGapLimit,
+					Hint: "receive payments to previously returned addresses, " +
+						"or request the address with the ignore or wrap gap policy",
+					Params: map[string]string{
+						"account":  accountName,
+						"gaplimit": strconv.FormatUint(uint64(w.gapLimit), 10),
+					},
+				}, "generating next address violates the unused address gap limit policy")
 
 			case gapPolicyIgnore:
 				// Addresses beyond the last used child + gap limit are not
@@ -579,12 +568,12 @@ func (w *Wallet) nextAddress(ctx context.Context, op errors.Op, persist persistR
 				if err != nil {
 					break
 				}
-				addrs, err := deriveChildAddresses(alb.branchXpub,
+				addrs, pubKeyAddrs, err := deriveChildWatchAddresses(alb.branchXpub,
 					alb.lastUsed+1+alb.cursor, w.gapLimit, w.chainParams)
 				if err != nil {
 					return nil, errors.E(op, err)
 				}
-				err = n.LoadTxFilter(ctx, false, addrs, nil)
+				err = n.LoadTxFilter(ctx, false, append(addrs, pubKeyAddrs...), nil)
 				if err != nil {
 					return nil, err
 				}
@@ -691,34 +680,115 @@ func (w *Wallet) AddressAtIdx(ctx context.Context, account, branch,
 	return addr, nil
 }
 
-// markUsedAddress updates the database, recording that the previously looked up
-// managed address has been publicly used.  After recording this usage, new
-// addresses are derived and saved to the db.
-func (w *Wallet) markUsedAddress(op errors.Op, dbtx walletdb.ReadWriteTx, addr udb.ManagedAddress) error {
+// markUsedAddresses updates the database, recording that previously looked up
+// managed addresses have been publicly used.  The usage of all BIP0044 account
+// addresses is recorded with a single batched update, after which new
+// addresses are derived and saved to the db for each used account branch.
+// header is the header of the block mining the transactions using the
+// addresses, and is nil for unmined transactions.  Account activity and
+// payments to expiring addresses are recorded at the block timestamp and
+// height, or the current time and tip height for unmined transactions, so
+// rescans record historical usage at the time it occurred.
+func (w *Wallet) markUsedAddresses(op errors.Op, dbtx walletdb.ReadWriteTx, addrs []udb.ManagedAddress,
+	header *wire.BlockHeader) error {
+
+	if len(addrs) == 0 {
+		return nil
+	}
 	ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
-	account := addr.Account()
-	err := w.manager.MarkUsed(dbtx, addr.Address())
-	if err != nil {
-		return errors.E(op, err)
+
+	var usedTime time.Time
+	var usedHeight int32
+	if header != nil {
+		usedTime, usedHeight = header.Timestamp, int32(header.Height)
+	} else {
+		usedTime = time.Now()
+		_, usedHeight = w.txStore.MainChainTip(dbtx)
 	}
-	if account == udb.ImportedAddrAccount {
+
+	type accountBranch struct{ account, branch uint32 }
+	var uses []udb.ChildIndexUse
+	prevProps := make(map[uint32]*udb.AccountProperties)
+	branches := make(map[accountBranch]struct{})
+	for _, addr := range addrs {
+		if !addr.Internal() {
+			err := udb.MarkExpiringAddressPaid(dbtx, addr.Address().String(),
+				usedTime, usedHeight)
+			if err != nil {
+				return errors.E(op, err)
+			}
+		}
+
+		// Only the usage of addresses derived from account branches is
+		// recorded.
+		pkAddr, ok := addr.(udb.ManagedPubKeyAddress)
+		if !ok || addr.Imported() {
+			continue
+		}
+		account := addr.Account()
+		if _, ok := prevProps[account]; !ok {
+			props, err := w.manager.AccountProperties(ns, account)
+			if err != nil {
+				return errors.E(op, err)
+			}
+			prevProps[account] = props
+		}
+		branch := udb.ExternalBranch
+		if addr.Internal() {
+			branch = udb.InternalBranch
+		}
+		uses = append(uses, udb.ChildIndexUse{
+			Account: account,
+			Branch:  branch,
+			Child:   pkAddr.Index(),
+		})
+		branches[accountBranch{account, branch}] = struct{}{}
+		log.Debugf("Marked address %v used", addr.Address())
+	}
+	if len(uses) == 0 {
 		return nil
 	}
-	props, err := w.manager.AccountProperties(ns, account)
+	err := w.manager.MarkUsedChildIndexes(dbtx, uses)
 	if err != nil {
 		return errors.E(op, err)
 	}
-	lastUsed := props.LastUsedExternalIndex
-	branch := udb.ExternalBranch
-	if addr.Internal() {
-		lastUsed = props.LastUsedInternalIndex
-		branch = udb.InternalBranch
-	}
-	err = w.manager.SyncAccountToAddrIndex(ns, account,
-		min(hdkeychain.HardenedKeyStart-1, lastUsed+w.gapLimit),
-		branch)
-	if err != nil {
-		return errors.E(op, err)
+
+	for account, prev := range prevProps {
+		props, err := w.manager.AccountProperties(ns, account)
+		if err != nil {
+			return errors.E(op, err)
+		}
+
+		// Record the number of child indexes the last used indexes
+		// advanced by as the account's address usage.  Unused branches
+		// record a last used index of ^uint32(0), and the addition wraps
+		// to count from zero.
+		used := (props.LastUsedExternalIndex + 1) - (prev.LastUsedExternalIndex + 1) +
+			(props.LastUsedInternalIndex + 1) - (prev.LastUsedInternalIndex + 1)
+		err = udb.RecordAccountActivity(dbtx, account, usedTime, 0, used)
+		if err != nil {
+			return errors.E(op, err)
+		}
+
+		gapLimit, err := w.accountAddrGapLimit(dbtx, account, w.gapLimit)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		for _, branch := range []uint32{udb.ExternalBranch, udb.InternalBranch} {
+			if _, ok := branches[accountBranch{account, branch}]; !ok {
+				continue
+			}
+			lastUsed := props.LastUsedExternalIndex
+			if branch == udb.InternalBranch {
+				lastUsed = props.LastUsedInternalIndex
+			}
+			err = w.manager.SyncAccountToAddrIndex(ns, account,
+				min(hdkeychain.HardenedKeyStart-1, lastUsed+gapLimit),
+				branch)
+			if err != nil {
+				return errors.E(op, err)
+			}
+		}
 	}
 	return nil
 }
@@ -732,7 +802,10 @@ func (w *Wallet) NewExternalAddress(ctx context.Context, account uint32, callOpt
 		return nil, err
 	}
 
-	accountName, _ := w.AccountName(ctx, account)
+	account, accountName, err := w.rolloverAccount(ctx, op, account)
+	if err != nil {
+		return nil, err
+	}
 	return w.nextAddress(ctx, op, w.persistReturnedChild(ctx, nil),
 		accountName, account, udb.ExternalBranch, callOpts...)
 }
@@ -751,6 +824,46 @@ func (w *Wallet) NewInternalAddress(ctx context.Context, account uint32, callOpt
 		accountName, account, udb.InternalBranch, callOpts...)
 }
 
+// MaxPregeneratedAddresses is the maximum number of addresses returned by a
+// single call to PregenerateAddresses.
+const MaxPregeneratedAddresses = 10000
+
+// PregenerateAddresses returns the next count addresses of an account branch,
+// ignoring the gap limit.  The addresses are recorded as returned and are
+// watched for payments, so they may be handed out far ahead of observed
+// usage, such as by watching-only wallets of cold storage accounts.
+func (w *Wallet) PregenerateAddresses(ctx context.Context, account, branch, count uint32) ([]stdaddr.Address, error) {
+	const op errors.Op = "wallet.PregenerateAddresses"
+	if count == 0 || count > MaxPregeneratedAddresses {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("count must "+
+			"be between 1 and %d", MaxPregeneratedAddresses))
+	}
+
+	// Imported voting accounts must not be used for normal transactions.
+	if err := w.notVotingAcct(ctx, op, account); err != nil {
+		return nil, err
+	}
+	accountName, _ := w.AccountName(ctx, account)
+
+	addrs := make([]stdaddr.Address, 0, count)
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		persist := w.persistReturnedChild(ctx, dbtx)
+		for range count {
+			addr, err := w.nextAddress(ctx, op, persist, accountName,
+				account, branch, WithGapPolicyIgnore())
+			if err != nil {
+				return err
+			}
+			addrs = append(addrs, addr)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return addrs, nil
+}
+
 // notVotingAcct errors if an account is a special voting type. This account
 // should not be used to receive funds.
 func (w *Wallet) notVotingAcct(ctx context.Context, op errors.Op, account uint32) error {
@@ -875,12 +988,12 @@ func (w *Wallet) SyncLastReturnedAddress(ctx context.Context, account, branch, c
 			return nil
 		}
 		additionalAddrs := child - lastWatched
-		addrs, err := deriveChildAddresses(branchXpub, lastUsed+1+w.gapLimit,
-			additionalAddrs, w.chainParams)
+		addrs, pubKeyAddrs, err := deriveChildWatchAddresses(branchXpub,
+			lastUsed+1+w.gapLimit, additionalAddrs, w.chainParams)
 		if err != nil {
 			return errors.E(op, err)
 		}
-		err = n.LoadTxFilter(ctx, false, addrs, nil)
+		err = n.LoadTxFilter(ctx, false, append(addrs, pubKeyAddrs...), nil)
 		if err != nil {
 			return errors.E(op, err)
 		}
@@ -890,6 +1003,8 @@ func (w *Wallet) SyncLastReturnedAddress(ctx context.Context, account, branch, c
 }
 
 // ImportedAddresses returns each of the addresses imported into an account.
+// Besides the imported account, this includes public keys and scripts
+// imported as watched addresses of other accounts.
 func (w *Wallet) ImportedAddresses(ctx context.Context, account string) (_ []KnownAddress, err error) {
 	const opf = "wallet.ImportedAddresses(%q)"
 	defer func() {
@@ -899,14 +1014,17 @@ func (w *Wallet) ImportedAddresses(ctx context.Context, account string) (_ []Kno
 		}
 	}()
 
-	if account != "imported" {
-		return nil, errors.E("account does not record imported keys")
-	}
-
 	var addrs []KnownAddress
 	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
 		ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		acct, err := w.manager.LookupAccount(ns, account)
+		if err != nil {
+			return err
+		}
 		f := func(a udb.ManagedAddress) error {
+			if !a.Imported() {
+				return nil
+			}
 			ma, err := wrapManagedAddress(a, account, AccountKindImported)
 			if err != nil {
 				return err
@@ -914,7 +1032,7 @@ func (w *Wallet) ImportedAddresses(ctx context.Context, account string) (_ []Kno
 			addrs = append(addrs, ma)
 			return nil
 		}
-		return w.manager.ForEachAccountAddress(ns, udb.ImportedAddrAccount, f)
+		return w.manager.ForEachAccountAddress(ns, acct, f)
 	})
 	return addrs, err
 }
@@ -996,6 +1114,54 @@ func deriveChildAddresses(key *hdkeychain.ExtendedKey, startIndex, count uint32,
 	return addresses, nil
 }
 
+// deriveChildWatchAddresses derives the P2PKH addresses of count child keys
+// beginning at startIndex, and the P2PK addresses paying directly to the
+// compressed public keys of the same children.  Some legacy payouts pay to the
+// bare public key, and these outputs are only matched by compact filters when
+// the P2PK script is watched as well.  The returned slices are the same length
+// and index the same children.
+func deriveChildWatchAddresses(key *hdkeychain.ExtendedKey, startIndex, count uint32,
+	params *chaincfg.Params) (addrs, pubKeyAddrs []stdaddr.Address, err error) {
+
+	addrs = make([]stdaddr.Address, 0, count)
+	pubKeyAddrs = make([]stdaddr.Address, 0, count)
+	for i := uint32(0); i < count; i++ {
+		child, err := key.Child(startIndex + i)
+		if errors.Is(err, hdkeychain.ErrInvalidChild) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		addr, err := compat.HD2Address(child, params)
+		if err != nil {
+			return nil, nil, err
+		}
+		pubKeyAddr, err := stdaddr.NewAddressPubKeyEcdsaSecp256k1V0Raw(
+			child.SerializedPubKey(), params)
+		if err != nil {
+			return nil, nil, err
+		}
+		addrs = append(addrs, addr)
+		pubKeyAddrs = append(pubKeyAddrs, pubKeyAddr)
+	}
+	return addrs, pubKeyAddrs, nil
+}
+
+// pubKeyWatchAddress returns the P2PK address of an imported public key
+// address, or nil if the address does not have a public key.
+func pubKeyWatchAddress(a udb.ManagedAddress, params *chaincfg.Params) stdaddr.Address {
+	pka, ok := a.(udb.ManagedPubKeyAddress)
+	if !ok {
+		return nil
+	}
+	addr, err := stdaddr.NewAddressPubKeyEcdsaSecp256k1V0Raw(pka.PubKey(), params)
+	if err != nil {
+		return nil
+	}
+	return addr
+}
+
 func deriveChildAddress(key *hdkeychain.ExtendedKey, child uint32, params *chaincfg.Params) (stdaddr.Address, error) {
 	childKey, err := key.Child(child)
 	if err != nil {
@@ -10,6 +10,8 @@ import (
 	"encoding/hex"
 	"testing"
 
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
 	"decred.org/dcrwallet/v5/wallet/walletdb"
 	"github.com/decred/dcrd/txscript/v4/stdaddr"
 )
@@ -299,7 +301,7 @@ func useAddress(child uint32) func(ctx context.Context, t *testing.T, w *Wallet)
 			if err != nil {
 				return err
 			}
-			return w.markUsedAddress("", dbtx, ma)
+			return w.markUsedAddresses("", dbtx, []udb.ManagedAddress{ma}, nil)
 		})
 		if err != nil {
 			t.Fatal(err)
@@ -307,3 +309,128 @@ func useAddress(child uint32) func(ctx context.Context, t *testing.T, w *Wallet)
 		watchFutureAddresses(ctx, t, w)
 	}
 }
+
+func TestScriptIndex(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stakeAddr := addr.(stdaddr.StakeAddress)
+	redeemScript := []byte{0x51} // OP_TRUE
+	if _, err := w.ImportScript(ctx, redeemScript, NoRescan); err != nil {
+		t.Fatal(err)
+	}
+	p2sh, err := stdaddr.NewAddressScriptHashV0(redeemScript, w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(make([]byte, 20), w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type script func() (uint16, []byte)
+	tests := []struct {
+		name    string
+		script  script
+		account uint32
+		exists  bool
+	}{
+		{"p2pkh", addr.PaymentScript, 0, true},
+		{"voting rights", stakeAddr.VotingRightsScript, 0, true},
+		{"stake change", stakeAddr.StakeChangeScript, 0, true},
+		{"p2sh", p2sh.PaymentScript, udb.ImportedAddrAccount, true},
+		{"p2sh vote", p2sh.PayVoteCommitmentScript, udb.ImportedAddrAccount, true},
+		{"foreign", other.PaymentScript, 0, false},
+		{"unindexed", func() (uint16, []byte) { return 0, redeemScript }, 0, false},
+	}
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		for _, test := range tests {
+			version, pkScript := test.script()
+			exists := w.manager.ExistsScript(ns, version, pkScript)
+			if exists != test.exists {
+				t.Errorf("%s: exists %v, want %v", test.name, exists, test.exists)
+			}
+			account, err := w.manager.ScriptAccount(ns, version, pkScript)
+			switch {
+			case !test.exists && !errors.Is(err, errors.NotExist):
+				t.Errorf("%s: expected NotExist error, got %v", test.name, err)
+			case test.exists && err != nil:
+				t.Errorf("%s: %v", test.name, err)
+			case test.exists && account != test.account:
+				t.Errorf("%s: account %d, want %d", test.name, account, test.account)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestImportScriptToAccount(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	redeemScript := []byte{0x51} // OP_TRUE
+	_, err := w.ImportScriptToAccount(ctx, redeemScript, 1, NoRescan)
+	if !errors.Is(err, errors.NotExist) {
+		t.Fatalf("expected NotExist error importing to missing account, got %v", err)
+	}
+	if _, err := w.ImportScriptToAccount(ctx, redeemScript, 0, NoRescan); err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = w.ImportPublicKeyToAccount(ctx, make([]byte, 33), 0, NoRescan)
+	if !errors.Is(err, errors.Invalid) {
+		t.Fatalf("expected Invalid error importing pubkey to spending wallet, got %v", err)
+	}
+
+	p2sh, err := stdaddr.NewAddressScriptHashV0(redeemScript, w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ka, err := w.KnownAddress(ctx, p2sh)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ka.AccountName() != "default" {
+		t.Errorf("imported script recorded in account %q", ka.AccountName())
+	}
+
+	imported, err := w.ImportedAddresses(ctx, "default")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(imported) != 1 || imported[0].String() != p2sh.String() {
+		t.Errorf("unexpected imported addresses of default account: %v", imported)
+	}
+	imported, err = w.ImportedAddresses(ctx, "imported")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(imported) != 0 {
+		t.Errorf("unexpected imported addresses of imported account: %v", imported)
+	}
+
+	// Imported addresses of every account are watched.
+	var watched []string
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		return w.manager.ForEachImportedAddress(ns, func(a udb.ManagedAddress) error {
+			watched = append(watched, a.Address().String())
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(watched) != 1 || watched[0] != p2sh.String() {
+		t.Errorf("unexpected watched imported addresses: %v", watched)
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/blockchain/stake/v5"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/wire"
+)
+
+// ReusedAddress describes a wallet address which received funds more than
+// once.
+type ReusedAddress struct {
+	Address stdaddr.Address
+	Account uint32
+	Outputs []wire.OutPoint
+	Total   dcrutil.Amount
+}
+
+// AccountAddressReuse summarizes the reused addresses of an account.
+type AccountAddressReuse struct {
+	Account   uint32
+	Addresses int
+	Outputs   int
+	Total     dcrutil.Amount
+}
+
+// AddressReuseReport describes every reused wallet address, and the reuse of
+// each account.
+type AddressReuseReport struct {
+	Addresses []ReusedAddress
+	Accounts  []AccountAddressReuse
+}
+
+// AddressReuse scans all wallet transactions for addresses which were paid by
+// more than a single output, including ticket commitments.  Addresses are
+// only reported if they were paid at least once since the block height since.
+// Votes and revocations are not considered reuse, as they must pay the
+// addresses committed to by their tickets.
+//
+// Addresses are reported in order of decreasing number of outputs, and
+// accounts by increasing account number.
+func (w *Wallet) AddressReuse(ctx context.Context, since int32) (*AddressReuseReport, error) {
+	const op errors.Op = "wallet.AddressReuse"
+
+	reuse := make(map[string]*ReusedAddress)
+	inRange := make(map[string]struct{})
+	record := func(addr stdaddr.Address, account uint32, outpoint wire.OutPoint,
+		amount dcrutil.Amount, b *Block) {
+
+		s := addr.String()
+		r, ok := reuse[s]
+		if !ok {
+			r = &ReusedAddress{Address: addr, Account: account}
+			reuse[s] = r
+		}
+		r.Outputs = append(r.Outputs, outpoint)
+		r.Total += amount
+		if b.Header == nil || int32(b.Header.Height) >= since {
+			inRange[s] = struct{}{}
+		}
+	}
+	err := w.GetTransactions(ctx, func(b *Block) (bool, error) {
+		for _, tx := range b.Transactions {
+			switch tx.Type {
+			case TransactionTypeVote, TransactionTypeRevocation:
+				continue
+			}
+			for _, out := range tx.MyOutputs {
+				record(out.Address, out.Account,
+					wire.OutPoint{Hash: *tx.Hash, Index: out.Index},
+					out.Amount, b)
+			}
+			if tx.Type != TransactionTypeTicketPurchase {
+				continue
+			}
+			ticket := new(wire.MsgTx)
+			err := ticket.Deserialize(bytes.NewReader(tx.Transaction))
+			if err != nil {
+				return false, err
+			}
+			for i := 1; i < len(ticket.TxOut); i += 2 { // iterate commitments
+				script := ticket.TxOut[i].PkScript
+				addr, err := stake.AddrFromSStxPkScrCommitment(script, w.chainParams)
+				if err != nil {
+					return false, err
+				}
+				amount, err := stake.AmountFromSStxPkScrCommitment(script)
+				if err != nil {
+					return false, err
+				}
+				var account uint32
+				err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+					addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+					var err error
+					account, err = w.manager.AddrAccount(addrmgrNs, addr)
+					return err
+				})
+				if errors.Is(err, errors.NotExist) {
+					continue
+				}
+				if err != nil {
+					return false, err
+				}
+				record(addr, account, wire.OutPoint{Hash: *tx.Hash, Index: uint32(i)},
+					amount, b)
+			}
+		}
+		return false, nil
+	}, nil, nil)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	report := new(AddressReuseReport)
+	accounts := make(map[uint32]*AccountAddressReuse)
+	for s, r := range reuse {
+		if _, ok := inRange[s]; !ok || len(r.Outputs) <= 1 {
+			continue
+		}
+		report.Addresses = append(report.Addresses, *r)
+		a, ok := accounts[r.Account]
+		if !ok {
+			a = &AccountAddressReuse{Account: r.Account}
+			accounts[r.Account] = a
+		}
+		a.Addresses++
+		a.Outputs += len(r.Outputs)
+		a.Total += r.Total
+	}
+	sort.Slice(report.Addresses, func(i, j int) bool {
+		a, b := &report.Addresses[i], &report.Addresses[j]
+		if len(a.Outputs) != len(b.Outputs) {
+			return len(a.Outputs) > len(b.Outputs)
+		}
+		return a.Address.String() < b.Address.String()
+	})
+	for _, a := range accounts {
+		report.Accounts = append(report.Accounts, *a)
+	}
+	sort.Slice(report.Accounts, func(i, j int) bool {
+		return report.Accounts[i].Account < report.Accounts[j].Account
+	})
+	return report, nil
+}
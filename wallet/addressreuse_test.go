@@ -0,0 +1,69 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/decred/dcrd/wire"
+)
+
+func TestAddressReuse(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	reused, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unique, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reusedVersion, reusedScript := reused.PaymentScript()
+	uniqueVersion, uniqueScript := unique.PaymentScript()
+	for i, value := range []int64{1e8, 2e8} {
+		tx := wire.NewMsgTx()
+		tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: uint32(i)}, 0, nil))
+		tx.AddTxOut(&wire.TxOut{Value: value, Version: reusedVersion, PkScript: reusedScript})
+		if i == 0 {
+			tx.AddTxOut(&wire.TxOut{Value: value, Version: uniqueVersion, PkScript: uniqueScript})
+		}
+		if err := w.AddTransaction(ctx, tx, &w.chainParams.GenesisHash); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	report, err := w.AddressReuse(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Addresses) != 1 {
+		t.Fatalf("expected one reused address, got %d", len(report.Addresses))
+	}
+	r := report.Addresses[0]
+	if r.Address.String() != reused.String() || r.Account != 0 ||
+		len(r.Outputs) != 2 || r.Total != 3e8 {
+		t.Errorf("unexpected reused address %+v", r)
+	}
+	if len(report.Accounts) != 1 {
+		t.Fatalf("expected one account, got %d", len(report.Accounts))
+	}
+	if a := report.Accounts[0]; a.Account != 0 || a.Addresses != 1 ||
+		a.Outputs != 2 || a.Total != 3e8 {
+		t.Errorf("unexpected account reuse %+v", a)
+	}
+
+	// No addresses were paid after the genesis block.
+	report, err = w.AddressReuse(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Addresses) != 0 || len(report.Accounts) != 0 {
+		t.Errorf("unexpected reuse since height 1: %+v", report)
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/v3"
+)
+
+// AgendaStatus describes an agenda of the wallet's vote version and the
+// wallet's default choice for it.
+type AgendaStatus struct {
+	Vote       chaincfg.Vote
+	StartTime  time.Time
+	ExpireTime time.Time
+	Expired    bool
+
+	// ChoiceID is the saved default choice, or "abstain" when no choice is
+	// saved.  Saved is false when no choice is saved.
+	ChoiceID string
+	Saved    bool
+}
+
+// ObsoleteAgendaChoice describes a saved default agenda choice which does not
+// apply to the wallet's vote version, either because it was saved for the
+// agendas of another vote version or because it names a choice which the
+// agenda does not define.
+type ObsoleteAgendaChoice struct {
+	Version  uint32
+	AgendaID string
+	ChoiceID string
+}
+
+// VoteVersionStatus describes the agendas known to the wallet, the vote
+// versions of the wallet and network, and whether the wallet's saved agenda
+// choices are still valid.
+type VoteVersionStatus struct {
+	// VoteVersion is the version of votes created by the wallet.
+	VoteVersion uint32
+
+	// NetworkStakeVersion is the stake version of the main chain tip
+	// block, or zero if the tip header is not known.
+	NetworkStakeVersion uint32
+
+	Agendas  []AgendaStatus
+	Obsolete []ObsoleteAgendaChoice
+
+	// Warnings describe conditions requiring operator attention, such as
+	// saved choices which are no longer applied.
+	Warnings []string
+}
+
+// VoteVersionStatus returns the agendas of the wallet's vote version with the
+// wallet's default choices, and reports saved choices made obsolete by an
+// upgrade of the vote version.
+func (w *Wallet) VoteVersionStatus(ctx context.Context) (*VoteVersionStatus, error) {
+	const op errors.Op = "wallet.VoteVersionStatus"
+
+	version, deployments := CurrentAgendas(w.chainParams)
+	status := &VoteVersionStatus{
+		VoteVersion: version,
+		Agendas:     make([]AgendaStatus, 0, len(deployments)),
+	}
+	var prefs []udb.AgendaPreference
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		tipHash, _ := w.txStore.MainChainTip(dbtx)
+		if header, err := w.txStore.GetBlockHeader(dbtx, &tipHash); err == nil {
+			status.NetworkStakeVersion = header.StakeVersion
+		}
+		var err error
+		prefs, err = udb.DefaultAgendaPreferences(dbtx)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	saved := make(map[string]string)
+	for _, p := range prefs {
+		if p.Version == version {
+			saved[p.AgendaID] = p.ChoiceID
+		}
+	}
+	now := time.Now()
+	for i := range deployments {
+		d := &deployments[i]
+		a := AgendaStatus{
+			Vote:       d.Vote,
+			StartTime:  time.Unix(int64(d.StartTime), 0),
+			ExpireTime: time.Unix(int64(d.ExpireTime), 0),
+			ChoiceID:   "abstain",
+		}
+		a.Expired = now.After(a.ExpireTime)
+		if choice, ok := saved[d.Vote.Id]; ok {
+			a.ChoiceID, a.Saved = choice, true
+		}
+		status.Agendas = append(status.Agendas, a)
+	}
+
+	agenda := func(id string) *AgendaStatus {
+		for i := range status.Agendas {
+			if status.Agendas[i].Vote.Id == id {
+				return &status.Agendas[i]
+			}
+		}
+		return nil
+	}
+	var superseded int
+	for _, p := range prefs {
+		a := agenda(p.AgendaID)
+		if p.Version == version && a != nil && validChoice(&a.Vote, p.ChoiceID) {
+			continue
+		}
+		status.Obsolete = append(status.Obsolete, ObsoleteAgendaChoice(p))
+		switch {
+		case p.Version == version:
+			status.Warnings = append(status.Warnings, fmt.Sprintf(
+				"saved choice %q for agenda %q is not defined by vote "+
+					"version %d and is not applied", p.ChoiceID,
+				p.AgendaID, version))
+		case a != nil && !a.Saved && p.ChoiceID != "abstain":
+			status.Warnings = append(status.Warnings, fmt.Sprintf(
+				"choice %q for agenda %q was saved for vote version %d "+
+					"and is not applied to vote version %d; set the "+
+					"choice again to vote on the agenda", p.ChoiceID,
+				p.AgendaID, p.Version, version))
+		default:
+			superseded++
+		}
+	}
+	if superseded > 0 {
+		status.Warnings = append(status.Warnings, fmt.Sprintf(
+			"%d saved choices for agendas of other vote versions are "+
+				"obsolete", superseded))
+	}
+	if status.NetworkStakeVersion > version {
+		status.Warnings = append(status.Warnings, fmt.Sprintf(
+			"network stake version %d is newer than the wallet vote "+
+				"version %d; upgrade the wallet to vote on new agendas",
+			status.NetworkStakeVersion, version))
+	}
+	return status, nil
+}
+
+func validChoice(vote *chaincfg.Vote, choiceID string) bool {
+	for i := range vote.Choices {
+		if vote.Choices[i].Id == choiceID {
+			return true
+		}
+	}
+	return false
+}
+
+// warnObsoleteAgendaChoices logs the warnings of the wallet's vote version
+// status.
+func (w *Wallet) warnObsoleteAgendaChoices(ctx context.Context) {
+	status, err := w.VoteVersionStatus(ctx)
+	if err != nil {
+		log.Errorf("Failed to check saved agenda choices: %v", err)
+		return
+	}
+	for _, warning := range status.Warnings {
+		log.Warnf("Vote version %d: %s", status.VoteVersion, warning)
+	}
+}
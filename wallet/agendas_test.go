@@ -0,0 +1,91 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+func TestVoteVersionStatus(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	version, deployments := CurrentAgendas(w.ChainParams())
+	if len(deployments) < 2 {
+		t.Skip("test requires at least two agendas")
+	}
+	current := deployments[0].Vote
+	upgraded := deployments[1].Vote
+	_, err := w.SetAgendaChoices(ctx, nil, map[string]string{
+		current.Id: current.Choices[len(current.Choices)-1].Id,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		err := udb.SetDefaultAgendaPreference(dbtx, version-1, upgraded.Id, "yes")
+		if err != nil {
+			return err
+		}
+		return udb.SetDefaultAgendaPreference(dbtx, version-1, "retired", "no")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	status, err := w.VoteVersionStatus(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.VoteVersion != version {
+		t.Errorf("vote version %d, want %d", status.VoteVersion, version)
+	}
+	if len(status.Agendas) != len(deployments) {
+		t.Fatalf("%d agendas, want %d", len(status.Agendas), len(deployments))
+	}
+	for _, a := range status.Agendas {
+		switch a.Vote.Id {
+		case current.Id:
+			if !a.Saved || a.ChoiceID != current.Choices[len(current.Choices)-1].Id {
+				t.Errorf("agenda %q: choice %q saved=%v", a.Vote.Id, a.ChoiceID, a.Saved)
+			}
+		default:
+			if a.Saved || a.ChoiceID != "abstain" {
+				t.Errorf("agenda %q: choice %q saved=%v", a.Vote.Id, a.ChoiceID, a.Saved)
+			}
+		}
+	}
+	if len(status.Obsolete) != 2 {
+		t.Fatalf("%d obsolete choices, want 2: %v", len(status.Obsolete), status.Obsolete)
+	}
+	for _, o := range status.Obsolete {
+		if o.Version != version-1 {
+			t.Errorf("obsolete choice %v has wrong version", o)
+		}
+	}
+	// One warning for the choice of the upgraded agenda, and one for the
+	// retired agenda.
+	if len(status.Warnings) != 2 {
+		t.Errorf("warnings %q, want 2", status.Warnings)
+	}
+
+	// Setting the choice again for the current version clears the warning.
+	_, err = w.SetAgendaChoices(ctx, nil, map[string]string{upgraded.Id: "yes"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	status, err = w.VoteVersionStatus(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(status.Warnings) != 1 {
+		t.Errorf("warnings %q, want 1", status.Warnings)
+	}
+}
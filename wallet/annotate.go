@@ -0,0 +1,193 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"fmt"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/txscript/v4/stdscript"
+	"github.com/decred/dcrd/wire"
+)
+
+// AddressOwnership describes a wallet address paid by a transaction output.
+type AddressOwnership struct {
+	Address     KnownAddress
+	Account     uint32
+	AccountName string
+
+	// Path is the BIP0032 derivation path of the address.  Addresses of
+	// imported xpub accounts have paths relative to the account xpub, and
+	// individually imported addresses have no path.
+	Path string
+}
+
+// AnnotatedInput describes a transaction input and, if the previous output
+// is recorded by the wallet, its value and owning wallet address.
+type AnnotatedInput struct {
+	Index            uint32
+	PreviousOutPoint wire.OutPoint
+
+	// PreviousOutput is nil when the previous transaction is unknown to
+	// the wallet.
+	PreviousOutput *wire.TxOut
+	Owner          *AddressOwnership
+}
+
+// AnnotatedOutput describes a transaction output and the wallet address it
+// pays, if any.
+type AnnotatedOutput struct {
+	Index      uint32
+	Output     *wire.TxOut
+	ScriptType stdscript.ScriptType
+	Addresses  []stdaddr.Address
+	Owner      *AddressOwnership
+}
+
+// TransactionAnnotation describes the relationship of a transaction's inputs
+// and outputs to the wallet.
+type TransactionAnnotation struct {
+	Inputs  []AnnotatedInput
+	Outputs []AnnotatedOutput
+
+	// Debits is the total value of wallet outputs spent by the transaction,
+	// and Credits is the total value of outputs paying to the wallet.
+	Debits  dcrutil.Amount
+	Credits dcrutil.Amount
+
+	// Fee is the transaction fee, which is only known when the values of
+	// all previous outputs are known.
+	Fee      dcrutil.Amount
+	FeeKnown bool
+}
+
+// addressOwnership returns the wallet ownership of an address, or nil if the
+// address does not belong to the wallet.
+func (w *Wallet) addressOwnership(ctx context.Context, addr stdaddr.Address, coinType uint32) (*AddressOwnership, error) {
+	ka, err := w.KnownAddress(ctx, addr)
+	if errors.Is(err, errors.NotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var account uint32
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		var err error
+		account, err = w.manager.AddrAccount(addrmgrNs, addr)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	o := &AddressOwnership{
+		Address:     ka,
+		Account:     account,
+		AccountName: ka.AccountName(),
+	}
+	if bip44, ok := ka.(BIP0044Address); ok {
+		acct, branch, child := bip44.Path()
+		switch ka.AccountKind() {
+		case AccountKindBIP0044:
+			o.Path = fmt.Sprintf("m/44'/%d'/%d'/%d/%d", coinType, acct, branch, child)
+		case AccountKindImportedXpub:
+			o.Path = fmt.Sprintf("m/%d/%d", branch, child)
+		}
+	}
+	return o, nil
+}
+
+// AnnotateTransaction describes which inputs and outputs of any transaction
+// belong to the wallet.  The transaction does not need to be recorded by the
+// wallet.
+func (w *Wallet) AnnotateTransaction(ctx context.Context, tx *wire.MsgTx) (*TransactionAnnotation, error) {
+	const op errors.Op = "wallet.AnnotateTransaction"
+
+	coinType, err := w.CoinType(ctx)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	a := &TransactionAnnotation{
+		Inputs:   make([]AnnotatedInput, len(tx.TxIn)),
+		Outputs:  make([]AnnotatedOutput, len(tx.TxOut)),
+		FeeKnown: true,
+	}
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		for i, in := range tx.TxIn {
+			a.Inputs[i] = AnnotatedInput{
+				Index:            uint32(i),
+				PreviousOutPoint: in.PreviousOutPoint,
+			}
+			prev, err := w.txStore.TxDetails(txmgrNs, &in.PreviousOutPoint.Hash)
+			if errors.Is(err, errors.NotExist) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if in.PreviousOutPoint.Index < uint32(len(prev.MsgTx.TxOut)) {
+				a.Inputs[i].PreviousOutput = prev.MsgTx.TxOut[in.PreviousOutPoint.Index]
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	// Owners of the scripts are looked up by address, using only the first
+	// address of scripts paying to multiple addresses.
+	owner := func(version uint16, pkScript []byte) (*AddressOwnership, error) {
+		_, addrs := stdscript.ExtractAddrs(version, pkScript, w.chainParams)
+		if len(addrs) == 0 {
+			return nil, nil
+		}
+		return w.addressOwnership(ctx, addrs[0], coinType)
+	}
+	var inputTotal, outputTotal dcrutil.Amount
+	for i := range a.Inputs {
+		in := &a.Inputs[i]
+		if in.PreviousOutput == nil {
+			a.FeeKnown = false
+			continue
+		}
+		inputTotal += dcrutil.Amount(in.PreviousOutput.Value)
+		in.Owner, err = owner(in.PreviousOutput.Version, in.PreviousOutput.PkScript)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		if in.Owner != nil {
+			a.Debits += dcrutil.Amount(in.PreviousOutput.Value)
+		}
+	}
+	for i, out := range tx.TxOut {
+		outputTotal += dcrutil.Amount(out.Value)
+		scriptType, addrs := stdscript.ExtractAddrs(out.Version, out.PkScript, w.chainParams)
+		a.Outputs[i] = AnnotatedOutput{
+			Index:      uint32(i),
+			Output:     out,
+			ScriptType: scriptType,
+			Addresses:  addrs,
+		}
+		a.Outputs[i].Owner, err = owner(out.Version, out.PkScript)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		if a.Outputs[i].Owner != nil {
+			a.Credits += dcrutil.Amount(out.Value)
+		}
+	}
+	if a.FeeKnown {
+		a.Fee = inputTotal - outputTotal
+	}
+	return a, nil
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/decred/dcrd/wire"
+)
+
+func TestAnnotateTransaction(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, pkScript := addr.PaymentScript()
+	funding := wire.NewMsgTx()
+	funding.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, 0, nil))
+	funding.AddTxOut(&wire.TxOut{Value: 5e8, Version: version, PkScript: pkScript})
+	if err := w.AddTransaction(ctx, funding, &w.chainParams.GenesisHash); err != nil {
+		t.Fatal(err)
+	}
+
+	change, err := w.NewInternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	changeVersion, changeScript := change.PaymentScript()
+	fundingHash := funding.TxHash()
+	spend := wire.NewMsgTx()
+	spend.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&fundingHash, 0, wire.TxTreeRegular), 5e8, nil))
+	spend.AddTxOut(&wire.TxOut{Value: 2e8, PkScript: []byte{0x51}})
+	spend.AddTxOut(&wire.TxOut{Value: 29e7, Version: changeVersion, PkScript: changeScript})
+
+	coinType, err := w.CoinType(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := w.AnnotateTransaction(ctx, spend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	in := a.Inputs[0]
+	if in.PreviousOutput == nil || in.Owner == nil || in.Owner.AccountName != "default" ||
+		in.Owner.Path != fmt.Sprintf("m/44'/%d'/0'/0/0", coinType) {
+		t.Errorf("unexpected input annotation %+v owner %+v", in, in.Owner)
+	}
+	if a.Outputs[0].Owner != nil {
+		t.Errorf("foreign output annotated as owned")
+	}
+	if o := a.Outputs[1].Owner; o == nil || o.Account != 0 || o.Path != fmt.Sprintf("m/44'/%d'/0'/1/0", coinType) {
+		t.Errorf("unexpected change annotation %+v", o)
+	}
+	if a.Debits != 5e8 || a.Credits != 29e7 || !a.FeeKnown || a.Fee != 1e7 {
+		t.Errorf("unexpected totals %+v", a)
+	}
+
+	// Fees are unknown when spending outputs not recorded by the wallet.
+	a, err = w.AnnotateTransaction(ctx, funding)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.FeeKnown || a.Inputs[0].PreviousOutput != nil || a.Outputs[0].Owner == nil {
+		t.Errorf("unexpected funding annotation %+v", a)
+	}
+}
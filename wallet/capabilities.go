@@ -0,0 +1,60 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import "sort"
+
+// Capability names describing optional wallet subsystems which are enabled.
+const (
+	CapabilityWatchingOnly     = "watchingonly"
+	CapabilityVoteOnly         = "voteonly"
+	CapabilityMixing           = "mixing"
+	CapabilityVSP              = "vsp"
+	CapabilityTxPruning        = "txpruning"
+	CapabilitySidechainPruning = "sidechainpruning"
+	CapabilityAccountRollover  = "accountrollover"
+	CapabilityReadOnly         = "readonly"
+	CapabilityTreasuryKeys     = "treasurykeys"
+)
+
+// Capabilities returns the sorted names of the optional subsystems enabled by
+// the wallet.  RPC servers report these to clients so they may adapt to the
+// wallet configuration without probing with trial calls.
+func (w *Wallet) Capabilities() []string {
+	var caps []string
+	if w.WatchingOnly() {
+		caps = append(caps, CapabilityWatchingOnly)
+	}
+	if w.voteOnly {
+		caps = append(caps, CapabilityVoteOnly)
+	}
+	if w.readOnly {
+		caps = append(caps, CapabilityReadOnly)
+	}
+	if w.treasuryKeys {
+		caps = append(caps, CapabilityTreasuryKeys)
+	}
+	if w.mixingEnabled {
+		caps = append(caps, CapabilityMixing)
+	}
+	w.vspClientsMu.Lock()
+	if len(w.vspClients) != 0 {
+		caps = append(caps, CapabilityVSP)
+	}
+	w.vspClientsMu.Unlock()
+	if w.txPruneDepth != 0 {
+		caps = append(caps, CapabilityTxPruning)
+	}
+	if w.sidechainPruneDepth != 0 {
+		caps = append(caps, CapabilitySidechainPruning)
+	}
+	w.accountRolloverLimitMu.Lock()
+	if w.accountRolloverLimit != 0 {
+		caps = append(caps, CapabilityAccountRollover)
+	}
+	w.accountRolloverLimitMu.Unlock()
+	sort.Strings(caps)
+	return caps
+}
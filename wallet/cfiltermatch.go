@@ -0,0 +1,148 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/hdkeychain/v3"
+)
+
+// CFilterMatch describes a main chain block whose compact filter matches a
+// wallet script.
+type CFilterMatch struct {
+	Hash   chainhash.Hash
+	Height int32
+}
+
+// ownedScripts returns the payment scripts of all imported addresses, and of
+// the addresses of each HD account branch through the account's gap limit beyond the
+// last returned address.  P2PK scripts paying the public keys of these
+// addresses are included.  These are the scripts watched during wallet syncs.
+func (w *Wallet) ownedScripts(dbtx walletdb.ReadTx) ([][]byte, error) {
+	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+	lastAcct, err := w.manager.LastAccount(addrmgrNs)
+	if err != nil {
+		return nil, err
+	}
+	lastImportedAcct, err := w.manager.LastImportedAccount(dbtx)
+	if err != nil {
+		return nil, err
+	}
+
+	var scripts [][]byte
+	addBranch := func(acct, branch, lastReturned, gapLimit uint32) error {
+		xpub, err := w.manager.AccountBranchExtendedPubKey(dbtx, acct, branch)
+		if err != nil {
+			return err
+		}
+		end := min(lastReturned+gapLimit, hdkeychain.HardenedKeyStart-1)
+		addrs, pubKeyAddrs, err := deriveChildWatchAddresses(xpub, 0, end+1,
+			w.chainParams)
+		if err != nil {
+			return err
+		}
+		for _, addr := range append(addrs, pubKeyAddrs...) {
+			_, script := addr.PaymentScript()
+			scripts = append(scripts, script)
+		}
+		return nil
+	}
+	addAccount := func(acct uint32) error {
+		props, err := w.manager.AccountProperties(addrmgrNs, acct)
+		if err != nil {
+			return err
+		}
+		gapLimit, err := w.accountAddrGapLimit(dbtx, acct, w.gapLimit)
+		if err != nil {
+			return err
+		}
+		err = addBranch(acct, udb.ExternalBranch, props.LastReturnedExternalIndex,
+			gapLimit)
+		if err != nil {
+			return err
+		}
+		return addBranch(acct, udb.InternalBranch, props.LastReturnedInternalIndex,
+			gapLimit)
+	}
+	for acct := uint32(0); acct <= lastAcct; acct++ {
+		if err := addAccount(acct); err != nil {
+			return nil, err
+		}
+	}
+	for acct := uint32(udb.ImportedAddrAccount + 1); acct <= lastImportedAcct; acct++ {
+		if err := addAccount(acct); err != nil {
+			return nil, err
+		}
+	}
+
+	err = w.manager.ForEachImportedAddress(addrmgrNs, func(a udb.ManagedAddress) error {
+		_, script := a.Address().PaymentScript()
+		scripts = append(scripts, script)
+		if pubKeyAddr := pubKeyWatchAddress(a, w.chainParams); pubKeyAddr != nil {
+			_, script := pubKeyAddr.PaymentScript()
+			scripts = append(scripts, script)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return scripts, nil
+}
+
+// MatchCFilters returns the main chain blocks between startHeight and
+// endHeight (inclusive) whose version 2 compact filters match any script of
+// the wallet, without fetching or processing the blocks.  The scripts include
+// all imported addresses and HD account addresses through the gap limit
+// beyond the last returned address.  Compact filters may report false
+// positives, but never omit a block with a transaction paying to or spending
+// from a matched script, allowing the wallet's recorded transactions to be
+// audited against the blocks which must be inspected.
+func (w *Wallet) MatchCFilters(ctx context.Context, startHeight, endHeight int32) ([]CFilterMatch, error) {
+	const op errors.Op = "wallet.MatchCFilters"
+	if startHeight < 0 || endHeight < startHeight {
+		return nil, errors.E(op, errors.Invalid, "invalid block range")
+	}
+
+	var matches []CFilterMatch
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		_, tipHeight := w.txStore.MainChainTip(dbtx)
+		if endHeight > tipHeight {
+			return errors.E(errors.Invalid, errors.Errorf("end height %d "+
+				"is above the main chain tip %d", endHeight, tipHeight))
+		}
+		scripts, err := w.ownedScripts(dbtx)
+		if err != nil {
+			return err
+		}
+		for height := startHeight; height <= endHeight; height++ {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			hash, err := w.txStore.GetMainChainBlockHashForHeight(txmgrNs, height)
+			if err != nil {
+				return err
+			}
+			key, filter, err := w.txStore.CFilterV2(dbtx, &hash)
+			if err != nil {
+				return err
+			}
+			if filter.N() != 0 && filter.MatchAny(key, scripts) {
+				matches = append(matches, CFilterMatch{Hash: hash, Height: height})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return matches, nil
+}
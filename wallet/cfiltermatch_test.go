@@ -0,0 +1,66 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet_test
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet"
+	"decred.org/dcrwallet/v5/wallet/wallettest"
+)
+
+func TestMatchCFilters(t *testing.T) {
+	ctx := context.Background()
+	h := wallettest.New(t, nil)
+	w := h.Wallet
+	other := wallettest.New(t, &wallettest.Config{Params: w.ChainParams()})
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherAddr, err := other.Wallet.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Block 1 pays the wallet, block 2 pays another wallet, and block 3
+	// contains no transactions.
+	fund, err := h.Chain.MineBlock(ctx, h.Chain.FundingTx(addr, 10e8))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.Chain.MineBlock(ctx, h.Chain.FundingTx(otherAddr, 10e8)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.Chain.MineBlock(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	matches, err := w.MatchCFilters(ctx, 0, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := wallet.CFilterMatch{Hash: fund.BlockHash(), Height: 1}
+	if len(matches) != 1 || matches[0] != want {
+		t.Fatalf("matches %v, want %v", matches, want)
+	}
+	matches, err = w.MatchCFilters(ctx, 2, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matches) != 0 {
+		t.Fatalf("unexpected matches %v", matches)
+	}
+
+	for _, r := range [][2]int32{{-1, 1}, {2, 1}, {1, 4}} {
+		_, err := w.MatchCFilters(ctx, r[0], r[1])
+		if !errors.Is(err, errors.Invalid) {
+			t.Errorf("range %d-%d: %v", r[0], r[1], err)
+		}
+	}
+}
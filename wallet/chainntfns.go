@@ -57,6 +57,7 @@ func (w *Wallet) extendMainChain(ctx context.Context, op errors.Op, dbtx walletd
 	}
 
 	var watch []wire.OutPoint
+	var used []udb.ManagedAddress
 	for _, tx := range transactions {
 		// In manual ticket mode, tickets are only ever added to the
 		// wallet using AddTransaction.  Skip over any relevant tickets
@@ -72,13 +73,18 @@ func (w *Wallet) extendMainChain(ctx context.Context, op errors.Op, dbtx walletd
 		if err != nil {
 			return nil, errors.E(op, err)
 		}
-		ops, err := w.processTransactionRecord(ctx, dbtx, rec, n.Header, &blockMeta)
+		ops, err := w.recordTransaction(ctx, dbtx, rec, n.Header, &blockMeta, &used)
 		if err != nil {
 			return nil, errors.E(op, err)
 		}
 		watch = append(watch, ops...)
 	}
 
+	// Record the usage of all addresses used by the block at once.
+	if err := w.markUsedAddresses(op, dbtx, used, n.Header); err != nil {
+		return nil, err
+	}
+
 	return watch, nil
 }
 
@@ -143,6 +149,9 @@ func (w *Wallet) ChainSwitch(ctx context.Context, forest *SidechainForest, chain
 				// For transaction notifications, the blocks are notified in reverse
 				// height order.
 				w.NtfnServer.notifyDetachedBlock(header)
+				if err := w.journalDetachedBlock(dbtx, &hash, i); err != nil {
+					return err
+				}
 
 				oldWork.Add(oldWork, blockchain.CalcWork(header.Bits))
 			}
@@ -203,6 +212,10 @@ func (w *Wallet) ChainSwitch(ctx context.Context, forest *SidechainForest, chain
 			// searches until it is passed.
 		}
 
+		if err := journalTipChanged(dbtx, chain[len(chain)-1]); err != nil {
+			return err
+		}
+
 		if relevantTxs != nil {
 			// To avoid skipped blocks, the marker is not advanced if there is a
 			// gap between the existing rescan point (main chain fork point of
@@ -232,15 +245,54 @@ func (w *Wallet) ChainSwitch(ctx context.Context, forest *SidechainForest, chain
 		// TODO: The stake difficulty passed here is not correct.  This must be
 		// the difficulty of the next block, not the tip block.
 		tip := chain[len(chain)-1]
+		expiring, err := w.txStore.ExpiredUnminedTxDetails(txmgrNs,
+			int32(tip.Header.Height))
+		if err != nil {
+			log.Errorf("Failed to find expired unmined transactions when "+
+				"connecting block height %v: %v", tip.Header.Height, err)
+		}
 		hashes, err := w.txStore.PruneUnmined(dbtx, tip.Header.SBits)
 		if err != nil {
 			log.Errorf("Failed to prune unmined transactions when "+
 				"connecting block height %v: %v", tip.Header.Height, err)
 		}
 
+		expired := make(map[chainhash.Hash]*udb.TxDetails, len(expiring))
+		for _, details := range expiring {
+			expired[details.Hash] = details
+		}
 		for _, hash := range hashes {
+			typ := udb.JournalTxRemoved
+			if details, ok := expired[*hash]; ok {
+				typ = udb.JournalTxExpired
+				w.NtfnServer.notifyExpiredTransaction(dbtx, details)
+			}
+			err := journalTx(dbtx, typ, hash, nil)
+			if err != nil {
+				return err
+			}
 			w.NtfnServer.notifyRemovedTransaction(*hash)
 		}
+
+		height := int32(tip.Header.Height)
+		if w.sidechainPruneDepth != 0 && height%sidechainPruneInterval == 0 {
+			r, err := w.txStore.PruneSidechains(dbtx, height-w.sidechainPruneDepth)
+			if err != nil {
+				log.Errorf("Failed to prune sidechain records when "+
+					"connecting block height %v: %v", height, err)
+			} else if r.Headers != 0 || r.CFilters != 0 {
+				log.Infof("Pruned %d header(s) and %d cfilter(s) of "+
+					"blocks not in the main chain", r.Headers, r.CFilters)
+			}
+		}
+
+		// Reconcile invoices with the mined payments to their addresses
+		// on the new main chain.  As above, errors are only logged.
+		err = w.reconcileInvoices(dbtx, height, time.Now())
+		if err != nil {
+			log.Errorf("Failed to reconcile invoices when connecting "+
+				"block height %v: %v", height, err)
+		}
 		return nil
 	})
 	w.lockedOutpointMu.Unlock()
@@ -273,6 +325,22 @@ func (w *Wallet) ChainSwitch(ctx context.Context, forest *SidechainForest, chain
 		}
 	}
 
+	// Periodically prune deeply-confirmed transactions when configured.
+	// This is performed after the chain switch is committed, as finding the
+	// prunable transactions reads every transaction record.  Errors are only
+	// logged.
+	tipHeight := int32(chain[len(chain)-1].Header.Height)
+	if w.txPruneDepth != 0 && tipHeight%txPruneInterval == 0 {
+		n, err := w.pruneTransactions(ctx, tipHeight-w.txPruneDepth)
+		if err != nil {
+			log.Errorf("Failed to prune transactions when "+
+				"connecting block height %v: %v", tipHeight, err)
+		} else if n != 0 {
+			log.Infof("Pruned %d transaction(s) with more than %d "+
+				"confirmations", n, w.txPruneDepth)
+		}
+	}
+
 	forest.PruneChain(chain)
 	forest.Prune(int32(chain[len(chain)-1].Header.Height), w.chainParams)
 
@@ -376,6 +444,26 @@ func (w *Wallet) processTransactionRecord(ctx context.Context, dbtx walletdb.Rea
 	header *wire.BlockHeader, blockMeta *udb.BlockMeta) (watchOutPoints []wire.OutPoint, err error) {
 
 	const op errors.Op = "wallet.processTransactionRecord"
+	var used []udb.ManagedAddress
+	watchOutPoints, err = w.recordTransaction(ctx, dbtx, rec, header, blockMeta, &used)
+	if err != nil {
+		return nil, err
+	}
+	err = w.markUsedAddresses(op, dbtx, used, header)
+	if err != nil {
+		return nil, err
+	}
+	return watchOutPoints, nil
+}
+
+// recordTransaction inserts a relevant transaction record into the wallet,
+// appending the managed addresses it uses to used.  The caller must mark the
+// used addresses with markUsedAddresses, which allows the usage of all
+// transactions of a block to be recorded at once.
+func (w *Wallet) recordTransaction(ctx context.Context, dbtx walletdb.ReadWriteTx, rec *udb.TxRecord,
+	header *wire.BlockHeader, blockMeta *udb.BlockMeta, used *[]udb.ManagedAddress) (watchOutPoints []wire.OutPoint, err error) {
+
+	const op errors.Op = "wallet.recordTransaction"
 
 	addrmgrNs := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
 	txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
@@ -430,11 +518,7 @@ func (w *Wallet) processTransactionRecord(ctx context.Context, dbtx walletdb.Rea
 					return nil, errors.E(op, err)
 				}
 				isRelevant = true
-				err = w.markUsedAddress(op, dbtx, ma)
-				if err != nil {
-					return nil, err
-				}
-				log.Debugf("Marked address %v used", addr)
+				*used = append(*used, ma)
 			}
 
 			// Add the script to the script databases.
@@ -534,15 +618,11 @@ func (w *Wallet) processTransactionRecord(ctx context.Context, dbtx walletdb.Rea
 			if err != nil {
 				return nil, errors.E(op, err)
 			}
-			err = w.markUsedAddress(op, dbtx, ma)
-			if err != nil {
-				return nil, err
-			}
+			*used = append(*used, ma)
 			if watchOutPoint {
 				outpoint.Index = uint32(i)
 				watchOutPoints = append(watchOutPoints, outpoint)
 			}
-			log.Debugf("Marked address %v used", addr)
 		}
 
 		// Handle P2SH addresses that are multisignature scripts
@@ -591,6 +671,16 @@ func (w *Wallet) processTransactionRecord(ctx context.Context, dbtx walletdb.Rea
 		}
 	}
 
+	// Record the mined or unmined transaction in the event journal.
+	if header == nil {
+		err = journalTx(dbtx, udb.JournalTxUnmined, &rec.Hash, nil)
+	} else {
+		err = journalTx(dbtx, udb.JournalTxMined, &rec.Hash, &blockMeta.Block)
+	}
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
 	// Send notification of mined or unmined transaction to any interested
 	// clients.
 	//
@@ -636,6 +726,7 @@ func selectOwnedTickets(w *Wallet, dbtx walletdb.ReadTx, tickets []*chainhash.Ha
 // relevant commitment outputs are loaded as watched data.
 func (w *Wallet) VoteOnOwnedTickets(ctx context.Context, winningTicketHashes []*chainhash.Hash, blockHash *chainhash.Hash, blockHeight int32) error {
 	const op errors.Op = "wallet.VoteOnOwnedTickets"
+	notified := time.Now()
 
 	if !w.votingEnabled || blockHeight < int32(w.chainParams.StakeValidationHeight)-1 {
 		return nil
@@ -669,7 +760,9 @@ func (w *Wallet) VoteOnOwnedTickets(ctx context.Context, winningTicketHashes []*
 	var ticketHashes []*chainhash.Hash
 	var votes []*wire.MsgTx
 	var usedVoteBits []stake.VoteBits
+	var cosignTickets []*wire.MsgTx
 	defaultVoteBits := w.VoteBits()
+	haveCosigners := len(w.cosigners()) != 0
 	var watchOutPoints []wire.OutPoint
 	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
 		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
@@ -682,6 +775,7 @@ func (w *Wallet) VoteOnOwnedTickets(ctx context.Context, winningTicketHashes []*
 
 		votes = make([]*wire.MsgTx, len(ticketHashes))
 		usedVoteBits = make([]stake.VoteBits, len(ticketHashes))
+		cosignTickets = make([]*wire.MsgTx, len(ticketHashes))
 
 		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
 
@@ -694,12 +788,22 @@ func (w *Wallet) VoteOnOwnedTickets(ctx context.Context, winningTicketHashes []*
 			}
 
 			// Don't create votes when this wallet doesn't have voting
-			// authority or the private key to vote.
+			// authority or the private key to vote.  Votes of tickets
+			// with multisig voting scripts may be partially signed when
+			// cosigners are able to provide the remaining signatures.
 			owned, haveKey, err := w.hasVotingAuthority(addrmgrNs, ticketPurchase)
 			if err != nil {
 				return err
 			}
-			if !(owned && haveKey) {
+			cosign := false
+			if owned && !haveKey && haveCosigners {
+				have, _, err := w.votingScriptKeys(addrmgrNs, ticketPurchase)
+				if err != nil {
+					return err
+				}
+				cosign = have > 0
+			}
+			if !(owned && (haveKey || cosign)) {
 				continue
 			}
 
@@ -789,6 +893,9 @@ func (w *Wallet) VoteOnOwnedTickets(ctx context.Context, winningTicketHashes []*
 			}
 			votes[i] = vote
 			usedVoteBits[i] = ticketVoteBits
+			if cosign {
+				cosignTickets[i] = ticketPurchase
+			}
 
 			watchOutPoints = w.appendRelevantOutpoints(watchOutPoints, dbtx, vote)
 		}
@@ -798,15 +905,77 @@ func (w *Wallet) VoteOnOwnedTickets(ctx context.Context, winningTicketHashes []*
 		log.Errorf("View failed: %v", errors.E(op, err))
 	}
 
-	// Remove nil votes without preserving order.
+	// Votes which are completely signed are published without waiting on
+	// the vote cosigners.  Partially signed votes are published once the
+	// remaining signatures are collected.
+	var cosignHashes []*chainhash.Hash
+	var cosignVotes, cosignTicketTxs []*wire.MsgTx
+	var cosignVoteBits []stake.VoteBits
+	for i, ticket := range cosignTickets {
+		if ticket == nil || votes[i] == nil {
+			continue
+		}
+		cosignTicketTxs = append(cosignTicketTxs, ticket)
+		cosignHashes = append(cosignHashes, ticketHashes[i])
+		cosignVotes = append(cosignVotes, votes[i])
+		cosignVoteBits = append(cosignVoteBits, usedVoteBits[i])
+		votes[i] = nil
+	}
+	err = w.publishVotes(ctx, n, ticketHashes, votes, usedVoteBits,
+		blockHash, blockHeight, notified)
+
+	if len(watchOutPoints) > 0 {
+		err := n.LoadTxFilter(ctx, false, nil, watchOutPoints)
+		if err != nil {
+			log.Errorf("Failed to watch outpoints: %v", err)
+		}
+	}
+
+	if len(cosignVotes) > 0 {
+		w.assembleVotes(ctx, cosignTicketTxs, cosignVotes)
+		cosignErr := w.publishVotes(ctx, n, cosignHashes, cosignVotes,
+			cosignVoteBits, blockHash, blockHeight, notified)
+		if err == nil {
+			err = cosignErr
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if n, err := w.NetworkBackend(); err == nil {
+		_, err := w.watchHDAddrs(ctx, false, n)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// publishVotes publishes and records the votes on a block.  Nil votes are
+// skipped, and the ticket hash and vote bits of each vote are at the same
+// index as the vote.
+func (w *Wallet) publishVotes(ctx context.Context, n NetworkBackend, ticketHashes []*chainhash.Hash,
+	votes []*wire.MsgTx, usedVoteBits []stake.VoteBits, blockHash *chainhash.Hash,
+	blockHeight int32, notified time.Time) error {
+
+	// Remove nil votes without preserving order, keeping the ticket hashes
+	// and vote bits of each vote at the same index.
 	for i := 0; i < len(votes); {
 		if votes[i] == nil {
-			votes[i], votes[len(votes)-1] = votes[len(votes)-1], votes[i]
-			votes = votes[:len(votes)-1]
+			last := len(votes) - 1
+			votes[i], votes[last] = votes[last], votes[i]
+			ticketHashes[i], ticketHashes[last] = ticketHashes[last], ticketHashes[i]
+			usedVoteBits[i], usedVoteBits[last] = usedVoteBits[last], usedVoteBits[i]
+			votes = votes[:last]
 			continue
 		}
 		i++
 	}
+	if len(votes) == 0 {
+		return nil
+	}
+	signed := time.Now()
 
 	voteRecords := make([]*udb.TxRecord, 0, len(votes))
 	for i := range votes {
@@ -828,20 +997,15 @@ func (w *Wallet) VoteOnOwnedTickets(ctx context.Context, winningTicketHashes []*
 	w.recentlyPublishedMu.Unlock()
 
 	// Publish before recording votes in database to slightly reduce latency.
-	err = n.PublishTransactions(ctx, votes...)
+	err := w.publishTransactions(ctx, n, votes...)
 	if err != nil {
 		log.Errorf("Failed to send one or more votes: %v", err)
 	}
-
-	if len(watchOutPoints) > 0 {
-		err := n.LoadTxFilter(ctx, false, nil, watchOutPoints)
-		if err != nil {
-			log.Errorf("Failed to watch outpoints: %v", err)
-		}
-	}
+	w.recordVoteDiagnostics(n, ticketHashes, votes, blockHash,
+		blockHeight, notified, signed, time.Now(), err)
 
 	// w.lockedOutpointMu is intentionally not locked.
-	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+	return walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
 		for i := range voteRecords {
 			_, err := w.processTransactionRecord(ctx, dbtx, voteRecords[i], nil, nil)
 			if err != nil {
@@ -850,17 +1014,6 @@ func (w *Wallet) VoteOnOwnedTickets(ctx context.Context, winningTicketHashes []*
 		}
 		return nil
 	})
-	if err != nil {
-		return err
-	}
-
-	if n, err := w.NetworkBackend(); err == nil {
-		_, err := w.watchHDAddrs(ctx, false, n)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
 }
 
 // RevokeOwnedTickets no longer revokes any tickets since revocations are now
@@ -0,0 +1,168 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/txsizes"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/txscript/v4"
+)
+
+// ChangePolicy directs the change outputs of wallet-authored transactions to
+// addresses of a branch of an account, such as a designated consolidation
+// account or a branch of the mixed account.
+type ChangePolicy struct {
+	Account uint32
+	Branch  uint32
+}
+
+// TxAuthorOption modifies the behavior of a single transaction authoring
+// call.
+type TxAuthorOption func(*txAuthorOptions)
+
+type txAuthorOptions struct {
+	changePolicy        *ChangePolicy
+	changePolicySet     bool
+	destinationOverride []byte
+	txExpiry            int32
+	txExpirySet         bool
+	idempotencyKey      string
+	idempotencyRequest  chainhash.Hash
+}
+
+// WithChangePolicy overrides the wallet's change policy for a single
+// transaction authoring call.  A nil policy returns change to the internal
+// branch of the call's change account, ignoring any wallet change policy.
+func WithChangePolicy(p *ChangePolicy) TxAuthorOption {
+	return func(o *txAuthorOptions) {
+		o.changePolicy = p
+		o.changePolicySet = true
+	}
+}
+
+// SetChangePolicy directs the change of payments authored by the wallet to a
+// branch of an account, replacing the default of returning change to the
+// internal branch of the spending account.  The policy is not persisted.  A
+// nil policy restores the default.
+//
+// Change of ticket purchases and mixing transactions, and of payments which
+// request a change account other than the spending account, is unaffected by
+// the change policy.
+func (w *Wallet) SetChangePolicy(ctx context.Context, p *ChangePolicy) error {
+	const op errors.Op = "wallet.SetChangePolicy"
+	if p != nil {
+		if err := w.checkChangePolicy(ctx, p); err != nil {
+			return errors.E(op, err)
+		}
+		p = &ChangePolicy{Account: p.Account, Branch: p.Branch}
+	}
+
+	w.lockedOutpointMu.Lock()
+	w.changePolicy = p
+	w.lockedOutpointMu.Unlock()
+	return nil
+}
+
+// ChangePolicy returns the wallet's change policy, or nil when change is
+// returned to the internal branch of each call's change account.
+func (w *Wallet) ChangePolicy() *ChangePolicy {
+	defer w.lockedOutpointMu.Unlock()
+	w.lockedOutpointMu.Lock()
+
+	if w.changePolicy == nil {
+		return nil
+	}
+	p := *w.changePolicy
+	return &p
+}
+
+// checkChangePolicy errors when change can not be directed by the policy p.
+func (w *Wallet) checkChangePolicy(ctx context.Context, p *ChangePolicy) error {
+	if p.Branch > udb.InternalBranch {
+		return errors.E(errors.Invalid, "change branch must be 0 or 1")
+	}
+	if p.Account == udb.ImportedAddrAccount {
+		return errors.E(errors.Invalid, "imported account may not receive change")
+	}
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		props, err := w.manager.AccountProperties(addrmgrNs, p.Account)
+		if err != nil {
+			return err
+		}
+		if udb.IsImportedVoting(props.AccountType) {
+			return errors.E(errors.Invalid, "voting accounts may not receive change")
+		}
+		return nil
+	})
+	return err
+}
+
+// resolveChangePolicy returns the change policy of an authoring call with the
+// options opts.  A nil policy and error are returned when change should be
+// returned to the call's change account.  The wallet's change policy does not
+// apply to calls which chose a change account other than the spending
+// account, such as the redirection of mixed account change.
+func (w *Wallet) resolveChangePolicy(ctx context.Context, opts []TxAuthorOption,
+	changeChosen bool) (*ChangePolicy, error) {
+
+	var o txAuthorOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if !o.changePolicySet {
+		if changeChosen {
+			return nil, nil
+		}
+		return w.ChangePolicy(), nil
+	}
+	if o.changePolicy == nil {
+		return nil, nil
+	}
+	if err := w.checkChangePolicy(ctx, o.changePolicy); err != nil {
+		return nil, err
+	}
+	return o.changePolicy, nil
+}
+
+// policyChangeSource is the change source of transactions authored under a
+// change policy.  Treasury change is prefixed by OP_SSTXCHANGE.
+type policyChangeSource struct {
+	persist  persistReturnedChildFunc
+	policy   ChangePolicy
+	treasury bool
+	wallet   *Wallet
+	ctx      context.Context
+}
+
+func (src *policyChangeSource) Script() ([]byte, uint16, error) {
+	const accountName = "" // not returned, so can be faked.
+	changeAddress, err := src.wallet.nextAddress(src.ctx, "", src.persist,
+		accountName, src.policy.Account, src.policy.Branch,
+		withGapPolicy(gapPolicyWrap))
+	if err != nil {
+		return nil, 0, err
+	}
+	vers, script := changeAddress.PaymentScript()
+	if !src.treasury {
+		return script, vers, nil
+	}
+	s := make([]byte, len(script)+1)
+	s[0] = txscript.OP_SSTXCHANGE
+	copy(s[1:], script)
+	return s, vers, nil
+}
+
+func (src *policyChangeSource) ScriptSize() int {
+	if src.treasury {
+		return txsizes.P2PKHPkTreasruryScriptSize
+	}
+	return txsizes.P2PKHPkScriptSize
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"github.com/decred/dcrd/txscript/v4/stdscript"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestChangePolicy(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	cfg.AccountGapLimit = 2
+	w, teardown := testWallet(ctx, t, &cfg, nil)
+	defer teardown()
+
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	consolidation, err := w.NextAccount(ctx, "consolidation")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, pkScript := addr.PaymentScript()
+	fund := wire.NewMsgTx()
+	fund.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, 0, nil))
+	fund.AddTxOut(&wire.TxOut{Value: 10e8, Version: version, PkScript: pkScript})
+	if err := w.AddTransaction(ctx, fund, &w.chainParams.GenesisHash); err != nil {
+		t.Fatal(err)
+	}
+
+	// changePath returns the account and branch of the change address of
+	// a transaction paying from account 0.
+	changePath := func(opts ...TxAuthorOption) (account, branch uint32) {
+		t.Helper()
+		out := &wire.TxOut{Value: 1e8, PkScript: []byte{0x51}}
+		atx, err := w.NewUnsignedTransaction(ctx, []*wire.TxOut{out},
+			w.RelayFee(), 0, 0, OutputSelectionAlgorithmDefault, nil, nil,
+			opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if atx.ChangeIndex < 0 {
+			t.Fatal("transaction has no change")
+		}
+		change := atx.Tx.TxOut[atx.ChangeIndex]
+		_, addrs := stdscript.ExtractAddrs(change.Version, change.PkScript,
+			w.chainParams)
+		if len(addrs) != 1 {
+			t.Fatal("change does not pay an address")
+		}
+		ka, err := w.KnownAddress(ctx, addrs[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		account, branch, _ = ka.(BIP0044Address).Path()
+		return account, branch
+	}
+
+	if acct, branch := changePath(); acct != 0 || branch != udb.InternalBranch {
+		t.Errorf("default change paid to account %d branch %d", acct, branch)
+	}
+
+	invalid := []*ChangePolicy{
+		{Account: consolidation, Branch: 2},
+		{Account: udb.ImportedAddrAccount},
+		{Account: consolidation + 1},
+	}
+	for _, p := range invalid {
+		if err := w.SetChangePolicy(ctx, p); err == nil {
+			t.Errorf("invalid policy %+v accepted", p)
+		}
+	}
+	p := &ChangePolicy{Account: consolidation, Branch: udb.ExternalBranch}
+	if err := w.SetChangePolicy(ctx, p); err != nil {
+		t.Fatal(err)
+	}
+	if got := w.ChangePolicy(); got == nil || *got != *p {
+		t.Fatalf("change policy %+v, want %+v", got, p)
+	}
+	if acct, branch := changePath(); acct != consolidation || branch != udb.ExternalBranch {
+		t.Errorf("policy change paid to account %d branch %d", acct, branch)
+	}
+
+	// Calls may override or ignore the wallet policy.
+	override := &ChangePolicy{Account: consolidation, Branch: udb.InternalBranch}
+	if acct, branch := changePath(WithChangePolicy(override)); acct != consolidation ||
+		branch != udb.InternalBranch {
+		t.Errorf("overridden change paid to account %d branch %d", acct, branch)
+	}
+	if acct, branch := changePath(WithChangePolicy(nil)); acct != 0 ||
+		branch != udb.InternalBranch {
+		t.Errorf("unpolicied change paid to account %d branch %d", acct, branch)
+	}
+	// Payments choosing a change account other than the spending account,
+	// such as mixed account change redirected to the change account, are
+	// not directed by the wallet policy.
+	other, err := w.NextAccount(ctx, "other")
+	if err != nil {
+		t.Fatal(err)
+	}
+	proposedChange := func(changeAccount uint32) (account, branch uint32) {
+		t.Helper()
+		out := &wire.TxOut{Value: 1e8, PkScript: []byte{0x51}}
+		p, err := w.ProposeSpend(ctx, []*wire.TxOut{out}, 0, changeAccount, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			if err := w.RejectPendingSpend(ctx, &p.Hash); err != nil {
+				t.Fatal(err)
+			}
+		}()
+		for _, out := range p.Tx.TxOut {
+			_, addrs := stdscript.ExtractAddrs(out.Version, out.PkScript,
+				w.chainParams)
+			if len(addrs) != 1 {
+				continue
+			}
+			ka, err := w.KnownAddress(ctx, addrs[0])
+			if err != nil {
+				continue
+			}
+			account, branch, _ = ka.(BIP0044Address).Path()
+			return account, branch
+		}
+		t.Fatal("transaction has no change")
+		return
+	}
+	if acct, branch := proposedChange(0); acct != consolidation ||
+		branch != udb.ExternalBranch {
+		t.Errorf("policy change paid to account %d branch %d", acct, branch)
+	}
+	if acct, branch := proposedChange(other); acct != other ||
+		branch != udb.InternalBranch {
+		t.Errorf("chosen change account paid to account %d branch %d", acct, branch)
+	}
+
+	_, err = w.NewUnsignedTransaction(ctx, nil, w.RelayFee(), 0, 0,
+		OutputSelectionAlgorithmAll, nil, nil,
+		WithChangePolicy(&ChangePolicy{Branch: 2}))
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("invalid call policy accepted: %v", err)
+	}
+
+	if err := w.SetChangePolicy(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+	if w.ChangePolicy() != nil {
+		t.Error("change policy not cleared")
+	}
+}
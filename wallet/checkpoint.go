@@ -0,0 +1,511 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/hdkeychain/v3"
+)
+
+// AccountCheckpoint records the address cursors of a BIP0044 account.  Child
+// indexes are ^uint32(0) when no address of the branch was used or returned.
+type AccountCheckpoint struct {
+	Account              uint32 `json:"account"`
+	Name                 string `json:"name"`
+	LastUsedExternal     uint32 `json:"lastusedexternal"`
+	LastUsedInternal     uint32 `json:"lastusedinternal"`
+	LastReturnedExternal uint32 `json:"lastreturnedexternal"`
+	LastReturnedInternal uint32 `json:"lastreturnedinternal"`
+}
+
+// Checkpoint is a compact record of the wallet state which can not be
+// recovered from the seed without rediscovering address usage across the
+// entire chain history.  It records the account address cursors at the last
+// processed main chain block.
+type Checkpoint struct {
+	Network     string              `json:"network"`
+	CoinType    uint32              `json:"cointype"`
+	Created     int64               `json:"created"`
+	BlockHash   chainhash.Hash      `json:"blockhash"`
+	BlockHeight int32               `json:"blockheight"`
+	Accounts    []AccountCheckpoint `json:"accounts"`
+}
+
+// checkpointJSON is the JSON encoding of a checkpoint, with the block hash
+// encoded as a hex string.
+type checkpointJSON struct {
+	*checkpointFields
+	BlockHash string `json:"blockhash"`
+}
+
+type checkpointFields Checkpoint
+
+// MarshalJSON implements json.Marshaler.
+func (c *Checkpoint) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&checkpointJSON{
+		checkpointFields: (*checkpointFields)(c),
+		BlockHash:        c.BlockHash.String(),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *Checkpoint) UnmarshalJSON(b []byte) error {
+	j := checkpointJSON{checkpointFields: (*checkpointFields)(c)}
+	if err := json.Unmarshal(b, &j); err != nil {
+		return err
+	}
+	return chainhash.Decode(&c.BlockHash, j.BlockHash)
+}
+
+// checkpointFile is the encoding of a checkpoint written by WriteCheckpoint.
+// MAC is a HMAC-SHA256 of the encoded checkpoint.
+type checkpointFile struct {
+	Checkpoint json.RawMessage `json:"checkpoint"`
+	MAC        string          `json:"mac"`
+}
+
+// checkpointKey derives the key authenticating checkpoints from the private
+// key of a coin type.  The key is shared by every wallet restored from the same
+// seed, but unlike the account extended public keys, which may be shared with
+// watching-only wallets and other services, it is not known to anyone who does
+// not hold the seed.
+func checkpointKey(coinTypeXpriv *hdkeychain.ExtendedKey) ([]byte, error) {
+	priv, err := coinTypeXpriv.SerializedPrivKey()
+	if err != nil {
+		return nil, err
+	}
+	mac := hmac.New(sha256.New, []byte("dcrwallet checkpoint"))
+	mac.Write(priv)
+	for i := range priv {
+		priv[i] = 0
+	}
+	return mac.Sum(nil), nil
+}
+
+// activeCheckpointKey returns the checkpoint key of the coin type in use.  The
+// key is recorded in the database the first time it is derived, so that
+// checkpoints may later be written while the wallet is locked.  Deriving the
+// key requires the unlocked wallet, and watching-only wallets can not
+// authenticate checkpoints.
+func (w *Wallet) activeCheckpointKey(dbtx walletdb.ReadWriteTx) ([]byte, error) {
+	coinType, err := w.manager.CoinType(dbtx)
+	if err != nil {
+		return nil, err
+	}
+	recordedCoinType, key := udb.FetchCheckpointKey(dbtx)
+	if key != nil && recordedCoinType == coinType {
+		return key, nil
+	}
+	xpriv, err := w.manager.CoinTypePrivKey(dbtx)
+	if err != nil {
+		return nil, err
+	}
+	key, err = checkpointKey(xpriv)
+	xpriv.Zero()
+	if err != nil {
+		return nil, err
+	}
+	err = udb.PutCheckpointKey(dbtx, coinType, key)
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// checkpointCoinType returns the coin type whose checkpoint key authenticates
+// mac.  Both the coin type in use and, for wallets which continue to use the
+// legacy coin type, the saved SLIP0044 coin type are tried, so that checkpoints
+// written by an upgraded wallet may be verified before upgrading the coin type
+// of a restored wallet.  Errors with kind errors.Invalid are returned when
+// neither key authenticates the checkpoint.
+func (w *Wallet) checkpointCoinType(dbtx walletdb.ReadTx, checkpoint, mac []byte) (uint32, error) {
+	active, err := w.manager.CoinType(dbtx)
+	if err != nil {
+		return 0, err
+	}
+	type candidate struct {
+		coinType uint32
+		xpriv    func(walletdb.ReadTx) (*hdkeychain.ExtendedKey, error)
+	}
+	candidates := []candidate{{active, w.manager.CoinTypePrivKey}}
+	legacyCoinType, slip0044CoinType := udb.CoinTypes(w.chainParams)
+	if active == legacyCoinType && legacyCoinType != slip0044CoinType {
+		candidates = append(candidates, candidate{slip0044CoinType,
+			w.manager.SLIP0044CoinTypePrivKey})
+	}
+	for _, c := range candidates {
+		xpriv, err := c.xpriv(dbtx)
+		if errors.Is(err, errors.NotExist) {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		key, err := checkpointKey(xpriv)
+		xpriv.Zero()
+		if err != nil {
+			return 0, err
+		}
+		if hmac.Equal(mac, checkpointMAC(key, checkpoint)) {
+			return c.coinType, nil
+		}
+	}
+	return 0, errors.E(errors.Invalid, "checkpoint is not authenticated "+
+		"by this wallet's key")
+}
+
+func checkpointMAC(key, checkpoint []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(checkpoint)
+	return mac.Sum(nil)
+}
+
+// Checkpoint returns a checkpoint of the current wallet state.
+func (w *Wallet) Checkpoint(ctx context.Context) (*Checkpoint, error) {
+	const op errors.Op = "wallet.Checkpoint"
+	c := &Checkpoint{
+		Network: w.chainParams.Name,
+		Created: time.Now().Unix(),
+	}
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		var err error
+		c.CoinType, err = w.manager.CoinType(dbtx)
+		if err != nil && !errors.Is(err, errors.WatchingOnly) {
+			return err
+		}
+		hash, height := w.txStore.MainChainTip(dbtx)
+		c.BlockHash, c.BlockHeight = hash, height
+		lastAcct, err := w.manager.LastAccount(ns)
+		if err != nil {
+			return err
+		}
+		c.Accounts = make([]AccountCheckpoint, 0, lastAcct+1)
+		for acct := uint32(0); acct <= lastAcct; acct++ {
+			props, err := w.manager.AccountProperties(ns, acct)
+			if err != nil {
+				return err
+			}
+			c.Accounts = append(c.Accounts, AccountCheckpoint{
+				Account:              acct,
+				Name:                 props.AccountName,
+				LastUsedExternal:     props.LastUsedExternalIndex,
+				LastUsedInternal:     props.LastUsedInternalIndex,
+				LastReturnedExternal: props.LastReturnedExternalIndex,
+				LastReturnedInternal: props.LastReturnedInternalIndex,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return c, nil
+}
+
+// EncodeCheckpoint returns the encoding of a checkpoint authenticated by the
+// wallet's checkpoint key.  The wallet must have been unlocked when a
+// checkpoint was first encoded.
+func (w *Wallet) EncodeCheckpoint(ctx context.Context, c *Checkpoint) ([]byte, error) {
+	const op errors.Op = "wallet.EncodeCheckpoint"
+	var key []byte
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		var err error
+		key, err = w.activeCheckpointKey(dbtx)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	checkpoint, err := json.Marshal(c)
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	b, err := json.Marshal(&checkpointFile{
+		Checkpoint: checkpoint,
+		MAC:        hex.EncodeToString(checkpointMAC(key, checkpoint)),
+	})
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	return b, nil
+}
+
+// WriteCheckpoint writes an authenticated checkpoint of the current wallet
+// state to a file, replacing any previous checkpoint.
+func (w *Wallet) WriteCheckpoint(ctx context.Context, path string) error {
+	const op errors.Op = "wallet.WriteCheckpoint"
+	c, err := w.Checkpoint(ctx)
+	if err != nil {
+		return err
+	}
+	b, err := w.EncodeCheckpoint(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file and rename so that a crash never leaves a
+	// truncated checkpoint in place of the previous one.
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	_, err = f.Write(b)
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), path)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// RunCheckpoints writes a checkpoint to path immediately and after every
+// interval until the context is cancelled.  Failed writes are logged and
+// retried at the next interval.
+func (w *Wallet) RunCheckpoints(ctx context.Context, path string, interval time.Duration) error {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		err := w.WriteCheckpoint(ctx, path)
+		if err != nil && ctx.Err() == nil {
+			log.Errorf("Failed to write wallet checkpoint %s: %v", path, err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// ImportCheckpoint restores the account address cursors of a checkpoint
+// written by WriteCheckpoint, typically to a wallet newly restored from the
+// same seed.  Missing accounts are created, requiring the wallet to be
+// unlocked, and the checkpoint block is recorded so that the next address
+// discovery only searches blocks after it.
+//
+// The wallet must be unlocked to verify the checkpoint, which must be
+// authenticated by this wallet's checkpoint key, or an error with kind
+// errors.Invalid is returned.
+func (w *Wallet) ImportCheckpoint(ctx context.Context, b []byte) (*Checkpoint, error) {
+	const op errors.Op = "wallet.ImportCheckpoint"
+	var f checkpointFile
+	if err := json.Unmarshal(b, &f); err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	mac, err := hex.DecodeString(f.MAC)
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	c := new(Checkpoint)
+	if err := json.Unmarshal(f.Checkpoint, c); err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	if c.Network != w.chainParams.Name {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("checkpoint "+
+			"is for network %q", c.Network))
+	}
+
+	// Checkpoints are authenticated by a key derived from the coin type
+	// in use, and the coin type of a restored wallet may not yet have been
+	// upgraded.  The checkpoint is authenticated before the coin type is
+	// changed.
+	var coinType uint32
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		coinType, err = w.checkpointCoinType(dbtx, f.Checkpoint, mac)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if coinType != c.CoinType {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("checkpoint "+
+			"coin type %d is not authenticated", c.CoinType))
+	}
+	err = w.alignCheckpointCoinType(ctx, c.CoinType)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	w.addressBuffersMu.Lock()
+	defer w.addressBuffersMu.Unlock()
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		for i := range c.Accounts {
+			a := &c.Accounts[i]
+			if a.Account > udb.MaxAccountNum {
+				return errors.E(errors.Invalid, errors.Errorf("checkpoint "+
+					"account %d is not a BIP0044 account", a.Account))
+			}
+			lastAcct, err := w.manager.LastAccount(ns)
+			if err != nil {
+				return err
+			}
+			for acct := lastAcct + 1; acct <= a.Account; acct++ {
+				name := a.Name
+				if acct != a.Account {
+					name = fmt.Sprintf("account-%d", acct)
+				}
+				_, err := w.manager.NewAccount(ns, name)
+				if err != nil {
+					return err
+				}
+			}
+			err = w.importAccountCheckpoint(dbtx, a)
+			if err != nil {
+				return err
+			}
+		}
+		return udb.SetDiscoveryCheckpoint(dbtx, &c.BlockHash)
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	log.Infof("Imported checkpoint of %d account(s) at block %v (height %d)",
+		len(c.Accounts), &c.BlockHash, c.BlockHeight)
+	return c, nil
+}
+
+// alignCheckpointCoinType upgrades a wallet using the legacy coin type to the
+// SLIP0044 coin type of an authenticated checkpoint.
+func (w *Wallet) alignCheckpointCoinType(ctx context.Context, coinType uint32) error {
+	active, err := w.CoinType(ctx)
+	if err != nil {
+		return err
+	}
+	if active == coinType {
+		return nil
+	}
+	_, slip0044CoinType := udb.CoinTypes(w.chainParams)
+	if coinType != slip0044CoinType {
+		return errors.E(errors.Invalid, errors.Errorf("checkpoint coin "+
+			"type %d does not match wallet coin type %d", coinType, active))
+	}
+	return w.UpgradeToSLIP0044CoinType(ctx)
+}
+
+// importAccountCheckpoint records the used and returned child indexes of an
+// account checkpoint, saves the addresses through the gap limit beyond them,
+// and updates the account's address buffers.  Indexes are never moved
+// backwards.
+//
+// This method must be called with the addressBuffersMu held.
+func (w *Wallet) importAccountCheckpoint(dbtx walletdb.ReadWriteTx, a *AccountCheckpoint) error {
+	ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+	branches := []struct {
+		branch, lastUsed, lastReturned uint32
+	}{
+		{udb.ExternalBranch, a.LastUsedExternal, a.LastReturnedExternal},
+		{udb.InternalBranch, a.LastUsedInternal, a.LastReturnedInternal},
+	}
+	for _, b := range branches {
+		if b.lastUsed != ^uint32(0) {
+			err := w.manager.MarkUsedChildIndex(dbtx, a.Account, b.branch, b.lastUsed)
+			if err != nil {
+				return err
+			}
+		}
+		if b.lastReturned != ^uint32(0) {
+			err := w.manager.MarkReturnedChildIndex(dbtx, a.Account, b.branch, b.lastReturned)
+			if err != nil {
+				return err
+			}
+		}
+		last := max(b.lastUsed+1, b.lastReturned+1)
+		syncTo := min(last+w.gapLimit, hdkeychain.HardenedKeyStart) - 1
+		err := w.manager.SyncAccountToAddrIndex(ns, a.Account, syncTo, b.branch)
+		if err != nil {
+			return err
+		}
+	}
+
+	props, err := w.manager.AccountProperties(ns, a.Account)
+	if err != nil {
+		return err
+	}
+	acctData, ok := w.addressBuffers[a.Account]
+	if !ok {
+		xpub, err := w.manager.AccountExtendedPubKey(dbtx, a.Account)
+		if err != nil {
+			return err
+		}
+		extKey, intKey, err := deriveBranches(xpub)
+		if err != nil {
+			return err
+		}
+		acctData = &bip0044AccountData{
+			xpub:        xpub,
+			albExternal: addressBuffer{branchXpub: extKey, lastUsed: ^uint32(0)},
+			albInternal: addressBuffer{branchXpub: intKey, lastUsed: ^uint32(0)},
+		}
+		w.addressBuffers[a.Account] = acctData
+	}
+	acctData.albExternal.advance(props.LastUsedExternalIndex, props.LastReturnedExternalIndex)
+	acctData.albInternal.advance(props.LastUsedInternalIndex, props.LastReturnedInternalIndex)
+	return nil
+}
+
+// advance moves the last used child of the address buffer to lastUsed and the
+// next returned child beyond lastReturned.  The next returned child is never
+// moved backwards to avoid address reuse.
+func (alb *addressBuffer) advance(lastUsed, lastReturned uint32) {
+	next := max(alb.lastUsed+1+alb.cursor, lastReturned+1, lastUsed+1)
+	if lastUsed+1 > alb.lastUsed+1 {
+		alb.lastUsed = lastUsed
+	}
+	alb.cursor = next - (alb.lastUsed + 1)
+}
+
+// discoveryStart returns the block at which address discovery beginning at
+// startBlock may instead begin.  Address usage before the block of an imported
+// checkpoint is already recorded, so when the checkpoint block is in the main
+// chain after startBlock, it is returned with imported set to true.
+func (w *Wallet) discoveryStart(ctx context.Context, startBlock *chainhash.Hash) (start *chainhash.Hash, imported bool, err error) {
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		start = startBlock
+		checkpoint := udb.FetchDiscoveryCheckpoint(dbtx)
+		if checkpoint == nil {
+			return nil
+		}
+		if inMainChain, _ := w.txStore.BlockInMainChain(dbtx, checkpoint); !inMainChain {
+			return nil
+		}
+		startHeader, err := w.txStore.GetBlockHeader(dbtx, startBlock)
+		if err != nil {
+			return err
+		}
+		checkpointHeader, err := w.txStore.GetBlockHeader(dbtx, checkpoint)
+		if err != nil {
+			return err
+		}
+		if checkpointHeader.Height > startHeader.Height {
+			start, imported = checkpoint, true
+		}
+		return nil
+	})
+	return start, imported, err
+}
@@ -0,0 +1,223 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+func TestCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	cfg.AccountGapLimit = 2
+	w, teardown := testWallet(ctx, t, &cfg, seed)
+	defer teardown()
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Use and return addresses of the default account and a second account.
+	acct, err := w.NextAccount(ctx, "savings")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 5; i++ {
+		if _, err := w.NewExternalAddress(ctx, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	addr, err := w.NewExternalAddress(ctx, acct)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ma, err := w.manager.Address(dbtx.ReadBucket(waddrmgrNamespaceKey), addr)
+		if err != nil {
+			return err
+		}
+		return w.markUsedAddresses("", dbtx, []udb.ManagedAddress{ma}, nil)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+	if err := w.WriteCheckpoint(ctx, path); err != nil {
+		t.Fatal(err)
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A wallet restored from the same seed recovers the accounts and their
+	// address cursors.
+	restoredCfg := basicWalletConfig
+	restored, teardown := testWallet(ctx, t, &restoredCfg, seed)
+	defer teardown()
+	if err := restored.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	tampered := bytes.Replace(b, []byte(`"savings"`), []byte(`"spending"`), 1)
+	if _, err := restored.ImportCheckpoint(ctx, tampered); !errors.Is(err, errors.Invalid) {
+		t.Fatalf("imported tampered checkpoint: %v", err)
+	}
+	c, err := restored.ImportCheckpoint(ctx, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c.Accounts) != 2 {
+		t.Fatalf("checkpoint of %d accounts, want 2", len(c.Accounts))
+	}
+	got, err := restored.Checkpoint(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got.Accounts, c.Accounts) {
+		t.Errorf("restored accounts %+v, want %+v", got.Accounts, c.Accounts)
+	}
+	if c.Accounts[1].Name != "savings" || c.Accounts[1].LastUsedExternal != 0 ||
+		c.Accounts[0].LastReturnedExternal != 4 {
+		t.Errorf("unexpected checkpoint accounts %+v", c.Accounts)
+	}
+
+	// The next returned address follows the checkpointed cursor.
+	next, err := restored.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nextOriginal, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next.String() != nextOriginal.String() {
+		t.Errorf("next address %v, want %v", next, nextOriginal)
+	}
+
+	var checkpoint bool
+	err = walletdb.View(ctx, restored.db, func(dbtx walletdb.ReadTx) error {
+		checkpoint = udb.FetchDiscoveryCheckpoint(dbtx) != nil
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !checkpoint {
+		t.Errorf("discovery checkpoint was not recorded")
+	}
+
+	// Wallets from other seeds do not accept the checkpoint, and
+	// checkpoints can not be verified by locked wallets.
+	otherCfg := basicWalletConfig
+	other, teardown := testWallet(ctx, t, &otherCfg, nil)
+	defer teardown()
+	if _, err := other.ImportCheckpoint(ctx, b); !errors.Is(err, errors.Locked) {
+		t.Fatalf("locked wallet imported checkpoint: %v", err)
+	}
+	if err := other.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := other.ImportCheckpoint(ctx, b); !errors.Is(err, errors.Invalid) {
+		t.Fatalf("other wallet imported checkpoint: %v", err)
+	}
+
+	// The recorded checkpoint key permits writing checkpoints while locked.
+	w.Lock()
+	if err := w.WriteCheckpoint(ctx, path); err != nil {
+		t.Fatalf("write checkpoint while locked: %v", err)
+	}
+}
+
+func TestCheckpointCoinType(t *testing.T) {
+	ctx := context.Background()
+	legacyCoinType, slip0044CoinType := udb.CoinTypes(basicWalletConfig.Params)
+
+	// A checkpoint written by a wallet using the SLIP0044 coin type
+	// upgrades the coin type of a wallet restored from the same seed.
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, seed)
+	defer teardown()
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.UpgradeToSLIP0044CoinType(ctx); err != nil {
+		t.Fatal(err)
+	}
+	c, err := w.Checkpoint(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.CoinType != slip0044CoinType {
+		t.Fatalf("checkpoint coin type %d, want %d", c.CoinType, slip0044CoinType)
+	}
+	b, err := w.EncodeCheckpoint(ctx, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Checkpoints of other seeds are rejected before the coin type of
+	// the restored wallet is changed.
+	otherCfg := basicWalletConfig
+	other, teardown := testWallet(ctx, t, &otherCfg, nil)
+	defer teardown()
+	if err := other.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := other.UpgradeToSLIP0044CoinType(ctx); err != nil {
+		t.Fatal(err)
+	}
+	otherCheckpoint, err := other.Checkpoint(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	forged, err := other.EncodeCheckpoint(ctx, otherCheckpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	restoredCfg := basicWalletConfig
+	restored, teardown := testWallet(ctx, t, &restoredCfg, seed)
+	defer teardown()
+	if err := restored.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	coinType, err := restored.CoinType(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if coinType != legacyCoinType {
+		t.Fatalf("restored wallet coin type %d, want %d", coinType, legacyCoinType)
+	}
+	if _, err := restored.ImportCheckpoint(ctx, forged); !errors.Is(err, errors.Invalid) {
+		t.Fatalf("imported checkpoint of other seed: %v", err)
+	}
+	coinType, err = restored.CoinType(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if coinType != legacyCoinType {
+		t.Fatalf("rejected checkpoint changed coin type to %d", coinType)
+	}
+
+	if _, err := restored.ImportCheckpoint(ctx, b); err != nil {
+		t.Fatal(err)
+	}
+	coinType, err = restored.CoinType(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if coinType != slip0044CoinType {
+		t.Errorf("restored wallet coin type %d, want %d", coinType, slip0044CoinType)
+	}
+}
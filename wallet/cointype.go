@@ -59,3 +59,51 @@ func (w *Wallet) UpgradeToSLIP0044CoinType(ctx context.Context) error {
 
 	return nil
 }
+
+// ImportSLIP0044Account merges a SLIP0044 coin type account into a wallet
+// which continues to use the legacy coin type, such as a wallet restored from a
+// seed with usage of both coin types.  The extended private key of the account
+// is derived from the SLIP0044 coin type key saved by the wallet and imported
+// as an account encrypted by passphrase.  Funds of the imported account can be
+// spent after unlocking it with the passphrase, and address usage is
+// discovered by a later rescan.  The wallet must be unlocked.
+func (w *Wallet) ImportSLIP0044Account(ctx context.Context, account uint32,
+	passphrase []byte, name string) (uint32, error) {
+
+	const op errors.Op = "wallet.ImportSLIP0044Account"
+
+	if account >= hdkeychain.HardenedKeyStart {
+		return 0, errors.E(op, errors.Invalid, "account number is too large")
+	}
+
+	var coinTypeXpriv *hdkeychain.ExtendedKey
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		legacyCoinType, _ := udb.CoinTypes(w.chainParams)
+		coinType, err := w.manager.CoinType(dbtx)
+		if err != nil {
+			return err
+		}
+		if coinType != legacyCoinType {
+			return errors.E(errors.Invalid, "wallet already uses the "+
+				"SLIP0044 coin type")
+		}
+		coinTypeXpriv, err = w.manager.SLIP0044CoinTypePrivKey(dbtx)
+		return err
+	})
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	acctXpriv, err := coinTypeXpriv.Child(hdkeychain.HardenedKeyStart + account)
+	coinTypeXpriv.Zero()
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	defer acctXpriv.Zero()
+
+	n, err := w.ImportVotingAccount(ctx, acctXpriv, passphrase, name)
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	log.Infof("Imported SLIP0044 account %d as account %d (%q)", account, n, name)
+	return n, nil
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"github.com/decred/dcrd/hdkeychain/v3"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/jrick/bitset"
+)
+
+// usedAddrsNetwork is a NetworkBackend reporting usage of a set of addresses
+// as an exists address index would.
+type usedAddrsNetwork struct {
+	mockNetwork
+	used map[string]struct{}
+}
+
+func (n usedAddrsNetwork) UsedAddresses(ctx context.Context, addrs []stdaddr.Address) (bitset.Bytes, error) {
+	bits := bitset.NewBytes(len(addrs))
+	for i, a := range addrs {
+		if _, ok := n.used[a.String()]; ok {
+			bits.Set(i)
+		}
+	}
+	return bits, nil
+}
+
+func TestSelectRestoredCoinType(t *testing.T) {
+	ctx := context.Background()
+	seed := bytes.Repeat([]byte{0x5e}, hdkeychain.RecommendedSeedLen)
+	params := basicWalletConfig.Params
+	legacyCoinType, slip0044CoinType := udb.CoinTypes(params)
+
+	_, _, legacyAcct, slip0044Acct, err := udb.HDKeysFromSeed(seed, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	firstAddr := func(acctXpriv *hdkeychain.ExtendedKey) string {
+		extKey, _, err := deriveBranches(acctXpriv.Neuter())
+		if err != nil {
+			t.Fatal(err)
+		}
+		addrs, err := deriveChildAddresses(extKey, 0, 1, params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return addrs[0].String()
+	}
+	legacyAddr, slip0044Addr := firstAddr(legacyAcct), firstAddr(slip0044Acct)
+
+	tests := []struct {
+		name     string
+		used     []string
+		coinType uint32
+	}{
+		{"unused", nil, slip0044CoinType},
+		{"legacy", []string{legacyAddr}, legacyCoinType},
+		{"slip0044", []string{slip0044Addr}, slip0044CoinType},
+		{"both", []string{legacyAddr, slip0044Addr}, legacyCoinType},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := basicWalletConfig
+			w, teardown := testWallet(ctx, t, &cfg, seed)
+			defer teardown()
+			if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+				t.Fatal(err)
+			}
+
+			n := usedAddrsNetwork{used: make(map[string]struct{})}
+			for _, a := range tc.used {
+				n.used[a] = struct{}{}
+			}
+			err := w.DiscoverActiveAddresses(ctx, n, &params.GenesisHash, true, cfg.GapLimit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			coinType, err := w.CoinType(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if coinType != tc.coinType {
+				t.Fatalf("coin type %d, want %d", coinType, tc.coinType)
+			}
+
+			// SLIP0044 accounts can only be imported by legacy coin type
+			// wallets.
+			acct, err := w.ImportSLIP0044Account(ctx, 0, []byte("slip0044"), "slip0044")
+			if coinType == slip0044CoinType {
+				if err == nil {
+					t.Fatal("imported SLIP0044 account into SLIP0044 wallet")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			xpub, err := w.AccountXpub(ctx, acct)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if xpub.String() != slip0044Acct.Neuter().String() {
+				t.Fatal("imported account does not derive SLIP0044 account 0")
+			}
+		})
+	}
+}
@@ -0,0 +1,258 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/txrules"
+	"decred.org/dcrwallet/v5/wallet/txsizes"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/hdkeychain/v3"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/wire"
+)
+
+// defaultColdSweepMaxInputs is the default limit of inputs spent by each
+// transaction of a cold storage sweep.
+const defaultColdSweepMaxInputs = 500
+
+// ColdSweepRequest describes a sweep of the entire spendable balance of an
+// account to cold storage.  Exactly one of DestAccount and DestXpub must be
+// set.
+type ColdSweepRequest struct {
+	SourceAccount uint32
+	MinConf       int32
+
+	// DestAccount is a wallet account, commonly a watching-only account,
+	// receiving the swept value at new external addresses.
+	DestAccount *uint32
+
+	// DestXpub is an account extended public key.  Swept value is paid to
+	// consecutive external branch addresses beginning at child
+	// DestXpubIndex.
+	DestXpub      *hdkeychain.ExtendedKey
+	DestXpubIndex uint32
+
+	// FeeRate is the fee per kilobyte, defaulting to the relay fee when
+	// zero.  When MaxFee is nonzero, the sweep is aborted if any
+	// transaction would pay a greater fee.
+	FeeRate dcrutil.Amount
+	MaxFee  dcrutil.Amount
+
+	// MaxInputs limits the number of inputs spent by each transaction.
+	// Sweeps of more outputs are batched across several transactions.
+	MaxInputs int
+
+	// DryRun creates the transactions without signing or publishing them,
+	// and without deriving new addresses of DestAccount.
+	DryRun bool
+}
+
+// ColdSweepTx describes a transaction of a cold storage sweep.  Amount is the
+// total value of the spent outputs, and the single output pays Amount less
+// Fee to Address.  Address is nil for dry runs to a wallet account.
+type ColdSweepTx struct {
+	Tx      *wire.MsgTx
+	Address stdaddr.Address
+	Inputs  int
+	Amount  dcrutil.Amount
+	Fee     dcrutil.Amount
+}
+
+// SweepToColdStorage spends all spendable outputs of an account to cold
+// storage in batches of at most MaxInputs inputs.  Each transaction pays a
+// single output and includes no change.  Unless the request is a dry run,
+// every transaction is signed and published, and the wallet must be unlocked.
+func (w *Wallet) SweepToColdStorage(ctx context.Context, n NetworkBackend,
+	req *ColdSweepRequest) ([]*ColdSweepTx, error) {
+
+	const op errors.Op = "wallet.SweepToColdStorage"
+	if err := w.checkVoteOnly(); err != nil {
+		return nil, errors.E(op, err)
+	}
+	if (req.DestAccount == nil) == (req.DestXpub == nil) {
+		return nil, errors.E(op, errors.Invalid, "exactly one destination account or xpub is required")
+	}
+	if req.DestAccount != nil && *req.DestAccount == req.SourceAccount {
+		return nil, errors.E(op, errors.Invalid, "destination is the source account")
+	}
+	if req.DestXpub != nil && req.DestXpub.IsPrivate() {
+		return nil, errors.E(op, errors.Invalid, "destination key must be an extended public key")
+	}
+	if req.MinConf < 0 {
+		return nil, errors.E(op, errors.Invalid, "negative minconf")
+	}
+	if req.FeeRate < 0 || req.MaxFee < 0 || req.MaxInputs < 0 {
+		return nil, errors.E(op, errors.Invalid, "negative fee or input limit")
+	}
+	feeRate := req.FeeRate
+	if feeRate == 0 {
+		feeRate = w.RelayFee()
+	}
+	if err := w.checkFeeRate(feeRate); err != nil {
+		return nil, errors.E(op, err)
+	}
+	maxInputs := req.MaxInputs
+	if maxInputs == 0 {
+		maxInputs = defaultColdSweepMaxInputs
+	}
+	var xpubBranch *hdkeychain.ExtendedKey
+	if req.DestXpub != nil {
+		var err error
+		xpubBranch, err = req.DestXpub.Child(0)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+
+	// Reserve every eligible output for the duration of the sweep.
+	var eligible []Input
+	w.lockedOutpointMu.Lock()
+	err := checkMixedSpendAccount(w.mixedSpend, req.SourceAccount)
+	if err == nil {
+		err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+			_, tipHeight := w.txStore.MainChainTip(dbtx)
+			var err error
+			eligible, err = w.findEligibleOutputs(dbtx, req.SourceAccount,
+				req.MinConf, tipHeight, nil)
+			return err
+		})
+	}
+	for i := range eligible {
+		op := &eligible[i].OutPoint
+		w.lockedOutpoints[outpoint{op.Hash, op.Index}] = struct{}{}
+	}
+	w.lockedOutpointMu.Unlock()
+	defer func() {
+		w.lockedOutpointMu.Lock()
+		for i := range eligible {
+			op := &eligible[i].OutPoint
+			delete(w.lockedOutpoints, outpoint{op.Hash, op.Index})
+		}
+		w.lockedOutpointMu.Unlock()
+	}()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if len(eligible) == 0 {
+		return nil, errors.E(op, errors.InsufficientBalance, "no spendable outputs")
+	}
+
+	// Create every transaction before publishing any of them so fee limits
+	// and dust checks apply to the sweep as a whole.
+	var sweep []*ColdSweepTx
+	var batches [][]Input
+	xpubChild := req.DestXpubIndex
+	for start := 0; start < len(eligible); start += maxInputs {
+		batch := eligible[start:min(start+maxInputs, len(eligible))]
+		batches = append(batches, batch)
+
+		var payTo stdaddr.Address
+		var pkScript []byte
+		var version uint16
+		switch {
+		case xpubBranch != nil:
+			payTo, err = deriveChildAddress(xpubBranch, xpubChild, w.chainParams)
+			if errors.Is(err, hdkeychain.ErrInvalidChild) {
+				xpubChild++
+				payTo, err = deriveChildAddress(xpubBranch, xpubChild, w.chainParams)
+			}
+			if err != nil {
+				return nil, errors.E(op, err)
+			}
+			xpubChild++
+			version, pkScript = payTo.PaymentScript()
+		case req.DryRun:
+			pkScript = p2pkhSizedScript
+		default:
+			payTo, err = w.NewExternalAddress(ctx, *req.DestAccount, WithGapPolicyWrap())
+			if err != nil {
+				return nil, errors.E(op, err)
+			}
+			version, pkScript = payTo.PaymentScript()
+		}
+
+		tx := wire.NewMsgTx()
+		var amount dcrutil.Amount
+		scriptSizes := make([]int, len(batch))
+		for i := range batch {
+			in := &batch[i]
+			tx.AddTxIn(wire.NewTxIn(&in.OutPoint, in.PrevOut.Value, nil))
+			amount += dcrutil.Amount(in.PrevOut.Value)
+			scriptSizes[i] = txsizes.RedeemP2PKHSigScriptSize
+		}
+		txOut := &wire.TxOut{Version: version, PkScript: pkScript}
+		tx.AddTxOut(txOut)
+		size := txsizes.EstimateSerializeSize(scriptSizes, tx.TxOut, 0)
+		fee := txrules.FeeForSerializeSize(feeRate, size)
+		if req.MaxFee != 0 && fee > req.MaxFee {
+			return nil, errors.E(op, errors.Invalid, errors.Errorf("sweep "+
+				"transaction fee %v exceeds maximum %v", fee, req.MaxFee))
+		}
+		txOut.Value = int64(amount - fee)
+		if txrules.IsDustOutput(txOut, feeRate) {
+			return nil, errors.E(op, errors.InsufficientBalance, errors.Errorf("swept "+
+				"value %v is too small to pay fee %v", amount, fee))
+		}
+		sweep = append(sweep, &ColdSweepTx{
+			Tx:      tx,
+			Address: payTo,
+			Inputs:  len(batch),
+			Amount:  amount,
+			Fee:     fee,
+		})
+	}
+	if req.DryRun {
+		return sweep, nil
+	}
+
+	var approvals []*SpendApproval
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		for _, s := range sweep {
+			if a := w.spendApproval(dbtx, s.Tx, -1, false); a != nil {
+				approvals = append(approvals, a)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	for _, a := range approvals {
+		if err := w.requestSpendApproval(ctx, a); err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		for i, s := range sweep {
+			if err := w.signP2PKHMsgTx(s.Tx, batches[i], addrmgrNs); err != nil {
+				return err
+			}
+			prevScripts := make([][]byte, len(batches[i]))
+			for j := range batches[i] {
+				prevScripts[j] = batches[i][j].PrevOut.PkScript
+			}
+			if err := validateMsgTx(op, s.Tx, prevScripts); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	w.noteSigningActivity()
+	for _, s := range sweep {
+		if _, err := w.PublishTransaction(ctx, s.Tx, n); err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+	return sweep, nil
+}
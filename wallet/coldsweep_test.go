@@ -0,0 +1,177 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet"
+	"decred.org/dcrwallet/v5/wallet/wallettest"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/hdkeychain/v3"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestSweepToColdStorage(t *testing.T) {
+	ctx := context.Background()
+	h := wallettest.New(t, nil)
+	w := h.Wallet
+	params := w.ChainParams()
+
+	var funds []*wire.MsgTx
+	for range 5 {
+		addr, err := w.NewExternalAddress(ctx, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		funds = append(funds, h.Chain.FundingTx(addr, 1e8))
+	}
+	if _, err := h.Chain.MineBlock(ctx, funds...); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Unlock(ctx, wallettest.PrivatePassphrase, nil); err != nil {
+		t.Fatal(err)
+	}
+	cold, err := w.NextAccount(ctx, "cold")
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Lock()
+
+	master, err := hdkeychain.NewMaster(bytes.Repeat([]byte{1}, 32), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	xpub := master.Neuter()
+	source := uint32(0)
+
+	invalid := []*wallet.ColdSweepRequest{
+		{},
+		{DestAccount: &cold, DestXpub: xpub},
+		{DestAccount: &source},
+		{DestXpub: master},
+		{DestAccount: &cold, MinConf: -1},
+		{DestAccount: &cold, MaxInputs: -1},
+	}
+	for i, req := range invalid {
+		if _, err := w.SweepToColdStorage(ctx, h.Chain, req); !errors.Is(err, errors.Invalid) {
+			t.Errorf("invalid request %d: %v", i, err)
+		}
+	}
+
+	// Dry runs batch the outputs without signing, publishing, or deriving
+	// addresses of the destination account.
+	sweep, err := w.SweepToColdStorage(ctx, h.Chain, &wallet.ColdSweepRequest{
+		DestAccount: &cold,
+		MaxInputs:   2,
+		DryRun:      true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sweep) != 3 {
+		t.Fatalf("dry run created %d transactions, want 3", len(sweep))
+	}
+	var inputs int
+	var amount dcrutil.Amount
+	for _, s := range sweep {
+		inputs += s.Inputs
+		amount += s.Amount
+		if s.Address != nil {
+			t.Errorf("dry run derived address %v", s.Address)
+		}
+		if len(s.Tx.TxOut) != 1 || s.Tx.TxOut[0].Value != int64(s.Amount-s.Fee) {
+			t.Errorf("dry run transaction pays %v", s.Tx.TxOut)
+		}
+	}
+	if inputs != 5 || amount != 5e8 {
+		t.Fatalf("dry run spends %d inputs of %v", inputs, amount)
+	}
+	if len(h.Chain.Published()) != 0 {
+		t.Fatal("dry run published transactions")
+	}
+	locked, err := w.LockedOutpoints(ctx, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(locked) != 0 {
+		t.Fatalf("sweep left %d outputs locked", len(locked))
+	}
+
+	// Xpub destinations pay consecutive external branch addresses.
+	sweep, err = w.SweepToColdStorage(ctx, h.Chain, &wallet.ColdSweepRequest{
+		DestXpub:      xpub,
+		DestXpubIndex: 3,
+		MaxInputs:     3,
+		DryRun:        true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	branch, err := xpub.Child(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, s := range sweep {
+		child, err := branch.Child(3 + uint32(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(
+			stdaddr.Hash160(child.SerializedPubKey()), params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.Address.String() != want.String() {
+			t.Errorf("transaction %d pays %v, want %v", i, s.Address, want)
+		}
+	}
+
+	// Fee limits apply to every transaction of the sweep.
+	_, err = w.SweepToColdStorage(ctx, h.Chain, &wallet.ColdSweepRequest{
+		DestAccount: &cold,
+		MaxFee:      1,
+		DryRun:      true,
+	})
+	if !errors.Is(err, errors.Invalid) {
+		t.Fatalf("sweep exceeding maximum fee: %v", err)
+	}
+
+	// Sweeps are signed and published by unlocked wallets.
+	req := &wallet.ColdSweepRequest{DestAccount: &cold, MaxInputs: 2}
+	if _, err := w.SweepToColdStorage(ctx, h.Chain, req); !errors.Is(err, errors.Locked) {
+		t.Fatalf("sweep by locked wallet: %v", err)
+	}
+	if err := w.Unlock(ctx, wallettest.PrivatePassphrase, nil); err != nil {
+		t.Fatal(err)
+	}
+	sweep, err = w.SweepToColdStorage(ctx, h.Chain, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	published := h.Chain.Published()
+	if len(sweep) != 3 || len(published) != 3 {
+		t.Fatalf("published %d of %d sweep transactions", len(published), len(sweep))
+	}
+	for i, s := range sweep {
+		if published[i].TxHash() != s.Tx.TxHash() {
+			t.Errorf("transaction %d was not published", i)
+		}
+		ka, err := w.KnownAddress(ctx, s.Address)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ka.AccountName() != "cold" {
+			t.Errorf("transaction %d pays account %q", i, ka.AccountName())
+		}
+	}
+	if _, err := w.SweepToColdStorage(ctx, h.Chain, req); !errors.Is(err, errors.InsufficientBalance) {
+		t.Fatalf("sweep of swept account: %v", err)
+	}
+}
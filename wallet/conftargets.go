@@ -0,0 +1,239 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+)
+
+// AddConfirmationTarget registers a notification of when a transaction reaches
+// a number of confirmations.  Targets are persisted until they are notified to
+// a ConfirmationTargetNotificationsClient, so a target reached while no client
+// is registered or the wallet is not running is notified when a client is next
+// registered.  Transactions need not be known to the wallet at the time of
+// registration.
+func (w *Wallet) AddConfirmationTarget(ctx context.Context, txHash *chainhash.Hash, target int32) error {
+	const op errors.Op = "wallet.AddConfirmationTarget"
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.PutConfirmationTarget(dbtx, txHash, target)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	w.NtfnServer.queueConfirmationTargets()
+	return nil
+}
+
+// RemoveConfirmationTarget removes a confirmation target which has not yet
+// been notified.
+func (w *Wallet) RemoveConfirmationTarget(ctx context.Context, txHash *chainhash.Hash, target int32) error {
+	const op errors.Op = "wallet.RemoveConfirmationTarget"
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.DeleteConfirmationTarget(dbtx, txHash, target)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// ConfirmationTargets returns all confirmation targets which have not yet
+// been notified.
+func (w *Wallet) ConfirmationTargets(ctx context.Context) ([]udb.ConfirmationTarget, error) {
+	const op errors.Op = "wallet.ConfirmationTargets"
+	var targets []udb.ConfirmationTarget
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		targets, err = udb.ConfirmationTargets(dbtx)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return targets, nil
+}
+
+// ConfirmationTargetNotification describes a transaction which has reached a
+// registered confirmation target.
+type ConfirmationTargetNotification struct {
+	TxHash        chainhash.Hash
+	Target        int32
+	Confirmations int32
+	BlockHash     chainhash.Hash
+	BlockHeight   int32
+}
+
+// ConfirmationTargetNotificationsClient receives notifications of reached
+// confirmation targets until the caller's context signals done.  Each reached
+// target is notified exactly once, to a single client, after which it is
+// removed from the database.
+type ConfirmationTargetNotificationsClient struct {
+	c   chan []ConfirmationTargetNotification
+	ctx context.Context
+}
+
+// ConfirmationTargetNotifications registers a client for notifications of
+// reached confirmation targets.  Targets which were reached before the client
+// was registered are notified immediately.
+func (s *NotificationServer) ConfirmationTargetNotifications(ctx context.Context) *ConfirmationTargetNotificationsClient {
+	c := &ConfirmationTargetNotificationsClient{
+		c:   make(chan []ConfirmationTargetNotification),
+		ctx: ctx,
+	}
+
+	s.mu.Lock()
+	s.targetClients = append(s.targetClients, c)
+	s.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		s.mu.Lock()
+		clients := s.targetClients
+		for i, sc := range clients {
+			if c == sc {
+				clients[i] = clients[len(clients)-1]
+				s.targetClients = clients[:len(clients)-1]
+				break
+			}
+		}
+		s.mu.Unlock()
+	}()
+
+	s.queueConfirmationTargets()
+	return c
+}
+
+// Recv waits for the next notification.  Returns context.Canceled when the
+// context is canceled.
+func (c *ConfirmationTargetNotificationsClient) Recv() ([]ConfirmationTargetNotification, error) {
+	select {
+	case <-c.ctx.Done():
+		return nil, context.Canceled
+	case n := <-c.c:
+		return n, nil
+	}
+}
+
+// queueConfirmationTargets begins delivering reached confirmation targets in
+// the background.  At most one delivery waits behind a delivery in progress,
+// as any waiting delivery will observe every target reached before it runs.
+func (s *NotificationServer) queueConfirmationTargets() {
+	if !s.targetQueued.CompareAndSwap(false, true) {
+		return
+	}
+	go func() {
+		s.targetMu.Lock()
+		s.targetQueued.Store(false)
+		defer s.targetMu.Unlock()
+
+		err := s.deliverConfirmationTargets(context.Background())
+		if err != nil {
+			log.Errorf("Failed to deliver confirmation target "+
+				"notifications: %v", err)
+		}
+	}()
+}
+
+// reachedConfirmationTargets returns notifications for each recorded target
+// which has been reached by a main chain transaction.
+func (w *Wallet) reachedConfirmationTargets(dbtx walletdb.ReadTx) ([]ConfirmationTargetNotification, error) {
+	targets, err := udb.ConfirmationTargets(dbtx)
+	if err != nil || len(targets) == 0 {
+		return nil, err
+	}
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+	_, tipHeight := w.txStore.MainChainTip(dbtx)
+	var reached []ConfirmationTargetNotification
+	for i := range targets {
+		t := &targets[i]
+		height, err := w.txStore.TxBlockHeight(dbtx, &t.TxHash)
+		if errors.Is(err, errors.NotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		confs := confirms(height, tipHeight)
+		if confs < t.Target {
+			continue
+		}
+		blockHash, err := w.txStore.GetMainChainBlockHashForHeight(txmgrNs, height)
+		if err != nil {
+			return nil, err
+		}
+		// Transactions of blocks invalidated by the next block's votes
+		// are not confirmed until they are mined again.
+		if tipHeight > height {
+			_, invalidated := w.txStore.BlockInMainChain(dbtx, &blockHash)
+			if invalidated {
+				continue
+			}
+		}
+		reached = append(reached, ConfirmationTargetNotification{
+			TxHash:        t.TxHash,
+			Target:        t.Target,
+			Confirmations: confs,
+			BlockHash:     blockHash,
+			BlockHeight:   height,
+		})
+	}
+	return reached, nil
+}
+
+// deliverConfirmationTargets notifies a registered client of all reached
+// confirmation targets and removes them from the database.  Targets are only
+// removed after a client has received them.  It must be called with targetMu
+// held.
+func (s *NotificationServer) deliverConfirmationTargets(ctx context.Context) error {
+	w := s.wallet
+	var reached []ConfirmationTargetNotification
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		reached, err = w.reachedConfirmationTargets(dbtx)
+		return err
+	})
+	if err != nil || len(reached) == 0 {
+		return err
+	}
+
+	for {
+		var c *ConfirmationTargetNotificationsClient
+		s.mu.Lock()
+		for _, sc := range s.targetClients {
+			if sc.ctx.Err() == nil {
+				c = sc
+				break
+			}
+		}
+		s.mu.Unlock()
+		if c == nil {
+			return nil
+		}
+
+		select {
+		case c.c <- reached:
+		case <-c.ctx.Done():
+			continue
+		}
+		break
+	}
+
+	return walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		for i := range reached {
+			n := &reached[i]
+			err := udb.DeleteConfirmationTarget(dbtx, &n.TxHash, n.Target)
+			if err != nil && !errors.Is(err, errors.NotExist) {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestConfirmationTargets(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	// Record a transaction mined in the genesis block, giving it a single
+	// confirmation.
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, 0, nil))
+	tx.AddTxOut(wire.NewTxOut(1e8, []byte{0x51}))
+	rec, err := udb.NewTxRecordFromMsgTx(tx, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return w.txStore.InsertMinedTx(dbtx, rec, &w.chainParams.GenesisHash)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := w.AddConfirmationTarget(ctx, &rec.Hash, 0); !errors.Is(err, errors.Invalid) {
+		t.Errorf("zero confirmation target accepted: %v", err)
+	}
+	unknown := chainhash.Hash{1}
+	for _, target := range []udb.ConfirmationTarget{
+		{TxHash: rec.Hash, Target: 1},
+		{TxHash: rec.Hash, Target: 2},
+		{TxHash: unknown, Target: 1},
+		{TxHash: unknown, Target: 6},
+	} {
+		if err := w.AddConfirmationTarget(ctx, &target.TxHash, target.Target); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.RemoveConfirmationTarget(ctx, &unknown, 6); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.RemoveConfirmationTarget(ctx, &unknown, 6); !errors.Is(err, errors.NotExist) {
+		t.Errorf("removed missing target: %v", err)
+	}
+
+	// The target reached before any client was registered is notified once
+	// a client registers.
+	c := w.NtfnServer.ConfirmationTargetNotifications(ctx)
+	n, err := c.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(n) != 1 || n[0].TxHash != rec.Hash || n[0].Target != 1 ||
+		n[0].Confirmations != 1 || n[0].BlockHash != w.chainParams.GenesisHash ||
+		n[0].BlockHeight != 0 {
+		t.Fatalf("unexpected notifications %+v", n)
+	}
+
+	// Wait for the notified target to be removed.
+	for {
+		w.NtfnServer.targetMu.Lock()
+		w.NtfnServer.targetMu.Unlock()
+		targets, err := w.ConfirmationTargets(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(targets) == 2 {
+			for _, target := range targets {
+				if target.Target == 1 && target.TxHash == rec.Hash {
+					t.Fatalf("notified target was not removed")
+				}
+			}
+			break
+		}
+		select {
+		case <-ctx.Done():
+			t.Fatalf("unexpected targets %+v", targets)
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
@@ -82,15 +82,35 @@ const (
 // account outputs.
 //
 // The changeSource and inputSource parameters are optional and can be nil.
-// When the changeSource is nil and change output should be added, an internal
-// change address is created for the account.  When the inputSource is nil,
-// the inputs will be selected by the wallet.
+// When the changeSource is nil and change output should be added, a change
+// address is created for the wallet's change policy, or the policy of the
+// WithChangePolicy option, and otherwise for the internal branch of the
+// account.  When the inputSource is nil, the inputs will be selected by the
+// wallet.  The transaction never expires unless the WithTxExpiry option is
+// provided.
 func (w *Wallet) NewUnsignedTransaction(ctx context.Context, outputs []*wire.TxOut,
 	relayFeePerKb dcrutil.Amount, account uint32, minConf int32,
-	algo OutputSelectionAlgorithm, changeSource txauthor.ChangeSource, inputSource txauthor.InputSource) (*txauthor.AuthoredTx, error) {
+	algo OutputSelectionAlgorithm, changeSource txauthor.ChangeSource, inputSource txauthor.InputSource,
+	opts ...TxAuthorOption) (*txauthor.AuthoredTx, error) {
 
 	const op errors.Op = "wallet.NewUnsignedTransaction"
 
+	if err := w.checkFeeRate(relayFeePerKb); err != nil {
+		return nil, errors.E(op, err)
+	}
+	var changePolicy *ChangePolicy
+	if changeSource == nil {
+		var err error
+		changePolicy, err = w.resolveChangePolicy(ctx, opts, false)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+	expiry, err := resolveTxExpiry(0, opts)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
 	ignoreInput := func(op *wire.OutPoint) bool {
 		_, ok := w.lockedOutpoints[outpoint{op.Hash, op.Index}]
 		return ok
@@ -100,7 +120,7 @@ func (w *Wallet) NewUnsignedTransaction(ctx context.Context, outputs []*wire.TxO
 
 	var authoredTx *txauthor.AuthoredTx
 	var changeSourceUpdates []func(walletdb.ReadWriteTx) error
-	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
 		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
 		_, tipHeight := w.txStore.MainChainTip(dbtx)
 
@@ -115,8 +135,11 @@ func (w *Wallet) NewUnsignedTransaction(ctx context.Context, outputs []*wire.TxO
 		}
 
 		if inputSource == nil {
+			if err := checkMixedSpendAccount(w.mixedSpend, account); err != nil {
+				return err
+			}
 			sourceImpl := w.txStore.MakeInputSource(dbtx, account,
-				minConf, tipHeight, ignoreInput)
+				minConf, tipHeight, w.mixedSpendIgnore(dbtx, ignoreInput))
 			switch algo {
 			case OutputSelectionAlgorithmDefault:
 				inputSource = sourceImpl.SelectInputs
@@ -136,7 +159,16 @@ func (w *Wallet) NewUnsignedTransaction(ctx context.Context, outputs []*wire.TxO
 			}
 		}
 
-		if changeSource == nil {
+		switch {
+		case changeSource != nil:
+		case changePolicy != nil:
+			changeSource = &policyChangeSource{
+				persist: w.deferPersistReturnedChild(ctx, &changeSourceUpdates),
+				policy:  *changePolicy,
+				wallet:  w,
+				ctx:     context.Background(),
+			}
+		default:
 			changeSource = &p2PKHChangeSource{
 				persist: w.deferPersistReturnedChild(ctx, &changeSourceUpdates),
 				account: account,
@@ -149,10 +181,12 @@ func (w *Wallet) NewUnsignedTransaction(ctx context.Context, outputs []*wire.TxO
 		authoredTx, err = txauthor.NewUnsignedTransaction(outputs, relayFeePerKb,
 			inputSource, changeSource, w.chainParams.MaxTxSize)
 		if err != nil {
-			return err
+			return mixedSpendError(w.mixedSpend, err)
 		}
+		authoredTx.Tx.Expiry = expiryHeight(tipHeight, expiry)
 
-		return nil
+		return w.checkSpendDestinations(dbtx, authoredTx.Tx,
+			authoredTx.PrevScripts, destinationOverride(opts))
 	})
 	if err != nil {
 		return nil, errors.E(op, err)
@@ -195,62 +229,6 @@ func (s *secretSource) GetScript(addr stdaddr.Address) ([]byte, error) {
 	return s.Manager.RedeemScript(s.addrmgrNs, addr)
 }
 
-// SecretsSource is an implementation of txauthor.SecretsSource querying the
-// wallet's address manager.
-//
-// The Close method must be called after the SecretsSource usage is over.
-type SecretsSource struct {
-	wallet    *Wallet
-	dbtx      walletdb.ReadTx
-	doneFuncs []func()
-}
-
-// SecretsSource returns a txauthor.SecretsSource implementor using the wallet
-// as the backing store for keys and scripts.
-func (w *Wallet) SecretsSource() (*SecretsSource, error) {
-	dbtx, err := w.db.BeginReadTx()
-	if err != nil {
-		return nil, err
-	}
-	return &SecretsSource{wallet: w, dbtx: dbtx}, nil
-}
-
-// ChainParams returns the chain parameters.
-func (s *SecretsSource) ChainParams() *chaincfg.Params {
-	return s.wallet.chainParams
-}
-
-// GetKey provides the private key associated with an address.
-func (s *SecretsSource) GetKey(addr stdaddr.Address) (key []byte, sigType dcrec.SignatureType, compressed bool, err error) {
-	addrmgrNs := s.dbtx.ReadBucket(waddrmgrNamespaceKey)
-	privKey, done, err := s.wallet.manager.PrivateKey(addrmgrNs, addr)
-	if err != nil {
-		return
-	}
-	s.doneFuncs = append(s.doneFuncs, done)
-	return privKey.Serialize(), dcrec.STEcdsaSecp256k1, true, nil
-}
-
-// GetScript provides the redeem script for a P2SH address.
-func (s *SecretsSource) GetScript(addr stdaddr.Address) ([]byte, error) {
-	addrmgrNs := s.dbtx.ReadBucket(waddrmgrNamespaceKey)
-	return s.wallet.manager.RedeemScript(addrmgrNs, addr)
-}
-
-// Close finishes the SecretsSource usage by releasing all secret key material
-// and closing the underlying database transaction.
-func (s *SecretsSource) Close() error {
-	for _, f := range s.doneFuncs {
-		f()
-	}
-	s.doneFuncs = nil
-	err := s.dbtx.Rollback()
-	if err == nil {
-		s.dbtx = nil
-	}
-	return err
-}
-
 // CreatedTx holds the state of a newly-created transaction and the change
 // output (if one was added).
 type CreatedTx struct {
@@ -273,6 +251,10 @@ func (w *Wallet) insertIntoTxMgr(dbtx walletdb.ReadWriteTx, msgTx *wire.MsgTx) (
 	if err != nil {
 		return nil, err
 	}
+	err = journalTx(dbtx, udb.JournalTxUnmined, &rec.Hash, nil)
+	if err != nil {
+		return nil, err
+	}
 	return rec, nil
 }
 
@@ -285,6 +267,7 @@ func (w *Wallet) insertCreditsIntoTxMgr(op errors.Op, dbtx walletdb.ReadWriteTx,
 
 	// Check every output to determine whether it is controlled by a wallet
 	// key.  If so, mark the output as a credit.
+	var used []udb.ManagedAddress
 	for i, output := range msgTx.TxOut {
 		_, addrs := stdscript.ExtractAddrs(output.Version, output.PkScript, w.chainParams)
 		for _, addr := range addrs {
@@ -298,11 +281,7 @@ func (w *Wallet) insertCreditsIntoTxMgr(op errors.Op, dbtx walletdb.ReadWriteTx,
 				if err != nil {
 					return errors.E(op, err)
 				}
-				err = w.markUsedAddress(op, dbtx, ma)
-				if err != nil {
-					return err
-				}
-				log.Debugf("Marked address %v used", addr)
+				used = append(used, ma)
 				continue
 			}
 
@@ -314,7 +293,7 @@ func (w *Wallet) insertCreditsIntoTxMgr(op errors.Op, dbtx walletdb.ReadWriteTx,
 		}
 	}
 
-	return nil
+	return w.markUsedAddresses(op, dbtx, used, nil)
 }
 
 // insertMultisigOutIntoTxMgr inserts a multisignature output into the
@@ -354,7 +333,7 @@ func (w *Wallet) publishAndWatch(ctx context.Context, op errors.Op, n NetworkBac
 		}
 	}
 
-	err := n.PublishTransactions(ctx, tx)
+	err := w.publishTransactions(ctx, n, tx)
 	if err != nil {
 		hash := tx.TxHash()
 		log.Errorf("Abandoning transaction %v which failed to publish", &hash)
@@ -383,11 +362,24 @@ type authorTx struct {
 	outputs            []*wire.TxOut
 	account            uint32
 	changeAccount      uint32
+	changePolicy       *ChangePolicy // overrides changeAccount when non-nil
 	minconf            int32
 	randomizeChangeIdx bool
 	txFee              dcrutil.Amount
 	dontSignTx         bool
 	isTreasury         bool
+	sourceBranches     sourceBranches
+	override           []byte // destination policy override credential
+	expiry             int32  // blocks in which the tx may be mined, or 0
+
+	// background is set for transactions of ticket purchases, which may
+	// be automated, and whose signing does not extend the idle lock.
+	background bool
+
+	// idempotencyKey is recorded with the transaction by
+	// recordAuthoredTx when non-empty.
+	idempotencyKey     string
+	idempotencyRequest chainhash.Hash
 
 	atx                 *txauthor.AuthoredTx
 	changeSourceUpdates []func(walletdb.ReadWriteTx) error
@@ -401,6 +393,14 @@ type authorTx struct {
 // wallet's current relay fee.  The wallet must be unlocked to create the
 // transaction.
 func (w *Wallet) authorTx(ctx context.Context, op errors.Op, a *authorTx) error {
+	if !a.dontSignTx {
+		if err := w.checkVoteOnly(); err != nil {
+			return errors.E(op, err)
+		}
+	}
+	if err := w.checkFeeRate(a.txFee); err != nil {
+		return errors.E(op, err)
+	}
 	var unlockOutpoints []*wire.OutPoint
 	defer func() {
 		for _, op := range unlockOutpoints {
@@ -416,15 +416,28 @@ func (w *Wallet) authorTx(ctx context.Context, op errors.Op, a *authorTx) error
 
 	var atx *txauthor.AuthoredTx
 	var changeSourceUpdates []func(walletdb.ReadWriteTx) error
+	var approval *SpendApproval
 	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
-		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
-
 		// Create the unsigned transaction.
 		_, tipHeight := w.txStore.MainChainTip(dbtx)
+		if err := checkMixedSpendAccount(w.mixedSpend, a.account); err != nil {
+			return err
+		}
+		ignore := a.sourceBranches.ignore(w, dbtx, ignoreInput)
 		inputSource := w.txStore.MakeInputSource(dbtx, a.account,
-			a.minconf, tipHeight, ignoreInput)
+			a.minconf, tipHeight, w.mixedSpendIgnore(dbtx, ignore))
 		var changeSource txauthor.ChangeSource
-		if a.isTreasury {
+		switch {
+		case a.changePolicy != nil:
+			changeSource = &policyChangeSource{
+				persist: w.deferPersistReturnedChild(ctx,
+					&changeSourceUpdates),
+				policy:   *a.changePolicy,
+				treasury: a.isTreasury,
+				wallet:   w,
+				ctx:      ctx,
+			}
+		case a.isTreasury:
 			changeSource = &p2PKHTreasuryChangeSource{
 				persist: w.deferPersistReturnedChild(ctx,
 					&changeSourceUpdates),
@@ -432,7 +445,7 @@ func (w *Wallet) authorTx(ctx context.Context, op errors.Op, a *authorTx) error
 				wallet:  w,
 				ctx:     ctx,
 			}
-		} else {
+		default:
 			changeSource = &p2PKHChangeSource{
 				persist: w.deferPersistReturnedChild(ctx,
 					&changeSourceUpdates),
@@ -447,8 +460,9 @@ func (w *Wallet) authorTx(ctx context.Context, op errors.Op, a *authorTx) error
 			inputSource.SelectInputs, changeSource,
 			w.chainParams.MaxTxSize)
 		if err != nil {
-			return err
+			return mixedSpendError(w.mixedSpend, err)
 		}
+		atx.Tx.Expiry = expiryHeight(tipHeight, a.expiry)
 		for _, in := range atx.Tx.TxIn {
 			prev := &in.PreviousOutPoint
 			w.lockedOutpoints[outpoint{prev.Hash, prev.Index}] = struct{}{}
@@ -478,18 +492,55 @@ func (w *Wallet) authorTx(ctx context.Context, op errors.Op, a *authorTx) error
 			atx.Tx.Version = wire.TxVersionTreasury
 		}
 
+		err = w.checkSpendDestinations(dbtx, atx.Tx, atx.PrevScripts, a.override)
+		if err != nil {
+			return err
+		}
 		if !a.dontSignTx {
-			// Sign the transaction.
+			approval = w.spendApproval(dbtx, atx.Tx, atx.ChangeIndex, false)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	if approval != nil {
+		// Other transactions may be created while waiting for approval,
+		// and the selected inputs are reserved so that they can not be
+		// unlocked and selected by them in the meantime.
+		for _, prev := range unlockOutpoints {
+			w.approvalOutpoints[outpoint{prev.Hash, prev.Index}] = struct{}{}
+		}
+		w.lockedOutpointMu.Unlock()
+		err := w.requestSpendApproval(ctx, approval)
+		w.lockedOutpointMu.Lock()
+		for _, prev := range unlockOutpoints {
+			delete(w.approvalOutpoints, outpoint{prev.Hash, prev.Index})
+		}
+		if err != nil {
+			return errors.E(op, err)
+		}
+	}
+	if !a.dontSignTx {
+		// Sign the transaction.
+		err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+			addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
 			secrets := &secretSource{Manager: w.manager, addrmgrNs: addrmgrNs}
-			err = atx.AddAllInputScripts(secrets)
+			start := time.Now()
+			err := atx.AddAllInputScripts(secrets)
+			w.timings.observe(TimingSign, start)
 			for _, done := range secrets.doneFuncs {
 				done()
 			}
+			return err
+		})
+		if err != nil {
+			return errors.E(op, err)
+		}
+		if !a.background {
+			w.noteSigningActivity()
 		}
-		return err
-	})
-	if err != nil {
-		return errors.E(op, err)
 	}
 
 	// Warn when spending UTXOs controlled by imported keys created change for
@@ -549,7 +600,15 @@ func (w *Wallet) recordAuthoredTx(ctx context.Context, op errors.Op, a *authorTx
 		// relevant transactions, since this does a lot of extra work.
 		var err error
 		watch, err = w.processTransactionRecord(ctx, dbtx, rec, nil, nil)
-		return err
+		if err != nil || a.idempotencyKey == "" {
+			return err
+		}
+		return udb.PutIdempotentRequest(dbtx, a.idempotencyKey,
+			&udb.IdempotentRequest{
+				Created:     rec.Received,
+				RequestHash: a.idempotencyRequest,
+				TxHashes:    []chainhash.Hash{rec.Hash},
+			})
 	})
 	if err != nil {
 		return errors.E(op, err)
@@ -585,6 +644,13 @@ func (w *Wallet) txToMultisig(ctx context.Context, op errors.Op, account uint32,
 func (w *Wallet) txToMultisigInternal(ctx context.Context, op errors.Op, dbtx walletdb.ReadWriteTx, account uint32, amount dcrutil.Amount,
 	pubkeys [][]byte, nRequired int8, minconf int32) (*CreatedTx, stdaddr.Address, []byte, error) {
 
+	if err := w.checkVoteOnly(); err != nil {
+		return nil, nil, nil, errors.E(op, err)
+	}
+	if err := w.checkFeeRate(w.RelayFee()); err != nil {
+		return nil, nil, nil, errors.E(op, err)
+	}
+
 	addrmgrNs := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
 
 	txToMultisigError := func(err error) (*CreatedTx, stdaddr.Address, []byte, error) {
@@ -614,12 +680,16 @@ func (w *Wallet) txToMultisigInternal(ctx context.Context, op errors.Op, dbtx wa
 	}
 	amountRequired := amount + feeEstForTx
 
+	if err := checkMixedSpendAccount(w.mixedSpend, account); err != nil {
+		return txToMultisigError(errors.E(op, err))
+	}
+
 	// Instead of taking reward addresses by arg, just create them now  and
 	// automatically find all eligible outputs from all current utxos.
 	const minAmount = 0
 	const maxResults = 0
 	eligible, err := w.findEligibleOutputsAmount(dbtx, account, minconf,
-		amountRequired, topHeight, minAmount, maxResults)
+		amountRequired, topHeight, minAmount, maxResults, nil)
 	if err != nil {
 		return txToMultisigError(errors.E(op, err))
 	}
@@ -707,17 +777,21 @@ func (w *Wallet) txToMultisigInternal(ctx context.Context, op errors.Op, dbtx wa
 		})
 	}
 
+	if w.spendApproval(dbtx, msgtx, -1, false) != nil {
+		return txToMultisigError(errors.E(op, errApprovalUnsupported))
+	}
 	err = w.signP2PKHMsgTx(msgtx, forSigning, addrmgrNs)
 	if err != nil {
 		return txToMultisigError(errors.E(op, err))
 	}
+	w.noteSigningActivity()
 
 	err = w.checkHighFees(totalInput, msgtx)
 	if err != nil {
 		return txToMultisigError(errors.E(op, err))
 	}
 
-	err = n.PublishTransactions(ctx, msgtx)
+	err = w.publishTransactions(ctx, n, msgtx)
 	if err != nil {
 		return txToMultisigError(errors.E(op, err))
 	}
@@ -795,6 +869,10 @@ func (w *Wallet) compressWallet(ctx context.Context, op errors.Op, maxNumIns int
 func (w *Wallet) compressWalletInternal(ctx context.Context, op errors.Op, dbtx walletdb.ReadWriteTx, maxNumIns int, account uint32,
 	changeAddr stdaddr.Address) (*chainhash.Hash, error) {
 
+	if err := w.checkVoteOnly(); err != nil {
+		return nil, errors.E(op, err)
+	}
+
 	addrmgrNs := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
 
 	n, err := w.NetworkBackend()
@@ -806,7 +884,7 @@ func (w *Wallet) compressWalletInternal(ctx context.Context, op errors.Op, dbtx
 	_, tipHeight := w.txStore.MainChainTip(dbtx)
 
 	minconf := int32(1)
-	eligible, err := w.findEligibleOutputs(dbtx, account, minconf, tipHeight)
+	eligible, err := w.findEligibleOutputs(dbtx, account, minconf, tipHeight, nil)
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
@@ -871,6 +949,9 @@ func (w *Wallet) compressWalletInternal(ctx context.Context, op errors.Op, dbtx
 	// Get an initial fee estimate based on the number of selected inputs
 	// and added outputs, with no change.
 	feeRate := w.RelayFee()
+	if err := w.checkFeeRate(feeRate); err != nil {
+		return nil, errors.E(op, err)
+	}
 	szEst := txsizes.EstimateSerializeSize(scriptSizes, msgtx.TxOut, 0)
 	feeEst := txrules.FeeForSerializeSize(feeRate, szEst)
 
@@ -879,10 +960,14 @@ func (w *Wallet) compressWalletInternal(ctx context.Context, op errors.Op, dbtx
 		return nil, errors.E(op, errors.InsufficientBalance)
 	}
 
+	if w.spendApproval(dbtx, msgtx, -1, false) != nil {
+		return nil, errors.E(op, errApprovalUnsupported)
+	}
 	err = w.signP2PKHMsgTx(msgtx, forSigning, addrmgrNs)
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
+	w.noteSigningActivity()
 	err = validateMsgTx(op, msgtx, creditScripts(forSigning))
 	if err != nil {
 		return nil, errors.E(op, err)
@@ -893,7 +978,7 @@ func (w *Wallet) compressWalletInternal(ctx context.Context, op errors.Op, dbtx
 		return nil, errors.E(op, err)
 	}
 
-	err = n.PublishTransactions(ctx, msgtx)
+	err = w.publishTransactions(ctx, n, msgtx)
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
@@ -1030,8 +1115,12 @@ func (w *Wallet) mixedSplit(ctx context.Context, req *PurchaseTicketsRequest, ne
 	var atx *txauthor.AuthoredTx
 	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
 		_, tipHeight := w.txStore.MainChainTip(dbtx)
+		if err := checkMixedSpendAccount(w.mixedSpend, req.SourceAccount); err != nil {
+			return err
+		}
+		ignore := sourceBranches(req.SourceBranches).ignore(w, dbtx, ignoreInput)
 		inputSource := w.txStore.MakeInputSource(dbtx, req.SourceAccount,
-			req.MinConf, tipHeight, ignoreInput)
+			req.MinConf, tipHeight, w.mixedSpendIgnore(dbtx, ignore))
 		changeSource := &p2PKHChangeSource{
 			persist:   w.deferPersistReturnedChild(ctx, &changeSourceUpdates),
 			account:   req.ChangeAccount,
@@ -1044,7 +1133,7 @@ func (w *Wallet) mixedSplit(ctx context.Context, req *PurchaseTicketsRequest, ne
 			inputSource.SelectInputs, changeSource,
 			w.chainParams.MaxTxSize)
 		if err != nil {
-			return err
+			return mixedSpendError(w.mixedSpend, err)
 		}
 		for _, in := range atx.Tx.TxIn {
 			prev := &in.PreviousOutPoint
@@ -1127,6 +1216,8 @@ func (w *Wallet) individualSplit(ctx context.Context, req *PurchaseTicketsReques
 		txFee:              w.RelayFee(),
 		dontSignTx:         req.DontSignTx,
 		isTreasury:         false,
+		sourceBranches:     req.SourceBranches,
+		background:         true,
 	}
 	err = w.authorTx(ctx, op, a)
 	if err != nil {
@@ -1178,6 +1269,23 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 		return nil, errors.E(op, errors.Invalid, "expiry height must be above next block height")
 	}
 
+	// Tickets with a voting script all commit to the script's P2SH
+	// address.  The script is imported before any ticket is created so that
+	// the tickets are recorded as owned.
+	var scriptVote *stdaddr.AddressScriptHashV0
+	if req.VotingScript != nil {
+		scriptVote, err = multisigVotingAddress(req.VotingScript, w.chainParams)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		if err := w.checkVotingScriptKeys(ctx, req.VotingScript); err != nil {
+			return nil, errors.E(op, err)
+		}
+		if err := w.importVotingScript(ctx, req.VotingScript); err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+
 	stakeAddrFunc := func(op errors.Op, account, branch uint32) (stdaddr.StakeAddress, uint32, error) {
 		const accountName = "" // not used, so can be faked.
 		a, err := w.nextAddress(ctx, op, w.persistReturnedChild(ctx, nil), accountName,
@@ -1198,6 +1306,15 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 		}
 	}
 
+	// Each ticket commits to fresh voting and commitment addresses, as
+	// reuse links the tickets to the same owner.
+	ticketAddrs := &freshTicketAddresses{
+		w:      w,
+		derive: stakeAddrFunc,
+		check:  w.ticketAddressCheck(),
+		seen:   make(map[string]struct{}),
+	}
+
 	// Calculate the current ticket price.  If the DCP0001 deployment is not
 	// active, fallback to querying the ticket price over RPC.
 	ticketPrice, err := w.NextStakeDifficulty(ctx)
@@ -1217,6 +1334,9 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 	var neededPerTicket dcrutil.Amount
 	var estSize int
 	ticketRelayFee := w.RelayFee()
+	if err := w.checkFeeRate(ticketRelayFee); err != nil {
+		return nil, errors.E(op, err)
+	}
 
 	// A solo ticket has:
 	//   - a single input redeeming a P2PKH for the worst case size
@@ -1315,8 +1435,8 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 		var lowBalance bool
 		for i := 0; i < req.Count; i++ {
 			if req.extraSplitOutput == nil {
-				credits, err := w.ReserveOutputsForAmount(ctx,
-					req.SourceAccount, fee, req.MinConf)
+				credits, err := w.reserveOutputsForAmount(ctx,
+					req.SourceAccount, fee, req.MinConf, req.SourceBranches)
 
 				if errors.Is(err, errors.InsufficientBalance) {
 					lowBalance = true
@@ -1329,12 +1449,12 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 				vspFeeCredits = append(vspFeeCredits, credits)
 			}
 
-			credits, err := w.ReserveOutputsForAmount(ctx, req.SourceAccount,
-				ticketPrice, req.MinConf)
+			credits, err := w.reserveOutputsForAmount(ctx, req.SourceAccount,
+				ticketPrice, req.MinConf, req.SourceBranches)
 			if errors.Is(err, errors.InsufficientBalance) {
 				lowBalance = true
 				credits, _ = w.reserveOutputs(ctx, req.SourceAccount,
-					req.MinConf)
+					req.MinConf, req.SourceBranches)
 				if len(credits) != 0 {
 					ticketCredits = append(ticketCredits, credits)
 				}
@@ -1468,7 +1588,7 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 		w.recentlyPublishedMu.Lock()
 		w.recentlyPublished[rec.Hash] = struct{}{}
 		w.recentlyPublishedMu.Unlock()
-		err = n.PublishTransactions(ctx, splitTx)
+		err = w.publishTransactions(ctx, n, splitTx)
 		if err != nil {
 			return nil, err
 		}
@@ -1508,14 +1628,20 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 			PrevOut:  *txOut,
 		}
 
-		addrVote, idx, err := stakeAddrFunc(op, req.VotingAccount, 1)
-		if err != nil {
-			return nil, err
-		}
-		_, err = w.signingAddressAtIdx(ctx, op, w.persistReturnedChild(ctx, nil),
-			req.VotingAccount, idx)
-		if err != nil {
-			return nil, err
+		var addrVote stdaddr.StakeAddress
+		if scriptVote != nil {
+			addrVote = scriptVote
+		} else {
+			var idx uint32
+			addrVote, idx, err = ticketAddrs.next(ctx, op, req.VotingAccount, 1)
+			if err != nil {
+				return nil, err
+			}
+			_, err = w.signingAddressAtIdx(ctx, op, w.persistReturnedChild(ctx, nil),
+				req.VotingAccount, idx)
+			if err != nil {
+				return nil, err
+			}
 		}
 		subsidyAccount := req.SourceAccount
 		var branch uint32 = 1
@@ -1523,7 +1649,7 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 			subsidyAccount = req.MixedAccount
 			branch = req.MixedAccountBranch
 		}
-		addrSubsidy, _, err := stakeAddrFunc(op, subsidyAccount, branch)
+		addrSubsidy, _, err := ticketAddrs.next(ctx, op, subsidyAccount, branch)
 		if err != nil {
 			return nil, err
 		}
@@ -1614,7 +1740,7 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 		}
 
 		// Publish transaction
-		err = n.PublishTransactions(ctx, ticket)
+		err = w.publishTransactions(ctx, n, ticket)
 		if err != nil {
 			return purchaseTicketsResponse, errors.E(op, err)
 		}
@@ -1664,6 +1790,14 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 // ReserveOutputsForAmount returns locked spendable outpoints from the given
 // account.  It is the responsibility of the caller to unlock the outpoints.
 func (w *Wallet) ReserveOutputsForAmount(ctx context.Context, account uint32, amount dcrutil.Amount, minconf int32) ([]Input, error) {
+	return w.reserveOutputsForAmount(ctx, account, amount, minconf, nil)
+}
+
+// reserveOutputsForAmount returns locked spendable outpoints from the given
+// account and branches.
+func (w *Wallet) reserveOutputsForAmount(ctx context.Context, account uint32, amount dcrutil.Amount,
+	minconf int32, branches sourceBranches) ([]Input, error) {
+
 	defer w.lockedOutpointMu.Unlock()
 	w.lockedOutpointMu.Lock()
 
@@ -1676,7 +1810,7 @@ func (w *Wallet) ReserveOutputsForAmount(ctx context.Context, account uint32, am
 		const minAmount = 0
 		const maxResults = 0
 		outputs, err = w.findEligibleOutputsAmount(dbtx, account, minconf, amount, tipHeight,
-			minAmount, maxResults)
+			minAmount, maxResults, branches)
 		if err != nil {
 			return err
 		}
@@ -1694,7 +1828,9 @@ func (w *Wallet) ReserveOutputsForAmount(ctx context.Context, account uint32, am
 	return outputs, nil
 }
 
-func (w *Wallet) reserveOutputs(ctx context.Context, account uint32, minconf int32) ([]Input, error) {
+func (w *Wallet) reserveOutputs(ctx context.Context, account uint32, minconf int32,
+	branches sourceBranches) ([]Input, error) {
+
 	defer w.lockedOutpointMu.Unlock()
 	w.lockedOutpointMu.Lock()
 
@@ -1704,7 +1840,7 @@ func (w *Wallet) reserveOutputs(ctx context.Context, account uint32, minconf int
 		_, tipHeight := w.txStore.MainChainTip(dbtx)
 
 		var err error
-		outputs, err = w.findEligibleOutputs(dbtx, account, minconf, tipHeight)
+		outputs, err = w.findEligibleOutputs(dbtx, account, minconf, tipHeight, branches)
 		if err != nil {
 			return err
 		}
@@ -1726,7 +1862,7 @@ func (w *Wallet) reserveOutputs(ctx context.Context, account uint32, minconf int
 // outputs.  Prefer to use findEligibleOutputsAmount with various filter options
 // instead.
 func (w *Wallet) findEligibleOutputs(dbtx walletdb.ReadTx, account uint32, minconf int32,
-	currentHeight int32) ([]Input, error) {
+	currentHeight int32, branches sourceBranches) ([]Input, error) {
 
 	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
 
@@ -1803,6 +1939,12 @@ func (w *Wallet) findEligibleOutputs(dbtx walletdb.ReadTx, account uint32, minco
 		if err != nil || addrAcct != account {
 			continue
 		}
+		if !w.mixedSpendAddr(addrmgrNs, addrs[0]) {
+			continue
+		}
+		if !branches.allows(w, addrmgrNs, addrs[0]) {
+			continue
+		}
 
 		txOut := &wire.TxOut{
 			Value:    int64(output.Amount),
@@ -1821,7 +1963,8 @@ func (w *Wallet) findEligibleOutputs(dbtx walletdb.ReadTx, account uint32, minco
 // findEligibleOutputsAmount uses wtxmgr to find a number of unspent outputs
 // while doing maturity checks there.
 func (w *Wallet) findEligibleOutputsAmount(dbtx walletdb.ReadTx, account uint32, minconf int32,
-	amount dcrutil.Amount, currentHeight int32, minAmount dcrutil.Amount, maxResults int) ([]Input, error) {
+	amount dcrutil.Amount, currentHeight int32, minAmount dcrutil.Amount, maxResults int,
+	branches sourceBranches) ([]Input, error) {
 
 	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
 
@@ -1895,6 +2038,12 @@ func (w *Wallet) findEligibleOutputsAmount(dbtx walletdb.ReadTx, account uint32,
 		if err != nil || addrAcct != account {
 			return true
 		}
+		if !w.mixedSpendAddr(addrmgrNs, addrs[0]) {
+			return true
+		}
+		if !branches.allows(w, addrmgrNs, addrs[0]) {
+			return true
+		}
 
 		return false
 	}
@@ -1983,7 +2132,10 @@ func (w *Wallet) findEligibleOutputsAmount(dbtx walletdb.ReadTx, account uint32,
 // signP2PKHMsgTx sets the SignatureScript for every item in msgtx.TxIn.
 // It must be called every time a msgtx is changed.
 // Only P2PKH outputs are supported at this point.
+// Signing does not extend the idle lock, as ticket purchases may be
+// automated, and other callers must note the signing activity.
 func (w *Wallet) signP2PKHMsgTx(msgtx *wire.MsgTx, prevOutputs []Input, addrmgrNs walletdb.ReadBucket) error {
+	defer w.timings.observe(TimingSign, time.Now())
 	if len(prevOutputs) != len(msgtx.TxIn) {
 		return errors.Errorf(
 			"Number of prevOutputs (%d) does not match number of tx inputs (%d)",
@@ -2019,6 +2171,8 @@ func (w *Wallet) signP2PKHMsgTx(msgtx *wire.MsgTx, prevOutputs []Input, addrmgrN
 // signVoteOrRevocation signs a vote or revocation, specified by the isVote
 // argument.  This signs the transaction by modifying tx's input scripts.
 func (w *Wallet) signVoteOrRevocation(addrmgrNs walletdb.ReadBucket, ticketPurchase, tx *wire.MsgTx, isVote bool) error {
+	defer w.timings.observe(TimingSign, time.Now())
+
 	// Create a slice of functions to run after the retreived secrets are no
 	// longer needed.
 	doneFuncs := make([]func(), 0, len(tx.TxIn))
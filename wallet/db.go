@@ -5,6 +5,7 @@
 package wallet
 
 import (
+	"context"
 	"io"
 
 	"decred.org/dcrwallet/v5/errors"
@@ -49,3 +50,24 @@ func CreateDB(driver string, args ...any) (DB, error) {
 	}
 	return opaqueDB{db}, nil
 }
+
+// ExportDB writes the entire contents of the database to w in a canonical,
+// driver-independent format.  Along with ImportDB, this allows a wallet to be
+// migrated between database drivers without restoring from seed.
+func ExportDB(ctx context.Context, db DB, w io.Writer) error {
+	const op errors.Op = "wallet.ExportDB"
+	if err := walletdb.Export(ctx, db.internal(), w); err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// ImportDB reads an export written by ExportDB from r into the database, which
+// must be newly created and empty.
+func ImportDB(ctx context.Context, db DB, r io.Reader) error {
+	const op errors.Op = "wallet.ImportDB"
+	if err := walletdb.Import(ctx, db.internal(), r); err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
@@ -0,0 +1,249 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/crypto/rand"
+)
+
+// DebugDump is a structural description of a wallet intended to be attached
+// to bug reports.  It excludes all data which could identify the wallet or its
+// transactions, such as keys, addresses, account names, hashes, and balances.
+type DebugDump struct {
+	Network      string
+	DBVersion    uint32
+	CoinType     uint32
+	WatchingOnly bool
+	Locked       bool
+
+	MainChainTipHeight  int32
+	BirthHeight         uint32
+	MissingCFilters     bool
+	UnminedTransactions int
+
+	Accounts []DebugDumpAccount
+
+	// Buckets records the number of key/value pairs in each database
+	// bucket, keyed by bucket path.
+	Buckets map[string]int
+}
+
+// DebugDumpAccount describes the address cursors of an account.
+type DebugDumpAccount struct {
+	AccountNumber             uint32
+	AccountType               uint8
+	LastUsedExternalIndex     uint32
+	LastUsedInternalIndex     uint32
+	LastReturnedExternalIndex uint32
+	LastReturnedInternalIndex uint32
+	ImportedKeyCount          uint32
+}
+
+// DebugDump creates a privacy-scrubbed structural description of the wallet.
+func (w *Wallet) DebugDump(ctx context.Context) (*DebugDump, error) {
+	const op errors.Op = "wallet.DebugDump"
+	d := &DebugDump{
+		Network:      w.chainParams.Name,
+		WatchingOnly: w.WatchingOnly(),
+		Locked:       w.Locked(),
+	}
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+		var err error
+		d.DBVersion, err = udb.StoredVersion(dbtx)
+		if err != nil {
+			return err
+		}
+		d.CoinType, err = w.manager.CoinType(dbtx)
+		if err != nil {
+			return err
+		}
+		_, d.MainChainTipHeight = w.txStore.MainChainTip(dbtx)
+		if birth := udb.BirthState(dbtx); birth != nil {
+			d.BirthHeight = birth.Height
+		}
+		d.MissingCFilters = w.txStore.IsMissingMainChainCFilters(dbtx)
+		unmined, err := w.txStore.UnminedTxHashes(txmgrNs)
+		if err != nil {
+			return err
+		}
+		d.UnminedTransactions = len(unmined)
+
+		err = w.manager.ForEachAccount(addrmgrNs, func(account uint32) error {
+			props, err := w.manager.AccountProperties(addrmgrNs, account)
+			if err != nil {
+				return err
+			}
+			d.Accounts = append(d.Accounts, DebugDumpAccount{
+				AccountNumber:             account,
+				AccountType:               props.AccountType,
+				LastUsedExternalIndex:     props.LastUsedExternalIndex,
+				LastUsedInternalIndex:     props.LastUsedInternalIndex,
+				LastReturnedExternalIndex: props.LastReturnedExternalIndex,
+				LastReturnedInternalIndex: props.LastReturnedInternalIndex,
+				ImportedKeyCount:          props.ImportedKeyCount,
+			})
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		d.Buckets, err = udb.BucketSizes(dbtx)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return d, nil
+}
+
+// DiffDebugDumps describes every difference between two debug dumps, one per
+// line, in a stable order.  No differences are returned for identical dumps.
+func DiffDebugDumps(a, b *DebugDump) []string {
+	var diffs []string
+	diff := func(field string, x, y any) {
+		if x != y {
+			diffs = append(diffs, fmt.Sprintf("%s: %v -> %v", field, x, y))
+		}
+	}
+
+	diff("network", a.Network, b.Network)
+	diff("dbversion", a.DBVersion, b.DBVersion)
+	diff("cointype", a.CoinType, b.CoinType)
+	diff("watchingonly", a.WatchingOnly, b.WatchingOnly)
+	diff("locked", a.Locked, b.Locked)
+	diff("tipheight", a.MainChainTipHeight, b.MainChainTipHeight)
+	diff("birthheight", a.BirthHeight, b.BirthHeight)
+	diff("missingcfilters", a.MissingCFilters, b.MissingCFilters)
+	diff("unmined", a.UnminedTransactions, b.UnminedTransactions)
+
+	accounts := make(map[uint32][2]*DebugDumpAccount)
+	for i := range a.Accounts {
+		acct := &a.Accounts[i]
+		pair := accounts[acct.AccountNumber]
+		pair[0] = acct
+		accounts[acct.AccountNumber] = pair
+	}
+	for i := range b.Accounts {
+		acct := &b.Accounts[i]
+		pair := accounts[acct.AccountNumber]
+		pair[1] = acct
+		accounts[acct.AccountNumber] = pair
+	}
+	accountNumbers := make([]uint32, 0, len(accounts))
+	for n := range accounts {
+		accountNumbers = append(accountNumbers, n)
+	}
+	sort.Slice(accountNumbers, func(i, j int) bool {
+		return accountNumbers[i] < accountNumbers[j]
+	})
+	for _, n := range accountNumbers {
+		pair := accounts[n]
+		switch {
+		case pair[0] == nil:
+			diffs = append(diffs, fmt.Sprintf("account %d: added", n))
+		case pair[1] == nil:
+			diffs = append(diffs, fmt.Sprintf("account %d: removed", n))
+		default:
+			x, y := pair[0], pair[1]
+			prefix := fmt.Sprintf("account %d ", n)
+			diff(prefix+"type", x.AccountType, y.AccountType)
+			diff(prefix+"lastusedexternal", x.LastUsedExternalIndex, y.LastUsedExternalIndex)
+			diff(prefix+"lastusedinternal", x.LastUsedInternalIndex, y.LastUsedInternalIndex)
+			diff(prefix+"lastreturnedexternal", x.LastReturnedExternalIndex, y.LastReturnedExternalIndex)
+			diff(prefix+"lastreturnedinternal", x.LastReturnedInternalIndex, y.LastReturnedInternalIndex)
+			diff(prefix+"importedkeys", x.ImportedKeyCount, y.ImportedKeyCount)
+		}
+	}
+
+	paths := make(map[string]struct{})
+	for p := range a.Buckets {
+		paths[p] = struct{}{}
+	}
+	for p := range b.Buckets {
+		paths[p] = struct{}{}
+	}
+	sortedPaths := make([]string, 0, len(paths))
+	for p := range paths {
+		sortedPaths = append(sortedPaths, p)
+	}
+	sort.Strings(sortedPaths)
+	for _, p := range sortedPaths {
+		x, inA := a.Buckets[p]
+		y, inB := b.Buckets[p]
+		switch {
+		case !inA:
+			diffs = append(diffs, fmt.Sprintf("bucket %s: added (%d)", p, y))
+		case !inB:
+			diffs = append(diffs, fmt.Sprintf("bucket %s: removed (%d)", p, x))
+		default:
+			diff("bucket "+p, x, y)
+		}
+	}
+	return diffs
+}
+
+// DBInspection describes the layout of the wallet database for remote
+// debugging, without revealing wallet data.  Keys which are not schema names,
+// and all values, are replaced by salted hashes.
+type DBInspection struct {
+	// Versions records the serialization versions stored by the
+	// database, keyed by the path of the version key, and
+	// LatestVersion is the newest database version understood by this
+	// software.
+	Versions      map[string]uint32
+	LatestVersion uint32
+
+	Buckets []udb.BucketSummary
+
+	// Entries describes the entries of the inspected bucket, if any,
+	// and TotalEntries counts all entries of the bucket, including
+	// those beyond the inspection limit.
+	Entries      []udb.BucketEntry
+	TotalEntries int
+}
+
+// InspectDB describes the buckets of the wallet database, their sizes, and the
+// recorded serialization versions.  If path is not empty, up to limit entries
+// of the bucket at the slash-separated path are also described.  Redacted keys
+// and values are hashed with a secret salt generated for each inspection, so
+// hashes may be compared within an inspection but not between inspections,
+// and guessed keys and values may not be confirmed from their hashes.
+func (w *Wallet) InspectDB(ctx context.Context, path string, limit int) (*DBInspection, error) {
+	const op errors.Op = "wallet.InspectDB"
+	salt := make([]byte, 32)
+	rand.Read(salt)
+	defer clear(salt)
+	in := &DBInspection{
+		LatestVersion: udb.DBVersion,
+	}
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		in.Versions = udb.SerializationVersions(dbtx)
+		var err error
+		in.Buckets, err = udb.SummarizeBuckets(dbtx)
+		if err != nil {
+			return err
+		}
+		if path == "" {
+			return nil
+		}
+		in.Entries, in.TotalEntries, err = udb.InspectBucket(dbtx, path, salt, limit)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return in, nil
+}
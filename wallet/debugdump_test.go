@@ -0,0 +1,164 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+)
+
+func TestDebugDump(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	cfg.AccountGapLimit = 2
+	w, teardown := testWallet(ctx, t, &cfg, nil)
+	defer teardown()
+
+	before, err := w.DebugDump(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if before.DBVersion != udb.DBVersion || len(before.Accounts) == 0 {
+		t.Fatalf("unexpected dump %+v", before)
+	}
+	if len(DiffDebugDumps(before, before)) != 0 {
+		t.Errorf("identical dumps differ")
+	}
+	for path := range before.Buckets {
+		for _, c := range path {
+			if c < 0x20 || c > 0x7e {
+				t.Fatalf("bucket path %q reveals binary keys", path)
+			}
+		}
+	}
+
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.NextAccount(ctx, "private name"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.NewExternalAddress(ctx, 0); err != nil {
+		t.Fatal(err)
+	}
+	after, err := w.DebugDump(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	diffs := strings.Join(DiffDebugDumps(before, after), "\n")
+	for _, want := range []string{"locked: true -> false", "account 1: added",
+		"account 0 lastreturnedexternal"} {
+		if !strings.Contains(diffs, want) {
+			t.Errorf("diff missing %q:\n%s", want, diffs)
+		}
+	}
+	if strings.Contains(diffs, "private name") {
+		t.Errorf("diff reveals account name:\n%s", diffs)
+	}
+}
+
+func TestInspectDB(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	cfg.AccountGapLimit = 2
+	w, teardown := testWallet(ctx, t, &cfg, nil)
+	defer teardown()
+
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.NextAccount(ctx, "private name"); err != nil {
+		t.Fatal(err)
+	}
+
+	in, err := w.InspectDB(ctx, "", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if in.Versions["meta/ver"] != udb.DBVersion || in.LatestVersion != udb.DBVersion {
+		t.Fatalf("unexpected versions %v", in.Versions)
+	}
+	if len(in.Buckets) == 0 || in.Entries != nil {
+		t.Fatalf("unexpected inspection %+v", in)
+	}
+
+	// Nested buckets are only named by their keys when they are nested in
+	// schema buckets.
+	for _, b := range in.Buckets {
+		i := strings.LastIndexByte(b.Path, '/')
+		if i == -1 || b.Path[i+1:] == "*" {
+			continue
+		}
+		switch b.Path[:i] {
+		case "meta", "waddrmgr", "waddrmgr/main", "waddrmgr/meta",
+			"waddrmgr/sync", "wtxmgr":
+		default:
+			t.Errorf("nested bucket name revealed: %s", b.Path)
+		}
+	}
+
+	// Schema keys are revealed, but values are not.
+	in, err = w.InspectDB(ctx, "waddrmgr/main", 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sawWatchOnly bool
+	for _, e := range in.Entries {
+		if e.Redacted {
+			t.Errorf("schema key %q redacted", e.Key)
+		}
+		if e.Key == "watchonly" {
+			sawWatchOnly = e.ValueHash != "" && e.ValueLen == 1
+		}
+	}
+	if !sawWatchOnly {
+		t.Errorf("watchonly key not described: %+v", in.Entries)
+	}
+
+	// Account names are keys of a data bucket and must be redacted.
+	in, err = w.InspectDB(ctx, "waddrmgr/acctnameidx", 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if in.TotalEntries < 2 {
+		t.Fatalf("expected account name entries, got %d", in.TotalEntries)
+	}
+	for _, e := range in.Entries {
+		if !e.Redacted || strings.Contains(e.Key, "private") {
+			t.Errorf("account name revealed: %+v", e)
+		}
+	}
+	// Every inspection is salted with a new secret.
+	again, err := w.InspectDB(ctx, "waddrmgr/acctnameidx", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(again.Entries) != 1 || again.Entries[0].Key == in.Entries[0].Key {
+		t.Errorf("inspections share a salt")
+	}
+
+	// Keys of nested buckets of data buckets are redacted.
+	in, err = w.InspectDB(ctx, "waddrmgr/acctvars", 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(in.Entries) == 0 {
+		t.Fatal("no account variable buckets")
+	}
+	for _, e := range in.Entries {
+		if !e.Bucket || !e.Redacted {
+			t.Errorf("nested bucket key revealed: %+v", e)
+		}
+	}
+
+	_, err = w.InspectDB(ctx, "waddrmgr/missing", 1)
+	if !errors.Is(err, errors.NotExist) {
+		t.Errorf("inspected missing bucket: %v", err)
+	}
+}
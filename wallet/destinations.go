@@ -0,0 +1,279 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"crypto/subtle"
+	"slices"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/kdf"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/crypto/rand"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/txscript/v4/stdscript"
+	"github.com/decred/dcrd/wire"
+)
+
+// WithDestinationOverride provides the credential set by
+// SetDestinationOverride to permit a single transaction authoring call to pay
+// destinations prohibited by the account's destination policy.
+func WithDestinationOverride(credential []byte) TxAuthorOption {
+	return func(o *txAuthorOptions) {
+		o.destinationOverride = credential
+	}
+}
+
+// destinationOverride returns the destination override credential of the
+// options, or nil.
+func destinationOverride(opts []TxAuthorOption) []byte {
+	var o txAuthorOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o.destinationOverride
+}
+
+// SetDestinationPolicy persists a policy restricting the destinations of
+// payments authored by an account.  Destinations are decoded and recorded in
+// their canonical encoding.  A nil policy removes any restriction.
+//
+// Changing a policy requires the credential set by SetDestinationOverride, or
+// an unlocked wallet when no credential is set.  Payments to HD addresses of
+// the wallet are never restricted.
+func (w *Wallet) SetDestinationPolicy(ctx context.Context, account uint32, p *udb.DestinationPolicy,
+	credential []byte) error {
+
+	const op errors.Op = "wallet.SetDestinationPolicy"
+	if p != nil {
+		canonical := &udb.DestinationPolicy{Allowlist: p.Allowlist}
+		lists := []struct {
+			from []string
+			to   *[]string
+		}{
+			{p.Allowed, &canonical.Allowed},
+			{p.Denied, &canonical.Denied},
+		}
+		for _, l := range lists {
+			seen := make(map[string]struct{}, len(l.from))
+			for _, dest := range l.from {
+				addr, err := stdaddr.DecodeAddress(dest, w.chainParams)
+				if err != nil {
+					return errors.E(op, errors.Invalid, err)
+				}
+				s := addr.String()
+				if _, ok := seen[s]; ok {
+					continue
+				}
+				seen[s] = struct{}{}
+				*l.to = append(*l.to, s)
+			}
+		}
+		p = canonical
+	}
+
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		if err := w.checkDestinationCredential(dbtx, credential); err != nil {
+			return err
+		}
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		if _, err := w.manager.AccountName(addrmgrNs, account); err != nil {
+			return err
+		}
+		return udb.PutDestinationPolicy(dbtx, account, p)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// DestinationPolicy returns the destination policy of an account, or nil when
+// payments from the account are unrestricted.
+func (w *Wallet) DestinationPolicy(ctx context.Context, account uint32) (*udb.DestinationPolicy, error) {
+	const op errors.Op = "wallet.DestinationPolicy"
+	var p *udb.DestinationPolicy
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		p, err = udb.FetchDestinationPolicy(dbtx, account)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return p, nil
+}
+
+// SetDestinationOverride persists a credential which permits payments
+// prohibited by destination policies when provided with the
+// WithDestinationOverride option, and which is required to change destination
+// policies.  Only a verifier derived from the credential is recorded.  An
+// empty credential removes the override.  The wallet must be unlocked, and
+// current must match any credential which is already set.
+func (w *Wallet) SetDestinationOverride(ctx context.Context, current, credential []byte) error {
+	const op errors.Op = "wallet.SetDestinationOverride"
+	if w.Locked() {
+		return errors.E(op, errors.Locked, "wallet must be unlocked to "+
+			"set the destination override credential")
+	}
+
+	var verifier []byte
+	if len(credential) != 0 {
+		kdfp, err := kdf.NewArgon2idParams(rand.Reader())
+		if err != nil {
+			return errors.E(op, err)
+		}
+		params, err := kdfp.MarshalBinary()
+		if err != nil {
+			return errors.E(op, err)
+		}
+		verifier = append(params, kdf.DeriveKey(credential, kdfp, 32)...)
+	}
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		if err := w.checkDestinationCredential(dbtx, current); err != nil {
+			return err
+		}
+		return udb.PutDestinationOverride(dbtx, verifier)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// checkDestinationCredential errors unless credential matches the recorded
+// destination override credential, or, when no credential is set, the wallet
+// is unlocked.
+func (w *Wallet) checkDestinationCredential(dbtx walletdb.ReadTx, credential []byte) error {
+	verifier := udb.FetchDestinationOverride(dbtx)
+	if verifier == nil {
+		if w.Locked() {
+			return errors.E(errors.Locked, "wallet must be unlocked to "+
+				"change destination policies")
+		}
+		return nil
+	}
+	if !verifyDestinationOverride(verifier, credential) {
+		return errors.E(errors.Permission, "invalid destination override credential")
+	}
+	return nil
+}
+
+// verifyDestinationOverride returns whether credential matches the verifier
+// recorded by SetDestinationOverride.
+func verifyDestinationOverride(verifier, credential []byte) bool {
+	if len(verifier) != kdf.MarshaledLen+32 || len(credential) == 0 {
+		return false
+	}
+	kdfp := new(kdf.Argon2idParams)
+	if err := kdfp.UnmarshalBinary(verifier[:kdf.MarshaledLen]); err != nil {
+		return false
+	}
+	key := kdf.DeriveKey(credential, kdfp, 32)
+	return subtle.ConstantTimeCompare(key, verifier[kdf.MarshaledLen:]) == 1
+}
+
+// ownHDAddress returns whether addr is derived by an account of the wallet,
+// rather than imported.
+func (w *Wallet) ownHDAddress(addrmgrNs walletdb.ReadBucket, addr stdaddr.Address) bool {
+	ma, err := w.manager.Address(addrmgrNs, addr)
+	if err != nil {
+		return false
+	}
+	return !ma.Imported() && ma.Account() <= udb.MaxAccountNum
+}
+
+// prohibitedDestination returns the first destination of outputs prohibited
+// by the destination policy of account, or the empty string.
+func (w *Wallet) prohibitedDestination(dbtx walletdb.ReadTx, account uint32,
+	outputs []*wire.TxOut) (string, error) {
+
+	p, err := udb.FetchDestinationPolicy(dbtx, account)
+	if err != nil || p == nil {
+		return "", err
+	}
+	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+	allowed := make(map[string]struct{}, len(p.Allowed))
+	for _, dest := range p.Allowed {
+		allowed[dest] = struct{}{}
+	}
+	denied := make(map[string]struct{}, len(p.Denied))
+	for _, dest := range p.Denied {
+		denied[dest] = struct{}{}
+	}
+	for _, out := range outputs {
+		_, addrs := stdscript.ExtractAddrs(out.Version, out.PkScript, w.chainParams)
+		if len(addrs) != 1 {
+			// Outputs without a single destination address,
+			// such as null data, can not be allowed.
+			if p.Allowlist && out.Value != 0 {
+				return "non-address output", nil
+			}
+			continue
+		}
+		if w.ownHDAddress(addrmgrNs, addrs[0]) {
+			continue
+		}
+		dest := addrs[0].String()
+		if _, ok := denied[dest]; ok {
+			return dest, nil
+		}
+		if _, ok := allowed[dest]; p.Allowlist && !ok {
+			return dest, nil
+		}
+	}
+	return "", nil
+}
+
+// checkSpendDestinations errors with errors.Policy when tx pays a destination
+// prohibited by the destination policy of any account whose outputs are spent
+// by tx, unless the override credential is valid.  prevScripts are the
+// previous output scripts of the transaction inputs, and inputs which do not
+// spend wallet outputs are ignored.
+//
+// Every path which authors or signs spends of wallet outputs must call this
+// before signing.
+func (w *Wallet) checkSpendDestinations(dbtx walletdb.ReadTx, tx *wire.MsgTx,
+	prevScripts [][]byte, override []byte) error {
+
+	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+	var accounts []uint32
+	for _, script := range prevScripts {
+		_, addrs := stdscript.ExtractAddrs(scriptVersionAssumed, script, w.chainParams)
+		if len(addrs) != 1 {
+			continue
+		}
+		account, err := w.manager.AddrAccount(addrmgrNs, addrs[0])
+		if err != nil {
+			continue
+		}
+		if !slices.Contains(accounts, account) {
+			accounts = append(accounts, account)
+		}
+	}
+	slices.Sort(accounts)
+
+	for _, account := range accounts {
+		prohibited, err := w.prohibitedDestination(dbtx, account, tx.TxOut)
+		if err != nil {
+			return err
+		}
+		if prohibited == "" {
+			continue
+		}
+		verifier := udb.FetchDestinationOverride(dbtx)
+		if verifyDestinationOverride(verifier, override) {
+			log.Warnf("Destination policy of account %d overridden to pay %s",
+				account, prohibited)
+			return nil
+		}
+		return errors.E(errors.Policy, errors.Errorf("destination %s is "+
+			"prohibited by the destination policy of account %d", prohibited,
+			account))
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/txscript/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestDestinationPolicy(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	external := func(b byte) stdaddr.Address {
+		addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(
+			[]byte{19: b}, w.chainParams)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return addr
+	}
+	allowed, denied, unlisted := external(1), external(2), external(3)
+	own, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, ownScript := own.PaymentScript()
+
+	// Scripts imported to an account are not exempt from its policy.
+	redeemScript := []byte{txscript.OP_TRUE}
+	if _, err := w.ImportScriptToAccount(ctx, redeemScript, 0, NoRescan); err != nil {
+		t.Fatal(err)
+	}
+	imported, err := stdaddr.NewAddressScriptHashV0(redeemScript, w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// check tests a spend of an account 0 output paying addr.
+	check := func(addr stdaddr.Address, override []byte) error {
+		tx := wire.NewMsgTx()
+		tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, 2e8, nil))
+		version, script := addr.PaymentScript()
+		tx.AddTxOut(&wire.TxOut{Value: 1e8, Version: version, PkScript: script})
+		return walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+			return w.checkSpendDestinations(dbtx, tx, [][]byte{ownScript}, override)
+		})
+	}
+
+	// Payments are unrestricted without a policy.
+	if err := check(unlisted, nil); err != nil {
+		t.Fatalf("unrestricted payment: %v", err)
+	}
+
+	// Without a credential, changing policies requires the unlocked wallet.
+	err = w.SetDestinationPolicy(ctx, 0, &udb.DestinationPolicy{
+		Denied: []string{denied.String()},
+	}, nil)
+	if !errors.Is(err, errors.Locked) {
+		t.Fatalf("set policy while locked: %v", err)
+	}
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	err = w.SetDestinationPolicy(ctx, 0, &udb.DestinationPolicy{
+		Allowed: []string{allowed.String(), allowed.String()},
+		Denied:  []string{denied.String()},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := w.DestinationPolicy(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p == nil || p.Allowlist || len(p.Allowed) != 1 || len(p.Denied) != 1 {
+		t.Fatalf("unexpected policy %+v", p)
+	}
+	if err := check(unlisted, nil); err != nil {
+		t.Errorf("denylist rejected unlisted destination: %v", err)
+	}
+	if err := check(denied, nil); !errors.Is(err, errors.Policy) {
+		t.Errorf("denylist permitted denied destination: %v", err)
+	}
+
+	p.Allowlist = true
+	if err := w.SetDestinationPolicy(ctx, 0, p, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := check(allowed, nil); err != nil {
+		t.Errorf("allowlist rejected allowed destination: %v", err)
+	}
+	if err := check(own, nil); err != nil {
+		t.Errorf("allowlist rejected wallet address: %v", err)
+	}
+	if err := check(imported, nil); !errors.Is(err, errors.Policy) {
+		t.Errorf("allowlist permitted imported script: %v", err)
+	}
+	if err := check(unlisted, nil); !errors.Is(err, errors.Policy) {
+		t.Errorf("allowlist permitted unlisted destination: %v", err)
+	}
+
+	// Signing is subject to the policy of the spent account.
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, 2e8, nil))
+	version, script := unlisted.PaymentScript()
+	tx.AddTxOut(&wire.TxOut{Value: 1e8, Version: version, PkScript: script})
+	prevScripts := map[wire.OutPoint][]byte{tx.TxIn[0].PreviousOutPoint: ownScript}
+	_, err = w.SignTransaction(ctx, tx, txscript.SigHashAll, prevScripts, nil, nil)
+	if !errors.Is(err, errors.Policy) {
+		t.Errorf("signed prohibited payment: %v", err)
+	}
+	_, _, err = w.CreateSignature(ctx, tx, 0, own, txscript.SigHashAll, ownScript)
+	if !errors.Is(err, errors.Policy) {
+		t.Errorf("created signature for prohibited payment: %v", err)
+	}
+
+	// The override credential permits prohibited payments and is then
+	// required to change policies and the credential itself.
+	if err := w.SetDestinationOverride(ctx, nil, []byte("override")); err != nil {
+		t.Fatal(err)
+	}
+	if err := check(unlisted, []byte("override")); err != nil {
+		t.Errorf("override rejected: %v", err)
+	}
+	if err := check(unlisted, []byte("wrong")); !errors.Is(err, errors.Policy) {
+		t.Errorf("wrong override permitted payment: %v", err)
+	}
+	if err := w.SetDestinationPolicy(ctx, 0, nil, nil); !errors.Is(err, errors.Permission) {
+		t.Errorf("removed policy without credential: %v", err)
+	}
+	if err := w.SetDestinationPolicy(ctx, 0, nil, []byte("wrong")); !errors.Is(err, errors.Permission) {
+		t.Errorf("removed policy with wrong credential: %v", err)
+	}
+	if err := w.SetDestinationOverride(ctx, nil, []byte("other")); !errors.Is(err, errors.Permission) {
+		t.Errorf("replaced override without credential: %v", err)
+	}
+
+	if err := w.SetDestinationPolicy(ctx, 0, nil, []byte("override")); err != nil {
+		t.Fatal(err)
+	}
+	if err := check(denied, nil); err != nil {
+		t.Errorf("removed policy rejected payment: %v", err)
+	}
+	if err := w.SetDestinationPolicy(ctx, 0, &udb.DestinationPolicy{
+		Denied: []string{"not an address"},
+	}, []byte("override")); !errors.Is(err, errors.Invalid) {
+		t.Errorf("invalid destination: %v", err)
+	}
+
+	w.Lock()
+	if err := w.SetDestinationOverride(ctx, []byte("override"), []byte("other")); !errors.Is(err, errors.Locked) {
+		t.Errorf("set override while locked: %v", err)
+	}
+}
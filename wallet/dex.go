@@ -0,0 +1,471 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"math"
+	"sort"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/txrules"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4"
+	"github.com/decred/dcrd/txscript/v4/sign"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/wire"
+)
+
+// SwapSecretSize is the size of the secret (preimage) revealed to redeem an
+// atomic swap contract.
+const SwapSecretSize = 32
+
+// FundsReservation describes wallet outputs which are locked to fund an
+// external order, such as an order placed on a decentralized exchange.
+// Reserved outputs are not selected by any other transaction authoring until
+// the reservation is released.
+type FundsReservation struct {
+	ID        string
+	Account   uint32
+	Outpoints []wire.OutPoint
+	Amount    dcrutil.Amount
+}
+
+// ReserveFunds selects and locks unspent outputs from an account totaling at
+// least amount, recording them under the order identifier id.  Reservations
+// are recorded in the database and remain in effect across restarts and
+// ResetLockedOutpoints until released.
+func (w *Wallet) ReserveFunds(ctx context.Context, id string, account uint32,
+	amount dcrutil.Amount, minconf int32) (*FundsReservation, error) {
+
+	const op errors.Op = "wallet.ReserveFunds"
+	if id == "" {
+		return nil, errors.E(op, errors.Invalid, "empty reservation id")
+	}
+	if amount <= 0 {
+		return nil, errors.E(op, errors.Invalid, "reservation amount must be positive")
+	}
+
+	defer w.lockedOutpointMu.Unlock()
+	w.lockedOutpointMu.Lock()
+
+	if _, ok := w.fundsReservations[id]; ok {
+		return nil, errors.E(op, errors.Exist, errors.Errorf("reservation %q already exists", id))
+	}
+	if err := checkMixedSpendAccount(w.mixedSpend, account); err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	var inputs []Input
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		_, tipHeight := w.txStore.MainChainTip(dbtx)
+
+		var err error
+		const minAmount = 0
+		const maxResults = 0
+		inputs, err = w.findEligibleOutputsAmount(dbtx, account, minconf,
+			amount, tipHeight, minAmount, maxResults, nil)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, mixedSpendError(w.mixedSpend, err))
+	}
+
+	r := &FundsReservation{
+		ID:        id,
+		Account:   account,
+		Outpoints: make([]wire.OutPoint, 0, len(inputs)),
+	}
+	for i := range inputs {
+		in := &inputs[i]
+		r.Outpoints = append(r.Outpoints, in.OutPoint)
+		r.Amount += dcrutil.Amount(in.PrevOut.Value)
+	}
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.PutFundsReservation(dbtx, &udb.FundsReservation{
+			ID:        r.ID,
+			Account:   r.Account,
+			Amount:    r.Amount,
+			Outpoints: r.Outpoints,
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	w.lockFundsReservation(r)
+
+	res := *r
+	res.Outpoints = append([]wire.OutPoint(nil), r.Outpoints...)
+	return &res, nil
+}
+
+// lockFundsReservation records a funds reservation and locks its outputs.
+// w.lockedOutpointMu must be held.
+func (w *Wallet) lockFundsReservation(r *FundsReservation) {
+	w.fundsReservations[r.ID] = r
+	for i := range r.Outpoints {
+		op := &r.Outpoints[i]
+		w.lockedOutpoints[outpoint{op.Hash, op.Index}] = struct{}{}
+	}
+}
+
+// ReleaseFunds unlocks all outputs of a funds reservation and forgets it.
+func (w *Wallet) ReleaseFunds(ctx context.Context, id string) error {
+	const op errors.Op = "wallet.ReleaseFunds"
+
+	defer w.lockedOutpointMu.Unlock()
+	w.lockedOutpointMu.Lock()
+
+	r, ok := w.fundsReservations[id]
+	if !ok {
+		return errors.E(op, errors.NotExist, errors.Errorf("no reservation %q", id))
+	}
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.DeleteFundsReservation(dbtx, id)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	for i := range r.Outpoints {
+		op := &r.Outpoints[i]
+		w.unlockOutpoint(outpoint{op.Hash, op.Index})
+	}
+	delete(w.fundsReservations, id)
+	return nil
+}
+
+// FundsReservations returns all current funds reservations sorted by ID.
+func (w *Wallet) FundsReservations() []FundsReservation {
+	defer w.lockedOutpointMu.Unlock()
+	w.lockedOutpointMu.Lock()
+
+	res := make([]FundsReservation, 0, len(w.fundsReservations))
+	for _, r := range w.fundsReservations {
+		c := *r
+		c.Outpoints = append([]wire.OutPoint(nil), r.Outpoints...)
+		res = append(res, c)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	return res
+}
+
+// ReservedBalance returns the total value of all outputs reserved from an
+// account.  Reserved outputs remain part of the account's spendable balance,
+// and the available balance is the difference of the two.
+func (w *Wallet) ReservedBalance(account uint32) dcrutil.Amount {
+	defer w.lockedOutpointMu.Unlock()
+	w.lockedOutpointMu.Lock()
+
+	var reserved dcrutil.Amount
+	for _, r := range w.fundsReservations {
+		if r.Account == account {
+			reserved += r.Amount
+		}
+	}
+	return reserved
+}
+
+// SwapContract describes a hashed timelock atomic swap contract.  The
+// contract pays to the recipient when the secret hashing to SecretHash is
+// revealed, or back to the refund address after LockTime.
+type SwapContract struct {
+	Contract      []byte
+	Address       stdaddr.Address // P2SH address of the contract
+	Recipient     stdaddr.Address
+	RefundAddress stdaddr.Address
+	SecretHash    []byte
+	LockTime      int64
+}
+
+// swapContractScript returns the script of an atomic swap contract using the
+// same construction as the dcrdex client:
+//
+//	OP_IF
+//	    OP_SIZE 32 OP_EQUALVERIFY OP_SHA256 <secret hash> OP_EQUALVERIFY
+//	    OP_DUP OP_HASH160 <recipient pubkey hash>
+//	OP_ELSE
+//	    <locktime> OP_CHECKLOCKTIMEVERIFY OP_DROP
+//	    OP_DUP OP_HASH160 <refund pubkey hash>
+//	OP_ENDIF
+//	OP_EQUALVERIFY OP_CHECKSIG
+func swapContractScript(recipientHash160, refundHash160, secretHash []byte, lockTime int64) ([]byte, error) {
+	b := txscript.NewScriptBuilder()
+
+	b.AddOp(txscript.OP_IF)
+	b.AddOp(txscript.OP_SIZE)
+	b.AddInt64(SwapSecretSize)
+	b.AddOp(txscript.OP_EQUALVERIFY)
+	b.AddOp(txscript.OP_SHA256)
+	b.AddData(secretHash)
+	b.AddOp(txscript.OP_EQUALVERIFY)
+	b.AddOp(txscript.OP_DUP)
+	b.AddOp(txscript.OP_HASH160)
+	b.AddData(recipientHash160)
+
+	b.AddOp(txscript.OP_ELSE)
+	b.AddInt64(lockTime)
+	b.AddOp(txscript.OP_CHECKLOCKTIMEVERIFY)
+	b.AddOp(txscript.OP_DROP)
+	b.AddOp(txscript.OP_DUP)
+	b.AddOp(txscript.OP_HASH160)
+	b.AddData(refundHash160)
+	b.AddOp(txscript.OP_ENDIF)
+
+	b.AddOp(txscript.OP_EQUALVERIFY)
+	b.AddOp(txscript.OP_CHECKSIG)
+
+	return b.Script()
+}
+
+// parseSwapContract extracts the data pushes of an atomic swap contract
+// created by swapContractScript.
+func parseSwapContract(contract []byte) (recipientHash160, refundHash160, secretHash []byte, lockTime int64, err error) {
+	const scriptVersion = 0
+	var pushes [][]byte
+	var opcodes []byte
+	tokenizer := txscript.MakeScriptTokenizer(scriptVersion, contract)
+	for tokenizer.Next() {
+		pushes = append(pushes, tokenizer.Data())
+		opcodes = append(opcodes, tokenizer.Opcode())
+	}
+	if err := tokenizer.Err(); err != nil {
+		return nil, nil, nil, 0, errors.E(errors.Invalid, err)
+	}
+	notContract := errors.E(errors.Invalid, "not an atomic swap contract")
+	if len(pushes) != 20 {
+		return nil, nil, nil, 0, notContract
+	}
+	secretHash, recipientHash160, refundHash160 = pushes[5], pushes[9], pushes[16]
+	if txscript.IsSmallInt(opcodes[11]) {
+		lockTime = int64(txscript.AsSmallInt(opcodes[11]))
+	} else {
+		n, err := txscript.MakeScriptNum(pushes[11], 5)
+		if err != nil {
+			return nil, nil, nil, 0, notContract
+		}
+		lockTime = int64(n)
+	}
+
+	// Rebuild the contract from the extracted pushes to verify that the
+	// remaining opcodes match the template.
+	rebuilt, err := swapContractScript(recipientHash160, refundHash160, secretHash, lockTime)
+	if err != nil || !bytes.Equal(rebuilt, contract) {
+		return nil, nil, nil, 0, notContract
+	}
+	return recipientHash160, refundHash160, secretHash, lockTime, nil
+}
+
+// hash160Address returns the hash160 of a secp256k1 P2PKH address.
+func hash160Address(addr stdaddr.Address) ([]byte, error) {
+	a, ok := addr.(*stdaddr.AddressPubKeyHashEcdsaSecp256k1V0)
+	if !ok {
+		return nil, errors.E(errors.Invalid, errors.Errorf("address %v is not a "+
+			"secp256k1 P2PKH address", addr))
+	}
+	return a.Hash160()[:], nil
+}
+
+// NewSwapContract creates an atomic swap contract paying to recipient when the
+// secret hashing to secretHash is revealed, and refundable to a newly derived
+// internal address of account after lockTime.  The wallet does not fund the
+// contract; its P2SH address may be paid by reserved outputs.
+func (w *Wallet) NewSwapContract(ctx context.Context, account uint32, recipient stdaddr.Address,
+	secretHash []byte, lockTime int64) (*SwapContract, error) {
+
+	const op errors.Op = "wallet.NewSwapContract"
+	if len(secretHash) != sha256.Size {
+		return nil, errors.E(op, errors.Invalid, "secret hash must be 32 bytes")
+	}
+	if lockTime <= 0 || lockTime > math.MaxUint32 {
+		return nil, errors.E(op, errors.Invalid, "locktime out of range")
+	}
+	recipientHash160, err := hash160Address(recipient)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	refundAddr, err := w.NewInternalAddress(ctx, account, WithGapPolicyWrap())
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	refundHash160, err := hash160Address(refundAddr)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	contract, err := swapContractScript(recipientHash160, refundHash160, secretHash, lockTime)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	p2sh, err := stdaddr.NewAddressScriptHashV0(contract, w.chainParams)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return &SwapContract{
+		Contract:      contract,
+		Address:       p2sh,
+		Recipient:     recipient,
+		RefundAddress: refundAddr,
+		SecretHash:    secretHash,
+		LockTime:      lockTime,
+	}, nil
+}
+
+// RedeemSwap spends the output of contractTx paying to contract by revealing
+// the secret, paying the contract value less fees to a new internal address
+// of account.  The redeeming transaction is published to the network.
+func (w *Wallet) RedeemSwap(ctx context.Context, n NetworkBackend, account uint32,
+	contractTx *wire.MsgTx, contract, secret []byte) (*chainhash.Hash, error) {
+
+	const op errors.Op = "wallet.RedeemSwap"
+	recipientHash160, _, secretHash, _, err := parseSwapContract(contract)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if len(secret) != SwapSecretSize {
+		return nil, errors.E(op, errors.Invalid, "secret must be 32 bytes")
+	}
+	if h := sha256.Sum256(secret); !bytes.Equal(h[:], secretHash) {
+		return nil, errors.E(op, errors.Invalid, "secret does not match contract secret hash")
+	}
+	hash, err := w.spendSwapContract(ctx, op, n, account, contractTx, contract,
+		recipientHash160, 0, func(sig, pubkey []byte) ([]byte, error) {
+			b := txscript.NewScriptBuilder()
+			b.AddData(sig)
+			b.AddData(pubkey)
+			b.AddData(secret)
+			b.AddInt64(1)
+			b.AddData(contract)
+			return b.Script()
+		})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return hash, nil
+}
+
+// RefundSwap spends the output of contractTx paying to contract after the
+// contract locktime has passed, returning the contract value less fees to a
+// new internal address of account.  The refunding transaction is published to
+// the network.
+func (w *Wallet) RefundSwap(ctx context.Context, n NetworkBackend, account uint32,
+	contractTx *wire.MsgTx, contract []byte) (*chainhash.Hash, error) {
+
+	const op errors.Op = "wallet.RefundSwap"
+	_, refundHash160, _, lockTime, err := parseSwapContract(contract)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if lockTime <= 0 || lockTime > math.MaxUint32 {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("contract "+
+			"locktime %d is out of range", lockTime))
+	}
+	hash, err := w.spendSwapContract(ctx, op, n, account, contractTx, contract,
+		refundHash160, uint32(lockTime), func(sig, pubkey []byte) ([]byte, error) {
+			b := txscript.NewScriptBuilder()
+			b.AddData(sig)
+			b.AddData(pubkey)
+			b.AddInt64(0)
+			b.AddData(contract)
+			return b.Script()
+		})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return hash, nil
+}
+
+func (w *Wallet) spendSwapContract(ctx context.Context, op errors.Op, n NetworkBackend,
+	account uint32, contractTx *wire.MsgTx, contract, signerHash160 []byte, lockTime uint32,
+	sigScript func(sig, pubkey []byte) ([]byte, error)) (*chainhash.Hash, error) {
+
+	if err := w.checkVoteOnly(); err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	p2sh, err := stdaddr.NewAddressScriptHashV0(contract, w.chainParams)
+	if err != nil {
+		return nil, err
+	}
+	_, p2shScript := p2sh.PaymentScript()
+	outIndex := -1
+	for i, out := range contractTx.TxOut {
+		if bytes.Equal(out.PkScript, p2shScript) {
+			outIndex = i
+			break
+		}
+	}
+	if outIndex == -1 {
+		return nil, errors.E(errors.Invalid, "transaction does not pay to contract")
+	}
+	contractOut := contractTx.TxOut[outIndex]
+
+	signer, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(signerHash160, w.chainParams)
+	if err != nil {
+		return nil, err
+	}
+	payTo, err := w.NewInternalAddress(ctx, account, WithGapPolicyWrap())
+	if err != nil {
+		return nil, err
+	}
+	payVersion, payScript := payTo.PaymentScript()
+
+	tx := wire.NewMsgTx()
+	tx.LockTime = lockTime
+	prevOut := wire.NewOutPoint(&chainhash.Hash{}, uint32(outIndex), wire.TxTreeRegular)
+	prevOut.Hash = contractTx.TxHash()
+	txIn := wire.NewTxIn(prevOut, contractOut.Value, nil)
+	if lockTime != 0 {
+		// Sequence must not be final for the locktime to be enforced.
+		txIn.Sequence = wire.MaxTxInSequenceNum - 1
+	}
+	tx.AddTxIn(txIn)
+	txOut := &wire.TxOut{Version: payVersion, PkScript: payScript}
+	tx.AddTxOut(txOut)
+
+	privKey, zero, err := w.LoadPrivateKey(ctx, signer)
+	if err != nil {
+		return nil, err
+	}
+	defer zero()
+	pubkey := privKey.PubKey().SerializeCompressed()
+
+	// Estimate the fee using a maximum size signature placeholder.
+	const maxSigSize = 73
+	estScript, err := sigScript(make([]byte, maxSigSize), pubkey)
+	if err != nil {
+		return nil, err
+	}
+	tx.TxIn[0].SignatureScript = estScript
+	if err := w.checkFeeRate(w.RelayFee()); err != nil {
+		return nil, errors.E(op, err)
+	}
+	fee := txrules.FeeForSerializeSize(w.RelayFee(), tx.SerializeSize())
+	txOut.Value = contractOut.Value - int64(fee)
+	if txrules.IsDustOutput(txOut, w.RelayFee()) {
+		return nil, errors.E(errors.Invalid, errors.Errorf("contract value "+
+			"%v is too small to pay fee %v", dcrutil.Amount(contractOut.Value), fee))
+	}
+
+	sig, err := sign.RawTxInSignature(tx, 0, contract, txscript.SigHashAll,
+		privKey.Serialize(), dcrec.STEcdsaSecp256k1)
+	if err != nil {
+		return nil, err
+	}
+	tx.TxIn[0].SignatureScript, err = sigScript(sig, pubkey)
+	if err != nil {
+		return nil, err
+	}
+	err = validateMsgTx(op, tx, [][]byte{contractOut.PkScript})
+	if err != nil {
+		return nil, err
+	}
+
+	return w.PublishTransaction(ctx, tx, n)
+}
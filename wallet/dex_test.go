@@ -0,0 +1,118 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestSwapContractScript(t *testing.T) {
+	recipient := bytes.Repeat([]byte{0x01}, 20)
+	refund := bytes.Repeat([]byte{0x02}, 20)
+	secretHash := bytes.Repeat([]byte{0x03}, 32)
+
+	for _, lockTime := range []int64{1, 16, 17, 500000, 1700000000} {
+		contract, err := swapContractScript(recipient, refund, secretHash, lockTime)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gotRecipient, gotRefund, gotSecretHash, gotLockTime, err := parseSwapContract(contract)
+		if err != nil {
+			t.Fatalf("locktime %d: parse: %v", lockTime, err)
+		}
+		if !bytes.Equal(gotRecipient, recipient) || !bytes.Equal(gotRefund, refund) ||
+			!bytes.Equal(gotSecretHash, secretHash) || gotLockTime != lockTime {
+			t.Errorf("locktime %d: parsed contract does not match inputs", lockTime)
+		}
+
+		// Modifying any opcode must fail template matching.
+		bad := append([]byte(nil), contract...)
+		bad[len(bad)-1]++
+		if _, _, _, _, err := parseSwapContract(bad); err == nil {
+			t.Errorf("locktime %d: modified contract parsed", lockTime)
+		}
+	}
+}
+
+func TestFundsReservationsPersist(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, nil)
+	defer teardown()
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, pkScript := addr.PaymentScript()
+	fund := wire.NewMsgTx()
+	fund.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, 0, nil))
+	fund.AddTxOut(&wire.TxOut{Value: 3e8, Version: version, PkScript: pkScript})
+	if err := w.AddTransaction(ctx, fund, &w.chainParams.GenesisHash); err != nil {
+		t.Fatal(err)
+	}
+	fundHash := fund.TxHash()
+
+	if _, err := w.ReserveFunds(ctx, "order", 0, 1e8, 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.ReserveFunds(ctx, "order", 0, 1e8, 0); !errors.Is(err, errors.Exist) {
+		t.Errorf("duplicate reservation: %v", err)
+	}
+
+	// Reservations survive resetting locked outputs and reopening the wallet.
+	w.ResetLockedOutpoints()
+	if !w.LockedOutpoint(&fundHash, 0) {
+		t.Fatal("reserved output unlocked by reset")
+	}
+	w, err = Open(ctx, &cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !w.LockedOutpoint(&fundHash, 0) || w.ReservedBalance(0) != 3e8 {
+		t.Fatal("reservation was not restored")
+	}
+
+	if err := w.ReleaseFunds(ctx, "order"); err != nil {
+		t.Fatal(err)
+	}
+	if w.LockedOutpoint(&fundHash, 0) {
+		t.Error("released output remains locked")
+	}
+	w, err = Open(ctx, &cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(w.FundsReservations()) != 0 {
+		t.Error("released reservation was restored")
+	}
+}
+
+func TestRefundSwapLockTimeRange(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	recipient := bytes.Repeat([]byte{0x01}, 20)
+	refund := bytes.Repeat([]byte{0x02}, 20)
+	secretHash := bytes.Repeat([]byte{0x03}, 32)
+	contract, err := swapContractScript(recipient, refund, secretHash, math.MaxUint32+1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, _, err := parseSwapContract(contract); err != nil {
+		t.Fatal(err)
+	}
+	_, err = w.RefundSwap(ctx, mockNetwork{}, 0, wire.NewMsgTx(), contract)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("refunded contract with out of range locktime: %v", err)
+	}
+}
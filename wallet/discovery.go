@@ -101,6 +101,8 @@ type accountUsage struct {
 	intLastUsed    uint32
 	extlo, intlo   uint32
 	exthi, inthi   uint32 // Set to lo - 1 when finished, be cautious of unsigned underflow
+	gapLimit       uint32 // Per-account gap limit, expanded by recent activity
+	segments       uint32
 }
 
 type scriptPath struct {
@@ -110,8 +112,6 @@ type scriptPath struct {
 
 type addrFinder struct {
 	w           *Wallet
-	gaplimit    uint32
-	segments    uint32
 	usage       []accountUsage
 	commitments blockCommitmentCache
 	mu          sync.RWMutex
@@ -120,8 +120,6 @@ type addrFinder struct {
 func newAddrFinder(ctx context.Context, w *Wallet, gapLimit uint32) (*addrFinder, error) {
 	a := &addrFinder{
 		w:           w,
-		gaplimit:    gapLimit,
-		segments:    hd.HardenedKeyStart / gapLimit,
 		commitments: make(blockCommitmentCache),
 	}
 	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
@@ -148,12 +146,17 @@ func newAddrFinder(ctx context.Context, w *Wallet, gapLimit uint32) (*addrFinder
 			if err != nil {
 				return err
 			}
+			acctGapLimit, err := w.accountAddrGapLimit(dbtx, acct, gapLimit)
+			if err != nil {
+				return err
+			}
+			segments := hd.HardenedKeyStart / acctGapLimit
 			var extlo, intlo uint32
 			if props.LastUsedExternalIndex != ^uint32(0) {
-				extlo = props.LastUsedExternalIndex / a.gaplimit
+				extlo = props.LastUsedExternalIndex / acctGapLimit
 			}
 			if props.LastUsedInternalIndex != ^uint32(0) {
-				intlo = props.LastUsedInternalIndex / a.gaplimit
+				intlo = props.LastUsedInternalIndex / acctGapLimit
 			}
 			a.usage = append(a.usage, accountUsage{
 				account:     acct,
@@ -162,9 +165,11 @@ func newAddrFinder(ctx context.Context, w *Wallet, gapLimit uint32) (*addrFinder
 				extLastUsed: props.LastUsedExternalIndex,
 				intLastUsed: props.LastUsedInternalIndex,
 				extlo:       extlo,
-				exthi:       a.segments - 1,
+				exthi:       segments - 1,
 				intlo:       intlo,
-				inthi:       a.segments - 1,
+				inthi:       segments - 1,
+				gapLimit:    acctGapLimit,
+				segments:    segments,
 			})
 			return nil
 		}
@@ -210,24 +215,29 @@ func (a *addrFinder) find(ctx context.Context, start *chainhash.Hash, n NetworkB
 		var data [][]byte
 		scrPaths := make(map[string]scriptPath)
 		addBranch := func(branchPub *hd.ExtendedKey, usageIndex int, acct, branch, lo, hi uint32) error {
-			if lo > hi || hi >= a.segments { // Terminating condition
+			u := &a.usage[usageIndex]
+			if lo > hi || hi >= u.segments { // Terminating condition
 				return nil
 			}
 			mid := (hi + lo) / 2
-			begin := mid * a.gaplimit
-			addrs, err := deriveChildAddresses(branchPub, begin, a.gaplimit, a.w.chainParams)
+			begin := mid * u.gapLimit
+			addrs, pubKeyAddrs, err := deriveChildWatchAddresses(branchPub,
+				begin, u.gapLimit, a.w.chainParams)
 			if err != nil {
 				return err
 			}
 			for i, addr := range addrs {
-				_, scr := addr.PaymentScript()
-				data = append(data, scr)
-				scrPaths[string(scr)] = scriptPath{
+				path := scriptPath{
 					usageIndex: usageIndex,
 					account:    acct,
 					branch:     branch,
-					index:      mid*a.gaplimit + uint32(i),
+					index:      begin + uint32(i),
 				}
+				_, scr := addr.PaymentScript()
+				_, pubKeyScr := pubKeyAddrs[i].PaymentScript()
+				data = append(data, scr, pubKeyScr)
+				scrPaths[string(scr)] = path
+				scrPaths[string(pubKeyScr)] = path
 			}
 			return nil
 		}
@@ -293,8 +303,8 @@ func (a *addrFinder) find(ctx context.Context, start *chainhash.Hash, n NetworkB
 				mid := (u.exthi + u.extlo) / 2
 				// When the last used index is in this segment's index half open
 				// range [begin,end) then an address was found in this segment.
-				begin := mid * a.gaplimit
-				end := begin + a.gaplimit
+				begin := mid * u.gapLimit
+				end := begin + u.gapLimit
 				if u.extLastUsed >= begin && u.extLastUsed < end {
 					u.extlo = mid + 1
 				} else {
@@ -303,8 +313,8 @@ func (a *addrFinder) find(ctx context.Context, start *chainhash.Hash, n NetworkB
 			}
 			if u.intlo <= u.inthi {
 				mid := (u.inthi + u.intlo) / 2
-				begin := mid * a.gaplimit
-				end := begin + a.gaplimit
+				begin := mid * u.gapLimit
+				end := begin + u.gapLimit
 				if u.intLastUsed >= begin && u.intLastUsed < end {
 					u.intlo = mid + 1
 				} else {
@@ -429,121 +439,188 @@ func (w *Wallet) filterBlocks(ctx context.Context, startBlock *chainhash.Hash, d
 
 func (w *Wallet) findLastUsedAccount(ctx context.Context, n NetworkBackend, blockCache blockCommitmentCache,
 	coinTypeXpriv *hd.ExtendedKey, gapLimit uint32, startBlock *chainhash.Hash) (uint32, error) {
-	var (
-		acctGapLimit = uint32(w.accountGapLimit)
-		addrScripts  = make([][]byte, 0, acctGapLimit*gapLimit*2*2)
-	)
 
-	lastUsedInRange := func(begin, end uint32) (uint32, error) { // [begin,end)
-		addrScripts = addrScripts[:0]
-		addrScriptAccts := make(map[string]uint32)
-		if end >= hd.HardenedKeyStart {
-			end = hd.HardenedKeyStart - 1
+	lastUsedInRange := func(begin, end uint32) (uint32, error) {
+		lastUsed, _, err := w.lastUsedAccountInRange(ctx, n, blockCache,
+			coinTypeXpriv, gapLimit, startBlock, begin, end)
+		return lastUsed, err
+	}
+	return scanAccountGap(uint32(w.accountGapLimit), lastUsedInRange)
+}
+
+// lastUsedAccountInRange searches the cfilters of main chain blocks beginning
+// at startBlock for usage of the accounts [begin,end) derived from
+// coinTypeXpriv.  It returns the last used account and whether any account in
+// the range is used.
+func (w *Wallet) lastUsedAccountInRange(ctx context.Context, n NetworkBackend, blockCache blockCommitmentCache,
+	coinTypeXpriv *hd.ExtendedKey, gapLimit uint32, startBlock *chainhash.Hash,
+	begin, end uint32) (uint32, bool, error) {
+
+	if end >= hd.HardenedKeyStart {
+		end = hd.HardenedKeyStart - 1
+	}
+	var addrScripts [][]byte
+	if end > begin {
+		addrScripts = make([][]byte, 0, (end-begin)*gapLimit*4)
+	}
+	addrScriptAccts := make(map[string]uint32)
+	for acct := begin; acct < end; acct++ {
+		xpriv, err := coinTypeXpriv.Child(hd.HardenedKeyStart + acct)
+		if err != nil {
+			return 0, false, err
 		}
-		for acct := begin; acct < end; acct++ {
-			xpriv, err := coinTypeXpriv.Child(hd.HardenedKeyStart + acct)
-			if err != nil {
-				return 0, err
-			}
-			xpub := xpriv.Neuter()
-			extKey, intKey, err := deriveBranches(xpub)
-			if err != nil {
-				xpriv.Zero()
-				return 0, err
-			}
-			addrs, err := deriveChildAddresses(extKey, 0, gapLimit, w.chainParams)
+		xpub := xpriv.Neuter()
+		extKey, intKey, err := deriveBranches(xpub)
+		if err != nil {
 			xpriv.Zero()
+			return 0, false, err
+		}
+		xpriv.Zero()
+		for _, branchKey := range []*hd.ExtendedKey{extKey, intKey} {
+			addrs, pubKeyAddrs, err := deriveChildWatchAddresses(branchKey,
+				0, gapLimit, w.chainParams)
 			if err != nil {
-				return 0, err
-			}
-			for _, a := range addrs {
-				_, script := a.PaymentScript()
-				addrScriptAccts[string(script)] = acct
-				addrScripts = append(addrScripts, script)
-			}
-			addrs, err = deriveChildAddresses(intKey, 0, gapLimit, w.chainParams)
-			if err != nil {
-				return 0, err
+				return 0, false, err
 			}
-			for _, a := range addrs {
+			for _, a := range append(addrs, pubKeyAddrs...) {
 				_, script := a.PaymentScript()
 				addrScriptAccts[string(script)] = acct
 				addrScripts = append(addrScripts, script)
 			}
 		}
+	}
 
-		sb := startBlock
-		if sb == nil {
-			sb = &w.chainParams.GenesisHash
-		}
-		searchBlocks, err := w.filterBlocks(ctx, sb, addrScripts)
-		if err != nil {
-			return 0, err
-		}
+	sb := startBlock
+	if sb == nil {
+		sb = &w.chainParams.GenesisHash
+	}
+	searchBlocks, err := w.filterBlocks(ctx, sb, addrScripts)
+	if err != nil {
+		return 0, false, err
+	}
 
-		// Fetch blocks that have not been fetched yet, and reduce them to a set
-		// of output script commitments.
-		err = cacheMissingCommitments(ctx, n, blockCache, searchBlocks)
-		if err != nil {
-			return 0, err
-		}
+	// Fetch blocks that have not been fetched yet, and reduce them to a set
+	// of output script commitments.
+	err = cacheMissingCommitments(ctx, n, blockCache, searchBlocks)
+	if err != nil {
+		return 0, false, err
+	}
 
-		// Search matching blocks for account usage.
-		var lastUsed uint32
-		for _, b := range searchBlocks {
-			commitments := blockCache[*b]
-			for _, script := range addrScripts {
-				if _, ok := commitments[string(script)]; !ok {
-					continue
-				}
+	// Search matching blocks for account usage.
+	var lastUsed uint32
+	var used bool
+	for _, b := range searchBlocks {
+		commitments := blockCache[*b]
+		for _, script := range addrScripts {
+			if _, ok := commitments[string(script)]; !ok {
+				continue
+			}
 
-				// Filter match was not a false positive and an output pays to a
-				// matching address in the block.  Look up the account of the
-				// script and increase the last used account when necessary.
-				acct := addrScriptAccts[string(script)]
-				log.Debugf("Found match for script %x account %v in block %v",
-					script, acct, b)
-				if lastUsed < acct {
-					lastUsed = acct
-				}
+			// Filter match was not a false positive and an output pays to a
+			// matching address in the block.  Look up the account of the
+			// script and increase the last used account when necessary.
+			acct := addrScriptAccts[string(script)]
+			log.Debugf("Found match for script %x account %v in block %v",
+				script, acct, b)
+			used = true
+			if lastUsed < acct {
+				lastUsed = acct
 			}
 		}
-		return lastUsed, nil
 	}
+	return lastUsed, used, nil
+}
 
-	// A binary search may be needed to efficiently find the last used account
-	// in the case where many accounts are used.  However, for most users, only
-	// a small number of accounts are ever created so a linear scan is performed
-	// first.  Search through the first two segments of accounts, and when the
-	// last used account is not in the second segment, the bsearch is
-	// unnecessary.
-	lastUsed, err := lastUsedInRange(0, acctGapLimit*2)
-	if err != nil {
-		return 0, err
-	}
-	if lastUsed < acctGapLimit {
-		return lastUsed, nil
+// coinTypeUsed returns whether any address of the accounts derived from
+// coinTypeXpriv, through the account gap limit, has been used.
+func (w *Wallet) coinTypeUsed(ctx context.Context, n NetworkBackend, blockCache blockCommitmentCache,
+	coinTypeXpriv *hd.ExtendedKey, gapLimit uint32, startBlock *chainhash.Hash) (bool, error) {
+
+	end := uint32(w.accountGapLimit) + 1
+	var used bool
+	var err error
+	if rpc, ok := n.(usedAddressesQuerier); ok {
+		f := existsAddrIndexFinder{w, rpc, gapLimit}
+		_, used, err = f.lastUsedAccountInRange(ctx, coinTypeXpriv, 0, end)
+	} else {
+		_, used, err = w.lastUsedAccountInRange(ctx, n, blockCache,
+			coinTypeXpriv, gapLimit, startBlock, 0, end)
 	}
+	return used, err
+}
 
-	// Fallback to a binary search, starting in the third segment
-	var lo, hi uint32 = 2, hd.HardenedKeyStart / acctGapLimit
-	for lo <= hi {
-		mid := (hi + lo) / 2
-		begin := mid * acctGapLimit
-		end := begin + acctGapLimit
-		last, err := lastUsedInRange(begin, end)
+// selectRestoredCoinType decides the coin type used by a legacy coin type
+// wallet restored from seed by searching for usage of both the legacy and
+// SLIP0044 coin types.  Wallets without legacy coin type usage are upgraded to
+// the SLIP0044 coin type unless upgrades are disabled, and it returns whether
+// the wallet was upgraded.  When both coin types are used, the legacy coin
+// type remains in use and the SLIP0044 accounts may be merged into the wallet
+// with ImportSLIP0044Account.
+func (w *Wallet) selectRestoredCoinType(ctx context.Context, n NetworkBackend,
+	blockCache blockCommitmentCache, gapLimit uint32, startBlock *chainhash.Hash) (bool, error) {
+
+	var legacyXpriv, slip0044Xpriv *hd.ExtendedKey
+	defer func() {
+		if legacyXpriv != nil {
+			legacyXpriv.Zero()
+		}
+		if slip0044Xpriv != nil {
+			slip0044Xpriv.Zero()
+		}
+	}()
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		legacyXpriv, err = w.manager.CoinTypePrivKey(dbtx)
 		if err != nil {
-			return 0, err
+			return err
 		}
-		if last > lastUsed {
-			lastUsed = last
+		slip0044Xpriv, err = w.manager.SLIP0044CoinTypePrivKey(dbtx)
+		if errors.Is(err, errors.NotExist) {
+			err = nil
 		}
-		if mid == 0 {
-			break
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+	if slip0044Xpriv == nil {
+		return false, nil
+	}
+
+	log.Infof("Searching for usage of legacy and SLIP0044 coin types")
+	legacyUsed, err := w.coinTypeUsed(ctx, n, blockCache, legacyXpriv,
+		gapLimit, startBlock)
+	if err != nil {
+		return false, err
+	}
+	if !legacyUsed && !w.disableCoinTypeUpgrades {
+		_, slip0044CoinType := udb.CoinTypes(w.chainParams)
+		log.Infof("No legacy coin type usage found; upgrading wallet to "+
+			"SLIP0044 coin type %d", slip0044CoinType)
+		err := w.UpgradeToSLIP0044CoinType(ctx)
+		if err != nil {
+			log.Errorf("Coin type upgrade failed: %v", err)
+			return false, nil
 		}
-		hi = mid - 1
+		return true, nil
 	}
-	return lastUsed, nil
+
+	slip0044Used, err := w.coinTypeUsed(ctx, n, blockCache, slip0044Xpriv,
+		gapLimit, startBlock)
+	if err != nil {
+		return false, err
+	}
+	switch {
+	case slip0044Used && legacyUsed:
+		log.Warnf("Seed has been used with both the legacy and SLIP0044 " +
+			"coin types.  Continuing with the legacy coin type; import " +
+			"used SLIP0044 accounts to recover their funds")
+	case slip0044Used:
+		log.Warnf("Seed has been used with the SLIP0044 coin type, but " +
+			"coin type upgrades are disabled.  Import used SLIP0044 " +
+			"accounts to recover their funds")
+	}
+	return false, nil
 }
 
 // existsAddrIndexFinder implements address and account discovery using the
@@ -555,57 +632,84 @@ type existsAddrIndexFinder struct {
 }
 
 func (f *existsAddrIndexFinder) findLastUsedAccount(ctx context.Context, coinTypeXpriv *hd.ExtendedKey) (uint32, error) {
-	scanLen := uint32(f.wallet.accountGapLimit)
-	var (
-		lastUsed uint32
-		lo, hi   uint32 = 0, hd.HardenedKeyStart / scanLen
-	)
-Bsearch:
-	for lo <= hi {
-		mid := (hi + lo) / 2
-		type result struct {
-			used    bool
-			account uint32
-			err     error
+	lastUsedInRange := func(begin, end uint32) (uint32, error) {
+		lastUsed, _, err := f.lastUsedAccountInRange(ctx, coinTypeXpriv, begin, end)
+		return lastUsed, err
+	}
+	return scanAccountGap(uint32(f.wallet.accountGapLimit), lastUsedInRange)
+}
+
+// lastUsedAccountInRange returns the last used account of the accounts
+// [begin,end) derived from coinTypeXpriv, and whether any account in the range
+// is used.
+func (f *existsAddrIndexFinder) lastUsedAccountInRange(ctx context.Context,
+	coinTypeXpriv *hd.ExtendedKey, begin, end uint32) (uint32, bool, error) {
+
+	type result struct {
+		used bool
+		err  error
+	}
+	results := make([]result, end-begin)
+	var wg sync.WaitGroup
+	for account := begin; account < end; account++ {
+		xpriv, err := coinTypeXpriv.Child(hd.HardenedKeyStart + account)
+		if err != nil {
+			wg.Wait()
+			return 0, false, err
 		}
-		var results = make([]result, scanLen)
-		var wg sync.WaitGroup
-		for i := int(scanLen) - 1; i >= 0; i-- {
-			i := i
-			account := mid*scanLen + uint32(i)
-			if account >= hd.HardenedKeyStart {
-				continue
-			}
-			xpriv, err := coinTypeXpriv.Child(hd.HardenedKeyStart + account)
-			if err != nil {
-				return 0, err
-			}
-			xpub := xpriv.Neuter()
-			wg.Add(1)
-			go func() {
-				used, err := f.accountUsed(ctx, xpub)
-				xpriv.Zero()
-				results[i] = result{used, account, err}
-				wg.Done()
-			}()
+		xpub := xpriv.Neuter()
+		wg.Add(1)
+		go func(i uint32) {
+			used, err := f.accountUsed(ctx, xpub)
+			xpriv.Zero()
+			results[i] = result{used, err}
+			wg.Done()
+		}(account - begin)
+	}
+	wg.Wait()
+	var lastUsed uint32
+	var used bool
+	for i := range results {
+		if results[i].err != nil {
+			return 0, false, results[i].err
 		}
-		wg.Wait()
-		for i := int(scanLen) - 1; i >= 0; i-- {
-			if results[i].err != nil {
-				return 0, results[i].err
-			}
-			if results[i].used {
-				lastUsed = results[i].account
-				lo = mid + 1
-				continue Bsearch
-			}
+		if results[i].used {
+			lastUsed = begin + uint32(i)
+			used = true
 		}
-		if mid == 0 {
-			break
+	}
+	return lastUsed, used, nil
+}
+
+// scanAccountGap finds the last used account by searching ranges of accounts
+// until accountGapLimit accounts past the last used account are found to be
+// unused.  Searching past unused accounts allows recovering sparsely numbered
+// accounts, as long as they are not separated by more than the gap limit.
+// lastUsedInRange returns the last used account in the range [begin,end), or
+// zero when no account in the range is used.
+func scanAccountGap(accountGapLimit uint32,
+	lastUsedInRange func(begin, end uint32) (uint32, error)) (uint32, error) {
+
+	var lastUsed, scanned uint32
+	for {
+		end := lastUsed + 1 + accountGapLimit
+		if end > hd.HardenedKeyStart || end < lastUsed {
+			end = hd.HardenedKeyStart
 		}
-		hi = mid - 1
+		if scanned >= end {
+			return lastUsed, nil
+		}
+		last, err := lastUsedInRange(scanned, end)
+		if err != nil {
+			return 0, err
+		}
+		if last > lastUsed {
+			log.Debugf("Found used account %d; searching through account %d",
+				last, last+accountGapLimit)
+			lastUsed = last
+		}
+		scanned = end
 	}
-	return lastUsed, nil
 }
 
 func (f *existsAddrIndexFinder) accountUsed(ctx context.Context, xpub *hd.ExtendedKey) (bool, error) {
@@ -653,12 +757,11 @@ func (f *existsAddrIndexFinder) branchUsed(ctx context.Context, branchXpub *hd.E
 }
 
 // findLastUsedAddress returns the child index of the last used child address
-// derived from a branch key.  If no addresses are found, ^uint32(0) is
-// returned.
-func (f *existsAddrIndexFinder) findLastUsedAddress(ctx context.Context, xpub *hd.ExtendedKey) (uint32, error) {
+// derived from a branch key, scanning in segments of scanLen addresses.  If no
+// addresses are found, ^uint32(0) is returned.
+func (f *existsAddrIndexFinder) findLastUsedAddress(ctx context.Context, xpub *hd.ExtendedKey, scanLen uint32) (uint32, error) {
 	var (
 		lastUsed        = ^uint32(0)
-		scanLen         = f.gapLimit
 		segments        = hd.HardenedKeyStart / scanLen
 		lo, hi   uint32 = 0, segments - 1
 	)
@@ -690,7 +793,7 @@ Bsearch:
 
 func (f *existsAddrIndexFinder) find(ctx context.Context, finder *addrFinder) error {
 	var g errgroup.Group
-	lastUsed := func(acct, branch uint32, index *uint32) error {
+	lastUsed := func(acct, branch, gapLimit uint32, index *uint32) error {
 		var k *hd.ExtendedKey
 		err := walletdb.View(ctx, f.wallet.db, func(tx walletdb.ReadTx) error {
 			var err error
@@ -700,7 +803,7 @@ func (f *existsAddrIndexFinder) find(ctx context.Context, finder *addrFinder) er
 		if err != nil {
 			return err
 		}
-		lastUsed, err := f.findLastUsedAddress(ctx, k)
+		lastUsed, err := f.findLastUsedAddress(ctx, k, gapLimit)
 		if err != nil {
 			return err
 		}
@@ -709,17 +812,22 @@ func (f *existsAddrIndexFinder) find(ctx context.Context, finder *addrFinder) er
 	}
 	for i := range finder.usage {
 		u := &finder.usage[i]
-		acct := u.account
-		g.Go(func() error { return lastUsed(acct, 0, &u.extLastUsed) })
-		g.Go(func() error { return lastUsed(acct, 1, &u.intLastUsed) })
+		acct, gapLimit := u.account, u.gapLimit
+		g.Go(func() error { return lastUsed(acct, 0, gapLimit, &u.extLastUsed) })
+		g.Go(func() error { return lastUsed(acct, 1, gapLimit, &u.intLastUsed) })
 	}
 	return g.Wait()
 }
 
 // DiscoverActiveAddresses searches for future wallet address usage in all
 // blocks starting from startBlock.  If discoverAccts is true, used accounts
-// will be discovered as well.  This feature requires the wallet to be unlocked
-// in order to derive hardened account extended pubkeys.
+// and the used addresses of account watch keys (see AccountWatchKey) will be
+// discovered as well.  This feature requires the wallet to be unlocked in
+// order to derive hardened account extended pubkeys.
+//
+// Address usage within each account is searched using the larger of gapLimit
+// and the account's activity-derived gap limit (see AccountAddressGapLimit), so
+// that accounts which recently issued many addresses are not truncated.
 //
 // If the wallet is currently on the legacy coin type and no address or account
 // usage is observed and coin type upgrades are not disabled, the wallet will be
@@ -754,9 +862,34 @@ func (w *Wallet) DiscoverActiveAddresses(ctx context.Context, n NetworkBackend,
 	// searched for.
 	blockAddresses := make(blockCommitmentCache)
 
+	// Accounts and address usage recorded by an imported checkpoint need
+	// not be discovered again in blocks before the checkpoint block.
+	scanStart, fromCheckpoint, err := w.discoveryStart(ctx, startBlock)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if fromCheckpoint {
+		log.Infof("Discovering usage after imported checkpoint block %v", scanStart)
+	}
+
 	// Start by rescanning the accounts and determining what the current account
 	// index is. This scan should only ever be performed if we're restoring our
 	// wallet from seed.
+	//
+	// The coin type of a wallet restored from a checkpoint was set by the
+	// checkpoint.  Usage before the checkpoint block is not searched, so it
+	// can not be used to select the coin type.
+	if discoverAccts && coinTypeKnown && !isSLIP0044CoinType && !fromCheckpoint {
+		upgraded, err := w.selectRestoredCoinType(ctx, n, blockAddresses,
+			gapLimit, scanStart)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		if upgraded {
+			return w.DiscoverActiveAddresses(ctx, n, startBlock, discoverAccts, gapLimit)
+		}
+	}
+
 	if discoverAccts {
 		log.Infof("Discovering used accounts")
 		var coinTypePrivKey *hd.ExtendedKey
@@ -779,7 +912,7 @@ func (w *Wallet) DiscoverActiveAddresses(ctx context.Context, n NetworkBackend,
 			f := existsAddrIndexFinder{w, rpc, gapLimit}
 			lastUsed, err = f.findLastUsedAccount(ctx, coinTypePrivKey)
 		} else {
-			lastUsed, err = w.findLastUsedAccount(ctx, n, blockAddresses, coinTypePrivKey, gapLimit, startBlock)
+			lastUsed, err = w.findLastUsedAccount(ctx, n, blockAddresses, coinTypePrivKey, gapLimit, scanStart)
 		}
 		if err != nil {
 			return errors.E(op, err)
@@ -845,7 +978,7 @@ func (w *Wallet) DiscoverActiveAddresses(ctx context.Context, n NetworkBackend,
 		f := existsAddrIndexFinder{w, rpc, gapLimit}
 		err = f.find(ctx, finder)
 	} else {
-		err = finder.find(ctx, startBlock, n)
+		err = finder.find(ctx, scanStart, n)
 	}
 	if err != nil {
 		return errors.E(op, err)
@@ -865,7 +998,7 @@ func (w *Wallet) DiscoverActiveAddresses(ctx context.Context, n NetworkBackend,
 		acct := u.account
 
 		const N = 256
-		max := u.extLastUsed + gapLimit
+		max := u.extLastUsed + u.gapLimit
 		for j := lastUsed[i].extLastUsed; ; j += N {
 			if ctx.Err() != nil {
 				return ctx.Err()
@@ -884,7 +1017,7 @@ func (w *Wallet) DiscoverActiveAddresses(ctx context.Context, n NetworkBackend,
 			}
 		}
 
-		max = u.intLastUsed + gapLimit
+		max = u.intLastUsed + u.gapLimit
 		for j := lastUsed[i].intLastUsed; ; j += N {
 			if ctx.Err() != nil {
 				return ctx.Err()
@@ -908,17 +1041,24 @@ func (w *Wallet) DiscoverActiveAddresses(ctx context.Context, n NetworkBackend,
 		w.addressBuffersMu.Lock()
 		err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
 			ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+			uses := make([]udb.ChildIndexUse, 0, 2)
 			if u.extLastUsed < hd.HardenedKeyStart {
-				err = w.manager.MarkUsedChildIndex(dbtx, acct, 0, u.extLastUsed)
-				if err != nil {
-					return err
-				}
+				uses = append(uses, udb.ChildIndexUse{
+					Account: acct,
+					Branch:  udb.ExternalBranch,
+					Child:   u.extLastUsed,
+				})
 			}
 			if u.intLastUsed < hd.HardenedKeyStart {
-				err = w.manager.MarkUsedChildIndex(dbtx, acct, 1, u.intLastUsed)
-				if err != nil {
-					return err
-				}
+				uses = append(uses, udb.ChildIndexUse{
+					Account: acct,
+					Branch:  udb.InternalBranch,
+					Child:   u.intLastUsed,
+				})
+			}
+			err = w.manager.MarkUsedChildIndexes(dbtx, uses)
+			if err != nil {
+				return err
 			}
 
 			props, err := w.manager.AccountProperties(ns, acct)
@@ -954,6 +1094,27 @@ func (w *Wallet) DiscoverActiveAddresses(ctx context.Context, n NetworkBackend,
 		}
 	}
 
+	// Watch keys are derived from the account private keys, and their
+	// addresses are only discovered when restoring accounts.
+	var watchKeysUsed bool
+	if discoverAccts {
+		log.Infof("Discovering used watch key addresses")
+		watchKeysUsed, err = w.discoverWatchKeys(ctx, n, blockAddresses,
+			scanStart, gapLimit)
+		if err != nil {
+			return errors.E(op, err)
+		}
+	}
+
+	if fromCheckpoint {
+		err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+			return udb.SetDiscoveryCheckpoint(dbtx, nil)
+		})
+		if err != nil {
+			return errors.E(op, err)
+		}
+	}
+
 	// If the wallet does not know the current coin type (e.g. it is a watching
 	// only wallet created from an account master pubkey) or when the wallet
 	// uses the SLIP0044 coin type, there is nothing more to do.
@@ -965,7 +1126,7 @@ func (w *Wallet) DiscoverActiveAddresses(ctx context.Context, n NetworkBackend,
 	// Do not upgrade legacy coin type wallets if there are returned or used
 	// addresses or coin type upgrades are disabled.
 	if !isSLIP0044CoinType && (w.disableCoinTypeUpgrades ||
-		len(finder.usage) != 1 ||
+		len(finder.usage) != 1 || watchKeysUsed ||
 		finder.usage[0].extLastUsed != ^uint32(0) ||
 		finder.usage[0].intLastUsed != ^uint32(0)) {
 		log.Infof("Finished address discovery")
@@ -8,6 +8,7 @@ import (
 	"context"
 	"testing"
 
+	"decred.org/dcrwallet/v5/wallet/udb"
 	"decred.org/dcrwallet/v5/wallet/walletdb"
 )
 
@@ -79,7 +80,7 @@ func TestDiscoveryCursorPos(t *testing.T) {
 		if err != nil {
 			return err
 		}
-		return w.markUsedAddress("", dbtx, maddr4)
+		return w.markUsedAddresses("", dbtx, []udb.ManagedAddress{maddr4}, nil)
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -100,3 +101,48 @@ func TestDiscoveryCursorPos(t *testing.T) {
 			lastUsed, wasLastUsed, cursor, wasCursor)
 	}
 }
+
+func TestScanAccountGap(t *testing.T) {
+	tests := []struct {
+		name     string
+		used     []uint32
+		gap      uint32
+		lastUsed uint32
+	}{
+		{"default account only", []uint32{0}, 5, 0},
+		{"contiguous", []uint32{0, 1, 2, 3}, 5, 3},
+		{"sparse within gap", []uint32{0, 3, 8, 13}, 5, 13},
+		{"beyond gap", []uint32{0, 3, 9}, 5, 3},
+		{"first account beyond default", []uint32{0, 5}, 5, 5},
+	}
+	for _, test := range tests {
+		used := make(map[uint32]bool)
+		for _, acct := range test.used {
+			used[acct] = true
+		}
+		var scanned []uint32
+		lastUsed, err := scanAccountGap(test.gap, func(begin, end uint32) (uint32, error) {
+			var last uint32
+			for acct := begin; acct < end; acct++ {
+				scanned = append(scanned, acct)
+				if used[acct] {
+					last = acct
+				}
+			}
+			return last, nil
+		})
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if lastUsed != test.lastUsed {
+			t.Errorf("%s: last used account %d, want %d", test.name,
+				lastUsed, test.lastUsed)
+		}
+		// Every account is scanned once, through the gap past the last
+		// used account.
+		if want := test.lastUsed + 1 + test.gap; uint32(len(scanned)) != want {
+			t.Errorf("%s: scanned %d accounts, want %d", test.name,
+				len(scanned), want)
+		}
+	}
+}
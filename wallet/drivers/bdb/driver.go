@@ -11,6 +11,9 @@
 //	if err != nil { /* handle error */ }
 //	db, err = wallet.OpenDB("bdb", filename)
 //	if err != nil { /* handle error */ }
+//
+// Passing true as an additional argument to wallet.OpenDB opens the database
+// read-only.
 package bdb
 
 import _ "decred.org/dcrwallet/v5/wallet/internal/bdb" // Register bdb driver during init
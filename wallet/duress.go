@@ -0,0 +1,59 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+// SetDuressPassphrase configures a secondary private passphrase for use under
+// coercion.  Unlocking the wallet with the duress passphrase reports the
+// wallet as unlocked, but only the private keys of account are available.
+// The wallet must be unlocked with its private passphrase.
+func (w *Wallet) SetDuressPassphrase(ctx context.Context, account uint32, passphrase []byte) error {
+	const op errors.Op = "wallet.SetDuressPassphrase"
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		addrmgrNs := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		return w.manager.SetDuressPassphrase(addrmgrNs, account, passphrase)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// ClearDuressPassphrase removes the duress passphrase.  The wallet must be
+// unlocked with its private passphrase.
+func (w *Wallet) ClearDuressPassphrase(ctx context.Context) error {
+	const op errors.Op = "wallet.ClearDuressPassphrase"
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		addrmgrNs := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		return w.manager.ClearDuressPassphrase(addrmgrNs)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// DuressAccount returns the account unlocked by the duress passphrase, and
+// whether one is configured.  The wallet must be unlocked, and no duress
+// passphrase is reported after unlocking with it.
+func (w *Wallet) DuressAccount(ctx context.Context) (account uint32, ok bool, err error) {
+	const op errors.Op = "wallet.DuressAccount"
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		var err error
+		account, ok, err = w.manager.DuressAccount(addrmgrNs)
+		return err
+	})
+	if err != nil {
+		return 0, false, errors.E(op, err)
+	}
+	return account, ok, nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+)
+
+func TestDuressPassphrase(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	cfg.AccountGapLimit = 2
+	w, teardown := testWallet(ctx, t, &cfg, nil)
+	defer teardown()
+
+	duressPass := []byte("duress")
+	if err := w.SetDuressPassphrase(ctx, 0, duressPass); !errors.Is(err, errors.Locked) {
+		t.Fatalf("duress passphrase set while locked: %v", err)
+	}
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	decoy, err := w.NextAccount(ctx, "decoy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.SetDuressPassphrase(ctx, decoy, testPrivPass); !errors.Is(err, errors.Invalid) {
+		t.Fatalf("private passphrase accepted as duress passphrase: %v", err)
+	}
+	if err := w.SetDuressPassphrase(ctx, decoy, duressPass); err != nil {
+		t.Fatal(err)
+	}
+	if acct, ok, err := w.DuressAccount(ctx); err != nil || !ok || acct != decoy {
+		t.Fatalf("DuressAccount: %v %v %v", acct, ok, err)
+	}
+	err = w.ChangePrivatePassphrase(ctx, testPrivPass, duressPass)
+	if !errors.Is(err, errors.Invalid) {
+		t.Fatalf("private passphrase changed to duress passphrase: %v", err)
+	}
+
+	// Unlocking with the duress passphrase must report the wallet as
+	// unlocked while only providing the decoy account's keys.
+	w.Lock()
+	if err := w.Unlock(ctx, duressPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	if w.Locked() {
+		t.Fatal("wallet reports locked after duress unlock")
+	}
+	if err := w.Unlock(ctx, duressPass, nil); err != nil {
+		t.Fatalf("repeated duress unlock: %v", err)
+	}
+	if _, err := w.AccountXpriv(ctx, decoy); err != nil {
+		t.Errorf("decoy account xpriv: %v", err)
+	}
+	if _, err := w.AccountXpriv(ctx, 0); !errors.Is(err, errors.Locked) {
+		t.Errorf("default account xpriv available after duress unlock: %v", err)
+	}
+	if _, ok, err := w.DuressAccount(ctx); err != nil || ok {
+		t.Errorf("duress account revealed after duress unlock: %v %v", ok, err)
+	}
+
+	// The private passphrase fully unlocks the wallet after a duress
+	// unlock.
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.AccountXpriv(ctx, 0); err != nil {
+		t.Errorf("default account xpriv: %v", err)
+	}
+
+	if err := w.ClearDuressPassphrase(ctx); err != nil {
+		t.Fatal(err)
+	}
+	w.Lock()
+	if err := w.Unlock(ctx, duressPass, nil); !errors.Is(err, errors.Passphrase) {
+		t.Errorf("cleared duress passphrase unlocked wallet: %v", err)
+	}
+}
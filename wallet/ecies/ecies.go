@@ -0,0 +1,117 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package ecies implements the Elliptic Curve Integrated Encryption Scheme
+// over secp256k1, encrypting messages to a public key such that only the
+// holder of the private key may decrypt them.
+//
+// A message is encrypted by generating an ephemeral secp256k1 key and
+// performing ECDH with the recipient public key.  The x coordinate of the
+// shared point is expanded with HKDF-SHA256, salted by the compressed
+// ephemeral and recipient public keys and using the info string
+// "dcrwallet ECIES", into a 32-byte ChaCha20-Poly1305 key.  As each key
+// encrypts a single message, the all-zero nonce is used.
+//
+// Ciphertexts are serialized as:
+//
+//	[0:33] Compressed ephemeral public key (33 bytes)
+//	[33:]  Sealed message followed by the Poly1305 tag (16 bytes)
+package ecies
+
+import (
+	"crypto/sha256"
+	"io"
+
+	"decred.org/dcrwallet/v5/errors"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/hkdf"
+)
+
+// Overhead is the number of bytes by which a ciphertext is longer than the
+// encrypted message.
+const Overhead = secp256k1.PubKeyBytesLenCompressed + chacha20poly1305.Overhead
+
+var info = []byte("dcrwallet ECIES")
+
+func aead(secret, ephemeral, recipient []byte) ([]byte, error) {
+	salt := make([]byte, 0, len(ephemeral)+len(recipient))
+	salt = append(salt, ephemeral...)
+	salt = append(salt, recipient...)
+	key := make([]byte, chacha20poly1305.KeySize)
+	_, err := io.ReadFull(hkdf.New(sha256.New, secret, salt, info), key)
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// Encrypt encrypts msg to the public key pub.
+func Encrypt(pub *secp256k1.PublicKey, msg []byte) ([]byte, error) {
+	const op errors.Op = "ecies.Encrypt"
+	ephemeral, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	defer ephemeral.Zero()
+	ephemeralPub := ephemeral.PubKey().SerializeCompressed()
+
+	secret := secp256k1.GenerateSharedSecret(ephemeral, pub)
+	key, err := aead(secret, ephemeralPub, pub.SerializeCompressed())
+	zero(secret)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	defer zero(key)
+	c, err := chacha20poly1305.New(key)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	var nonce [chacha20poly1305.NonceSize]byte
+	ciphertext := make([]byte, 0, len(msg)+Overhead)
+	ciphertext = append(ciphertext, ephemeralPub...)
+	return c.Seal(ciphertext, nonce[:], msg, nil), nil
+}
+
+// Decrypt decrypts a ciphertext created by Encrypt with the private key priv.
+// Errors with errors.Crypto if the ciphertext was not encrypted to the public
+// key of priv or has been modified.
+func Decrypt(priv *secp256k1.PrivateKey, ciphertext []byte) ([]byte, error) {
+	const op errors.Op = "ecies.Decrypt"
+	if len(ciphertext) < Overhead {
+		return nil, errors.E(op, errors.Invalid, "ciphertext is too short")
+	}
+	ephemeralPub := ciphertext[:secp256k1.PubKeyBytesLenCompressed]
+	pub, err := secp256k1.ParsePubKey(ephemeralPub)
+	if err != nil {
+		return nil, errors.E(op, errors.Invalid, err)
+	}
+
+	secret := secp256k1.GenerateSharedSecret(priv, pub)
+	key, err := aead(secret, ephemeralPub, priv.PubKey().SerializeCompressed())
+	zero(secret)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	defer zero(key)
+	c, err := chacha20poly1305.New(key)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	var nonce [chacha20poly1305.NonceSize]byte
+	sealed := ciphertext[secp256k1.PubKeyBytesLenCompressed:]
+	msg, err := c.Open(nil, nonce[:], sealed, nil)
+	if err != nil {
+		return nil, errors.E(op, errors.Crypto, "message authentication failed")
+	}
+	return msg, nil
+}
+
+func zero(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
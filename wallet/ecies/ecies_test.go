@@ -0,0 +1,65 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package ecies
+
+import (
+	"bytes"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	priv, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, msg := range [][]byte{nil, []byte("payment for invoice 42")} {
+		ciphertext, err := Encrypt(priv.PubKey(), msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ciphertext) != len(msg)+Overhead {
+			t.Errorf("ciphertext length %d, want %d", len(ciphertext),
+				len(msg)+Overhead)
+		}
+		again, err := Encrypt(priv.PubKey(), msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bytes.Equal(ciphertext, again) {
+			t.Error("encryption is deterministic")
+		}
+
+		plaintext, err := Decrypt(priv, ciphertext)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(plaintext, msg) {
+			t.Errorf("decrypted %q, want %q", plaintext, msg)
+		}
+
+		if _, err := Decrypt(other, ciphertext); !errors.Is(err, errors.Crypto) {
+			t.Errorf("decrypted with wrong key: %v", err)
+		}
+		ciphertext[len(ciphertext)-1] ^= 1
+		if _, err := Decrypt(priv, ciphertext); !errors.Is(err, errors.Crypto) {
+			t.Errorf("decrypted modified ciphertext: %v", err)
+		}
+	}
+
+	if _, err := Decrypt(priv, make([]byte, Overhead-1)); !errors.Is(err, errors.Invalid) {
+		t.Errorf("decrypted short ciphertext: %v", err)
+	}
+	if _, err := Decrypt(priv, make([]byte, Overhead)); !errors.Is(err, errors.Invalid) {
+		t.Errorf("decrypted ciphertext with invalid ephemeral key: %v", err)
+	}
+}
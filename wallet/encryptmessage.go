@@ -0,0 +1,69 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/ecies"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+)
+
+// EncryptMessage encrypts msg to the secp256k1 public key of a wallet P2PKH
+// address using ECIES, such that it may only be decrypted by DecryptMessage.
+// Encryption does not require the wallet to be unlocked.
+func (w *Wallet) EncryptMessage(ctx context.Context, addr stdaddr.Address, msg []byte) ([]byte, error) {
+	const op errors.Op = "wallet.EncryptMessage"
+	ka, err := w.KnownAddress(ctx, addr)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	pkha, ok := ka.(PubKeyHashAddress)
+	if !ok {
+		return nil, errors.E(op, errors.Invalid,
+			errors.Errorf("address %v is not a P2PKH address", addr))
+	}
+	pub, err := secp256k1.ParsePubKey(pkha.PubKey())
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	ciphertext, err := ecies.Encrypt(pub, msg)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return ciphertext, nil
+}
+
+// DecryptMessage decrypts an ECIES ciphertext encrypted to the public key of a
+// wallet address, such as the output of EncryptMessage.  The wallet or account
+// must be unlocked.
+func (w *Wallet) DecryptMessage(ctx context.Context, addr stdaddr.Address, ciphertext []byte) ([]byte, error) {
+	const op errors.Op = "wallet.DecryptMessage"
+	var privKey *secp256k1.PrivateKey
+	var done func()
+	defer func() {
+		if done != nil {
+			done()
+		}
+	}()
+	err := walletdb.View(ctx, w.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		var err error
+		privKey, done, err = w.manager.PrivateKey(addrmgrNs, addr)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	w.noteSigningActivity()
+	msg, err := ecies.Decrypt(privKey, ciphertext)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return msg, nil
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+)
+
+func TestEncryptMessage(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := []byte("invoice 42")
+
+	// Encryption only requires the public key.
+	ciphertext, err := w.EncryptMessage(ctx, addr, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.DecryptMessage(ctx, addr, ciphertext); !errors.Is(err, errors.Locked) {
+		t.Errorf("decrypted with locked wallet: %v", err)
+	}
+
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	plaintext, err := w.DecryptMessage(ctx, addr, ciphertext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(plaintext, msg) {
+		t.Errorf("decrypted %q, want %q", plaintext, msg)
+	}
+	if _, err := w.DecryptMessage(ctx, other, ciphertext); !errors.Is(err, errors.Crypto) {
+		t.Errorf("decrypted with key of another address: %v", err)
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/txauthor"
+	"decred.org/dcrwallet/v5/wallet/txsizes"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/wire"
+)
+
+// UnsignedTxEstimate describes the transaction which would be authored to pay
+// outputs, without deriving change addresses, locking the selected inputs, or
+// signing the transaction.
+type UnsignedTxEstimate struct {
+	// Inputs are the previous outputs selected to fund the transaction.
+	Inputs []wire.OutPoint
+
+	// TotalInput is the total value of the selected inputs.
+	TotalInput dcrutil.Amount
+
+	// Fee is the absolute fee paid by the transaction.
+	Fee dcrutil.Amount
+
+	// Change is the value of the change output, or zero if change would
+	// not be returned to the wallet.
+	Change dcrutil.Amount
+
+	// SerializeSize is the serialize size of the transaction without
+	// input signature scripts.
+	SerializeSize int
+
+	// SignedSerializeSize is the serialize size of the transaction after
+	// signing, as estimated from the script types of the selected inputs.
+	// The fee is calculated from this size.
+	SignedSerializeSize int
+}
+
+// estimateChangeSource is a txauthor.ChangeSource returning a placeholder P2PKH
+// script.  Change scripts of the same size do not affect transaction sizes or
+// fees, and the placeholder avoids deriving change addresses for transactions
+// which are never published.
+type estimateChangeSource struct {
+	params stdaddr.AddressParams
+}
+
+func (src estimateChangeSource) Script() ([]byte, uint16, error) {
+	var hash160 [20]byte
+	addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(hash160[:], src.params)
+	if err != nil {
+		return nil, 0, err
+	}
+	version, script := addr.PaymentScript()
+	return script, version, nil
+}
+
+func (estimateChangeSource) ScriptSize() int {
+	return txsizes.P2PKHPkScriptSize
+}
+
+// EstimateUnsignedTransaction performs the coin selection of
+// NewUnsignedTransaction and returns the size and fee of the transaction which
+// would be authored, without modifying the wallet.  The sizes of input
+// signature scripts are determined by the script types of the selected
+// outputs, and private keys are not required, allowing watching-only wallets
+// to quote the fees of transactions which are signed offline.
+//
+// The changeSource parameter is optional and can be nil, in which case the
+// change is estimated as paying a P2PKH wallet address.
+func (w *Wallet) EstimateUnsignedTransaction(ctx context.Context, outputs []*wire.TxOut,
+	relayFeePerKb dcrutil.Amount, account uint32, minConf int32,
+	algo OutputSelectionAlgorithm, changeSource txauthor.ChangeSource) (*UnsignedTxEstimate, error) {
+
+	const op errors.Op = "wallet.EstimateUnsignedTransaction"
+
+	if changeSource == nil {
+		changeSource = estimateChangeSource{w.chainParams}
+	}
+	atx, err := w.NewUnsignedTransaction(ctx, outputs, relayFeePerKb, account,
+		minConf, algo, changeSource, nil)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	e := &UnsignedTxEstimate{
+		Inputs:              make([]wire.OutPoint, len(atx.Tx.TxIn)),
+		TotalInput:          atx.TotalInput,
+		Fee:                 atx.TotalInput,
+		SerializeSize:       atx.Tx.SerializeSize(),
+		SignedSerializeSize: atx.EstimatedSignedSerializeSize,
+	}
+	for i, in := range atx.Tx.TxIn {
+		e.Inputs[i] = in.PreviousOutPoint
+	}
+	for _, out := range atx.Tx.TxOut {
+		e.Fee -= dcrutil.Amount(out.Value)
+	}
+	if atx.ChangeIndex >= 0 {
+		e.Change = dcrutil.Amount(atx.Tx.TxOut[atx.ChangeIndex].Value)
+	}
+	return e, nil
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/wallet/txrules"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestEstimateUnsignedTransaction(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, pkScript := addr.PaymentScript()
+	fund := wire.NewMsgTx()
+	fund.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, 0, nil))
+	fund.AddTxOut(&wire.TxOut{Value: 10e8, Version: version, PkScript: pkScript})
+	if err := w.AddTransaction(ctx, fund, &w.chainParams.GenesisHash); err != nil {
+		t.Fatal(err)
+	}
+
+	err = walletdb.Update(ctx, w.db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrBucketKey)
+		return w.manager.ConvertToWatchingOnly(ns)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lastReturnedChange := func() uint32 {
+		t.Helper()
+		var props *udb.AccountProperties
+		err := walletdb.View(ctx, w.db, func(tx walletdb.ReadTx) error {
+			var err error
+			props, err = w.manager.AccountProperties(tx.ReadBucket(waddrmgrBucketKey), 0)
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return props.LastReturnedInternalIndex
+	}
+	lastReturned := lastReturnedChange()
+
+	const feeRate dcrutil.Amount = 1e4
+	out := &wire.TxOut{Value: 1e8, Version: version, PkScript: pkScript}
+	e, err := w.EstimateUnsignedTransaction(ctx, []*wire.TxOut{out}, feeRate,
+		0, 0, OutputSelectionAlgorithmDefault, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(e.Inputs) != 1 || e.Inputs[0] != (wire.OutPoint{Hash: fund.TxHash()}) {
+		t.Errorf("selected inputs %v", e.Inputs)
+	}
+	if e.SignedSerializeSize <= e.SerializeSize {
+		t.Errorf("signed size %d not larger than unsigned size %d",
+			e.SignedSerializeSize, e.SerializeSize)
+	}
+	if want := txrules.FeeForSerializeSize(feeRate, e.SignedSerializeSize); e.Fee != want {
+		t.Errorf("fee %v, want %v", e.Fee, want)
+	}
+	if e.TotalInput != 10e8 || e.Change != e.TotalInput-1e8-e.Fee {
+		t.Errorf("total input %v, change %v", e.TotalInput, e.Change)
+	}
+
+	// Estimating does not derive change addresses.
+	if lastReturnedChange() != lastReturned {
+		t.Errorf("change address returned by estimate")
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+)
+
+// journalTx records an event for a wallet transaction in the event journal.
+// block is nil for unmined transaction events.
+func journalTx(dbtx walletdb.ReadWriteTx, typ udb.JournalEventType,
+	txHash *chainhash.Hash, block *udb.Block) error {
+
+	e := &udb.JournalEvent{
+		Type: typ,
+		Hash: *txHash,
+		Time: time.Now(),
+	}
+	if block != nil {
+		e.BlockHash = block.Hash
+		e.Height = block.Height
+	}
+	return udb.AppendJournalEvents(dbtx, e)
+}
+
+// journalDetachedBlock records events for every wallet transaction mined in
+// the main chain block at height, which is about to be removed from the main
+// chain.
+func (w *Wallet) journalDetachedBlock(dbtx walletdb.ReadWriteTx, blockHash *chainhash.Hash,
+	height int32) error {
+
+	txHashes, err := w.txStore.BlockTxHashes(dbtx, height)
+	if err != nil {
+		return err
+	}
+	block := &udb.Block{Hash: *blockHash, Height: height}
+	for i := range txHashes {
+		err := journalTx(dbtx, udb.JournalTxDetached, &txHashes[i], block)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// journalTipChanged records the new main chain tip in the event journal.
+func journalTipChanged(dbtx walletdb.ReadWriteTx, tip *BlockNode) error {
+	return udb.AppendJournalEvents(dbtx, &udb.JournalEvent{
+		Type:      udb.JournalTipChanged,
+		Hash:      *tip.Hash,
+		BlockHash: *tip.Hash,
+		Height:    int32(tip.Header.Height),
+		Time:      time.Now(),
+	})
+}
+
+// JournalEvents returns up to count events from the wallet's event journal,
+// beginning with sequence number from, and the sequence number that will be
+// assigned to the next recorded event.
+//
+// Events are recorded in the same database transactions as the changes they
+// describe, so a client that has processed every event before some sequence
+// number may resume from it after disconnecting without missing any change.
+// The first returned event has a later sequence number than from when older
+// events have been removed from the journal.  Events may be recorded more
+// than once for the same change (for example, by a rescan which rediscovers a
+// mined transaction) and clients must tolerate duplicates.
+func (w *Wallet) JournalEvents(ctx context.Context, from uint64, count int) ([]*udb.JournalEvent, uint64, error) {
+	const op errors.Op = "wallet.JournalEvents"
+	if count <= 0 {
+		return nil, 0, errors.E(op, errors.Invalid, "event count must be positive")
+	}
+	var events []*udb.JournalEvent
+	var next uint64
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		events, next, err = udb.JournalEvents(dbtx, from, count)
+		return err
+	})
+	if err != nil {
+		return nil, 0, errors.E(op, err)
+	}
+	return events, next, nil
+}
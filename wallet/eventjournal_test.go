@@ -0,0 +1,163 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet_test
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/wallettest"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestJournalEvents(t *testing.T) {
+	ctx := context.Background()
+	h := wallettest.New(t, nil)
+	w := h.Wallet
+
+	var seq uint64
+	next := func() []*udb.JournalEvent {
+		t.Helper()
+		events, next, err := w.JournalEvents(ctx, seq, 100)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, e := range events {
+			if e.Sequence < seq {
+				t.Fatalf("event sequence %d before %d", e.Sequence, seq)
+			}
+			seq = e.Sequence + 1
+		}
+		if seq != next {
+			t.Fatalf("next sequence %d, want %d", next, seq)
+		}
+		return events
+	}
+	type event struct {
+		typ    udb.JournalEventType
+		hash   chainhash.Hash
+		height int32
+	}
+	expect := func(events []*udb.JournalEvent, want ...event) {
+		t.Helper()
+		if len(events) != len(want) {
+			t.Fatalf("%d events, want %d", len(events), len(want))
+		}
+		for i, e := range events {
+			if e.Type != want[i].typ || e.Hash != want[i].hash ||
+				e.Height != want[i].height {
+				t.Errorf("event %d: %v %v at height %d, want %v %v at height %d",
+					i, e.Type, &e.Hash, e.Height, want[i].typ,
+					&want[i].hash, want[i].height)
+			}
+		}
+	}
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fund := h.Chain.FundingTx(addr, 1e8)
+	fundHash := fund.TxHash()
+	if err := h.Chain.AcceptTransaction(ctx, fund); err != nil {
+		t.Fatal(err)
+	}
+	expect(next(), event{udb.JournalTxUnmined, fundHash, 0})
+
+	b1, err := h.Chain.MineMempool(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect(next(),
+		event{udb.JournalTxMined, fundHash, 1},
+		event{udb.JournalTipChanged, b1.BlockHash(), 1})
+	resume := seq
+
+	// Reorganizing the block records the detached transaction before the
+	// new tip.
+	blocks, err := h.Chain.Reorg(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	events := next()
+	if len(events) < 2 {
+		t.Fatalf("%d events after reorg", len(events))
+	}
+	expect(events[:1], event{udb.JournalTxDetached, fundHash, 1})
+	expect(events[len(events)-1:],
+		event{udb.JournalTipChanged, blocks[len(blocks)-1].BlockHash(), 2})
+
+	// A client resuming from an earlier sequence number receives the same
+	// events again.
+	replayed, _, err := w.JournalEvents(ctx, resume, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(replayed) != len(events) || replayed[0].Sequence != resume {
+		t.Fatalf("replayed %d events from %d, want %d", len(replayed),
+			resume, len(events))
+	}
+	if _, _, err := w.JournalEvents(ctx, 0, 0); err == nil {
+		t.Fatal("zero event count did not error")
+	}
+}
+
+func TestJournalDoubleSpendRemoval(t *testing.T) {
+	ctx := context.Background()
+	h := wallettest.New(t, nil)
+	w := h.Wallet
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, script := addr.PaymentScript()
+	fund := h.Chain.FundingTx(addr, 1e8)
+	fundHash := fund.TxHash()
+	if _, err := h.Chain.MineBlock(ctx, fund); err != nil {
+		t.Fatal(err)
+	}
+	_, seq, err := w.JournalEvents(ctx, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// An unmined spend of the funding output, and an unmined spend of
+	// that transaction, are both removed when a conflicting spend is mined.
+	spend := wire.NewMsgTx()
+	spend.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&fundHash, 0, 0), 1e8, nil))
+	spend.AddTxOut(&wire.TxOut{Value: 1e8 - 1e4, Version: version, PkScript: script})
+	spendHash := spend.TxHash()
+	child := wire.NewMsgTx()
+	child.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&spendHash, 0, 0), 1e8-1e4, nil))
+	child.AddTxOut(&wire.TxOut{Value: 1e8 - 2e4, Version: version, PkScript: script})
+	for _, tx := range []*wire.MsgTx{spend, child} {
+		if err := h.Chain.AcceptTransaction(ctx, tx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	conflict := wire.NewMsgTx()
+	conflict.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&fundHash, 0, 0), 1e8, nil))
+	conflict.AddTxOut(&wire.TxOut{Value: 1e8 - 1e4, PkScript: []byte{0x51}})
+	if _, err := h.Chain.MineBlock(ctx, conflict); err != nil {
+		t.Fatal(err)
+	}
+
+	events, _, err := w.JournalEvents(ctx, seq, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	removed := make(map[chainhash.Hash]bool)
+	for _, e := range events {
+		if e.Type == udb.JournalTxRemoved {
+			removed[e.Hash] = true
+		}
+	}
+	if len(removed) != 2 || !removed[spendHash] || !removed[child.TxHash()] {
+		t.Fatalf("double spent transactions not journaled: %v", removed)
+	}
+}
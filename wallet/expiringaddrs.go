@@ -0,0 +1,97 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+)
+
+// ExpiredAddress describes an address issued by NewExpiringAddress which
+// expired before receiving a payment.  A non-zero Paid time records a late
+// payment received after the expiry.
+type ExpiredAddress struct {
+	Address stdaddr.Address
+	udb.ExpiringAddress
+}
+
+// NewExpiringAddress returns a new external address of an account which
+// expires at the time expiry or the block height expiryHeight, whichever
+// occurs first.  A zero expiry or expiryHeight disables expiry by time or
+// height, but at least one must be set.  Addresses which expire before being
+// paid are reported by ExpiredAddresses.
+func (w *Wallet) NewExpiringAddress(ctx context.Context, account uint32, expiry time.Time,
+	expiryHeight int32, callOpts ...NextAddressCallOption) (stdaddr.Address, error) {
+
+	const op errors.Op = "wallet.NewExpiringAddress"
+	if expiry.IsZero() && expiryHeight <= 0 {
+		return nil, errors.E(op, errors.Invalid, "no expiry time or height")
+	}
+	if expiryHeight < 0 {
+		return nil, errors.E(op, errors.Invalid, "negative expiry height")
+	}
+
+	// Imported voting accounts must not be used for normal transactions.
+	if err := w.notVotingAcct(ctx, op, account); err != nil {
+		return nil, err
+	}
+	accountName, _ := w.AccountName(ctx, account)
+
+	var addr stdaddr.Address
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		var err error
+		addr, err = w.nextAddress(ctx, op, w.persistReturnedChild(ctx, dbtx),
+			accountName, account, udb.ExternalBranch, callOpts...)
+		if err != nil {
+			return err
+		}
+		return udb.PutExpiringAddress(dbtx, addr.String(), &udb.ExpiringAddress{
+			Issued:       time.Now(),
+			Expiry:       expiry,
+			ExpiryHeight: expiryHeight,
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return addr, nil
+}
+
+// ExpiredAddresses returns every address issued by NewExpiringAddress which
+// has expired without being paid before its expiry, ordered by the time they
+// were issued.  Addresses which have since received a late payment are included
+// with the time and main chain tip height the payment was first observed.
+func (w *Wallet) ExpiredAddresses(ctx context.Context) ([]ExpiredAddress, error) {
+	const op errors.Op = "wallet.ExpiredAddresses"
+	var expired []ExpiredAddress
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		now := time.Now()
+		_, tipHeight := w.txStore.MainChainTip(dbtx)
+		return udb.ForEachExpiringAddress(dbtx, func(s string, a *udb.ExpiringAddress) error {
+			if !a.Expired(now, tipHeight) || (!a.Paid.IsZero() && !a.PaidLate()) {
+				return nil
+			}
+			addr, err := stdaddr.DecodeAddress(s, w.chainParams)
+			if err != nil {
+				return errors.E(errors.IO, err)
+			}
+			expired = append(expired, ExpiredAddress{Address: addr, ExpiringAddress: *a})
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	sort.SliceStable(expired, func(i, j int) bool {
+		return expired[i].Issued.Before(expired[j].Issued)
+	})
+	return expired, nil
+}
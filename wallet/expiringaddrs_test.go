@@ -0,0 +1,106 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestExpiredAddresses(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	if _, err := w.NewExpiringAddress(ctx, 0, time.Time{}, 0); !errors.Is(err, errors.Invalid) {
+		t.Fatalf("issued address without expiry: %v", err)
+	}
+
+	_, tipHeight := w.MainChainTip(ctx)
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+	newAddr := func(expiry time.Time, expiryHeight int32) stdaddr.Address {
+		t.Helper()
+		addr, err := w.NewExpiringAddress(ctx, 0, expiry, expiryHeight)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return addr
+	}
+	markPaidBy := func(addr stdaddr.Address, header *wire.BlockHeader) {
+		t.Helper()
+		err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+			ma, err := w.manager.Address(dbtx.ReadBucket(waddrmgrNamespaceKey), addr)
+			if err != nil {
+				return err
+			}
+			return w.markUsedAddresses("", dbtx, []udb.ManagedAddress{ma}, header)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	markPaid := func(addr stdaddr.Address) {
+		t.Helper()
+		markPaidBy(addr, nil)
+	}
+
+	expiredByTime := newAddr(past, tipHeight+10)
+	newAddr(future, 0)                 // not expired
+	newAddr(time.Time{}, tipHeight+10) // not expired
+	paidInTime := newAddr(future, 0)
+	markPaid(paidInTime)
+	paidLate := newAddr(past, 0)
+	markPaid(paidLate)
+
+	// A payment mined before the expiry is recorded at the block time and
+	// height, even when it is only observed after the expiry.
+	paidByBlock := newAddr(past, 0)
+	markPaidBy(paidByBlock, &wire.BlockHeader{
+		Height:    uint32(tipHeight),
+		Timestamp: past.Add(-time.Hour),
+	})
+
+	// Paying an address again does not change the recorded payment.
+	var paid *udb.ExpiringAddress
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		paid, err = udb.ExpiringAddressFor(dbtx, paidLate.String())
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	markPaid(paidLate)
+
+	expired, err := w.ExpiredAddresses(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(expired) != 2 {
+		t.Fatalf("%d expired addresses, want 2", len(expired))
+	}
+	for _, e := range expired {
+		switch e.Address.String() {
+		case expiredByTime.String():
+			if !e.Paid.IsZero() {
+				t.Error("unpaid address recorded as paid")
+			}
+		case paidLate.String():
+			if e.Paid != paid.Paid || e.PaidHeight != tipHeight {
+				t.Errorf("late payment recorded at %v (height %d)", e.Paid, e.PaidHeight)
+			}
+		default:
+			t.Errorf("unexpected expired address %v", e.Address)
+		}
+	}
+}
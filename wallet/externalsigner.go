@@ -0,0 +1,54 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/hdkeychain/v3"
+)
+
+// ImportExternalSignerAccount imports a watching-only account for the account
+// extended public key of a hardware signing device, such as a Ledger or
+// Trezor.  The signer records the device model, the fingerprint of the
+// device's master key, and the derivation path of xpub from the master key,
+// which must match the depth and child number of xpub.  The signer is
+// reported in account listings so clients know which device must sign for the
+// account and which paths to request from it.
+func (w *Wallet) ImportExternalSignerAccount(ctx context.Context, name string,
+	xpub *hdkeychain.ExtendedKey, signer *udb.ExternalSigner) (uint32, error) {
+
+	const op errors.Op = "wallet.ImportExternalSignerAccount"
+	var account uint32
+	err := w.importXpubAccount(ctx, xpub, func(ns walletdb.ReadWriteBucket) (uint32, error) {
+		var err error
+		account, err = w.manager.ImportExternalSignerAccount(ns, name, xpub, signer)
+		return account, err
+	})
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return account, nil
+}
+
+// ExternalSigner returns the signing device of an external signer account.
+// Errors with NotExist if the account is not an external signer account.
+func (w *Wallet) ExternalSigner(ctx context.Context, account uint32) (*udb.ExternalSigner, error) {
+	const op errors.Op = "wallet.ExternalSigner"
+	var signer *udb.ExternalSigner
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		var err error
+		signer, err = w.manager.ExternalSigner(ns, account)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return signer, nil
+}
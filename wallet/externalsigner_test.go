@@ -0,0 +1,104 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"reflect"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/hdkeychain/v3"
+)
+
+func TestImportExternalSignerAccount(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	master, err := hdkeychain.NewMaster(bytes.Repeat([]byte{3}, 32), w.ChainParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+	fingerprint := binary.BigEndian.Uint32(dcrutil.Hash160(master.SerializedPubKey()))
+	path, err := udb.ParseDerivationPath("m/44'/1h/0'")
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := master
+	for _, child := range path {
+		key, err = key.Child(child)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	xpub := key.Neuter()
+	signer := &udb.ExternalSigner{
+		Fingerprint: fingerprint,
+		Path:        path,
+		Model:       "trezor",
+	}
+	if s := signer.PathString(); s != "m/44'/1'/0'" {
+		t.Errorf("path string %q", s)
+	}
+
+	// The path must describe the derivation of the key.
+	bad := *signer
+	bad.Path = path[:2]
+	_, err = w.ImportExternalSignerAccount(ctx, "bad", xpub, &bad)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("import with wrong path: %v", err)
+	}
+
+	account, err := w.ImportExternalSignerAccount(ctx, "trezor", xpub, signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := w.ExternalSigner(ctx, account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, signer) {
+		t.Errorf("external signer %+v, want %+v", got, signer)
+	}
+	if _, err := w.ExternalSigner(ctx, 0); !errors.Is(err, errors.NotExist) {
+		t.Errorf("default account external signer: %v", err)
+	}
+
+	accounts, err := w.Accounts(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var found bool
+	for _, a := range accounts.Accounts {
+		switch {
+		case a.AccountNumber == account:
+			found = true
+			if !udb.IsExternalSigner(a.AccountType) || !reflect.DeepEqual(a.ExternalSigner, signer) {
+				t.Errorf("account listing %+v", a)
+			}
+		case a.ExternalSigner != nil:
+			t.Errorf("account %d has external signer", a.AccountNumber)
+		}
+	}
+	if !found {
+		t.Error("external signer account not listed")
+	}
+
+	// Addresses are derived from the device's account key.
+	addr, err := w.NewExternalAddress(ctx, account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	extKey, _ := xpub.Child(udb.ExternalBranch)
+	childKey, _ := extKey.Child(0)
+	if !bytes.Equal(addr.(*xpubAddress).PubKey(), childKey.SerializedPubKey()) {
+		t.Error("address was not derived from the account key")
+	}
+}
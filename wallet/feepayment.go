@@ -420,6 +420,9 @@ func (fp *vspFeePayment) reconcilePayment() error {
 			if errors.As(err, &apiErr) && apiErr.Code == types.ErrTicketCannotVote {
 				fp.remove("ticket cannot vote")
 			}
+			// Fee transactions which could not be created (e.g. due
+			// to insufficient funds) are retried later.
+			fp.client.recordFeeResult(ctx, fp.ticket.Hash(), err)
 			return err
 		}
 	}
@@ -0,0 +1,280 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4/stdscript"
+	"github.com/decred/dcrd/wire"
+)
+
+// Hold describes unspent outputs of an account which are frozen, such as to
+// comply with a legal hold.  Held outputs are never selected by transaction
+// authoring, may not be spent by signed transactions, are not unlocked by
+// UnlockOutpoint or ResetLockedOutpoints, and remain held across restarts
+// until the hold is released.
+type Hold struct {
+	ID        string
+	Account   uint32
+	Reason    string
+	Created   time.Time
+	Outpoints []wire.OutPoint
+
+	// Amount is the amount requested by HoldAmount, and is zero for holds
+	// placed by HoldOutputs.
+	Amount dcrutil.Amount
+
+	// Held is the total value of the held outputs which remain unspent.
+	Held dcrutil.Amount
+}
+
+// lockHold locks the outputs of a hold.  w.lockedOutpointMu must be held.
+func (w *Wallet) lockHold(h *udb.Hold) {
+	w.holds[h.ID] = h
+	for i := range h.Outpoints {
+		op := outpoint{h.Outpoints[i].Hash, h.Outpoints[i].Index}
+		w.lockedOutpoints[op] = struct{}{}
+		w.heldOutpoints[op] = h.ID
+	}
+}
+
+// unlockOutpoint unlocks an outpoint unless it is held or is spent by a
+// pending spend or a spend awaiting approval.  w.lockedOutpointMu must be
+// held.
+func (w *Wallet) unlockOutpoint(op outpoint) {
+	if _, ok := w.heldOutpoints[op]; ok {
+		return
+	}
+	if _, ok := w.pendingOutpoints[op]; ok {
+		return
+	}
+	if _, ok := w.approvalOutpoints[op]; ok {
+		return
+	}
+	delete(w.lockedOutpoints, op)
+}
+
+// checkHeldInputs errors with errors.Policy if a transaction spends a held
+// output.
+func (w *Wallet) checkHeldInputs(tx *wire.MsgTx) error {
+	defer w.lockedOutpointMu.Unlock()
+	w.lockedOutpointMu.Lock()
+	for _, in := range tx.TxIn {
+		prev := &in.PreviousOutPoint
+		if id, ok := w.heldOutpoints[outpoint{prev.Hash, prev.Index}]; ok {
+			return errors.E(errors.Policy, errors.Errorf("output %v "+
+				"is held by hold %q", prev, id))
+		}
+	}
+	return nil
+}
+
+// putHold records and locks a new hold.  w.lockedOutpointMu must be held.
+func (w *Wallet) putHold(ctx context.Context, h *udb.Hold) error {
+	if _, ok := w.holds[h.ID]; ok {
+		return errors.E(errors.Exist, errors.Errorf("hold %q already exists", h.ID))
+	}
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.PutHold(dbtx, h)
+	})
+	if err != nil {
+		return err
+	}
+	w.lockHold(h)
+	return nil
+}
+
+// HoldOutputs places a hold with an identifier and reason on unspent outputs
+// of a single account.  Outputs which are already held by another hold, or
+// are otherwise locked, such as the inputs of pending spends or funds
+// reservations, may not be held.
+func (w *Wallet) HoldOutputs(ctx context.Context, id, reason string, outpoints []wire.OutPoint) (*Hold, error) {
+	const op errors.Op = "wallet.HoldOutputs"
+	if len(outpoints) == 0 {
+		return nil, errors.E(op, errors.Invalid, "no outputs to hold")
+	}
+
+	defer w.lockedOutpointMu.Unlock()
+	w.lockedOutpointMu.Lock()
+
+	h := &udb.Hold{
+		ID:        id,
+		Reason:    reason,
+		Created:   time.Now(),
+		Outpoints: make([]wire.OutPoint, 0, len(outpoints)),
+	}
+	seen := make(map[outpoint]struct{}, len(outpoints))
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		for i := range outpoints {
+			prev := &outpoints[i]
+			k := outpoint{prev.Hash, prev.Index}
+			if _, ok := seen[k]; ok {
+				return errors.E(errors.Invalid, errors.Errorf("duplicate output %v", prev))
+			}
+			seen[k] = struct{}{}
+			if other, ok := w.heldOutpoints[k]; ok {
+				return errors.E(errors.Exist, errors.Errorf("output %v "+
+					"is already held by hold %q", prev, other))
+			}
+			if _, ok := w.lockedOutpoints[k]; ok {
+				return errors.E(errors.Exist, errors.Errorf("output %v "+
+					"is locked", prev))
+			}
+			credit, err := w.txStore.UnspentOutput(txmgrNs, *prev, true)
+			if err != nil {
+				return err
+			}
+			_, addrs := stdscript.ExtractAddrs(scriptVersionAssumed,
+				credit.PkScript, w.chainParams)
+			if len(addrs) != 1 {
+				return errors.E(errors.Invalid, errors.Errorf("output %v "+
+					"is not controlled by an account", prev))
+			}
+			account, err := w.manager.AddrAccount(addrmgrNs, addrs[0])
+			if err != nil {
+				return err
+			}
+			if i == 0 {
+				h.Account = account
+			} else if account != h.Account {
+				return errors.E(errors.Invalid, "held outputs must belong "+
+					"to a single account")
+			}
+			h.Outpoints = append(h.Outpoints, credit.OutPoint)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if err := w.putHold(ctx, h); err != nil {
+		return nil, errors.E(op, err)
+	}
+	return w.describeHold(ctx, h)
+}
+
+// HoldAmount places a hold with an identifier and reason on unspent outputs of
+// an account totaling at least amount.  The outputs are selected in the same
+// manner as transaction inputs, and the held value may exceed amount.
+func (w *Wallet) HoldAmount(ctx context.Context, id, reason string, account uint32,
+	amount dcrutil.Amount, minconf int32) (*Hold, error) {
+
+	const op errors.Op = "wallet.HoldAmount"
+	if amount <= 0 {
+		return nil, errors.E(op, errors.Invalid, "hold amount must be positive")
+	}
+
+	defer w.lockedOutpointMu.Unlock()
+	w.lockedOutpointMu.Lock()
+
+	var inputs []Input
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		_, tipHeight := w.txStore.MainChainTip(dbtx)
+
+		var err error
+		const minAmount = 0
+		const maxResults = 0
+		inputs, err = w.findEligibleOutputsAmount(dbtx, account, minconf,
+			amount, tipHeight, minAmount, maxResults, nil)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	h := &udb.Hold{
+		ID:        id,
+		Account:   account,
+		Amount:    amount,
+		Reason:    reason,
+		Created:   time.Now(),
+		Outpoints: make([]wire.OutPoint, 0, len(inputs)),
+	}
+	for i := range inputs {
+		h.Outpoints = append(h.Outpoints, inputs[i].OutPoint)
+	}
+	if err := w.putHold(ctx, h); err != nil {
+		return nil, errors.E(op, err)
+	}
+	return w.describeHold(ctx, h)
+}
+
+// ReleaseHold releases a hold, unlocking its outputs.
+func (w *Wallet) ReleaseHold(ctx context.Context, id string) error {
+	const op errors.Op = "wallet.ReleaseHold"
+
+	defer w.lockedOutpointMu.Unlock()
+	w.lockedOutpointMu.Lock()
+
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.DeleteHold(dbtx, id)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	h := w.holds[id]
+	for i := range h.Outpoints {
+		op := outpoint{h.Outpoints[i].Hash, h.Outpoints[i].Index}
+		delete(w.heldOutpoints, op)
+		w.unlockOutpoint(op)
+	}
+	delete(w.holds, id)
+	return nil
+}
+
+// Holds returns all holds ordered by ID.
+func (w *Wallet) Holds(ctx context.Context) ([]Hold, error) {
+	const op errors.Op = "wallet.Holds"
+	var res []Hold
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		return udb.ForEachHold(dbtx, func(h *udb.Hold) error {
+			res = append(res, w.holdFromDB(dbtx, h))
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return res, nil
+}
+
+func (w *Wallet) describeHold(ctx context.Context, h *udb.Hold) (*Hold, error) {
+	var res Hold
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		res = w.holdFromDB(dbtx, h)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (w *Wallet) holdFromDB(dbtx walletdb.ReadTx, h *udb.Hold) Hold {
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+	res := Hold{
+		ID:        h.ID,
+		Account:   h.Account,
+		Reason:    h.Reason,
+		Created:   h.Created,
+		Outpoints: append([]wire.OutPoint(nil), h.Outpoints...),
+		Amount:    h.Amount,
+	}
+	for i := range h.Outpoints {
+		credit, err := w.txStore.UnspentOutput(txmgrNs, h.Outpoints[i], true)
+		if err == nil {
+			res.Held += credit.Amount
+		}
+	}
+	return res
+}
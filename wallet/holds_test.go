@@ -0,0 +1,160 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"github.com/decred/dcrd/txscript/v4"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestHolds(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, pkScript := addr.PaymentScript()
+	fund := wire.NewMsgTx()
+	fund.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, 0, nil))
+	fund.AddTxOut(&wire.TxOut{Value: 3e8, Version: version, PkScript: pkScript})
+	fund.AddTxOut(&wire.TxOut{Value: 5e8, Version: version, PkScript: pkScript})
+	if err := w.AddTransaction(ctx, fund, &w.chainParams.GenesisHash); err != nil {
+		t.Fatal(err)
+	}
+	fundHash := fund.TxHash()
+	out0 := *wire.NewOutPoint(&fundHash, 0, wire.TxTreeRegular)
+
+	h, err := w.HoldOutputs(ctx, "court-order", "pending litigation", []wire.OutPoint{out0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.Account != 0 || h.Amount != 0 || h.Held != 3e8 || len(h.Outpoints) != 1 {
+		t.Fatalf("unexpected output hold %+v", h)
+	}
+	_, err = w.HoldOutputs(ctx, "other", "reason", []wire.OutPoint{out0})
+	if !errors.Is(err, errors.Exist) {
+		t.Errorf("held output twice: %v", err)
+	}
+	_, err = w.HoldOutputs(ctx, "court-order", "reason",
+		[]wire.OutPoint{*wire.NewOutPoint(&fundHash, 1, wire.TxTreeRegular)})
+	if !errors.Is(err, errors.Exist) {
+		t.Errorf("duplicate hold ID: %v", err)
+	}
+
+	// Held outputs are not unlocked other than by releasing the hold.
+	w.UnlockOutpoint(&fundHash, 0)
+	w.ResetLockedOutpoints()
+	if !w.LockedOutpoint(&fundHash, 0) {
+		t.Fatal("held output was unlocked")
+	}
+
+	// Transactions spending held outputs are not signed.
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	spend := wire.NewMsgTx()
+	spend.AddTxIn(wire.NewTxIn(&out0, 3e8, nil))
+	spend.AddTxOut(&wire.TxOut{Value: 2e8, Version: version, PkScript: pkScript})
+	_, err = w.SignTransaction(ctx, spend, txscript.SigHashAll, nil, nil, nil)
+	if !errors.Is(err, errors.Policy) {
+		t.Errorf("signed spend of held output: %v", err)
+	}
+	_, _, err = w.CreateSignature(ctx, spend, 0, addr, txscript.SigHashAll, pkScript)
+	if !errors.Is(err, errors.Policy) {
+		t.Errorf("created signature for spend of held output: %v", err)
+	}
+
+	// Amount holds select only outputs which are not held.
+	h, err = w.HoldAmount(ctx, "audit", "account audit", 0, 4e8, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.Amount != 4e8 || h.Held != 5e8 || len(h.Outpoints) != 1 ||
+		h.Outpoints[0].Index != 1 {
+		t.Fatalf("unexpected amount hold %+v", h)
+	}
+	_, err = w.HoldAmount(ctx, "more", "reason", 0, 1e8, 1)
+	if !errors.Is(err, errors.InsufficientBalance) {
+		t.Errorf("held more than the unheld balance: %v", err)
+	}
+
+	holds, err := w.Holds(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(holds) != 2 || holds[0].ID != "audit" || holds[1].ID != "court-order" ||
+		holds[1].Reason != "pending litigation" || holds[1].Created.IsZero() {
+		t.Fatalf("unexpected holds %+v", holds)
+	}
+
+	if err := w.ReleaseHold(ctx, "court-order"); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.ReleaseHold(ctx, "court-order"); !errors.Is(err, errors.NotExist) {
+		t.Errorf("released hold twice: %v", err)
+	}
+	if w.LockedOutpoint(&fundHash, 0) {
+		t.Error("released output remains locked")
+	}
+	if !w.LockedOutpoint(&fundHash, 1) {
+		t.Error("output of unreleased hold is unlocked")
+	}
+}
+
+func TestHoldPendingSpendInput(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+	w.SetNetworkBackend(mockNetwork{})
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, pkScript := addr.PaymentScript()
+	fund := wire.NewMsgTx()
+	fund.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, 0, nil))
+	fund.AddTxOut(&wire.TxOut{Value: 5e8, Version: version, PkScript: pkScript})
+	if err := w.AddTransaction(ctx, fund, &w.chainParams.GenesisHash); err != nil {
+		t.Fatal(err)
+	}
+	out := &wire.TxOut{Value: 1e8, PkScript: []byte{0x51}}
+	p, err := w.ProposeSpend(ctx, []*wire.TxOut{out}, 0, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	in := p.Tx.TxIn[0].PreviousOutPoint
+
+	// Locked outputs may not be held.
+	_, err = w.HoldOutputs(ctx, "audit", "reason", []wire.OutPoint{in})
+	if !errors.Is(err, errors.Exist) {
+		t.Fatalf("held pending spend input: %v", err)
+	}
+
+	// Releasing a hold of a pending spend input, such as a hold recorded
+	// before locked outputs were refused, does not unlock the input.
+	w.lockedOutpointMu.Lock()
+	err = w.putHold(ctx, &udb.Hold{ID: "audit", Reason: "reason",
+		Created: time.Now(), Outpoints: []wire.OutPoint{in}})
+	w.lockedOutpointMu.Unlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.ReleaseHold(ctx, "audit"); err != nil {
+		t.Fatal(err)
+	}
+	if !w.LockedOutpoint(&in.Hash, in.Index) {
+		t.Error("pending spend input was unlocked by releasing a hold")
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+)
+
+// MaxIdempotencyKeyLen is the maximum length of idempotency keys accepted by
+// Idempotent.
+const MaxIdempotencyKeyLen = 256
+
+// WithIdempotencyKey causes SendOutputs to create and publish a transaction
+// at most once for the idempotency key.  The key is recorded with the hash of
+// the authored transaction in the same database update which records the
+// transaction, before it is published, and retries of the request return the
+// recorded hash.  The request is an encoding of the request parameters, as
+// described by Idempotent.
+func WithIdempotencyKey(key string, request []byte) TxAuthorOption {
+	return func(o *txAuthorOptions) {
+		o.idempotencyKey = key
+		o.idempotencyRequest = chainhash.HashH(request)
+	}
+}
+
+// idempotencyKey returns the idempotency key and request hash of the options,
+// or an empty key.
+func idempotencyKey(opts []TxAuthorOption) (string, chainhash.Hash) {
+	var o txAuthorOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o.idempotencyKey, o.idempotencyRequest
+}
+
+func checkIdempotencyKey(key string) error {
+	if key == "" {
+		return errors.E(errors.Invalid, "empty idempotency key")
+	}
+	if len(key) > MaxIdempotencyKeyLen {
+		return errors.E(errors.Invalid, errors.Errorf("idempotency "+
+			"key exceeds max length %d", MaxIdempotencyKeyLen))
+	}
+	return nil
+}
+
+// previousIdempotentRequest returns the transaction hashes recorded for a
+// request made with an idempotency key, or nil if the key was not used.  An
+// error with kind errors.Exist is returned when the key was used by a
+// different request.  w.idempotencyMu must be held.
+func (w *Wallet) previousIdempotentRequest(ctx context.Context, key string,
+	requestHash chainhash.Hash) ([]chainhash.Hash, error) {
+
+	var prev *udb.IdempotentRequest
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		prev, err = udb.IdempotentRequestFor(dbtx, key)
+		return err
+	})
+	switch {
+	case errors.Is(err, errors.NotExist):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	case prev.RequestHash != requestHash:
+		return nil, errors.E(errors.Exist, errors.Errorf("idempotency "+
+			"key %q was used by a different request", key))
+	}
+	log.Infof("Returning transactions previously created with "+
+		"idempotency key %q", key)
+	return prev.TxHashes, nil
+}
+
+// releaseAbandonedIdempotencyKey removes the idempotency key recorded for a
+// transaction which failed to publish and was abandoned, allowing the request
+// to be retried with the key.  Keys of transactions which remain recorded by
+// the wallet, and which are published again when the wallet syncs, are kept.
+// w.idempotencyMu must be held.
+func (w *Wallet) releaseAbandonedIdempotencyKey(ctx context.Context, key string,
+	txHash *chainhash.Hash) {
+
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		if w.txStore.ExistsTx(txmgrNs, txHash) {
+			return nil
+		}
+		return udb.DeleteIdempotentRequest(dbtx, key)
+	})
+	if err != nil {
+		log.Errorf("Failed to release idempotency key %q: %v", key, err)
+	}
+}
+
+// Idempotent calls create, which creates and publishes transactions, at most
+// once for each idempotency key.  The hashes of the created transactions are
+// recorded with the key, and are returned without calling create again when
+// the same request is retried with the key.  This allows clients to safely
+// retry requests after timeouts without creating duplicate transactions.
+//
+// The request is an encoding of the request parameters.  An error with kind
+// errors.Exist is returned when a key is reused for a different request.  Keys
+// are not recorded when create errors, allowing failed requests to be retried
+// with the same key.
+//
+// The key is recorded only after create returns, and transactions published
+// by create before a crash may be created again by a retry.  Payments should
+// instead use SendOutputs with the WithIdempotencyKey option.
+//
+// Requests made with idempotency keys are serialized.
+func (w *Wallet) Idempotent(ctx context.Context, key string, request []byte,
+	create func() ([]chainhash.Hash, error)) ([]chainhash.Hash, error) {
+
+	const op errors.Op = "wallet.Idempotent"
+	if err := checkIdempotencyKey(key); err != nil {
+		return nil, errors.E(op, err)
+	}
+	requestHash := chainhash.HashH(request)
+
+	w.idempotencyMu.Lock()
+	defer w.idempotencyMu.Unlock()
+
+	prev, err := w.previousIdempotentRequest(ctx, key, requestHash)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if prev != nil {
+		return prev, nil
+	}
+
+	hashes, err := create()
+	if err != nil {
+		return nil, err
+	}
+
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.PutIdempotentRequest(dbtx, key, &udb.IdempotentRequest{
+			Created:     time.Now(),
+			RequestHash: requestHash,
+			TxHashes:    hashes,
+		})
+	})
+	if err != nil {
+		// The transactions were already published, and returning an
+		// error would invite the retry this is meant to prevent.
+		log.Errorf("Failed to record idempotency key %q: %v", key, err)
+	}
+	return hashes, nil
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestIdempotent(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	calls := 0
+	created := []chainhash.Hash{{1}, {2}}
+	create := func() ([]chainhash.Hash, error) {
+		calls++
+		return created, nil
+	}
+	failed := errors.New("create failed")
+
+	// Failed requests are not recorded and may be retried with the key.
+	_, err := w.Idempotent(ctx, "key", []byte("request"), func() ([]chainhash.Hash, error) {
+		return nil, failed
+	})
+	if !errors.Is(err, failed) {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		hashes, err := w.Idempotent(ctx, "key", []byte("request"), create)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(hashes) != len(created) || hashes[0] != created[0] || hashes[1] != created[1] {
+			t.Fatalf("returned hashes %v, want %v", hashes, created)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("create called %d times, want 1", calls)
+	}
+
+	_, err = w.Idempotent(ctx, "key", []byte("other request"), create)
+	if !errors.Is(err, errors.Exist) {
+		t.Errorf("key reused for a different request: %v", err)
+	}
+	_, err = w.Idempotent(ctx, "", []byte("request"), create)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("empty key: %v", err)
+	}
+	long := strings.Repeat("k", MaxIdempotencyKeyLen+1)
+	_, err = w.Idempotent(ctx, long, []byte("request"), create)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("long key: %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("create called %d times, want 1", calls)
+	}
+}
+
+func TestSendOutputsIdempotencyKey(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, pkScript := addr.PaymentScript()
+	fund := wire.NewMsgTx()
+	fund.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, 0, nil))
+	fund.AddTxOut(&wire.TxOut{Value: 10e8, Version: version, PkScript: pkScript})
+	if err := w.AddTransaction(ctx, fund, &w.chainParams.GenesisHash); err != nil {
+		t.Fatal(err)
+	}
+
+	dest, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(make([]byte, 20), w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, pkScript = dest.PaymentScript()
+	outputs := []*wire.TxOut{{Value: 1e8, Version: version, PkScript: pkScript}}
+	key := WithIdempotencyKey("key", []byte("request"))
+
+	// The wallet has no network backend, so publishing fails after the
+	// transaction and key are recorded.  The transaction is published when
+	// the wallet syncs, and retries return it rather than sending again.
+	if _, err := w.SendOutputs(ctx, outputs, 0, 0, 0, key); err == nil {
+		t.Fatal("published without a network backend")
+	}
+	var recorded *chainhash.Hash
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		r, err := udb.IdempotentRequestFor(dbtx, "key")
+		if err != nil {
+			return err
+		}
+		recorded = &r.TxHashes[0]
+		if !w.txStore.ExistsTx(dbtx.ReadBucket(wtxmgrNamespaceKey), recorded) {
+			t.Error("idempotency key recorded without its transaction")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash, err := w.SendOutputs(ctx, outputs, 0, 0, 0, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *hash != *recorded {
+		t.Errorf("retry returned %v, want %v", hash, recorded)
+	}
+
+	_, err = w.SendOutputs(ctx, outputs, 0, 0, 0,
+		WithIdempotencyKey("key", []byte("other request")))
+	if !errors.Is(err, errors.Exist) {
+		t.Errorf("key reused for a different request: %v", err)
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"sync"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+)
+
+// maxIdleLockWarning is the longest duration before an idle lock at which the
+// IdleLockNotification warning is sent.  Timeouts shorter than twice this
+// duration warn halfway through the timeout.
+const maxIdleLockWarning = time.Minute
+
+// idleLock locks the wallet after a duration without signing activity.
+type idleLock struct {
+	mu        sync.Mutex
+	timeout   time.Duration
+	deadline  time.Time // zero when not running
+	warnTimer *time.Timer
+	lockTimer *time.Timer
+	gen       uint64 // invalidates the callbacks of stopped timers
+}
+
+// stop stops the timers.  l.mu must be held.
+func (l *idleLock) stop() {
+	if l.warnTimer != nil {
+		l.warnTimer.Stop()
+		l.lockTimer.Stop()
+		l.warnTimer, l.lockTimer = nil, nil
+	}
+	l.deadline = time.Time{}
+	l.gen++
+}
+
+// SetIdleLockTimeout sets the duration after which an unlocked wallet is
+// locked if no private keys are used to sign transactions, messages, or hashes,
+// or to decrypt messages.  Each signing operation restarts the timeout.
+// Signatures created in the background by automatic voting and mixing are not
+// considered activity.  A zero timeout, the default, disables the idle lock.
+//
+// The idle lock is independent of any timeout provided to Unlock, and the
+// wallet is locked by whichever expires first.  An IdleLockNotification is
+// sent shortly before the wallet is idle locked, and again when it is locked.
+// The setting is not persisted.
+func (w *Wallet) SetIdleLockTimeout(timeout time.Duration) error {
+	const op errors.Op = "wallet.SetIdleLockTimeout"
+	if timeout < 0 {
+		return errors.E(op, errors.Invalid, "negative idle lock timeout")
+	}
+	w.idleLock.mu.Lock()
+	w.idleLock.timeout = timeout
+	w.idleLock.stop()
+	w.idleLock.mu.Unlock()
+	w.noteSigningActivity()
+	return nil
+}
+
+// IdleLockTimeout returns the idle lock timeout, or zero if the idle lock is
+// disabled.
+func (w *Wallet) IdleLockTimeout() time.Duration {
+	w.idleLock.mu.Lock()
+	defer w.idleLock.mu.Unlock()
+	return w.idleLock.timeout
+}
+
+// IdleLockRemaining returns the remaining duration before the wallet is locked
+// due to inactivity.  The boolean is false when the idle lock is disabled or
+// the wallet is locked.
+func (w *Wallet) IdleLockRemaining() (time.Duration, bool) {
+	w.idleLock.mu.Lock()
+	defer w.idleLock.mu.Unlock()
+	if w.idleLock.deadline.IsZero() {
+		return 0, false
+	}
+	return max(0, time.Until(w.idleLock.deadline)), true
+}
+
+// noteSigningActivity restarts the idle lock timeout when the idle lock is
+// enabled and the wallet is unlocked.
+func (w *Wallet) noteSigningActivity() {
+	if w.Locked() {
+		return
+	}
+	l := &w.idleLock
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.timeout == 0 {
+		return
+	}
+	l.stop()
+	gen := l.gen
+	l.deadline = time.Now().Add(l.timeout)
+	warning := min(maxIdleLockWarning, l.timeout/2)
+	l.warnTimer = time.AfterFunc(l.timeout-warning, func() { w.idleLockWarn(gen) })
+	l.lockTimer = time.AfterFunc(l.timeout, func() { w.idleLockExpire(gen) })
+}
+
+// stopIdleLock stops the idle lock timeout after the wallet is locked.
+func (w *Wallet) stopIdleLock() {
+	w.idleLock.mu.Lock()
+	w.idleLock.stop()
+	w.idleLock.mu.Unlock()
+}
+
+func (w *Wallet) idleLockWarn(gen uint64) {
+	l := &w.idleLock
+	l.mu.Lock()
+	if gen != l.gen {
+		l.mu.Unlock()
+		return
+	}
+	remaining := max(0, time.Until(l.deadline))
+	l.mu.Unlock()
+
+	log.Infof("The wallet will be locked in %v without signing activity",
+		remaining.Round(time.Second))
+	w.NtfnServer.notifyIdleLock(&IdleLockNotification{Remaining: remaining})
+}
+
+func (w *Wallet) idleLockExpire(gen uint64) {
+	l := &w.idleLock
+	l.mu.Lock()
+	stale := gen != l.gen
+	l.mu.Unlock()
+	if stale {
+		return
+	}
+
+	w.Lock()
+	log.Info("The wallet has been locked due to inactivity.")
+	w.NtfnServer.notifyIdleLock(&IdleLockNotification{Locked: true})
+}
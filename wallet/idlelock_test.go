@@ -0,0 +1,133 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestIdleLock(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	if err := w.SetIdleLockTimeout(-time.Second); !errors.Is(err, errors.Invalid) {
+		t.Fatalf("set negative idle lock timeout: %v", err)
+	}
+	const timeout = 400 * time.Millisecond
+	if err := w.SetIdleLockTimeout(timeout); err != nil {
+		t.Fatal(err)
+	}
+	if _, active := w.IdleLockRemaining(); active {
+		t.Fatal("idle lock active while the wallet is locked")
+	}
+
+	client := w.NtfnServer.IdleLockNotifications()
+	defer client.Done()
+
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Signing restarts the timeout.
+	time.Sleep(timeout / 4)
+	before, _ := w.IdleLockRemaining()
+	if _, err := w.SignMessage(ctx, "message", addr); err != nil {
+		t.Fatal(err)
+	}
+	after, active := w.IdleLockRemaining()
+	if !active {
+		t.Fatal("idle lock not active after signing")
+	}
+	if after <= before {
+		t.Fatalf("signing did not extend idle lock: %v before, %v after", before, after)
+	}
+
+	recv := func() *IdleLockNotification {
+		t.Helper()
+		select {
+		case n := <-client.C:
+			return n
+		case <-time.After(5 * time.Second):
+			t.Fatal("no idle lock notification")
+			return nil
+		}
+	}
+	if n := recv(); n.Locked || n.Remaining <= 0 {
+		t.Fatalf("unexpected warning %+v", n)
+	}
+	if n := recv(); !n.Locked {
+		t.Fatalf("unexpected lock notification %+v", n)
+	}
+	if !w.Locked() {
+		t.Fatal("wallet is unlocked after idle lock")
+	}
+	if _, active := w.IdleLockRemaining(); active {
+		t.Fatal("idle lock active after locking")
+	}
+
+	// Disabling the idle lock stops its timeout.
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.SetIdleLockTimeout(0); err != nil {
+		t.Fatal(err)
+	}
+	if _, active := w.IdleLockRemaining(); active {
+		t.Fatal("disabled idle lock is active")
+	}
+	time.Sleep(timeout * 2)
+	if w.Locked() {
+		t.Fatal("wallet locked after disabling idle lock")
+	}
+}
+
+func TestIdleLockSendOutputs(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+	w.SetNetworkBackend(mockNetwork{})
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, pkScript := addr.PaymentScript()
+	fund := wire.NewMsgTx()
+	fund.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, 0, nil))
+	fund.AddTxOut(&wire.TxOut{Value: 5e8, Version: version, PkScript: pkScript})
+	if err := w.AddTransaction(ctx, fund, &w.chainParams.GenesisHash); err != nil {
+		t.Fatal(err)
+	}
+
+	const timeout = time.Minute
+	if err := w.SetIdleLockTimeout(timeout); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Sending signs with the wallet keys and restarts the timeout.
+	time.Sleep(50 * time.Millisecond)
+	before, _ := w.IdleLockRemaining()
+	out := &wire.TxOut{Value: 1e8, Version: version, PkScript: pkScript}
+	if _, err := w.SendOutputs(ctx, []*wire.TxOut{out}, 0, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	after, active := w.IdleLockRemaining()
+	if !active || after <= before {
+		t.Fatalf("sending did not extend idle lock: %v before, %v after", before, after)
+	}
+}
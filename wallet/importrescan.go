@@ -0,0 +1,79 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+// NoRescan is passed as the scan height of an import to skip rescanning blocks
+// for transactions of the imported key or script.
+const NoRescan int32 = -1
+
+// ImportRescan is a handle to the rescan of main chain blocks scheduled by
+// importing a key or script with a scan height.
+//
+// The scan height is recorded in the database before the import returns, and
+// the wallet's rescan point is held at or before it until a rescan covers the
+// blocks through the main chain tip.  If the rescan is not performed using the
+// handle, or does not complete, it is performed by the next sync of the wallet.
+type ImportRescan struct {
+	// ScanFrom is the height of the first block to rescan.
+	ScanFrom int32
+
+	w *Wallet
+}
+
+// Rescan rescans the main chain from the scan height through the tip block
+// using the network backend n.  This function blocks until the rescan
+// completes.
+func (r *ImportRescan) Rescan(ctx context.Context, n NetworkBackend) error {
+	return r.w.RescanFromHeight(ctx, n, r.ScanFrom)
+}
+
+// recordImportRescan records that blocks from scanFrom must be rescanned for an
+// import, and returns the height to rescan from, or -1 if no blocks in the
+// main chain are to be rescanned.
+func (w *Wallet) recordImportRescan(dbtx walletdb.ReadWriteTx, scanFrom int32) (int32, error) {
+	if scanFrom < 0 {
+		return -1, nil
+	}
+	if _, tipHeight := w.txStore.MainChainTip(dbtx); scanFrom > tipHeight {
+		// Blocks after the tip are checked for transactions of the
+		// import by the transaction filter.
+		return -1, nil
+	}
+	if h := udb.FetchImportRescanHeight(dbtx); h >= 0 && h <= scanFrom {
+		return scanFrom, nil
+	}
+	return scanFrom, udb.SetImportRescanHeight(dbtx, scanFrom)
+}
+
+// advanceImportRescan advances the recorded import rescan height when it is
+// covered by the rescan of the blocks between first and through (inclusive).
+// The record is removed when the rescan reached the main chain tip.
+func (w *Wallet) advanceImportRescan(dbtx walletdb.ReadWriteTx, first, through int32) error {
+	h := udb.FetchImportRescanHeight(dbtx)
+	if h < first || h > through {
+		return nil
+	}
+	next := through + 1
+	if _, tipHeight := w.txStore.MainChainTip(dbtx); next > tipHeight {
+		next = -1
+	}
+	return udb.SetImportRescanHeight(dbtx, next)
+}
+
+// importRescan returns the handle to the rescan recorded by an import from
+// height scanFrom, or nil when no rescan was recorded.
+func (w *Wallet) importRescan(scanFrom int32) *ImportRescan {
+	if scanFrom < 0 {
+		return nil
+	}
+	return &ImportRescan{ScanFrom: scanFrom, w: w}
+}
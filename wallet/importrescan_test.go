@@ -0,0 +1,141 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet_test
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/wallet"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/wallettest"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+)
+
+func TestImportRescan(t *testing.T) {
+	ctx := context.Background()
+	h := wallettest.New(t, nil)
+	w := h.Wallet
+	params := w.ChainParams()
+	if err := w.Unlock(ctx, wallettest.PrivatePassphrase, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	newKey := func() (*dcrutil.WIF, stdaddr.Address) {
+		t.Helper()
+		priv, err := secp256k1.GeneratePrivateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		wif, err := dcrutil.NewWIF(priv.Serialize(), params.PrivateKeyID,
+			dcrec.STEcdsaSecp256k1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pkh := dcrutil.Hash160(priv.PubKey().SerializeCompressed())
+		addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(pkh, params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return wif, addr
+	}
+	balance := func() int64 {
+		t.Helper()
+		b, err := w.AccountBalance(ctx, udb.ImportedAddrAccount, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return int64(b.Total)
+	}
+	rescanPoint := func() int32 {
+		t.Helper()
+		rp, err := w.RescanPoint(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rp == nil {
+			return -1
+		}
+		header, err := w.BlockHeader(ctx, rp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return int32(header.Height)
+	}
+
+	// Mine blocks paying keys which are imported after the payments.
+	wif1, addr1 := newKey()
+	wif2, addr2 := newKey()
+	for _, addr := range []stdaddr.Address{addr1, addr2} {
+		if _, err := h.Chain.MineBlock(ctx, h.Chain.FundingTx(addr, 1e8)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := h.Chain.MineBlock(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// Imports without a rescan, or scanning from after the tip, do not
+	// schedule a rescan.
+	_, rescan, err := w.ImportPrivateKey(ctx, wif1, wallet.NoRescan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rescan != nil || rescanPoint() != -1 {
+		t.Fatal("rescan scheduled by import without rescan")
+	}
+	_, rescan, err = w.ImportPrivateKey(ctx, wif2, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rescan != nil || rescanPoint() != -1 {
+		t.Fatal("rescan scheduled by import scanning from after the tip")
+	}
+
+	// Rescans are recorded in the wallet's rescan point until performed.
+	// The lowest scan height of all imports is retained.
+	rescan, err = w.ImportScript(ctx, []byte{0x51}, 2) // OP_TRUE
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rescan == nil || rescan.ScanFrom != 2 || rescanPoint() != 2 {
+		t.Fatalf("import rescan from height 2 not scheduled")
+	}
+	_, err = w.ImportScriptToAccount(ctx, []byte{0x52}, udb.ImportedAddrAccount, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rescanPoint() != 1 {
+		t.Fatalf("rescan point %d, want 1", rescanPoint())
+	}
+	if b := balance(); b != 0 {
+		t.Fatalf("balance %v before rescan", b)
+	}
+
+	// A rescan from a later height leaves the earlier scan height pending.
+	if err := rescan.Rescan(ctx, h.Chain); err != nil {
+		t.Fatal(err)
+	}
+	if b := balance(); b != 1e8 {
+		t.Fatalf("balance %v after rescan from height 2, want 1 DCR", b)
+	}
+	if rescanPoint() != 1 {
+		t.Fatalf("rescan point %d after partial rescan, want 1", rescanPoint())
+	}
+
+	// Rescanning from the earliest height completes the scheduled rescans.
+	if err := w.RescanFromHeight(ctx, h.Chain, 1); err != nil {
+		t.Fatal(err)
+	}
+	if b := balance(); b != 2e8 {
+		t.Fatalf("balance %v after rescan, want 2 DCR", b)
+	}
+	if rp := rescanPoint(); rp != -1 {
+		t.Fatalf("rescan point %d remains after rescan", rp)
+	}
+}
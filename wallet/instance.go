@@ -0,0 +1,241 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/crypto/rand"
+)
+
+const (
+	// instanceHeartbeatInterval is the interval at which a running wallet
+	// records that it is still the writer of its database.
+	instanceHeartbeatInterval = time.Minute
+
+	// instanceStaleAfter is the duration after the last heartbeat of a
+	// process which did not close the database before the process is
+	// assumed to have exited rather than still be running.
+	instanceStaleAfter = 3 * instanceHeartbeatInterval
+)
+
+// InstanceConflict describes another wallet process detected writing to the
+// same wallet database, or to a copy of it which replaced the database.  Two
+// processes alternating writes to copies of a wallet corrupt the wallet's
+// address cursors and transaction history, and at most one process should be
+// run for each wallet.
+type InstanceConflict struct {
+	// Other is the record written by the other process.
+	Other udb.InstanceRecord
+
+	// Detected is the time the conflict was detected.
+	Detected time.Time
+
+	// Running is true when the other process was recorded as running when
+	// this wallet opened the database.  Otherwise, the other process wrote
+	// to the database while this wallet had it open.
+	Running bool
+}
+
+func (c *InstanceConflict) String() string {
+	id := hex.EncodeToString(c.Other.ID[:])
+	if c.Other.PID != 0 {
+		id = fmt.Sprintf("%s, pid %d on %s", id, c.Other.PID, c.Other.Host)
+	}
+	if c.Running {
+		return fmt.Sprintf("wallet database was opened while another "+
+			"process (instance %s, started %v) had not closed it; its "+
+			"last heartbeat was %v", id, c.Other.Started, c.Other.Heartbeat)
+	}
+	return fmt.Sprintf("another process (instance %s, started %v) wrote "+
+		"to the wallet database at %v while it was open by this process",
+		id, c.Other.Started, c.Other.Heartbeat)
+}
+
+// InstanceConflict returns the most recently detected conflict with another
+// wallet process writing to the wallet database, or nil if none was detected.
+func (w *Wallet) InstanceConflict() *InstanceConflict {
+	w.instanceMu.Lock()
+	defer w.instanceMu.Unlock()
+	if w.instanceConflict == nil {
+		return nil
+	}
+	c := *w.instanceConflict
+	return &c
+}
+
+func (w *Wallet) reportInstanceConflict(c *InstanceConflict) {
+	w.instanceMu.Lock()
+	w.instanceConflict = c
+	w.instanceMu.Unlock()
+	if w.NtfnServer != nil {
+		n := *c
+		w.NtfnServer.notifyInstanceConflict(&n)
+	}
+
+	log.Errorf("MULTIPLE WALLET PROCESSES DETECTED: %v", c)
+	log.Errorf("Running more than one process for copies of the same wallet " +
+		"database corrupts the wallet.  Stop all other processes using " +
+		"this wallet, and restore from seed if the wallet shows missing " +
+		"transactions or reused addresses.")
+}
+
+// thisInstanceHost returns the host name recorded with the instance records of
+// this process.
+func thisInstanceHost() string {
+	host, err := os.Hostname()
+	if err != nil {
+		return ""
+	}
+	return host
+}
+
+// instanceExited returns whether the process of an instance record which did
+// not close the database is known to have exited, such as after a crash.
+// This is only known for processes of the same host.  The database file is
+// locked while open, so a record of this process is of a previous opening of
+// the database which was not released.
+func instanceExited(r *udb.InstanceRecord, host string) bool {
+	if r.PID == 0 || r.Host == "" || r.Host != host {
+		return false
+	}
+	return r.PID == uint32(os.Getpid()) || !processRunning(r.PID)
+}
+
+// claimInstance records a new random instance ID as the writer of the
+// database, reporting a conflict if the previous writer never closed the
+// database, recorded a heartbeat recently enough to still be running, and is
+// not known to have exited.
+func (w *Wallet) claimInstance(ctx context.Context, now time.Time) error {
+	var id udb.InstanceID
+	rand.Read(id[:])
+	host := thisInstanceHost()
+
+	var prev *udb.InstanceRecord
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		var err error
+		prev, err = udb.LastInstance(dbtx)
+		if err != nil {
+			return err
+		}
+		w.instanceMu.Lock()
+		w.instance = udb.InstanceRecord{
+			ID:        id,
+			Started:   now,
+			Heartbeat: now,
+			PID:       uint32(os.Getpid()),
+			Host:      host,
+		}
+		r := w.instance
+		w.instanceMu.Unlock()
+		return udb.PutInstance(dbtx, &r)
+	})
+	if err != nil {
+		return err
+	}
+	if prev == nil || prev.Closed || now.Sub(prev.Heartbeat) >= instanceStaleAfter {
+		return nil
+	}
+	if instanceExited(prev, host) {
+		log.Infof("Previous wallet process (pid %d) exited without "+
+			"closing the wallet database", prev.PID)
+		return nil
+	}
+	w.reportInstanceConflict(&InstanceConflict{
+		Other:    *prev,
+		Detected: now,
+		Running:  true,
+	})
+	return nil
+}
+
+// instanceHeartbeat records that this process is still the writer of the
+// database, reporting a conflict if another process recorded itself as the
+// writer since the previous heartbeat.
+func (w *Wallet) instanceHeartbeat(ctx context.Context, now time.Time) error {
+	var other *udb.InstanceRecord
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		last, err := udb.LastInstance(dbtx)
+		if err != nil {
+			return err
+		}
+		w.instanceMu.Lock()
+		if last == nil || last.ID != w.instance.ID {
+			other = last
+		}
+		w.instance.Heartbeat = now
+		r := w.instance
+		w.instanceMu.Unlock()
+		return udb.PutInstance(dbtx, &r)
+	})
+	if err != nil {
+		return err
+	}
+	if other != nil {
+		w.reportInstanceConflict(&InstanceConflict{
+			Other:    *other,
+			Detected: now,
+		})
+	}
+	return nil
+}
+
+// RunInstanceHeartbeats periodically records that this process is still the
+// writer of the wallet database, detecting other processes which write to the
+// same database or replace it with a copy.  It returns when the context is
+// cancelled.
+func (w *Wallet) RunInstanceHeartbeats(ctx context.Context) error {
+	const op errors.Op = "wallet.RunInstanceHeartbeats"
+	if w.readOnly {
+		return errors.E(op, errors.Invalid, "wallet is read-only")
+	}
+	ticker := time.NewTicker(instanceHeartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case now := <-ticker.C:
+			if err := w.instanceHeartbeat(ctx, now); err != nil {
+				log.Errorf("Failed to record wallet heartbeat: %v", err)
+			}
+		}
+	}
+}
+
+// ReleaseInstance records that this process closed the wallet database, so
+// the next process to open it does not report a conflict.  The record is left
+// unchanged if another process has since written to the database.
+func (w *Wallet) ReleaseInstance(ctx context.Context) error {
+	const op errors.Op = "wallet.ReleaseInstance"
+	if w.readOnly {
+		return nil
+	}
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		last, err := udb.LastInstance(dbtx)
+		if err != nil {
+			return err
+		}
+		w.instanceMu.Lock()
+		defer w.instanceMu.Unlock()
+		if last == nil || last.ID != w.instance.ID {
+			return nil
+		}
+		w.instance.Heartbeat = time.Now()
+		w.instance.Closed = true
+		return udb.PutInstance(dbtx, &w.instance)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
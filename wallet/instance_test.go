@@ -0,0 +1,124 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"math"
+	"os"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+func TestInstanceConflict(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	if c := w.InstanceConflict(); c != nil {
+		t.Fatalf("conflict after opening new wallet: %v", c)
+	}
+	now := time.Now()
+	if err := w.instanceHeartbeat(ctx, now); err != nil {
+		t.Fatal(err)
+	}
+	if c := w.InstanceConflict(); c != nil {
+		t.Fatalf("conflict after own heartbeat: %v", c)
+	}
+
+	// Another process writing to a copy of the database which replaces
+	// this wallet's database is detected at the next heartbeat.
+	other := udb.InstanceRecord{
+		ID:        udb.InstanceID{1},
+		Started:   now.Add(-time.Hour),
+		Heartbeat: now,
+	}
+	putOther := func() {
+		err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+			return udb.PutInstance(dbtx, &other)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	putOther()
+	ntfns := w.NtfnServer.InstanceConflictNotifications()
+	heartbeatErr := make(chan error, 1)
+	go func() { heartbeatErr <- w.instanceHeartbeat(ctx, now.Add(time.Minute)) }()
+	select {
+	case n := <-ntfns.C:
+		if n.Running || n.Other.ID != other.ID {
+			t.Errorf("conflict notification %+v", n)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("no conflict notification")
+	}
+	ntfns.Done()
+	if err := <-heartbeatErr; err != nil {
+		t.Fatal(err)
+	}
+	c := w.InstanceConflict()
+	if c == nil || c.Running || c.Other.ID != other.ID {
+		t.Fatalf("conflict %+v after other process write", c)
+	}
+
+	// Opening a database last written by a running process is a conflict,
+	// but a process which closed the database, stopped sending heartbeats,
+	// or is known to have exited is not.  Processes of other hosts are
+	// assumed to be running.
+	host := thisInstanceHost()
+	const exitedPID = math.MaxInt32 - 1
+	tests := []struct {
+		closed    bool
+		heartbeat time.Time
+		pid       uint32
+		host      string
+		conflict  bool
+	}{
+		{false, now.Add(-time.Minute), 0, "", true},
+		{true, now.Add(-time.Minute), 0, "", false},
+		{false, now.Add(-time.Hour), 0, "", false},
+		{false, now.Add(-time.Minute), exitedPID, host, false},
+		{false, now.Add(-time.Minute), uint32(os.Getpid()), host, false},
+		{false, now.Add(-time.Minute), exitedPID, host + ".other", true},
+	}
+	for i, tc := range tests {
+		w.instanceConflict = nil
+		other.Closed = tc.closed
+		other.Heartbeat = tc.heartbeat
+		other.PID = tc.pid
+		other.Host = tc.host
+		putOther()
+		if err := w.claimInstance(ctx, now); err != nil {
+			t.Fatal(err)
+		}
+		c := w.InstanceConflict()
+		if (c != nil) != tc.conflict || (c != nil && !c.Running) {
+			t.Errorf("test %d: conflict %+v", i, c)
+		}
+	}
+
+	// Closing the database records a clean close for the next process.
+	if err := w.ReleaseInstance(ctx); err != nil {
+		t.Fatal(err)
+	}
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		last, err := udb.LastInstance(dbtx)
+		if err != nil {
+			return err
+		}
+		if last.ID != w.instance.ID || !last.Closed ||
+			last.PID != uint32(os.Getpid()) || last.Host != host {
+			t.Errorf("last instance %+v after release", last)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -25,6 +25,8 @@ func convertErr(err error) error {
 		kind = errors.IO
 	case bolt.ErrDatabaseNotOpen, bolt.ErrTxNotWritable, bolt.ErrTxClosed:
 		kind = errors.Invalid
+	case bolt.ErrDatabaseReadOnly:
+		kind = errors.Permission
 	case bolt.ErrBucketNameRequired, bolt.ErrKeyRequired, bolt.ErrKeyTooLarge, bolt.ErrValueTooLarge, bolt.ErrIncompatibleValue:
 		kind = errors.Invalid
 	case bolt.ErrBucketNotFound:
@@ -46,6 +48,17 @@ func (tx *transaction) ReadBucket(key []byte) walletdb.ReadBucket {
 	return tx.ReadWriteBucket(key)
 }
 
+func (tx *transaction) ForEachBucket(f func(key []byte) error) error {
+	return convertErr(tx.boltTx.ForEach(func(k []byte, _ *bolt.Bucket) error {
+		return f(k)
+	}))
+}
+
+func (tx *transaction) WriteTo(w io.Writer) (int64, error) {
+	n, err := tx.boltTx.WriteTo(w)
+	return n, convertErr(err)
+}
+
 func (tx *transaction) ReadWriteBucket(key []byte) walletdb.ReadWriteBucket {
 	boltBucket := tx.boltTx.Bucket(key)
 	if boltBucket == nil {
@@ -321,3 +334,15 @@ func openDB(dbPath string, create bool) (walletdb.DB, error) {
 	boltDB, err := bolt.Open(dbPath, 0600, nil)
 	return (*db)(boltDB), convertErr(err)
 }
+
+// openReadOnlyDB opens the existing database at the provided path read-only.
+// Read-only databases share the file lock with other read-only opens, but may
+// not be opened while any process holds the database open for writing.
+func openReadOnlyDB(dbPath string) (walletdb.DB, error) {
+	if !fileExists(dbPath) {
+		return nil, errors.E(errors.NotExist, "missing database file")
+	}
+
+	boltDB, err := bolt.Open(dbPath, 0600, &bolt.Options{ReadOnly: true})
+	return (*db)(boltDB), convertErr(err)
+}
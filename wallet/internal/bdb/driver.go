@@ -33,13 +33,27 @@ func parseArgs(funcName string, args ...any) (string, error) {
 }
 
 // openDBDriver is the callback provided during driver registration that opens
-// an existing database for use.
+// an existing database for use.  An optional second boolean argument opens the
+// database read-only.
 func openDBDriver(args ...any) (walletdb.DB, error) {
+	var readOnly bool
+	if len(args) == 2 {
+		var ok bool
+		readOnly, ok = args[1].(bool)
+		if !ok {
+			return nil, errors.Errorf("second argument to %s.Open is "+
+				"invalid -- expected read-only bool", dbType)
+		}
+		args = args[:1]
+	}
 	dbPath, err := parseArgs("Open", args...)
 	if err != nil {
 		return nil, err
 	}
 
+	if readOnly {
+		return openReadOnlyDB(dbPath)
+	}
 	return openDB(dbPath, false)
 }
 
@@ -157,3 +157,58 @@ func TestPersistence(t *testing.T) {
 		t.Fatalf("%v", err)
 	}
 }
+
+// TestReadOnly ensures that databases opened read-only can be read but refuse
+// read-write transactions.
+func TestReadOnly(t *testing.T) {
+	ctx := context.Background()
+	dbPath := "readonlytest.db"
+	db, err := walletdb.Create(dbType, dbPath)
+	if err != nil {
+		t.Fatalf("Failed to create test database (%s) %v", dbType, err)
+	}
+	defer os.Remove(dbPath)
+	nsKey := []byte("ns")
+	err = walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
+		bkt, err := tx.CreateTopLevelBucket(nsKey)
+		if err != nil {
+			return err
+		}
+		return bkt.Put([]byte("key"), []byte("value"))
+	})
+	if err != nil {
+		t.Fatalf("Update: unexpected error: %v", err)
+	}
+	db.Close()
+
+	if _, err := walletdb.Open(dbType, dbPath, "true"); err == nil {
+		t.Errorf("Open: accepted non-bool read-only argument")
+	}
+	db, err = walletdb.Open(dbType, dbPath, true)
+	if err != nil {
+		t.Fatalf("Failed to open test database read-only (%s) %v", dbType, err)
+	}
+	defer db.Close()
+
+	var snapshot bytes.Buffer
+	err = walletdb.View(ctx, db, func(tx walletdb.ReadTx) error {
+		if v := tx.ReadBucket(nsKey).Get([]byte("key")); string(v) != "value" {
+			return errors.Errorf("Get: unexpected value %q", v)
+		}
+		n, err := tx.WriteTo(&snapshot)
+		if err != nil {
+			return err
+		}
+		if n != int64(snapshot.Len()) || n == 0 {
+			return errors.Errorf("WriteTo: wrote %d bytes, reported %d",
+				snapshot.Len(), n)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("View: %v", err)
+	}
+	if _, err := db.BeginReadWriteTx(); !errors.Is(err, errors.Permission) {
+		t.Errorf("BeginReadWriteTx: unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,15 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+//go:build !unix && !windows
+
+package securemem
+
+import "errors"
+
+func lock(b []byte) error {
+	return errors.New("memory locking is not supported on this platform")
+}
+
+func unlock(b []byte) {}
@@ -0,0 +1,17 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+//go:build unix
+
+package securemem
+
+import "golang.org/x/sys/unix"
+
+func lock(b []byte) error {
+	return unix.Mlock(b)
+}
+
+func unlock(b []byte) {
+	unix.Munlock(b)
+}
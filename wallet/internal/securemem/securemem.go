@@ -0,0 +1,100 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package securemem provides buffers for secret key material which are locked
+// into physical memory, preventing the operating system from writing their
+// contents to swap.
+//
+// Buffers only protect secrets which are written directly into them.  Keys
+// held by types which allocate their own memory, such as hdkeychain extended
+// keys, are not protected by copying them from a buffer.
+//
+// Locking is best-effort.  When the operating system refuses to lock memory,
+// commonly due to resource limits on the amount of locked memory a process may
+// hold, buffers are still usable and report that they are not locked.
+package securemem
+
+import (
+	"os"
+	"sync"
+	"unsafe"
+)
+
+// Buffer is a fixed-size byte buffer for secret data.  Buffers occupy whole
+// memory pages which are not shared with any other allocation, so locking and
+// unlocking one buffer never affects another.
+type Buffer struct {
+	mu      sync.Mutex
+	b       []byte
+	pages   []byte
+	locked  bool
+	lockErr error
+}
+
+// New allocates a zeroed buffer of size bytes and attempts to lock it into
+// physical memory.
+func New(size int) *Buffer {
+	if size <= 0 {
+		panic("securemem: nonpositive buffer size")
+	}
+
+	// Allocate enough memory to align the buffer to the start of a page and
+	// pad it to a whole number of pages.  Memory allocated by the Go
+	// runtime is never moved, so the pages remain locked for the lifetime
+	// of the buffer.
+	pageSize := os.Getpagesize()
+	n := (size + pageSize - 1) / pageSize * pageSize
+	mem := make([]byte, n+pageSize)
+	offset := 0
+	if rem := int(uintptr(unsafe.Pointer(&mem[0])) % uintptr(pageSize)); rem != 0 {
+		offset = pageSize - rem
+	}
+	buf := &Buffer{
+		b:     mem[offset : offset+size : offset+size],
+		pages: mem[offset : offset+n : offset+n],
+	}
+	buf.lockErr = lock(buf.pages)
+	buf.locked = buf.lockErr == nil
+	return buf
+}
+
+// Bytes returns the buffer's memory.  The returned slice must not be retained
+// after the buffer is freed.
+func (b *Buffer) Bytes() []byte {
+	return b.b
+}
+
+// Locked returns whether the buffer is locked into physical memory.
+func (b *Buffer) Locked() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.locked
+}
+
+// LockError returns the reason the buffer could not be locked, or nil if it
+// is locked.
+func (b *Buffer) LockError() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.lockErr
+}
+
+// Zero clears the buffer's contents.
+func (b *Buffer) Zero() {
+	clear(b.b)
+}
+
+// Free zeroes and unlocks the buffer.  The buffer may not be used after it is
+// freed.
+func (b *Buffer) Free() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	clear(b.pages)
+	if b.locked {
+		unlock(b.pages)
+		b.locked = false
+	}
+	b.b = nil
+	b.pages = nil
+}
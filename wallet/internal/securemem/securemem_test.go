@@ -0,0 +1,45 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package securemem
+
+import (
+	"os"
+	"testing"
+	"unsafe"
+)
+
+func TestBuffer(t *testing.T) {
+	pageSize := os.Getpagesize()
+	for _, size := range []int{1, 32, pageSize, pageSize + 1} {
+		b := New(size)
+		mem := b.Bytes()
+		if len(mem) != size || cap(mem) != size {
+			t.Fatalf("size %d: buffer len %d cap %d", size, len(mem), cap(mem))
+		}
+		if uintptr(unsafe.Pointer(&mem[0]))%uintptr(pageSize) != 0 {
+			t.Errorf("size %d: buffer is not page aligned", size)
+		}
+		if !b.Locked() {
+			t.Logf("size %d: buffer not locked: %v", size, b.LockError())
+		}
+		for i := range mem {
+			mem[i] = 0xff
+		}
+		b.Zero()
+		for i := range mem {
+			if mem[i] != 0 {
+				t.Fatalf("size %d: byte %d not zeroed", size, i)
+			}
+		}
+		mem[0] = 1
+		b.Free()
+		if mem[0] != 0 {
+			t.Errorf("size %d: freed buffer not zeroed", size)
+		}
+		if b.Locked() || b.Bytes() != nil {
+			t.Errorf("size %d: freed buffer remains usable", size)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"crypto/sha256"
 	"crypto/subtle"
 	"encoding/binary"
+	"io"
 	"runtime/debug"
 
 	"decred.org/dcrwallet/v5/errors"
@@ -33,9 +34,16 @@ type CryptoKey [KeySize]byte
 
 // Encrypt encrypts the passed data.
 func (ck *CryptoKey) Encrypt(in []byte) ([]byte, error) {
+	return ck.EncryptWithRand(rand.Reader(), in)
+}
+
+// EncryptWithRand encrypts the passed data using a nonce read from random.
+func (ck *CryptoKey) EncryptWithRand(random io.Reader, in []byte) ([]byte, error) {
 	const op errors.Op = "cryptokey.Encrypt"
 	var nonce [NonceSize]byte
-	rand.Read(nonce[:])
+	if _, err := io.ReadFull(random, nonce[:]); err != nil {
+		return nil, errors.E(op, errors.IO, err)
+	}
 	sealed := make([]byte, NonceSize, NonceSize+len(in)+Overhead)
 	copy(sealed, nonce[:])
 	sealed = secretbox.Seal(sealed, in, &nonce, (*[KeySize]byte)(ck))
@@ -72,9 +80,16 @@ func (ck *CryptoKey) Zero() {
 
 // GenerateCryptoKey generates a new crypotgraphically random key.
 func GenerateCryptoKey() (*CryptoKey, error) {
+	return GenerateCryptoKeyWithRand(rand.Reader())
+}
+
+// GenerateCryptoKeyWithRand generates a new key read from random.
+func GenerateCryptoKeyWithRand(random io.Reader) (*CryptoKey, error) {
 	const op errors.Op = "snacl.GenerateCryptoKey"
 	var key CryptoKey
-	rand.Read(key[:])
+	if _, err := io.ReadFull(random, key[:]); err != nil {
+		return nil, errors.E(op, errors.IO, err)
+	}
 	return &key, nil
 }
 
@@ -206,8 +221,13 @@ func (sk *SecretKey) DeriveKey(password *[]byte) error {
 
 // Encrypt encrypts in bytes and returns a JSON blob.
 func (sk *SecretKey) Encrypt(in []byte) ([]byte, error) {
+	return sk.EncryptWithRand(rand.Reader(), in)
+}
+
+// EncryptWithRand encrypts in bytes using a nonce read from random.
+func (sk *SecretKey) EncryptWithRand(random io.Reader, in []byte) ([]byte, error) {
 	const op errors.Op = "secretkey.Encrypt"
-	out, err := sk.Key.Encrypt(in)
+	out, err := sk.Key.EncryptWithRand(random, in)
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
@@ -226,6 +246,12 @@ func (sk *SecretKey) Decrypt(in []byte) ([]byte, error) {
 
 // NewSecretKey returns a SecretKey structure based on the passed parameters.
 func NewSecretKey(password *[]byte, N, r, p int) (*SecretKey, error) {
+	return NewSecretKeyWithRand(rand.Reader(), password, N, r, p)
+}
+
+// NewSecretKeyWithRand returns a SecretKey structure based on the passed
+// parameters, with a salt read from random.
+func NewSecretKeyWithRand(random io.Reader, password *[]byte, N, r, p int) (*SecretKey, error) {
 	const op errors.Op = "snacl.NewSecretKey"
 	sk := SecretKey{
 		Key: (*CryptoKey)(&[KeySize]byte{}),
@@ -234,7 +260,9 @@ func NewSecretKey(password *[]byte, N, r, p int) (*SecretKey, error) {
 	sk.Parameters.N = N
 	sk.Parameters.R = r
 	sk.Parameters.P = p
-	rand.Read(sk.Parameters.Salt[:])
+	if _, err := io.ReadFull(random, sk.Parameters.Salt[:]); err != nil {
+		return nil, errors.E(op, errors.IO, err)
+	}
 
 	// derive key
 	err := sk.deriveKey(op, password)
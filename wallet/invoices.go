@@ -0,0 +1,182 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+)
+
+// Invoice describes an invoice created by NewInvoice and its reconciliation
+// with the mined payments received by its address.
+type Invoice struct {
+	ID string
+	udb.Invoice
+}
+
+// NewInvoice records an invoice for an amount, identified by a caller-chosen
+// ID, and returns it with a new external address of the account to be paid.
+// The status of the invoice is updated as payments to the address are mined
+// and reorged, and invoices not fully paid by a non-zero expiry become
+// expired.  Returns errors.Exist if an invoice with the ID was already
+// created.
+//
+// As every invoice is paid to a distinct address, and unpaid invoices leave
+// their addresses unused, addresses are returned beyond the gap limit with the
+// ignore gap policy by default, and are watched for payments as they are
+// returned.  This may be overridden by the callOpts.
+func (w *Wallet) NewInvoice(ctx context.Context, id string, account uint32, amount dcrutil.Amount,
+	expiry time.Time, callOpts ...NextAddressCallOption) (*Invoice, error) {
+
+	const op errors.Op = "wallet.NewInvoice"
+	if id == "" || len(id) > udb.MaxInvoiceIDLen {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("invoice ID "+
+			"must be between 1 and %d bytes", udb.MaxInvoiceIDLen))
+	}
+	if amount <= 0 {
+		return nil, errors.E(op, errors.Invalid, "invoice amount must be positive")
+	}
+	if !expiry.IsZero() && !expiry.After(time.Now()) {
+		return nil, errors.E(op, errors.Invalid, "expiry is not in the future")
+	}
+
+	// Imported voting accounts must not be used for normal transactions.
+	if err := w.notVotingAcct(ctx, op, account); err != nil {
+		return nil, err
+	}
+	accountName, _ := w.AccountName(ctx, account)
+
+	// Times are recorded with second precision.
+	now := time.Unix(time.Now().Unix(), 0)
+	if !expiry.IsZero() {
+		expiry = time.Unix(expiry.Unix(), 0)
+	}
+
+	inv := &Invoice{ID: id}
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		_, err := udb.InvoiceFor(dbtx, id)
+		if err == nil {
+			return errors.E(errors.Exist, errors.Errorf("invoice %q already exists", id))
+		}
+		if !errors.Is(err, errors.NotExist) {
+			return err
+		}
+		opts := append([]NextAddressCallOption{WithGapPolicyIgnore()}, callOpts...)
+		addr, err := w.nextAddress(ctx, op, w.persistReturnedChild(ctx, dbtx),
+			accountName, account, udb.ExternalBranch, opts...)
+		if err != nil {
+			return err
+		}
+		inv.Invoice = udb.Invoice{
+			Address: addr.String(),
+			Account: account,
+			Amount:  amount,
+			Status:  udb.InvoicePending,
+			Created: now,
+			Expiry:  expiry,
+		}
+		return udb.PutInvoice(dbtx, id, &inv.Invoice)
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return inv, nil
+}
+
+// Invoice returns the invoice created with an ID.  Returns errors.NotExist if
+// no invoice has the ID.
+func (w *Wallet) Invoice(ctx context.Context, id string) (*Invoice, error) {
+	const op errors.Op = "wallet.Invoice"
+	var inv *udb.Invoice
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		inv, err = udb.InvoiceFor(dbtx, id)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return &Invoice{ID: id, Invoice: *inv}, nil
+}
+
+// Invoices returns all invoices ordered by ID.  When f is non-nil, only
+// invoices for which f returns true are returned.
+func (w *Wallet) Invoices(ctx context.Context, f func(*Invoice) bool) ([]*Invoice, error) {
+	const op errors.Op = "wallet.Invoices"
+	var invoices []*Invoice
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		return udb.ForEachInvoice(dbtx, func(id string, inv *udb.Invoice) error {
+			i := &Invoice{ID: id, Invoice: *inv}
+			if f == nil || f(i) {
+				invoices = append(invoices, i)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return invoices, nil
+}
+
+// DeleteInvoice removes the invoice created with an ID.  The address of the
+// invoice remains a wallet address.  Returns errors.NotExist if no invoice has
+// the ID.
+func (w *Wallet) DeleteInvoice(ctx context.Context, id string) error {
+	const op errors.Op = "wallet.DeleteInvoice"
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.DeleteInvoice(dbtx, id)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// reconcileInvoices updates every invoice with the mined amount received by
+// its address at the main chain tip height and time now.  Status changes are
+// logged.
+func (w *Wallet) reconcileInvoices(dbtx walletdb.ReadWriteTx, height int32, now time.Time) error {
+	type change struct {
+		id  string
+		inv *udb.Invoice
+	}
+	var changes []change
+	err := udb.ForEachInvoice(dbtx, func(id string, inv *udb.Invoice) error {
+		addr, err := stdaddr.DecodeAddress(inv.Address, w.chainParams)
+		if err != nil {
+			return err
+		}
+		status := inv.Status
+		received := w.txStore.ReceivedByAddress(dbtx, addr)
+		if !inv.Reconcile(received, height, now) {
+			return nil
+		}
+		if inv.Status != status {
+			log.Infof("Invoice %q is %v (received %v of %v)", id,
+				inv.Status, inv.Received, inv.Amount)
+		}
+		changes = append(changes, change{id, inv})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	// Invoices are rewritten after iteration completes, as the bucket must
+	// not be modified during iteration.
+	for _, c := range changes {
+		err := udb.PutInvoice(dbtx, c.id, c.inv)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
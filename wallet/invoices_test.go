@@ -0,0 +1,167 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/wallettest"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+)
+
+func TestInvoices(t *testing.T) {
+	ctx := context.Background()
+	h := wallettest.New(t, nil)
+	w := h.Wallet
+
+	inv, err := w.NewInvoice(ctx, "order-1", 0, 2e8, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inv.Status != udb.InvoicePending || inv.Received != 0 {
+		t.Fatalf("new invoice %+v", inv)
+	}
+	if _, err := w.NewInvoice(ctx, "order-1", 0, 1e8, time.Time{}); !errors.Is(err, errors.Exist) {
+		t.Errorf("created duplicate invoice: %v", err)
+	}
+	if _, err := w.NewInvoice(ctx, "order-2", 0, 0, time.Time{}); !errors.Is(err, errors.Invalid) {
+		t.Errorf("created invoice without amount: %v", err)
+	}
+	addr, err := stdaddr.DecodeAddress(inv.Address, w.ChainParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	check := func(status udb.InvoiceStatus, received dcrutil.Amount) {
+		t.Helper()
+		inv, err := w.Invoice(ctx, "order-1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if inv.Status != status || inv.Received != received {
+			t.Fatalf("invoice is %v with %v received, want %v with %v",
+				inv.Status, inv.Received, status, received)
+		}
+	}
+
+	// Payments are reconciled as they are mined and reorged.
+	if _, err := h.Chain.MineBlock(ctx, h.Chain.FundingTx(addr, 1e8)); err != nil {
+		t.Fatal(err)
+	}
+	check(udb.InvoicePartial, 1e8)
+	if _, err := h.Chain.MineBlock(ctx, h.Chain.FundingTx(addr, 1e8)); err != nil {
+		t.Fatal(err)
+	}
+	check(udb.InvoicePaid, 2e8)
+	if _, err := h.Chain.Reorg(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	check(udb.InvoicePartial, 1e8)
+	if _, err := h.Chain.MineMempool(ctx); err != nil {
+		t.Fatal(err)
+	}
+	check(udb.InvoicePaid, 2e8)
+	if _, err := h.Chain.MineBlock(ctx, h.Chain.FundingTx(addr, 1e8)); err != nil {
+		t.Fatal(err)
+	}
+	check(udb.InvoiceOverpaid, 3e8)
+
+	invoices, err := w.Invoices(ctx, func(inv *wallet.Invoice) bool {
+		return inv.Status == udb.InvoiceOverpaid
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(invoices) != 1 || invoices[0].ID != "order-1" {
+		t.Fatalf("overpaid invoices %v", invoices)
+	}
+	if err := w.DeleteInvoice(ctx, "order-1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Invoice(ctx, "order-1"); !errors.Is(err, errors.NotExist) {
+		t.Errorf("deleted invoice returned %v", err)
+	}
+}
+
+func TestInvoiceExpiry(t *testing.T) {
+	created := time.Unix(1e9, 0)
+	inv := &udb.Invoice{
+		Amount:  2e8,
+		Created: created,
+		Expiry:  created.Add(time.Hour),
+	}
+	if !inv.Reconcile(1e8, 10, created.Add(time.Minute)) || inv.Status != udb.InvoicePartial {
+		t.Fatalf("partially paid invoice is %v", inv.Status)
+	}
+	if inv.Reconcile(1e8, 11, created.Add(2*time.Minute)) {
+		t.Fatal("unchanged invoice reconciled as changed")
+	}
+	if !inv.Reconcile(1e8, 12, created.Add(time.Hour)) || inv.Status != udb.InvoiceExpired {
+		t.Fatalf("underpaid invoice after expiry is %v", inv.Status)
+	}
+
+	// Late payments are recorded, but the invoice remains expired.
+	if !inv.Reconcile(2e8, 13, created.Add(2*time.Hour)) || inv.Status != udb.InvoiceExpired ||
+		inv.ChangedHeight != 13 {
+		t.Fatalf("late paid invoice is %v changed at %d", inv.Status, inv.ChangedHeight)
+	}
+
+	// Invoices paid before expiring do not expire.
+	inv = &udb.Invoice{Amount: 2e8, Expiry: created.Add(time.Hour)}
+	inv.Reconcile(2e8, 10, created)
+	inv.Reconcile(2e8, 20, created.Add(2*time.Hour))
+	if inv.Status != udb.InvoicePaid {
+		t.Fatalf("paid invoice after expiry is %v", inv.Status)
+	}
+}
+
+func TestInvoicesBeyondGapLimit(t *testing.T) {
+	ctx := context.Background()
+	h := wallettest.New(t, nil)
+	w := h.Wallet
+
+	// Unpaid invoices do not prevent creating more invoices than the gap
+	// limit, and each is paid to a distinct watched address.
+	seen := make(map[string]bool)
+	var last *wallet.Invoice
+	for i := 0; i <= int(w.GapLimit()); i++ {
+		inv, err := w.NewInvoice(ctx, fmt.Sprintf("order-%d", i), 0, 1e8, time.Time{})
+		if err != nil {
+			t.Fatalf("invoice %d: %v", i, err)
+		}
+		if seen[inv.Address] {
+			t.Fatalf("invoice %d reuses address %s", i, inv.Address)
+		}
+		seen[inv.Address] = true
+		last = inv
+	}
+	_, err := w.NewInvoice(ctx, "order-error", 0, 1e8, time.Time{},
+		wallet.WithGapPolicyError())
+	if !errors.Is(err, errors.Policy) {
+		t.Fatalf("error gap policy not applied: %v", err)
+	}
+
+	addr, err := stdaddr.DecodeAddress(last.Address, w.ChainParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.Chain.MineBlock(ctx, h.Chain.FundingTx(addr, 1e8)); err != nil {
+		t.Fatal(err)
+	}
+	inv, err := w.Invoice(ctx, last.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inv.Status != udb.InvoicePaid {
+		t.Fatalf("invoice beyond the gap limit is %v", inv.Status)
+	}
+}
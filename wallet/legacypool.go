@@ -0,0 +1,141 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/blockchain/stake/v5"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/txscript/v4/stdscript"
+	"github.com/decred/dcrd/wire"
+)
+
+// LegacyStakePoolImport describes tickets purchased through a legacy stake
+// pool, which granted voting rights to a 1-of-2 multisig script shared by the
+// user and pool, and paid the pool fee through an additional ticket
+// commitment.
+type LegacyStakePoolImport struct {
+	// Host identifies the stake pool.  It is recorded as the VSP host of
+	// imported tickets.
+	Host string
+
+	// Script is the multisig redeem script of the ticket voting address.
+	Script []byte
+
+	// PoolFeeAddress is the address paid the pool fee by the first ticket
+	// commitment.
+	PoolFeeAddress stdaddr.Address
+
+	// Tickets are the hashes of the tickets to import.  When empty, all
+	// unspent and unexpired wallet tickets purchased through the pool are
+	// imported.
+	Tickets []*chainhash.Hash
+}
+
+// checkLegacyStakePoolTicket returns an error unless a ticket was purchased
+// through a legacy stake pool, by voting with the pool's multisig address and
+// paying the pool fee as the first commitment.
+func checkLegacyStakePoolTicket(ticket *wire.MsgTx, votingAddr *stdaddr.AddressScriptHashV0,
+	poolFeeAddr stdaddr.Address, params stdaddr.AddressParamsV0) error {
+
+	if !stake.IsSStx(ticket) {
+		return errors.E(errors.Invalid, "transaction is not a ticket")
+	}
+	if len(ticket.TxOut) != 5 {
+		return errors.E(errors.Invalid, "ticket does not have a pool fee commitment")
+	}
+	_, addrs := stdscript.ExtractAddrs(ticket.TxOut[0].Version, ticket.TxOut[0].PkScript, params)
+	if len(addrs) != 1 || addrs[0].String() != votingAddr.String() {
+		return errors.E(errors.Invalid, "ticket does not vote with the pool script")
+	}
+	feeAddr, err := stake.AddrFromSStxPkScrCommitment(ticket.TxOut[1].PkScript, params)
+	if err != nil {
+		return errors.E(errors.Invalid, err)
+	}
+	if feeAddr.String() != poolFeeAddr.String() {
+		return errors.E(errors.Invalid, "ticket does not commit to the pool fee address")
+	}
+	return nil
+}
+
+// ImportLegacyStakePoolTickets imports the voting script of a legacy stake
+// pool and records its tickets with the VSP ticket tracking.  Imported tickets
+// are recorded with a confirmed fee, as the pool fee was paid by the ticket
+// itself, so VSP clients do not attempt to pay fees for them.  The hashes of
+// all imported tickets are returned.
+//
+// Tickets purchased through the pool which are not yet recorded by the wallet
+// are discovered by rescanning the blockchain after the script is imported,
+// and this method must be called again to record them.
+func (w *Wallet) ImportLegacyStakePoolTickets(ctx context.Context, req *LegacyStakePoolImport) ([]*chainhash.Hash, error) {
+	const op errors.Op = "wallet.ImportLegacyStakePoolTickets"
+
+	if req.Host == "" {
+		return nil, errors.E(op, errors.Invalid, "stake pool host is required")
+	}
+	votingAddr, err := multisigVotingAddress(req.Script, w.chainParams)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if err := w.importVotingScript(ctx, req.Script); err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	hashes := req.Tickets
+	explicit := len(hashes) != 0
+	if !explicit {
+		err := w.ForUnspentUnexpiredTickets(ctx, func(hash *chainhash.Hash) error {
+			hashes = append(hashes, hash)
+			return nil
+		})
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+
+	var imported []*chainhash.Hash
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		for _, hash := range hashes {
+			ticket, err := w.txStore.Tx(txmgrNs, hash)
+			switch {
+			case errors.Is(err, errors.NotExist) && !explicit:
+				continue
+			case errors.Is(err, errors.NotExist):
+				return errors.E(errors.NotExist, errors.Errorf("ticket %v "+
+					"is not recorded by the wallet", hash))
+			case err != nil:
+				return err
+			}
+			err = checkLegacyStakePoolTicket(ticket, votingAddr,
+				req.PoolFeeAddress, w.chainParams)
+			if err != nil {
+				if !explicit {
+					continue
+				}
+				return errors.E(errors.Invalid, errors.Errorf("ticket %v: %v",
+					hash, err))
+			}
+			err = udb.SetVSPTicket(dbtx, hash, &udb.VSPTicket{
+				FeeTxStatus: uint32(udb.VSPFeeProcessConfirmed),
+				Host:        req.Host,
+			})
+			if err != nil {
+				return err
+			}
+			imported = append(imported, hash)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return imported, nil
+}
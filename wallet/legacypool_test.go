@@ -0,0 +1,115 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/txscript/v4/stdscript"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestImportLegacyStakePoolTickets(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	userAddr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ka, err := w.KnownAddress(ctx, userAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	poolKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	poolPubKey := poolKey.PubKey().SerializeCompressed()
+	script, err := stdscript.MultiSigScriptV0(1, ka.(PubKeyHashAddress).PubKey(), poolPubKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	votingAddr, err := stdaddr.NewAddressScriptHashV0(script, w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	poolFeeAddr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(
+		stdaddr.Hash160(poolPubKey), w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Record a legacy pool ticket committing to the pool fee and the user.
+	ticket := wire.NewMsgTx()
+	ticket.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{1}}, 1e7, nil))
+	ticket.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{2}}, 2e8, nil))
+	addOut := func(version uint16, pkScript []byte) {
+		ticket.AddTxOut(&wire.TxOut{Version: version, PkScript: pkScript})
+	}
+	addOut(votingAddr.VotingRightsScript())
+	addOut(poolFeeAddr.RewardCommitmentScript(1e7, 0, 0))
+	addOut(poolFeeAddr.StakeChangeScript())
+	addOut(userAddr.(stdaddr.StakeAddress).RewardCommitmentScript(2e8, 0, 0))
+	addOut(userAddr.(stdaddr.StakeAddress).StakeChangeScript())
+	ticket.TxOut[0].Value = 21e7
+	rec, err := udb.NewTxRecordFromMsgTx(ticket, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return w.txStore.InsertMinedTx(dbtx, rec, &w.chainParams.GenesisHash)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := &LegacyStakePoolImport{
+		Host:           "https://pool.example.com",
+		Script:         script,
+		PoolFeeAddress: userAddr,
+		Tickets:        []*chainhash.Hash{&rec.Hash},
+	}
+	if _, err := w.ImportLegacyStakePoolTickets(ctx, req); !errors.Is(err, errors.Invalid) {
+		t.Fatalf("ticket with wrong pool fee address imported: %v", err)
+	}
+	req.PoolFeeAddress = poolFeeAddr
+	imported, err := w.ImportLegacyStakePoolTickets(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(imported) != 1 || *imported[0] != rec.Hash {
+		t.Fatalf("unexpected imported tickets %v", imported)
+	}
+	host, err := w.VSPHostForTicket(ctx, &rec.Hash)
+	if err != nil || host != req.Host {
+		t.Errorf("VSP host %q %v", host, err)
+	}
+	confirmed, err := w.IsVSPTicketConfirmed(ctx, &rec.Hash)
+	if err != nil || !confirmed {
+		t.Errorf("imported ticket fee not confirmed: %v", err)
+	}
+	mine, haveKeys := hasVotingAuthority(ctx, t, w, ticket)
+	if !mine || !haveKeys {
+		t.Errorf("no voting authority for imported ticket: %v %v", mine, haveKeys)
+	}
+
+	req.Tickets = []*chainhash.Hash{{3}}
+	if _, err := w.ImportLegacyStakePoolTickets(ctx, req); !errors.Is(err, errors.NotExist) {
+		t.Errorf("unknown ticket imported: %v", err)
+	}
+}
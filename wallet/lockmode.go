@@ -0,0 +1,72 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"decred.org/dcrwallet/v5/errors"
+)
+
+// LockMode describes which keys are removed from memory when the wallet is
+// locked.
+type LockMode uint8
+
+const (
+	// LockModeFull removes all private keys when the wallet is locked,
+	// including the keys of individually unlocked accounts.
+	LockModeFull LockMode = iota
+
+	// LockModeSpending removes the keys of all spending accounts when the
+	// wallet is locked, but keeps imported voting accounts unlocked so that
+	// always-on voting wallets may continue to vote without holding spending
+	// keys in memory.
+	LockModeSpending
+)
+
+// String returns the name of the lock mode.
+func (m LockMode) String() string {
+	switch m {
+	case LockModeFull:
+		return "full"
+	case LockModeSpending:
+		return "spending"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseLockMode returns the lock mode with the name s.
+func ParseLockMode(s string) (LockMode, error) {
+	switch s {
+	case "full":
+		return LockModeFull, nil
+	case "spending":
+		return LockModeSpending, nil
+	default:
+		return 0, errors.E(errors.Invalid, errors.Errorf("unknown lock mode %q", s))
+	}
+}
+
+// SetLockMode sets the keys removed from memory by subsequent locks of the
+// wallet, including locks after an unlock timeout expires.  Changing the mode
+// does not lock the wallet or unlock any accounts.
+func (w *Wallet) SetLockMode(mode LockMode) error {
+	const op errors.Op = "wallet.SetLockMode"
+	switch mode {
+	case LockModeFull, LockModeSpending:
+	default:
+		return errors.E(op, errors.Invalid, errors.Errorf("unknown lock mode %d", mode))
+	}
+	w.passphraseUsedMu.Lock()
+	w.lockMode = mode
+	w.passphraseUsedMu.Unlock()
+	return nil
+}
+
+// LockMode returns the current lock mode of the wallet.
+func (w *Wallet) LockMode() LockMode {
+	w.passphraseUsedMu.RLock()
+	defer w.passphraseUsedMu.RUnlock()
+	return w.lockMode
+}
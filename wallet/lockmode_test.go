@@ -0,0 +1,71 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/decred/dcrd/hdkeychain/v3"
+)
+
+func TestLockModeSpending(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	master, err := hdkeychain.NewMaster(bytes.Repeat([]byte{2}, 32), w.ChainParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+	voting, err := w.ImportVotingAccount(ctx, master, []byte("voting"), "voting")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.UnlockAccount(ctx, voting, []byte("voting")); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := w.SetLockMode(LockModeSpending); err != nil {
+		t.Fatal(err)
+	}
+	w.Lock()
+	if !w.Locked() {
+		t.Fatal("wallet is unlocked after spending lock")
+	}
+	unlocked, err := w.AccountUnlocked(ctx, voting)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !unlocked {
+		t.Fatal("voting account was locked by spending lock")
+	}
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.SignMessage(ctx, "spend", addr); err == nil {
+		t.Error("signed with default account after spending lock")
+	}
+
+	// Locks in full mode also lock the voting account.
+	if err := w.SetLockMode(LockModeFull); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	w.Lock()
+	unlocked, err = w.AccountUnlocked(ctx, voting)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if unlocked {
+		t.Error("voting account is unlocked after full lock")
+	}
+}
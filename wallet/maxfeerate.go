@@ -0,0 +1,57 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/dcrutil/v4"
+)
+
+// SetMaxFeeRate persists a maximum fee rate (per kB of serialized
+// transaction) for all wallet-authored transactions, including sends, ticket
+// purchases and mixes.  Authoring a transaction at a higher rate, whether the
+// rate was provided by the caller or set with SetRelayFee, errors with
+// errors.Policy.  A zero rate removes the limit.
+func (w *Wallet) SetMaxFeeRate(ctx context.Context, feeRate dcrutil.Amount) error {
+	const op errors.Op = "wallet.SetMaxFeeRate"
+	if feeRate < 0 {
+		return errors.E(op, errors.Invalid, "negative fee rate")
+	}
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.SetMaxFeeRate(dbtx, feeRate)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	w.relayFeeMu.Lock()
+	w.maxFeeRate = feeRate
+	w.relayFeeMu.Unlock()
+	return nil
+}
+
+// MaxFeeRate returns the maximum fee rate of wallet-authored transactions, or
+// zero when there is no limit.
+func (w *Wallet) MaxFeeRate() dcrutil.Amount {
+	w.relayFeeMu.Lock()
+	maxFeeRate := w.maxFeeRate
+	w.relayFeeMu.Unlock()
+	return maxFeeRate
+}
+
+// checkFeeRate errors with errors.Policy if a transaction may not be authored
+// with a fee rate.
+func (w *Wallet) checkFeeRate(feeRate dcrutil.Amount) error {
+	maxFeeRate := w.MaxFeeRate()
+	if maxFeeRate != 0 && feeRate > maxFeeRate {
+		return errors.E(errors.Policy, errors.Errorf("fee rate %v/kB "+
+			"exceeds maximum fee rate %v/kB", feeRate, maxFeeRate))
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestMaxFeeRate(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, pkScript := addr.PaymentScript()
+	fund := wire.NewMsgTx()
+	fund.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, 0, nil))
+	fund.AddTxOut(&wire.TxOut{Value: 10e8, Version: version, PkScript: pkScript})
+	if err := w.AddTransaction(ctx, fund, &w.chainParams.GenesisHash); err != nil {
+		t.Fatal(err)
+	}
+
+	author := func(feeRate dcrutil.Amount) error {
+		out := &wire.TxOut{Value: 1e8, PkScript: []byte{0x51}}
+		_, err := w.NewUnsignedTransaction(ctx, []*wire.TxOut{out}, feeRate,
+			0, 0, OutputSelectionAlgorithmDefault, nil, nil)
+		return err
+	}
+	const maxFeeRate = 1e5
+	if err := author(maxFeeRate * 10); err != nil {
+		t.Fatalf("unlimited fee rate rejected: %v", err)
+	}
+
+	if err := w.SetMaxFeeRate(ctx, -1); !errors.Is(err, errors.Invalid) {
+		t.Errorf("negative maximum fee rate accepted: %v", err)
+	}
+	if err := w.SetMaxFeeRate(ctx, maxFeeRate); err != nil {
+		t.Fatal(err)
+	}
+	if got := w.MaxFeeRate(); got != maxFeeRate {
+		t.Errorf("max fee rate %v, want %v", got, dcrutil.Amount(maxFeeRate))
+	}
+	var persisted dcrutil.Amount
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		persisted = udb.FetchMaxFeeRate(dbtx)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if persisted != maxFeeRate {
+		t.Errorf("persisted max fee rate %v, want %v", persisted,
+			dcrutil.Amount(maxFeeRate))
+	}
+
+	if err := author(maxFeeRate); err != nil {
+		t.Errorf("fee rate at maximum rejected: %v", err)
+	}
+	if err := author(maxFeeRate + 1); !errors.Is(err, errors.Policy) {
+		t.Errorf("caller fee rate above maximum accepted: %v", err)
+	}
+
+	// The maximum also applies to the wallet relay fee.
+	w.SetRelayFee(maxFeeRate * 2)
+	out := &wire.TxOut{Value: 1e8, PkScript: []byte{0x51}}
+	_, err = w.ProposeSpend(ctx, []*wire.TxOut{out}, 0, 0, 0)
+	if !errors.Is(err, errors.Policy) {
+		t.Errorf("relay fee above maximum accepted: %v", err)
+	}
+
+	if err := w.SetMaxFeeRate(ctx, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := author(maxFeeRate * 10); err != nil {
+		t.Errorf("removed limit still enforced: %v", err)
+	}
+}
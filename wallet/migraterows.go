@@ -0,0 +1,35 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+// MigrateRows rewrites every account and address row which is not recorded in
+// the serialization of the latest database version in a single database
+// transaction.  This allows databases to be audited for a single row
+// serialization rather than one for each version which wrote a row.
+//
+// progress, if non-nil, is called with the rows examined and rewritten so far.
+// The rewritten rows are only committed if all rows are migrated successfully.
+func (w *Wallet) MigrateRows(ctx context.Context, progress func(udb.RowMigration)) (udb.RowMigration, error) {
+	const op errors.Op = "wallet.MigrateRows"
+	var res udb.RowMigration
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		addrmgrNs := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		var err error
+		res, err = w.manager.MigrateRows(addrmgrNs, progress)
+		return err
+	})
+	if err != nil {
+		return res, errors.E(op, err)
+	}
+	return res, nil
+}
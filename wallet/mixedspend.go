@@ -0,0 +1,146 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/txscript/v4/stdscript"
+	"github.com/decred/dcrd/wire"
+)
+
+// SetMixedSpendPolicy configures the wallet to only source the inputs of
+// wallet-authored transactions from outputs paying to the branch of the mixed
+// account.  The policy is persisted and applies to all sends and ticket
+// purchases which select inputs automatically.
+func (w *Wallet) SetMixedSpendPolicy(ctx context.Context, account, branch uint32) error {
+	const op errors.Op = "wallet.SetMixedSpendPolicy"
+	if branch > 1 {
+		return errors.E(op, errors.Invalid, "mixed branch must be 0 or 1")
+	}
+	if account == udb.ImportedAddrAccount {
+		return errors.E(op, errors.Invalid, "imported account may not be the mixed account")
+	}
+	p := &udb.MixedSpendPolicy{Account: account, Branch: branch}
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		if _, err := w.manager.AccountName(addrmgrNs, account); err != nil {
+			return err
+		}
+		return udb.SetMixedSpendPolicy(dbtx, p)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	w.lockedOutpointMu.Lock()
+	w.mixedSpend = p
+	w.lockedOutpointMu.Unlock()
+	return nil
+}
+
+// ClearMixedSpendPolicy removes the mixed spend policy, allowing transactions
+// to spend from any account.
+func (w *Wallet) ClearMixedSpendPolicy(ctx context.Context) error {
+	const op errors.Op = "wallet.ClearMixedSpendPolicy"
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.SetMixedSpendPolicy(dbtx, nil)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	w.lockedOutpointMu.Lock()
+	w.mixedSpend = nil
+	w.lockedOutpointMu.Unlock()
+	return nil
+}
+
+// MixedSpendPolicy returns the mixed spend policy, or nil when transactions
+// may spend from any account.
+func (w *Wallet) MixedSpendPolicy() *udb.MixedSpendPolicy {
+	defer w.lockedOutpointMu.Unlock()
+	w.lockedOutpointMu.Lock()
+
+	if w.mixedSpend == nil {
+		return nil
+	}
+	p := *w.mixedSpend
+	return &p
+}
+
+// checkMixedSpendAccount errors when the mixed spend policy p prohibits
+// spending from account.  A nil policy permits spending from any account.
+func checkMixedSpendAccount(p *udb.MixedSpendPolicy, account uint32) error {
+	if p == nil || p.Account == account {
+		return nil
+	}
+	return errors.E(errors.Invalid, errors.Errorf("wallet only spends "+
+		"mixed outputs of account %d branch %d; account %d may not be "+
+		"spent from", p.Account, p.Branch, account))
+}
+
+// mixedSpendAddr returns whether the mixed spend policy permits spending an
+// output paying to addr.  Only outputs of the mixed account are restricted to
+// the mixed branch; spending from other accounts is instead prevented by
+// checkMixedSpendAccount, as mixing itself must spend unmixed outputs.  The
+// caller must hold lockedOutpointMu.
+func (w *Wallet) mixedSpendAddr(addrmgrNs walletdb.ReadBucket, addr stdaddr.Address) bool {
+	p := w.mixedSpend
+	if p == nil {
+		return true
+	}
+	ma, err := w.manager.Address(addrmgrNs, addr)
+	if err != nil {
+		return false
+	}
+	if ma.Account() != p.Account {
+		return true
+	}
+	if _, ok := ma.(udb.ManagedPubKeyAddress); !ok {
+		return false
+	}
+	return ma.Internal() == (p.Branch == udb.InternalBranch)
+}
+
+// mixedSpendIgnore wraps an input source ignore func to additionally ignore
+// outputs which may not be spent under the mixed spend policy.  The caller
+// must hold lockedOutpointMu.
+func (w *Wallet) mixedSpendIgnore(dbtx walletdb.ReadTx, ignore func(*wire.OutPoint) bool) func(*wire.OutPoint) bool {
+	if w.mixedSpend == nil {
+		return ignore
+	}
+	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+	return func(op *wire.OutPoint) bool {
+		if ignore(op) {
+			return true
+		}
+		credit, err := w.txStore.UnspentOutput(txmgrNs, *op, true)
+		if err != nil {
+			return true
+		}
+		_, addrs := stdscript.ExtractAddrs(scriptVersionAssumed, credit.PkScript, w.chainParams)
+		return len(addrs) != 1 || !w.mixedSpendAddr(addrmgrNs, addrs[0])
+	}
+}
+
+var errInsufficientMixedFunds = errors.New("insufficient mixed funds")
+
+// mixedSpendError describes insufficient balance errors caused by the mixed
+// spend policy p.
+func mixedSpendError(p *udb.MixedSpendPolicy, err error) error {
+	if p == nil || !errors.Is(err, errors.InsufficientBalance) ||
+		errors.Is(err, errInsufficientMixedFunds) {
+		return err
+	}
+	return errors.E(errors.InsufficientBalance, errors.Errorf("%w: wallet "+
+		"only spends outputs of mixed account %d branch %d",
+		errInsufficientMixedFunds, p.Account, p.Branch))
+}
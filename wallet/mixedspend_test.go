@@ -0,0 +1,91 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+func TestMixedSpendPolicy(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	cfg.AccountGapLimit = 2
+	w, teardown := testWallet(ctx, t, &cfg, nil)
+	defer teardown()
+
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	mixed, err := w.NextAccount(ctx, "mixed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	external, err := w.NewExternalAddress(ctx, mixed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	internal, err := w.NewInternalAddress(ctx, mixed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unmixed, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := w.SetMixedSpendPolicy(ctx, mixed, 2); !errors.Is(err, errors.Invalid) {
+		t.Fatalf("invalid branch accepted: %v", err)
+	}
+	if err := w.SetMixedSpendPolicy(ctx, mixed, udb.ExternalBranch); err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		p := udb.FetchMixedSpendPolicy(dbtx)
+		if p == nil || p.Account != mixed || p.Branch != udb.ExternalBranch {
+			t.Errorf("persisted policy %+v", p)
+		}
+
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		w.lockedOutpointMu.Lock()
+		defer w.lockedOutpointMu.Unlock()
+		if !w.mixedSpendAddr(addrmgrNs, external) {
+			t.Errorf("mixed branch output not spendable")
+		}
+		if w.mixedSpendAddr(addrmgrNs, internal) {
+			t.Errorf("unmixed branch output of mixed account spendable")
+		}
+		if !w.mixedSpendAddr(addrmgrNs, unmixed) {
+			t.Errorf("output of other account restricted")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := w.MixedSpendPolicy()
+	if err := checkMixedSpendAccount(p, 0); !errors.Is(err, errors.Invalid) {
+		t.Errorf("spending from unmixed account permitted: %v", err)
+	}
+	if err := checkMixedSpendAccount(p, mixed); err != nil {
+		t.Errorf("spending from mixed account rejected: %v", err)
+	}
+	err = mixedSpendError(p, mixedSpendError(p, errors.E(errors.InsufficientBalance)))
+	if !errors.Is(err, errors.InsufficientBalance) || !errors.Is(err, errInsufficientMixedFunds) {
+		t.Errorf("unexpected insufficient balance error %v", err)
+	}
+
+	if err := w.ClearMixedSpendPolicy(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if p := w.MixedSpendPolicy(); p != nil {
+		t.Errorf("policy not cleared: %+v", p)
+	}
+}
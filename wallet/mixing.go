@@ -5,7 +5,10 @@
 package wallet
 
 import (
+	"bytes"
+	"cmp"
 	"context"
+	"slices"
 	"sync/atomic"
 
 	"decred.org/dcrwallet/v5/errors"
@@ -132,6 +135,13 @@ func (w *mixingWallet) SubmitMixMessage(ctx context.Context, msg mixing.Message)
 // SignInput adds a signature script to a transaction input.
 func (w *mixingWallet) SignInput(tx *wire.MsgTx, index int, prevScript []byte) error {
 	wallet := (*Wallet)(w)
+	if err := wallet.checkVoteOnly(); err != nil {
+		return err
+	}
+	if err := wallet.checkMixPeers(tx); err != nil {
+		log.Warnf("Refusing to sign mix transaction: %v", err)
+		return err
+	}
 	ctx := context.Background()
 	in := tx.TxIn[index]
 
@@ -245,6 +255,85 @@ func smallestMixChange(feeRate dcrutil.Amount) dcrutil.Amount {
 	return fee + splitPoints[len(splitPoints)-1]
 }
 
+// mixSplit describes the split of an output by a mix.
+type mixSplit struct {
+	index  int // of the denomination in splitPoints
+	denom  dcrutil.Amount
+	count  uint32
+	change dcrutil.Amount
+}
+
+// chooseMixSplit chooses the denomination, count, and change of the mixed
+// outputs of an output of some amount, given the current ticket price and fee
+// rate.  Returns false if the amount can not be mixed at any denomination.
+func chooseMixSplit(amount, sdiff, feeRate dcrutil.Amount) (mixSplit, bool) {
+	var smallestMixChange = smallestMixChange(feeRate)
+	for i := 0; i < len(splitPoints); i++ {
+		last := i == len(splitPoints)-1
+		mixValue := splitPoints[i]
+
+		// When the sdiff is more than this mixed output amount, there
+		// is a smaller common mixed amount with more pairing activity
+		// (due to CoinShuffle++ participation from ticket buyers).
+		// Skipping this amount and moving to the next smallest common
+		// mixed amount will result in quicker pairings, or pairings
+		// occurring at all.  The number of mixed outputs is capped to
+		// prevent a single mix being overwhelmingly funded by a single
+		// output, and to conserve memory resources.
+		if !last && mixValue >= sdiff {
+			continue
+		}
+
+		count := min(uint32(amount/mixValue), 4)
+		for ; count > 0; count-- {
+			remValue := amount - dcrutil.Amount(count)*mixValue
+			if remValue < 0 {
+				continue
+			}
+
+			// Determine required fee and change value, if possible.
+			// No change is ever included when mixing at the
+			// smallest amount.
+			const P2PKHv0Len = 25
+			inScriptSizes := []int{txsizes.RedeemP2PKHSigScriptSize}
+			outScriptSizes := make([]int, count)
+			for i := range outScriptSizes {
+				outScriptSizes[i] = P2PKHv0Len
+			}
+			size := txsizes.EstimateSerializeSizeFromScriptSizes(
+				inScriptSizes, outScriptSizes, P2PKHv0Len)
+			fee := txrules.FeeForSerializeSize(feeRate, size)
+			changeValue := remValue - fee
+			if last {
+				changeValue = 0
+			}
+			if changeValue <= 0 {
+				// Determine required fee without a change
+				// output.  A lower mix count or amount is
+				// required if the fee is still not payable.
+				size = txsizes.EstimateSerializeSizeFromScriptSizes(
+					inScriptSizes, outScriptSizes, 0)
+				fee = txrules.FeeForSerializeSize(feeRate, size)
+				if remValue < fee {
+					continue
+				}
+				changeValue = 0
+			}
+			if changeValue < smallestMixChange {
+				changeValue = 0
+			}
+
+			return mixSplit{
+				index:  i,
+				denom:  mixValue,
+				count:  count,
+				change: changeValue,
+			}, true
+		}
+	}
+	return mixSplit{}, false
+}
+
 type mixSemaphores struct {
 	splitSems [len(splitPoints)]chan struct{}
 }
@@ -257,6 +346,10 @@ func newMixSemaphores(n int) mixSemaphores {
 	return m
 }
 
+// mixMinConf is the number of confirmations required of outputs mixed by
+// MixAccount.
+const mixMinConf = 2
+
 var (
 	errNoSplitDenomination = errors.New("no suitable split denomination")
 	errThrottledMixRequest = errors.New("throttled mix request for split denomination")
@@ -269,6 +362,10 @@ func (w *Wallet) MixOutput(ctx context.Context, output *wire.OutPoint, changeAcc
 
 	op := errors.Opf("wallet.MixOutput(%v)", output)
 
+	if err := w.checkVoteOnly(); err != nil {
+		return errors.E(op, err)
+	}
+
 	// Mixing requests require wallet mixing support.
 	if !w.mixingEnabled {
 		s := "wallet mixing support is disabled"
@@ -322,85 +419,26 @@ func (w *Wallet) MixOutput(ctx context.Context, output *wire.OutPoint, changeAcc
 		w.lockedOutpointMu.Unlock()
 	}()
 
-	var i int
-	var count uint32
-	var mixValue, remValue, changeValue dcrutil.Amount
 	var feeRate = w.RelayFee()
-	var smallestMixChange = smallestMixChange(feeRate)
-SplitPoints:
-	for i = 0; i < len(splitPoints); i++ {
-		last := i == len(splitPoints)-1
-		mixValue = splitPoints[i]
-
-		// When the sdiff is more than this mixed output amount, there
-		// is a smaller common mixed amount with more pairing activity
-		// (due to CoinShuffle++ participation from ticket buyers).
-		// Skipping this amount and moving to the next smallest common
-		// mixed amount will result in quicker pairings, or pairings
-		// occurring at all.  The number of mixed outputs is capped to
-		// prevent a single mix being overwhelmingly funded by a single
-		// output, and to conserve memory resources.
-		if !last && mixValue >= sdiff {
-			continue
-		}
-
-		count = min(uint32(amount/mixValue), 4)
-		for ; count > 0; count-- {
-			remValue = amount - dcrutil.Amount(count)*mixValue
-			if remValue < 0 {
-				continue
-			}
-
-			// Determine required fee and change value, if possible.
-			// No change is ever included when mixing at the
-			// smallest amount.
-			const P2PKHv0Len = 25
-			inScriptSizes := []int{txsizes.RedeemP2PKHSigScriptSize}
-			outScriptSizes := make([]int, count)
-			for i := range outScriptSizes {
-				outScriptSizes[i] = P2PKHv0Len
-			}
-			size := txsizes.EstimateSerializeSizeFromScriptSizes(
-				inScriptSizes, outScriptSizes, P2PKHv0Len)
-			fee := txrules.FeeForSerializeSize(feeRate, size)
-			changeValue = remValue - fee
-			if last {
-				changeValue = 0
-			}
-			if changeValue <= 0 {
-				// Determine required fee without a change
-				// output.  A lower mix count or amount is
-				// required if the fee is still not payable.
-				size = txsizes.EstimateSerializeSizeFromScriptSizes(
-					inScriptSizes, outScriptSizes, 0)
-				fee = txrules.FeeForSerializeSize(feeRate, size)
-				if remValue < fee {
-					continue
-				}
-				changeValue = 0
-			}
-			if changeValue < smallestMixChange {
-				changeValue = 0
-			}
-
-			break SplitPoints
-		}
+	if err := w.checkFeeRate(feeRate); err != nil {
+		return errors.E(op, err)
 	}
-	if i == len(splitPoints) {
+	split, ok := chooseMixSplit(amount, sdiff, feeRate)
+	if !ok {
 		err := errors.Errorf("output %v (%v): %w", output, amount, errNoSplitDenomination)
 		return errors.E(op, err)
 	}
 	select {
 	case <-ctx.Done():
 		return errors.E(op, ctx.Err())
-	case w.mixSems.splitSems[i] <- struct{}{}:
-		defer func() { <-w.mixSems.splitSems[i] }()
+	case w.mixSems.splitSems[split.index] <- struct{}{}:
+		defer func() { <-w.mixSems.splitSems[split.index] }()
 	default:
 		return errThrottledMixRequest
 	}
 
 	var change *wire.TxOut
-	if changeValue > 0 {
+	if split.change > 0 {
 		persist := w.persistReturnedChild(ctx, nil)
 		const accountName = "" // not used, so can be faked.
 		addr, err := w.nextAddress(ctx, op, persist,
@@ -410,7 +448,7 @@ SplitPoints:
 		}
 		version, changeScript := addr.PaymentScript()
 		change = &wire.TxOut{
-			Value:    int64(changeValue),
+			Value:    int64(split.change),
 			PkScript: changeScript,
 			Version:  version,
 		}
@@ -420,7 +458,7 @@ SplitPoints:
 
 	gen := w.makeGen(ctx, mixAccount, mixBranch)
 	expires := w.dicemixExpiry(ctx)
-	cj := mixclient.NewCoinJoin(gen, change, int64(mixValue), expires, count)
+	cj := mixclient.NewCoinJoin(gen, change, int64(split.denom), expires, split.count)
 	input := &wire.TxIn{
 		PreviousOutPoint: *output,
 		ValueIn:          int64(amount),
@@ -450,6 +488,10 @@ func (w *Wallet) MixAccount(ctx context.Context, changeAccount, mixAccount,
 	mixBranch uint32) error {
 	const op errors.Op = "wallet.MixAccount"
 
+	if err := w.checkVoteOnly(); err != nil {
+		return errors.E(op, err)
+	}
+
 	// Mixing requests require wallet mixing support.
 	if !w.mixingEnabled {
 		s := "wallet mixing support is disabled"
@@ -461,12 +503,11 @@ func (w *Wallet) MixAccount(ctx context.Context, changeAccount, mixAccount,
 	var credits []Input
 	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
 		var err error
-		const minconf = 2
 		const targetAmount = 0
 		var minAmount = splitPoints[len(splitPoints)-1]
 		var maxResults = cap(w.mixSems.splitSems[0]) * len(splitPoints)
-		credits, err = w.findEligibleOutputsAmount(dbtx, changeAccount, minconf,
-			targetAmount, tipHeight, minAmount, maxResults)
+		credits, err = w.findEligibleOutputsAmount(dbtx, changeAccount, mixMinConf,
+			targetAmount, tipHeight, minAmount, maxResults, nil)
 		return err
 	})
 	if err != nil {
@@ -506,6 +547,87 @@ func (w *Wallet) MixAccount(ctx context.Context, changeAccount, mixAccount,
 	return nil
 }
 
+// MixSplitPreview describes how an output would be split into mixed outputs.
+type MixSplitPreview struct {
+	OutPoint wire.OutPoint
+	Amount   dcrutil.Amount
+
+	// Denomination and Count describe the mixed outputs.  Both are zero
+	// when the output can not be mixed at any denomination.
+	Denomination dcrutil.Amount
+	Count        uint32
+
+	// Change is the value of the unmixed change output, if any, and Fee is
+	// the remaining value of the output, which pays the fee of its inputs
+	// and outputs in the mix.
+	Change dcrutil.Amount
+	Fee    dcrutil.Amount
+}
+
+// PreviewMixAccount reports how MixAccount would split each output of
+// changeAccount that is eligible for mixing, at the current ticket price and
+// relay fee, without deriving change addresses or performing any network
+// operations.  Previews are ordered by decreasing amount, then by outpoint.
+//
+// A single call to MixAccount mixes a limited number of randomly selected
+// outputs, and defers outputs when too many are already being mixed at the
+// same denomination, so all previewed outputs may require several mixes.
+func (w *Wallet) PreviewMixAccount(ctx context.Context, changeAccount uint32) ([]MixSplitPreview, error) {
+	const op errors.Op = "wallet.PreviewMixAccount"
+
+	sdiff, err := w.NextStakeDifficulty(ctx)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	feeRate := w.RelayFee()
+	if err := w.checkFeeRate(feeRate); err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	_, tipHeight := w.MainChainTip(ctx)
+	w.lockedOutpointMu.Lock()
+	var credits []Input
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		const targetAmount = 0
+		const maxResults = 0 // all eligible outputs
+		var minAmount = splitPoints[len(splitPoints)-1]
+		credits, err = w.findEligibleOutputsAmount(dbtx, changeAccount, mixMinConf,
+			targetAmount, tipHeight, minAmount, maxResults, nil)
+		return err
+	})
+	w.lockedOutpointMu.Unlock()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	previews := make([]MixSplitPreview, 0, len(credits))
+	for i := range credits {
+		amount := dcrutil.Amount(credits[i].PrevOut.Value)
+		p := MixSplitPreview{
+			OutPoint: credits[i].OutPoint,
+			Amount:   amount,
+		}
+		if split, ok := chooseMixSplit(amount, sdiff, feeRate); ok {
+			p.Denomination = split.denom
+			p.Count = split.count
+			p.Change = split.change
+			p.Fee = amount - dcrutil.Amount(split.count)*split.denom - split.change
+		}
+		previews = append(previews, p)
+	}
+	slices.SortFunc(previews, func(a, b MixSplitPreview) int {
+		if c := cmp.Compare(b.Amount, a.Amount); c != 0 {
+			return c
+		}
+		if c := bytes.Compare(a.OutPoint.Hash[:], b.OutPoint.Hash[:]); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.OutPoint.Index, b.OutPoint.Index)
+	})
+	return previews, nil
+}
+
 // PossibleCoinJoin tests if a transaction may be a CSPP-mixed transaction.
 // It can return false positives, as one can create a tx which looks like a
 // coinjoin tx, although it isn't.
@@ -1,10 +1,13 @@
 package wallet
 
 import (
+	"context"
 	"encoding/hex"
 	"strings"
 	"testing"
 
+	"decred.org/dcrwallet/v5/errors"
+	"github.com/decred/dcrd/dcrutil/v4"
 	"github.com/decred/dcrd/wire"
 )
 
@@ -18,6 +21,69 @@ func MsgTxFromHex(hexTx string) (*wire.MsgTx, error) {
 	return tx, nil
 }
 
+func TestPurchaseTicketsMixedSplitRequirements(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, nil)
+	defer teardown()
+
+	req := &PurchaseTicketsRequest{Count: 1, Mixing: true, DontSignTx: true}
+	if _, err := w.PurchaseTickets(ctx, nil, req); !errors.Is(err, errors.Invalid) {
+		t.Errorf("purchased unsigned tickets with mixed split: %v", err)
+	}
+
+	cfg = basicWalletConfig
+	cfg.MixingEnabled = false
+	unmixed, teardown := testWallet(ctx, t, &cfg, nil)
+	defer teardown()
+	req = &PurchaseTicketsRequest{Count: 1, Mixing: true}
+	if _, err := unmixed.PurchaseTickets(ctx, nil, req); !errors.Is(err, errors.Invalid) {
+		t.Errorf("purchased mixed split tickets without mixing support: %v", err)
+	}
+}
+
+func TestChooseMixSplit(t *testing.T) {
+	t.Parallel()
+
+	const feeRate = 1e4
+	tests := []struct {
+		name       string
+		amount     dcrutil.Amount
+		sdiff      dcrutil.Amount
+		ok         bool
+		denom      dcrutil.Amount
+		count      uint32
+		withChange bool
+	}{
+		{"below ticket price", 5e8, 2e8, true, 1 << 26, 4, true},
+		{"exact", 2*(1<<30) + 1e4, 100e8, true, 1 << 30, 2, false},
+		{"smallest has no change", 3*(1<<18) + 1e5, 1, true, 1 << 18, 3, false},
+		{"too small", 1 << 18, 1, false, 0, 0, false},
+	}
+	for _, tc := range tests {
+		split, ok := chooseMixSplit(tc.amount, tc.sdiff, feeRate)
+		if ok != tc.ok {
+			t.Errorf("%s: ok %v, want %v", tc.name, ok, tc.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if split.denom != tc.denom || split.count != tc.count ||
+			splitPoints[split.index] != split.denom {
+			t.Errorf("%s: split %+v, want %v x %v", tc.name, split,
+				tc.count, tc.denom)
+		}
+		if (split.change != 0) != tc.withChange {
+			t.Errorf("%s: change %v", tc.name, split.change)
+		}
+		fee := tc.amount - dcrutil.Amount(split.count)*split.denom - split.change
+		if fee <= 0 {
+			t.Errorf("%s: fee %v", tc.name, fee)
+		}
+	}
+}
+
 func TestIsMixTx(t *testing.T) {
 	t.Parallel()
 
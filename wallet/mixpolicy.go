@@ -0,0 +1,89 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"decred.org/dcrwallet/v5/errors"
+	"github.com/decred/dcrd/mixing/mixclient"
+	"github.com/decred/dcrd/wire"
+)
+
+// MixPolicy describes the minimum anonymity set required of peer-to-peer mixes
+// the wallet participates in.
+type MixPolicy struct {
+	// MinPeers is the minimum number of peers, including the wallet's own
+	// pair requests, contributing inputs to a mix transaction.  The wallet
+	// refuses to sign mix transactions with fewer peers.  Values below the
+	// protocol minimum of mixclient.MinPeers impose no further
+	// restriction.
+	MinPeers int
+
+	// MinPeerVersion is the minimum protocol version of network peers
+	// that mixing messages are exchanged with.  Values below
+	// wire.MixVersion impose no further restriction.
+	MinPeerVersion uint32
+}
+
+// SetMixPolicy sets the minimum peer counts and peer protocol versions
+// required of mixes.  The policy is not persisted.
+func (w *Wallet) SetMixPolicy(p MixPolicy) error {
+	const op errors.Op = "wallet.SetMixPolicy"
+	if p.MinPeers < 0 {
+		return errors.E(op, errors.Invalid, "negative minimum mix peers")
+	}
+	if p.MinPeerVersion > wire.ProtocolVersion {
+		return errors.E(op, errors.Invalid, errors.Errorf("minimum mix "+
+			"peer version %d exceeds supported protocol version %d",
+			p.MinPeerVersion, wire.ProtocolVersion))
+	}
+
+	w.mixPolicyMu.Lock()
+	w.mixPolicy = p
+	w.mixPolicyMu.Unlock()
+	return nil
+}
+
+// MixPolicy returns the current mix policy.
+func (w *Wallet) MixPolicy() MixPolicy {
+	w.mixPolicyMu.Lock()
+	defer w.mixPolicyMu.Unlock()
+	return w.mixPolicy
+}
+
+// MixPeerVersion returns the minimum protocol version of network peers that
+// mixing messages may be exchanged with.
+func (w *Wallet) MixPeerVersion() uint32 {
+	return max(wire.MixVersion, w.MixPolicy().MinPeerVersion)
+}
+
+// checkMixPeers errors when fewer peers than required by the mix policy
+// contribute inputs to a mix transaction.  Peers are identified by the pair
+// requests in the mixpool whose UTXOs are spent by the transaction.
+func (w *Wallet) checkMixPeers(tx *wire.MsgTx) error {
+	minPeers := w.MixPolicy().MinPeers
+	if minPeers <= mixclient.MinPeers {
+		return nil
+	}
+
+	inputs := make(map[wire.OutPoint]struct{}, len(tx.TxIn))
+	for _, in := range tx.TxIn {
+		inputs[in.PreviousOutPoint] = struct{}{}
+	}
+	peers := make(map[[33]byte]struct{})
+	for _, pr := range w.mixpool.MixPRs() {
+		for i := range pr.UTXOs {
+			if _, ok := inputs[pr.UTXOs[i].OutPoint]; ok {
+				peers[pr.Identity] = struct{}{}
+				break
+			}
+		}
+	}
+	if len(peers) < minPeers {
+		return errors.E(errors.Policy, errors.Errorf("mix of %d peers is "+
+			"below the minimum of %d required by the mix policy",
+			len(peers), minPeers))
+	}
+	return nil
+}
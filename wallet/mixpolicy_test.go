@@ -0,0 +1,58 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestMixPolicy(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, nil)
+	defer teardown()
+
+	if v := w.MixPeerVersion(); v != wire.MixVersion {
+		t.Errorf("default mix peer version %d, want %d", v, wire.MixVersion)
+	}
+
+	invalid := []MixPolicy{
+		{MinPeers: -1},
+		{MinPeerVersion: wire.ProtocolVersion + 1},
+	}
+	for _, p := range invalid {
+		if err := w.SetMixPolicy(p); !errors.Is(err, errors.Invalid) {
+			t.Errorf("SetMixPolicy(%+v): %v", p, err)
+		}
+	}
+
+	p := MixPolicy{MinPeers: 6, MinPeerVersion: wire.ProtocolVersion}
+	if err := w.SetMixPolicy(p); err != nil {
+		t.Fatal(err)
+	}
+	if got := w.MixPolicy(); got != p {
+		t.Errorf("mix policy %+v, want %+v", got, p)
+	}
+	if v := w.MixPeerVersion(); v != wire.ProtocolVersion {
+		t.Errorf("mix peer version %d, want %d", v, wire.ProtocolVersion)
+	}
+
+	// Transactions spending no inputs of known pair requests have no peers.
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, 0, nil))
+	if err := w.checkMixPeers(tx); !errors.Is(err, errors.Policy) {
+		t.Errorf("checkMixPeers below minimum peers: %v", err)
+	}
+	if err := w.SetMixPolicy(MixPolicy{MinPeers: 4}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.checkMixPeers(tx); err != nil {
+		t.Errorf("checkMixPeers at protocol minimum: %v", err)
+	}
+}
@@ -0,0 +1,255 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/hdkeychain/v3"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/txscript/v4/stdscript"
+	"github.com/decred/dcrd/wire"
+)
+
+// MixBranchRecovery records the address cursors of a mixing account branch
+// before and after mix output recovery.  Child indexes are ^uint32(0) when no
+// address of the branch was used or returned.
+type MixBranchRecovery struct {
+	Account            uint32
+	Branch             uint32
+	LastUsedBefore     uint32
+	LastReturnedBefore uint32
+	LastUsed           uint32
+	LastReturned       uint32
+}
+
+// MixRecoveredOutput describes an unspent output of a mixing account paying
+// to an address beyond the last used address of its branch prior to recovery.
+type MixRecoveredOutput struct {
+	OutPoint wire.OutPoint
+	Amount   dcrutil.Amount
+	Account  uint32
+	Branch   uint32
+	Index    uint32
+
+	// Unreferenced is true when the address was also beyond the last
+	// returned address of the branch, as happens when a mix completes
+	// after the wallet failed to record the addresses it derived.
+	Unreferenced bool
+}
+
+// MixRecovery is the result of RecoverMixOutputs.
+type MixRecovery struct {
+	Branches []MixBranchRecovery
+	Outputs  []MixRecoveredOutput
+}
+
+// RecoverMixOutputs searches both branches of mixing accounts for outputs
+// stranded by interrupted mixes.  Mixed outputs and unmixed change may pay to
+// addresses of either branch far beyond the last used and returned addresses
+// recorded by the wallet, which are not watched and leave the funds missing
+// from the wallet's balance.
+//
+// For each account branch, scanLen addresses beyond the last used or returned
+// address are saved and watched, and the main chain is rescanned from
+// startHeight.  The address cursors are then moved beyond the last used
+// address found, and the unspent outputs of these addresses are returned with
+// the cursors of each branch before and after recovery.
+func (w *Wallet) RecoverMixOutputs(ctx context.Context, n NetworkBackend,
+	accounts []uint32, scanLen uint32, startHeight int32) (*MixRecovery, error) {
+
+	const op errors.Op = "wallet.RecoverMixOutputs"
+	if scanLen == 0 {
+		return nil, errors.E(op, errors.Invalid, "scan length must be positive")
+	}
+	for _, acct := range accounts {
+		if acct > udb.MaxAccountNum {
+			return nil, errors.E(op, errors.Invalid, errors.Errorf("account "+
+				"%d is not a BIP0044 account", acct))
+		}
+	}
+
+	r := new(MixRecovery)
+	var watch []stdaddr.Address
+	syncedTo := make(map[[2]uint32]uint32)
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		for _, acct := range accounts {
+			props, err := w.manager.AccountProperties(ns, acct)
+			if err != nil {
+				return err
+			}
+			xpub, err := w.manager.AccountExtendedPubKey(dbtx, acct)
+			if err != nil {
+				return err
+			}
+			extKey, intKey, err := deriveBranches(xpub)
+			if err != nil {
+				return err
+			}
+			branches := []struct {
+				branch, lastUsed, lastReturned uint32
+				key                            *hdkeychain.ExtendedKey
+			}{
+				{udb.ExternalBranch, props.LastUsedExternalIndex, props.LastReturnedExternalIndex, extKey},
+				{udb.InternalBranch, props.LastUsedInternalIndex, props.LastReturnedInternalIndex, intKey},
+			}
+			for _, b := range branches {
+				r.Branches = append(r.Branches, MixBranchRecovery{
+					Account:            acct,
+					Branch:             b.branch,
+					LastUsedBefore:     b.lastUsed,
+					LastReturnedBefore: b.lastReturned,
+				})
+				first := b.lastUsed + 1
+				last := max(first, b.lastReturned+1)
+				syncTo := min(last+scanLen, hdkeychain.HardenedKeyStart) - 1
+				err := w.manager.SyncAccountToAddrIndex(ns, acct, syncTo, b.branch)
+				if err != nil {
+					return err
+				}
+				syncedTo[[2]uint32{acct, b.branch}] = syncTo
+				addrs, pubKeyAddrs, err := deriveChildWatchAddresses(b.key,
+					first, syncTo-first+1, w.chainParams)
+				if err != nil {
+					return err
+				}
+				watch = append(watch, addrs...)
+				watch = append(watch, pubKeyAddrs...)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	err = n.LoadTxFilter(ctx, false, watch, nil)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	err = w.RescanFromHeight(ctx, n, startHeight)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	// Reconcile the address cursors with the addresses used by recovered
+	// transactions, and watch the gap beyond the new last used addresses.
+	w.addressBuffersMu.Lock()
+	defer w.addressBuffersMu.Unlock()
+	watch = watch[:0]
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		for i := range r.Branches {
+			b := &r.Branches[i]
+			props, err := w.manager.AccountProperties(ns, b.Account)
+			if err != nil {
+				return err
+			}
+			acctData, ok := w.addressBuffers[b.Account]
+			if !ok {
+				return errors.E(errors.NotExist, errors.Errorf("account %d", b.Account))
+			}
+			alb := &acctData.albExternal
+			b.LastUsed, b.LastReturned = props.LastUsedExternalIndex, props.LastReturnedExternalIndex
+			if b.Branch == udb.InternalBranch {
+				alb = &acctData.albInternal
+				b.LastUsed, b.LastReturned = props.LastUsedInternalIndex, props.LastReturnedInternalIndex
+			}
+			alb.advance(b.LastUsed, b.LastReturned)
+
+			syncTo := min(b.LastUsed+1+w.gapLimit, hdkeychain.HardenedKeyStart) - 1
+			prevSyncTo := syncedTo[[2]uint32{b.Account, b.Branch}]
+			if syncTo <= prevSyncTo {
+				continue
+			}
+			err = w.manager.SyncAccountToAddrIndex(ns, b.Account, syncTo, b.Branch)
+			if err != nil {
+				return err
+			}
+			addrs, pubKeyAddrs, err := deriveChildWatchAddresses(alb.branchXpub,
+				prevSyncTo+1, syncTo-prevSyncTo, w.chainParams)
+			if err != nil {
+				return err
+			}
+			watch = append(watch, addrs...)
+			watch = append(watch, pubKeyAddrs...)
+		}
+
+		r.Outputs, err = w.strandedMixOutputs(dbtx, r.Branches)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if len(watch) != 0 {
+		err = n.LoadTxFilter(ctx, false, watch, nil)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+
+	for i := range r.Outputs {
+		o := &r.Outputs[i]
+		log.Infof("Recovered mix output %v (%v) of account %d branch %d index %d",
+			&o.OutPoint, o.Amount, o.Account, o.Branch, o.Index)
+	}
+	return r, nil
+}
+
+// strandedMixOutputs returns the unspent outputs paying to addresses of the
+// account branches beyond their last used addresses prior to recovery.
+func (w *Wallet) strandedMixOutputs(dbtx walletdb.ReadTx, branches []MixBranchRecovery) ([]MixRecoveredOutput, error) {
+	ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+	before := make(map[[2]uint32]*MixBranchRecovery, len(branches))
+	for i := range branches {
+		b := &branches[i]
+		before[[2]uint32{b.Account, b.Branch}] = b
+	}
+
+	credits, err := w.txStore.UnspentOutputs(dbtx)
+	if err != nil {
+		return nil, err
+	}
+	var outputs []MixRecoveredOutput
+	for _, c := range credits {
+		_, addrs := stdscript.ExtractAddrs(scriptVersionAssumed, c.PkScript, w.chainParams)
+		if len(addrs) != 1 {
+			continue
+		}
+		ma, err := w.manager.Address(ns, addrs[0])
+		if errors.Is(err, errors.NotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		xpa, ok := ma.(udb.ManagedPubKeyAddress)
+		if !ok || xpa.Imported() {
+			continue
+		}
+		branch := udb.ExternalBranch
+		if xpa.Internal() {
+			branch = udb.InternalBranch
+		}
+		b, ok := before[[2]uint32{xpa.Account(), branch}]
+		if !ok || xpa.Index()+1 <= b.LastUsedBefore+1 {
+			continue
+		}
+		outputs = append(outputs, MixRecoveredOutput{
+			OutPoint:     c.OutPoint,
+			Amount:       c.Amount,
+			Account:      xpa.Account(),
+			Branch:       branch,
+			Index:        xpa.Index(),
+			Unreferenced: xpa.Index()+1 > b.LastReturnedBefore+1,
+		})
+	}
+	return outputs, nil
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet_test
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/wallettest"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+)
+
+func TestRecoverMixOutputs(t *testing.T) {
+	ctx := context.Background()
+	h := wallettest.New(t, &wallettest.Config{GapLimit: 10})
+	w := h.Wallet
+
+	// Pay an internal address of the default account far beyond the gap
+	// limit, as an interrupted mix which failed to record its derived
+	// addresses would.
+	const strandedIndex = 25
+	xpub, err := w.AccountXpub(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	branch, err := xpub.Child(udb.InternalBranch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	child, err := branch.Child(strandedIndex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkh := dcrutil.Hash160(child.SerializedPubKey())
+	addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(pkh, w.ChainParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.Chain.MineBlock(ctx, h.Chain.FundingTx(addr, 3e8)); err != nil {
+		t.Fatal(err)
+	}
+	balance := func() dcrutil.Amount {
+		t.Helper()
+		b, err := w.AccountBalance(ctx, 0, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b.Total
+	}
+	if b := balance(); b != 0 {
+		t.Fatalf("balance %v before recovery", b)
+	}
+
+	if _, err := w.RecoverMixOutputs(ctx, h.Chain, []uint32{0}, 0, 1); !errors.Is(err, errors.Invalid) {
+		t.Fatalf("recovery with zero scan length: %v", err)
+	}
+	r, err := w.RecoverMixOutputs(ctx, h.Chain, []uint32{0}, 30, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Outputs) != 1 {
+		t.Fatalf("recovered %d outputs, want 1", len(r.Outputs))
+	}
+	o := r.Outputs[0]
+	if o.Amount != 3e8 || o.Branch != udb.InternalBranch ||
+		o.Index != strandedIndex || !o.Unreferenced {
+		t.Errorf("unexpected recovered output %+v", o)
+	}
+	if b := balance(); b != 3e8 {
+		t.Errorf("balance %v after recovery, want 3 DCR", b)
+	}
+	if len(r.Branches) != 2 || r.Branches[1].LastUsed != strandedIndex {
+		t.Errorf("unexpected branch cursors %+v", r.Branches)
+	}
+
+	// Addresses are no longer returned at or before the stranded index.
+	_, intChild, err := w.BIP0044BranchNextIndexes(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if intChild != strandedIndex+1 {
+		t.Errorf("next internal child %d, want %d", intChild, strandedIndex+1)
+	}
+
+	// Recovery is idempotent once outputs are found.
+	r, err = w.RecoverMixOutputs(ctx, h.Chain, []uint32{0}, 30, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Outputs) != 0 {
+		t.Errorf("recovered %d outputs again", len(r.Outputs))
+	}
+}
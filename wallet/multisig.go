@@ -8,10 +8,13 @@ import (
 	"context"
 
 	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/txauthor"
 	"decred.org/dcrwallet/v5/wallet/txrules"
 	"decred.org/dcrwallet/v5/wallet/txsizes"
 	"decred.org/dcrwallet/v5/wallet/udb"
 	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4"
 	"github.com/decred/dcrd/txscript/v4/stdaddr"
 	"github.com/decred/dcrd/wire"
 )
@@ -86,6 +89,9 @@ func (w *Wallet) PrepareRedeemMultiSigOutTxOutput(msgTx *wire.MsgTx, p2shOutput
 
 	// estimate the output fee
 	txOut := wire.NewTxOut(0, *pkScript)
+	if err := w.checkFeeRate(w.RelayFee()); err != nil {
+		return errors.E(op, err)
+	}
 	feeSize := txsizes.EstimateSerializeSize(scriptSizes, []*wire.TxOut{txOut}, 0)
 	feeEst := txrules.FeeForSerializeSize(w.RelayFee(), feeSize)
 	if feeEst >= p2shOutput.OutputAmount {
@@ -99,3 +105,129 @@ func (w *Wallet) PrepareRedeemMultiSigOutTxOutput(msgTx *wire.MsgTx, p2shOutput
 	msgTx.AddTxOut(txOut)
 	return nil
 }
+
+// MultisigSpendRequest describes a spend of the unspent outputs of a P2SH
+// multisig address.  Cosigners authoring the same request with identical
+// views of the address's outputs create identical unsigned transactions.
+type MultisigSpendRequest struct {
+	Address *stdaddr.AddressScriptHashV0
+	Outputs []*wire.TxOut
+
+	// Inputs selects the outputs of Address to spend by hash and index.
+	// When empty, every unspent output of Address is spent.
+	Inputs []wire.OutPoint
+
+	// FeeRate is the fee per kilobyte, defaulting to the relay fee when
+	// zero.  Cosigners must agree on the fee rate, as the relay fee is
+	// configurable.
+	FeeRate dcrutil.Amount
+}
+
+// multisigSigScriptSize returns the worst case size of a signature script
+// redeeming a P2SH output with an m-of-n redeem script.
+func multisigSigScriptSize(m int, redeemScript []byte) int {
+	size := m * (1 + 73)
+	switch l := len(redeemScript); {
+	case l < txscript.OP_PUSHDATA1:
+		size += 1 + l
+	case l <= 0xff:
+		size += 2 + l
+	default:
+		size += 3 + l
+	}
+	return size
+}
+
+// AuthorMultisigSpend creates an unsigned transaction spending outputs of a
+// P2SH multisig address.  Any change is returned to the multisig address
+// itself, and inputs and outputs are sorted with txauthor.SortBIP69, so the
+// transaction only depends on the request and the spent outputs.  This allows
+// each cosigner to independently author and sign the same transaction.
+func (w *Wallet) AuthorMultisigSpend(ctx context.Context, req *MultisigSpendRequest) (*txauthor.AuthoredTx, error) {
+	const op errors.Op = "wallet.AuthorMultisigSpend"
+	if len(req.Outputs) == 0 {
+		return nil, errors.E(op, errors.Invalid, "no outputs")
+	}
+	if req.FeeRate < 0 {
+		return nil, errors.E(op, errors.Invalid, "negative fee rate")
+	}
+	feeRate := req.FeeRate
+	if feeRate == 0 {
+		feeRate = w.RelayFee()
+	}
+	if err := w.checkFeeRate(feeRate); err != nil {
+		return nil, errors.E(op, err)
+	}
+	for _, output := range req.Outputs {
+		if err := txrules.CheckOutput(output, feeRate); err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+
+	var credits []*udb.MultisigCredit
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		credits, err = w.txStore.UnspentMultisigCreditsForAddress(dbtx, req.Address)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if len(req.Inputs) != 0 {
+		byOutPoint := make(map[outpoint]*udb.MultisigCredit, len(credits))
+		for _, c := range credits {
+			byOutPoint[outpoint{c.OutPoint.Hash, c.OutPoint.Index}] = c
+		}
+		credits = credits[:0]
+		for i := range req.Inputs {
+			in := &req.Inputs[i]
+			key := outpoint{in.Hash, in.Index}
+			c, ok := byOutPoint[key]
+			if !ok {
+				return nil, errors.E(op, errors.NotExist, errors.Errorf("outpoint %v "+
+					"is not an unspent output of %v", in, req.Address))
+			}
+			delete(byOutPoint, key)
+			credits = append(credits, c)
+		}
+	}
+	if len(credits) == 0 {
+		return nil, errors.E(op, errors.InsufficientBalance,
+			errors.Errorf("no unspent outputs of %v", req.Address))
+	}
+
+	_, p2shScript := req.Address.PaymentScript()
+	detail := &txauthor.InputDetail{}
+	for _, c := range credits {
+		detail.Amount += c.Amount
+		detail.Inputs = append(detail.Inputs, wire.NewTxIn(c.OutPoint, int64(c.Amount), nil))
+		detail.Scripts = append(detail.Scripts, p2shScript)
+		detail.RedeemScriptSizes = append(detail.RedeemScriptSizes,
+			multisigSigScriptSize(int(c.M), c.MSScript))
+	}
+	inputSource := func(dcrutil.Amount) (*txauthor.InputDetail, error) {
+		return detail, nil
+	}
+	changeSource := &multisigChangeSource{script: p2shScript}
+	atx, err := txauthor.NewUnsignedTransaction(req.Outputs, feeRate,
+		inputSource, changeSource, w.chainParams.MaxTxSize)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	atx.SortBIP69()
+	return atx, nil
+}
+
+// multisigChangeSource returns change to the P2SH multisig address being
+// spent.
+type multisigChangeSource struct {
+	script []byte
+}
+
+func (s *multisigChangeSource) Script() ([]byte, uint16, error) {
+	return s.script, 0, nil
+}
+
+func (s *multisigChangeSource) ScriptSize() int {
+	return txsizes.P2SHPkScriptSize
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet"
+	"decred.org/dcrwallet/v5/wallet/wallettest"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/txscript/v4/stdscript"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestAuthorMultisigSpend(t *testing.T) {
+	ctx := context.Background()
+	h := wallettest.New(t, nil)
+	w := h.Wallet
+	params := w.ChainParams()
+
+	// Create a 2-of-2 multisig address of a wallet key and a cosigner key.
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ka, err := w.KnownAddress(ctx, addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cosigner, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	redeemScript, err := stdscript.MultiSigScriptV0(2,
+		ka.(wallet.PubKeyHashAddress).PubKey(),
+		cosigner.PubKey().SerializeCompressed())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.ImportScript(ctx, redeemScript, wallet.NoRescan); err != nil {
+		t.Fatal(err)
+	}
+	p2sh, err := stdaddr.NewAddressScriptHashV0(redeemScript, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := &wallet.MultisigSpendRequest{Address: p2sh}
+	if _, err := w.AuthorMultisigSpend(ctx, req); !errors.Is(err, errors.Invalid) {
+		t.Fatalf("spend without outputs: %v", err)
+	}
+	payee, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(make([]byte, 20), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, pkScript := payee.PaymentScript()
+	req.Outputs = []*wire.TxOut{
+		{Value: 2e8, Version: version, PkScript: pkScript},
+		{Value: 1e8, Version: version, PkScript: pkScript},
+	}
+	if _, err := w.AuthorMultisigSpend(ctx, req); !errors.Is(err, errors.InsufficientBalance) {
+		t.Fatalf("spend of address without outputs: %v", err)
+	}
+
+	funds := []*wire.MsgTx{
+		h.Chain.FundingTx(p2sh, 3e8),
+		h.Chain.FundingTx(p2sh, 4e8),
+		h.Chain.FundingTx(p2sh, 5e8),
+	}
+	if _, err := h.Chain.MineBlock(ctx, funds...); err != nil {
+		t.Fatal(err)
+	}
+
+	// Spends of the same outputs are identical regardless of the order
+	// the inputs and outputs are requested in.
+	all, err := w.AuthorMultisigSpend(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all.Tx.TxIn) != len(funds) {
+		t.Fatalf("spend of all outputs has %d inputs", len(all.Tx.TxIn))
+	}
+	var inputs []wire.OutPoint
+	for _, fund := range funds {
+		inputs = append(inputs, wire.OutPoint{Hash: fund.TxHash()})
+	}
+	reversed := &wallet.MultisigSpendRequest{
+		Address: p2sh,
+		Outputs: []*wire.TxOut{req.Outputs[1], req.Outputs[0]},
+		Inputs:  []wire.OutPoint{inputs[2], inputs[1], inputs[0]},
+	}
+	atx, err := w.AuthorMultisigSpend(ctx, reversed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if atx.Tx.TxHash() != all.Tx.TxHash() {
+		t.Fatal("spends of the same outputs differ")
+	}
+
+	// Inputs are sorted by outpoint, outputs by value, and change is
+	// returned to the multisig address.
+	for i := 1; i < len(atx.Tx.TxIn); i++ {
+		a, b := &atx.Tx.TxIn[i-1].PreviousOutPoint.Hash, &atx.Tx.TxIn[i].PreviousOutPoint.Hash
+		if bytes.Compare(reverseHash(a), reverseHash(b)) >= 0 {
+			t.Fatalf("inputs are not sorted: %v", atx.Tx.TxIn)
+		}
+	}
+	for i := 1; i < len(atx.Tx.TxOut); i++ {
+		if atx.Tx.TxOut[i-1].Value > atx.Tx.TxOut[i].Value {
+			t.Fatalf("outputs are not sorted: %v", atx.Tx.TxOut)
+		}
+	}
+	if atx.ChangeIndex < 0 {
+		t.Fatal("spend has no change")
+	}
+	_, p2shScript := p2sh.PaymentScript()
+	change := atx.Tx.TxOut[atx.ChangeIndex]
+	if !bytes.Equal(change.PkScript, p2shScript) {
+		t.Fatalf("change pays %x, want %x", change.PkScript, p2shScript)
+	}
+	fee := int64(atx.TotalInput)
+	for _, out := range atx.Tx.TxOut {
+		fee -= out.Value
+	}
+	if atx.TotalInput != 12e8 || fee <= 0 {
+		t.Fatalf("spend of %v pays fee %v", atx.TotalInput, fee)
+	}
+
+	// Selected inputs must be unspent outputs of the address.
+	req.Inputs = []wire.OutPoint{inputs[0], inputs[0]}
+	if _, err := w.AuthorMultisigSpend(ctx, req); !errors.Is(err, errors.NotExist) {
+		t.Fatalf("spend of duplicate input: %v", err)
+	}
+	req.Inputs = []wire.OutPoint{{Hash: chainhash.Hash{1}}}
+	if _, err := w.AuthorMultisigSpend(ctx, req); !errors.Is(err, errors.NotExist) {
+		t.Fatalf("spend of unknown output: %v", err)
+	}
+}
+
+// reverseHash returns the bytes of a hash in the order of its string
+// encoding.
+func reverseHash(h *chainhash.Hash) []byte {
+	b := make([]byte, len(h))
+	for i := range h {
+		b[i] = h[len(h)-1-i]
+	}
+	return b
+}
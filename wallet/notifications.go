@@ -9,6 +9,8 @@ import (
 	"bytes"
 	"context"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"decred.org/dcrwallet/v5/errors"
 	"decred.org/dcrwallet/v5/internal/compat"
@@ -45,9 +47,17 @@ type NotificationServer struct {
 	accountClients            []chan *AccountNotification
 	tipChangedClients         []chan *MainTipChangedNotification
 	confClients               []*ConfirmationNotificationsClient
+	targetClients             []*ConfirmationTargetNotificationsClient
 	removedTransactionClients []chan *RemovedTransactionNotification
+	idleLockClients           []chan *IdleLockNotification
+	instanceConflictClients   []chan *InstanceConflict
 	mu                        sync.Mutex // Only protects registered clients
 	wallet                    *Wallet    // smells like hacks
+
+	// targetMu serializes the delivery of reached confirmation targets,
+	// and targetQueued is set while a delivery is waiting on targetMu.
+	targetMu     sync.Mutex
+	targetQueued atomic.Bool
 }
 
 func newNotificationServer(wallet *Wallet) *NotificationServer {
@@ -285,6 +295,23 @@ func (s *NotificationServer) notifyMinedTransaction(dbtx walletdb.ReadTx, detail
 	*txs = append(*txs, makeTxSummary(dbtx, s.wallet, details))
 }
 
+// notifyExpiredTransaction includes an unmined transaction removed after
+// reaching its expiry in the next attached block notification.  The details
+// must be read before the transaction is removed.
+func (s *NotificationServer) notifyExpiredTransaction(dbtx walletdb.ReadTx, details *udb.TxDetails) {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+
+	if len(s.transactions) == 0 {
+		return
+	}
+	if s.currentTxNtfn == nil {
+		s.currentTxNtfn = &TransactionNotifications{}
+	}
+	s.currentTxNtfn.ExpiredTransactions = append(s.currentTxNtfn.ExpiredTransactions,
+		makeTxSummary(dbtx, s.wallet, details))
+}
+
 func (s *NotificationServer) notifyAttachedBlock(block *wire.BlockHeader, blockHash *chainhash.Hash) {
 	defer s.mu.Unlock()
 	s.mu.Lock()
@@ -337,6 +364,7 @@ func (s *NotificationServer) sendAttachedBlockNotification(ctx context.Context)
 		for _, b := range currentTxNtfn.AttachedBlocks {
 			relevantAccounts(bals, b.Transactions)
 		}
+		relevantAccounts(bals, currentTxNtfn.ExpiredTransactions)
 		return totalBalances(dbtx, w, bals)
 
 	})
@@ -366,7 +394,9 @@ func (s *NotificationServer) sendAttachedBlockNotification(ctx context.Context)
 //
 // All newly added unmined transactions are included.  Removed unmined
 // transactions are not explicitly included.  Instead, the hashes of all
-// transactions still unmined are included.
+// transactions still unmined are included.  Unmined transactions removed after
+// reaching their expiry height are additionally described by
+// ExpiredTransactions, as their inputs may be spent again.
 //
 // If any transactions were involved, each affected account's new total balance
 // is included.
@@ -378,6 +408,7 @@ type TransactionNotifications struct {
 	DetachedBlocks           []*wire.BlockHeader
 	UnminedTransactions      []TransactionSummary
 	UnminedTransactionHashes []*chainhash.Hash
+	ExpiredTransactions      []TransactionSummary
 	NewBalances              []AccountBalance
 }
 
@@ -424,6 +455,10 @@ const (
 	// TransactionTypeRevocation transaction type for all transactions that consume a
 	// ticket, but offer no stake base reward.
 	TransactionTypeRevocation
+
+	// TransactionTypeTreasuryAdd transaction type for all transactions that
+	// contribute funds to the treasury with an OP_TADD output.
+	TransactionTypeTreasuryAdd
 )
 
 // TxTransactionType returns the correct TransactionType given a wire transaction
@@ -436,6 +471,8 @@ func TxTransactionType(tx *wire.MsgTx) TransactionType {
 		return TransactionTypeVote
 	} else if isRevocation(tx) {
 		return TransactionTypeRevocation
+	} else if stake.IsTAdd(tx) {
+		return TransactionTypeTreasuryAdd
 	} else {
 		return TransactionTypeRegular
 	}
@@ -586,6 +623,127 @@ func (s *NotificationServer) notifyRemovedTransaction(hash chainhash.Hash) {
 	}
 }
 
+// IdleLockNotification is sent shortly before the wallet is locked due to
+// inactivity, and again when it is locked.  See Wallet.SetIdleLockTimeout.
+type IdleLockNotification struct {
+	// Remaining is the duration before the wallet is locked unless a
+	// signing operation occurs.  It is zero when Locked is set.
+	Remaining time.Duration
+
+	// Locked is set when the wallet has been locked.
+	Locked bool
+}
+
+// IdleLockNotificationsClient receives IdleLockNotifications over the channel
+// C.
+type IdleLockNotificationsClient struct {
+	C      chan *IdleLockNotification
+	server *NotificationServer
+}
+
+// IdleLockNotifications returns a client for receiving IdleLockNotifications
+// over a channel.  The channel is unbuffered.  When finished, the client's Done
+// method should be called to disassociate the client from the server.
+func (s *NotificationServer) IdleLockNotifications() IdleLockNotificationsClient {
+	c := make(chan *IdleLockNotification)
+	s.mu.Lock()
+	s.idleLockClients = append(s.idleLockClients, c)
+	s.mu.Unlock()
+	return IdleLockNotificationsClient{
+		C:      c,
+		server: s,
+	}
+}
+
+// Done deregisters the client from the server and drains any remaining
+// messages.  It must be called exactly once when the client is finished
+// receiving notifications.
+func (c *IdleLockNotificationsClient) Done() {
+	go func() {
+		for range c.C {
+		}
+	}()
+	go func() {
+		s := c.server
+		s.mu.Lock()
+		clients := s.idleLockClients
+		for i, ch := range clients {
+			if c.C == ch {
+				clients[i] = clients[len(clients)-1]
+				s.idleLockClients = clients[:len(clients)-1]
+				close(ch)
+				break
+			}
+		}
+		s.mu.Unlock()
+	}()
+}
+
+func (s *NotificationServer) notifyIdleLock(n *IdleLockNotification) {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+	for _, c := range s.idleLockClients {
+		c <- n
+	}
+}
+
+// InstanceConflictNotificationsClient receives an InstanceConflict over the
+// channel C each time another wallet process is detected writing to the
+// wallet database.  Conflicts detected while opening the wallet are sent
+// before clients are able to register, and are returned by
+// Wallet.InstanceConflict.
+type InstanceConflictNotificationsClient struct {
+	C      chan *InstanceConflict
+	server *NotificationServer
+}
+
+// InstanceConflictNotifications returns a client for receiving
+// InstanceConflicts over a channel.  The channel is unbuffered.  When finished,
+// the client's Done method should be called to disassociate the client from
+// the server.
+func (s *NotificationServer) InstanceConflictNotifications() InstanceConflictNotificationsClient {
+	c := make(chan *InstanceConflict)
+	s.mu.Lock()
+	s.instanceConflictClients = append(s.instanceConflictClients, c)
+	s.mu.Unlock()
+	return InstanceConflictNotificationsClient{
+		C:      c,
+		server: s,
+	}
+}
+
+// Done deregisters the client from the server and drains any remaining
+// messages.  It must be called exactly once when the client is finished
+// receiving notifications.
+func (c *InstanceConflictNotificationsClient) Done() {
+	go func() {
+		for range c.C {
+		}
+	}()
+	go func() {
+		s := c.server
+		s.mu.Lock()
+		clients := s.instanceConflictClients
+		for i, ch := range clients {
+			if c.C == ch {
+				clients[i] = clients[len(clients)-1]
+				s.instanceConflictClients = clients[:len(clients)-1]
+				close(ch)
+				break
+			}
+		}
+		s.mu.Unlock()
+	}()
+}
+
+func (s *NotificationServer) notifyInstanceConflict(n *InstanceConflict) {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+	for _, c := range s.instanceConflictClients {
+		c <- n
+	}
+}
+
 // AccountNotification contains properties regarding an account, such as its
 // name and the number of derived and imported keys.  When any of these
 // properties change, the notification is fired.
@@ -595,9 +753,18 @@ type AccountNotification struct {
 	ExternalKeyCount uint32
 	InternalKeyCount uint32
 	ImportedKeyCount uint32
+
+	// RolloverFrom is non-nil when the account was created by the account
+	// rollover policy, and is the number of the account whose external
+	// branch reached the rollover limit.
+	RolloverFrom *uint32
 }
 
 func (s *NotificationServer) notifyAccountProperties(props *udb.AccountProperties) {
+	s.notifyAccount(props, nil)
+}
+
+func (s *NotificationServer) notifyAccount(props *udb.AccountProperties, rolloverFrom *uint32) {
 	defer s.mu.Unlock()
 	s.mu.Lock()
 	clients := s.accountClients
@@ -610,6 +777,7 @@ func (s *NotificationServer) notifyAccountProperties(props *udb.AccountPropertie
 		ExternalKeyCount: 0,
 		InternalKeyCount: 0,
 		ImportedKeyCount: props.ImportedKeyCount,
+		RolloverFrom:     rolloverFrom,
 	}
 	// Key counts have to be fudged for BIP0044 accounts a little bit because
 	// only the last used child index is saved.  Add the gap limit since these
@@ -747,6 +915,10 @@ func (s *NotificationServer) notifyMainChainTipChanged(n *MainTipChangedNotifica
 		wg.Wait()
 	}
 
+	if len(s.targetClients) > 0 {
+		s.queueConfirmationTargets()
+	}
+
 	s.mu.Unlock()
 }
 
@@ -0,0 +1,129 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/paymenturi"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+)
+
+// PaymentRequest describes a payment request issued by NewPaymentRequest and
+// the total amount of mined outputs received by its address.
+type PaymentRequest struct {
+	Address stdaddr.Address
+	Account uint32
+	udb.PaymentRequest
+	Received dcrutil.Amount
+}
+
+// Paid returns whether the address has received at least the requested
+// amount, or any amount when no amount was requested.
+func (r *PaymentRequest) Paid() bool {
+	if r.Amount == 0 {
+		return r.Received > 0
+	}
+	return r.Received >= r.Amount
+}
+
+// NewPaymentRequest returns a payment request URI for a new external address
+// of an account.  The amount, label, message, and expiry are included in the
+// URI and recorded with the address, and are returned with the amount paid
+// to the address by PaymentRequest.  Requests with a non-zero expiry are also
+// issued as expiring addresses, and are reported by ExpiredAddresses when not
+// paid in time.
+func (w *Wallet) NewPaymentRequest(ctx context.Context, account uint32, amount dcrutil.Amount,
+	label, message string, expiry time.Time, callOpts ...NextAddressCallOption) (*paymenturi.URI, error) {
+
+	const op errors.Op = "wallet.NewPaymentRequest"
+	if amount < 0 {
+		return nil, errors.E(op, errors.Invalid, "negative amount")
+	}
+	if !expiry.IsZero() && !expiry.After(time.Now()) {
+		return nil, errors.E(op, errors.Invalid, "expiry is not in the future")
+	}
+
+	// Imported voting accounts must not be used for normal transactions.
+	if err := w.notVotingAcct(ctx, op, account); err != nil {
+		return nil, err
+	}
+	accountName, _ := w.AccountName(ctx, account)
+
+	// Expiry times are recorded with second precision.
+	if !expiry.IsZero() {
+		expiry = time.Unix(expiry.Unix(), 0)
+	}
+
+	var addr stdaddr.Address
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		var err error
+		addr, err = w.nextAddress(ctx, op, w.persistReturnedChild(ctx, dbtx),
+			accountName, account, udb.ExternalBranch, callOpts...)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		err = udb.PutPaymentRequest(dbtx, addr.String(), &udb.PaymentRequest{
+			Issued:  now,
+			Expiry:  expiry,
+			Amount:  amount,
+			Label:   label,
+			Message: message,
+		})
+		if err != nil || expiry.IsZero() {
+			return err
+		}
+		return udb.PutExpiringAddress(dbtx, addr.String(), &udb.ExpiringAddress{
+			Issued: now,
+			Expiry: expiry,
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return &paymenturi.URI{
+		Address: addr,
+		Amount:  amount,
+		Label:   label,
+		Message: message,
+		Expiry:  expiry,
+	}, nil
+}
+
+// PaymentRequest returns the payment request issued for an address by
+// NewPaymentRequest.  Returns errors.NotExist if no payment request was issued
+// for the address.
+func (w *Wallet) PaymentRequest(ctx context.Context, addr stdaddr.Address) (*PaymentRequest, error) {
+	const op errors.Op = "wallet.PaymentRequest"
+	var r *PaymentRequest
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		req, err := udb.PaymentRequestFor(dbtx, addr.String())
+		if err != nil {
+			return err
+		}
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		account, err := w.manager.AddrAccount(addrmgrNs, addr)
+		if err != nil {
+			return err
+		}
+		r = &PaymentRequest{
+			Address:        addr,
+			Account:        account,
+			PaymentRequest: *req,
+			Received:       w.txStore.ReceivedByAddress(dbtx, addr),
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return r, nil
+}
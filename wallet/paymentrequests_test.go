@@ -0,0 +1,74 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/paymenturi"
+	"decred.org/dcrwallet/v5/wallet/wallettest"
+	"github.com/decred/dcrd/dcrutil/v4"
+)
+
+func TestPaymentRequests(t *testing.T) {
+	ctx := context.Background()
+	h := wallettest.New(t, nil)
+	w := h.Wallet
+
+	expiry := time.Now().Add(time.Hour)
+	uri, err := w.NewPaymentRequest(ctx, 0, 2e8, "Alice", "Invoice 42", expiry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := paymenturi.Parse(uri.String(), w.ChainParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Address.String() != uri.Address.String() || parsed.Amount != 2e8 ||
+		parsed.Label != "Alice" || parsed.Message != "Invoice 42" ||
+		parsed.Expiry.Unix() != expiry.Unix() {
+		t.Fatalf("parsed %+v from %q", parsed, uri)
+	}
+
+	r, err := w.PaymentRequest(ctx, parsed.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Amount != 2e8 || r.Label != "Alice" || r.Expiry.Unix() != expiry.Unix() || r.Paid() {
+		t.Fatalf("recorded request %+v", r)
+	}
+
+	// Partial payments are reconciled but do not pay the request.
+	for _, amount := range []dcrutil.Amount{1e8, 1.5e8} {
+		_, err := h.Chain.MineBlock(ctx, h.Chain.FundingTx(parsed.Address, amount))
+		if err != nil {
+			t.Fatal(err)
+		}
+		r, err = w.PaymentRequest(ctx, parsed.Address)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if amount == 1e8 && (r.Received != 1e8 || r.Paid()) {
+			t.Fatalf("partially paid request received %v (paid %v)", r.Received, r.Paid())
+		}
+	}
+	if r.Received != 2.5e8 || !r.Paid() {
+		t.Fatalf("paid request received %v (paid %v)", r.Received, r.Paid())
+	}
+
+	if _, err := w.NewPaymentRequest(ctx, 0, 0, "", "", time.Now().Add(-time.Hour)); !errors.Is(err, errors.Invalid) {
+		t.Errorf("issued request with past expiry: %v", err)
+	}
+	other, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.PaymentRequest(ctx, other); !errors.Is(err, errors.NotExist) {
+		t.Errorf("address without request returned %v", err)
+	}
+}
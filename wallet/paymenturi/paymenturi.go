@@ -0,0 +1,140 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package paymenturi builds and parses decred: payment request URIs.
+//
+// URIs follow the form of BIP0021, with the address as the URI path and the
+// optional query parameters:
+//
+//	amount   Requested amount in DCR, as a decimal number
+//	label    Label of the payment recipient
+//	message  Message describing the payment
+//	expiry   Unix time after which the request should not be paid
+//
+// For example:
+//
+//	decred:DsExampleAddress?amount=1.5&label=Alice&message=Invoice%2042
+//
+// Unknown parameters are ignored when parsing, except those beginning with
+// "req-", which indicate parameters required for the payment and cause the URI
+// to be rejected.
+package paymenturi
+
+import (
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+)
+
+// Scheme is the URI scheme of Decred payment requests.
+const Scheme = "decred"
+
+// URI describes a payment request.  Zero values of the optional fields are
+// omitted from the encoded URI.
+type URI struct {
+	Address stdaddr.Address
+	Amount  dcrutil.Amount
+	Label   string
+	Message string
+	Expiry  time.Time
+}
+
+func escape(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
+
+// String encodes the payment request as a decred: URI.
+func (u *URI) String() string {
+	var b strings.Builder
+	b.WriteString(Scheme)
+	b.WriteByte(':')
+	b.WriteString(u.Address.String())
+	sep := byte('?')
+	param := func(key, value string) {
+		b.WriteByte(sep)
+		b.WriteString(key)
+		b.WriteByte('=')
+		b.WriteString(value)
+		sep = '&'
+	}
+	if u.Amount != 0 {
+		param("amount", strconv.FormatFloat(u.Amount.ToCoin(), 'f', -1, 64))
+	}
+	if u.Label != "" {
+		param("label", escape(u.Label))
+	}
+	if u.Message != "" {
+		param("message", escape(u.Message))
+	}
+	if !u.Expiry.IsZero() {
+		param("expiry", strconv.FormatInt(u.Expiry.Unix(), 10))
+	}
+	return b.String()
+}
+
+// Parse decodes a decred: URI.  The address must be valid for the network
+// described by params.  Errors have kind errors.Encoding for malformed URIs,
+// and errors.Invalid for addresses of other networks and unsupported required
+// parameters.
+func Parse(s string, params stdaddr.AddressParams) (*URI, error) {
+	const op errors.Op = "paymenturi.Parse"
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	if !strings.EqualFold(u.Scheme, Scheme) {
+		return nil, errors.E(op, errors.Encoding, errors.Errorf("scheme %q is not %q", u.Scheme, Scheme))
+	}
+	if u.Opaque == "" {
+		return nil, errors.E(op, errors.Encoding, "missing address")
+	}
+	addr, err := stdaddr.DecodeAddress(u.Opaque, params)
+	if err != nil {
+		return nil, errors.E(op, errors.Invalid, err)
+	}
+	query, err := url.ParseQuery(u.RawQuery)
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+
+	p := &URI{Address: addr}
+	for key, values := range query {
+		if len(values) != 1 {
+			return nil, errors.E(op, errors.Encoding, errors.Errorf("duplicate parameter %q", key))
+		}
+		value := values[0]
+		switch key {
+		case "amount":
+			f, err := strconv.ParseFloat(value, 64)
+			if err != nil || !(f > 0) || math.IsInf(f, 0) {
+				return nil, errors.E(op, errors.Encoding, errors.Errorf("invalid amount %q", value))
+			}
+			p.Amount, err = dcrutil.NewAmount(f)
+			if err != nil {
+				return nil, errors.E(op, errors.Encoding, err)
+			}
+		case "label":
+			p.Label = value
+		case "message":
+			p.Message = value
+		case "expiry":
+			sec, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || sec <= 0 {
+				return nil, errors.E(op, errors.Encoding, errors.Errorf("invalid expiry %q", value))
+			}
+			p.Expiry = time.Unix(sec, 0)
+		default:
+			if strings.HasPrefix(key, "req-") {
+				return nil, errors.E(op, errors.Invalid, errors.Errorf("unsupported required parameter %q", key))
+			}
+		}
+	}
+	return p, nil
+}
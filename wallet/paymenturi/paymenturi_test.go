@@ -0,0 +1,86 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package paymenturi
+
+import (
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+)
+
+func TestRoundTrip(t *testing.T) {
+	params := chaincfg.MainNetParams()
+	addr, err := stdaddr.DecodeAddress("DsUZxxoHJSty8DCfwfartwTYbuhmVct7tJu", params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		uri  URI
+		want string
+	}{{
+		uri:  URI{Address: addr},
+		want: "decred:DsUZxxoHJSty8DCfwfartwTYbuhmVct7tJu",
+	}, {
+		uri: URI{
+			Address: addr,
+			Amount:  150000001,
+			Label:   "Alice & Bob",
+			Message: "Invoice 42+1",
+			Expiry:  time.Unix(1700000000, 0),
+		},
+		want: "decred:DsUZxxoHJSty8DCfwfartwTYbuhmVct7tJu?amount=1.50000001" +
+			"&label=Alice%20%26%20Bob&message=Invoice%2042%2B1&expiry=1700000000",
+	}}
+	for _, test := range tests {
+		s := test.uri.String()
+		if s != test.want {
+			t.Errorf("encoded %q, want %q", s, test.want)
+			continue
+		}
+		u, err := Parse(s, params)
+		if err != nil {
+			t.Errorf("parse %q: %v", s, err)
+			continue
+		}
+		if u.Address.String() != addr.String() || u.Amount != test.uri.Amount ||
+			u.Label != test.uri.Label || u.Message != test.uri.Message ||
+			!u.Expiry.Equal(test.uri.Expiry) {
+			t.Errorf("parsed %+v, want %+v", u, test.uri)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	params := chaincfg.MainNetParams()
+	const addr = "DsUZxxoHJSty8DCfwfartwTYbuhmVct7tJu"
+	tests := []struct {
+		uri  string
+		kind errors.Kind
+	}{
+		{"bitcoin:" + addr, errors.Encoding},
+		{"decred:", errors.Encoding},
+		{"decred:TsWjioPrP8E1TuTMmTrVMM2BA4iPrjQXBpR", errors.Invalid},
+		{"decred:" + addr + "?amount=-1", errors.Encoding},
+		{"decred:" + addr + "?amount=NaN", errors.Encoding},
+		{"decred:" + addr + "?amount=1&amount=2", errors.Encoding},
+		{"decred:" + addr + "?expiry=soon", errors.Encoding},
+		{"decred:" + addr + "?req-refund=1", errors.Invalid},
+	}
+	for _, test := range tests {
+		_, err := Parse(test.uri, params)
+		if !errors.Is(err, test.kind) {
+			t.Errorf("parse %q: error %v, want kind %v", test.uri, err, test.kind)
+		}
+	}
+
+	// Unknown optional parameters are ignored.
+	if _, err := Parse("DECRED:"+addr+"?foo=bar", params); err != nil {
+		t.Errorf("unknown parameter: %v", err)
+	}
+}
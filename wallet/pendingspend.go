@@ -0,0 +1,256 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/txauthor"
+	"decred.org/dcrwallet/v5/wallet/txrules"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4"
+	"github.com/decred/dcrd/wire"
+)
+
+// PendingSpend describes an unsigned transaction proposed by ProposeSpend,
+// which is signed and published only after approval.  Amount is the total
+// value of outputs which do not pay to wallet addresses.
+type PendingSpend struct {
+	Hash     chainhash.Hash
+	Tx       *wire.MsgTx
+	Account  uint32
+	Proposed time.Time
+	Amount   dcrutil.Amount
+	Fee      dcrutil.Amount
+}
+
+// ProposeSpend creates an unsigned transaction paying outputs, as SendOutputs
+// would, and records it as a pending spend rather than signing and publishing
+// it.  The inputs of the transaction remain locked until the spend is approved
+// with ApprovePendingSpend or rejected with RejectPendingSpend, and the
+// returned hash will be the hash of the published transaction.
+//
+// Proposing spends does not require an unlocked wallet, allowing the
+// approving party to be the only holder of the private passphrase.
+func (w *Wallet) ProposeSpend(ctx context.Context, outputs []*wire.TxOut, account, changeAccount uint32,
+	minconf int32, opts ...TxAuthorOption) (*PendingSpend, error) {
+
+	const op errors.Op = "wallet.ProposeSpend"
+	relayFee := w.RelayFee()
+	for _, output := range outputs {
+		err := txrules.CheckOutput(output, relayFee)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+
+	changePolicy, err := w.resolveChangePolicy(ctx, opts,
+		changeAccount != account)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	expiry, err := resolveTxExpiry(w.TxExpiry(), opts)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	a := &authorTx{
+		outputs:            outputs,
+		account:            account,
+		changeAccount:      changeAccount,
+		changePolicy:       changePolicy,
+		override:           destinationOverride(opts),
+		expiry:             expiry,
+		minconf:            minconf,
+		randomizeChangeIdx: true,
+		txFee:              relayFee,
+		dontSignTx:         true,
+	}
+	err = w.authorTx(ctx, op, a)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &udb.PendingSpend{
+		Tx:       a.atx.Tx,
+		Account:  account,
+		Proposed: time.Now(),
+	}
+	var spend *PendingSpend
+	defer w.lockedOutpointMu.Unlock()
+	w.lockedOutpointMu.Lock()
+	// The inputs were unlocked after authoring the transaction, and may
+	// have been selected by another transaction in the meantime.
+	for _, in := range p.Tx.TxIn {
+		prev := &in.PreviousOutPoint
+		if _, ok := w.lockedOutpoints[outpoint{prev.Hash, prev.Index}]; ok {
+			return nil, errors.E(op, errors.Invalid, errors.Errorf("input "+
+				"%v was locked by another transaction", prev))
+		}
+	}
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		for _, up := range a.changeSourceUpdates {
+			if err := up(dbtx); err != nil {
+				return err
+			}
+		}
+		if err := udb.PutPendingSpend(dbtx, p); err != nil {
+			return err
+		}
+		spend = w.makePendingSpend(dbtx, p)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	w.lockPendingSpendInputs(p.Tx)
+
+	log.Infof("Proposed spend of %v in transaction %v", spend.Amount, &spend.Hash)
+	return spend, nil
+}
+
+func (w *Wallet) makePendingSpend(dbtx walletdb.ReadTx, p *udb.PendingSpend) *PendingSpend {
+	amount, fee := w.spendAmounts(dbtx, p.Tx, -1)
+	return &PendingSpend{
+		Hash:     p.Tx.TxHash(),
+		Tx:       p.Tx,
+		Account:  p.Account,
+		Proposed: p.Proposed,
+		Amount:   amount,
+		Fee:      fee,
+	}
+}
+
+// lockPendingSpendInputs locks the inputs of a pending spend.  The inputs are
+// not unlocked by UnlockOutpoint or ResetLockedOutpoints while the spend is
+// pending.  The caller must hold lockedOutpointMu.
+func (w *Wallet) lockPendingSpendInputs(tx *wire.MsgTx) {
+	for _, in := range tx.TxIn {
+		prev := &in.PreviousOutPoint
+		op := outpoint{prev.Hash, prev.Index}
+		w.lockedOutpoints[op] = struct{}{}
+		w.pendingOutpoints[op] = struct{}{}
+	}
+}
+
+// unlockPendingSpendInputs unlocks the inputs of a removed pending spend.
+func (w *Wallet) unlockPendingSpendInputs(tx *wire.MsgTx) {
+	w.lockedOutpointMu.Lock()
+	for _, in := range tx.TxIn {
+		prev := &in.PreviousOutPoint
+		op := outpoint{prev.Hash, prev.Index}
+		delete(w.pendingOutpoints, op)
+		w.unlockOutpoint(op)
+	}
+	w.lockedOutpointMu.Unlock()
+}
+
+// PendingSpends returns all spends awaiting approval, ordered by hash.
+func (w *Wallet) PendingSpends(ctx context.Context) ([]PendingSpend, error) {
+	const op errors.Op = "wallet.PendingSpends"
+	var spends []PendingSpend
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ps, err := udb.PendingSpends(dbtx)
+		if err != nil {
+			return err
+		}
+		spends = make([]PendingSpend, 0, len(ps))
+		for _, p := range ps {
+			spends = append(spends, *w.makePendingSpend(dbtx, p))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return spends, nil
+}
+
+// ApprovePendingSpend signs and publishes a pending spend.  The wallet must
+// be unlocked, and any spend approver configured by SetSpendApprover must
+// also approve the spend.  The spend is removed once the transaction is
+// published, and remains pending if signing or publishing fails.
+func (w *Wallet) ApprovePendingSpend(ctx context.Context, hash *chainhash.Hash) error {
+	const op errors.Op = "wallet.ApprovePendingSpend"
+
+	if err := w.checkVoteOnly(); err != nil {
+		return errors.E(op, err)
+	}
+
+	defer w.pendingSpendMu.Unlock()
+	w.pendingSpendMu.Lock()
+
+	var p *udb.PendingSpend
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		p, err = udb.FetchPendingSpend(dbtx, hash)
+		return err
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	tx := p.Tx.Copy()
+	sigErrs, err := w.signTransaction(ctx, tx, txscript.SigHashAll, nil, nil, nil,
+		signPendingSpend)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if len(sigErrs) != 0 {
+		if errors.Is(sigErrs[0].Error, errors.Locked) {
+			return errors.E(op, sigErrs[0].Error)
+		}
+		return errors.E(op, errors.Errorf("unable to sign input %d: %v",
+			sigErrs[0].InputIndex, sigErrs[0].Error))
+	}
+
+	a := &authorTx{atx: &txauthor.AuthoredTx{Tx: tx}}
+	if err := w.recordAuthoredTx(ctx, op, a); err != nil {
+		return err
+	}
+	if err := w.publishAndWatch(ctx, op, nil, tx, a.watch); err != nil {
+		return err
+	}
+
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.DeletePendingSpend(dbtx, hash)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	w.unlockPendingSpendInputs(tx)
+	log.Infof("Approved and published pending spend %v", hash)
+	return nil
+}
+
+// RejectPendingSpend removes a pending spend without signing it and unlocks
+// its inputs.
+func (w *Wallet) RejectPendingSpend(ctx context.Context, hash *chainhash.Hash) error {
+	const op errors.Op = "wallet.RejectPendingSpend"
+
+	defer w.pendingSpendMu.Unlock()
+	w.pendingSpendMu.Lock()
+
+	var p *udb.PendingSpend
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		var err error
+		p, err = udb.FetchPendingSpend(dbtx, hash)
+		if err != nil {
+			return err
+		}
+		return udb.DeletePendingSpend(dbtx, hash)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	w.unlockPendingSpendInputs(p.Tx)
+	log.Infof("Rejected pending spend %v", hash)
+	return nil
+}
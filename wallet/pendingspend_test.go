@@ -0,0 +1,163 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestPendingSpends(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+	w.SetNetworkBackend(mockNetwork{})
+	w.RequirePendingSpends(true)
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, pkScript := addr.PaymentScript()
+	fund := wire.NewMsgTx()
+	fund.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, 0, nil))
+	fund.AddTxOut(&wire.TxOut{Value: 10e8, Version: version, PkScript: pkScript})
+	if err := w.AddTransaction(ctx, fund, &w.chainParams.GenesisHash); err != nil {
+		t.Fatal(err)
+	}
+
+	// Spends are proposed while the wallet is locked.
+	propose := func() (*PendingSpend, error) {
+		out := &wire.TxOut{Value: 1e8, PkScript: []byte{0x51}}
+		return w.ProposeSpend(ctx, []*wire.TxOut{out}, 0, 0, 0)
+	}
+	p, err := propose()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Amount != 1e8 || p.Fee <= 0 {
+		t.Errorf("pending spend amount %v fee %v", p.Amount, p.Fee)
+	}
+	fundHash := fund.TxHash()
+	if !w.LockedOutpoint(&fundHash, 0) {
+		t.Error("pending spend input is not locked")
+	}
+	// Pending spend inputs are not unlocked other than by removing the
+	// spend.
+	w.UnlockOutpoint(&fundHash, 0)
+	w.ResetLockedOutpoints()
+	if !w.LockedOutpoint(&fundHash, 0) {
+		t.Error("pending spend input was unlocked")
+	}
+	// The only output is locked by the pending spend.
+	if _, err := propose(); !errors.Is(err, errors.InsufficientBalance) {
+		t.Errorf("spent locked output: %v", err)
+	}
+
+	spends, err := w.PendingSpends(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(spends) != 1 || spends[0].Hash != p.Hash {
+		t.Fatalf("pending spends %v, want %v", spends, p.Hash)
+	}
+
+	if err := w.RejectPendingSpend(ctx, &p.Hash); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.RejectPendingSpend(ctx, &p.Hash); !errors.Is(err, errors.NotExist) {
+		t.Errorf("rejected spend twice: %v", err)
+	}
+	if w.LockedOutpoint(&fundHash, 0) {
+		t.Error("rejected spend input remains locked")
+	}
+
+	p, err = propose()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.ApprovePendingSpend(ctx, &p.Hash); !errors.Is(err, errors.Locked) {
+		t.Errorf("approved spend with locked wallet: %v", err)
+	}
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Spends which are not proposed are refused.
+	fund2 := wire.NewMsgTx()
+	fund2.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 2}, 0, nil))
+	fund2.AddTxOut(&wire.TxOut{Value: 10e8, Version: version, PkScript: pkScript})
+	if err := w.AddTransaction(ctx, fund2, &w.chainParams.GenesisHash); err != nil {
+		t.Fatal(err)
+	}
+	out := &wire.TxOut{Value: 1e8, PkScript: []byte{0x51}}
+	if _, err := w.SendOutputs(ctx, []*wire.TxOut{out}, 0, 0, 0); !errors.Is(err, errors.Permission) {
+		t.Errorf("sent without proposing: %v", err)
+	}
+
+	// Payments to imported keys, which need not be controlled by the
+	// approver, are not internal to the wallet.
+	priv, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wif, err := dcrutil.NewWIF(priv.Serialize(), w.chainParams.PrivateKeyID,
+		dcrec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	importedStr, _, err := w.ImportPrivateKey(ctx, wif, NoRescan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	imported, err := stdaddr.DecodeAddress(importedStr, w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, pkScript = imported.PaymentScript()
+	out = &wire.TxOut{Value: 1e8, Version: version, PkScript: pkScript}
+	if _, err := w.SendOutputs(ctx, []*wire.TxOut{out}, 0, 0, 0); !errors.Is(err, errors.Permission) {
+		t.Errorf("sent to imported key without proposing: %v", err)
+	}
+	// Payments between wallet accounts need not be proposed.
+	owned, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, pkScript = owned.PaymentScript()
+	out = &wire.TxOut{Value: 1e8, Version: version, PkScript: pkScript}
+	if _, err := w.SendOutputs(ctx, []*wire.TxOut{out}, 0, 0, 0); err != nil {
+		t.Errorf("payment to wallet account: %v", err)
+	}
+	raw := p.Tx.Copy()
+	if _, err := w.SignTransaction(ctx, raw, txscript.SigHashAll, nil, nil, nil); !errors.Is(err, errors.Permission) {
+		t.Errorf("signed raw spend without proposing: %v", err)
+	}
+
+	if err := w.ApprovePendingSpend(ctx, &p.Hash); err != nil {
+		t.Fatal(err)
+	}
+	spends, err = w.PendingSpends(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(spends) != 0 {
+		t.Error("approved spend remains pending")
+	}
+	if _, _, _, err := w.TransactionSummary(ctx, &p.Hash); err != nil {
+		t.Errorf("approved transaction was not recorded: %v", err)
+	}
+	if w.LockedOutpoint(&fundHash, 0) {
+		t.Error("approved spend input remains locked")
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/wallettest"
+)
+
+func TestPregenerateAddresses(t *testing.T) {
+	ctx := context.Background()
+	h := wallettest.New(t, &wallettest.Config{GapLimit: 5})
+	w := h.Wallet
+
+	if _, err := w.PregenerateAddresses(ctx, 0, udb.ExternalBranch, 0); !errors.Is(err, errors.Invalid) {
+		t.Errorf("pregenerated zero addresses: %v", err)
+	}
+	addrs, err := w.PregenerateAddresses(ctx, 0, udb.ExternalBranch, 12)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(addrs) != 12 {
+		t.Fatalf("pregenerated %d addresses", len(addrs))
+	}
+	if _, err := w.NewExternalAddress(ctx, 0); !errors.Is(err, errors.Policy) {
+		t.Fatalf("returned address beyond the gap limit: %v", err)
+	}
+
+	// Addresses beyond the gap limit are watched for payments.
+	last := addrs[len(addrs)-1]
+	if _, err := h.Chain.MineBlock(ctx, h.Chain.FundingTx(last, 1e8)); err != nil {
+		t.Fatal(err)
+	}
+	received, err := w.TotalReceivedForAddr(ctx, last, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if received != 1e8 {
+		t.Fatalf("pregenerated address received %v", received)
+	}
+
+	// The next address follows the pregenerated addresses.
+	next, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := w.AddressAtIdx(ctx, 0, udb.ExternalBranch, 12)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next.String() != expected.String() {
+		t.Fatalf("next address %v, expected child 12 %v", next, expected)
+	}
+}
+
+func TestWatchingOnlyGapLimit(t *testing.T) {
+	ctx := context.Background()
+	h := wallettest.New(t, nil)
+	xpub, err := h.Wallet.AccountXpub(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	open := func(watchingOnlyGapLimit uint32) *wallet.Wallet {
+		t.Helper()
+		db, err := wallet.CreateDB("bdb", filepath.Join(t.TempDir(), "wallet.db"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { db.Close() })
+		pubPass := []byte(wallet.InsecurePubPassphrase)
+		params := h.Wallet.ChainParams()
+		err = wallet.CreateWatchOnly(ctx, db, xpub.String(), pubPass, params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w, err := wallet.Open(ctx, &wallet.Config{
+			DB:                   db,
+			PubPassphrase:        pubPass,
+			GapLimit:             wallet.DefaultGapLimit,
+			WatchingOnlyGapLimit: watchingOnlyGapLimit,
+			AccountGapLimit:      wallet.DefaultAccountGapLimit,
+			RelayFee:             h.Wallet.RelayFee(),
+			Params:               params,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return w
+	}
+
+	if w := open(500); w.GapLimit() != 500 {
+		t.Errorf("watching-only wallet gap limit %d", w.GapLimit())
+	}
+	if w := open(5); w.GapLimit() != wallet.DefaultGapLimit {
+		t.Errorf("smaller watching-only gap limit replaced gap limit: %d", w.GapLimit())
+	}
+}
@@ -0,0 +1,16 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+//go:build unix
+
+package wallet
+
+import "golang.org/x/sys/unix"
+
+// processRunning returns whether a process with the PID is running on this
+// host.
+func processRunning(pid uint32) bool {
+	err := unix.Kill(int(pid), 0)
+	return err == nil || err == unix.EPERM
+}
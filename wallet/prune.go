@@ -0,0 +1,183 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/wire"
+)
+
+// MinTxPruneDepth is the minimum number of confirmations of pruned
+// transactions.  Transactions must be buried deep enough that the blocks
+// mining them are never expected to be reorganized out of the main chain.
+const MinTxPruneDepth = 512
+
+// txPruneInterval is the number of blocks between automatic transaction
+// pruning when a prune depth is configured.
+const txPruneInterval = 144
+
+// sidechainPruneInterval is the number of blocks between automatic pruning of
+// sidechain headers and compact filters when a prune depth is configured.
+const sidechainPruneInterval = 144
+
+// PruneTransactions removes the serialized transactions of regular
+// transactions with more than depth confirmations when all wallet outputs
+// they create have been spent.  Balances and the spent state of wallet outputs
+// are unaffected, but pruned transactions are reported without their
+// serialized transactions until they are refetched with
+// FetchPrunedTransaction.  The number of pruned transactions is returned.
+func (w *Wallet) PruneTransactions(ctx context.Context, depth int32) (int, error) {
+	const op errors.Op = "wallet.PruneTransactions"
+	if depth < MinTxPruneDepth {
+		err := errors.Errorf("prune depth must be at least %d", MinTxPruneDepth)
+		return 0, errors.E(op, errors.Invalid, err)
+	}
+
+	var tipHeight int32
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		_, tipHeight = w.txStore.MainChainTip(dbtx)
+		return nil
+	})
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	n, err := w.pruneTransactions(ctx, tipHeight-depth)
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return n, nil
+}
+
+// pruneTransactions prunes transactions mined at or below maxHeight.  The
+// prunable transactions are found by a read-only scan, so the database update
+// only writes the pruned records and does not hold the write lock while every
+// transaction record is read.
+func (w *Wallet) pruneTransactions(ctx context.Context, maxHeight int32) (int, error) {
+	var prunable []udb.PrunableTx
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		prunable, err = w.txStore.PrunableTransactions(dbtx, maxHeight)
+		return err
+	})
+	if err != nil || len(prunable) == 0 {
+		return 0, err
+	}
+	var n int
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		var err error
+		n, err = w.txStore.PruneTransactions(dbtx, prunable)
+		return err
+	})
+	return n, err
+}
+
+// SidechainRecords returns the number of saved headers and compact filters of
+// blocks which are not in the main chain.
+func (w *Wallet) SidechainRecords(ctx context.Context) (*udb.SidechainRecords, error) {
+	const op errors.Op = "wallet.SidechainRecords"
+	var r *udb.SidechainRecords
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		r, err = w.txStore.SidechainRecords(dbtx)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return r, nil
+}
+
+// PruneSidechains removes the saved headers and compact filters of blocks
+// which are not in the main chain and are at least depth blocks below the
+// main chain tip.  Blocks which are reorganized back into the main chain have
+// their headers and filters saved again, so no data required by the wallet is
+// lost.  The numbers of removed records are returned.
+func (w *Wallet) PruneSidechains(ctx context.Context, depth int32) (*udb.SidechainRecords, error) {
+	const op errors.Op = "wallet.PruneSidechains"
+	if depth < 0 {
+		return nil, errors.E(op, errors.Invalid, "negative prune depth")
+	}
+
+	var r *udb.SidechainRecords
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		_, tipHeight := w.txStore.MainChainTip(dbtx)
+		var err error
+		r, err = w.txStore.PruneSidechains(dbtx, tipHeight-depth)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return r, nil
+}
+
+// FetchPrunedTransaction refetches a pruned transaction from the block it was
+// mined in and restores it to the transaction store.
+func (w *Wallet) FetchPrunedTransaction(ctx context.Context, n NetworkBackend, txHash *chainhash.Hash) (*wire.MsgTx, error) {
+	const op errors.Op = "wallet.FetchPrunedTransaction"
+
+	var blockHash chainhash.Hash
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		block, err := w.txStore.PrunedTxBlock(txmgrNs, txHash)
+		if err != nil {
+			return err
+		}
+		blockHash = block.Hash
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	blocks, err := n.Blocks(ctx, []*chainhash.Hash{&blockHash})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if len(blocks) != 1 || blocks[0].BlockHash() != blockHash {
+		return nil, errors.E(op, errors.Protocol, "network backend did not "+
+			"return the requested block")
+	}
+	var tx *wire.MsgTx
+	for _, txs := range [][]*wire.MsgTx{blocks[0].Transactions, blocks[0].STransactions} {
+		for _, t := range txs {
+			if t.TxHash() == *txHash {
+				tx = t
+				break
+			}
+		}
+	}
+	if tx == nil {
+		err := errors.Errorf("block %v does not mine transaction %v",
+			&blockHash, txHash)
+		return nil, errors.E(op, errors.Protocol, err)
+	}
+
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return w.txStore.RestorePrunedTransaction(dbtx, tx, &blockHash)
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return tx, nil
+}
+
+// prunedTx returns whether a transaction has been pruned from the transaction
+// store.
+func (w *Wallet) prunedTx(ctx context.Context, txHash *chainhash.Hash) bool {
+	var pruned bool
+	_ = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		_, err := w.txStore.PrunedTxBlock(txmgrNs, txHash)
+		pruned = err == nil
+		return nil
+	})
+	return pruned
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestPruneTransactions(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	if _, err := w.PruneTransactions(ctx, MinTxPruneDepth-1); !errors.Is(err, errors.Invalid) {
+		t.Fatalf("expected Invalid error for shallow prune depth, got %v", err)
+	}
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, script := addr.PaymentScript()
+	funding := wire.NewMsgTx()
+	funding.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, 0, nil))
+	funding.AddTxOut(&wire.TxOut{Value: 1e8, Version: version, PkScript: script})
+	unspent := wire.NewMsgTx()
+	unspent.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, 0, nil))
+	unspent.AddTxOut(&wire.TxOut{Value: 2e8, Version: version, PkScript: script})
+	fundingHash, unspentHash := funding.TxHash(), unspent.TxHash()
+	spend := wire.NewMsgTx()
+	spend.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&fundingHash, 0, 0), 1e8, nil))
+	spend.AddTxOut(&wire.TxOut{Value: 1e8 - 1e4, PkScript: []byte{0x6a}})
+	genesis := &w.chainParams.GenesisHash
+	for _, tx := range []*wire.MsgTx{funding, unspent, spend} {
+		if err := w.AddTransaction(ctx, tx, genesis); err != nil {
+			t.Fatal(err)
+		}
+	}
+	balances, err := w.AccountBalance(ctx, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pruned, err := w.pruneTransactions(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The funding and spending transactions are pruned, but the transaction
+	// with an unspent output is kept.
+	if pruned != 2 {
+		t.Fatalf("expected 2 pruned transactions, got %d", pruned)
+	}
+	if !w.prunedTx(ctx, &fundingHash) || w.prunedTx(ctx, &unspentHash) {
+		t.Fatal("unexpected pruned transactions")
+	}
+	_, err = UnstableAPI(w).TxDetails(ctx, &fundingHash)
+	if !errors.Is(err, errors.NotExist) {
+		t.Fatalf("expected NotExist error for pruned transaction, got %v", err)
+	}
+
+	// Pruned transactions are still reported by RangeTransactions with
+	// their credits and debits.
+	prunedDetails := make(map[chainhash.Hash]udb.TxDetails)
+	err = UnstableAPI(w).RangeTransactions(ctx, 0, -1, func(details []udb.TxDetails) (bool, error) {
+		for _, d := range details {
+			if d.Pruned {
+				prunedDetails[d.Hash] = d
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(prunedDetails) != 2 {
+		t.Fatalf("expected 2 pruned transaction details, got %d", len(prunedDetails))
+	}
+	if d := prunedDetails[fundingHash]; len(d.Credits) != 1 || d.Credits[0].Amount != 1e8 {
+		t.Fatalf("pruned transaction credits not reported: %+v", d.Credits)
+	}
+	if d := prunedDetails[spend.TxHash()]; len(d.Debits) != 1 || d.Debits[0].Amount != 1e8 {
+		t.Fatalf("pruned transaction debits not reported: %+v", d.Debits)
+	}
+	if _, err := w.ListAllTransactions(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	newBalances, err := w.AccountBalance(ctx, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if newBalances != balances {
+		t.Fatalf("balances changed by pruning: %+v != %+v", newBalances, balances)
+	}
+
+	restore := func(blockHash *chainhash.Hash) error {
+		return walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+			return w.txStore.RestorePrunedTransaction(dbtx, funding, blockHash)
+		})
+	}
+	if err := restore(&chainhash.Hash{1}); !errors.Is(err, errors.Invalid) {
+		t.Fatalf("expected Invalid error restoring from wrong block, got %v", err)
+	}
+	if err := restore(genesis); err != nil {
+		t.Fatal(err)
+	}
+	details, err := UnstableAPI(w).TxDetails(ctx, &fundingHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if details.TxRecord.Hash != fundingHash || w.prunedTx(ctx, &fundingHash) {
+		t.Fatal("pruned transaction was not restored")
+	}
+}
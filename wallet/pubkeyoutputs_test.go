@@ -0,0 +1,89 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet_test
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/wallet"
+	"decred.org/dcrwallet/v5/wallet/wallettest"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestPubKeyOutputs(t *testing.T) {
+	ctx := context.Background()
+	h := wallettest.New(t, nil)
+	w := h.Wallet
+	other := wallettest.New(t, &wallettest.Config{Params: w.ChainParams()})
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ka, err := w.KnownAddress(ctx, addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKeyAddr, err := stdaddr.NewAddressPubKeyEcdsaSecp256k1V0Raw(
+		ka.(wallet.PubKeyHashAddress).PubKey(), w.ChainParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// An output paying the bare public key is watched and credited.
+	fund := h.Chain.FundingTx(pubKeyAddr, 10e8)
+	fundBlock, err := h.Chain.MineBlock(ctx, fund)
+	if err != nil {
+		t.Fatal(err)
+	}
+	balance := func() wallet.Balances {
+		t.Helper()
+		b, err := w.AccountBalance(ctx, 0, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+	if b := balance(); b.Spendable != 10e8 {
+		t.Fatalf("spendable balance %v, want 10 DCR", b.Spendable)
+	}
+	matches, err := w.MatchCFilters(ctx, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matches) != 1 || matches[0].Hash != fundBlock.BlockHash() {
+		t.Fatalf("matches %v, want block %v", matches, fundBlock.BlockHash())
+	}
+
+	// The output is selected and signed when spending.
+	if err := w.Unlock(ctx, wallettest.PrivatePassphrase, nil); err != nil {
+		t.Fatal(err)
+	}
+	otherAddr, err := other.Wallet.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, script := otherAddr.PaymentScript()
+	out := &wire.TxOut{Value: 1e8, Version: version, PkScript: script}
+	if _, err := w.SendOutputs(ctx, []*wire.TxOut{out}, 0, 0, 1); err != nil {
+		t.Fatal(err)
+	}
+	published := h.Chain.Published()
+	if len(published) != 1 {
+		t.Fatalf("%d published transactions", len(published))
+	}
+	spend := published[0]
+	if len(spend.TxIn) != 1 || spend.TxIn[0].PreviousOutPoint.Hash != fund.TxHash() {
+		t.Fatalf("spend does not redeem the P2PK output")
+	}
+	if _, err := h.Chain.MineMempool(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if b := balance(); b.Spendable <= 8e8 || b.Spendable >= 9e8 {
+		t.Fatalf("spendable balance %v after send", b.Spendable)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+// ReadOnly returns whether the wallet was opened from a read-only database,
+// such as the snapshot served by a reporting replica.  Every operation which
+// writes to the database of a read-only wallet errors with errors.Permission.
+func (w *Wallet) ReadOnly() bool {
+	return w.readOnly
+}
+
+// ctxWriter is an io.Writer which errors once its context is cancelled.
+type ctxWriter struct {
+	ctx context.Context
+	w   io.Writer
+}
+
+func (w *ctxWriter) Write(p []byte) (int, error) {
+	if err := w.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return w.w.Write(p)
+}
+
+// WriteSnapshot writes a copy of the wallet database to path, which replicas
+// may open read-only to serve reporting RPCs without impacting this wallet.
+// The database is streamed from a single read transaction, which does not
+// block writers, to a temporary file that is renamed so that replicas never
+// observe a partially written snapshot.  Replicas which have the previous
+// snapshot open continue to read it until reopening path.
+func (w *Wallet) WriteSnapshot(ctx context.Context, path string) error {
+	const op errors.Op = "wallet.WriteSnapshot"
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		_, err := dbtx.WriteTo(&ctxWriter{ctx, f})
+		return err
+	})
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), path)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		if ctx.Err() != nil {
+			// Writer errors are not wrapped by bolt.
+			return errors.E(op, ctx.Err())
+		}
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
+
+// RunSnapshots writes a database snapshot to path immediately and after every
+// interval until the context is cancelled.  Failed writes are logged and
+// retried at the next interval.
+func (w *Wallet) RunSnapshots(ctx context.Context, path string, interval time.Duration) error {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		err := w.WriteSnapshot(ctx, path)
+		if err != nil && ctx.Err() == nil {
+			log.Errorf("Failed to write wallet snapshot %s: %v", path, err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
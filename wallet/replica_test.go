@@ -0,0 +1,74 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+)
+
+func TestReadOnlySnapshot(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	cfg.AccountGapLimit = DefaultAccountGapLimit
+	w, teardown := testWallet(ctx, t, &cfg, nil)
+	defer teardown()
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	acct, err := w.NextAccount(ctx, "reporting")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), "wallet.db.snapshot")
+	if err := w.WriteSnapshot(ctx, path); err != nil {
+		t.Fatal(err)
+	}
+	db, err := OpenDB("bdb", path, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	cfg.DB = db
+	cfg.ReadOnly = true
+	replica, err := Open(ctx, &cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !replica.ReadOnly() || !slices.Contains(replica.Capabilities(), CapabilityReadOnly) {
+		t.Errorf("replica does not report read-only mode")
+	}
+	if w.ReadOnly() {
+		t.Errorf("primary reports read-only mode")
+	}
+	name, err := replica.AccountName(ctx, acct)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "reporting" {
+		t.Errorf("replica account %d is named %q", acct, name)
+	}
+	if _, err := replica.NextAccount(ctx, "other"); !errors.Is(err, errors.Permission) {
+		t.Errorf("replica wrote new account: %v", err)
+	}
+
+	// Cancelled snapshots are not written.
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	path = filepath.Join(t.TempDir(), "cancelled.snapshot")
+	if err := w.WriteSnapshot(cancelled, path); !errors.Is(err, context.Canceled) {
+		t.Errorf("cancelled snapshot: %v", err)
+	}
+	if entries, _ := os.ReadDir(filepath.Dir(path)); len(entries) != 0 {
+		t.Errorf("cancelled snapshot left %d files", len(entries))
+	}
+}
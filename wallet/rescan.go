@@ -188,6 +188,7 @@ func (w *Wallet) saveRescanned(ctx context.Context, dbtx walletdb.ReadWriteTx,
 		return err
 	}
 
+	var used []udb.ManagedAddress
 	for _, tx := range txs {
 		if logTxs {
 			w.logRescannedTx(txmgrNs, blockMeta.Height, tx)
@@ -197,12 +198,12 @@ func (w *Wallet) saveRescanned(ctx context.Context, dbtx walletdb.ReadWriteTx,
 		if err != nil {
 			return err
 		}
-		_, err = w.processTransactionRecord(ctx, dbtx, rec, header, &blockMeta)
+		_, err = w.recordTransaction(ctx, dbtx, rec, header, &blockMeta, &used)
 		if err != nil {
 			return err
 		}
 	}
-	return nil
+	return w.markUsedAddresses(op, dbtx, used, header)
 }
 
 // rescan synchronously scans over all blocks on the main chain starting at
@@ -242,6 +243,7 @@ func (w *Wallet) rescan(ctx context.Context, n NetworkBackend,
 			break
 		}
 
+		first := height
 		through := height + int32(len(rescanBlocks)) - 1
 		// Genesis block is not rescanned
 		if height == 0 {
@@ -338,7 +340,11 @@ func (w *Wallet) rescan(ctx context.Context, n NetworkBackend,
 			return err
 		}
 		err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
-			return w.txStore.UpdateProcessedTxsBlockMarker(dbtx, &rescanBlocks[len(rescanBlocks)-1])
+			err := w.txStore.UpdateProcessedTxsBlockMarker(dbtx, &rescanBlocks[len(rescanBlocks)-1])
+			if err != nil {
+				return err
+			}
+			return w.advanceImportRescan(dbtx, first, through)
 		})
 		if err != nil {
 			return err
@@ -458,7 +464,8 @@ func (w *Wallet) mainChainAncestor(dbtx walletdb.ReadTx, hash *chainhash.Hash) (
 }
 
 // RescanPoint returns the block hash at which a rescan should begin
-// (inclusive), or nil when no rescan is necessary.
+// (inclusive), or nil when no rescan is necessary.  The rescan point is never
+// after the scan height of an import whose rescan has not completed.
 func (w *Wallet) RescanPoint(ctx context.Context) (*chainhash.Hash, error) {
 	const op errors.Op = "wallet.RescanPoint"
 	var rp *chainhash.Hash
@@ -480,16 +487,27 @@ func (w *Wallet) rescanPoint(dbtx walletdb.ReadTx) (*chainhash.Hash, error) {
 	if err != nil {
 		return nil, err
 	}
-	if tipHash, _ := w.txStore.MainChainTip(dbtx); *r == tipHash {
-		return nil, nil
+	tipHash, tipHeight := w.txStore.MainChainTip(dbtx)
+	rescanHeight := int32(-1)
+	if *r != tipHash {
+		// r is not the tip, so a child block must exist in the main chain.
+		h, err := w.txStore.GetBlockHeader(dbtx, r)
+		if err != nil {
+			log.Info(err)
+			return nil, err
+		}
+		rescanHeight = int32(h.Height) + 1
 	}
-	// r is not the tip, so a child block must exist in the main chain.
-	h, err := w.txStore.GetBlockHeader(dbtx, r)
-	if err != nil {
-		log.Info(err)
-		return nil, err
+	// Imports may require rescanning blocks before the marker.
+	importHeight := udb.FetchImportRescanHeight(dbtx)
+	if importHeight >= 0 && importHeight <= tipHeight &&
+		(rescanHeight == -1 || importHeight < rescanHeight) {
+		rescanHeight = importHeight
+	}
+	if rescanHeight == -1 {
+		return nil, nil
 	}
-	rescanPoint, err := w.txStore.GetMainChainBlockHashForHeight(ns, int32(h.Height)+1)
+	rescanPoint, err := w.txStore.GetMainChainBlockHashForHeight(ns, rescanHeight)
 	if err != nil {
 		log.Info(err)
 		return nil, err
@@ -0,0 +1,232 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	blockchain "github.com/decred/dcrd/blockchain/standalone/v2"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/txscript/v4/stdscript"
+	"github.com/decred/dcrd/wire"
+)
+
+// ReserveOutput is an unspent output included in a reserve snapshot, along
+// with a signature by the key controlling it.
+type ReserveOutput struct {
+	OutPoint  wire.OutPoint
+	Amount    dcrutil.Amount
+	Version   uint16
+	PkScript  []byte
+	Address   string
+	Signature []byte
+}
+
+// leafHash returns the merkle leaf committing to the output.
+func (o *ReserveOutput) leafHash() chainhash.Hash {
+	b := make([]byte, 0, 32+4+1+8+2+len(o.PkScript))
+	b = append(b, o.OutPoint.Hash[:]...)
+	b = binary.LittleEndian.AppendUint32(b, o.OutPoint.Index)
+	b = append(b, byte(o.OutPoint.Tree))
+	b = binary.LittleEndian.AppendUint64(b, uint64(o.Amount))
+	b = binary.LittleEndian.AppendUint16(b, o.Version)
+	b = append(b, o.PkScript...)
+	return chainhash.HashH(b)
+}
+
+// ReserveSnapshot is a proof of the wallet's reserves at a main chain block.
+// Every unspent output paying to a public key hash is committed to by the
+// merkle root of the outputs, and is signed by its controlling key over a
+// message binding the block, the merkle root, and the output.
+type ReserveSnapshot struct {
+	BlockHash   chainhash.Hash
+	BlockHeight int32
+	Message     string
+	MerkleRoot  chainhash.Hash
+	Total       dcrutil.Amount
+	Outputs     []ReserveOutput
+}
+
+// reserveProofMessage returns the message signed by the key controlling a
+// reserve output.
+func (s *ReserveSnapshot) reserveProofMessage(o *ReserveOutput) string {
+	return fmt.Sprintf("Decred reserve snapshot\nblock: %v\nroot: %v\n"+
+		"output: %v\nmessage: %s", &s.BlockHash, &s.MerkleRoot,
+		&o.OutPoint, s.Message)
+}
+
+// merkleRoot returns the merkle root of the snapshot outputs.
+func (s *ReserveSnapshot) merkleRoot() chainhash.Hash {
+	leaves := make([]chainhash.Hash, len(s.Outputs))
+	for i := range s.Outputs {
+		leaves[i] = s.Outputs[i].leafHash()
+	}
+	return blockchain.CalcMerkleRootInPlace(leaves)
+}
+
+// reserveAddress returns the public key hash address paid by an output
+// script, or nil when the output is not provably controlled by a single key.
+func reserveAddress(version uint16, pkScript []byte, params stdaddr.AddressParams) stdaddr.Address {
+	_, addrs := stdscript.ExtractAddrs(version, pkScript, params)
+	if len(addrs) != 1 {
+		return nil
+	}
+	addr, ok := addrs[0].(*stdaddr.AddressPubKeyHashEcdsaSecp256k1V0)
+	if !ok {
+		return nil
+	}
+	return addr
+}
+
+// ReserveSnapshot creates a signed snapshot of all mined unspent outputs
+// controlled by the wallet at the current main chain tip.  The message is
+// included in every signature, and may be used by verifiers to require the
+// freshness of a proof.  Outputs which are not paid to a public key hash
+// are not included.  The wallet must be unlocked.
+//
+// The outputs are read and signed in a single database transaction, so the
+// snapshot is consistent with the recorded block even when blocks are
+// connected concurrently.
+func (w *Wallet) ReserveSnapshot(ctx context.Context, message string) (*ReserveSnapshot, error) {
+	const op errors.Op = "wallet.ReserveSnapshot"
+
+	s := &ReserveSnapshot{Message: message}
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+
+		s.BlockHash, s.BlockHeight = w.txStore.MainChainTip(dbtx)
+		outputs, err := w.txStore.UnspentOutputs(dbtx)
+		if err != nil {
+			return err
+		}
+		var addrs []stdaddr.Address
+		for _, output := range outputs {
+			if output.Height < 0 || output.Height > s.BlockHeight {
+				continue
+			}
+			addr := reserveAddress(scriptVersionAssumed, output.PkScript, w.chainParams)
+			if addr == nil {
+				continue
+			}
+			s.Outputs = append(s.Outputs, ReserveOutput{
+				OutPoint: output.OutPoint,
+				Amount:   output.Amount,
+				Version:  scriptVersionAssumed,
+				PkScript: output.PkScript,
+				Address:  addr.String(),
+			})
+			addrs = append(addrs, addr)
+		}
+
+		// Order outputs by outpoint so snapshots of the same outputs
+		// always produce the same merkle root.
+		sort.Sort(reserveOutputsByOutPoint{s.Outputs, addrs})
+		s.MerkleRoot = s.merkleRoot()
+		for i := range s.Outputs {
+			o := &s.Outputs[i]
+			s.Total += o.Amount
+			privKey, done, err := w.manager.PrivateKey(addrmgrNs, addrs[i])
+			if err != nil {
+				return err
+			}
+			msgHash := signedMessageHash(s.reserveProofMessage(o))
+			o.Signature = ecdsa.SignCompact(privKey, msgHash, true)
+			done()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if len(s.Outputs) != 0 {
+		w.noteSigningActivity()
+	}
+	return s, nil
+}
+
+type reserveOutputsByOutPoint struct {
+	outputs []ReserveOutput
+	addrs   []stdaddr.Address
+}
+
+func (s reserveOutputsByOutPoint) Len() int { return len(s.outputs) }
+func (s reserveOutputsByOutPoint) Less(i, j int) bool {
+	a, b := &s.outputs[i].OutPoint, &s.outputs[j].OutPoint
+	if c := bytes.Compare(a.Hash[:], b.Hash[:]); c != 0 {
+		return c < 0
+	}
+	return a.Index < b.Index
+}
+func (s reserveOutputsByOutPoint) Swap(i, j int) {
+	s.outputs[i], s.outputs[j] = s.outputs[j], s.outputs[i]
+	s.addrs[i], s.addrs[j] = s.addrs[j], s.addrs[i]
+}
+
+// UnspentOutputFunc returns a currently unspent output from the blockchain.
+// It must return an error matching errors.NotExist if the output was spent
+// or never existed.
+type UnspentOutputFunc func(ctx context.Context, op *wire.OutPoint) (*wire.TxOut, error)
+
+// VerifyReserveSnapshot checks that every output of a reserve snapshot is
+// committed to by the merkle root and correctly signed by the key it pays,
+// and that each output remains unspent in the blockchain.  The total amount
+// of all verified outputs is returned.  Verification does not require a
+// wallet and may be performed by any party with access to the blockchain.
+func VerifyReserveSnapshot(ctx context.Context, s *ReserveSnapshot, params stdaddr.AddressParams,
+	unspentOutput UnspentOutputFunc) (dcrutil.Amount, error) {
+
+	const op errors.Op = "wallet.VerifyReserveSnapshot"
+
+	if root := s.merkleRoot(); root != s.MerkleRoot {
+		return 0, errors.E(op, errors.Invalid, errors.Errorf("merkle root "+
+			"%v does not commit to outputs (expected %v)", &s.MerkleRoot, &root))
+	}
+	var total dcrutil.Amount
+	seen := make(map[wire.OutPoint]struct{}, len(s.Outputs))
+	for i := range s.Outputs {
+		o := &s.Outputs[i]
+		if _, ok := seen[o.OutPoint]; ok {
+			return 0, errors.E(op, errors.Invalid, errors.Errorf("duplicate "+
+				"output %v", &o.OutPoint))
+		}
+		seen[o.OutPoint] = struct{}{}
+
+		addr := reserveAddress(o.Version, o.PkScript, params)
+		if addr == nil || addr.String() != o.Address {
+			return 0, errors.E(op, errors.Invalid, errors.Errorf("output %v "+
+				"does not pay to address %s", &o.OutPoint, o.Address))
+		}
+		ok, err := VerifyMessage(s.reserveProofMessage(o), addr, o.Signature, params)
+		if err != nil || !ok {
+			return 0, errors.E(op, errors.Invalid, errors.Errorf("invalid "+
+				"signature for output %v", &o.OutPoint))
+		}
+
+		txOut, err := unspentOutput(ctx, &o.OutPoint)
+		if err != nil {
+			return 0, errors.E(op, err)
+		}
+		if txOut.Value != int64(o.Amount) || txOut.Version != o.Version ||
+			!bytes.Equal(txOut.PkScript, o.PkScript) {
+			return 0, errors.E(op, errors.Invalid, errors.Errorf("output %v "+
+				"does not match the blockchain", &o.OutPoint))
+		}
+		total += o.Amount
+	}
+	if total != s.Total {
+		return 0, errors.E(op, errors.Invalid, errors.Errorf("snapshot total "+
+			"%v does not match output total %v", s.Total, total))
+	}
+	return total, nil
+}
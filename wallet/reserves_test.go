@@ -0,0 +1,91 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestReserveSnapshot(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Mine a transaction paying the wallet twice in the genesis block.
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, 0, nil))
+	for _, amount := range []int64{3e8, 5e8} {
+		addr, err := w.NewExternalAddress(ctx, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		version, pkScript := addr.PaymentScript()
+		tx.AddTxOut(&wire.TxOut{Value: amount, Version: version, PkScript: pkScript})
+	}
+	if err := w.AddTransaction(ctx, tx, &w.chainParams.GenesisHash); err != nil {
+		t.Fatal(err)
+	}
+
+	// Locked wallets can not sign the snapshot.
+	w.Lock()
+	if _, err := w.ReserveSnapshot(ctx, "audit 2025"); !errors.Is(err, errors.Locked) {
+		t.Fatalf("snapshot by locked wallet: %v", err)
+	}
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := w.ReserveSnapshot(ctx, "audit 2025")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s.Outputs) != 2 || s.Total != 8e8 || s.BlockHash != w.chainParams.GenesisHash {
+		t.Fatalf("unexpected snapshot %+v", s)
+	}
+	chain := make(map[wire.OutPoint]*wire.TxOut)
+	txHash := tx.TxHash()
+	for i, out := range tx.TxOut {
+		chain[wire.OutPoint{Hash: txHash, Index: uint32(i)}] = out
+	}
+	unspent := func(ctx context.Context, op *wire.OutPoint) (*wire.TxOut, error) {
+		out, ok := chain[*op]
+		if !ok {
+			return nil, errors.E(errors.NotExist, "spent")
+		}
+		return out, nil
+	}
+	total, err := VerifyReserveSnapshot(ctx, s, w.chainParams, unspent)
+	if err != nil || total != 8e8 {
+		t.Fatalf("verify: %v %v", total, err)
+	}
+
+	// Altered messages invalidate the signatures.
+	s.Message = "audit 2024"
+	if _, err := VerifyReserveSnapshot(ctx, s, w.chainParams, unspent); !errors.Is(err, errors.Invalid) {
+		t.Errorf("altered message verified: %v", err)
+	}
+	s.Message = "audit 2025"
+
+	// Inflated amounts break the merkle commitment.
+	s.Outputs[0].Amount += 1e8
+	if _, err := VerifyReserveSnapshot(ctx, s, w.chainParams, unspent); !errors.Is(err, errors.Invalid) {
+		t.Errorf("inflated amount verified: %v", err)
+	}
+	s.Outputs[0].Amount -= 1e8
+
+	// Spent outputs fail verification.
+	delete(chain, s.Outputs[1].OutPoint)
+	if _, err := VerifyReserveSnapshot(ctx, s, w.chainParams, unspent); !errors.Is(err, errors.NotExist) {
+		t.Errorf("spent output verified: %v", err)
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"encoding/hex"
+	"sort"
+	"strings"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"decred.org/dcrwallet/v5/walletseed"
+	"github.com/decred/dcrd/crypto/rand"
+)
+
+const (
+	// seedChallengeLifetime is the duration after which an unanswered seed
+	// verification challenge expires.
+	seedChallengeLifetime = 10 * time.Minute
+
+	// minSeedChallengeWords is the fewest words asked for by a seed
+	// verification challenge.  Challenges only report whether all words
+	// were correct, so requesting several words prevents discovering
+	// individual words by guessing.
+	minSeedChallengeWords = 2
+
+	// maxSeedChallengeFailures is the number of consecutive failed
+	// verifications after which challenges are neither created nor
+	// answered until seedChallengeCooldown has passed.
+	maxSeedChallengeFailures = 3
+	seedChallengeCooldown    = time.Minute
+
+	// maxSeedChallenges is the most unexpired challenges which may be
+	// outstanding at once.  Limiting open challenges prevents requesting
+	// many challenges before answering any of them to avoid the cooldown.
+	maxSeedChallenges = 3
+)
+
+// SeedChallenge asks the user for the words at some positions of the wallet's
+// recovery phrase.  Positions are 1-based.
+type SeedChallenge struct {
+	ID        string
+	Positions []int
+	Expires   time.Time
+}
+
+type seedChallenge struct {
+	positions []int
+	words     []string
+	expires   time.Time
+}
+
+// RetainSeedForVerification saves the wallet seed, encrypted by the wallet's
+// private passphrase, so that the user's backup of the recovery phrase may be
+// verified with seed challenges after the wallet is created.  The seed must
+// derive the wallet's default account, and the wallet must be unlocked.  The
+// seed is retained until a challenge is answered correctly or
+// ForgetVerificationSeed is called.
+func (w *Wallet) RetainSeedForVerification(ctx context.Context, seed []byte) error {
+	const op errors.Op = "wallet.RetainSeedForVerification"
+
+	_, _, legacyAcct, slip0044Acct, err := udb.HDKeysFromSeed(seed, w.chainParams)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	legacyXpub := legacyAcct.Neuter().String()
+	slip0044Xpub := slip0044Acct.Neuter().String()
+	legacyAcct.Zero()
+	slip0044Acct.Zero()
+
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		xpub, err := w.manager.AccountExtendedPubKey(dbtx, 0)
+		if err != nil {
+			return err
+		}
+		if s := xpub.String(); s != legacyXpub && s != slip0044Xpub {
+			return errors.E(errors.Seed, "seed does not derive the wallet's keys")
+		}
+		return w.manager.RetainVerificationSeed(ns, seed)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// VerificationSeedRetained returns whether the wallet seed is retained for
+// backup verification.
+func (w *Wallet) VerificationSeedRetained(ctx context.Context) (bool, error) {
+	const op errors.Op = "wallet.VerificationSeedRetained"
+	var retained bool
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		retained = w.manager.HasVerificationSeed(ns)
+		return nil
+	})
+	if err != nil {
+		return false, errors.E(op, err)
+	}
+	return retained, nil
+}
+
+// ForgetVerificationSeed removes any seed retained for backup verification and
+// cancels all outstanding challenges.
+func (w *Wallet) ForgetVerificationSeed(ctx context.Context) error {
+	const op errors.Op = "wallet.ForgetVerificationSeed"
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		return w.manager.ForgetVerificationSeed(ns)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	w.seedChallengesMu.Lock()
+	w.seedChallenges = nil
+	w.seedChallengesMu.Unlock()
+	return nil
+}
+
+// NewSeedChallenge creates a challenge asking for the words at n random
+// positions of the wallet's recovery phrase.  The wallet seed must be retained
+// for verification and the wallet must be unlocked.  Only the challenged
+// words are held by the challenge, and no words are revealed by it.
+func (w *Wallet) NewSeedChallenge(ctx context.Context, n int) (*SeedChallenge, error) {
+	const op errors.Op = "wallet.NewSeedChallenge"
+
+	w.seedChallengesMu.Lock()
+	defer w.seedChallengesMu.Unlock()
+
+	if err := w.seedChallengeCooldown(); err != nil {
+		return nil, errors.E(op, err)
+	}
+	now := time.Now()
+	for k, old := range w.seedChallenges {
+		if now.After(old.expires) {
+			delete(w.seedChallenges, k)
+		}
+	}
+	if len(w.seedChallenges) >= maxSeedChallenges {
+		return nil, errors.E(op, errors.Policy, errors.Errorf("at most %d "+
+			"seed challenges may be outstanding", maxSeedChallenges))
+	}
+
+	var seed []byte
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		var err error
+		seed, err = w.manager.VerificationSeed(ns)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	words := walletseed.EncodeMnemonicSlice(seed)
+	clear(seed)
+	if n < minSeedChallengeWords || n > len(words) {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("challenge "+
+			"must ask for between %d and %d words", minSeedChallengeWords,
+			len(words)))
+	}
+
+	// Choose n distinct positions in increasing order.
+	perm := make([]int, len(words))
+	for i := range perm {
+		perm[i] = i
+	}
+	rand.Shuffle(len(perm), func(i, j int) { perm[i], perm[j] = perm[j], perm[i] })
+	perm = perm[:n]
+	sort.Ints(perm)
+	c := &seedChallenge{
+		positions: make([]int, n),
+		words:     make([]string, n),
+		expires:   now.Add(seedChallengeLifetime),
+	}
+	for i, p := range perm {
+		c.positions[i] = p + 1
+		c.words[i] = words[p]
+	}
+
+	var id [16]byte
+	rand.Read(id[:])
+	idStr := hex.EncodeToString(id[:])
+	if w.seedChallenges == nil {
+		w.seedChallenges = make(map[string]*seedChallenge)
+	}
+	w.seedChallenges[idStr] = c
+
+	return &SeedChallenge{
+		ID:        idStr,
+		Positions: append([]int(nil), c.positions...),
+		Expires:   c.expires,
+	}, nil
+}
+
+// seedChallengeCooldown errors with errors.Policy after too many consecutive
+// failed verifications until the cooldown has passed.  w.seedChallengesMu must
+// be held.
+func (w *Wallet) seedChallengeCooldown() error {
+	if w.seedChallengeFailures >= maxSeedChallengeFailures &&
+		time.Since(w.seedChallengeLastFail) < seedChallengeCooldown {
+		return errors.E(errors.Policy, "too many failed seed "+
+			"verifications; wait before trying again")
+	}
+	return nil
+}
+
+// VerifySeedChallenge checks the user's answers, in the order of the
+// challenge positions, against the words of the recovery phrase.  Only
+// whether all answers are correct is reported, and each challenge may only be
+// answered once.  Answers are refused during the cooldown following too many
+// failed verifications.  After a correct answer the retained seed is
+// forgotten, as the user's backup is verified.
+func (w *Wallet) VerifySeedChallenge(ctx context.Context, id string, answers []string) (bool, error) {
+	const op errors.Op = "wallet.VerifySeedChallenge"
+
+	w.seedChallengesMu.Lock()
+	if err := w.seedChallengeCooldown(); err != nil {
+		w.seedChallengesMu.Unlock()
+		return false, errors.E(op, err)
+	}
+	c, ok := w.seedChallenges[id]
+	delete(w.seedChallenges, id)
+	w.seedChallengesMu.Unlock()
+	if !ok || time.Now().After(c.expires) {
+		return false, errors.E(op, errors.NotExist, "unknown or expired seed challenge")
+	}
+	if len(answers) != len(c.words) {
+		return false, errors.E(op, errors.Invalid, errors.Errorf("challenge "+
+			"requires %d words", len(c.words)))
+	}
+
+	correct := true
+	for i := range answers {
+		if !strings.EqualFold(strings.TrimSpace(answers[i]), c.words[i]) {
+			correct = false
+		}
+	}
+
+	w.seedChallengesMu.Lock()
+	if correct {
+		w.seedChallengeFailures = 0
+	} else {
+		w.seedChallengeFailures++
+		w.seedChallengeLastFail = time.Now()
+	}
+	w.seedChallengesMu.Unlock()
+	if !correct {
+		return false, nil
+	}
+
+	if err := w.ForgetVerificationSeed(ctx); err != nil {
+		return true, errors.E(op, err)
+	}
+	log.Infof("Recovery phrase backup verified; forgot retained seed")
+	return true, nil
+}
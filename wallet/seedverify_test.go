@@ -0,0 +1,154 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/walletseed"
+	"github.com/decred/dcrd/hdkeychain/v3"
+)
+
+func TestSeedChallenge(t *testing.T) {
+	ctx := context.Background()
+	seed := bytes.Repeat([]byte{0x5e}, hdkeychain.RecommendedSeedLen)
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, seed)
+	defer teardown()
+
+	wrongSeed := bytes.Repeat([]byte{0x6f}, hdkeychain.RecommendedSeedLen)
+	err := w.RetainSeedForVerification(ctx, wrongSeed)
+	if !errors.Is(err, errors.Locked) && !errors.Is(err, errors.Seed) {
+		t.Fatalf("retained wrong seed: %v", err)
+	}
+	err = w.Unlock(ctx, testPrivPass, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.RetainSeedForVerification(ctx, wrongSeed)
+	if !errors.Is(err, errors.Seed) {
+		t.Fatalf("retained wrong seed: %v", err)
+	}
+	_, err = w.NewSeedChallenge(ctx, 3)
+	if !errors.Is(err, errors.NotExist) {
+		t.Fatalf("challenge without retained seed: %v", err)
+	}
+
+	err = w.RetainSeedForVerification(ctx, append([]byte(nil), seed...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	words := walletseed.EncodeMnemonicSlice(seed)
+
+	if _, err := w.NewSeedChallenge(ctx, 1); !errors.Is(err, errors.Invalid) {
+		t.Fatalf("single word challenge: %v", err)
+	}
+
+	answer := func(c *SeedChallenge, wrong bool) []string {
+		answers := make([]string, len(c.Positions))
+		for i, p := range c.Positions {
+			answers[i] = words[p-1]
+		}
+		if wrong {
+			answers[0] = "notaword"
+		}
+		return answers
+	}
+
+	// Incorrect answers are reported without revealing which words were
+	// wrong, and challenges may not be answered twice.
+	c, err := w.NewSeedChallenge(ctx, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c.Positions) != 3 {
+		t.Fatalf("challenge has %d positions", len(c.Positions))
+	}
+	ok, err := w.VerifySeedChallenge(ctx, c.ID, answer(c, true))
+	if err != nil || ok {
+		t.Fatalf("wrong answer verified: ok=%v err=%v", ok, err)
+	}
+	_, err = w.VerifySeedChallenge(ctx, c.ID, answer(c, false))
+	if !errors.Is(err, errors.NotExist) {
+		t.Fatalf("challenge answered twice: %v", err)
+	}
+
+	// Correct answers forget the retained seed.
+	c, err = w.NewSeedChallenge(ctx, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, err = w.VerifySeedChallenge(ctx, c.ID, answer(c, false))
+	if err != nil || !ok {
+		t.Fatalf("correct answer not verified: ok=%v err=%v", ok, err)
+	}
+	retained, err := w.VerificationSeedRetained(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retained {
+		t.Fatal("seed retained after verification")
+	}
+}
+
+func TestSeedChallengeCooldown(t *testing.T) {
+	ctx := context.Background()
+	seed := bytes.Repeat([]byte{0x5e}, hdkeychain.RecommendedSeedLen)
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, seed)
+	defer teardown()
+
+	err := w.Unlock(ctx, testPrivPass, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.RetainSeedForVerification(ctx, append([]byte(nil), seed...))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Only a limited number of challenges may be outstanding.
+	challenges := make([]*SeedChallenge, maxSeedChallenges)
+	for i := range challenges {
+		challenges[i], err = w.NewSeedChallenge(ctx, minSeedChallengeWords)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err = w.NewSeedChallenge(ctx, minSeedChallengeWords)
+	if !errors.Is(err, errors.Policy) {
+		t.Fatalf("challenge issued beyond outstanding limit: %v", err)
+	}
+
+	// Challenges requested before failing are not answered during the
+	// cooldown.
+	spare, challenges := challenges[0], challenges[1:]
+	for i := 0; i < maxSeedChallengeFailures; i++ {
+		var c *SeedChallenge
+		if i < len(challenges) {
+			c = challenges[i]
+		} else {
+			c, err = w.NewSeedChallenge(ctx, minSeedChallengeWords)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		answers := make([]string, len(c.Positions))
+		ok, err := w.VerifySeedChallenge(ctx, c.ID, answers)
+		if err != nil || ok {
+			t.Fatalf("wrong answer verified: ok=%v err=%v", ok, err)
+		}
+	}
+	_, err = w.NewSeedChallenge(ctx, minSeedChallengeWords)
+	if !errors.Is(err, errors.Policy) {
+		t.Fatalf("challenge issued during cooldown: %v", err)
+	}
+	answers := make([]string, len(spare.Positions))
+	_, err = w.VerifySeedChallenge(ctx, spare.ID, answers)
+	if !errors.Is(err, errors.Policy) {
+		t.Fatalf("challenge answered during cooldown: %v", err)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"slices"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/txscript/v4/stdscript"
+	"github.com/decred/dcrd/wire"
+)
+
+// sourceBranches restricts the automatic selection of transaction inputs to
+// outputs paying to addresses of particular branches of the spending account.
+// An empty restriction permits spending all outputs.
+type sourceBranches []uint32
+
+// check errors when the restriction names a branch other than the external
+// and internal branches.
+func (b sourceBranches) check() error {
+	for _, branch := range b {
+		if branch > udb.InternalBranch {
+			return errors.E(errors.Invalid, errors.Errorf("source "+
+				"branch %d must be 0 or 1", branch))
+		}
+	}
+	return nil
+}
+
+// allows returns whether an output paying to addr may be spent under the
+// restriction.  Outputs paying to imported addresses are never spent under a
+// non-empty restriction.
+func (b sourceBranches) allows(w *Wallet, addrmgrNs walletdb.ReadBucket, addr stdaddr.Address) bool {
+	if len(b) == 0 {
+		return true
+	}
+	ma, err := w.manager.Address(addrmgrNs, addr)
+	if err != nil || ma.Account() == udb.ImportedAddrAccount {
+		return false
+	}
+	if _, ok := ma.(udb.ManagedPubKeyAddress); !ok {
+		return false
+	}
+	branch := udb.ExternalBranch
+	if ma.Internal() {
+		branch = udb.InternalBranch
+	}
+	return slices.Contains(b, branch)
+}
+
+// ignore wraps an input source ignore func to additionally ignore outputs
+// which are not permitted by the restriction.  The caller must hold
+// lockedOutpointMu.
+func (b sourceBranches) ignore(w *Wallet, dbtx walletdb.ReadTx, ignore func(*wire.OutPoint) bool) func(*wire.OutPoint) bool {
+	if len(b) == 0 {
+		return ignore
+	}
+	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+	return func(op *wire.OutPoint) bool {
+		if ignore(op) {
+			return true
+		}
+		credit, err := w.txStore.UnspentOutput(txmgrNs, *op, true)
+		if err != nil {
+			return true
+		}
+		_, addrs := stdscript.ExtractAddrs(scriptVersionAssumed, credit.PkScript, w.chainParams)
+		return len(addrs) != 1 || !b.allows(w, addrmgrNs, addrs[0])
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+)
+
+func TestSourceBranches(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	external, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	internal, err := w.NewInternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	priv, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wif, err := dcrutil.NewWIF(priv.Serialize(), w.ChainParams().PrivateKeyID,
+		dcrec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	importedStr, _, err := w.ImportPrivateKey(ctx, wif, NoRescan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	imported, err := stdaddr.DecodeAddress(importedStr, w.ChainParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := (sourceBranches{0, 2}).check(); !errors.Is(err, errors.Invalid) {
+		t.Errorf("invalid branch accepted: %v", err)
+	}
+	if err := (sourceBranches{1, 0}).check(); err != nil {
+		t.Errorf("valid branches rejected: %v", err)
+	}
+
+	tests := []struct {
+		branches sourceBranches
+		allowed  []bool // external, internal, imported
+	}{
+		{nil, []bool{true, true, true}},
+		{sourceBranches{0}, []bool{true, false, false}},
+		{sourceBranches{1}, []bool{false, true, false}},
+		{sourceBranches{0, 1}, []bool{true, true, false}},
+	}
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		for _, test := range tests {
+			for i, addr := range []stdaddr.Address{external, internal, imported} {
+				if test.branches.allows(w, addrmgrNs, addr) != test.allowed[i] {
+					t.Errorf("branches %v: address %d allowed=%v",
+						test.branches, i, !test.allowed[i])
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
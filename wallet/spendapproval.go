@@ -0,0 +1,164 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4/stdscript"
+	"github.com/decred/dcrd/wire"
+)
+
+// SpendApproval describes an unsigned transaction awaiting approval before
+// the wallet signs it.  Amount is the total value of outputs which do not pay
+// to addresses derived by wallet accounts, and Fee is the transaction fee calculated from the
+// input amounts recorded in the transaction.
+type SpendApproval struct {
+	Tx     *wire.MsgTx
+	Amount dcrutil.Amount
+	Fee    dcrutil.Amount
+
+	// proposalRequired is set when the spend must instead be proposed
+	// with ProposeSpend and approved with ApprovePendingSpend.
+	proposalRequired bool
+}
+
+// SpendApprover approves spends before they are signed, e.g. by requesting
+// confirmation from an operator or a second factor.  ApproveSpend may block
+// until a decision is made, and returns nil only when the spend is approved.
+type SpendApprover interface {
+	ApproveSpend(ctx context.Context, a *SpendApproval) error
+}
+
+// SetSpendApprover requires approval by a before signing transactions which
+// send more than threshold to addresses outside of the wallet.  A nil
+// approver removes the requirement.  The approver is held for the lifetime of
+// the loaded wallet and is not persisted.
+//
+// Approval applies to transactions authored by the wallet to send to
+// addresses, cold storage sweeps, and signing of raw transactions with wallet
+// keys.  Ticket purchases, votes, revocations, and mixing are not affected.
+// Signing of arbitrary hashes, whose spends can not be determined, is refused
+// while an approver is configured.
+func (w *Wallet) SetSpendApprover(a SpendApprover, threshold dcrutil.Amount) {
+	w.spendApproverMu.Lock()
+	w.spendApprover = a
+	w.spendApprovalThreshold = threshold
+	w.spendApproverMu.Unlock()
+}
+
+// RequirePendingSpends requires every spend sending to addresses outside of
+// the wallet to be proposed with ProposeSpend and approved with
+// ApprovePendingSpend.  All other methods which author or sign such spends,
+// including signing of raw transactions, error with kind errors.Permission.
+// As with SetSpendApprover, ticket purchases, votes, revocations, and mixing
+// are not affected.  The requirement is not persisted.
+func (w *Wallet) RequirePendingSpends(require bool) {
+	w.spendApproverMu.Lock()
+	w.pendingSpendsRequired = require
+	w.spendApproverMu.Unlock()
+}
+
+// approvalConfigured returns whether spends may require approval, either by
+// the spend approver or as pending spends.
+func (w *Wallet) approvalConfigured() bool {
+	w.spendApproverMu.Lock()
+	defer w.spendApproverMu.Unlock()
+	return w.spendApprover != nil || w.pendingSpendsRequired
+}
+
+// spendApproval returns the approval request for an unsigned transaction, or
+// nil when no approver is configured or the transaction does not send more
+// than the approval threshold outside of the wallet.  Unless the transaction
+// is an approved pending spend, transactions sending any amount outside of
+// the wallet require approval when pending spends are required.  changeIndex
+// is the index of a change output paying a wallet address which may not yet
+// be recorded, or negative.
+func (w *Wallet) spendApproval(dbtx walletdb.ReadTx, tx *wire.MsgTx, changeIndex int, pendingSpend bool) *SpendApproval {
+	w.spendApproverMu.Lock()
+	approver, threshold := w.spendApprover, w.spendApprovalThreshold
+	proposalRequired := w.pendingSpendsRequired && !pendingSpend
+	w.spendApproverMu.Unlock()
+	if approver == nil && !proposalRequired {
+		return nil
+	}
+
+	amount, fee := w.spendAmounts(dbtx, tx, changeIndex)
+	switch {
+	case proposalRequired && amount > 0:
+	case approver == nil || amount <= threshold:
+		return nil
+	default:
+		proposalRequired = false
+	}
+	return &SpendApproval{Tx: tx.Copy(), Amount: amount, Fee: fee,
+		proposalRequired: proposalRequired}
+}
+
+// spendAmounts returns the total value of outputs of tx which do not pay to
+// addresses derived by wallet accounts, and the fee calculated from the input
+// amounts recorded in the transaction.  Imported keys, scripts, and xpub
+// accounts may be controlled by others, and payments to them are included.
+// The output at changeIndex, when not negative, is excluded.
+func (w *Wallet) spendAmounts(dbtx walletdb.ReadTx, tx *wire.MsgTx, changeIndex int) (amount, fee dcrutil.Amount) {
+	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+	var in, out dcrutil.Amount
+	for _, txIn := range tx.TxIn {
+		in += dcrutil.Amount(txIn.ValueIn)
+	}
+	for i, txOut := range tx.TxOut {
+		out += dcrutil.Amount(txOut.Value)
+		if i == changeIndex {
+			continue
+		}
+		_, addrs := stdscript.ExtractAddrs(txOut.Version, txOut.PkScript, w.chainParams)
+		if len(addrs) == 1 && w.ownHDAddress(addrmgrNs, addrs[0]) {
+			continue
+		}
+		amount += dcrutil.Amount(txOut.Value)
+	}
+	if in > out {
+		fee = in - out
+	}
+	return amount, fee
+}
+
+// requestSpendApproval blocks until the spend approver approves or rejects a
+// spend.  The caller must not hold any database transaction or wallet mutex
+// which would prevent other wallet operations while awaiting a decision.
+func (w *Wallet) requestSpendApproval(ctx context.Context, a *SpendApproval) error {
+	if a.proposalRequired {
+		return errPendingSpendRequired
+	}
+	w.spendApproverMu.Lock()
+	approver := w.spendApprover
+	w.spendApproverMu.Unlock()
+	if approver == nil {
+		return nil
+	}
+
+	txHash := a.Tx.TxHash()
+	log.Infof("Requesting approval to send %v in transaction %v", a.Amount, &txHash)
+	if err := approver.ApproveSpend(ctx, a); err != nil {
+		return errors.E(errors.Permission, errors.Errorf("spend of %v "+
+			"in transaction %v was not approved: %w", a.Amount, &txHash, err))
+	}
+	log.Infof("Spend of %v in transaction %v approved", a.Amount, &txHash)
+	return nil
+}
+
+// errApprovalUnsupported describes spends requiring approval from methods
+// which author and sign transactions in a single database update, and cannot
+// wait for approval.
+var errApprovalUnsupported = errors.E(errors.Permission,
+	"spend requires approval, which is not supported by this method")
+
+// errPendingSpendRequired describes spends which must be proposed as pending
+// spends.
+var errPendingSpendRequired = errors.E(errors.Permission,
+	"spends must be proposed and approved as pending spends")
@@ -0,0 +1,172 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/wire"
+)
+
+type testApprover struct {
+	requests  []*SpendApproval
+	err       error
+	onRequest func(*SpendApproval)
+}
+
+func (a *testApprover) ApproveSpend(ctx context.Context, req *SpendApproval) error {
+	a.requests = append(a.requests, req)
+	if a.onRequest != nil {
+		a.onRequest(req)
+	}
+	return a.err
+}
+
+func TestSpendApproval(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	owned, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	external, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(make([]byte, 20), w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, 10e8, nil))
+	for _, out := range []struct {
+		addr  stdaddr.Address
+		value int64
+	}{{owned, 6e8}, {external, 3e8}} {
+		version, script := out.addr.PaymentScript()
+		tx.AddTxOut(&wire.TxOut{Value: out.value, Version: version, PkScript: script})
+	}
+
+	approval := func() *SpendApproval {
+		var a *SpendApproval
+		err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+			a = w.spendApproval(dbtx, tx, -1, false)
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return a
+	}
+
+	if a := approval(); a != nil {
+		t.Fatalf("approval required without an approver")
+	}
+	approver := &testApprover{err: errors.New("rejected")}
+	w.SetSpendApprover(approver, dcrutil.Amount(3e8))
+	if a := approval(); a != nil {
+		t.Fatalf("approval required for spend at threshold")
+	}
+	w.SetSpendApprover(approver, dcrutil.Amount(1e8))
+	a := approval()
+	if a == nil || a.Amount != 3e8 || a.Fee != 1e8 {
+		t.Fatalf("unexpected approval request %+v", a)
+	}
+
+	// Payments to imported scripts count towards the threshold.
+	redeemScript := []byte{0x51} // OP_TRUE
+	if _, err := w.ImportScript(ctx, redeemScript, NoRescan); err != nil {
+		t.Fatal(err)
+	}
+	importedAddr, err := stdaddr.NewAddressScriptHashV0(redeemScript, w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	importedTx := tx.Copy()
+	_, importedTx.TxOut[0].PkScript = importedAddr.PaymentScript()
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		a = w.spendApproval(dbtx, importedTx, -1, false)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a == nil || a.Amount != 9e8 {
+		t.Fatalf("unexpected approval request for imported script %+v", a)
+	}
+
+	_, err = w.SignTransaction(ctx, tx, txscript.SigHashAll, nil, nil, nil)
+	if !errors.Is(err, errors.Permission) || len(approver.requests) != 1 {
+		t.Fatalf("rejected spend signed: %v", err)
+	}
+
+	// Every signing method requires approval.
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	_, ownedScript := owned.PaymentScript()
+	_, _, err = w.CreateSignature(ctx, tx, 0, owned, txscript.SigHashAll, ownedScript)
+	if !errors.Is(err, errors.Permission) || len(approver.requests) != 2 {
+		t.Errorf("rejected spend signature created: %v", err)
+	}
+	_, _, err = w.SignHashes(ctx, [][]byte{make([]byte, 32)}, owned)
+	if !errors.Is(err, errors.Permission) {
+		t.Errorf("hashes signed with an approver: %v", err)
+	}
+}
+
+func TestSpendApprovalReservesInputs(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, pkScript := addr.PaymentScript()
+	fund := wire.NewMsgTx()
+	fund.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, 0, nil))
+	fund.AddTxOut(&wire.TxOut{Value: 5e8, Version: version, PkScript: pkScript})
+	if err := w.AddTransaction(ctx, fund, &w.chainParams.GenesisHash); err != nil {
+		t.Fatal(err)
+	}
+	fundHash := fund.TxHash()
+
+	// The inputs of a spend awaiting approval may not be unlocked.
+	approver := &testApprover{err: errors.New("rejected")}
+	approver.onRequest = func(*SpendApproval) {
+		w.UnlockOutpoint(&fundHash, 0)
+		w.ResetLockedOutpoints()
+		if !w.LockedOutpoint(&fundHash, 0) {
+			t.Error("input of spend awaiting approval was unlocked")
+		}
+	}
+	w.SetSpendApprover(approver, 0)
+	external, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(make([]byte, 20), w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, pkScript = external.PaymentScript()
+	out := &wire.TxOut{Value: 1e8, Version: version, PkScript: pkScript}
+	_, err = w.SendOutputs(ctx, []*wire.TxOut{out}, 0, 0, 0)
+	if !errors.Is(err, errors.Permission) || len(approver.requests) != 1 {
+		t.Fatalf("rejected spend sent: %v", err)
+	}
+	// Change returned to the wallet is not approved.
+	if a := approver.requests[0]; a.Amount != 1e8 {
+		t.Errorf("approval requested for %v, want %v", a.Amount, dcrutil.Amount(1e8))
+	}
+	if w.LockedOutpoint(&fundHash, 0) {
+		t.Error("input of rejected spend remains locked")
+	}
+}
@@ -0,0 +1,379 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	blockchain "github.com/decred/dcrd/blockchain/standalone/v2"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v4"
+)
+
+// maxForecastBlocks limits the number of blocks projected by ForecastStake.
+const maxForecastBlocks = 250000
+
+// StakeForecastConfig describes the projection performed by ForecastStake and
+// the ticket purchasing it assumes, which mirrors the configuration of the
+// ticket buyer.
+type StakeForecastConfig struct {
+	// Blocks is the number of blocks after the main chain tip to project,
+	// and Interval is the number of blocks between each sample.
+	Blocks   int32
+	Interval int32
+
+	// Account is the purchasing account whose spendable balance is
+	// projected.  Vote rewards and revoked ticket value are assumed to be
+	// returned to this account.
+	Account uint32
+
+	// BuyTickets enables the purchase of new tickets whenever the
+	// spendable balance exceeds Maintain by the ticket price.  Limit, when
+	// non-zero, limits the number of tickets purchased in each block.
+	BuyTickets bool
+	Maintain   dcrutil.Amount
+	Limit      int
+
+	// TicketPrice is the price of purchased tickets.  When zero, the next
+	// stake difficulty is used.
+	TicketPrice dcrutil.Amount
+
+	// Balance, when non-zero, is a hypothetical spendable balance which is
+	// projected instead of the balance and tickets of the wallet.
+	Balance dcrutil.Amount
+}
+
+// TicketVoteForecast describes the expected vote of an unspent ticket.
+type TicketVoteForecast struct {
+	Hash  chainhash.Hash
+	Value dcrutil.Amount
+
+	// FirstVoteHeight and LastVoteHeight are the heights of the first and
+	// last blocks in which the ticket may be chosen to vote.
+	FirstVoteHeight int32
+	LastVoteHeight  int32
+
+	// VoteProbability is the probability of the ticket voting before
+	// expiry.  ExpectedVoteHeight and ExpectedVoteTime describe the
+	// expected block of the vote, assuming the ticket votes.
+	VoteProbability    float64
+	ExpectedVoteHeight int32
+	ExpectedVoteTime   time.Time
+}
+
+// StakeForecastSample describes the expected stake and balance of the wallet
+// at a future block.
+type StakeForecastSample struct {
+	Height int32
+	Time   time.Time
+
+	// Locked is the expected value of unspent tickets and Tickets is the
+	// expected number of unspent tickets.
+	Locked  dcrutil.Amount
+	Tickets float64
+
+	// Immature is the expected value of vote and revocation outputs which
+	// have not reached coinbase maturity, and Spendable is the expected
+	// spendable balance of the purchasing account.
+	Immature  dcrutil.Amount
+	Spendable dcrutil.Amount
+
+	// Votes is the expected number of votes cast since the main chain tip,
+	// and Purchased is the number of tickets purchased since the tip.
+	Votes     float64
+	Purchased int
+}
+
+// StakeForecast is the result of ForecastStake.
+type StakeForecast struct {
+	TipHeight   int32
+	TicketPrice dcrutil.Amount
+	Tickets     []TicketVoteForecast
+	Samples     []StakeForecastSample
+}
+
+// ticketVoteWindow returns the heights of the first and last blocks in which
+// a ticket mined at txHeight may be chosen to vote.  Tickets are chosen from
+// the live ticket pool of the previous block, and ticket maturity and expiry
+// are both extended by dcrd's off-by-one maturity.
+func ticketVoteWindow(txHeight int32, maturity, expiry uint32) (first, last int32) {
+	first = txHeight + int32(maturity) + 2
+	last = txHeight + int32(maturity) + int32(expiry) + 1
+	return
+}
+
+// expectedVote returns the probability of a ticket being chosen to vote within
+// n blocks when chosen by each block with probability p, and the expected
+// number of blocks until the vote, given that it occurs.
+func expectedVote(p float64, n int32) (prob, blocks float64) {
+	if n <= 0 {
+		return 0, 0
+	}
+	qn := math.Pow(1-p, float64(n))
+	prob = 1 - qn
+	// Mean of the geometric distribution truncated to n trials.
+	blocks = 1/p - float64(n)*qn/prob
+	return prob, blocks
+}
+
+// ticketCohort is the expected value and number of tickets entering or
+// leaving the live ticket pool at a block.
+type ticketCohort struct {
+	value   float64
+	tickets float64
+}
+
+// ForecastStake projects the expected locked and spendable balances, votes
+// and ticket purchases of the wallet over the blocks following the main chain
+// tip, and the expected vote of each unspent ticket.
+//
+// Each live ticket is assumed to be chosen to vote by each block with
+// probability 1/TicketPoolSize, the probability when the ticket pool is at its
+// target size.  Unmined tickets are assumed to be mined in the next block,
+// missed tickets are assumed to be revoked after expiry, and vote rewards are
+// calculated with the current subsidy split.  Transaction and VSP fees are not
+// included.
+func (w *Wallet) ForecastStake(ctx context.Context, cfg *StakeForecastConfig) (*StakeForecast, error) {
+	const op errors.Op = "wallet.ForecastStake"
+	if cfg.Blocks < 1 || cfg.Blocks > maxForecastBlocks {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf(
+			"forecast blocks must be between 1 and %d", maxForecastBlocks))
+	}
+	if cfg.Interval < 1 {
+		return nil, errors.E(op, errors.Invalid, "interval must be positive")
+	}
+	if cfg.Maintain < 0 || cfg.Limit < 0 || cfg.TicketPrice < 0 {
+		return nil, errors.E(op, errors.Invalid, "negative ticket buyer parameter")
+	}
+	if cfg.Balance < 0 {
+		return nil, errors.E(op, errors.Invalid, "negative balance")
+	}
+	hypothetical := cfg.Balance > 0
+
+	price := cfg.TicketPrice
+	if price == 0 {
+		var err error
+		price, err = w.NextStakeDifficulty(ctx)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+	spendable := float64(cfg.Balance)
+	if !hypothetical {
+		bal, err := w.AccountBalance(ctx, cfg.Account, 1)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		spendable = float64(bal.Spendable)
+	}
+
+	params := w.chainParams
+	p := 1 / float64(params.TicketPoolSize)
+	maturity := uint32(params.TicketMaturity)
+	now := time.Now()
+	timeAt := func(tipHeight, height int32) time.Time {
+		return now.Add(time.Duration(height-tipHeight) * params.TargetTimePerBlock)
+	}
+
+	// Events are indexed by the number of blocks after the tip.  Events
+	// beyond the end of the forecast are not recorded.
+	n := int(cfg.Blocks) + 1
+	entering := make([]ticketCohort, n)
+	expiring := make([]ticketCohort, n)
+	maturing := make([]float64, n)
+	var live ticketCohort
+	addTickets := func(tipHeight, txHeight int32, c ticketCohort) {
+		first, last := ticketVoteWindow(txHeight, maturity, params.TicketExpiry)
+		if first <= tipHeight {
+			live.value += c.value
+			live.tickets += c.tickets
+			first = tipHeight + 1
+		} else if i := first - tipHeight; i < int32(n) {
+			entering[i].value += c.value
+			entering[i].tickets += c.tickets
+		}
+		// The remaining tickets which were not chosen to vote are
+		// revoked in the block after expiry.
+		if i := last + 1 - tipHeight; i < int32(n) {
+			remain := math.Pow(1-p, float64(last-first+1))
+			expiring[i].value += c.value * remain
+			expiring[i].tickets += c.tickets * remain
+		}
+	}
+
+	f := &StakeForecast{TicketPrice: price}
+	var locked, tickets float64
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		_, f.TipHeight = w.txStore.MainChainTip(dbtx)
+		if hypothetical {
+			return nil
+		}
+
+		it := w.txStore.IterateTickets(dbtx)
+		defer it.Close()
+		for it.Next() {
+			if it.SpenderHash != (chainhash.Hash{}) ||
+				ticketExpired(params, it.Block.Height, f.TipHeight) {
+				continue
+			}
+			txHeight := it.Block.Height
+			if txHeight == -1 {
+				txHeight = f.TipHeight + 1
+			}
+			value := dcrutil.Amount(it.MsgTx.TxOut[0].Value)
+			locked += float64(value)
+			tickets++
+			addTickets(f.TipHeight, txHeight, ticketCohort{float64(value), 1})
+
+			first, last := ticketVoteWindow(txHeight, maturity, params.TicketExpiry)
+			start := max(first, f.TipHeight+1)
+			prob, blocks := expectedVote(p, last-start+1)
+			voteHeight := start - 1 + int32(math.Round(blocks))
+			f.Tickets = append(f.Tickets, TicketVoteForecast{
+				Hash:               it.Hash,
+				Value:              value,
+				FirstVoteHeight:    first,
+				LastVoteHeight:     last,
+				VoteProbability:    prob,
+				ExpectedVoteHeight: voteHeight,
+				ExpectedVoteTime:   timeAt(f.TipHeight, voteHeight),
+			})
+		}
+		return it.Err()
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	var (
+		immature  float64
+		votes     float64
+		purchased int
+	)
+	for i := 1; i < n; i++ {
+		height := f.TipHeight + int32(i)
+
+		// Remove expired tickets before adding newly live tickets and
+		// choosing the votes of the block.
+		live.value = max(live.value-expiring[i].value, 0)
+		live.tickets = max(live.tickets-expiring[i].tickets, 0)
+		live.value += entering[i].value
+		live.tickets += entering[i].tickets
+		voted := ticketCohort{live.value * p, live.tickets * p}
+		live.value -= voted.value
+		live.tickets -= voted.tickets
+		votes += voted.tickets
+
+		subsidy := w.subsidyCache.CalcStakeVoteSubsidyV3(int64(height),
+			blockchain.SSVDCP0012)
+		returned := voted.value + voted.tickets*float64(subsidy) + expiring[i].value
+		locked = max(locked-voted.value-expiring[i].value, 0)
+		tickets = max(tickets-voted.tickets-expiring[i].tickets, 0)
+		immature += returned
+		if m := i + int(params.CoinbaseMaturity); m < n {
+			maturing[m] += returned
+		}
+		immature -= maturing[i]
+		spendable += maturing[i]
+
+		if cfg.BuyTickets && price > 0 && spendable-float64(cfg.Maintain) >= float64(price) {
+			buy := int((spendable - float64(cfg.Maintain)) / float64(price))
+			if cfg.Limit > 0 {
+				buy = min(buy, cfg.Limit)
+			}
+			cost := float64(buy) * float64(price)
+			spendable -= cost
+			locked += cost
+			tickets += float64(buy)
+			purchased += buy
+			addTickets(f.TipHeight, height+1, ticketCohort{cost, float64(buy)})
+		}
+
+		if i%int(cfg.Interval) == 0 || i == n-1 {
+			f.Samples = append(f.Samples, StakeForecastSample{
+				Height:    height,
+				Time:      timeAt(f.TipHeight, height),
+				Locked:    dcrutil.Amount(math.Round(locked)),
+				Tickets:   tickets,
+				Immature:  dcrutil.Amount(math.Round(immature)),
+				Spendable: dcrutil.Amount(math.Round(spendable)),
+				Votes:     votes,
+				Purchased: purchased,
+			})
+		}
+	}
+	return f, nil
+}
+
+// StakeSimulation is the result of SimulateStake.
+type StakeSimulation struct {
+	Balance     dcrutil.Amount
+	TicketPrice dcrutil.Amount
+	Blocks      int32
+
+	// Value is the expected total of the spendable, immature and locked
+	// balances at the end of the period, and Rewards is the expected
+	// increase over the simulated balance.
+	Value   dcrutil.Amount
+	Rewards dcrutil.Amount
+
+	// Purchased is the number of tickets purchased and Votes is the
+	// expected number of votes cast during the period.
+	Purchased int
+	Votes     float64
+
+	// Return is the expected return over the period, and AnnualReturn is
+	// the return compounded over a year, as fractions of the balance.
+	Return       float64
+	AnnualReturn float64
+}
+
+// SimulateStake simulates the expected returns of staking a hypothetical
+// balance over a number of blocks following the main chain tip.  All of the
+// balance that can be is used to purchase tickets, and vote rewards and
+// returned ticket value are restaked as they mature.
+//
+// The simulation is performed by ForecastStake, and makes the same
+// assumptions, using the current subsidy schedule.  Tickets are purchased at
+// the ticket price, or the next stake difficulty when zero, which is assumed
+// to remain constant.
+func (w *Wallet) SimulateStake(ctx context.Context, balance, ticketPrice dcrutil.Amount,
+	blocks int32) (*StakeSimulation, error) {
+
+	const op errors.Op = "wallet.SimulateStake"
+	if balance <= 0 {
+		return nil, errors.E(op, errors.Invalid, "balance must be positive")
+	}
+	f, err := w.ForecastStake(ctx, &StakeForecastConfig{
+		Blocks:      blocks,
+		Interval:    blocks,
+		BuyTickets:  true,
+		TicketPrice: ticketPrice,
+		Balance:     balance,
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	end := &f.Samples[len(f.Samples)-1]
+	value := end.Spendable + end.Immature + end.Locked
+	sim := &StakeSimulation{
+		Balance:     balance,
+		TicketPrice: f.TicketPrice,
+		Blocks:      blocks,
+		Value:       value,
+		Rewards:     value - balance,
+		Purchased:   end.Purchased,
+		Votes:       end.Votes,
+		Return:      float64(value-balance) / float64(balance),
+	}
+	year := float64(365*24*time.Hour) / float64(w.chainParams.TargetTimePerBlock)
+	sim.AnnualReturn = math.Pow(1+sim.Return, year/float64(blocks)) - 1
+	return sim, nil
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestExpectedVote(t *testing.T) {
+	// Without expiry, the expected wait is the mean of the geometric
+	// distribution.
+	p := 1.0 / 8192
+	prob, blocks := expectedVote(p, 1e7)
+	if prob < 0.9999 || math.Abs(blocks-8192) > 1 {
+		t.Errorf("unbounded vote: probability %v, blocks %v", prob, blocks)
+	}
+	// Tickets which may vote in a single block vote there.
+	prob, blocks = expectedVote(p, 1)
+	if math.Abs(prob-p) > 1e-12 || math.Abs(blocks-1) > 1e-6 {
+		t.Errorf("single block: probability %v, blocks %v", prob, blocks)
+	}
+	if prob, _ := expectedVote(p, 0); prob != 0 {
+		t.Errorf("expired ticket votes with probability %v", prob)
+	}
+}
+
+func TestForecastStake(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, pkScript := addr.PaymentScript()
+	fund := wire.NewMsgTx()
+	fund.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, 0, nil))
+	fund.AddTxOut(&wire.TxOut{Value: 10e8, Version: version, PkScript: pkScript})
+	if err := w.AddTransaction(ctx, fund, &w.chainParams.GenesisHash); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &StakeForecastConfig{
+		Blocks:      1000,
+		Interval:    1,
+		BuyTickets:  true,
+		Maintain:    5e8,
+		Limit:       2,
+		TicketPrice: 1e8,
+	}
+	f, err := w.ForecastStake(ctx, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(f.Samples) != 1000 {
+		t.Fatalf("%d samples, want 1000", len(f.Samples))
+	}
+
+	// Purchases are limited per block and maintain the balance.
+	for i, want := range []int{2, 4, 5} {
+		s := &f.Samples[i]
+		if s.Purchased != want {
+			t.Errorf("block %d: purchased %d tickets, want %d", s.Height,
+				s.Purchased, want)
+		}
+	}
+	if s := &f.Samples[2]; s.Spendable != 5e8 || s.Locked != 5e8 {
+		t.Errorf("spendable %v, locked %v after purchases", s.Spendable, s.Locked)
+	}
+
+	// Tickets vote and return funds with vote rewards, which are
+	// reinvested.
+	last := &f.Samples[len(f.Samples)-1]
+	if last.Votes <= 0 || last.Purchased <= 5 {
+		t.Errorf("%v votes, %d purchases", last.Votes, last.Purchased)
+	}
+	for i := range f.Samples {
+		s := &f.Samples[i]
+		total := s.Locked + s.Immature + s.Spendable
+		if total < 10e8-3 {
+			t.Fatalf("block %d: total balance %v decreased", s.Height, total)
+		}
+		if s.Spendable < cfg.Maintain {
+			t.Fatalf("block %d: spendable %v below maintained balance",
+				s.Height, s.Spendable)
+		}
+	}
+	if total := last.Locked + last.Immature + last.Spendable; total <= 10e8 {
+		t.Errorf("no vote rewards: total balance %v", total)
+	}
+
+	// Without purchases, the spendable balance is unchanged.
+	cfg.BuyTickets = false
+	f, err = w.ForecastStake(ctx, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := f.Samples[len(f.Samples)-1]; s.Spendable != 10e8 || s.Locked != 0 {
+		t.Errorf("spendable %v, locked %v without purchases", s.Spendable, s.Locked)
+	}
+
+	cfg.Interval = 0
+	if _, err := w.ForecastStake(ctx, cfg); !errors.Is(err, errors.Invalid) {
+		t.Errorf("zero interval accepted: %v", err)
+	}
+	cfg.Interval = 1
+	cfg.Maintain = -dcrutil.Amount(1)
+	if _, err := w.ForecastStake(ctx, cfg); !errors.Is(err, errors.Invalid) {
+		t.Errorf("negative balance to maintain accepted: %v", err)
+	}
+}
+
+func TestSimulateStake(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	if _, err := w.SimulateStake(ctx, 0, 1e8, 1000); !errors.Is(err, errors.Invalid) {
+		t.Errorf("zero balance: %v", err)
+	}
+
+	// The hypothetical balance is staked although the wallet has no funds.
+	params := w.chainParams
+	blocks := int32(params.TicketMaturity) + int32(params.TicketExpiry) +
+		int32(params.CoinbaseMaturity) + 2
+	sim, err := w.SimulateStake(ctx, 10e8+5e7, 1e8, blocks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sim.Purchased < 10 {
+		t.Errorf("purchased %d tickets, want at least 10", sim.Purchased)
+	}
+	if sim.Votes <= 0 || sim.Rewards <= 0 || sim.Value != sim.Balance+sim.Rewards {
+		t.Errorf("votes %v, rewards %v, value %v", sim.Votes, sim.Rewards, sim.Value)
+	}
+	if want := float64(sim.Rewards) / float64(sim.Balance); math.Abs(sim.Return-want) > 1e-12 {
+		t.Errorf("return %v, want %v", sim.Return, want)
+	}
+	if sim.AnnualReturn <= 0 {
+		t.Errorf("annual return %v", sim.AnnualReturn)
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/dcrutil/v4"
+)
+
+// maxBlockRangeStats limits the number of intervals returned by
+// BlockRangeStats.
+const maxBlockRangeStats = 10000
+
+// BlockRangeStats summarizes the wallet transactions mined in a range of
+// main chain blocks.
+type BlockRangeStats struct {
+	StartHeight int32
+	EndHeight   int32
+
+	Transactions int
+
+	// Debits is the total value of spent wallet outputs, and Credits is
+	// the total value of outputs paying to the wallet.
+	Debits  dcrutil.Amount
+	Credits dcrutil.Amount
+
+	// Fees is the total fee of all transactions with only wallet inputs.
+	Fees dcrutil.Amount
+
+	TicketPurchases int
+	Votes           int
+	Revocations     int
+}
+
+// BlockRangeStats returns statistics of wallet transactions mined in the
+// main chain blocks [startHeight, endHeight], divided into consecutive
+// intervals of the given number of blocks.  The final interval may be
+// shorter, and the end height is limited by the main chain tip.
+func (w *Wallet) BlockRangeStats(ctx context.Context, startHeight, endHeight, interval int32) ([]BlockRangeStats, error) {
+	const op errors.Op = "wallet.BlockRangeStats"
+	if startHeight < 0 || endHeight < startHeight {
+		return nil, errors.E(op, errors.Invalid, "invalid block range")
+	}
+	if interval < 1 {
+		return nil, errors.E(op, errors.Invalid, "interval must be positive")
+	}
+
+	var stats []BlockRangeStats
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		_, tipHeight := w.txStore.MainChainTip(dbtx)
+		if endHeight > tipHeight {
+			endHeight = tipHeight
+		}
+		if startHeight > endHeight {
+			return nil
+		}
+		n := (int64(endHeight-startHeight) + int64(interval)) / int64(interval)
+		if n > maxBlockRangeStats {
+			return errors.E(errors.Invalid, errors.Errorf("block range "+
+				"requires more than %d intervals", maxBlockRangeStats))
+		}
+		stats = make([]BlockRangeStats, n)
+		for i := range stats {
+			s := &stats[i]
+			// Heights are computed as int64 since the interval may
+			// extend beyond the maximum int32 height.
+			start := int64(startHeight) + int64(i)*int64(interval)
+			end := min(start+int64(interval)-1, int64(endHeight))
+			s.StartHeight = int32(start)
+			s.EndHeight = int32(end)
+		}
+
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		rangeFn := func(details []udb.TxDetails) (bool, error) {
+			for i := range details {
+				d := &details[i]
+				s := &stats[(d.Block.Height-startHeight)/interval]
+				s.Transactions++
+				for _, deb := range d.Debits {
+					s.Debits += deb.Amount
+				}
+				for _, cred := range d.Credits {
+					s.Credits += cred.Amount
+				}
+				if d.Pruned {
+					// Pruned transactions are regular
+					// transactions whose fees are unknown.
+					continue
+				}
+				if len(d.Debits) != 0 && len(d.Debits) == len(d.MsgTx.TxIn) {
+					for _, deb := range d.Debits {
+						s.Fees += deb.Amount
+					}
+					for _, txOut := range d.MsgTx.TxOut {
+						s.Fees -= dcrutil.Amount(txOut.Value)
+					}
+				}
+				switch TxTransactionType(&d.MsgTx) {
+				case TransactionTypeTicketPurchase:
+					s.TicketPurchases++
+				case TransactionTypeVote:
+					s.Votes++
+				case TransactionTypeRevocation:
+					s.Revocations++
+				}
+			}
+			return false, nil
+		}
+		return w.txStore.RangeTransactions(ctx, txmgrNs, startHeight, endHeight, rangeFn)
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return stats, nil
+}
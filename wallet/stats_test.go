@@ -0,0 +1,63 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/wallettest"
+)
+
+func TestBlockRangeStats(t *testing.T) {
+	ctx := context.Background()
+	h := wallettest.New(t, nil)
+	w := h.Wallet
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range 3 {
+		if _, err := h.Chain.MineBlock(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := h.Chain.MineBlock(ctx, h.Chain.FundingTx(addr, 3e8)); err != nil {
+		t.Fatal(err)
+	}
+
+	stats, err := w.BlockRangeStats(ctx, 0, 100, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats) != 3 {
+		t.Fatalf("expected range limited to tip, got %d intervals", len(stats))
+	}
+	s := stats[2]
+	if s.StartHeight != 4 || s.EndHeight != 4 || s.Transactions != 1 ||
+		s.Credits != 3e8 || s.Debits != 0 || s.Fees != 0 {
+		t.Errorf("unexpected stats %+v", s)
+	}
+
+	// Intervals extending past the maximum height do not overflow.
+	stats, err = w.BlockRangeStats(ctx, 3, math.MaxInt32, math.MaxInt32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats) != 1 || stats[0].StartHeight != 3 || stats[0].EndHeight != 4 ||
+		stats[0].Transactions != 1 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+
+	if _, err := w.BlockRangeStats(ctx, 5, 4, 1); !errors.Is(err, errors.Invalid) {
+		t.Errorf("reversed range accepted: %v", err)
+	}
+	if _, err := w.BlockRangeStats(ctx, 0, 4, 0); !errors.Is(err, errors.Invalid) {
+		t.Errorf("zero interval accepted: %v", err)
+	}
+}
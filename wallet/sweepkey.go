@@ -0,0 +1,251 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/txrules"
+	"decred.org/dcrwallet/v5/wallet/txsizes"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4"
+	"github.com/decred/dcrd/txscript/v4/sign"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/wire"
+)
+
+// SweepResult describes a transaction sweeping the outputs of a private key.
+type SweepResult struct {
+	Hash   chainhash.Hash
+	Inputs []wire.OutPoint
+	Amount dcrutil.Amount
+	Fee    dcrutil.Amount
+}
+
+// SweepPrivateKey discovers the mature unspent outputs of main chain blocks
+// beginning at startHeight which pay to the P2PKH address of a private key,
+// and publishes a transaction spending all of them to a new internal address
+// of account.  Outputs are discovered by matching the wallet's compact filters
+// and fetching matching blocks from the network backend.  The key is only used
+// to sign the sweeping transaction and is not imported.
+//
+// The transaction spends the oldest outputs which fit in the maximum
+// transaction size.  Any outputs which do not fit remain unspent, and may be
+// swept by calling SweepPrivateKey again after the transaction is mined.
+func (w *Wallet) SweepPrivateKey(ctx context.Context, n NetworkBackend, wif *dcrutil.WIF,
+	account uint32, startHeight int32) (*SweepResult, error) {
+
+	const op errors.Op = "wallet.SweepPrivateKey"
+	if err := w.checkVoteOnly(); err != nil {
+		return nil, errors.E(op, err)
+	}
+	if wif.DSA() != dcrec.STEcdsaSecp256k1 {
+		return nil, errors.E(op, errors.Invalid, "only secp256k1 private keys may be swept")
+	}
+	if startHeight < 0 {
+		return nil, errors.E(op, errors.Invalid, "negative start height")
+	}
+	addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(
+		stdaddr.Hash160(wif.PubKey()), w.chainParams)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	_, pkScript := addr.PaymentScript()
+
+	utxos, err := w.findKeyOutputs(ctx, n, pkScript, startHeight)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if len(utxos) == 0 {
+		return nil, errors.E(op, errors.InsufficientBalance,
+			errors.Errorf("no spendable outputs pay to %v", addr))
+	}
+
+	payTo, err := w.NewInternalAddress(ctx, account, WithGapPolicyWrap())
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	payVersion, payScript := payTo.PaymentScript()
+
+	maxTxSize := w.chainParams.MaxTxSize
+	if w.chainParams.Net == wire.MainNet {
+		maxTxSize = maxStandardTxSize
+	}
+
+	tx := wire.NewMsgTx()
+	txOut := &wire.TxOut{Version: payVersion, PkScript: payScript}
+	tx.AddTxOut(txOut)
+	res := &SweepResult{Inputs: make([]wire.OutPoint, 0, len(utxos))}
+	prevScripts := make([][]byte, 0, len(utxos))
+	scriptSizes := make([]int, 0, len(utxos))
+	for _, u := range utxos {
+		scriptSizes = append(scriptSizes, txsizes.RedeemP2PKHSigScriptSize)
+		if txsizes.EstimateSerializeSize(scriptSizes, tx.TxOut, 0) > maxTxSize {
+			scriptSizes = scriptSizes[:len(scriptSizes)-1]
+			break
+		}
+		tx.AddTxIn(wire.NewTxIn(&u.OutPoint, u.PrevOut.Value, nil))
+		res.Inputs = append(res.Inputs, u.OutPoint)
+		res.Amount += dcrutil.Amount(u.PrevOut.Value)
+		prevScripts = append(prevScripts, pkScript)
+	}
+	if len(tx.TxIn) == 0 {
+		return nil, errors.E(op, errors.Invalid,
+			"a single input exceeds the maximum transaction size")
+	}
+	if err := w.checkFeeRate(w.RelayFee()); err != nil {
+		return nil, errors.E(op, err)
+	}
+	size := txsizes.EstimateSerializeSize(scriptSizes, tx.TxOut, 0)
+	res.Fee = txrules.FeeForSerializeSize(w.RelayFee(), size)
+	txOut.Value = int64(res.Amount - res.Fee)
+	if txrules.IsDustOutput(txOut, w.RelayFee()) {
+		return nil, errors.E(op, errors.InsufficientBalance, errors.Errorf("swept "+
+			"value %v is too small to pay fee %v", res.Amount, res.Fee))
+	}
+
+	for i := range tx.TxIn {
+		tx.TxIn[i].SignatureScript, err = sign.SignatureScript(tx, i, pkScript,
+			txscript.SigHashAll, wif.PrivKey(), dcrec.STEcdsaSecp256k1, true)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+	if err := validateMsgTx(op, tx, prevScripts); err != nil {
+		return nil, err
+	}
+
+	hash, err := w.PublishTransaction(ctx, tx, n)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	res.Hash = *hash
+	return res, nil
+}
+
+// findKeyOutputs returns the unspent outputs of main chain blocks at or after
+// startHeight paying to a P2PKH pkScript.  Blocks are only fetched when their
+// compact filter matches the script, which also matches blocks spending
+// outputs paying to the script.
+func (w *Wallet) findKeyOutputs(ctx context.Context, n NetworkBackend,
+	pkScript []byte, startHeight int32) ([]Input, error) {
+
+	type keyOutput struct {
+		Input
+		height   int32
+		coinbase bool
+	}
+	unspent := make(map[wire.OutPoint]*keyOutput)
+	var order []wire.OutPoint
+	matchData := [][]byte{pkScript}
+
+	var tipHeight int32
+	hashStorage := make([]chainhash.Hash, maxBlocksPerRescan)
+	var from chainhash.Hash
+	inclusive := true
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		_, tipHeight = w.txStore.MainChainTip(dbtx)
+		if startHeight > tipHeight {
+			return errors.E(errors.Invalid, "start height is above the main chain tip")
+		}
+		var err error
+		from, err = w.txStore.GetMainChainBlockHashForHeight(txmgrNs, startHeight)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	height := startHeight
+	for {
+		var hashes []chainhash.Hash
+		var matches []*chainhash.Hash
+		var matchHeights []int32
+		err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+			txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+			var err error
+			hashes, err = w.txStore.GetMainChainBlockHashes(txmgrNs, &from,
+				inclusive, hashStorage)
+			if err != nil {
+				return err
+			}
+			for i := range hashes {
+				key, filter, err := w.txStore.CFilterV2(dbtx, &hashes[i])
+				if err != nil {
+					return err
+				}
+				if filter.N() != 0 && filter.MatchAny(key, matchData) {
+					matches = append(matches, &hashes[i])
+					matchHeights = append(matchHeights, height+int32(i))
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(hashes) == 0 {
+			break
+		}
+
+		if len(matches) != 0 {
+			blocks, err := n.Blocks(ctx, matches)
+			if err != nil {
+				return nil, err
+			}
+			for i, b := range blocks {
+				for _, tx := range b.STransactions {
+					for _, in := range tx.TxIn {
+						delete(unspent, in.PreviousOutPoint)
+					}
+				}
+				// Stake transaction outputs are always tagged and
+				// never pay to the P2PKH script, so only outputs of
+				// the regular tree are recorded.
+				for k, tx := range b.Transactions {
+					for _, in := range tx.TxIn {
+						delete(unspent, in.PreviousOutPoint)
+					}
+					txHash := tx.TxHash()
+					for j, out := range tx.TxOut {
+						if out.Version != 0 || !bytes.Equal(out.PkScript, pkScript) {
+							continue
+						}
+						op := wire.OutPoint{Hash: txHash, Index: uint32(j), Tree: wire.TxTreeRegular}
+						unspent[op] = &keyOutput{
+							Input:    Input{OutPoint: op, PrevOut: *out},
+							height:   matchHeights[i],
+							coinbase: k == 0,
+						}
+						order = append(order, op)
+					}
+				}
+			}
+		}
+
+		from = hashes[len(hashes)-1]
+		height += int32(len(hashes))
+		inclusive = false
+	}
+
+	var inputs []Input
+	for _, op := range order {
+		u, ok := unspent[op]
+		if !ok {
+			continue
+		}
+		if u.coinbase && !coinbaseMatured(w.chainParams, u.height, tipHeight) {
+			continue
+		}
+		inputs = append(inputs, u.Input)
+	}
+	return inputs, nil
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet_test
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/wallettest"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/txscript/v4/stdscript"
+	"github.com/decred/dcrd/wire"
+)
+
+// newSweepKey returns a random private key and its P2PKH address.
+func newSweepKey(t *testing.T, params *chaincfg.Params) (*dcrutil.WIF, stdaddr.Address) {
+	t.Helper()
+	priv, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wif, err := dcrutil.NewWIF(priv.Serialize(), params.PrivateKeyID,
+		dcrec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(
+		stdaddr.Hash160(wif.PubKey()), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return wif, addr
+}
+
+func TestSweepPrivateKey(t *testing.T) {
+	ctx := context.Background()
+	h := wallettest.New(t, nil)
+	w := h.Wallet
+	params := w.ChainParams()
+	wif, addr := newSweepKey(t, params)
+
+	if _, err := w.SweepPrivateKey(ctx, h.Chain, wif, 0, 0); !errors.Is(err, errors.InsufficientBalance) {
+		t.Fatalf("sweep of key without outputs: %v", err)
+	}
+
+	// Of three outputs paying the key, one is spent before sweeping.
+	funds := []*wire.MsgTx{
+		h.Chain.FundingTx(addr, 1e8),
+		h.Chain.FundingTx(addr, 2e8),
+		h.Chain.FundingTx(addr, 3e8),
+	}
+	if _, err := h.Chain.MineBlock(ctx, funds[:2]...); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.Chain.MineBlock(ctx, funds[2]); err != nil {
+		t.Fatal(err)
+	}
+	spend := wire.NewMsgTx()
+	spend.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: funds[1].TxHash()}, 2e8, nil))
+	spend.AddTxOut(&wire.TxOut{Value: 2e8 - 1e4, PkScript: []byte{0x6a}})
+	if _, err := h.Chain.MineBlock(ctx, spend); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := w.SweepPrivateKey(ctx, h.Chain, wif, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []wire.OutPoint{
+		{Hash: funds[0].TxHash()},
+		{Hash: funds[2].TxHash()},
+	}
+	if len(res.Inputs) != len(want) || res.Inputs[0] != want[0] || res.Inputs[1] != want[1] {
+		t.Fatalf("swept inputs %v, want %v", res.Inputs, want)
+	}
+	if res.Amount != 4e8 || res.Fee <= 0 {
+		t.Fatalf("swept amount %v with fee %v", res.Amount, res.Fee)
+	}
+	published := h.Chain.Published()
+	if len(published) != 1 || published[0].TxHash() != res.Hash {
+		t.Fatalf("sweep transaction was not published")
+	}
+	sweep := published[0]
+	if len(sweep.TxOut) != 1 || sweep.TxOut[0].Value != int64(res.Amount-res.Fee) {
+		t.Fatalf("sweep pays %v", sweep.TxOut)
+	}
+	if _, err := w.KnownAddress(ctx, payAddr(t, sweep.TxOut[0], params)); err != nil {
+		t.Fatalf("sweep does not pay the wallet: %v", err)
+	}
+
+	// The key is not imported.
+	if _, err := w.KnownAddress(ctx, addr); !errors.Is(err, errors.NotExist) {
+		t.Fatalf("swept key is known by the wallet: %v", err)
+	}
+}
+
+func TestSweepPrivateKeyMaxTxSize(t *testing.T) {
+	ctx := context.Background()
+	params := chaincfg.SimNetParams()
+	params.MaxTxSize = 500
+	h := wallettest.New(t, &wallettest.Config{Params: params})
+	w := h.Wallet
+	wif, addr := newSweepKey(t, params)
+
+	var funds []*wire.MsgTx
+	for range 5 {
+		funds = append(funds, h.Chain.FundingTx(addr, 1e8))
+	}
+	if _, err := h.Chain.MineBlock(ctx, funds...); err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the outputs fitting in the maximum transaction size are swept.
+	res, err := w.SweepPrivateKey(ctx, h.Chain, wif, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Inputs) == 0 || len(res.Inputs) >= len(funds) {
+		t.Fatalf("swept %d of %d outputs", len(res.Inputs), len(funds))
+	}
+	for i, in := range res.Inputs {
+		if in.Hash != funds[i].TxHash() {
+			t.Fatalf("input %d spends %v, want the oldest outputs", i, in)
+		}
+	}
+	sweep := h.Chain.Published()[0]
+	if size := sweep.SerializeSize(); size > params.MaxTxSize {
+		t.Fatalf("sweep size %d exceeds maximum %d", size, params.MaxTxSize)
+	}
+}
+
+// payAddr returns the address paid by a P2PKH output.
+func payAddr(t *testing.T, out *wire.TxOut, params *chaincfg.Params) stdaddr.Address {
+	t.Helper()
+	_, addrs := stdscript.ExtractAddrs(out.Version, out.PkScript, params)
+	if len(addrs) != 1 {
+		t.Fatalf("output pays %d addresses", len(addrs))
+	}
+	return addrs[0]
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"sync"
+)
+
+// SyncPhase describes the step of wallet synchronization being performed by
+// a network backend.
+type SyncPhase uint8
+
+// Sync phases, in the order they are performed during an initial sync.
+const (
+	SyncPhaseConnecting SyncPhase = iota
+	SyncPhaseFetchingCFilters
+	SyncPhaseFetchingHeaders
+	SyncPhaseDiscoveringAddresses
+	SyncPhaseRescanning
+	SyncPhaseSynced
+)
+
+var syncPhaseStrings = [...]string{
+	SyncPhaseConnecting:           "connecting",
+	SyncPhaseFetchingCFilters:     "fetchingcfilters",
+	SyncPhaseFetchingHeaders:      "fetchingheaders",
+	SyncPhaseDiscoveringAddresses: "discoveringaddresses",
+	SyncPhaseRescanning:           "rescanning",
+	SyncPhaseSynced:               "synced",
+}
+
+// String returns the short lowercase name of the sync phase.
+func (p SyncPhase) String() string {
+	if int(p) < len(syncPhaseStrings) {
+		return syncPhaseStrings[p]
+	}
+	return "unknown"
+}
+
+// SyncPhaseTracker records the current sync phase of a network backend and
+// the block height it has progressed through during the phase.  The zero
+// value describes the connecting phase and is ready to use.  It is safe for
+// concurrent access.
+type SyncPhaseTracker struct {
+	mu     sync.Mutex
+	phase  SyncPhase
+	height int32
+}
+
+// Begin begins a new sync phase without any progress.
+func (t *SyncPhaseTracker) Begin(phase SyncPhase) {
+	t.mu.Lock()
+	t.phase = phase
+	t.height = 0
+	t.mu.Unlock()
+}
+
+// Progress records that the current phase has progressed through a block
+// height.
+func (t *SyncPhaseTracker) Progress(height int32) {
+	t.mu.Lock()
+	t.height = height
+	t.mu.Unlock()
+}
+
+// Phase returns the current sync phase and the height it has progressed
+// through.
+func (t *SyncPhaseTracker) Phase() (SyncPhase, int32) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.phase, t.height
+}
+
+// SyncStatusReporter is implemented by network backends which report the
+// phases of wallet synchronization.
+type SyncStatusReporter interface {
+	// SyncPhase returns the current sync phase and the block height the
+	// phase has progressed through.
+	SyncPhase() (SyncPhase, int32)
+
+	// SyncBackend returns a short description of the kind of backend and
+	// the addresses of the peers or servers it is syncing from.
+	SyncBackend() (kind string, peers []string)
+}
+
+// SyncStatus describes the synchronization of the wallet with the network.
+type SyncStatus struct {
+	Phase SyncPhase
+
+	// Height is the block height the current phase has progressed
+	// through, and TargetHeight is the height the phase completes at.
+	// Both are zero when the phase does not report progress.
+	Height       int32
+	TargetHeight int32
+
+	Synced    bool
+	TipHeight int32
+
+	// Backend describes the kind of network backend ("spv", "rpc", or
+	// "offline"), and is empty when the wallet is not associated with a
+	// backend.  Peers lists the addresses of the peers or servers the
+	// backend syncs from.
+	Backend string
+	Peers   []string
+}
+
+// SyncStatus returns the sync phase and progress of the wallet's network
+// backend.  Wallets without a network backend are reported as connecting.
+func (w *Wallet) SyncStatus(ctx context.Context) *SyncStatus {
+	s := new(SyncStatus)
+	_, s.TipHeight = w.MainChainTip(ctx)
+
+	n, err := w.NetworkBackend()
+	if err != nil {
+		return s
+	}
+	var syncTarget int32
+	s.Synced, syncTarget = n.Synced(ctx)
+	switch n := n.(type) {
+	case SyncStatusReporter:
+		s.Phase, s.Height = n.SyncPhase()
+		s.Backend, s.Peers = n.SyncBackend()
+	case OfflineNetworkBackend:
+		s.Backend = "offline"
+	}
+	if s.Synced {
+		s.Phase = SyncPhaseSynced
+	} else if s.Phase == SyncPhaseSynced {
+		s.Phase = SyncPhaseConnecting
+	}
+
+	switch s.Phase {
+	case SyncPhaseFetchingCFilters, SyncPhaseRescanning:
+		s.TargetHeight = s.TipHeight
+	case SyncPhaseFetchingHeaders:
+		s.TargetHeight = syncTarget
+	case SyncPhaseSynced:
+		s.Height = s.TipHeight
+		s.TargetHeight = s.TipHeight
+	default:
+		s.Height = 0
+	}
+	return s
+}
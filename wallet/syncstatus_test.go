@@ -0,0 +1,77 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+)
+
+// phaseNetwork is a NetworkBackend reporting sync phases from a tracker.
+type phaseNetwork struct {
+	mockNetwork
+	phase        *SyncPhaseTracker
+	synced       bool
+	targetHeight int32
+}
+
+func (n phaseNetwork) Synced(ctx context.Context) (bool, int32) {
+	return n.synced, n.targetHeight
+}
+
+func (n phaseNetwork) SyncPhase() (SyncPhase, int32) {
+	return n.phase.Phase()
+}
+
+func (n phaseNetwork) SyncBackend() (string, []string) {
+	return "spv", []string{"127.0.0.1:9108"}
+}
+
+func TestSyncStatus(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	s := w.SyncStatus(ctx)
+	if s.Phase != SyncPhaseConnecting || s.Synced || s.Backend != "" {
+		t.Errorf("unexpected status without a backend: %+v", s)
+	}
+
+	w.SetNetworkBackend(OfflineNetworkBackend{})
+	s = w.SyncStatus(ctx)
+	if s.Phase != SyncPhaseSynced || !s.Synced || s.Backend != "offline" {
+		t.Errorf("unexpected offline status: %+v", s)
+	}
+
+	tracker := new(SyncPhaseTracker)
+	n := phaseNetwork{phase: tracker, targetHeight: 1000}
+	w.SetNetworkBackend(n)
+	s = w.SyncStatus(ctx)
+	if s.Phase != SyncPhaseConnecting || s.Backend != "spv" || len(s.Peers) != 1 {
+		t.Errorf("unexpected connecting status: %+v", s)
+	}
+
+	tracker.Begin(SyncPhaseFetchingHeaders)
+	tracker.Progress(400)
+	s = w.SyncStatus(ctx)
+	if s.Phase != SyncPhaseFetchingHeaders || s.Height != 400 || s.TargetHeight != 1000 {
+		t.Errorf("unexpected headers status: %+v", s)
+	}
+
+	tracker.Begin(SyncPhaseDiscoveringAddresses)
+	s = w.SyncStatus(ctx)
+	if s.Phase != SyncPhaseDiscoveringAddresses || s.Height != 0 || s.TargetHeight != 0 {
+		t.Errorf("unexpected discovery status: %+v", s)
+	}
+
+	// Backends which consider the wallet synced are always reported in the
+	// synced phase.
+	n.synced = true
+	w.SetNetworkBackend(n)
+	s = w.SyncStatus(ctx)
+	if s.Phase != SyncPhaseSynced || s.Phase.String() != "synced" {
+		t.Errorf("unexpected synced status: %+v", s)
+	}
+}
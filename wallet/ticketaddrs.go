@@ -0,0 +1,270 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/blockchain/stake/v5"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/txscript/v4/stdscript"
+	"github.com/decred/dcrd/wire"
+)
+
+// SetTicketAddressCheck sets whether ticket purchases verify that the voting
+// and commitment addresses of each ticket were never used before, skipping
+// any previously used addresses.  The check is enabled by default, and is
+// held for the lifetime of the loaded wallet.
+//
+// Tickets purchased with a voting script always commit to the script's
+// address, and this is not considered reuse.
+func (w *Wallet) SetTicketAddressCheck(enabled bool) {
+	w.ticketAddrCheckMu.Lock()
+	w.ticketAddrCheckDisabled = !enabled
+	w.ticketAddrCheckMu.Unlock()
+}
+
+func (w *Wallet) ticketAddressCheck() bool {
+	w.ticketAddrCheckMu.Lock()
+	defer w.ticketAddrCheckMu.Unlock()
+	return !w.ticketAddrCheckDisabled
+}
+
+// ticketAddressReused returns whether a ticket address has received any mined
+// credit, has recorded usage on its account branch, or is committed to by a
+// ticket in committed, the addresses of all tickets recorded by the wallet.
+func (w *Wallet) ticketAddressReused(dbtx walletdb.ReadTx, addr stdaddr.StakeAddress,
+	committed map[string]struct{}) (bool, error) {
+
+	if _, ok := committed[addr.String()]; ok {
+		return true, nil
+	}
+	if w.txStore.ReceivedByAddress(dbtx, addr) != 0 {
+		return true, nil
+	}
+	xpa, ok := addr.(*xpubAddress)
+	if !ok {
+		return false, nil
+	}
+	ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+	props, err := w.manager.AccountProperties(ns, xpa.account)
+	if err != nil {
+		return false, err
+	}
+	lastUsed := props.LastUsedExternalIndex
+	if xpa.branch == udb.InternalBranch {
+		lastUsed = props.LastUsedInternalIndex
+	}
+	return lastUsed != ^uint32(0) && xpa.child <= lastUsed, nil
+}
+
+// ticketAddresses returns the voting address of a ticket, or nil if the ticket
+// commits voting rights to a script, and the addresses of its commitments.
+func (w *Wallet) ticketAddresses(ticket *wire.MsgTx) (stdaddr.Address, []stdaddr.Address, error) {
+	var voting stdaddr.Address
+	out := ticket.TxOut[0]
+	_, addrs := stdscript.ExtractAddrs(out.Version, out.PkScript, w.chainParams)
+	if len(addrs) == 1 {
+		if _, p2sh := addrs[0].(*stdaddr.AddressScriptHashV0); !p2sh {
+			voting = addrs[0]
+		}
+	}
+	var commitments []stdaddr.Address
+	for i := 1; i < len(ticket.TxOut); i += 2 { // iterate commitments
+		script := ticket.TxOut[i].PkScript
+		addr, err := stake.AddrFromSStxPkScrCommitment(script, w.chainParams)
+		if err != nil {
+			return nil, nil, err
+		}
+		commitments = append(commitments, addr)
+	}
+	return voting, commitments, nil
+}
+
+// ticketCommittedAddresses returns the encoded voting and commitment
+// addresses of all tickets recorded by the wallet, mined or unmined.
+func (w *Wallet) ticketCommittedAddresses(dbtx walletdb.ReadTx) (map[string]struct{}, error) {
+	committed := make(map[string]struct{})
+	it := w.txStore.IterateTickets(dbtx)
+	defer it.Close()
+	for it.Next() {
+		voting, commitments, err := w.ticketAddresses(&it.MsgTx)
+		if err != nil {
+			return nil, err
+		}
+		if voting != nil {
+			committed[voting.String()] = struct{}{}
+		}
+		for _, a := range commitments {
+			committed[a.String()] = struct{}{}
+		}
+	}
+	return committed, it.Err()
+}
+
+// freshTicketAddresses derives ticket addresses which were never returned
+// for another ticket of the same purchase and, unless the check is disabled,
+// were never committed to by another ticket or paid by any mined transaction.
+type freshTicketAddresses struct {
+	w      *Wallet
+	derive func(op errors.Op, account, branch uint32) (stdaddr.StakeAddress, uint32, error)
+	check  bool
+	seen   map[string]struct{}
+
+	// committed records the addresses of the wallet's tickets, and is
+	// loaded by the first checked derivation.
+	committed map[string]struct{}
+}
+
+func (f *freshTicketAddresses) next(ctx context.Context, op errors.Op,
+	account, branch uint32) (stdaddr.StakeAddress, uint32, error) {
+
+	for skipped := uint32(0); ; skipped++ {
+		addr, idx, err := f.derive(op, account, branch)
+		if err != nil {
+			return nil, 0, err
+		}
+		if !f.check {
+			return addr, idx, nil
+		}
+		_, used := f.seen[addr.String()]
+		if !used {
+			err := walletdb.View(ctx, f.w.db, func(dbtx walletdb.ReadTx) error {
+				if f.committed == nil {
+					var err error
+					f.committed, err = f.w.ticketCommittedAddresses(dbtx)
+					if err != nil {
+						return err
+					}
+				}
+				var err error
+				used, err = f.w.ticketAddressReused(dbtx, addr, f.committed)
+				return err
+			})
+			if err != nil {
+				return nil, 0, err
+			}
+		}
+		if !used {
+			f.seen[addr.String()] = struct{}{}
+			return addr, idx, nil
+		}
+		if skipped >= f.w.gapLimit {
+			return nil, 0, errors.E(op, errors.Policy, errors.Errorf("no "+
+				"unused ticket address found for account %d after "+
+				"skipping %d used addresses", account, skipped))
+		}
+		log.Warnf("Skipping previously used ticket address %v", addr)
+	}
+}
+
+// ReusedTicketAddress describes a wallet address which was committed to by
+// more than a single ticket.
+type ReusedTicketAddress struct {
+	Address stdaddr.Address
+	Account uint32
+	Voting  bool // voting rights address rather than commitment
+	Tickets []chainhash.Hash
+}
+
+// TicketAddressReuse audits all tickets recorded by the wallet for voting and
+// commitment addresses shared by more than one ticket, as reuse links the
+// tickets to the same owner.  Only addresses of the wallet are reported, and
+// voting script (P2SH) voting addresses, which are shared by the tickets of
+// all users of the script, are not considered reuse.
+//
+// Addresses are reported in order of decreasing number of tickets.
+func (w *Wallet) TicketAddressReuse(ctx context.Context) ([]ReusedTicketAddress, error) {
+	const op errors.Op = "wallet.TicketAddressReuse"
+
+	type key struct {
+		addr   string
+		voting bool
+	}
+	uses := make(map[key]*ReusedTicketAddress)
+	record := func(addr stdaddr.Address, voting bool, ticket *chainhash.Hash) {
+		k := key{addr.String(), voting}
+		r, ok := uses[k]
+		if !ok {
+			r = &ReusedTicketAddress{Address: addr, Voting: voting}
+			uses[k] = r
+		}
+		for i := range r.Tickets {
+			if r.Tickets[i] == *ticket {
+				return
+			}
+		}
+		r.Tickets = append(r.Tickets, *ticket)
+	}
+	err := w.GetTransactions(ctx, func(b *Block) (bool, error) {
+		for _, tx := range b.Transactions {
+			if tx.Type != TransactionTypeTicketPurchase {
+				continue
+			}
+			ticket := new(wire.MsgTx)
+			err := ticket.Deserialize(bytes.NewReader(tx.Transaction))
+			if err != nil {
+				return false, err
+			}
+			voting, commitments, err := w.ticketAddresses(ticket)
+			if err != nil {
+				return false, err
+			}
+			if voting != nil {
+				record(voting, true, tx.Hash)
+			}
+			for _, addr := range commitments {
+				record(addr, false, tx.Hash)
+			}
+		}
+		return false, nil
+	}, nil, nil)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	// Accounts are looked up after the transactions are iterated, as the
+	// iteration holds its own database view.  Addresses which do not
+	// belong to the wallet are not reported.
+	var reused []ReusedTicketAddress
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		for _, r := range uses {
+			if len(r.Tickets) < 2 {
+				continue
+			}
+			account, err := w.manager.AddrAccount(addrmgrNs, r.Address)
+			if errors.Is(err, errors.NotExist) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			r.Account = account
+			reused = append(reused, *r)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	sort.Slice(reused, func(i, j int) bool {
+		a, b := &reused[i], &reused[j]
+		if len(a.Tickets) != len(b.Tickets) {
+			return len(a.Tickets) > len(b.Tickets)
+		}
+		if a.Voting != b.Voting {
+			return a.Voting
+		}
+		return a.Address.String() < b.Address.String()
+	})
+	return reused, nil
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestTicketAddressReuse(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	stakeAddr := func() stdaddr.StakeAddress {
+		t.Helper()
+		addr, err := w.NewExternalAddress(ctx, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return addr.(stdaddr.StakeAddress)
+	}
+	addTicket := func(index uint32, vote, commitment stdaddr.StakeAddress) {
+		t.Helper()
+		input := &Input{
+			OutPoint: wire.OutPoint{Index: index},
+			PrevOut:  wire.TxOut{Value: 2e8},
+		}
+		ticket, err := makeTicket(w.chainParams, input, vote, commitment, 1e8)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := w.AddTransaction(ctx, ticket, &w.chainParams.GenesisHash); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	vote, commitment := stakeAddr(), stakeAddr()
+	addTicket(0, vote, commitment)
+	addTicket(1, vote, commitment)
+	addTicket(2, stakeAddr(), stakeAddr())
+
+	reused, err := w.TicketAddressReuse(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reused) != 2 {
+		t.Fatalf("reported %d reused ticket addresses, want 2", len(reused))
+	}
+	if !reused[0].Voting || reused[0].Address.String() != vote.String() {
+		t.Errorf("first reused address is %v (voting %v)", reused[0].Address, reused[0].Voting)
+	}
+	if reused[1].Voting || reused[1].Address.String() != commitment.String() {
+		t.Errorf("second reused address is %v (voting %v)", reused[1].Address, reused[1].Voting)
+	}
+	for _, r := range reused {
+		if len(r.Tickets) != 2 {
+			t.Errorf("address %v reused by %d tickets, want 2", r.Address, len(r.Tickets))
+		}
+	}
+
+	// A commitment address of a ticket which is neither paid by a mined
+	// credit nor marked used.
+	internal, err := w.NewInternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	committed := internal.(stdaddr.StakeAddress)
+	addTicket(3, stakeAddr(), committed)
+
+	// Fresh ticket addresses skip addresses with recorded usage, those
+	// committed to by other tickets, and those already returned for the
+	// purchase.
+	unused, unused2 := stakeAddr(), stakeAddr()
+	derived := []stdaddr.StakeAddress{vote, committed, unused, unused, unused2}
+	f := &freshTicketAddresses{
+		w: w,
+		derive: func(op errors.Op, account, branch uint32) (stdaddr.StakeAddress, uint32, error) {
+			a := derived[0]
+			derived = derived[1:]
+			return a, 0, nil
+		},
+		check: true,
+		seen:  make(map[string]struct{}),
+	}
+	for _, want := range []stdaddr.StakeAddress{unused, unused2} {
+		addr, _, err := f.next(ctx, "test", 0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if addr.String() != want.String() {
+			t.Errorf("fresh ticket address is %v, want %v", addr, want)
+		}
+	}
+}
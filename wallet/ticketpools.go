@@ -0,0 +1,152 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"sort"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v4"
+)
+
+// TicketPool summarizes the unspent, unexpired tickets of the wallet which
+// are managed by a single VSP.  Tickets which were never processed by a VSP
+// are grouped in a pool with an empty Host.
+type TicketPool struct {
+	Host string
+
+	Unmined  int
+	Immature int
+	Live     int
+
+	// FeePending counts tickets whose VSP fee payment has not been
+	// confirmed by the VSP.
+	FeePending int
+
+	// LockedValue is the total ticket price of all tickets.
+	LockedValue dcrutil.Amount
+
+	// Expiries is a histogram of the expiry heights of all mined tickets,
+	// in order of increasing height.  Intervals without expiring tickets are
+	// omitted.
+	Expiries []TicketExpiryInterval
+}
+
+// Tickets returns the total number of tickets in the pool.
+func (p *TicketPool) Tickets() int {
+	return p.Unmined + p.Immature + p.Live
+}
+
+// TicketExpiryInterval describes the tickets expiring in the block heights
+// [StartHeight, EndHeight].
+type TicketExpiryInterval struct {
+	StartHeight int32
+	EndHeight   int32
+	Tickets     int
+	Value       dcrutil.Amount
+}
+
+// TicketPools returns the live ticket exposure of the wallet grouped by the
+// VSP managing each ticket, sorted by host.  Expiry heights are divided into
+// intervals of the given number of blocks, beginning at the block after the
+// main chain tip.
+//
+// Live tickets are the mature tickets that have not been spent or reached
+// expiry.  The wallet is unable to determine whether these tickets were
+// missed and not yet revoked.
+func (w *Wallet) TicketPools(ctx context.Context, interval int32) ([]TicketPool, error) {
+	const op errors.Op = "wallet.TicketPools"
+	if interval < 1 {
+		return nil, errors.E(op, errors.Invalid, "interval must be positive")
+	}
+
+	pools := make(map[string]*TicketPool)
+	expiries := make(map[string]map[int32]*TicketExpiryInterval)
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		_, tipHeight := w.txStore.MainChainTip(dbtx)
+
+		it := w.txStore.IterateTickets(dbtx)
+		defer it.Close()
+		for it.Next() {
+			if it.SpenderHash != (chainhash.Hash{}) ||
+				ticketExpired(w.chainParams, it.Block.Height, tipHeight) {
+				continue
+			}
+
+			var host string
+			feePending := true
+			vspTicket, err := udb.GetVSPTicket(dbtx, it.Hash)
+			switch {
+			case errors.Is(err, errors.NotExist):
+				feePending = false
+			case err != nil:
+				return err
+			default:
+				host = vspTicket.Host
+				feePending = vspTicket.FeeTxStatus != uint32(udb.VSPFeeProcessConfirmed)
+			}
+
+			p := pools[host]
+			if p == nil {
+				p = &TicketPool{Host: host}
+				pools[host] = p
+				expiries[host] = make(map[int32]*TicketExpiryInterval)
+			}
+			if feePending {
+				p.FeePending++
+			}
+			value := dcrutil.Amount(it.MsgTx.TxOut[0].Value)
+			p.LockedValue += value
+
+			switch {
+			case it.Block.Height == -1:
+				p.Unmined++
+				continue
+			case !ticketMatured(w.chainParams, it.Block.Height, tipHeight):
+				p.Immature++
+			default:
+				p.Live++
+			}
+
+			// Note the off-by-one of ticket maturity also applies to the
+			// expiry height.
+			expiry := it.Block.Height + int32(w.chainParams.TicketMaturity) +
+				int32(w.chainParams.TicketExpiry) + 1
+			i := (expiry - tipHeight - 1) / interval
+			e := expiries[host][i]
+			if e == nil {
+				start := tipHeight + 1 + i*interval
+				e = &TicketExpiryInterval{
+					StartHeight: start,
+					EndHeight:   start + interval - 1,
+				}
+				expiries[host][i] = e
+			}
+			e.Tickets++
+			e.Value += value
+		}
+		return it.Err()
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	res := make([]TicketPool, 0, len(pools))
+	for host, p := range pools {
+		for _, e := range expiries[host] {
+			p.Expiries = append(p.Expiries, *e)
+		}
+		sort.Slice(p.Expiries, func(i, j int) bool {
+			return p.Expiries[i].StartHeight < p.Expiries[j].StartHeight
+		})
+		res = append(res, *p)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Host < res[j].Host })
+	return res, nil
+}
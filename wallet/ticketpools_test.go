@@ -0,0 +1,108 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestTicketPools(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	addTicket := func(price int64, blockHash *chainhash.Hash, host string,
+		feeStatus udb.FeeStatus) {
+
+		t.Helper()
+		addr, err := w.NewExternalAddress(ctx, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		stakeAddr := addr.(stdaddr.StakeAddress)
+		ticket := wire.NewMsgTx()
+		ticket.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{byte(price)}}, price, nil))
+		version, pkScript := stakeAddr.VotingRightsScript()
+		ticket.AddTxOut(&wire.TxOut{Value: price, Version: version, PkScript: pkScript})
+		version, pkScript = stakeAddr.RewardCommitmentScript(price, 0, 0)
+		ticket.AddTxOut(&wire.TxOut{Version: version, PkScript: pkScript})
+		version, pkScript = stakeAddr.StakeChangeScript()
+		ticket.AddTxOut(&wire.TxOut{Version: version, PkScript: pkScript})
+		if err := w.AddTransaction(ctx, ticket, blockHash); err != nil {
+			t.Fatal(err)
+		}
+		if host == "" {
+			return
+		}
+		ticketHash := ticket.TxHash()
+		err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+			return udb.SetVSPTicket(dbtx, &ticketHash, &udb.VSPTicket{
+				FeeTxStatus: uint32(feeStatus),
+				Host:        host,
+				PubKey:      []byte(host),
+			})
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	genesis := &w.chainParams.GenesisHash
+	addTicket(1e8, genesis, "", 0)
+	addTicket(2e8, genesis, "https://vsp.one", udb.VSPFeeProcessConfirmed)
+	addTicket(3e8, genesis, "https://vsp.one", udb.VSPFeeProcessPaid)
+	addTicket(4e8, nil, "https://vsp.two", udb.VSPFeeProcessStarted)
+
+	pools, err := w.TicketPools(ctx, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pools) != 3 {
+		t.Fatalf("got %d pools, want 3", len(pools))
+	}
+
+	// Tickets mined in the genesis block expire at the same height.
+	expiry := int32(w.chainParams.TicketMaturity) + int32(w.chainParams.TicketExpiry) + 1
+	start := (expiry-1)/100*100 + 1
+	tests := []struct {
+		host                          string
+		immature, unmined, feePending int
+		locked                        dcrutil.Amount
+		expiries                      []TicketExpiryInterval
+	}{
+		{"", 1, 0, 0, 1e8, []TicketExpiryInterval{{start, start + 99, 1, 1e8}}},
+		{"https://vsp.one", 2, 0, 1, 5e8, []TicketExpiryInterval{{start, start + 99, 2, 5e8}}},
+		{"https://vsp.two", 0, 1, 1, 4e8, nil},
+	}
+	for i, tc := range tests {
+		p := &pools[i]
+		if p.Host != tc.host || p.Immature != tc.immature || p.Live != 0 ||
+			p.Unmined != tc.unmined || p.FeePending != tc.feePending ||
+			p.LockedValue != tc.locked {
+			t.Errorf("pool %d: unexpected summary %+v", i, p)
+		}
+		if len(p.Expiries) != len(tc.expiries) {
+			t.Errorf("pool %d: expiries %+v, want %+v", i, p.Expiries, tc.expiries)
+			continue
+		}
+		for j := range tc.expiries {
+			if p.Expiries[j] != tc.expiries[j] {
+				t.Errorf("pool %d: expiries %+v, want %+v", i, p.Expiries, tc.expiries)
+			}
+		}
+	}
+
+	if _, err := w.TicketPools(ctx, 0); !errors.Is(err, errors.Invalid) {
+		t.Errorf("zero interval accepted: %v", err)
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/wire"
+)
+
+// Names of the wallet operations whose durations are recorded.
+const (
+	// TimingUnlock is the unlocking of the wallet with its passphrase,
+	// dominated by the scrypt derivation of the master key.
+	TimingUnlock = "unlock"
+
+	// TimingDeriveAddress is the derivation of a new child address.
+	TimingDeriveAddress = "deriveaddress"
+
+	// TimingDBView is the duration a read transaction is held open.
+	TimingDBView = "dbview"
+
+	// TimingDBWriteWait is the time spent waiting to begin a read-write
+	// transaction, during which another writer holds the database.
+	TimingDBWriteWait = "dbwritewait"
+
+	// TimingDBUpdate is the duration a read-write transaction is held open,
+	// including its commit.
+	TimingDBUpdate = "dbupdate"
+
+	// TimingSign is the signing of transaction inputs.
+	TimingSign = "sign"
+
+	// TimingPublish is the publishing of transactions to the network
+	// backend.
+	TimingPublish = "publish"
+)
+
+// OpTiming summarizes the recorded durations of a wallet operation.
+type OpTiming struct {
+	Name  string
+	Count uint64
+	Total time.Duration
+	Max   time.Duration
+}
+
+// Mean returns the mean duration of the operation.
+func (t *OpTiming) Mean() time.Duration {
+	if t.Count == 0 {
+		return 0
+	}
+	return t.Total / time.Duration(t.Count)
+}
+
+// opTimings records the durations of wallet operations.  The zero value is
+// ready for use.
+type opTimings struct {
+	mu  sync.Mutex
+	ops map[string]*OpTiming
+}
+
+// observe records the duration of the named operation which began at start.
+func (t *opTimings) observe(name string, start time.Time) {
+	d := time.Since(start)
+	t.mu.Lock()
+	if t.ops == nil {
+		t.ops = make(map[string]*OpTiming)
+	}
+	op, ok := t.ops[name]
+	if !ok {
+		op = &OpTiming{Name: name}
+		t.ops[name] = op
+	}
+	op.Count++
+	op.Total += d
+	if d > op.Max {
+		op.Max = d
+	}
+	t.mu.Unlock()
+}
+
+// summary returns the recorded timings sorted by operation name, optionally
+// resetting them.
+func (t *opTimings) summary(reset bool) []OpTiming {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	res := make([]OpTiming, 0, len(t.ops))
+	for _, op := range t.ops {
+		res = append(res, *op)
+	}
+	if reset {
+		t.ops = nil
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+	return res
+}
+
+// OpTimings returns the recorded durations of wallet operations since the
+// wallet was opened or the timings were last reset, sorted by operation name.
+// Operators may use these to attribute latency to passphrase unlocks, database
+// contention, signing, or the network backend.  Operations which have not
+// been performed are omitted.
+func (w *Wallet) OpTimings(reset bool) []OpTiming {
+	return w.timings.summary(reset)
+}
+
+// publishTransactions publishes transactions to the network backend,
+// recording the duration of the publish.
+func (w *Wallet) publishTransactions(ctx context.Context, n NetworkBackend, txs ...*wire.MsgTx) error {
+	defer w.timings.observe(TimingPublish, time.Now())
+	return n.PublishTransactions(ctx, txs...)
+}
+
+// timedDB wraps a database to record the time spent waiting for and holding
+// transactions.
+type timedDB struct {
+	walletdb.DB
+	t *opTimings
+}
+
+func (db *timedDB) BeginReadTx() (walletdb.ReadTx, error) {
+	tx, err := db.DB.BeginReadTx()
+	if err != nil {
+		return nil, err
+	}
+	return &timedReadTx{ReadTx: tx, t: db.t, start: time.Now()}, nil
+}
+
+func (db *timedDB) BeginReadWriteTx() (walletdb.ReadWriteTx, error) {
+	start := time.Now()
+	tx, err := db.DB.BeginReadWriteTx()
+	db.t.observe(TimingDBWriteWait, start)
+	if err != nil {
+		return nil, err
+	}
+	return &timedReadWriteTx{ReadWriteTx: tx, t: db.t, start: time.Now()}, nil
+}
+
+type timedReadTx struct {
+	walletdb.ReadTx
+	t     *opTimings
+	start time.Time
+}
+
+func (tx *timedReadTx) Rollback() error {
+	err := tx.ReadTx.Rollback()
+	tx.t.observe(TimingDBView, tx.start)
+	return err
+}
+
+type timedReadWriteTx struct {
+	walletdb.ReadWriteTx
+	t     *opTimings
+	start time.Time
+}
+
+func (tx *timedReadWriteTx) Commit() error {
+	err := tx.ReadWriteTx.Commit()
+	tx.t.observe(TimingDBUpdate, tx.start)
+	return err
+}
+
+func (tx *timedReadWriteTx) Rollback() error {
+	err := tx.ReadWriteTx.Rollback()
+	tx.t.observe(TimingDBUpdate, tx.start)
+	return err
+}
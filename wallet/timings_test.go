@@ -0,0 +1,47 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+)
+
+func TestOpTimings(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	w.Lock()
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.NewExternalAddress(ctx, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	timings := make(map[string]OpTiming)
+	for _, op := range w.OpTimings(true) {
+		timings[op.Name] = op
+	}
+	for _, name := range []string{TimingUnlock, TimingDeriveAddress,
+		TimingDBView, TimingDBWriteWait, TimingDBUpdate} {
+		op, ok := timings[name]
+		if !ok || op.Count == 0 {
+			t.Errorf("no %s timings recorded", name)
+			continue
+		}
+		if op.Max > op.Total || op.Mean() > op.Max {
+			t.Errorf("inconsistent %s timing %+v", name, op)
+		}
+	}
+	if _, ok := timings[TimingPublish]; ok {
+		t.Errorf("publish timing recorded without publishing")
+	}
+
+	if ops := w.OpTimings(false); len(ops) != 0 {
+		t.Errorf("timings not reset: %+v", ops)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/rpc/jsonrpc/types"
+	"github.com/decred/dcrd/txscript/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestTreasuryAddCredits(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, changeScript := addr.(stdaddr.StakeAddress).StakeChangeScript()
+	tadd := wire.NewMsgTx()
+	tadd.Version = wire.TxVersionTreasury
+	tadd.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, 3e8, nil))
+	tadd.AddTxOut(&wire.TxOut{Value: 1e8, PkScript: []byte{txscript.OP_TADD}})
+	tadd.AddTxOut(&wire.TxOut{Value: 2e8, Version: version, PkScript: changeScript})
+	if TxTransactionType(tadd) != TransactionTypeTreasuryAdd {
+		t.Fatalf("treasury add classified as %v", TxTransactionType(tadd))
+	}
+	if err := w.AddTransaction(ctx, tadd, &w.chainParams.GenesisHash); err != nil {
+		t.Fatal(err)
+	}
+
+	txs, err := w.ListTransactions(ctx, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(txs) != 1 {
+		t.Fatalf("expected one listed transaction, got %d", len(txs))
+	}
+	if txs[0].TxType == nil || *txs[0].TxType != types.LTTTTreasuryAdd {
+		t.Errorf("treasury add listed with type %v", txs[0].TxType)
+	}
+	if txs[0].Amount != 2 {
+		t.Errorf("treasury add change credited %v", txs[0].Amount)
+	}
+
+	// Change of a treasury add is immature until ticket change maturity.
+	unspent, err := w.ListUnspent(ctx, 0, 9999999, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unspent) != 0 {
+		t.Errorf("immature treasury add change listed as unspent")
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/blockchain/stake/v5"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/txscript/v4/sign"
+	"github.com/decred/dcrd/wire"
+)
+
+// errTreasuryKeysDisabled describes treasury key operations refused by wallets
+// which were not opened with the TreasuryKeys config option.
+var errTreasuryKeysDisabled = errors.E(errors.Permission,
+	"treasury keys are not enabled")
+
+// TreasuryKey describes an imported treasury spend signing key.
+type TreasuryKey struct {
+	PubKey  []byte // compressed
+	Name    string
+	Created time.Time
+}
+
+// checkTreasuryKey errors unless treasury keys are enabled and pubKey is one
+// of the treasury spend keys of the network.
+func (w *Wallet) checkTreasuryKey(pubKey []byte) error {
+	if !w.treasuryKeys {
+		return errTreasuryKeysDisabled
+	}
+	for _, piKey := range w.chainParams.PiKeys {
+		if bytes.Equal(pubKey, piKey) {
+			return nil
+		}
+	}
+	return errors.E(errors.Invalid, errors.Errorf("public key %x is not a "+
+		"treasury spend key of the %s network", pubKey, w.chainParams.Name))
+}
+
+// ImportTreasuryKey imports the private key of a treasury spend signing key
+// of the network, to be used by SignTSpend.  The key is encrypted by the
+// wallet's private passphrase, and is not associated with any account, so it
+// never receives or spends wallet funds and is never revealed by private key
+// exports.  Treasury keys may only be imported by unlocked wallets opened with
+// the TreasuryKeys config option.
+//
+// Returns the compressed public key of the imported key.
+func (w *Wallet) ImportTreasuryKey(ctx context.Context, name string, privKey []byte) ([]byte, error) {
+	const op errors.Op = "wallet.ImportTreasuryKey"
+	if len(privKey) != secp256k1.PrivKeyBytesLen {
+		return nil, errors.E(op, errors.Invalid, "invalid private key length")
+	}
+	priv := secp256k1.PrivKeyFromBytes(privKey)
+	defer priv.Zero()
+	pubKey := priv.PubKey().SerializeCompressed()
+	if err := w.checkTreasuryKey(pubKey); err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	encrypted, err := w.manager.Encrypt(udb.CKTPrivate, privKey)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	k := &udb.TreasuryKey{
+		PubKey:  pubKey,
+		Name:    name,
+		Created: time.Now(),
+	}
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.PutTreasuryKey(dbtx, k, encrypted)
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return pubKey, nil
+}
+
+// TreasuryKeys returns the imported treasury keys, ordered by public key.
+func (w *Wallet) TreasuryKeys(ctx context.Context) ([]TreasuryKey, error) {
+	const op errors.Op = "wallet.TreasuryKeys"
+	var keys []TreasuryKey
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		return udb.ForEachTreasuryKey(dbtx, func(k *udb.TreasuryKey) error {
+			keys = append(keys, TreasuryKey{
+				PubKey:  k.PubKey,
+				Name:    k.Name,
+				Created: k.Created,
+			})
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return keys, nil
+}
+
+// RemoveTreasuryKey removes an imported treasury key.  Treasury keys may only
+// be removed by unlocked wallets opened with the TreasuryKeys config option.
+func (w *Wallet) RemoveTreasuryKey(ctx context.Context, pubKey []byte) error {
+	const op errors.Op = "wallet.RemoveTreasuryKey"
+	if !w.treasuryKeys {
+		return errors.E(op, errTreasuryKeysDisabled)
+	}
+	if w.Locked() {
+		return errors.E(op, errors.Locked, "wallet must be unlocked to "+
+			"remove treasury keys")
+	}
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.DeleteTreasuryKey(dbtx, pubKey)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// SignTSpend signs the treasury spend tx with the imported treasury key
+// pubKey, replacing the signature script of its only input.  The transaction
+// must otherwise be a complete treasury spend.  The wallet must be unlocked
+// and opened with the TreasuryKeys config option.
+func (w *Wallet) SignTSpend(ctx context.Context, tx *wire.MsgTx, pubKey []byte) error {
+	const op errors.Op = "wallet.SignTSpend"
+	if err := w.checkTreasuryKey(pubKey); err != nil {
+		return errors.E(op, err)
+	}
+	if tx.Version != wire.TxVersionTreasury || len(tx.TxIn) != 1 {
+		return errors.E(op, errors.Invalid, "transaction is not a treasury spend")
+	}
+
+	var k *udb.TreasuryKey
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		k, err = udb.TreasuryKeyFor(dbtx, pubKey)
+		return err
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	privKey, err := w.manager.Decrypt(udb.CKTPrivate, k.Encrypted())
+	if err != nil {
+		return errors.E(op, err)
+	}
+	defer clear(privKey)
+	w.noteSigningActivity()
+
+	signed := tx.Copy()
+	sigScript, err := sign.TSpendSignatureScript(signed, privKey)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	signed.TxIn[0].SignatureScript = sigScript
+	if !stake.IsTSpend(signed) {
+		return errors.E(op, errors.Invalid, "transaction is not a treasury spend")
+	}
+	tx.TxIn[0].SignatureScript = sigScript
+	return nil
+}
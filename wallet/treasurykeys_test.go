@@ -0,0 +1,162 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"github.com/decred/dcrd/blockchain/stake/v5"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/schnorr"
+	"github.com/decred/dcrd/txscript/v4"
+	"github.com/decred/dcrd/wire"
+)
+
+// testTSpend returns an unsigned treasury spend paying a single P2PKH output.
+func testTSpend() *wire.MsgTx {
+	tx := wire.NewMsgTx()
+	tx.Version = wire.TxVersionTreasury
+	tx.Expiry = 1000
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: *wire.NewOutPoint(&chainhash.Hash{},
+			wire.MaxPrevOutIndex, wire.TxTreeRegular),
+		ValueIn: 1e8,
+	})
+	nullData := make([]byte, 0, 34)
+	nullData = append(nullData, txscript.OP_RETURN, txscript.OP_DATA_32)
+	nullData = append(nullData, bytes.Repeat([]byte{0x01}, 32)...)
+	tx.AddTxOut(wire.NewTxOut(0, nullData))
+	pkScript := []byte{txscript.OP_TGEN, txscript.OP_DUP, txscript.OP_HASH160,
+		txscript.OP_DATA_20}
+	pkScript = append(pkScript, make([]byte, 20)...)
+	pkScript = append(pkScript, txscript.OP_EQUALVERIFY, txscript.OP_CHECKSIG)
+	tx.AddTxOut(wire.NewTxOut(1e8-1e5, pkScript))
+	return tx
+}
+
+func TestTreasuryKeys(t *testing.T) {
+	ctx := context.Background()
+
+	priv, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	privKey := priv.Serialize()
+	pubKey := priv.PubKey().SerializeCompressed()
+
+	params := chaincfg.SimNetParams()
+	params.PiKeys = append(params.PiKeys, pubKey)
+	cfg := basicWalletConfig
+	cfg.Params = params
+
+	// Treasury keys may not be imported unless enabled.
+	w, teardown := testWallet(ctx, t, &cfg, nil)
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	_, err = w.ImportTreasuryKey(ctx, "pi", privKey)
+	if !errors.Is(err, errors.Permission) {
+		t.Fatalf("import with treasury keys disabled: %v", err)
+	}
+	err = w.RemoveTreasuryKey(ctx, pubKey)
+	if !errors.Is(err, errors.Permission) {
+		t.Fatalf("remove with treasury keys disabled: %v", err)
+	}
+	teardown()
+
+	cfg.TreasuryKeys = true
+	w, teardown = testWallet(ctx, t, &cfg, nil)
+	defer teardown()
+
+	// Importing requires an unlocked wallet.
+	_, err = w.ImportTreasuryKey(ctx, "pi", privKey)
+	if !errors.Is(err, errors.Locked) {
+		t.Fatalf("import by locked wallet: %v", err)
+	}
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Keys which are not treasury keys of the network are refused.
+	other, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = w.ImportTreasuryKey(ctx, "other", other.Serialize())
+	if !errors.Is(err, errors.Invalid) {
+		t.Fatalf("import of unknown key: %v", err)
+	}
+
+	imported, err := w.ImportTreasuryKey(ctx, "pi", privKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(imported, pubKey) {
+		t.Fatalf("imported pubkey %x, want %x", imported, pubKey)
+	}
+	_, err = w.ImportTreasuryKey(ctx, "pi", privKey)
+	if !errors.Is(err, errors.Exist) {
+		t.Fatalf("duplicate import: %v", err)
+	}
+	keys, err := w.TreasuryKeys(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 1 || !bytes.Equal(keys[0].PubKey, pubKey) ||
+		keys[0].Name != "pi" || keys[0].Created.IsZero() {
+		t.Fatalf("unexpected treasury keys %+v", keys)
+	}
+
+	tx := testTSpend()
+	if err := w.SignTSpend(ctx, tx, pubKey); err != nil {
+		t.Fatal(err)
+	}
+	sig, sigPubKey, err := stake.CheckTSpend(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(sigPubKey, pubKey) {
+		t.Fatalf("signed by %x, want %x", sigPubKey, pubKey)
+	}
+	hash, err := txscript.CalcSignatureHash(nil, txscript.SigHashAll, tx, 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	schnorrSig, err := schnorr.ParseSignature(sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !schnorrSig.Verify(hash, priv.PubKey()) {
+		t.Fatal("invalid treasury spend signature")
+	}
+
+	// Signing requires an unlocked wallet.
+	w.Lock()
+	if err := w.SignTSpend(ctx, testTSpend(), pubKey); !errors.Is(err, errors.Locked) {
+		t.Fatalf("sign by locked wallet: %v", err)
+	}
+
+	// Removal requires an unlocked wallet.
+	if err := w.RemoveTreasuryKey(ctx, pubKey); !errors.Is(err, errors.Locked) {
+		t.Fatalf("remove by locked wallet: %v", err)
+	}
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.RemoveTreasuryKey(ctx, pubKey); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.RemoveTreasuryKey(ctx, pubKey); !errors.Is(err, errors.NotExist) {
+		t.Fatalf("remove of removed key: %v", err)
+	}
+	if err := w.SignTSpend(ctx, testTSpend(), pubKey); !errors.Is(err, errors.NotExist) {
+		t.Fatalf("sign with removed key: %v", err)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor
+
+import (
+	"bytes"
+	"cmp"
+	"slices"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/wire"
+)
+
+// compareHashes compares two hashes in the byte order of their string
+// encoding, which is the reverse of their in-memory order.
+func compareHashes(a, b *chainhash.Hash) int {
+	for i := chainhash.HashSize - 1; i >= 0; i-- {
+		if c := cmp.Compare(a[i], b[i]); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// compareInputs orders inputs by previous outpoint hash, index, and tree.
+func compareInputs(a, b *wire.TxIn) int {
+	pa, pb := &a.PreviousOutPoint, &b.PreviousOutPoint
+	if c := compareHashes(&pa.Hash, &pb.Hash); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(pa.Index, pb.Index); c != 0 {
+		return c
+	}
+	return cmp.Compare(pa.Tree, pb.Tree)
+}
+
+// compareOutputs orders outputs by value, script version, and script.
+func compareOutputs(a, b *wire.TxOut) int {
+	if c := cmp.Compare(a.Value, b.Value); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.Version, b.Version); c != 0 {
+		return c
+	}
+	return bytes.Compare(a.PkScript, b.PkScript)
+}
+
+// SortBIP69 sorts the inputs and outputs of an authored transaction into the
+// canonical order described by BIP0069, adapted for Decred outpoint trees and
+// output script versions.  The previous output scripts and change index are
+// updated to match the new positions.  Since the resulting order only depends
+// on the inputs and outputs themselves, independently authored transactions
+// spending the same outputs to the same destinations are identical.  This
+// should be done before signing and replaces randomizing the change position.
+func (tx *AuthoredTx) SortBIP69() {
+	var change *wire.TxOut
+	if tx.ChangeIndex >= 0 {
+		change = tx.Tx.TxOut[tx.ChangeIndex]
+	}
+
+	perm := make([]int, len(tx.Tx.TxIn))
+	for i := range perm {
+		perm[i] = i
+	}
+	slices.SortStableFunc(perm, func(i, j int) int {
+		return compareInputs(tx.Tx.TxIn[i], tx.Tx.TxIn[j])
+	})
+	inputs := make([]*wire.TxIn, len(perm))
+	scripts := make([][]byte, len(perm))
+	for i, j := range perm {
+		inputs[i] = tx.Tx.TxIn[j]
+		scripts[i] = tx.PrevScripts[j]
+	}
+	tx.Tx.TxIn = inputs
+	tx.PrevScripts = scripts
+
+	// The outputs may share a backing array with the caller's outputs, so
+	// sort a copy.
+	tx.Tx.TxOut = slices.Clone(tx.Tx.TxOut)
+	slices.SortStableFunc(tx.Tx.TxOut, compareOutputs)
+	if change != nil {
+		tx.ChangeIndex = slices.Index(tx.Tx.TxOut, change)
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor_test
+
+import (
+	"bytes"
+	"testing"
+
+	"decred.org/dcrwallet/v5/wallet/txauthor"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestSortBIP69(t *testing.T) {
+	// Hashes compare in string encoding order, so hashLow sorts before
+	// hashHigh despite its greater first byte.
+	hashLow := chainhash.Hash{0: 0xff}
+	hashHigh := chainhash.Hash{31: 0x01}
+	newTx := func(order []int) *txauthor.AuthoredTx {
+		inputs := []*wire.TxIn{
+			wire.NewTxIn(&wire.OutPoint{Hash: hashLow, Index: 0}, 1, nil),
+			wire.NewTxIn(&wire.OutPoint{Hash: hashLow, Index: 1}, 1, nil),
+			wire.NewTxIn(&wire.OutPoint{Hash: hashHigh, Index: 0}, 1, nil),
+		}
+		outputs := []*wire.TxOut{
+			{Value: 5, PkScript: []byte{1}},
+			{Value: 5, PkScript: []byte{2}},
+			{Value: 7, PkScript: []byte{0}}, // change
+		}
+		atx := &txauthor.AuthoredTx{Tx: wire.NewMsgTx(), ChangeIndex: -1}
+		for _, i := range order {
+			atx.Tx.AddTxIn(inputs[i])
+			atx.PrevScripts = append(atx.PrevScripts, []byte{byte(i)})
+			atx.Tx.AddTxOut(outputs[i])
+			if i == 2 {
+				atx.ChangeIndex = len(atx.Tx.TxOut) - 1
+			}
+		}
+		return atx
+	}
+
+	want := newTx([]int{0, 1, 2})
+	want.SortBIP69()
+	for _, order := range [][]int{{2, 1, 0}, {1, 2, 0}, {0, 2, 1}} {
+		atx := newTx(order)
+		atx.SortBIP69()
+		if atx.Tx.TxHash() != want.Tx.TxHash() {
+			t.Errorf("order %v: sorted transaction differs", order)
+		}
+		for i, in := range atx.Tx.TxIn {
+			script := []byte{byte(in.PreviousOutPoint.Index)}
+			if in.PreviousOutPoint.Hash == hashHigh {
+				script = []byte{2}
+			}
+			if !bytes.Equal(atx.PrevScripts[i], script) {
+				t.Errorf("order %v: previous script %d not moved with input", order, i)
+			}
+		}
+		if atx.ChangeIndex != 2 || atx.Tx.TxOut[2].Value != 7 {
+			t.Errorf("order %v: change index %d", order, atx.ChangeIndex)
+		}
+	}
+	if want.Tx.TxIn[2].PreviousOutPoint.Hash != hashHigh {
+		t.Errorf("inputs not sorted by reversed hash")
+	}
+	if !bytes.Equal(want.Tx.TxOut[0].PkScript, []byte{1}) {
+		t.Errorf("equal value outputs not sorted by script")
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"math"
+
+	"decred.org/dcrwallet/v5/errors"
+	"github.com/decred/dcrd/wire"
+)
+
+// WithTxExpiry overrides the wallet's transaction expiry for a single
+// transaction authoring call.  The transaction may only be mined in the next
+// blocks blocks, and a zero value creates a transaction which never expires.
+func WithTxExpiry(blocks int32) TxAuthorOption {
+	return func(o *txAuthorOptions) {
+		o.txExpiry = blocks
+		o.txExpirySet = true
+	}
+}
+
+// SetTxExpiry sets the number of blocks in which payments published by the
+// wallet may be mined.  Transactions which are not mined before the expiry are
+// removed from the unmined transaction set, releasing their inputs to be spent
+// again, and are reported by the ExpiredTransactions field of transaction
+// notifications.  A zero value, the default, creates transactions which never
+// expire.  The setting is not persisted.
+//
+// Ticket purchases, votes, revocations, and mixing transactions are
+// unaffected, and unsigned transactions are only given an expiry by the
+// WithTxExpiry option.
+func (w *Wallet) SetTxExpiry(blocks int32) error {
+	const op errors.Op = "wallet.SetTxExpiry"
+	if blocks < 0 {
+		return errors.E(op, errors.Invalid, "negative transaction expiry")
+	}
+	w.txExpiryMu.Lock()
+	w.txExpiry = blocks
+	w.txExpiryMu.Unlock()
+	return nil
+}
+
+// TxExpiry returns the number of blocks in which payments published by the
+// wallet may be mined, or zero if they never expire.
+func (w *Wallet) TxExpiry() int32 {
+	w.txExpiryMu.Lock()
+	defer w.txExpiryMu.Unlock()
+	return w.txExpiry
+}
+
+// resolveTxExpiry returns the expiry of an authoring call with the options
+// opts.  When the option is not provided, def is returned.
+func resolveTxExpiry(def int32, opts []TxAuthorOption) (int32, error) {
+	var o txAuthorOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if !o.txExpirySet {
+		return def, nil
+	}
+	if o.txExpiry < 0 {
+		return 0, errors.E(errors.Invalid, "negative transaction expiry")
+	}
+	return o.txExpiry, nil
+}
+
+// expiryHeight returns the expiry of a transaction which may be mined in the
+// next blocks blocks after the block at tipHeight.  Transactions can not be
+// mined in blocks at or above their expiry height.
+func expiryHeight(tipHeight, blocks int32) uint32 {
+	if blocks == 0 {
+		return wire.NoExpiryValue
+	}
+	h := int64(tipHeight) + 1 + int64(blocks)
+	if h > math.MaxUint32 {
+		return wire.NoExpiryValue
+	}
+	return uint32(h)
+}
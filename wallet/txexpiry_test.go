@@ -0,0 +1,135 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestTxExpiry(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, nil)
+	defer teardown()
+
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, pkScript := addr.PaymentScript()
+	fund := wire.NewMsgTx()
+	fund.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, 0, nil))
+	fund.AddTxOut(&wire.TxOut{Value: 10e8, Version: version, PkScript: pkScript})
+	if err := w.AddTransaction(ctx, fund, &w.chainParams.GenesisHash); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := w.SetTxExpiry(-1); !errors.Is(err, errors.Invalid) {
+		t.Fatalf("set negative expiry: %v", err)
+	}
+	if err := w.SetTxExpiry(10); err != nil {
+		t.Fatal(err)
+	}
+
+	spend := func(opts ...TxAuthorOption) (*wire.MsgTx, error) {
+		out := &wire.TxOut{Value: 1e8, PkScript: []byte{0x51}}
+		atx, err := w.NewUnsignedTransaction(ctx, []*wire.TxOut{out},
+			w.RelayFee(), 0, 0, OutputSelectionAlgorithmDefault, nil, nil,
+			opts...)
+		if err != nil {
+			return nil, err
+		}
+		return atx.Tx, nil
+	}
+
+	// Unsigned transactions ignore the wallet setting.
+	tx, err := spend()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.Expiry != wire.NoExpiryValue {
+		t.Errorf("unsigned transaction has expiry %d", tx.Expiry)
+	}
+	if _, err := spend(WithTxExpiry(-1)); !errors.Is(err, errors.Invalid) {
+		t.Errorf("negative expiry option: %v", err)
+	}
+
+	// With a main chain tip at height 0, a transaction which may be mined
+	// in the next block expires at height 2.
+	tx, err = spend(WithTxExpiry(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.Expiry != 2 {
+		t.Fatalf("expiry %d, want 2", tx.Expiry)
+	}
+	if err := w.AddTransaction(ctx, tx, nil); err != nil {
+		t.Fatal(err)
+	}
+	txHash := tx.TxHash()
+
+	n := w.NtfnServer.TransactionNotifications()
+	defer n.Done()
+	ntfns := make(chan *TransactionNotifications, 2)
+	go func() {
+		for v := range n.C {
+			ntfns <- v
+		}
+	}()
+
+	tg := maketg(t, cfg.Params)
+	tw := &tw{t, w}
+	forest := new(SidechainForest)
+	attach := func(b *gblock) *TransactionNotifications {
+		t.Helper()
+		mustAddBlockNode(t, forest, b.BlockNode)
+		tw.chainSwitch(ctx, forest, tw.evaluateBestChain(ctx, forest, 1, b.Hash))
+		select {
+		case v := <-ntfns:
+			return v
+		case <-time.After(5 * time.Second):
+			t.Fatal("no transaction notification")
+			return nil
+		}
+	}
+
+	v := attach(tg.createBlockOne("block-one"))
+	if len(v.ExpiredTransactions) != 0 || len(v.UnminedTransactionHashes) != 1 {
+		t.Fatalf("transaction expired early: %+v", v)
+	}
+	v = attach(tg.nextBlock("block-two", nil, nil))
+	if len(v.ExpiredTransactions) != 1 || *v.ExpiredTransactions[0].Hash != txHash {
+		t.Fatalf("expired transaction not notified: %+v", v)
+	}
+	if len(v.UnminedTransactionHashes) != 0 {
+		t.Errorf("expired transaction remains unmined")
+	}
+	if len(v.NewBalances) == 0 {
+		t.Errorf("balance of account with expired transaction not notified")
+	}
+
+	events, _, err := w.JournalEvents(ctx, 1, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var journaled bool
+	for _, e := range events {
+		if e.Type == udb.JournalTxExpired && e.Hash == txHash {
+			journaled = true
+		}
+	}
+	if !journaled {
+		t.Errorf("expired transaction not journaled")
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txsizes
+
+import "github.com/decred/dcrd/txscript/v4"
+
+// Script size estimates of stake transactions.
+const (
+	// StakeBaseSigScriptSize is the size of the signature script of the
+	// stakebase input of votes, which is the StakeBaseSigScript of the
+	// network parameters.
+	StakeBaseSigScriptSize = 2
+
+	// VoteBlockRefScriptSize is the size of the output script of votes
+	// referencing the voted block.  It is calculated as:
+	//
+	//   - OP_RETURN
+	//   - OP_DATA_36
+	//   - 32 bytes block hash
+	//   - 4 bytes block height
+	VoteBlockRefScriptSize = 1 + 1 + 32 + 4
+
+	// VoteBitsScriptSize is the size of the output script of votes
+	// recording the vote bits created by the wallet.  It is calculated as:
+	//
+	//   - OP_RETURN
+	//   - OP_DATA_6
+	//   - 2 bytes vote bits
+	//   - 4 bytes extended vote bits recording the vote version
+	VoteBitsScriptSize = 1 + 1 + 2 + 4
+)
+
+// RedeemMultiSigSigScriptSize returns the worst case (largest) serialize size
+// of a transaction input script that redeems a P2SH output of a required-of-
+// keys multisig script of compressed pubkeys.  It is calculated as:
+//
+//   - required OP_DATA_73 and 73-byte signature pairs
+//   - the push of the redeem script
+//   - OP_required, keys OP_DATA_33 and 33 byte pubkey pairs, OP_keys, and
+//     OP_CHECKMULTISIG
+func RedeemMultiSigSigScriptSize(required, keys int) int {
+	redeemScriptSize := 1 + keys*(1+33) + 1 + 1
+	pushSize := 1
+	switch {
+	case redeemScriptSize > 0xff:
+		pushSize = 3
+	case redeemScriptSize > txscript.OP_DATA_75:
+		pushSize = 2
+	}
+	return required*(1+73) + pushSize + redeemScriptSize
+}
+
+// EstimateTicketSize returns the worst case serialize size estimate for a
+// signed ticket purchase spending inputs redeemed by signature scripts of the
+// sizes in inputSizes.  Tickets pay a stake submission output with a script of
+// submissionScriptSize, and a ticket commitment output and OP_SSTXCHANGE
+// tagged change output with a script of changeScriptSize for each input.
+func EstimateTicketSize(inputSizes []int, submissionScriptSize, changeScriptSize int) int {
+	outputSizes := make([]int, 0, 1+2*len(inputSizes))
+	outputSizes = append(outputSizes, submissionScriptSize)
+	for range inputSizes {
+		outputSizes = append(outputSizes, TicketCommitmentScriptSize, changeScriptSize)
+	}
+	return EstimateSerializeSizeFromScriptSizes(inputSizes, outputSizes, 0)
+}
+
+// EstimateVoteSize returns the worst case serialize size estimate for a signed
+// vote spending a stakebase input and a ticket redeemed by a signature script
+// of ticketSigScriptSize.  Votes pay the block reference and vote bits
+// outputs, and an OP_SSGEN tagged output with a script of each size of
+// rewardScriptSizes for each ticket commitment.
+func EstimateVoteSize(ticketSigScriptSize int, rewardScriptSizes []int) int {
+	inputSizes := []int{StakeBaseSigScriptSize, ticketSigScriptSize}
+	outputSizes := make([]int, 0, 2+len(rewardScriptSizes))
+	outputSizes = append(outputSizes, VoteBlockRefScriptSize, VoteBitsScriptSize)
+	outputSizes = append(outputSizes, rewardScriptSizes...)
+	return EstimateSerializeSizeFromScriptSizes(inputSizes, outputSizes, 0)
+}
+
+// EstimateRevocationSize returns the serialize size of an automatic ticket
+// revocation, which spends the ticket with an empty signature script and pays
+// an OP_SSRTX tagged output with a script of each size of refundScriptSizes
+// for each ticket commitment.
+func EstimateRevocationSize(refundScriptSizes []int) int {
+	return EstimateSerializeSizeFromScriptSizes([]int{0}, refundScriptSizes, 0)
+}
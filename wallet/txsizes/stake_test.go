@@ -0,0 +1,76 @@
+package txsizes_test
+
+import (
+	"testing"
+
+	. "decred.org/dcrwallet/v5/wallet/txsizes"
+	"github.com/decred/dcrd/wire"
+)
+
+// makeTx returns a transaction with inputs and outputs of the script sizes.
+func makeTx(sigScriptSizes, pkScriptSizes []int) *wire.MsgTx {
+	tx := wire.NewMsgTx()
+	for _, size := range sigScriptSizes {
+		tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, 0, make([]byte, size)))
+	}
+	for _, size := range pkScriptSizes {
+		tx.AddTxOut(wire.NewTxOut(0, make([]byte, size)))
+	}
+	return tx
+}
+
+func TestRedeemMultiSigSigScriptSize(t *testing.T) {
+	tests := []struct {
+		required, keys, want int
+	}{
+		// 2-of-3 redeem script is 105 bytes pushed with OP_PUSHDATA1.
+		{2, 3, 2*74 + 2 + 105},
+		// 1-of-2 redeem script is 71 bytes pushed with OP_DATA_71.
+		{1, 2, 74 + 1 + 71},
+		// 8-of-8 redeem script is 275 bytes pushed with OP_PUSHDATA2.
+		{8, 8, 8*74 + 3 + 275},
+	}
+	for _, test := range tests {
+		got := RedeemMultiSigSigScriptSize(test.required, test.keys)
+		if got != test.want {
+			t.Errorf("%d-of-%d: size %d, want %d", test.required, test.keys,
+				got, test.want)
+		}
+	}
+}
+
+func TestStakeTransactionSizes(t *testing.T) {
+	const (
+		stakeP2PKH = P2PKHPkScriptSize + 1
+		stakeP2SH  = P2SHPkScriptSize + 1
+	)
+
+	ticket := makeTx([]int{RedeemP2PKHSigScriptSize},
+		[]int{stakeP2PKH, TicketCommitmentScriptSize, stakeP2PKH})
+	got := EstimateTicketSize([]int{RedeemP2PKHSigScriptSize}, stakeP2PKH, stakeP2PKH)
+	if want := ticket.SerializeSize(); got != want {
+		t.Errorf("ticket size %d, want %d", got, want)
+	}
+
+	multiInputTicket := makeTx([]int{RedeemP2PKHSigScriptSize, RedeemP2SHSigScriptSize},
+		[]int{stakeP2SH, TicketCommitmentScriptSize, stakeP2PKH,
+			TicketCommitmentScriptSize, stakeP2PKH})
+	got = EstimateTicketSize([]int{RedeemP2PKHSigScriptSize, RedeemP2SHSigScriptSize},
+		stakeP2SH, stakeP2PKH)
+	if want := multiInputTicket.SerializeSize(); got != want {
+		t.Errorf("multiple input ticket size %d, want %d", got, want)
+	}
+
+	vote := makeTx([]int{StakeBaseSigScriptSize, RedeemP2PKHSigScriptSize},
+		[]int{VoteBlockRefScriptSize, VoteBitsScriptSize, stakeP2PKH, stakeP2SH})
+	got = EstimateVoteSize(RedeemP2PKHSigScriptSize, []int{stakeP2PKH, stakeP2SH})
+	if want := vote.SerializeSize(); got != want {
+		t.Errorf("vote size %d, want %d", got, want)
+	}
+
+	revocation := makeTx([]int{0}, []int{stakeP2PKH})
+	got = EstimateRevocationSize([]int{stakeP2PKH})
+	if want := revocation.SerializeSize(); got != want {
+		t.Errorf("revocation size %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/crypto/rand"
+	"github.com/decred/dcrd/hdkeychain/v3"
+)
+
+// RebuildTxStore replaces the transaction store of the unopened wallet
+// database db with an empty store, preserving the wallet's keys, accounts and
+// address usage.  This recovers wallets whose transaction history is damaged,
+// and the history is rebuilt by rescanning the blockchain when the wallet is
+// next synced.  The birthday, mixed spend policy, maximum fee rate, and
+// checkpoint key of the replaced store are preserved when they can be read.
+//
+// The empty store is created by initializing scratch, an empty database, with
+// a random seed.  The scratch database is not otherwise used and should be
+// removed by the caller.  Both databases must be for the same network.
+func RebuildTxStore(ctx context.Context, db, scratch DB, params *chaincfg.Params) error {
+	const op errors.Op = "wallet.RebuildTxStore"
+	seed, err := hdkeychain.GenerateSeed(hdkeychain.RecommendedSeedLen)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	privPass := make([]byte, 32)
+	rand.Read(privPass)
+	err = udb.Initialize(ctx, scratch.internal(), params, seed,
+		[]byte(InsecurePubPassphrase), privPass)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	err = udb.ReplaceTxStore(ctx, db.internal(), scratch.internal())
+	if err != nil {
+		return errors.E(op, err)
+	}
+	log.Infof("Replaced transaction store; transaction history will be " +
+		"rebuilt by a rescan")
+	return nil
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestRebuildTxStore(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, nil)
+	defer teardown()
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, pkScript := addr.PaymentScript()
+	fund := wire.NewMsgTx()
+	fund.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, 0, nil))
+	fund.AddTxOut(&wire.TxOut{Value: 10e8, Version: version, PkScript: pkScript})
+	if err := w.AddTransaction(ctx, fund, nil); err != nil {
+		t.Fatal(err)
+	}
+	accountProps := func(w *Wallet) (props *udb.AccountProperties) {
+		err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+			var err error
+			props, err = w.manager.AccountProperties(
+				dbtx.ReadBucket(waddrmgrNamespaceKey), 0)
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return props
+	}
+	props := accountProps(w)
+	if bal, err := w.AccountBalance(ctx, 0, 0); err != nil || bal.Total == 0 {
+		t.Fatalf("balance %v before rebuild: %v", bal.Total, err)
+	}
+
+	// Settings which are not rebuilt from the blockchain are preserved.
+	if err := w.SetMixedSpendPolicy(ctx, 0, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.SetMaxFeeRate(ctx, 1e6); err != nil {
+		t.Fatal(err)
+	}
+	birth := &udb.BirthdayState{Height: 100, Time: time.Unix(1e9, 0), SetFromHeight: true}
+	if err := w.SetBirthState(ctx, birth); err != nil {
+		t.Fatal(err)
+	}
+
+	scratch, err := CreateDB("bdb", filepath.Join(t.TempDir(), "scratch.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer scratch.Close()
+	if err := RebuildTxStore(ctx, cfg.DB, scratch, cfg.Params); err != nil {
+		t.Fatal(err)
+	}
+
+	w, err = Open(ctx, &cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fundHash := fund.TxHash()
+	if _, _, _, err := w.TransactionSummary(ctx, &fundHash); err == nil {
+		t.Errorf("transaction history was not removed")
+	}
+	bal, err := w.AccountBalance(ctx, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bal.Total != 0 {
+		t.Errorf("balance %v after rebuild", bal.Total)
+	}
+	if p := w.MixedSpendPolicy(); p == nil || *p != (udb.MixedSpendPolicy{Account: 0, Branch: 1}) {
+		t.Errorf("mixed spend policy %+v after rebuild", p)
+	}
+	if rate := w.MaxFeeRate(); rate != 1e6 {
+		t.Errorf("maximum fee rate %v after rebuild", rate)
+	}
+	bs, err := w.BirthState(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bs == nil || bs.Height != birth.Height || !bs.Time.Equal(birth.Time) ||
+		!bs.SetFromHeight {
+		t.Errorf("birth state %+v after rebuild", bs)
+	}
+	// Address usage is preserved.
+	rebuiltProps := accountProps(w)
+	if rebuiltProps.LastUsedExternalIndex != props.LastUsedExternalIndex ||
+		rebuiltProps.LastReturnedExternalIndex != props.LastReturnedExternalIndex {
+		t.Errorf("address usage changed: %+v, want %+v", rebuiltProps, props)
+	}
+}
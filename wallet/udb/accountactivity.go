@@ -0,0 +1,117 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+var accountActivityBucketKey = []byte("accountactivity")
+
+const (
+	// activityBuckets is the number of hourly buckets recording the
+	// address activity of each account.
+	activityBuckets = 24
+
+	// ActivityWindow is the duration over which account address activity
+	// is measured.
+	ActivityWindow = activityBuckets * time.Hour
+
+	activityBucketSize = 16
+)
+
+// AccountActivity describes the rate at which addresses of an account have
+// been issued and used over the ActivityWindow ending at the time of the
+// query.  Usage counts the number of child indexes by which the last used
+// index of each branch advanced, and so also measures addresses skipped by
+// out of order usage.
+type AccountActivity struct {
+	Issued     uint32
+	Used       uint32
+	PeakIssued uint32 // Most addresses issued in any one hour
+	PeakUsed   uint32 // Most addresses used in any one hour
+}
+
+// Account activity keys are account numbers, and values are serialized as
+// activityBuckets ring buckets indexed by the Unix hour modulo the number of
+// buckets.  Each bucket is serialized as:
+//
+// [0:8]   Unix hour (Unix time / 3600) of the bucket (8 bytes)
+// [8:12]  Addresses issued during the hour (4 bytes)
+// [12:16] Addresses used during the hour (4 bytes)
+func accountActivityKey(account uint32) []byte {
+	k := make([]byte, 4)
+	byteOrder.PutUint32(k, account)
+	return k
+}
+
+func unixHour(t time.Time) uint64 {
+	return uint64(t.Unix() / 3600)
+}
+
+// RecordAccountActivity adds issued and used address counts to the activity
+// of an account at the time now.
+func RecordAccountActivity(dbtx walletdb.ReadWriteTx, account uint32, now time.Time, issued, used uint32) error {
+	if issued == 0 && used == 0 {
+		return nil
+	}
+	bucket := dbtx.ReadWriteBucket(accountActivityBucketKey)
+	k := accountActivityKey(account)
+	v := make([]byte, activityBuckets*activityBucketSize)
+	if old := bucket.Get(k); old != nil {
+		if len(old) != len(v) {
+			return errors.E(errors.IO, errors.Errorf("bad account activity "+
+				"length %d", len(old)))
+		}
+		copy(v, old)
+	}
+	hour := unixHour(now)
+	b := v[(hour%activityBuckets)*activityBucketSize:][:activityBucketSize]
+	if byteOrder.Uint64(b[0:8]) != hour {
+		byteOrder.PutUint64(b[0:8], hour)
+		byteOrder.PutUint32(b[8:12], 0)
+		byteOrder.PutUint32(b[12:16], 0)
+	}
+	byteOrder.PutUint32(b[8:12], byteOrder.Uint32(b[8:12])+issued)
+	byteOrder.PutUint32(b[12:16], byteOrder.Uint32(b[12:16])+used)
+	err := bucket.Put(k, v)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// AccountActivityFor returns the address activity of an account over the
+// ActivityWindow ending at the time now.  Accounts without recorded activity
+// return zero counts.
+func AccountActivityFor(dbtx walletdb.ReadTx, account uint32, now time.Time) (*AccountActivity, error) {
+	a := new(AccountActivity)
+	v := dbtx.ReadBucket(accountActivityBucketKey).Get(accountActivityKey(account))
+	if v == nil {
+		return a, nil
+	}
+	if len(v) != activityBuckets*activityBucketSize {
+		return nil, errors.E(errors.IO, errors.Errorf("bad account activity "+
+			"length %d", len(v)))
+	}
+	hour := unixHour(now)
+	for i := 0; i < activityBuckets; i++ {
+		b := v[i*activityBucketSize:][:activityBucketSize]
+		h := byteOrder.Uint64(b[0:8])
+		if h > hour || h+activityBuckets <= hour {
+			continue
+		}
+		issued := byteOrder.Uint32(b[8:12])
+		used := byteOrder.Uint32(b[12:16])
+		a.Issued += issued
+		a.Used += used
+		a.PeakIssued = max(a.PeakIssued, issued)
+		a.PeakUsed = max(a.PeakUsed, used)
+	}
+	return a, nil
+}
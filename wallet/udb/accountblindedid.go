@@ -0,0 +1,129 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"crypto/hmac"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/crypto/blake256"
+)
+
+var (
+	// acctBlindedIDBucketName is the bucket of account blinded IDs keyed by
+	// account number, and acctBlindedIDIdxBucketName indexes account
+	// numbers by blinded ID.
+	acctBlindedIDBucketName    = []byte("acctblindedid")
+	acctBlindedIDIdxBucketName = []byte("acctblindedididx")
+
+	// acctBlindedIDKeyName is the meta bucket key of the secret key used
+	// to blind account extended public keys.
+	acctBlindedIDKeyName = []byte("acctblindedidkey")
+)
+
+// AccountBlindedID is a keyed BLAKE-256 hash (HMAC-BLAKE256) of an account's
+// extended public key, using a random key which is never revealed by the
+// wallet.  It allows external services to refer to an account without the
+// account xpub appearing in requests or logs.
+type AccountBlindedID [blake256.Size]byte
+
+// String returns the hex encoding of the blinded ID.
+func (id AccountBlindedID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+// ParseAccountBlindedID decodes the hex encoding of an account blinded ID.
+func ParseAccountBlindedID(s string) (AccountBlindedID, error) {
+	var id AccountBlindedID
+	if hex.DecodedLen(len(s)) != len(id) {
+		return id, errors.E(errors.Encoding, "invalid blinded ID length")
+	}
+	if _, err := hex.Decode(id[:], []byte(s)); err != nil {
+		return id, errors.E(errors.Encoding, err)
+	}
+	return id, nil
+}
+
+// putNewAccountBlindedIDKey records a new random key for blinding account
+// xpubs.
+func putNewAccountBlindedIDKey(ns walletdb.ReadWriteBucket, r io.Reader) error {
+	key := make([]byte, blake256.Size)
+	if err := readRand(r, key); err != nil {
+		return err
+	}
+	err := ns.NestedReadWriteBucket(metaBucketName).Put(acctBlindedIDKeyName, key)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// blindAccountXpub returns the blinded ID of an account with the serialized
+// extended public key xpub.
+func blindAccountXpub(key []byte, xpub string) AccountBlindedID {
+	var id AccountBlindedID
+	mac := hmac.New(blake256.New, key)
+	mac.Write([]byte(xpub))
+	copy(id[:], mac.Sum(nil))
+	return id
+}
+
+// putAccountBlindedID records the blinded ID of an account with the serialized
+// extended public key xpub, replacing any previously indexed ID of the
+// account.  Nothing is recorded by upgrades prior to the creation of the
+// blinded ID index, which indexes all accounts recorded by earlier versions.
+func putAccountBlindedID(ns walletdb.ReadWriteBucket, account uint32, xpub string) error {
+	bucket := ns.NestedReadWriteBucket(acctBlindedIDBucketName)
+	if bucket == nil {
+		return nil
+	}
+	idxBucket := ns.NestedReadWriteBucket(acctBlindedIDIdxBucketName)
+	key := ns.NestedReadBucket(metaBucketName).Get(acctBlindedIDKeyName)
+	if len(key) != blake256.Size {
+		return errors.E(errors.IO, "missing account blinded ID key")
+	}
+
+	accountKey := uint32ToBytes(account)
+	if old := bucket.Get(accountKey); old != nil {
+		if err := idxBucket.Delete(old); err != nil {
+			return errors.E(errors.IO, err)
+		}
+	}
+	id := blindAccountXpub(key, xpub)
+	if err := bucket.Put(accountKey, id[:]); err != nil {
+		return errors.E(errors.IO, err)
+	}
+	if err := idxBucket.Put(id[:], accountKey); err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// AccountBlindedID returns the blinded ID of an account.  Accounts without
+// an extended public key, such as the imported account, have no blinded ID
+// and error with errors.NotExist.
+func (m *Manager) AccountBlindedID(ns walletdb.ReadBucket, account uint32) (AccountBlindedID, error) {
+	var id AccountBlindedID
+	v := ns.NestedReadBucket(acctBlindedIDBucketName).Get(uint32ToBytes(account))
+	if len(v) != len(id) {
+		return id, errors.E(errors.NotExist, errors.Errorf("no blinded ID for account %d", account))
+	}
+	copy(id[:], v)
+	return id, nil
+}
+
+// LookupAccountBlindedID returns the account number of the account identified
+// by a blinded ID.
+func (m *Manager) LookupAccountBlindedID(ns walletdb.ReadBucket, id AccountBlindedID) (uint32, error) {
+	v := ns.NestedReadBucket(acctBlindedIDIdxBucketName).Get(id[:])
+	if len(v) != 4 {
+		return 0, errors.E(errors.NotExist, "no account with blinded ID")
+	}
+	return binary.LittleEndian.Uint32(v), nil
+}
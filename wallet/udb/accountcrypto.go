@@ -0,0 +1,130 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/kdf"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+// privPassphraseChangedName is the key of the main bucket recording when the
+// private passphrase was last set.  acctVarPassphraseChanged is the account
+// variable recording when a unique account passphrase was last set.  Wallets
+// and accounts with passphrases set by older software do not record these
+// times.
+var (
+	privPassphraseChangedName = []byte("privpasschanged")
+	acctVarPassphraseChanged  = []byte("passchanged")
+)
+
+// Account key encryption schemes.
+const (
+	// AccountEncryptionNone describes accounts without private keys.
+	AccountEncryptionNone = "none"
+
+	// AccountEncryptionGlobal describes accounts with private keys
+	// encrypted by the wallet's crypto key, which is protected by a snacl
+	// key derived from the wallet's private passphrase with scrypt.
+	AccountEncryptionGlobal = "snacl-scrypt"
+
+	// AccountEncryptionUnique describes accounts with private keys sealed
+	// by XChaCha20-Poly1305 using a key derived from the unique account
+	// passphrase with Argon2id.
+	AccountEncryptionUnique = "xchacha20poly1305-argon2id"
+)
+
+// ScryptParams describes the difficulty of the scrypt KDF.
+type ScryptParams struct {
+	N, R, P int
+}
+
+// AccountCryptoParams describes the encryption of an account's private keys.
+type AccountCryptoParams struct {
+	// Encryption is one of the AccountEncryption schemes.
+	Encryption string
+
+	// Scrypt describes the KDF of the wallet's private passphrase, and is
+	// set only for the AccountEncryptionGlobal scheme.
+	Scrypt *ScryptParams
+
+	// Argon2id describes the KDF of the unique account passphrase, and is
+	// set only for the AccountEncryptionUnique scheme.
+	Argon2id *kdf.Argon2idParams
+
+	// PassphraseChanged is when the passphrase protecting the keys was last
+	// set, or the zero time if unknown.
+	PassphraseChanged time.Time
+}
+
+func putPassphraseChanged(bucket walletdb.ReadWriteBucket, key []byte) error {
+	v := make([]byte, 8)
+	byteOrder.PutUint64(v, uint64(time.Now().Unix()))
+	if err := bucket.Put(key, v); err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+func fetchPassphraseChanged(bucket walletdb.ReadBucket, key []byte) time.Time {
+	v := bucket.Get(key)
+	if len(v) != 8 {
+		return time.Time{}
+	}
+	return time.Unix(int64(byteOrder.Uint64(v)), 0)
+}
+
+// AccountCryptoParams returns the encryption scheme and KDF parameters
+// protecting the private keys of an account, and when its passphrase was last
+// set.  No secrets are returned and the manager may be locked.
+func (m *Manager) AccountCryptoParams(dbtx walletdb.ReadTx, account uint32) (*AccountCryptoParams, error) {
+	defer m.mtx.RUnlock()
+	m.mtx.RLock()
+
+	ns := dbtx.ReadBucket(waddrmgrBucketKey)
+
+	if account == ImportedAddrAccount {
+		// Imported private keys are encrypted by the crypto key.
+		if m.watchingOnly {
+			return &AccountCryptoParams{Encryption: AccountEncryptionNone}, nil
+		}
+		return m.globalCryptoParams(ns), nil
+	}
+
+	acctInfo, err := m.loadAccountInfo(ns, account)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case acctInfo.uniqueKey != nil:
+		kdfp := *acctInfo.uniqueKey
+		vars := ns.NestedReadBucket(acctVarsBucketName).
+			NestedReadBucket(uint32ToBytes(account))
+		p := &AccountCryptoParams{
+			Encryption: AccountEncryptionUnique,
+			Argon2id:   &kdfp,
+		}
+		if vars != nil {
+			p.PassphraseChanged = fetchPassphraseChanged(vars, acctVarPassphraseChanged)
+		}
+		return p, nil
+	case len(acctInfo.acctKeyEncrypted) == 0 || m.watchingOnly:
+		return &AccountCryptoParams{Encryption: AccountEncryptionNone}, nil
+	default:
+		return m.globalCryptoParams(ns), nil
+	}
+}
+
+func (m *Manager) globalCryptoParams(ns walletdb.ReadBucket) *AccountCryptoParams {
+	params := &m.masterKeyPriv.Parameters
+	return &AccountCryptoParams{
+		Encryption: AccountEncryptionGlobal,
+		Scrypt:     &ScryptParams{N: params.N, R: params.R, P: params.P},
+		PassphraseChanged: fetchPassphraseChanged(ns.NestedReadBucket(mainBucketName),
+			privPassphraseChangedName),
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+var (
+	// acctUUIDBucketName is the bucket of account UUIDs keyed by account
+	// number, and acctUUIDIdxBucketName indexes account numbers by UUID.
+	acctUUIDBucketName    = []byte("acctuuid")
+	acctUUIDIdxBucketName = []byte("acctuuididx")
+
+	// acctVarRenames is the account variable recording the rename history
+	// of an account.
+	acctVarRenames = []byte("renames")
+)
+
+// AccountUUID is a random identifier assigned to each account when it is
+// created.  Unlike account names, it never changes, and unlike account
+// numbers, it is not reused by other wallets restored from the same seed.
+type AccountUUID [16]byte
+
+// String returns the RFC 4122 text encoding of the UUID.
+func (u AccountUUID) String() string {
+	var b [36]byte
+	hex.Encode(b[0:8], u[0:4])
+	b[8] = '-'
+	hex.Encode(b[9:13], u[4:6])
+	b[13] = '-'
+	hex.Encode(b[14:18], u[6:8])
+	b[18] = '-'
+	hex.Encode(b[19:23], u[8:10])
+	b[23] = '-'
+	hex.Encode(b[24:], u[10:])
+	return string(b[:])
+}
+
+// ParseAccountUUID decodes the RFC 4122 text encoding of an account UUID.
+func ParseAccountUUID(s string) (AccountUUID, error) {
+	var u AccountUUID
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return u, errors.E(errors.Encoding, "invalid UUID format")
+	}
+	h := s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:]
+	if _, err := hex.Decode(u[:], []byte(h)); err != nil {
+		return u, errors.E(errors.Encoding, err)
+	}
+	return u, nil
+}
+
+// newAccountUUID returns a version 4 UUID read from r, or from a
+// cryptographically secure source when r is nil.
+func newAccountUUID(r io.Reader) (AccountUUID, error) {
+	var u AccountUUID
+	if err := readRand(r, u[:]); err != nil {
+		return u, err
+	}
+	u[6] = u[6]&0x0f | 0x40
+	u[8] = u[8]&0x3f | 0x80
+	return u, nil
+}
+
+// putNewAccountUUID assigns a new random UUID to an account.
+func putNewAccountUUID(ns walletdb.ReadWriteBucket, r io.Reader, account uint32) error {
+	u, err := newAccountUUID(r)
+	if err != nil {
+		return err
+	}
+	err = ns.NestedReadWriteBucket(acctUUIDBucketName).Put(uint32ToBytes(account), u[:])
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	err = ns.NestedReadWriteBucket(acctUUIDIdxBucketName).Put(u[:], uint32ToBytes(account))
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// fetchAccountUUID returns the UUID of an account.
+func fetchAccountUUID(ns walletdb.ReadBucket, account uint32) (AccountUUID, error) {
+	var u AccountUUID
+	v := ns.NestedReadBucket(acctUUIDBucketName).Get(uint32ToBytes(account))
+	if len(v) != len(u) {
+		return u, errors.E(errors.NotExist, errors.Errorf("no UUID for account %d", account))
+	}
+	copy(u[:], v)
+	return u, nil
+}
+
+// AccountUUID returns the immutable UUID of an account.
+func (m *Manager) AccountUUID(ns walletdb.ReadBucket, account uint32) (AccountUUID, error) {
+	return fetchAccountUUID(ns, account)
+}
+
+// LookupAccountUUID returns the account number of the account identified by a
+// UUID.
+func (m *Manager) LookupAccountUUID(ns walletdb.ReadBucket, u AccountUUID) (uint32, error) {
+	v := ns.NestedReadBucket(acctUUIDIdxBucketName).Get(u[:])
+	if len(v) != 4 {
+		return 0, errors.E(errors.NotExist, errors.Errorf("no account with UUID %v", u))
+	}
+	return binary.LittleEndian.Uint32(v), nil
+}
+
+// AccountRename describes a change of an account's name.
+type AccountRename struct {
+	Time    time.Time
+	OldName string
+	NewName string
+}
+
+// appendAccountRename records a rename in the history of an account.  Each
+// rename is serialized as the unix time (8 bytes) followed by the old and new
+// names, each prefixed by their 2 byte length.
+func appendAccountRename(vars walletdb.ReadWriteBucket, r *AccountRename) error {
+	if len(r.OldName) > 0xffff || len(r.NewName) > 0xffff {
+		return errors.E(errors.Invalid, "account name is too long")
+	}
+	old := vars.Get(acctVarRenames)
+	v := make([]byte, len(old), len(old)+12+len(r.OldName)+len(r.NewName))
+	copy(v, old)
+	v = binary.LittleEndian.AppendUint64(v, uint64(r.Time.Unix()))
+	v = binary.LittleEndian.AppendUint16(v, uint16(len(r.OldName)))
+	v = append(v, r.OldName...)
+	v = binary.LittleEndian.AppendUint16(v, uint16(len(r.NewName)))
+	v = append(v, r.NewName...)
+	if err := vars.Put(acctVarRenames, v); err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// AccountRenames returns the rename history of an account, oldest first.
+func (m *Manager) AccountRenames(ns walletdb.ReadBucket, account uint32) ([]AccountRename, error) {
+	vars := ns.NestedReadBucket(acctVarsBucketName).NestedReadBucket(uint32ToBytes(account))
+	if vars == nil {
+		return nil, nil
+	}
+	v := vars.Get(acctVarRenames)
+	errShort := errors.E(errors.IO, "short account rename history")
+	readName := func() (string, error) {
+		if len(v) < 2 {
+			return "", errShort
+		}
+		l := int(binary.LittleEndian.Uint16(v))
+		if len(v) < 2+l {
+			return "", errShort
+		}
+		name := string(v[2 : 2+l])
+		v = v[2+l:]
+		return name, nil
+	}
+	var renames []AccountRename
+	for len(v) != 0 {
+		if len(v) < 8 {
+			return nil, errShort
+		}
+		r := AccountRename{Time: time.Unix(int64(binary.LittleEndian.Uint64(v)), 0)}
+		v = v[8:]
+		var err error
+		if r.OldName, err = readName(); err != nil {
+			return nil, err
+		}
+		if r.NewName, err = readName(); err != nil {
+			return nil, err
+		}
+		renames = append(renames, r)
+	}
+	return renames, nil
+}
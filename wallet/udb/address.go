@@ -35,6 +35,23 @@ type ManagedAddress interface {
 	// Multisig returns true if the backing address was created for multisig
 	// use.
 	Multisig() bool
+
+	// PaymentScript returns the script version and output script paying to
+	// the address.
+	PaymentScript() (version uint16, script []byte)
+
+	// VotingRightsScript returns the script version and ticket output script
+	// giving voting rights to the address.
+	VotingRightsScript() (version uint16, script []byte)
+
+	// StakeChangeScript returns the script version and ticket output script
+	// paying change to the address.
+	StakeChangeScript() (version uint16, script []byte)
+
+	// RewardCommitmentScript returns the script version and ticket output
+	// script committing the original ticket funds plus the reward to the
+	// address, limiting the vote and revocation fees.
+	RewardCommitmentScript(amount, voteFeeLimit, revocationFeeLimit int64) (version uint16, script []byte)
 }
 
 // ManagedPubKeyAddress extends ManagedAddress and additionally provides the
@@ -119,6 +136,36 @@ func (a *managedAddress) Multisig() bool {
 	return a.multisig
 }
 
+// PaymentScript returns the pay-to-pubkey-hash script paying to the address.
+//
+// This is part of the ManagedAddress interface implementation.
+func (a *managedAddress) PaymentScript() (uint16, []byte) {
+	return a.address.PaymentScript()
+}
+
+// VotingRightsScript returns the ticket script giving voting rights to the
+// address.
+//
+// This is part of the ManagedAddress interface implementation.
+func (a *managedAddress) VotingRightsScript() (uint16, []byte) {
+	return a.address.VotingRightsScript()
+}
+
+// StakeChangeScript returns the ticket script paying change to the address.
+//
+// This is part of the ManagedAddress interface implementation.
+func (a *managedAddress) StakeChangeScript() (uint16, []byte) {
+	return a.address.StakeChangeScript()
+}
+
+// RewardCommitmentScript returns the ticket script committing the original
+// funds and reward to the address.
+//
+// This is part of the ManagedAddress interface implementation.
+func (a *managedAddress) RewardCommitmentScript(amount, voteFeeLimit, revocationFeeLimit int64) (uint16, []byte) {
+	return a.address.RewardCommitmentScript(amount, voteFeeLimit, revocationFeeLimit)
+}
+
 // PubKey returns the public key associated with the address.
 //
 // This is part of the ManagedPubKeyAddress interface implementation.
@@ -215,6 +262,36 @@ func (a *scriptAddress) Multisig() bool {
 	return false
 }
 
+// PaymentScript returns the pay-to-script-hash script paying to the address.
+//
+// This is part of the ManagedAddress interface implementation.
+func (a *scriptAddress) PaymentScript() (uint16, []byte) {
+	return a.address.PaymentScript()
+}
+
+// VotingRightsScript returns the ticket script giving voting rights to the
+// address.
+//
+// This is part of the ManagedAddress interface implementation.
+func (a *scriptAddress) VotingRightsScript() (uint16, []byte) {
+	return a.address.VotingRightsScript()
+}
+
+// StakeChangeScript returns the ticket script paying change to the address.
+//
+// This is part of the ManagedAddress interface implementation.
+func (a *scriptAddress) StakeChangeScript() (uint16, []byte) {
+	return a.address.StakeChangeScript()
+}
+
+// RewardCommitmentScript returns the ticket script committing the original
+// funds and reward to the address.
+//
+// This is part of the ManagedAddress interface implementation.
+func (a *scriptAddress) RewardCommitmentScript(amount, voteFeeLimit, revocationFeeLimit int64) (uint16, []byte) {
+	return a.address.RewardCommitmentScript(amount, voteFeeLimit, revocationFeeLimit)
+}
+
 func (a *scriptAddress) RedeemScript() (version uint16, script []byte) {
 	return 0, a.redeemScript
 }
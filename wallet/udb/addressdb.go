@@ -6,6 +6,7 @@
 package udb
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
 	"time"
@@ -51,6 +52,7 @@ const (
 	actBIP0044Legacy accountType = iota
 	actBIP0044
 	importedVoting
+	externalSigner
 )
 
 // dbAccountRow houses information stored about an account in the database.
@@ -119,11 +121,19 @@ func (a *dbBIP0044Account) deserializeRow(v []byte) error {
 	}
 
 	encPubLen := binary.LittleEndian.Uint32(v)
+	if uint64(encPubLen) > uint64(len(v)-8) {
+		err := errors.Errorf("BIP0044 account row bad pubkey len %d", encPubLen)
+		return errors.E(errors.IO, err)
+	}
 	off := uint32(4)
 	encPub := append([]byte(nil), v[off:off+encPubLen]...)
 	off += encPubLen
 	encPrivLen := binary.LittleEndian.Uint32(v[off:])
 	off += 4
+	if uint64(encPrivLen) > uint64(len(v))-uint64(off) {
+		err := errors.Errorf("BIP0044 account row bad privkey len %d", encPrivLen)
+		return errors.E(errors.IO, err)
+	}
 	encPriv := append([]byte(nil), v[off:off+encPrivLen]...)
 	off += encPrivLen
 	if int(off) != len(v) {
@@ -191,6 +201,15 @@ var (
 	// NewAddress
 	addrAcctIdxBucketName = []byte("addracctidx")
 
+	// scriptIdxBucketName is used to index the output scripts paying to
+	// each recorded address, allowing output scripts to be matched
+	// against the wallet without first decoding them as addresses.
+	// Entries map:
+	// * script index key => account id
+	// The key is the hash of the script class and the address hash160
+	// (see scriptIndexKey).  This index was added in database version 29.
+	scriptIdxBucketName = []byte("scriptidx")
+
 	// acctNameIdxBucketName is used to create an index
 	// mapping an account name string to the corresponding
 	// account id.
@@ -259,6 +278,7 @@ var (
 	coinTypeSLIP0044PubKeyName  = []byte("ctpub-slip0044")
 	watchingOnlyName            = []byte("watchonly")
 	slip0044Account0RowName     = []byte("slip0044acct0")
+	duressName                  = []byte("duress")
 
 	// Used addresses (used bucket).  This was removed by database version 2.
 	usedAddrBucketName = []byte("usedaddrs")
@@ -530,6 +550,9 @@ func deserializeAccountRow(serializedAccount []byte) (*dbAccountRow, error) {
 	row := dbAccountRow{}
 	row.acctType = accountType(serializedAccount[0])
 	rdlen := binary.LittleEndian.Uint32(serializedAccount[1:5])
+	if uint64(rdlen) > uint64(len(serializedAccount)-5) {
+		return nil, errors.E(errors.IO, errors.Errorf("bad account raw data len %d", rdlen))
+	}
 	row.rawData = make([]byte, rdlen)
 	copy(row.rawData, serializedAccount[5:5+rdlen])
 
@@ -793,7 +816,7 @@ func fetchAccountInfo(ns walletdb.ReadBucket, account uint32, dbVersion uint32)
 
 	accountID := uint32ToBytes(account)
 	switch row.acctType {
-	case actBIP0044Legacy, importedVoting:
+	case actBIP0044Legacy, importedVoting, externalSigner:
 		return deserializeBIP0044AccountRow(accountID, row, dbVersion)
 	}
 
@@ -815,7 +838,7 @@ func fetchDBAccount(ns walletdb.ReadBucket, account uint32, dbVersion uint32) (d
 			return nil, errors.E(err)
 		}
 		return deserializeBIP0044AccountRow(accountID, row, dbVersion)
-	case actBIP0044, importedVoting:
+	case actBIP0044, importedVoting, externalSigner:
 		bucketKey := uint32ToBytes(account)
 		varsBucket := ns.NestedReadBucket(acctVarsBucketName).
 			NestedReadBucket(bucketKey)
@@ -925,6 +948,45 @@ func putAddrAccountIndex(ns walletdb.ReadWriteBucket, account uint32, addrHash [
 	return nil
 }
 
+// scriptIndexKey returns the script index key of a P2PKH or P2SH address
+// hash160.  The additional hash conceals the address, as with the address
+// bucket keys.
+func scriptIndexKey(p2sh bool, hash160 []byte) [sha256.Size]byte {
+	var b [1 + 20]byte
+	if p2sh {
+		b[0] = 1
+	}
+	copy(b[1:], hash160)
+	return sha256.Sum256(b[:])
+}
+
+// putScriptIndex records the account of the address paid by P2PKH or P2SH
+// output scripts with a hash160.  Nothing is recorded by upgrades prior to
+// the creation of the script index, which indexes all addresses recorded by
+// earlier versions.
+func putScriptIndex(ns walletdb.ReadWriteBucket, p2sh bool, hash160 []byte, account uint32) error {
+	bucket := ns.NestedReadWriteBucket(scriptIdxBucketName)
+	if bucket == nil {
+		return nil
+	}
+	key := scriptIndexKey(p2sh, hash160)
+	err := bucket.Put(key[:], uint32ToBytes(account))
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// fetchScriptIndex returns the account of the address indexed by a script
+// index key, and whether the key was found.
+func fetchScriptIndex(ns walletdb.ReadBucket, key []byte) (uint32, bool) {
+	v := ns.NestedReadBucket(scriptIdxBucketName).Get(key)
+	if len(v) != 4 {
+		return 0, false
+	}
+	return binary.LittleEndian.Uint32(v), true
+}
+
 // putAccountRow stores the provided account information to the database.  This
 // is used a common base for storing the various account types.
 func putAccountRow(ns walletdb.ReadWriteBucket, account uint32, row *dbAccountRow) error {
@@ -1035,6 +1097,7 @@ var (
 	acctVarLastReturnedInternal = []byte("intret")
 	acctVarName                 = []byte("name")
 	acctVarKDF                  = []byte("kdf-params")
+	acctVarExternalSigner       = []byte("signer")
 )
 
 func putAccountUint32Var(varsBucket walletdb.ReadWriteBucket, varName []byte, value uint32) error {
@@ -1126,6 +1189,9 @@ func deserializeAddressRow(serializedAddress []byte) (*dbAddressRow, error) {
 	row.account = binary.LittleEndian.Uint32(serializedAddress[1:5])
 	row.addTime = binary.LittleEndian.Uint64(serializedAddress[5:13])
 	rdlen := binary.LittleEndian.Uint32(serializedAddress[14:18])
+	if uint64(rdlen) > uint64(len(serializedAddress)-18) {
+		return nil, errors.E(errors.IO, errors.Errorf("bad address raw data len %d", rdlen))
+	}
 	row.rawData = make([]byte, rdlen)
 	copy(row.rawData, serializedAddress[18:18+rdlen])
 
@@ -1205,11 +1271,17 @@ func deserializeImportedAddress(row *dbAddressRow) (*dbImportedAddressRow, error
 	}
 
 	pubLen := binary.LittleEndian.Uint32(row.rawData[0:4])
+	if uint64(pubLen) > uint64(len(row.rawData)-8) {
+		return nil, errors.E(errors.IO, errors.Errorf("bad imported address pubkey len %d", pubLen))
+	}
 	retRow.encryptedPubKey = make([]byte, pubLen)
 	copy(retRow.encryptedPubKey, row.rawData[4:4+pubLen])
 	offset := 4 + pubLen
 	privLen := binary.LittleEndian.Uint32(row.rawData[offset : offset+4])
 	offset += 4
+	if uint64(privLen) > uint64(len(row.rawData))-uint64(offset) {
+		return nil, errors.E(errors.IO, errors.Errorf("bad imported address privkey len %d", privLen))
+	}
 	retRow.encryptedPrivKey = make([]byte, privLen)
 	copy(retRow.encryptedPrivKey, row.rawData[offset:offset+privLen])
 
@@ -1256,11 +1328,17 @@ func deserializeScriptAddress(row *dbAddressRow) (*dbScriptAddressRow, error) {
 	}
 
 	hashLen := binary.LittleEndian.Uint32(row.rawData[0:4])
+	if uint64(hashLen) > uint64(len(row.rawData)-8) {
+		return nil, errors.E(errors.IO, errors.Errorf("bad script address hash len %d", hashLen))
+	}
 	retRow.encryptedHash = make([]byte, hashLen)
 	copy(retRow.encryptedHash, row.rawData[4:4+hashLen])
 	offset := 4 + hashLen
 	scriptLen := binary.LittleEndian.Uint32(row.rawData[offset : offset+4])
 	offset += 4
+	if uint64(scriptLen) > uint64(len(row.rawData))-uint64(offset) {
+		return nil, errors.E(errors.IO, errors.Errorf("bad script address script len %d", scriptLen))
+	}
 	retRow.script = make([]byte, scriptLen)
 	copy(retRow.script, row.rawData[offset:offset+scriptLen])
 
@@ -1346,7 +1424,11 @@ func putAddress(ns walletdb.ReadWriteBucket, addressID []byte, row *dbAddressRow
 		return errors.E(errors.IO, err)
 	}
 	// Update address account index
-	return putAddrAccountIndex(ns, row.account, addrHash[:])
+	err = putAddrAccountIndex(ns, row.account, addrHash[:])
+	if err != nil {
+		return err
+	}
+	return putScriptIndex(ns, row.addrType == adtScript, addressID, row.account)
 }
 
 // putChainedAddress stores the provided chained address information to the
@@ -1446,6 +1528,51 @@ func forEachAccountAddress(ns walletdb.ReadBucket, account uint32, fn func(rowIn
 	return nil
 }
 
+// forEachAccountAddressPage calls fn with up to limit addresses of an account
+// in the order of the account index keys, beginning after the key after.  The
+// key of the final visited address is returned when limit addresses were
+// visited, and nil is returned when no addresses remain.
+func forEachAccountAddressPage(ns walletdb.ReadBucket, account uint32, after []byte,
+	limit int, fn func(rowInterface any) error) ([]byte, error) {
+
+	bucket := ns.NestedReadBucket(addrAcctIdxBucketName).
+		NestedReadBucket(uint32ToBytes(account))
+	if bucket == nil {
+		return nil, nil
+	}
+
+	c := bucket.ReadCursor()
+	defer c.Close()
+	var k, v []byte
+	if after == nil {
+		k, v = c.First()
+	} else {
+		k, v = c.Seek(after)
+		if bytes.Equal(k, after) {
+			k, v = c.Next()
+		}
+	}
+	for n := 0; k != nil; k, v = c.Next() {
+		// Skip buckets.
+		if v == nil {
+			continue
+		}
+		addrRow, err := fetchAddressByHash(ns, k)
+		if err != nil {
+			return nil, errors.E(errors.IO, err)
+		}
+		err = fn(addrRow)
+		if err != nil {
+			return nil, err
+		}
+		n++
+		if n == limit {
+			return append([]byte(nil), k...), nil
+		}
+	}
+	return nil, nil
+}
+
 // forEachActiveAddress calls the given function with each active address
 // stored in the manager, breaking early on error.
 func forEachActiveAddress(ns walletdb.ReadBucket, fn func(rowInterface any) error) error {
@@ -1496,6 +1623,9 @@ func deletePrivateKeys(ns walletdb.ReadWriteBucket, dbVersion uint32) error {
 	if err := bucket.Delete(coinTypeSLIP0044PrivKeyName); err != nil {
 		return errors.E(errors.IO, err)
 	}
+	if err := bucket.Delete(duressName); err != nil {
+		return errors.E(errors.IO, err)
+	}
 
 	BIP0044Set := map[string]*dbAccountRow{}
 
@@ -9,19 +9,22 @@ import (
 	"crypto/subtle"
 	"fmt"
 	"hash"
+	"io"
 	"sync"
+	"time"
 
 	"decred.org/dcrwallet/v5/errors"
 	"decred.org/dcrwallet/v5/internal/compat"
 	"decred.org/dcrwallet/v5/kdf"
+	"decred.org/dcrwallet/v5/wallet/internal/securemem"
 	"decred.org/dcrwallet/v5/wallet/internal/snacl"
 	"decred.org/dcrwallet/v5/wallet/walletdb"
 	"github.com/decred/dcrd/chaincfg/v3"
-	"github.com/decred/dcrd/crypto/rand"
 	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"github.com/decred/dcrd/dcrutil/v4"
 	"github.com/decred/dcrd/hdkeychain/v3"
 	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/txscript/v4/stdscript"
 	"github.com/decred/dcrd/wire"
 	"golang.org/x/crypto/blake2b"
 	"golang.org/x/crypto/chacha20poly1305"
@@ -141,6 +144,7 @@ type accountInfo struct {
 	// the internal and external addresses.
 	// The accountKeyPriv will be nil when the address manager is locked,
 	// or the account is uniquely encrypted and not currently unlocked.
+	// It is held in ordinary memory which is not locked against swapping.
 	// acctKeyEncrypted is the encrypted account key, sealed using snacl
 	// when the account is protected by the wallet global passphrase,
 	// and sealed using XChaCha20Poly1305 with an Argon2id-derived key
@@ -167,10 +171,14 @@ const (
 // argon2id-derived keys.
 type keyType []byte
 
-func seal(key keyType, plaintext []byte) ([]byte, error) {
+// seal encrypts plaintext with XChaCha20-Poly1305 using a nonce read from r, or
+// from a cryptographically secure source when r is nil.
+func seal(r io.Reader, key keyType, plaintext []byte) ([]byte, error) {
 	sealedLen := len(plaintext) + xchacha20poly1305Overhead
 	nonce := make([]byte, xchacha20NonceSize, sealedLen)
-	rand.Read(nonce)
+	if err := readRand(r, nonce); err != nil {
+		return nil, err
+	}
 
 	aead, err := chacha20poly1305.NewX(key)
 	if err != nil {
@@ -221,6 +229,7 @@ type AccountProperties = struct {
 	ImportedKeyCount          uint32
 	AccountEncrypted          bool
 	AccountUnlocked           bool
+	AccountUUID               AccountUUID
 }
 
 // IsImportedVoting compares a uint8 to the internal importedVoting type and
@@ -229,9 +238,10 @@ func IsImportedVoting(acctType uint8) bool {
 	return acctType == uint8(importedVoting)
 }
 
-// defaultNewSecretKey returns a new secret key.  See newSecretKey.
-func defaultNewSecretKey(passphrase *[]byte, config *scryptOptions) (*snacl.SecretKey, error) {
-	return snacl.NewSecretKey(passphrase, config.N, config.R, config.P)
+// defaultNewSecretKey returns a new secret key with a salt read from r.  See
+// newSecretKey.
+func defaultNewSecretKey(r io.Reader, passphrase *[]byte, config *scryptOptions) (*snacl.SecretKey, error) {
+	return snacl.NewSecretKeyWithRand(randReader(r), passphrase, config.N, config.R, config.P)
 }
 
 // newSecretKey is used as a way to replace the new secret key generation
@@ -252,6 +262,13 @@ type EncryptorDecryptor interface {
 // cryptoKey extends snacl.CryptoKey to implement EncryptorDecryptor.
 type cryptoKey struct {
 	snacl.CryptoKey
+	rand io.Reader
+}
+
+// Encrypt encrypts the passed data using a nonce read from the key's source
+// of randomness.
+func (ck *cryptoKey) Encrypt(in []byte) ([]byte, error) {
+	return ck.CryptoKey.EncryptWithRand(randReader(ck.rand), in)
 }
 
 // Bytes returns a copy of this crypto key's byte slice.
@@ -264,13 +281,14 @@ func (ck *cryptoKey) CopyBytes(from []byte) {
 	copy(ck.CryptoKey[:], from)
 }
 
-// defaultNewCryptoKey returns a new CryptoKey.  See newCryptoKey.
-func defaultNewCryptoKey() (EncryptorDecryptor, error) {
-	key, err := snacl.GenerateCryptoKey()
+// defaultNewCryptoKey returns a new CryptoKey read from r, which is also
+// used for the nonces of encryptions by the key.  See newCryptoKey.
+func defaultNewCryptoKey(r io.Reader) (EncryptorDecryptor, error) {
+	key, err := snacl.GenerateCryptoKeyWithRand(randReader(r))
 	if err != nil {
 		return nil, err
 	}
-	return &cryptoKey{*key}, nil
+	return &cryptoKey{CryptoKey: *key, rand: r}, nil
 }
 
 // CryptoKeyType is used to differentiate between different kinds of
@@ -307,6 +325,11 @@ type Manager struct {
 	locked       bool
 	closed       bool
 
+	// duressUnlocked is set when the manager was unlocked with the duress
+	// passphrase.  The manager remains locked, with only the duress
+	// account's private key available, but reports itself as unlocked.
+	duressUnlocked bool
+
 	// acctInfo houses information about accounts including what is needed
 	// to generate deterministic chained keys for each created account.
 	acctInfo map[uint32]*accountInfo
@@ -336,12 +359,25 @@ type Manager struct {
 	cryptoKeyPrivEncrypted []byte
 	cryptoKeyPriv          EncryptorDecryptor
 
+	// keyMem is the locked memory holding the cleartext private master
+	// and crypto keys, which is released when the manager is closed.
+	// No other key material is held in locked memory: account extended
+	// private keys are held by hdkeychain in ordinary memory and are only
+	// cleared when the manager is locked, and private keys returned by
+	// PrivateKey, including decrypted imported keys, are ordinary memory
+	// cleared by their done functions.
+	keyMem []*securemem.Buffer
+
 	// privPassphraseHasher is a blake2b-256 hasher (keyed with random
 	// bytes) to hash passphrases, to compare for correct passphrases when
 	// unlocking an already unlocked wallet without deriving another key.
 	privPassphraseHasher   hash.Hash
 	privPassphraseHasherMu sync.Mutex // protects privPassphraseHasher
 	privPassphraseHash     []byte     // protected by m.mtx, not privPassphraseHasherMu
+
+	// rand is the source of all randomness used by the manager.  When nil,
+	// a cryptographically secure source is used.  See WithRand.
+	rand io.Reader
 }
 
 func zero(b []byte) {
@@ -366,6 +402,7 @@ func (m *Manager) lock() {
 	// Remove clear text private master and crypto keys from memory.
 	m.cryptoKeyPriv.Zero()
 	m.masterKeyPriv.Zero()
+	m.duressUnlocked = false
 
 	// NOTE: m.cryptoKeyPub is intentionally not cleared here as the address
 	// manager needs to be able to continue to read and decrypt public data
@@ -404,13 +441,19 @@ func (m *Manager) Close() error {
 	defer m.mtx.Unlock()
 
 	// Attempt to clear private key material from memory.
-	if !m.watchingOnly && !m.locked {
+	if !m.watchingOnly && (!m.locked || m.duressUnlocked) {
 		m.lock()
 	}
 
 	// Attempt to clear sensitive public key material from memory too.
 	m.zeroSensitivePublicData()
 
+	// Release the locked memory of the private master and crypto keys.
+	for _, mem := range m.keyMem {
+		mem.Free()
+	}
+	m.keyMem = nil
+
 	m.closed = true
 	return nil
 }
@@ -443,8 +486,11 @@ func deriveKey(acctInfo *accountInfo, branch, index uint32, private bool) (*hdke
 	if private {
 		if acctInfo.acctKeyPriv == nil {
 			if acctInfo.uniqueKey != nil {
-				return nil, errors.E(errors.Locked,
-					"account with unique passphrase is locked")
+				return nil, errors.E(errors.Locked, errors.Detail{
+					Code:   errors.ErrLockedAccount,
+					Hint:   "unlock the account with its passphrase",
+					Params: map[string]string{"account": acctInfo.acctName},
+				}, "account with unique passphrase is locked")
 			}
 			if len(acctInfo.acctKeyEncrypted) != 0 {
 				return nil, errors.E(errors.Locked,
@@ -509,7 +555,9 @@ func (m *Manager) loadAccountInfo(ns walletdb.ReadBucket, account uint32) (*acco
 		acctInfo.uniqueKey = row.uniqueKey
 		if acctInfo.uniqueKey != nil { // a passphrase hasher is required
 			hashKey := make([]byte, 32)
-			rand.Read(hashKey)
+			if err := readRand(m.rand, hashKey); err != nil {
+				return nil, err
+			}
 			hasher, err := blake2b.New256(hashKey)
 			if err != nil {
 				return nil, errors.E(errors.IO, err)
@@ -528,7 +576,8 @@ func (m *Manager) loadAccountInfo(ns walletdb.ReadBucket, account uint32) (*acco
 			return nil, errors.E(errors.Crypto, errors.Errorf("decrypt account %d privkey: %v", account, err))
 		}
 
-		acctKeyPriv, err := hdkeychain.NewKeyFromString(string(decrypted), m.chainParams)
+		acctKeyPriv, err := xprivFromBytes(decrypted, m.chainParams)
+		zero(decrypted)
 		if err != nil {
 			return nil, errors.E(errors.IO, err)
 		}
@@ -560,10 +609,10 @@ func (m *Manager) AccountProperties(ns walletdb.ReadBucket, account uint32) (*Ac
 	// the accountInfo struct is filled with a BIP0044 account's extended
 	// keys, and the imported accounts has none.
 	//
-	// Since only the imported account allows imports currently, the number
-	// of imported keys for any other account is zero, and since the
-	// imported account cannot contain non-imported keys, the external and
-	// internal key counts for it are zero.
+	// Imported keys are only counted for the imported account, which
+	// cannot contain non-imported keys, so the external and internal key
+	// counts for it are zero.  Watched public keys and scripts imported
+	// into other accounts are not counted.
 	if account != ImportedAddrAccount {
 		acctInfo, err := m.loadAccountInfo(ns, account)
 		if err != nil {
@@ -601,6 +650,10 @@ func (m *Manager) AccountProperties(ns walletdb.ReadBucket, account uint32) (*Ac
 
 	props.AccountEncrypted, props.AccountUnlocked = m.accountHasPassphrase(ns, account)
 
+	// Databases which have not been upgraded to record account UUIDs
+	// report the zero UUID.
+	props.AccountUUID, _ = fetchAccountUUID(ns, account)
+
 	return props, nil
 }
 
@@ -685,7 +738,41 @@ func (m *Manager) CoinTypePrivKey(dbtx walletdb.ReadTx) (*hdkeychain.ExtendedKey
 	if err != nil {
 		return nil, errors.E(errors.Crypto, errors.Errorf("decrypt cointype privkey: %v", err))
 	}
-	coinTypeKeyPriv, err := hdkeychain.NewKeyFromString(string(serializedKeyPriv), m.chainParams)
+	coinTypeKeyPriv, err := xprivFromBytes(serializedKeyPriv, m.chainParams)
+	zero(serializedKeyPriv)
+	if err != nil {
+		return nil, errors.E(errors.IO, err)
+	}
+	return coinTypeKeyPriv, nil
+}
+
+// SLIP0044CoinTypePrivKey returns the SLIP0044 coin type private key saved by
+// wallets using the legacy coin type.  The key does not derive any accounts of
+// the wallet, but allows searching for and recovering usage of SLIP0044
+// accounts derived from the same seed.  Errors with code NotExist are returned
+// when the key was not saved.
+func (m *Manager) SLIP0044CoinTypePrivKey(dbtx walletdb.ReadTx) (*hdkeychain.ExtendedKey, error) {
+	defer m.mtx.RUnlock()
+	m.mtx.RLock()
+
+	if m.locked {
+		return nil, errors.E(errors.Locked)
+	}
+	if m.watchingOnly {
+		return nil, errors.E(errors.WatchingOnly)
+	}
+
+	ns := dbtx.ReadBucket(waddrmgrBucketKey)
+	mainBucket := ns.NestedReadBucket(mainBucketName)
+	coinTypePrivEnc := mainBucket.Get(coinTypeSLIP0044PrivKeyName)
+	if coinTypePrivEnc == nil {
+		return nil, errors.E(errors.NotExist, "missing SLIP0044 coin type privkey")
+	}
+	serializedKeyPriv, err := m.cryptoKeyPriv.Decrypt(coinTypePrivEnc)
+	if err != nil {
+		return nil, errors.E(errors.Crypto, errors.Errorf("decrypt cointype privkey: %v", err))
+	}
+	coinTypeKeyPriv, err := xprivFromBytes(serializedKeyPriv, m.chainParams)
 	zero(serializedKeyPriv)
 	if err != nil {
 		return nil, errors.E(errors.IO, err)
@@ -817,6 +904,14 @@ func (m *Manager) UpgradeToSLIP0044CoinType(dbtx walletdb.ReadWriteTx) error {
 	if err != nil {
 		return err
 	}
+	slip0044Xpub, err := m.cryptoKeyPub.Decrypt(slip0044Account.pubKeyEncrypted)
+	if err != nil {
+		return errors.E(errors.Crypto, errors.Errorf("decrypt SLIP0044 account 0 pubkey: %v", err))
+	}
+	err = putAccountBlindedID(ns, 0, string(slip0044Xpub))
+	if err != nil {
+		return err
+	}
 	err = mainBucket.Delete(slip0044Account0RowName)
 	if err != nil {
 		return errors.E(errors.IO, err)
@@ -852,7 +947,8 @@ func (m *Manager) UpgradeToSLIP0044CoinType(dbtx walletdb.ReadWriteTx) error {
 		if err != nil {
 			return errors.E(errors.Crypto, errors.Errorf("decrypt SLIP0044 account 0 xpriv: %v", err))
 		}
-		acctExtPrivKey, err = hdkeychain.NewKeyFromString(string(acctExtPrivKeyStr), m.chainParams)
+		acctExtPrivKey, err = xprivFromBytes(acctExtPrivKeyStr, m.chainParams)
+		zero(acctExtPrivKeyStr)
 		if err != nil {
 			return errors.E(errors.IO, err)
 		}
@@ -1059,9 +1155,21 @@ func (m *Manager) ChangePassphrase(ns walletdb.ReadWriteBucket, oldPassphrase, n
 	}
 	defer secretKey.Zero()
 
+	// The new private passphrase may not be the duress passphrase, which
+	// unlocks only the duress account.
+	if private {
+		isDuress, err := matchesDuressPassphrase(ns, newPassphrase)
+		if err != nil {
+			return err
+		}
+		if isDuress {
+			return errors.E(errors.Invalid, "new passphrase matches the duress passphrase")
+		}
+	}
+
 	// Generate a new master key from the passphrase which is used to secure
 	// the actual secret keys.
-	newMasterKey, err := newSecretKey(&newPassphrase, scryptOptionsForNet(m.chainParams.Net))
+	newMasterKey, err := newSecretKey(m.rand, &newPassphrase, scryptOptionsForNet(m.chainParams.Net))
 	if err != nil {
 		return err
 	}
@@ -1077,7 +1185,9 @@ func (m *Manager) ChangePassphrase(ns walletdb.ReadWriteBucket, oldPassphrase, n
 
 		// Create a new passphrase hasher.
 		hashKey := make([]byte, 32)
-		rand.Read(hashKey)
+		if err := readRand(m.rand, hashKey); err != nil {
+			return err
+		}
 		passHasher, err := blake2b.New256(hashKey)
 		if err != nil {
 			return err
@@ -1089,7 +1199,7 @@ func (m *Manager) ChangePassphrase(ns walletdb.ReadWriteBucket, oldPassphrase, n
 		if err != nil {
 			return errors.E(errors.Crypto, errors.Errorf("decrypt crypto privkey: %v", err))
 		}
-		encPriv, err := newMasterKey.Encrypt(decPriv)
+		encPriv, err := newMasterKey.EncryptWithRand(randReader(m.rand), decPriv)
 		zero(decPriv)
 		if err != nil {
 			return errors.E(errors.Crypto, errors.Errorf("encrypt crypto privkey: %v", err))
@@ -1118,18 +1228,26 @@ func (m *Manager) ChangePassphrase(ns walletdb.ReadWriteBucket, oldPassphrase, n
 		if err != nil {
 			return err
 		}
+		err = putPassphraseChanged(ns.NestedReadWriteBucket(mainBucketName),
+			privPassphraseChangedName)
+		if err != nil {
+			return err
+		}
 
 		// Now that the db has been successfully updated, clear the old
 		// key and set the new one.
+		// The new key is copied into the existing key's locked memory.
 		copy(m.cryptoKeyPrivEncrypted, encPriv)
 		m.masterKeyPriv.Zero() // Clear the old key.
-		m.masterKeyPriv = newMasterKey
+		copy(m.masterKeyPriv.Key[:], newMasterKey.Key[:])
+		m.masterKeyPriv.Parameters = newMasterKey.Parameters
+		newMasterKey.Zero()
 		m.privPassphraseHasher = passHasher
 		m.privPassphraseHash = passHash
 	} else {
 		// Re-encrypt the crypto public key using the new master public
 		// key.
-		encryptedPub, err := newMasterKey.Encrypt(m.cryptoKeyPub.Bytes())
+		encryptedPub, err := newMasterKey.EncryptWithRand(randReader(m.rand), m.cryptoKeyPub.Bytes())
 		if err != nil {
 			return errors.E(errors.Crypto, errors.Errorf("encrypt crypto pubkey: %v", err))
 		}
@@ -1223,6 +1341,60 @@ func (m *Manager) ExistsHash160(ns walletdb.ReadBucket, hash160 []byte) bool {
 	return existsAddress(ns, hash160)
 }
 
+// scriptIndexHash returns the HASH160 of the address paid by a version 0
+// P2PKH or P2SH output script, including stake-tagged scripts, and whether
+// the script is P2SH.  A nil hash is returned for all other scripts, which
+// are not recorded by the script index.
+func scriptIndexHash(version uint16, pkScript []byte) (p2sh bool, hash160 []byte) {
+	if version != 0 {
+		return false, nil
+	}
+	if h := stdscript.ExtractPubKeyHashV0(pkScript); h != nil {
+		return false, h
+	}
+	if h := stdscript.ExtractScriptHashV0(pkScript); h != nil {
+		return true, h
+	}
+	if h := stdscript.ExtractStakePubKeyHashV0(pkScript); h != nil {
+		return false, h
+	}
+	if h := stdscript.ExtractStakeScriptHashV0(pkScript); h != nil {
+		return true, h
+	}
+	return false, nil
+}
+
+// ScriptAccount returns the account of the address paid by an output script,
+// using a single lookup of the script index.  Only version 0 P2PKH and P2SH
+// scripts, and their stake-tagged forms, are indexed.  Errors with code
+// NotExist are returned for all other scripts and scripts which do not pay
+// recorded addresses.
+func (m *Manager) ScriptAccount(ns walletdb.ReadBucket, version uint16, pkScript []byte) (uint32, error) {
+	p2sh, hash160 := scriptIndexHash(version, pkScript)
+	if hash160 == nil {
+		return 0, errors.E(errors.NotExist, "script is not indexed")
+	}
+	key := scriptIndexKey(p2sh, hash160)
+	account, ok := fetchScriptIndex(ns, key[:])
+	if !ok {
+		return 0, errors.E(errors.NotExist, "script does not pay a wallet address")
+	}
+	return account, nil
+}
+
+// ExistsScript returns whether an output script pays to an address recorded
+// by the address manager.  It is equivalent to checking ScriptAccount for
+// errors but does not allocate errors for scripts unrelated to the wallet.
+func (m *Manager) ExistsScript(ns walletdb.ReadBucket, version uint16, pkScript []byte) bool {
+	p2sh, hash160 := scriptIndexHash(version, pkScript)
+	if hash160 == nil {
+		return false
+	}
+	key := scriptIndexKey(p2sh, hash160)
+	_, ok := fetchScriptIndex(ns, key[:])
+	return ok
+}
+
 // ImportPrivateKey imports a WIF private key into the address manager.  The
 // imported address is created using either a compressed or uncompressed
 // serialized public key, depending on the CompressPubKey bool of the WIF.
@@ -1241,6 +1413,16 @@ func (m *Manager) ExistsHash160(ns walletdb.ReadBucket, hash160 []byte) bool {
 // It will also return an error if the address already exists.  Any other errors
 // returned are generally unexpected.
 func (m *Manager) ImportPrivateKey(ns walletdb.ReadWriteBucket, wif *dcrutil.WIF) (ManagedPubKeyAddress, error) {
+	return m.ImportPrivateKeyToAccount(ns, wif, ImportedAddrAccount)
+}
+
+// ImportPrivateKeyToAccount imports a WIF private key into an existing
+// account.  This allows keys derived by the wallet outside of the account's
+// address branches to be spent and tracked by the account.  See ImportPrivateKey
+// for further details.
+func (m *Manager) ImportPrivateKeyToAccount(ns walletdb.ReadWriteBucket, wif *dcrutil.WIF,
+	account uint32) (ManagedPubKeyAddress, error) {
+
 	defer m.mtx.Unlock()
 	m.mtx.Lock()
 
@@ -1248,6 +1430,11 @@ func (m *Manager) ImportPrivateKey(ns walletdb.ReadWriteBucket, wif *dcrutil.WIF
 	if !m.watchingOnly && m.locked {
 		return nil, errors.E(errors.Locked)
 	}
+	if account != ImportedAddrAccount {
+		if _, err := fetchAccountName(ns, account); err != nil {
+			return nil, err
+		}
+	}
 
 	// Prevent duplicates.
 	serializedPubKey := wif.PubKey()
@@ -1275,14 +1462,14 @@ func (m *Manager) ImportPrivateKey(ns walletdb.ReadWriteBucket, wif *dcrutil.WIF
 
 	// Save the new imported address to the db and update start block (if
 	// needed) in a single transaction.
-	err = putImportedAddress(ns, pubKeyHash, ImportedAddrAccount,
+	err = putImportedAddress(ns, pubKeyHash, account,
 		encryptedPubKey, encryptedPrivKey)
 	if err != nil {
 		return nil, err
 	}
 
 	// Create a new managed address based on the imported address.
-	managedAddr, err := newManagedAddressWithoutPrivKey(m, ImportedAddrAccount,
+	managedAddr, err := newManagedAddressWithoutPrivKey(m, account,
 		serializedPubKey)
 	if err != nil {
 		return nil, err
@@ -1295,6 +1482,16 @@ func (m *Manager) ImportPrivateKey(ns walletdb.ReadWriteBucket, wif *dcrutil.WIF
 // the derived P2PKH address.  This method may only be used by watching-only
 // wallets.
 func (m *Manager) ImportPublicKey(ns walletdb.ReadWriteBucket, pubkey []byte) (ManagedPubKeyAddress, error) {
+	return m.ImportPublicKeyToAccount(ns, pubkey, ImportedAddrAccount)
+}
+
+// ImportPublicKeyToAccount imports a compressed 33-byte serialized secp256k1
+// public key and the derived P2PKH address into an existing account.  This
+// allows externally controlled addresses to be grouped with the account they
+// are tracked for.  This method may only be used by watching-only wallets.
+func (m *Manager) ImportPublicKeyToAccount(ns walletdb.ReadWriteBucket, pubkey []byte,
+	account uint32) (ManagedPubKeyAddress, error) {
+
 	defer m.mtx.Unlock()
 	m.mtx.Lock()
 
@@ -1302,6 +1499,9 @@ func (m *Manager) ImportPublicKey(ns walletdb.ReadWriteBucket, pubkey []byte) (M
 		return nil, errors.E(errors.Invalid, "public keys may "+
 			"only be imported by watching-only wallets")
 	}
+	if _, err := fetchAccountName(ns, account); err != nil {
+		return nil, err
+	}
 
 	if len(pubkey) != secp256k1.PubKeyBytesLenCompressed {
 		return nil, errors.E(errors.Encoding, "invalid length for "+
@@ -1331,15 +1531,13 @@ func (m *Manager) ImportPublicKey(ns walletdb.ReadWriteBucket, pubkey []byte) (M
 
 	// Save the new imported address to the db and update start block (if
 	// needed) in a single transaction.
-	err = putImportedAddress(ns, pkh, ImportedAddrAccount,
-		encryptedPubKey, nil)
+	err = putImportedAddress(ns, pkh, account, encryptedPubKey, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	// Create a new managed address based on the imported address.
-	managedAddr, err := newManagedAddressWithoutPrivKey(m,
-		ImportedAddrAccount, pubkey)
+	managedAddr, err := newManagedAddressWithoutPrivKey(m, account, pubkey)
 	if err != nil {
 		return nil, err
 	}
@@ -1353,9 +1551,23 @@ func (m *Manager) ImportPublicKey(ns walletdb.ReadWriteBucket, pubkey []byte) (M
 // All imported script addresses will be part of the account defined by the
 // ImportedAddrAccount constant.
 func (m *Manager) ImportScript(ns walletdb.ReadWriteBucket, script []byte) (ManagedScriptAddress, error) {
+	return m.ImportScriptToAccount(ns, script, ImportedAddrAccount)
+}
+
+// ImportScriptToAccount imports a user-provided script into an existing
+// account of the address manager.  The imported script will act as a
+// pay-to-script-hash address.  Outputs paying imported scripts are never
+// selected as inputs of transactions created by the wallet.
+func (m *Manager) ImportScriptToAccount(ns walletdb.ReadWriteBucket, script []byte,
+	account uint32) (ManagedScriptAddress, error) {
+
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
+	if _, err := fetchAccountName(ns, account); err != nil {
+		return nil, err
+	}
+
 	// Prevent duplicates.
 	scriptHash := dcrutil.Hash160(script)
 	if existsAddress(ns, scriptHash) {
@@ -1371,50 +1583,59 @@ func (m *Manager) ImportScript(ns walletdb.ReadWriteBucket, script []byte) (Mana
 
 	// Save the new imported address to the db and update start block (if
 	// needed) in a single transaction.
-	err = putScriptAddress(ns, scriptHash, ImportedAddrAccount,
-		encryptedHash, script)
+	err = putScriptAddress(ns, scriptHash, account, encryptedHash, script)
 	if err != nil {
 		return nil, err
 	}
 
 	// Create a new managed address based on the imported script.
-	return newScriptAddress(m, ImportedAddrAccount, scriptHash, script)
+	return newScriptAddress(m, account, scriptHash, script)
 }
 
 func (m *Manager) ImportXpubAccount(ns walletdb.ReadWriteBucket, name string, xpub *hdkeychain.ExtendedKey) error {
 	defer m.mtx.Unlock()
 	m.mtx.Lock()
 
+	_, err := m.importXpubAccount(ns, actBIP0044, name, xpub)
+	return err
+}
+
+// importXpubAccount records a watching-only account of an account type for
+// the extended public key, returning the imported account number.  The
+// manager mutex must be held.
+func (m *Manager) importXpubAccount(ns walletdb.ReadWriteBucket, acctType accountType,
+	name string, xpub *hdkeychain.ExtendedKey) (uint32, error) {
+
 	// Validate account name
 	if err := ValidateAccountName(name); err != nil {
-		return err
+		return 0, err
 	}
 
 	// There may not be an account by the same name
 	if _, err := fetchAccountByName(ns, name); err == nil {
-		return errors.E(errors.Exist, "account name in use")
+		return 0, errors.E(errors.Exist, "account name in use")
 	}
 
 	// Reserve next imported account number
 	account, err := fetchLastImportedAccount(ns)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	account++
 	if account < MaxAccountNum {
-		return errors.E(errors.Invalid, "exhausted possible imported accounts")
+		return 0, errors.E(errors.Invalid, "exhausted possible imported accounts")
 	}
 
 	// Encrypt the default account keys with the associated crypto keys.
 	apes := xpub.String()
 	acctPubEnc, err := m.cryptoKeyPub.Encrypt([]byte(apes))
 	if err != nil {
-		return errors.E(errors.Crypto, errors.Errorf("encrypt account pubkey: %v", err))
+		return 0, errors.E(errors.Crypto, errors.Errorf("encrypt account pubkey: %v", err))
 	}
 	// We have the encrypted account extended keys, so save them to the
 	// database
 	dbAcct := new(dbBIP0044Account)
-	dbAcct.acctType = actBIP0044
+	dbAcct.acctType = acctType
 	dbAcct.pubKeyEncrypted = acctPubEnc
 	dbAcct.lastUsedExternalIndex = ^uint32(0)
 	dbAcct.lastUsedInternalIndex = ^uint32(0)
@@ -1424,15 +1645,21 @@ func (m *Manager) ImportXpubAccount(ns walletdb.ReadWriteBucket, name string, xp
 	dbAcct.rawData = dbAcct.serializeRow()
 	err = putNewBIP0044Account(ns, account, dbAcct)
 	if err != nil {
-		return err
+		return 0, err
+	}
+	if err := putNewAccountUUID(ns, m.rand, account); err != nil {
+		return 0, err
+	}
+	if err := putAccountBlindedID(ns, account, xpub.String()); err != nil {
+		return 0, err
 	}
 
 	// Save last imported account metadata
 	if err := putLastImportedAccount(ns, account); err != nil {
-		return err
+		return 0, err
 	}
 
-	return nil
+	return account, nil
 }
 
 // IsLocked returns whether or not the address managed is locked.  When it is
@@ -1442,7 +1669,7 @@ func (m *Manager) IsLocked() bool {
 	m.mtx.RLock()
 	defer m.mtx.RUnlock()
 
-	return m.locked
+	return m.locked && !m.duressUnlocked
 }
 
 // Lock performs a best try effort to remove and zero all secret keys associated
@@ -1460,7 +1687,7 @@ func (m *Manager) Lock() error {
 	defer m.mtx.Unlock()
 
 	// Error on attempt to lock an already locked manager.
-	if m.locked {
+	if m.locked && !m.duressUnlocked {
 		return errors.E(errors.Locked)
 	}
 
@@ -1468,6 +1695,36 @@ func (m *Manager) Lock() error {
 	return nil
 }
 
+// LockSpending performs a partial lock of the address manager, removing and
+// zeroing all secret keys except the private keys of unlocked imported voting
+// accounts.  This allows tickets to continue to be voted without keeping the
+// keys of spending accounts in memory.
+//
+// This function will return an error if invoked on a watching-only address
+// manager.
+func (m *Manager) LockSpending() error {
+	// A watching-only address manager can't be locked.
+	if m.watchingOnly {
+		return errors.E(errors.WatchingOnly)
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	voting := make(map[*accountInfo]*hdkeychain.ExtendedKey)
+	for _, acctInfo := range m.acctInfo {
+		if acctInfo.acctType == importedVoting && acctInfo.acctKeyPriv != nil {
+			voting[acctInfo] = acctInfo.acctKeyPriv
+			acctInfo.acctKeyPriv = nil
+		}
+	}
+	m.lock()
+	for acctInfo, acctKeyPriv := range voting {
+		acctInfo.acctKeyPriv = acctKeyPriv
+	}
+	return nil
+}
+
 // LookupAccount loads account number stored in the manager for the given
 // account name
 func (m *Manager) LookupAccount(ns walletdb.ReadBucket, name string) (uint32, error) {
@@ -1490,7 +1747,7 @@ func (m *Manager) UnlockedWithPassphrase(passphrase []byte) error {
 		return errors.E(errors.WatchingOnly, "watching wallets can not be unlocked")
 	}
 
-	if m.locked {
+	if m.locked && !m.duressUnlocked {
 		return errors.E(errors.Locked)
 	}
 
@@ -1501,6 +1758,12 @@ func (m *Manager) UnlockedWithPassphrase(passphrase []byte) error {
 	m.privPassphraseHasherMu.Unlock()
 
 	if subtle.ConstantTimeCompare(passHash, m.privPassphraseHash) != 1 {
+		// A different passphrase after unlocking with the duress
+		// passphrase may be the private passphrase, which must fully
+		// unlock the manager.
+		if m.duressUnlocked {
+			return errors.E(errors.Locked)
+		}
 		return errors.E(errors.Passphrase)
 	}
 
@@ -1541,9 +1804,33 @@ func (m *Manager) Unlock(ns walletdb.ReadBucket, passphrase []byte) error {
 		return nil
 	}
 
-	// Derive the master private key using the provided passphrase.
-	if err := m.masterKeyPriv.DeriveKey(&passphrase); err != nil {
+	// Avoid unlocking again if unlocked with the same duress passphrase.
+	// Otherwise, clear the duress account's key before unlocking with a
+	// different passphrase.
+	if m.duressUnlocked {
+		if subtle.ConstantTimeCompare(passHash, m.privPassphraseHash) == 1 {
+			return nil
+		}
+		m.lock()
+	}
+
+	// Derive the master private key using the provided passphrase.  When
+	// it is incorrect, attempt to unlock with the duress passphrase.  The
+	// duress key is derived for every passphrase, so unlocking with the
+	// private, duress, or an incorrect passphrase performs the same key
+	// derivations and does not reveal whether a duress passphrase exists.
+	err := m.masterKeyPriv.DeriveKey(&passphrase)
+	duressAcct, duressKey, derr := openDuress(ns, passphrase)
+	defer zero(duressKey)
+	if derr != nil {
+		m.lock()
+		return derr
+	}
+	if err != nil {
 		m.lock()
+		if errors.Is(err, errors.Passphrase) && duressKey != nil {
+			return m.unlockDuress(ns, duressAcct, duressKey, passHash)
+		}
 		return err
 	}
 
@@ -1572,7 +1859,7 @@ func (m *Manager) Unlock(ns walletdb.ReadBucket, passphrase []byte) error {
 			return errors.E(errors.Crypto, errors.Errorf("decrypt account %d privkey: %v", account, err))
 		}
 
-		acctKeyPriv, err := hdkeychain.NewKeyFromString(string(decrypted), m.chainParams)
+		acctKeyPriv, err := xprivFromBytes(decrypted, m.chainParams)
 		zero(decrypted)
 		if err != nil {
 			m.lock()
@@ -1635,8 +1922,7 @@ func (m *Manager) UnlockAccount(dbtx walletdb.ReadTx, account uint32,
 		return err
 	}
 
-	acctKeyPriv, err := hdkeychain.NewKeyFromString(string(plaintext),
-		m.chainParams)
+	acctKeyPriv, err := xprivFromBytes(plaintext, m.chainParams)
 	if err != nil {
 		return errors.E(errors.IO, err)
 	}
@@ -1729,7 +2015,9 @@ func (m *Manager) SetAccountPassphrase(dbtx walletdb.ReadWriteTx, account uint32
 	// Create a new passphase hasher from a new key, and hash the new
 	// passphrase.
 	hashKey := make([]byte, 32)
-	rand.Read(hashKey)
+	if err := readRand(m.rand, hashKey); err != nil {
+		return err
+	}
 	hasher, err := blake2b.New256(hashKey)
 	if err != nil {
 		return errors.E(errors.IO, err)
@@ -1738,13 +2026,13 @@ func (m *Manager) SetAccountPassphrase(dbtx walletdb.ReadWriteTx, account uint32
 	passHash := hasher.Sum(nil)
 
 	// Encrypt the account xpriv with a new key.
-	kdfp, err := kdf.NewArgon2idParams(rand.Reader())
+	kdfp, err := kdf.NewArgon2idParams(randReader(m.rand))
 	if err != nil {
 		return err
 	}
 	plaintext := []byte(acctInfo.acctKeyPriv.String())
 	key := argon2idKey(passphrase, kdfp)
-	ciphertext, err := seal(key, plaintext)
+	ciphertext, err := seal(m.rand, key, plaintext)
 	zero(plaintext)
 	if err != nil {
 		return err
@@ -1758,6 +2046,10 @@ func (m *Manager) SetAccountPassphrase(dbtx walletdb.ReadWriteTx, account uint32
 	if err != nil {
 		return err
 	}
+	err = putPassphraseChanged(vars, acctVarPassphraseChanged)
+	if err != nil {
+		return err
+	}
 
 	// Write a new account row with the new xpriv ciphertext.
 	dbAcct, err := fetchDBAccount(ns, account, DBVersion)
@@ -1812,6 +2104,10 @@ func (m *Manager) removeAccountPassphrase(ns walletdb.ReadWriteBucket, account u
 	if err != nil {
 		return errors.E(errors.IO, err)
 	}
+	err = vars.Delete(acctVarPassphraseChanged)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
 
 	// Write a new account row with the new xpriv ciphertext.
 	dbAcct, err := fetchDBAccount(ns, account, DBVersion)
@@ -1890,49 +2186,71 @@ func (m *Manager) MarkUsed(tx walletdb.ReadWriteTx, address stdaddr.Address) err
 
 // MarkUsedChildIndex marks a BIP0044 account branch child as used.
 func (m *Manager) MarkUsedChildIndex(tx walletdb.ReadWriteTx, account, branch, child uint32) error {
+	return m.MarkUsedChildIndexes(tx, []ChildIndexUse{{account, branch, child}})
+}
+
+// ChildIndexUse describes the use of a BIP0044 account branch child.
+type ChildIndexUse struct {
+	Account, Branch, Child uint32
+}
+
+// MarkUsedChildIndexes marks many BIP0044 account branch children as used.
+// Only the greatest child of each account branch is recorded, and the last
+// used and last returned variables of each account branch are read and written
+// at most once, no matter how many uses of the branch are described.
+func (m *Manager) MarkUsedChildIndexes(tx walletdb.ReadWriteTx, uses []ChildIndexUse) error {
 	ns := tx.ReadWriteBucket(waddrmgrBucketKey)
 
-	var lastUsedVarName, lastReturnedVarName []byte
-	switch branch {
-	case ExternalBranch:
-		lastUsedVarName = acctVarLastUsedExternal
-		lastReturnedVarName = acctVarLastReturnedExternal
-	case InternalBranch:
-		lastUsedVarName = acctVarLastUsedInternal
-		lastReturnedVarName = acctVarLastReturnedInternal
-	default:
-		return errors.E(errors.Invalid, errors.Errorf("account branch %d", branch))
+	type accountBranch struct{ account, branch uint32 }
+	lastUses := make(map[accountBranch]uint32)
+	for _, u := range uses {
+		if u.Branch != ExternalBranch && u.Branch != InternalBranch {
+			return errors.E(errors.Invalid, errors.Errorf("account branch %d", u.Branch))
+		}
+		k := accountBranch{u.Account, u.Branch}
+		if child, ok := lastUses[k]; !ok || u.Child > child {
+			lastUses[k] = u.Child
+		}
 	}
 
-	acctKey := uint32ToBytes(account)
-	vars := ns.NestedReadWriteBucket(acctVarsBucketName).
-		NestedReadWriteBucket(acctKey)
+	for k, child := range lastUses {
+		lastUsedVarName := acctVarLastUsedExternal
+		lastReturnedVarName := acctVarLastReturnedExternal
+		if k.branch == InternalBranch {
+			lastUsedVarName = acctVarLastUsedInternal
+			lastReturnedVarName = acctVarLastReturnedInternal
+		}
 
-	var r accountVarReader
-	lastUsed := r.getAccountUint32Var(vars, lastUsedVarName)
-	lastRet := r.getAccountUint32Var(vars, lastReturnedVarName)
-	if r.err != nil {
-		return errors.E(errors.IO, r.err)
-	}
+		acctKey := uint32ToBytes(k.account)
+		vars := ns.NestedReadWriteBucket(acctVarsBucketName).
+			NestedReadWriteBucket(acctKey)
 
-	// Change nothing when the child is not beyond the currently-recorded
-	// last used child index.
-	if child+1 <= lastUsed+1 {
-		return nil
-	}
+		var r accountVarReader
+		lastUsed := r.getAccountUint32Var(vars, lastUsedVarName)
+		lastRet := r.getAccountUint32Var(vars, lastReturnedVarName)
+		if r.err != nil {
+			return errors.E(errors.IO, r.err)
+		}
 
-	// Write larger last used child index.
-	err := putAccountUint32Var(vars, lastUsedVarName, child)
-	if err != nil {
-		return err
-	}
-	// Increase last returned child if necessary.  This value should never
-	// be lower than the last used child.
-	if lastRet+1 < child+1 {
-		err = putAccountUint32Var(vars, lastReturnedVarName, child)
+		// Change nothing when the child is not beyond the
+		// currently-recorded last used child index.
+		if child+1 <= lastUsed+1 {
+			continue
+		}
+
+		// Write larger last used child index.
+		err := putAccountUint32Var(vars, lastUsedVarName, child)
 		if err != nil {
 			return err
 		}
+		// Increase last returned child if necessary.  This value should
+		// never be lower than the last used child.
+		if lastRet+1 < child+1 {
+			err = putAccountUint32Var(vars, lastReturnedVarName, child)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -2166,6 +2484,12 @@ func (m *Manager) NewAccount(ns walletdb.ReadWriteBucket, name string) (uint32,
 	if err != nil {
 		return 0, err
 	}
+	if err := putNewAccountUUID(ns, m.rand, account); err != nil {
+		return 0, err
+	}
+	if err := putAccountBlindedID(ns, account, acctKeyPub.String()); err != nil {
+		return 0, err
+	}
 
 	return account, nil
 }
@@ -2189,13 +2513,13 @@ func (m *Manager) ImportVotingAccount(dbtx walletdb.ReadWriteTx, acctKeyPriv *hd
 		return 0, err
 	}
 	// Encrypt the account xpriv with a new key.
-	kdfp, err := kdf.NewArgon2idParams(rand.Reader())
+	kdfp, err := kdf.NewArgon2idParams(randReader(m.rand))
 	if err != nil {
 		return 0, err
 	}
 	plaintext := []byte(acctKeyPriv.String())
 	key := argon2idKey(passphrase, kdfp)
-	ciphertext, err := seal(key, plaintext)
+	ciphertext, err := seal(m.rand, key, plaintext)
 	zero(plaintext)
 	if err != nil {
 		return 0, err
@@ -2309,6 +2633,12 @@ func (m *Manager) importAccount(dbtx walletdb.ReadWriteTx, acctType accountType,
 	if err != nil {
 		return 0, err
 	}
+	if err := putNewAccountUUID(ns, m.rand, account); err != nil {
+		return 0, err
+	}
+	if err := putAccountBlindedID(ns, account, acctKeyPub.String()); err != nil {
+		return 0, err
+	}
 
 	return account, nil
 }
@@ -2368,6 +2698,14 @@ func (m *Manager) RenameAccount(ns walletdb.ReadWriteBucket, account uint32, nam
 		if err != nil {
 			return errors.E(errors.IO, err)
 		}
+		err = appendAccountRename(acctVars, &AccountRename{
+			Time:    time.Now(),
+			OldName: oldName,
+			NewName: name,
+		})
+		if err != nil {
+			return err
+		}
 	default:
 		return errors.Errorf("unknown account type %T", dbAcct)
 	}
@@ -2435,6 +2773,34 @@ func (m *Manager) ForEachAccountAddress(ns walletdb.ReadBucket, account uint32,
 	return forEachAccountAddress(ns, account, addrFn)
 }
 
+// ForEachAccountAddressPage calls fn with up to limit addresses of an account
+// stored in the manager, breaking early on error.  Iteration begins after the
+// cursor after, or with the first address when after is nil.  The returned
+// cursor continues the iteration with the next page of addresses, and is nil
+// when no addresses remain.  Addresses are not returned in any meaningful
+// order, but pages never repeat or skip addresses which are not added or
+// removed between calls.  This allows iteration over very large numbers of
+// addresses across many short database transactions.
+func (m *Manager) ForEachAccountAddressPage(ns walletdb.ReadBucket, account uint32,
+	after []byte, limit int, fn func(maddr ManagedAddress) error) ([]byte, error) {
+
+	if limit <= 0 {
+		return nil, errors.E(errors.Invalid, "page limit must be positive")
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	addrFn := func(rowInterface any) error {
+		managedAddr, err := m.rowInterfaceToManaged(ns, rowInterface)
+		if err != nil {
+			return err
+		}
+		return fn(managedAddr)
+	}
+	return forEachAccountAddressPage(ns, account, after, limit, addrFn)
+}
+
 // ForEachActiveAccountAddress calls the given function with each active
 // address of the given account stored in the manager, breaking early on
 // error.
@@ -2443,6 +2809,25 @@ func (m *Manager) ForEachActiveAccountAddress(ns walletdb.ReadBucket, account ui
 	return m.ForEachAccountAddress(ns, account, fn)
 }
 
+// ForEachImportedAddress calls the given function with each imported key and
+// script address of every account, breaking early on error.
+func (m *Manager) ForEachImportedAddress(ns walletdb.ReadBucket, fn func(maddr ManagedAddress) error) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	addrFn := func(rowInterface any) error {
+		if _, ok := rowInterface.(*dbChainAddressRow); ok {
+			return nil
+		}
+		managedAddr, err := m.rowInterfaceToManaged(ns, rowInterface)
+		if err != nil {
+			return err
+		}
+		return fn(managedAddr)
+	}
+	return forEachActiveAddress(ns, addrFn)
+}
+
 // ForEachActiveAddress calls the given function with each active address
 // stored in the manager, breaking early on error.
 func (m *Manager) ForEachActiveAddress(ns walletdb.ReadBucket, fn func(addr stdaddr.Address) error) error {
@@ -2634,9 +3019,23 @@ func (m *Manager) Decrypt(keyType CryptoKeyType, in []byte) ([]byte, error) {
 }
 
 // newManager returns a new locked address manager with the given parameters.
+// All randomness used by the manager is read from r, or from a
+// cryptographically secure source when r is nil.
 func newManager(chainParams *chaincfg.Params, masterKeyPub *snacl.SecretKey,
 	masterKeyPriv *snacl.SecretKey, cryptoKeyPub EncryptorDecryptor,
-	cryptoKeyPrivEncrypted []byte, privPassphraseHasher hash.Hash) *Manager {
+	cryptoKeyPrivEncrypted []byte, privPassphraseHasher hash.Hash,
+	r io.Reader) *Manager {
+
+	// Hold the cleartext private master and crypto keys in locked memory.
+	cryptoKeyPriv, cryptoKeyMem := newLockedKey()
+	keyMem := []*securemem.Buffer{cryptoKeyMem}
+	if masterKeyPriv != nil && masterKeyPriv.Key != nil {
+		key, mem := newLockedKey()
+		copy(key[:], masterKeyPriv.Key[:])
+		masterKeyPriv.Key.Zero()
+		masterKeyPriv.Key = key
+		keyMem = append(keyMem, mem)
+	}
 
 	return &Manager{
 		chainParams:            chainParams,
@@ -2646,8 +3045,10 @@ func newManager(chainParams *chaincfg.Params, masterKeyPub *snacl.SecretKey,
 		masterKeyPriv:          masterKeyPriv,
 		cryptoKeyPub:           cryptoKeyPub,
 		cryptoKeyPrivEncrypted: cryptoKeyPrivEncrypted,
-		cryptoKeyPriv:          &cryptoKey{},
+		cryptoKeyPriv:          lockedCryptoKey{cryptoKeyPriv, r},
+		keyMem:                 keyMem,
 		privPassphraseHasher:   privPassphraseHasher,
+		rand:                   r,
 	}
 }
 
@@ -2725,8 +3126,9 @@ func checkBranchKeys(acctKey *hdkeychain.ExtendedKey) error {
 
 // loadManager returns a new address manager that results from loading it from
 // the passed opened database.  The public passphrase is required to decrypt the
-// public keys.
-func loadManager(ns walletdb.ReadBucket, pubPassphrase []byte, chainParams *chaincfg.Params) (*Manager, error) {
+// public keys.  All randomness used by the manager is read from r, or from a
+// cryptographically secure source when r is nil.
+func loadManager(ns walletdb.ReadBucket, pubPassphrase []byte, chainParams *chaincfg.Params, r io.Reader) (*Manager, error) {
 	// Load whether or not the manager is watching-only from the db.
 	watchingOnly, err := fetchWatchingOnly(ns)
 	if err != nil {
@@ -2766,7 +3168,7 @@ func loadManager(ns walletdb.ReadBucket, pubPassphrase []byte, chainParams *chai
 	}
 
 	// Use the master public key to decrypt the crypto public key.
-	cryptoKeyPub := &cryptoKey{snacl.CryptoKey{}}
+	cryptoKeyPub := &cryptoKey{rand: r}
 	cryptoKeyPubCT, err := masterKeyPub.Decrypt(cryptoKeyPubEnc)
 	if err != nil {
 		return nil, errors.E(errors.Crypto, errors.Errorf("decrypt crypto pubkey: %v", err))
@@ -2776,7 +3178,9 @@ func loadManager(ns walletdb.ReadBucket, pubPassphrase []byte, chainParams *chai
 
 	// Generate a private passphrase hasher.
 	hasherKey := make([]byte, 32)
-	rand.Read(hasherKey)
+	if err := readRand(r, hasherKey); err != nil {
+		return nil, err
+	}
 	passHasher, err := blake2b.New256(hasherKey)
 	if err != nil {
 		return nil, err
@@ -2786,7 +3190,7 @@ func loadManager(ns walletdb.ReadBucket, pubPassphrase []byte, chainParams *chai
 	// the defaults for the additional fields which are not specified in the
 	// call to new with the values loaded from the database.
 	mgr := newManager(chainParams, &masterKeyPub, &masterKeyPriv,
-		cryptoKeyPub, cryptoKeyPrivEnc, passHasher)
+		cryptoKeyPub, cryptoKeyPrivEnc, passHasher, r)
 	mgr.watchingOnly = watchingOnly
 	return mgr, nil
 }
@@ -2888,7 +3292,10 @@ func HDKeysFromSeed(seed []byte, params *chaincfg.Params) (coinTypeLegacyKeyPriv
 // passphrase is required on subsequent opens of the address manager, and the
 // private passphrase is required to unlock the address manager in order to gain
 // access to any private keys and information.
-func createAddressManager(ns walletdb.ReadWriteBucket, seed, pubPassphrase, privPassphrase []byte, chainParams *chaincfg.Params) error {
+//
+// All salts, crypto keys, and encryption nonces are read from r, or from a
+// cryptographically secure source when r is nil.
+func createAddressManager(ns walletdb.ReadWriteBucket, seed, pubPassphrase, privPassphrase []byte, chainParams *chaincfg.Params, r io.Reader) error {
 	// Return an error if the manager has already been created in the given
 	// database namespace.
 	if managerExists(ns) {
@@ -2921,11 +3328,11 @@ func createAddressManager(ns walletdb.ReadWriteBucket, seed, pubPassphrase, priv
 	// Generate new master keys.  These master keys are used to protect the
 	// crypto keys that will be generated next.
 	scryptOpts := scryptOptionsForNet(chainParams.Net)
-	masterKeyPub, err := newSecretKey(&pubPassphrase, scryptOpts)
+	masterKeyPub, err := newSecretKey(r, &pubPassphrase, scryptOpts)
 	if err != nil {
 		return err
 	}
-	masterKeyPriv, err := newSecretKey(&privPassphrase, scryptOpts)
+	masterKeyPriv, err := newSecretKey(r, &privPassphrase, scryptOpts)
 	if err != nil {
 		return err
 	}
@@ -2934,22 +3341,22 @@ func createAddressManager(ns walletdb.ReadWriteBucket, seed, pubPassphrase, priv
 	// Generate new crypto public and private keys.  These keys are used to
 	// protect the actual public and private data such as addresses, and
 	// extended keys.
-	cryptoKeyPub, err := newCryptoKey()
+	cryptoKeyPub, err := newCryptoKey(r)
 	if err != nil {
 		return err
 	}
-	cryptoKeyPriv, err := newCryptoKey()
+	cryptoKeyPriv, err := newCryptoKey(r)
 	if err != nil {
 		return err
 	}
 	defer cryptoKeyPriv.Zero()
 
 	// Encrypt the crypto keys with the associated master keys.
-	cryptoKeyPubEnc, err := masterKeyPub.Encrypt(cryptoKeyPub.Bytes())
+	cryptoKeyPubEnc, err := masterKeyPub.EncryptWithRand(randReader(r), cryptoKeyPub.Bytes())
 	if err != nil {
 		return errors.E(errors.Crypto, errors.Errorf("encrypt crypto pubkey: %v", err))
 	}
-	cryptoKeyPrivEnc, err := masterKeyPriv.Encrypt(cryptoKeyPriv.Bytes())
+	cryptoKeyPrivEnc, err := masterKeyPriv.EncryptWithRand(randReader(r), cryptoKeyPriv.Bytes())
 	if err != nil {
 		return errors.E(errors.Crypto, errors.Errorf("encrypt crypto privkey: %v", err))
 	}
@@ -3009,6 +3416,11 @@ func createAddressManager(ns walletdb.ReadWriteBucket, seed, pubPassphrase, priv
 	if err != nil {
 		return err
 	}
+	err = putPassphraseChanged(ns.NestedReadWriteBucket(mainBucketName),
+		privPassphraseChangedName)
+	if err != nil {
+		return err
+	}
 
 	// Save the encrypted crypto keys to the database.
 	err = putCryptoKeys(ns, cryptoKeyPubEnc, cryptoKeyPrivEnc)
@@ -3016,6 +3428,12 @@ func createAddressManager(ns walletdb.ReadWriteBucket, seed, pubPassphrase, priv
 		return err
 	}
 
+	// Record a decoy duress record until a duress passphrase is set.
+	err = putDecoyDuress(ns, chainParams, randReader(r))
+	if err != nil {
+		return err
+	}
+
 	// Save the encrypted legacy cointype keys to the database.
 	err = putCoinTypeLegacyKeys(ns, coinTypeLegacyPubEnc, coinTypeLegacyPrivEnc)
 	if err != nil {
@@ -3083,7 +3501,7 @@ func createAddressManager(ns walletdb.ReadWriteBucket, seed, pubPassphrase, priv
 // All public keys and information are protected by secret keys derived from the
 // provided public passphrase.  The public passphrase is required on subsequent
 // opens of the address manager.
-func createWatchOnly(ns walletdb.ReadWriteBucket, hdPubKey string, pubPassphrase []byte, chainParams *chaincfg.Params) (err error) {
+func createWatchOnly(ns walletdb.ReadWriteBucket, hdPubKey string, pubPassphrase []byte, chainParams *chaincfg.Params, r io.Reader) (err error) {
 	// Return an error if the manager has already been created in the given
 	// database namespace.
 	if managerExists(ns) {
@@ -3122,11 +3540,11 @@ func createWatchOnly(ns walletdb.ReadWriteBucket, hdPubKey string, pubPassphrase
 	// Generate new master keys.  These master keys are used to protect the
 	// crypto keys that will be generated next.
 	scryptOpts := scryptOptionsForNet(chainParams.Net)
-	masterKeyPub, err := newSecretKey(&pubPassphrase, scryptOpts)
+	masterKeyPub, err := newSecretKey(r, &pubPassphrase, scryptOpts)
 	if err != nil {
 		return err
 	}
-	masterKeyPriv, err := newSecretKey(&pubPassphrase, scryptOpts)
+	masterKeyPriv, err := newSecretKey(r, &pubPassphrase, scryptOpts)
 	if err != nil {
 		return err
 	}
@@ -3135,22 +3553,22 @@ func createWatchOnly(ns walletdb.ReadWriteBucket, hdPubKey string, pubPassphrase
 	// Generate new crypto public and private keys.  These keys are
 	// used to protect the actual public and private data such as addresses
 	// and extended keys.
-	cryptoKeyPub, err := newCryptoKey()
+	cryptoKeyPub, err := newCryptoKey(r)
 	if err != nil {
 		return err
 	}
-	cryptoKeyPriv, err := newCryptoKey()
+	cryptoKeyPriv, err := newCryptoKey(r)
 	if err != nil {
 		return err
 	}
 	defer cryptoKeyPriv.Zero()
 
 	// Encrypt the crypto keys with the associated master keys.
-	cryptoKeyPubEnc, err := masterKeyPub.Encrypt(cryptoKeyPub.Bytes())
+	cryptoKeyPubEnc, err := masterKeyPub.EncryptWithRand(randReader(r), cryptoKeyPub.Bytes())
 	if err != nil {
 		return errors.E(errors.Crypto, errors.Errorf("encrypt crypto pubkey: %v", err))
 	}
-	cryptoKeyPrivEnc, err := masterKeyPriv.Encrypt(cryptoKeyPriv.Bytes())
+	cryptoKeyPrivEnc, err := masterKeyPriv.EncryptWithRand(randReader(r), cryptoKeyPriv.Bytes())
 	if err != nil {
 		return errors.E(errors.Crypto, errors.Errorf("encrypt crypto privkey: %v", err))
 	}
@@ -3174,6 +3592,11 @@ func createWatchOnly(ns walletdb.ReadWriteBucket, hdPubKey string, pubPassphrase
 	if err != nil {
 		return err
 	}
+	err = putPassphraseChanged(ns.NestedReadWriteBucket(mainBucketName),
+		privPassphraseChangedName)
+	if err != nil {
+		return err
+	}
 
 	// Save the encrypted crypto keys to the database.
 	err = putCryptoKeys(ns, cryptoKeyPubEnc, cryptoKeyPrivEnc)
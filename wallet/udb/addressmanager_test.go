@@ -126,6 +126,36 @@ func testAddress(tc *testContext, prefix string, gotAddr ManagedAddress, wantAdd
 		return false
 	}
 
+	// Scripts must match those created from the decoded address.
+	decoded, err := stdaddr.DecodeAddress(wantAddr.address, tc.manager.chainParams)
+	if err != nil {
+		tc.t.Errorf("%s DecodeAddress: %v", prefix, err)
+		return false
+	}
+	stakeAddr := decoded.(stdaddr.StakeAddress)
+	scripts := []struct {
+		name      string
+		got, want func() (uint16, []byte)
+	}{
+		{"PaymentScript", gotAddr.PaymentScript, decoded.PaymentScript},
+		{"VotingRightsScript", gotAddr.VotingRightsScript, stakeAddr.VotingRightsScript},
+		{"StakeChangeScript", gotAddr.StakeChangeScript, stakeAddr.StakeChangeScript},
+		{"RewardCommitmentScript", func() (uint16, []byte) {
+			return gotAddr.RewardCommitmentScript(1e8, 0, 1<<24)
+		}, func() (uint16, []byte) {
+			return stakeAddr.RewardCommitmentScript(1e8, 0, 1<<24)
+		}},
+	}
+	for _, s := range scripts {
+		gotVersion, gotScript := s.got()
+		wantVersion, wantScript := s.want()
+		if gotVersion != wantVersion || !bytes.Equal(gotScript, wantScript) {
+			tc.t.Errorf("%s %s: unexpected script - got %d:%x, want %d:%x",
+				prefix, s.name, gotVersion, gotScript, wantVersion, wantScript)
+			return false
+		}
+	}
+
 	switch addr := gotAddr.(type) {
 	case ManagedPubKeyAddress:
 		if !testManagedPubKeyAddress(tc, prefix, addr, wantAddr) {
@@ -1093,6 +1123,58 @@ func TestManagerEncryptDecrypt(t *testing.T) {
 	testEncryptDecrypt(ctx, tc)
 }
 
+func TestMarkUsedChildIndexes(t *testing.T) {
+	ctx := context.Background()
+	db, mgr, _, teardown, err := cloneDB(ctx, "mark_used.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mgr.Close()
+
+	err = walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
+		err := mgr.MarkUsedChildIndexes(tx, []ChildIndexUse{
+			{Account: 0, Branch: ExternalBranch, Child: 4},
+			{Account: 0, Branch: ExternalBranch, Child: 9},
+			{Account: 0, Branch: ExternalBranch, Child: 2},
+			{Account: 0, Branch: InternalBranch, Child: 3},
+		})
+		if err != nil {
+			return err
+		}
+		// Lower children never decrease the recorded indexes.
+		err = mgr.MarkUsedChildIndexes(tx, []ChildIndexUse{
+			{Account: 0, Branch: InternalBranch, Child: 1},
+		})
+		if err != nil {
+			return err
+		}
+		err = mgr.MarkUsedChildIndexes(tx, []ChildIndexUse{
+			{Account: 0, Branch: 2, Child: 1},
+		})
+		if !errors.Is(err, errors.Invalid) {
+			t.Errorf("invalid branch: %v", err)
+		}
+
+		props, err := mgr.AccountProperties(tx.ReadBucket(waddrmgrBucketKey), 0)
+		if err != nil {
+			return err
+		}
+		if props.LastUsedExternalIndex != 9 || props.LastReturnedExternalIndex != 9 {
+			t.Errorf("external branch last used %d, last returned %d; want 9",
+				props.LastUsedExternalIndex, props.LastReturnedExternalIndex)
+		}
+		if props.LastUsedInternalIndex != 3 || props.LastReturnedInternalIndex != 3 {
+			t.Errorf("internal branch last used %d, last returned %d; want 3",
+				props.LastUsedInternalIndex, props.LastReturnedInternalIndex)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestChangePassphrase(t *testing.T) {
 	ctx := context.Background()
 	db, mgr, _, teardown, err := cloneDB(ctx, "change_passphrase.kv")
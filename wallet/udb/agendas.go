@@ -92,3 +92,32 @@ func DefaultAgendaPreference(dbtx walletdb.ReadTx, version uint32, agendaID stri
 func TicketAgendaPreference(dbtx walletdb.ReadTx, ticketHash *chainhash.Hash, version uint32, agendaID string) (choiceID string) {
 	return agendaPreferences.ticketPreference(dbtx, ticketHash, version, agendaID)
 }
+
+// AgendaPreference describes a saved default agenda choice.
+type AgendaPreference struct {
+	Version  uint32
+	AgendaID string
+	ChoiceID string
+}
+
+// DefaultAgendaPreferences returns every saved default agenda choice of all
+// deployment versions, ordered by version and agenda ID.
+func DefaultAgendaPreferences(dbtx walletdb.ReadTx) ([]AgendaPreference, error) {
+	b := dbtx.ReadBucket(agendaPreferences.defaultBucketKey())
+	var prefs []AgendaPreference
+	err := b.ForEach(func(k, v []byte) error {
+		if len(k) < 4 {
+			return errors.E(errors.IO, errors.Errorf("bad agenda preference key %x", k))
+		}
+		prefs = append(prefs, AgendaPreference{
+			Version:  byteOrder.Uint32(k),
+			AgendaID: string(k[4:]),
+			ChoiceID: string(v),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return prefs, nil
+}
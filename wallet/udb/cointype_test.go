@@ -134,6 +134,11 @@ func TestCoinTypeUpgrade(t *testing.T) {
 			t.Fatalf("initialized database has wrong coin type key")
 		}
 
+		legacyBlindedID, err := m.AccountBlindedID(ns, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
 		// Perform the upgrade
 		err = m.UpgradeToSLIP0044CoinType(dbtx)
 		if err != nil {
@@ -166,6 +171,21 @@ func TestCoinTypeUpgrade(t *testing.T) {
 			t.Fatalf("upgraded database has wrong account xpub")
 		}
 
+		// Check the account 0 blinded ID was replaced.
+		blindedID, err := m.AccountBlindedID(ns, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if blindedID == legacyBlindedID {
+			t.Fatalf("upgraded database did not replace account blinded ID")
+		}
+		if _, err := m.LookupAccountBlindedID(ns, legacyBlindedID); !errors.Is(err, errors.NotExist) {
+			t.Fatalf("legacy account blinded ID remains indexed: %v", err)
+		}
+		if a, err := m.LookupAccountBlindedID(ns, blindedID); err != nil || a != 0 {
+			t.Fatalf("upgraded account blinded ID lookup returned %d %v", a, err)
+		}
+
 		// Check that the SLIP0044-derived account 0's first address can be
 		// created and is indexed.
 		err = m.SyncAccountToAddrIndex(ns, 0, 1, 0)
@@ -0,0 +1,96 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+)
+
+var confTargetsBucketKey = []byte("conftargets")
+
+// ConfirmationTarget describes a number of confirmations of a transaction
+// which a caller has requested to be notified of.
+type ConfirmationTarget struct {
+	TxHash     chainhash.Hash
+	Target     int32
+	Registered time.Time
+}
+
+// confTargetKey serializes a confirmation target as the key of the
+// confirmation targets bucket.  Targets are keyed by the transaction hash
+// followed by the target, so multiple targets may be recorded for
+// a single transaction.  Values hold the unix time of registration.
+//
+// [0:32] Transaction hash (32 bytes)
+// [32:36] Target confirmations (4 bytes)
+func confTargetKey(txHash *chainhash.Hash, target int32) []byte {
+	k := make([]byte, hashSize+4)
+	copy(k, txHash[:])
+	byteOrder.PutUint32(k[hashSize:], uint32(target))
+	return k
+}
+
+// PutConfirmationTarget records a confirmation target of a transaction.
+// Recording a target which already exists is not an error and keeps the
+// original registration time.
+func PutConfirmationTarget(dbtx walletdb.ReadWriteTx, txHash *chainhash.Hash, target int32) error {
+	if target < 1 {
+		return errors.E(errors.Invalid, "confirmation target must be positive")
+	}
+	bucket := dbtx.ReadWriteBucket(confTargetsBucketKey)
+	k := confTargetKey(txHash, target)
+	if bucket.Get(k) != nil {
+		return nil
+	}
+	v := make([]byte, 8)
+	byteOrder.PutUint64(v, uint64(time.Now().Unix()))
+	err := bucket.Put(k, v)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// DeleteConfirmationTarget removes a recorded confirmation target.  It is
+// a NotExist error if the target was not recorded.
+func DeleteConfirmationTarget(dbtx walletdb.ReadWriteTx, txHash *chainhash.Hash, target int32) error {
+	bucket := dbtx.ReadWriteBucket(confTargetsBucketKey)
+	k := confTargetKey(txHash, target)
+	if bucket.Get(k) == nil {
+		return errors.E(errors.NotExist, errors.Errorf("no confirmation "+
+			"target %d for transaction %v", target, txHash))
+	}
+	if err := bucket.Delete(k); err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// ConfirmationTargets returns all recorded confirmation targets, ordered by
+// transaction hash and then target.
+func ConfirmationTargets(dbtx walletdb.ReadTx) ([]ConfirmationTarget, error) {
+	var targets []ConfirmationTarget
+	bucket := dbtx.ReadBucket(confTargetsBucketKey)
+	err := bucket.ForEach(func(k, v []byte) error {
+		if len(k) != hashSize+4 || len(v) != 8 {
+			return errors.E(errors.IO, errors.Errorf("bad confirmation "+
+				"target length %d/%d", len(k), len(v)))
+		}
+		var t ConfirmationTarget
+		copy(t.TxHash[:], k)
+		t.Target = int32(byteOrder.Uint32(k[hashSize:]))
+		t.Registered = time.Unix(int64(byteOrder.Uint64(v)), 0)
+		targets = append(targets, t)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return targets, nil
+}
@@ -0,0 +1,288 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"crypto/hmac"
+	"encoding/binary"
+	"encoding/hex"
+	"sort"
+	"strings"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/crypto/blake256"
+)
+
+// topLevelBuckets returns the keys of every top level bucket of the database.
+func topLevelBuckets() [][]byte {
+	return [][]byte{
+		unifiedDBMetadata{}.rootBucketKey(),
+		waddrmgrBucketKey,
+		wtxmgrBucketKey,
+		agendaPreferences.defaultBucketKey(),
+		agendaPreferences.ticketsBucketKey(),
+		treasuryPolicyBucketKey,
+		tspendPolicyBucketKey,
+		vspBucketKey,
+		vspHostBucketKey,
+		vspPubKeyBucketKey,
+		vspTreasuryPolicyBucketKey,
+		vspTspendPolicyBucketKey,
+		confTargetsBucketKey,
+		pendingSpendsBucketKey,
+		eventJournalBucketKey,
+		vspFeeRetryBucketKey,
+		expiringAddrsBucketKey,
+		paymentRequestsBucketKey,
+		accountActivityBucketKey,
+		idempotencyKeysBucketKey,
+		destinationPoliciesBucketKey,
+		invoicesBucketKey,
+		treasuryKeysBucketKey,
+		holdsBucketKey,
+		fundsReservationsBucketKey,
+		votePolicyNoncesBucketKey,
+	}
+}
+
+// printableBucketName returns whether a bucket key is a printable name rather
+// than a binary key (such as an account number or hash).  Printable keys may
+// still identify wallet data, so only keys of schema buckets are revealed.
+func printableBucketName(k []byte) bool {
+	if len(k) == 0 {
+		return false
+	}
+	for _, c := range k {
+		if c < 0x20 || c > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// nestedBucketName returns the path component describing a nested bucket
+// with key k of the bucket at path parent.  Nested buckets of schema buckets
+// are named by their keys, while all other nested buckets, whose keys may be
+// wallet data such as account numbers, hashes, or names, are named "*".
+func nestedBucketName(parent string, k []byte) string {
+	if _, ok := schemaBuckets[parent]; ok && printableBucketName(k) {
+		return string(k)
+	}
+	return "*"
+}
+
+// BucketSizes returns the number of key/value pairs recorded in each bucket
+// of the database, keyed by the slash-separated bucket path.  Nested buckets
+// of buckets other than schema buckets do not reveal their keys, and are
+// instead counted together under the path component "*".
+func BucketSizes(dbtx walletdb.ReadTx) (map[string]int, error) {
+	sizes := make(map[string]int)
+	var walk func(b walletdb.ReadBucket, path string) error
+	walk = func(b walletdb.ReadBucket, path string) error {
+		sizes[path] += 0
+		return b.ForEach(func(k, v []byte) error {
+			if v != nil {
+				sizes[path]++
+				return nil
+			}
+			nested := b.NestedReadBucket(k)
+			if nested == nil {
+				sizes[path]++
+				return nil
+			}
+			return walk(nested, path+"/"+nestedBucketName(path, k))
+		})
+	}
+	for _, k := range topLevelBuckets() {
+		b := dbtx.ReadBucket(k)
+		if b == nil {
+			continue
+		}
+		if err := walk(b, string(k)); err != nil {
+			return nil, err
+		}
+	}
+	return sizes, nil
+}
+
+// StoredVersion returns the version recorded by the database metadata.
+func StoredVersion(dbtx walletdb.ReadTx) (uint32, error) {
+	metadataBucket := dbtx.ReadBucket(unifiedDBMetadata{}.rootBucketKey())
+	if metadataBucket == nil {
+		return 0, errors.E(errors.IO, "missing metadata bucket")
+	}
+	return unifiedDBMetadata{}.getVersion(metadataBucket)
+}
+
+// schemaBuckets are the bucket paths whose keys are fixed names defined by the
+// database schema, rather than wallet data such as account names, addresses,
+// or hashes.  Keys of all other buckets are redacted by InspectBucket.
+var schemaBuckets = map[string]struct{}{
+	"meta":          {},
+	"waddrmgr":      {},
+	"waddrmgr/main": {},
+	"waddrmgr/meta": {},
+	"waddrmgr/sync": {},
+	"wtxmgr":        {},
+}
+
+// BucketSummary describes the size of a database bucket.  Nested buckets of
+// buckets other than schema buckets are summarized together under the path
+// component "*", in which case Buckets counts the summarized buckets.
+type BucketSummary struct {
+	Path       string
+	Buckets    int
+	Entries    int
+	KeyBytes   int
+	ValueBytes int
+}
+
+// BucketEntry describes a key/value pair or nested bucket without revealing
+// its contents.  Key is only the plain key when the bucket's keys are schema
+// names; otherwise Key is a salted hash of the key and Redacted is set, even
+// for the keys of nested buckets.  ValueHash is a salted hash of the value,
+// and is empty for nested buckets.
+type BucketEntry struct {
+	Key       string
+	Redacted  bool
+	Bucket    bool
+	KeyLen    int
+	ValueLen  int
+	ValueHash string
+}
+
+// redact returns a salted hash of sensitive data.  The hash is truncated, as
+// it is only intended for comparing values during debugging.
+func redact(salt, data []byte) string {
+	mac := hmac.New(blake256.New, salt)
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil)[:8])
+}
+
+// SummarizeBuckets describes the size of every bucket of the database,
+// ordered by bucket path.
+func SummarizeBuckets(dbtx walletdb.ReadTx) ([]BucketSummary, error) {
+	summaries := make(map[string]*BucketSummary)
+	var walk func(b walletdb.ReadBucket, path string) error
+	walk = func(b walletdb.ReadBucket, path string) error {
+		s := summaries[path]
+		if s == nil {
+			s = &BucketSummary{Path: path}
+			summaries[path] = s
+		}
+		s.Buckets++
+		return b.ForEach(func(k, v []byte) error {
+			var nested walletdb.ReadBucket
+			if v == nil {
+				nested = b.NestedReadBucket(k)
+			}
+			if nested == nil {
+				s.Entries++
+				s.KeyBytes += len(k)
+				s.ValueBytes += len(v)
+				return nil
+			}
+			return walk(nested, path+"/"+nestedBucketName(path, k))
+		})
+	}
+	err := dbtx.ForEachBucket(func(k []byte) error {
+		b := dbtx.ReadBucket(k)
+		if b == nil {
+			return nil
+		}
+		name := "*"
+		if printableBucketName(k) {
+			name = string(k)
+		}
+		return walk(b, name)
+	})
+	if err != nil {
+		return nil, errors.E(errors.IO, err)
+	}
+	res := make([]BucketSummary, 0, len(summaries))
+	for _, s := range summaries {
+		res = append(res, *s)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Path < res[j].Path })
+	return res, nil
+}
+
+// InspectBucket describes up to limit entries of the bucket with the
+// slash-separated path, and returns the total number of entries in the bucket.
+// Each path component must name a bucket with a printable key.  Keys of
+// buckets other than schema buckets, including the keys of nested buckets, and
+// all values, are redacted by hashing them with salt.  The salt must be a
+// secret generated for the inspection, as redacted keys which may be guessed
+// are otherwise revealed by comparing their hashes.
+func InspectBucket(dbtx walletdb.ReadTx, path string, salt []byte, limit int) ([]BucketEntry, int, error) {
+	components := strings.Split(path, "/")
+	b := dbtx.ReadBucket([]byte(components[0]))
+	for _, c := range components[1:] {
+		if b == nil {
+			break
+		}
+		b = b.NestedReadBucket([]byte(c))
+	}
+	if b == nil {
+		return nil, 0, errors.E(errors.NotExist, errors.Errorf("no bucket %q", path))
+	}
+	_, schema := schemaBuckets[path]
+
+	var entries []BucketEntry
+	var total int
+	err := b.ForEach(func(k, v []byte) error {
+		total++
+		if len(entries) >= limit {
+			return nil
+		}
+		e := BucketEntry{
+			KeyLen:   len(k),
+			ValueLen: len(v),
+		}
+		e.Bucket = v == nil && b.NestedReadBucket(k) != nil
+		if schema && printableBucketName(k) {
+			e.Key = string(k)
+		} else {
+			e.Key = redact(salt, k)
+			e.Redacted = true
+		}
+		if !e.Bucket {
+			e.ValueHash = redact(salt, v)
+		}
+		entries = append(entries, e)
+		return nil
+	})
+	if err != nil {
+		return nil, 0, errors.E(errors.IO, err)
+	}
+	return entries, total, nil
+}
+
+// SerializationVersions returns the versions recorded by the database, keyed
+// by the path of the version key.  The address manager and transaction store
+// versions are only recorded by databases which were never migrated to the
+// unified database version.
+func SerializationVersions(dbtx walletdb.ReadTx) map[string]uint32 {
+	versions := make(map[string]uint32)
+	if b := dbtx.ReadBucket(metadataRootBucketKey); b != nil {
+		if v := b.Get([]byte(unifiedDBMetadataVersionKey)); len(v) == 4 {
+			versions["meta/"+unifiedDBMetadataVersionKey] = byteOrder.Uint32(v)
+		}
+	}
+	if ns := dbtx.ReadBucket(waddrmgrBucketKey); ns != nil {
+		if b := ns.NestedReadBucket(mainBucketName); b != nil {
+			if v := b.Get(mgrVersionName); len(v) == 4 {
+				versions["waddrmgr/main/mgrver"] = binary.LittleEndian.Uint32(v)
+			}
+		}
+	}
+	if ns := dbtx.ReadBucket(wtxmgrBucketKey); ns != nil {
+		if v := ns.Get(rootVersion); len(v) == 4 {
+			versions["wtxmgr/vers"] = byteOrder.Uint32(v)
+		}
+	}
+	return versions
+}
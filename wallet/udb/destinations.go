@@ -0,0 +1,144 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+var destinationPoliciesBucketKey = []byte("destinationpolicies")
+
+// destinationOverrideKey is the key of the destination policy override
+// credential verifier.  It is distinguished from the 4 byte account keys of
+// destination policies by its length.
+var destinationOverrideKey = []byte("override")
+
+// DestinationPolicy restricts the destinations of payments authored by an
+// account.  Destinations are encoded addresses.  Payments to denied
+// destinations are always rejected, and when Allowlist is set, payments to
+// destinations which are not allowed are also rejected.
+type DestinationPolicy struct {
+	Allowlist bool
+	Allowed   []string
+	Denied    []string
+}
+
+// Destination policies are keyed by account number, and values are serialized
+// as:
+//
+// [0]     Allowlist mode (1 byte)
+// [1:5]   Allowed destination count (4 bytes)
+// [5:]    Allowed destinations, each as a 2 byte length and encoded address
+// [...]   Denied destination count (4 bytes)
+// [...]   Denied destinations, each as a 2 byte length and encoded address
+func serializeDestinationPolicy(p *DestinationPolicy) ([]byte, error) {
+	v := make([]byte, 1, 9)
+	if p.Allowlist {
+		v[0] = 1
+	}
+	for _, list := range [][]string{p.Allowed, p.Denied} {
+		v = byteOrder.AppendUint32(v, uint32(len(list)))
+		for _, dest := range list {
+			if len(dest) > 0xffff {
+				return nil, errors.E(errors.Invalid, "destination too long")
+			}
+			v = byteOrder.AppendUint16(v, uint16(len(dest)))
+			v = append(v, dest...)
+		}
+	}
+	return v, nil
+}
+
+func deserializeDestinationPolicy(v []byte) (*DestinationPolicy, error) {
+	bad := errors.E(errors.IO, errors.Errorf("bad destination policy "+
+		"length %d", len(v)))
+	if len(v) < 1 {
+		return nil, bad
+	}
+	p := &DestinationPolicy{Allowlist: v[0] == 1}
+	v = v[1:]
+	lists := []*[]string{&p.Allowed, &p.Denied}
+	for _, list := range lists {
+		if len(v) < 4 {
+			return nil, bad
+		}
+		n := byteOrder.Uint32(v)
+		v = v[4:]
+		for i := uint32(0); i < n; i++ {
+			if len(v) < 2 {
+				return nil, bad
+			}
+			l := int(byteOrder.Uint16(v))
+			if len(v) < 2+l {
+				return nil, bad
+			}
+			*list = append(*list, string(v[2:2+l]))
+			v = v[2+l:]
+		}
+	}
+	if len(v) != 0 {
+		return nil, bad
+	}
+	return p, nil
+}
+
+// PutDestinationPolicy records the destination policy of an account.  A nil
+// policy removes any recorded policy.
+func PutDestinationPolicy(dbtx walletdb.ReadWriteTx, account uint32, p *DestinationPolicy) error {
+	bucket := dbtx.ReadWriteBucket(destinationPoliciesBucketKey)
+	k := uint32ToBytes(account)
+	var err error
+	if p == nil {
+		err = bucket.Delete(k)
+	} else {
+		var v []byte
+		v, err = serializeDestinationPolicy(p)
+		if err != nil {
+			return err
+		}
+		err = bucket.Put(k, v)
+	}
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// FetchDestinationPolicy returns the destination policy of an account, or nil
+// if no policy is recorded.
+func FetchDestinationPolicy(dbtx walletdb.ReadTx, account uint32) (*DestinationPolicy, error) {
+	v := dbtx.ReadBucket(destinationPoliciesBucketKey).Get(uint32ToBytes(account))
+	if v == nil {
+		return nil, nil
+	}
+	return deserializeDestinationPolicy(v)
+}
+
+// PutDestinationOverride records the verifier of the credential which
+// overrides destination policies.  A nil verifier removes the credential.
+func PutDestinationOverride(dbtx walletdb.ReadWriteTx, verifier []byte) error {
+	bucket := dbtx.ReadWriteBucket(destinationPoliciesBucketKey)
+	var err error
+	if verifier == nil {
+		err = bucket.Delete(destinationOverrideKey)
+	} else {
+		err = bucket.Put(destinationOverrideKey, verifier)
+	}
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// FetchDestinationOverride returns the verifier of the credential which
+// overrides destination policies, or nil if no credential is recorded.
+func FetchDestinationOverride(dbtx walletdb.ReadTx) []byte {
+	v := dbtx.ReadBucket(destinationPoliciesBucketKey).Get(destinationOverrideKey)
+	if v == nil {
+		return nil
+	}
+	return append([]byte(nil), v...)
+}
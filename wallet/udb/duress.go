@@ -0,0 +1,288 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"crypto/subtle"
+	"encoding/binary"
+	"io"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/kdf"
+	"decred.org/dcrwallet/v5/wallet/internal/snacl"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+// Every wallet with private keys records a duress record, which has the
+// format:
+//
+//	[0:25]      Argon2id KDF parameters
+//	[25:25+n]   Duress key sealed with a key derived from the duress passphrase
+//	[25+n:]     Duress account number sealed with the crypto private key
+//
+// The sealed duress key is the duress account number and the account's
+// serialized extended private key, padded to a fixed length.  The sealed
+// account number allows a wallet unlocked with its private passphrase to
+// report the duress account.
+//
+// When no duress passphrase is configured, the record is a decoy of the same
+// length with new KDF parameters and random bytes in place of the sealed
+// values, so the record does not reveal whether a duress passphrase exists.
+const (
+	duressPlaintextLen = 128
+	duressSealedLen    = duressPlaintextLen + xchacha20poly1305Overhead
+	duressTagLen       = 4 + snacl.NonceSize + snacl.Overhead
+	duressRecordLen    = kdf.MarshaledLen + duressSealedLen + duressTagLen
+)
+
+// duressRow is the database record of a duress passphrase.
+type duressRow struct {
+	kdfp   *kdf.Argon2idParams
+	sealed []byte
+	tag    []byte
+}
+
+// duressKDFParams returns new Argon2id parameters for deriving duress keys.
+// As with the scrypt parameters of master keys, simnet uses cheap parameters.
+func duressKDFParams(net wire.CurrencyNet, r io.Reader) (*kdf.Argon2idParams, error) {
+	p, err := kdf.NewArgon2idParams(r)
+	if err != nil {
+		return nil, err
+	}
+	if net == wire.SimNet {
+		p.Time = 1
+		p.Memory = 64
+		p.Threads = 1
+	}
+	return p, nil
+}
+
+func fetchDuress(ns walletdb.ReadBucket) (*duressRow, error) {
+	v := ns.NestedReadBucket(mainBucketName).Get(duressName)
+	if v == nil {
+		return nil, nil
+	}
+	if len(v) != duressRecordLen {
+		return nil, errors.E(errors.IO, errors.Errorf("bad duress record length %d", len(v)))
+	}
+	off := kdf.MarshaledLen
+	row := &duressRow{
+		kdfp:   new(kdf.Argon2idParams),
+		sealed: append([]byte(nil), v[off:off+duressSealedLen]...),
+		tag:    append([]byte(nil), v[off+duressSealedLen:]...),
+	}
+	if err := row.kdfp.UnmarshalBinary(v[:off]); err != nil {
+		return nil, errors.E(errors.IO, err)
+	}
+	return row, nil
+}
+
+func putDuress(ns walletdb.ReadWriteBucket, row *duressRow) error {
+	kdfp, err := row.kdfp.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	v := make([]byte, 0, duressRecordLen)
+	v = append(v, kdfp...)
+	v = append(v, row.sealed...)
+	v = append(v, row.tag...)
+	if len(v) != duressRecordLen {
+		return errors.E(errors.Bug, errors.Errorf("duress record length %d", len(v)))
+	}
+	err = ns.NestedReadWriteBucket(mainBucketName).Put(duressName, v)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// putDecoyDuress records a decoy duress record, indistinguishable from a
+// configured duress passphrase, which no passphrase unlocks.
+func putDecoyDuress(ns walletdb.ReadWriteBucket, params *chaincfg.Params, r io.Reader) error {
+	kdfp, err := duressKDFParams(params.Net, r)
+	if err != nil {
+		return err
+	}
+	row := &duressRow{
+		kdfp:   kdfp,
+		sealed: make([]byte, duressSealedLen),
+		tag:    make([]byte, duressTagLen),
+	}
+	if _, err := io.ReadFull(r, row.sealed); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(r, row.tag); err != nil {
+		return err
+	}
+	return putDuress(ns, row)
+}
+
+// SetDuressPassphrase configures a secondary private passphrase which unlocks
+// only the private keys of a single account.  When the manager is unlocked
+// with the duress passphrase, it reports itself as unlocked, but the master
+// and crypto private keys remain cleared, so keys of all other accounts and
+// imported keys are unavailable.  This is intended to allow revealing a
+// low-value account under coercion.
+//
+// The manager must be unlocked with the private passphrase, and the duress
+// passphrase must differ from it.  Setting a new duress passphrase replaces
+// any previous one.
+func (m *Manager) SetDuressPassphrase(ns walletdb.ReadWriteBucket, account uint32, passphrase []byte) error {
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	if m.watchingOnly {
+		return errors.E(errors.WatchingOnly)
+	}
+	if m.locked {
+		return errors.E(errors.Locked, "wallet must be unlocked to set a duress passphrase")
+	}
+	if account >= ImportedAddrAccount {
+		return errors.E(errors.Invalid, "duress account must be a BIP0044 account")
+	}
+	if len(passphrase) == 0 {
+		return errors.E(errors.Invalid, "empty duress passphrase")
+	}
+
+	m.privPassphraseHasherMu.Lock()
+	m.privPassphraseHasher.Reset()
+	m.privPassphraseHasher.Write(passphrase)
+	passHash := m.privPassphraseHasher.Sum(nil)
+	m.privPassphraseHasherMu.Unlock()
+	if subtle.ConstantTimeCompare(passHash, m.privPassphraseHash) == 1 {
+		return errors.E(errors.Invalid, "duress passphrase must differ from the private passphrase")
+	}
+
+	acctInfo, err := m.loadAccountInfo(ns, account)
+	if err != nil {
+		return err
+	}
+	if acctInfo.acctKeyPriv == nil {
+		return errors.E(errors.Locked, errors.Errorf("account %d must be "+
+			"unlocked to set a duress passphrase", account))
+	}
+
+	xpriv := acctInfo.acctKeyPriv.String()
+	if len(xpriv) > duressPlaintextLen-5 {
+		return errors.E(errors.Bug, "extended key too long for duress record")
+	}
+	plaintext := make([]byte, duressPlaintextLen)
+	binary.LittleEndian.PutUint32(plaintext, account)
+	plaintext[4] = byte(len(xpriv))
+	copy(plaintext[5:], xpriv)
+	defer zero(plaintext)
+
+	kdfp, err := duressKDFParams(m.chainParams.Net, randReader(m.rand))
+	if err != nil {
+		return err
+	}
+	key := argon2idKey(passphrase, kdfp)
+	sealed, err := seal(m.rand, key, plaintext)
+	zero(key)
+	if err != nil {
+		return err
+	}
+	tag, err := m.cryptoKeyPriv.Encrypt(plaintext[:4])
+	if err != nil {
+		return errors.E(errors.Crypto, err)
+	}
+	return putDuress(ns, &duressRow{kdfp: kdfp, sealed: sealed, tag: tag})
+}
+
+// ClearDuressPassphrase removes the duress passphrase, replacing its record
+// with a decoy.  The manager must be unlocked with the private passphrase.
+func (m *Manager) ClearDuressPassphrase(ns walletdb.ReadWriteBucket) error {
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	if m.watchingOnly {
+		return errors.E(errors.WatchingOnly)
+	}
+	if m.locked {
+		return errors.E(errors.Locked, "wallet must be unlocked to clear the duress passphrase")
+	}
+	return putDecoyDuress(ns, m.chainParams, randReader(m.rand))
+}
+
+// DuressAccount returns the account unlocked by the duress passphrase, and
+// whether a duress passphrase is configured.  The manager must be unlocked.
+// When unlocked with the duress passphrase, no duress passphrase is reported.
+func (m *Manager) DuressAccount(ns walletdb.ReadBucket) (account uint32, ok bool, err error) {
+	defer m.mtx.RUnlock()
+	m.mtx.RLock()
+
+	if m.duressUnlocked {
+		return 0, false, nil
+	}
+	if m.locked {
+		return 0, false, errors.E(errors.Locked)
+	}
+	row, err := fetchDuress(ns)
+	if err != nil || row == nil {
+		return 0, false, err
+	}
+	// Decoy records hold random bytes which do not decrypt.
+	acct, err := m.cryptoKeyPriv.Decrypt(row.tag)
+	if err != nil || len(acct) != 4 {
+		return 0, false, nil
+	}
+	return binary.LittleEndian.Uint32(acct), true, nil
+}
+
+// openDuress derives a duress key from passphrase and unseals the duress
+// record with it, returning the duress account and the padded plaintext of
+// its extended private key.  A nil plaintext is returned when the passphrase
+// is not the duress passphrase, including when the record is a decoy.  The
+// caller must zero the returned plaintext.
+func openDuress(ns walletdb.ReadBucket, passphrase []byte) (account uint32, plaintext []byte, err error) {
+	row, err := fetchDuress(ns)
+	if err != nil || row == nil {
+		return 0, nil, err
+	}
+	key := argon2idKey(passphrase, row.kdfp)
+	plaintext, err = unseal(key, row.sealed)
+	zero(key)
+	if err != nil {
+		return 0, nil, nil
+	}
+	if len(plaintext) != duressPlaintextLen || int(plaintext[4]) > duressPlaintextLen-5 {
+		zero(plaintext)
+		return 0, nil, errors.E(errors.IO, "bad duress plaintext")
+	}
+	return binary.LittleEndian.Uint32(plaintext), plaintext, nil
+}
+
+// unlockDuress unlocks the duress account with the plaintext opened by
+// openDuress.
+//
+// This function MUST be called with the manager lock held for writes and the
+// manager locked.
+func (m *Manager) unlockDuress(ns walletdb.ReadBucket, account uint32, plaintext, passHash []byte) error {
+	acctInfo, err := m.loadAccountInfo(ns, account)
+	if err != nil {
+		return err
+	}
+	acctKeyPriv, err := xprivFromBytes(plaintext[5:5+int(plaintext[4])], m.chainParams)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	acctInfo.acctKeyPriv = acctKeyPriv
+	m.duressUnlocked = true
+	m.privPassphraseHash = passHash
+	return nil
+}
+
+// matchesDuressPassphrase returns whether passphrase is the duress
+// passphrase.
+func matchesDuressPassphrase(ns walletdb.ReadBucket, passphrase []byte) (bool, error) {
+	_, plaintext, err := openDuress(ns, passphrase)
+	if err != nil {
+		return false, err
+	}
+	zero(plaintext)
+	return plaintext != nil, nil
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/kdf"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/v3"
+)
+
+func TestDuressRecordIndistinguishable(t *testing.T) {
+	ctx := context.Background()
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	params := chaincfg.SimNetParams()
+	err := Initialize(ctx, db, params, seed, pubPassphrase, privPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, _, err := Open(ctx, db, params, pubPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	duressPass := []byte("duress")
+	var records [][]byte
+	record := func(ns walletdb.ReadBucket) {
+		v := ns.NestedReadBucket(mainBucketName).Get(duressName)
+		if len(v) != duressRecordLen {
+			t.Fatalf("duress record length %d, want %d", len(v), duressRecordLen)
+		}
+		records = append(records, append([]byte(nil), v...))
+	}
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrBucketKey)
+
+		// New wallets record a decoy which does not unlock the wallet.
+		record(ns)
+		if err := m.Unlock(ns, duressPass); !errors.Is(err, errors.Passphrase) {
+			t.Fatalf("decoy duress record unlocked wallet: %v", err)
+		}
+		if err := m.Unlock(ns, privPassphrase); err != nil {
+			return err
+		}
+		if _, ok, err := m.DuressAccount(ns); err != nil || ok {
+			t.Fatalf("decoy reported as duress passphrase: %v %v", ok, err)
+		}
+
+		if err := m.SetDuressPassphrase(ns, 0, duressPass); err != nil {
+			return err
+		}
+		record(ns)
+		if acct, ok, err := m.DuressAccount(ns); err != nil || !ok || acct != 0 {
+			t.Fatalf("DuressAccount: %v %v %v", acct, ok, err)
+		}
+
+		// Clearing the duress passphrase replaces the record with a new
+		// decoy.
+		if err := m.ClearDuressPassphrase(ns); err != nil {
+			return err
+		}
+		record(ns)
+		if _, ok, err := m.DuressAccount(ns); err != nil || ok {
+			t.Fatalf("cleared duress passphrase reported: %v %v", ok, err)
+		}
+		m.Lock()
+		if err := m.Unlock(ns, duressPass); !errors.Is(err, errors.Passphrase) {
+			t.Fatalf("cleared duress passphrase unlocked wallet: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Every record has new KDF parameters.
+	for i := range records {
+		for j := i + 1; j < len(records); j++ {
+			if string(records[i][:kdf.MarshaledLen]) == string(records[j][:kdf.MarshaledLen]) {
+				t.Errorf("records %d and %d share KDF parameters", i, j)
+			}
+		}
+	}
+}
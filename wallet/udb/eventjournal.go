@@ -0,0 +1,213 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+)
+
+var eventJournalBucketKey = []byte("eventjournal")
+
+// journalRetention is the number of most recent events retained by the event
+// journal.  Older events are removed as new events are appended.  It is
+// modified by tests.
+var journalRetention uint64 = 100000
+
+// JournalEventType describes the kind of change recorded by a JournalEvent.
+type JournalEventType uint8
+
+// Journal event types.
+const (
+	// JournalTxUnmined records a wallet transaction added to the unmined
+	// transaction set.
+	JournalTxUnmined JournalEventType = iota + 1
+
+	// JournalTxMined records a wallet transaction mined in a main chain
+	// block.
+	JournalTxMined
+
+	// JournalTxDetached records a wallet transaction whose block was
+	// removed from the main chain by a reorganize.
+	JournalTxDetached
+
+	// JournalTxRemoved records an unmined wallet transaction which was
+	// removed after being double spent or being abandoned.  Expired
+	// transactions are recorded by JournalTxExpired.
+	JournalTxRemoved
+
+	// JournalTipChanged records a new main chain tip block.
+	JournalTipChanged
+
+	// JournalVSPFeeFailed records a ticket whose VSP fee payment was
+	// abandoned after retries were exhausted or the ticket could no
+	// longer be voted by the VSP.
+	JournalVSPFeeFailed
+
+	// JournalTxExpired records an unmined wallet transaction which was
+	// removed after reaching its expiry height without being mined.
+	JournalTxExpired
+)
+
+var journalEventTypeStrings = [...]string{
+	JournalTxUnmined:    "txunmined",
+	JournalTxMined:      "txmined",
+	JournalTxDetached:   "txdetached",
+	JournalTxRemoved:    "txremoved",
+	JournalTipChanged:   "tipchanged",
+	JournalVSPFeeFailed: "vspfeefailed",
+	JournalTxExpired:    "txexpired",
+}
+
+// String returns the name of the event type.
+func (t JournalEventType) String() string {
+	if t == 0 || int(t) >= len(journalEventTypeStrings) {
+		return "unknown"
+	}
+	return journalEventTypeStrings[t]
+}
+
+// JournalEvent is a change to wallet transactions or the main chain recorded
+// by the event journal.
+//
+// Hash is the transaction hash for transaction events, the block hash of the
+// new tip for JournalTipChanged, and the ticket hash for JournalVSPFeeFailed.
+// BlockHash and Height describe the block a transaction was mined in or
+// detached from, and the tip block for JournalTipChanged.  They are zero for
+// all other events.
+type JournalEvent struct {
+	Sequence  uint64
+	Type      JournalEventType
+	Hash      chainhash.Hash
+	BlockHash chainhash.Hash
+	Height    int32
+	Time      time.Time
+}
+
+// Journal event keys are the big endian sequence numbers, and values are
+// serialized as:
+//
+// [0]     Event type (1 byte)
+// [1:33]  Transaction or block hash (32 bytes)
+// [33:65] Block hash (32 bytes)
+// [65:69] Block height (4 bytes)
+// [69:77] Unix time of the event (8 bytes)
+const journalEventSize = 77
+
+func keyJournalEvent(seq uint64) []byte {
+	k := make([]byte, 8)
+	byteOrder.PutUint64(k, seq)
+	return k
+}
+
+func serializeJournalEvent(e *JournalEvent) []byte {
+	v := make([]byte, journalEventSize)
+	v[0] = byte(e.Type)
+	copy(v[1:33], e.Hash[:])
+	copy(v[33:65], e.BlockHash[:])
+	byteOrder.PutUint32(v[65:69], uint32(e.Height))
+	byteOrder.PutUint64(v[69:77], uint64(e.Time.Unix()))
+	return v
+}
+
+func deserializeJournalEvent(k, v []byte) (*JournalEvent, error) {
+	if len(k) != 8 || len(v) < journalEventSize {
+		return nil, errors.E(errors.IO, errors.Errorf("bad journal event "+
+			"key/value lengths %d/%d", len(k), len(v)))
+	}
+	e := &JournalEvent{
+		Sequence: byteOrder.Uint64(k),
+		Type:     JournalEventType(v[0]),
+		Height:   int32(byteOrder.Uint32(v[65:69])),
+		Time:     time.Unix(int64(byteOrder.Uint64(v[69:77])), 0),
+	}
+	copy(e.Hash[:], v[1:33])
+	copy(e.BlockHash[:], v[33:65])
+	return e, nil
+}
+
+// journalRemovedTxs records JournalTxRemoved events for unmined transactions
+// removed from the store.
+func journalRemovedTxs(dbtx walletdb.ReadWriteTx, hashes []chainhash.Hash) error {
+	if len(hashes) == 0 {
+		return nil
+	}
+	now := time.Now()
+	events := make([]*JournalEvent, len(hashes))
+	for i := range hashes {
+		events[i] = &JournalEvent{
+			Type: JournalTxRemoved,
+			Hash: hashes[i],
+			Time: now,
+		}
+	}
+	return AppendJournalEvents(dbtx, events...)
+}
+
+// AppendJournalEvents records events in the event journal, assigning each the
+// next sequence number.  Sequence numbers begin at one and are never reused.
+// Events beyond the most recent journalRetention are removed.
+func AppendJournalEvents(dbtx walletdb.ReadWriteTx, events ...*JournalEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	bucket := dbtx.ReadWriteBucket(eventJournalBucketKey)
+	seq := nextJournalSequence(bucket)
+	for _, e := range events {
+		e.Sequence = seq
+		err := bucket.Put(keyJournalEvent(seq), serializeJournalEvent(e))
+		if err != nil {
+			return errors.E(errors.IO, err)
+		}
+		seq++
+	}
+
+	if seq <= journalRetention+1 {
+		return nil
+	}
+	c := bucket.ReadCursor()
+	k, _ := c.First()
+	c.Close()
+	for first := byteOrder.Uint64(k); first < seq-journalRetention; first++ {
+		if err := bucket.Delete(keyJournalEvent(first)); err != nil {
+			return errors.E(errors.IO, err)
+		}
+	}
+	return nil
+}
+
+func nextJournalSequence(bucket walletdb.ReadBucket) uint64 {
+	c := bucket.ReadCursor()
+	k, _ := c.Last()
+	c.Close()
+	if len(k) != 8 {
+		return 1
+	}
+	return byteOrder.Uint64(k) + 1
+}
+
+// JournalEvents returns up to count journal events beginning with sequence
+// number from, and the sequence number which will be assigned to the next
+// recorded event.  Events which have been removed from the journal are
+// skipped, and the first returned event will have a later sequence number than
+// requested.
+func JournalEvents(dbtx walletdb.ReadTx, from uint64, count int) ([]*JournalEvent, uint64, error) {
+	bucket := dbtx.ReadBucket(eventJournalBucketKey)
+	next := nextJournalSequence(bucket)
+	var events []*JournalEvent
+	c := bucket.ReadCursor()
+	defer c.Close()
+	for k, v := c.Seek(keyJournalEvent(from)); k != nil && len(events) < count; k, v = c.Next() {
+		e, err := deserializeJournalEvent(k, v)
+		if err != nil {
+			return nil, 0, err
+		}
+		events = append(events, e)
+	}
+	return events, next, nil
+}
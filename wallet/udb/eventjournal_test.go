@@ -0,0 +1,75 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+func TestJournalRetention(t *testing.T) {
+	ctx := context.Background()
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	defer func(r uint64) { journalRetention = r }(journalRetention)
+	journalRetention = 20
+	const extra = 10
+	err := walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		if _, err := dbtx.CreateTopLevelBucket(eventJournalBucketKey); err != nil {
+			return err
+		}
+		events := make([]*JournalEvent, journalRetention)
+		for i := range events {
+			events[i] = &JournalEvent{Type: JournalTipChanged, Height: int32(i),
+				Time: time.Now()}
+		}
+		if err := AppendJournalEvents(dbtx, events...); err != nil {
+			return err
+		}
+		for i := 0; i < extra; i++ {
+			e := &JournalEvent{Type: JournalTxUnmined, Time: time.Now()}
+			if err := AppendJournalEvents(dbtx, e); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		events, next, err := JournalEvents(dbtx, 0, 2)
+		if err != nil {
+			return err
+		}
+		if next != journalRetention+extra+1 {
+			t.Errorf("next sequence %d, want %d", next, journalRetention+extra+1)
+		}
+		if len(events) != 2 || events[0].Sequence != extra+1 ||
+			events[1].Sequence != extra+2 {
+			t.Fatalf("oldest events not removed")
+		}
+		if e := events[0]; e.Type != JournalTipChanged || e.Height != extra {
+			t.Errorf("event %d recorded as %v at height %d", e.Sequence,
+				e.Type, e.Height)
+		}
+		events, _, err = JournalEvents(dbtx, next-1, 10)
+		if err != nil {
+			return err
+		}
+		if len(events) != 1 || events[0].Type != JournalTxUnmined {
+			t.Errorf("latest event not returned")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+var expiringAddrsBucketKey = []byte("expiringaddrs")
+
+// ExpiringAddress records an external address which was issued with an expiry
+// time and/or block height, and the first payment to the address observed by
+// the wallet.  Zero values of Expiry and ExpiryHeight indicate no expiry by
+// time or height, and a zero Paid time indicates that no payment has been
+// observed.
+type ExpiringAddress struct {
+	Issued       time.Time
+	Expiry       time.Time
+	ExpiryHeight int32
+	Paid         time.Time
+	PaidHeight   int32
+}
+
+// Expired returns whether the address has expired at the time now and main
+// chain tip height.
+func (a *ExpiringAddress) Expired(now time.Time, tipHeight int32) bool {
+	return (!a.Expiry.IsZero() && !now.Before(a.Expiry)) ||
+		(a.ExpiryHeight > 0 && tipHeight >= a.ExpiryHeight)
+}
+
+// PaidLate returns whether the first observed payment to the address occurred
+// after its expiry.
+func (a *ExpiringAddress) PaidLate() bool {
+	return !a.Paid.IsZero() && a.Expired(a.Paid, a.PaidHeight)
+}
+
+func unixOrZero(t time.Time) uint64 {
+	if t.IsZero() {
+		return 0
+	}
+	return uint64(t.Unix())
+}
+
+func timeOrZero(v uint64) time.Time {
+	if v == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(v), 0)
+}
+
+// Expiring address keys are encoded addresses, and values are serialized as:
+//
+// [0:8]   Unix time the address was issued (8 bytes)
+// [8:16]  Unix expiry time, or zero (8 bytes)
+// [16:20] Expiry height, or zero (4 bytes)
+// [20:28] Unix time of the first observed payment, or zero (8 bytes)
+// [28:32] Main chain tip height when the payment was observed (4 bytes)
+func serializeExpiringAddress(a *ExpiringAddress) []byte {
+	v := make([]byte, 32)
+	byteOrder.PutUint64(v[0:8], unixOrZero(a.Issued))
+	byteOrder.PutUint64(v[8:16], unixOrZero(a.Expiry))
+	byteOrder.PutUint32(v[16:20], uint32(a.ExpiryHeight))
+	byteOrder.PutUint64(v[20:28], unixOrZero(a.Paid))
+	byteOrder.PutUint32(v[28:32], uint32(a.PaidHeight))
+	return v
+}
+
+func deserializeExpiringAddress(v []byte) (*ExpiringAddress, error) {
+	if len(v) != 32 {
+		return nil, errors.E(errors.IO, errors.Errorf("bad expiring address "+
+			"length %d", len(v)))
+	}
+	return &ExpiringAddress{
+		Issued:       timeOrZero(byteOrder.Uint64(v[0:8])),
+		Expiry:       timeOrZero(byteOrder.Uint64(v[8:16])),
+		ExpiryHeight: int32(byteOrder.Uint32(v[16:20])),
+		Paid:         timeOrZero(byteOrder.Uint64(v[20:28])),
+		PaidHeight:   int32(byteOrder.Uint32(v[28:32])),
+	}, nil
+}
+
+// PutExpiringAddress records the expiry of an issued address, replacing any
+// previous record.
+func PutExpiringAddress(dbtx walletdb.ReadWriteTx, addr string, a *ExpiringAddress) error {
+	bucket := dbtx.ReadWriteBucket(expiringAddrsBucketKey)
+	err := bucket.Put([]byte(addr), serializeExpiringAddress(a))
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// ExpiringAddressFor returns the expiry record of an issued address.  Returns
+// errors.NotExist if the address was not issued with an expiry.
+func ExpiringAddressFor(dbtx walletdb.ReadTx, addr string) (*ExpiringAddress, error) {
+	v := dbtx.ReadBucket(expiringAddrsBucketKey).Get([]byte(addr))
+	if v == nil {
+		err := errors.Errorf("address %s was not issued with an expiry", addr)
+		return nil, errors.E(errors.NotExist, err)
+	}
+	return deserializeExpiringAddress(v)
+}
+
+// MarkExpiringAddressPaid records the first observed payment to an address
+// issued with an expiry at the time and height of the paying block.
+// Addresses without an expiry record, and addresses with a previously
+// recorded payment, are not modified.
+func MarkExpiringAddressPaid(dbtx walletdb.ReadWriteTx, addr string, paid time.Time, paidHeight int32) error {
+	a, err := ExpiringAddressFor(dbtx, addr)
+	if errors.Is(err, errors.NotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !a.Paid.IsZero() {
+		return nil
+	}
+	a.Paid = paid
+	a.PaidHeight = paidHeight
+	return PutExpiringAddress(dbtx, addr, a)
+}
+
+// ForEachExpiringAddress calls f with every address issued with an expiry.
+func ForEachExpiringAddress(dbtx walletdb.ReadTx, f func(addr string, a *ExpiringAddress) error) error {
+	return dbtx.ReadBucket(expiringAddrsBucketKey).ForEach(func(k, v []byte) error {
+		a, err := deserializeExpiringAddress(v)
+		if err != nil {
+			return err
+		}
+		return f(string(k), a)
+	})
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"encoding/binary"
+	"strconv"
+	"strings"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/hdkeychain/v3"
+)
+
+// maxSignerModelLen is the maximum length of an external signer device model.
+const maxSignerModelLen = 64
+
+// ExternalSigner describes the hardware device holding the private keys of an
+// external signer account, and the derivation of the account extended key
+// from the device's master key.
+type ExternalSigner struct {
+	// Fingerprint is the BIP0032 fingerprint of the device's master key:
+	// the first four bytes of the hash160 of the master public key,
+	// interpreted as a big endian integer.
+	Fingerprint uint32
+
+	// Path is the derivation path of the account extended key from the
+	// master key, with the hardened offset applied to hardened children.
+	Path []uint32
+
+	// Model describes the device, e.g. "ledger" or "trezor".
+	Model string
+}
+
+// PathString returns the derivation path in the m/44'/42'/0' notation.
+func (s *ExternalSigner) PathString() string {
+	var b strings.Builder
+	b.WriteString("m")
+	for _, child := range s.Path {
+		b.WriteByte('/')
+		if child >= hdkeychain.HardenedKeyStart {
+			b.WriteString(strconv.FormatUint(uint64(child-hdkeychain.HardenedKeyStart), 10))
+			b.WriteByte('\'')
+			continue
+		}
+		b.WriteString(strconv.FormatUint(uint64(child), 10))
+	}
+	return b.String()
+}
+
+// ParseDerivationPath parses a derivation path in the m/44'/42'/0' notation.
+// Hardened children may be marked with either ' or h.
+func ParseDerivationPath(s string) ([]uint32, error) {
+	const op errors.Op = "udb.ParseDerivationPath"
+	elems := strings.Split(s, "/")
+	if elems[0] != "m" {
+		return nil, errors.E(op, errors.Invalid, "derivation path must begin with m")
+	}
+	path := make([]uint32, 0, len(elems)-1)
+	for _, e := range elems[1:] {
+		hardened := strings.HasSuffix(e, "'") || strings.HasSuffix(e, "h")
+		if hardened {
+			e = e[:len(e)-1]
+		}
+		child, err := strconv.ParseUint(e, 10, 32)
+		if err != nil || child >= hdkeychain.HardenedKeyStart {
+			return nil, errors.E(op, errors.Invalid,
+				errors.Errorf("invalid derivation path element %q", e))
+		}
+		if hardened {
+			child += hdkeychain.HardenedKeyStart
+		}
+		path = append(path, uint32(child))
+	}
+	return path, nil
+}
+
+func (s *ExternalSigner) serialize() []byte {
+	// Format:
+	//   <fingerprint><pathlen><path...><model>
+	v := make([]byte, 5+4*len(s.Path)+len(s.Model))
+	binary.LittleEndian.PutUint32(v, s.Fingerprint)
+	v[4] = byte(len(s.Path))
+	off := 5
+	for _, child := range s.Path {
+		binary.LittleEndian.PutUint32(v[off:], child)
+		off += 4
+	}
+	copy(v[off:], s.Model)
+	return v
+}
+
+func deserializeExternalSigner(v []byte) (*ExternalSigner, error) {
+	if len(v) < 5 || len(v) < 5+4*int(v[4]) {
+		err := errors.Errorf("external signer bad len %d", len(v))
+		return nil, errors.E(errors.IO, err)
+	}
+	s := &ExternalSigner{
+		Fingerprint: binary.LittleEndian.Uint32(v),
+		Path:        make([]uint32, v[4]),
+	}
+	off := 5
+	for i := range s.Path {
+		s.Path[i] = binary.LittleEndian.Uint32(v[off:])
+		off += 4
+	}
+	s.Model = string(v[off:])
+	return s, nil
+}
+
+// validate checks that the signer describes the derivation of xpub.
+func (s *ExternalSigner) validate(xpub *hdkeychain.ExtendedKey) error {
+	if xpub.IsPrivate() {
+		return errors.E(errors.Invalid, "extended key must be an xpub")
+	}
+	if s.Model == "" || len(s.Model) > maxSignerModelLen {
+		return errors.E(errors.Invalid, errors.Errorf("device model must "+
+			"be between 1 and %d characters", maxSignerModelLen))
+	}
+	if len(s.Path) == 0 || len(s.Path) > 255 {
+		return errors.E(errors.Invalid, "derivation path must have between 1 and 255 children")
+	}
+	if int(xpub.Depth()) != len(s.Path) {
+		return errors.E(errors.Invalid, errors.Errorf("extended key depth %d "+
+			"does not match derivation path %s", xpub.Depth(), s.PathString()))
+	}
+	if xpub.ChildNum() != s.Path[len(s.Path)-1] {
+		return errors.E(errors.Invalid, errors.Errorf("extended key child "+
+			"number does not match derivation path %s", s.PathString()))
+	}
+	if len(s.Path) == 1 && xpub.ParentFingerprint() != s.Fingerprint {
+		return errors.E(errors.Invalid, "extended key parent does not "+
+			"match master key fingerprint")
+	}
+	return nil
+}
+
+// IsExternalSigner compares a uint8 to the internal externalSigner type and
+// returns if the value represents an external signer account.
+func IsExternalSigner(acctType uint8) bool {
+	return acctType == uint8(externalSigner)
+}
+
+// ImportExternalSignerAccount imports a watching-only account for the account
+// extended public key of a hardware signing device, recording the device and
+// derivation path which must be used to sign for the account.  The signer
+// description is encrypted with the crypto public key, like the account xpub.
+func (m *Manager) ImportExternalSignerAccount(ns walletdb.ReadWriteBucket, name string,
+	xpub *hdkeychain.ExtendedKey, signer *ExternalSigner) (uint32, error) {
+
+	if err := signer.validate(xpub); err != nil {
+		return 0, err
+	}
+
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	account, err := m.importXpubAccount(ns, externalSigner, name, xpub)
+	if err != nil {
+		return 0, err
+	}
+	signerEnc, err := m.cryptoKeyPub.Encrypt(signer.serialize())
+	if err != nil {
+		return 0, errors.E(errors.Crypto, errors.Errorf("encrypt external signer: %v", err))
+	}
+	err = accountVarsBucket(ns, account).Put(acctVarExternalSigner, signerEnc)
+	if err != nil {
+		return 0, errors.E(errors.IO, err)
+	}
+	return account, nil
+}
+
+// ExternalSigner returns the signing device of an external signer account.
+// Errors with NotExist if the account is not an external signer account.
+func (m *Manager) ExternalSigner(ns walletdb.ReadBucket, account uint32) (*ExternalSigner, error) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	row, err := fetchAccountRow(ns, account)
+	if err != nil {
+		return nil, err
+	}
+	if row.acctType != externalSigner {
+		return nil, errors.E(errors.NotExist,
+			errors.Errorf("account %d is not an external signer account", account))
+	}
+	vars := ns.NestedReadBucket(acctVarsBucketName).NestedReadBucket(uint32ToBytes(account))
+	signerEnc := vars.Get(acctVarExternalSigner)
+	if signerEnc == nil {
+		return nil, errors.E(errors.IO,
+			errors.Errorf("account %d has no external signer", account))
+	}
+	v, err := m.cryptoKeyPub.Decrypt(signerEnc)
+	if err != nil {
+		err := errors.Errorf("decrypt account %d external signer: %v", account, err)
+		return nil, errors.E(errors.Crypto, err)
+	}
+	return deserializeExternalSigner(v)
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"encoding/binary"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/wire"
+)
+
+var fundsReservationsBucketKey = []byte("fundsreservations")
+
+// MaxFundsReservationIDLen is the maximum length of a funds reservation
+// identifier.
+const MaxFundsReservationIDLen = 256
+
+// FundsReservation describes unspent outputs of an account which are locked to
+// fund an external order until the reservation is released.  Amount is the
+// total value of the reserved outputs.
+type FundsReservation struct {
+	ID        string
+	Account   uint32
+	Amount    dcrutil.Amount
+	Outpoints []wire.OutPoint
+}
+
+// Value format:
+//
+//	[0:4]   Account (4 bytes)
+//	[4:12]  Amount (8 bytes)
+//	[12:]   Outpoints (37 bytes each: hash, index, tree)
+
+func serializeFundsReservation(r *FundsReservation) []byte {
+	v := make([]byte, 12+len(r.Outpoints)*holdOutpointSize)
+	binary.LittleEndian.PutUint32(v, r.Account)
+	binary.LittleEndian.PutUint64(v[4:], uint64(r.Amount))
+	off := 12
+	for i := range r.Outpoints {
+		op := &r.Outpoints[i]
+		copy(v[off:], op.Hash[:])
+		binary.LittleEndian.PutUint32(v[off+chainhash.HashSize:], op.Index)
+		v[off+chainhash.HashSize+4] = byte(op.Tree)
+		off += holdOutpointSize
+	}
+	return v
+}
+
+func deserializeFundsReservation(id, v []byte) (*FundsReservation, error) {
+	if len(v) < 12 || (len(v)-12)%holdOutpointSize != 0 {
+		return nil, errors.E(errors.IO, errors.Errorf("funds reservation %q: "+
+			"bad value length %d", id, len(v)))
+	}
+	r := &FundsReservation{
+		ID:        string(id),
+		Account:   binary.LittleEndian.Uint32(v),
+		Amount:    dcrutil.Amount(binary.LittleEndian.Uint64(v[4:])),
+		Outpoints: make([]wire.OutPoint, (len(v)-12)/holdOutpointSize),
+	}
+	off := 12
+	for i := range r.Outpoints {
+		op := &r.Outpoints[i]
+		copy(op.Hash[:], v[off:])
+		op.Index = binary.LittleEndian.Uint32(v[off+chainhash.HashSize:])
+		op.Tree = int8(v[off+chainhash.HashSize+4])
+		off += holdOutpointSize
+	}
+	return r, nil
+}
+
+// PutFundsReservation records a funds reservation.  Returns errors.Exist if a
+// reservation with the same ID is already recorded.
+func PutFundsReservation(dbtx walletdb.ReadWriteTx, r *FundsReservation) error {
+	if r.ID == "" || len(r.ID) > MaxFundsReservationIDLen {
+		return errors.E(errors.Invalid, errors.Errorf("reservation ID must "+
+			"be between 1 and %d bytes", MaxFundsReservationIDLen))
+	}
+	bucket := dbtx.ReadWriteBucket(fundsReservationsBucketKey)
+	if bucket.Get([]byte(r.ID)) != nil {
+		return errors.E(errors.Exist, errors.Errorf("reservation %q already exists", r.ID))
+	}
+	if err := bucket.Put([]byte(r.ID), serializeFundsReservation(r)); err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// DeleteFundsReservation removes the funds reservation with an ID.  Returns
+// errors.NotExist if no such reservation is recorded.
+func DeleteFundsReservation(dbtx walletdb.ReadWriteTx, id string) error {
+	bucket := dbtx.ReadWriteBucket(fundsReservationsBucketKey)
+	if bucket.Get([]byte(id)) == nil {
+		return errors.E(errors.NotExist, errors.Errorf("no reservation %q", id))
+	}
+	if err := bucket.Delete([]byte(id)); err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// ForEachFundsReservation calls f with every recorded funds reservation,
+// ordered by ID.
+func ForEachFundsReservation(dbtx walletdb.ReadTx, f func(r *FundsReservation) error) error {
+	return dbtx.ReadBucket(fundsReservationsBucketKey).ForEach(func(k, v []byte) error {
+		r, err := deserializeFundsReservation(k, v)
+		if err != nil {
+			return err
+		}
+		return f(r)
+	})
+}
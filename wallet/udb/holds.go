@@ -0,0 +1,141 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"encoding/binary"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/wire"
+)
+
+var holdsBucketKey = []byte("holds")
+
+// Maximum lengths of hold identifiers and reasons.
+const (
+	MaxHoldIDLen     = 64
+	MaxHoldReasonLen = 1024
+)
+
+// Hold describes unspent outputs of an account which are frozen, such as to
+// comply with a legal hold, until the hold is released.  Amount is the amount
+// requested to be held, and is zero for holds of specific outputs.
+type Hold struct {
+	ID        string
+	Account   uint32
+	Amount    dcrutil.Amount
+	Reason    string
+	Created   time.Time
+	Outpoints []wire.OutPoint
+}
+
+// Value format:
+//
+//	[0:4]     Account (4 bytes)
+//	[4:12]    Amount (8 bytes)
+//	[12:20]   Created unix time (8 bytes)
+//	[20:22]   Reason length (2 bytes)
+//	[22:22+n] Reason (n bytes)
+//	[22+n:]   Outpoints (37 bytes each: hash, index, tree)
+
+const holdOutpointSize = chainhash.HashSize + 4 + 1
+
+func serializeHold(h *Hold) []byte {
+	n := len(h.Reason)
+	v := make([]byte, 22+n+len(h.Outpoints)*holdOutpointSize)
+	binary.LittleEndian.PutUint32(v, h.Account)
+	binary.LittleEndian.PutUint64(v[4:], uint64(h.Amount))
+	binary.LittleEndian.PutUint64(v[12:], uint64(h.Created.Unix()))
+	binary.LittleEndian.PutUint16(v[20:], uint16(n))
+	copy(v[22:], h.Reason)
+	off := 22 + n
+	for i := range h.Outpoints {
+		op := &h.Outpoints[i]
+		copy(v[off:], op.Hash[:])
+		binary.LittleEndian.PutUint32(v[off+chainhash.HashSize:], op.Index)
+		v[off+chainhash.HashSize+4] = byte(op.Tree)
+		off += holdOutpointSize
+	}
+	return v
+}
+
+func deserializeHold(id, v []byte) (*Hold, error) {
+	short := func() error {
+		return errors.E(errors.IO, errors.Errorf("hold %q: short value", id))
+	}
+	if len(v) < 22 {
+		return nil, short()
+	}
+	n := int(binary.LittleEndian.Uint16(v[20:]))
+	if len(v) < 22+n || (len(v)-22-n)%holdOutpointSize != 0 {
+		return nil, short()
+	}
+	h := &Hold{
+		ID:        string(id),
+		Account:   binary.LittleEndian.Uint32(v),
+		Amount:    dcrutil.Amount(binary.LittleEndian.Uint64(v[4:])),
+		Created:   time.Unix(int64(binary.LittleEndian.Uint64(v[12:])), 0),
+		Reason:    string(v[22 : 22+n]),
+		Outpoints: make([]wire.OutPoint, (len(v)-22-n)/holdOutpointSize),
+	}
+	off := 22 + n
+	for i := range h.Outpoints {
+		op := &h.Outpoints[i]
+		copy(op.Hash[:], v[off:])
+		op.Index = binary.LittleEndian.Uint32(v[off+chainhash.HashSize:])
+		op.Tree = int8(v[off+chainhash.HashSize+4])
+		off += holdOutpointSize
+	}
+	return h, nil
+}
+
+// PutHold records a hold.  Returns errors.Exist if a hold with the same ID is
+// already recorded.
+func PutHold(dbtx walletdb.ReadWriteTx, h *Hold) error {
+	if h.ID == "" || len(h.ID) > MaxHoldIDLen {
+		return errors.E(errors.Invalid, errors.Errorf("hold ID must be "+
+			"between 1 and %d bytes", MaxHoldIDLen))
+	}
+	if h.Reason == "" || len(h.Reason) > MaxHoldReasonLen {
+		return errors.E(errors.Invalid, errors.Errorf("hold reason must be "+
+			"between 1 and %d bytes", MaxHoldReasonLen))
+	}
+	bucket := dbtx.ReadWriteBucket(holdsBucketKey)
+	if bucket.Get([]byte(h.ID)) != nil {
+		return errors.E(errors.Exist, errors.Errorf("hold %q already exists", h.ID))
+	}
+	if err := bucket.Put([]byte(h.ID), serializeHold(h)); err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// DeleteHold removes the hold with an ID.  Returns errors.NotExist if no such
+// hold is recorded.
+func DeleteHold(dbtx walletdb.ReadWriteTx, id string) error {
+	bucket := dbtx.ReadWriteBucket(holdsBucketKey)
+	if bucket.Get([]byte(id)) == nil {
+		return errors.E(errors.NotExist, errors.Errorf("no hold %q", id))
+	}
+	if err := bucket.Delete([]byte(id)); err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// ForEachHold calls f with every recorded hold, ordered by ID.
+func ForEachHold(dbtx walletdb.ReadTx, f func(h *Hold) error) error {
+	return dbtx.ReadBucket(holdsBucketKey).ForEach(func(k, v []byte) error {
+		h, err := deserializeHold(k, v)
+		if err != nil {
+			return err
+		}
+		return f(h)
+	})
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+)
+
+var idempotencyKeysBucketKey = []byte("idempotencykeys")
+
+// IdempotentRequest records the transactions created by a request made with a
+// client-provided idempotency key.  RequestHash commits to the parameters of
+// the request, so that reuse of a key for a different request is detected.
+type IdempotentRequest struct {
+	Created     time.Time
+	RequestHash chainhash.Hash
+	TxHashes    []chainhash.Hash
+}
+
+// Idempotent request keys are the client-provided idempotency keys, and values
+// are serialized as:
+//
+// [0:8]   Unix time the request was recorded (8 bytes)
+// [8:40]  Request hash (32 bytes)
+// [40:44] Transaction hash count (4 bytes)
+// [44:]   Transaction hashes (32 bytes each)
+func serializeIdempotentRequest(r *IdempotentRequest) []byte {
+	v := make([]byte, 44+len(r.TxHashes)*chainhash.HashSize)
+	byteOrder.PutUint64(v[0:8], unixOrZero(r.Created))
+	copy(v[8:40], r.RequestHash[:])
+	byteOrder.PutUint32(v[40:44], uint32(len(r.TxHashes)))
+	off := 44
+	for i := range r.TxHashes {
+		off += copy(v[off:], r.TxHashes[i][:])
+	}
+	return v
+}
+
+func deserializeIdempotentRequest(v []byte) (*IdempotentRequest, error) {
+	if len(v) < 44 {
+		return nil, errors.E(errors.IO, errors.Errorf("bad idempotent "+
+			"request length %d", len(v)))
+	}
+	n := byteOrder.Uint32(v[40:44])
+	if uint64(len(v)) != 44+uint64(n)*chainhash.HashSize {
+		return nil, errors.E(errors.IO, errors.Errorf("bad idempotent "+
+			"request length %d for %d hashes", len(v), n))
+	}
+	r := &IdempotentRequest{
+		Created:  timeOrZero(byteOrder.Uint64(v[0:8])),
+		TxHashes: make([]chainhash.Hash, n),
+	}
+	copy(r.RequestHash[:], v[8:40])
+	off := 44
+	for i := range r.TxHashes {
+		off += copy(r.TxHashes[i][:], v[off:])
+	}
+	return r, nil
+}
+
+// PutIdempotentRequest records the transactions created by the request made
+// with an idempotency key.  Returns errors.Exist if the key was previously
+// recorded.
+func PutIdempotentRequest(dbtx walletdb.ReadWriteTx, key string, r *IdempotentRequest) error {
+	bucket := dbtx.ReadWriteBucket(idempotencyKeysBucketKey)
+	if bucket.Get([]byte(key)) != nil {
+		err := errors.Errorf("idempotency key %q was previously used", key)
+		return errors.E(errors.Exist, err)
+	}
+	err := bucket.Put([]byte(key), serializeIdempotentRequest(r))
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// DeleteIdempotentRequest removes the request recorded with an idempotency
+// key.
+func DeleteIdempotentRequest(dbtx walletdb.ReadWriteTx, key string) error {
+	err := dbtx.ReadWriteBucket(idempotencyKeysBucketKey).Delete([]byte(key))
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// IdempotentRequestFor returns the recorded request made with an idempotency
+// key.  Returns errors.NotExist if no request was recorded with the key.
+func IdempotentRequestFor(dbtx walletdb.ReadTx, key string) (*IdempotentRequest, error) {
+	v := dbtx.ReadBucket(idempotencyKeysBucketKey).Get([]byte(key))
+	if v == nil {
+		err := errors.Errorf("no request was recorded with idempotency key %q", key)
+		return nil, errors.E(errors.NotExist, err)
+	}
+	return deserializeIdempotentRequest(v)
+}
@@ -15,7 +15,8 @@ import (
 // Initialize prepares an empty database for usage by initializing all buckets
 // and key/value pairs.  The database is initialized with the latest version and
 // does not require any upgrades to use.
-func Initialize(ctx context.Context, db walletdb.DB, params *chaincfg.Params, seed, pubPass, privPass []byte) error {
+func Initialize(ctx context.Context, db walletdb.DB, params *chaincfg.Params, seed, pubPass, privPass []byte, opts ...ManagerOption) error {
+	o := applyManagerOptions(opts)
 	err := walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
 		addrmgrNs, err := tx.CreateTopLevelBucket(waddrmgrBucketKey)
 		if err != nil {
@@ -27,7 +28,7 @@ func Initialize(ctx context.Context, db walletdb.DB, params *chaincfg.Params, se
 		}
 
 		// Create the address manager and transaction store.
-		err = createAddressManager(addrmgrNs, seed, pubPass, privPass, params)
+		err = createAddressManager(addrmgrNs, seed, pubPass, privPass, params, o.rand)
 		if err != nil {
 			return err
 		}
@@ -53,7 +54,8 @@ func Initialize(ctx context.Context, db walletdb.DB, params *chaincfg.Params, se
 // InitializeWatchOnly prepares an empty database for watching-only wallet usage
 // by initializing all buckets and key/value pairs.  The database is initialized
 // with the latest version and does not require any upgrades to use.
-func InitializeWatchOnly(ctx context.Context, db walletdb.DB, params *chaincfg.Params, hdPubKey string, pubPass []byte) error {
+func InitializeWatchOnly(ctx context.Context, db walletdb.DB, params *chaincfg.Params, hdPubKey string, pubPass []byte, opts ...ManagerOption) error {
+	o := applyManagerOptions(opts)
 	err := walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
 		addrmgrNs, err := tx.CreateTopLevelBucket(waddrmgrBucketKey)
 		if err != nil {
@@ -65,7 +67,7 @@ func InitializeWatchOnly(ctx context.Context, db walletdb.DB, params *chaincfg.P
 		}
 
 		// Create the address manager and transaction store.
-		err = createWatchOnly(addrmgrNs, hdPubKey, pubPass, params)
+		err = createWatchOnly(addrmgrNs, hdPubKey, pubPass, params, o.rand)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,84 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+// instanceKey is the metadata bucket key recording the wallet process which
+// last wrote to the database.
+var instanceKey = []byte("instance")
+
+// InstanceID is a random identifier chosen by a wallet process each time it
+// opens the database.
+type InstanceID [16]byte
+
+// InstanceRecord describes the wallet process which last opened and wrote to
+// the database.  Heartbeat is periodically updated by the running process, and
+// Closed is set when the process closes the database.  Host and PID identify
+// the process, and are empty and zero for records written before they were
+// recorded.
+type InstanceRecord struct {
+	ID        InstanceID
+	Started   time.Time
+	Heartbeat time.Time
+	Closed    bool
+	PID       uint32
+	Host      string
+}
+
+// maxInstanceHostLen is the maximum recorded length of an instance host name.
+const maxInstanceHostLen = 255
+
+// LastInstance returns the record of the wallet process which last wrote to
+// the database, or nil if no process has recorded itself.
+func LastInstance(dbtx walletdb.ReadTx) (*InstanceRecord, error) {
+	// Format:
+	//   <id><started><heartbeat><closed>[<pid><host>]
+	v := dbtx.ReadBucket(metadataRootBucketKey).Get(instanceKey)
+	if v == nil {
+		return nil, nil
+	}
+	if len(v) != 33 && (len(v) < 37 || len(v) > 37+maxInstanceHostLen) {
+		return nil, errors.E(errors.IO, errors.Errorf("bad instance record len %d", len(v)))
+	}
+	r := new(InstanceRecord)
+	copy(r.ID[:], v)
+	r.Started = time.Unix(int64(byteOrder.Uint64(v[16:])), 0)
+	r.Heartbeat = time.Unix(int64(byteOrder.Uint64(v[24:])), 0)
+	r.Closed = v[32] != 0
+	if len(v) >= 37 {
+		r.PID = byteOrder.Uint32(v[33:])
+		r.Host = string(v[37:])
+	}
+	return r, nil
+}
+
+// PutInstance records the wallet process which last wrote to the database.
+// Host names longer than 255 bytes are truncated.
+func PutInstance(dbtx walletdb.ReadWriteTx, r *InstanceRecord) error {
+	host := r.Host
+	if len(host) > maxInstanceHostLen {
+		host = host[:maxInstanceHostLen]
+	}
+	v := make([]byte, 37+len(host))
+	copy(v, r.ID[:])
+	byteOrder.PutUint64(v[16:], uint64(r.Started.Unix()))
+	byteOrder.PutUint64(v[24:], uint64(r.Heartbeat.Unix()))
+	if r.Closed {
+		v[32] = 1
+	}
+	byteOrder.PutUint32(v[33:], r.PID)
+	copy(v[37:], host)
+	err := dbtx.ReadWriteBucket(metadataRootBucketKey).Put(instanceKey, v)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
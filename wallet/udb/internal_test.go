@@ -13,6 +13,8 @@ interface. The functions are only exported while the tests are being run.
 package udb
 
 import (
+	"io"
+
 	"decred.org/dcrwallet/v5/errors"
 	"decred.org/dcrwallet/v5/wallet/internal/snacl"
 )
@@ -29,7 +31,7 @@ func TstRunWithReplacedNewSecretKey(callback func()) {
 	defer func() {
 		newSecretKey = orig
 	}()
-	newSecretKey = func(passphrase *[]byte, config *scryptOptions) (*snacl.SecretKey, error) {
+	newSecretKey = func(r io.Reader, passphrase *[]byte, config *scryptOptions) (*snacl.SecretKey, error) {
 		return nil, errors.E(errors.Crypto)
 	}
 	callback()
@@ -0,0 +1,219 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/dcrutil/v4"
+)
+
+var invoicesBucketKey = []byte("invoices")
+
+// MaxInvoiceIDLen is the maximum length of an invoice ID.
+const MaxInvoiceIDLen = 128
+
+// InvoiceStatus describes the payment of an invoice.
+type InvoiceStatus uint8
+
+// Invoice statuses.
+const (
+	// InvoicePending describes an invoice whose address has not received
+	// any mined payment.
+	InvoicePending InvoiceStatus = iota
+
+	// InvoicePartial describes an invoice whose address has received less
+	// than the invoiced amount.
+	InvoicePartial
+
+	// InvoicePaid describes an invoice whose address has received exactly
+	// the invoiced amount.
+	InvoicePaid
+
+	// InvoiceOverpaid describes an invoice whose address has received more
+	// than the invoiced amount.
+	InvoiceOverpaid
+
+	// InvoiceExpired describes an invoice which was not fully paid before
+	// its expiry.  Expired invoices remain expired, but later payments
+	// continue to be recorded by the Received amount.
+	InvoiceExpired
+)
+
+var invoiceStatusStrings = [...]string{
+	InvoicePending:  "pending",
+	InvoicePartial:  "partial",
+	InvoicePaid:     "paid",
+	InvoiceOverpaid: "overpaid",
+	InvoiceExpired:  "expired",
+}
+
+// String returns the name of the status.
+func (s InvoiceStatus) String() string {
+	if int(s) >= len(invoiceStatusStrings) {
+		return "unknown"
+	}
+	return invoiceStatusStrings[s]
+}
+
+// ParseInvoiceStatus returns the status named by s.
+func ParseInvoiceStatus(s string) (InvoiceStatus, error) {
+	for i, name := range invoiceStatusStrings {
+		if s == name {
+			return InvoiceStatus(i), nil
+		}
+	}
+	return 0, errors.E(errors.Invalid, errors.Errorf("unknown invoice status %q", s))
+}
+
+// Invoice records an amount invoiced to an external address of an account,
+// and the reconciliation of mined payments to the address with the invoice.
+// A zero Expiry indicates the invoice does not expire.  ChangedHeight is the
+// main chain height at which the received amount last changed, or zero if no
+// payment has been received.
+type Invoice struct {
+	Address       string
+	Account       uint32
+	Amount        dcrutil.Amount
+	Received      dcrutil.Amount
+	Status        InvoiceStatus
+	Created       time.Time
+	Expiry        time.Time
+	Updated       time.Time
+	ChangedHeight int32
+}
+
+// Reconcile updates the invoice with the total amount received by its
+// address at the main chain tip height and time now, and returns whether the
+// invoice changed.  Invoices which are not fully paid before their expiry
+// become expired.
+func (inv *Invoice) Reconcile(received dcrutil.Amount, height int32, now time.Time) bool {
+	changed := false
+	if received != inv.Received {
+		inv.Received = received
+		inv.ChangedHeight = height
+		if received == 0 {
+			inv.ChangedHeight = 0
+		}
+		changed = true
+	}
+
+	status := inv.Status
+	switch {
+	case status == InvoiceExpired:
+	case received > inv.Amount:
+		status = InvoiceOverpaid
+	case received == inv.Amount:
+		status = InvoicePaid
+	case !inv.Expiry.IsZero() && !now.Before(inv.Expiry):
+		status = InvoiceExpired
+	case received > 0:
+		status = InvoicePartial
+	default:
+		status = InvoicePending
+	}
+	if status != inv.Status {
+		inv.Status = status
+		changed = true
+	}
+	if changed {
+		inv.Updated = now
+	}
+	return changed
+}
+
+// Invoice keys are the invoice IDs, and values are serialized as:
+//
+// [0:4]   Account (4 bytes)
+// [4:12]  Invoiced amount (8 bytes)
+// [12:20] Received amount (8 bytes)
+// [20]    Status (1 byte)
+// [21:29] Unix creation time (8 bytes)
+// [29:37] Unix expiry time, or zero (8 bytes)
+// [37:45] Unix time of the last update, or zero (8 bytes)
+// [45:49] Height the received amount last changed (4 bytes)
+// [49:]   Encoded address
+const invoiceHeaderSize = 49
+
+func serializeInvoice(inv *Invoice) []byte {
+	v := make([]byte, invoiceHeaderSize+len(inv.Address))
+	byteOrder.PutUint32(v[0:4], inv.Account)
+	byteOrder.PutUint64(v[4:12], uint64(inv.Amount))
+	byteOrder.PutUint64(v[12:20], uint64(inv.Received))
+	v[20] = byte(inv.Status)
+	byteOrder.PutUint64(v[21:29], unixOrZero(inv.Created))
+	byteOrder.PutUint64(v[29:37], unixOrZero(inv.Expiry))
+	byteOrder.PutUint64(v[37:45], unixOrZero(inv.Updated))
+	byteOrder.PutUint32(v[45:49], uint32(inv.ChangedHeight))
+	copy(v[invoiceHeaderSize:], inv.Address)
+	return v
+}
+
+func deserializeInvoice(v []byte) (*Invoice, error) {
+	if len(v) <= invoiceHeaderSize {
+		return nil, errors.E(errors.IO, errors.Errorf("bad invoice length %d", len(v)))
+	}
+	return &Invoice{
+		Account:       byteOrder.Uint32(v[0:4]),
+		Amount:        dcrutil.Amount(byteOrder.Uint64(v[4:12])),
+		Received:      dcrutil.Amount(byteOrder.Uint64(v[12:20])),
+		Status:        InvoiceStatus(v[20]),
+		Created:       timeOrZero(byteOrder.Uint64(v[21:29])),
+		Expiry:        timeOrZero(byteOrder.Uint64(v[29:37])),
+		Updated:       timeOrZero(byteOrder.Uint64(v[37:45])),
+		ChangedHeight: int32(byteOrder.Uint32(v[45:49])),
+		Address:       string(v[invoiceHeaderSize:]),
+	}, nil
+}
+
+// PutInvoice records an invoice, replacing any previous record with the ID.
+func PutInvoice(dbtx walletdb.ReadWriteTx, id string, inv *Invoice) error {
+	if id == "" || len(id) > MaxInvoiceIDLen {
+		return errors.E(errors.Invalid, errors.Errorf("invoice ID must be "+
+			"between 1 and %d bytes", MaxInvoiceIDLen))
+	}
+	bucket := dbtx.ReadWriteBucket(invoicesBucketKey)
+	err := bucket.Put([]byte(id), serializeInvoice(inv))
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// InvoiceFor returns the invoice with an ID.  Returns errors.NotExist if no
+// invoice has the ID.
+func InvoiceFor(dbtx walletdb.ReadTx, id string) (*Invoice, error) {
+	v := dbtx.ReadBucket(invoicesBucketKey).Get([]byte(id))
+	if v == nil {
+		return nil, errors.E(errors.NotExist, errors.Errorf("no invoice %q", id))
+	}
+	return deserializeInvoice(v)
+}
+
+// DeleteInvoice removes the invoice with an ID.  Returns errors.NotExist if no
+// invoice has the ID.
+func DeleteInvoice(dbtx walletdb.ReadWriteTx, id string) error {
+	bucket := dbtx.ReadWriteBucket(invoicesBucketKey)
+	if bucket.Get([]byte(id)) == nil {
+		return errors.E(errors.NotExist, errors.Errorf("no invoice %q", id))
+	}
+	if err := bucket.Delete([]byte(id)); err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// ForEachInvoice calls f with every invoice, ordered by ID.
+func ForEachInvoice(dbtx walletdb.ReadTx, f func(id string, inv *Invoice) error) error {
+	return dbtx.ReadBucket(invoicesBucketKey).ForEach(func(k, v []byte) error {
+		inv, err := deserializeInvoice(v)
+		if err != nil {
+			return err
+		}
+		return f(string(k), inv)
+	})
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"io"
+	"sync"
+	"unsafe"
+
+	"decred.org/dcrwallet/v5/wallet/internal/securemem"
+	"decred.org/dcrwallet/v5/wallet/internal/snacl"
+	"github.com/decred/dcrd/hdkeychain/v3"
+)
+
+var warnUnlockedKeyMem sync.Once
+
+// newLockedKey allocates a zeroed crypto key in memory that is locked against
+// being swapped to disk.  When the operating system refuses to lock memory,
+// a warning is logged once and the key is held in ordinary memory.
+//
+// Only the private master and crypto keys are held in locked memory.  Account
+// extended private keys, the private keys derived from them, and decrypted
+// imported private keys are allocated by hdkeychain and secp256k1, which do
+// not accept caller-owned memory.  These are held in ordinary memory that may
+// be written to swap, and are zeroed after use or when the manager is locked.
+func newLockedKey() (*snacl.CryptoKey, *securemem.Buffer) {
+	mem := securemem.New(snacl.KeySize)
+	if !mem.Locked() {
+		warnUnlockedKeyMem.Do(func() {
+			log.Warnf("Unable to lock memory for the private master and "+
+				"crypto keys (%v); they may be written to swap",
+				mem.LockError())
+		})
+	}
+	return (*snacl.CryptoKey)(mem.Bytes()), mem
+}
+
+// lockedCryptoKey is a crypto key held in locked memory which implements
+// EncryptorDecryptor.
+type lockedCryptoKey struct {
+	*snacl.CryptoKey
+	rand io.Reader
+}
+
+// Encrypt encrypts the passed data using a nonce read from the key's source
+// of randomness.
+func (ck lockedCryptoKey) Encrypt(in []byte) ([]byte, error) {
+	return ck.CryptoKey.EncryptWithRand(randReader(ck.rand), in)
+}
+
+// Bytes returns the crypto key's byte slice.
+func (ck lockedCryptoKey) Bytes() []byte {
+	return ck.CryptoKey[:]
+}
+
+// CopyBytes copies the bytes from the given slice into the crypto key.
+func (ck lockedCryptoKey) CopyBytes(from []byte) {
+	copy(ck.CryptoKey[:], from)
+}
+
+// xprivFromBytes parses a decrypted, serialized extended private key.  The key
+// is parsed without first converting it to a string, as strings are immutable
+// and the copy could never be cleared.  The caller should zero b after use.
+func xprivFromBytes(b []byte, net hdkeychain.NetworkParams) (*hdkeychain.ExtendedKey, error) {
+	return hdkeychain.NewKeyFromString(unsafe.String(unsafe.SliceData(b), len(b)), net)
+}
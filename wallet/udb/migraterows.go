@@ -0,0 +1,190 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"bytes"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+// migrateRowsProgressInterval is the number of address rows examined between
+// progress reports by MigrateRows.
+const migrateRowsProgressInterval = 10000
+
+// RowMigration describes the account and address rows examined and rewritten
+// by MigrateRows.
+type RowMigration struct {
+	Accounts           int
+	AccountsRewritten  int
+	Addresses          int
+	AddressesRewritten int
+}
+
+// MigrateRows rewrites every account and address row of the address manager
+// which is not recorded in the serialization of the latest database version.
+// Legacy BIP0044 account rows are converted to rows with account variables,
+// and other rows are rewritten when their canonical serialization differs
+// from the recorded value (for example, due to unused or trailing bytes).
+// Rows which can not be deserialized error with errors.IO, and no rows are
+// rewritten unless the database transaction is committed.
+//
+// progress, if non-nil, is called after all accounts have been examined, and
+// periodically while examining addresses.
+func (m *Manager) MigrateRows(ns walletdb.ReadWriteBucket, progress func(RowMigration)) (RowMigration, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	var res RowMigration
+	report := func() {
+		if progress != nil {
+			progress(res)
+		}
+	}
+
+	var accounts []uint32
+	err := forEachAccount(ns, func(account uint32) error {
+		accounts = append(accounts, account)
+		return nil
+	})
+	if err != nil {
+		return res, err
+	}
+	for _, account := range accounts {
+		rewritten, err := migrateAccountRow(ns, account)
+		if err != nil {
+			return res, err
+		}
+		res.Accounts++
+		if rewritten {
+			res.AccountsRewritten++
+		}
+	}
+	report()
+
+	// Address rows are keyed by a hash of the address and can not be
+	// rewritten by putAddress.  Collect the canonical rows first, as the
+	// bucket must not be modified during iteration.
+	type rewrite struct{ k, v []byte }
+	var rewrites []rewrite
+	bucket := ns.NestedReadWriteBucket(addrBucketName)
+	err = bucket.ForEach(func(k, v []byte) error {
+		// Skip buckets.
+		if v == nil {
+			return nil
+		}
+		canonical, err := canonicalAddressRow(v)
+		if err != nil {
+			return errors.E(errors.IO, errors.Errorf("address %x: %v", k, err))
+		}
+		res.Addresses++
+		if !bytes.Equal(v, canonical) {
+			rewrites = append(rewrites, rewrite{append([]byte(nil), k...), canonical})
+			res.AddressesRewritten++
+		}
+		if res.Addresses%migrateRowsProgressInterval == 0 {
+			report()
+		}
+		return nil
+	})
+	if err != nil {
+		return res, err
+	}
+	for _, r := range rewrites {
+		err := bucket.Put(r.k, r.v)
+		if err != nil {
+			return res, errors.E(errors.IO, err)
+		}
+	}
+	report()
+
+	return res, nil
+}
+
+// migrateAccountRow rewrites the row of an account in the latest
+// serialization, returning whether the recorded row was modified.
+func migrateAccountRow(ns walletdb.ReadWriteBucket, account uint32) (bool, error) {
+	row, err := fetchAccountRow(ns, account)
+	if err != nil {
+		return false, err
+	}
+	accountID := uint32ToBytes(account)
+	switch row.acctType {
+	case actBIP0044Legacy:
+		// Legacy rows are recorded in the last serialization used prior
+		// to account variables.
+		a, err := deserializeBIP0044AccountRow(accountID, row, accountVariablesVersion-1)
+		if err != nil {
+			return false, err
+		}
+		newAcct := &dbBIP0044Account{
+			dbAccountRow:              a.dbAccountRow,
+			pubKeyEncrypted:           a.pubKeyEncrypted,
+			privKeyEncrypted:          a.privKeyEncrypted,
+			lastUsedExternalIndex:     a.lastUsedExternalIndex,
+			lastUsedInternalIndex:     a.lastUsedInternalIndex,
+			lastReturnedExternalIndex: a.lastReturnedExternalIndex,
+			lastReturnedInternalIndex: a.lastReturnedInternalIndex,
+			name:                      a.name,
+		}
+		newAcct.acctType = actBIP0044
+		newAcct.rawData = newAcct.serializeRow()
+		return true, putNewBIP0044Account(ns, account, newAcct)
+
+	case actBIP0044, importedVoting, externalSigner:
+		varsBucket := ns.NestedReadBucket(acctVarsBucketName).NestedReadBucket(accountID)
+		if varsBucket == nil {
+			err := errors.Errorf("account %d has no variables", account)
+			return false, errors.E(errors.IO, err)
+		}
+		a := new(dbBIP0044Account)
+		if err := a.deserializeRow(row.rawData); err != nil {
+			return false, err
+		}
+		a.acctType = row.acctType
+		a.serializeRow()
+		recorded := ns.NestedReadBucket(acctBucketName).Get(accountID)
+		if bytes.Equal(recorded, serializeAccountRow(&a.dbAccountRow)) {
+			return false, nil
+		}
+		return true, putAccountRow(ns, account, &a.dbAccountRow)
+	}
+
+	err = errors.Errorf("unknown type %d for account %d", row.acctType, account)
+	return false, errors.E(errors.IO, err)
+}
+
+// canonicalAddressRow returns the serialization of an address row in the
+// latest version.
+func canonicalAddressRow(v []byte) ([]byte, error) {
+	row, err := deserializeAddressRow(v)
+	if err != nil {
+		return nil, err
+	}
+	switch row.addrType {
+	case adtChain:
+		r, err := deserializeChainedAddress(row)
+		if err != nil {
+			return nil, err
+		}
+		row.rawData = serializeChainedAddress(r.branch, r.index)
+	case adtImport:
+		r, err := deserializeImportedAddress(row)
+		if err != nil {
+			return nil, err
+		}
+		row.rawData = serializeImportedAddress(r.encryptedPubKey, r.encryptedPrivKey)
+	case adtScript:
+		r, err := deserializeScriptAddress(row)
+		if err != nil {
+			return nil, err
+		}
+		row.rawData = serializeScriptAddress(r.encryptedHash, r.script)
+	default:
+		return nil, errors.Errorf("unknown address type %d", row.addrType)
+	}
+	return serializeAddressRow(row), nil
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+func TestMigrateRows(t *testing.T) {
+	ctx := context.Background()
+	db, mgr, _, teardown, err := cloneDB(ctx, "migrate_rows.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mgr.Close()
+
+	const legacyAccount = 5
+	chainedID := []byte("chained address")
+	chainedKey := sha256.Sum256(chainedID)
+	importedKey := sha256.Sum256([]byte("imported address"))
+	var importedRow []byte
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrBucketKey)
+
+		// Record an account in the legacy BIP0044 row serialization.
+		row := bip0044AccountInfo([]byte("pub"), []byte("priv"), 0, 0,
+			10, 20, 11, 21, "legacy", accountVariablesVersion-1)
+		err := putBIP0044AccountInfo(ns, legacyAccount, row)
+		if err != nil {
+			return err
+		}
+
+		err = putChainedAddress(ns, chainedID, 0, 0, 1)
+		if err != nil {
+			return err
+		}
+
+		// Record an imported address with a set unused byte and
+		// trailing data.
+		importedRow = serializeAddressRow(&dbAddressRow{
+			addrType: adtImport,
+			rawData:  serializeImportedAddress([]byte("pub"), []byte("priv")),
+		})
+		importedRow[13] = 1
+		importedRow = append(importedRow, 0xff)
+		return ns.NestedReadWriteBucket(addrBucketName).Put(importedKey[:],
+			importedRow)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	migrate := func() (RowMigration, error) {
+		var res RowMigration
+		var reports int
+		err := walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+			ns := dbtx.ReadWriteBucket(waddrmgrBucketKey)
+			var err error
+			res, err = mgr.MigrateRows(ns, func(RowMigration) { reports++ })
+			return err
+		})
+		if err == nil && reports < 2 {
+			t.Errorf("%d progress reports", reports)
+		}
+		return res, err
+	}
+
+	res, err := migrate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.AccountsRewritten != 1 || res.AddressesRewritten != 1 ||
+		res.Accounts < 2 || res.Addresses != 2 {
+		t.Fatalf("migration %+v", res)
+	}
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(waddrmgrBucketKey)
+		a, err := fetchDBAccount(ns, legacyAccount, DBVersion)
+		if err != nil {
+			return err
+		}
+		acct, ok := a.(*dbBIP0044Account)
+		if !ok {
+			t.Fatalf("migrated account is %T", a)
+		}
+		if acct.name != "legacy" || acct.lastUsedExternalIndex != 10 ||
+			acct.lastUsedInternalIndex != 20 ||
+			acct.lastReturnedExternalIndex != 11 ||
+			acct.lastReturnedInternalIndex != 21 ||
+			!bytes.Equal(acct.pubKeyEncrypted, []byte("pub")) {
+			t.Errorf("migrated account %+v", acct)
+		}
+		v := ns.NestedReadBucket(addrBucketName).Get(importedKey[:])
+		if len(v) != len(importedRow)-1 || v[13] != 0 {
+			t.Errorf("imported address row not rewritten: %x", v)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Migrating again rewrites no rows.
+	res, err = migrate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.AccountsRewritten != 0 || res.AddressesRewritten != 0 {
+		t.Fatalf("second migration %+v", res)
+	}
+
+	// Rows which can not be deserialized are errors.
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrBucketKey)
+		v := serializeAddressRow(&dbAddressRow{addrType: adtChain,
+			rawData: make([]byte, 4)})
+		return ns.NestedReadWriteBucket(addrBucketName).Put(chainedKey[:], v)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := migrate(); !errors.Is(err, errors.IO) {
+		t.Fatalf("corrupt address row migrated: %v", err)
+	}
+}
@@ -18,7 +18,8 @@ import (
 // A NotExist error will be returned if the database has not been initialized.
 // The recorded database version must match exactly with DBVersion.  If the
 // version does not match, an Invalid error is returned.
-func Open(ctx context.Context, db walletdb.DB, params *chaincfg.Params, pubPass []byte) (addrMgr *Manager, txStore *Store, err error) {
+func Open(ctx context.Context, db walletdb.DB, params *chaincfg.Params, pubPass []byte, opts ...ManagerOption) (addrMgr *Manager, txStore *Store, err error) {
+	o := applyManagerOptions(opts)
 	err = walletdb.View(ctx, db, func(tx walletdb.ReadTx) error {
 		// Verify the database exists and the recorded version is supported by
 		// this software version.
@@ -39,7 +40,7 @@ func Open(ctx context.Context, db walletdb.DB, params *chaincfg.Params, pubPass
 
 		addrmgrNs := tx.ReadBucket(waddrmgrBucketKey)
 
-		addrMgr, err = loadManager(addrmgrNs, pubPass, params)
+		addrMgr, err = loadManager(addrmgrNs, pubPass, params, o.rand)
 		if err != nil {
 			return err
 		}
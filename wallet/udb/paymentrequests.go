@@ -0,0 +1,112 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/dcrutil/v4"
+)
+
+var paymentRequestsBucketKey = []byte("paymentrequests")
+
+// PaymentRequest records the details of a payment request URI issued for an
+// external address, allowing later payments to the address to be reconciled
+// with the request.  A zero Amount indicates that no amount was requested,
+// and a zero Expiry indicates the request does not expire.
+type PaymentRequest struct {
+	Issued  time.Time
+	Expiry  time.Time
+	Amount  dcrutil.Amount
+	Label   string
+	Message string
+}
+
+// Payment request keys are encoded addresses, and values are serialized as:
+//
+// [0:8]   Unix time the request was issued (8 bytes)
+// [8:16]  Unix expiry time, or zero (8 bytes)
+// [16:24] Requested amount, or zero (8 bytes)
+// [24:28] Label length (4 bytes)
+// [28:L]  Label
+// [L:L+4] Message length (4 bytes)
+// [L+4:]  Message
+func serializePaymentRequest(r *PaymentRequest) []byte {
+	v := make([]byte, 32+len(r.Label)+len(r.Message))
+	byteOrder.PutUint64(v[0:8], unixOrZero(r.Issued))
+	byteOrder.PutUint64(v[8:16], unixOrZero(r.Expiry))
+	byteOrder.PutUint64(v[16:24], uint64(r.Amount))
+	off := 24
+	for _, s := range []string{r.Label, r.Message} {
+		byteOrder.PutUint32(v[off:], uint32(len(s)))
+		off += 4
+		off += copy(v[off:], s)
+	}
+	return v
+}
+
+func deserializePaymentRequest(v []byte) (*PaymentRequest, error) {
+	if len(v) < 32 {
+		return nil, errors.E(errors.IO, errors.Errorf("bad payment request "+
+			"length %d", len(v)))
+	}
+	r := &PaymentRequest{
+		Issued: timeOrZero(byteOrder.Uint64(v[0:8])),
+		Expiry: timeOrZero(byteOrder.Uint64(v[8:16])),
+		Amount: dcrutil.Amount(byteOrder.Uint64(v[16:24])),
+	}
+	off := 24
+	var strs [2]string
+	for i := range strs {
+		if len(v[off:]) < 4 {
+			return nil, errors.E(errors.IO, "short payment request")
+		}
+		n := int(byteOrder.Uint32(v[off:]))
+		off += 4
+		if len(v[off:]) < n {
+			return nil, errors.E(errors.IO, "short payment request")
+		}
+		strs[i] = string(v[off : off+n])
+		off += n
+	}
+	r.Label, r.Message = strs[0], strs[1]
+	return r, nil
+}
+
+// PutPaymentRequest records the payment request issued for an address,
+// replacing any previous record.
+func PutPaymentRequest(dbtx walletdb.ReadWriteTx, addr string, r *PaymentRequest) error {
+	bucket := dbtx.ReadWriteBucket(paymentRequestsBucketKey)
+	err := bucket.Put([]byte(addr), serializePaymentRequest(r))
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// PaymentRequestFor returns the payment request issued for an address.
+// Returns errors.NotExist if no payment request was issued for the address.
+func PaymentRequestFor(dbtx walletdb.ReadTx, addr string) (*PaymentRequest, error) {
+	v := dbtx.ReadBucket(paymentRequestsBucketKey).Get([]byte(addr))
+	if v == nil {
+		err := errors.Errorf("no payment request was issued for address %s", addr)
+		return nil, errors.E(errors.NotExist, err)
+	}
+	return deserializePaymentRequest(v)
+}
+
+// ForEachPaymentRequest calls f with every address issued with a payment
+// request.
+func ForEachPaymentRequest(dbtx walletdb.ReadTx, f func(addr string, r *PaymentRequest) error) error {
+	return dbtx.ReadBucket(paymentRequestsBucketKey).ForEach(func(k, v []byte) error {
+		r, err := deserializePaymentRequest(v)
+		if err != nil {
+			return err
+		}
+		return f(string(k), r)
+	})
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"bytes"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/wire"
+)
+
+var pendingSpendsBucketKey = []byte("pendingspends")
+
+// PendingSpend is an unsigned transaction proposed to be signed and published
+// after approval.  Because the hash of a Decred transaction does not commit
+// to signature scripts, the hash of the unsigned transaction remains the hash
+// of the published transaction.
+type PendingSpend struct {
+	Tx       *wire.MsgTx
+	Account  uint32
+	Proposed time.Time
+}
+
+// Pending spend values are serialized as:
+//
+// [0:8]  Unix time of the proposal (8 bytes)
+// [8:12] Account (4 bytes)
+// [12:]  Serialized unsigned transaction
+func serializePendingSpend(p *PendingSpend) ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 12, 12+p.Tx.SerializeSize()))
+	byteOrder.PutUint64(buf.Bytes(), uint64(p.Proposed.Unix()))
+	byteOrder.PutUint32(buf.Bytes()[8:], p.Account)
+	if err := p.Tx.Serialize(buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func deserializePendingSpend(v []byte) (*PendingSpend, error) {
+	if len(v) < 12 {
+		return nil, errors.E(errors.IO, errors.Errorf("bad pending spend "+
+			"length %d", len(v)))
+	}
+	p := &PendingSpend{
+		Tx:       new(wire.MsgTx),
+		Account:  byteOrder.Uint32(v[8:]),
+		Proposed: time.Unix(int64(byteOrder.Uint64(v)), 0),
+	}
+	if err := p.Tx.Deserialize(bytes.NewReader(v[12:])); err != nil {
+		return nil, errors.E(errors.IO, err)
+	}
+	return p, nil
+}
+
+// PutPendingSpend records a proposed spend, keyed by its transaction hash.
+func PutPendingSpend(dbtx walletdb.ReadWriteTx, p *PendingSpend) error {
+	v, err := serializePendingSpend(p)
+	if err != nil {
+		return err
+	}
+	txHash := p.Tx.TxHash()
+	bucket := dbtx.ReadWriteBucket(pendingSpendsBucketKey)
+	if err := bucket.Put(txHash[:], v); err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// FetchPendingSpend returns the proposed spend with a transaction hash.
+func FetchPendingSpend(dbtx walletdb.ReadTx, txHash *chainhash.Hash) (*PendingSpend, error) {
+	v := dbtx.ReadBucket(pendingSpendsBucketKey).Get(txHash[:])
+	if v == nil {
+		return nil, errors.E(errors.NotExist, errors.Errorf("no pending "+
+			"spend %v", txHash))
+	}
+	return deserializePendingSpend(v)
+}
+
+// DeletePendingSpend removes a proposed spend.  It is a NotExist error if the
+// spend was not recorded.
+func DeletePendingSpend(dbtx walletdb.ReadWriteTx, txHash *chainhash.Hash) error {
+	bucket := dbtx.ReadWriteBucket(pendingSpendsBucketKey)
+	if bucket.Get(txHash[:]) == nil {
+		return errors.E(errors.NotExist, errors.Errorf("no pending "+
+			"spend %v", txHash))
+	}
+	if err := bucket.Delete(txHash[:]); err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// PendingSpends returns all proposed spends, ordered by transaction hash.
+func PendingSpends(dbtx walletdb.ReadTx) ([]*PendingSpend, error) {
+	var spends []*PendingSpend
+	bucket := dbtx.ReadBucket(pendingSpendsBucketKey)
+	err := bucket.ForEach(func(k, v []byte) error {
+		p, err := deserializePendingSpend(v)
+		if err != nil {
+			return err
+		}
+		spends = append(spends, p)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return spends, nil
+}
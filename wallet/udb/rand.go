@@ -0,0 +1,63 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"io"
+
+	"decred.org/dcrwallet/v5/errors"
+	"github.com/decred/dcrd/crypto/rand"
+)
+
+// ManagerOption modifies the construction of an address manager by
+// Initialize, InitializeWatchOnly, and Open.
+type ManagerOption func(*managerOptions)
+
+type managerOptions struct {
+	rand io.Reader
+}
+
+// WithRand sets the source of all randomness used by the address manager,
+// including master key salts, crypto keys, encryption nonces, KDF salts of
+// account passphrases, passphrase hashing keys, and account UUIDs.  Account
+// UUIDs assigned by database upgrades are not affected.
+//
+// A deterministic source allows address manager operations to be reproduced
+// for test vectors of the encryption and sealing formats.  It must never be
+// used by wallets protecting real funds.
+func WithRand(r io.Reader) ManagerOption {
+	return func(o *managerOptions) {
+		o.rand = r
+	}
+}
+
+func applyManagerOptions(opts []ManagerOption) *managerOptions {
+	o := new(managerOptions)
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// randReader returns r, or a cryptographically secure source when r is nil.
+func randReader(r io.Reader) io.Reader {
+	if r == nil {
+		return rand.Reader()
+	}
+	return r
+}
+
+// readRand fills b from r, or from a cryptographically secure source when r
+// is nil.
+func readRand(r io.Reader, b []byte) error {
+	if r == nil {
+		rand.Read(b)
+		return nil
+	}
+	if _, err := io.ReadFull(r, b); err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"bytes"
+	"context"
+	"math/rand/v2"
+	"testing"
+
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/v3"
+)
+
+// randVectors creates an address manager using randomness from a ChaCha8
+// stream keyed by k, and returns the encrypted and sealed data written by
+// account creation and by setting a unique account passphrase.
+func randVectors(t *testing.T, k byte) [][]byte {
+	ctx := context.Background()
+	db, teardown := tempDB(t)
+	defer teardown()
+
+	params := chaincfg.SimNetParams()
+	r := rand.NewChaCha8([32]byte{k})
+	err := Initialize(ctx, db, params, seed, pubPassphrase, privPassphrase, WithRand(r))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, _, err := Open(ctx, db, params, pubPassphrase, WithRand(r))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var vectors [][]byte
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrBucketKey)
+		if err := m.Unlock(ns, privPassphrase); err != nil {
+			return err
+		}
+		account, err := m.NewAccount(ns, "vectors")
+		if err != nil {
+			return err
+		}
+		err = m.SetAccountPassphrase(dbtx, account, []byte("account passphrase"))
+		if err != nil {
+			return err
+		}
+
+		masterPub, masterPriv, err := fetchMasterKeyParams(ns)
+		if err != nil {
+			return err
+		}
+		cryptoPub, cryptoPriv, err := fetchCryptoKeys(ns)
+		if err != nil {
+			return err
+		}
+		vectors = append(vectors, masterPub, masterPriv, cryptoPub, cryptoPriv)
+		for _, acct := range []uint32{0, account} {
+			row, err := fetchDBAccount(ns, acct, DBVersion)
+			if err != nil {
+				return err
+			}
+			a := row.(*dbBIP0044Account)
+			vectors = append(vectors, a.pubKeyEncrypted, a.privKeyEncrypted)
+		}
+		row, err := fetchDBAccount(ns, account, DBVersion)
+		if err != nil {
+			return err
+		}
+		kdfp, err := row.(*dbBIP0044Account).uniqueKey.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		u, err := fetchAccountUUID(ns, account)
+		if err != nil {
+			return err
+		}
+		vectors = append(vectors, kdfp, u[:])
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return vectors
+}
+
+func TestWithRand(t *testing.T) {
+	a := randVectors(t, 1)
+	b := randVectors(t, 1)
+	c := randVectors(t, 2)
+	for i := range a {
+		if !bytes.Equal(a[i], b[i]) {
+			t.Errorf("vector %d differs with the same randomness: %x != %x",
+				i, a[i], b[i])
+		}
+		if bytes.Equal(a[i], c[i]) {
+			t.Errorf("vector %d is unchanged with other randomness: %x",
+				i, a[i])
+		}
+	}
+}
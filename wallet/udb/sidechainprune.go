@@ -0,0 +1,118 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"bytes"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+// SidechainRecords counts the saved records of blocks which are not in the
+// main chain.
+type SidechainRecords struct {
+	// Headers is the number of saved headers of blocks which were
+	// reorganized out of the main chain.
+	Headers int
+
+	// CFilters is the number of saved compact filters of blocks which are
+	// not in the main chain, including filters without a saved header.
+	CFilters int
+}
+
+// sidechainHeaderHeight returns the height of a header keyed by block hash and
+// whether the block is not in the main chain.
+func sidechainHeaderHeight(ns walletdb.ReadBucket, k, header []byte) (int32, bool) {
+	height := extractBlockHeaderHeight(header)
+	_, v := existsBlockRecord(ns, height)
+	return height, v == nil || !bytes.Equal(extractRawBlockRecordHash(v), k)
+}
+
+// sidechainCFilter returns whether a compact filter keyed by block hash
+// belongs to a block without a saved header, or to a block which is not in
+// the main chain and at or below maxHeight.
+func sidechainCFilter(ns walletdb.ReadBucket, k []byte, maxHeight int32) bool {
+	header := existsBlockHeader(ns, k)
+	if header == nil {
+		return true
+	}
+	height, side := sidechainHeaderHeight(ns, k, header)
+	return side && height <= maxHeight
+}
+
+// SidechainRecords returns the number of saved headers and compact filters of
+// blocks which are not in the main chain.
+func (s *Store) SidechainRecords(dbtx walletdb.ReadTx) (*SidechainRecords, error) {
+	ns := dbtx.ReadBucket(wtxmgrBucketKey)
+	var r SidechainRecords
+	err := ns.NestedReadBucket(bucketHeaders).ForEach(func(k, v []byte) error {
+		if _, side := sidechainHeaderHeight(ns, k, v); side {
+			r.Headers++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(errors.IO, err)
+	}
+	err = ns.NestedReadBucket(bucketCFilters).ForEach(func(k, v []byte) error {
+		if sidechainCFilter(ns, k, maxBlockHeight) {
+			r.CFilters++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(errors.IO, err)
+	}
+	return &r, nil
+}
+
+// maxBlockHeight is larger than the height of any block.
+const maxBlockHeight = int32(^uint32(0) >> 1)
+
+// PruneSidechains removes the saved headers and compact filters of blocks
+// which are not in the main chain and are at or below maxHeight, and compact
+// filters of blocks without a saved header.  Headers and filters of main chain
+// blocks are never removed.  The numbers of removed records are returned.
+func (s *Store) PruneSidechains(dbtx walletdb.ReadWriteTx, maxHeight int32) (*SidechainRecords, error) {
+	ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+
+	// Filters are checked first, as their staleness depends on the saved
+	// headers.
+	var filters, headers [][]byte
+	err := ns.NestedReadBucket(bucketCFilters).ForEach(func(k, v []byte) error {
+		if sidechainCFilter(ns, k, maxHeight) {
+			filters = append(filters, append(k[:0:0], k...))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(errors.IO, err)
+	}
+	err = ns.NestedReadBucket(bucketHeaders).ForEach(func(k, v []byte) error {
+		height, side := sidechainHeaderHeight(ns, k, v)
+		if side && height <= maxHeight {
+			headers = append(headers, append(k[:0:0], k...))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(errors.IO, err)
+	}
+
+	filterBucket := ns.NestedReadWriteBucket(bucketCFilters)
+	for _, k := range filters {
+		if err := filterBucket.Delete(k); err != nil {
+			return nil, errors.E(errors.IO, err)
+		}
+	}
+	headerBucket := ns.NestedReadWriteBucket(bucketHeaders)
+	for _, k := range headers {
+		if err := headerBucket.Delete(k); err != nil {
+			return nil, errors.E(errors.IO, err)
+		}
+	}
+	return &SidechainRecords{Headers: len(headers), CFilters: len(filters)}, nil
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/dcrutil/v4"
+)
+
+func TestPruneSidechains(t *testing.T) {
+	ctx := context.Background()
+	db, _, s, teardown, err := cloneDB(ctx, "prune_sidechains.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g := makeBlockGenerator()
+	block1Header := g.generate(dcrutil.BlockValid)
+	block2Header := g.generate(dcrutil.BlockValid)
+	forkGen := g
+	// Vote bits differ only to create a distinct block at the fork.
+	staleHeader := g.generate(dcrutil.BlockValid | 1<<1)
+	block3Header := forkGen.generate(dcrutil.BlockValid)
+	block4Header := forkGen.generate(dcrutil.BlockValid)
+
+	check := func(dbtx walletdb.ReadTx, desc string, headers, cfilters int) {
+		t.Helper()
+		r, err := s.SidechainRecords(dbtx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.Headers != headers || r.CFilters != cfilters {
+			t.Errorf("%s: %d headers and %d cfilters, want %d and %d",
+				desc, r.Headers, r.CFilters, headers, cfilters)
+		}
+	}
+
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		headerData := makeHeaderDataSlice(block1Header, block2Header, staleHeader)
+		err := insertMainChainHeaders(s, dbtx, headerData, emptyFilters(3))
+		if err != nil {
+			return err
+		}
+		check(dbtx, "main chain", 0, 0)
+
+		err = s.Rollback(dbtx, 3)
+		if err != nil {
+			return err
+		}
+		headerData = makeHeaderDataSlice(block3Header, block4Header)
+		err = insertMainChainHeaders(s, dbtx, headerData, emptyFilters(2))
+		if err != nil {
+			return err
+		}
+		check(dbtx, "reorganized", 1, 1)
+
+		// Records above the max height are retained.
+		r, err := s.PruneSidechains(dbtx, 2)
+		if err != nil {
+			return err
+		}
+		if r.Headers != 0 || r.CFilters != 0 {
+			t.Errorf("pruned %+v above max height", r)
+		}
+		check(dbtx, "retained", 1, 1)
+
+		r, err = s.PruneSidechains(dbtx, 4)
+		if err != nil {
+			return err
+		}
+		if r.Headers != 1 || r.CFilters != 1 {
+			t.Errorf("pruned %+v, want 1 header and cfilter", r)
+		}
+		check(dbtx, "pruned", 0, 0)
+
+		staleHash := staleHeader.BlockHash()
+		if _, err := s.GetBlockHeader(dbtx, &staleHash); err == nil {
+			t.Errorf("stale header was not pruned")
+		}
+		tip, height := s.MainChainTip(dbtx)
+		if tip != block4Header.BlockHash() || height != 4 {
+			t.Errorf("main chain tip changed to %v at height %d", &tip, height)
+		}
+		for _, h := range makeHeaderDataSlice(block1Header, block2Header, block3Header) {
+			if _, err := s.GetBlockHeader(dbtx, &h.BlockHash); err != nil {
+				t.Errorf("main chain header pruned: %v", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
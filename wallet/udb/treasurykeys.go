@@ -0,0 +1,124 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"encoding/binary"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+var treasuryKeysBucketKey = []byte("treasurykeys")
+
+// MaxTreasuryKeyNameLen is the maximum length of a treasury key name.
+const MaxTreasuryKeyNameLen = 64
+
+// TreasuryKey describes an imported treasury spend signing key.  The private
+// key is encrypted by the private crypto key of the address manager, and is
+// not associated with any account or address.
+type TreasuryKey struct {
+	PubKey  []byte // compressed
+	Name    string
+	Created time.Time
+
+	encrypted []byte
+}
+
+// Encrypted returns the encrypted private key.
+func (k *TreasuryKey) Encrypted() []byte {
+	return k.encrypted
+}
+
+// Value format:
+//
+//	[0:8]   Created unix time (8 bytes)
+//	[8]     Name length (1 byte)
+//	[9:9+n] Name (n bytes)
+//	[9+n:]  Encrypted private key
+
+func serializeTreasuryKey(k *TreasuryKey) []byte {
+	v := make([]byte, 9+len(k.Name)+len(k.encrypted))
+	binary.LittleEndian.PutUint64(v, uint64(k.Created.Unix()))
+	v[8] = byte(len(k.Name))
+	copy(v[9:], k.Name)
+	copy(v[9+len(k.Name):], k.encrypted)
+	return v
+}
+
+func deserializeTreasuryKey(pubKey, v []byte) (*TreasuryKey, error) {
+	if len(v) < 9 || len(v) < 9+int(v[8]) {
+		return nil, errors.E(errors.IO, errors.Errorf("treasury key %x: "+
+			"short value", pubKey))
+	}
+	n := int(v[8])
+	return &TreasuryKey{
+		PubKey:    append([]byte(nil), pubKey...),
+		Name:      string(v[9 : 9+n]),
+		Created:   time.Unix(int64(binary.LittleEndian.Uint64(v)), 0),
+		encrypted: append([]byte(nil), v[9+n:]...),
+	}, nil
+}
+
+// PutTreasuryKey records a treasury key with its encrypted private key.
+// Returns errors.Exist if a key with the same public key is already recorded.
+func PutTreasuryKey(dbtx walletdb.ReadWriteTx, k *TreasuryKey, encrypted []byte) error {
+	if len(k.PubKey) != secp256k1.PubKeyBytesLenCompressed {
+		return errors.E(errors.Invalid, "treasury key must be a compressed public key")
+	}
+	if k.Name == "" || len(k.Name) > MaxTreasuryKeyNameLen {
+		return errors.E(errors.Invalid, errors.Errorf("treasury key name "+
+			"must be between 1 and %d bytes", MaxTreasuryKeyNameLen))
+	}
+	bucket := dbtx.ReadWriteBucket(treasuryKeysBucketKey)
+	if bucket.Get(k.PubKey) != nil {
+		return errors.E(errors.Exist, errors.Errorf("treasury key %x "+
+			"already imported", k.PubKey))
+	}
+	rec := *k
+	rec.encrypted = encrypted
+	if err := bucket.Put(k.PubKey, serializeTreasuryKey(&rec)); err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// TreasuryKeyFor returns the treasury key with a compressed public key.
+// Returns errors.NotExist if the key has not been imported.
+func TreasuryKeyFor(dbtx walletdb.ReadTx, pubKey []byte) (*TreasuryKey, error) {
+	v := dbtx.ReadBucket(treasuryKeysBucketKey).Get(pubKey)
+	if v == nil {
+		return nil, errors.E(errors.NotExist, errors.Errorf("no treasury "+
+			"key %x", pubKey))
+	}
+	return deserializeTreasuryKey(pubKey, v)
+}
+
+// DeleteTreasuryKey removes the treasury key with a compressed public key.
+// Returns errors.NotExist if the key has not been imported.
+func DeleteTreasuryKey(dbtx walletdb.ReadWriteTx, pubKey []byte) error {
+	bucket := dbtx.ReadWriteBucket(treasuryKeysBucketKey)
+	if bucket.Get(pubKey) == nil {
+		return errors.E(errors.NotExist, errors.Errorf("no treasury "+
+			"key %x", pubKey))
+	}
+	if err := bucket.Delete(pubKey); err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// ForEachTreasuryKey calls f with every treasury key, ordered by public key.
+func ForEachTreasuryKey(dbtx walletdb.ReadTx, f func(k *TreasuryKey) error) error {
+	return dbtx.ReadBucket(treasuryKeysBucketKey).ForEach(func(k, v []byte) error {
+		key, err := deserializeTreasuryKey(k, v)
+		if err != nil {
+			return err
+		}
+		return f(key)
+	})
+}
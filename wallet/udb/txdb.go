@@ -149,18 +149,25 @@ var (
 	bucketCFilters                = []byte("cf")
 	bucketTicketCommitments       = []byte("cmt")
 	bucketTicketCommitmentsUsp    = []byte("cmu")
+	bucketReceivedAddrs           = []byte("ra")
+	bucketReceivedAccounts        = []byte("racct")
 )
 
 // Root (namespace) bucket keys
 var (
-	rootCreateDate   = []byte("date")
-	rootVersion      = []byte("vers")
-	rootMinedBalance = []byte("bal")
-	rootTipBlock     = []byte("tip")
-	rootHaveCFilters = []byte("havecfilters")
-	rootLastTxsBlock = []byte("lasttxsblock")
-	rootVSPHostIndex = []byte("vsphostindex")
-	rootBirthState   = []byte("birthstate")
+	rootCreateDate          = []byte("date")
+	rootVersion             = []byte("vers")
+	rootMinedBalance        = []byte("bal")
+	rootTipBlock            = []byte("tip")
+	rootHaveCFilters        = []byte("havecfilters")
+	rootLastTxsBlock        = []byte("lasttxsblock")
+	rootVSPHostIndex        = []byte("vsphostindex")
+	rootBirthState          = []byte("birthstate")
+	rootMixedSpend          = []byte("mixedspend")
+	rootMaxFeeRate          = []byte("maxfeerate")
+	rootImportRescan        = []byte("importrescan")
+	rootDiscoveryCheckpoint = []byte("discoverycheckpoint")
+	rootCheckpointKey       = []byte("checkpointkey")
 )
 
 // The root bucket's mined balance k/v pair records the total balance for all
@@ -497,7 +504,7 @@ func existsBlockHeader(ns walletdb.ReadBucket, k []byte) []byte {
 // The record value is serialized as such:
 //
 //   [0:8]   Received time (8 bytes)
-//   [8:]    Serialized transaction (varies)
+//   [8:]    Serialized transaction (varies, omitted when pruned)
 
 func keyTxRecord(txHash *chainhash.Hash, block *Block) []byte {
 	k := make([]byte, 68)
@@ -550,6 +557,9 @@ func readRawTxRecordMsgTx(v []byte, msgTx *wire.MsgTx) error {
 	if len(v) < 8 {
 		return errors.E(errors.IO, errors.Errorf("tx record len %d", len(v)))
 	}
+	if txRecordPruned(v) {
+		return errors.E(errors.NotExist, "transaction has been pruned")
+	}
 	err := msgTx.Deserialize(bytes.NewReader(v[8:]))
 	if err != nil {
 		return errors.E(errors.IO, err)
@@ -562,6 +572,9 @@ func readRawTxRecord(txHash *chainhash.Hash, v []byte, rec *TxRecord) error {
 	if len(v) < 8 {
 		return errors.E(errors.IO, errors.Errorf("tx record len %d", len(v)))
 	}
+	if txRecordPruned(v) {
+		return errPrunedTx(txHash)
+	}
 	rec.Hash = *txHash
 	rec.Received = time.Unix(int64(byteOrder.Uint64(v)), 0)
 	err := rec.MsgTx.Deserialize(bytes.NewReader(v[8:]))
@@ -611,6 +624,9 @@ func fetchRawTxRecordPkScript(k, v []byte, index uint32, scrLoc uint32, scrLen u
 	if err != nil {
 		return nil, err
 	}
+	if txRecordPruned(v) {
+		return nil, errPrunedTx(&txHash)
+	}
 
 	// The script isn't stored (legacy credits). Deserialize the
 	// entire transaction.
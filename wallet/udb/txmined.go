@@ -233,9 +233,9 @@ func (s *Store) ExtendMainChain(ns walletdb.ReadWriteBucket, header *wire.BlockH
 
 	var err error
 	if approvesParent(header.VoteBits) {
-		err = stakeValidate(ns, currentTipHeight)
+		err = stakeValidate(ns, s.chainParams, currentTipHeight)
 	} else {
-		err = stakeInvalidate(ns, currentTipHeight)
+		err = stakeInvalidate(ns, s.chainParams, currentTipHeight)
 	}
 	if err != nil {
 		return err
@@ -391,6 +391,143 @@ func BirthState(dbtx walletdb.ReadTx) *BirthdayState {
 	}
 }
 
+// MixedSpendPolicy restricts the inputs of wallet-authored transactions to
+// outputs paying to a single account branch, typically the branch receiving
+// the outputs of mixed transactions.
+type MixedSpendPolicy struct {
+	Account uint32
+	Branch  uint32
+}
+
+// SetMixedSpendPolicy records the mixed spend policy in the database.  A nil
+// policy removes any existing policy.
+//
+// [0:4] Account (4 bytes)
+// [4:8] Branch (4 bytes)
+func SetMixedSpendPolicy(dbtx walletdb.ReadWriteTx, p *MixedSpendPolicy) error {
+	ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+	if p == nil {
+		return ns.Delete(rootMixedSpend)
+	}
+	v := make([]byte, 8)
+	byteOrder.PutUint32(v, p.Account)
+	byteOrder.PutUint32(v[4:], p.Branch)
+	return ns.Put(rootMixedSpend, v)
+}
+
+// FetchMixedSpendPolicy returns the recorded mixed spend policy, or nil if no
+// policy is set.
+func FetchMixedSpendPolicy(dbtx walletdb.ReadTx) *MixedSpendPolicy {
+	v := dbtx.ReadBucket(wtxmgrBucketKey).Get(rootMixedSpend)
+	if len(v) != 8 {
+		return nil
+	}
+	return &MixedSpendPolicy{
+		Account: byteOrder.Uint32(v),
+		Branch:  byteOrder.Uint32(v[4:]),
+	}
+}
+
+// SetMaxFeeRate records the maximum fee rate (per kB of serialized
+// transaction) of wallet-authored transactions.  A zero rate removes the
+// limit.
+//
+// [0:8] Fee rate in atoms/kB (8 bytes)
+func SetMaxFeeRate(dbtx walletdb.ReadWriteTx, feeRate dcrutil.Amount) error {
+	ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+	if feeRate == 0 {
+		return ns.Delete(rootMaxFeeRate)
+	}
+	v := make([]byte, 8)
+	byteOrder.PutUint64(v, uint64(feeRate))
+	return ns.Put(rootMaxFeeRate, v)
+}
+
+// FetchMaxFeeRate returns the recorded maximum fee rate, or zero if no limit
+// is set.
+func FetchMaxFeeRate(dbtx walletdb.ReadTx) dcrutil.Amount {
+	v := dbtx.ReadBucket(wtxmgrBucketKey).Get(rootMaxFeeRate)
+	if len(v) != 8 {
+		return 0
+	}
+	return dcrutil.Amount(byteOrder.Uint64(v))
+}
+
+// SetImportRescanHeight records the lowest main chain block height which must
+// be rescanned for transactions of imported keys and scripts.  A negative
+// height removes the record.
+//
+// [0:4] Height (4 bytes)
+func SetImportRescanHeight(dbtx walletdb.ReadWriteTx, height int32) error {
+	ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+	if height < 0 {
+		return ns.Delete(rootImportRescan)
+	}
+	v := make([]byte, 4)
+	byteOrder.PutUint32(v, uint32(height))
+	return ns.Put(rootImportRescan, v)
+}
+
+// FetchImportRescanHeight returns the recorded import rescan height, or -1 if
+// no imports await a rescan.
+func FetchImportRescanHeight(dbtx walletdb.ReadTx) int32 {
+	v := dbtx.ReadBucket(wtxmgrBucketKey).Get(rootImportRescan)
+	if len(v) != 4 {
+		return -1
+	}
+	return int32(byteOrder.Uint32(v))
+}
+
+// SetDiscoveryCheckpoint records the block of an imported wallet checkpoint.
+// Address usage in blocks before the checkpoint block is known from the
+// checkpoint and need not be discovered again.  A nil hash removes the record.
+//
+// [0:32] Block hash (32 bytes)
+func SetDiscoveryCheckpoint(dbtx walletdb.ReadWriteTx, hash *chainhash.Hash) error {
+	ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+	if hash == nil {
+		return ns.Delete(rootDiscoveryCheckpoint)
+	}
+	return ns.Put(rootDiscoveryCheckpoint, hash[:])
+}
+
+// FetchDiscoveryCheckpoint returns the recorded discovery checkpoint block, or
+// nil if no checkpoint was imported.
+func FetchDiscoveryCheckpoint(dbtx walletdb.ReadTx) *chainhash.Hash {
+	v := dbtx.ReadBucket(wtxmgrBucketKey).Get(rootDiscoveryCheckpoint)
+	if len(v) != chainhash.HashSize {
+		return nil
+	}
+	var hash chainhash.Hash
+	copy(hash[:], v)
+	return &hash
+}
+
+// PutCheckpointKey records the key authenticating wallet checkpoints of a coin
+// type, replacing any key recorded for another coin type.
+//
+// [0:4]  Coin type (4 bytes)
+// [4:36] Key (32 bytes)
+func PutCheckpointKey(dbtx walletdb.ReadWriteTx, coinType uint32, key []byte) error {
+	if len(key) != 32 {
+		return errors.E(errors.Invalid, "checkpoint key must be 32 bytes")
+	}
+	v := make([]byte, 36)
+	byteOrder.PutUint32(v, coinType)
+	copy(v[4:], key)
+	return dbtx.ReadWriteBucket(wtxmgrBucketKey).Put(rootCheckpointKey, v)
+}
+
+// FetchCheckpointKey returns the key authenticating wallet checkpoints and its
+// coin type, or a nil key if none is recorded.
+func FetchCheckpointKey(dbtx walletdb.ReadTx) (coinType uint32, key []byte) {
+	v := dbtx.ReadBucket(wtxmgrBucketKey).Get(rootCheckpointKey)
+	if len(v) != 36 {
+		return 0, nil
+	}
+	return byteOrder.Uint32(v), append([]byte(nil), v[4:]...)
+}
+
 // IsMissingMainChainCFilters returns whether all compact filters for main chain
 // blocks have been recorded to the database after the upgrade which began to
 // require them to extend the main chain.  If compact filters are missing, they
@@ -626,7 +763,7 @@ type BlockHeaderData struct {
 //
 // Stake validation or invalidation should only occur for the block at height
 // tip-1.
-func stakeValidate(ns walletdb.ReadWriteBucket, height int32) error {
+func stakeValidate(ns walletdb.ReadWriteBucket, params *chaincfg.Params, height int32) error {
 	k, v := existsBlockRecord(ns, height)
 	if v == nil {
 		return errors.E(errors.IO, errors.Errorf("missing block record for height %v", height))
@@ -690,6 +827,11 @@ func stakeValidate(ns walletdb.ReadWriteBucket, height int32) error {
 			if err != nil {
 				return err
 			}
+			err = adjustStakeValidatedReceived(ns, params, v, output,
+				dcrutil.Amount(output.Value))
+			if err != nil {
+				return err
+			}
 
 			creditOutPoint.Index = uint32(i)
 			err = putUnspent(ns, &creditOutPoint, &blockRec.Block)
@@ -762,7 +904,7 @@ func stakeValidate(ns walletdb.ReadWriteBucket, height int32) error {
 // tip-1.
 //
 // See stakeValidate (which performs the reverse operation) for more details.
-func stakeInvalidate(ns walletdb.ReadWriteBucket, height int32) error {
+func stakeInvalidate(ns walletdb.ReadWriteBucket, params *chaincfg.Params, height int32) error {
 	k, v := existsBlockRecord(ns, height)
 	if v == nil {
 		return errors.E(errors.IO, errors.Errorf("missing block record for height %v", height))
@@ -825,6 +967,11 @@ func stakeInvalidate(ns walletdb.ReadWriteBucket, height int32) error {
 			if err != nil {
 				return errors.E(errors.IO, err)
 			}
+			err = adjustStakeValidatedReceived(ns, params, v, output,
+				-dcrutil.Amount(output.Value))
+			if err != nil {
+				return err
+			}
 
 			unspentKey := canonicalOutPoint(txHash, uint32(i))
 			err = deleteRawUnspent(ns, unspentKey)
@@ -1180,6 +1327,11 @@ func (s *Store) moveMinedTx(ns walletdb.ReadWriteBucket, addrmgrNs walletdb.Read
 		if err != nil {
 			return err
 		}
+		err = adjustCreditReceived(ns, s.chainParams, rec.MsgTx.TxOut[i].Version,
+			pkScript, acct, amount)
+		if err != nil {
+			return err
+		}
 		err = putUnspent(ns, &cred.outPoint, &block.Block)
 		if err != nil {
 			return err
@@ -1353,7 +1505,7 @@ func (s *Store) InsertMinedTx(dbtx walletdb.ReadWriteTx, rec *TxRecord, blockHas
 	// from the unconfirmed set.  This also handles removing unconfirmed
 	// transaction spend chains if any other unconfirmed transactions spend
 	// outputs of the removed double spend.
-	err = s.removeDoubleSpends(ns, rec)
+	err = s.removeDoubleSpends(dbtx, rec)
 	if err != nil {
 		return err
 	}
@@ -1539,6 +1691,11 @@ func (s *Store) addCredit(ns walletdb.ReadWriteBucket, rec *TxRecord, block *Blo
 	if err != nil {
 		return false, err
 	}
+	err = adjustCreditReceived(ns, s.chainParams, scriptVersion, pkScript,
+		account, txOutAmt)
+	if err != nil {
+		return false, err
+	}
 
 	minedBalance, err := fetchMinedBalance(ns)
 	if err != nil {
@@ -1961,6 +2118,15 @@ func (s *Store) Rollback(dbtx walletdb.ReadWriteTx, height int32) error {
 					if err != nil {
 						return err
 					}
+					acct, err := s.fetchAccountForPkScript(addrmgrNs, v, nil, output.PkScript)
+					if err != nil {
+						return err
+					}
+					err = adjustCreditReceived(ns, s.chainParams, output.Version,
+						output.PkScript, acct, -dcrutil.Amount(output.Value))
+					if err != nil {
+						return err
+					}
 
 					// Check if this output is a multisignature
 					// P2SH output. If it is, access the value
@@ -2133,6 +2299,11 @@ func (s *Store) Rollback(dbtx walletdb.ReadWriteTx, height int32) error {
 				if err != nil {
 					return err
 				}
+				err = adjustCreditReceived(ns, s.chainParams, output.Version,
+					output.PkScript, acct, -amt)
+				if err != nil {
+					return err
+				}
 
 				credKey := existsRawUnspent(ns, outPointKey)
 				if credKey != nil {
@@ -2209,7 +2380,12 @@ func (s *Store) Rollback(dbtx walletdb.ReadWriteTx, height int32) error {
 
 			log.Debugf("Transaction %v spends a removed coinbase "+
 				"output -- removing as well", unminedRec.Hash)
-			err = s.RemoveUnconfirmed(ns, &unminedRec.MsgTx, &unminedRec.Hash)
+			var removed []chainhash.Hash
+			err = s.removeUnconfirmed(ns, &unminedRec.MsgTx, &unminedRec.Hash, &removed)
+			if err != nil {
+				return err
+			}
+			err = journalRemovedTxs(dbtx, removed)
 			if err != nil {
 				return err
 			}
@@ -2975,6 +3151,13 @@ func ticketMatured(params *chaincfg.Params, txHeight, curHeight int32) bool {
 	return txHeight >= 0 && curHeight-txHeight > int32(params.TicketMaturity)
 }
 
+// ticketExpired returns whether a ticket mined at txHeight has
+// reached ticket expiry in a chain with a tip height curHeight.
+func ticketExpired(params *chaincfg.Params, txHeight, curHeight int32) bool {
+	// Ticket maturity off-by-one extends to the expiry depth as well.
+	return txHeight >= 0 && curHeight-txHeight > int32(params.TicketMaturity)+int32(params.TicketExpiry)
+}
+
 func (s *Store) fastCreditPkScriptLookup(ns walletdb.ReadBucket, credKey []byte, unminedCredKey []byte) ([]byte, error) {
 	// It has to exists as a credit or an unmined credit.
 	// Look both of these up. If it doesn't, throw an
@@ -3593,6 +3776,24 @@ func (s *Store) balanceFullScan(dbtx walletdb.ReadTx, minConf int32, syncHeight
 
 		ab.LockedByTickets += it.amount
 		ab.Total += it.amount
+
+		// Mined tickets which have matured and not yet expired are live.
+		ticketHash := it.ck[:32]
+		if existsRawUnmined(ns, ticketHash) != nil {
+			continue
+		}
+		k, _ := latestTxRecord(ns, ticketHash)
+		if k == nil {
+			continue
+		}
+		var height int32
+		if err := readRawTxRecordBlockHeight(k, &height); err != nil {
+			return nil, err
+		}
+		if ticketMatured(s.chainParams, height, syncHeight) &&
+			!ticketExpired(s.chainParams, height, syncHeight) {
+			ab.LockedByLiveTickets += it.amount
+		}
 	}
 	it.close()
 
@@ -3605,6 +3806,7 @@ type Balances = struct {
 	ImmatureCoinbaseRewards dcrutil.Amount
 	ImmatureStakeGeneration dcrutil.Amount
 	LockedByTickets         dcrutil.Amount
+	LockedByLiveTickets     dcrutil.Amount
 	Spendable               dcrutil.Amount
 	Total                   dcrutil.Amount
 	VotingAuthority         dcrutil.Amount
@@ -0,0 +1,197 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"bytes"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/blockchain/stake/v5"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/wire"
+)
+
+// Pruned transaction records keep the received time of the record value, but
+// omit the serialized transaction.  The record key, and all credits and debits
+// of the transaction, are retained.
+
+// txRecordPruned returns whether a transaction record value has been pruned.
+func txRecordPruned(v []byte) bool {
+	return len(v) == 8
+}
+
+// errPrunedTx returns the error describing a read of a pruned transaction.
+func errPrunedTx(txHash *chainhash.Hash) error {
+	return errors.E(errors.NotExist, errors.Errorf("transaction %v "+
+		"has been pruned", txHash))
+}
+
+// PrunableTx identifies a mined transaction record which may be pruned.
+type PrunableTx struct {
+	Hash  chainhash.Hash
+	Block Block
+}
+
+// PrunableTransactions returns the mined regular transactions at or below
+// maxHeight when every wallet output they create has been spent by a mined
+// transaction.  The scan only reads the database, so it may be performed
+// outside of the update which prunes the transactions with PruneTransactions.
+func (s *Store) PrunableTransactions(dbtx walletdb.ReadTx, maxHeight int32) ([]PrunableTx, error) {
+	ns := dbtx.ReadBucket(wtxmgrBucketKey)
+
+	var prunable []PrunableTx
+	c := ns.NestedReadBucket(bucketTxRecords).ReadCursor()
+	defer c.Close()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if v == nil || txRecordPruned(v) {
+			continue
+		}
+		var height int32
+		err := readRawTxRecordBlockHeight(k, &height)
+		if err != nil {
+			return nil, err
+		}
+		if height > maxHeight {
+			continue
+		}
+		ok, err := prunableTxRecord(ns, k, v)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		var p PrunableTx
+		err = readRawTxRecordHash(k, &p.Hash)
+		if err == nil {
+			err = readRawTxRecordBlock(k, &p.Block)
+		}
+		if err != nil {
+			return nil, err
+		}
+		prunable = append(prunable, p)
+	}
+	return prunable, nil
+}
+
+// PruneTransactions removes the serialized transactions of transactions
+// returned by PrunableTransactions.  Each record is checked again before it is
+// pruned, and records which were removed or are no longer prunable are
+// skipped.  Credits, debits, and hashes of pruned transactions are retained,
+// so balances and spends are not affected.  Transaction queries by hash return
+// errors.NotExist, and RangeTransactions reports the details with Pruned set,
+// until the transactions are restored with RestorePrunedTransaction.  The
+// number of pruned transactions is returned.
+func (s *Store) PruneTransactions(dbtx walletdb.ReadWriteTx, txs []PrunableTx) (int, error) {
+	ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+
+	var n int
+	for i := range txs {
+		k := keyTxRecord(&txs[i].Hash, &txs[i].Block)
+		v := existsRawTxRecord(ns, k)
+		if v == nil || txRecordPruned(v) {
+			continue
+		}
+		ok, err := prunableTxRecord(ns, k, v)
+		if err != nil {
+			return 0, err
+		}
+		if !ok {
+			continue
+		}
+		err = putRawTxRecord(ns, k, append(v[:0:0], v[:8]...))
+		if err != nil {
+			return 0, err
+		}
+		n++
+	}
+	return n, nil
+}
+
+// prunableTxRecord returns whether a mined transaction record may be pruned.
+func prunableTxRecord(ns walletdb.ReadBucket, k, v []byte) (bool, error) {
+	var txHash chainhash.Hash
+	err := readRawTxRecordHash(k, &txHash)
+	if err != nil {
+		return false, err
+	}
+	var tx wire.MsgTx
+	err = readRawTxRecordMsgTx(v, &tx)
+	if err != nil {
+		return false, err
+	}
+	if stake.DetermineTxType(&tx) != stake.TxTypeRegular {
+		return false, nil
+	}
+	for i := range tx.TxOut {
+		if existsMultisigOut(ns, keyMultisigOut(txHash, uint32(i))) != nil {
+			return false, nil
+		}
+	}
+	credIter := makeReadCreditIterator(ns, k, DBVersion)
+	defer credIter.close()
+	for credIter.next() {
+		if !credIter.elem.Spent {
+			return false, nil
+		}
+	}
+	return credIter.err == nil, credIter.err
+}
+
+// PrunedTxBlock returns the block of the newest mined record of a transaction
+// whose serialized transaction has been pruned.  Errors with code NotExist are
+// returned for transactions which are not recorded as mined or have not been
+// pruned.
+func (s *Store) PrunedTxBlock(ns walletdb.ReadBucket, txHash *chainhash.Hash) (*Block, error) {
+	k, v := latestTxRecord(ns, txHash[:])
+	if v == nil {
+		return nil, errors.E(errors.NotExist, errors.Errorf("no mined "+
+			"transaction %v", txHash))
+	}
+	if !txRecordPruned(v) {
+		return nil, errors.E(errors.NotExist, errors.Errorf("transaction "+
+			"%v is not pruned", txHash))
+	}
+	block := new(Block)
+	err := readRawTxRecordBlock(k, block)
+	if err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+// RestorePrunedTransaction records the serialized transaction of a pruned
+// transaction record mined in a block.  The transaction must have been
+// refetched from the block it is recorded as being mined in.  Restoring a
+// transaction which is not pruned is a no-op.
+func (s *Store) RestorePrunedTransaction(dbtx walletdb.ReadWriteTx, tx *wire.MsgTx,
+	blockHash *chainhash.Hash) error {
+
+	ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+
+	txHash := tx.TxHash()
+	k, v := latestTxRecord(ns, txHash[:])
+	if v == nil {
+		return errors.E(errors.NotExist, errors.Errorf("no mined "+
+			"transaction %v", &txHash))
+	}
+	if !txRecordPruned(v) {
+		return nil
+	}
+	if !bytes.Equal(k[36:68], blockHash[:]) {
+		return errors.E(errors.Invalid, errors.Errorf("transaction %v "+
+			"is not recorded in block %v", &txHash, blockHash))
+	}
+
+	newV := make([]byte, 8, 8+tx.SerializeSize())
+	copy(newV, v)
+	buf := bytes.NewBuffer(newV)
+	err := tx.Serialize(buf)
+	if err != nil {
+		return errors.E(errors.Invalid, err)
+	}
+	return putRawTxRecord(ns, k, buf.Bytes())
+}
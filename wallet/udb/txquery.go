@@ -8,6 +8,7 @@ package udb
 import (
 	"bytes"
 	"context"
+	"time"
 
 	"decred.org/dcrwallet/v5/errors"
 	"decred.org/dcrwallet/v5/wallet/walletdb"
@@ -41,11 +42,16 @@ type DebitRecord struct {
 // TxDetails is intended to provide callers with access to rich details
 // regarding a relevant transaction and which inputs and outputs are credit or
 // debits.
+//
+// Details of pruned transactions set Pruned and record only the hash, received
+// time, block, credits, and debits.  The MsgTx of a pruned transaction is
+// empty and must not be indexed by the credit and debit indexes.
 type TxDetails struct {
 	TxRecord
 	Block   BlockMeta
 	Credits []CreditRecord
 	Debits  []DebitRecord
+	Pruned  bool
 }
 
 // Height returns the height of a transaction according to the BlockMeta.
@@ -345,6 +351,21 @@ func (s *Store) TxBlockHeight(dbtx walletdb.ReadTx, txHash *chainhash.Hash) (int
 	return height, err
 }
 
+// BlockTxHashes returns the hashes of the wallet transactions mined in the
+// main chain block at height.
+func (s *Store) BlockTxHashes(dbtx walletdb.ReadTx, height int32) ([]chainhash.Hash, error) {
+	ns := dbtx.ReadBucket(wtxmgrBucketKey)
+	k, v := existsBlockRecord(ns, height)
+	if v == nil {
+		return nil, nil
+	}
+	var br blockRecord
+	if err := readRawBlockRecord(k, v, &br); err != nil {
+		return nil, err
+	}
+	return br.transactions, nil
+}
+
 // rangeUnminedTransactions executes the function f with TxDetails for every
 // unmined transaction.  f is not executed if no unmined transactions exist.
 // Error returns from f (if any) are propigated to the caller.  Returns true
@@ -444,6 +465,14 @@ func (s *Store) rangeBlockTransactions(ctx context.Context, ns walletdb.ReadBuck
 					Time:  block.Time,
 				},
 			}
+			if txRecordPruned(v) {
+				err := readPrunedTxDetails(ns, &txHash, k, v, &detail)
+				if err != nil {
+					return false, err
+				}
+				details = append(details, detail)
+				continue
+			}
 			err := readRawTxRecord(&txHash, v, &detail.TxRecord)
 			if err != nil {
 				return false, err
@@ -503,6 +532,32 @@ func (s *Store) rangeBlockTransactions(ctx context.Context, ns walletdb.ReadBuck
 	return false, blockIter.err
 }
 
+// readPrunedTxDetails reads the details of a pruned mined transaction record.
+// Only regular transactions are pruned, and every credit of a pruned
+// transaction is spent by a mined transaction.
+func readPrunedTxDetails(ns walletdb.ReadBucket, txHash *chainhash.Hash, k, v []byte, detail *TxDetails) error {
+	detail.Hash = *txHash
+	detail.Received = time.Unix(int64(byteOrder.Uint64(v)), 0)
+	detail.TxType = stake.TxTypeRegular
+	detail.Pruned = true
+
+	credIter := makeReadCreditIterator(ns, k, DBVersion)
+	for credIter.next() {
+		detail.Credits = append(detail.Credits, credIter.elem)
+	}
+	credIter.close()
+	if credIter.err != nil {
+		return credIter.err
+	}
+
+	debIter := makeReadDebitIterator(ns, k)
+	defer debIter.close()
+	for debIter.next() {
+		detail.Debits = append(detail.Debits, debIter.elem)
+	}
+	return debIter.err
+}
+
 // RangeTransactions runs the function f on all transaction details between
 // blocks on the best chain over the height range [begin,end].  The special
 // height -1 may be used to also include unmined transactions.  If the end
@@ -513,6 +568,8 @@ func (s *Store) rangeBlockTransactions(ctx context.Context, ns walletdb.ReadBuck
 // caller.  Additionally, a boolean return value allows exiting the function
 // early without reading any additional transactions early when true.
 //
+// Mined transactions which have been pruned are included with Pruned set.
+//
 // All calls to f are guaranteed to be passed a slice with more than zero
 // elements.  The slice may be reused for multiple blocks, so it is not safe to
 // use it after the loop iteration it was acquired.
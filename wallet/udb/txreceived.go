@@ -0,0 +1,131 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/txscript/v4/stdscript"
+	"github.com/decred/dcrd/wire"
+)
+
+// The received address and account buckets record the total amount of all
+// mined credits paying each address and account, keyed by encoded address and
+// account number.  Totals are incremented when credits are recorded as mined
+// or stake validated, and decremented when credits are detached from the main
+// chain or stake invalidated.  Credits of pruned transactions remain counted.
+// Values are amounts serialized as uint64.
+
+// adjustReceived adds delta to the total recorded for a key of a received
+// totals bucket.  Nothing is recorded before the buckets are created by the
+// database upgrade which indexes all existing credits.
+func adjustReceived(ns walletdb.ReadWriteBucket, bucketKey, k []byte, delta dcrutil.Amount) error {
+	bucket := ns.NestedReadWriteBucket(bucketKey)
+	if bucket == nil {
+		return nil
+	}
+	var total dcrutil.Amount
+	if v := bucket.Get(k); len(v) == 8 {
+		total = dcrutil.Amount(byteOrder.Uint64(v))
+	}
+	// Totals never become negative, even if the index was built after
+	// some credits could no longer be read.
+	total = max(total+delta, 0)
+	var err error
+	if total == 0 {
+		err = bucket.Delete(k)
+	} else {
+		v := make([]byte, 8)
+		byteOrder.PutUint64(v, uint64(total))
+		err = bucket.Put(k, v)
+	}
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// adjustAddrsReceived adds delta to the received totals of every address paid
+// by an output script.  Non-standard scripts are ignored.
+func adjustAddrsReceived(ns walletdb.ReadWriteBucket, params stdaddr.AddressParams,
+	version uint16, pkScript []byte, delta dcrutil.Amount) error {
+
+	_, addrs := stdscript.ExtractAddrs(version, pkScript, params)
+	seen := make(map[string]struct{}, len(addrs))
+	for _, a := range addrs {
+		s := a.String()
+		if _, ok := seen[s]; ok {
+			continue
+		}
+		seen[s] = struct{}{}
+		err := adjustReceived(ns, bucketReceivedAddrs, []byte(s), delta)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// adjustAccountReceived adds delta to the received total of an account.
+func adjustAccountReceived(ns walletdb.ReadWriteBucket, account uint32, delta dcrutil.Amount) error {
+	return adjustReceived(ns, bucketReceivedAccounts, uint32ToBytes(account), delta)
+}
+
+// adjustCreditReceived adds delta to the received totals of the addresses and
+// account of a mined credit.
+func adjustCreditReceived(ns walletdb.ReadWriteBucket, params *chaincfg.Params,
+	version uint16, pkScript []byte, account uint32, delta dcrutil.Amount) error {
+
+	err := adjustAddrsReceived(ns, params, version, pkScript, delta)
+	if err != nil {
+		return err
+	}
+	return adjustAccountReceived(ns, account, delta)
+}
+
+func fetchReceived(ns walletdb.ReadBucket, bucketKey, k []byte) dcrutil.Amount {
+	v := ns.NestedReadBucket(bucketKey).Get(k)
+	if len(v) != 8 {
+		return 0
+	}
+	return dcrutil.Amount(byteOrder.Uint64(v))
+}
+
+// ReceivedByAddress returns the total amount of all mined credits paying an
+// address, including spent credits.  Credits of unmined transactions are not
+// included.
+func (s *Store) ReceivedByAddress(dbtx walletdb.ReadTx, addr stdaddr.Address) dcrutil.Amount {
+	ns := dbtx.ReadBucket(wtxmgrBucketKey)
+	return fetchReceived(ns, bucketReceivedAddrs, []byte(addr.String()))
+}
+
+// ReceivedByAccount returns the total amount of all mined credits paying an
+// account, including spent credits.  Credits of unmined transactions are not
+// included.
+func (s *Store) ReceivedByAccount(dbtx walletdb.ReadTx, account uint32) dcrutil.Amount {
+	ns := dbtx.ReadBucket(wtxmgrBucketKey)
+	return fetchReceived(ns, bucketReceivedAccounts, uint32ToBytes(account))
+}
+
+// adjustStakeValidatedReceived adds delta to the received totals of a credit
+// moved between the mined and stake invalidated credits buckets.
+func adjustStakeValidatedReceived(ns walletdb.ReadWriteBucket, params *chaincfg.Params,
+	credVal []byte, output *wire.TxOut, delta dcrutil.Amount) error {
+
+	err := adjustAddrsReceived(ns, params, output.Version, output.PkScript, delta)
+	if err != nil {
+		return err
+	}
+	// Credits recorded by old versions may not record an account, and
+	// do not contribute to account totals.
+	account, err := fetchRawCreditAccount(credVal)
+	if err != nil {
+		return nil
+	}
+	return adjustAccountReceived(ns, account, delta)
+}
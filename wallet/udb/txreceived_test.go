@@ -0,0 +1,135 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestReceivedTotals(t *testing.T) {
+	ctx := context.Background()
+	db, _, s, teardown, err := cloneDB(ctx, "received_totals.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(make([]byte, 20), s.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, pkScript := addr.PaymentScript()
+
+	g := makeBlockGenerator()
+	block1Header := g.generate(dcrutil.BlockValid)
+	block2Header := g.generate(dcrutil.BlockValid)
+	validatingGen := g
+	invalidatingHeader := g.generate(0)
+	validatingHeader := validatingGen.generate(dcrutil.BlockValid)
+
+	tx1 := wire.MsgTx{
+		TxOut: []*wire.TxOut{{Value: 2e8, PkScript: pkScript}},
+	}
+	tx2 := wire.MsgTx{
+		TxIn: []*wire.TxIn{{
+			PreviousOutPoint: wire.OutPoint{Hash: tx1.TxHash()},
+		}},
+		TxOut: []*wire.TxOut{{Value: 1e8, PkScript: pkScript}},
+	}
+	rec1, err := NewTxRecordFromMsgTx(&tx1, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec2, err := NewTxRecordFromMsgTx(&tx2, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	check := func(dbtx walletdb.ReadTx, desc string, want dcrutil.Amount) {
+		t.Helper()
+		if got := s.ReceivedByAddress(dbtx, addr); got != want {
+			t.Errorf("%s: address received %v, want %v", desc, got, want)
+		}
+		if got := s.ReceivedByAccount(dbtx, 0); got != want {
+			t.Errorf("%s: account received %v, want %v", desc, got, want)
+		}
+	}
+
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		// Unmined credits are not counted.
+		err := s.InsertMemPoolTx(dbtx, rec1)
+		if err != nil {
+			return err
+		}
+		err = s.AddCredit(dbtx, rec1, nil, 0, false, 0)
+		if err != nil {
+			return err
+		}
+		check(dbtx, "unmined", 0)
+
+		headerData := makeHeaderDataSlice(block1Header, block2Header)
+		err = insertMainChainHeaders(s, dbtx, headerData, emptyFilters(2))
+		if err != nil {
+			return err
+		}
+		err = s.InsertMinedTx(dbtx, rec1, &headerData[0].BlockHash)
+		if err != nil {
+			return err
+		}
+		check(dbtx, "mined unmined credit", 2e8)
+
+		// Spent credits remain counted, and credits are only counted
+		// once.
+		err = s.InsertMinedTx(dbtx, rec2, &headerData[1].BlockHash)
+		if err != nil {
+			return err
+		}
+		block2Meta := makeBlockMeta(block2Header)
+		for range 2 {
+			err = s.AddCredit(dbtx, rec2, block2Meta, 0, false, 0)
+			if err != nil {
+				return err
+			}
+		}
+		check(dbtx, "mined credit", 3e8)
+
+		// Stake invalidation of block 2 removes its credits, and stake
+		// validation by an alternate block restores them.
+		headerData = makeHeaderDataSlice(invalidatingHeader)
+		err = insertMainChainHeaders(s, dbtx, headerData, emptyFilters(1))
+		if err != nil {
+			return err
+		}
+		check(dbtx, "stake invalidated", 2e8)
+		err = s.Rollback(dbtx, 3)
+		if err != nil {
+			return err
+		}
+		headerData = makeHeaderDataSlice(validatingHeader)
+		err = insertMainChainHeaders(s, dbtx, headerData, emptyFilters(1))
+		if err != nil {
+			return err
+		}
+		check(dbtx, "stake validated", 3e8)
+
+		// Credits of detached blocks are removed.
+		err = s.Rollback(dbtx, 1)
+		if err != nil {
+			return err
+		}
+		check(dbtx, "rollback", 0)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
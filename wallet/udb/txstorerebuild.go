@@ -0,0 +1,137 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/dcrutil/v4"
+)
+
+// ReplaceTxStore replaces the transaction store of db with the transaction
+// store of empty, which must be a newly initialized database.  The address
+// manager and all other buckets of db are preserved.
+//
+// Settings recorded in the transaction store which are not rebuilt from the
+// blockchain, namely the birthday, the mixed spend policy, the maximum fee
+// rate, and the checkpoint key, are carried over from the replaced store when
+// they can be read.
+//
+// This recovers wallets whose transaction history is damaged but whose keys
+// are intact.  The replaced store only records the genesis block, and the
+// transaction history is rebuilt by rescanning the blockchain when the wallet
+// is next synced.  Both databases must be of the current version.
+func ReplaceTxStore(ctx context.Context, db, empty walletdb.DB) error {
+	checkVersion := func(dbtx walletdb.ReadTx) error {
+		metadataBucket := dbtx.ReadBucket(unifiedDBMetadata{}.rootBucketKey())
+		if metadataBucket == nil {
+			return errors.E(errors.NotExist, "database has not been initialized")
+		}
+		dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+		if err != nil {
+			return err
+		}
+		if dbVersion != DBVersion {
+			return errors.E(errors.Invalid, errors.Errorf("database version %d "+
+				"must be upgraded to version %d", dbVersion, DBVersion))
+		}
+		return nil
+	}
+
+	return walletdb.View(ctx, empty, func(emptytx walletdb.ReadTx) error {
+		if err := checkVersion(emptytx); err != nil {
+			return err
+		}
+		src := emptytx.ReadBucket(wtxmgrBucketKey)
+		if src == nil {
+			return errors.E(errors.NotExist, "missing transaction store")
+		}
+		return walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+			if err := checkVersion(dbtx); err != nil {
+				return err
+			}
+			var settings *txStoreSettings
+			if dbtx.ReadBucket(wtxmgrBucketKey) != nil {
+				settings = fetchTxStoreSettings(dbtx)
+				err := dbtx.DeleteTopLevelBucket(wtxmgrBucketKey)
+				if err != nil {
+					return errors.E(errors.IO, err)
+				}
+			}
+			dst, err := dbtx.CreateTopLevelBucket(wtxmgrBucketKey)
+			if err != nil {
+				return errors.E(errors.IO, err)
+			}
+			if err := copyBucket(dst, src); err != nil {
+				return err
+			}
+			if settings != nil {
+				return settings.put(dbtx)
+			}
+			return nil
+		})
+	})
+}
+
+// txStoreSettings are the settings of a transaction store which are preserved
+// when the store is replaced.
+type txStoreSettings struct {
+	birthState     *BirthdayState
+	mixedSpend     *MixedSpendPolicy
+	maxFeeRate     dcrutil.Amount
+	checkpointCoin uint32
+	checkpointKey  []byte
+}
+
+func fetchTxStoreSettings(dbtx walletdb.ReadTx) *txStoreSettings {
+	s := &txStoreSettings{
+		birthState: BirthState(dbtx),
+		mixedSpend: FetchMixedSpendPolicy(dbtx),
+		maxFeeRate: FetchMaxFeeRate(dbtx),
+	}
+	s.checkpointCoin, s.checkpointKey = FetchCheckpointKey(dbtx)
+	return s
+}
+
+func (s *txStoreSettings) put(dbtx walletdb.ReadWriteTx) error {
+	if s.birthState != nil {
+		if err := SetBirthState(dbtx, s.birthState); err != nil {
+			return errors.E(errors.IO, err)
+		}
+	}
+	if err := SetMixedSpendPolicy(dbtx, s.mixedSpend); err != nil {
+		return errors.E(errors.IO, err)
+	}
+	if err := SetMaxFeeRate(dbtx, s.maxFeeRate); err != nil {
+		return errors.E(errors.IO, err)
+	}
+	if s.checkpointKey != nil {
+		err := PutCheckpointKey(dbtx, s.checkpointCoin, s.checkpointKey)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyBucket recursively copies the key/value pairs and nested buckets of src
+// to dst.
+func copyBucket(dst walletdb.ReadWriteBucket, src walletdb.ReadBucket) error {
+	return src.ForEach(func(k, v []byte) error {
+		if v != nil {
+			if err := dst.Put(k, v); err != nil {
+				return errors.E(errors.IO, err)
+			}
+			return nil
+		}
+		nested, err := dst.CreateBucket(k)
+		if err != nil {
+			return errors.E(errors.IO, err)
+		}
+		return copyBucket(nested, src.NestedReadBucket(k))
+	})
+}
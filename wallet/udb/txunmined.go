@@ -69,7 +69,12 @@ func (s *Store) InsertMemPoolTx(dbtx walletdb.ReadWriteTx, rec *TxRecord) error
 					return errors.E(errors.DoubleSpend, err)
 				}
 
-				err = s.RemoveUnconfirmed(ns, &spenderTx, &spenderHash)
+				var removed []chainhash.Hash
+				err = s.removeUnconfirmed(ns, &spenderTx, &spenderHash, &removed)
+				if err != nil {
+					return err
+				}
+				err = journalRemovedTxs(dbtx, removed)
 				if err != nil {
 					return err
 				}
@@ -144,8 +149,11 @@ func (s *Store) SetPublished(dbtx walletdb.ReadWriteTx, txHash *chainhash.Hash,
 // removeDoubleSpends checks for any unmined transactions which would introduce
 // a double spend if tx was added to the store (either as a confirmed or unmined
 // transaction).  Each conflicting transaction and all transactions which spend
-// it are recursively removed.
-func (s *Store) removeDoubleSpends(ns walletdb.ReadWriteBucket, rec *TxRecord) error {
+// it are recursively removed, and their removal is recorded in the event
+// journal.
+func (s *Store) removeDoubleSpends(dbtx walletdb.ReadWriteTx, rec *TxRecord) error {
+	ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+	var removed []chainhash.Hash
 	for _, input := range rec.MsgTx.TxIn {
 		prevOut := &input.PreviousOutPoint
 		prevOutKey := canonicalOutPoint(&prevOut.Hash, prevOut.Index)
@@ -162,13 +170,14 @@ func (s *Store) removeDoubleSpends(ns walletdb.ReadWriteBucket, rec *TxRecord) e
 
 			log.Debugf("Removing double spending transaction %v",
 				doubleSpend.Hash)
-			err = s.RemoveUnconfirmed(ns, &doubleSpend.MsgTx, &doubleSpend.Hash)
+			err = s.removeUnconfirmed(ns, &doubleSpend.MsgTx,
+				&doubleSpend.Hash, &removed)
 			if err != nil {
 				return err
 			}
 		}
 	}
-	return nil
+	return journalRemovedTxs(dbtx, removed)
 }
 
 // RemoveUnconfirmed removes an unmined transaction record and all spend chains
@@ -178,6 +187,14 @@ func (s *Store) removeDoubleSpends(ns walletdb.ReadWriteBucket, rec *TxRecord) e
 // can also be used to remove old tickets that do not meet the network difficulty
 // and expired transactions.
 func (s *Store) RemoveUnconfirmed(ns walletdb.ReadWriteBucket, tx *wire.MsgTx, txHash *chainhash.Hash) error {
+	return s.removeUnconfirmed(ns, tx, txHash, nil)
+}
+
+// removeUnconfirmed removes an unmined transaction record and all spend chains
+// deriving from it.  The hashes of all removed transactions are appended to
+// removed when it is non-nil.
+func (s *Store) removeUnconfirmed(ns walletdb.ReadWriteBucket, tx *wire.MsgTx,
+	txHash *chainhash.Hash, removed *[]chainhash.Hash) error {
 
 	stxType := stake.DetermineTxType(tx)
 
@@ -199,7 +216,7 @@ func (s *Store) RemoveUnconfirmed(ns walletdb.ReadWriteBucket, tx *wire.MsgTx, t
 
 			log.Debugf("Transaction %v is part of a removed conflict "+
 				"chain -- removing as well", spender.Hash)
-			err = s.RemoveUnconfirmed(ns, &spender.MsgTx, &spender.Hash)
+			err = s.removeUnconfirmed(ns, &spender.MsgTx, &spender.Hash, removed)
 			if err != nil {
 				return err
 			}
@@ -275,7 +292,14 @@ func (s *Store) RemoveUnconfirmed(ns walletdb.ReadWriteBucket, tx *wire.MsgTx, t
 		return err
 	}
 
-	return deleteRawUnmined(ns, txHash[:])
+	err = deleteRawUnmined(ns, txHash[:])
+	if err != nil {
+		return err
+	}
+	if removed != nil {
+		*removed = append(*removed, *txHash)
+	}
+	return nil
 }
 
 // UnminedTxs returns the transaction records for all unmined transactions
@@ -333,6 +357,30 @@ func (s *Store) unminedTxHashes(ns walletdb.ReadBucket) ([]*chainhash.Hash, erro
 	return hashes, err
 }
 
+// ExpiredUnminedTxDetails returns the details of each unmined transaction which
+// can not be mined in blocks after height due to its expiry.  These are removed
+// by PruneUnmined once height is the main chain tip.
+func (s *Store) ExpiredUnminedTxDetails(ns walletdb.ReadBucket, height int32) ([]*TxDetails, error) {
+	var expired []*TxDetails
+	err := ns.NestedReadBucket(bucketUnmined).ForEach(func(k, v []byte) error {
+		var txHash chainhash.Hash
+		err := readRawUnminedHash(k, &txHash)
+		if err != nil {
+			return err
+		}
+		details, err := s.unminedTxDetails(ns, &txHash, v)
+		if err != nil {
+			return err
+		}
+		expiry := details.MsgTx.Expiry
+		if expiry != wire.NoExpiryValue && expiry <= uint32(height) {
+			expired = append(expired, details)
+		}
+		return nil
+	})
+	return expired, err
+}
+
 // PruneUnmined removes unmined transactions that no longer belong in the
 // unmined tx set.  This includes:
 //
@@ -5,6 +5,7 @@
 package udb
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 
@@ -207,10 +208,119 @@ const (
 	// the genesis block.
 	birthBlockVersion = 26
 
+	// accountUUIDVersion is the 27th version of the database.  It adds
+	// buckets recording a random UUID for every account, and the reverse
+	// index, and assigns UUIDs to all existing accounts.
+	accountUUIDVersion = 27
+
+	// confTargetsVersion is the 28th version of the database.  It adds a
+	// top level bucket recording transaction confirmation targets which
+	// have not yet been reached and notified.
+	confTargetsVersion = 28
+
+	// scriptIndexVersion is the 29th version of the database.  It adds an
+	// address manager index mapping the output scripts paying to each
+	// recorded address to the address account.
+	scriptIndexVersion = 29
+
+	// pendingSpendsVersion is the 30th version of the database.  It adds a
+	// top level bucket recording unsigned transactions proposed to be
+	// signed and published after approval.
+	pendingSpendsVersion = 30
+
+	// eventJournalVersion is the 31st version of the database.  It adds a
+	// top level bucket recording wallet transaction and main chain events
+	// with sequence numbers for clients to resume from.
+	eventJournalVersion = 31
+
+	// vspFeeRetryVersion is the 32nd version of the database.  It adds a
+	// top level bucket recording VSP fee payments which failed and are
+	// scheduled to be retried.
+	vspFeeRetryVersion = 32
+
+	// accountBlindedIDVersion is the 33rd version of the database.  It adds
+	// a random key for blinding account extended public keys, and buckets
+	// recording the blinded ID of every account and the reverse index.
+	accountBlindedIDVersion = 33
+
+	// expiringAddrsVersion is the 34th version of the database.  It adds a
+	// top level bucket recording external addresses issued with an expiry
+	// time or height.
+	expiringAddrsVersion = 34
+
+	// receivedTotalsVersion is the 35th version of the database.  It adds
+	// buckets to the transaction store recording the total amount of mined
+	// credits received by each address and account.
+	receivedTotalsVersion = 35
+
+	// pubKeyOutputsVersion is the 36th version of the database.  Wallet
+	// syncs previously did not watch for outputs paying directly to the
+	// public keys of wallet addresses, and blocks containing only these
+	// outputs were never fetched.  The upgrade moves the processed
+	// transactions block marker back to the wallet birthday, causing a
+	// rescan on next startup to record any missed P2PK outputs.
+	pubKeyOutputsVersion = 36
+
+	// paymentRequestsVersion is the 37th version of the database.  It adds
+	// a top level bucket recording the payment request URIs issued for
+	// external addresses.
+	paymentRequestsVersion = 37
+
+	// accountActivityVersion is the 38th version of the database.  It adds
+	// a top level bucket recording the hourly rates at which the addresses
+	// of each account are issued and used.
+	accountActivityVersion = 38
+
+	// idempotencyKeysVersion is the 39th version of the database.  It adds a
+	// top level bucket mapping client-provided idempotency keys of send and
+	// ticket purchase requests to the hashes of the transactions they created.
+	idempotencyKeysVersion = 39
+
+	// destinationPoliciesVersion is the 40th version of the database.  It
+	// adds a top level bucket recording the allowed and denied payment
+	// destinations of accounts.
+	destinationPoliciesVersion = 40
+
+	// invoicesVersion is the 41st version of the database.  It adds a top
+	// level bucket recording invoices and their reconciliation with payments
+	// received by their addresses.
+	invoicesVersion = 41
+
+	// treasuryKeysVersion is the 42nd version of the database.  It adds a
+	// top level bucket recording imported treasury spend signing keys.
+	treasuryKeysVersion = 42
+
+	// holdsVersion is the 43rd version of the database.  It adds a top level
+	// bucket recording holds placed on unspent outputs.
+	holdsVersion = 43
+
+	// fundsReservationsVersion is the 44th version of the database.  It adds
+	// a top level bucket recording outputs reserved to fund external orders.
+	fundsReservationsVersion = 44
+
+	// duressDecoyVersion is the 45th version of the database.  It records a
+	// decoy duress record in wallets without a duress passphrase, so the
+	// record does not reveal whether one is configured.  Duress records of
+	// the previous format, which recorded the duress account in cleartext,
+	// are replaced by decoys.  Wallets created at this version record a
+	// decoy when the address manager is created.
+	duressDecoyVersion = 45
+
+	// externalSignerVersion is the 46th version of the database.  It enables
+	// importing external signer accounts, a new account type describing the
+	// hardware device holding the account private keys.  The new type is not
+	// recognized by previous wallet versions.  This version only updates the
+	// db version number so that previous versions will error on startup.
+	externalSignerVersion = 46
+
+	// votePolicyNoncesVersion is the 47th version of the database.  It adds a
+	// top level bucket recording the nonces of imported vote policies.
+	votePolicyNoncesVersion = 47
+
 	// DBVersion is the latest version of the database that is understood by the
 	// program.  Databases with recorded versions higher than this will fail to
 	// open (meaning any upgrades prevent reverting to older software).
-	DBVersion = birthBlockVersion
+	DBVersion = votePolicyNoncesVersion
 )
 
 // upgrades maps between old database versions and the upgrade function to
@@ -242,6 +352,27 @@ var upgrades = [...]func(walletdb.ReadWriteTx, []byte, *chaincfg.Params) error{
 	vspTreasuryPoliciesVersion - 1:        vspTreasuryPoliciesUpgrade,
 	importVotingAccountVersion - 1:        importVotingAccountUpgrade,
 	birthBlockVersion - 1:                 birthBlockUpgrade,
+	accountUUIDVersion - 1:                accountUUIDUpgrade,
+	confTargetsVersion - 1:                confTargetsUpgrade,
+	scriptIndexVersion - 1:                scriptIndexUpgrade,
+	pendingSpendsVersion - 1:              pendingSpendsUpgrade,
+	eventJournalVersion - 1:               eventJournalUpgrade,
+	vspFeeRetryVersion - 1:                vspFeeRetryUpgrade,
+	accountBlindedIDVersion - 1:           accountBlindedIDUpgrade,
+	expiringAddrsVersion - 1:              expiringAddrsUpgrade,
+	receivedTotalsVersion - 1:             receivedTotalsUpgrade,
+	pubKeyOutputsVersion - 1:              pubKeyOutputsUpgrade,
+	paymentRequestsVersion - 1:            paymentRequestsUpgrade,
+	accountActivityVersion - 1:            accountActivityUpgrade,
+	idempotencyKeysVersion - 1:            idempotencyKeysUpgrade,
+	destinationPoliciesVersion - 1:        destinationPoliciesUpgrade,
+	invoicesVersion - 1:                   invoicesUpgrade,
+	treasuryKeysVersion - 1:               treasuryKeysUpgrade,
+	holdsVersion - 1:                      holdsUpgrade,
+	fundsReservationsVersion - 1:          fundsReservationsUpgrade,
+	duressDecoyVersion - 1:                duressDecoyUpgrade,
+	externalSignerVersion - 1:             externalSignerUpgrade,
+	votePolicyNoncesVersion - 1:           votePolicyNoncesUpgrade,
 }
 
 func lastUsedAddressIndexUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
@@ -289,7 +420,7 @@ func lastUsedAddressIndexUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byt
 	if err != nil {
 		return errors.E(errors.Crypto, errors.Errorf("decrypt public crypto key: %v", err))
 	}
-	cryptoPubKey := &cryptoKey{snacl.CryptoKey{}}
+	cryptoPubKey := &cryptoKey{CryptoKey: snacl.CryptoKey{}}
 	copy(cryptoPubKey.CryptoKey[:], cryptoPubKeyCT)
 
 	// Determine how many BIP0044 accounts have been created.  Each of these
@@ -1155,7 +1286,7 @@ func unencryptedRedeemScriptsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase [
 	if err != nil {
 		return errors.E(errors.Crypto, errors.Errorf("decrypt public crypto key: %v", err))
 	}
-	cryptoPubKey := &cryptoKey{snacl.CryptoKey{}}
+	cryptoPubKey := &cryptoKey{CryptoKey: snacl.CryptoKey{}}
 	copy(cryptoPubKey.CryptoKey[:], cryptoPubKeyCT)
 
 	// Read all unencrypted redeem scripts saved in the transaction store
@@ -1688,6 +1819,811 @@ func birthBlockUpgrade(tx walletdb.ReadWriteTx, _ []byte, params *chaincfg.Param
 	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
 }
 
+func accountUUIDUpgrade(tx walletdb.ReadWriteTx, _ []byte, params *chaincfg.Params) error {
+	const oldVersion = 26
+	const newVersion = 27
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	addrmgrBucket := tx.ReadWriteBucket(waddrmgrBucketKey)
+
+	// Assert that this function is only called on version 26 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "accountUUIDUpgrade inappropriately called")
+	}
+
+	// Create the UUID buckets and assign a UUID to every account.
+	_, err = addrmgrBucket.CreateBucket(acctUUIDBucketName)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	_, err = addrmgrBucket.CreateBucket(acctUUIDIdxBucketName)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	var accounts []uint32
+	err = forEachAccount(addrmgrBucket, func(account uint32) error {
+		accounts = append(accounts, account)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, account := range accounts {
+		if err := putNewAccountUUID(addrmgrBucket, nil, account); err != nil {
+			return err
+		}
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func confTargetsUpgrade(tx walletdb.ReadWriteTx, _ []byte, params *chaincfg.Params) error {
+	const oldVersion = 27
+	const newVersion = 28
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+
+	// Assert that this function is only called on version 27 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "confTargetsUpgrade inappropriately called")
+	}
+
+	// Create the confirmation targets bucket.
+	_, err = tx.CreateTopLevelBucket(confTargetsBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+// upgradeCryptoKeyPub opens the crypto key used to encrypt public data of the
+// address manager with the public passphrase.
+func upgradeCryptoKeyPub(addrmgrBucket walletdb.ReadBucket, publicPassphrase []byte) (*cryptoKey, error) {
+	masterKeyPubParams, _, err := fetchMasterKeyParams(addrmgrBucket)
+	if err != nil {
+		return nil, err
+	}
+	var masterKeyPub snacl.SecretKey
+	err = masterKeyPub.Unmarshal(masterKeyPubParams)
+	if err != nil {
+		return nil, errors.E(errors.IO, errors.Errorf("unmarshal master pubkey params: %v", err))
+	}
+	err = masterKeyPub.DeriveKey(&publicPassphrase)
+	if err != nil {
+		return nil, errors.E(errors.Passphrase, "incorrect public passphrase")
+	}
+	cryptoPubKeyEnc, _, err := fetchCryptoKeys(addrmgrBucket)
+	if err != nil {
+		return nil, err
+	}
+	cryptoPubKeyCT, err := masterKeyPub.Decrypt(cryptoPubKeyEnc)
+	if err != nil {
+		return nil, errors.E(errors.Crypto, errors.Errorf("decrypt public crypto key: %v", err))
+	}
+	cryptoPubKey := &cryptoKey{CryptoKey: snacl.CryptoKey{}}
+	copy(cryptoPubKey.CryptoKey[:], cryptoPubKeyCT)
+	return cryptoPubKey, nil
+}
+
+func scriptIndexUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 28
+	const newVersion = 29
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	addrmgrBucket := tx.ReadWriteBucket(waddrmgrBucketKey)
+
+	// Assert that this function is only called on version 28 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "scriptIndexUpgrade inappropriately called")
+	}
+
+	// Open the encrypted public data crypto key, which is needed to
+	// recover the hash160 of each imported address and the extended
+	// public keys of each account.
+	cryptoPubKey, err := upgradeCryptoKeyPub(addrmgrBucket, publicPassphrase)
+	if err != nil {
+		return err
+	}
+
+	// Read every recorded address row.
+	var addrHashes [][]byte
+	addressBucket := addrmgrBucket.NestedReadBucket(addrBucketName)
+	cursor := addressBucket.ReadCursor()
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		if v == nil {
+			continue // skip nested buckets
+		}
+		addrHashes = append(addrHashes, append(k[:0:0], k...))
+	}
+	cursor.Close()
+
+	_, err = addrmgrBucket.CreateBucket(scriptIdxBucketName)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Recover the hash160 of each address and index it.  The account branch
+	// extended public keys are cached as chained addresses are derived.
+	type accountBranch struct{ account, branch uint32 }
+	branchXpubs := make(map[accountBranch]*hdkeychain.ExtendedKey)
+	branchXpub := func(account, branch uint32) (*hdkeychain.ExtendedKey, error) {
+		if xpub, ok := branchXpubs[accountBranch{account, branch}]; ok {
+			return xpub, nil
+		}
+		a, err := fetchDBAccount(addrmgrBucket, account, oldVersion)
+		if err != nil {
+			return nil, err
+		}
+		row, ok := a.(*dbBIP0044Account)
+		if !ok {
+			return nil, errors.E(errors.IO, errors.Errorf("account %d "+
+				"has unexpected row type %T", account, a))
+		}
+		serializedKeyPub, err := cryptoPubKey.Decrypt(row.pubKeyEncrypted)
+		if err != nil {
+			return nil, errors.E(errors.Crypto, errors.Errorf("decrypt extended pubkey: %v", err))
+		}
+		xpub, err := hdkeychain.NewKeyFromString(string(serializedKeyPub), params)
+		if err != nil {
+			return nil, errors.E(errors.IO, err)
+		}
+		xpub, err = xpub.Child(branch)
+		if err != nil {
+			return nil, err
+		}
+		branchXpubs[accountBranch{account, branch}] = xpub
+		return xpub, nil
+	}
+	for _, addrHash := range addrHashes {
+		row, err := fetchAddressByHash(addrmgrBucket, addrHash)
+		if err != nil {
+			return err
+		}
+		var p2sh bool
+		var hash160 []byte
+		var account uint32
+		switch row := row.(type) {
+		case *dbChainAddressRow:
+			xpub, err := branchXpub(row.account, row.branch)
+			if err != nil {
+				return err
+			}
+			child, err := xpub.Child(row.index)
+			if err != nil {
+				return err
+			}
+			hash160 = dcrutil.Hash160(child.SerializedPubKey())
+			account = row.account
+		case *dbImportedAddressRow:
+			pubKey, err := cryptoPubKey.Decrypt(row.encryptedPubKey)
+			if err != nil {
+				return errors.E(errors.Crypto, errors.Errorf("decrypt imported pubkey: %v", err))
+			}
+			hash160 = dcrutil.Hash160(pubKey)
+			account = row.account
+		case *dbScriptAddressRow:
+			hash160, err = cryptoPubKey.Decrypt(row.encryptedHash)
+			if err != nil {
+				return errors.E(errors.Crypto, errors.Errorf("decrypt imported P2SH address: %v", err))
+			}
+			p2sh = true
+			account = row.account
+		}
+		if sha := sha256.Sum256(hash160); !bytes.Equal(sha[:], addrHash) {
+			return errors.E(errors.IO, errors.Errorf("address with "+
+				"hash %x recovered with mismatched hash160 %x",
+				addrHash, hash160))
+		}
+		err = putScriptIndex(addrmgrBucket, p2sh, hash160, account)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func pendingSpendsUpgrade(tx walletdb.ReadWriteTx, _ []byte, params *chaincfg.Params) error {
+	const oldVersion = 29
+	const newVersion = 30
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+
+	// Assert that this function is only called on version 29 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "pendingSpendsUpgrade inappropriately called")
+	}
+
+	// Create the pending spends bucket.
+	_, err = tx.CreateTopLevelBucket(pendingSpendsBucketKey)
+	if err != nil {
+		return err
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func eventJournalUpgrade(tx walletdb.ReadWriteTx, _ []byte, params *chaincfg.Params) error {
+	const oldVersion = 30
+	const newVersion = 31
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+
+	// Assert that this function is only called on version 30 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "eventJournalUpgrade inappropriately called")
+	}
+
+	// Create the event journal bucket.
+	_, err = tx.CreateTopLevelBucket(eventJournalBucketKey)
+	if err != nil {
+		return err
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func vspFeeRetryUpgrade(tx walletdb.ReadWriteTx, _ []byte, params *chaincfg.Params) error {
+	const oldVersion = 31
+	const newVersion = 32
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+
+	// Assert that this function is only called on version 31 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "vspFeeRetryUpgrade inappropriately called")
+	}
+
+	// Create the VSP fee retry bucket.
+	_, err = tx.CreateTopLevelBucket(vspFeeRetryBucketKey)
+	if err != nil {
+		return err
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func accountBlindedIDUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 32
+	const newVersion = 33
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	addrmgrBucket := tx.ReadWriteBucket(waddrmgrBucketKey)
+
+	// Assert that this function is only called on version 32 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "accountBlindedIDUpgrade inappropriately called")
+	}
+
+	// The public crypto key is needed to recover the extended public key
+	// of each account.
+	cryptoPubKey, err := upgradeCryptoKeyPub(addrmgrBucket, publicPassphrase)
+	if err != nil {
+		return err
+	}
+
+	// Create the blinding key and blinded ID buckets, and index the
+	// blinded ID of every account with an extended public key.
+	err = putNewAccountBlindedIDKey(addrmgrBucket, nil)
+	if err != nil {
+		return err
+	}
+	_, err = addrmgrBucket.CreateBucket(acctBlindedIDBucketName)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	_, err = addrmgrBucket.CreateBucket(acctBlindedIDIdxBucketName)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	var accounts []uint32
+	err = forEachAccount(addrmgrBucket, func(account uint32) error {
+		if account != ImportedAddrAccount {
+			accounts = append(accounts, account)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, account := range accounts {
+		a, err := fetchDBAccount(addrmgrBucket, account, oldVersion)
+		if err != nil {
+			return err
+		}
+		row, ok := a.(*dbBIP0044Account)
+		if !ok {
+			return errors.E(errors.IO, errors.Errorf("account %d "+
+				"has unexpected row type %T", account, a))
+		}
+		xpub, err := cryptoPubKey.Decrypt(row.pubKeyEncrypted)
+		if err != nil {
+			return errors.E(errors.Crypto, errors.Errorf("decrypt extended pubkey: %v", err))
+		}
+		err = putAccountBlindedID(addrmgrBucket, account, string(xpub))
+		if err != nil {
+			return err
+		}
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func expiringAddrsUpgrade(tx walletdb.ReadWriteTx, _ []byte, params *chaincfg.Params) error {
+	const oldVersion = 33
+	const newVersion = 34
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+
+	// Assert that this function is only called on version 33 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "expiringAddrsUpgrade inappropriately called")
+	}
+
+	// Create the expiring addresses bucket.
+	_, err = tx.CreateTopLevelBucket(expiringAddrsBucketKey)
+	if err != nil {
+		return err
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func receivedTotalsUpgrade(tx walletdb.ReadWriteTx, _ []byte, params *chaincfg.Params) error {
+	const oldVersion = 34
+	const newVersion = 35
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	addrmgrBucket := tx.ReadBucket(waddrmgrBucketKey)
+	txmgrBucket := tx.ReadWriteBucket(wtxmgrBucketKey)
+
+	// Assert that this function is only called on version 34 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "receivedTotalsUpgrade inappropriately called")
+	}
+
+	// Create the received totals buckets.
+	_, err = txmgrBucket.CreateBucket(bucketReceivedAddrs)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	_, err = txmgrBucket.CreateBucket(bucketReceivedAccounts)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Add every mined credit to the totals.  Credits of pruned
+	// transactions can not be attributed to addresses and are skipped.
+	type receivedCredit struct {
+		pkScript []byte
+		amount   dcrutil.Amount
+		account  uint32
+		haveAcct bool
+	}
+	var credits []receivedCredit
+	err = txmgrBucket.NestedReadBucket(bucketCredits).ForEach(func(k, v []byte) error {
+		recKey := extractRawCreditTxRecordKey(k)
+		recVal := existsRawTxRecord(txmgrBucket, recKey)
+		if recVal == nil || txRecordPruned(recVal) {
+			return nil
+		}
+		pkScript, err := fetchRawTxRecordPkScript(recKey, recVal,
+			extractRawCreditIndex(k), fetchRawCreditScriptOffset(v),
+			fetchRawCreditScriptLength(v))
+		if err != nil {
+			return err
+		}
+		amount, err := fetchRawCreditAmount(v)
+		if err != nil {
+			return err
+		}
+		c := receivedCredit{pkScript: append([]byte(nil), pkScript...), amount: amount}
+		c.account, err = fetchRawCreditAccount(v)
+		if err != nil {
+			_, addrs := stdscript.ExtractAddrs(scriptVersionAssumed, pkScript, params)
+			if len(addrs) == 0 {
+				return nil
+			}
+			id, err := addressID(normalizeAddress(addrs[0]))
+			if err != nil {
+				return errors.E(errors.Bug, err)
+			}
+			c.account, err = fetchAddrAccount(addrmgrBucket, id)
+			c.haveAcct = err == nil
+		} else {
+			c.haveAcct = true
+		}
+		credits = append(credits, c)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, c := range credits {
+		err := adjustAddrsReceived(txmgrBucket, params, scriptVersionAssumed,
+			c.pkScript, c.amount)
+		if err != nil {
+			return err
+		}
+		if !c.haveAcct {
+			continue
+		}
+		err = adjustAccountReceived(txmgrBucket, c.account, c.amount)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func pubKeyOutputsUpgrade(tx walletdb.ReadWriteTx, _ []byte, params *chaincfg.Params) error {
+	const oldVersion = 35
+	const newVersion = 36
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	txmgrBucket := tx.ReadWriteBucket(wtxmgrBucketKey)
+
+	// Assert that this function is only called on version 35 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "pubKeyOutputsUpgrade inappropriately called")
+	}
+
+	// Rescan from the birthday block when its header is saved, and from the
+	// genesis block otherwise.  The marker is never moved forward.
+	marker := params.GenesisHash
+	if bs := BirthState(tx); bs != nil && !bs.SetFromHeight && !bs.SetFromTime {
+		if existsBlockHeader(txmgrBucket, bs.Hash[:]) != nil {
+			marker = bs.Hash
+		}
+	}
+	var lastTxsBlock chainhash.Hash
+	copy(lastTxsBlock[:], txmgrBucket.Get(rootLastTxsBlock))
+	lastTxsHeader := existsBlockHeader(txmgrBucket, lastTxsBlock[:])
+	markerHeader := existsBlockHeader(txmgrBucket, marker[:])
+	if lastTxsHeader != nil && markerHeader != nil &&
+		ExtractBlockHeaderHeight(markerHeader) < ExtractBlockHeaderHeight(lastTxsHeader) {
+		err := txmgrBucket.Put(rootLastTxsBlock, marker[:])
+		if err != nil {
+			return errors.E(errors.IO, err)
+		}
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func paymentRequestsUpgrade(tx walletdb.ReadWriteTx, _ []byte, params *chaincfg.Params) error {
+	const oldVersion = 36
+	const newVersion = 37
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+
+	// Assert that this function is only called on version 36 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "paymentRequestsUpgrade inappropriately called")
+	}
+
+	// Create the payment requests bucket.
+	_, err = tx.CreateTopLevelBucket(paymentRequestsBucketKey)
+	if err != nil {
+		return err
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func accountActivityUpgrade(tx walletdb.ReadWriteTx, _ []byte, params *chaincfg.Params) error {
+	const oldVersion = 37
+	const newVersion = 38
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+
+	// Assert that this function is only called on version 37 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "accountActivityUpgrade inappropriately called")
+	}
+
+	// Create the account activity bucket.
+	_, err = tx.CreateTopLevelBucket(accountActivityBucketKey)
+	if err != nil {
+		return err
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func idempotencyKeysUpgrade(tx walletdb.ReadWriteTx, _ []byte, params *chaincfg.Params) error {
+	const oldVersion = 38
+	const newVersion = 39
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+
+	// Assert that this function is only called on version 38 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "idempotencyKeysUpgrade inappropriately called")
+	}
+
+	// Create the idempotency keys bucket.
+	_, err = tx.CreateTopLevelBucket(idempotencyKeysBucketKey)
+	if err != nil {
+		return err
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func destinationPoliciesUpgrade(tx walletdb.ReadWriteTx, _ []byte, params *chaincfg.Params) error {
+	const oldVersion = 39
+	const newVersion = 40
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+
+	// Assert that this function is only called on version 39 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "destinationPoliciesUpgrade inappropriately called")
+	}
+
+	// Create the destination policies bucket.
+	_, err = tx.CreateTopLevelBucket(destinationPoliciesBucketKey)
+	if err != nil {
+		return err
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func invoicesUpgrade(tx walletdb.ReadWriteTx, _ []byte, params *chaincfg.Params) error {
+	const oldVersion = 40
+	const newVersion = 41
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+
+	// Assert that this function is only called on version 40 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "invoicesUpgrade inappropriately called")
+	}
+
+	// Create the invoices bucket.
+	_, err = tx.CreateTopLevelBucket(invoicesBucketKey)
+	if err != nil {
+		return err
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func treasuryKeysUpgrade(tx walletdb.ReadWriteTx, _ []byte, params *chaincfg.Params) error {
+	const oldVersion = 41
+	const newVersion = 42
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+
+	// Assert that this function is only called on version 41 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "treasuryKeysUpgrade inappropriately called")
+	}
+
+	// Create the treasury keys bucket.
+	_, err = tx.CreateTopLevelBucket(treasuryKeysBucketKey)
+	if err != nil {
+		return err
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func holdsUpgrade(tx walletdb.ReadWriteTx, _ []byte, params *chaincfg.Params) error {
+	const oldVersion = 42
+	const newVersion = 43
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+
+	// Assert that this function is only called on version 42 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "holdsUpgrade inappropriately called")
+	}
+
+	// Create the holds bucket.
+	_, err = tx.CreateTopLevelBucket(holdsBucketKey)
+	if err != nil {
+		return err
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func fundsReservationsUpgrade(tx walletdb.ReadWriteTx, _ []byte, params *chaincfg.Params) error {
+	const oldVersion = 43
+	const newVersion = 44
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+
+	// Assert that this function is only called on version 43 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "fundsReservationsUpgrade inappropriately called")
+	}
+
+	// Create the funds reservations bucket.
+	_, err = tx.CreateTopLevelBucket(fundsReservationsBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func duressDecoyUpgrade(tx walletdb.ReadWriteTx, _ []byte, params *chaincfg.Params) error {
+	const oldVersion = 44
+	const newVersion = 45
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+
+	// Assert that this function is only called on version 44 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "duressDecoyUpgrade inappropriately called")
+	}
+
+	// Watching-only wallets have no private keys to protect.
+	ns := tx.ReadWriteBucket(waddrmgrBucketKey)
+	watchingOnly, err := fetchWatchingOnly(ns)
+	if err != nil {
+		return err
+	}
+	v := ns.NestedReadBucket(mainBucketName).Get(duressName)
+	if !watchingOnly && len(v) != duressRecordLen {
+		if v != nil {
+			log.Warnf("The duress passphrase has been removed by a " +
+				"database upgrade and must be set again")
+		}
+		err := putDecoyDuress(ns, params, randReader(nil))
+		if err != nil {
+			return err
+		}
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func externalSignerUpgrade(tx walletdb.ReadWriteTx, _ []byte, params *chaincfg.Params) error {
+	const oldVersion = 45
+	const newVersion = 46
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+
+	// Assert that this function is only called on version 45 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "externalSignerUpgrade inappropriately called")
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func votePolicyNoncesUpgrade(tx walletdb.ReadWriteTx, _ []byte, params *chaincfg.Params) error {
+	const oldVersion = 46
+	const newVersion = 47
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+
+	// Assert that this function is only called on version 46 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "votePolicyNoncesUpgrade inappropriately called")
+	}
+
+	// Create the vote policy nonces bucket.
+	_, err = tx.CreateTopLevelBucket(votePolicyNoncesBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
 // Upgrade checks whether the any upgrades are necessary before the database is
 // ready for application usage.  If any are, they are performed.
 func Upgrade(ctx context.Context, db walletdb.DB, publicPassphrase []byte, params *chaincfg.Params) error {
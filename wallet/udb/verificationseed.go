@@ -0,0 +1,74 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+// verificationSeedName is the main bucket key of the seed retained, encrypted
+// with the crypto private key, until the user verifies a backup of it.
+var verificationSeedName = []byte("verifyseed")
+
+// RetainVerificationSeed saves the wallet seed, encrypted with the crypto
+// private key, so the user's backup of the seed may later be verified.  The
+// seed is only retained until ForgetVerificationSeed is called, and the
+// manager must be unlocked.
+func (m *Manager) RetainVerificationSeed(ns walletdb.ReadWriteBucket, seed []byte) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.watchingOnly {
+		return errors.E(errors.WatchingOnly)
+	}
+	if m.locked {
+		return errors.E(errors.Locked, "wallet must be unlocked to retain seed")
+	}
+	ciphertext, err := m.cryptoKeyPriv.Encrypt(seed)
+	if err != nil {
+		return errors.E(errors.Crypto, errors.Errorf("encrypt seed: %v", err))
+	}
+	err = ns.NestedReadWriteBucket(mainBucketName).Put(verificationSeedName, ciphertext)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// HasVerificationSeed returns whether a seed is retained for verification.
+func (m *Manager) HasVerificationSeed(ns walletdb.ReadBucket) bool {
+	return ns.NestedReadBucket(mainBucketName).Get(verificationSeedName) != nil
+}
+
+// VerificationSeed returns the retained wallet seed.  The manager must be
+// unlocked.  Errors with NotExist if no seed is retained.  The caller should
+// zero the returned seed when it is no longer needed.
+func (m *Manager) VerificationSeed(ns walletdb.ReadBucket) ([]byte, error) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	ciphertext := ns.NestedReadBucket(mainBucketName).Get(verificationSeedName)
+	if ciphertext == nil {
+		return nil, errors.E(errors.NotExist, "wallet seed is not retained")
+	}
+	if m.locked {
+		return nil, errors.E(errors.Locked, "wallet must be unlocked to verify seed")
+	}
+	seed, err := m.cryptoKeyPriv.Decrypt(ciphertext)
+	if err != nil {
+		return nil, errors.E(errors.Crypto, errors.Errorf("decrypt seed: %v", err))
+	}
+	return seed, nil
+}
+
+// ForgetVerificationSeed removes any retained wallet seed.
+func (m *Manager) ForgetVerificationSeed(ns walletdb.ReadWriteBucket) error {
+	err := ns.NestedReadWriteBucket(mainBucketName).Delete(verificationSeedName)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
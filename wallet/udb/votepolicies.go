@@ -0,0 +1,33 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+var votePolicyNoncesBucketKey = []byte("votepolicynonces")
+
+// PutVotePolicyNonce records the nonce of imported vote policies.  Keys are
+// the nonces, and values are the Unix time the policies were applied (8
+// bytes).  Returns errors.Exist if the nonce was previously recorded, which
+// prevents replaying old policies over newer choices.
+func PutVotePolicyNonce(dbtx walletdb.ReadWriteTx, nonce []byte, applied time.Time) error {
+	bucket := dbtx.ReadWriteBucket(votePolicyNoncesBucketKey)
+	if bucket.Get(nonce) != nil {
+		err := errors.Errorf("vote policies with nonce %x were previously applied", nonce)
+		return errors.E(errors.Exist, err)
+	}
+	v := make([]byte, 8)
+	byteOrder.PutUint64(v, unixOrZero(applied))
+	err := bucket.Put(nonce, v)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
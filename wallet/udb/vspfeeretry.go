@@ -0,0 +1,115 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+)
+
+var vspFeeRetryBucketKey = []byte("vspfeeretry")
+
+// VSPFeeRetry records a failed VSP fee payment for a ticket which is scheduled
+// to be attempted again.
+type VSPFeeRetry struct {
+	Host        string
+	Attempts    uint32
+	NextAttempt time.Time
+	LastError   string
+}
+
+// VSP fee retry keys are ticket hashes, and values are serialized as:
+//
+// [0:4]   Attempts (4 bytes)
+// [4:12]  Unix time of the next attempt (8 bytes)
+// [12:16] Host length (4 bytes)
+// [16:]   Host, followed by the last error
+func serializeVSPFeeRetry(r *VSPFeeRetry) []byte {
+	v := make([]byte, 16+len(r.Host)+len(r.LastError))
+	byteOrder.PutUint32(v[0:4], r.Attempts)
+	byteOrder.PutUint64(v[4:12], uint64(r.NextAttempt.Unix()))
+	byteOrder.PutUint32(v[12:16], uint32(len(r.Host)))
+	copy(v[16:], r.Host)
+	copy(v[16+len(r.Host):], r.LastError)
+	return v
+}
+
+func deserializeVSPFeeRetry(v []byte) (*VSPFeeRetry, error) {
+	if len(v) < 16 {
+		return nil, errors.E(errors.IO, errors.Errorf("bad VSP fee retry "+
+			"length %d", len(v)))
+	}
+	hostLen := int(byteOrder.Uint32(v[12:16]))
+	if len(v) < 16+hostLen {
+		return nil, errors.E(errors.IO, errors.Errorf("bad VSP fee retry "+
+			"host length %d", hostLen))
+	}
+	return &VSPFeeRetry{
+		Attempts:    byteOrder.Uint32(v[0:4]),
+		NextAttempt: time.Unix(int64(byteOrder.Uint64(v[4:12])), 0),
+		Host:        string(v[16 : 16+hostLen]),
+		LastError:   string(v[16+hostLen:]),
+	}, nil
+}
+
+// PutVSPFeeRetry records a fee payment retry for a ticket, replacing any
+// previous record.
+func PutVSPFeeRetry(dbtx walletdb.ReadWriteTx, ticketHash *chainhash.Hash, r *VSPFeeRetry) error {
+	bucket := dbtx.ReadWriteBucket(vspFeeRetryBucketKey)
+	err := bucket.Put(ticketHash[:], serializeVSPFeeRetry(r))
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// VSPFeeRetryForTicket returns the fee payment retry recorded for a ticket.
+// Returns errors.NotExist if no retry is recorded.
+func VSPFeeRetryForTicket(dbtx walletdb.ReadTx, ticketHash *chainhash.Hash) (*VSPFeeRetry, error) {
+	bucket := dbtx.ReadBucket(vspFeeRetryBucketKey)
+	v := bucket.Get(ticketHash[:])
+	if v == nil {
+		err := errors.Errorf("no VSP fee retry for ticket %v", ticketHash)
+		return nil, errors.E(errors.NotExist, err)
+	}
+	return deserializeVSPFeeRetry(v)
+}
+
+// VSPFeeRetries returns every recorded fee payment retry keyed by ticket hash.
+func VSPFeeRetries(dbtx walletdb.ReadTx) (map[chainhash.Hash]*VSPFeeRetry, error) {
+	bucket := dbtx.ReadBucket(vspFeeRetryBucketKey)
+	retries := make(map[chainhash.Hash]*VSPFeeRetry)
+	err := bucket.ForEach(func(k, v []byte) error {
+		if len(k) != chainhash.HashSize {
+			return errors.E(errors.IO, errors.Errorf("bad VSP fee retry "+
+				"key length %d", len(k)))
+		}
+		r, err := deserializeVSPFeeRetry(v)
+		if err != nil {
+			return err
+		}
+		var hash chainhash.Hash
+		copy(hash[:], k)
+		retries[hash] = r
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return retries, nil
+}
+
+// DeleteVSPFeeRetry removes any fee payment retry recorded for a ticket.
+func DeleteVSPFeeRetry(dbtx walletdb.ReadWriteTx, ticketHash *chainhash.Hash) error {
+	bucket := dbtx.ReadWriteBucket(vspFeeRetryBucketKey)
+	err := bucket.Delete(ticketHash[:])
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"encoding/binary"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+// acctVarWatchKeyEnd records the child index following the last watch key
+// address imported to an account.
+var acctVarWatchKeyEnd = []byte("watchend")
+
+// WatchKeyEnd returns the child index following the last watch key address
+// imported to an account, or zero if no watch key addresses were imported.
+func (m *Manager) WatchKeyEnd(ns walletdb.ReadBucket, account uint32) (uint32, error) {
+	vars := ns.NestedReadBucket(acctVarsBucketName).NestedReadBucket(uint32ToBytes(account))
+	if vars == nil {
+		return 0, errors.E(errors.NotExist, errors.Errorf("no account %d", account))
+	}
+	v := vars.Get(acctVarWatchKeyEnd)
+	switch len(v) {
+	case 0:
+		return 0, nil
+	case 4:
+		return binary.LittleEndian.Uint32(v), nil
+	default:
+		return 0, errors.E(errors.IO, errors.Errorf("bad watch key end "+
+			"length %d", len(v)))
+	}
+}
+
+// PutWatchKeyEnd records the child index following the last watch key address
+// imported to an account.  The recorded end is never reduced.
+func (m *Manager) PutWatchKeyEnd(ns walletdb.ReadWriteBucket, account, end uint32) error {
+	recorded, err := m.WatchKeyEnd(ns, account)
+	if err != nil {
+		return err
+	}
+	if end <= recorded {
+		return nil
+	}
+	return putAccountUint32Var(accountVarsBucket(ns, account), acctVarWatchKeyEnd, end)
+}
@@ -36,6 +36,23 @@ func (u unstableAPI) TxDetails(ctx context.Context, txHash *chainhash.Hash) (*ud
 		details, err = u.w.txStore.TxDetails(txmgrNs, txHash)
 		return err
 	})
+	if errors.Is(err, errors.NotExist) && u.w.prunedTx(ctx, txHash) {
+		// Refetch pruned transactions on demand when a network
+		// backend is available.
+		n, nerr := u.w.NetworkBackend()
+		if nerr != nil {
+			return nil, errors.E(op, err)
+		}
+		if _, err := u.w.FetchPrunedTransaction(ctx, n, txHash); err != nil {
+			return nil, errors.E(op, err)
+		}
+		err = walletdb.View(ctx, u.w.db, func(dbtx walletdb.ReadTx) error {
+			txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+			var err error
+			details, err = u.w.txStore.TxDetails(txmgrNs, txHash)
+			return err
+		})
+	}
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
@@ -0,0 +1,133 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/txrules"
+	"decred.org/dcrwallet/v5/wallet/txsizes"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4/stdscript"
+)
+
+// UTXOStats summarizes the unspent outputs of an account.  Coin ages are
+// measured in blocks as the number of confirmations of each output, with
+// unmined outputs having an age of zero.  Dust outputs are counted by
+// DustCount and DustTotal.
+type UTXOStats struct {
+	Account   uint32
+	Count     int
+	Total     dcrutil.Amount
+	MedianAge float64
+	MeanAge   float64
+	DustCount int
+	DustTotal dcrutil.Amount
+	Largest   dcrutil.Amount
+	Smallest  dcrutil.Amount
+}
+
+// UTXOStats returns statistics of the unspent outputs of every account with
+// at least one unspent output, ordered by account number.  Outputs of
+// unpublished transactions, outputs spent by unmined transactions, and ticket
+// submission outputs are not included.  Outputs valued below dustThreshold
+// are counted as dust.  When the threshold is zero, outputs are counted as
+// dust when a P2PKH output of the same value would be dust at the wallet's
+// relay fee.
+func (w *Wallet) UTXOStats(ctx context.Context, dustThreshold dcrutil.Amount) ([]UTXOStats, error) {
+	const op errors.Op = "wallet.UTXOStats"
+	if dustThreshold < 0 {
+		return nil, errors.E(op, errors.Invalid, "negative dust threshold")
+	}
+	isDust := func(v dcrutil.Amount) bool { return v < dustThreshold }
+	if dustThreshold == 0 {
+		relayFee := w.RelayFee()
+		isDust = func(v dcrutil.Amount) bool {
+			return txrules.IsDustAmount(v, txsizes.P2PKHPkScriptSize, relayFee)
+		}
+	}
+
+	type accountOutputs struct {
+		stats  UTXOStats
+		values []dcrutil.Amount
+		ages   []int32
+	}
+	accounts := make(map[uint32]*accountOutputs)
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		_, tipHeight := w.txStore.MainChainTip(dbtx)
+
+		outputs, err := w.txStore.UnspentOutputs(dbtx)
+		if err != nil {
+			return err
+		}
+		for _, output := range outputs {
+			class, addrs := stdscript.ExtractAddrs(scriptVersionAssumed,
+				output.PkScript, w.chainParams)
+			if len(addrs) == 0 {
+				continue
+			}
+			switch class {
+			case stdscript.STStakeSubmissionPubKeyHash,
+				stdscript.STStakeSubmissionScriptHash:
+				continue
+			}
+			account, err := w.manager.AddrAccount(addrmgrNs, addrs[0])
+			if errors.Is(err, errors.NotExist) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			a := accounts[account]
+			if a == nil {
+				a = &accountOutputs{stats: UTXOStats{Account: account}}
+				accounts[account] = a
+			}
+			a.values = append(a.values, output.Amount)
+			a.ages = append(a.ages, confirms(output.Height, tipHeight))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	stats := make([]UTXOStats, 0, len(accounts))
+	for _, a := range accounts {
+		s := &a.stats
+		s.Count = len(a.values)
+		s.Smallest = a.values[0]
+		var totalAge int64
+		for i, v := range a.values {
+			s.Total += v
+			s.Largest = max(s.Largest, v)
+			s.Smallest = min(s.Smallest, v)
+			if isDust(v) {
+				s.DustCount++
+				s.DustTotal += v
+			}
+			totalAge += int64(a.ages[i])
+		}
+		s.MeanAge = float64(totalAge) / float64(s.Count)
+		slices.Sort(a.ages)
+		mid := s.Count / 2
+		if s.Count%2 == 1 {
+			s.MedianAge = float64(a.ages[mid])
+		} else {
+			s.MedianAge = float64(a.ages[mid-1]+a.ages[mid]) / 2
+		}
+		stats = append(stats, *s)
+	}
+	slices.SortFunc(stats, func(a, b UTXOStats) int {
+		return cmp.Compare(a.Account, b.Account)
+	})
+	return stats, nil
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet_test
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/wallet/wallettest"
+	"github.com/decred/dcrd/dcrutil/v4"
+)
+
+func TestUTXOStats(t *testing.T) {
+	ctx := context.Background()
+	h := wallettest.New(t, nil)
+	w := h.Wallet
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Mine outputs of 1 DCR, 3 DCR, and 100 atoms (dust), aged 3, 2, and
+	// 1 blocks.
+	for _, amount := range []dcrutil.Amount{1e8, 3e8, 100} {
+		_, err := h.Chain.MineBlock(ctx, h.Chain.FundingTx(addr, amount))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stats, err := w.UTXOStats(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats) != 1 {
+		t.Fatalf("stats of %d accounts", len(stats))
+	}
+	st := stats[0]
+	if st.Account != 0 || st.Count != 3 || st.Total != 4e8+100 {
+		t.Errorf("account %d has %d outputs totaling %v", st.Account, st.Count, st.Total)
+	}
+	if st.MedianAge != 2 || st.MeanAge != 2 {
+		t.Errorf("median age %v, mean age %v", st.MedianAge, st.MeanAge)
+	}
+	if st.DustCount != 1 || st.DustTotal != 100 {
+		t.Errorf("%d dust outputs totaling %v", st.DustCount, st.DustTotal)
+	}
+	if st.Largest != 3e8 || st.Smallest != 100 {
+		t.Errorf("largest %v, smallest %v", st.Largest, st.Smallest)
+	}
+
+	// A configured threshold counts every smaller output as dust.
+	stats, err = w.UTXOStats(ctx, 2e8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st := stats[0]; st.DustCount != 2 || st.DustTotal != 1e8+100 {
+		t.Errorf("%d dust outputs totaling %v below 2 DCR", st.DustCount, st.DustTotal)
+	}
+}
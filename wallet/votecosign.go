@@ -0,0 +1,277 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/blockchain/stake/v5"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/decred/dcrd/txscript/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/txscript/v4/stdscript"
+	"github.com/decred/dcrd/wire"
+)
+
+// VoteCosigner adds signatures to votes of tickets whose voting rights are
+// held by a multisig script shared with other devices, such as another wallet
+// holding different keys of the script.  CosignVote returns the vote with the
+// cosigner's signatures merged into the signature script of the ticket input.
+// The vote must not be otherwise modified.
+type VoteCosigner interface {
+	CosignVote(ctx context.Context, vote *wire.MsgTx) (*wire.MsgTx, error)
+}
+
+// SetVoteCosigners sets the cosigners which are asked to sign votes of
+// winning tickets for which the wallet holds some but not all of the required
+// keys of the multisig voting script.  Every cosigner is asked at once, and
+// votes are only published once enough signatures are collected.  Cosigning
+// not completed within the timeout of the votes being signed is abandoned,
+// and a zero timeout waits on the cosigners without a deadline.  Calling
+// without any cosigners disables vote cosigning.  Cosigners are held for the
+// lifetime of the loaded wallet and are not persisted.
+func (w *Wallet) SetVoteCosigners(timeout time.Duration, cosigners ...VoteCosigner) {
+	w.voteCosignersMu.Lock()
+	w.voteCosigners = cosigners
+	w.voteCosignerTimeout = timeout
+	w.voteCosignersMu.Unlock()
+}
+
+func (w *Wallet) cosigners() []VoteCosigner {
+	w.voteCosignersMu.Lock()
+	defer w.voteCosignersMu.Unlock()
+	return w.voteCosigners
+}
+
+// votingScriptKeys returns the number of keys the wallet holds of the
+// imported multisig script holding the voting rights of a ticket, and the
+// number of signatures required by the script.  Zero required signatures are
+// returned when the voting rights are not held by an imported multisig script.
+func (w *Wallet) votingScriptKeys(addrmgrNs walletdb.ReadBucket, ticket *wire.MsgTx) (have, required uint16, err error) {
+	out := ticket.TxOut[0]
+	_, addrs := stdscript.ExtractAddrs(out.Version, out.PkScript, w.chainParams)
+	if len(addrs) != 1 {
+		return 0, 0, nil
+	}
+	addr, ok := addrs[0].(*stdaddr.AddressScriptHashV0)
+	if !ok || !w.manager.ExistsHash160(addrmgrNs, addr.Hash160()[:]) {
+		return 0, 0, nil
+	}
+	return w.multisigKeys(addrmgrNs, addr)
+}
+
+// voteComplete returns whether the ticket input of a vote is completely signed.
+func voteComplete(ticket, vote *wire.MsgTx) bool {
+	out := ticket.TxOut[0]
+	vm, err := txscript.NewEngine(out.PkScript, vote, 1, sanityVerifyFlags,
+		out.Version, nil)
+	return err == nil && vm.Execute() == nil
+}
+
+// CosignVote adds the wallet's signatures to the ticket input of a vote whose
+// voting rights are held by an imported multisig script, merging them with
+// any signatures already present.  The signed vote is returned along with
+// whether it is now completely signed.  The wallet must hold at least one key
+// of the voting script and be unlocked.
+func (w *Wallet) CosignVote(ctx context.Context, vote *wire.MsgTx) (*wire.MsgTx, bool, error) {
+	const op errors.Op = "wallet.CosignVote"
+	if !stake.IsSSGen(vote) {
+		return nil, false, errors.E(op, errors.Invalid, "transaction is not a vote")
+	}
+	vote = vote.Copy()
+	ticketHash := &vote.TxIn[1].PreviousOutPoint.Hash
+
+	var ticket *wire.MsgTx
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+		var err error
+		ticket, err = w.txStore.Tx(txmgrNs, ticketHash)
+		if err != nil {
+			return err
+		}
+		have, required, err := w.votingScriptKeys(addrmgrNs, ticket)
+		if err != nil {
+			return err
+		}
+		if required == 0 {
+			return errors.E(errors.Invalid, errors.Errorf("voting rights "+
+				"of ticket %v are not held by an imported multisig script",
+				ticketHash))
+		}
+		if have == 0 {
+			return errors.E(errors.Permission, errors.Errorf("wallet holds "+
+				"no keys of the voting script of ticket %v", ticketHash))
+		}
+		return w.signVote(addrmgrNs, ticket, vote)
+	})
+	if err != nil {
+		return nil, false, errors.E(op, err)
+	}
+	return vote, voteComplete(ticket, vote), nil
+}
+
+// assembleVotes collects the remaining signatures of partially signed votes
+// from the vote cosigners.  All votes are cosigned concurrently and share the
+// cosigning deadline.  Votes which do not reach the quorum of signatures are
+// replaced by nil.
+func (w *Wallet) assembleVotes(ctx context.Context, tickets, votes []*wire.MsgTx) {
+	w.voteCosignersMu.Lock()
+	timeout := w.voteCosignerTimeout
+	w.voteCosignersMu.Unlock()
+	if timeout != 0 {
+		var cancel func()
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	var wg sync.WaitGroup
+	for i := range votes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			vote, err := w.assembleVote(ctx, tickets[i], votes[i])
+			if err != nil {
+				ticketHash := &votes[i].TxIn[1].PreviousOutPoint.Hash
+				log.Errorf("Failed to assemble vote for ticket hash %v: %v",
+					ticketHash, err)
+			}
+			votes[i] = vote
+		}()
+	}
+	wg.Wait()
+}
+
+// assembleVote requests signatures of a partially signed vote from every vote
+// cosigner at once, merging the signatures of each response until the ticket
+// input is completely signed.  Cosigners which have not responded by then are
+// abandoned.  An error is returned if the cosigners are unable to provide
+// enough signatures before ctx is done.
+func (w *Wallet) assembleVote(ctx context.Context, ticket, vote *wire.MsgTx) (*wire.MsgTx, error) {
+	voteHash := vote.TxHash()
+	cosigners := w.cosigners()
+	if voteComplete(ticket, vote) {
+		return vote, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	type result struct {
+		vote *wire.MsgTx
+		err  error
+	}
+	results := make(chan result, len(cosigners))
+	for _, c := range cosigners {
+		go func() {
+			cosigned, err := c.CosignVote(ctx, vote)
+			results <- result{cosigned, err}
+		}()
+	}
+
+	sigScripts := [][]byte{vote.TxIn[1].SignatureScript}
+	for range cosigners {
+		var r result
+		select {
+		case r = <-results:
+		case <-ctx.Done():
+			return nil, errors.E(errors.Errorf("quorum of signatures for "+
+				"vote %v was not reached: %w", &voteHash, ctx.Err()))
+		}
+		if r.err != nil {
+			log.Warnf("Failed to cosign vote %v: %v", &voteHash, r.err)
+			continue
+		}
+		if r.vote.TxHash() != voteHash {
+			log.Warnf("Vote cosigner modified vote %v", &voteHash)
+			continue
+		}
+		sigScripts = append(sigScripts, r.vote.TxIn[1].SignatureScript)
+		merged, err := mergeMultisigSignatures(vote, 1, sigScripts)
+		if err != nil {
+			log.Warnf("Failed to merge signatures of vote %v: %v",
+				&voteHash, err)
+			continue
+		}
+		assembled := vote.Copy()
+		assembled.TxIn[1].SignatureScript = merged
+		if voteComplete(ticket, assembled) {
+			return assembled, nil
+		}
+	}
+	return nil, errors.E(errors.Errorf("quorum of signatures for vote "+
+		"%v was not reached", &voteHash))
+}
+
+// mergeMultisigSignatures combines the signatures of several P2SH multisig
+// signature scripts of a transaction input into a single signature script.
+// Each script must end with a push of the same redeem script.  Signatures
+// which do not verify for a key of the redeem script are dropped, and the
+// remaining signatures are ordered by their keys as required by the
+// multisig script.
+func mergeMultisigSignatures(tx *wire.MsgTx, idx int, sigScripts [][]byte) ([]byte, error) {
+	var redeemScript []byte
+	var sigs [][]byte
+	for _, script := range sigScripts {
+		var pushes [][]byte
+		tokenizer := txscript.MakeScriptTokenizer(0, script)
+		for tokenizer.Next() {
+			pushes = append(pushes, tokenizer.Data())
+		}
+		if tokenizer.Err() != nil || len(pushes) == 0 {
+			continue
+		}
+		last := pushes[len(pushes)-1]
+		switch {
+		case redeemScript == nil:
+			redeemScript = last
+		case !bytes.Equal(redeemScript, last):
+			return nil, errors.E(errors.Invalid, "signature scripts "+
+				"redeem different scripts")
+		}
+		sigs = append(sigs, pushes[:len(pushes)-1]...)
+	}
+	details := stdscript.ExtractMultiSigScriptDetailsV0(redeemScript, true)
+	if !details.Valid {
+		return nil, errors.E(errors.Invalid, "redeem script is not multisig")
+	}
+	sigHash, err := txscript.CalcSignatureHash(redeemScript,
+		txscript.SigHashAll, tx, idx, nil)
+	if err != nil {
+		return nil, errors.E(errors.Invalid, err)
+	}
+
+	b := txscript.NewScriptBuilder()
+	var signed uint16
+	for _, pk := range details.PubKeys {
+		if signed == details.RequiredSigs {
+			break
+		}
+		pubKey, err := secp256k1.ParsePubKey(pk)
+		if err != nil {
+			continue
+		}
+		for _, sig := range sigs {
+			if len(sig) == 0 || txscript.SigHashType(sig[len(sig)-1]) != txscript.SigHashAll {
+				continue
+			}
+			s, err := ecdsa.ParseDERSignature(sig[:len(sig)-1])
+			if err != nil || !s.Verify(sigHash, pubKey) {
+				continue
+			}
+			b.AddData(sig)
+			signed++
+			break
+		}
+	}
+	b.AddData(redeemScript)
+	return b.Script()
+}
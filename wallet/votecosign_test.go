@@ -0,0 +1,222 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"github.com/decred/dcrd/blockchain/stake/v5"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4"
+	"github.com/decred/dcrd/txscript/v4/sign"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/txscript/v4/stdscript"
+	"github.com/decred/dcrd/wire"
+)
+
+// testVoteCosigner signs the ticket input of votes with a single key of a
+// multisig voting script.
+type testVoteCosigner struct {
+	key    *secp256k1.PrivateKey
+	script []byte
+	ticket *wire.MsgTx
+	params *chaincfg.Params
+}
+
+func (c *testVoteCosigner) CosignVote(ctx context.Context, vote *wire.MsgTx) (*wire.MsgTx, error) {
+	vote = vote.Copy()
+	var getKey sign.KeyClosure = func(addr stdaddr.Address) ([]byte, dcrec.SignatureType, bool, error) {
+		pk, ok := addr.(stdaddr.SerializedPubKeyer)
+		if !ok || !bytes.Equal(pk.SerializedPubKey(), c.key.PubKey().SerializeCompressed()) {
+			return nil, 0, false, errors.E(errors.NotExist)
+		}
+		return c.key.Serialize(), dcrec.STEcdsaSecp256k1, true, nil
+	}
+	var getScript sign.ScriptClosure = func(stdaddr.Address) ([]byte, error) {
+		return c.script, nil
+	}
+	sigScript, err := sign.SignTxOutput(c.params, vote, 1, c.ticket.TxOut[0].PkScript,
+		txscript.SigHashAll, getKey, getScript, vote.TxIn[1].SignatureScript, true)
+	if err != nil {
+		return nil, err
+	}
+	vote.TxIn[1].SignatureScript = sigScript
+	return vote, nil
+}
+
+type failingVoteCosigner struct{}
+
+func (failingVoteCosigner) CosignVote(ctx context.Context, vote *wire.MsgTx) (*wire.MsgTx, error) {
+	return nil, errors.New("cosigner is offline")
+}
+
+// unresponsiveVoteCosigner never responds before the cosigning deadline.
+type unresponsiveVoteCosigner struct{}
+
+func (unresponsiveVoteCosigner) CosignVote(ctx context.Context, vote *wire.MsgTx) (*wire.MsgTx, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestCosignVote(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ka, err := w.KnownAddress(ctx, addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deviceKeys := make([]*secp256k1.PrivateKey, 2)
+	pubKeys := [][]byte{ka.(PubKeyHashAddress).PubKey()}
+	for i := range deviceKeys {
+		deviceKeys[i], err = secp256k1.GeneratePrivateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		pubKeys = append(pubKeys, deviceKeys[i].PubKey().SerializeCompressed())
+	}
+	script, err := stdscript.MultiSigScriptV0(2, pubKeys...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2sh, err := multisigVotingAddress(script, w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.importVotingScript(ctx, script); err != nil {
+		t.Fatal(err)
+	}
+
+	commitmentAddr := addr.(stdaddr.StakeAddress)
+	ticket := wire.NewMsgTx()
+	ticket.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{1}}, 1e8, nil))
+	version, pkScript := p2sh.VotingRightsScript()
+	ticket.AddTxOut(&wire.TxOut{Value: 1e8, Version: version, PkScript: pkScript})
+	version, pkScript = commitmentAddr.RewardCommitmentScript(1e8, 0, 0)
+	ticket.AddTxOut(&wire.TxOut{Version: version, PkScript: pkScript})
+	version, pkScript = commitmentAddr.StakeChangeScript()
+	ticket.AddTxOut(&wire.TxOut{Version: version, PkScript: pkScript})
+	if err := w.AddTransaction(ctx, ticket, nil); err != nil {
+		t.Fatal(err)
+	}
+	ticketHash := ticket.TxHash()
+
+	vote, err := createUnsignedVote(&ticketHash, ticket, 100, &chainhash.Hash{2},
+		stake.VoteBits{Bits: dcrutil.BlockValid}, w.subsidyCache, w.chainParams,
+		false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The wallet alone holds one of the two required keys.
+	partial, complete, err := w.CosignVote(ctx, vote)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if complete || len(partial.TxIn[1].SignatureScript) == 0 {
+		t.Fatalf("wallet signature: complete=%v script=%x", complete,
+			partial.TxIn[1].SignatureScript)
+	}
+	if len(vote.TxIn[1].SignatureScript) != 0 {
+		t.Errorf("CosignVote modified the passed vote")
+	}
+
+	if _, err := w.assembleVote(ctx, ticket, partial); err == nil {
+		t.Errorf("vote assembled without cosigners")
+	}
+
+	// Failing cosigners are skipped, and unresponsive cosigners are not
+	// waited on once quorum is reached.
+	device := &testVoteCosigner{deviceKeys[1], script, ticket, w.chainParams}
+	w.SetVoteCosigners(0, failingVoteCosigner{}, unresponsiveVoteCosigner{}, device)
+	assembled, err := w.assembleVote(ctx, ticket, partial)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !voteComplete(ticket, assembled) {
+		t.Errorf("assembled vote is not completely signed")
+	}
+
+	// Cosigning is abandoned at the deadline.
+	w.SetVoteCosigners(10*time.Millisecond, failingVoteCosigner{}, unresponsiveVoteCosigner{})
+	votes := []*wire.MsgTx{partial}
+	w.assembleVotes(ctx, []*wire.MsgTx{ticket}, votes)
+	if votes[0] != nil {
+		t.Errorf("vote assembled without quorum")
+	}
+
+	// Signatures of another device are merged with the wallet's signature.
+	other := &testVoteCosigner{deviceKeys[0], script, ticket, w.chainParams}
+	cosigned, err := other.CosignVote(ctx, vote)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cosigned, complete, err = w.CosignVote(ctx, cosigned)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !complete || !voteComplete(ticket, cosigned) {
+		t.Errorf("merged vote is not completely signed")
+	}
+
+	// Signatures of cosigners responding concurrently are merged when the
+	// wallet and each cosigner hold a single key of the script.
+	script3, err := stdscript.MultiSigScriptV0(3, pubKeys...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2sh3, err := multisigVotingAddress(script3, w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.importVotingScript(ctx, script3); err != nil {
+		t.Fatal(err)
+	}
+	ticket3 := ticket.Copy()
+	ticket3.TxIn[0].PreviousOutPoint.Hash = chainhash.Hash{3}
+	ticket3.TxOut[0].Version, ticket3.TxOut[0].PkScript = p2sh3.VotingRightsScript()
+	if err := w.AddTransaction(ctx, ticket3, nil); err != nil {
+		t.Fatal(err)
+	}
+	ticket3Hash := ticket3.TxHash()
+	vote3, err := createUnsignedVote(&ticket3Hash, ticket3, 100, &chainhash.Hash{2},
+		stake.VoteBits{Bits: dcrutil.BlockValid}, w.subsidyCache, w.chainParams,
+		false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	partial3, _, err := w.CosignVote(ctx, vote3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.SetVoteCosigners(time.Minute,
+		&testVoteCosigner{deviceKeys[0], script3, ticket3, w.chainParams},
+		&testVoteCosigner{deviceKeys[1], script3, ticket3, w.chainParams})
+	votes = []*wire.MsgTx{partial3}
+	w.assembleVotes(ctx, []*wire.MsgTx{ticket3}, votes)
+	if votes[0] == nil || !voteComplete(ticket3, votes[0]) {
+		t.Errorf("signatures of concurrent cosigners were not merged")
+	}
+
+	if _, _, err := w.CosignVote(ctx, ticket); !errors.Is(err, errors.Invalid) {
+		t.Errorf("cosigning a non-vote: expected Invalid error, got %v", err)
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"sync"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/wire"
+)
+
+// maxVoteDiagnostics is the number of most recent vote diagnostics which are
+// retained.
+const maxVoteDiagnostics = 512
+
+// VoteDiagnostic describes the creation and broadcast of a single vote.
+// Durations are measured from when the wallet was notified of the winning
+// tickets of the voted block.
+type VoteDiagnostic struct {
+	Ticket      chainhash.Hash
+	Vote        chainhash.Hash
+	BlockHash   chainhash.Hash
+	BlockHeight int32
+
+	// Notified is the time the winning tickets notification was received.
+	Notified time.Time
+
+	// Signed is the duration until the vote was signed, including the
+	// time spent waiting for vote cosigners.
+	Signed time.Duration
+
+	// Broadcast is the duration until the network backend accepted or
+	// rejected the vote.
+	Broadcast time.Duration
+
+	// Backend describes the kind of network backend the vote was
+	// published with, and Peers its addresses, when reported.
+	Backend string
+	Peers   []string
+
+	// Size is the serialized size of the vote, and Fee the amount by which
+	// its inputs exceed its outputs.
+	Size int
+	Fee  dcrutil.Amount
+
+	// Err is the error publishing the vote, if any.  As votes of a block
+	// are published together, the error may have been caused by another
+	// vote.
+	Err error
+}
+
+// voteDiagnostics records the most recent vote diagnostics.  The zero value is
+// ready for use.
+type voteDiagnostics struct {
+	mu   sync.Mutex
+	ring []VoteDiagnostic
+	next int
+}
+
+func (d *voteDiagnostics) add(diags ...VoteDiagnostic) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for i := range diags {
+		if len(d.ring) < maxVoteDiagnostics {
+			d.ring = append(d.ring, diags[i])
+			continue
+		}
+		d.ring[d.next] = diags[i]
+		d.next = (d.next + 1) % maxVoteDiagnostics
+	}
+}
+
+// recent returns up to count diagnostics, most recent first.
+func (d *voteDiagnostics) recent(count int) []VoteDiagnostic {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	n := min(count, len(d.ring))
+	res := make([]VoteDiagnostic, 0, n)
+	for i := 0; i < n; i++ {
+		// d.next is the index of the oldest entry when the ring is
+		// full, and zero otherwise.
+		j := (d.next - 1 - i + 2*len(d.ring)) % len(d.ring)
+		res = append(res, d.ring[j])
+	}
+	return res
+}
+
+// VoteDiagnostics returns the diagnostics of up to count of the most recent
+// votes created by the wallet since it was opened, most recent first.  Up to
+// 512 diagnostics are retained.  Stakers may use these to find votes which
+// were broadcast late due to a slow network backend or vote cosigner.
+func (w *Wallet) VoteDiagnostics(count int) []VoteDiagnostic {
+	if count <= 0 {
+		return nil
+	}
+	return w.voteDiagnostics.recent(count)
+}
+
+// recordVoteDiagnostics records the diagnostics of votes published with the
+// network backend n.
+func (w *Wallet) recordVoteDiagnostics(n NetworkBackend, tickets []*chainhash.Hash,
+	votes []*wire.MsgTx, blockHash *chainhash.Hash, blockHeight int32,
+	notified, signed, broadcast time.Time, err error) {
+
+	var backend string
+	var peers []string
+	if r, ok := n.(SyncStatusReporter); ok {
+		backend, peers = r.SyncBackend()
+	}
+	diags := make([]VoteDiagnostic, 0, len(votes))
+	for i, vote := range votes {
+		var in, out int64
+		for _, txIn := range vote.TxIn {
+			in += txIn.ValueIn
+		}
+		for _, txOut := range vote.TxOut {
+			out += txOut.Value
+		}
+		diags = append(diags, VoteDiagnostic{
+			Ticket:      *tickets[i],
+			Vote:        vote.TxHash(),
+			BlockHash:   *blockHash,
+			BlockHeight: blockHeight,
+			Notified:    notified,
+			Signed:      signed.Sub(notified),
+			Broadcast:   broadcast.Sub(notified),
+			Backend:     backend,
+			Peers:       peers,
+			Size:        vote.SerializeSize(),
+			Fee:         dcrutil.Amount(in - out),
+			Err:         err,
+		})
+	}
+	w.voteDiagnostics.add(diags...)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestVoteDiagnosticsRing(t *testing.T) {
+	var d voteDiagnostics
+	if res := d.recent(10); len(res) != 0 {
+		t.Fatalf("empty diagnostics returned %d entries", len(res))
+	}
+
+	add := func(from, to int32) {
+		for h := from; h < to; h++ {
+			d.add(VoteDiagnostic{BlockHeight: h})
+		}
+	}
+	check := func(count int, newest int32, n int) {
+		t.Helper()
+		res := d.recent(count)
+		if len(res) != n {
+			t.Fatalf("recent(%d) returned %d entries, expected %d", count, len(res), n)
+		}
+		for i := range res {
+			if res[i].BlockHeight != newest-int32(i) {
+				t.Fatalf("entry %d has height %d, expected %d", i,
+					res[i].BlockHeight, newest-int32(i))
+			}
+		}
+	}
+
+	add(0, 10)
+	check(3, 9, 3)
+	check(100, 9, 10)
+
+	// Oldest entries are overwritten once full.
+	add(10, maxVoteDiagnostics+25)
+	check(maxVoteDiagnostics*2, maxVoteDiagnostics+24, maxVoteDiagnostics)
+	check(30, maxVoteDiagnostics+24, 30)
+}
+
+func TestVoteDiagnosticsRecorded(t *testing.T) {
+	w := &Wallet{}
+	ticket := chainhash.Hash{1}
+	block := chainhash.Hash{2}
+	vote := wire.NewMsgTx()
+	vote.AddTxIn(&wire.TxIn{ValueIn: 1e6})
+	vote.AddTxIn(&wire.TxIn{ValueIn: 2e8})
+	vote.AddTxOut(wire.NewTxOut(0, []byte{0x6a}))
+	vote.AddTxOut(wire.NewTxOut(2e8+1e6-1000, []byte{0x51}))
+
+	now := time.Now()
+	w.recordVoteDiagnostics(nil, []*chainhash.Hash{&ticket}, []*wire.MsgTx{vote},
+		&block, 100, now, now.Add(2*time.Millisecond), now.Add(5*time.Millisecond), nil)
+	if res := w.VoteDiagnostics(0); len(res) != 0 {
+		t.Fatalf("zero count returned %d diagnostics", len(res))
+	}
+	res := w.VoteDiagnostics(5)
+	if len(res) != 1 {
+		t.Fatalf("recorded %d diagnostics", len(res))
+	}
+	d := &res[0]
+	if d.Ticket != ticket || d.BlockHash != block || d.Vote != vote.TxHash() {
+		t.Errorf("wrong hashes recorded: %+v", d)
+	}
+	if d.Signed != 2*time.Millisecond || d.Broadcast != 5*time.Millisecond {
+		t.Errorf("recorded durations %v and %v", d.Signed, d.Broadcast)
+	}
+	if d.Fee != 1000 || d.Size != vote.SerializeSize() {
+		t.Errorf("recorded fee %v and size %d", d.Fee, d.Size)
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"decred.org/dcrwallet/v5/errors"
+)
+
+// errVoteOnly describes operations refused by wallets opened in vote-only
+// mode.
+var errVoteOnly = errors.E(errors.Permission,
+	"operation is disabled by vote-only mode")
+
+// VoteOnly returns whether the wallet was opened in vote-only mode.
+//
+// Vote-only wallets sign votes and revocations of their tickets, but refuse
+// every other operation which signs a transaction spending wallet outputs
+// (including sends, ticket purchases, VSP fee payments, mixing, sweeps, swaps,
+// and signing of raw transactions and hashes) or which reveals private keys.
+// Read operations and the management of addresses, accounts, vote choices, and
+// treasury policies remain available.  The mode is enforced by the wallet
+// itself, and is not dependent on the RPC servers used to access it.
+func (w *Wallet) VoteOnly() bool {
+	return w.voteOnly
+}
+
+// checkVoteOnly errors with errors.Permission when the wallet is in vote-only
+// mode.
+func (w *Wallet) checkVoteOnly() error {
+	if w.voteOnly {
+		return errVoteOnly
+	}
+	return nil
+}
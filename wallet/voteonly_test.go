@@ -0,0 +1,80 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"github.com/decred/dcrd/txscript/v4"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestVoteOnly(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	cfg.VoteOnly = true
+	w, teardown := testWallet(ctx, t, &cfg, nil)
+	defer teardown()
+
+	if !w.VoteOnly() {
+		t.Fatal("wallet not in vote-only mode")
+	}
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Address management and other read operations are permitted.
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.SignMessage(ctx, "message", addr); err != nil {
+		t.Errorf("message signing refused: %v", err)
+	}
+
+	version, script := addr.PaymentScript()
+	out := &wire.TxOut{Value: 1e8, Version: version, PkScript: script}
+	tx := wire.NewMsgTx()
+	tx.AddTxOut(out)
+	refused := []struct {
+		name string
+		f    func() error
+	}{
+		{"SendOutputs", func() error {
+			_, err := w.SendOutputs(ctx, []*wire.TxOut{out}, 0, 0, 1)
+			return err
+		}},
+		{"PurchaseTickets", func() error {
+			_, err := w.PurchaseTickets(ctx, nil, &PurchaseTicketsRequest{Count: 1})
+			return err
+		}},
+		{"SignTransaction", func() error {
+			_, err := w.SignTransaction(ctx, tx, txscript.SigHashAll, nil, nil, nil)
+			return err
+		}},
+		{"SignHashes", func() error {
+			_, _, err := w.SignHashes(ctx, [][]byte{make([]byte, 32)}, addr)
+			return err
+		}},
+		{"DumpWIFPrivateKey", func() error {
+			_, err := w.DumpWIFPrivateKey(ctx, addr)
+			return err
+		}},
+		{"AccountXpriv", func() error {
+			_, err := w.AccountXpriv(ctx, 0)
+			return err
+		}},
+		{"MixAccount", func() error {
+			return w.MixAccount(ctx, 0, 0, 0)
+		}},
+	}
+	for _, test := range refused {
+		if err := test.f(); !errors.Is(err, errVoteOnly) {
+			t.Errorf("%s: error %v, want vote-only error", test.name, err)
+		}
+	}
+}
@@ -0,0 +1,306 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/blockchain/stake/v5"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+)
+
+// VotePolicies describes the default consensus and treasury voting policies
+// of a wallet.  Per-ticket policies set by VSPs are not included.
+type VotePolicies struct {
+	Network string `json:"network"`
+	Created int64  `json:"created"`
+
+	// Nonce is a random hex-encoded value set when policies are exported.
+	// Each export may only be applied once by a wallet, so older policies
+	// can not be replayed over newer choices.
+	Nonce string `json:"nonce,omitempty"`
+
+	// Agendas maps the ID of every agenda of the supported stake version
+	// to the ID of the chosen choice, including abstains.
+	Agendas map[string]string `json:"agendas"`
+
+	// TSpends maps tspend hashes, and TreasuryKeys maps hex-encoded
+	// treasury keys, to a "yes" or "no" policy.  Treasury spends and keys
+	// without a policy are abstained from.
+	TSpends      map[string]string `json:"tspends"`
+	TreasuryKeys map[string]string `json:"treasurykeys"`
+}
+
+// signedVotePolicies is the encoding of exported vote policies.  Signature is
+// the base64-encoded message signature of the encoded policies created with
+// the key of Address.
+type signedVotePolicies struct {
+	Policies  json.RawMessage `json:"policies"`
+	Address   string          `json:"address"`
+	Signature string          `json:"signature"`
+}
+
+func treasuryVoteString(policy stake.TreasuryVoteT) string {
+	switch policy {
+	case stake.TreasuryVoteYes:
+		return "yes"
+	case stake.TreasuryVoteNo:
+		return "no"
+	default:
+		return "abstain"
+	}
+}
+
+func parseTreasuryVote(s string) (stake.TreasuryVoteT, error) {
+	switch s {
+	case "yes":
+		return stake.TreasuryVoteYes, nil
+	case "no":
+		return stake.TreasuryVoteNo, nil
+	case "abstain":
+		return stake.TreasuryVoteInvalid, nil
+	default:
+		return 0, errors.E(errors.Invalid, errors.Errorf("unknown treasury policy %q", s))
+	}
+}
+
+// VotePolicies returns the default consensus and treasury voting policies of
+// the wallet.
+func (w *Wallet) VotePolicies(ctx context.Context) (*VotePolicies, error) {
+	const op errors.Op = "wallet.VotePolicies"
+	agendas, _, err := w.AgendaChoices(ctx, nil)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	p := &VotePolicies{
+		Network:      w.chainParams.Name,
+		Created:      time.Now().Unix(),
+		Agendas:      agendas,
+		TSpends:      make(map[string]string),
+		TreasuryKeys: make(map[string]string),
+	}
+	w.stakeSettingsLock.Lock()
+	for hash, policy := range w.tspendPolicy {
+		if policy != stake.TreasuryVoteInvalid {
+			p.TSpends[hash.String()] = treasuryVoteString(policy)
+		}
+	}
+	for key, policy := range w.tspendKeyPolicy {
+		if policy != stake.TreasuryVoteInvalid {
+			p.TreasuryKeys[hex.EncodeToString([]byte(key))] = treasuryVoteString(policy)
+		}
+	}
+	w.stakeSettingsLock.Unlock()
+	return p, nil
+}
+
+// ExportVotePolicies returns the default voting policies of the wallet,
+// signed by the key of a wallet address, which may be applied to other
+// wallets by ImportVotePolicies.  The wallet must be unlocked.
+func (w *Wallet) ExportVotePolicies(ctx context.Context, signer stdaddr.Address) ([]byte, error) {
+	const op errors.Op = "wallet.ExportVotePolicies"
+	p, err := w.VotePolicies(ctx)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	var nonce [16]byte
+	if _, err := rand.Read(nonce[:]); err != nil {
+		return nil, errors.E(op, err)
+	}
+	p.Nonce = hex.EncodeToString(nonce[:])
+	policies, err := json.Marshal(p)
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	sig, err := w.SignMessage(ctx, string(policies), signer)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	blob, err := json.Marshal(&signedVotePolicies{
+		Policies:  policies,
+		Address:   signer.String(),
+		Signature: base64.StdEncoding.EncodeToString(sig),
+	})
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	return blob, nil
+}
+
+// ImportVotePolicies applies vote policies exported by ExportVotePolicies
+// after verifying they were signed by the key of the signer address.
+//
+// The default agenda choices are set to the exported choices, and the
+// treasury spend and treasury key policies are replaced by the exported
+// policies, abstaining from any treasury spends and keys without an exported
+// policy.  All policies are applied in a single database update, and nothing
+// is applied if the policies are not valid for the wallet's network and
+// supported agendas.  Each export may only be applied once; importing
+// policies with a previously applied nonce returns an errors.Exist error.
+func (w *Wallet) ImportVotePolicies(ctx context.Context, blob []byte, signer stdaddr.Address) (*VotePolicies, error) {
+	const op errors.Op = "wallet.ImportVotePolicies"
+	var s signedVotePolicies
+	if err := json.Unmarshal(blob, &s); err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	if s.Address != signer.String() {
+		return nil, errors.E(op, errors.Permission,
+			errors.Errorf("policies are signed by %s", s.Address))
+	}
+	sig, err := base64.StdEncoding.DecodeString(s.Signature)
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	valid, err := VerifyMessage(string(s.Policies), signer, sig, w.chainParams)
+	if err != nil || !valid {
+		return nil, errors.E(op, errors.Permission, "invalid policies signature")
+	}
+	p := new(VotePolicies)
+	if err := json.Unmarshal(s.Policies, p); err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	if p.Network != w.chainParams.Name {
+		return nil, errors.E(op, errors.Invalid,
+			errors.Errorf("policies are for network %q", p.Network))
+	}
+	nonce, err := hex.DecodeString(p.Nonce)
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	if len(nonce) == 0 {
+		return nil, errors.E(op, errors.Invalid, "policies have no nonce")
+	}
+
+	// Decode every policy before applying any.
+	version, deployments := CurrentAgendas(w.chainParams)
+	type maskChoice struct {
+		mask uint16
+		bits uint16
+	}
+	choices := make([]maskChoice, 0, len(p.Agendas))
+	for agendaID, choiceID := range p.Agendas {
+		var matchingAgenda *chaincfg.Vote
+		for i := range deployments {
+			if deployments[i].Vote.Id == agendaID {
+				matchingAgenda = &deployments[i].Vote
+				break
+			}
+		}
+		if matchingAgenda == nil {
+			return nil, errors.E(op, errors.Invalid,
+				errors.Errorf("no agenda with ID %q", agendaID))
+		}
+		var matchingChoice *chaincfg.Choice
+		for i := range matchingAgenda.Choices {
+			if matchingAgenda.Choices[i].Id == choiceID {
+				matchingChoice = &matchingAgenda.Choices[i]
+				break
+			}
+		}
+		if matchingChoice == nil {
+			return nil, errors.E(op, errors.Invalid, errors.Errorf("agenda "+
+				"%q has no choice ID %q", agendaID, choiceID))
+		}
+		choices = append(choices, maskChoice{
+			mask: matchingAgenda.Mask,
+			bits: matchingChoice.Bits,
+		})
+	}
+	tspends := make(map[chainhash.Hash]stake.TreasuryVoteT, len(p.TSpends))
+	for s, policy := range p.TSpends {
+		var hash chainhash.Hash
+		if err := chainhash.Decode(&hash, s); err != nil {
+			return nil, errors.E(op, errors.Encoding, err)
+		}
+		tspends[hash], err = parseTreasuryVote(policy)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+	keys := make(map[string]stake.TreasuryVoteT, len(p.TreasuryKeys))
+	for s, policy := range p.TreasuryKeys {
+		key, err := hex.DecodeString(s)
+		if err != nil {
+			return nil, errors.E(op, errors.Encoding, err)
+		}
+		keys[string(key)], err = parseTreasuryVote(policy)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+
+	defer w.stakeSettingsLock.Unlock()
+	w.stakeSettingsLock.Lock()
+
+	// Abstain from treasury spends and keys without an exported policy.
+	for hash := range w.tspendPolicy {
+		if _, ok := tspends[hash]; !ok {
+			tspends[hash] = stake.TreasuryVoteInvalid
+		}
+	}
+	for key := range w.tspendKeyPolicy {
+		if _, ok := keys[key]; !ok {
+			keys[key] = stake.TreasuryVoteInvalid
+		}
+	}
+
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		err := udb.PutVotePolicyNonce(dbtx, nonce, time.Now())
+		if err != nil {
+			return err
+		}
+		for agendaID, choiceID := range p.Agendas {
+			err := udb.SetDefaultAgendaPreference(dbtx, version, agendaID, choiceID)
+			if err != nil {
+				return err
+			}
+		}
+		for hash, policy := range tspends {
+			if err := udb.SetTSpendPolicy(dbtx, &hash, policy); err != nil {
+				return err
+			}
+		}
+		for key, policy := range keys {
+			if err := udb.SetTreasuryKeyPolicy(dbtx, []byte(key), policy); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	// With the DB update successful, modify the cached policies.
+	for _, c := range choices {
+		w.defaultVoteBits.Bits &^= c.mask
+		w.defaultVoteBits.Bits |= c.bits
+	}
+	for hash, policy := range tspends {
+		if policy == stake.TreasuryVoteInvalid {
+			delete(w.tspendPolicy, hash)
+			continue
+		}
+		w.tspendPolicy[hash] = policy
+	}
+	for key, policy := range keys {
+		if policy == stake.TreasuryVoteInvalid {
+			delete(w.tspendKeyPolicy, key)
+			continue
+		}
+		w.tspendKeyPolicy[key] = policy
+	}
+	return p, nil
+}
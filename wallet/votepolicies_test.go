@@ -0,0 +1,133 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"github.com/decred/dcrd/blockchain/stake/v5"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+)
+
+func TestVotePolicies(t *testing.T) {
+	ctx := context.Background()
+	src, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+	dst, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	if err := src.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	signer, err := src.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := src.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	yes := chainhash.Hash{1}
+	no := chainhash.Hash{2}
+	stale := chainhash.Hash{3}
+	key := bytes.Repeat([]byte{2}, 33)
+	for hash, policy := range map[chainhash.Hash]stake.TreasuryVoteT{
+		yes: stake.TreasuryVoteYes,
+		no:  stake.TreasuryVoteNo,
+	} {
+		if err := src.SetTSpendPolicy(ctx, &hash, policy, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := src.SetTreasuryKeyPolicy(ctx, key, stake.TreasuryVoteNo, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, deployments := CurrentAgendas(src.chainParams); len(deployments) != 0 {
+		agenda := &deployments[0].Vote
+		choices := map[string]string{agenda.Id: agenda.Choices[len(agenda.Choices)-1].Id}
+		if _, err := src.SetAgendaChoices(ctx, nil, choices); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := dst.SetTSpendPolicy(ctx, &stale, stake.TreasuryVoteYes, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	blob, err := src.ExportVotePolicies(ctx, signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = dst.ImportVotePolicies(ctx, blob, other)
+	if !errors.Is(err, errors.Permission) {
+		t.Fatalf("import with wrong signer: expected Permission error, got %v", err)
+	}
+	tampered := bytes.Replace(blob, []byte(`"yes"`), []byte(`"no"`), 1)
+	_, err = dst.ImportVotePolicies(ctx, tampered, signer)
+	if !errors.Is(err, errors.Permission) {
+		t.Fatalf("import of tampered policies: expected Permission error, got %v", err)
+	}
+
+	p, err := dst.ImportVotePolicies(ctx, blob, signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.TSpends) != 2 || len(p.TreasuryKeys) != 1 {
+		t.Fatalf("unexpected imported policies %+v", p)
+	}
+	for hash, want := range map[chainhash.Hash]stake.TreasuryVoteT{
+		yes:   stake.TreasuryVoteYes,
+		no:    stake.TreasuryVoteNo,
+		stale: stake.TreasuryVoteInvalid,
+	} {
+		if got := dst.TSpendPolicy(&hash, nil); got != want {
+			t.Errorf("tspend %v: policy %v, want %v", hash, got, want)
+		}
+	}
+	if got := dst.TreasuryKeyPolicy(key, nil); got != stake.TreasuryVoteNo {
+		t.Errorf("treasury key policy %v, want %v", got, stake.TreasuryVoteNo)
+	}
+
+	// Each export may only be applied once.
+	if err := dst.SetTSpendPolicy(ctx, &no, stake.TreasuryVoteYes, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dst.ImportVotePolicies(ctx, blob, signer); !errors.Is(err, errors.Exist) {
+		t.Fatalf("replayed import: expected Exist error, got %v", err)
+	}
+	if got := dst.TSpendPolicy(&no, nil); got != stake.TreasuryVoteYes {
+		t.Errorf("replayed import changed tspend policy to %v", got)
+	}
+
+	// A new export is applied.
+	blob, err = src.ExportVotePolicies(ctx, signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dst.ImportVotePolicies(ctx, blob, signer); err != nil {
+		t.Fatal(err)
+	}
+	if got := dst.TSpendPolicy(&no, nil); got != stake.TreasuryVoteNo {
+		t.Errorf("tspend %v: policy %v, want %v", no, got, stake.TreasuryVoteNo)
+	}
+
+	srcAgendas, _, err := src.AgendaChoices(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dstAgendas, _, err := dst.AgendaChoices(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for id, choice := range srcAgendas {
+		if dstAgendas[id] != choice {
+			t.Errorf("agenda %s: choice %q, want %q", id, dstAgendas[id], choice)
+		}
+	}
+}
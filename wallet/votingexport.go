@@ -0,0 +1,105 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"github.com/decred/dcrd/hdkeychain/v3"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+)
+
+// maxVotingAddresses limits the number of voting addresses derived when
+// exporting and verifying voting accounts.
+const maxVotingAddresses = 10000
+
+// VotingAccountExport is the key material required by a hot voting wallet to
+// vote the tickets of an account delegated by a cold staking wallet.  Xpriv is
+// imported by ImportVotingAccount on the voting wallet, and Addresses are the
+// first voting addresses of the account which both wallets must agree on.
+type VotingAccountExport struct {
+	Xpriv     *hdkeychain.ExtendedKey
+	Addresses []stdaddr.Address
+}
+
+// ExportVotingAccount exports the extended private key of an account which is
+// designated as the voting account of purchased tickets, together with the
+// first count voting addresses derived by the account.  The default account
+// and accounts holding any funds, including unconfirmed outputs, can not be
+// exported, as the voting wallet would also be able to spend them.  The wallet
+// or account must be unlocked.
+func (w *Wallet) ExportVotingAccount(ctx context.Context, account, count uint32) (*VotingAccountExport, error) {
+	const op errors.Op = "wallet.ExportVotingAccount"
+	if account == udb.DefaultAccountNum {
+		return nil, errors.E(op, errors.Invalid, "the default account can not "+
+			"be exported as a voting account")
+	}
+	bal, err := w.AccountBalance(ctx, account, 0)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if bal.Total != 0 {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("account "+
+			"holds %v which the voting wallet would be able to spend", bal.Total))
+	}
+	addrs, err := w.VotingAddresses(ctx, account, count)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	xpriv, err := w.AccountXpriv(ctx, account)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return &VotingAccountExport{Xpriv: xpriv, Addresses: addrs}, nil
+}
+
+// VotingAddresses returns the first count voting addresses of an account.
+// Tickets purchased with the account as the voting account pay their votes to
+// addresses of the account's internal branch.
+func (w *Wallet) VotingAddresses(ctx context.Context, account, count uint32) ([]stdaddr.Address, error) {
+	const op errors.Op = "wallet.VotingAddresses"
+	if count == 0 || count > maxVotingAddresses {
+		return nil, errors.E(op, errors.Invalid,
+			errors.Errorf("voting address count must be between 1 and %d", maxVotingAddresses))
+	}
+	xpub, err := w.AccountXpub(ctx, account)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	_, intKey, err := deriveBranches(xpub)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	addrs, err := deriveChildAddresses(intKey, 0, count, w.chainParams)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return addrs, nil
+}
+
+// VerifyVotingAddresses checks that addrs, as returned by ExportVotingAccount
+// or VotingAddresses of another wallet, are the first voting addresses of an
+// account.  An error with code errors.Invalid describes the first address
+// which does not match.
+func (w *Wallet) VerifyVotingAddresses(ctx context.Context, account uint32, addrs []stdaddr.Address) error {
+	const op errors.Op = "wallet.VerifyVotingAddresses"
+	derived, err := w.VotingAddresses(ctx, account, uint32(len(addrs)))
+	if err != nil {
+		return errors.E(op, err)
+	}
+	for i, a := range addrs {
+		if i >= len(derived) {
+			return errors.E(op, errors.Invalid, errors.Errorf(
+				"account does not derive voting address %d (%v)", i, a))
+		}
+		if a.String() != derived[i].String() {
+			return errors.E(op, errors.Invalid, errors.Errorf(
+				"voting address %d is %v, account derives %v", i, a, derived[i]))
+		}
+	}
+	return nil
+}
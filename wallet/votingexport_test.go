@@ -0,0 +1,89 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestExportVotingAccount(t *testing.T) {
+	ctx := context.Background()
+	coldCfg := basicWalletConfig
+	coldCfg.AccountGapLimit = 10
+	cold, teardown := testWallet(ctx, t, &coldCfg, nil)
+	defer teardown()
+	hot, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	for _, w := range []*Wallet{cold, hot} {
+		if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	account, err := cold.NextAccount(ctx, "voting")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cold.ExportVotingAccount(ctx, 0, 5); !errors.Is(err, errors.Invalid) {
+		t.Fatalf("exported default account: %v", err)
+	}
+
+	// An account holding funds can not be exported.
+	funded, err := cold.NextAccount(ctx, "funded")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, err := cold.NewExternalAddress(ctx, funded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, pkScript := addr.PaymentScript()
+	fund := wire.NewMsgTx()
+	fund.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, 0, nil))
+	fund.AddTxOut(&wire.TxOut{Value: 1e8, Version: version, PkScript: pkScript})
+	if err := cold.AddTransaction(ctx, fund, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cold.ExportVotingAccount(ctx, funded, 5); !errors.Is(err, errors.Invalid) {
+		t.Fatalf("exported funded account: %v", err)
+	}
+
+	export, err := cold.ExportVotingAccount(ctx, account, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(export.Addresses) != 5 {
+		t.Fatalf("exported %d voting addresses, want 5", len(export.Addresses))
+	}
+
+	hotAccount, err := hot.ImportVotingAccount(ctx, export.Xpriv, []byte("voting"), "cold")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := hot.VerifyVotingAddresses(ctx, hotAccount, export.Addresses); err != nil {
+		t.Fatal(err)
+	}
+	hotAddrs, err := hot.VotingAddresses(ctx, hotAccount, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cold.VerifyVotingAddresses(ctx, account, hotAddrs); err != nil {
+		t.Fatal(err)
+	}
+
+	// Addresses of other accounts and out of order addresses do not verify.
+	if err := hot.VerifyVotingAddresses(ctx, 0, export.Addresses); !errors.Is(err, errors.Invalid) {
+		t.Errorf("verified voting addresses of another account: %v", err)
+	}
+	swapped := append(export.Addresses[1:2:2], export.Addresses[0])
+	if err := hot.VerifyVotingAddresses(ctx, hotAccount, swapped); !errors.Is(err, errors.Invalid) {
+		t.Errorf("verified out of order voting addresses: %v", err)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/txscript/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/txscript/v4/stdscript"
+)
+
+// multisigVotingAddress returns the P2SH address committed to by tickets
+// granting voting rights to the holders of an m-of-n multisig redeem script.
+func multisigVotingAddress(script []byte, params *chaincfg.Params) (*stdaddr.AddressScriptHashV0, error) {
+	if len(script) > txscript.MaxScriptElementSize {
+		return nil, errors.E(errors.Invalid, "voting script is too large")
+	}
+	if !stdscript.IsMultiSigScriptV0(script) {
+		return nil, errors.E(errors.Invalid, "voting script is not a multisig script")
+	}
+	return stdaddr.NewAddressScriptHashV0(script, params)
+}
+
+// importVotingScript records a ticket voting script with the address manager
+// so tickets paying to its P2SH address are recognized as owned and their
+// votes may be signed.  Scripts which were previously imported are not an
+// error.
+func (w *Wallet) importVotingScript(ctx context.Context, script []byte) error {
+	_, err := w.ImportScript(ctx, script, NoRescan)
+	if errors.Is(err, errors.Exist) {
+		err = nil
+	}
+	return err
+}
+
+// checkVotingScriptKeys errors with errors.Invalid unless votes of tickets
+// committing to a voting script can be completely signed: the wallet must hold
+// the required number of the script's keys, or hold at least one key and have
+// vote cosigners configured to add the remaining signatures.  Tickets with
+// voting scripts failing this check would never vote.
+func (w *Wallet) checkVotingScriptKeys(ctx context.Context, script []byte) error {
+	var have, required uint16
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		have, required, err = w.multisigScriptKeys(
+			dbtx.ReadBucket(waddrmgrNamespaceKey), script)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	switch {
+	case required != 0 && have >= required:
+		return nil
+	case have != 0 && len(w.cosigners()) != 0:
+		return nil
+	}
+	return errors.E(errors.Invalid, errors.Errorf("wallet holds %d of the %d "+
+		"keys required to sign votes with the voting script and no vote "+
+		"cosigners are configured", have, required))
+}
+
+// haveMultisigKeys returns whether the wallet holds enough private keys of the
+// multisig redeem script of a P2SH address to create a complete signature
+// script.  Addresses of other scripts are reported as unsignable.
+func (w *Wallet) haveMultisigKeys(addrmgrNs walletdb.ReadBucket, addr stdaddr.Address) (bool, error) {
+	have, required, err := w.multisigKeys(addrmgrNs, addr)
+	if err != nil {
+		return false, err
+	}
+	return required != 0 && have >= required, nil
+}
+
+// multisigKeys returns the number of private keys the wallet holds of the
+// multisig redeem script of a P2SH address, and the number of signatures the
+// script requires.  Zero required signatures are returned for addresses of
+// other scripts.
+func (w *Wallet) multisigKeys(addrmgrNs walletdb.ReadBucket, addr stdaddr.Address) (have, required uint16, err error) {
+	script, err := w.manager.RedeemScript(addrmgrNs, addr)
+	if err != nil {
+		return 0, 0, err
+	}
+	return w.multisigScriptKeys(addrmgrNs, script)
+}
+
+// multisigScriptKeys returns the number of private keys the wallet holds of a
+// multisig script and the number of signatures the script requires.  Zero
+// required signatures are returned for other scripts.
+func (w *Wallet) multisigScriptKeys(addrmgrNs walletdb.ReadBucket, script []byte) (have, required uint16, err error) {
+	details := stdscript.ExtractMultiSigScriptDetailsV0(script, true)
+	if !details.Valid {
+		return 0, 0, nil
+	}
+	for _, pk := range details.PubKeys {
+		pkh, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(
+			stdaddr.Hash160(pk), w.chainParams)
+		if err != nil {
+			return 0, 0, err
+		}
+		haveKey, err := w.manager.HavePrivateKey(addrmgrNs, pkh)
+		if errors.Is(err, errors.NotExist) {
+			continue
+		}
+		if err != nil {
+			return 0, 0, err
+		}
+		if haveKey {
+			have++
+		}
+	}
+	return have, details.RequiredSigs, nil
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/txscript/v4"
+	"github.com/decred/dcrd/txscript/v4/stdscript"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestMultisigVotingAuthority(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	walletPubKey := func() []byte {
+		addr, err := w.NewExternalAddress(ctx, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ka, err := w.KnownAddress(ctx, addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ka.(PubKeyHashAddress).PubKey()
+	}
+	foreignKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	foreignPubKey := foreignKey.PubKey().SerializeCompressed()
+
+	if _, err := multisigVotingAddress(foreignPubKey, w.chainParams); !errors.Is(err, errors.Invalid) {
+		t.Errorf("non-multisig voting script accepted: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		pubKeys  [][]byte
+		haveKeys bool
+	}{
+		{"below threshold", [][]byte{walletPubKey(), foreignPubKey, foreignPubKey}, false},
+		{"at threshold", [][]byte{walletPubKey(), foreignPubKey, walletPubKey()}, true},
+	}
+	for _, test := range tests {
+		script, err := stdscript.MultiSigScriptV0(2, test.pubKeys...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p2sh, err := multisigVotingAddress(script, w.chainParams)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ticket := wire.NewMsgTx()
+		version, pkScript := p2sh.VotingRightsScript()
+		ticket.AddTxOut(&wire.TxOut{Version: version, PkScript: pkScript})
+
+		// Tickets may only commit to scripts the wallet can sign votes
+		// with, by itself or with vote cosigners.
+		err = w.checkVotingScriptKeys(ctx, script)
+		if (err == nil) != test.haveKeys {
+			t.Errorf("%s: check voting script keys: %v", test.name, err)
+		}
+		w.SetVoteCosigners(0, failingVoteCosigner{})
+		if err := w.checkVotingScriptKeys(ctx, script); err != nil {
+			t.Errorf("%s: check voting script keys with cosigners: %v", test.name, err)
+		}
+		w.SetVoteCosigners(0)
+
+		mine, haveKeys := hasVotingAuthority(ctx, t, w, ticket)
+		if mine {
+			t.Errorf("%s: ticket owned before importing voting script", test.name)
+		}
+		for i := 0; i < 2; i++ {
+			if err := w.importVotingScript(ctx, script); err != nil {
+				t.Fatalf("%s: import %d: %v", test.name, i, err)
+			}
+		}
+		mine, haveKeys = hasVotingAuthority(ctx, t, w, ticket)
+		if !mine || haveKeys != test.haveKeys {
+			t.Errorf("%s: owned=%v haveKeys=%v", test.name, mine, haveKeys)
+		}
+		if !haveKeys {
+			continue
+		}
+
+		// Both wallet keys sign the ticket input of a vote.
+		ticketHash := ticket.TxHash()
+		vote := wire.NewMsgTx()
+		vote.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: wire.MaxPrevOutIndex}, 0, nil))
+		vote.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&ticketHash, 0, wire.TxTreeStake), 0, nil))
+		err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+			return w.signVote(dbtx.ReadBucket(waddrmgrNamespaceKey), ticket, vote)
+		})
+		if err != nil {
+			t.Fatalf("%s: sign vote: %v", test.name, err)
+		}
+		vm, err := txscript.NewEngine(pkScript, vote, 1, sanityVerifyFlags,
+			scriptVersionAssumed, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := vm.Execute(); err != nil {
+			t.Errorf("%s: vote signature script invalid: %v", test.name, err)
+		}
+	}
+}
+
+func hasVotingAuthority(ctx context.Context, t *testing.T, w *Wallet, ticket *wire.MsgTx) (mine, haveKeys bool) {
+	t.Helper()
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		mine, haveKeys, err = w.hasVotingAuthority(dbtx.ReadBucket(waddrmgrNamespaceKey), ticket)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return mine, haveKeys
+}
@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"net/url"
 	"sync"
+	"time"
 
 	"decred.org/dcrwallet/v5/errors"
 	"decred.org/dcrwallet/v5/wallet/udb"
@@ -39,6 +40,10 @@ type VSPClient struct {
 	mu   sync.Mutex
 	jobs map[chainhash.Hash]*vspFeePayment
 
+	retryMu     sync.Mutex
+	retryTimers map[chainhash.Hash]*time.Timer
+	closed      bool // no retries are scheduled once closed
+
 	log slog.Logger
 }
 
@@ -75,7 +80,7 @@ func (w *Wallet) NewVSPClient(cfg VSPClientConfig, log slog.Logger, dialer DialF
 	client := &vspd.Client{
 		URL:    u.String(),
 		PubKey: pubKey,
-		Sign:   w.SignMessage,
+		Sign:   w.vspClientSigner(DefaultVSPAPIVersion),
 		Log:    log,
 	}
 	client.Transport = &http.Transport{
@@ -88,6 +93,8 @@ func (w *Wallet) NewVSPClient(cfg VSPClientConfig, log slog.Logger, dialer DialF
 		Client: client,
 		jobs:   make(map[chainhash.Hash]*vspFeePayment),
 		log:    log,
+
+		retryTimers: make(map[chainhash.Hash]*time.Timer),
 	}
 	return v, nil
 }
@@ -192,7 +199,16 @@ func (c *VSPClient) ProcessManagedTickets(ctx context.Context, tickets []*VSPTic
 // the inputs and the fee and change outputs before returning without an error.
 // The fee transaction is also recorded as unpublised in the wallet, and the fee
 // hash is associated with the ticket.
+//
+// Fee payments which fail are recorded in the wallet and retried with
+// increasing delays by the client for the VSP, including after restarts.  A
+// fee payment which can not be completed is eventually abandoned and recorded
+// in the event journal.
 func (c *VSPClient) Process(ctx context.Context, ticket *VSPTicket, feeTx *wire.MsgTx) error {
+	// Processing replaces any scheduled retry, which is scheduled again
+	// if the fee payment fails.
+	c.stopFeeRetry(ticket.Hash())
+
 	vspTicket, err := ticket.VSPTicketInfo(ctx)
 	if err != nil && !errors.Is(err, errors.NotExist) {
 		return err
@@ -205,40 +221,11 @@ func (c *VSPClient) Process(ctx context.Context, ticket *VSPTicket, feeTx *wire.
 	switch feeStatus {
 	case udb.VSPFeeProcessStarted, udb.VSPFeeProcessErrored:
 		// If VSPTicket has been started or errored then attempt to create a new fee
-		// transaction, submit it then confirm.
-		fp := c.feePayment(ctx, ticket, false)
-		if fp == nil {
-			err := ticket.UpdateFeeErrored(ctx, c.Client.URL, c.Client.PubKey)
-			if err != nil {
-				return err
-			}
-			return fmt.Errorf("fee payment cannot be processed")
-		}
-		fp.mu.Lock()
-		if fp.feeTx == nil {
-			fp.feeTx = feeTx
-		}
-		fp.mu.Unlock()
-		err := fp.receiveFeeAddress()
-		if err != nil {
-			err := ticket.UpdateFeeErrored(ctx, c.Client.URL, c.Client.PubKey)
-			if err != nil {
-				return err
-			}
-			// XXX, retry? (old Process retried)
-			// but this may not be necessary any longer as the parent of
-			// the ticket is always relayed to the vsp as well.
-			return err
-		}
-		err = fp.makeFeeTx(feeTx)
-		if err != nil {
-			err := ticket.UpdateFeeErrored(ctx, c.Client.URL, c.Client.PubKey)
-			if err != nil {
-				return err
-			}
-			return err
-		}
-		return fp.submitPayment()
+		// transaction, submit it then confirm.  Failures are queued to be
+		// retried.
+		err := c.payFee(ctx, ticket, feeTx)
+		c.recordFeeResult(ctx, ticket.Hash(), err)
+		return err
 	case udb.VSPFeeProcessPaid:
 		// If a VSP ticket has been paid, but confirm payment.
 		if len(vspTicket.Host) > 0 && vspTicket.Host != c.Client.URL {
@@ -260,6 +247,40 @@ func (c *VSPClient) Process(ctx context.Context, ticket *VSPTicket, feeTx *wire.
 	return nil
 }
 
+// payFee creates a new fee transaction for a ticket and submits it to the VSP.
+func (c *VSPClient) payFee(ctx context.Context, ticket *VSPTicket, feeTx *wire.MsgTx) error {
+	fp := c.feePayment(ctx, ticket, false)
+	if fp == nil {
+		err := ticket.UpdateFeeErrored(ctx, c.Client.URL, c.Client.PubKey)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("fee payment cannot be processed")
+	}
+	fp.mu.Lock()
+	if fp.feeTx == nil {
+		fp.feeTx = feeTx
+	}
+	fp.mu.Unlock()
+	err := fp.receiveFeeAddress()
+	if err != nil {
+		uerr := ticket.UpdateFeeErrored(ctx, c.Client.URL, c.Client.PubKey)
+		if uerr != nil {
+			return uerr
+		}
+		return err
+	}
+	err = fp.makeFeeTx(feeTx)
+	if err != nil {
+		uerr := ticket.UpdateFeeErrored(ctx, c.Client.URL, c.Client.PubKey)
+		if uerr != nil {
+			return uerr
+		}
+		return err
+	}
+	return fp.submitPayment()
+}
+
 // SetVoteChoice takes the provided consensus, tspend and treasury key voting
 // preferences, and checks if they match the status of the specified ticket from
 // the connected VSP. The status provides the current voting preferences so we
@@ -1,6 +1,8 @@
 package wallet
 
 import (
+	"context"
+
 	"decred.org/dcrwallet/v5/errors"
 	"decred.org/dcrwallet/v5/internal/loggers"
 )
@@ -20,6 +22,10 @@ func (w *Wallet) VSP(cfg VSPClientConfig) (*VSPClient, error) {
 		return nil, err
 	}
 	w.vspClients[key] = client
+	err = client.resumeFeeRetries(context.Background())
+	if err != nil {
+		client.log.Errorf("Unable to resume VSP fee payment retries: %v", err)
+	}
 	return client, nil
 }
 
@@ -48,3 +54,14 @@ func (w *Wallet) AllVSPs() map[string]*VSPClient {
 	}
 	return res
 }
+
+// CloseVSPs closes and deregisters all VSP clients, stopping their scheduled
+// fee payment retries.  It must be called before closing the wallet database.
+func (w *Wallet) CloseVSPs() {
+	w.vspClientsMu.Lock()
+	defer w.vspClientsMu.Unlock()
+	for host, client := range w.vspClients {
+		client.Close()
+		delete(w.vspClients, host)
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/vspd/types/v3"
+)
+
+// Failed VSP fee payments are retried after a delay beginning at
+// vspFeeRetryBase and doubling with every failed attempt, up to
+// vspFeeRetryMax.  The payment is abandoned after maxVSPFeeRetries failed
+// retries.
+const (
+	vspFeeRetryBase  = 5 * time.Minute
+	vspFeeRetryMax   = 6 * time.Hour
+	maxVSPFeeRetries = 12
+)
+
+// vspFeeRetryDelay returns the delay before retrying a fee payment which has
+// failed attempts times.
+func vspFeeRetryDelay(attempts uint32) time.Duration {
+	d := vspFeeRetryBase
+	for i := uint32(1); i < attempts && d < vspFeeRetryMax; i++ {
+		d *= 2
+	}
+	return min(d, vspFeeRetryMax)
+}
+
+// recordFeeResult updates the persisted fee retry queue with the result of an
+// attempt to pay the VSP fee of a ticket.  Failed payments are queued to be
+// retried, and payments which succeeded or are no longer needed are removed
+// from the queue.
+func (c *VSPClient) recordFeeResult(ctx context.Context, ticketHash *chainhash.Hash, result error) {
+	var err error
+	var apiErr types.ErrorResponse
+	switch {
+	case result == nil, errors.Is(result, errStopped):
+		err = c.clearFeeRetry(ctx, ticketHash)
+	case errors.As(result, &apiErr) && apiErr.Code == types.ErrTicketCannotVote:
+		err = c.failFeeRetry(ctx, ticketHash, "ticket cannot vote")
+	default:
+		err = c.queueFeeRetry(ctx, ticketHash, result)
+	}
+	if err != nil {
+		c.log.Errorf("Unable to update VSP fee retry for ticket %v: %v",
+			ticketHash, err)
+	}
+}
+
+// queueFeeRetry records a failed fee payment for a ticket and schedules
+// another attempt.  The payment is abandoned once retries are exhausted.
+func (c *VSPClient) queueFeeRetry(ctx context.Context, ticketHash *chainhash.Hash, cause error) error {
+	var r *udb.VSPFeeRetry
+	err := walletdb.Update(ctx, c.wallet.db, func(dbtx walletdb.ReadWriteTx) error {
+		var err error
+		r, err = udb.VSPFeeRetryForTicket(dbtx, ticketHash)
+		if errors.Is(err, errors.NotExist) {
+			r, err = new(udb.VSPFeeRetry), nil
+		}
+		if err != nil {
+			return err
+		}
+		r.Attempts++
+		if r.Attempts > maxVSPFeeRetries {
+			return abandonFeeRetry(dbtx, ticketHash)
+		}
+		r.Host = c.Client.URL
+		r.NextAttempt = time.Now().Add(vspFeeRetryDelay(r.Attempts))
+		r.LastError = cause.Error()
+		return udb.PutVSPFeeRetry(dbtx, ticketHash, r)
+	})
+	if err != nil {
+		return err
+	}
+	if r.Attempts > maxVSPFeeRetries {
+		c.stopFeeRetry(ticketHash)
+		c.log.Errorf("Abandoning VSP fee payment for ticket %v after %d "+
+			"failed attempts: %v", ticketHash, r.Attempts, cause)
+		return nil
+	}
+	delay := time.Until(r.NextAttempt)
+	c.log.Warnf("VSP fee payment for ticket %v failed (attempt %d), retrying "+
+		"in %v: %v", ticketHash, r.Attempts, delay.Round(time.Second), cause)
+	c.scheduleFeeRetry(ticketHash, delay)
+	return nil
+}
+
+// failFeeRetry abandons the fee payment for a ticket which can no longer be
+// paid.
+func (c *VSPClient) failFeeRetry(ctx context.Context, ticketHash *chainhash.Hash, reason string) error {
+	c.stopFeeRetry(ticketHash)
+	err := walletdb.Update(ctx, c.wallet.db, func(dbtx walletdb.ReadWriteTx) error {
+		return abandonFeeRetry(dbtx, ticketHash)
+	})
+	if err != nil {
+		return err
+	}
+	c.log.Errorf("Abandoning VSP fee payment for ticket %v: %s", ticketHash, reason)
+	return nil
+}
+
+// abandonFeeRetry removes a ticket from the fee retry queue and records the
+// permanent failure in the event journal.
+func abandonFeeRetry(dbtx walletdb.ReadWriteTx, ticketHash *chainhash.Hash) error {
+	err := udb.DeleteVSPFeeRetry(dbtx, ticketHash)
+	if err != nil {
+		return err
+	}
+	return udb.AppendJournalEvents(dbtx, &udb.JournalEvent{
+		Type: udb.JournalVSPFeeFailed,
+		Hash: *ticketHash,
+		Time: time.Now(),
+	})
+}
+
+// clearFeeRetry removes a ticket from the fee retry queue.
+func (c *VSPClient) clearFeeRetry(ctx context.Context, ticketHash *chainhash.Hash) error {
+	c.stopFeeRetry(ticketHash)
+	return walletdb.Update(ctx, c.wallet.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.DeleteVSPFeeRetry(dbtx, ticketHash)
+	})
+}
+
+// scheduleFeeRetry schedules a retry of the fee payment for a ticket after
+// delay, replacing any previously scheduled retry.
+func (c *VSPClient) scheduleFeeRetry(ticketHash *chainhash.Hash, delay time.Duration) {
+	hash := *ticketHash
+	c.retryMu.Lock()
+	defer c.retryMu.Unlock()
+	if c.closed {
+		return
+	}
+	if t := c.retryTimers[hash]; t != nil {
+		t.Stop()
+	}
+	c.retryTimers[hash] = time.AfterFunc(delay, func() {
+		c.retryFee(&hash)
+	})
+}
+
+// stopFeeRetry cancels any scheduled retry of the fee payment for a ticket.
+func (c *VSPClient) stopFeeRetry(ticketHash *chainhash.Hash) {
+	c.retryMu.Lock()
+	defer c.retryMu.Unlock()
+	if t := c.retryTimers[*ticketHash]; t != nil {
+		t.Stop()
+		delete(c.retryTimers, *ticketHash)
+	}
+}
+
+// Close stops all scheduled fee payment retries of the client.  Queued retries
+// remain recorded in the wallet and are resumed by the next client created for
+// the VSP.
+func (c *VSPClient) Close() {
+	c.retryMu.Lock()
+	defer c.retryMu.Unlock()
+	c.closed = true
+	for hash, t := range c.retryTimers {
+		t.Stop()
+		delete(c.retryTimers, hash)
+	}
+}
+
+// retryFee attempts to pay the VSP fee of a queued ticket again.
+func (c *VSPClient) retryFee(ticketHash *chainhash.Hash) {
+	ctx := context.Background()
+	c.retryMu.Lock()
+	delete(c.retryTimers, *ticketHash)
+	c.retryMu.Unlock()
+
+	ticket, err := c.wallet.NewVSPTicket(ctx, ticketHash)
+	switch {
+	case errors.Is(err, errors.NotExist):
+		err = c.failFeeRetry(ctx, ticketHash, "ticket was removed from the wallet")
+	case err != nil:
+		err = c.queueFeeRetry(ctx, ticketHash, err)
+	case ticket.Spent(ctx):
+		err = c.clearFeeRetry(ctx, ticketHash)
+	case ticket.Expired(ctx):
+		err = c.failFeeRetry(ctx, ticketHash, "ticket expired")
+	default:
+		c.log.Infof("Retrying VSP fee payment for ticket %v", ticketHash)
+		// Process records the result in the retry queue.
+		err = c.Process(ctx, ticket, nil)
+	}
+	if err != nil {
+		c.log.Errorf("Ticket %v: retry fee payment: %v", ticketHash, err)
+	}
+}
+
+// resumeFeeRetries schedules the queued fee payment retries of tickets
+// registered with the VSP.  Retries which were due while the wallet was not
+// running are scheduled immediately.
+func (c *VSPClient) resumeFeeRetries(ctx context.Context) error {
+	var retries map[chainhash.Hash]*udb.VSPFeeRetry
+	err := walletdb.View(ctx, c.wallet.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		retries, err = udb.VSPFeeRetries(dbtx)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	for hash, r := range retries {
+		if r.Host != c.Client.URL {
+			continue
+		}
+		c.scheduleFeeRetry(&hash, max(time.Until(r.NextAttempt), 0))
+	}
+	return nil
+}
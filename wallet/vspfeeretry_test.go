@@ -0,0 +1,176 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/slog"
+)
+
+func TestVSPFeeRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempts uint32
+		delay    time.Duration
+	}{
+		{1, 5 * time.Minute},
+		{2, 10 * time.Minute},
+		{3, 20 * time.Minute},
+		{7, 320 * time.Minute},
+		{8, vspFeeRetryMax},
+		{maxVSPFeeRetries, vspFeeRetryMax},
+	}
+	for _, test := range tests {
+		if d := vspFeeRetryDelay(test.attempts); d != test.delay {
+			t.Errorf("attempt %d: delay %v, want %v", test.attempts, d, test.delay)
+		}
+	}
+}
+
+func TestVSPFeeRetryQueue(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	c, err := w.NewVSPClient(VSPClientConfig{
+		URL:    "https://vsp.example.com",
+		PubKey: "AAAA",
+		Policy: &VSPPolicy{},
+	}, slog.Disabled, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	retry := func(hash *chainhash.Hash) *udb.VSPFeeRetry {
+		t.Helper()
+		var r *udb.VSPFeeRetry
+		err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+			var err error
+			r, err = udb.VSPFeeRetryForTicket(dbtx, hash)
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+	scheduled := func(hash *chainhash.Hash) bool {
+		c.retryMu.Lock()
+		defer c.retryMu.Unlock()
+		return c.retryTimers[*hash] != nil
+	}
+
+	ticket := chainhash.Hash{1}
+	cause := errors.New("insufficient funds")
+	c.recordFeeResult(ctx, &ticket, cause)
+	r := retry(&ticket)
+	if r.Attempts != 1 || r.Host != c.Client.URL || r.LastError != cause.Error() {
+		t.Fatalf("recorded retry %+v", r)
+	}
+	if d := time.Until(r.NextAttempt); d <= 0 || d > vspFeeRetryBase {
+		t.Errorf("next attempt in %v", d)
+	}
+	if !scheduled(&ticket) {
+		t.Fatal("retry not scheduled")
+	}
+	c.stopFeeRetry(&ticket)
+
+	// Queued retries are scheduled again by clients for the same VSP.
+	other, err := w.NewVSPClient(VSPClientConfig{
+		URL:    "https://other.example.com",
+		PubKey: "AAAA",
+		Policy: &VSPPolicy{},
+	}, slog.Disabled, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := other.resumeFeeRetries(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(other.retryTimers) != 0 {
+		t.Error("retry resumed by client for another VSP")
+	}
+	if err := c.resumeFeeRetries(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if !scheduled(&ticket) {
+		t.Fatal("retry not resumed")
+	}
+
+	// Exhausting the retries abandons the payment and records the failure
+	// in the event journal.
+	for i := 1; i <= maxVSPFeeRetries; i++ {
+		c.recordFeeResult(ctx, &ticket, cause)
+	}
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		_, err := udb.VSPFeeRetryForTicket(dbtx, &ticket)
+		return err
+	})
+	if err == nil {
+		t.Fatal("abandoned retry remains queued")
+	}
+	if scheduled(&ticket) {
+		t.Error("abandoned retry remains scheduled")
+	}
+	events, _, err := w.JournalEvents(ctx, 0, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].Type != udb.JournalVSPFeeFailed ||
+		events[0].Hash != ticket {
+		t.Fatalf("failure not recorded in journal: %v", events)
+	}
+
+	// Successful payments are removed from the queue.
+	c.recordFeeResult(ctx, &ticket, cause)
+	c.recordFeeResult(ctx, &ticket, nil)
+	if scheduled(&ticket) {
+		t.Error("retry scheduled after successful payment")
+	}
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		retries, err := udb.VSPFeeRetries(dbtx)
+		if len(retries) != 0 {
+			t.Errorf("%d retries queued after successful payment", len(retries))
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Closing the wallet's clients stops scheduled retries, which remain
+	// queued for the next client of the VSP.
+	c, err = w.VSP(VSPClientConfig{
+		URL:    "https://vsp.example.com",
+		PubKey: "AAAA",
+		Policy: &VSPPolicy{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.recordFeeResult(ctx, &ticket, cause)
+	if !scheduled(&ticket) {
+		t.Fatal("retry not scheduled")
+	}
+	w.CloseVSPs()
+	if scheduled(&ticket) {
+		t.Error("retry remains scheduled after close")
+	}
+	c.scheduleFeeRetry(&ticket, time.Hour)
+	if scheduled(&ticket) {
+		t.Error("retry scheduled by closed client")
+	}
+	if _, err := w.LookupVSP(c.Client.URL); err == nil {
+		t.Error("closed client remains registered")
+	}
+	if retry(&ticket).Attempts != 1 {
+		t.Error("retry not queued after close")
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"decred.org/dcrwallet/v5/errors"
+	"github.com/decred/dcrd/blockchain/stake/v5"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/txscript/v4/stdscript"
+)
+
+// DefaultVSPAPIVersion is the version of the VSP API used by the VSP client.
+const DefaultVSPAPIVersion = 3
+
+// vspClientSignatureHeader is the HTTP header in which the VSP client sends
+// ownership proofs.
+const vspClientSignatureHeader = "VSP-Client-Signature"
+
+// VSPProofTicket describes the keys of a ticket which may prove its ownership
+// to a VSP.
+type VSPProofTicket struct {
+	Hash           chainhash.Hash
+	VotingAddr     stdaddr.StakeAddress
+	CommitmentAddr stdaddr.StakeAddress
+}
+
+// VSPOwnershipProof is a signature proving ownership of a ticket to a VSP.
+// Header names the HTTP header in which the signature is sent to the VSP,
+// and Address is the address whose key created the signature.
+type VSPOwnershipProof struct {
+	Ticket    chainhash.Hash
+	Address   stdaddr.Address
+	Header    string
+	Signature []byte
+}
+
+// SignMessageFunc signs a message with the key of a wallet address.
+type SignMessageFunc func(ctx context.Context, message string, addr stdaddr.Address) ([]byte, error)
+
+// VSPProofSigner creates ticket ownership proofs of the requests of a version
+// of the VSP API.  Implementations choose the signed message and signing key,
+// and sign with the provided function.
+type VSPProofSigner interface {
+	ProveOwnership(ctx context.Context, sign SignMessageFunc, ticket *VSPProofTicket,
+		request []byte) (*VSPOwnershipProof, error)
+}
+
+var vspProofSigners = struct {
+	sync.RWMutex
+	m map[uint32]VSPProofSigner
+}{m: map[uint32]VSPProofSigner{3: vspV3ProofSigner{}}}
+
+// RegisterVSPProofSigner registers the ownership proof signer of a VSP API
+// version, replacing any previously registered signer of the version.
+func RegisterVSPProofSigner(apiVersion uint32, signer VSPProofSigner) {
+	vspProofSigners.Lock()
+	vspProofSigners.m[apiVersion] = signer
+	vspProofSigners.Unlock()
+}
+
+// vspV3ProofSigner signs the JSON bodies of version 3 VSP API requests with
+// the key of the ticket commitment address.
+type vspV3ProofSigner struct{}
+
+func (vspV3ProofSigner) ProveOwnership(ctx context.Context, sign SignMessageFunc,
+	ticket *VSPProofTicket, request []byte) (*VSPOwnershipProof, error) {
+
+	// Every request of the API identifies the ticket, and signing requests
+	// of other tickets would prove ownership of the wrong ticket.
+	var body struct {
+		TicketHash string `json:"tickethash"`
+	}
+	if err := json.Unmarshal(request, &body); err != nil {
+		return nil, errors.E(errors.Encoding, err)
+	}
+	if body.TicketHash != ticket.Hash.String() {
+		return nil, errors.E(errors.Invalid, "request is not for the ticket")
+	}
+	sig, err := sign(ctx, string(request), ticket.CommitmentAddr)
+	if err != nil {
+		return nil, err
+	}
+	return &VSPOwnershipProof{
+		Ticket:    ticket.Hash,
+		Address:   ticket.CommitmentAddr,
+		Header:    vspClientSignatureHeader,
+		Signature: sig,
+	}, nil
+}
+
+// ProveVSPOwnership signs a VSP API request of a wallet ticket as required by
+// the registered signer of the API version.  The wallet must be unlocked.
+func (w *Wallet) ProveVSPOwnership(ctx context.Context, apiVersion uint32,
+	ticketHash *chainhash.Hash, request []byte) (*VSPOwnershipProof, error) {
+
+	const op errors.Op = "wallet.ProveVSPOwnership"
+	vspProofSigners.RLock()
+	signer, ok := vspProofSigners.m[apiVersion]
+	vspProofSigners.RUnlock()
+	if !ok {
+		return nil, errors.E(op, errors.Invalid,
+			errors.Errorf("unsupported VSP API version %d", apiVersion))
+	}
+
+	txs, _, err := w.GetTransactionsByHashes(ctx, []*chainhash.Hash{ticketHash})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	ticketTx := txs[0]
+	if !stake.IsSStx(ticketTx) {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("%v is not a ticket", ticketHash))
+	}
+	ticket := &VSPProofTicket{Hash: *ticketHash}
+	_, addrs := stdscript.ExtractAddrs(ticketTx.TxOut[0].Version,
+		ticketTx.TxOut[0].PkScript, w.chainParams)
+	if len(addrs) == 1 {
+		ticket.VotingAddr, _ = addrs[0].(stdaddr.StakeAddress)
+	}
+	if ticket.VotingAddr == nil {
+		return nil, errors.E(op, errors.Invalid,
+			errors.Errorf("cannot parse voting address of ticket %v", ticketHash))
+	}
+	ticket.CommitmentAddr, err = stake.AddrFromSStxPkScrCommitment(
+		ticketTx.TxOut[1].PkScript, w.chainParams)
+	if err != nil {
+		return nil, errors.E(op, errors.Invalid, err)
+	}
+
+	proof, err := signer.ProveOwnership(ctx, w.SignMessage, ticket, request)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return proof, nil
+}
+
+// vspClientSigner returns the signing function of VSP clients.  Requests are
+// signed by the registered signer of the VSP API version, which must send its
+// proofs in the header used by the client.
+func (w *Wallet) vspClientSigner(apiVersion uint32) SignMessageFunc {
+	return func(ctx context.Context, message string, _ stdaddr.Address) ([]byte, error) {
+		var body struct {
+			TicketHash string `json:"tickethash"`
+		}
+		if err := json.Unmarshal([]byte(message), &body); err != nil {
+			return nil, errors.E(errors.Encoding, err)
+		}
+		ticketHash, err := chainhash.NewHashFromStr(body.TicketHash)
+		if err != nil {
+			return nil, errors.E(errors.Encoding, err)
+		}
+		proof, err := w.ProveVSPOwnership(ctx, apiVersion, ticketHash, []byte(message))
+		if err != nil {
+			return nil, err
+		}
+		if proof.Header != vspClientSignatureHeader {
+			return nil, errors.E(errors.Invalid, errors.Errorf("VSP API "+
+				"version %d proofs use unsupported header %q", apiVersion,
+				proof.Header))
+		}
+		return proof.Signature, nil
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/wire"
+)
+
+type testVSPProofSigner struct{}
+
+func (testVSPProofSigner) ProveOwnership(ctx context.Context, sign SignMessageFunc,
+	ticket *VSPProofTicket, request []byte) (*VSPOwnershipProof, error) {
+
+	msg := ticket.Hash.String() + ":" + string(request)
+	sig, err := sign(ctx, msg, ticket.VotingAddr)
+	if err != nil {
+		return nil, err
+	}
+	return &VSPOwnershipProof{
+		Ticket:    ticket.Hash,
+		Address:   ticket.VotingAddr,
+		Header:    "Test-Signature",
+		Signature: sig,
+	}, nil
+}
+
+// registerTestVSPProofSigner registers a signer of an API version for the
+// duration of a test, restoring the previously registered signer afterwards.
+func registerTestVSPProofSigner(t *testing.T, apiVersion uint32, signer VSPProofSigner) {
+	t.Helper()
+	vspProofSigners.Lock()
+	prev, ok := vspProofSigners.m[apiVersion]
+	vspProofSigners.m[apiVersion] = signer
+	vspProofSigners.Unlock()
+	t.Cleanup(func() {
+		vspProofSigners.Lock()
+		defer vspProofSigners.Unlock()
+		if ok {
+			vspProofSigners.m[apiVersion] = prev
+		} else {
+			delete(vspProofSigners.m, apiVersion)
+		}
+	})
+}
+
+func TestProveVSPOwnership(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	newAddr := func() stdaddr.StakeAddress {
+		t.Helper()
+		addr, err := w.NewExternalAddress(ctx, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return addr.(stdaddr.StakeAddress)
+	}
+	votingAddr, commitmentAddr := newAddr(), newAddr()
+	ticket := wire.NewMsgTx()
+	ticket.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{1}}, 1e8, nil))
+	version, pkScript := votingAddr.VotingRightsScript()
+	ticket.AddTxOut(&wire.TxOut{Value: 1e8, Version: version, PkScript: pkScript})
+	version, pkScript = commitmentAddr.RewardCommitmentScript(1e8, 0, 0)
+	ticket.AddTxOut(&wire.TxOut{Version: version, PkScript: pkScript})
+	version, pkScript = commitmentAddr.StakeChangeScript()
+	ticket.AddTxOut(&wire.TxOut{Version: version, PkScript: pkScript})
+	if err := w.AddTransaction(ctx, ticket, nil); err != nil {
+		t.Fatal(err)
+	}
+	ticketHash := ticket.TxHash()
+
+	request := fmt.Sprintf(`{"timestamp":1,"tickethash":%q}`, ticketHash)
+	proof, err := w.ProveVSPOwnership(ctx, DefaultVSPAPIVersion, &ticketHash, []byte(request))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proof.Address.String() != commitmentAddr.String() || proof.Header != "VSP-Client-Signature" {
+		t.Fatalf("unexpected proof %+v", proof)
+	}
+	valid, err := VerifyMessage(request, commitmentAddr, proof.Signature, w.chainParams)
+	if err != nil || !valid {
+		t.Fatalf("invalid proof signature: %v", err)
+	}
+
+	other := fmt.Sprintf(`{"tickethash":%q}`, chainhash.Hash{2})
+	_, err = w.ProveVSPOwnership(ctx, DefaultVSPAPIVersion, &ticketHash, []byte(other))
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("request of another ticket: expected Invalid error, got %v", err)
+	}
+	_, err = w.ProveVSPOwnership(ctx, 99, &ticketHash, []byte(request))
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("unregistered API version: expected Invalid error, got %v", err)
+	}
+
+	registerTestVSPProofSigner(t, 99, testVSPProofSigner{})
+	proof, err = w.ProveVSPOwnership(ctx, 99, &ticketHash, []byte("challenge"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid, err = VerifyMessage(ticketHash.String()+":challenge", votingAddr,
+		proof.Signature, w.chainParams)
+	if err != nil || !valid {
+		t.Fatalf("invalid registered signer proof: %v", err)
+	}
+
+	// VSP clients sign requests with the registered signer of their API
+	// version, and reject signers using a different header.
+	sign := w.vspClientSigner(DefaultVSPAPIVersion)
+	sig, err := sign(ctx, request, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid, err = VerifyMessage(request, commitmentAddr, sig, w.chainParams)
+	if err != nil || !valid {
+		t.Fatalf("invalid VSP client signature: %v", err)
+	}
+	registerTestVSPProofSigner(t, DefaultVSPAPIVersion, testVSPProofSigner{})
+	if _, err := sign(ctx, request, nil); !errors.Is(err, errors.Invalid) {
+		t.Errorf("signer with another header: expected Invalid error, got %v", err)
+	}
+}
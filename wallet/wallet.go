@@ -121,22 +121,55 @@ type Wallet struct {
 	vspTSpendKeyPolicy map[udb.VSPTreasuryKey]stake.TreasuryVoteT
 
 	// Start up flags/settings
-	gapLimit        uint32
-	watchLast       uint32
-	accountGapLimit int
+	gapLimit            uint32
+	watchLast           uint32
+	txPruneDepth        int32
+	sidechainPruneDepth int32
+	accountGapLimit     int
+	voteOnly            bool
+	treasuryKeys        bool
+	readOnly            bool
 
 	// initialHeight is the wallet's tip height prior to syncing with the
 	// network. Useful for calculating or estimating headers fetch progress
 	// during sync if the target header height is known or can be estimated.
 	initialHeight int32
 
+	// timings records the durations of wallet operations.
+	timings opTimings
+
+	// voteDiagnostics records the timing of recently created votes.
+	voteDiagnostics voteDiagnostics
+
+	// instance identifies this process as a writer of the database, and
+	// records any other process detected writing to the same wallet.
+	instance         udb.InstanceRecord
+	instanceConflict *InstanceConflict
+	instanceMu       sync.Mutex
+
+	// Outstanding seed backup verification challenges.
+	seedChallenges        map[string]*seedChallenge
+	seedChallengeFailures int
+	seedChallengeLastFail time.Time
+	seedChallengesMu      sync.Mutex
+
 	networkBackend   NetworkBackend
 	networkBackendMu sync.Mutex
 
-	lockedOutpoints  map[outpoint]struct{}
-	lockedOutpointMu sync.Mutex
+	lockedOutpoints   map[outpoint]struct{}
+	fundsReservations map[string]*FundsReservation
+	holds             map[string]*udb.Hold
+	heldOutpoints     map[outpoint]string   // hold IDs
+	pendingOutpoints  map[outpoint]struct{} // inputs of pending spends
+	approvalOutpoints map[outpoint]struct{} // inputs of spends awaiting approval
+	mixedSpend        *udb.MixedSpendPolicy
+	changePolicy      *ChangePolicy
+	lockedOutpointMu  sync.Mutex
+	pendingSpendMu    sync.Mutex // serializes approval and rejection
+	idempotencyMu     sync.Mutex // serializes requests with idempotency keys
 
 	relayFee                   dcrutil.Amount
+	maxFeeRate                 dcrutil.Amount // zero when unlimited
 	relayFeeMu                 sync.Mutex
 	allowHighFees              bool
 	disableCoinTypeUpgrades    bool
@@ -153,12 +186,39 @@ type Wallet struct {
 	passphraseUsedMu        sync.RWMutex
 	passphraseTimeoutMu     sync.Mutex
 	passphraseTimeoutCancel chan struct{}
+	lockMode                LockMode // protected by passphraseUsedMu
+	idleLock                idleLock
+
+	// Spend approval
+	spendApprover          SpendApprover
+	spendApprovalThreshold dcrutil.Amount
+	pendingSpendsRequired  bool
+	spendApproverMu        sync.Mutex
+
+	// Account rollover
+	accountRolloverLimit   uint32
+	accountRolloverLimitMu sync.Mutex
+
+	// Ticket address reuse avoidance
+	ticketAddrCheckDisabled bool
+	ticketAddrCheckMu       sync.Mutex
+
+	// Expiry of authored transactions
+	txExpiry   int32
+	txExpiryMu sync.Mutex
+
+	// Vote cosigning
+	voteCosigners       []VoteCosigner
+	voteCosignerTimeout time.Duration
+	voteCosignersMu     sync.Mutex
 
 	// Mixing
 	mixingEnabled bool
 	mixpool       *mixpool.Pool
 	mixSems       mixSemaphores
 	mixClient     *mixclient.Client
+	mixPolicy     MixPolicy
+	mixPolicyMu   sync.Mutex
 
 	// Cached Blake3 anchor candidate
 	cachedBlake3WorkDiffCandidateAnchor   *wire.BlockHeader
@@ -196,8 +256,39 @@ type Config struct {
 	ManualTickets bool
 	AllowHighFees bool
 	RelayFee      dcrutil.Amount
-	VSPMaxFee     dcrutil.Amount
-	Params        *chaincfg.Params
+
+	// TxPruneDepth enables periodic pruning of transactions with more
+	// than this number of confirmations when non-zero.  It must be at
+	// least MinTxPruneDepth.
+	TxPruneDepth int32
+
+	// SidechainPruneDepth enables periodic pruning of the saved headers
+	// and compact filters of blocks which were reorganized out of the main
+	// chain more than this number of blocks below the tip when non-zero.
+	SidechainPruneDepth int32
+
+	// WatchingOnlyGapLimit is the address gap limit of watching-only
+	// wallets when greater than GapLimit.  Watching-only wallets derive
+	// and watch this many addresses beyond the last used address of each
+	// account branch.
+	WatchingOnlyGapLimit uint32
+
+	// VoteOnly opens the wallet in vote-only mode, refusing all operations
+	// which spend wallet outputs other than votes and revocations, or which
+	// reveal private keys.
+	VoteOnly bool
+
+	// TreasuryKeys permits the import of treasury spend signing keys and
+	// the signing of treasury spends with them.
+	TreasuryKeys bool
+
+	// ReadOnly opens a wallet from a database opened read-only, such as a
+	// replica snapshot.  Database migrations and upgrades are not performed
+	// and every operation writing to the database errors.
+	ReadOnly bool
+
+	VSPMaxFee dcrutil.Amount
+	Params    *chaincfg.Params
 
 	Dialer DialFunc
 }
@@ -296,7 +387,13 @@ func voteVersion(params *chaincfg.Params) uint32 {
 	case wire.SimNet:
 		return 11
 	default:
-		return 1
+		// Private networks vote on the latest agendas defined by
+		// their parameters.
+		version := uint32(1)
+		for v := range params.Deployments {
+			version = max(version, v)
+		}
+		return version
 	}
 }
 
@@ -904,6 +1001,7 @@ func (w *Wallet) watchHDAddrs(ctx context.Context, firstWatch bool, n NetworkBac
 		lastWatchedExternal, lastWatchedInternal   uint32
 		lastReturnedExternal, lastReturnedInternal uint32
 		lastUsedExternal, lastUsedInternal         uint32
+		gapLimit                                   uint32
 	}
 	hdAccounts := make(map[uint32]hdAccount)
 	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
@@ -924,13 +1022,18 @@ func (w *Wallet) watchHDAddrs(ctx context.Context, firstWatch bool, n NetworkBac
 			if err != nil {
 				return err
 			}
+			gapLimit, err := w.accountAddrGapLimit(dbtx, acct, w.gapLimit)
+			if err != nil {
+				return err
+			}
 			hdAccounts[acct] = hdAccount{
-				externalCount:        min(props.LastReturnedExternalIndex+w.gapLimit, hdkeychain.HardenedKeyStart-1),
-				internalCount:        min(props.LastReturnedInternalIndex+w.gapLimit, hdkeychain.HardenedKeyStart-1),
+				externalCount:        min(props.LastReturnedExternalIndex+gapLimit, hdkeychain.HardenedKeyStart-1),
+				internalCount:        min(props.LastReturnedInternalIndex+gapLimit, hdkeychain.HardenedKeyStart-1),
 				lastReturnedExternal: props.LastReturnedExternalIndex,
 				lastReturnedInternal: props.LastReturnedInternalIndex,
 				lastUsedExternal:     props.LastUsedExternalIndex,
 				lastUsedInternal:     props.LastUsedInternalIndex,
+				gapLimit:             gapLimit,
 			}
 			return nil
 		}
@@ -1013,35 +1116,29 @@ func (w *Wallet) watchHDAddrs(ctx context.Context, firstWatch bool, n NetworkBac
 		watchError <- nil
 	}()
 	var deriveError error
-	loadBranchAddrs := func(branchKey *hdkeychain.ExtendedKey, start, end uint32) {
-		if start == 0 && w.watchLast != 0 && end-w.gapLimit > w.watchLast {
-			start = end - w.gapLimit - w.watchLast
+	loadBranchAddrs := func(branchKey *hdkeychain.ExtendedKey, start, end, gapLimit uint32) {
+		if start == 0 && w.watchLast != 0 && end-gapLimit > w.watchLast {
+			start = end - gapLimit - w.watchLast
 		}
 		const step = 256
 		for ; start <= end; start += step {
-			addrs := make([]stdaddr.Address, 0, step)
 			stop := min(end+1, start+step)
-			for child := start; child < stop; child++ {
-				addr, err := deriveChildAddress(branchKey, child, w.chainParams)
-				if errors.Is(err, hdkeychain.ErrInvalidChild) {
-					continue
-				}
-				if err != nil {
-					deriveError = err
-					return
-				}
-				addrs = append(addrs, addr)
+			addrs, pubKeyAddrs, err := deriveChildWatchAddresses(branchKey,
+				start, stop-start, w.chainParams)
+			if err != nil {
+				deriveError = err
+				return
 			}
 			select {
-			case watchAddrs <- addrs:
+			case watchAddrs <- append(addrs, pubKeyAddrs...):
 			case <-ctx.Done():
 				return
 			}
 		}
 	}
 	for _, hd := range hdAccounts {
-		loadBranchAddrs(hd.externalKey, hd.lastWatchedExternal, hd.externalCount)
-		loadBranchAddrs(hd.internalKey, hd.lastWatchedInternal, hd.internalCount)
+		loadBranchAddrs(hd.externalKey, hd.lastWatchedExternal, hd.externalCount, hd.gapLimit)
+		loadBranchAddrs(hd.internalKey, hd.lastWatchedInternal, hd.internalCount, hd.gapLimit)
 		if ctx.Err() != nil || deriveError != nil {
 			break
 		}
@@ -1090,6 +1187,9 @@ func (w *Wallet) CoinType(ctx context.Context) (uint32, error) {
 // CoinTypePrivKey returns the BIP0044 coin type private key.
 func (w *Wallet) CoinTypePrivKey(ctx context.Context) (*hdkeychain.ExtendedKey, error) {
 	const op errors.Op = "wallet.CoinTypePrivKey"
+	if err := w.checkVoteOnly(); err != nil {
+		return nil, errors.E(op, err)
+	}
 	var coinTypePrivKey *hdkeychain.ExtendedKey
 	err := walletdb.View(ctx, w.db, func(tx walletdb.ReadTx) error {
 		var err error
@@ -1144,9 +1244,11 @@ func (w *Wallet) LoadActiveDataFilters(ctx context.Context, n NetworkBackend, re
 	abuf := make([]stdaddr.Address, 0, 256)
 	var importedAddrCount int
 	watchAddress := func(a udb.ManagedAddress) error {
-		addr := a.Address()
-		abuf = append(abuf, addr)
-		if len(abuf) == cap(abuf) {
+		abuf = append(abuf, a.Address())
+		if pubKeyAddr := pubKeyWatchAddress(a, w.chainParams); pubKeyAddr != nil {
+			abuf = append(abuf, pubKeyAddr)
+		}
+		if len(abuf) >= cap(abuf)-1 {
 			importedAddrCount += len(abuf)
 			err := n.LoadTxFilter(ctx, false, abuf, nil)
 			abuf = abuf[:0]
@@ -1156,7 +1258,7 @@ func (w *Wallet) LoadActiveDataFilters(ctx context.Context, n NetworkBackend, re
 	}
 	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
 		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
-		return w.manager.ForEachAccountAddress(addrmgrNs, udb.ImportedAddrAccount, watchAddress)
+		return w.manager.ForEachImportedAddress(addrmgrNs, watchAddress)
 	})
 	if err != nil {
 		return err
@@ -1562,7 +1664,28 @@ type PurchaseTicketsRequest struct {
 	Expiry        int32
 	DontSignTx    bool
 
-	// Mixed split buying through CoinShuffle++
+	// VotingScript is an optional m-of-n multisig redeem script.  When set,
+	// tickets commit voting rights to its P2SH address instead of addresses
+	// of VotingAccount, and the script is imported so that votes may be
+	// signed.  The wallet must hold at least m of the script's keys, or
+	// some of its keys with vote cosigners configured to add the remaining
+	// signatures.  A VSP may not be used.
+	VotingScript []byte
+
+	// SourceBranches optionally restricts the outputs spent by ticket
+	// purchases and VSP fee payments to those paying to addresses of these
+	// branches of SourceAccount, such as the mixed branch of a mixed
+	// account.  Outputs paying to imported addresses are not spent when
+	// set.  Locked outputs, including those of funds reservations, are
+	// never spent.
+	SourceBranches []uint32
+
+	// Mixed split buying through CoinShuffle++.  When Mixing is set, the
+	// split transaction funding the tickets is created by a mix of the
+	// SourceAccount inputs with other peers, producing ticket inputs
+	// derived from MixedSplitAccount.  Unmixed change is returned to
+	// ChangeAccount, and ticket subsidies are paid to the
+	// MixedAccountBranch of MixedAccount.
 	Mixing             bool
 	MixedAccount       uint32
 	MixedAccountBranch uint32
@@ -1594,18 +1717,46 @@ func (w *Wallet) PurchaseTickets(ctx context.Context, n NetworkBackend,
 
 	const op errors.Op = "wallet.PurchaseTickets"
 
+	if err := w.checkVoteOnly(); err != nil {
+		return nil, errors.E(op, err)
+	}
+
 	// Mixing requests require wallet mixing support.
 	if req.Mixing && !w.mixingEnabled {
 		s := "wallet mixing support is disabled"
 		return nil, errors.E(op, errors.Invalid, s)
 	}
 
+	// Mixed splits are signed and published by the mix participants, and
+	// can not be returned unsigned.
+	if req.Mixing && req.DontSignTx {
+		s := "mixed ticket splits may not be left unsigned"
+		return nil, errors.E(op, errors.Invalid, s)
+	}
+
+	if err := sourceBranches(req.SourceBranches).check(); err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	// VSPs require the private key of a P2PKH voting address.
+	if req.VotingScript != nil && req.VSPClient != nil {
+		s := "tickets with a voting script may not use a VSP"
+		return nil, errors.E(op, errors.Invalid, s)
+	}
+
+	// Wallets configured to only spend mixed outputs must purchase tickets
+	// from the mixed account.
+	mixedSpend := w.MixedSpendPolicy()
+	if err := checkMixedSpendAccount(mixedSpend, req.SourceAccount); err != nil {
+		return nil, errors.E(op, err)
+	}
+
 	ctx, cancel := WrapNetworkBackendContext(n, ctx)
 	defer cancel()
 
 	resp, err := w.purchaseTickets(ctx, op, n, req)
 	if err == nil || !errors.Is(err, errVSPFeeRequiresUTXOSplit) || req.DontSignTx {
-		return resp, err
+		return resp, mixedSpendError(mixedSpend, err)
 	}
 
 	// Do not attempt to split utxos for a fee payment when spending from
@@ -1651,6 +1802,8 @@ func (w *Wallet) PurchaseTickets(ctx context.Context, n NetworkBackend,
 		minconf:            req.MinConf,
 		randomizeChangeIdx: true,
 		txFee:              relayFee,
+		sourceBranches:     req.SourceBranches,
+		background:         true,
 	}
 	addr, err := w.NewInternalAddress(ctx, req.SourceAccount)
 	if err != nil {
@@ -1734,16 +1887,19 @@ func (w *Wallet) Unlock(ctx context.Context, passphrase []byte, timeout <-chan t
 	case errors.Is(err, errors.Locked):
 		defer w.passphraseUsedMu.RUnlock()
 		w.passphraseUsedMu.RLock()
+		start := time.Now()
 		err = walletdb.View(ctx, w.db, func(tx walletdb.ReadTx) error {
 			addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
 			return w.manager.Unlock(addrmgrNs, passphrase)
 		})
+		w.timings.observe(TimingUnlock, start)
 		if err != nil {
 			return errors.E(op, errors.Passphrase, err)
 		}
 	case err == nil:
 	}
 	w.replacePassphraseTimeout(wasLocked, timeout)
+	w.noteSigningActivity()
 	return nil
 }
 
@@ -1760,6 +1916,7 @@ func (w *Wallet) SetAccountPassphrase(ctx context.Context, account uint32, passp
 
 // UnlockAccount decrypts a uniquely-encrypted account's private keys.
 func (w *Wallet) UnlockAccount(ctx context.Context, account uint32, passphrase []byte) error {
+	defer w.timings.observe(TimingUnlock, time.Now())
 	return walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
 		return w.manager.UnlockAccount(dbtx, account, passphrase)
 	})
@@ -1842,14 +1999,21 @@ func (w *Wallet) replacePassphraseTimeout(wasLocked bool, newTimeout <-chan time
 	}
 }
 
-// Lock locks the wallet's address manager.
+// Lock locks the wallet's address manager.  When the lock mode is
+// LockModeSpending, unlocked imported voting accounts remain unlocked.
 func (w *Wallet) Lock() {
 	w.passphraseUsedMu.Lock()
 	w.passphraseTimeoutMu.Lock()
-	_ = w.manager.Lock()
+	switch w.lockMode {
+	case LockModeSpending:
+		_ = w.manager.LockSpending()
+	default:
+		_ = w.manager.Lock()
+	}
 	w.passphraseTimeoutCancel = nil
 	w.passphraseTimeoutMu.Unlock()
 	w.passphraseUsedMu.Unlock()
+	w.stopIdleLock()
 }
 
 // Locked returns whether the account manager for a wallet is locked.
@@ -1900,26 +2064,93 @@ func (w *Wallet) ChangePublicPassphrase(ctx context.Context, old, new []byte) er
 
 // Balances describes a breakdown of an account's balances in various
 // categories.
+//
+// LockedByLiveTickets is the portion of LockedByTickets committed by live
+// (mature and unexpired) tickets.  LockedByUnspent is the value of unspent
+// outputs locked with LockOutpoint or reserved for orders; these outputs are
+// still included in the other categories.
 type Balances struct {
 	Account                 uint32
 	ImmatureCoinbaseRewards dcrutil.Amount
 	ImmatureStakeGeneration dcrutil.Amount
 	LockedByTickets         dcrutil.Amount
+	LockedByLiveTickets     dcrutil.Amount
+	LockedByUnspent         dcrutil.Amount
 	Spendable               dcrutil.Amount
 	Total                   dcrutil.Amount
 	VotingAuthority         dcrutil.Amount
 	Unconfirmed             dcrutil.Amount
 }
 
+func makeBalances(b *udb.Balances, lockedByUnspent map[uint32]dcrutil.Amount) Balances {
+	return Balances{
+		Account:                 b.Account,
+		ImmatureCoinbaseRewards: b.ImmatureCoinbaseRewards,
+		ImmatureStakeGeneration: b.ImmatureStakeGeneration,
+		LockedByTickets:         b.LockedByTickets,
+		LockedByLiveTickets:     b.LockedByLiveTickets,
+		LockedByUnspent:         lockedByUnspent[b.Account],
+		Spendable:               b.Spendable,
+		Total:                   b.Total,
+		VotingAuthority:         b.VotingAuthority,
+		Unconfirmed:             b.Unconfirmed,
+	}
+}
+
+// lockedOutpointBalances sums the values of locked unspent outputs by account.
+// Locked outpoints that are not unspent wallet outputs are ignored.
+func (w *Wallet) lockedOutpointBalances(dbtx walletdb.ReadTx) (map[uint32]dcrutil.Amount, error) {
+	w.lockedOutpointMu.Lock()
+	locked := make([]outpoint, 0, len(w.lockedOutpoints))
+	for op := range w.lockedOutpoints {
+		locked = append(locked, op)
+	}
+	w.lockedOutpointMu.Unlock()
+
+	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+	balances := make(map[uint32]dcrutil.Amount)
+	for _, op := range locked {
+		credit, err := w.txStore.UnspentOutput(txmgrNs,
+			wire.OutPoint{Hash: op.hash, Index: op.index}, true)
+		if errors.Is(err, errors.NotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		_, addrs := stdscript.ExtractAddrs(scriptVersionAssumed, credit.PkScript, w.chainParams)
+		if len(addrs) == 0 {
+			continue
+		}
+		account, err := w.manager.AddrAccount(addrmgrNs, addrs[0])
+		if errors.Is(err, errors.NotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		balances[account] += credit.Amount
+	}
+	return balances, nil
+}
+
 // AccountBalance returns the balance breakdown for a single account.
 func (w *Wallet) AccountBalance(ctx context.Context, account uint32, confirms int32) (Balances, error) {
 	const op errors.Op = "wallet.CalculateAccountBalance"
 	var balance Balances
 	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
-		var err error
-		balance, err = w.txStore.AccountBalance(dbtx,
+		b, err := w.txStore.AccountBalance(dbtx,
 			confirms, account)
-		return err
+		if err != nil {
+			return err
+		}
+		locked, err := w.lockedOutpointBalances(dbtx)
+		if err != nil {
+			return err
+		}
+		balance = makeBalances(&b, locked)
+		return nil
 	})
 	if err != nil {
 		return balance, errors.E(op, err)
@@ -1933,13 +2164,17 @@ func (w *Wallet) AccountBalances(ctx context.Context, confirms int32) ([]Balance
 	var balances []Balances
 	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
 		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		locked, err := w.lockedOutpointBalances(dbtx)
+		if err != nil {
+			return err
+		}
 		return w.manager.ForEachAccount(addrmgrNs, func(acct uint32) error {
 			balance, err := w.txStore.AccountBalance(dbtx,
 				confirms, acct)
 			if err != nil {
 				return err
 			}
-			balances = append(balances, balance)
+			balances = append(balances, makeBalances(&balance, locked))
 			return nil
 		})
 	})
@@ -1981,6 +2216,14 @@ func (w *Wallet) CurrentAddress(account uint32) (stdaddr.Address, error) {
 func (w *Wallet) SignHashes(ctx context.Context, hashes [][]byte, addr stdaddr.Address) ([][]byte,
 	[]byte, error) {
 
+	if err := w.checkVoteOnly(); err != nil {
+		return nil, nil, errors.E("wallet.SignHashes", err)
+	}
+	// The spends of signed hashes are unknown and can not be approved.
+	if w.approvalConfigured() {
+		return nil, nil, errors.E("wallet.SignHashes", errApprovalUnsupported)
+	}
+
 	var privKey *secp256k1.PrivateKey
 	var done func()
 	defer func() {
@@ -1997,6 +2240,7 @@ func (w *Wallet) SignHashes(ctx context.Context, hashes [][]byte, addr stdaddr.A
 	if err != nil {
 		return nil, nil, err
 	}
+	w.noteSigningActivity()
 
 	signatures := make([][]byte, len(hashes))
 	for i, hash := range hashes {
@@ -2007,14 +2251,19 @@ func (w *Wallet) SignHashes(ctx context.Context, hashes [][]byte, addr stdaddr.A
 	return signatures, privKey.PubKey().SerializeCompressed(), nil
 }
 
+// signedMessageHash returns the hash of a message signed by SignMessage.
+func signedMessageHash(msg string) []byte {
+	var buf bytes.Buffer
+	wire.WriteVarString(&buf, 0, "Decred Signed Message:\n")
+	wire.WriteVarString(&buf, 0, msg)
+	return chainhash.HashB(buf.Bytes())
+}
+
 // SignMessage returns the signature of a signed message using an address'
 // associated private key.
 func (w *Wallet) SignMessage(ctx context.Context, msg string, addr stdaddr.Address) (sig []byte, err error) {
 	const op errors.Op = "wallet.SignMessage"
-	var buf bytes.Buffer
-	wire.WriteVarString(&buf, 0, "Decred Signed Message:\n")
-	wire.WriteVarString(&buf, 0, msg)
-	messageHash := chainhash.HashB(buf.Bytes())
+	messageHash := signedMessageHash(msg)
 	var privKey *secp256k1.PrivateKey
 	var done func()
 	defer func() {
@@ -2031,6 +2280,7 @@ func (w *Wallet) SignMessage(ctx context.Context, msg string, addr stdaddr.Addre
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
+	w.noteSigningActivity()
 	sig = ecdsa.SignCompact(privKey, messageHash, true)
 	return sig, nil
 }
@@ -2041,10 +2291,7 @@ func VerifyMessage(msg string, addr stdaddr.Address, sig []byte, params stdaddr.
 	const op errors.Op = "wallet.VerifyMessage"
 	// Validate the signature - this just shows that it was valid for any pubkey
 	// at all. Whether the pubkey matches is checked below.
-	var buf bytes.Buffer
-	wire.WriteVarString(&buf, 0, "Decred Signed Message:\n")
-	wire.WriteVarString(&buf, 0, msg)
-	expectedMessageHash := chainhash.HashB(buf.Bytes())
+	expectedMessageHash := signedMessageHash(msg)
 	pk, wasCompressed, err := ecdsa.RecoverCompact(sig, expectedMessageHash)
 	if err != nil {
 		return false, errors.E(op, err)
@@ -2142,6 +2389,13 @@ func (w *Wallet) RenameAccount(ctx context.Context, account uint32, newName stri
 // spec, which allows no unused account gaps).
 func (w *Wallet) NextAccount(ctx context.Context, name string) (uint32, error) {
 	const op errors.Op = "wallet.NextAccount"
+	return w.nextAccount(ctx, op, name, nil)
+}
+
+// nextAccount implements NextAccount.  rolloverFrom is the account whose
+// external branch reached the rollover limit when the account is created by
+// the account rollover policy, and is included in the account notification.
+func (w *Wallet) nextAccount(ctx context.Context, op errors.Op, name string, rolloverFrom *uint32) (uint32, error) {
 	maxEmptyAccounts := uint32(w.accountGapLimit)
 	var account uint32
 	var props *udb.AccountProperties
@@ -2221,13 +2475,13 @@ func (w *Wallet) NextAccount(ctx context.Context, name string) (uint32, error) {
 		for _, branchKey := range []*hdkeychain.ExtendedKey{extKey, intKey} {
 			branchKey := branchKey
 			go func() {
-				addrs, err := deriveChildAddresses(branchKey, 0,
-					w.gapLimit, w.chainParams)
+				addrs, pubKeyAddrs, err := deriveChildWatchAddresses(branchKey,
+					0, w.gapLimit, w.chainParams)
 				if err != nil {
 					errs <- err
 					return
 				}
-				errs <- n.LoadTxFilter(ctx, false, addrs, nil)
+				errs <- n.LoadTxFilter(ctx, false, append(addrs, pubKeyAddrs...), nil)
 			}()
 		}
 		for i := 0; i < cap(errs); i++ {
@@ -2238,7 +2492,7 @@ func (w *Wallet) NextAccount(ctx context.Context, name string) (uint32, error) {
 		}
 	}
 
-	w.NtfnServer.notifyAccountProperties(props)
+	w.NtfnServer.notifyAccount(props, rolloverFrom)
 
 	return account, nil
 }
@@ -2264,6 +2518,9 @@ func (w *Wallet) AccountXpub(ctx context.Context, account uint32) (*hdkeychain.E
 // must exist and the wallet must be unlocked, otherwise this function fails.
 func (w *Wallet) AccountXpriv(ctx context.Context, account uint32) (*hdkeychain.ExtendedKey, error) {
 	const op errors.Op = "wallet.AccountXpriv"
+	if err := w.checkVoteOnly(); err != nil {
+		return nil, errors.E(op, err)
+	}
 
 	var privKey *hdkeychain.ExtendedKey
 	err := walletdb.View(ctx, w.db, func(tx walletdb.ReadTx) error {
@@ -2278,6 +2535,51 @@ func (w *Wallet) AccountXpriv(ctx context.Context, account uint32) (*hdkeychain.
 	return privKey, nil
 }
 
+// AccountChildXpub returns the extended public key derived from a BIP0044
+// account's extended private key by the hardened children of path.  Path
+// elements are child indexes below hdkeychain.HardenedKeyStart, which are
+// hardened during derivation, e.g. path [13 0] derives the account key's
+// 13'/0' child.  Hardened derivation ensures the keys are neither derivable
+// from the account xpub nor overlapping with the account's address branches,
+// and allows external protocols to use purpose-specific keys derived from the
+// wallet seed.  The wallet or account must be unlocked.
+func (w *Wallet) AccountChildXpub(ctx context.Context, account uint32, path []uint32) (*hdkeychain.ExtendedKey, error) {
+	const op errors.Op = "wallet.AccountChildXpub"
+	if len(path) == 0 {
+		return nil, errors.E(op, errors.Invalid, "empty child path")
+	}
+	for _, i := range path {
+		if i >= hdkeychain.HardenedKeyStart {
+			return nil, errors.E(op, errors.Invalid, errors.Errorf("child "+
+				"index %d is out of range", i))
+		}
+	}
+
+	var acctXpriv *hdkeychain.ExtendedKey
+	err := walletdb.View(ctx, w.db, func(tx walletdb.ReadTx) error {
+		var err error
+		acctXpriv, err = w.manager.AccountExtendedPrivKey(tx, account)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	xpriv := acctXpriv
+	for _, i := range path {
+		child, err := xpriv.Child(hdkeychain.HardenedKeyStart + i)
+		if xpriv != acctXpriv {
+			xpriv.Zero()
+		}
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		xpriv = child
+	}
+	// The final key is not zeroed as the neutered key shares its chain code.
+	return xpriv.Neuter(), nil
+}
+
 // TxBlock returns the hash and height of a block which mines a transaction.
 func (w *Wallet) TxBlock(ctx context.Context, hash *chainhash.Hash) (chainhash.Hash, int32, error) {
 	var blockHash chainhash.Hash
@@ -2393,10 +2695,14 @@ func recvCategory(details *udb.TxDetails, syncHeight int32, chainParams *chaincf
 }
 
 // listTransactions creates a object that may be marshalled to a response result
-// for a listtransactions RPC.
+// for a listtransactions RPC.  Pruned transactions have no outputs to describe
+// and create no results.
 //
 // TODO: This should be moved to the jsonrpc package.
 func listTransactions(tx walletdb.ReadTx, details *udb.TxDetails, addrMgr *udb.Manager, syncHeight int32, net *chaincfg.Params) (sends, receives []types.ListTransactionsResult) {
+	if details.Pruned {
+		return nil, nil
+	}
 	addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
 
 	var (
@@ -2425,6 +2731,8 @@ func listTransactions(tx walletdb.ReadTx, details *udb.TxDetails, addrMgr *udb.M
 		txTypeStr = types.LTTTVote
 	case stake.TxTypeSSRtx:
 		txTypeStr = types.LTTTRevocation
+	case stake.TxTypeTAdd:
+		txTypeStr = types.LTTTTreasuryAdd
 	}
 
 	// Fee can only be determined if every input is a debit.
@@ -2628,6 +2936,9 @@ func (w *Wallet) ListAddressTransactions(ctx context.Context, pkHashes map[strin
 		loopDetails:
 			for i := range details {
 				detail := &details[i]
+				if detail.Pruned {
+					continue
+				}
 
 				for _, cred := range detail.Credits {
 					if detail.MsgTx.TxOut[cred.Index].Version != scriptVersionAssumed {
@@ -3272,8 +3583,14 @@ func (w *Wallet) GetTransactions(ctx context.Context, f func(*Block) (bool, erro
 
 			txs := make([]TransactionSummary, 0, len(details))
 			for i := range details {
+				if details[i].Pruned {
+					continue
+				}
 				txs = append(txs, makeTxSummary(dbtx, w, &details[i]))
 			}
+			if len(txs) == 0 {
+				return false, nil
+			}
 
 			var block *Block
 			if details[0].Block.Height != -1 {
@@ -3353,12 +3670,16 @@ type AccountProperties struct {
 	ImportedKeyCount          uint32
 	AccountEncrypted          bool
 	AccountUnlocked           bool
+	AccountUUID               udb.AccountUUID
 }
 
 // AccountResult is a single account result for the AccountsResult type.
+// ExternalSigner is non-nil for accounts whose keys are held by a hardware
+// signing device.
 type AccountResult struct {
 	AccountProperties
-	TotalBalance dcrutil.Amount
+	TotalBalance   dcrutil.Amount
+	ExternalSigner *udb.ExternalSigner
 }
 
 // AccountsResult is the resutl of the wallet's Accounts method.  See that
@@ -3399,8 +3720,16 @@ func (w *Wallet) Accounts(ctx context.Context) (*AccountsResult, error) {
 			if err != nil {
 				return err
 			}
+			var signer *udb.ExternalSigner
+			if udb.IsExternalSigner(props.AccountType) {
+				signer, err = w.manager.ExternalSigner(addrmgrNs, acct)
+				if err != nil {
+					return err
+				}
+			}
 			accounts = append(accounts, AccountResult{
 				AccountProperties: *props,
+				ExternalSigner:    signer,
 				// TotalBalance set below
 			})
 			return nil
@@ -3551,6 +3880,14 @@ func (w *Wallet) ListUnspent(ctx context.Context, minconf, maxconf int32, addres
 				if !coinbaseMatured(w.chainParams, details.Height(), tipHeight) {
 					continue
 				}
+			case stake.TxTypeTAdd:
+				// Change of a treasury add is only spendable after
+				// ticket change maturity.
+				if output.Index > 0 {
+					if !ticketChangeMatured(w.chainParams, details.Height(), tipHeight) {
+						continue
+					}
+				}
 			}
 
 			// Exclude locked outputs from the result set.
@@ -3686,6 +4023,7 @@ func (w *Wallet) LoadPrivateKey(ctx context.Context, addr stdaddr.Address) (key
 	if err != nil {
 		return nil, nil, errors.E(op, err)
 	}
+	w.noteSigningActivity()
 
 	return key, zero, nil
 }
@@ -3694,6 +4032,9 @@ func (w *Wallet) LoadPrivateKey(ctx context.Context, addr stdaddr.Address) (key
 // single wallet address.
 func (w *Wallet) DumpWIFPrivateKey(ctx context.Context, addr stdaddr.Address) (string, error) {
 	const op errors.Op = "wallet.DumpWIFPrivateKey"
+	if err := w.checkVoteOnly(); err != nil {
+		return "", errors.E(op, err)
+	}
 	privKey, zero, err := w.LoadPrivateKey(ctx, addr)
 	if err != nil {
 		return "", errors.E(op, err)
@@ -3709,7 +4050,12 @@ func (w *Wallet) DumpWIFPrivateKey(ctx context.Context, addr stdaddr.Address) (s
 
 // ImportPrivateKey imports a private key to the wallet and writes the new
 // wallet to disk.
-func (w *Wallet) ImportPrivateKey(ctx context.Context, wif *dcrutil.WIF) (string, error) {
+//
+// Blocks from the scan height through the main chain tip must be rescanned for
+// transactions of the key, which is scheduled by the import and may be
+// performed using the returned handle.  A NoRescan height imports the key
+// without a rescan.  The handle is nil when no blocks must be rescanned.
+func (w *Wallet) ImportPrivateKey(ctx context.Context, wif *dcrutil.WIF, scanFrom int32) (string, *ImportRescan, error) {
 	const op errors.Op = "wallet.ImportPrivateKey"
 	// Attempt to import private key into wallet.
 	var addr stdaddr.Address
@@ -3717,21 +4063,26 @@ func (w *Wallet) ImportPrivateKey(ctx context.Context, wif *dcrutil.WIF) (string
 	err := walletdb.Update(ctx, w.db, func(tx walletdb.ReadWriteTx) error {
 		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
 		maddr, err := w.manager.ImportPrivateKey(addrmgrNs, wif)
-		if err == nil {
-			addr = maddr.Address()
-			props, err = w.manager.AccountProperties(
-				addrmgrNs, udb.ImportedAddrAccount)
+		if err != nil {
+			return err
+		}
+		addr = maddr.Address()
+		props, err = w.manager.AccountProperties(
+			addrmgrNs, udb.ImportedAddrAccount)
+		if err != nil {
+			return err
 		}
+		scanFrom, err = w.recordImportRescan(tx, scanFrom)
 		return err
 	})
 	if err != nil {
-		return "", errors.E(op, err)
+		return "", nil, errors.E(op, err)
 	}
 
 	if n, err := w.NetworkBackend(); err == nil {
 		err := n.LoadTxFilter(ctx, false, []stdaddr.Address{addr}, nil)
 		if err != nil {
-			return "", errors.E(op, err)
+			return "", nil, errors.E(op, err)
 		}
 	}
 
@@ -3741,34 +4092,50 @@ func (w *Wallet) ImportPrivateKey(ctx context.Context, wif *dcrutil.WIF) (string
 	w.NtfnServer.notifyAccountProperties(props)
 
 	// Return the payment address string of the imported private key.
-	return addrStr, nil
+	return addrStr, w.importRescan(scanFrom), nil
 }
 
 // ImportPublicKey imports a compressed secp256k1 public key and its derived
-// P2PKH address.
-func (w *Wallet) ImportPublicKey(ctx context.Context, pubkey []byte) (string, error) {
-	const op errors.Op = "wallet.ImportPublicKey"
-	// Attempt to import private key into wallet.
+// P2PKH address.  See ImportPublicKeyToAccount for the rescan of the scan
+// height.
+func (w *Wallet) ImportPublicKey(ctx context.Context, pubkey []byte, scanFrom int32) (string, *ImportRescan, error) {
+	return w.ImportPublicKeyToAccount(ctx, pubkey, udb.ImportedAddrAccount, scanFrom)
+}
+
+// ImportPublicKeyToAccount imports a compressed secp256k1 public key and its
+// derived P2PKH address as a watched address of an existing account.
+//
+// Blocks from the scan height through the main chain tip must be rescanned for
+// transactions of the address, which is scheduled by the import and may be
+// performed using the returned handle.  A NoRescan height imports the key
+// without a rescan.  The handle is nil when no blocks must be rescanned.
+func (w *Wallet) ImportPublicKeyToAccount(ctx context.Context, pubkey []byte, account uint32, scanFrom int32) (string, *ImportRescan, error) {
+	const op errors.Op = "wallet.ImportPublicKeyToAccount"
+	// Attempt to import public key into wallet.
 	var addr stdaddr.Address
 	var props *udb.AccountProperties
 	err := walletdb.Update(ctx, w.db, func(tx walletdb.ReadWriteTx) error {
 		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
-		maddr, err := w.manager.ImportPublicKey(addrmgrNs, pubkey)
-		if err == nil {
-			addr = maddr.Address()
-			props, err = w.manager.AccountProperties(
-				addrmgrNs, udb.ImportedAddrAccount)
+		maddr, err := w.manager.ImportPublicKeyToAccount(addrmgrNs, pubkey, account)
+		if err != nil {
+			return err
+		}
+		addr = maddr.Address()
+		props, err = w.manager.AccountProperties(addrmgrNs, account)
+		if err != nil {
+			return err
 		}
+		scanFrom, err = w.recordImportRescan(tx, scanFrom)
 		return err
 	})
 	if err != nil {
-		return "", errors.E(op, err)
+		return "", nil, errors.E(op, err)
 	}
 
 	if n, err := w.NetworkBackend(); err == nil {
 		err := n.LoadTxFilter(ctx, false, []stdaddr.Address{addr}, nil)
 		if err != nil {
-			return "", errors.E(op, err)
+			return "", nil, errors.E(op, err)
 		}
 	}
 
@@ -3778,17 +4145,32 @@ func (w *Wallet) ImportPublicKey(ctx context.Context, pubkey []byte) (string, er
 	w.NtfnServer.notifyAccountProperties(props)
 
 	// Return the payment address string of the imported private key.
-	return addrStr, nil
+	return addrStr, w.importRescan(scanFrom), nil
+}
+
+// ImportScript imports a redeemscript to the wallet.  See ImportScriptToAccount
+// for the rescan of the scan height.
+func (w *Wallet) ImportScript(ctx context.Context, rs []byte, scanFrom int32) (*ImportRescan, error) {
+	return w.ImportScriptToAccount(ctx, rs, udb.ImportedAddrAccount, scanFrom)
 }
 
-// ImportScript imports a redeemscript to the wallet. If it also allows the
-// user to specify whether or not they want the redeemscript to be rescanned,
-// and how far back they wish to rescan.
-func (w *Wallet) ImportScript(ctx context.Context, rs []byte) error {
-	const op errors.Op = "wallet.ImportScript"
+// ImportScriptToAccount imports a redeem script as a watched P2SH address of an
+// existing account.  Outputs paying the script are included in the account's
+// balances but are never spent by the wallet.
+//
+// Blocks from the scan height through the main chain tip must be rescanned for
+// transactions of the script, which is scheduled by the import and may be
+// performed using the returned handle.  A NoRescan height imports the script
+// without a rescan.  The handle is nil when no blocks must be rescanned.
+func (w *Wallet) ImportScriptToAccount(ctx context.Context, rs []byte, account uint32, scanFrom int32) (*ImportRescan, error) {
+	const op errors.Op = "wallet.ImportScriptToAccount"
 	err := walletdb.Update(ctx, w.db, func(tx walletdb.ReadWriteTx) error {
 		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
-		mscriptaddr, err := w.manager.ImportScript(addrmgrNs, rs)
+		mscriptaddr, err := w.manager.ImportScriptToAccount(addrmgrNs, rs, account)
+		if err != nil {
+			return err
+		}
+		scanFrom, err = w.recordImportRescan(tx, scanFrom)
 		if err != nil {
 			return err
 		}
@@ -3806,9 +4188,9 @@ func (w *Wallet) ImportScript(ctx context.Context, rs []byte) error {
 		return nil
 	})
 	if err != nil {
-		return errors.E(op, err)
+		return nil, errors.E(op, err)
 	}
-	return nil
+	return w.importRescan(scanFrom), nil
 }
 
 // VotingXprivFromSeed derives a voting xpriv from a byte seed.
@@ -3868,11 +4250,12 @@ func (w *Wallet) ImportVotingAccount(ctx context.Context, xpriv *hdkeychain.Exte
 	// Internal addresses are used in signing messages and are not expected
 	// to be found used on chain.
 	if n, err := w.NetworkBackend(); err == nil {
-		extAddrs, err := deriveChildAddresses(extKey, 0, w.gapLimit, w.chainParams)
+		extAddrs, extPubKeyAddrs, err := deriveChildWatchAddresses(extKey, 0,
+			w.gapLimit, w.chainParams)
 		if err != nil {
 			return 0, errors.E(op, err)
 		}
-		err = n.LoadTxFilter(ctx, false, extAddrs, nil)
+		err = n.LoadTxFilter(ctx, false, append(extAddrs, extPubKeyAddrs...), nil)
 		if err != nil {
 			return 0, errors.E(op, err)
 		}
@@ -3903,39 +4286,59 @@ func (w *Wallet) ImportXpubAccount(ctx context.Context, name string, xpub *hdkey
 		return errors.E(op, "extended key must be an xpub")
 	}
 
-	extKey, intKey, err := deriveBranches(xpub)
+	err := w.importXpubAccount(ctx, xpub, func(ns walletdb.ReadWriteBucket) (uint32, error) {
+		err := w.manager.ImportXpubAccount(ns, name, xpub)
+		if err != nil {
+			return 0, err
+		}
+		return w.manager.LookupAccount(ns, name)
+	})
 	if err != nil {
 		return errors.E(op, err)
 	}
+	return nil
+}
+
+// importXpubAccount watches the addresses of an account extended public key,
+// records the account using the import function, and adds the account's
+// address buffers.
+func (w *Wallet) importXpubAccount(ctx context.Context, xpub *hdkeychain.ExtendedKey,
+	importFunc func(ns walletdb.ReadWriteBucket) (uint32, error)) error {
+
+	extKey, intKey, err := deriveBranches(xpub)
+	if err != nil {
+		return err
+	}
 
 	if n, err := w.NetworkBackend(); err == nil {
-		extAddrs, err := deriveChildAddresses(extKey, 0, w.gapLimit, w.chainParams)
+		extAddrs, extPubKeyAddrs, err := deriveChildWatchAddresses(extKey, 0,
+			w.gapLimit, w.chainParams)
 		if err != nil {
-			return errors.E(op, err)
+			return err
 		}
-		intAddrs, err := deriveChildAddresses(intKey, 0, w.gapLimit, w.chainParams)
+		intAddrs, intPubKeyAddrs, err := deriveChildWatchAddresses(intKey, 0,
+			w.gapLimit, w.chainParams)
 		if err != nil {
-			return errors.E(op, err)
+			return err
 		}
 		watch := append(extAddrs, intAddrs...)
+		watch = append(watch, extPubKeyAddrs...)
+		watch = append(watch, intPubKeyAddrs...)
 		err = n.LoadTxFilter(ctx, false, watch, nil)
 		if err != nil {
-			return errors.E(op, err)
+			return err
 		}
 	}
 
 	var account uint32
 	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
 		ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
-		err := w.manager.ImportXpubAccount(ns, name, xpub)
-		if err != nil {
-			return err
-		}
-		account, err = w.manager.LookupAccount(ns, name)
+		var err error
+		account, err = importFunc(ns)
 		return err
 	})
 	if err != nil {
-		return errors.E(op, err)
+		return err
 	}
 
 	defer w.addressBuffersMu.Unlock()
@@ -3990,7 +4393,9 @@ func isTreasurySpend(tx *wire.MsgTx) bool {
 }
 
 // hasVotingAuthority returns whether the 0th output of a ticket purchase can be
-// spent by a vote or revocation created by this wallet.
+// spent by a vote or revocation created by this wallet.  Tickets committing to
+// a P2SH multisig address are owned when the redeem script is known, and may
+// only be spent when the wallet holds the threshold number of keys.
 func (w *Wallet) hasVotingAuthority(addrmgrNs walletdb.ReadBucket, ticketPurchase *wire.MsgTx) (
 	mine, havePrivKey bool, err error) {
 	out := ticketPurchase.TxOut[0]
@@ -4004,6 +4409,10 @@ func (w *Wallet) hasVotingAuthority(addrmgrNs walletdb.ReadBucket, ticketPurchas
 			continue
 		}
 		if w.manager.ExistsHash160(addrmgrNs, hash160[:]) {
+			if _, ok := a.(*stdaddr.AddressScriptHashV0); ok {
+				haveKeys, err := w.haveMultisigKeys(addrmgrNs, a)
+				return true, haveKeys, err
+			}
 			haveKey, err := w.manager.HavePrivateKey(addrmgrNs, a)
 			return true, haveKey, err
 		}
@@ -4271,15 +4680,28 @@ func (w *Wallet) LockOutpoint(txHash *chainhash.Hash, index uint32) {
 func (w *Wallet) UnlockOutpoint(txHash *chainhash.Hash, index uint32) {
 	op := outpoint{*txHash, index}
 	w.lockedOutpointMu.Lock()
-	delete(w.lockedOutpoints, op)
+	w.unlockOutpoint(op)
 	w.lockedOutpointMu.Unlock()
 }
 
 // ResetLockedOutpoints resets the set of locked outpoints so all may be used
-// as inputs for new transactions.
+// as inputs for new transactions.  Outputs of holds and funds reservations and
+// inputs of pending spends and of spends awaiting approval remain locked.
 func (w *Wallet) ResetLockedOutpoints() {
 	w.lockedOutpointMu.Lock()
 	w.lockedOutpoints = make(map[outpoint]struct{})
+	for _, r := range w.fundsReservations {
+		w.lockFundsReservation(r)
+	}
+	for op := range w.heldOutpoints {
+		w.lockedOutpoints[op] = struct{}{}
+	}
+	for op := range w.pendingOutpoints {
+		w.lockedOutpoints[op] = struct{}{}
+	}
+	for op := range w.approvalOutpoints {
+		w.lockedOutpoints[op] = struct{}{}
+	}
 	w.lockedOutpointMu.Unlock()
 }
 
@@ -4496,6 +4918,9 @@ func (w *Wallet) TotalReceivedForAccounts(ctx context.Context, minConf int32) ([
 		rangeFn := func(details []udb.TxDetails) (bool, error) {
 			for i := range details {
 				detail := &details[i]
+				if detail.Pruned {
+					continue
+				}
 				for _, cred := range detail.Credits {
 					pkVersion := detail.MsgTx.TxOut[cred.Index].Version
 					pkScript := detail.MsgTx.TxOut[cred.Index].PkScript
@@ -4523,45 +4948,55 @@ func (w *Wallet) TotalReceivedForAccounts(ctx context.Context, minConf int32) ([
 	return results, nil
 }
 
-// TotalReceivedForAddr iterates through a wallet's transaction history,
-// returning the total amount of decred received for a single wallet
-// address.
+// TotalReceivedForAddr returns the total amount of decred received by a
+// single wallet address from transactions with at least minConf
+// confirmations.  The total is read from the transaction store's index of
+// received amounts, and only transactions with fewer than minConf
+// confirmations (or unmined transactions when minConf is zero) are read.
 func (w *Wallet) TotalReceivedForAddr(ctx context.Context, addr stdaddr.Address, minConf int32) (dcrutil.Amount, error) {
 	const op errors.Op = "wallet.TotalReceivedForAddr"
 	var amount dcrutil.Amount
 	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
-		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
-
-		_, tipHeight := w.txStore.MainChainTip(dbtx)
-
-		var (
-			addrStr    = addr.String()
-			stopHeight int32
-		)
-
-		if minConf > 0 {
-			stopHeight = tipHeight - minConf + 1
-		} else {
-			stopHeight = -1
-		}
-		rangeFn := func(details []udb.TxDetails) (bool, error) {
-			for i := range details {
-				detail := &details[i]
-				for _, cred := range detail.Credits {
-					pkVersion := detail.MsgTx.TxOut[cred.Index].Version
-					pkScript := detail.MsgTx.TxOut[cred.Index].PkScript
-					_, addrs := stdscript.ExtractAddrs(pkVersion, pkScript, w.chainParams)
-					for _, a := range addrs { // no addresses means non-standard credit, ignored
-						if addrStr == a.String() {
-							amount += cred.Amount
-							break
-						}
-					}
+		addrStr := addr.String()
+		match := func(addrs []stdaddr.Address) bool {
+			for _, a := range addrs {
+				if addrStr == a.String() {
+					return true
 				}
 			}
-			return false, nil
+			return false
 		}
-		return w.txStore.RangeTransactions(ctx, txmgrNs, 0, stopHeight, rangeFn)
+		var err error
+		amount, err = w.receivedWithConf(ctx, dbtx,
+			w.txStore.ReceivedByAddress(dbtx, addr), minConf, match)
+		return err
+	})
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return amount, nil
+}
+
+// TotalReceivedForAccount returns the total amount of decred received by an
+// account from transactions with at least minConf confirmations.  Like
+// TotalReceivedForAddr, the total is read from the transaction store's index
+// of received amounts.
+func (w *Wallet) TotalReceivedForAccount(ctx context.Context, account uint32, minConf int32) (dcrutil.Amount, error) {
+	const op errors.Op = "wallet.TotalReceivedForAccount"
+	var amount dcrutil.Amount
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		match := func(addrs []stdaddr.Address) bool {
+			if len(addrs) == 0 {
+				return false
+			}
+			outputAcct, err := w.manager.AddrAccount(addrmgrNs, addrs[0])
+			return err == nil && outputAcct == account
+		}
+		var err error
+		amount, err = w.receivedWithConf(ctx, dbtx,
+			w.txStore.ReceivedByAccount(dbtx, account), minConf, match)
+		return err
 	})
 	if err != nil {
 		return 0, errors.E(op, err)
@@ -4569,9 +5004,64 @@ func (w *Wallet) TotalReceivedForAddr(ctx context.Context, addr stdaddr.Address,
 	return amount, nil
 }
 
+// receivedWithConf adjusts the total amount received by all mined credits
+// paying addresses matched by match to the total received by credits with at
+// least minConf confirmations.  Credits mined in blocks with too few
+// confirmations are subtracted, and unmined credits are added when minConf is
+// zero.
+func (w *Wallet) receivedWithConf(ctx context.Context, dbtx walletdb.ReadTx, mined dcrutil.Amount,
+	minConf int32, match func([]stdaddr.Address) bool) (dcrutil.Amount, error) {
+
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+	_, tipHeight := w.txStore.MainChainTip(dbtx)
+
+	var begin, end int32
+	var sign dcrutil.Amount
+	switch {
+	case minConf <= 0:
+		// Add unmined credits.
+		begin, end, sign = -1, -1, 1
+	case minConf == 1:
+		return mined, nil
+	case tipHeight-minConf+1 < 0:
+		return 0, nil
+	default:
+		// Subtract credits from blocks with fewer than minConf
+		// confirmations.
+		begin, end, sign = tipHeight-minConf+2, tipHeight, -1
+	}
+
+	total := mined
+	rangeFn := func(details []udb.TxDetails) (bool, error) {
+		for i := range details {
+			detail := &details[i]
+			if detail.Pruned {
+				continue
+			}
+			for _, cred := range detail.Credits {
+				pkVersion := detail.MsgTx.TxOut[cred.Index].Version
+				pkScript := detail.MsgTx.TxOut[cred.Index].PkScript
+				_, addrs := stdscript.ExtractAddrs(pkVersion, pkScript, w.chainParams)
+				if match(addrs) {
+					total += sign * cred.Amount
+				}
+			}
+		}
+		return false, nil
+	}
+	err := w.txStore.RangeTransactions(ctx, txmgrNs, begin, end, rangeFn)
+	if err != nil {
+		return 0, err
+	}
+	return max(total, 0), nil
+}
+
 // SendOutputs creates and sends payment transactions. It returns the
-// transaction hash upon success
-func (w *Wallet) SendOutputs(ctx context.Context, outputs []*wire.TxOut, account, changeAccount uint32, minconf int32) (*chainhash.Hash, error) {
+// transaction hash upon success.  Change is returned to changeAccount when it
+// differs from account, and is otherwise directed by the wallet's change
+// policy.  Either may be overridden with the WithChangePolicy option, and the
+// WithIdempotencyKey option prevents sending again on retries.
+func (w *Wallet) SendOutputs(ctx context.Context, outputs []*wire.TxOut, account, changeAccount uint32, minconf int32, opts ...TxAuthorOption) (*chainhash.Hash, error) {
 	const op errors.Op = "wallet.SendOutputs"
 	relayFee := w.RelayFee()
 	for _, output := range outputs {
@@ -4581,17 +5071,48 @@ func (w *Wallet) SendOutputs(ctx context.Context, outputs []*wire.TxOut, account
 		}
 	}
 
+	key, requestHash := idempotencyKey(opts)
+	if key != "" {
+		if err := checkIdempotencyKey(key); err != nil {
+			return nil, errors.E(op, err)
+		}
+		w.idempotencyMu.Lock()
+		defer w.idempotencyMu.Unlock()
+		prev, err := w.previousIdempotentRequest(ctx, key, requestHash)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		if len(prev) != 0 {
+			return &prev[0], nil
+		}
+	}
+
+	changePolicy, err := w.resolveChangePolicy(ctx, opts,
+		changeAccount != account)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	expiry, err := resolveTxExpiry(w.TxExpiry(), opts)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
 	a := &authorTx{
 		outputs:            outputs,
 		account:            account,
 		changeAccount:      changeAccount,
+		changePolicy:       changePolicy,
+		override:           destinationOverride(opts),
+		expiry:             expiry,
 		minconf:            minconf,
 		randomizeChangeIdx: true,
 		txFee:              relayFee,
 		dontSignTx:         false,
 		isTreasury:         false,
+		idempotencyKey:     key,
+		idempotencyRequest: requestHash,
 	}
-	err := w.authorTx(ctx, op, a)
+	err = w.authorTx(ctx, op, a)
 	if err != nil {
 		return nil, err
 	}
@@ -4599,16 +5120,19 @@ func (w *Wallet) SendOutputs(ctx context.Context, outputs []*wire.TxOut, account
 	if err != nil {
 		return nil, err
 	}
+	hash := a.atx.Tx.TxHash()
 	err = w.publishAndWatch(ctx, op, nil, a.atx.Tx, a.watch)
 	if err != nil {
+		if key != "" {
+			w.releaseAbandonedIdempotencyKey(ctx, key, &hash)
+		}
 		return nil, err
 	}
-	hash := a.atx.Tx.TxHash()
 	return &hash, nil
 }
 
 // transaction hash upon success
-func (w *Wallet) SendOutputsToTreasury(ctx context.Context, outputs []*wire.TxOut, account, changeAccount uint32, minconf int32) (*chainhash.Hash, error) {
+func (w *Wallet) SendOutputsToTreasury(ctx context.Context, outputs []*wire.TxOut, account, changeAccount uint32, minconf int32, opts ...TxAuthorOption) (*chainhash.Hash, error) {
 	const op errors.Op = "wallet.SendOutputsToTreasury"
 	relayFee := w.RelayFee()
 	for _, output := range outputs {
@@ -4618,17 +5142,30 @@ func (w *Wallet) SendOutputsToTreasury(ctx context.Context, outputs []*wire.TxOu
 		}
 	}
 
+	changePolicy, err := w.resolveChangePolicy(ctx, opts,
+		changeAccount != account)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	expiry, err := resolveTxExpiry(w.TxExpiry(), opts)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
 	a := &authorTx{
 		outputs:            outputs,
 		account:            account,
 		changeAccount:      changeAccount,
+		changePolicy:       changePolicy,
+		override:           destinationOverride(opts),
+		expiry:             expiry,
 		minconf:            minconf,
 		randomizeChangeIdx: false,
 		txFee:              relayFee,
 		dontSignTx:         false,
 		isTreasury:         true,
 	}
-	err := w.authorTx(ctx, op, a)
+	err = w.authorTx(ctx, op, a)
 	if err != nil {
 		return nil, err
 	}
@@ -4667,6 +5204,10 @@ func (s sigDataSource) GetScript(a stdaddr.Address) ([]byte, error) { return s.s
 func (w *Wallet) CreateVspPayment(ctx context.Context, tx *wire.MsgTx, fee dcrutil.Amount,
 	feeAddr stdaddr.Address, feeAcct uint32, changeAcct uint32) error {
 
+	if err := w.checkVoteOnly(); err != nil {
+		return errors.E("wallet.CreateVspPayment", err)
+	}
+
 	// Reserve new outputs to pay the fee if outputs have not already been
 	// reserved.  This will be the case for fee payments that were begun on
 	// already purchased tickets, where the caller did not ensure that fee
@@ -4721,6 +5262,9 @@ func (w *Wallet) CreateVspPayment(ctx context.Context, tx *wire.MsgTx, fee dcrut
 		PkScript: feeScript,
 	})
 	feeRate := w.RelayFee()
+	if err := w.checkFeeRate(feeRate); err != nil {
+		return err
+	}
 	scriptSizes := make([]int, len(tx.TxIn))
 	for i := range scriptSizes {
 		scriptSizes[i] = txsizes.RedeemP2PKHSigScriptSize
@@ -4737,7 +5281,8 @@ func (w *Wallet) CreateVspPayment(ctx context.Context, tx *wire.MsgTx, fee dcrut
 
 	feeHash := tx.TxHash()
 
-	sigErrs, err := w.SignTransaction(ctx, tx, txscript.SigHashAll, nil, nil, nil)
+	sigErrs, err := w.signTransaction(ctx, tx, txscript.SigHashAll, nil, nil, nil,
+		signTicketFee)
 	if err != nil || len(sigErrs) > 0 {
 		log.Errorf("failed to sign transaction: %v", err)
 		sigErrStr := ""
@@ -4775,7 +5320,41 @@ func (w *Wallet) CreateVspPayment(ctx context.Context, tx *wire.MsgTx, fee dcrut
 func (w *Wallet) SignTransaction(ctx context.Context, tx *wire.MsgTx, hashType txscript.SigHashType, additionalPrevScripts map[wire.OutPoint][]byte,
 	additionalKeysByAddress map[string]*dcrutil.WIF, p2shRedeemScriptsByAddress map[string][]byte) ([]SignatureError, error) {
 
+	return w.signTransaction(ctx, tx, hashType, additionalPrevScripts,
+		additionalKeysByAddress, p2shRedeemScriptsByAddress, signSpend)
+}
+
+// signPurpose describes why a transaction is signed, determining the
+// policies checked before signing.
+type signPurpose int
+
+const (
+	// signSpend signs a spend subject to destination policies and spend
+	// approval.
+	signSpend signPurpose = iota
+
+	// signPendingSpend signs an approved pending spend, whose
+	// destinations were checked when it was proposed.
+	signPendingSpend
+
+	// signTicketFee signs the VSP fee payment of a ticket, which like
+	// ticket purchases does not require approval.
+	signTicketFee
+)
+
+// signTransaction implements SignTransaction.
+func (w *Wallet) signTransaction(ctx context.Context, tx *wire.MsgTx, hashType txscript.SigHashType,
+	additionalPrevScripts map[wire.OutPoint][]byte, additionalKeysByAddress map[string]*dcrutil.WIF,
+	p2shRedeemScriptsByAddress map[string][]byte, purpose signPurpose) ([]SignatureError, error) {
+
 	const op errors.Op = "wallet.SignTransaction"
+	if err := w.checkVoteOnly(); err != nil {
+		return nil, errors.E(op, err)
+	}
+	if err := w.checkHeldInputs(tx); err != nil {
+		return nil, errors.E(op, err)
+	}
+	defer w.timings.observe(TimingSign, time.Now())
 
 	var doneFuncs []func()
 	defer func() {
@@ -4784,6 +5363,33 @@ func (w *Wallet) SignTransaction(ctx context.Context, tx *wire.MsgTx, hashType t
 		}
 	}()
 
+	// Spends signed with wallet keys are subject to destination policies
+	// and may require approval.  Votes and revocations are exempt.
+	if len(additionalKeysByAddress) == 0 && !stake.IsSSGen(tx) && !stake.IsSSRtx(tx) {
+		var approval *SpendApproval
+		err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+			if purpose != signPendingSpend {
+				prevScripts := w.prevScripts(dbtx, tx, additionalPrevScripts)
+				err := w.checkSpendDestinations(dbtx, tx, prevScripts, nil)
+				if err != nil {
+					return err
+				}
+			}
+			if purpose != signTicketFee {
+				approval = w.spendApproval(dbtx, tx, -1, purpose == signPendingSpend)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		if approval != nil {
+			if err := w.requestSpendApproval(ctx, approval); err != nil {
+				return nil, errors.E(op, err)
+			}
+		}
+	}
+
 	var signErrors []SignatureError
 	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
 		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
@@ -4831,6 +5437,7 @@ func (w *Wallet) SignTransaction(ctx context.Context, tx *wire.MsgTx, hashType t
 					return nil, 0, false, err
 				}
 				doneFuncs = append(doneFuncs, done)
+				w.noteSigningActivity()
 				return key.Serialize(), dcrec.STEcdsaSecp256k1, true, nil
 			}
 			source.script = func(addr stdaddr.Address) ([]byte, error) {
@@ -4910,12 +5517,38 @@ func (w *Wallet) SignTransaction(ctx context.Context, tx *wire.MsgTx, hashType t
 	return signErrors, nil
 }
 
+// prevScripts returns the previous output scripts of each input of tx, from
+// additional or the wallet's transaction store.  Scripts of unknown previous
+// outputs are nil.
+func (w *Wallet) prevScripts(dbtx walletdb.ReadTx, tx *wire.MsgTx,
+	additional map[wire.OutPoint][]byte) [][]byte {
+
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+	scripts := make([][]byte, len(tx.TxIn))
+	for i, in := range tx.TxIn {
+		prev := &in.PreviousOutPoint
+		if script, ok := additional[*prev]; ok {
+			scripts[i] = script
+			continue
+		}
+		details, err := w.txStore.TxDetails(txmgrNs, &prev.Hash)
+		if err != nil || int(prev.Index) >= len(details.MsgTx.TxOut) {
+			continue
+		}
+		scripts[i] = details.MsgTx.TxOut[prev.Index].PkScript
+	}
+	return scripts
+}
+
 // CreateSignature returns the raw signature created by the private key of addr
 // for tx's idx'th input script and the serialized compressed pubkey for the
 // address.
 func (w *Wallet) CreateSignature(ctx context.Context, tx *wire.MsgTx, idx uint32, addr stdaddr.Address,
 	hashType txscript.SigHashType, prevPkScript []byte) (sig, pubkey []byte, err error) {
 	const op errors.Op = "wallet.CreateSignature"
+	if err := w.checkVoteOnly(); err != nil {
+		return nil, nil, errors.E(op, err)
+	}
 	var privKey *secp256k1.PrivateKey
 	var pubKey *secp256k1.PublicKey
 	var done func()
@@ -4925,9 +5558,36 @@ func (w *Wallet) CreateSignature(ctx context.Context, tx *wire.MsgTx, idx uint32
 		}
 	}()
 
+	// Signatures are subject to the destination policy of the spend and
+	// may require approval.
+	var approval *SpendApproval
 	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
-		ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		if int(idx) >= len(tx.TxIn) {
+			return errors.E(errors.Invalid, "input index out of range")
+		}
+		if err := w.checkHeldInputs(tx); err != nil {
+			return err
+		}
+		prevScripts := make([][]byte, len(tx.TxIn))
+		prevScripts[idx] = prevPkScript
+		err := w.checkSpendDestinations(dbtx, tx, prevScripts, nil)
+		if err != nil {
+			return err
+		}
+		approval = w.spendApproval(dbtx, tx, -1, false)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, errors.E(op, err)
+	}
+	if approval != nil {
+		if err := w.requestSpendApproval(ctx, approval); err != nil {
+			return nil, nil, errors.E(op, err)
+		}
+	}
 
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
 		var err error
 		privKey, done, err = w.manager.PrivateKey(ns, addr)
 		if err != nil {
@@ -4939,6 +5599,7 @@ func (w *Wallet) CreateSignature(ctx context.Context, tx *wire.MsgTx, idx uint32
 	if err != nil {
 		return nil, nil, errors.E(op, err)
 	}
+	w.noteSigningActivity()
 
 	sig, err = sign.RawTxInSignature(tx, int(idx), prevPkScript, hashType,
 		privKey.Serialize(), dcrec.STEcdsaSecp256k1)
@@ -4967,18 +5628,8 @@ func (w *Wallet) isRelevantTx(dbtx walletdb.ReadTx, tx *wire.MsgTx) bool {
 		}
 	}
 	for _, out := range tx.TxOut {
-		_, addrs := stdscript.ExtractAddrs(out.Version, out.PkScript, w.chainParams)
-		for _, a := range addrs {
-			var hash160 *[20]byte
-			switch a := a.(type) {
-			case stdaddr.Hash160er:
-				hash160 = a.Hash160()
-			default:
-				continue
-			}
-			if w.manager.ExistsHash160(addrmgrNs, hash160[:]) {
-				return true
-			}
+		if w.manager.ExistsScript(addrmgrNs, out.Version, out.PkScript) {
+			return true
 		}
 	}
 
@@ -5035,26 +5686,19 @@ func (w *Wallet) appendRelevantOutpoints(relevant []wire.OutPoint, dbtx walletdb
 			}
 		}
 
-		class, addrs := stdscript.ExtractAddrs(out.Version, out.PkScript, w.chainParams)
+		if !w.manager.ExistsScript(addrmgrNs, out.Version, out.PkScript) {
+			continue
+		}
+		class := stdscript.DetermineScriptType(out.Version, out.PkScript)
 		tree := wire.TxTreeRegular
 		if _, isStake := txrules.StakeSubScriptType(class); isStake {
 			tree = wire.TxTreeStake
 		}
-
-		for _, a := range addrs {
-			var hash160 *[20]byte
-			if a, ok := a.(stdaddr.Hash160er); ok {
-				hash160 = a.Hash160()
-			}
-			if hash160 != nil && w.manager.ExistsHash160(addrmgrNs, hash160[:]) {
-				op.Index = uint32(i)
-				op.Tree = tree
-				relevant = append(relevant, op)
-				if isTicket && i == 0 {
-					watchedTicketOutputZero = true
-				}
-				break
-			}
+		op.Index = uint32(i)
+		op.Tree = tree
+		relevant = append(relevant, op)
+		if isTicket && i == 0 {
+			watchedTicketOutputZero = true
 		}
 	}
 
@@ -5083,7 +5727,11 @@ func (w *Wallet) AbandonTransaction(ctx context.Context, hash *chainhash.Hash) e
 		if details.Block.Height != -1 {
 			return errors.E(errors.Invalid, errors.Errorf("transaction %v is mined in main chain", hash))
 		}
-		return w.txStore.RemoveUnconfirmed(ns, &details.MsgTx, hash)
+		err = w.txStore.RemoveUnconfirmed(ns, &details.MsgTx, hash)
+		if err != nil {
+			return err
+		}
+		return journalTx(dbtx, udb.JournalTxRemoved, hash, nil)
 	})
 	if err != nil {
 		op := errors.Opf(opf, hash)
@@ -5147,7 +5795,7 @@ func (w *Wallet) PublishTransaction(ctx context.Context, tx *wire.MsgTx, n Netwo
 		}
 	}
 
-	err = n.PublishTransactions(ctx, tx)
+	err = w.publishTransactions(ctx, n, tx)
 	if err != nil {
 		if relevant {
 			if err := w.AbandonTransaction(ctx, &txHash); err != nil {
@@ -5177,7 +5825,7 @@ func (w *Wallet) PublishUnminedTransactions(ctx context.Context, n NetworkBacken
 	if err != nil {
 		return errors.E(op, err)
 	}
-	err = n.PublishTransactions(ctx, unminedTxs...)
+	err = w.publishTransactions(ctx, n, unminedTxs...)
 	if err != nil {
 		return errors.E(op, err)
 	}
@@ -5341,12 +5989,24 @@ func (w *Wallet) ManualTickets() bool {
 // configuration options and sets it up it according to the rest of options.
 func Open(ctx context.Context, cfg *Config) (*Wallet, error) {
 	const op errors.Op = "wallet.Open"
+	if cfg.TxPruneDepth != 0 && cfg.TxPruneDepth < MinTxPruneDepth {
+		err := errors.Errorf("transaction prune depth must be at least %d",
+			MinTxPruneDepth)
+		return nil, errors.E(op, errors.Invalid, err)
+	}
+	if cfg.SidechainPruneDepth < 0 {
+		return nil, errors.E(op, errors.Invalid, "negative sidechain prune depth")
+	}
 	// Migrate to the unified DB if necessary.
 	db := cfg.DB.internal()
 	needsMigration, err := udb.NeedsMigration(ctx, db)
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
+	if needsMigration && cfg.ReadOnly {
+		return nil, errors.E(op, errors.Invalid,
+			"read-only database requires migration")
+	}
 	if needsMigration {
 		err := udb.Migrate(ctx, db, cfg.Params)
 		if err != nil {
@@ -5354,10 +6014,13 @@ func Open(ctx context.Context, cfg *Config) (*Wallet, error) {
 		}
 	}
 
-	// Perform upgrades as necessary.
-	err = udb.Upgrade(ctx, db, cfg.PubPassphrase, cfg.Params)
-	if err != nil {
-		return nil, errors.E(op, err)
+	// Perform upgrades as necessary.  Read-only databases which require an
+	// upgrade fail to open with the database managers below.
+	if !cfg.ReadOnly {
+		err = udb.Upgrade(ctx, db, cfg.PubPassphrase, cfg.Params)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
 	}
 
 	// Impose a maximum difficulty target on the test network to prevent runaway
@@ -5403,6 +6066,11 @@ func Open(ctx context.Context, cfg *Config) (*Wallet, error) {
 		accountGapLimit:         cfg.AccountGapLimit,
 		disableCoinTypeUpgrades: cfg.DisableCoinTypeUpgrades,
 		manualTickets:           cfg.ManualTickets,
+		txPruneDepth:            cfg.TxPruneDepth,
+		sidechainPruneDepth:     cfg.SidechainPruneDepth,
+		voteOnly:                cfg.VoteOnly,
+		treasuryKeys:            cfg.TreasuryKeys,
+		readOnly:                cfg.ReadOnly,
 
 		// Chain params
 		subsidyCache:       blockchain.NewSubsidyCache(params),
@@ -5411,7 +6079,12 @@ func Open(ctx context.Context, cfg *Config) (*Wallet, error) {
 		minTestNetTarget:   minTestNetTarget,
 		minTestNetDiffBits: minTestNetDiffBits,
 
-		lockedOutpoints: make(map[outpoint]struct{}),
+		lockedOutpoints:   make(map[outpoint]struct{}),
+		fundsReservations: make(map[string]*FundsReservation),
+		holds:             make(map[string]*udb.Hold),
+		heldOutpoints:     make(map[outpoint]string),
+		pendingOutpoints:  make(map[outpoint]struct{}),
+		approvalOutpoints: make(map[outpoint]struct{}),
 
 		recentlyPublished: make(map[chainhash.Hash]struct{}),
 
@@ -5425,6 +6098,7 @@ func Open(ctx context.Context, cfg *Config) (*Wallet, error) {
 
 		dialer: cfg.Dialer,
 	}
+	w.db = &timedDB{DB: db, t: &w.timings}
 
 	// Open database managers
 	w.manager, w.txStore, err = udb.Open(ctx, db, params, cfg.PubPassphrase)
@@ -5433,11 +6107,21 @@ func Open(ctx context.Context, cfg *Config) (*Wallet, error) {
 	}
 	log.Infof("Opened wallet") // TODO: log balance? last sync height?
 
-	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
-		return w.rollbackInvalidCheckpoints(dbtx)
-	})
-	if err != nil {
-		return nil, errors.E(op, err)
+	if w.manager.WatchingOnly() && cfg.WatchingOnlyGapLimit > w.gapLimit {
+		w.gapLimit = cfg.WatchingOnlyGapLimit
+		log.Infof("Using watching-only address gap limit %d", w.gapLimit)
+	}
+
+	if !w.readOnly {
+		err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+			return w.rollbackInvalidCheckpoints(dbtx)
+		})
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		if err := w.claimInstance(ctx, time.Now()); err != nil {
+			return nil, errors.E(op, err)
+		}
 	}
 
 	var vb stake.VoteBits
@@ -5445,6 +6129,11 @@ func Open(ctx context.Context, cfg *Config) (*Wallet, error) {
 	var treasuryKeyPolicy map[string]stake.TreasuryVoteT
 	var vspTSpendPolicy map[udb.VSPTSpend]stake.TreasuryVoteT
 	var vspTreasuryKeyPolicy map[udb.VSPTreasuryKey]stake.TreasuryVoteT
+	var mixedSpend *udb.MixedSpendPolicy
+	var pendingSpends []*udb.PendingSpend
+	var holds []*udb.Hold
+	var reservations []*FundsReservation
+	var maxFeeRate dcrutil.Amount
 	err = walletdb.View(ctx, w.db, func(tx walletdb.ReadTx) error {
 		ns := tx.ReadBucket(waddrmgrNamespaceKey)
 		lastAcct, err := w.manager.LastAccount(ns)
@@ -5505,7 +6194,31 @@ func Open(ctx context.Context, cfg *Config) (*Wallet, error) {
 			return err
 		}
 
-		return nil
+		mixedSpend = udb.FetchMixedSpendPolicy(tx)
+		maxFeeRate = udb.FetchMaxFeeRate(tx)
+
+		pendingSpends, err = udb.PendingSpends(tx)
+		if err != nil {
+			return err
+		}
+
+		err = udb.ForEachHold(tx, func(h *udb.Hold) error {
+			holds = append(holds, h)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		return udb.ForEachFundsReservation(tx, func(r *udb.FundsReservation) error {
+			reservations = append(reservations, &FundsReservation{
+				ID:        r.ID,
+				Account:   r.Account,
+				Outpoints: r.Outpoints,
+				Amount:    r.Amount,
+			})
+			return nil
+		})
 	})
 	if err != nil {
 		return nil, errors.E(op, err)
@@ -5517,13 +6230,28 @@ func Open(ctx context.Context, cfg *Config) (*Wallet, error) {
 	w.tspendKeyPolicy = treasuryKeyPolicy
 	w.vspTSpendPolicy = vspTSpendPolicy
 	w.vspTSpendKeyPolicy = vspTreasuryKeyPolicy
+	w.mixedSpend = mixedSpend
+	for _, p := range pendingSpends {
+		w.lockPendingSpendInputs(p.Tx)
+	}
+	for _, h := range holds {
+		w.lockHold(h)
+	}
+	for _, r := range reservations {
+		w.lockFundsReservation(r)
+	}
 
 	// Amounts
 	w.relayFee = cfg.RelayFee
+	w.maxFeeRate = maxFeeRate
 
 	// Record current tip as initialHeight.
 	_, w.initialHeight = w.MainChainTip(ctx)
 
+	// Warn about saved agenda choices which a vote version upgrade made
+	// obsolete.
+	w.warnObsoleteAgendaChoices(ctx)
+
 	if w.mixingEnabled {
 		w.mixpool = mixpool.NewPool((*mixpoolBlockchain)(w))
 		w.mixClient = mixclient.NewClient((*mixingWallet)(w))
@@ -5560,7 +6288,7 @@ func (w *Wallet) getCoinjoinTxsSumbByAcct(ctx context.Context) (map[uint32]int,
 		_, tipHeight := w.txStore.MainChainTip(dbtx)
 		rangeFn := func(details []udb.TxDetails) (bool, error) {
 			for _, detail := range details {
-				if detail.TxType != stake.TxTypeRegular {
+				if detail.TxType != stake.TxTypeRegular || detail.Pruned {
 					continue
 				}
 				isMixedTx, mixDenom, _ := PossibleCoinJoin(&detail.MsgTx)
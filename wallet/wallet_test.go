@@ -5,12 +5,14 @@
 package wallet
 
 import (
+	"context"
 	"encoding/hex"
 	"math"
 	"testing"
 
 	"decred.org/dcrwallet/v5/errors"
 	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/hdkeychain/v3"
 )
 
 func TestCoinbaseMatured(t *testing.T) {
@@ -138,3 +140,54 @@ func TestVotingXprivFromSeed(t *testing.T) {
 		}
 	}
 }
+
+func TestAccountChildXpub(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	path := []uint32{13, 0}
+	if _, err := w.AccountChildXpub(ctx, 0, path); !errors.Is(err, errors.Locked) {
+		t.Errorf("derived child xpub of locked wallet: %v", err)
+	}
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	invalid := [][]uint32{nil, {hdkeychain.HardenedKeyStart}}
+	for _, p := range invalid {
+		if _, err := w.AccountChildXpub(ctx, 0, p); !errors.Is(err, errors.Invalid) {
+			t.Errorf("path %v: derived invalid path: %v", p, err)
+		}
+	}
+
+	xpub, err := w.AccountChildXpub(ctx, 0, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if xpub.IsPrivate() {
+		t.Fatal("child xpub is private")
+	}
+	acctXpriv, err := w.AccountXpriv(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := acctXpriv
+	for _, i := range path {
+		want, err = want.Child(hdkeychain.HardenedKeyStart + i)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if xpub.String() != want.Neuter().String() {
+		t.Errorf("child xpub %v, want %v", xpub, want.Neuter())
+	}
+
+	// The cached account key must not be zeroed by derivation.
+	again, err := w.AccountChildXpub(ctx, 0, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.String() != xpub.String() {
+		t.Errorf("repeated derivation returned %v, want %v", again, xpub)
+	}
+}
@@ -0,0 +1,327 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package walletdb
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+	"io"
+	"slices"
+
+	"decred.org/dcrwallet/v5/errors"
+)
+
+// The export format is a driver-independent serialization of every bucket and
+// key/value pair of a database.  It begins with the magic bytes and a uvarint
+// format version, followed by a stream of records, each beginning with a
+// record type byte:
+//
+//	recBucket  uvarint key length, key: begins a bucket nested in the current
+//	           bucket, or a top level bucket
+//	recValue   uvarint key length, key, uvarint value length, value: a
+//	           key/value pair of the current bucket
+//	recEnd     ends the current bucket
+//	recTrailer 32-byte SHA-256 hash of all preceding bytes of the stream
+//
+// The keys of top level buckets, and of the buckets and pairs within each
+// bucket, are written in ascending bytewise order regardless of the iteration
+// order of the exporting driver, so exporting the same database contents
+// always produces the same stream.
+const (
+	recTrailer byte = iota
+	recBucket
+	recValue
+	recEnd
+)
+
+var exportMagic = []byte("dcrwalletdb")
+
+const exportVersion = 1
+
+// maxExportLen limits the length of keys and values read from an export to
+// avoid large allocations when reading a corrupt stream.
+const maxExportLen = 1 << 28
+
+// Export writes every top level bucket, and all nested buckets and key/value
+// pairs within them, to w in a canonical format which may be read by Import
+// into a database of any driver.  The export is performed in a single read
+// transaction and is a consistent view of the database.
+func Export(ctx context.Context, db DB, w io.Writer) error {
+	const op errors.Op = "walletdb.Export"
+
+	h := sha256.New()
+	bw := bufio.NewWriter(io.MultiWriter(w, h))
+	e := &exporter{w: bw}
+	e.write(exportMagic)
+	e.uvarint(exportVersion)
+	err := View(ctx, db, func(tx ReadTx) error {
+		keys, err := sortedKeys(tx.ForEachBucket)
+		if err != nil {
+			return err
+		}
+		for _, k := range keys {
+			b := tx.ReadBucket(k)
+			if b == nil {
+				return errors.Errorf("top level key %x is not a bucket", k)
+			}
+			if err := e.bucket(ctx, k, b); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	e.write([]byte{recTrailer})
+	if e.err == nil {
+		e.err = bw.Flush()
+	}
+	if e.err == nil {
+		_, e.err = w.Write(h.Sum(nil))
+	}
+	if e.err != nil {
+		return errors.E(op, errors.IO, e.err)
+	}
+	return nil
+}
+
+// exporter writes export records, retaining the first write error.
+type exporter struct {
+	w   *bufio.Writer
+	err error
+	buf [binary.MaxVarintLen64]byte
+}
+
+func (e *exporter) write(b []byte) {
+	if e.err == nil {
+		_, e.err = e.w.Write(b)
+	}
+}
+
+func (e *exporter) uvarint(v uint64) {
+	n := binary.PutUvarint(e.buf[:], v)
+	e.write(e.buf[:n])
+}
+
+func (e *exporter) bytes(b []byte) {
+	e.uvarint(uint64(len(b)))
+	e.write(b)
+}
+
+// bucket writes the records of bucket b with key k.
+func (e *exporter) bucket(ctx context.Context, k []byte, b ReadBucket) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	e.write([]byte{recBucket})
+	e.bytes(k)
+	keys, err := sortedKeys(func(f func(k []byte) error) error {
+		return b.ForEach(func(k, _ []byte) error { return f(k) })
+	})
+	if err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if nested := b.NestedReadBucket(k); nested != nil {
+			if err := e.bucket(ctx, k, nested); err != nil {
+				return err
+			}
+			continue
+		}
+		e.write([]byte{recValue})
+		e.bytes(k)
+		e.bytes(b.Get(k))
+		if e.err != nil {
+			return e.err
+		}
+	}
+	e.write([]byte{recEnd})
+	return e.err
+}
+
+// sortedKeys returns copies of the keys iterated by forEach in ascending
+// bytewise order.
+func sortedKeys(forEach func(func(k []byte) error) error) ([][]byte, error) {
+	var keys [][]byte
+	err := forEach(func(k []byte) error {
+		keys = append(keys, bytes.Clone(k))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(keys, bytes.Compare)
+	return keys, nil
+}
+
+// Import reads an export written by Export from r and recreates its buckets
+// and key/value pairs in db, which must not contain any top level buckets.
+// The import is performed in a single read-write transaction which is only
+// committed after the integrity of the entire stream is verified.  Errors
+// with code Encoding if the stream is malformed or corrupt.
+func Import(ctx context.Context, db DB, r io.Reader) error {
+	const op errors.Op = "walletdb.Import"
+
+	im := &importer{r: bufio.NewReader(r), h: sha256.New()}
+	magic, err := im.read(len(exportMagic))
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if !bytes.Equal(magic, exportMagic) {
+		return errors.E(op, errors.Encoding, "not a wallet database export")
+	}
+	version, err := im.uvarint()
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if version != exportVersion {
+		return errors.E(op, errors.Encoding,
+			errors.Errorf("unsupported export version %d", version))
+	}
+
+	err = Update(ctx, db, func(tx ReadWriteTx) error {
+		err := tx.ForEachBucket(func([]byte) error {
+			return errors.E(errors.Exist, "database is not empty")
+		})
+		if err != nil {
+			return err
+		}
+		return im.records(ctx, tx)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// importer reads export records, hashing all bytes read before the trailer.
+type importer struct {
+	r *bufio.Reader
+	h hash.Hash
+}
+
+func readErr(err error) error {
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return errors.E(errors.Encoding, "unexpected end of export")
+	}
+	return errors.E(errors.IO, err)
+}
+
+func (im *importer) ReadByte() (byte, error) {
+	b, err := im.r.ReadByte()
+	if err != nil {
+		return 0, readErr(err)
+	}
+	im.h.Write([]byte{b})
+	return b, nil
+}
+
+func (im *importer) read(n int) ([]byte, error) {
+	b := make([]byte, n)
+	if _, err := io.ReadFull(im.r, b); err != nil {
+		return nil, readErr(err)
+	}
+	im.h.Write(b)
+	return b, nil
+}
+
+func (im *importer) uvarint() (uint64, error) {
+	v, err := binary.ReadUvarint(im)
+	if errors.Is(err, errors.Encoding) || errors.Is(err, errors.IO) {
+		return 0, err
+	}
+	if err != nil {
+		return 0, errors.E(errors.Encoding, err)
+	}
+	return v, nil
+}
+
+func (im *importer) bytes() ([]byte, error) {
+	n, err := im.uvarint()
+	if err != nil {
+		return nil, err
+	}
+	if n > maxExportLen {
+		return nil, errors.E(errors.Encoding,
+			errors.Errorf("record length %d exceeds limit", n))
+	}
+	return im.read(int(n))
+}
+
+// records reads all records through the trailer and writes them to tx.
+func (im *importer) records(ctx context.Context, tx ReadWriteTx) error {
+	var stack []ReadWriteBucket
+	for {
+		typ, err := im.ReadByte()
+		if err != nil {
+			return err
+		}
+		switch typ {
+		case recBucket:
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			k, err := im.bytes()
+			if err != nil {
+				return err
+			}
+			var b ReadWriteBucket
+			if len(stack) == 0 {
+				b, err = tx.CreateTopLevelBucket(k)
+			} else {
+				b, err = stack[len(stack)-1].CreateBucket(k)
+			}
+			if err != nil {
+				return err
+			}
+			stack = append(stack, b)
+
+		case recValue:
+			if len(stack) == 0 {
+				return errors.E(errors.Encoding, "key/value pair outside of bucket")
+			}
+			k, err := im.bytes()
+			if err != nil {
+				return err
+			}
+			v, err := im.bytes()
+			if err != nil {
+				return err
+			}
+			if err := stack[len(stack)-1].Put(k, v); err != nil {
+				return err
+			}
+
+		case recEnd:
+			if len(stack) == 0 {
+				return errors.E(errors.Encoding, "unbalanced bucket end")
+			}
+			stack = stack[:len(stack)-1]
+
+		case recTrailer:
+			if len(stack) != 0 {
+				return errors.E(errors.Encoding, "unterminated bucket")
+			}
+			sum := im.h.Sum(nil)
+			trailer := make([]byte, len(sum))
+			if _, err := io.ReadFull(im.r, trailer); err != nil {
+				return readErr(err)
+			}
+			if !bytes.Equal(sum, trailer) {
+				return errors.E(errors.Encoding, "export checksum mismatch")
+			}
+			return nil
+
+		default:
+			return errors.E(errors.Encoding,
+				errors.Errorf("unknown record type %d", typ))
+		}
+	}
+}
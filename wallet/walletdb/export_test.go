@@ -0,0 +1,183 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package walletdb_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+func createTestDB(t *testing.T) walletdb.DB {
+	t.Helper()
+	db, err := walletdb.Create(dbType, filepath.Join(t.TempDir(), "db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+// reversedDB iterates the buckets and keys of a database in descending order.
+type reversedDB struct{ walletdb.DB }
+
+type reversedTx struct{ walletdb.ReadTx }
+
+type reversedBucket struct{ walletdb.ReadBucket }
+
+func (db reversedDB) BeginReadTx() (walletdb.ReadTx, error) {
+	tx, err := db.DB.BeginReadTx()
+	return reversedTx{tx}, err
+}
+
+func (tx reversedTx) ReadBucket(k []byte) walletdb.ReadBucket {
+	if b := tx.ReadTx.ReadBucket(k); b != nil {
+		return reversedBucket{b}
+	}
+	return nil
+}
+
+func (tx reversedTx) ForEachBucket(f func(k []byte) error) error {
+	var keys [][]byte
+	err := tx.ReadTx.ForEachBucket(func(k []byte) error {
+		keys = append(keys, k)
+		return nil
+	})
+	for i := len(keys) - 1; err == nil && i >= 0; i-- {
+		err = f(keys[i])
+	}
+	return err
+}
+
+func (b reversedBucket) NestedReadBucket(k []byte) walletdb.ReadBucket {
+	if nested := b.ReadBucket.NestedReadBucket(k); nested != nil {
+		return reversedBucket{nested}
+	}
+	return nil
+}
+
+func (b reversedBucket) ForEach(f func(k, v []byte) error) error {
+	var keys, values [][]byte
+	err := b.ReadBucket.ForEach(func(k, v []byte) error {
+		keys, values = append(keys, k), append(values, v)
+		return nil
+	})
+	for i := len(keys) - 1; err == nil && i >= 0; i-- {
+		err = f(keys[i], values[i])
+	}
+	return err
+}
+
+func TestExportImport(t *testing.T) {
+	ctx := context.Background()
+	src := createTestDB(t)
+	err := walletdb.Update(ctx, src, func(tx walletdb.ReadWriteTx) error {
+		ns1, err := tx.CreateTopLevelBucket([]byte("ns1"))
+		if err != nil {
+			return err
+		}
+		if err := ns1.Put([]byte("k1"), []byte("v1")); err != nil {
+			return err
+		}
+		if err := ns1.Put([]byte("empty"), []byte{}); err != nil {
+			return err
+		}
+		nested, err := ns1.CreateBucket([]byte("nested"))
+		if err != nil {
+			return err
+		}
+		if err := nested.Put([]byte("k2"), []byte("v2")); err != nil {
+			return err
+		}
+		if _, err := nested.CreateBucket([]byte("emptybucket")); err != nil {
+			return err
+		}
+		_, err = tx.CreateTopLevelBucket([]byte("ns2"))
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var export bytes.Buffer
+	if err := walletdb.Export(ctx, src, &export); err != nil {
+		t.Fatal(err)
+	}
+	dst := createTestDB(t)
+	if err := walletdb.Import(ctx, dst, bytes.NewReader(export.Bytes())); err != nil {
+		t.Fatal(err)
+	}
+
+	// Exports are canonical, so the imported database must export
+	// identically.
+	var reexport bytes.Buffer
+	if err := walletdb.Export(ctx, dst, &reexport); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(export.Bytes(), reexport.Bytes()) {
+		t.Fatalf("reexport differs from original export")
+	}
+
+	// Exports do not depend on the iteration order of the driver.
+	var reversed bytes.Buffer
+	if err := walletdb.Export(ctx, reversedDB{src}, &reversed); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(export.Bytes(), reversed.Bytes()) {
+		t.Fatalf("export depends on key iteration order")
+	}
+	err = walletdb.View(ctx, dst, func(tx walletdb.ReadTx) error {
+		ns1 := tx.ReadBucket([]byte("ns1"))
+		if ns1 == nil || tx.ReadBucket([]byte("ns2")) == nil {
+			t.Fatalf("missing top level buckets")
+		}
+		if v := ns1.Get([]byte("empty")); v == nil || len(v) != 0 {
+			t.Errorf("empty value not imported: %x", v)
+		}
+		nested := ns1.NestedReadBucket([]byte("nested"))
+		if nested == nil {
+			t.Fatalf("missing nested bucket")
+		}
+		if v := nested.Get([]byte("k2")); !bytes.Equal(v, []byte("v2")) {
+			t.Errorf("nested value is %q", v)
+		}
+		if nested.NestedReadBucket([]byte("emptybucket")) == nil {
+			t.Errorf("missing empty nested bucket")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Importing into a database with existing buckets must fail.
+	err = walletdb.Import(ctx, dst, bytes.NewReader(export.Bytes()))
+	if !errors.Is(err, errors.Exist) {
+		t.Errorf("import into non-empty database: %v", err)
+	}
+
+	// Corrupt and truncated exports must not write any buckets.
+	corrupt := bytes.Clone(export.Bytes())
+	corrupt[len(corrupt)-40] ^= 0xff
+	for _, b := range [][]byte{corrupt, export.Bytes()[:export.Len()-1]} {
+		db := createTestDB(t)
+		err := walletdb.Import(ctx, db, bytes.NewReader(b))
+		if !errors.Is(err, errors.Encoding) {
+			t.Errorf("import of corrupt export: %v", err)
+		}
+		err = walletdb.View(ctx, db, func(tx walletdb.ReadTx) error {
+			return tx.ForEachBucket(func(k []byte) error {
+				return errors.Errorf("bucket %q written by failed import", k)
+			})
+		})
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
@@ -23,6 +23,14 @@ type ReadTx interface {
 	// described by the key does not exist, nil is returned.
 	ReadBucket(key []byte) ReadBucket
 
+	// ForEachBucket invokes the passed function with the key of every top
+	// level bucket, in the driver's key order.
+	ForEachBucket(func(key []byte) error) error
+
+	// WriteTo streams a consistent copy of the entire database, as viewed
+	// by the transaction, to w.  It returns the number of bytes written.
+	WriteTo(w io.Writer) (n int64, err error)
+
 	// Rollback closes the transaction, discarding changes (if any) if the
 	// database was modified by a write transaction.
 	Rollback() error
@@ -0,0 +1,555 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallettest
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet"
+	"github.com/decred/dcrd/blockchain/stake/v5"
+	"github.com/decred/dcrd/blockchain/standalone/v2"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/gcs/v4"
+	"github.com/decred/dcrd/gcs/v4/blockcf2"
+	"github.com/decred/dcrd/mixing"
+	"github.com/decred/dcrd/txscript/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/txscript/v4/stdscript"
+	"github.com/decred/dcrd/wire"
+)
+
+type block struct {
+	msg    *wire.MsgBlock
+	hash   chainhash.Hash
+	filter *gcs.FilterV2
+}
+
+type prevScript struct {
+	version uint16
+	script  []byte
+}
+
+// Chain is a simulated Decred network which implements wallet.NetworkBackend.
+// Blocks are mined on demand with scripted transactions, and may be mined on
+// any known block to create side chains and reorganizations.  A transaction
+// filter loaded by the wallet determines which transactions are notified to
+// it, as with a dcrd JSON-RPC backend.
+//
+// Every block is assigned the minimum difficulty of the network, so the chain
+// with the most blocks is the best chain.  Blocks are not otherwise validated,
+// and transactions may spend previous outputs which never existed.
+type Chain struct {
+	params *chaincfg.Params
+
+	mu          sync.Mutex
+	blocks      map[chainhash.Hash]*block
+	mainChain   []*block
+	prevScripts map[wire.OutPoint]prevScript
+	mempool     []*wire.MsgTx
+	published   []*wire.MsgTx
+	nonce       uint32
+	fundings    uint32
+
+	watchedAddrs     map[string]struct{}
+	watchedOutPoints map[wire.OutPoint]struct{}
+
+	// forestMu serializes wallet notifications and protects the
+	// sidechain forest used to notify them.
+	forestMu sync.Mutex
+	forest   wallet.SidechainForest
+	wallet   *wallet.Wallet
+}
+
+// NewChain creates a simulated network for params containing only the
+// genesis block.
+func NewChain(params *chaincfg.Params) *Chain {
+	genesis := &block{
+		msg:  params.GenesisBlock,
+		hash: params.GenesisHash,
+	}
+	return &Chain{
+		params:           params,
+		blocks:           map[chainhash.Hash]*block{genesis.hash: genesis},
+		mainChain:        []*block{genesis},
+		prevScripts:      make(map[wire.OutPoint]prevScript),
+		watchedAddrs:     make(map[string]struct{}),
+		watchedOutPoints: make(map[wire.OutPoint]struct{}),
+	}
+}
+
+// Connect associates the wallet with the simulated network and loads its
+// transaction filter.  Blocks mined afterwards are notified to the wallet.
+func (c *Chain) Connect(ctx context.Context, w *wallet.Wallet) error {
+	c.forestMu.Lock()
+	c.wallet = w
+	c.forestMu.Unlock()
+	w.SetNetworkBackend(c)
+	return w.LoadActiveDataFilters(ctx, c, true)
+}
+
+// Tip returns the hash and height of the main chain tip block.
+func (c *Chain) Tip() (chainhash.Hash, int32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tip := c.mainChain[len(c.mainChain)-1]
+	return tip.hash, int32(len(c.mainChain) - 1)
+}
+
+// MainChainBlock returns the main chain block at a height.
+func (c *Chain) MainChainBlock(height int32) (*wire.MsgBlock, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if height < 0 || int(height) >= len(c.mainChain) {
+		return nil, errors.E(errors.NotExist, errors.Errorf("no main "+
+			"chain block at height %d", height))
+	}
+	return c.mainChain[height].msg, nil
+}
+
+// FundingTx returns a transaction paying amount to addr.  Its single input
+// spends a unique previous output which does not exist on the chain, so the
+// transaction is only useful to fund wallets.
+func (c *Chain) FundingTx(addr stdaddr.Address, amount dcrutil.Amount) *wire.MsgTx {
+	c.mu.Lock()
+	c.fundings++
+	prevOut := wire.OutPoint{Index: c.fundings}
+	c.mu.Unlock()
+
+	version, script := addr.PaymentScript()
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(&prevOut, int64(amount), nil))
+	tx.AddTxOut(&wire.TxOut{Value: int64(amount), Version: version, PkScript: script})
+	return tx
+}
+
+// AcceptTransaction adds tx to the mempool, notifying the wallet when it is
+// relevant.
+func (c *Chain) AcceptTransaction(ctx context.Context, tx *wire.MsgTx) error {
+	c.mu.Lock()
+	c.addMempoolTx(tx)
+	relevant := c.relevant(tx)
+	c.mu.Unlock()
+
+	c.forestMu.Lock()
+	defer c.forestMu.Unlock()
+	if !relevant || c.wallet == nil {
+		return nil
+	}
+	if c.wallet.ManualTickets() && stake.IsSStx(tx) {
+		return nil
+	}
+	return c.wallet.AddTransaction(ctx, tx, nil)
+}
+
+// Mempool returns the transactions accepted to the mempool which have not
+// been mined in a main chain block.
+func (c *Chain) Mempool() []*wire.MsgTx {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]*wire.MsgTx(nil), c.mempool...)
+}
+
+// Published returns all transactions published by the wallet.
+func (c *Chain) Published() []*wire.MsgTx {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]*wire.MsgTx(nil), c.published...)
+}
+
+// MineBlock mines a block containing txs on the main chain tip.  Stake
+// transactions are included in the stake tree of the block.
+func (c *Chain) MineBlock(ctx context.Context, txs ...*wire.MsgTx) (*wire.MsgBlock, error) {
+	tip, _ := c.Tip()
+	return c.MineBlockOn(ctx, &tip, txs...)
+}
+
+// MineMempool mines a block on the main chain tip containing every mempool
+// transaction.
+func (c *Chain) MineMempool(ctx context.Context) (*wire.MsgBlock, error) {
+	return c.MineBlock(ctx, c.Mempool()...)
+}
+
+// MineBlockOn mines a block containing txs on the block with hash parent.
+// When the block extends a side chain beyond the height of the main chain,
+// the chain is reorganized and the wallet is notified of the new best chain.
+func (c *Chain) MineBlockOn(ctx context.Context, parent *chainhash.Hash, txs ...*wire.MsgTx) (*wire.MsgBlock, error) {
+	c.mu.Lock()
+	b, err := c.newBlock(parent, txs)
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+	c.blocks[b.hash] = b
+	bestChain := c.connectBlock(b)
+	relevantTxs := make(map[chainhash.Hash][]*wire.MsgTx, len(bestChain))
+	for _, b := range bestChain {
+		relevantTxs[b.hash] = c.relevantBlockTxs(b.msg)
+	}
+	c.mu.Unlock()
+
+	err = c.notifyBlock(ctx, b, relevantTxs)
+	if err != nil {
+		return nil, err
+	}
+	return b.msg, nil
+}
+
+// Reorg replaces the depth blocks at the tip of the main chain with depth+1
+// blocks without any transactions.  Transactions of the removed blocks are
+// returned to the mempool.  The new main chain blocks are returned.
+func (c *Chain) Reorg(ctx context.Context, depth int) ([]*wire.MsgBlock, error) {
+	_, tipHeight := c.Tip()
+	if depth <= 0 || int32(depth) > tipHeight {
+		return nil, errors.E(errors.Invalid, errors.Errorf("invalid "+
+			"reorg depth %d at tip height %d", depth, tipHeight))
+	}
+	fork, err := c.MainChainBlock(tipHeight - int32(depth))
+	if err != nil {
+		return nil, err
+	}
+	parent := fork.BlockHash()
+	blocks := make([]*wire.MsgBlock, 0, depth+1)
+	for i := 0; i <= depth; i++ {
+		b, err := c.MineBlockOn(ctx, &parent)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, b)
+		parent = b.BlockHash()
+	}
+	return blocks, nil
+}
+
+// newBlock creates a block on parent with txs and records the previous output
+// scripts they create.  The block commits to its version 2 committed filter.
+// c.mu must be held.
+func (c *Chain) newBlock(parent *chainhash.Hash, txs []*wire.MsgTx) (*block, error) {
+	p, ok := c.blocks[*parent]
+	if !ok {
+		return nil, errors.E(errors.NotExist, errors.Errorf("unknown "+
+			"parent block %v", parent))
+	}
+
+	// The coinbase commits to the block nonce so that coinbases of
+	// competing blocks have unique hashes.
+	c.nonce++
+	nullData := []byte{txscript.OP_RETURN, txscript.OP_DATA_4, 0, 0, 0, 0}
+	binary.LittleEndian.PutUint32(nullData[2:], c.nonce)
+	coinbase := wire.NewMsgTx()
+	coinbase.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: *wire.NewOutPoint(&chainhash.Hash{},
+			wire.MaxPrevOutIndex, wire.TxTreeRegular),
+		Sequence:        wire.MaxTxInSequenceNum,
+		BlockHeight:     wire.NullBlockHeight,
+		BlockIndex:      wire.NullBlockIndex,
+		SignatureScript: []byte{txscript.OP_0, txscript.OP_0},
+	})
+	coinbase.AddTxOut(&wire.TxOut{PkScript: nullData})
+	msg := &wire.MsgBlock{
+		Header: wire.BlockHeader{
+			Version:      p.msg.Header.Version,
+			PrevBlock:    *parent,
+			VoteBits:     1,
+			Bits:         c.params.PowLimitBits,
+			SBits:        c.params.MinimumStakeDiff,
+			Height:       p.msg.Header.Height + 1,
+			Timestamp:    p.msg.Header.Timestamp.Add(c.params.TargetTimePerBlock),
+			Nonce:        c.nonce,
+			StakeVersion: p.msg.Header.StakeVersion,
+		},
+		Transactions: []*wire.MsgTx{coinbase},
+	}
+	for _, tx := range txs {
+		if stake.DetermineTxType(tx) == stake.TxTypeRegular {
+			msg.Transactions = append(msg.Transactions, tx)
+		} else {
+			msg.STransactions = append(msg.STransactions, tx)
+		}
+	}
+	msg.Header.MerkleRoot = standalone.CalcTxTreeMerkleRoot(msg.Transactions)
+
+	filter, err := blockcf2.Regular(msg, c)
+	if err != nil {
+		return nil, err
+	}
+	// The header commitment root of a single filter is the filter hash.
+	msg.Header.StakeRoot = filter.Hash()
+
+	for _, tree := range [][]*wire.MsgTx{msg.Transactions, msg.STransactions} {
+		for _, tx := range tree {
+			txHash := tx.TxHash()
+			treeType := wire.TxTreeRegular
+			if stake.DetermineTxType(tx) != stake.TxTypeRegular {
+				treeType = wire.TxTreeStake
+			}
+			for i, out := range tx.TxOut {
+				op := wire.OutPoint{Hash: txHash, Index: uint32(i), Tree: treeType}
+				c.prevScripts[op] = prevScript{out.Version, out.PkScript}
+			}
+		}
+	}
+
+	return &block{msg: msg, hash: msg.BlockHash(), filter: filter}, nil
+}
+
+// PrevScript implements blockcf2.PrevScripter.  Unknown previous outputs are
+// treated as empty scripts, so transactions may spend outputs which never
+// existed.  c.mu must be held.
+func (c *Chain) PrevScript(op *wire.OutPoint) (uint16, []byte, bool) {
+	s := c.prevScripts[*op]
+	return s.version, s.script, true
+}
+
+// connectBlock updates the main chain when b is the tip of a longer chain,
+// returning the blocks added to the main chain.  Transactions of removed
+// blocks are returned to the mempool and mined transactions are removed.
+// c.mu must be held.
+func (c *Chain) connectBlock(b *block) []*block {
+	height := int(b.msg.Header.Height)
+	if height < len(c.mainChain) {
+		return nil
+	}
+
+	var attached []*block
+	for n := b; ; n = c.blocks[n.msg.Header.PrevBlock] {
+		h := int(n.msg.Header.Height)
+		if h < len(c.mainChain) && c.mainChain[h] == n {
+			break
+		}
+		attached = append(attached, n)
+	}
+	for i, j := 0, len(attached)-1; i < j; i, j = i+1, j-1 {
+		attached[i], attached[j] = attached[j], attached[i]
+	}
+
+	forkHeight := int(attached[0].msg.Header.Height)
+	for _, removed := range c.mainChain[forkHeight:] {
+		for _, tx := range removed.msg.Transactions[1:] {
+			c.addMempoolTx(tx)
+		}
+		for _, tx := range removed.msg.STransactions {
+			c.addMempoolTx(tx)
+		}
+	}
+	c.mainChain = append(c.mainChain[:forkHeight], attached...)
+
+	mined := make(map[chainhash.Hash]struct{})
+	for _, n := range attached {
+		for _, tx := range n.msg.Transactions {
+			mined[tx.TxHash()] = struct{}{}
+		}
+		for _, tx := range n.msg.STransactions {
+			mined[tx.TxHash()] = struct{}{}
+		}
+	}
+	mempool := c.mempool[:0]
+	for _, tx := range c.mempool {
+		if _, ok := mined[tx.TxHash()]; !ok {
+			mempool = append(mempool, tx)
+		}
+	}
+	c.mempool = mempool
+
+	return attached
+}
+
+// addMempoolTx adds tx to the mempool if it is not already present.  c.mu
+// must be held.
+func (c *Chain) addMempoolTx(tx *wire.MsgTx) {
+	txHash := tx.TxHash()
+	for _, m := range c.mempool {
+		if m.TxHash() == txHash {
+			return
+		}
+	}
+	c.mempool = append(c.mempool, tx)
+}
+
+// relevant returns whether tx spends a watched outpoint or pays a watched
+// address.  Outputs paying watched addresses are added to the watched
+// outpoints.  c.mu must be held.
+func (c *Chain) relevant(tx *wire.MsgTx) bool {
+	var relevant bool
+	for _, in := range tx.TxIn {
+		if _, ok := c.watchedOutPoints[in.PreviousOutPoint]; ok {
+			relevant = true
+		}
+	}
+	txHash := tx.TxHash()
+	tree := wire.TxTreeRegular
+	if stake.DetermineTxType(tx) != stake.TxTypeRegular {
+		tree = wire.TxTreeStake
+	}
+	for i, out := range tx.TxOut {
+		_, addrs := stdscript.ExtractAddrs(out.Version, out.PkScript, c.params)
+		for _, a := range addrs {
+			if _, ok := c.watchedAddrs[a.String()]; ok {
+				relevant = true
+				op := wire.OutPoint{Hash: txHash, Index: uint32(i), Tree: tree}
+				c.watchedOutPoints[op] = struct{}{}
+			}
+		}
+	}
+	return relevant
+}
+
+// relevantBlockTxs returns the relevant regular and stake transactions of a
+// block.  c.mu must be held.
+func (c *Chain) relevantBlockTxs(b *wire.MsgBlock) []*wire.MsgTx {
+	var txs []*wire.MsgTx
+	for _, tree := range [][]*wire.MsgTx{b.Transactions, b.STransactions} {
+		for _, tx := range tree {
+			if c.relevant(tx) {
+				txs = append(txs, tx)
+			}
+		}
+	}
+	return txs
+}
+
+// notifyBlock notifies the wallet of a mined block, switching the wallet's
+// main chain when it extends the best chain.
+func (c *Chain) notifyBlock(ctx context.Context, b *block, relevantTxs map[chainhash.Hash][]*wire.MsgTx) error {
+	c.forestMu.Lock()
+	defer c.forestMu.Unlock()
+	if c.wallet == nil {
+		return nil
+	}
+
+	header := b.msg.Header
+	c.forest.AddBlockNode(wallet.NewBlockNode(&header, &b.hash, b.filter))
+	bestChain, err := c.wallet.EvaluateBestChain(ctx, &c.forest)
+	if err != nil {
+		return err
+	}
+	if len(bestChain) == 0 {
+		return nil
+	}
+	prevChain, err := c.wallet.ChainSwitch(ctx, &c.forest, bestChain, relevantTxs)
+	if err != nil {
+		return err
+	}
+	for _, n := range prevChain {
+		c.forest.AddBlockNode(n)
+	}
+	return nil
+}
+
+// Blocks implements wallet.NetworkBackend.
+func (c *Chain) Blocks(ctx context.Context, blockHashes []*chainhash.Hash) ([]*wire.MsgBlock, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	blocks := make([]*wire.MsgBlock, len(blockHashes))
+	for i, hash := range blockHashes {
+		b, ok := c.blocks[*hash]
+		if !ok {
+			return nil, errors.E(errors.NotExist, errors.Errorf("unknown "+
+				"block %v", hash))
+		}
+		blocks[i] = b.msg
+	}
+	return blocks, nil
+}
+
+// CFiltersV2 implements wallet.NetworkBackend.
+func (c *Chain) CFiltersV2(ctx context.Context, blockHashes []*chainhash.Hash) ([]wallet.FilterProof, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	filters := make([]wallet.FilterProof, len(blockHashes))
+	for i, hash := range blockHashes {
+		b, ok := c.blocks[*hash]
+		if !ok || b.filter == nil {
+			return nil, errors.E(errors.NotExist, errors.Errorf("no "+
+				"filter for block %v", hash))
+		}
+		filters[i] = wallet.FilterProof{Filter: b.filter}
+	}
+	return filters, nil
+}
+
+// PublishTransactions implements wallet.NetworkBackend by adding the
+// transactions to the mempool.
+func (c *Chain) PublishTransactions(ctx context.Context, txs ...*wire.MsgTx) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, tx := range txs {
+		c.addMempoolTx(tx)
+		c.relevant(tx)
+		c.published = append(c.published, tx)
+	}
+	return nil
+}
+
+// PublishMixMessages implements wallet.NetworkBackend.  Mixing is not
+// simulated and messages are discarded.
+func (c *Chain) PublishMixMessages(ctx context.Context, msgs ...mixing.Message) error {
+	return nil
+}
+
+// LoadTxFilter implements wallet.NetworkBackend.
+func (c *Chain) LoadTxFilter(ctx context.Context, reload bool, addrs []stdaddr.Address, outpoints []wire.OutPoint) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if reload {
+		c.watchedAddrs = make(map[string]struct{})
+		c.watchedOutPoints = make(map[wire.OutPoint]struct{})
+	}
+	for _, a := range addrs {
+		c.watchedAddrs[a.String()] = struct{}{}
+	}
+	for _, op := range outpoints {
+		c.watchedOutPoints[op] = struct{}{}
+	}
+	return nil
+}
+
+// Rescan implements wallet.NetworkBackend.
+func (c *Chain) Rescan(ctx context.Context, blocks []chainhash.Hash, save func(block *chainhash.Hash, txs []*wire.MsgTx) error) error {
+	for i := range blocks {
+		c.mu.Lock()
+		b, ok := c.blocks[blocks[i]]
+		var txs []*wire.MsgTx
+		if ok {
+			txs = c.relevantBlockTxs(b.msg)
+		}
+		c.mu.Unlock()
+		if !ok {
+			return errors.E(errors.NotExist, errors.Errorf("unknown "+
+				"block %v", &blocks[i]))
+		}
+		if len(txs) == 0 {
+			continue
+		}
+		if err := save(&blocks[i], txs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StakeDifficulty implements wallet.NetworkBackend.  Every simulated block
+// has the minimum stake difficulty.
+func (c *Chain) StakeDifficulty(ctx context.Context) (dcrutil.Amount, error) {
+	return dcrutil.Amount(c.params.MinimumStakeDiff), nil
+}
+
+// Synced implements wallet.NetworkBackend.  The simulated network is always
+// synced.
+func (c *Chain) Synced(ctx context.Context) (bool, int32) {
+	_, height := c.Tip()
+	return true, height
+}
+
+// Done implements wallet.NetworkBackend.  The simulated network never
+// disconnects.
+func (c *Chain) Done() <-chan struct{} { return nil }
+
+// Err implements wallet.NetworkBackend.
+func (c *Chain) Err() error { return nil }
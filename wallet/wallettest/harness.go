@@ -0,0 +1,97 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package wallettest provides a harness for integration testing applications
+// against wallet behavior.  A harness creates a wallet in a temporary
+// directory and connects it to a simulated chain, which mines scripted blocks,
+// reorganizes the chain, and accepts mempool transactions on demand without
+// running a dcrd simnet node.
+package wallettest
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"decred.org/dcrwallet/v5/wallet"
+	_ "decred.org/dcrwallet/v5/wallet/drivers/bdb" // driver loaded during init
+	"decred.org/dcrwallet/v5/wallet/txrules"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/dcrutil/v4"
+)
+
+// PrivatePassphrase is the private passphrase of wallets created by New.
+var PrivatePassphrase = []byte("private")
+
+// Config describes the wallet created by New.  All fields are optional.
+type Config struct {
+	// Params describes the simulated network.  Defaults to simnet.
+	Params *chaincfg.Params
+
+	// Seed is the wallet seed.  A random seed is used when nil.
+	Seed []byte
+
+	// GapLimit defaults to wallet.DefaultGapLimit.
+	GapLimit uint32
+
+	// RelayFee defaults to txrules.DefaultRelayFeePerKb.
+	RelayFee dcrutil.Amount
+}
+
+// Harness is a wallet connected to a simulated chain.
+type Harness struct {
+	Wallet *wallet.Wallet
+	Chain  *Chain
+}
+
+// New creates a wallet in a temporary directory of the test and connects it
+// to a new simulated chain.  The wallet database is closed when the test and
+// its subtests complete.  A nil cfg uses the defaults of all options.
+func New(t testing.TB, cfg *Config) *Harness {
+	t.Helper()
+
+	var c Config
+	if cfg != nil {
+		c = *cfg
+	}
+	if c.Params == nil {
+		c.Params = chaincfg.SimNetParams()
+	}
+	if c.GapLimit == 0 {
+		c.GapLimit = wallet.DefaultGapLimit
+	}
+	if c.RelayFee == 0 {
+		c.RelayFee = txrules.DefaultRelayFeePerKb
+	}
+
+	ctx := context.Background()
+	db, err := wallet.CreateDB("bdb", filepath.Join(t.TempDir(), "wallet.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	pubPass := []byte(wallet.InsecurePubPassphrase)
+	err = wallet.Create(ctx, db, pubPass, PrivatePassphrase, c.Seed, c.Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := wallet.Open(ctx, &wallet.Config{
+		DB:              db,
+		PubPassphrase:   pubPass,
+		GapLimit:        c.GapLimit,
+		AccountGapLimit: wallet.DefaultAccountGapLimit,
+		RelayFee:        c.RelayFee,
+		Params:          c.Params,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	chain := NewChain(c.Params)
+	if err := chain.Connect(ctx, w); err != nil {
+		t.Fatal(err)
+	}
+	return &Harness{Wallet: w, Chain: chain}
+}
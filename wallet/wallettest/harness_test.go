@@ -0,0 +1,117 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallettest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestHarness(t *testing.T) {
+	ctx := context.Background()
+	h := New(t, nil)
+	w := h.Wallet
+
+	balance := func(confs int32) dcrutil.Amount {
+		t.Helper()
+		b, err := w.AccountBalance(ctx, 0, confs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b.Spendable
+	}
+
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fund := h.Chain.FundingTx(addr, 10e8)
+	if err := h.Chain.AcceptTransaction(ctx, fund); err != nil {
+		t.Fatal(err)
+	}
+	// An irrelevant transaction is not notified to the wallet.
+	other := h.Chain.FundingTx(otherAddr(t, h), 1e8)
+	if err := h.Chain.AcceptTransaction(ctx, other); err != nil {
+		t.Fatal(err)
+	}
+	if b := balance(0); b != 10e8 {
+		t.Fatalf("unconfirmed balance %v, want 10 DCR", b)
+	}
+	if b := balance(1); b != 0 {
+		t.Fatalf("confirmed balance %v before mining", b)
+	}
+
+	if _, err := h.Chain.MineMempool(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(h.Chain.Mempool()) != 0 {
+		t.Fatal("mined transactions remain in mempool")
+	}
+	_, tipHeight := w.MainChainTip(ctx)
+	if tipHeight != 1 {
+		t.Fatalf("wallet tip height %d, want 1", tipHeight)
+	}
+	if b := balance(1); b != 10e8 {
+		t.Fatalf("confirmed balance %v, want 10 DCR", b)
+	}
+
+	// Reorganizing the funding block out of the main chain returns the
+	// transaction to the mempool and unconfirms it in the wallet.
+	if _, err := h.Chain.Reorg(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	tipHash, tipHeight := w.MainChainTip(ctx)
+	if chainTip, _ := h.Chain.Tip(); tipHash != chainTip || tipHeight != 2 {
+		t.Fatalf("wallet tip %v (height %d) not reorganized to %v",
+			&tipHash, tipHeight, &chainTip)
+	}
+	if b := balance(1); b != 0 {
+		t.Fatalf("confirmed balance %v after reorg", b)
+	}
+	if len(h.Chain.Mempool()) != 2 {
+		t.Fatalf("reorged transactions not returned to mempool")
+	}
+	if _, err := h.Chain.MineMempool(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if b := balance(1); b != 10e8 {
+		t.Fatalf("confirmed balance %v after remining, want 10 DCR", b)
+	}
+
+	// Transactions sent by the wallet are published to the chain.
+	if err := w.Unlock(ctx, PrivatePassphrase, nil); err != nil {
+		t.Fatal(err)
+	}
+	version, script := otherAddr(t, h).PaymentScript()
+	out := &wire.TxOut{Value: 1e8, Version: version, PkScript: script}
+	txHash, err := w.SendOutputs(ctx, []*wire.TxOut{out}, 0, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	published := h.Chain.Published()
+	if len(published) != 1 || published[0].TxHash() != *txHash {
+		t.Fatalf("sent transaction %v was not published", txHash)
+	}
+	if _, err := h.Chain.MineMempool(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if b := balance(1); b <= 8e8 || b >= 9e8 {
+		t.Fatalf("confirmed balance %v after send", b)
+	}
+}
+
+// otherAddr returns an address of another harness wallet.
+func otherAddr(t *testing.T, h *Harness) stdaddr.Address {
+	other := New(t, &Config{Params: h.Wallet.ChainParams()})
+	addr, err := other.Wallet.NewExternalAddress(context.Background(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return addr
+}
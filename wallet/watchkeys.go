@@ -0,0 +1,299 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/hdkeychain/v3"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+)
+
+// watchKeyChild is the hardened child of an account extended key from which
+// watch keys are derived.  It is distinct from the non-hardened external and
+// internal address branches, so watch keys reveal neither.
+const watchKeyChild = 2
+
+// maxWatchKeyWindow is the largest number of addresses of a watch key which
+// may be imported by a single request.
+const maxWatchKeyWindow = 1000
+
+// WatchKey is a single-branch extended public key which may be given to
+// third-party services to detect payments to an account.  Addresses are the
+// P2PKH addresses of the children Start through Start+Count-1 of Xpub.
+type WatchKey struct {
+	Xpub         *hdkeychain.ExtendedKey
+	Start, Count uint32
+}
+
+// AccountWatchKey returns the watch key of an account, and imports the private
+// keys of the addresses of the watch key's window into the account so
+// payments to them are detected and spendable by the account.
+//
+// The watch key is the external branch of the account key's hardened
+// watchKeyChild child, and unlike the account xpub, neither the account's
+// internal branch nor any other account address can be derived from it.  The
+// wallet must be unlocked, and accounts with unique passphrases are not
+// supported.
+//
+// Windows may be extended with further requests, and addresses of the window
+// which were previously imported are skipped.  See ImportPublicKeyToAccount
+// for the rescan of the scan height.
+//
+// Watch keys are derived from the seed, so when restoring a wallet, address
+// discovery imports the keys of used watch key addresses and the gap limit
+// addresses following them.
+func (w *Wallet) AccountWatchKey(ctx context.Context, account, start, count uint32,
+	scanFrom int32) (*WatchKey, *ImportRescan, error) {
+
+	const op errors.Op = "wallet.AccountWatchKey"
+	if count == 0 || count > maxWatchKeyWindow {
+		return nil, nil, errors.E(op, errors.Invalid,
+			errors.Errorf("window size must be between 1 and %d", maxWatchKeyWindow))
+	}
+	if start >= hdkeychain.HardenedKeyStart || count > hdkeychain.HardenedKeyStart-start {
+		return nil, nil, errors.E(op, errors.Invalid, "window exceeds non-hardened children")
+	}
+	if err := w.checkVoteOnly(); err != nil {
+		return nil, nil, errors.E(op, err)
+	}
+
+	var watchKey *WatchKey
+	var addrs []stdaddr.Address
+	var props *udb.AccountProperties
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		branchXpriv, err := w.watchKeyBranch(dbtx, account)
+		if err != nil {
+			return err
+		}
+		defer branchXpriv.Zero()
+		addrs, err = w.importWatchKeys(ns, branchXpriv, account, start, start+count)
+		if err != nil {
+			return err
+		}
+		props, err = w.manager.AccountProperties(ns, account)
+		if err != nil {
+			return err
+		}
+		scanFrom, err = w.recordImportRescan(dbtx, scanFrom)
+		if err != nil {
+			return err
+		}
+		// The neutered key shares the chain code of the private key, so
+		// a copy is returned.
+		xpub, err := hdkeychain.NewKeyFromString(branchXpriv.Neuter().String(),
+			w.chainParams)
+		if err != nil {
+			return err
+		}
+		watchKey = &WatchKey{
+			Xpub:  xpub,
+			Start: start,
+			Count: count,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, errors.E(op, err)
+	}
+
+	if len(addrs) == 0 {
+		return watchKey, nil, nil
+	}
+	if n, err := w.NetworkBackend(); err == nil {
+		err := n.LoadTxFilter(ctx, false, addrs, nil)
+		if err != nil {
+			return nil, nil, errors.E(op, err)
+		}
+	}
+	log.Infof("Imported %d watch key addresses to account %d", len(addrs), account)
+	w.NtfnServer.notifyAccountProperties(props)
+	return watchKey, w.importRescan(scanFrom), nil
+}
+
+// watchKeyBranch returns the extended private key of the watch key branch of
+// an account.  The caller must zero the key.
+func (w *Wallet) watchKeyBranch(dbtx walletdb.ReadTx, account uint32) (*hdkeychain.ExtendedKey, error) {
+	ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+	props, err := w.manager.AccountProperties(ns, account)
+	if err != nil {
+		return nil, err
+	}
+	if props.AccountEncrypted {
+		return nil, errors.E(errors.Invalid, "watch keys are not supported "+
+			"by accounts with unique passphrases")
+	}
+	acctXpriv, err := w.manager.AccountExtendedPrivKey(dbtx, account)
+	if err != nil {
+		return nil, err
+	}
+	watchXpriv, err := acctXpriv.Child(hdkeychain.HardenedKeyStart + watchKeyChild)
+	if err != nil {
+		return nil, err
+	}
+	branchXpriv, err := watchXpriv.Child(udb.ExternalBranch)
+	watchXpriv.Zero()
+	return branchXpriv, err
+}
+
+// importWatchKeys imports the private keys of the children start through
+// end-1 of a watch key branch into an account, and records the end of the
+// imported window so address discovery imports at least as many keys when
+// the wallet is restored.  Keys which were previously imported are skipped,
+// and the addresses of newly imported keys are returned.
+func (w *Wallet) importWatchKeys(ns walletdb.ReadWriteBucket, branchXpriv *hdkeychain.ExtendedKey,
+	account, start, end uint32) ([]stdaddr.Address, error) {
+
+	var addrs []stdaddr.Address
+	for i := start; i < end; i++ {
+		child, err := branchXpriv.Child(i)
+		if errors.Is(err, hdkeychain.ErrInvalidChild) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		// The serialized private key shares memory with the child key,
+		// which is zeroed after the import.
+		priv, err := child.SerializedPrivKey()
+		if err != nil {
+			child.Zero()
+			return nil, err
+		}
+		wif, err := dcrutil.NewWIF(priv, w.chainParams.PrivateKeyID,
+			dcrec.STEcdsaSecp256k1)
+		if err != nil {
+			child.Zero()
+			return nil, err
+		}
+		ma, err := w.manager.ImportPrivateKeyToAccount(ns, wif, account)
+		child.Zero()
+		if errors.Is(err, errors.Exist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, ma.Address())
+	}
+	if err := w.manager.PutWatchKeyEnd(ns, account, end); err != nil {
+		return nil, err
+	}
+	return addrs, nil
+}
+
+// discoverWatchKeys searches for usage of the watch key addresses of each
+// account beginning at startBlock, and imports the private keys of used
+// addresses and of the gap limit addresses following them, so that payments
+// to watch keys are recovered when restoring a wallet from seed.  Keys are
+// imported through at least the end of any previously imported window.  The
+// wallet must be unlocked, and accounts with unique passphrases (which have no
+// watch keys) are skipped.
+//
+// It returns whether usage of any watch key address was found.
+func (w *Wallet) discoverWatchKeys(ctx context.Context, n NetworkBackend,
+	blockCache blockCommitmentCache, startBlock *chainhash.Hash, gapLimit uint32) (bool, error) {
+
+	var usage []accountUsage
+	segments := hdkeychain.HardenedKeyStart / gapLimit
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		lastAcct, err := w.manager.LastAccount(ns)
+		if err != nil {
+			return err
+		}
+		for acct := uint32(0); acct <= lastAcct; acct++ {
+			branchXpriv, err := w.watchKeyBranch(dbtx, acct)
+			if errors.Is(err, errors.Invalid) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			xpub, err := hdkeychain.NewKeyFromString(branchXpriv.Neuter().String(),
+				w.chainParams)
+			branchXpriv.Zero()
+			if err != nil {
+				return err
+			}
+			// Only the external branch of each usage is searched.
+			usage = append(usage, accountUsage{
+				account:     acct,
+				extkey:      xpub,
+				intkey:      xpub,
+				extLastUsed: ^uint32(0),
+				intLastUsed: ^uint32(0),
+				exthi:       segments - 1,
+				intlo:       1,
+				gapLimit:    gapLimit,
+				segments:    segments,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if rpc, ok := n.(usedAddressesQuerier); ok {
+		f := existsAddrIndexFinder{w, rpc, gapLimit}
+		for i := range usage {
+			u := &usage[i]
+			u.extLastUsed, err = f.findLastUsedAddress(ctx, u.extkey, gapLimit)
+			if err != nil {
+				return false, err
+			}
+		}
+	} else {
+		finder := &addrFinder{w: w, usage: usage, commitments: blockCache}
+		if err := finder.find(ctx, startBlock, n); err != nil {
+			return false, err
+		}
+	}
+
+	var found bool
+	for i := range usage {
+		u := &usage[i]
+		var end uint32
+		if u.extLastUsed != ^uint32(0) {
+			found = true
+			end = min(u.extLastUsed+1+gapLimit, hdkeychain.HardenedKeyStart)
+		}
+		var addrs []stdaddr.Address
+		err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+			ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+			recorded, err := w.manager.WatchKeyEnd(ns, u.account)
+			if err != nil {
+				return err
+			}
+			end = max(end, recorded)
+			if end == 0 {
+				return nil
+			}
+			branchXpriv, err := w.watchKeyBranch(dbtx, u.account)
+			if err != nil {
+				return err
+			}
+			defer branchXpriv.Zero()
+			addrs, err = w.importWatchKeys(ns, branchXpriv, u.account, 0, end)
+			return err
+		})
+		if err != nil {
+			return false, err
+		}
+		if len(addrs) != 0 {
+			log.Infof("Imported %d discovered watch key addresses to account %d",
+				len(addrs), u.account)
+		}
+	}
+	return found, nil
+}
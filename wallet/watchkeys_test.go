@@ -0,0 +1,158 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/hdkeychain/v3"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+)
+
+// watchKeyAddr returns the address of a child of a watch key.
+func watchKeyAddr(t *testing.T, w *Wallet, key *WatchKey, i uint32) stdaddr.Address {
+	t.Helper()
+	child, err := key.Xpub.Child(i)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(
+		stdaddr.Hash160(child.SerializedPubKey()), w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return addr
+}
+
+func TestAccountWatchKey(t *testing.T) {
+	ctx := context.Background()
+	w, teardown := testWallet(ctx, t, &basicWalletConfig, nil)
+	defer teardown()
+
+	_, _, err := w.AccountWatchKey(ctx, 0, 0, 5, NoRescan)
+	if !errors.Is(err, errors.Locked) {
+		t.Fatalf("watch key of locked wallet: %v", err)
+	}
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := w.AccountWatchKey(ctx, 0, 0, 0, NoRescan); !errors.Is(err, errors.Invalid) {
+		t.Errorf("empty window: %v", err)
+	}
+
+	key, _, err := w.AccountWatchKey(ctx, 0, 0, 5, NoRescan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Extending the window skips imported addresses.
+	key2, _, err := w.AccountWatchKey(ctx, 0, 3, 5, NoRescan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.Xpub.String() != key2.Xpub.String() {
+		t.Fatalf("watch key changed")
+	}
+	acctXpub, err := w.AccountXpub(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	extXpub, err := acctXpub.Child(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.Xpub.String() == extXpub.String() {
+		t.Fatalf("watch key is the account external branch")
+	}
+
+	// Addresses of the window belong to the account and are spendable.
+	for i := uint32(0); i < 8; i++ {
+		addr := watchKeyAddr(t, w, key, i)
+		err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+			ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+			ma, err := w.manager.Address(ns, addr)
+			if err != nil {
+				return err
+			}
+			if ma.Account() != 0 {
+				t.Errorf("address %d has account %d", i, ma.Account())
+			}
+			have, err := w.manager.HavePrivateKey(ns, addr)
+			if err != nil {
+				return err
+			}
+			if !have {
+				t.Errorf("address %d is not spendable", i)
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("address %d: %v", i, err)
+		}
+	}
+}
+
+func TestDiscoverWatchKeys(t *testing.T) {
+	ctx := context.Background()
+	seed := bytes.Repeat([]byte{0x3c}, hdkeychain.RecommendedSeedLen)
+	cfg := basicWalletConfig
+	cfg.DisableCoinTypeUpgrades = true
+
+	w, teardown := testWallet(ctx, t, &cfg, seed)
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	key, _, err := w.AccountWatchKey(ctx, 0, 0, 5, NoRescan)
+	teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A wallet restored from the same seed discovers the watch key
+	// addresses paid by a third party.
+	const used = 12
+	n := usedAddrsNetwork{used: map[string]struct{}{
+		watchKeyAddr(t, w, key, used).String(): {},
+	}}
+	w, teardown = testWallet(ctx, t, &cfg, seed)
+	defer teardown()
+	if err := w.Unlock(ctx, testPrivPass, nil); err != nil {
+		t.Fatal(err)
+	}
+	err = w.DiscoverActiveAddresses(ctx, n, &w.chainParams.GenesisHash, true, cfg.GapLimit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		for _, i := range []uint32{0, used, used + cfg.GapLimit} {
+			have, err := w.manager.HavePrivateKey(ns, watchKeyAddr(t, w, key, i))
+			if err != nil {
+				return err
+			}
+			if !have {
+				t.Errorf("watch key address %d was not imported", i)
+			}
+		}
+		_, err := w.manager.Address(ns, watchKeyAddr(t, w, key, used+cfg.GapLimit+1))
+		if !errors.Is(err, errors.NotExist) {
+			t.Errorf("imported watch key address beyond the gap limit: %v", err)
+		}
+		end, err := w.manager.WatchKeyEnd(ns, 0)
+		if err != nil {
+			return err
+		}
+		if end != used+cfg.GapLimit+1 {
+			t.Errorf("watch key end %d, want %d", end, used+cfg.GapLimit+1)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}